@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName  = "/cosmos.auth.v1beta1.Msg/UpdateParams"
-	Msg_NonAtomicExec_FullMethodName = "/cosmos.auth.v1beta1.Msg/NonAtomicExec"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_NonAtomicExec_FullMethodName         = "/cosmos.auth.v1beta1.Msg/NonAtomicExec"
+	Msg_SetPruningOptOut_FullMethodName      = "/cosmos.auth.v1beta1.Msg/SetPruningOptOut"
+	Msg_CreateMultisigAccount_FullMethodName = "/cosmos.auth.v1beta1.Msg/CreateMultisigAccount"
+	Msg_UpdateMultisigPolicy_FullMethodName  = "/cosmos.auth.v1beta1.Msg/UpdateMultisigPolicy"
+	Msg_ChangePubKey_FullMethodName          = "/cosmos.auth.v1beta1.Msg/ChangePubKey"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +36,18 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// NonAtomicExec allows users to submit multiple messages for non-atomic execution.
 	NonAtomicExec(ctx context.Context, in *MsgNonAtomicExec, opts ...grpc.CallOption) (*MsgNonAtomicExecResponse, error)
+	// SetPruningOptOut allows an account to opt out of (or back into) dormant
+	// account pruning.
+	SetPruningOptOut(ctx context.Context, in *MsgSetPruningOptOut, opts ...grpc.CallOption) (*MsgSetPruningOptOutResponse, error)
+	// CreateMultisigAccount creates a new multisig account controlled by the
+	// given policy.
+	CreateMultisigAccount(ctx context.Context, in *MsgCreateMultisigAccount, opts ...grpc.CallOption) (*MsgCreateMultisigAccountResponse, error)
+	// UpdateMultisigPolicy replaces the policy of a multisig account. The msg is
+	// signed by the multisig account itself, and thus per its current policy.
+	UpdateMultisigPolicy(ctx context.Context, in *MsgUpdateMultisigPolicy, opts ...grpc.CallOption) (*MsgUpdateMultisigPolicyResponse, error)
+	// ChangePubKey rotates the public key of an account, keeping its address,
+	// balances and other state. The msg is signed with the current key.
+	ChangePubKey(ctx context.Context, in *MsgChangePubKey, opts ...grpc.CallOption) (*MsgChangePubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -60,6 +76,42 @@ func (c *msgClient) NonAtomicExec(ctx context.Context, in *MsgNonAtomicExec, opt
 	return out, nil
 }
 
+func (c *msgClient) SetPruningOptOut(ctx context.Context, in *MsgSetPruningOptOut, opts ...grpc.CallOption) (*MsgSetPruningOptOutResponse, error) {
+	out := new(MsgSetPruningOptOutResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPruningOptOut_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMultisigAccount(ctx context.Context, in *MsgCreateMultisigAccount, opts ...grpc.CallOption) (*MsgCreateMultisigAccountResponse, error) {
+	out := new(MsgCreateMultisigAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateMultisigAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMultisigPolicy(ctx context.Context, in *MsgUpdateMultisigPolicy, opts ...grpc.CallOption) (*MsgUpdateMultisigPolicyResponse, error) {
+	out := new(MsgUpdateMultisigPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMultisigPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangePubKey(ctx context.Context, in *MsgChangePubKey, opts ...grpc.CallOption) (*MsgChangePubKeyResponse, error) {
+	out := new(MsgChangePubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_ChangePubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -69,6 +121,18 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// NonAtomicExec allows users to submit multiple messages for non-atomic execution.
 	NonAtomicExec(context.Context, *MsgNonAtomicExec) (*MsgNonAtomicExecResponse, error)
+	// SetPruningOptOut allows an account to opt out of (or back into) dormant
+	// account pruning.
+	SetPruningOptOut(context.Context, *MsgSetPruningOptOut) (*MsgSetPruningOptOutResponse, error)
+	// CreateMultisigAccount creates a new multisig account controlled by the
+	// given policy.
+	CreateMultisigAccount(context.Context, *MsgCreateMultisigAccount) (*MsgCreateMultisigAccountResponse, error)
+	// UpdateMultisigPolicy replaces the policy of a multisig account. The msg is
+	// signed by the multisig account itself, and thus per its current policy.
+	UpdateMultisigPolicy(context.Context, *MsgUpdateMultisigPolicy) (*MsgUpdateMultisigPolicyResponse, error)
+	// ChangePubKey rotates the public key of an account, keeping its address,
+	// balances and other state. The msg is signed with the current key.
+	ChangePubKey(context.Context, *MsgChangePubKey) (*MsgChangePubKeyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -82,6 +146,18 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) NonAtomicExec(context.Context, *MsgNonAtomicExec) (*MsgNonAtomicExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NonAtomicExec not implemented")
 }
+func (UnimplementedMsgServer) SetPruningOptOut(context.Context, *MsgSetPruningOptOut) (*MsgSetPruningOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPruningOptOut not implemented")
+}
+func (UnimplementedMsgServer) CreateMultisigAccount(context.Context, *MsgCreateMultisigAccount) (*MsgCreateMultisigAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisigAccount not implemented")
+}
+func (UnimplementedMsgServer) UpdateMultisigPolicy(context.Context, *MsgUpdateMultisigPolicy) (*MsgUpdateMultisigPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMultisigPolicy not implemented")
+}
+func (UnimplementedMsgServer) ChangePubKey(context.Context, *MsgChangePubKey) (*MsgChangePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePubKey not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +207,78 @@ func _Msg_NonAtomicExec_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPruningOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPruningOptOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPruningOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPruningOptOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPruningOptOut(ctx, req.(*MsgSetPruningOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMultisigAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMultisigAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateMultisigAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMultisigAccount(ctx, req.(*MsgCreateMultisigAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMultisigPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMultisigPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMultisigPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMultisigPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMultisigPolicy(ctx, req.(*MsgUpdateMultisigPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChangePubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangePubKey(ctx, req.(*MsgChangePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +294,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NonAtomicExec",
 			Handler:    _Msg_NonAtomicExec_Handler,
 		},
+		{
+			MethodName: "SetPruningOptOut",
+			Handler:    _Msg_SetPruningOptOut_Handler,
+		},
+		{
+			MethodName: "CreateMultisigAccount",
+			Handler:    _Msg_CreateMultisigAccount_Handler,
+		},
+		{
+			MethodName: "UpdateMultisigPolicy",
+			Handler:    _Msg_UpdateMultisigPolicy_Handler,
+		},
+		{
+			MethodName: "ChangePubKey",
+			Handler:    _Msg_ChangePubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -25,6 +25,7 @@ const (
 	Query_SpendableBalanceByDenom_FullMethodName    = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
 	Query_TotalSupply_FullMethodName                = "/cosmos.bank.v1beta1.Query/TotalSupply"
 	Query_SupplyOf_FullMethodName                   = "/cosmos.bank.v1beta1.Query/SupplyOf"
+	Query_TotalSupplyOf_FullMethodName              = "/cosmos.bank.v1beta1.Query/TotalSupplyOf"
 	Query_Params_FullMethodName                     = "/cosmos.bank.v1beta1.Query/Params"
 	Query_DenomMetadata_FullMethodName              = "/cosmos.bank.v1beta1.Query/DenomMetadata"
 	Query_DenomMetadataByQueryString_FullMethodName = "/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString"
@@ -32,6 +33,13 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_Escrow_FullMethodName                     = "/cosmos.bank.v1beta1.Query/Escrow"
+	Query_Escrows_FullMethodName                    = "/cosmos.bank.v1beta1.Query/Escrows"
+	Query_PeriodicPayment_FullMethodName            = "/cosmos.bank.v1beta1.Query/PeriodicPayment"
+	Query_PeriodicPaymentsBySender_FullMethodName   = "/cosmos.bank.v1beta1.Query/PeriodicPaymentsBySender"
+	Query_BalanceHistory_FullMethodName             = "/cosmos.bank.v1beta1.Query/BalanceHistory"
+	Query_HTLC_FullMethodName                       = "/cosmos.bank.v1beta1.Query/HTLC"
+	Query_HTLCsBySender_FullMethodName              = "/cosmos.bank.v1beta1.Query/HTLCsBySender"
 )
 
 // QueryClient is the client API for Query service.
@@ -66,6 +74,12 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// TotalSupplyOf queries the supply of the coins whose denom starts with the
+	// given prefix (e.g. "ibc/"), ordered by denom.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	TotalSupplyOf(ctx context.Context, in *QueryTotalSupplyOfRequest, opts ...grpc.CallOption) (*QueryTotalSupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -90,6 +104,24 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Escrow queries a pending escrow by module and id.
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// Escrows queries the pending escrows of a module.
+	Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error)
+	// PeriodicPayment queries a periodic payment by its id.
+	PeriodicPayment(ctx context.Context, in *QueryPeriodicPaymentRequest, opts ...grpc.CallOption) (*QueryPeriodicPaymentResponse, error)
+	// PeriodicPaymentsBySender queries the periodic payments of a sender.
+	PeriodicPaymentsBySender(ctx context.Context, in *QueryPeriodicPaymentsBySenderRequest, opts ...grpc.CallOption) (*QueryPeriodicPaymentsBySenderResponse, error)
+	// BalanceHistory queries the balance of a single coin of an account at a
+	// given height from the balance history index of the node.
+	//
+	// The index is node-local and only available on nodes enabling it in their
+	// app.toml, this query is not module query safe.
+	BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error)
+	// HTLC queries a hashed timelock transfer by its id.
+	HTLC(ctx context.Context, in *QueryHTLCRequest, opts ...grpc.CallOption) (*QueryHTLCResponse, error)
+	// HTLCsBySender queries the pending hashed timelock transfers of a sender.
+	HTLCsBySender(ctx context.Context, in *QueryHTLCsBySenderRequest, opts ...grpc.CallOption) (*QueryHTLCsBySenderResponse, error)
 }
 
 type queryClient struct {
@@ -154,6 +186,15 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 	return out, nil
 }
 
+func (c *queryClient) TotalSupplyOf(ctx context.Context, in *QueryTotalSupplyOfRequest, opts ...grpc.CallOption) (*QueryTotalSupplyOfResponse, error) {
+	out := new(QueryTotalSupplyOfResponse)
+	err := c.cc.Invoke(ctx, Query_TotalSupplyOf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -217,6 +258,69 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_Escrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error) {
+	out := new(QueryEscrowsResponse)
+	err := c.cc.Invoke(ctx, Query_Escrows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PeriodicPayment(ctx context.Context, in *QueryPeriodicPaymentRequest, opts ...grpc.CallOption) (*QueryPeriodicPaymentResponse, error) {
+	out := new(QueryPeriodicPaymentResponse)
+	err := c.cc.Invoke(ctx, Query_PeriodicPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PeriodicPaymentsBySender(ctx context.Context, in *QueryPeriodicPaymentsBySenderRequest, opts ...grpc.CallOption) (*QueryPeriodicPaymentsBySenderResponse, error) {
+	out := new(QueryPeriodicPaymentsBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_PeriodicPaymentsBySender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error) {
+	out := new(QueryBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HTLC(ctx context.Context, in *QueryHTLCRequest, opts ...grpc.CallOption) (*QueryHTLCResponse, error) {
+	out := new(QueryHTLCResponse)
+	err := c.cc.Invoke(ctx, Query_HTLC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HTLCsBySender(ctx context.Context, in *QueryHTLCsBySenderRequest, opts ...grpc.CallOption) (*QueryHTLCsBySenderResponse, error) {
+	out := new(QueryHTLCsBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_HTLCsBySender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +353,12 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// TotalSupplyOf queries the supply of the coins whose denom starts with the
+	// given prefix (e.g. "ibc/"), ordered by denom.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	TotalSupplyOf(context.Context, *QueryTotalSupplyOfRequest) (*QueryTotalSupplyOfResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -273,6 +383,24 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Escrow queries a pending escrow by module and id.
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// Escrows queries the pending escrows of a module.
+	Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error)
+	// PeriodicPayment queries a periodic payment by its id.
+	PeriodicPayment(context.Context, *QueryPeriodicPaymentRequest) (*QueryPeriodicPaymentResponse, error)
+	// PeriodicPaymentsBySender queries the periodic payments of a sender.
+	PeriodicPaymentsBySender(context.Context, *QueryPeriodicPaymentsBySenderRequest) (*QueryPeriodicPaymentsBySenderResponse, error)
+	// BalanceHistory queries the balance of a single coin of an account at a
+	// given height from the balance history index of the node.
+	//
+	// The index is node-local and only available on nodes enabling it in their
+	// app.toml, this query is not module query safe.
+	BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error)
+	// HTLC queries a hashed timelock transfer by its id.
+	HTLC(context.Context, *QueryHTLCRequest) (*QueryHTLCResponse, error)
+	// HTLCsBySender queries the pending hashed timelock transfers of a sender.
+	HTLCsBySender(context.Context, *QueryHTLCsBySenderRequest) (*QueryHTLCsBySenderResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +426,9 @@ func (UnimplementedQueryServer) TotalSupply(context.Context, *QueryTotalSupplyRe
 func (UnimplementedQueryServer) SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
+func (UnimplementedQueryServer) TotalSupplyOf(context.Context, *QueryTotalSupplyOfRequest) (*QueryTotalSupplyOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSupplyOf not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -319,6 +450,27 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (UnimplementedQueryServer) Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrows not implemented")
+}
+func (UnimplementedQueryServer) PeriodicPayment(context.Context, *QueryPeriodicPaymentRequest) (*QueryPeriodicPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodicPayment not implemented")
+}
+func (UnimplementedQueryServer) PeriodicPaymentsBySender(context.Context, *QueryPeriodicPaymentsBySenderRequest) (*QueryPeriodicPaymentsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodicPaymentsBySender not implemented")
+}
+func (UnimplementedQueryServer) BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceHistory not implemented")
+}
+func (UnimplementedQueryServer) HTLC(context.Context, *QueryHTLCRequest) (*QueryHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HTLC not implemented")
+}
+func (UnimplementedQueryServer) HTLCsBySender(context.Context, *QueryHTLCsBySenderRequest) (*QueryHTLCsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HTLCsBySender not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -440,6 +592,24 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalSupplyOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSupplyOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSupplyOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalSupplyOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSupplyOf(ctx, req.(*QueryTotalSupplyOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -566,6 +736,132 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrows(ctx, req.(*QueryEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PeriodicPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodicPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PeriodicPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PeriodicPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PeriodicPayment(ctx, req.(*QueryPeriodicPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PeriodicPaymentsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodicPaymentsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PeriodicPaymentsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PeriodicPaymentsBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PeriodicPaymentsBySender(ctx, req.(*QueryPeriodicPaymentsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceHistory(ctx, req.(*QueryBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HTLC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HTLC(ctx, req.(*QueryHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HTLCsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHTLCsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HTLCsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HTLCsBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HTLCsBySender(ctx, req.(*QueryHTLCsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -597,6 +893,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "TotalSupplyOf",
+			Handler:    _Query_TotalSupplyOf_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -625,6 +925,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "Escrows",
+			Handler:    _Query_Escrows_Handler,
+		},
+		{
+			MethodName: "PeriodicPayment",
+			Handler:    _Query_PeriodicPayment_Handler,
+		},
+		{
+			MethodName: "PeriodicPaymentsBySender",
+			Handler:    _Query_PeriodicPaymentsBySender_Handler,
+		},
+		{
+			MethodName: "BalanceHistory",
+			Handler:    _Query_BalanceHistory_Handler,
+		},
+		{
+			MethodName: "HTLC",
+			Handler:    _Query_HTLC_Handler,
+		},
+		{
+			MethodName: "HTLCsBySender",
+			Handler:    _Query_HTLCsBySender_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                  = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName             = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName                  = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName        = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_UpdateDenomMetadata_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateDenomMetadata"
+	Msg_CreatePeriodicPayment_FullMethodName = "/cosmos.bank.v1beta1.Msg/CreatePeriodicPayment"
+	Msg_CancelPeriodicPayment_FullMethodName = "/cosmos.bank.v1beta1.Msg/CancelPeriodicPayment"
+	Msg_CreateHTLC_FullMethodName            = "/cosmos.bank.v1beta1.Msg/CreateHTLC"
+	Msg_ClaimHTLC_FullMethodName             = "/cosmos.bank.v1beta1.Msg/ClaimHTLC"
+	Msg_RefundHTLC_FullMethodName            = "/cosmos.bank.v1beta1.Msg/RefundHTLC"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +50,25 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// UpdateDenomMetadata is a governance operation for setting the metadata of
+	// a denom, e.g. the display denom and exponent of an IBC denom. The existing
+	// metadata of the denom, if any, is replaced.
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// CreatePeriodicPayment defines a method for registering a recurring send of
+	// coins from the sender to a recipient.
+	CreatePeriodicPayment(ctx context.Context, in *MsgCreatePeriodicPayment, opts ...grpc.CallOption) (*MsgCreatePeriodicPaymentResponse, error)
+	// CancelPeriodicPayment defines a method for the sender to cancel one of its
+	// periodic payments.
+	CancelPeriodicPayment(ctx context.Context, in *MsgCancelPeriodicPayment, opts ...grpc.CallOption) (*MsgCancelPeriodicPaymentResponse, error)
+	// CreateHTLC defines a method for locking coins of the sender under a hash
+	// lock until the recipient claims them or they expire.
+	CreateHTLC(ctx context.Context, in *MsgCreateHTLC, opts ...grpc.CallOption) (*MsgCreateHTLCResponse, error)
+	// ClaimHTLC defines a method for sending the coins of an HTLC to its
+	// recipient by revealing the preimage of its hash lock.
+	ClaimHTLC(ctx context.Context, in *MsgClaimHTLC, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error)
+	// RefundHTLC defines a method for refunding the coins of an expired HTLC to
+	// its sender.
+	RefundHTLC(ctx context.Context, in *MsgRefundHTLC, opts ...grpc.CallOption) (*MsgRefundHTLCResponse, error)
 }
 
 type msgClient struct {
@@ -99,6 +124,60 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePeriodicPayment(ctx context.Context, in *MsgCreatePeriodicPayment, opts ...grpc.CallOption) (*MsgCreatePeriodicPaymentResponse, error) {
+	out := new(MsgCreatePeriodicPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_CreatePeriodicPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPeriodicPayment(ctx context.Context, in *MsgCancelPeriodicPayment, opts ...grpc.CallOption) (*MsgCancelPeriodicPaymentResponse, error) {
+	out := new(MsgCancelPeriodicPaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPeriodicPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateHTLC(ctx context.Context, in *MsgCreateHTLC, opts ...grpc.CallOption) (*MsgCreateHTLCResponse, error) {
+	out := new(MsgCreateHTLCResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateHTLC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimHTLC(ctx context.Context, in *MsgClaimHTLC, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error) {
+	out := new(MsgClaimHTLCResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimHTLC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundHTLC(ctx context.Context, in *MsgRefundHTLC, opts ...grpc.CallOption) (*MsgRefundHTLCResponse, error) {
+	out := new(MsgRefundHTLCResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundHTLC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -117,6 +196,25 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// UpdateDenomMetadata is a governance operation for setting the metadata of
+	// a denom, e.g. the display denom and exponent of an IBC denom. The existing
+	// metadata of the denom, if any, is replaced.
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// CreatePeriodicPayment defines a method for registering a recurring send of
+	// coins from the sender to a recipient.
+	CreatePeriodicPayment(context.Context, *MsgCreatePeriodicPayment) (*MsgCreatePeriodicPaymentResponse, error)
+	// CancelPeriodicPayment defines a method for the sender to cancel one of its
+	// periodic payments.
+	CancelPeriodicPayment(context.Context, *MsgCancelPeriodicPayment) (*MsgCancelPeriodicPaymentResponse, error)
+	// CreateHTLC defines a method for locking coins of the sender under a hash
+	// lock until the recipient claims them or they expire.
+	CreateHTLC(context.Context, *MsgCreateHTLC) (*MsgCreateHTLCResponse, error)
+	// ClaimHTLC defines a method for sending the coins of an HTLC to its
+	// recipient by revealing the preimage of its hash lock.
+	ClaimHTLC(context.Context, *MsgClaimHTLC) (*MsgClaimHTLCResponse, error)
+	// RefundHTLC defines a method for refunding the coins of an expired HTLC to
+	// its sender.
+	RefundHTLC(context.Context, *MsgRefundHTLC) (*MsgRefundHTLCResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -139,6 +237,24 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) CreatePeriodicPayment(context.Context, *MsgCreatePeriodicPayment) (*MsgCreatePeriodicPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicPayment not implemented")
+}
+func (UnimplementedMsgServer) CancelPeriodicPayment(context.Context, *MsgCancelPeriodicPayment) (*MsgCancelPeriodicPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPeriodicPayment not implemented")
+}
+func (UnimplementedMsgServer) CreateHTLC(context.Context, *MsgCreateHTLC) (*MsgCreateHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHTLC not implemented")
+}
+func (UnimplementedMsgServer) ClaimHTLC(context.Context, *MsgClaimHTLC) (*MsgClaimHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHTLC not implemented")
+}
+func (UnimplementedMsgServer) RefundHTLC(context.Context, *MsgRefundHTLC) (*MsgRefundHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundHTLC not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -242,6 +358,114 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePeriodicPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePeriodicPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePeriodicPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreatePeriodicPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePeriodicPayment(ctx, req.(*MsgCreatePeriodicPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPeriodicPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPeriodicPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPeriodicPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPeriodicPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPeriodicPayment(ctx, req.(*MsgCancelPeriodicPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateHTLC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateHTLC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateHTLC(ctx, req.(*MsgCreateHTLC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHTLC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimHTLC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHTLC(ctx, req.(*MsgClaimHTLC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundHTLC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundHTLC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundHTLC(ctx, req.(*MsgRefundHTLC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +493,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "CreatePeriodicPayment",
+			Handler:    _Msg_CreatePeriodicPayment_Handler,
+		},
+		{
+			MethodName: "CancelPeriodicPayment",
+			Handler:    _Msg_CancelPeriodicPayment_Handler,
+		},
+		{
+			MethodName: "CreateHTLC",
+			Handler:    _Msg_CreateHTLC_Handler,
+		},
+		{
+			MethodName: "ClaimHTLC",
+			Handler:    _Msg_ClaimHTLC_Handler,
+		},
+		{
+			MethodName: "RefundHTLC",
+			Handler:    _Msg_RefundHTLC_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/batch/v1beta1/batch.proto
+
+package batchv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BatchQueryService_BatchQuery_FullMethodName = "/cosmos.base.batch.v1beta1.BatchQueryService/BatchQuery"
+)
+
+// BatchQueryServiceClient is the client API for BatchQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BatchQueryServiceClient interface {
+	// BatchQuery executes a batch of queries of any query services, at the same
+	// height. The batch fails if any of its queries fails.
+	BatchQuery(ctx context.Context, in *BatchQueryRequest, opts ...grpc.CallOption) (*BatchQueryResponse, error)
+}
+
+type batchQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBatchQueryServiceClient(cc grpc.ClientConnInterface) BatchQueryServiceClient {
+	return &batchQueryServiceClient{cc}
+}
+
+func (c *batchQueryServiceClient) BatchQuery(ctx context.Context, in *BatchQueryRequest, opts ...grpc.CallOption) (*BatchQueryResponse, error) {
+	out := new(BatchQueryResponse)
+	err := c.cc.Invoke(ctx, BatchQueryService_BatchQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchQueryServiceServer is the server API for BatchQueryService service.
+// All implementations must embed UnimplementedBatchQueryServiceServer
+// for forward compatibility
+type BatchQueryServiceServer interface {
+	// BatchQuery executes a batch of queries of any query services, at the same
+	// height. The batch fails if any of its queries fails.
+	BatchQuery(context.Context, *BatchQueryRequest) (*BatchQueryResponse, error)
+	mustEmbedUnimplementedBatchQueryServiceServer()
+}
+
+// UnimplementedBatchQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBatchQueryServiceServer struct {
+}
+
+func (UnimplementedBatchQueryServiceServer) BatchQuery(context.Context, *BatchQueryRequest) (*BatchQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchQuery not implemented")
+}
+func (UnimplementedBatchQueryServiceServer) mustEmbedUnimplementedBatchQueryServiceServer() {}
+
+// UnsafeBatchQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BatchQueryServiceServer will
+// result in compilation errors.
+type UnsafeBatchQueryServiceServer interface {
+	mustEmbedUnimplementedBatchQueryServiceServer()
+}
+
+func RegisterBatchQueryServiceServer(s grpc.ServiceRegistrar, srv BatchQueryServiceServer) {
+	s.RegisterService(&BatchQueryService_ServiceDesc, srv)
+}
+
+func _BatchQueryService_BatchQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchQueryServiceServer).BatchQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BatchQueryService_BatchQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchQueryServiceServer).BatchQuery(ctx, req.(*BatchQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BatchQueryService_ServiceDesc is the grpc.ServiceDesc for BatchQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BatchQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.batch.v1beta1.BatchQueryService",
+	HandlerType: (*BatchQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchQuery",
+			Handler:    _BatchQueryService_BatchQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/batch/v1beta1/batch.proto",
+}
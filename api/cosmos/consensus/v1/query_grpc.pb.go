@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/cosmos.consensus.v1.Query/Params"
+	Query_Params_FullMethodName               = "/cosmos.consensus.v1.Query/Params"
+	Query_BlockerPanicSkipList_FullMethodName = "/cosmos.consensus.v1.Query/BlockerPanicSkipList"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,6 +31,8 @@ const (
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlockerPanicSkipList returns the modules whose begin and end blocker panics are skipped.
+	BlockerPanicSkipList(ctx context.Context, in *QueryBlockerPanicSkipListRequest, opts ...grpc.CallOption) (*QueryBlockerPanicSkipListResponse, error)
 }
 
 type queryClient struct {
@@ -49,12 +52,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BlockerPanicSkipList(ctx context.Context, in *QueryBlockerPanicSkipListRequest, opts ...grpc.CallOption) (*QueryBlockerPanicSkipListResponse, error) {
+	out := new(QueryBlockerPanicSkipListResponse)
+	err := c.cc.Invoke(ctx, Query_BlockerPanicSkipList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlockerPanicSkipList returns the modules whose begin and end blocker panics are skipped.
+	BlockerPanicSkipList(context.Context, *QueryBlockerPanicSkipListRequest) (*QueryBlockerPanicSkipListResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -65,6 +79,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) BlockerPanicSkipList(context.Context, *QueryBlockerPanicSkipListRequest) (*QueryBlockerPanicSkipListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockerPanicSkipList not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +113,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockerPanicSkipList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockerPanicSkipListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockerPanicSkipList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockerPanicSkipList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockerPanicSkipList(ctx, req.(*QueryBlockerPanicSkipListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +142,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BlockerPanicSkipList",
+			Handler:    _Query_BlockerPanicSkipList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -21,7 +21,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName               = "/cosmos.consensus.v1.Msg/UpdateParams"
+	Msg_UpdateBlockerPanicSkipList_FullMethodName = "/cosmos.consensus.v1.Msg/UpdateBlockerPanicSkipList"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateBlockerPanicSkipList defines a governance operation for updating the
+	// list of modules whose begin and end blocker panics are skipped instead of
+	// halting the chain.
+	UpdateBlockerPanicSkipList(ctx context.Context, in *MsgUpdateBlockerPanicSkipList, opts ...grpc.CallOption) (*MsgUpdateBlockerPanicSkipListResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +55,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateBlockerPanicSkipList(ctx context.Context, in *MsgUpdateBlockerPanicSkipList, opts ...grpc.CallOption) (*MsgUpdateBlockerPanicSkipListResponse, error) {
+	out := new(MsgUpdateBlockerPanicSkipListResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateBlockerPanicSkipList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -57,6 +71,10 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateBlockerPanicSkipList defines a governance operation for updating the
+	// list of modules whose begin and end blocker panics are skipped instead of
+	// halting the chain.
+	UpdateBlockerPanicSkipList(context.Context, *MsgUpdateBlockerPanicSkipList) (*MsgUpdateBlockerPanicSkipListResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -67,6 +85,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateBlockerPanicSkipList(context.Context, *MsgUpdateBlockerPanicSkipList) (*MsgUpdateBlockerPanicSkipListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockerPanicSkipList not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +119,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBlockerPanicSkipList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockerPanicSkipList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockerPanicSkipList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBlockerPanicSkipList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockerPanicSkipList(ctx, req.(*MsgUpdateBlockerPanicSkipList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +148,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateBlockerPanicSkipList",
+			Handler:    _Msg_UpdateBlockerPanicSkipList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -19,16 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                      = "/cosmos.distribution.v1beta1.Query/Params"
-	Query_ValidatorDistributionInfo_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
-	Query_ValidatorOutstandingRewards_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
-	Query_ValidatorCommission_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
-	Query_ValidatorSlashes_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
-	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
-	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
-	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
-	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_Params_FullMethodName                           = "/cosmos.distribution.v1beta1.Query/Params"
+	Query_ValidatorDistributionInfo_FullMethodName        = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
+	Query_ValidatorOutstandingRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
+	Query_ValidatorCommission_FullMethodName              = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
+	Query_ValidatorSlashes_FullMethodName                 = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
+	Query_DelegationRewards_FullMethodName                = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
+	Query_DelegationTotalRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
+	Query_DelegatorValidators_FullMethodName              = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorWithdrawAddress_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_CommunityPool_FullMethodName                    = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_BurnedFees_FullMethodName                       = "/cosmos.distribution.v1beta1.Query/BurnedFees"
+	Query_DelegationTotalRewardsProjection_FullMethodName = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewardsProjection"
 )
 
 // QueryClient is the client API for Query service.
@@ -59,6 +61,13 @@ type QueryClient interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
+	// DelegationTotalRewardsProjection queries the total rewards accrued by each
+	// validator, along with the rewards projected to be accrued a given number of
+	// blocks in the future, assuming the current provisions, validator set and
+	// commission rates remain unchanged.
+	DelegationTotalRewardsProjection(ctx context.Context, in *QueryDelegationTotalRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -160,6 +169,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_BurnedFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationTotalRewardsProjection(ctx context.Context, in *QueryDelegationTotalRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsProjectionResponse, error) {
+	out := new(QueryDelegationTotalRewardsProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationTotalRewardsProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -188,6 +215,13 @@ type QueryServer interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
+	// DelegationTotalRewardsProjection queries the total rewards accrued by each
+	// validator, along with the rewards projected to be accrued a given number of
+	// blocks in the future, assuming the current provisions, validator set and
+	// commission rates remain unchanged.
+	DelegationTotalRewardsProjection(context.Context, *QueryDelegationTotalRewardsProjectionRequest) (*QueryDelegationTotalRewardsProjectionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -225,6 +259,12 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (UnimplementedQueryServer) DelegationTotalRewardsProjection(context.Context, *QueryDelegationTotalRewardsProjectionRequest) (*QueryDelegationTotalRewardsProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewardsProjection not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -418,6 +458,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BurnedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationTotalRewardsProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationTotalRewardsProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationTotalRewardsProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationTotalRewardsProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationTotalRewardsProjection(ctx, req.(*QueryDelegationTotalRewardsProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -465,6 +541,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "DelegationTotalRewardsProjection",
+			Handler:    _Query_DelegationTotalRewardsProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
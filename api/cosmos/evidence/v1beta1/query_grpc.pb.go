@@ -29,7 +29,7 @@ const (
 type QueryClient interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
-	// AllEvidence queries all evidence.
+	// AllEvidence queries all evidence, optionally filtered by evidence route.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
 }
 
@@ -65,7 +65,7 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
-	// AllEvidence queries all evidence.
+	// AllEvidence queries all evidence, optionally filtered by evidence route.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
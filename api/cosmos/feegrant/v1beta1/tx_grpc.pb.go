@@ -21,9 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName      = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName     = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName     = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantSubAllowance_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/GrantSubAllowance"
+	Msg_RevokeSubAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/RevokeSubAllowance"
+	Msg_GrantAllowanceBatch_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/GrantAllowanceBatch"
+	Msg_RevokeAllAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllAllowances"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +42,19 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// GrantSubAllowance carves out a sub-allowance of the parent grantee's
+	// allowance, on the granter's account, to the grantee. The sub-allowance is
+	// bounded by the remaining budget of the parent allowance.
+	GrantSubAllowance(ctx context.Context, in *MsgGrantSubAllowance, opts ...grpc.CallOption) (*MsgGrantSubAllowanceResponse, error)
+	// RevokeSubAllowance revokes a sub-allowance carved out by the parent grantee,
+	// along with the sub-allowances carved out of it.
+	RevokeSubAllowance(ctx context.Context, in *MsgRevokeSubAllowance, opts ...grpc.CallOption) (*MsgRevokeSubAllowanceResponse, error)
+	// GrantAllowanceBatch grants the same fee allowance to each of the grantees on
+	// the granter's account.
+	GrantAllowanceBatch(ctx context.Context, in *MsgGrantAllowanceBatch, opts ...grpc.CallOption) (*MsgGrantAllowanceBatchResponse, error)
+	// RevokeAllAllowances revokes all the fee allowances granted on the granter's
+	// account.
+	RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +92,42 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) GrantSubAllowance(ctx context.Context, in *MsgGrantSubAllowance, opts ...grpc.CallOption) (*MsgGrantSubAllowanceResponse, error) {
+	out := new(MsgGrantSubAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantSubAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSubAllowance(ctx context.Context, in *MsgRevokeSubAllowance, opts ...grpc.CallOption) (*MsgRevokeSubAllowanceResponse, error) {
+	out := new(MsgRevokeSubAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeSubAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantAllowanceBatch(ctx context.Context, in *MsgGrantAllowanceBatch, opts ...grpc.CallOption) (*MsgGrantAllowanceBatchResponse, error) {
+	out := new(MsgGrantAllowanceBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantAllowanceBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAllAllowances(ctx context.Context, in *MsgRevokeAllAllowances, opts ...grpc.CallOption) (*MsgRevokeAllAllowancesResponse, error) {
+	out := new(MsgRevokeAllAllowancesResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeAllAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -87,6 +140,19 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// GrantSubAllowance carves out a sub-allowance of the parent grantee's
+	// allowance, on the granter's account, to the grantee. The sub-allowance is
+	// bounded by the remaining budget of the parent allowance.
+	GrantSubAllowance(context.Context, *MsgGrantSubAllowance) (*MsgGrantSubAllowanceResponse, error)
+	// RevokeSubAllowance revokes a sub-allowance carved out by the parent grantee,
+	// along with the sub-allowances carved out of it.
+	RevokeSubAllowance(context.Context, *MsgRevokeSubAllowance) (*MsgRevokeSubAllowanceResponse, error)
+	// GrantAllowanceBatch grants the same fee allowance to each of the grantees on
+	// the granter's account.
+	GrantAllowanceBatch(context.Context, *MsgGrantAllowanceBatch) (*MsgGrantAllowanceBatchResponse, error)
+	// RevokeAllAllowances revokes all the fee allowances granted on the granter's
+	// account.
+	RevokeAllAllowances(context.Context, *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -103,6 +169,18 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) GrantSubAllowance(context.Context, *MsgGrantSubAllowance) (*MsgGrantSubAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSubAllowance not implemented")
+}
+func (UnimplementedMsgServer) RevokeSubAllowance(context.Context, *MsgRevokeSubAllowance) (*MsgRevokeSubAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSubAllowance not implemented")
+}
+func (UnimplementedMsgServer) GrantAllowanceBatch(context.Context, *MsgGrantAllowanceBatch) (*MsgGrantAllowanceBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAllowanceBatch not implemented")
+}
+func (UnimplementedMsgServer) RevokeAllAllowances(context.Context, *MsgRevokeAllAllowances) (*MsgRevokeAllAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllAllowances not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +248,78 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantSubAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSubAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSubAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantSubAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSubAllowance(ctx, req.(*MsgGrantSubAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSubAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSubAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSubAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeSubAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSubAllowance(ctx, req.(*MsgRevokeSubAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantAllowanceBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAllowanceBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAllowanceBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantAllowanceBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAllowanceBatch(ctx, req.(*MsgGrantAllowanceBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAllAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllAllowances)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeAllAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllAllowances(ctx, req.(*MsgRevokeAllAllowances))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +339,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "GrantSubAllowance",
+			Handler:    _Msg_GrantSubAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeSubAllowance",
+			Handler:    _Msg_RevokeSubAllowance_Handler,
+		},
+		{
+			MethodName: "GrantAllowanceBatch",
+			Handler:    _Msg_GrantAllowanceBatch_Handler,
+		},
+		{
+			MethodName: "RevokeAllAllowances",
+			Handler:    _Msg_RevokeAllAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -21,17 +21,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
-	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
-	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Constitution_FullMethodName         = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName             = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName            = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                 = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName               = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName              = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName             = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName          = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalVoteOptions_FullMethodName  = "/cosmos.gov.v1.Query/ProposalVoteOptions"
+	Query_MessageBasedParams_FullMethodName   = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_TallySummary_FullMethodName         = "/cosmos.gov.v1.Query/TallySummary"
+	Query_ProposalsByDepositor_FullMethodName = "/cosmos.gov.v1.Query/ProposalsByDepositor"
+	Query_ProposalsByVoter_FullMethodName     = "/cosmos.gov.v1.Query/ProposalsByVoter"
+	Query_ProposalDependencies_FullMethodName = "/cosmos.gov.v1.Query/ProposalDependencies"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +64,16 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// TallySummary queries the tally of a proposal vote along with its turnout,
+	// quorum status and whether the proposal would currently pass.
+	TallySummary(ctx context.Context, in *QueryTallySummaryRequest, opts ...grpc.CallOption) (*QueryTallySummaryResponse, error)
+	// ProposalsByDepositor queries the proposals a given address has deposited on.
+	ProposalsByDepositor(ctx context.Context, in *QueryProposalsByDepositorRequest, opts ...grpc.CallOption) (*QueryProposalsByDepositorResponse, error)
+	// ProposalsByVoter queries the proposals a given address has voted on.
+	ProposalsByVoter(ctx context.Context, in *QueryProposalsByVoterRequest, opts ...grpc.CallOption) (*QueryProposalsByVoterResponse, error)
+	// ProposalDependencies queries the proposals a given proposal depends on and
+	// the proposals depending on it.
+	ProposalDependencies(ctx context.Context, in *QueryProposalDependenciesRequest, opts ...grpc.CallOption) (*QueryProposalDependenciesResponse, error)
 }
 
 type queryClient struct {
@@ -169,6 +183,42 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) TallySummary(ctx context.Context, in *QueryTallySummaryRequest, opts ...grpc.CallOption) (*QueryTallySummaryResponse, error) {
+	out := new(QueryTallySummaryResponse)
+	err := c.cc.Invoke(ctx, Query_TallySummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByDepositor(ctx context.Context, in *QueryProposalsByDepositorRequest, opts ...grpc.CallOption) (*QueryProposalsByDepositorResponse, error) {
+	out := new(QueryProposalsByDepositorResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByDepositor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByVoter(ctx context.Context, in *QueryProposalsByVoterRequest, opts ...grpc.CallOption) (*QueryProposalsByVoterResponse, error) {
+	out := new(QueryProposalsByVoterResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByVoter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalDependencies(ctx context.Context, in *QueryProposalDependenciesRequest, opts ...grpc.CallOption) (*QueryProposalDependenciesResponse, error) {
+	out := new(QueryProposalDependenciesResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -195,6 +245,16 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// TallySummary queries the tally of a proposal vote along with its turnout,
+	// quorum status and whether the proposal would currently pass.
+	TallySummary(context.Context, *QueryTallySummaryRequest) (*QueryTallySummaryResponse, error)
+	// ProposalsByDepositor queries the proposals a given address has deposited on.
+	ProposalsByDepositor(context.Context, *QueryProposalsByDepositorRequest) (*QueryProposalsByDepositorResponse, error)
+	// ProposalsByVoter queries the proposals a given address has voted on.
+	ProposalsByVoter(context.Context, *QueryProposalsByVoterRequest) (*QueryProposalsByVoterResponse, error)
+	// ProposalDependencies queries the proposals a given proposal depends on and
+	// the proposals depending on it.
+	ProposalDependencies(context.Context, *QueryProposalDependenciesRequest) (*QueryProposalDependenciesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -235,6 +295,18 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) TallySummary(context.Context, *QueryTallySummaryRequest) (*QueryTallySummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallySummary not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByDepositor(context.Context, *QueryProposalsByDepositorRequest) (*QueryProposalsByDepositorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByDepositor not implemented")
+}
+func (UnimplementedQueryServer) ProposalsByVoter(context.Context, *QueryProposalsByVoterRequest) (*QueryProposalsByVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByVoter not implemented")
+}
+func (UnimplementedQueryServer) ProposalDependencies(context.Context, *QueryProposalDependenciesRequest) (*QueryProposalDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalDependencies not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -446,6 +518,78 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TallySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallySummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TallySummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallySummary(ctx, req.(*QueryTallySummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByDepositor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByDepositorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByDepositor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByDepositor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByDepositor(ctx, req.(*QueryProposalsByDepositorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByVoter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByVoter(ctx, req.(*QueryProposalsByVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalDependencies(ctx, req.(*QueryProposalDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +641,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "TallySummary",
+			Handler:    _Query_TallySummary_Handler,
+		},
+		{
+			MethodName: "ProposalsByDepositor",
+			Handler:    _Query_ProposalsByDepositor_Handler,
+		},
+		{
+			MethodName: "ProposalsByVoter",
+			Handler:    _Query_ProposalsByVoter_Handler,
+		},
+		{
+			MethodName: "ProposalDependencies",
+			Handler:    _Query_ProposalDependencies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -16,12 +16,60 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Module_6_list)(nil)
+
+type _Module_6_list struct {
+	list *[]string
+}
+
+func (x *_Module_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Module_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Module_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Module_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Module_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Module at list field Authorities as it is not of Message kind"))
+}
+
+func (x *_Module_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Module_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Module_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Module                          protoreflect.MessageDescriptor
 	fd_Module_max_execution_period     protoreflect.FieldDescriptor
 	fd_Module_max_metadata_len         protoreflect.FieldDescriptor
 	fd_Module_max_proposal_title_len   protoreflect.FieldDescriptor
 	fd_Module_max_proposal_summary_len protoreflect.FieldDescriptor
+	fd_Module_max_group_nesting_depth  protoreflect.FieldDescriptor
+	fd_Module_authorities              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -31,6 +79,8 @@ func init() {
 	fd_Module_max_metadata_len = md_Module.Fields().ByName("max_metadata_len")
 	fd_Module_max_proposal_title_len = md_Module.Fields().ByName("max_proposal_title_len")
 	fd_Module_max_proposal_summary_len = md_Module.Fields().ByName("max_proposal_summary_len")
+	fd_Module_max_group_nesting_depth = md_Module.Fields().ByName("max_group_nesting_depth")
+	fd_Module_authorities = md_Module.Fields().ByName("authorities")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -122,6 +172,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxGroupNestingDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxGroupNestingDepth)
+		if !f(fd_Module_max_group_nesting_depth, value) {
+			return
+		}
+	}
+	if len(x.Authorities) != 0 {
+		value := protoreflect.ValueOfList(&_Module_6_list{list: &x.Authorities})
+		if !f(fd_Module_authorities, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -145,6 +207,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxProposalTitleLen != uint64(0)
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		return x.MaxProposalSummaryLen != uint64(0)
+	case "cosmos.group.module.v1.Module.max_group_nesting_depth":
+		return x.MaxGroupNestingDepth != uint64(0)
+	case "cosmos.group.module.v1.Module.authorities":
+		return len(x.Authorities) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -169,6 +235,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxProposalTitleLen = uint64(0)
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		x.MaxProposalSummaryLen = uint64(0)
+	case "cosmos.group.module.v1.Module.max_group_nesting_depth":
+		x.MaxGroupNestingDepth = uint64(0)
+	case "cosmos.group.module.v1.Module.authorities":
+		x.Authorities = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -197,6 +267,15 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		value := x.MaxProposalSummaryLen
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.module.v1.Module.max_group_nesting_depth":
+		value := x.MaxGroupNestingDepth
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.module.v1.Module.authorities":
+		if len(x.Authorities) == 0 {
+			return protoreflect.ValueOfList(&_Module_6_list{})
+		}
+		listValue := &_Module_6_list{list: &x.Authorities}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -225,6 +304,12 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxProposalTitleLen = value.Uint()
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		x.MaxProposalSummaryLen = value.Uint()
+	case "cosmos.group.module.v1.Module.max_group_nesting_depth":
+		x.MaxGroupNestingDepth = value.Uint()
+	case "cosmos.group.module.v1.Module.authorities":
+		lv := value.List()
+		clv := lv.(*_Module_6_list)
+		x.Authorities = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -250,12 +335,20 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.MaxExecutionPeriod = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.MaxExecutionPeriod.ProtoReflect())
+	case "cosmos.group.module.v1.Module.authorities":
+		if x.Authorities == nil {
+			x.Authorities = []string{}
+		}
+		value := &_Module_6_list{list: &x.Authorities}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		panic(fmt.Errorf("field max_metadata_len of message cosmos.group.module.v1.Module is not mutable"))
 	case "cosmos.group.module.v1.Module.max_proposal_title_len":
 		panic(fmt.Errorf("field max_proposal_title_len of message cosmos.group.module.v1.Module is not mutable"))
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		panic(fmt.Errorf("field max_proposal_summary_len of message cosmos.group.module.v1.Module is not mutable"))
+	case "cosmos.group.module.v1.Module.max_group_nesting_depth":
+		panic(fmt.Errorf("field max_group_nesting_depth of message cosmos.group.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -278,6 +371,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.module.v1.Module.max_group_nesting_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.module.v1.Module.authorities":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Module_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -360,6 +458,15 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.MaxProposalSummaryLen != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxProposalSummaryLen))
 		}
+		if x.MaxGroupNestingDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxGroupNestingDepth))
+		}
+		if len(x.Authorities) > 0 {
+			for _, s := range x.Authorities {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -389,6 +496,20 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Authorities) > 0 {
+			for iNdEx := len(x.Authorities) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Authorities[iNdEx])
+				copy(dAtA[i:], x.Authorities[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authorities[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.MaxGroupNestingDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxGroupNestingDepth))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.MaxProposalSummaryLen != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxProposalSummaryLen))
 			i--
@@ -560,6 +681,57 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxGroupNestingDepth", wireType)
+				}
+				x.MaxGroupNestingDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxGroupNestingDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authorities = append(x.Authorities, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -632,6 +804,15 @@ type Module struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64 `protobuf:"varint,4,opt,name=max_proposal_summary_len,json=maxProposalSummaryLen,proto3" json:"max_proposal_summary_len,omitempty"`
+	// MaxGroupNestingDepth defines the max depth of the group hierarchy, i.e.
+	// the max number of successive sub-groups (groups whose group policy
+	// accounts are members of another group) below a group.
+	// Defaults to 3 if not explicitly set.
+	MaxGroupNestingDepth uint64 `protobuf:"varint,5,opt,name=max_group_nesting_depth,json=maxGroupNestingDepth,proto3" json:"max_group_nesting_depth,omitempty"`
+	// authorities are the addresses of the group policy accounts configured as
+	// the authority of other modules, e.g. for their MsgUpdateParams. The group
+	// module checks at genesis that they are existing group policy accounts.
+	Authorities []string `protobuf:"bytes,6,rep,name=authorities,proto3" json:"authorities,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -682,6 +863,20 @@ func (x *Module) GetMaxProposalSummaryLen() uint64 {
 	return 0
 }
 
+func (x *Module) GetMaxGroupNestingDepth() uint64 {
+	if x != nil {
+		return x.MaxGroupNestingDepth
+	}
+	return 0
+}
+
+func (x *Module) GetAuthorities() []string {
+	if x != nil {
+		return x.Authorities
+	}
+	return nil
+}
+
 var File_cosmos_group_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
@@ -695,7 +890,7 @@ var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69,
-	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
+	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
 	0x75, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -711,23 +906,28 @@ var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x73,
 	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x15, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x3a, 0x1c, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x16, 0x0a,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0xd6, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x16, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22,
+	0x6d, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x3a, 0x1c, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x16, 0x0a, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0xd6,
+	0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16,
 	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1712,27 +1712,27 @@ func (x *fastReflection_QueryGroupPolicyInfoResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryGroupMembersRequest            protoreflect.MessageDescriptor
-	fd_QueryGroupMembersRequest_group_id   protoreflect.FieldDescriptor
-	fd_QueryGroupMembersRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGroupPolicyByLabelRequest          protoreflect.MessageDescriptor
+	fd_QueryGroupPolicyByLabelRequest_group_id protoreflect.FieldDescriptor
+	fd_QueryGroupPolicyByLabelRequest_label    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupMembersRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupMembersRequest")
-	fd_QueryGroupMembersRequest_group_id = md_QueryGroupMembersRequest.Fields().ByName("group_id")
-	fd_QueryGroupMembersRequest_pagination = md_QueryGroupMembersRequest.Fields().ByName("pagination")
+	md_QueryGroupPolicyByLabelRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPolicyByLabelRequest")
+	fd_QueryGroupPolicyByLabelRequest_group_id = md_QueryGroupPolicyByLabelRequest.Fields().ByName("group_id")
+	fd_QueryGroupPolicyByLabelRequest_label = md_QueryGroupPolicyByLabelRequest.Fields().ByName("label")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupMembersRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupPolicyByLabelRequest)(nil)
 
-type fastReflection_QueryGroupMembersRequest QueryGroupMembersRequest
+type fastReflection_QueryGroupPolicyByLabelRequest QueryGroupPolicyByLabelRequest
 
-func (x *QueryGroupMembersRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupMembersRequest)(x)
+func (x *QueryGroupPolicyByLabelRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupPolicyByLabelRequest)(x)
 }
 
-func (x *QueryGroupMembersRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupPolicyByLabelRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1744,43 +1744,43 @@ func (x *QueryGroupMembersRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupMembersRequest_messageType fastReflection_QueryGroupMembersRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupMembersRequest_messageType{}
+var _fastReflection_QueryGroupPolicyByLabelRequest_messageType fastReflection_QueryGroupPolicyByLabelRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupPolicyByLabelRequest_messageType{}
 
-type fastReflection_QueryGroupMembersRequest_messageType struct{}
+type fastReflection_QueryGroupPolicyByLabelRequest_messageType struct{}
 
-func (x fastReflection_QueryGroupMembersRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupMembersRequest)(nil)
+func (x fastReflection_QueryGroupPolicyByLabelRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupPolicyByLabelRequest)(nil)
 }
-func (x fastReflection_QueryGroupMembersRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupMembersRequest)
+func (x fastReflection_QueryGroupPolicyByLabelRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPolicyByLabelRequest)
 }
-func (x fastReflection_QueryGroupMembersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupMembersRequest
+func (x fastReflection_QueryGroupPolicyByLabelRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPolicyByLabelRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupMembersRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupMembersRequest
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPolicyByLabelRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupMembersRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupMembersRequest_messageType
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupPolicyByLabelRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupMembersRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupMembersRequest)
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPolicyByLabelRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupMembersRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupMembersRequest)(x)
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupPolicyByLabelRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1788,16 +1788,16 @@ func (x *fastReflection_QueryGroupMembersRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupMembersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.GroupId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.GroupId)
-		if !f(fd_QueryGroupMembersRequest_group_id, value) {
+		if !f(fd_QueryGroupPolicyByLabelRequest_group_id, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupMembersRequest_pagination, value) {
+	if x.Label != "" {
+		value := protoreflect.ValueOfString(x.Label)
+		if !f(fd_QueryGroupPolicyByLabelRequest_label, value) {
 			return
 		}
 	}
@@ -1814,17 +1814,17 @@ func (x *fastReflection_QueryGroupMembersRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupMembersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.group_id":
 		return x.GroupId != uint64(0)
-	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
-		return x.Pagination != nil
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.label":
+		return x.Label != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1834,17 +1834,17 @@ func (x *fastReflection_QueryGroupMembersRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.group_id":
 		x.GroupId = uint64(0)
-	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
-		x.Pagination = nil
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.label":
+		x.Label = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1854,19 +1854,19 @@ func (x *fastReflection_QueryGroupMembersRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupMembersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.group_id":
 		value := x.GroupId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.label":
+		value := x.Label
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1880,17 +1880,17 @@ func (x *fastReflection_QueryGroupMembersRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.group_id":
 		x.GroupId = value.Uint()
-	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.label":
+		x.Label = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1904,48 +1904,44 @@ func (x *fastReflection_QueryGroupMembersRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
-		panic(fmt.Errorf("field group_id of message cosmos.group.v1.QueryGroupMembersRequest is not mutable"))
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.QueryGroupPolicyByLabelRequest is not mutable"))
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.label":
+		panic(fmt.Errorf("field label of message cosmos.group.v1.QueryGroupPolicyByLabelRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupMembersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.group_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.QueryGroupPolicyByLabelRequest.label":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupMembersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupMembersRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPolicyByLabelRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1953,7 +1949,7 @@ func (x *fastReflection_QueryGroupMembersRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupMembersRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1964,7 +1960,7 @@ func (x *fastReflection_QueryGroupMembersRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1976,7 +1972,7 @@ func (x *fastReflection_QueryGroupMembersRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupMembersRequest) IsValid() bool {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1986,9 +1982,9 @@ func (x *fastReflection_QueryGroupMembersRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupPolicyByLabelRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupMembersRequest)
+		x := input.Message.Interface().(*QueryGroupPolicyByLabelRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2003,8 +1999,8 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 		if x.GroupId != 0 {
 			n += 1 + runtime.Sov(uint64(x.GroupId))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Label)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2017,7 +2013,7 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupMembersRequest)
+		x := input.Message.Interface().(*QueryGroupPolicyByLabelRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2036,17 +2032,10 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Label) > 0 {
+			i -= len(x.Label)
+			copy(dAtA[i:], x.Label)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Label)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -2066,7 +2055,7 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupMembersRequest)
+		x := input.Message.Interface().(*QueryGroupPolicyByLabelRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2098,10 +2087,10 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPolicyByLabelRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPolicyByLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2125,9 +2114,9 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2137,27 +2126,23 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Label = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2194,79 +2179,26 @@ func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_QueryGroupMembersResponse_1_list)(nil)
-
-type _QueryGroupMembersResponse_1_list struct {
-	list *[]*GroupMember
-}
-
-func (x *_QueryGroupMembersResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryGroupMembersResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryGroupMembersResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*GroupMember)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryGroupMembersResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*GroupMember)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryGroupMembersResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(GroupMember)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryGroupMembersResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryGroupMembersResponse_1_list) NewElement() protoreflect.Value {
-	v := new(GroupMember)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryGroupMembersResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryGroupMembersResponse            protoreflect.MessageDescriptor
-	fd_QueryGroupMembersResponse_members    protoreflect.FieldDescriptor
-	fd_QueryGroupMembersResponse_pagination protoreflect.FieldDescriptor
+	md_QueryGroupPolicyByLabelResponse      protoreflect.MessageDescriptor
+	fd_QueryGroupPolicyByLabelResponse_info protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupMembersResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupMembersResponse")
-	fd_QueryGroupMembersResponse_members = md_QueryGroupMembersResponse.Fields().ByName("members")
-	fd_QueryGroupMembersResponse_pagination = md_QueryGroupMembersResponse.Fields().ByName("pagination")
+	md_QueryGroupPolicyByLabelResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPolicyByLabelResponse")
+	fd_QueryGroupPolicyByLabelResponse_info = md_QueryGroupPolicyByLabelResponse.Fields().ByName("info")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupMembersResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupPolicyByLabelResponse)(nil)
 
-type fastReflection_QueryGroupMembersResponse QueryGroupMembersResponse
+type fastReflection_QueryGroupPolicyByLabelResponse QueryGroupPolicyByLabelResponse
 
-func (x *QueryGroupMembersResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupMembersResponse)(x)
+func (x *QueryGroupPolicyByLabelResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupPolicyByLabelResponse)(x)
 }
 
-func (x *QueryGroupMembersResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupPolicyByLabelResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2278,43 +2210,43 @@ func (x *QueryGroupMembersResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupMembersResponse_messageType fastReflection_QueryGroupMembersResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupMembersResponse_messageType{}
+var _fastReflection_QueryGroupPolicyByLabelResponse_messageType fastReflection_QueryGroupPolicyByLabelResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupPolicyByLabelResponse_messageType{}
 
-type fastReflection_QueryGroupMembersResponse_messageType struct{}
+type fastReflection_QueryGroupPolicyByLabelResponse_messageType struct{}
 
-func (x fastReflection_QueryGroupMembersResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupMembersResponse)(nil)
+func (x fastReflection_QueryGroupPolicyByLabelResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupPolicyByLabelResponse)(nil)
 }
-func (x fastReflection_QueryGroupMembersResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupMembersResponse)
+func (x fastReflection_QueryGroupPolicyByLabelResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPolicyByLabelResponse)
 }
-func (x fastReflection_QueryGroupMembersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupMembersResponse
+func (x fastReflection_QueryGroupPolicyByLabelResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPolicyByLabelResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupMembersResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupMembersResponse
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPolicyByLabelResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupMembersResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupMembersResponse_messageType
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupPolicyByLabelResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupMembersResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupMembersResponse)
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPolicyByLabelResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupMembersResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupMembersResponse)(x)
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupPolicyByLabelResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2322,16 +2254,10 @@ func (x *fastReflection_QueryGroupMembersResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupMembersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Members) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGroupMembersResponse_1_list{list: &x.Members})
-		if !f(fd_QueryGroupMembersResponse_members, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupMembersResponse_pagination, value) {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Info != nil {
+		value := protoreflect.ValueOfMessage(x.Info.ProtoReflect())
+		if !f(fd_QueryGroupPolicyByLabelResponse_info, value) {
 			return
 		}
 	}
@@ -2348,17 +2274,15 @@ func (x *fastReflection_QueryGroupMembersResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupMembersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersResponse.members":
-		return len(x.Members) != 0
-	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
-		return x.Pagination != nil
+	case "cosmos.group.v1.QueryGroupPolicyByLabelResponse.info":
+		return x.Info != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2368,17 +2292,15 @@ func (x *fastReflection_QueryGroupMembersResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersResponse.members":
-		x.Members = nil
-	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
-		x.Pagination = nil
+	case "cosmos.group.v1.QueryGroupPolicyByLabelResponse.info":
+		x.Info = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2388,22 +2310,16 @@ func (x *fastReflection_QueryGroupMembersResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupMembersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersResponse.members":
-		if len(x.Members) == 0 {
-			return protoreflect.ValueOfList(&_QueryGroupMembersResponse_1_list{})
-		}
-		listValue := &_QueryGroupMembersResponse_1_list{list: &x.Members}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
-		value := x.Pagination
+	case "cosmos.group.v1.QueryGroupPolicyByLabelResponse.info":
+		value := x.Info
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2417,19 +2333,15 @@ func (x *fastReflection_QueryGroupMembersResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersResponse.members":
-		lv := value.List()
-		clv := lv.(*_QueryGroupMembersResponse_1_list)
-		x.Members = *clv.list
-	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.group.v1.QueryGroupPolicyByLabelResponse.info":
+		x.Info = value.Message().Interface().(*GroupPolicyInfo)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2443,53 +2355,44 @@ func (x *fastReflection_QueryGroupMembersResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersResponse.members":
-		if x.Members == nil {
-			x.Members = []*GroupMember{}
+	case "cosmos.group.v1.QueryGroupPolicyByLabelResponse.info":
+		if x.Info == nil {
+			x.Info = new(GroupPolicyInfo)
 		}
-		value := &_QueryGroupMembersResponse_1_list{list: &x.Members}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Info.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupMembersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupMembersResponse.members":
-		list := []*GroupMember{}
-		return protoreflect.ValueOfList(&_QueryGroupMembersResponse_1_list{list: &list})
-	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "cosmos.group.v1.QueryGroupPolicyByLabelResponse.info":
+		m := new(GroupPolicyInfo)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPolicyByLabelResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPolicyByLabelResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupMembersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupMembersResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPolicyByLabelResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2497,7 +2400,7 @@ func (x *fastReflection_QueryGroupMembersResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupMembersResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2508,7 +2411,7 @@ func (x *fastReflection_QueryGroupMembersResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupMembersResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2520,7 +2423,7 @@ func (x *fastReflection_QueryGroupMembersResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupMembersResponse) IsValid() bool {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2530,9 +2433,9 @@ func (x *fastReflection_QueryGroupMembersResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupPolicyByLabelResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupMembersResponse)
+		x := input.Message.Interface().(*QueryGroupPolicyByLabelResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2544,14 +2447,8 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.Members) > 0 {
-			for _, e := range x.Members {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Info != nil {
+			l = options.Size(x.Info)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2564,7 +2461,7 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupMembersResponse)
+		x := input.Message.Interface().(*QueryGroupPolicyByLabelResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2583,8 +2480,8 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Info != nil {
+			encoded, err := options.Marshal(x.Info)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2595,23 +2492,7 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Members) > 0 {
-			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Members[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2624,7 +2505,7 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupMembersResponse)
+		x := input.Message.Interface().(*QueryGroupPolicyByLabelResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2656,49 +2537,15 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPolicyByLabelResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPolicyByLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Members = append(x.Members, &GroupMember{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Members[len(x.Members)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2725,10 +2572,10 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Info == nil {
+					x.Info = &GroupPolicyInfo{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Info); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2768,27 +2615,27 @@ func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_QueryGroupsByAdminRequest            protoreflect.MessageDescriptor
-	fd_QueryGroupsByAdminRequest_admin      protoreflect.FieldDescriptor
-	fd_QueryGroupsByAdminRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGroupMembersRequest            protoreflect.MessageDescriptor
+	fd_QueryGroupMembersRequest_group_id   protoreflect.FieldDescriptor
+	fd_QueryGroupMembersRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupsByAdminRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupsByAdminRequest")
-	fd_QueryGroupsByAdminRequest_admin = md_QueryGroupsByAdminRequest.Fields().ByName("admin")
-	fd_QueryGroupsByAdminRequest_pagination = md_QueryGroupsByAdminRequest.Fields().ByName("pagination")
+	md_QueryGroupMembersRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupMembersRequest")
+	fd_QueryGroupMembersRequest_group_id = md_QueryGroupMembersRequest.Fields().ByName("group_id")
+	fd_QueryGroupMembersRequest_pagination = md_QueryGroupMembersRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupsByAdminRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupMembersRequest)(nil)
 
-type fastReflection_QueryGroupsByAdminRequest QueryGroupsByAdminRequest
+type fastReflection_QueryGroupMembersRequest QueryGroupMembersRequest
 
-func (x *QueryGroupsByAdminRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupsByAdminRequest)(x)
+func (x *QueryGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupMembersRequest)(x)
 }
 
-func (x *QueryGroupsByAdminRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupMembersRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2800,43 +2647,43 @@ func (x *QueryGroupsByAdminRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupsByAdminRequest_messageType fastReflection_QueryGroupsByAdminRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupsByAdminRequest_messageType{}
+var _fastReflection_QueryGroupMembersRequest_messageType fastReflection_QueryGroupMembersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupMembersRequest_messageType{}
 
-type fastReflection_QueryGroupsByAdminRequest_messageType struct{}
+type fastReflection_QueryGroupMembersRequest_messageType struct{}
 
-func (x fastReflection_QueryGroupsByAdminRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupsByAdminRequest)(nil)
+func (x fastReflection_QueryGroupMembersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupMembersRequest)(nil)
 }
-func (x fastReflection_QueryGroupsByAdminRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupsByAdminRequest)
+func (x fastReflection_QueryGroupMembersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupMembersRequest)
 }
-func (x fastReflection_QueryGroupsByAdminRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupsByAdminRequest
+func (x fastReflection_QueryGroupMembersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupMembersRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupsByAdminRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupsByAdminRequest
+func (x *fastReflection_QueryGroupMembersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupMembersRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupsByAdminRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupsByAdminRequest_messageType
+func (x *fastReflection_QueryGroupMembersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupMembersRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupsByAdminRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupsByAdminRequest)
+func (x *fastReflection_QueryGroupMembersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupMembersRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupsByAdminRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupsByAdminRequest)(x)
+func (x *fastReflection_QueryGroupMembersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupMembersRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2844,16 +2691,16 @@ func (x *fastReflection_QueryGroupsByAdminRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupsByAdminRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Admin != "" {
-		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_QueryGroupsByAdminRequest_admin, value) {
+func (x *fastReflection_QueryGroupMembersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_QueryGroupMembersRequest_group_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupsByAdminRequest_pagination, value) {
+		if !f(fd_QueryGroupMembersRequest_pagination, value) {
 			return
 		}
 	}
@@ -2870,17 +2717,17 @@ func (x *fastReflection_QueryGroupsByAdminRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupsByAdminRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupMembersRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
-		return x.Admin != ""
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+		return x.GroupId != uint64(0)
+	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2890,17 +2737,17 @@ func (x *fastReflection_QueryGroupsByAdminRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupMembersRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
-		x.Admin = ""
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+		x.GroupId = uint64(0)
+	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2910,19 +2757,19 @@ func (x *fastReflection_QueryGroupsByAdminRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupsByAdminRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupMembersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
-		value := x.Admin
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2936,17 +2783,17 @@ func (x *fastReflection_QueryGroupsByAdminRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupMembersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
-		x.Admin = value.Interface().(string)
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+		x.GroupId = value.Uint()
+	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2960,48 +2807,48 @@ func (x *fastReflection_QueryGroupsByAdminRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupMembersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
-		panic(fmt.Errorf("field admin of message cosmos.group.v1.QueryGroupsByAdminRequest is not mutable"))
+	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.QueryGroupMembersRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupsByAdminRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupMembersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupMembersRequest.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.QueryGroupMembersRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupsByAdminRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupMembersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupsByAdminRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupMembersRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3009,7 +2856,7 @@ func (x *fastReflection_QueryGroupsByAdminRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupsByAdminRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupMembersRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3020,7 +2867,7 @@ func (x *fastReflection_QueryGroupsByAdminRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupMembersRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3032,7 +2879,7 @@ func (x *fastReflection_QueryGroupsByAdminRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupsByAdminRequest) IsValid() bool {
+func (x *fastReflection_QueryGroupMembersRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3042,9 +2889,9 @@ func (x *fastReflection_QueryGroupsByAdminRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupMembersRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupsByAdminRequest)
+		x := input.Message.Interface().(*QueryGroupMembersRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3056,9 +2903,8 @@ func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		l = len(x.Admin)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -3074,7 +2920,7 @@ func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupsByAdminRequest)
+		x := input.Message.Interface().(*QueryGroupMembersRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3107,12 +2953,10 @@ func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Me
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Admin) > 0 {
-			i -= len(x.Admin)
-			copy(dAtA[i:], x.Admin)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3125,7 +2969,7 @@ func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupsByAdminRequest)
+		x := input.Message.Interface().(*QueryGroupMembersRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3157,17 +3001,17 @@ func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 				}
-				var stringLen uint64
+				x.GroupId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3177,24 +3021,11 @@ func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.GroupId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Admin = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -3266,79 +3097,79 @@ func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_QueryGroupsByAdminResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGroupMembersResponse_1_list)(nil)
 
-type _QueryGroupsByAdminResponse_1_list struct {
-	list *[]*GroupInfo
+type _QueryGroupMembersResponse_1_list struct {
+	list *[]*GroupMember
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) Len() int {
+func (x *_QueryGroupMembersResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGroupMembersResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGroupMembersResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*GroupInfo)
+	concreteValue := valueUnwrapped.Interface().(*GroupMember)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGroupMembersResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*GroupInfo)
+	concreteValue := valueUnwrapped.Interface().(*GroupMember)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(GroupInfo)
+func (x *_QueryGroupMembersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(GroupMember)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) Truncate(n int) {
+func (x *_QueryGroupMembersResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) NewElement() protoreflect.Value {
-	v := new(GroupInfo)
+func (x *_QueryGroupMembersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(GroupMember)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGroupsByAdminResponse_1_list) IsValid() bool {
+func (x *_QueryGroupMembersResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGroupsByAdminResponse            protoreflect.MessageDescriptor
-	fd_QueryGroupsByAdminResponse_groups     protoreflect.FieldDescriptor
-	fd_QueryGroupsByAdminResponse_pagination protoreflect.FieldDescriptor
+	md_QueryGroupMembersResponse            protoreflect.MessageDescriptor
+	fd_QueryGroupMembersResponse_members    protoreflect.FieldDescriptor
+	fd_QueryGroupMembersResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupsByAdminResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupsByAdminResponse")
-	fd_QueryGroupsByAdminResponse_groups = md_QueryGroupsByAdminResponse.Fields().ByName("groups")
-	fd_QueryGroupsByAdminResponse_pagination = md_QueryGroupsByAdminResponse.Fields().ByName("pagination")
+	md_QueryGroupMembersResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupMembersResponse")
+	fd_QueryGroupMembersResponse_members = md_QueryGroupMembersResponse.Fields().ByName("members")
+	fd_QueryGroupMembersResponse_pagination = md_QueryGroupMembersResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupsByAdminResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupMembersResponse)(nil)
 
-type fastReflection_QueryGroupsByAdminResponse QueryGroupsByAdminResponse
+type fastReflection_QueryGroupMembersResponse QueryGroupMembersResponse
 
-func (x *QueryGroupsByAdminResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupsByAdminResponse)(x)
+func (x *QueryGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupMembersResponse)(x)
 }
 
-func (x *QueryGroupsByAdminResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupMembersResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3350,43 +3181,43 @@ func (x *QueryGroupsByAdminResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupsByAdminResponse_messageType fastReflection_QueryGroupsByAdminResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupsByAdminResponse_messageType{}
+var _fastReflection_QueryGroupMembersResponse_messageType fastReflection_QueryGroupMembersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupMembersResponse_messageType{}
 
-type fastReflection_QueryGroupsByAdminResponse_messageType struct{}
+type fastReflection_QueryGroupMembersResponse_messageType struct{}
 
-func (x fastReflection_QueryGroupsByAdminResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupsByAdminResponse)(nil)
+func (x fastReflection_QueryGroupMembersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupMembersResponse)(nil)
 }
-func (x fastReflection_QueryGroupsByAdminResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupsByAdminResponse)
+func (x fastReflection_QueryGroupMembersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupMembersResponse)
 }
-func (x fastReflection_QueryGroupsByAdminResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupsByAdminResponse
+func (x fastReflection_QueryGroupMembersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupMembersResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupsByAdminResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupsByAdminResponse
+func (x *fastReflection_QueryGroupMembersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupMembersResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupsByAdminResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupsByAdminResponse_messageType
+func (x *fastReflection_QueryGroupMembersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupMembersResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupsByAdminResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupsByAdminResponse)
+func (x *fastReflection_QueryGroupMembersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupMembersResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupsByAdminResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupsByAdminResponse)(x)
+func (x *fastReflection_QueryGroupMembersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupMembersResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3394,16 +3225,16 @@ func (x *fastReflection_QueryGroupsByAdminResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupsByAdminResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Groups) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGroupsByAdminResponse_1_list{list: &x.Groups})
-		if !f(fd_QueryGroupsByAdminResponse_groups, value) {
+func (x *fastReflection_QueryGroupMembersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Members) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGroupMembersResponse_1_list{list: &x.Members})
+		if !f(fd_QueryGroupMembersResponse_members, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupsByAdminResponse_pagination, value) {
+		if !f(fd_QueryGroupMembersResponse_pagination, value) {
 			return
 		}
 	}
@@ -3420,17 +3251,17 @@ func (x *fastReflection_QueryGroupsByAdminResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupsByAdminResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupMembersResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
-		return len(x.Groups) != 0
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupMembersResponse.members":
+		return len(x.Members) != 0
+	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3440,17 +3271,17 @@ func (x *fastReflection_QueryGroupsByAdminResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupMembersResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
-		x.Groups = nil
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupMembersResponse.members":
+		x.Members = nil
+	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3460,22 +3291,22 @@ func (x *fastReflection_QueryGroupsByAdminResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupsByAdminResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupMembersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
-		if len(x.Groups) == 0 {
-			return protoreflect.ValueOfList(&_QueryGroupsByAdminResponse_1_list{})
+	case "cosmos.group.v1.QueryGroupMembersResponse.members":
+		if len(x.Members) == 0 {
+			return protoreflect.ValueOfList(&_QueryGroupMembersResponse_1_list{})
 		}
-		listValue := &_QueryGroupsByAdminResponse_1_list{list: &x.Groups}
+		listValue := &_QueryGroupMembersResponse_1_list{list: &x.Members}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3489,19 +3320,19 @@ func (x *fastReflection_QueryGroupsByAdminResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupMembersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
+	case "cosmos.group.v1.QueryGroupMembersResponse.members":
 		lv := value.List()
-		clv := lv.(*_QueryGroupsByAdminResponse_1_list)
-		x.Groups = *clv.list
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
+		clv := lv.(*_QueryGroupMembersResponse_1_list)
+		x.Members = *clv.list
+	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3515,53 +3346,53 @@ func (x *fastReflection_QueryGroupsByAdminResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupMembersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
-		if x.Groups == nil {
-			x.Groups = []*GroupInfo{}
+	case "cosmos.group.v1.QueryGroupMembersResponse.members":
+		if x.Members == nil {
+			x.Members = []*GroupMember{}
 		}
-		value := &_QueryGroupsByAdminResponse_1_list{list: &x.Groups}
+		value := &_QueryGroupMembersResponse_1_list{list: &x.Members}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupsByAdminResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupMembersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
-		list := []*GroupInfo{}
-		return protoreflect.ValueOfList(&_QueryGroupsByAdminResponse_1_list{list: &list})
-	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupMembersResponse.members":
+		list := []*GroupMember{}
+		return protoreflect.ValueOfList(&_QueryGroupMembersResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryGroupMembersResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupMembersResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupMembersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupsByAdminResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupMembersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupsByAdminResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupMembersResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3569,7 +3400,7 @@ func (x *fastReflection_QueryGroupsByAdminResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupsByAdminResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupMembersResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3580,7 +3411,7 @@ func (x *fastReflection_QueryGroupsByAdminResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupsByAdminResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupMembersResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3592,7 +3423,7 @@ func (x *fastReflection_QueryGroupsByAdminResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupsByAdminResponse) IsValid() bool {
+func (x *fastReflection_QueryGroupMembersResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3602,9 +3433,9 @@ func (x *fastReflection_QueryGroupsByAdminResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupMembersResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupsByAdminResponse)
+		x := input.Message.Interface().(*QueryGroupMembersResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3616,8 +3447,8 @@ func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if len(x.Groups) > 0 {
-			for _, e := range x.Groups {
+		if len(x.Members) > 0 {
+			for _, e := range x.Members {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3636,7 +3467,7 @@ func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupsByAdminResponse)
+		x := input.Message.Interface().(*QueryGroupMembersResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3669,9 +3500,9 @@ func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.M
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Groups) > 0 {
-			for iNdEx := len(x.Groups) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Groups[iNdEx])
+		if len(x.Members) > 0 {
+			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Members[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3696,7 +3527,7 @@ func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupsByAdminResponse)
+		x := input.Message.Interface().(*QueryGroupMembersResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3728,15 +3559,15 @@ func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3763,8 +3594,8 @@ func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Groups = append(x.Groups, &GroupInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Groups[len(x.Groups)-1]); err != nil {
+				x.Members = append(x.Members, &GroupMember{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Members[len(x.Members)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3840,27 +3671,27 @@ func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryGroupPoliciesByGroupRequest            protoreflect.MessageDescriptor
-	fd_QueryGroupPoliciesByGroupRequest_group_id   protoreflect.FieldDescriptor
-	fd_QueryGroupPoliciesByGroupRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGroupsByAdminRequest            protoreflect.MessageDescriptor
+	fd_QueryGroupsByAdminRequest_admin      protoreflect.FieldDescriptor
+	fd_QueryGroupsByAdminRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupPoliciesByGroupRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByGroupRequest")
-	fd_QueryGroupPoliciesByGroupRequest_group_id = md_QueryGroupPoliciesByGroupRequest.Fields().ByName("group_id")
-	fd_QueryGroupPoliciesByGroupRequest_pagination = md_QueryGroupPoliciesByGroupRequest.Fields().ByName("pagination")
+	md_QueryGroupsByAdminRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupsByAdminRequest")
+	fd_QueryGroupsByAdminRequest_admin = md_QueryGroupsByAdminRequest.Fields().ByName("admin")
+	fd_QueryGroupsByAdminRequest_pagination = md_QueryGroupsByAdminRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByGroupRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupsByAdminRequest)(nil)
 
-type fastReflection_QueryGroupPoliciesByGroupRequest QueryGroupPoliciesByGroupRequest
+type fastReflection_QueryGroupsByAdminRequest QueryGroupsByAdminRequest
 
-func (x *QueryGroupPoliciesByGroupRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByGroupRequest)(x)
+func (x *QueryGroupsByAdminRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupsByAdminRequest)(x)
 }
 
-func (x *QueryGroupPoliciesByGroupRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupsByAdminRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3872,43 +3703,43 @@ func (x *QueryGroupPoliciesByGroupRequest) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupPoliciesByGroupRequest_messageType fastReflection_QueryGroupPoliciesByGroupRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByGroupRequest_messageType{}
+var _fastReflection_QueryGroupsByAdminRequest_messageType fastReflection_QueryGroupsByAdminRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupsByAdminRequest_messageType{}
 
-type fastReflection_QueryGroupPoliciesByGroupRequest_messageType struct{}
+type fastReflection_QueryGroupsByAdminRequest_messageType struct{}
 
-func (x fastReflection_QueryGroupPoliciesByGroupRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByGroupRequest)(nil)
+func (x fastReflection_QueryGroupsByAdminRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupsByAdminRequest)(nil)
 }
-func (x fastReflection_QueryGroupPoliciesByGroupRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByGroupRequest)
+func (x fastReflection_QueryGroupsByAdminRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupsByAdminRequest)
 }
-func (x fastReflection_QueryGroupPoliciesByGroupRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByGroupRequest
+func (x fastReflection_QueryGroupsByAdminRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupsByAdminRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByGroupRequest
+func (x *fastReflection_QueryGroupsByAdminRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupsByAdminRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupPoliciesByGroupRequest_messageType
+func (x *fastReflection_QueryGroupsByAdminRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupsByAdminRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByGroupRequest)
+func (x *fastReflection_QueryGroupsByAdminRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupsByAdminRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupPoliciesByGroupRequest)(x)
+func (x *fastReflection_QueryGroupsByAdminRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupsByAdminRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3916,16 +3747,16 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GroupId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GroupId)
-		if !f(fd_QueryGroupPoliciesByGroupRequest_group_id, value) {
+func (x *fastReflection_QueryGroupsByAdminRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_QueryGroupsByAdminRequest_admin, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupPoliciesByGroupRequest_pagination, value) {
+		if !f(fd_QueryGroupsByAdminRequest_pagination, value) {
 			return
 		}
 	}
@@ -3942,17 +3773,17 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupsByAdminRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
-		return x.GroupId != uint64(0)
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
+		return x.Admin != ""
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3962,17 +3793,17 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupsByAdminRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
-		x.GroupId = uint64(0)
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
+		x.Admin = ""
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3982,19 +3813,19 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupsByAdminRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
-		value := x.GroupId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4008,17 +3839,17 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupsByAdminRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
-		x.GroupId = value.Uint()
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
+		x.Admin = value.Interface().(string)
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4032,48 +3863,48 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupsByAdminRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
-		panic(fmt.Errorf("field group_id of message cosmos.group.v1.QueryGroupPoliciesByGroupRequest is not mutable"))
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
+		panic(fmt.Errorf("field admin of message cosmos.group.v1.QueryGroupsByAdminRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupsByAdminRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.admin":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.QueryGroupsByAdminRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupsByAdminRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByGroupRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupsByAdminRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4081,7 +3912,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupsByAdminRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4092,7 +3923,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupsByAdminRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4104,7 +3935,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) IsValid() bool {
+func (x *fastReflection_QueryGroupsByAdminRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4114,9 +3945,9 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupsByAdminRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupPoliciesByGroupRequest)
+		x := input.Message.Interface().(*QueryGroupsByAdminRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4128,8 +3959,9 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		if x.GroupId != 0 {
-			n += 1 + runtime.Sov(uint64(x.GroupId))
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -4145,7 +3977,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByGroupRequest)
+		x := input.Message.Interface().(*QueryGroupsByAdminRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4178,10 +4010,12 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoi
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.GroupId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4194,7 +4028,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByGroupRequest)
+		x := input.Message.Interface().(*QueryGroupsByAdminRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4226,17 +4060,17 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 				}
-				x.GroupId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4246,11 +4080,24 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GroupId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4322,79 +4169,79 @@ func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoi
 	}
 }
 
-var _ protoreflect.List = (*_QueryGroupPoliciesByGroupResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGroupsByAdminResponse_1_list)(nil)
 
-type _QueryGroupPoliciesByGroupResponse_1_list struct {
-	list *[]*GroupPolicyInfo
+type _QueryGroupsByAdminResponse_1_list struct {
+	list *[]*GroupInfo
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) Len() int {
+func (x *_QueryGroupsByAdminResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGroupsByAdminResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGroupsByAdminResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*GroupPolicyInfo)
+	concreteValue := valueUnwrapped.Interface().(*GroupInfo)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGroupsByAdminResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*GroupPolicyInfo)
+	concreteValue := valueUnwrapped.Interface().(*GroupInfo)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(GroupPolicyInfo)
+func (x *_QueryGroupsByAdminResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(GroupInfo)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) Truncate(n int) {
+func (x *_QueryGroupsByAdminResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) NewElement() protoreflect.Value {
-	v := new(GroupPolicyInfo)
+func (x *_QueryGroupsByAdminResponse_1_list) NewElement() protoreflect.Value {
+	v := new(GroupInfo)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGroupPoliciesByGroupResponse_1_list) IsValid() bool {
+func (x *_QueryGroupsByAdminResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGroupPoliciesByGroupResponse                protoreflect.MessageDescriptor
-	fd_QueryGroupPoliciesByGroupResponse_group_policies protoreflect.FieldDescriptor
-	fd_QueryGroupPoliciesByGroupResponse_pagination     protoreflect.FieldDescriptor
+	md_QueryGroupsByAdminResponse            protoreflect.MessageDescriptor
+	fd_QueryGroupsByAdminResponse_groups     protoreflect.FieldDescriptor
+	fd_QueryGroupsByAdminResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupPoliciesByGroupResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByGroupResponse")
-	fd_QueryGroupPoliciesByGroupResponse_group_policies = md_QueryGroupPoliciesByGroupResponse.Fields().ByName("group_policies")
-	fd_QueryGroupPoliciesByGroupResponse_pagination = md_QueryGroupPoliciesByGroupResponse.Fields().ByName("pagination")
+	md_QueryGroupsByAdminResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupsByAdminResponse")
+	fd_QueryGroupsByAdminResponse_groups = md_QueryGroupsByAdminResponse.Fields().ByName("groups")
+	fd_QueryGroupsByAdminResponse_pagination = md_QueryGroupsByAdminResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByGroupResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupsByAdminResponse)(nil)
 
-type fastReflection_QueryGroupPoliciesByGroupResponse QueryGroupPoliciesByGroupResponse
+type fastReflection_QueryGroupsByAdminResponse QueryGroupsByAdminResponse
 
-func (x *QueryGroupPoliciesByGroupResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByGroupResponse)(x)
+func (x *QueryGroupsByAdminResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupsByAdminResponse)(x)
 }
 
-func (x *QueryGroupPoliciesByGroupResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupsByAdminResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4406,43 +4253,43 @@ func (x *QueryGroupPoliciesByGroupResponse) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupPoliciesByGroupResponse_messageType fastReflection_QueryGroupPoliciesByGroupResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByGroupResponse_messageType{}
+var _fastReflection_QueryGroupsByAdminResponse_messageType fastReflection_QueryGroupsByAdminResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupsByAdminResponse_messageType{}
 
-type fastReflection_QueryGroupPoliciesByGroupResponse_messageType struct{}
+type fastReflection_QueryGroupsByAdminResponse_messageType struct{}
 
-func (x fastReflection_QueryGroupPoliciesByGroupResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByGroupResponse)(nil)
+func (x fastReflection_QueryGroupsByAdminResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupsByAdminResponse)(nil)
 }
-func (x fastReflection_QueryGroupPoliciesByGroupResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByGroupResponse)
+func (x fastReflection_QueryGroupsByAdminResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupsByAdminResponse)
 }
-func (x fastReflection_QueryGroupPoliciesByGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByGroupResponse
+func (x fastReflection_QueryGroupsByAdminResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupsByAdminResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByGroupResponse
+func (x *fastReflection_QueryGroupsByAdminResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupsByAdminResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupPoliciesByGroupResponse_messageType
+func (x *fastReflection_QueryGroupsByAdminResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupsByAdminResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByGroupResponse)
+func (x *fastReflection_QueryGroupsByAdminResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupsByAdminResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupPoliciesByGroupResponse)(x)
+func (x *fastReflection_QueryGroupsByAdminResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupsByAdminResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4450,16 +4297,16 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.GroupPolicies) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGroupPoliciesByGroupResponse_1_list{list: &x.GroupPolicies})
-		if !f(fd_QueryGroupPoliciesByGroupResponse_group_policies, value) {
+func (x *fastReflection_QueryGroupsByAdminResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Groups) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGroupsByAdminResponse_1_list{list: &x.Groups})
+		if !f(fd_QueryGroupsByAdminResponse_groups, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupPoliciesByGroupResponse_pagination, value) {
+		if !f(fd_QueryGroupsByAdminResponse_pagination, value) {
 			return
 		}
 	}
@@ -4476,17 +4323,17 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupsByAdminResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
-		return len(x.GroupPolicies) != 0
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
+		return len(x.Groups) != 0
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4496,17 +4343,17 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupsByAdminResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
-		x.GroupPolicies = nil
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
+		x.Groups = nil
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4516,22 +4363,22 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupsByAdminResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
-		if len(x.GroupPolicies) == 0 {
-			return protoreflect.ValueOfList(&_QueryGroupPoliciesByGroupResponse_1_list{})
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
+		if len(x.Groups) == 0 {
+			return protoreflect.ValueOfList(&_QueryGroupsByAdminResponse_1_list{})
 		}
-		listValue := &_QueryGroupPoliciesByGroupResponse_1_list{list: &x.GroupPolicies}
+		listValue := &_QueryGroupsByAdminResponse_1_list{list: &x.Groups}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4545,19 +4392,19 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupsByAdminResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
 		lv := value.List()
-		clv := lv.(*_QueryGroupPoliciesByGroupResponse_1_list)
-		x.GroupPolicies = *clv.list
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
+		clv := lv.(*_QueryGroupsByAdminResponse_1_list)
+		x.Groups = *clv.list
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4571,53 +4418,53 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupsByAdminResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
-		if x.GroupPolicies == nil {
-			x.GroupPolicies = []*GroupPolicyInfo{}
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
+		if x.Groups == nil {
+			x.Groups = []*GroupInfo{}
 		}
-		value := &_QueryGroupPoliciesByGroupResponse_1_list{list: &x.GroupPolicies}
+		value := &_QueryGroupsByAdminResponse_1_list{list: &x.Groups}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupsByAdminResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
-		list := []*GroupPolicyInfo{}
-		return protoreflect.ValueOfList(&_QueryGroupPoliciesByGroupResponse_1_list{list: &list})
-	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.groups":
+		list := []*GroupInfo{}
+		return protoreflect.ValueOfList(&_QueryGroupsByAdminResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryGroupsByAdminResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupsByAdminResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupsByAdminResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupsByAdminResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByGroupResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupsByAdminResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4625,7 +4472,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupsByAdminResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4636,7 +4483,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupsByAdminResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4648,7 +4495,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) IsValid() bool {
+func (x *fastReflection_QueryGroupsByAdminResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4658,9 +4505,9 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupsByAdminResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupPoliciesByGroupResponse)
+		x := input.Message.Interface().(*QueryGroupsByAdminResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4672,8 +4519,8 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *proto
 		var n int
 		var l int
 		_ = l
-		if len(x.GroupPolicies) > 0 {
-			for _, e := range x.GroupPolicies {
+		if len(x.Groups) > 0 {
+			for _, e := range x.Groups {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -4692,7 +4539,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByGroupResponse)
+		x := input.Message.Interface().(*QueryGroupsByAdminResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4725,9 +4572,9 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *proto
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.GroupPolicies) > 0 {
-			for iNdEx := len(x.GroupPolicies) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.GroupPolicies[iNdEx])
+		if len(x.Groups) > 0 {
+			for iNdEx := len(x.Groups) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Groups[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4752,7 +4599,7 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByGroupResponse)
+		x := input.Message.Interface().(*QueryGroupsByAdminResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4784,15 +4631,15 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4819,8 +4666,8 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *proto
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GroupPolicies = append(x.GroupPolicies, &GroupPolicyInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GroupPolicies[len(x.GroupPolicies)-1]); err != nil {
+				x.Groups = append(x.Groups, &GroupInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Groups[len(x.Groups)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4896,27 +4743,27 @@ func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *proto
 }
 
 var (
-	md_QueryGroupPoliciesByAdminRequest            protoreflect.MessageDescriptor
-	fd_QueryGroupPoliciesByAdminRequest_admin      protoreflect.FieldDescriptor
-	fd_QueryGroupPoliciesByAdminRequest_pagination protoreflect.FieldDescriptor
+	md_QueryGroupPoliciesByGroupRequest            protoreflect.MessageDescriptor
+	fd_QueryGroupPoliciesByGroupRequest_group_id   protoreflect.FieldDescriptor
+	fd_QueryGroupPoliciesByGroupRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupPoliciesByAdminRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByAdminRequest")
-	fd_QueryGroupPoliciesByAdminRequest_admin = md_QueryGroupPoliciesByAdminRequest.Fields().ByName("admin")
-	fd_QueryGroupPoliciesByAdminRequest_pagination = md_QueryGroupPoliciesByAdminRequest.Fields().ByName("pagination")
+	md_QueryGroupPoliciesByGroupRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByGroupRequest")
+	fd_QueryGroupPoliciesByGroupRequest_group_id = md_QueryGroupPoliciesByGroupRequest.Fields().ByName("group_id")
+	fd_QueryGroupPoliciesByGroupRequest_pagination = md_QueryGroupPoliciesByGroupRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByAdminRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByGroupRequest)(nil)
 
-type fastReflection_QueryGroupPoliciesByAdminRequest QueryGroupPoliciesByAdminRequest
+type fastReflection_QueryGroupPoliciesByGroupRequest QueryGroupPoliciesByGroupRequest
 
-func (x *QueryGroupPoliciesByAdminRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByAdminRequest)(x)
+func (x *QueryGroupPoliciesByGroupRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByGroupRequest)(x)
 }
 
-func (x *QueryGroupPoliciesByAdminRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupPoliciesByGroupRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4928,43 +4775,43 @@ func (x *QueryGroupPoliciesByAdminRequest) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupPoliciesByAdminRequest_messageType fastReflection_QueryGroupPoliciesByAdminRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByAdminRequest_messageType{}
+var _fastReflection_QueryGroupPoliciesByGroupRequest_messageType fastReflection_QueryGroupPoliciesByGroupRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByGroupRequest_messageType{}
 
-type fastReflection_QueryGroupPoliciesByAdminRequest_messageType struct{}
+type fastReflection_QueryGroupPoliciesByGroupRequest_messageType struct{}
 
-func (x fastReflection_QueryGroupPoliciesByAdminRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByAdminRequest)(nil)
+func (x fastReflection_QueryGroupPoliciesByGroupRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByGroupRequest)(nil)
 }
-func (x fastReflection_QueryGroupPoliciesByAdminRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByAdminRequest)
+func (x fastReflection_QueryGroupPoliciesByGroupRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByGroupRequest)
 }
-func (x fastReflection_QueryGroupPoliciesByAdminRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByAdminRequest
+func (x fastReflection_QueryGroupPoliciesByGroupRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByGroupRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByAdminRequest
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByGroupRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupPoliciesByAdminRequest_messageType
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupPoliciesByGroupRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByAdminRequest)
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByGroupRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupPoliciesByAdminRequest)(x)
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupPoliciesByGroupRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4972,16 +4819,16 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Admin != "" {
-		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_QueryGroupPoliciesByAdminRequest_admin, value) {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_QueryGroupPoliciesByGroupRequest_group_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupPoliciesByAdminRequest_pagination, value) {
+		if !f(fd_QueryGroupPoliciesByGroupRequest_pagination, value) {
 			return
 		}
 	}
@@ -4998,17 +4845,17 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
-		return x.Admin != ""
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
+		return x.GroupId != uint64(0)
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5018,17 +4865,17 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
-		x.Admin = ""
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
+		x.GroupId = uint64(0)
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5038,19 +4885,19 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
-		value := x.Admin
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5064,17 +4911,17 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
-		x.Admin = value.Interface().(string)
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
+		x.GroupId = value.Uint()
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5088,48 +4935,48 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
-		panic(fmt.Errorf("field admin of message cosmos.group.v1.QueryGroupPoliciesByAdminRequest is not mutable"))
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.QueryGroupPoliciesByGroupRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByAdminRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByGroupRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5137,7 +4984,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5148,7 +4995,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5160,7 +5007,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) IsValid() bool {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5170,9 +5017,9 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupPoliciesByGroupRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupPoliciesByAdminRequest)
+		x := input.Message.Interface().(*QueryGroupPoliciesByGroupRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5184,9 +5031,8 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		l = len(x.Admin)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -5202,7 +5048,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByAdminRequest)
+		x := input.Message.Interface().(*QueryGroupPoliciesByGroupRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5235,12 +5081,10 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoi
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Admin) > 0 {
-			i -= len(x.Admin)
-			copy(dAtA[i:], x.Admin)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5253,7 +5097,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByAdminRequest)
+		x := input.Message.Interface().(*QueryGroupPoliciesByGroupRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5285,17 +5129,17 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 				}
-				var stringLen uint64
+				x.GroupId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5305,24 +5149,11 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoi
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.GroupId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Admin = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5394,79 +5225,79 @@ func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoi
 	}
 }
 
-var _ protoreflect.List = (*_QueryGroupPoliciesByAdminResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGroupPoliciesByGroupResponse_1_list)(nil)
 
-type _QueryGroupPoliciesByAdminResponse_1_list struct {
+type _QueryGroupPoliciesByGroupResponse_1_list struct {
 	list *[]*GroupPolicyInfo
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) Len() int {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*GroupPolicyInfo)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*GroupPolicyInfo)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(GroupPolicyInfo)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) Truncate(n int) {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) NewElement() protoreflect.Value {
 	v := new(GroupPolicyInfo)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGroupPoliciesByAdminResponse_1_list) IsValid() bool {
+func (x *_QueryGroupPoliciesByGroupResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGroupPoliciesByAdminResponse                protoreflect.MessageDescriptor
-	fd_QueryGroupPoliciesByAdminResponse_group_policies protoreflect.FieldDescriptor
-	fd_QueryGroupPoliciesByAdminResponse_pagination     protoreflect.FieldDescriptor
+	md_QueryGroupPoliciesByGroupResponse                protoreflect.MessageDescriptor
+	fd_QueryGroupPoliciesByGroupResponse_group_policies protoreflect.FieldDescriptor
+	fd_QueryGroupPoliciesByGroupResponse_pagination     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryGroupPoliciesByAdminResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByAdminResponse")
-	fd_QueryGroupPoliciesByAdminResponse_group_policies = md_QueryGroupPoliciesByAdminResponse.Fields().ByName("group_policies")
-	fd_QueryGroupPoliciesByAdminResponse_pagination = md_QueryGroupPoliciesByAdminResponse.Fields().ByName("pagination")
+	md_QueryGroupPoliciesByGroupResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByGroupResponse")
+	fd_QueryGroupPoliciesByGroupResponse_group_policies = md_QueryGroupPoliciesByGroupResponse.Fields().ByName("group_policies")
+	fd_QueryGroupPoliciesByGroupResponse_pagination = md_QueryGroupPoliciesByGroupResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByAdminResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByGroupResponse)(nil)
 
-type fastReflection_QueryGroupPoliciesByAdminResponse QueryGroupPoliciesByAdminResponse
+type fastReflection_QueryGroupPoliciesByGroupResponse QueryGroupPoliciesByGroupResponse
 
-func (x *QueryGroupPoliciesByAdminResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByAdminResponse)(x)
+func (x *QueryGroupPoliciesByGroupResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByGroupResponse)(x)
 }
 
-func (x *QueryGroupPoliciesByAdminResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupPoliciesByGroupResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5478,43 +5309,43 @@ func (x *QueryGroupPoliciesByAdminResponse) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGroupPoliciesByAdminResponse_messageType fastReflection_QueryGroupPoliciesByAdminResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByAdminResponse_messageType{}
+var _fastReflection_QueryGroupPoliciesByGroupResponse_messageType fastReflection_QueryGroupPoliciesByGroupResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByGroupResponse_messageType{}
 
-type fastReflection_QueryGroupPoliciesByAdminResponse_messageType struct{}
+type fastReflection_QueryGroupPoliciesByGroupResponse_messageType struct{}
 
-func (x fastReflection_QueryGroupPoliciesByAdminResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGroupPoliciesByAdminResponse)(nil)
+func (x fastReflection_QueryGroupPoliciesByGroupResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByGroupResponse)(nil)
 }
-func (x fastReflection_QueryGroupPoliciesByAdminResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByAdminResponse)
+func (x fastReflection_QueryGroupPoliciesByGroupResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByGroupResponse)
 }
-func (x fastReflection_QueryGroupPoliciesByAdminResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByAdminResponse
+func (x fastReflection_QueryGroupPoliciesByGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByGroupResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGroupPoliciesByAdminResponse
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByGroupResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGroupPoliciesByAdminResponse_messageType
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupPoliciesByGroupResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGroupPoliciesByAdminResponse)
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByGroupResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGroupPoliciesByAdminResponse)(x)
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupPoliciesByGroupResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5522,16 +5353,16 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.GroupPolicies) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGroupPoliciesByAdminResponse_1_list{list: &x.GroupPolicies})
-		if !f(fd_QueryGroupPoliciesByAdminResponse_group_policies, value) {
+		value := protoreflect.ValueOfList(&_QueryGroupPoliciesByGroupResponse_1_list{list: &x.GroupPolicies})
+		if !f(fd_QueryGroupPoliciesByGroupResponse_group_policies, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGroupPoliciesByAdminResponse_pagination, value) {
+		if !f(fd_QueryGroupPoliciesByGroupResponse_pagination, value) {
 			return
 		}
 	}
@@ -5548,17 +5379,17 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
 		return len(x.GroupPolicies) != 0
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5568,17 +5399,17 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
 		x.GroupPolicies = nil
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5588,22 +5419,22 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
 		if len(x.GroupPolicies) == 0 {
-			return protoreflect.ValueOfList(&_QueryGroupPoliciesByAdminResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryGroupPoliciesByGroupResponse_1_list{})
 		}
-		listValue := &_QueryGroupPoliciesByAdminResponse_1_list{list: &x.GroupPolicies}
+		listValue := &_QueryGroupPoliciesByGroupResponse_1_list{list: &x.GroupPolicies}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5617,19 +5448,19 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
 		lv := value.List()
-		clv := lv.(*_QueryGroupPoliciesByAdminResponse_1_list)
+		clv := lv.(*_QueryGroupPoliciesByGroupResponse_1_list)
 		x.GroupPolicies = *clv.list
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5643,53 +5474,53 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
 		if x.GroupPolicies == nil {
 			x.GroupPolicies = []*GroupPolicyInfo{}
 		}
-		value := &_QueryGroupPoliciesByAdminResponse_1_list{list: &x.GroupPolicies}
+		value := &_QueryGroupPoliciesByGroupResponse_1_list{list: &x.GroupPolicies}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies":
 		list := []*GroupPolicyInfo{}
-		return protoreflect.ValueOfList(&_QueryGroupPoliciesByAdminResponse_1_list{list: &list})
-	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+		return protoreflect.ValueOfList(&_QueryGroupPoliciesByGroupResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByAdminResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByGroupResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5697,7 +5528,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5708,7 +5539,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5720,7 +5551,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) IsValid() bool {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5730,9 +5561,9 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGroupPoliciesByAdminResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupPoliciesByGroupResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGroupPoliciesByAdminResponse)
+		x := input.Message.Interface().(*QueryGroupPoliciesByGroupResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5764,7 +5595,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByAdminResponse)
+		x := input.Message.Interface().(*QueryGroupPoliciesByGroupResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5824,7 +5655,7 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGroupPoliciesByAdminResponse)
+		x := input.Message.Interface().(*QueryGroupPoliciesByGroupResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5856,10 +5687,10 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5968,25 +5799,27 @@ func (x *fastReflection_QueryGroupPoliciesByAdminResponse) ProtoMethods() *proto
 }
 
 var (
-	md_QueryProposalRequest             protoreflect.MessageDescriptor
-	fd_QueryProposalRequest_proposal_id protoreflect.FieldDescriptor
+	md_QueryGroupPoliciesByAdminRequest            protoreflect.MessageDescriptor
+	fd_QueryGroupPoliciesByAdminRequest_admin      protoreflect.FieldDescriptor
+	fd_QueryGroupPoliciesByAdminRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryProposalRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalRequest")
-	fd_QueryProposalRequest_proposal_id = md_QueryProposalRequest.Fields().ByName("proposal_id")
+	md_QueryGroupPoliciesByAdminRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByAdminRequest")
+	fd_QueryGroupPoliciesByAdminRequest_admin = md_QueryGroupPoliciesByAdminRequest.Fields().ByName("admin")
+	fd_QueryGroupPoliciesByAdminRequest_pagination = md_QueryGroupPoliciesByAdminRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByAdminRequest)(nil)
 
-type fastReflection_QueryProposalRequest QueryProposalRequest
+type fastReflection_QueryGroupPoliciesByAdminRequest QueryGroupPoliciesByAdminRequest
 
-func (x *QueryProposalRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalRequest)(x)
+func (x *QueryGroupPoliciesByAdminRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByAdminRequest)(x)
 }
 
-func (x *QueryProposalRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupPoliciesByAdminRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5998,43 +5831,43 @@ func (x *QueryProposalRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalRequest_messageType fastReflection_QueryProposalRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalRequest_messageType{}
+var _fastReflection_QueryGroupPoliciesByAdminRequest_messageType fastReflection_QueryGroupPoliciesByAdminRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByAdminRequest_messageType{}
 
-type fastReflection_QueryProposalRequest_messageType struct{}
+type fastReflection_QueryGroupPoliciesByAdminRequest_messageType struct{}
 
-func (x fastReflection_QueryProposalRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalRequest)(nil)
+func (x fastReflection_QueryGroupPoliciesByAdminRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByAdminRequest)(nil)
 }
-func (x fastReflection_QueryProposalRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalRequest)
+func (x fastReflection_QueryGroupPoliciesByAdminRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByAdminRequest)
 }
-func (x fastReflection_QueryProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalRequest
+func (x fastReflection_QueryGroupPoliciesByAdminRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByAdminRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalRequest
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByAdminRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalRequest_messageType
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupPoliciesByAdminRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalRequest)
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByAdminRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalRequest)(x)
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupPoliciesByAdminRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6042,10 +5875,16 @@ func (x *fastReflection_QueryProposalRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryProposalRequest_proposal_id, value) {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_QueryGroupPoliciesByAdminRequest_admin, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGroupPoliciesByAdminRequest_pagination, value) {
 			return
 		}
 	}
@@ -6062,15 +5901,17 @@ func (x *fastReflection_QueryProposalRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
-		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
+		return x.Admin != ""
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6080,15 +5921,17 @@ func (x *fastReflection_QueryProposalRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
-		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
+		x.Admin = ""
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6098,16 +5941,19 @@ func (x *fastReflection_QueryProposalRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6121,15 +5967,17 @@ func (x *fastReflection_QueryProposalRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
-		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
+		x.Admin = value.Interface().(string)
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6143,40 +5991,48 @@ func (x *fastReflection_QueryProposalRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.QueryProposalRequest is not mutable"))
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
+		panic(fmt.Errorf("field admin of message cosmos.group.v1.QueryGroupPoliciesByAdminRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.admin":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByAdminRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6184,7 +6040,7 @@ func (x *fastReflection_QueryProposalRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6195,7 +6051,7 @@ func (x *fastReflection_QueryProposalRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6207,7 +6063,7 @@ func (x *fastReflection_QueryProposalRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalRequest) IsValid() bool {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6217,9 +6073,9 @@ func (x *fastReflection_QueryProposalRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGroupPoliciesByAdminRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalRequest)
+		x := input.Message.Interface().(*QueryGroupPoliciesByAdminRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6231,8 +6087,13 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6244,7 +6105,7 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalRequest)
+		x := input.Message.Interface().(*QueryGroupPoliciesByAdminRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6263,10 +6124,26 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x12
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6279,7 +6156,7 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalRequest)
+		x := input.Message.Interface().(*QueryGroupPoliciesByAdminRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6311,17 +6188,17 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 				}
-				x.ProposalId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6331,33 +6208,82 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
 		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
 	}
 	return &protoiface.Methods{
@@ -6371,26 +6297,79 @@ func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryGroupPoliciesByAdminResponse_1_list)(nil)
+
+type _QueryGroupPoliciesByAdminResponse_1_list struct {
+	list *[]*GroupPolicyInfo
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GroupPolicyInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GroupPolicyInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(GroupPolicyInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) NewElement() protoreflect.Value {
+	v := new(GroupPolicyInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGroupPoliciesByAdminResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryProposalResponse          protoreflect.MessageDescriptor
-	fd_QueryProposalResponse_proposal protoreflect.FieldDescriptor
+	md_QueryGroupPoliciesByAdminResponse                protoreflect.MessageDescriptor
+	fd_QueryGroupPoliciesByAdminResponse_group_policies protoreflect.FieldDescriptor
+	fd_QueryGroupPoliciesByAdminResponse_pagination     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryProposalResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalResponse")
-	fd_QueryProposalResponse_proposal = md_QueryProposalResponse.Fields().ByName("proposal")
+	md_QueryGroupPoliciesByAdminResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryGroupPoliciesByAdminResponse")
+	fd_QueryGroupPoliciesByAdminResponse_group_policies = md_QueryGroupPoliciesByAdminResponse.Fields().ByName("group_policies")
+	fd_QueryGroupPoliciesByAdminResponse_pagination = md_QueryGroupPoliciesByAdminResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGroupPoliciesByAdminResponse)(nil)
 
-type fastReflection_QueryProposalResponse QueryProposalResponse
+type fastReflection_QueryGroupPoliciesByAdminResponse QueryGroupPoliciesByAdminResponse
 
-func (x *QueryProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalResponse)(x)
+func (x *QueryGroupPoliciesByAdminResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByAdminResponse)(x)
 }
 
-func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryGroupPoliciesByAdminResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6402,43 +6381,3913 @@ func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalResponse_messageType fastReflection_QueryProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalResponse_messageType{}
+var _fastReflection_QueryGroupPoliciesByAdminResponse_messageType fastReflection_QueryGroupPoliciesByAdminResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGroupPoliciesByAdminResponse_messageType{}
 
-type fastReflection_QueryProposalResponse_messageType struct{}
+type fastReflection_QueryGroupPoliciesByAdminResponse_messageType struct{}
 
-func (x fastReflection_QueryProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalResponse)(nil)
+func (x fastReflection_QueryGroupPoliciesByAdminResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGroupPoliciesByAdminResponse)(nil)
 }
-func (x fastReflection_QueryProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalResponse)
+func (x fastReflection_QueryGroupPoliciesByAdminResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByAdminResponse)
 }
-func (x fastReflection_QueryProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalResponse
+func (x fastReflection_QueryGroupPoliciesByAdminResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByAdminResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalResponse
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGroupPoliciesByAdminResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGroupPoliciesByAdminResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGroupPoliciesByAdminResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGroupPoliciesByAdminResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.GroupPolicies) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGroupPoliciesByAdminResponse_1_list{list: &x.GroupPolicies})
+		if !f(fd_QueryGroupPoliciesByAdminResponse_group_policies, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGroupPoliciesByAdminResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+		return len(x.GroupPolicies) != 0
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+		x.GroupPolicies = nil
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+		if len(x.GroupPolicies) == 0 {
+			return protoreflect.ValueOfList(&_QueryGroupPoliciesByAdminResponse_1_list{})
+		}
+		listValue := &_QueryGroupPoliciesByAdminResponse_1_list{list: &x.GroupPolicies}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+		lv := value.List()
+		clv := lv.(*_QueryGroupPoliciesByAdminResponse_1_list)
+		x.GroupPolicies = *clv.list
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+		if x.GroupPolicies == nil {
+			x.GroupPolicies = []*GroupPolicyInfo{}
+		}
+		value := &_QueryGroupPoliciesByAdminResponse_1_list{list: &x.GroupPolicies}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies":
+		list := []*GroupPolicyInfo{}
+		return protoreflect.ValueOfList(&_QueryGroupPoliciesByAdminResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryGroupPoliciesByAdminResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryGroupPoliciesByAdminResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryGroupPoliciesByAdminResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGroupPoliciesByAdminResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGroupPoliciesByAdminResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.GroupPolicies) > 0 {
+			for _, e := range x.GroupPolicies {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupPoliciesByAdminResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.GroupPolicies) > 0 {
+			for iNdEx := len(x.GroupPolicies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GroupPolicies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGroupPoliciesByAdminResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPolicies = append(x.GroupPolicies, &GroupPolicyInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GroupPolicies[len(x.GroupPolicies)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryProposalRequest             protoreflect.MessageDescriptor
+	fd_QueryProposalRequest_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryProposalRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalRequest")
+	fd_QueryProposalRequest_proposal_id = md_QueryProposalRequest.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProposalRequest)(nil)
+
+type fastReflection_QueryProposalRequest QueryProposalRequest
+
+func (x *QueryProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalRequest)(x)
+}
+
+func (x *QueryProposalRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProposalRequest_messageType fastReflection_QueryProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalRequest_messageType{}
+
+type fastReflection_QueryProposalRequest_messageType struct{}
+
+func (x fastReflection_QueryProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalRequest)(nil)
+}
+func (x fastReflection_QueryProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalRequest)
+}
+func (x fastReflection_QueryProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryProposalRequest_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.QueryProposalRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProposalRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProposalRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProposalRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProposalRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryProposalResponse          protoreflect.MessageDescriptor
+	fd_QueryProposalResponse_proposal protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryProposalResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalResponse")
+	fd_QueryProposalResponse_proposal = md_QueryProposalResponse.Fields().ByName("proposal")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProposalResponse)(nil)
+
+type fastReflection_QueryProposalResponse QueryProposalResponse
+
+func (x *QueryProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalResponse)(x)
+}
+
+func (x *QueryProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProposalResponse_messageType fastReflection_QueryProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalResponse_messageType{}
+
+type fastReflection_QueryProposalResponse_messageType struct{}
+
+func (x fastReflection_QueryProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalResponse)(nil)
+}
+func (x fastReflection_QueryProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalResponse)
+}
+func (x fastReflection_QueryProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Proposal != nil {
+		value := protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+		if !f(fd_QueryProposalResponse_proposal, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalResponse.proposal":
+		return x.Proposal != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalResponse.proposal":
+		x.Proposal = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryProposalResponse.proposal":
+		value := x.Proposal
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalResponse.proposal":
+		x.Proposal = value.Message().Interface().(*Proposal)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalResponse.proposal":
+		if x.Proposal == nil {
+			x.Proposal = new(Proposal)
+		}
+		return protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalResponse.proposal":
+		m := new(Proposal)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Proposal != nil {
+			l = options.Size(x.Proposal)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Proposal != nil {
+			encoded, err := options.Marshal(x.Proposal)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Proposal == nil {
+					x.Proposal = &Proposal{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposal); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryProposalsByGroupPolicyRequest            protoreflect.MessageDescriptor
+	fd_QueryProposalsByGroupPolicyRequest_address    protoreflect.FieldDescriptor
+	fd_QueryProposalsByGroupPolicyRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryProposalsByGroupPolicyRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalsByGroupPolicyRequest")
+	fd_QueryProposalsByGroupPolicyRequest_address = md_QueryProposalsByGroupPolicyRequest.Fields().ByName("address")
+	fd_QueryProposalsByGroupPolicyRequest_pagination = md_QueryProposalsByGroupPolicyRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupPolicyRequest)(nil)
+
+type fastReflection_QueryProposalsByGroupPolicyRequest QueryProposalsByGroupPolicyRequest
+
+func (x *QueryProposalsByGroupPolicyRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupPolicyRequest)(x)
+}
+
+func (x *QueryProposalsByGroupPolicyRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProposalsByGroupPolicyRequest_messageType fastReflection_QueryProposalsByGroupPolicyRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupPolicyRequest_messageType{}
+
+type fastReflection_QueryProposalsByGroupPolicyRequest_messageType struct{}
+
+func (x fastReflection_QueryProposalsByGroupPolicyRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupPolicyRequest)(nil)
+}
+func (x fastReflection_QueryProposalsByGroupPolicyRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupPolicyRequest)
+}
+func (x fastReflection_QueryProposalsByGroupPolicyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupPolicyRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupPolicyRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalsByGroupPolicyRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupPolicyRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalsByGroupPolicyRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryProposalsByGroupPolicyRequest_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalsByGroupPolicyRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
+		return x.Address != ""
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
+		x.Address = ""
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.group.v1.QueryProposalsByGroupPolicyRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalsByGroupPolicyRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProposalsByGroupPolicyRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalsByGroupPolicyRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalsByGroupPolicyRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryProposalsByGroupPolicyResponse_1_list)(nil)
+
+type _QueryProposalsByGroupPolicyResponse_1_list struct {
+	list *[]*Proposal
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Proposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Proposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProposalsByGroupPolicyResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryProposalsByGroupPolicyResponse            protoreflect.MessageDescriptor
+	fd_QueryProposalsByGroupPolicyResponse_proposals  protoreflect.FieldDescriptor
+	fd_QueryProposalsByGroupPolicyResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryProposalsByGroupPolicyResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalsByGroupPolicyResponse")
+	fd_QueryProposalsByGroupPolicyResponse_proposals = md_QueryProposalsByGroupPolicyResponse.Fields().ByName("proposals")
+	fd_QueryProposalsByGroupPolicyResponse_pagination = md_QueryProposalsByGroupPolicyResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupPolicyResponse)(nil)
+
+type fastReflection_QueryProposalsByGroupPolicyResponse QueryProposalsByGroupPolicyResponse
+
+func (x *QueryProposalsByGroupPolicyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupPolicyResponse)(x)
+}
+
+func (x *QueryProposalsByGroupPolicyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProposalsByGroupPolicyResponse_messageType fastReflection_QueryProposalsByGroupPolicyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupPolicyResponse_messageType{}
+
+type fastReflection_QueryProposalsByGroupPolicyResponse_messageType struct{}
+
+func (x fastReflection_QueryProposalsByGroupPolicyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProposalsByGroupPolicyResponse)(nil)
+}
+func (x fastReflection_QueryProposalsByGroupPolicyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupPolicyResponse)
+}
+func (x fastReflection_QueryProposalsByGroupPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupPolicyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProposalsByGroupPolicyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProposalsByGroupPolicyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProposalsByGroupPolicyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProposalsByGroupPolicyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Proposals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProposalsByGroupPolicyResponse_1_list{list: &x.Proposals})
+		if !f(fd_QueryProposalsByGroupPolicyResponse_proposals, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProposalsByGroupPolicyResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
+		return len(x.Proposals) != 0
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
+		x.Proposals = nil
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
+		if len(x.Proposals) == 0 {
+			return protoreflect.ValueOfList(&_QueryProposalsByGroupPolicyResponse_1_list{})
+		}
+		listValue := &_QueryProposalsByGroupPolicyResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
+		lv := value.List()
+		clv := lv.(*_QueryProposalsByGroupPolicyResponse_1_list)
+		x.Proposals = *clv.list
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
+		if x.Proposals == nil {
+			x.Proposals = []*Proposal{}
+		}
+		value := &_QueryProposalsByGroupPolicyResponse_1_list{list: &x.Proposals}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
+		list := []*Proposal{}
+		return protoreflect.ValueOfList(&_QueryProposalsByGroupPolicyResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalsByGroupPolicyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProposalsByGroupPolicyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Proposals) > 0 {
+			for _, e := range x.Proposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalsByGroupPolicyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Proposals) > 0 {
+			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProposalsByGroupPolicyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposals = append(x.Proposals, &Proposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVoteByProposalVoterRequest             protoreflect.MessageDescriptor
+	fd_QueryVoteByProposalVoterRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryVoteByProposalVoterRequest_voter       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryVoteByProposalVoterRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVoteByProposalVoterRequest")
+	fd_QueryVoteByProposalVoterRequest_proposal_id = md_QueryVoteByProposalVoterRequest.Fields().ByName("proposal_id")
+	fd_QueryVoteByProposalVoterRequest_voter = md_QueryVoteByProposalVoterRequest.Fields().ByName("voter")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
+
+type fastReflection_QueryVoteByProposalVoterRequest QueryVoteByProposalVoterRequest
+
+func (x *QueryVoteByProposalVoterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterRequest)(x)
+}
+
+func (x *QueryVoteByProposalVoterRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVoteByProposalVoterRequest_messageType fastReflection_QueryVoteByProposalVoterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterRequest_messageType{}
+
+type fastReflection_QueryVoteByProposalVoterRequest_messageType struct{}
+
+func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
+}
+func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterRequest)
+}
+func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteByProposalVoterRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteByProposalVoterRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryVoteByProposalVoterRequest_proposal_id, value) {
+			return
+		}
+	}
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_QueryVoteByProposalVoterRequest_voter, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+		return x.Voter != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+		x.Voter = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+		x.Voter = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.QueryVoteByProposalVoterRequest is not mutable"))
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+		panic(fmt.Errorf("field voter of message cosmos.group.v1.QueryVoteByProposalVoterRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVoteByProposalVoterRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVoteByProposalVoterResponse      protoreflect.MessageDescriptor
+	fd_QueryVoteByProposalVoterResponse_vote protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryVoteByProposalVoterResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVoteByProposalVoterResponse")
+	fd_QueryVoteByProposalVoterResponse_vote = md_QueryVoteByProposalVoterResponse.Fields().ByName("vote")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
+
+type fastReflection_QueryVoteByProposalVoterResponse QueryVoteByProposalVoterResponse
+
+func (x *QueryVoteByProposalVoterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterResponse)(x)
+}
+
+func (x *QueryVoteByProposalVoterResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVoteByProposalVoterResponse_messageType fastReflection_QueryVoteByProposalVoterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterResponse_messageType{}
+
+type fastReflection_QueryVoteByProposalVoterResponse_messageType struct{}
+
+func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
+}
+func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterResponse)
+}
+func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVoteByProposalVoterResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVoteByProposalVoterResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVoteByProposalVoterResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVoteByProposalVoterResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Vote != nil {
+		value := protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
+		if !f(fd_QueryVoteByProposalVoterResponse_vote, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
+		return x.Vote != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
+		x.Vote = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
+		value := x.Vote
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
+		x.Vote = value.Message().Interface().(*Vote)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
+		if x.Vote == nil {
+			x.Vote = new(Vote)
+		}
+		return protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
+		m := new(Vote)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVoteByProposalVoterResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Vote != nil {
+			l = options.Size(x.Vote)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Vote != nil {
+			encoded, err := options.Marshal(x.Vote)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Vote == nil {
+					x.Vote = &Vote{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vote); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVotesByProposalRequest             protoreflect.MessageDescriptor
+	fd_QueryVotesByProposalRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryVotesByProposalRequest_pagination  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryVotesByProposalRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByProposalRequest")
+	fd_QueryVotesByProposalRequest_proposal_id = md_QueryVotesByProposalRequest.Fields().ByName("proposal_id")
+	fd_QueryVotesByProposalRequest_pagination = md_QueryVotesByProposalRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalRequest)(nil)
+
+type fastReflection_QueryVotesByProposalRequest QueryVotesByProposalRequest
+
+func (x *QueryVotesByProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalRequest)(x)
+}
+
+func (x *QueryVotesByProposalRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVotesByProposalRequest_messageType fastReflection_QueryVotesByProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalRequest_messageType{}
+
+type fastReflection_QueryVotesByProposalRequest_messageType struct{}
+
+func (x fastReflection_QueryVotesByProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalRequest)(nil)
+}
+func (x fastReflection_QueryVotesByProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalRequest)
+}
+func (x fastReflection_QueryVotesByProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVotesByProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVotesByProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByProposalRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVotesByProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVotesByProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByProposalRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVotesByProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryVotesByProposalRequest_proposal_id, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotesByProposalRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVotesByProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVotesByProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.QueryVotesByProposalRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVotesByProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVotesByProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByProposalRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVotesByProposalRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVotesByProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVotesByProposalRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryVotesByProposalResponse_1_list)(nil)
+
+type _QueryVotesByProposalResponse_1_list struct {
+	list *[]*Vote
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Vote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Vote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVotesByProposalResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryVotesByProposalResponse            protoreflect.MessageDescriptor
+	fd_QueryVotesByProposalResponse_votes      protoreflect.FieldDescriptor
+	fd_QueryVotesByProposalResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_query_proto_init()
+	md_QueryVotesByProposalResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByProposalResponse")
+	fd_QueryVotesByProposalResponse_votes = md_QueryVotesByProposalResponse.Fields().ByName("votes")
+	fd_QueryVotesByProposalResponse_pagination = md_QueryVotesByProposalResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalResponse)(nil)
+
+type fastReflection_QueryVotesByProposalResponse QueryVotesByProposalResponse
+
+func (x *QueryVotesByProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalResponse)(x)
+}
+
+func (x *QueryVotesByProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVotesByProposalResponse_messageType fastReflection_QueryVotesByProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalResponse_messageType{}
+
+type fastReflection_QueryVotesByProposalResponse_messageType struct{}
+
+func (x fastReflection_QueryVotesByProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByProposalResponse)(nil)
+}
+func (x fastReflection_QueryVotesByProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalResponse)
+}
+func (x fastReflection_QueryVotesByProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVotesByProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalResponse_messageType
+func (x *fastReflection_QueryVotesByProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalResponse)
+func (x *fastReflection_QueryVotesByProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalResponse)(x)
+func (x *fastReflection_QueryVotesByProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6446,10 +10295,16 @@ func (x *fastReflection_QueryProposalResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Proposal != nil {
-		value := protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
-		if !f(fd_QueryProposalResponse_proposal, value) {
+func (x *fastReflection_QueryVotesByProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &x.Votes})
+		if !f(fd_QueryVotesByProposalResponse_votes, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotesByProposalResponse_pagination, value) {
 			return
 		}
 	}
@@ -6466,15 +10321,17 @@ func (x *fastReflection_QueryProposalResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotesByProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalResponse.proposal":
-		return x.Proposal != nil
+	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
+		return len(x.Votes) != 0
+	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6484,15 +10341,17 @@ func (x *fastReflection_QueryProposalResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotesByProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalResponse.proposal":
-		x.Proposal = nil
+	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
+		x.Votes = nil
+	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6502,16 +10361,22 @@ func (x *fastReflection_QueryProposalResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryProposalResponse.proposal":
-		value := x.Proposal
+	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{})
+		}
+		listValue := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6525,15 +10390,19 @@ func (x *fastReflection_QueryProposalResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotesByProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalResponse.proposal":
-		x.Proposal = value.Message().Interface().(*Proposal)
+	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
+		lv := value.List()
+		clv := lv.(*_QueryVotesByProposalResponse_1_list)
+		x.Votes = *clv.list
+	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6547,44 +10416,53 @@ func (x *fastReflection_QueryProposalResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalResponse.proposal":
-		if x.Proposal == nil {
-			x.Proposal = new(Proposal)
+	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
+		if x.Votes == nil {
+			x.Votes = []*Vote{}
 		}
-		return protoreflect.ValueOfMessage(x.Proposal.ProtoReflect())
+		value := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalResponse.proposal":
-		m := new(Proposal)
+	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
+		list := []*Vote{}
+		return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotesByProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6592,7 +10470,7 @@ func (x *fastReflection_QueryProposalResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotesByProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6603,7 +10481,7 @@ func (x *fastReflection_QueryProposalResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotesByProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6615,7 +10493,7 @@ func (x *fastReflection_QueryProposalResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalResponse) IsValid() bool {
+func (x *fastReflection_QueryVotesByProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6625,9 +10503,9 @@ func (x *fastReflection_QueryProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryVotesByProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6639,8 +10517,14 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Proposal != nil {
-			l = options.Size(x.Proposal)
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6653,7 +10537,7 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryVotesByProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6672,8 +10556,8 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Proposal != nil {
-			encoded, err := options.Marshal(x.Proposal)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6684,7 +10568,23 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6697,7 +10597,7 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalResponse)
+		x := input.Message.Interface().(*QueryVotesByProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6729,15 +10629,15 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6764,10 +10664,44 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Proposal == nil {
-					x.Proposal = &Proposal{}
+				x.Votes = append(x.Votes, &Vote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposal); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6807,28 +10741,28 @@ func (x *fastReflection_QueryProposalResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryProposalsByGroupPolicyRequest            protoreflect.MessageDescriptor
-	fd_QueryProposalsByGroupPolicyRequest_address    protoreflect.FieldDescriptor
-	fd_QueryProposalsByGroupPolicyRequest_pagination protoreflect.FieldDescriptor
+	md_QueryOffchainApprovalsByProposalRequest             protoreflect.MessageDescriptor
+	fd_QueryOffchainApprovalsByProposalRequest_proposal_id protoreflect.FieldDescriptor
+	fd_QueryOffchainApprovalsByProposalRequest_pagination  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryProposalsByGroupPolicyRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalsByGroupPolicyRequest")
-	fd_QueryProposalsByGroupPolicyRequest_address = md_QueryProposalsByGroupPolicyRequest.Fields().ByName("address")
-	fd_QueryProposalsByGroupPolicyRequest_pagination = md_QueryProposalsByGroupPolicyRequest.Fields().ByName("pagination")
+	md_QueryOffchainApprovalsByProposalRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryOffchainApprovalsByProposalRequest")
+	fd_QueryOffchainApprovalsByProposalRequest_proposal_id = md_QueryOffchainApprovalsByProposalRequest.Fields().ByName("proposal_id")
+	fd_QueryOffchainApprovalsByProposalRequest_pagination = md_QueryOffchainApprovalsByProposalRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupPolicyRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOffchainApprovalsByProposalRequest)(nil)
 
-type fastReflection_QueryProposalsByGroupPolicyRequest QueryProposalsByGroupPolicyRequest
+type fastReflection_QueryOffchainApprovalsByProposalRequest QueryOffchainApprovalsByProposalRequest
 
-func (x *QueryProposalsByGroupPolicyRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupPolicyRequest)(x)
+func (x *QueryOffchainApprovalsByProposalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOffchainApprovalsByProposalRequest)(x)
 }
 
-func (x *QueryProposalsByGroupPolicyRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[14]
+func (x *QueryOffchainApprovalsByProposalRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6839,43 +10773,43 @@ func (x *QueryProposalsByGroupPolicyRequest) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalsByGroupPolicyRequest_messageType fastReflection_QueryProposalsByGroupPolicyRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupPolicyRequest_messageType{}
+var _fastReflection_QueryOffchainApprovalsByProposalRequest_messageType fastReflection_QueryOffchainApprovalsByProposalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOffchainApprovalsByProposalRequest_messageType{}
 
-type fastReflection_QueryProposalsByGroupPolicyRequest_messageType struct{}
+type fastReflection_QueryOffchainApprovalsByProposalRequest_messageType struct{}
 
-func (x fastReflection_QueryProposalsByGroupPolicyRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupPolicyRequest)(nil)
+func (x fastReflection_QueryOffchainApprovalsByProposalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOffchainApprovalsByProposalRequest)(nil)
 }
-func (x fastReflection_QueryProposalsByGroupPolicyRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupPolicyRequest)
+func (x fastReflection_QueryOffchainApprovalsByProposalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOffchainApprovalsByProposalRequest)
 }
-func (x fastReflection_QueryProposalsByGroupPolicyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupPolicyRequest
+func (x fastReflection_QueryOffchainApprovalsByProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOffchainApprovalsByProposalRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupPolicyRequest
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOffchainApprovalsByProposalRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalsByGroupPolicyRequest_messageType
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOffchainApprovalsByProposalRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupPolicyRequest)
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryOffchainApprovalsByProposalRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalsByGroupPolicyRequest)(x)
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryOffchainApprovalsByProposalRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6883,16 +10817,16 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryProposalsByGroupPolicyRequest_address, value) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_QueryOffchainApprovalsByProposalRequest_proposal_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryProposalsByGroupPolicyRequest_pagination, value) {
+		if !f(fd_QueryOffchainApprovalsByProposalRequest_pagination, value) {
 			return
 		}
 	}
@@ -6909,17 +10843,17 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
-		return x.Address != ""
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6929,17 +10863,17 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
-		x.Address = ""
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6949,19 +10883,19 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6975,17 +10909,17 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6999,48 +10933,48 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
-		panic(fmt.Errorf("field address of message cosmos.group.v1.QueryProposalsByGroupPolicyRequest is not mutable"))
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.QueryOffchainApprovalsByProposalRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalsByGroupPolicyRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryOffchainApprovalsByProposalRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7048,7 +10982,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7059,7 +10993,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7071,7 +11005,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) IsValid() bool {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7081,9 +11015,9 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOffchainApprovalsByProposalRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalsByGroupPolicyRequest)
+		x := input.Message.Interface().(*QueryOffchainApprovalsByProposalRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7095,9 +11029,8 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -7113,7 +11046,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupPolicyRequest)
+		x := input.Message.Interface().(*QueryOffchainApprovalsByProposalRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7146,12 +11079,10 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *prot
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7164,7 +11095,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupPolicyRequest)
+		x := input.Message.Interface().(*QueryOffchainApprovalsByProposalRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7196,17 +11127,17 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOffchainApprovalsByProposalRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOffchainApprovalsByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var stringLen uint64
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7216,24 +11147,11 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *prot
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7305,80 +11223,80 @@ func (x *fastReflection_QueryProposalsByGroupPolicyRequest) ProtoMethods() *prot
 	}
 }
 
-var _ protoreflect.List = (*_QueryProposalsByGroupPolicyResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryOffchainApprovalsByProposalResponse_1_list)(nil)
 
-type _QueryProposalsByGroupPolicyResponse_1_list struct {
-	list *[]*Proposal
+type _QueryOffchainApprovalsByProposalResponse_1_list struct {
+	list *[]*OffchainApproval
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) Len() int {
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	concreteValue := valueUnwrapped.Interface().(*OffchainApproval)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Proposal)
+	concreteValue := valueUnwrapped.Interface().(*OffchainApproval)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Proposal)
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OffchainApproval)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) Truncate(n int) {
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Proposal)
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OffchainApproval)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryProposalsByGroupPolicyResponse_1_list) IsValid() bool {
+func (x *_QueryOffchainApprovalsByProposalResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryProposalsByGroupPolicyResponse            protoreflect.MessageDescriptor
-	fd_QueryProposalsByGroupPolicyResponse_proposals  protoreflect.FieldDescriptor
-	fd_QueryProposalsByGroupPolicyResponse_pagination protoreflect.FieldDescriptor
+	md_QueryOffchainApprovalsByProposalResponse            protoreflect.MessageDescriptor
+	fd_QueryOffchainApprovalsByProposalResponse_approvals  protoreflect.FieldDescriptor
+	fd_QueryOffchainApprovalsByProposalResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryProposalsByGroupPolicyResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryProposalsByGroupPolicyResponse")
-	fd_QueryProposalsByGroupPolicyResponse_proposals = md_QueryProposalsByGroupPolicyResponse.Fields().ByName("proposals")
-	fd_QueryProposalsByGroupPolicyResponse_pagination = md_QueryProposalsByGroupPolicyResponse.Fields().ByName("pagination")
+	md_QueryOffchainApprovalsByProposalResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryOffchainApprovalsByProposalResponse")
+	fd_QueryOffchainApprovalsByProposalResponse_approvals = md_QueryOffchainApprovalsByProposalResponse.Fields().ByName("approvals")
+	fd_QueryOffchainApprovalsByProposalResponse_pagination = md_QueryOffchainApprovalsByProposalResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryProposalsByGroupPolicyResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryOffchainApprovalsByProposalResponse)(nil)
 
-type fastReflection_QueryProposalsByGroupPolicyResponse QueryProposalsByGroupPolicyResponse
+type fastReflection_QueryOffchainApprovalsByProposalResponse QueryOffchainApprovalsByProposalResponse
 
-func (x *QueryProposalsByGroupPolicyResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupPolicyResponse)(x)
+func (x *QueryOffchainApprovalsByProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryOffchainApprovalsByProposalResponse)(x)
 }
 
-func (x *QueryProposalsByGroupPolicyResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[15]
+func (x *QueryOffchainApprovalsByProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7389,43 +11307,43 @@ func (x *QueryProposalsByGroupPolicyResponse) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryProposalsByGroupPolicyResponse_messageType fastReflection_QueryProposalsByGroupPolicyResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryProposalsByGroupPolicyResponse_messageType{}
+var _fastReflection_QueryOffchainApprovalsByProposalResponse_messageType fastReflection_QueryOffchainApprovalsByProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryOffchainApprovalsByProposalResponse_messageType{}
 
-type fastReflection_QueryProposalsByGroupPolicyResponse_messageType struct{}
+type fastReflection_QueryOffchainApprovalsByProposalResponse_messageType struct{}
 
-func (x fastReflection_QueryProposalsByGroupPolicyResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryProposalsByGroupPolicyResponse)(nil)
+func (x fastReflection_QueryOffchainApprovalsByProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryOffchainApprovalsByProposalResponse)(nil)
 }
-func (x fastReflection_QueryProposalsByGroupPolicyResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupPolicyResponse)
+func (x fastReflection_QueryOffchainApprovalsByProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryOffchainApprovalsByProposalResponse)
 }
-func (x fastReflection_QueryProposalsByGroupPolicyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupPolicyResponse
+func (x fastReflection_QueryOffchainApprovalsByProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOffchainApprovalsByProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryProposalsByGroupPolicyResponse
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryOffchainApprovalsByProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryProposalsByGroupPolicyResponse_messageType
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryOffchainApprovalsByProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryProposalsByGroupPolicyResponse)
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryOffchainApprovalsByProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryProposalsByGroupPolicyResponse)(x)
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryOffchainApprovalsByProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7433,16 +11351,16 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Proposals) != 0 {
-		value := protoreflect.ValueOfList(&_QueryProposalsByGroupPolicyResponse_1_list{list: &x.Proposals})
-		if !f(fd_QueryProposalsByGroupPolicyResponse_proposals, value) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Approvals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryOffchainApprovalsByProposalResponse_1_list{list: &x.Approvals})
+		if !f(fd_QueryOffchainApprovalsByProposalResponse_approvals, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryProposalsByGroupPolicyResponse_pagination, value) {
+		if !f(fd_QueryOffchainApprovalsByProposalResponse_pagination, value) {
 			return
 		}
 	}
@@ -7459,17 +11377,17 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
-		return len(x.Proposals) != 0
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.approvals":
+		return len(x.Approvals) != 0
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7479,17 +11397,17 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
-		x.Proposals = nil
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.approvals":
+		x.Approvals = nil
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7499,22 +11417,22 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
-		if len(x.Proposals) == 0 {
-			return protoreflect.ValueOfList(&_QueryProposalsByGroupPolicyResponse_1_list{})
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.approvals":
+		if len(x.Approvals) == 0 {
+			return protoreflect.ValueOfList(&_QueryOffchainApprovalsByProposalResponse_1_list{})
 		}
-		listValue := &_QueryProposalsByGroupPolicyResponse_1_list{list: &x.Proposals}
+		listValue := &_QueryOffchainApprovalsByProposalResponse_1_list{list: &x.Approvals}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7528,19 +11446,19 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.approvals":
 		lv := value.List()
-		clv := lv.(*_QueryProposalsByGroupPolicyResponse_1_list)
-		x.Proposals = *clv.list
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+		clv := lv.(*_QueryOffchainApprovalsByProposalResponse_1_list)
+		x.Approvals = *clv.list
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7554,53 +11472,53 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
-		if x.Proposals == nil {
-			x.Proposals = []*Proposal{}
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.approvals":
+		if x.Approvals == nil {
+			x.Approvals = []*OffchainApproval{}
 		}
-		value := &_QueryProposalsByGroupPolicyResponse_1_list{list: &x.Proposals}
+		value := &_QueryOffchainApprovalsByProposalResponse_1_list{list: &x.Approvals}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals":
-		list := []*Proposal{}
-		return protoreflect.ValueOfList(&_QueryProposalsByGroupPolicyResponse_1_list{list: &list})
-	case "cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination":
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.approvals":
+		list := []*OffchainApproval{}
+		return protoreflect.ValueOfList(&_QueryOffchainApprovalsByProposalResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryProposalsByGroupPolicyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryProposalsByGroupPolicyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryOffchainApprovalsByProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryProposalsByGroupPolicyResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryOffchainApprovalsByProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7608,7 +11526,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7619,7 +11537,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7631,7 +11549,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) IsValid() bool {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7641,9 +11559,9 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryOffchainApprovalsByProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryProposalsByGroupPolicyResponse)
+		x := input.Message.Interface().(*QueryOffchainApprovalsByProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7655,8 +11573,8 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		if len(x.Proposals) > 0 {
-			for _, e := range x.Proposals {
+		if len(x.Approvals) > 0 {
+			for _, e := range x.Approvals {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -7675,7 +11593,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupPolicyResponse)
+		x := input.Message.Interface().(*QueryOffchainApprovalsByProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7708,9 +11626,9 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *pro
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Proposals) > 0 {
-			for iNdEx := len(x.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Proposals[iNdEx])
+		if len(x.Approvals) > 0 {
+			for iNdEx := len(x.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Approvals[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7735,7 +11653,7 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryProposalsByGroupPolicyResponse)
+		x := input.Message.Interface().(*QueryOffchainApprovalsByProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7767,15 +11685,15 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOffchainApprovalsByProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryOffchainApprovalsByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7802,8 +11720,8 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *pro
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Proposals = append(x.Proposals, &Proposal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proposals[len(x.Proposals)-1]); err != nil {
+				x.Approvals = append(x.Approvals, &OffchainApproval{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Approvals[len(x.Approvals)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7879,28 +11797,28 @@ func (x *fastReflection_QueryProposalsByGroupPolicyResponse) ProtoMethods() *pro
 }
 
 var (
-	md_QueryVoteByProposalVoterRequest             protoreflect.MessageDescriptor
-	fd_QueryVoteByProposalVoterRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryVoteByProposalVoterRequest_voter       protoreflect.FieldDescriptor
+	md_QueryVotesByVoterRequest            protoreflect.MessageDescriptor
+	fd_QueryVotesByVoterRequest_voter      protoreflect.FieldDescriptor
+	fd_QueryVotesByVoterRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryVoteByProposalVoterRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVoteByProposalVoterRequest")
-	fd_QueryVoteByProposalVoterRequest_proposal_id = md_QueryVoteByProposalVoterRequest.Fields().ByName("proposal_id")
-	fd_QueryVoteByProposalVoterRequest_voter = md_QueryVoteByProposalVoterRequest.Fields().ByName("voter")
+	md_QueryVotesByVoterRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByVoterRequest")
+	fd_QueryVotesByVoterRequest_voter = md_QueryVotesByVoterRequest.Fields().ByName("voter")
+	fd_QueryVotesByVoterRequest_pagination = md_QueryVotesByVoterRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterRequest)(nil)
 
-type fastReflection_QueryVoteByProposalVoterRequest QueryVoteByProposalVoterRequest
+type fastReflection_QueryVotesByVoterRequest QueryVotesByVoterRequest
 
-func (x *QueryVoteByProposalVoterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterRequest)(x)
+func (x *QueryVotesByVoterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterRequest)(x)
 }
 
-func (x *QueryVoteByProposalVoterRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[16]
+func (x *QueryVotesByVoterRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7911,43 +11829,43 @@ func (x *QueryVoteByProposalVoterRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteByProposalVoterRequest_messageType fastReflection_QueryVoteByProposalVoterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterRequest_messageType{}
+var _fastReflection_QueryVotesByVoterRequest_messageType fastReflection_QueryVotesByVoterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterRequest_messageType{}
 
-type fastReflection_QueryVoteByProposalVoterRequest_messageType struct{}
+type fastReflection_QueryVotesByVoterRequest_messageType struct{}
 
-func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterRequest)(nil)
+func (x fastReflection_QueryVotesByVoterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterRequest)(nil)
 }
-func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterRequest)
+func (x fastReflection_QueryVotesByVoterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterRequest)
 }
-func (x fastReflection_QueryVoteByProposalVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterRequest
+func (x fastReflection_QueryVotesByVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterRequest
+func (x *fastReflection_QueryVotesByVoterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteByProposalVoterRequest_messageType
+func (x *fastReflection_QueryVotesByVoterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByVoterRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterRequest)
+func (x *fastReflection_QueryVotesByVoterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteByProposalVoterRequest)(x)
+func (x *fastReflection_QueryVotesByVoterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByVoterRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7955,16 +11873,16 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryVoteByProposalVoterRequest_proposal_id, value) {
+func (x *fastReflection_QueryVotesByVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_QueryVotesByVoterRequest_voter, value) {
 			return
 		}
 	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_QueryVoteByProposalVoterRequest_voter, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotesByVoterRequest_pagination, value) {
 			return
 		}
 	}
@@ -7981,17 +11899,17 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotesByVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
 		return x.Voter != ""
+	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8001,17 +11919,17 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotesByVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
-		x.ProposalId = uint64(0)
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
 		x.Voter = ""
+	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8021,19 +11939,19 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
 		value := x.Voter
 		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8047,17 +11965,17 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotesByVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
 		x.Voter = value.Interface().(string)
+	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8071,44 +11989,48 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.QueryVoteByProposalVoterRequest is not mutable"))
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
-		panic(fmt.Errorf("field voter of message cosmos.group.v1.QueryVoteByProposalVoterRequest is not mutable"))
+	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
+		panic(fmt.Errorf("field voter of message cosmos.group.v1.QueryVotesByVoterRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.QueryVoteByProposalVoterRequest.voter":
+	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
 		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotesByVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVoteByProposalVoterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByVoterRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8116,7 +12038,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotesByVoterRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8127,7 +12049,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotesByVoterRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8139,7 +12061,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) IsValid() bool {
+func (x *fastReflection_QueryVotesByVoterRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8149,9 +12071,9 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		x := input.Message.Interface().(*QueryVotesByVoterRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8163,13 +12085,14 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
-		}
 		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8180,7 +12103,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		x := input.Message.Interface().(*QueryVotesByVoterRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8199,17 +12122,26 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Voter) > 0 {
 			i -= len(x.Voter)
 			copy(dAtA[i:], x.Voter)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8222,7 +12154,7 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterRequest)
+		x := input.Message.Interface().(*QueryVotesByVoterRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8254,17 +12186,17 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
-				x.ProposalId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8274,16 +12206,29 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8293,23 +12238,27 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8346,27 +12295,80 @@ func (x *fastReflection_QueryVoteByProposalVoterRequest) ProtoMethods() *protoif
 	}
 }
 
+var _ protoreflect.List = (*_QueryVotesByVoterResponse_1_list)(nil)
+
+type _QueryVotesByVoterResponse_1_list struct {
+	list *[]*Vote
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Vote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Vote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Vote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVotesByVoterResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryVoteByProposalVoterResponse      protoreflect.MessageDescriptor
-	fd_QueryVoteByProposalVoterResponse_vote protoreflect.FieldDescriptor
+	md_QueryVotesByVoterResponse            protoreflect.MessageDescriptor
+	fd_QueryVotesByVoterResponse_votes      protoreflect.FieldDescriptor
+	fd_QueryVotesByVoterResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryVoteByProposalVoterResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVoteByProposalVoterResponse")
-	fd_QueryVoteByProposalVoterResponse_vote = md_QueryVoteByProposalVoterResponse.Fields().ByName("vote")
+	md_QueryVotesByVoterResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByVoterResponse")
+	fd_QueryVotesByVoterResponse_votes = md_QueryVotesByVoterResponse.Fields().ByName("votes")
+	fd_QueryVotesByVoterResponse_pagination = md_QueryVotesByVoterResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterResponse)(nil)
 
-type fastReflection_QueryVoteByProposalVoterResponse QueryVoteByProposalVoterResponse
+type fastReflection_QueryVotesByVoterResponse QueryVotesByVoterResponse
 
-func (x *QueryVoteByProposalVoterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterResponse)(x)
+func (x *QueryVotesByVoterResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterResponse)(x)
 }
 
-func (x *QueryVoteByProposalVoterResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[17]
+func (x *QueryVotesByVoterResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8377,43 +12379,43 @@ func (x *QueryVoteByProposalVoterResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVoteByProposalVoterResponse_messageType fastReflection_QueryVoteByProposalVoterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVoteByProposalVoterResponse_messageType{}
+var _fastReflection_QueryVotesByVoterResponse_messageType fastReflection_QueryVotesByVoterResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterResponse_messageType{}
 
-type fastReflection_QueryVoteByProposalVoterResponse_messageType struct{}
+type fastReflection_QueryVotesByVoterResponse_messageType struct{}
 
-func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVoteByProposalVoterResponse)(nil)
+func (x fastReflection_QueryVotesByVoterResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVotesByVoterResponse)(nil)
 }
-func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterResponse)
+func (x fastReflection_QueryVotesByVoterResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterResponse)
 }
-func (x fastReflection_QueryVoteByProposalVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterResponse
+func (x fastReflection_QueryVotesByVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVoteByProposalVoterResponse
+func (x *fastReflection_QueryVotesByVoterResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVotesByVoterResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVoteByProposalVoterResponse_messageType
+func (x *fastReflection_QueryVotesByVoterResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVotesByVoterResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVoteByProposalVoterResponse)
+func (x *fastReflection_QueryVotesByVoterResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVotesByVoterResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVoteByProposalVoterResponse)(x)
+func (x *fastReflection_QueryVotesByVoterResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVotesByVoterResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8421,10 +12423,16 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Vote != nil {
-		value := protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
-		if !f(fd_QueryVoteByProposalVoterResponse_vote, value) {
+func (x *fastReflection_QueryVotesByVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Votes) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &x.Votes})
+		if !f(fd_QueryVotesByVoterResponse_votes, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVotesByVoterResponse_pagination, value) {
 			return
 		}
 	}
@@ -8441,15 +12449,17 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryVotesByVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
-		return x.Vote != nil
+	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
+		return len(x.Votes) != 0
+	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8459,15 +12469,17 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryVotesByVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
-		x.Vote = nil
+	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
+		x.Votes = nil
+	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8477,16 +12489,22 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
-		value := x.Vote
+	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
+		if len(x.Votes) == 0 {
+			return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{})
+		}
+		listValue := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8500,15 +12518,19 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryVotesByVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
-		x.Vote = value.Message().Interface().(*Vote)
+	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
+		lv := value.List()
+		clv := lv.(*_QueryVotesByVoterResponse_1_list)
+		x.Votes = *clv.list
+	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8522,44 +12544,53 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
-		if x.Vote == nil {
-			x.Vote = new(Vote)
+	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
+		if x.Votes == nil {
+			x.Votes = []*Vote{}
 		}
-		return protoreflect.ValueOfMessage(x.Vote.ProtoReflect())
+		value := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryVotesByVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVoteByProposalVoterResponse.vote":
-		m := new(Vote)
+	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
+		list := []*Vote{}
+		return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVoteByProposalVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVoteByProposalVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryVotesByVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVoteByProposalVoterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByVoterResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8567,7 +12598,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryVotesByVoterResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8578,7 +12609,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryVotesByVoterResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8590,7 +12621,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) IsValid() bool {
+func (x *fastReflection_QueryVotesByVoterResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8600,9 +12631,9 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		x := input.Message.Interface().(*QueryVotesByVoterResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8614,8 +12645,14 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		if x.Vote != nil {
-			l = options.Size(x.Vote)
+		if len(x.Votes) > 0 {
+			for _, e := range x.Votes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8628,7 +12665,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		x := input.Message.Interface().(*QueryVotesByVoterResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8647,8 +12684,8 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Vote != nil {
-			encoded, err := options.Marshal(x.Vote)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8659,7 +12696,23 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Votes) > 0 {
+			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Votes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8672,7 +12725,7 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVoteByProposalVoterResponse)
+		x := input.Message.Interface().(*QueryVotesByVoterResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8704,15 +12757,15 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8739,10 +12792,44 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Vote == nil {
-					x.Vote = &Vote{}
+				x.Votes = append(x.Votes, &Vote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vote); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8782,28 +12869,28 @@ func (x *fastReflection_QueryVoteByProposalVoterResponse) ProtoMethods() *protoi
 }
 
 var (
-	md_QueryVotesByProposalRequest             protoreflect.MessageDescriptor
-	fd_QueryVotesByProposalRequest_proposal_id protoreflect.FieldDescriptor
-	fd_QueryVotesByProposalRequest_pagination  protoreflect.FieldDescriptor
+	md_QuerySubGroupsRequest            protoreflect.MessageDescriptor
+	fd_QuerySubGroupsRequest_group_id   protoreflect.FieldDescriptor
+	fd_QuerySubGroupsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryVotesByProposalRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByProposalRequest")
-	fd_QueryVotesByProposalRequest_proposal_id = md_QueryVotesByProposalRequest.Fields().ByName("proposal_id")
-	fd_QueryVotesByProposalRequest_pagination = md_QueryVotesByProposalRequest.Fields().ByName("pagination")
+	md_QuerySubGroupsRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QuerySubGroupsRequest")
+	fd_QuerySubGroupsRequest_group_id = md_QuerySubGroupsRequest.Fields().ByName("group_id")
+	fd_QuerySubGroupsRequest_pagination = md_QuerySubGroupsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySubGroupsRequest)(nil)
 
-type fastReflection_QueryVotesByProposalRequest QueryVotesByProposalRequest
+type fastReflection_QuerySubGroupsRequest QuerySubGroupsRequest
 
-func (x *QueryVotesByProposalRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalRequest)(x)
+func (x *QuerySubGroupsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubGroupsRequest)(x)
 }
 
-func (x *QueryVotesByProposalRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[18]
+func (x *QuerySubGroupsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8814,43 +12901,43 @@ func (x *QueryVotesByProposalRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByProposalRequest_messageType fastReflection_QueryVotesByProposalRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalRequest_messageType{}
+var _fastReflection_QuerySubGroupsRequest_messageType fastReflection_QuerySubGroupsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubGroupsRequest_messageType{}
 
-type fastReflection_QueryVotesByProposalRequest_messageType struct{}
+type fastReflection_QuerySubGroupsRequest_messageType struct{}
 
-func (x fastReflection_QueryVotesByProposalRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalRequest)(nil)
+func (x fastReflection_QuerySubGroupsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubGroupsRequest)(nil)
 }
-func (x fastReflection_QueryVotesByProposalRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalRequest)
+func (x fastReflection_QuerySubGroupsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubGroupsRequest)
 }
-func (x fastReflection_QueryVotesByProposalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalRequest
+func (x fastReflection_QuerySubGroupsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubGroupsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByProposalRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalRequest
+func (x *fastReflection_QuerySubGroupsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubGroupsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByProposalRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByProposalRequest_messageType
+func (x *fastReflection_QuerySubGroupsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubGroupsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByProposalRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalRequest)
+func (x *fastReflection_QuerySubGroupsRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySubGroupsRequest)
 }
 
 // Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByProposalRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByProposalRequest)(x)
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySubGroupsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubGroupsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8858,16 +12945,16 @@ func (x *fastReflection_QueryVotesByProposalRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByProposalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_QueryVotesByProposalRequest_proposal_id, value) {
+func (x *fastReflection_QuerySubGroupsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_QuerySubGroupsRequest_group_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByProposalRequest_pagination, value) {
+		if !f(fd_QuerySubGroupsRequest_pagination, value) {
 			return
 		}
 	}
@@ -8884,17 +12971,17 @@ func (x *fastReflection_QueryVotesByProposalRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByProposalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySubGroupsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+	case "cosmos.group.v1.QuerySubGroupsRequest.group_id":
+		return x.GroupId != uint64(0)
+	case "cosmos.group.v1.QuerySubGroupsRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8904,17 +12991,17 @@ func (x *fastReflection_QueryVotesByProposalRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySubGroupsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
-		x.ProposalId = uint64(0)
-	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+	case "cosmos.group.v1.QuerySubGroupsRequest.group_id":
+		x.GroupId = uint64(0)
+	case "cosmos.group.v1.QuerySubGroupsRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8924,19 +13011,19 @@ func (x *fastReflection_QueryVotesByProposalRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByProposalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubGroupsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
-		value := x.ProposalId
+	case "cosmos.group.v1.QuerySubGroupsRequest.group_id":
+		value := x.GroupId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+	case "cosmos.group.v1.QuerySubGroupsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8950,17 +13037,17 @@ func (x *fastReflection_QueryVotesByProposalRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySubGroupsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+	case "cosmos.group.v1.QuerySubGroupsRequest.group_id":
+		x.GroupId = value.Uint()
+	case "cosmos.group.v1.QuerySubGroupsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8974,48 +13061,48 @@ func (x *fastReflection_QueryVotesByProposalRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubGroupsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+	case "cosmos.group.v1.QuerySubGroupsRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.QueryVotesByProposalRequest is not mutable"))
+	case "cosmos.group.v1.QuerySubGroupsRequest.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.QuerySubGroupsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByProposalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubGroupsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalRequest.proposal_id":
+	case "cosmos.group.v1.QuerySubGroupsRequest.group_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.QueryVotesByProposalRequest.pagination":
+	case "cosmos.group.v1.QuerySubGroupsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByProposalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySubGroupsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByProposalRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QuerySubGroupsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9023,7 +13110,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByProposalRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySubGroupsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9034,7 +13121,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySubGroupsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9046,7 +13133,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByProposalRequest) IsValid() bool {
+func (x *fastReflection_QuerySubGroupsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9056,9 +13143,9 @@ func (x *fastReflection_QueryVotesByProposalRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySubGroupsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		x := input.Message.Interface().(*QuerySubGroupsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9070,8 +13157,8 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -9087,7 +13174,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		x := input.Message.Interface().(*QuerySubGroupsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9120,8 +13207,8 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -9136,7 +13223,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalRequest)
+		x := input.Message.Interface().(*QuerySubGroupsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9168,17 +13255,17 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubGroupsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 				}
-				x.ProposalId = 0
+				x.GroupId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9188,7 +13275,7 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					x.GroupId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -9264,80 +13351,80 @@ func (x *fastReflection_QueryVotesByProposalRequest) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryVotesByProposalResponse_1_list)(nil)
+var _ protoreflect.List = (*_QuerySubGroupsResponse_1_list)(nil)
 
-type _QueryVotesByProposalResponse_1_list struct {
-	list *[]*Vote
+type _QuerySubGroupsResponse_1_list struct {
+	list *[]*GroupLink
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) Len() int {
+func (x *_QuerySubGroupsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QuerySubGroupsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QuerySubGroupsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
+	concreteValue := valueUnwrapped.Interface().(*GroupLink)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QuerySubGroupsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
+	concreteValue := valueUnwrapped.Interface().(*GroupLink)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Vote)
+func (x *_QuerySubGroupsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(GroupLink)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) Truncate(n int) {
+func (x *_QuerySubGroupsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Vote)
+func (x *_QuerySubGroupsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(GroupLink)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryVotesByProposalResponse_1_list) IsValid() bool {
+func (x *_QuerySubGroupsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryVotesByProposalResponse            protoreflect.MessageDescriptor
-	fd_QueryVotesByProposalResponse_votes      protoreflect.FieldDescriptor
-	fd_QueryVotesByProposalResponse_pagination protoreflect.FieldDescriptor
+	md_QuerySubGroupsResponse            protoreflect.MessageDescriptor
+	fd_QuerySubGroupsResponse_links      protoreflect.FieldDescriptor
+	fd_QuerySubGroupsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryVotesByProposalResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByProposalResponse")
-	fd_QueryVotesByProposalResponse_votes = md_QueryVotesByProposalResponse.Fields().ByName("votes")
-	fd_QueryVotesByProposalResponse_pagination = md_QueryVotesByProposalResponse.Fields().ByName("pagination")
+	md_QuerySubGroupsResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QuerySubGroupsResponse")
+	fd_QuerySubGroupsResponse_links = md_QuerySubGroupsResponse.Fields().ByName("links")
+	fd_QuerySubGroupsResponse_pagination = md_QuerySubGroupsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QuerySubGroupsResponse)(nil)
 
-type fastReflection_QueryVotesByProposalResponse QueryVotesByProposalResponse
+type fastReflection_QuerySubGroupsResponse QuerySubGroupsResponse
 
-func (x *QueryVotesByProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalResponse)(x)
+func (x *QuerySubGroupsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySubGroupsResponse)(x)
 }
 
-func (x *QueryVotesByProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[19]
+func (x *QuerySubGroupsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9348,43 +13435,43 @@ func (x *QueryVotesByProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByProposalResponse_messageType fastReflection_QueryVotesByProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByProposalResponse_messageType{}
+var _fastReflection_QuerySubGroupsResponse_messageType fastReflection_QuerySubGroupsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySubGroupsResponse_messageType{}
 
-type fastReflection_QueryVotesByProposalResponse_messageType struct{}
+type fastReflection_QuerySubGroupsResponse_messageType struct{}
 
-func (x fastReflection_QueryVotesByProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByProposalResponse)(nil)
+func (x fastReflection_QuerySubGroupsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySubGroupsResponse)(nil)
 }
-func (x fastReflection_QueryVotesByProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalResponse)
+func (x fastReflection_QuerySubGroupsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySubGroupsResponse)
 }
-func (x fastReflection_QueryVotesByProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalResponse
+func (x fastReflection_QuerySubGroupsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubGroupsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByProposalResponse
+func (x *fastReflection_QuerySubGroupsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySubGroupsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByProposalResponse_messageType
+func (x *fastReflection_QuerySubGroupsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySubGroupsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByProposalResponse)
+func (x *fastReflection_QuerySubGroupsResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySubGroupsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByProposalResponse)(x)
+func (x *fastReflection_QuerySubGroupsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySubGroupsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9392,16 +13479,16 @@ func (x *fastReflection_QueryVotesByProposalResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Votes) != 0 {
-		value := protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &x.Votes})
-		if !f(fd_QueryVotesByProposalResponse_votes, value) {
+func (x *fastReflection_QuerySubGroupsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Links) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySubGroupsResponse_1_list{list: &x.Links})
+		if !f(fd_QuerySubGroupsResponse_links, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByProposalResponse_pagination, value) {
+		if !f(fd_QuerySubGroupsResponse_pagination, value) {
 			return
 		}
 	}
@@ -9418,17 +13505,17 @@ func (x *fastReflection_QueryVotesByProposalResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QuerySubGroupsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
-		return len(x.Votes) != 0
-	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+	case "cosmos.group.v1.QuerySubGroupsResponse.links":
+		return len(x.Links) != 0
+	case "cosmos.group.v1.QuerySubGroupsResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9438,17 +13525,17 @@ func (x *fastReflection_QueryVotesByProposalResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QuerySubGroupsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
-		x.Votes = nil
-	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+	case "cosmos.group.v1.QuerySubGroupsResponse.links":
+		x.Links = nil
+	case "cosmos.group.v1.QuerySubGroupsResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9458,22 +13545,22 @@ func (x *fastReflection_QueryVotesByProposalResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubGroupsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
-		if len(x.Votes) == 0 {
-			return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{})
+	case "cosmos.group.v1.QuerySubGroupsResponse.links":
+		if len(x.Links) == 0 {
+			return protoreflect.ValueOfList(&_QuerySubGroupsResponse_1_list{})
 		}
-		listValue := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
+		listValue := &_QuerySubGroupsResponse_1_list{list: &x.Links}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+	case "cosmos.group.v1.QuerySubGroupsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9487,19 +13574,19 @@ func (x *fastReflection_QueryVotesByProposalResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QuerySubGroupsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
+	case "cosmos.group.v1.QuerySubGroupsResponse.links":
 		lv := value.List()
-		clv := lv.(*_QueryVotesByProposalResponse_1_list)
-		x.Votes = *clv.list
-	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+		clv := lv.(*_QuerySubGroupsResponse_1_list)
+		x.Links = *clv.list
+	case "cosmos.group.v1.QuerySubGroupsResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9513,53 +13600,53 @@ func (x *fastReflection_QueryVotesByProposalResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubGroupsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
-		if x.Votes == nil {
-			x.Votes = []*Vote{}
+	case "cosmos.group.v1.QuerySubGroupsResponse.links":
+		if x.Links == nil {
+			x.Links = []*GroupLink{}
 		}
-		value := &_QueryVotesByProposalResponse_1_list{list: &x.Votes}
+		value := &_QuerySubGroupsResponse_1_list{list: &x.Links}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+	case "cosmos.group.v1.QuerySubGroupsResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QuerySubGroupsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByProposalResponse.votes":
-		list := []*Vote{}
-		return protoreflect.ValueOfList(&_QueryVotesByProposalResponse_1_list{list: &list})
-	case "cosmos.group.v1.QueryVotesByProposalResponse.pagination":
+	case "cosmos.group.v1.QuerySubGroupsResponse.links":
+		list := []*GroupLink{}
+		return protoreflect.ValueOfList(&_QuerySubGroupsResponse_1_list{list: &list})
+	case "cosmos.group.v1.QuerySubGroupsResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QuerySubGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QuerySubGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QuerySubGroupsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QuerySubGroupsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9567,7 +13654,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QuerySubGroupsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9578,7 +13665,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QuerySubGroupsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9590,7 +13677,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByProposalResponse) IsValid() bool {
+func (x *fastReflection_QuerySubGroupsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9600,9 +13687,9 @@ func (x *fastReflection_QueryVotesByProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QuerySubGroupsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByProposalResponse)
+		x := input.Message.Interface().(*QuerySubGroupsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9614,8 +13701,8 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if len(x.Votes) > 0 {
-			for _, e := range x.Votes {
+		if len(x.Links) > 0 {
+			for _, e := range x.Links {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -9634,7 +13721,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalResponse)
+		x := input.Message.Interface().(*QuerySubGroupsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9667,9 +13754,9 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Votes) > 0 {
-			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Votes[iNdEx])
+		if len(x.Links) > 0 {
+			for iNdEx := len(x.Links) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Links[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9694,7 +13781,7 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByProposalResponse)
+		x := input.Message.Interface().(*QuerySubGroupsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9726,15 +13813,15 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubGroupsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySubGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9761,8 +13848,8 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Votes = append(x.Votes, &Vote{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+				x.Links = append(x.Links, &GroupLink{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Links[len(x.Links)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9838,28 +13925,28 @@ func (x *fastReflection_QueryVotesByProposalResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryVotesByVoterRequest            protoreflect.MessageDescriptor
-	fd_QueryVotesByVoterRequest_voter      protoreflect.FieldDescriptor
-	fd_QueryVotesByVoterRequest_pagination protoreflect.FieldDescriptor
+	md_QueryParentGroupsRequest            protoreflect.MessageDescriptor
+	fd_QueryParentGroupsRequest_group_id   protoreflect.FieldDescriptor
+	fd_QueryParentGroupsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryVotesByVoterRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByVoterRequest")
-	fd_QueryVotesByVoterRequest_voter = md_QueryVotesByVoterRequest.Fields().ByName("voter")
-	fd_QueryVotesByVoterRequest_pagination = md_QueryVotesByVoterRequest.Fields().ByName("pagination")
+	md_QueryParentGroupsRequest = File_cosmos_group_v1_query_proto.Messages().ByName("QueryParentGroupsRequest")
+	fd_QueryParentGroupsRequest_group_id = md_QueryParentGroupsRequest.Fields().ByName("group_id")
+	fd_QueryParentGroupsRequest_pagination = md_QueryParentGroupsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParentGroupsRequest)(nil)
 
-type fastReflection_QueryVotesByVoterRequest QueryVotesByVoterRequest
+type fastReflection_QueryParentGroupsRequest QueryParentGroupsRequest
 
-func (x *QueryVotesByVoterRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterRequest)(x)
+func (x *QueryParentGroupsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParentGroupsRequest)(x)
 }
 
-func (x *QueryVotesByVoterRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[20]
+func (x *QueryParentGroupsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9870,43 +13957,43 @@ func (x *QueryVotesByVoterRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByVoterRequest_messageType fastReflection_QueryVotesByVoterRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterRequest_messageType{}
+var _fastReflection_QueryParentGroupsRequest_messageType fastReflection_QueryParentGroupsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParentGroupsRequest_messageType{}
 
-type fastReflection_QueryVotesByVoterRequest_messageType struct{}
+type fastReflection_QueryParentGroupsRequest_messageType struct{}
 
-func (x fastReflection_QueryVotesByVoterRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterRequest)(nil)
+func (x fastReflection_QueryParentGroupsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParentGroupsRequest)(nil)
 }
-func (x fastReflection_QueryVotesByVoterRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterRequest)
+func (x fastReflection_QueryParentGroupsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParentGroupsRequest)
 }
-func (x fastReflection_QueryVotesByVoterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterRequest
+func (x fastReflection_QueryParentGroupsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParentGroupsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByVoterRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterRequest
+func (x *fastReflection_QueryParentGroupsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParentGroupsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByVoterRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByVoterRequest_messageType
+func (x *fastReflection_QueryParentGroupsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParentGroupsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByVoterRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterRequest)
+func (x *fastReflection_QueryParentGroupsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParentGroupsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByVoterRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByVoterRequest)(x)
+func (x *fastReflection_QueryParentGroupsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParentGroupsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9914,16 +14001,16 @@ func (x *fastReflection_QueryVotesByVoterRequest) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByVoterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_QueryVotesByVoterRequest_voter, value) {
+func (x *fastReflection_QueryParentGroupsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_QueryParentGroupsRequest_group_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByVoterRequest_pagination, value) {
+		if !f(fd_QueryParentGroupsRequest_pagination, value) {
 			return
 		}
 	}
@@ -9940,17 +14027,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByVoterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParentGroupsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
-		return x.Voter != ""
-	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+	case "cosmos.group.v1.QueryParentGroupsRequest.group_id":
+		return x.GroupId != uint64(0)
+	case "cosmos.group.v1.QueryParentGroupsRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9960,17 +14047,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParentGroupsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
-		x.Voter = ""
-	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+	case "cosmos.group.v1.QueryParentGroupsRequest.group_id":
+		x.GroupId = uint64(0)
+	case "cosmos.group.v1.QueryParentGroupsRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9980,19 +14067,19 @@ func (x *fastReflection_QueryVotesByVoterRequest) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByVoterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParentGroupsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
-		value := x.Voter
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+	case "cosmos.group.v1.QueryParentGroupsRequest.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.QueryParentGroupsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10006,17 +14093,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParentGroupsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
-		x.Voter = value.Interface().(string)
-	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+	case "cosmos.group.v1.QueryParentGroupsRequest.group_id":
+		x.GroupId = value.Uint()
+	case "cosmos.group.v1.QueryParentGroupsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10030,48 +14117,48 @@ func (x *fastReflection_QueryVotesByVoterRequest) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParentGroupsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+	case "cosmos.group.v1.QueryParentGroupsRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
-		panic(fmt.Errorf("field voter of message cosmos.group.v1.QueryVotesByVoterRequest is not mutable"))
+	case "cosmos.group.v1.QueryParentGroupsRequest.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.QueryParentGroupsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByVoterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParentGroupsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterRequest.voter":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.QueryVotesByVoterRequest.pagination":
+	case "cosmos.group.v1.QueryParentGroupsRequest.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.QueryParentGroupsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByVoterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParentGroupsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByVoterRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryParentGroupsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10079,7 +14166,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByVoterRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParentGroupsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10090,7 +14177,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParentGroupsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10102,7 +14189,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByVoterRequest) IsValid() bool {
+func (x *fastReflection_QueryParentGroupsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10112,9 +14199,9 @@ func (x *fastReflection_QueryVotesByVoterRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParentGroupsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		x := input.Message.Interface().(*QueryParentGroupsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10126,9 +14213,8 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.Voter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -10144,7 +14230,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		x := input.Message.Interface().(*QueryParentGroupsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10177,12 +14263,10 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10195,7 +14279,7 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterRequest)
+		x := input.Message.Interface().(*QueryParentGroupsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10227,17 +14311,17 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParentGroupsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParentGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 				}
-				var stringLen uint64
+				x.GroupId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10247,24 +14331,11 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.GroupId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -10336,80 +14407,80 @@ func (x *fastReflection_QueryVotesByVoterRequest) ProtoMethods() *protoiface.Met
 	}
 }
 
-var _ protoreflect.List = (*_QueryVotesByVoterResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryParentGroupsResponse_1_list)(nil)
 
-type _QueryVotesByVoterResponse_1_list struct {
-	list *[]*Vote
+type _QueryParentGroupsResponse_1_list struct {
+	list *[]*GroupLink
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Len() int {
+func (x *_QueryParentGroupsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryParentGroupsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryParentGroupsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
+	concreteValue := valueUnwrapped.Interface().(*GroupLink)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryParentGroupsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Vote)
+	concreteValue := valueUnwrapped.Interface().(*GroupLink)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Vote)
+func (x *_QueryParentGroupsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(GroupLink)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) Truncate(n int) {
+func (x *_QueryParentGroupsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Vote)
+func (x *_QueryParentGroupsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(GroupLink)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryVotesByVoterResponse_1_list) IsValid() bool {
+func (x *_QueryParentGroupsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryVotesByVoterResponse            protoreflect.MessageDescriptor
-	fd_QueryVotesByVoterResponse_votes      protoreflect.FieldDescriptor
-	fd_QueryVotesByVoterResponse_pagination protoreflect.FieldDescriptor
+	md_QueryParentGroupsResponse            protoreflect.MessageDescriptor
+	fd_QueryParentGroupsResponse_links      protoreflect.FieldDescriptor
+	fd_QueryParentGroupsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_query_proto_init()
-	md_QueryVotesByVoterResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryVotesByVoterResponse")
-	fd_QueryVotesByVoterResponse_votes = md_QueryVotesByVoterResponse.Fields().ByName("votes")
-	fd_QueryVotesByVoterResponse_pagination = md_QueryVotesByVoterResponse.Fields().ByName("pagination")
+	md_QueryParentGroupsResponse = File_cosmos_group_v1_query_proto.Messages().ByName("QueryParentGroupsResponse")
+	fd_QueryParentGroupsResponse_links = md_QueryParentGroupsResponse.Fields().ByName("links")
+	fd_QueryParentGroupsResponse_pagination = md_QueryParentGroupsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryVotesByVoterResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParentGroupsResponse)(nil)
 
-type fastReflection_QueryVotesByVoterResponse QueryVotesByVoterResponse
+type fastReflection_QueryParentGroupsResponse QueryParentGroupsResponse
 
-func (x *QueryVotesByVoterResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterResponse)(x)
+func (x *QueryParentGroupsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParentGroupsResponse)(x)
 }
 
-func (x *QueryVotesByVoterResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[21]
+func (x *QueryParentGroupsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10420,43 +14491,43 @@ func (x *QueryVotesByVoterResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryVotesByVoterResponse_messageType fastReflection_QueryVotesByVoterResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryVotesByVoterResponse_messageType{}
+var _fastReflection_QueryParentGroupsResponse_messageType fastReflection_QueryParentGroupsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParentGroupsResponse_messageType{}
 
-type fastReflection_QueryVotesByVoterResponse_messageType struct{}
+type fastReflection_QueryParentGroupsResponse_messageType struct{}
 
-func (x fastReflection_QueryVotesByVoterResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryVotesByVoterResponse)(nil)
+func (x fastReflection_QueryParentGroupsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParentGroupsResponse)(nil)
 }
-func (x fastReflection_QueryVotesByVoterResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterResponse)
+func (x fastReflection_QueryParentGroupsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParentGroupsResponse)
 }
-func (x fastReflection_QueryVotesByVoterResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterResponse
+func (x fastReflection_QueryParentGroupsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParentGroupsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryVotesByVoterResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryVotesByVoterResponse
+func (x *fastReflection_QueryParentGroupsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParentGroupsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryVotesByVoterResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryVotesByVoterResponse_messageType
+func (x *fastReflection_QueryParentGroupsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParentGroupsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryVotesByVoterResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryVotesByVoterResponse)
+func (x *fastReflection_QueryParentGroupsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParentGroupsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryVotesByVoterResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryVotesByVoterResponse)(x)
+func (x *fastReflection_QueryParentGroupsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParentGroupsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10464,16 +14535,16 @@ func (x *fastReflection_QueryVotesByVoterResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryVotesByVoterResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Votes) != 0 {
-		value := protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &x.Votes})
-		if !f(fd_QueryVotesByVoterResponse_votes, value) {
+func (x *fastReflection_QueryParentGroupsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Links) != 0 {
+		value := protoreflect.ValueOfList(&_QueryParentGroupsResponse_1_list{list: &x.Links})
+		if !f(fd_QueryParentGroupsResponse_links, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryVotesByVoterResponse_pagination, value) {
+		if !f(fd_QueryParentGroupsResponse_pagination, value) {
 			return
 		}
 	}
@@ -10490,17 +14561,17 @@ func (x *fastReflection_QueryVotesByVoterResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryVotesByVoterResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParentGroupsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
-		return len(x.Votes) != 0
-	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+	case "cosmos.group.v1.QueryParentGroupsResponse.links":
+		return len(x.Links) != 0
+	case "cosmos.group.v1.QueryParentGroupsResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10510,17 +14581,17 @@ func (x *fastReflection_QueryVotesByVoterResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParentGroupsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
-		x.Votes = nil
-	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+	case "cosmos.group.v1.QueryParentGroupsResponse.links":
+		x.Links = nil
+	case "cosmos.group.v1.QueryParentGroupsResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10530,22 +14601,22 @@ func (x *fastReflection_QueryVotesByVoterResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryVotesByVoterResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParentGroupsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
-		if len(x.Votes) == 0 {
-			return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{})
+	case "cosmos.group.v1.QueryParentGroupsResponse.links":
+		if len(x.Links) == 0 {
+			return protoreflect.ValueOfList(&_QueryParentGroupsResponse_1_list{})
 		}
-		listValue := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		listValue := &_QueryParentGroupsResponse_1_list{list: &x.Links}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+	case "cosmos.group.v1.QueryParentGroupsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10559,19 +14630,19 @@ func (x *fastReflection_QueryVotesByVoterResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParentGroupsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
+	case "cosmos.group.v1.QueryParentGroupsResponse.links":
 		lv := value.List()
-		clv := lv.(*_QueryVotesByVoterResponse_1_list)
-		x.Votes = *clv.list
-	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+		clv := lv.(*_QueryParentGroupsResponse_1_list)
+		x.Links = *clv.list
+	case "cosmos.group.v1.QueryParentGroupsResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10585,53 +14656,53 @@ func (x *fastReflection_QueryVotesByVoterResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParentGroupsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
-		if x.Votes == nil {
-			x.Votes = []*Vote{}
+	case "cosmos.group.v1.QueryParentGroupsResponse.links":
+		if x.Links == nil {
+			x.Links = []*GroupLink{}
 		}
-		value := &_QueryVotesByVoterResponse_1_list{list: &x.Votes}
+		value := &_QueryParentGroupsResponse_1_list{list: &x.Links}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+	case "cosmos.group.v1.QueryParentGroupsResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryVotesByVoterResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParentGroupsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.QueryVotesByVoterResponse.votes":
-		list := []*Vote{}
-		return protoreflect.ValueOfList(&_QueryVotesByVoterResponse_1_list{list: &list})
-	case "cosmos.group.v1.QueryVotesByVoterResponse.pagination":
+	case "cosmos.group.v1.QueryParentGroupsResponse.links":
+		list := []*GroupLink{}
+		return protoreflect.ValueOfList(&_QueryParentGroupsResponse_1_list{list: &list})
+	case "cosmos.group.v1.QueryParentGroupsResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryVotesByVoterResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.QueryParentGroupsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.QueryVotesByVoterResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.QueryParentGroupsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryVotesByVoterResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParentGroupsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryVotesByVoterResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.QueryParentGroupsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10639,7 +14710,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryVotesByVoterResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParentGroupsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10650,7 +14721,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryVotesByVoterResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParentGroupsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10662,7 +14733,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryVotesByVoterResponse) IsValid() bool {
+func (x *fastReflection_QueryParentGroupsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10672,9 +14743,9 @@ func (x *fastReflection_QueryVotesByVoterResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParentGroupsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		x := input.Message.Interface().(*QueryParentGroupsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10686,8 +14757,8 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if len(x.Votes) > 0 {
-			for _, e := range x.Votes {
+		if len(x.Links) > 0 {
+			for _, e := range x.Links {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -10706,7 +14777,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		x := input.Message.Interface().(*QueryParentGroupsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10739,9 +14810,9 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Votes) > 0 {
-			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Votes[iNdEx])
+		if len(x.Links) > 0 {
+			for iNdEx := len(x.Links) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Links[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10766,7 +14837,7 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryVotesByVoterResponse)
+		x := input.Message.Interface().(*QueryParentGroupsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10798,15 +14869,15 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParentGroupsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParentGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10833,8 +14904,8 @@ func (x *fastReflection_QueryVotesByVoterResponse) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Votes = append(x.Votes, &Vote{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Votes[len(x.Votes)-1]); err != nil {
+				x.Links = append(x.Links, &GroupLink{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Links[len(x.Links)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10931,7 +15002,7 @@ func (x *QueryGroupsByMemberRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryGroupsByMemberRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[22]
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11481,7 +15552,7 @@ func (x *QueryGroupsByMemberResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryGroupsByMemberResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[23]
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12001,7 +16072,7 @@ func (x *QueryTallyResultRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTallyResultRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[24]
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12405,7 +16476,7 @@ func (x *QueryTallyResultResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTallyResultResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[25]
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12840,7 +16911,7 @@ func (x *QueryGroupsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryGroupsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[26]
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13328,7 +17399,7 @@ func (x *QueryGroupsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryGroupsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_query_proto_msgTypes[27]
+	mi := &file_cosmos_group_v1_query_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13991,6 +18062,89 @@ func (x *QueryGroupPolicyInfoResponse) GetInfo() *GroupPolicyInfo {
 	return nil
 }
 
+// QueryGroupPolicyByLabelRequest is the Query/GroupPolicyByLabel request type.
+type QueryGroupPolicyByLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// label is the label of the group policy.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *QueryGroupPolicyByLabelRequest) Reset() {
+	*x = QueryGroupPolicyByLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupPolicyByLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupPolicyByLabelRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupPolicyByLabelRequest.ProtoReflect.Descriptor instead.
+func (*QueryGroupPolicyByLabelRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryGroupPolicyByLabelRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *QueryGroupPolicyByLabelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// QueryGroupPolicyByLabelResponse is the Query/GroupPolicyByLabel response type.
+type QueryGroupPolicyByLabelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// info is the GroupPolicyInfo of the group policy.
+	Info *GroupPolicyInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *QueryGroupPolicyByLabelResponse) Reset() {
+	*x = QueryGroupPolicyByLabelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGroupPolicyByLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGroupPolicyByLabelResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGroupPolicyByLabelResponse.ProtoReflect.Descriptor instead.
+func (*QueryGroupPolicyByLabelResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryGroupPolicyByLabelResponse) GetInfo() *GroupPolicyInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
 // QueryGroupMembersRequest is the Query/GroupMembers request type.
 type QueryGroupMembersRequest struct {
 	state         protoimpl.MessageState
@@ -14006,7 +18160,7 @@ type QueryGroupMembersRequest struct {
 func (x *QueryGroupMembersRequest) Reset() {
 	*x = QueryGroupMembersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[4]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14020,7 +18174,7 @@ func (*QueryGroupMembersRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupMembersRequest.ProtoReflect.Descriptor instead.
 func (*QueryGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryGroupMembersRequest) GetGroupId() uint64 {
@@ -14052,7 +18206,7 @@ type QueryGroupMembersResponse struct {
 func (x *QueryGroupMembersResponse) Reset() {
 	*x = QueryGroupMembersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[5]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14066,7 +18220,7 @@ func (*QueryGroupMembersResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupMembersResponse.ProtoReflect.Descriptor instead.
 func (*QueryGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryGroupMembersResponse) GetMembers() []*GroupMember {
@@ -14098,7 +18252,7 @@ type QueryGroupsByAdminRequest struct {
 func (x *QueryGroupsByAdminRequest) Reset() {
 	*x = QueryGroupsByAdminRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[6]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14112,7 +18266,7 @@ func (*QueryGroupsByAdminRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupsByAdminRequest.ProtoReflect.Descriptor instead.
 func (*QueryGroupsByAdminRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryGroupsByAdminRequest) GetAdmin() string {
@@ -14144,7 +18298,7 @@ type QueryGroupsByAdminResponse struct {
 func (x *QueryGroupsByAdminResponse) Reset() {
 	*x = QueryGroupsByAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[7]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14158,7 +18312,7 @@ func (*QueryGroupsByAdminResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupsByAdminResponse.ProtoReflect.Descriptor instead.
 func (*QueryGroupsByAdminResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryGroupsByAdminResponse) GetGroups() []*GroupInfo {
@@ -14190,7 +18344,7 @@ type QueryGroupPoliciesByGroupRequest struct {
 func (x *QueryGroupPoliciesByGroupRequest) Reset() {
 	*x = QueryGroupPoliciesByGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[8]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14204,7 +18358,7 @@ func (*QueryGroupPoliciesByGroupRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupPoliciesByGroupRequest.ProtoReflect.Descriptor instead.
 func (*QueryGroupPoliciesByGroupRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryGroupPoliciesByGroupRequest) GetGroupId() uint64 {
@@ -14236,7 +18390,7 @@ type QueryGroupPoliciesByGroupResponse struct {
 func (x *QueryGroupPoliciesByGroupResponse) Reset() {
 	*x = QueryGroupPoliciesByGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[9]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14250,7 +18404,7 @@ func (*QueryGroupPoliciesByGroupResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupPoliciesByGroupResponse.ProtoReflect.Descriptor instead.
 func (*QueryGroupPoliciesByGroupResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryGroupPoliciesByGroupResponse) GetGroupPolicies() []*GroupPolicyInfo {
@@ -14282,7 +18436,7 @@ type QueryGroupPoliciesByAdminRequest struct {
 func (x *QueryGroupPoliciesByAdminRequest) Reset() {
 	*x = QueryGroupPoliciesByAdminRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[10]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14296,7 +18450,7 @@ func (*QueryGroupPoliciesByAdminRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupPoliciesByAdminRequest.ProtoReflect.Descriptor instead.
 func (*QueryGroupPoliciesByAdminRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryGroupPoliciesByAdminRequest) GetAdmin() string {
@@ -14328,7 +18482,7 @@ type QueryGroupPoliciesByAdminResponse struct {
 func (x *QueryGroupPoliciesByAdminResponse) Reset() {
 	*x = QueryGroupPoliciesByAdminResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[11]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14342,7 +18496,7 @@ func (*QueryGroupPoliciesByAdminResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupPoliciesByAdminResponse.ProtoReflect.Descriptor instead.
 func (*QueryGroupPoliciesByAdminResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryGroupPoliciesByAdminResponse) GetGroupPolicies() []*GroupPolicyInfo {
@@ -14372,7 +18526,7 @@ type QueryProposalRequest struct {
 func (x *QueryProposalRequest) Reset() {
 	*x = QueryProposalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[12]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14386,7 +18540,7 @@ func (*QueryProposalRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryProposalRequest.ProtoReflect.Descriptor instead.
 func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryProposalRequest) GetProposalId() uint64 {
@@ -14409,7 +18563,7 @@ type QueryProposalResponse struct {
 func (x *QueryProposalResponse) Reset() {
 	*x = QueryProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[13]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14423,7 +18577,7 @@ func (*QueryProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryProposalResponse.ProtoReflect.Descriptor instead.
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryProposalResponse) GetProposal() *Proposal {
@@ -14448,7 +18602,7 @@ type QueryProposalsByGroupPolicyRequest struct {
 func (x *QueryProposalsByGroupPolicyRequest) Reset() {
 	*x = QueryProposalsByGroupPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[14]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14462,7 +18616,7 @@ func (*QueryProposalsByGroupPolicyRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryProposalsByGroupPolicyRequest.ProtoReflect.Descriptor instead.
 func (*QueryProposalsByGroupPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryProposalsByGroupPolicyRequest) GetAddress() string {
@@ -14494,7 +18648,7 @@ type QueryProposalsByGroupPolicyResponse struct {
 func (x *QueryProposalsByGroupPolicyResponse) Reset() {
 	*x = QueryProposalsByGroupPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[15]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14508,7 +18662,7 @@ func (*QueryProposalsByGroupPolicyResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryProposalsByGroupPolicyResponse.ProtoReflect.Descriptor instead.
 func (*QueryProposalsByGroupPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryProposalsByGroupPolicyResponse) GetProposals() []*Proposal {
@@ -14540,7 +18694,7 @@ type QueryVoteByProposalVoterRequest struct {
 func (x *QueryVoteByProposalVoterRequest) Reset() {
 	*x = QueryVoteByProposalVoterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[16]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14554,7 +18708,7 @@ func (*QueryVoteByProposalVoterRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryVoteByProposalVoterRequest.ProtoReflect.Descriptor instead.
 func (*QueryVoteByProposalVoterRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueryVoteByProposalVoterRequest) GetProposalId() uint64 {
@@ -14584,7 +18738,7 @@ type QueryVoteByProposalVoterResponse struct {
 func (x *QueryVoteByProposalVoterResponse) Reset() {
 	*x = QueryVoteByProposalVoterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[17]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14598,7 +18752,7 @@ func (*QueryVoteByProposalVoterResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryVoteByProposalVoterResponse.ProtoReflect.Descriptor instead.
 func (*QueryVoteByProposalVoterResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryVoteByProposalVoterResponse) GetVote() *Vote {
@@ -14623,7 +18777,7 @@ type QueryVotesByProposalRequest struct {
 func (x *QueryVotesByProposalRequest) Reset() {
 	*x = QueryVotesByProposalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[18]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14637,7 +18791,7 @@ func (*QueryVotesByProposalRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryVotesByProposalRequest.ProtoReflect.Descriptor instead.
 func (*QueryVotesByProposalRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryVotesByProposalRequest) GetProposalId() uint64 {
@@ -14669,7 +18823,7 @@ type QueryVotesByProposalResponse struct {
 func (x *QueryVotesByProposalResponse) Reset() {
 	*x = QueryVotesByProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[19]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14683,7 +18837,7 @@ func (*QueryVotesByProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryVotesByProposalResponse.ProtoReflect.Descriptor instead.
 func (*QueryVotesByProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryVotesByProposalResponse) GetVotes() []*Vote {
@@ -14700,6 +18854,98 @@ func (x *QueryVotesByProposalResponse) GetPagination() *v1beta1.PageResponse {
 	return nil
 }
 
+// QueryOffchainApprovalsByProposalRequest is the Query/OffchainApprovalsByProposal request type.
+type QueryOffchainApprovalsByProposalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOffchainApprovalsByProposalRequest) Reset() {
+	*x = QueryOffchainApprovalsByProposalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOffchainApprovalsByProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOffchainApprovalsByProposalRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryOffchainApprovalsByProposalRequest.ProtoReflect.Descriptor instead.
+func (*QueryOffchainApprovalsByProposalRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryOffchainApprovalsByProposalRequest) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *QueryOffchainApprovalsByProposalRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryOffchainApprovalsByProposalResponse is the Query/OffchainApprovalsByProposal response type.
+type QueryOffchainApprovalsByProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// approvals are the list of off-chain approvals for given proposal_id.
+	Approvals []*OffchainApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryOffchainApprovalsByProposalResponse) Reset() {
+	*x = QueryOffchainApprovalsByProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryOffchainApprovalsByProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOffchainApprovalsByProposalResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryOffchainApprovalsByProposalResponse.ProtoReflect.Descriptor instead.
+func (*QueryOffchainApprovalsByProposalResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryOffchainApprovalsByProposalResponse) GetApprovals() []*OffchainApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *QueryOffchainApprovalsByProposalResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryVotesByVoterRequest is the Query/VotesByVoter request type.
 type QueryVotesByVoterRequest struct {
 	state         protoimpl.MessageState
@@ -14715,7 +18961,7 @@ type QueryVotesByVoterRequest struct {
 func (x *QueryVotesByVoterRequest) Reset() {
 	*x = QueryVotesByVoterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[20]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14729,63 +18975,247 @@ func (*QueryVotesByVoterRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryVotesByVoterRequest.ProtoReflect.Descriptor instead.
 func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QueryVotesByVoterRequest) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *QueryVotesByVoterRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryVotesByVoterResponse is the Query/VotesByVoter response type.
+type QueryVotesByVoterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// votes are the list of votes by given voter.
+	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryVotesByVoterResponse) Reset() {
+	*x = QueryVotesByVoterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVotesByVoterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVotesByVoterResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVotesByVoterResponse.ProtoReflect.Descriptor instead.
+func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryVotesByVoterResponse) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *QueryVotesByVoterResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QuerySubGroupsRequest is the Query/SubGroups request type.
+type QuerySubGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_id is the unique ID of the parent group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QuerySubGroupsRequest) Reset() {
+	*x = QuerySubGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubGroupsRequest) ProtoMessage() {}
+
+// Deprecated: Use QuerySubGroupsRequest.ProtoReflect.Descriptor instead.
+func (*QuerySubGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QuerySubGroupsRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *QuerySubGroupsRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QuerySubGroupsResponse is the Query/SubGroups response type.
+type QuerySubGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// links are the links of the group to its sub-groups.
+	Links []*GroupLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QuerySubGroupsResponse) Reset() {
+	*x = QuerySubGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySubGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySubGroupsResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySubGroupsResponse.ProtoReflect.Descriptor instead.
+func (*QuerySubGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QuerySubGroupsResponse) GetLinks() []*GroupLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *QuerySubGroupsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryParentGroupsRequest is the Query/ParentGroups request type.
+type QueryParentGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_id is the unique ID of the child group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryParentGroupsRequest) Reset() {
+	*x = QueryParentGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParentGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParentGroupsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParentGroupsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParentGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryVotesByVoterRequest) GetVoter() string {
+func (x *QueryParentGroupsRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.Voter
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryVotesByVoterRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryParentGroupsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryVotesByVoterResponse is the Query/VotesByVoter response type.
-type QueryVotesByVoterResponse struct {
+// QueryParentGroupsResponse is the Query/ParentGroups response type.
+type QueryParentGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// votes are the list of votes by given voter.
-	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	// links are the links of the group to its parent groups.
+	Links []*GroupLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryVotesByVoterResponse) Reset() {
-	*x = QueryVotesByVoterResponse{}
+func (x *QueryParentGroupsResponse) Reset() {
+	*x = QueryParentGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[21]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVotesByVoterResponse) String() string {
+func (x *QueryParentGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVotesByVoterResponse) ProtoMessage() {}
+func (*QueryParentGroupsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryVotesByVoterResponse.ProtoReflect.Descriptor instead.
-func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use QueryParentGroupsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParentGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryVotesByVoterResponse) GetVotes() []*Vote {
+func (x *QueryParentGroupsResponse) GetLinks() []*GroupLink {
 	if x != nil {
-		return x.Votes
+		return x.Links
 	}
 	return nil
 }
 
-func (x *QueryVotesByVoterResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryParentGroupsResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
@@ -14807,7 +19237,7 @@ type QueryGroupsByMemberRequest struct {
 func (x *QueryGroupsByMemberRequest) Reset() {
 	*x = QueryGroupsByMemberRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[22]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14821,7 +19251,7 @@ func (*QueryGroupsByMemberRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupsByMemberRequest.ProtoReflect.Descriptor instead.
 func (*QueryGroupsByMemberRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryGroupsByMemberRequest) GetAddress() string {
@@ -14853,7 +19283,7 @@ type QueryGroupsByMemberResponse struct {
 func (x *QueryGroupsByMemberResponse) Reset() {
 	*x = QueryGroupsByMemberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[23]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14867,7 +19297,7 @@ func (*QueryGroupsByMemberResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupsByMemberResponse.ProtoReflect.Descriptor instead.
 func (*QueryGroupsByMemberResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryGroupsByMemberResponse) GetGroups() []*GroupInfo {
@@ -14897,7 +19327,7 @@ type QueryTallyResultRequest struct {
 func (x *QueryTallyResultRequest) Reset() {
 	*x = QueryTallyResultRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[24]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14911,7 +19341,7 @@ func (*QueryTallyResultRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTallyResultRequest.ProtoReflect.Descriptor instead.
 func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QueryTallyResultRequest) GetProposalId() uint64 {
@@ -14934,7 +19364,7 @@ type QueryTallyResultResponse struct {
 func (x *QueryTallyResultResponse) Reset() {
 	*x = QueryTallyResultResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[25]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14948,7 +19378,7 @@ func (*QueryTallyResultResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTallyResultResponse.ProtoReflect.Descriptor instead.
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{25}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryTallyResultResponse) GetTally() *TallyResult {
@@ -14971,7 +19401,7 @@ type QueryGroupsRequest struct {
 func (x *QueryGroupsRequest) Reset() {
 	*x = QueryGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[26]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14985,7 +19415,7 @@ func (*QueryGroupsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupsRequest.ProtoReflect.Descriptor instead.
 func (*QueryGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{26}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryGroupsRequest) GetPagination() *v1beta1.PageRequest {
@@ -15010,7 +19440,7 @@ type QueryGroupsResponse struct {
 func (x *QueryGroupsResponse) Reset() {
 	*x = QueryGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_query_proto_msgTypes[27]
+		mi := &file_cosmos_group_v1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15024,7 +19454,7 @@ func (*QueryGroupsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGroupsResponse.ProtoReflect.Descriptor instead.
 func (*QueryGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{27}
+	return file_cosmos_group_v1_query_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *QueryGroupsResponse) GetGroups() []*GroupInfo {
@@ -15076,127 +19506,159 @@ var file_cosmos_group_v1_query_proto_rawDesc = []byte{
 	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
 	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
-	0x22, 0x7d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x9c, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93,
-	0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x85, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x22, 0x65, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x3a, 0x12, 0xd2, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x22, 0x6b, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x3a, 0x12, 0xd2, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30,
+	0x2e, 0x32, 0x2e, 0x30, 0x22, 0x7d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
 	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
-	0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x9a, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01,
-	0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x4e,
-	0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0xa0,
-	0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a,
+	0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
 	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
 	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x1f, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12,
-	0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x22,
-	0x4d, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x86,
-	0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50,
+	0x6e, 0x22, 0xb5, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x14, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x49, 0x64, 0x22, 0x4e, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x22, 0xa0, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x72, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65,
+	0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f,
+	0x74, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x1c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x27, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x66, 0x66, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x50,
 	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
 	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12,
@@ -15204,238 +19666,331 @@ var file_cosmos_group_v1_query_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
 	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05,
-	0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92,
-	0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56,
-	0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x76,
-	0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74,
-	0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x12, 0xd2, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x22, 0xc8, 0x01, 0x0a, 0x28,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66,
+	0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x12, 0xd2, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20,
+	0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x8e, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x12, 0xd2, 0xb4,
+	0x2d, 0x0e, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30,
+	0x22, 0xa7, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x12, 0xd2, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x12, 0xd2, 0xb4, 0x2d, 0x0e,
+	0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x22, 0xaa,
+	0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x47,
 	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
 	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
 	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x12, 0xd2, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x22, 0x98, 0x01, 0x0a, 0x1a,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x3a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x18, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22,
+	0x59, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x74,
+	0x61, 0x6c, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c,
+	0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x22, 0x73, 0x0a, 0x12, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x2e, 0x31, 0x22,
+	0xa9, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x2e, 0x31, 0x22, 0xa9, 0x01, 0x0a, 0x13,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x3a, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x20, 0x30, 0x2e, 0x34, 0x37, 0x2e, 0x31, 0x32, 0x91, 0x12, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0xa4, 0x01, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x7b, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x2e, 0x31, 0x32, 0x9c, 0x18, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
 	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
-	0x64, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x62,
-	0x79, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x7d, 0x12,
-	0xba, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa4, 0x01, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xcc, 0x01, 0x0a, 0x12,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0xca, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12,
+	0x39, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x62,
+	0x79, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x7b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0c, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x7b, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x7d, 0x12, 0xba, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x2e, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb7, 0x01, 0x0a,
-	0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xb7, 0x01, 0x0a, 0x14, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x79, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x7b,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x33,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42,
-	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x36, 0x12, 0x34, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x5f, 0x62, 0x79,
-	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2f, 0x7b, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x13, 0x56, 0x6f, 0x74, 0x65,
-	0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12,
-	0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x76,
-	0x6f, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
-	0x76, 0x6f, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xa8, 0x01, 0x0a, 0x0f,
-	0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12,
-	0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x42, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x7b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f,
 	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x65, 0x73,
-	0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x5f, 0x62, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x13, 0x56,
+	0x6f, 0x74, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x12, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x79,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65,
+	0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12,
+	0x3d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12, 0xa8,
+	0x01, 0x0a, 0x0f, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
 	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42,
-	0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x62,
-	0x79, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x7d, 0x12,
-	0xa0, 0x01, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x42, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x62,
-	0x79, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12,
-	0x2e, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x12,
-	0x89, 0x01, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x73,
+	0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x5f,
+	0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x0c, 0x56, 0x6f,
+	0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0xca, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x2e, 0x31, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x12, 0x17, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0xa9, 0x01, 0x0a, 0x13,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47,
-	0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x42, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x5f, 0x62, 0x79, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x2f, 0x7b, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x7d, 0x12, 0xeb, 0x01, 0x0a, 0x1b, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x38, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0xca, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3f, 0x12, 0x3d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x9e, 0x01, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75,
+	0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x40, 0xca, 0xb4, 0x2d, 0x0e, 0x78, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e,
+	0x32, 0x2e, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xaa, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0xca, 0xb4, 0x2d, 0x0e, 0x78,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x76, 0x30, 0x2e, 0x32, 0x2e, 0x30, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa0,
+	0x01, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x79, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x62, 0x79,
+	0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x89,
+	0x01, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0xca, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x2e, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x12, 0x17, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0xa9, 0x01, 0x0a, 0x13, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f,
+	0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58,
+	0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -15450,111 +20005,139 @@ func file_cosmos_group_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_group_v1_query_proto_rawDescData
 }
 
-var file_cosmos_group_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_cosmos_group_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_cosmos_group_v1_query_proto_goTypes = []interface{}{
-	(*QueryGroupInfoRequest)(nil),               // 0: cosmos.group.v1.QueryGroupInfoRequest
-	(*QueryGroupInfoResponse)(nil),              // 1: cosmos.group.v1.QueryGroupInfoResponse
-	(*QueryGroupPolicyInfoRequest)(nil),         // 2: cosmos.group.v1.QueryGroupPolicyInfoRequest
-	(*QueryGroupPolicyInfoResponse)(nil),        // 3: cosmos.group.v1.QueryGroupPolicyInfoResponse
-	(*QueryGroupMembersRequest)(nil),            // 4: cosmos.group.v1.QueryGroupMembersRequest
-	(*QueryGroupMembersResponse)(nil),           // 5: cosmos.group.v1.QueryGroupMembersResponse
-	(*QueryGroupsByAdminRequest)(nil),           // 6: cosmos.group.v1.QueryGroupsByAdminRequest
-	(*QueryGroupsByAdminResponse)(nil),          // 7: cosmos.group.v1.QueryGroupsByAdminResponse
-	(*QueryGroupPoliciesByGroupRequest)(nil),    // 8: cosmos.group.v1.QueryGroupPoliciesByGroupRequest
-	(*QueryGroupPoliciesByGroupResponse)(nil),   // 9: cosmos.group.v1.QueryGroupPoliciesByGroupResponse
-	(*QueryGroupPoliciesByAdminRequest)(nil),    // 10: cosmos.group.v1.QueryGroupPoliciesByAdminRequest
-	(*QueryGroupPoliciesByAdminResponse)(nil),   // 11: cosmos.group.v1.QueryGroupPoliciesByAdminResponse
-	(*QueryProposalRequest)(nil),                // 12: cosmos.group.v1.QueryProposalRequest
-	(*QueryProposalResponse)(nil),               // 13: cosmos.group.v1.QueryProposalResponse
-	(*QueryProposalsByGroupPolicyRequest)(nil),  // 14: cosmos.group.v1.QueryProposalsByGroupPolicyRequest
-	(*QueryProposalsByGroupPolicyResponse)(nil), // 15: cosmos.group.v1.QueryProposalsByGroupPolicyResponse
-	(*QueryVoteByProposalVoterRequest)(nil),     // 16: cosmos.group.v1.QueryVoteByProposalVoterRequest
-	(*QueryVoteByProposalVoterResponse)(nil),    // 17: cosmos.group.v1.QueryVoteByProposalVoterResponse
-	(*QueryVotesByProposalRequest)(nil),         // 18: cosmos.group.v1.QueryVotesByProposalRequest
-	(*QueryVotesByProposalResponse)(nil),        // 19: cosmos.group.v1.QueryVotesByProposalResponse
-	(*QueryVotesByVoterRequest)(nil),            // 20: cosmos.group.v1.QueryVotesByVoterRequest
-	(*QueryVotesByVoterResponse)(nil),           // 21: cosmos.group.v1.QueryVotesByVoterResponse
-	(*QueryGroupsByMemberRequest)(nil),          // 22: cosmos.group.v1.QueryGroupsByMemberRequest
-	(*QueryGroupsByMemberResponse)(nil),         // 23: cosmos.group.v1.QueryGroupsByMemberResponse
-	(*QueryTallyResultRequest)(nil),             // 24: cosmos.group.v1.QueryTallyResultRequest
-	(*QueryTallyResultResponse)(nil),            // 25: cosmos.group.v1.QueryTallyResultResponse
-	(*QueryGroupsRequest)(nil),                  // 26: cosmos.group.v1.QueryGroupsRequest
-	(*QueryGroupsResponse)(nil),                 // 27: cosmos.group.v1.QueryGroupsResponse
-	(*GroupInfo)(nil),                           // 28: cosmos.group.v1.GroupInfo
-	(*GroupPolicyInfo)(nil),                     // 29: cosmos.group.v1.GroupPolicyInfo
-	(*v1beta1.PageRequest)(nil),                 // 30: cosmos.base.query.v1beta1.PageRequest
-	(*GroupMember)(nil),                         // 31: cosmos.group.v1.GroupMember
-	(*v1beta1.PageResponse)(nil),                // 32: cosmos.base.query.v1beta1.PageResponse
-	(*Proposal)(nil),                            // 33: cosmos.group.v1.Proposal
-	(*Vote)(nil),                                // 34: cosmos.group.v1.Vote
-	(*TallyResult)(nil),                         // 35: cosmos.group.v1.TallyResult
+	(*QueryGroupInfoRequest)(nil),                    // 0: cosmos.group.v1.QueryGroupInfoRequest
+	(*QueryGroupInfoResponse)(nil),                   // 1: cosmos.group.v1.QueryGroupInfoResponse
+	(*QueryGroupPolicyInfoRequest)(nil),              // 2: cosmos.group.v1.QueryGroupPolicyInfoRequest
+	(*QueryGroupPolicyInfoResponse)(nil),             // 3: cosmos.group.v1.QueryGroupPolicyInfoResponse
+	(*QueryGroupPolicyByLabelRequest)(nil),           // 4: cosmos.group.v1.QueryGroupPolicyByLabelRequest
+	(*QueryGroupPolicyByLabelResponse)(nil),          // 5: cosmos.group.v1.QueryGroupPolicyByLabelResponse
+	(*QueryGroupMembersRequest)(nil),                 // 6: cosmos.group.v1.QueryGroupMembersRequest
+	(*QueryGroupMembersResponse)(nil),                // 7: cosmos.group.v1.QueryGroupMembersResponse
+	(*QueryGroupsByAdminRequest)(nil),                // 8: cosmos.group.v1.QueryGroupsByAdminRequest
+	(*QueryGroupsByAdminResponse)(nil),               // 9: cosmos.group.v1.QueryGroupsByAdminResponse
+	(*QueryGroupPoliciesByGroupRequest)(nil),         // 10: cosmos.group.v1.QueryGroupPoliciesByGroupRequest
+	(*QueryGroupPoliciesByGroupResponse)(nil),        // 11: cosmos.group.v1.QueryGroupPoliciesByGroupResponse
+	(*QueryGroupPoliciesByAdminRequest)(nil),         // 12: cosmos.group.v1.QueryGroupPoliciesByAdminRequest
+	(*QueryGroupPoliciesByAdminResponse)(nil),        // 13: cosmos.group.v1.QueryGroupPoliciesByAdminResponse
+	(*QueryProposalRequest)(nil),                     // 14: cosmos.group.v1.QueryProposalRequest
+	(*QueryProposalResponse)(nil),                    // 15: cosmos.group.v1.QueryProposalResponse
+	(*QueryProposalsByGroupPolicyRequest)(nil),       // 16: cosmos.group.v1.QueryProposalsByGroupPolicyRequest
+	(*QueryProposalsByGroupPolicyResponse)(nil),      // 17: cosmos.group.v1.QueryProposalsByGroupPolicyResponse
+	(*QueryVoteByProposalVoterRequest)(nil),          // 18: cosmos.group.v1.QueryVoteByProposalVoterRequest
+	(*QueryVoteByProposalVoterResponse)(nil),         // 19: cosmos.group.v1.QueryVoteByProposalVoterResponse
+	(*QueryVotesByProposalRequest)(nil),              // 20: cosmos.group.v1.QueryVotesByProposalRequest
+	(*QueryVotesByProposalResponse)(nil),             // 21: cosmos.group.v1.QueryVotesByProposalResponse
+	(*QueryOffchainApprovalsByProposalRequest)(nil),  // 22: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest
+	(*QueryOffchainApprovalsByProposalResponse)(nil), // 23: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse
+	(*QueryVotesByVoterRequest)(nil),                 // 24: cosmos.group.v1.QueryVotesByVoterRequest
+	(*QueryVotesByVoterResponse)(nil),                // 25: cosmos.group.v1.QueryVotesByVoterResponse
+	(*QuerySubGroupsRequest)(nil),                    // 26: cosmos.group.v1.QuerySubGroupsRequest
+	(*QuerySubGroupsResponse)(nil),                   // 27: cosmos.group.v1.QuerySubGroupsResponse
+	(*QueryParentGroupsRequest)(nil),                 // 28: cosmos.group.v1.QueryParentGroupsRequest
+	(*QueryParentGroupsResponse)(nil),                // 29: cosmos.group.v1.QueryParentGroupsResponse
+	(*QueryGroupsByMemberRequest)(nil),               // 30: cosmos.group.v1.QueryGroupsByMemberRequest
+	(*QueryGroupsByMemberResponse)(nil),              // 31: cosmos.group.v1.QueryGroupsByMemberResponse
+	(*QueryTallyResultRequest)(nil),                  // 32: cosmos.group.v1.QueryTallyResultRequest
+	(*QueryTallyResultResponse)(nil),                 // 33: cosmos.group.v1.QueryTallyResultResponse
+	(*QueryGroupsRequest)(nil),                       // 34: cosmos.group.v1.QueryGroupsRequest
+	(*QueryGroupsResponse)(nil),                      // 35: cosmos.group.v1.QueryGroupsResponse
+	(*GroupInfo)(nil),                                // 36: cosmos.group.v1.GroupInfo
+	(*GroupPolicyInfo)(nil),                          // 37: cosmos.group.v1.GroupPolicyInfo
+	(*v1beta1.PageRequest)(nil),                      // 38: cosmos.base.query.v1beta1.PageRequest
+	(*GroupMember)(nil),                              // 39: cosmos.group.v1.GroupMember
+	(*v1beta1.PageResponse)(nil),                     // 40: cosmos.base.query.v1beta1.PageResponse
+	(*Proposal)(nil),                                 // 41: cosmos.group.v1.Proposal
+	(*Vote)(nil),                                     // 42: cosmos.group.v1.Vote
+	(*OffchainApproval)(nil),                         // 43: cosmos.group.v1.OffchainApproval
+	(*GroupLink)(nil),                                // 44: cosmos.group.v1.GroupLink
+	(*TallyResult)(nil),                              // 45: cosmos.group.v1.TallyResult
 }
 var file_cosmos_group_v1_query_proto_depIdxs = []int32{
-	28, // 0: cosmos.group.v1.QueryGroupInfoResponse.info:type_name -> cosmos.group.v1.GroupInfo
-	29, // 1: cosmos.group.v1.QueryGroupPolicyInfoResponse.info:type_name -> cosmos.group.v1.GroupPolicyInfo
-	30, // 2: cosmos.group.v1.QueryGroupMembersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	31, // 3: cosmos.group.v1.QueryGroupMembersResponse.members:type_name -> cosmos.group.v1.GroupMember
-	32, // 4: cosmos.group.v1.QueryGroupMembersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	30, // 5: cosmos.group.v1.QueryGroupsByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	28, // 6: cosmos.group.v1.QueryGroupsByAdminResponse.groups:type_name -> cosmos.group.v1.GroupInfo
-	32, // 7: cosmos.group.v1.QueryGroupsByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	30, // 8: cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	29, // 9: cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies:type_name -> cosmos.group.v1.GroupPolicyInfo
-	32, // 10: cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	30, // 11: cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	29, // 12: cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies:type_name -> cosmos.group.v1.GroupPolicyInfo
-	32, // 13: cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	33, // 14: cosmos.group.v1.QueryProposalResponse.proposal:type_name -> cosmos.group.v1.Proposal
-	30, // 15: cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	33, // 16: cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals:type_name -> cosmos.group.v1.Proposal
-	32, // 17: cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	34, // 18: cosmos.group.v1.QueryVoteByProposalVoterResponse.vote:type_name -> cosmos.group.v1.Vote
-	30, // 19: cosmos.group.v1.QueryVotesByProposalRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	34, // 20: cosmos.group.v1.QueryVotesByProposalResponse.votes:type_name -> cosmos.group.v1.Vote
-	32, // 21: cosmos.group.v1.QueryVotesByProposalResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	30, // 22: cosmos.group.v1.QueryVotesByVoterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	34, // 23: cosmos.group.v1.QueryVotesByVoterResponse.votes:type_name -> cosmos.group.v1.Vote
-	32, // 24: cosmos.group.v1.QueryVotesByVoterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	30, // 25: cosmos.group.v1.QueryGroupsByMemberRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	28, // 26: cosmos.group.v1.QueryGroupsByMemberResponse.groups:type_name -> cosmos.group.v1.GroupInfo
-	32, // 27: cosmos.group.v1.QueryGroupsByMemberResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	35, // 28: cosmos.group.v1.QueryTallyResultResponse.tally:type_name -> cosmos.group.v1.TallyResult
-	30, // 29: cosmos.group.v1.QueryGroupsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	28, // 30: cosmos.group.v1.QueryGroupsResponse.groups:type_name -> cosmos.group.v1.GroupInfo
-	32, // 31: cosmos.group.v1.QueryGroupsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	0,  // 32: cosmos.group.v1.Query.GroupInfo:input_type -> cosmos.group.v1.QueryGroupInfoRequest
-	2,  // 33: cosmos.group.v1.Query.GroupPolicyInfo:input_type -> cosmos.group.v1.QueryGroupPolicyInfoRequest
-	4,  // 34: cosmos.group.v1.Query.GroupMembers:input_type -> cosmos.group.v1.QueryGroupMembersRequest
-	6,  // 35: cosmos.group.v1.Query.GroupsByAdmin:input_type -> cosmos.group.v1.QueryGroupsByAdminRequest
-	8,  // 36: cosmos.group.v1.Query.GroupPoliciesByGroup:input_type -> cosmos.group.v1.QueryGroupPoliciesByGroupRequest
-	10, // 37: cosmos.group.v1.Query.GroupPoliciesByAdmin:input_type -> cosmos.group.v1.QueryGroupPoliciesByAdminRequest
-	12, // 38: cosmos.group.v1.Query.Proposal:input_type -> cosmos.group.v1.QueryProposalRequest
-	14, // 39: cosmos.group.v1.Query.ProposalsByGroupPolicy:input_type -> cosmos.group.v1.QueryProposalsByGroupPolicyRequest
-	16, // 40: cosmos.group.v1.Query.VoteByProposalVoter:input_type -> cosmos.group.v1.QueryVoteByProposalVoterRequest
-	18, // 41: cosmos.group.v1.Query.VotesByProposal:input_type -> cosmos.group.v1.QueryVotesByProposalRequest
-	20, // 42: cosmos.group.v1.Query.VotesByVoter:input_type -> cosmos.group.v1.QueryVotesByVoterRequest
-	22, // 43: cosmos.group.v1.Query.GroupsByMember:input_type -> cosmos.group.v1.QueryGroupsByMemberRequest
-	24, // 44: cosmos.group.v1.Query.TallyResult:input_type -> cosmos.group.v1.QueryTallyResultRequest
-	26, // 45: cosmos.group.v1.Query.Groups:input_type -> cosmos.group.v1.QueryGroupsRequest
-	1,  // 46: cosmos.group.v1.Query.GroupInfo:output_type -> cosmos.group.v1.QueryGroupInfoResponse
-	3,  // 47: cosmos.group.v1.Query.GroupPolicyInfo:output_type -> cosmos.group.v1.QueryGroupPolicyInfoResponse
-	5,  // 48: cosmos.group.v1.Query.GroupMembers:output_type -> cosmos.group.v1.QueryGroupMembersResponse
-	7,  // 49: cosmos.group.v1.Query.GroupsByAdmin:output_type -> cosmos.group.v1.QueryGroupsByAdminResponse
-	9,  // 50: cosmos.group.v1.Query.GroupPoliciesByGroup:output_type -> cosmos.group.v1.QueryGroupPoliciesByGroupResponse
-	11, // 51: cosmos.group.v1.Query.GroupPoliciesByAdmin:output_type -> cosmos.group.v1.QueryGroupPoliciesByAdminResponse
-	13, // 52: cosmos.group.v1.Query.Proposal:output_type -> cosmos.group.v1.QueryProposalResponse
-	15, // 53: cosmos.group.v1.Query.ProposalsByGroupPolicy:output_type -> cosmos.group.v1.QueryProposalsByGroupPolicyResponse
-	17, // 54: cosmos.group.v1.Query.VoteByProposalVoter:output_type -> cosmos.group.v1.QueryVoteByProposalVoterResponse
-	19, // 55: cosmos.group.v1.Query.VotesByProposal:output_type -> cosmos.group.v1.QueryVotesByProposalResponse
-	21, // 56: cosmos.group.v1.Query.VotesByVoter:output_type -> cosmos.group.v1.QueryVotesByVoterResponse
-	23, // 57: cosmos.group.v1.Query.GroupsByMember:output_type -> cosmos.group.v1.QueryGroupsByMemberResponse
-	25, // 58: cosmos.group.v1.Query.TallyResult:output_type -> cosmos.group.v1.QueryTallyResultResponse
-	27, // 59: cosmos.group.v1.Query.Groups:output_type -> cosmos.group.v1.QueryGroupsResponse
-	46, // [46:60] is the sub-list for method output_type
-	32, // [32:46] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	36, // 0: cosmos.group.v1.QueryGroupInfoResponse.info:type_name -> cosmos.group.v1.GroupInfo
+	37, // 1: cosmos.group.v1.QueryGroupPolicyInfoResponse.info:type_name -> cosmos.group.v1.GroupPolicyInfo
+	37, // 2: cosmos.group.v1.QueryGroupPolicyByLabelResponse.info:type_name -> cosmos.group.v1.GroupPolicyInfo
+	38, // 3: cosmos.group.v1.QueryGroupMembersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	39, // 4: cosmos.group.v1.QueryGroupMembersResponse.members:type_name -> cosmos.group.v1.GroupMember
+	40, // 5: cosmos.group.v1.QueryGroupMembersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 6: cosmos.group.v1.QueryGroupsByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	36, // 7: cosmos.group.v1.QueryGroupsByAdminResponse.groups:type_name -> cosmos.group.v1.GroupInfo
+	40, // 8: cosmos.group.v1.QueryGroupsByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 9: cosmos.group.v1.QueryGroupPoliciesByGroupRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 10: cosmos.group.v1.QueryGroupPoliciesByGroupResponse.group_policies:type_name -> cosmos.group.v1.GroupPolicyInfo
+	40, // 11: cosmos.group.v1.QueryGroupPoliciesByGroupResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 12: cosmos.group.v1.QueryGroupPoliciesByAdminRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 13: cosmos.group.v1.QueryGroupPoliciesByAdminResponse.group_policies:type_name -> cosmos.group.v1.GroupPolicyInfo
+	40, // 14: cosmos.group.v1.QueryGroupPoliciesByAdminResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	41, // 15: cosmos.group.v1.QueryProposalResponse.proposal:type_name -> cosmos.group.v1.Proposal
+	38, // 16: cosmos.group.v1.QueryProposalsByGroupPolicyRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	41, // 17: cosmos.group.v1.QueryProposalsByGroupPolicyResponse.proposals:type_name -> cosmos.group.v1.Proposal
+	40, // 18: cosmos.group.v1.QueryProposalsByGroupPolicyResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	42, // 19: cosmos.group.v1.QueryVoteByProposalVoterResponse.vote:type_name -> cosmos.group.v1.Vote
+	38, // 20: cosmos.group.v1.QueryVotesByProposalRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	42, // 21: cosmos.group.v1.QueryVotesByProposalResponse.votes:type_name -> cosmos.group.v1.Vote
+	40, // 22: cosmos.group.v1.QueryVotesByProposalResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 23: cosmos.group.v1.QueryOffchainApprovalsByProposalRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	43, // 24: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.approvals:type_name -> cosmos.group.v1.OffchainApproval
+	40, // 25: cosmos.group.v1.QueryOffchainApprovalsByProposalResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 26: cosmos.group.v1.QueryVotesByVoterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	42, // 27: cosmos.group.v1.QueryVotesByVoterResponse.votes:type_name -> cosmos.group.v1.Vote
+	40, // 28: cosmos.group.v1.QueryVotesByVoterResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 29: cosmos.group.v1.QuerySubGroupsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	44, // 30: cosmos.group.v1.QuerySubGroupsResponse.links:type_name -> cosmos.group.v1.GroupLink
+	40, // 31: cosmos.group.v1.QuerySubGroupsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 32: cosmos.group.v1.QueryParentGroupsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	44, // 33: cosmos.group.v1.QueryParentGroupsResponse.links:type_name -> cosmos.group.v1.GroupLink
+	40, // 34: cosmos.group.v1.QueryParentGroupsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	38, // 35: cosmos.group.v1.QueryGroupsByMemberRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	36, // 36: cosmos.group.v1.QueryGroupsByMemberResponse.groups:type_name -> cosmos.group.v1.GroupInfo
+	40, // 37: cosmos.group.v1.QueryGroupsByMemberResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	45, // 38: cosmos.group.v1.QueryTallyResultResponse.tally:type_name -> cosmos.group.v1.TallyResult
+	38, // 39: cosmos.group.v1.QueryGroupsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	36, // 40: cosmos.group.v1.QueryGroupsResponse.groups:type_name -> cosmos.group.v1.GroupInfo
+	40, // 41: cosmos.group.v1.QueryGroupsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 42: cosmos.group.v1.Query.GroupInfo:input_type -> cosmos.group.v1.QueryGroupInfoRequest
+	2,  // 43: cosmos.group.v1.Query.GroupPolicyInfo:input_type -> cosmos.group.v1.QueryGroupPolicyInfoRequest
+	4,  // 44: cosmos.group.v1.Query.GroupPolicyByLabel:input_type -> cosmos.group.v1.QueryGroupPolicyByLabelRequest
+	6,  // 45: cosmos.group.v1.Query.GroupMembers:input_type -> cosmos.group.v1.QueryGroupMembersRequest
+	8,  // 46: cosmos.group.v1.Query.GroupsByAdmin:input_type -> cosmos.group.v1.QueryGroupsByAdminRequest
+	10, // 47: cosmos.group.v1.Query.GroupPoliciesByGroup:input_type -> cosmos.group.v1.QueryGroupPoliciesByGroupRequest
+	12, // 48: cosmos.group.v1.Query.GroupPoliciesByAdmin:input_type -> cosmos.group.v1.QueryGroupPoliciesByAdminRequest
+	14, // 49: cosmos.group.v1.Query.Proposal:input_type -> cosmos.group.v1.QueryProposalRequest
+	16, // 50: cosmos.group.v1.Query.ProposalsByGroupPolicy:input_type -> cosmos.group.v1.QueryProposalsByGroupPolicyRequest
+	18, // 51: cosmos.group.v1.Query.VoteByProposalVoter:input_type -> cosmos.group.v1.QueryVoteByProposalVoterRequest
+	20, // 52: cosmos.group.v1.Query.VotesByProposal:input_type -> cosmos.group.v1.QueryVotesByProposalRequest
+	24, // 53: cosmos.group.v1.Query.VotesByVoter:input_type -> cosmos.group.v1.QueryVotesByVoterRequest
+	22, // 54: cosmos.group.v1.Query.OffchainApprovalsByProposal:input_type -> cosmos.group.v1.QueryOffchainApprovalsByProposalRequest
+	26, // 55: cosmos.group.v1.Query.SubGroups:input_type -> cosmos.group.v1.QuerySubGroupsRequest
+	28, // 56: cosmos.group.v1.Query.ParentGroups:input_type -> cosmos.group.v1.QueryParentGroupsRequest
+	30, // 57: cosmos.group.v1.Query.GroupsByMember:input_type -> cosmos.group.v1.QueryGroupsByMemberRequest
+	32, // 58: cosmos.group.v1.Query.TallyResult:input_type -> cosmos.group.v1.QueryTallyResultRequest
+	34, // 59: cosmos.group.v1.Query.Groups:input_type -> cosmos.group.v1.QueryGroupsRequest
+	1,  // 60: cosmos.group.v1.Query.GroupInfo:output_type -> cosmos.group.v1.QueryGroupInfoResponse
+	3,  // 61: cosmos.group.v1.Query.GroupPolicyInfo:output_type -> cosmos.group.v1.QueryGroupPolicyInfoResponse
+	5,  // 62: cosmos.group.v1.Query.GroupPolicyByLabel:output_type -> cosmos.group.v1.QueryGroupPolicyByLabelResponse
+	7,  // 63: cosmos.group.v1.Query.GroupMembers:output_type -> cosmos.group.v1.QueryGroupMembersResponse
+	9,  // 64: cosmos.group.v1.Query.GroupsByAdmin:output_type -> cosmos.group.v1.QueryGroupsByAdminResponse
+	11, // 65: cosmos.group.v1.Query.GroupPoliciesByGroup:output_type -> cosmos.group.v1.QueryGroupPoliciesByGroupResponse
+	13, // 66: cosmos.group.v1.Query.GroupPoliciesByAdmin:output_type -> cosmos.group.v1.QueryGroupPoliciesByAdminResponse
+	15, // 67: cosmos.group.v1.Query.Proposal:output_type -> cosmos.group.v1.QueryProposalResponse
+	17, // 68: cosmos.group.v1.Query.ProposalsByGroupPolicy:output_type -> cosmos.group.v1.QueryProposalsByGroupPolicyResponse
+	19, // 69: cosmos.group.v1.Query.VoteByProposalVoter:output_type -> cosmos.group.v1.QueryVoteByProposalVoterResponse
+	21, // 70: cosmos.group.v1.Query.VotesByProposal:output_type -> cosmos.group.v1.QueryVotesByProposalResponse
+	25, // 71: cosmos.group.v1.Query.VotesByVoter:output_type -> cosmos.group.v1.QueryVotesByVoterResponse
+	23, // 72: cosmos.group.v1.Query.OffchainApprovalsByProposal:output_type -> cosmos.group.v1.QueryOffchainApprovalsByProposalResponse
+	27, // 73: cosmos.group.v1.Query.SubGroups:output_type -> cosmos.group.v1.QuerySubGroupsResponse
+	29, // 74: cosmos.group.v1.Query.ParentGroups:output_type -> cosmos.group.v1.QueryParentGroupsResponse
+	31, // 75: cosmos.group.v1.Query.GroupsByMember:output_type -> cosmos.group.v1.QueryGroupsByMemberResponse
+	33, // 76: cosmos.group.v1.Query.TallyResult:output_type -> cosmos.group.v1.QueryTallyResultResponse
+	35, // 77: cosmos.group.v1.Query.Groups:output_type -> cosmos.group.v1.QueryGroupsResponse
+	60, // [60:78] is the sub-list for method output_type
+	42, // [42:60] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_group_v1_query_proto_init() }
@@ -15613,7 +20196,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupMembersRequest); i {
+			switch v := v.(*QueryGroupPolicyByLabelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15625,7 +20208,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupMembersResponse); i {
+			switch v := v.(*QueryGroupPolicyByLabelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15637,7 +20220,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupsByAdminRequest); i {
+			switch v := v.(*QueryGroupMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15649,7 +20232,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupsByAdminResponse); i {
+			switch v := v.(*QueryGroupMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15661,7 +20244,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupPoliciesByGroupRequest); i {
+			switch v := v.(*QueryGroupsByAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15673,7 +20256,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupPoliciesByGroupResponse); i {
+			switch v := v.(*QueryGroupsByAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15685,7 +20268,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupPoliciesByAdminRequest); i {
+			switch v := v.(*QueryGroupPoliciesByGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15697,7 +20280,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupPoliciesByAdminResponse); i {
+			switch v := v.(*QueryGroupPoliciesByGroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15709,7 +20292,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalRequest); i {
+			switch v := v.(*QueryGroupPoliciesByAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15721,7 +20304,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalResponse); i {
+			switch v := v.(*QueryGroupPoliciesByAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15733,7 +20316,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalsByGroupPolicyRequest); i {
+			switch v := v.(*QueryProposalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15745,7 +20328,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryProposalsByGroupPolicyResponse); i {
+			switch v := v.(*QueryProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15757,7 +20340,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteByProposalVoterRequest); i {
+			switch v := v.(*QueryProposalsByGroupPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15769,7 +20352,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVoteByProposalVoterResponse); i {
+			switch v := v.(*QueryProposalsByGroupPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15781,7 +20364,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesByProposalRequest); i {
+			switch v := v.(*QueryVoteByProposalVoterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15793,7 +20376,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesByProposalResponse); i {
+			switch v := v.(*QueryVoteByProposalVoterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15805,7 +20388,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesByVoterRequest); i {
+			switch v := v.(*QueryVotesByProposalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15817,7 +20400,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVotesByVoterResponse); i {
+			switch v := v.(*QueryVotesByProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15829,7 +20412,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupsByMemberRequest); i {
+			switch v := v.(*QueryOffchainApprovalsByProposalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15841,7 +20424,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupsByMemberResponse); i {
+			switch v := v.(*QueryOffchainApprovalsByProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15853,7 +20436,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTallyResultRequest); i {
+			switch v := v.(*QueryVotesByVoterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15865,7 +20448,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTallyResultResponse); i {
+			switch v := v.(*QueryVotesByVoterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15877,7 +20460,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGroupsRequest); i {
+			switch v := v.(*QuerySubGroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15889,6 +20472,102 @@ func file_cosmos_group_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySubGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParentGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParentGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupsByMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupsByMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTallyResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTallyResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryGroupsResponse); i {
 			case 0:
 				return &v.state
@@ -15907,7 +20586,7 @@ func file_cosmos_group_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_group_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,20 +21,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GroupInfo_FullMethodName              = "/cosmos.group.v1.Query/GroupInfo"
-	Query_GroupPolicyInfo_FullMethodName        = "/cosmos.group.v1.Query/GroupPolicyInfo"
-	Query_GroupMembers_FullMethodName           = "/cosmos.group.v1.Query/GroupMembers"
-	Query_GroupsByAdmin_FullMethodName          = "/cosmos.group.v1.Query/GroupsByAdmin"
-	Query_GroupPoliciesByGroup_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
-	Query_GroupPoliciesByAdmin_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
-	Query_Proposal_FullMethodName               = "/cosmos.group.v1.Query/Proposal"
-	Query_ProposalsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
-	Query_VoteByProposalVoter_FullMethodName    = "/cosmos.group.v1.Query/VoteByProposalVoter"
-	Query_VotesByProposal_FullMethodName        = "/cosmos.group.v1.Query/VotesByProposal"
-	Query_VotesByVoter_FullMethodName           = "/cosmos.group.v1.Query/VotesByVoter"
-	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
-	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
-	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_GroupInfo_FullMethodName                   = "/cosmos.group.v1.Query/GroupInfo"
+	Query_GroupPolicyInfo_FullMethodName             = "/cosmos.group.v1.Query/GroupPolicyInfo"
+	Query_GroupPolicyByLabel_FullMethodName          = "/cosmos.group.v1.Query/GroupPolicyByLabel"
+	Query_GroupMembers_FullMethodName                = "/cosmos.group.v1.Query/GroupMembers"
+	Query_GroupsByAdmin_FullMethodName               = "/cosmos.group.v1.Query/GroupsByAdmin"
+	Query_GroupPoliciesByGroup_FullMethodName        = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
+	Query_GroupPoliciesByAdmin_FullMethodName        = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
+	Query_Proposal_FullMethodName                    = "/cosmos.group.v1.Query/Proposal"
+	Query_ProposalsByGroupPolicy_FullMethodName      = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
+	Query_VoteByProposalVoter_FullMethodName         = "/cosmos.group.v1.Query/VoteByProposalVoter"
+	Query_VotesByProposal_FullMethodName             = "/cosmos.group.v1.Query/VotesByProposal"
+	Query_VotesByVoter_FullMethodName                = "/cosmos.group.v1.Query/VotesByVoter"
+	Query_OffchainApprovalsByProposal_FullMethodName = "/cosmos.group.v1.Query/OffchainApprovalsByProposal"
+	Query_SubGroups_FullMethodName                   = "/cosmos.group.v1.Query/SubGroups"
+	Query_ParentGroups_FullMethodName                = "/cosmos.group.v1.Query/ParentGroups"
+	Query_GroupsByMember_FullMethodName              = "/cosmos.group.v1.Query/GroupsByMember"
+	Query_TallyResult_FullMethodName                 = "/cosmos.group.v1.Query/TallyResult"
+	Query_Groups_FullMethodName                      = "/cosmos.group.v1.Query/Groups"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +49,8 @@ type QueryClient interface {
 	GroupInfo(ctx context.Context, in *QueryGroupInfoRequest, opts ...grpc.CallOption) (*QueryGroupInfoResponse, error)
 	// GroupPolicyInfo queries group policy info based on account address of group policy.
 	GroupPolicyInfo(ctx context.Context, in *QueryGroupPolicyInfoRequest, opts ...grpc.CallOption) (*QueryGroupPolicyInfoResponse, error)
+	// GroupPolicyByLabel resolves a group policy from its group id and label.
+	GroupPolicyByLabel(ctx context.Context, in *QueryGroupPolicyByLabelRequest, opts ...grpc.CallOption) (*QueryGroupPolicyByLabelResponse, error)
 	// GroupMembers queries members of a group by group id.
 	GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error)
 	// GroupsByAdmin queries groups by admin address.
@@ -63,6 +69,14 @@ type QueryClient interface {
 	VotesByProposal(ctx context.Context, in *QueryVotesByProposalRequest, opts ...grpc.CallOption) (*QueryVotesByProposalResponse, error)
 	// VotesByVoter queries a vote by voter.
 	VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error)
+	// OffchainApprovalsByProposal queries the off-chain approvals of a proposal.
+	OffchainApprovalsByProposal(ctx context.Context, in *QueryOffchainApprovalsByProposalRequest, opts ...grpc.CallOption) (*QueryOffchainApprovalsByProposalResponse, error)
+	// SubGroups queries the links of a group to its sub-groups, i.e. the groups
+	// whose group policy accounts are members of the group.
+	SubGroups(ctx context.Context, in *QuerySubGroupsRequest, opts ...grpc.CallOption) (*QuerySubGroupsResponse, error)
+	// ParentGroups queries the links of a group to its parent groups, i.e. the
+	// groups having a group policy account of the group as member.
+	ParentGroups(ctx context.Context, in *QueryParentGroupsRequest, opts ...grpc.CallOption) (*QueryParentGroupsResponse, error)
 	// GroupsByMember queries groups by member address.
 	GroupsByMember(ctx context.Context, in *QueryGroupsByMemberRequest, opts ...grpc.CallOption) (*QueryGroupsByMemberResponse, error)
 	// TallyResult returns the tally result of a proposal. If the proposal is
@@ -101,6 +115,15 @@ func (c *queryClient) GroupPolicyInfo(ctx context.Context, in *QueryGroupPolicyI
 	return out, nil
 }
 
+func (c *queryClient) GroupPolicyByLabel(ctx context.Context, in *QueryGroupPolicyByLabelRequest, opts ...grpc.CallOption) (*QueryGroupPolicyByLabelResponse, error) {
+	out := new(QueryGroupPolicyByLabelResponse)
+	err := c.cc.Invoke(ctx, Query_GroupPolicyByLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error) {
 	out := new(QueryGroupMembersResponse)
 	err := c.cc.Invoke(ctx, Query_GroupMembers_FullMethodName, in, out, opts...)
@@ -182,6 +205,33 @@ func (c *queryClient) VotesByVoter(ctx context.Context, in *QueryVotesByVoterReq
 	return out, nil
 }
 
+func (c *queryClient) OffchainApprovalsByProposal(ctx context.Context, in *QueryOffchainApprovalsByProposalRequest, opts ...grpc.CallOption) (*QueryOffchainApprovalsByProposalResponse, error) {
+	out := new(QueryOffchainApprovalsByProposalResponse)
+	err := c.cc.Invoke(ctx, Query_OffchainApprovalsByProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubGroups(ctx context.Context, in *QuerySubGroupsRequest, opts ...grpc.CallOption) (*QuerySubGroupsResponse, error) {
+	out := new(QuerySubGroupsResponse)
+	err := c.cc.Invoke(ctx, Query_SubGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParentGroups(ctx context.Context, in *QueryParentGroupsRequest, opts ...grpc.CallOption) (*QueryParentGroupsResponse, error) {
+	out := new(QueryParentGroupsResponse)
+	err := c.cc.Invoke(ctx, Query_ParentGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GroupsByMember(ctx context.Context, in *QueryGroupsByMemberRequest, opts ...grpc.CallOption) (*QueryGroupsByMemberResponse, error) {
 	out := new(QueryGroupsByMemberResponse)
 	err := c.cc.Invoke(ctx, Query_GroupsByMember_FullMethodName, in, out, opts...)
@@ -217,6 +267,8 @@ type QueryServer interface {
 	GroupInfo(context.Context, *QueryGroupInfoRequest) (*QueryGroupInfoResponse, error)
 	// GroupPolicyInfo queries group policy info based on account address of group policy.
 	GroupPolicyInfo(context.Context, *QueryGroupPolicyInfoRequest) (*QueryGroupPolicyInfoResponse, error)
+	// GroupPolicyByLabel resolves a group policy from its group id and label.
+	GroupPolicyByLabel(context.Context, *QueryGroupPolicyByLabelRequest) (*QueryGroupPolicyByLabelResponse, error)
 	// GroupMembers queries members of a group by group id.
 	GroupMembers(context.Context, *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error)
 	// GroupsByAdmin queries groups by admin address.
@@ -235,6 +287,14 @@ type QueryServer interface {
 	VotesByProposal(context.Context, *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error)
 	// VotesByVoter queries a vote by voter.
 	VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error)
+	// OffchainApprovalsByProposal queries the off-chain approvals of a proposal.
+	OffchainApprovalsByProposal(context.Context, *QueryOffchainApprovalsByProposalRequest) (*QueryOffchainApprovalsByProposalResponse, error)
+	// SubGroups queries the links of a group to its sub-groups, i.e. the groups
+	// whose group policy accounts are members of the group.
+	SubGroups(context.Context, *QuerySubGroupsRequest) (*QuerySubGroupsResponse, error)
+	// ParentGroups queries the links of a group to its parent groups, i.e. the
+	// groups having a group policy account of the group as member.
+	ParentGroups(context.Context, *QueryParentGroupsRequest) (*QueryParentGroupsResponse, error)
 	// GroupsByMember queries groups by member address.
 	GroupsByMember(context.Context, *QueryGroupsByMemberRequest) (*QueryGroupsByMemberResponse, error)
 	// TallyResult returns the tally result of a proposal. If the proposal is
@@ -258,6 +318,9 @@ func (UnimplementedQueryServer) GroupInfo(context.Context, *QueryGroupInfoReques
 func (UnimplementedQueryServer) GroupPolicyInfo(context.Context, *QueryGroupPolicyInfoRequest) (*QueryGroupPolicyInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupPolicyInfo not implemented")
 }
+func (UnimplementedQueryServer) GroupPolicyByLabel(context.Context, *QueryGroupPolicyByLabelRequest) (*QueryGroupPolicyByLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupPolicyByLabel not implemented")
+}
 func (UnimplementedQueryServer) GroupMembers(context.Context, *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupMembers not implemented")
 }
@@ -285,6 +348,15 @@ func (UnimplementedQueryServer) VotesByProposal(context.Context, *QueryVotesByPr
 func (UnimplementedQueryServer) VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotesByVoter not implemented")
 }
+func (UnimplementedQueryServer) OffchainApprovalsByProposal(context.Context, *QueryOffchainApprovalsByProposalRequest) (*QueryOffchainApprovalsByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffchainApprovalsByProposal not implemented")
+}
+func (UnimplementedQueryServer) SubGroups(context.Context, *QuerySubGroupsRequest) (*QuerySubGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubGroups not implemented")
+}
+func (UnimplementedQueryServer) ParentGroups(context.Context, *QueryParentGroupsRequest) (*QueryParentGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParentGroups not implemented")
+}
 func (UnimplementedQueryServer) GroupsByMember(context.Context, *QueryGroupsByMemberRequest) (*QueryGroupsByMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupsByMember not implemented")
 }
@@ -343,6 +415,24 @@ func _Query_GroupPolicyInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupPolicyByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupPolicyByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupPolicyByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GroupPolicyByLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupPolicyByLabel(ctx, req.(*QueryGroupPolicyByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGroupMembersRequest)
 	if err := dec(in); err != nil {
@@ -505,6 +595,60 @@ func _Query_VotesByVoter_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OffchainApprovalsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffchainApprovalsByProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffchainApprovalsByProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OffchainApprovalsByProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffchainApprovalsByProposal(ctx, req.(*QueryOffchainApprovalsByProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SubGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubGroups(ctx, req.(*QuerySubGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParentGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParentGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParentGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParentGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParentGroups(ctx, req.(*QueryParentGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GroupsByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGroupsByMemberRequest)
 	if err := dec(in); err != nil {
@@ -574,6 +718,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GroupPolicyInfo",
 			Handler:    _Query_GroupPolicyInfo_Handler,
 		},
+		{
+			MethodName: "GroupPolicyByLabel",
+			Handler:    _Query_GroupPolicyByLabel_Handler,
+		},
 		{
 			MethodName: "GroupMembers",
 			Handler:    _Query_GroupMembers_Handler,
@@ -610,6 +758,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VotesByVoter",
 			Handler:    _Query_VotesByVoter_Handler,
 		},
+		{
+			MethodName: "OffchainApprovalsByProposal",
+			Handler:    _Query_OffchainApprovalsByProposal_Handler,
+		},
+		{
+			MethodName: "SubGroups",
+			Handler:    _Query_SubGroups_Handler,
+		},
+		{
+			MethodName: "ParentGroups",
+			Handler:    _Query_ParentGroups_Handler,
+		},
 		{
 			MethodName: "GroupsByMember",
 			Handler:    _Query_GroupsByMember_Handler,
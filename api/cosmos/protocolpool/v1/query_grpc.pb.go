@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_CommunityPool_FullMethodName   = "/cosmos.protocolpool.v1.Query/CommunityPool"
-	Query_UnclaimedBudget_FullMethodName = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_CommunityPool_FullMethodName     = "/cosmos.protocolpool.v1.Query/CommunityPool"
+	Query_UnclaimedBudget_FullMethodName   = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_ReceiptShare_FullMethodName      = "/cosmos.protocolpool.v1.Query/ReceiptShare"
+	Query_SpendMsgAllowlist_FullMethodName = "/cosmos.protocolpool.v1.Query/SpendMsgAllowlist"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,12 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// ReceiptShare queries the share of the receipts of an account routed to the
+	// community pool.
+	ReceiptShare(ctx context.Context, in *QueryReceiptShareRequest, opts ...grpc.CallOption) (*QueryReceiptShareResponse, error)
+	// SpendMsgAllowlist queries the messages community pool spend recipients can
+	// execute on their behalf.
+	SpendMsgAllowlist(ctx context.Context, in *QuerySpendMsgAllowlistRequest, opts ...grpc.CallOption) (*QuerySpendMsgAllowlistResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +67,24 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) ReceiptShare(ctx context.Context, in *QueryReceiptShareRequest, opts ...grpc.CallOption) (*QueryReceiptShareResponse, error) {
+	out := new(QueryReceiptShareResponse)
+	err := c.cc.Invoke(ctx, Query_ReceiptShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendMsgAllowlist(ctx context.Context, in *QuerySpendMsgAllowlistRequest, opts ...grpc.CallOption) (*QuerySpendMsgAllowlistResponse, error) {
+	out := new(QuerySpendMsgAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_SpendMsgAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +93,12 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// ReceiptShare queries the share of the receipts of an account routed to the
+	// community pool.
+	ReceiptShare(context.Context, *QueryReceiptShareRequest) (*QueryReceiptShareResponse, error)
+	// SpendMsgAllowlist queries the messages community pool spend recipients can
+	// execute on their behalf.
+	SpendMsgAllowlist(context.Context, *QuerySpendMsgAllowlistRequest) (*QuerySpendMsgAllowlistResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +112,12 @@ func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPo
 func (UnimplementedQueryServer) UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (UnimplementedQueryServer) ReceiptShare(context.Context, *QueryReceiptShareRequest) (*QueryReceiptShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiptShare not implemented")
+}
+func (UnimplementedQueryServer) SpendMsgAllowlist(context.Context, *QuerySpendMsgAllowlistRequest) (*QuerySpendMsgAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendMsgAllowlist not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +167,42 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReceiptShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReceiptShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ReceiptShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReceiptShare(ctx, req.(*QueryReceiptShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendMsgAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendMsgAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendMsgAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendMsgAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendMsgAllowlist(ctx, req.(*QuerySpendMsgAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +218,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "ReceiptShare",
+			Handler:    _Query_ReceiptShare_Handler,
+		},
+		{
+			MethodName: "SpendMsgAllowlist",
+			Handler:    _Query_SpendMsgAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -19,13 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_FundCommunityPool_FullMethodName      = "/cosmos.protocolpool.v1.Msg/FundCommunityPool"
-	Msg_CommunityPoolSpend_FullMethodName     = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
-	Msg_SubmitBudgetProposal_FullMethodName   = "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal"
-	Msg_ClaimBudget_FullMethodName            = "/cosmos.protocolpool.v1.Msg/ClaimBudget"
-	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
-	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
-	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_FundCommunityPool_FullMethodName       = "/cosmos.protocolpool.v1.Msg/FundCommunityPool"
+	Msg_CommunityPoolSpend_FullMethodName      = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
+	Msg_SubmitBudgetProposal_FullMethodName    = "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal"
+	Msg_ClaimBudget_FullMethodName             = "/cosmos.protocolpool.v1.Msg/ClaimBudget"
+	Msg_CreateContinuousFund_FullMethodName    = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
+	Msg_WithdrawContinuousFund_FullMethodName  = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
+	Msg_CancelContinuousFund_FullMethodName    = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_SetReceiptShare_FullMethodName         = "/cosmos.protocolpool.v1.Msg/SetReceiptShare"
+	Msg_UpdateSpendMsgAllowlist_FullMethodName = "/cosmos.protocolpool.v1.Msg/UpdateSpendMsgAllowlist"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +52,13 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// SetReceiptShare defines a method for an account to route a share of the
+	// coins it receives to the community pool.
+	SetReceiptShare(ctx context.Context, in *MsgSetReceiptShare, opts ...grpc.CallOption) (*MsgSetReceiptShareResponse, error)
+	// UpdateSpendMsgAllowlist defines a governance operation for updating the
+	// messages recipients can execute as part of a community pool spend. The
+	// authority is defined in the keeper.
+	UpdateSpendMsgAllowlist(ctx context.Context, in *MsgUpdateSpendMsgAllowlist, opts ...grpc.CallOption) (*MsgUpdateSpendMsgAllowlistResponse, error)
 }
 
 type msgClient struct {
@@ -123,6 +132,24 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) SetReceiptShare(ctx context.Context, in *MsgSetReceiptShare, opts ...grpc.CallOption) (*MsgSetReceiptShareResponse, error) {
+	out := new(MsgSetReceiptShareResponse)
+	err := c.cc.Invoke(ctx, Msg_SetReceiptShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSpendMsgAllowlist(ctx context.Context, in *MsgUpdateSpendMsgAllowlist, opts ...grpc.CallOption) (*MsgUpdateSpendMsgAllowlistResponse, error) {
+	out := new(MsgUpdateSpendMsgAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSpendMsgAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -145,6 +172,13 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// SetReceiptShare defines a method for an account to route a share of the
+	// coins it receives to the community pool.
+	SetReceiptShare(context.Context, *MsgSetReceiptShare) (*MsgSetReceiptShareResponse, error)
+	// UpdateSpendMsgAllowlist defines a governance operation for updating the
+	// messages recipients can execute as part of a community pool spend. The
+	// authority is defined in the keeper.
+	UpdateSpendMsgAllowlist(context.Context, *MsgUpdateSpendMsgAllowlist) (*MsgUpdateSpendMsgAllowlistResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +207,12 @@ func (UnimplementedMsgServer) WithdrawContinuousFund(context.Context, *MsgWithdr
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) SetReceiptShare(context.Context, *MsgSetReceiptShare) (*MsgSetReceiptShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiptShare not implemented")
+}
+func (UnimplementedMsgServer) UpdateSpendMsgAllowlist(context.Context, *MsgUpdateSpendMsgAllowlist) (*MsgUpdateSpendMsgAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpendMsgAllowlist not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +352,42 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetReceiptShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReceiptShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReceiptShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetReceiptShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReceiptShare(ctx, req.(*MsgSetReceiptShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSpendMsgAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSpendMsgAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpendMsgAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSpendMsgAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpendMsgAllowlist(ctx, req.(*MsgUpdateSpendMsgAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +423,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "SetReceiptShare",
+			Handler:    _Msg_SetReceiptShare_Handler,
+		},
+		{
+			MethodName: "UpdateSpendMsgAllowlist",
+			Handler:    _Msg_UpdateSpendMsgAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName               = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName          = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName         = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_SlashesByDelegator_FullMethodName   = "/cosmos.slashing.v1beta1.Query/SlashesByDelegator"
+	Query_TombstoneHistory_FullMethodName     = "/cosmos.slashing.v1beta1.Query/TombstoneHistory"
+	Query_ValidatorSlashes_FullMethodName     = "/cosmos.slashing.v1beta1.Query/ValidatorSlashes"
+	Query_ValidatorJailHistory_FullMethodName = "/cosmos.slashing.v1beta1.Query/ValidatorJailHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +38,19 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SlashesByDelegator queries the slashes which reduced the current
+	// delegations, unbonding delegations and redelegations of a delegator, from
+	// the oldest to the most recent.
+	SlashesByDelegator(ctx context.Context, in *QuerySlashesByDelegatorRequest, opts ...grpc.CallOption) (*QuerySlashesByDelegatorResponse, error)
+	// TombstoneHistory queries the changes of the tombstone flag of a
+	// validator, from the oldest to the most recent.
+	TombstoneHistory(ctx context.Context, in *QueryTombstoneHistoryRequest, opts ...grpc.CallOption) (*QueryTombstoneHistoryResponse, error)
+	// ValidatorSlashes queries the slashes of a validator, from the oldest to
+	// the most recent.
+	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
+	// ValidatorJailHistory queries the jailings of a validator, from the oldest
+	// to the most recent.
+	ValidatorJailHistory(ctx context.Context, in *QueryValidatorJailHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorJailHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +88,42 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) SlashesByDelegator(ctx context.Context, in *QuerySlashesByDelegatorRequest, opts ...grpc.CallOption) (*QuerySlashesByDelegatorResponse, error) {
+	out := new(QuerySlashesByDelegatorResponse)
+	err := c.cc.Invoke(ctx, Query_SlashesByDelegator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TombstoneHistory(ctx context.Context, in *QueryTombstoneHistoryRequest, opts ...grpc.CallOption) (*QueryTombstoneHistoryResponse, error) {
+	out := new(QueryTombstoneHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_TombstoneHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
+	out := new(QueryValidatorSlashesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSlashes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorJailHistory(ctx context.Context, in *QueryValidatorJailHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorJailHistoryResponse, error) {
+	out := new(QueryValidatorJailHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorJailHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +134,19 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SlashesByDelegator queries the slashes which reduced the current
+	// delegations, unbonding delegations and redelegations of a delegator, from
+	// the oldest to the most recent.
+	SlashesByDelegator(context.Context, *QuerySlashesByDelegatorRequest) (*QuerySlashesByDelegatorResponse, error)
+	// TombstoneHistory queries the changes of the tombstone flag of a
+	// validator, from the oldest to the most recent.
+	TombstoneHistory(context.Context, *QueryTombstoneHistoryRequest) (*QueryTombstoneHistoryResponse, error)
+	// ValidatorSlashes queries the slashes of a validator, from the oldest to
+	// the most recent.
+	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
+	// ValidatorJailHistory queries the jailings of a validator, from the oldest
+	// to the most recent.
+	ValidatorJailHistory(context.Context, *QueryValidatorJailHistoryRequest) (*QueryValidatorJailHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +163,18 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) SlashesByDelegator(context.Context, *QuerySlashesByDelegatorRequest) (*QuerySlashesByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashesByDelegator not implemented")
+}
+func (UnimplementedQueryServer) TombstoneHistory(context.Context, *QueryTombstoneHistoryRequest) (*QueryTombstoneHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TombstoneHistory not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
+}
+func (UnimplementedQueryServer) ValidatorJailHistory(context.Context, *QueryValidatorJailHistoryRequest) (*QueryValidatorJailHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorJailHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +242,78 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashesByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesByDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashesByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashesByDelegator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashesByDelegator(ctx, req.(*QuerySlashesByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TombstoneHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTombstoneHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TombstoneHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TombstoneHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TombstoneHistory(ctx, req.(*QueryTombstoneHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSlashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSlashes(ctx, req.(*QueryValidatorSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorJailHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorJailHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorJailHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorJailHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorJailHistory(ctx, req.(*QueryValidatorJailHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +333,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SlashesByDelegator",
+			Handler:    _Query_SlashesByDelegator_Handler,
+		},
+		{
+			MethodName: "TombstoneHistory",
+			Handler:    _Query_TombstoneHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorSlashes",
+			Handler:    _Query_ValidatorSlashes_Handler,
+		},
+		{
+			MethodName: "ValidatorJailHistory",
+			Handler:    _Query_ValidatorJailHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
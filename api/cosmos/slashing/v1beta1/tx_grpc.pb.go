@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName           = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_UnjailTombstoned_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UnjailTombstoned"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +35,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UnjailTombstoned defines a governance operation for clearing the tombstone
+	// of a validator, for cases of provable infrastructure bugs. The authority
+	// defaults to the x/gov module account.
+	UnjailTombstoned(ctx context.Context, in *MsgUnjailTombstoned, opts ...grpc.CallOption) (*MsgUnjailTombstonedResponse, error)
 }
 
 type msgClient struct {
@@ -62,6 +67,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UnjailTombstoned(ctx context.Context, in *MsgUnjailTombstoned, opts ...grpc.CallOption) (*MsgUnjailTombstonedResponse, error) {
+	out := new(MsgUnjailTombstonedResponse)
+	err := c.cc.Invoke(ctx, Msg_UnjailTombstoned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -73,6 +87,10 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UnjailTombstoned defines a governance operation for clearing the tombstone
+	// of a validator, for cases of provable infrastructure bugs. The authority
+	// defaults to the x/gov module account.
+	UnjailTombstoned(context.Context, *MsgUnjailTombstoned) (*MsgUnjailTombstonedResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -86,6 +104,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UnjailTombstoned(context.Context, *MsgUnjailTombstoned) (*MsgUnjailTombstonedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailTombstoned not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -135,6 +156,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnjailTombstoned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailTombstoned)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailTombstoned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnjailTombstoned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailTombstoned(ctx, req.(*MsgUnjailTombstoned))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,6 +189,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UnjailTombstoned",
+			Handler:    _Msg_UnjailTombstoned_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
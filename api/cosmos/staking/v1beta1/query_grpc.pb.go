@@ -33,6 +33,12 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_SupplyBreakdown_FullMethodName               = "/cosmos.staking.v1beta1.Query/SupplyBreakdown"
+	Query_HistoricalValidatorSet_FullMethodName        = "/cosmos.staking.v1beta1.Query/HistoricalValidatorSet"
+	Query_UpcomingUnbondingDelegations_FullMethodName  = "/cosmos.staking.v1beta1.Query/UpcomingUnbondingDelegations"
+	Query_ValidatorsByMoniker_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorsByMoniker"
+	Query_UnbondingTimeOverrides_FullMethodName        = "/cosmos.staking.v1beta1.Query/UnbondingTimeOverrides"
+	Query_DelegatorUnbondingTime_FullMethodName        = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingTime"
 )
 
 // QueryClient is the client API for Query service.
@@ -93,6 +99,28 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SupplyBreakdown queries the breakdown of the bond denom supply into bonded,
+	// unbonding, community pool, vesting locked and liquid tokens.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the number of accounts holding the bond denom is large.
+	SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error)
+	// HistoricalValidatorSet queries the bonded validator set at a given height,
+	// or at the latest height at or before a given time, among the last
+	// `historical_entries` heights.
+	HistoricalValidatorSet(ctx context.Context, in *QueryHistoricalValidatorSetRequest, opts ...grpc.CallOption) (*QueryHistoricalValidatorSetResponse, error)
+	// UpcomingUnbondingDelegations queries the unbonding delegation entries
+	// completing in a time window.
+	UpcomingUnbondingDelegations(ctx context.Context, in *QueryUpcomingUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryUpcomingUnbondingDelegationsResponse, error)
+	// ValidatorsByMoniker queries the validators whose moniker starts with a
+	// given prefix, case-insensitively, ordered by moniker.
+	ValidatorsByMoniker(ctx context.Context, in *QueryValidatorsByMonikerRequest, opts ...grpc.CallOption) (*QueryValidatorsByMonikerResponse, error)
+	// UnbondingTimeOverrides queries the unbonding time overrides.
+	UnbondingTimeOverrides(ctx context.Context, in *QueryUnbondingTimeOverridesRequest, opts ...grpc.CallOption) (*QueryUnbondingTimeOverridesResponse, error)
+	// DelegatorUnbondingTime queries the unbonding time applying to the
+	// undelegations of a delegator, taking the unbonding time overrides into
+	// account.
+	DelegatorUnbondingTime(ctx context.Context, in *QueryDelegatorUnbondingTimeRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingTimeResponse, error)
 }
 
 type queryClient struct {
@@ -230,6 +258,60 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SupplyBreakdown(ctx context.Context, in *QuerySupplyBreakdownRequest, opts ...grpc.CallOption) (*QuerySupplyBreakdownResponse, error) {
+	out := new(QuerySupplyBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalValidatorSet(ctx context.Context, in *QueryHistoricalValidatorSetRequest, opts ...grpc.CallOption) (*QueryHistoricalValidatorSetResponse, error) {
+	out := new(QueryHistoricalValidatorSetResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricalValidatorSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpcomingUnbondingDelegations(ctx context.Context, in *QueryUpcomingUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryUpcomingUnbondingDelegationsResponse, error) {
+	out := new(QueryUpcomingUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_UpcomingUnbondingDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsByMoniker(ctx context.Context, in *QueryValidatorsByMonikerRequest, opts ...grpc.CallOption) (*QueryValidatorsByMonikerResponse, error) {
+	out := new(QueryValidatorsByMonikerResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsByMoniker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingTimeOverrides(ctx context.Context, in *QueryUnbondingTimeOverridesRequest, opts ...grpc.CallOption) (*QueryUnbondingTimeOverridesResponse, error) {
+	out := new(QueryUnbondingTimeOverridesResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingTimeOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorUnbondingTime(ctx context.Context, in *QueryDelegatorUnbondingTimeRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingTimeResponse, error) {
+	out := new(QueryDelegatorUnbondingTimeResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorUnbondingTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -288,6 +370,28 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SupplyBreakdown queries the breakdown of the bond denom supply into bonded,
+	// unbonding, community pool, vesting locked and liquid tokens.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the number of accounts holding the bond denom is large.
+	SupplyBreakdown(context.Context, *QuerySupplyBreakdownRequest) (*QuerySupplyBreakdownResponse, error)
+	// HistoricalValidatorSet queries the bonded validator set at a given height,
+	// or at the latest height at or before a given time, among the last
+	// `historical_entries` heights.
+	HistoricalValidatorSet(context.Context, *QueryHistoricalValidatorSetRequest) (*QueryHistoricalValidatorSetResponse, error)
+	// UpcomingUnbondingDelegations queries the unbonding delegation entries
+	// completing in a time window.
+	UpcomingUnbondingDelegations(context.Context, *QueryUpcomingUnbondingDelegationsRequest) (*QueryUpcomingUnbondingDelegationsResponse, error)
+	// ValidatorsByMoniker queries the validators whose moniker starts with a
+	// given prefix, case-insensitively, ordered by moniker.
+	ValidatorsByMoniker(context.Context, *QueryValidatorsByMonikerRequest) (*QueryValidatorsByMonikerResponse, error)
+	// UnbondingTimeOverrides queries the unbonding time overrides.
+	UnbondingTimeOverrides(context.Context, *QueryUnbondingTimeOverridesRequest) (*QueryUnbondingTimeOverridesResponse, error)
+	// DelegatorUnbondingTime queries the unbonding time applying to the
+	// undelegations of a delegator, taking the unbonding time overrides into
+	// account.
+	DelegatorUnbondingTime(context.Context, *QueryDelegatorUnbondingTimeRequest) (*QueryDelegatorUnbondingTimeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -337,6 +441,24 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) SupplyBreakdown(context.Context, *QuerySupplyBreakdownRequest) (*QuerySupplyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyBreakdown not implemented")
+}
+func (UnimplementedQueryServer) HistoricalValidatorSet(context.Context, *QueryHistoricalValidatorSetRequest) (*QueryHistoricalValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalValidatorSet not implemented")
+}
+func (UnimplementedQueryServer) UpcomingUnbondingDelegations(context.Context, *QueryUpcomingUnbondingDelegationsRequest) (*QueryUpcomingUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingUnbondingDelegations not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsByMoniker(context.Context, *QueryValidatorsByMonikerRequest) (*QueryValidatorsByMonikerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsByMoniker not implemented")
+}
+func (UnimplementedQueryServer) UnbondingTimeOverrides(context.Context, *QueryUnbondingTimeOverridesRequest) (*QueryUnbondingTimeOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingTimeOverrides not implemented")
+}
+func (UnimplementedQueryServer) DelegatorUnbondingTime(context.Context, *QueryDelegatorUnbondingTimeRequest) (*QueryDelegatorUnbondingTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingTime not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -602,6 +724,114 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyBreakdown(ctx, req.(*QuerySupplyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricalValidatorSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalValidatorSet(ctx, req.(*QueryHistoricalValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpcomingUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpcomingUnbondingDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingUnbondingDelegations(ctx, req.(*QueryUpcomingUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsByMoniker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsByMonikerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsByMoniker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsByMoniker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsByMoniker(ctx, req.(*QueryValidatorsByMonikerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingTimeOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingTimeOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingTimeOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingTimeOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingTimeOverrides(ctx, req.(*QueryUnbondingTimeOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorUnbondingTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorUnbondingTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorUnbondingTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorUnbondingTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorUnbondingTime(ctx, req.(*QueryDelegatorUnbondingTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -665,6 +895,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "SupplyBreakdown",
+			Handler:    _Query_SupplyBreakdown_Handler,
+		},
+		{
+			MethodName: "HistoricalValidatorSet",
+			Handler:    _Query_HistoricalValidatorSet_Handler,
+		},
+		{
+			MethodName: "UpcomingUnbondingDelegations",
+			Handler:    _Query_UpcomingUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "ValidatorsByMoniker",
+			Handler:    _Query_ValidatorsByMoniker_Handler,
+		},
+		{
+			MethodName: "UnbondingTimeOverrides",
+			Handler:    _Query_UnbondingTimeOverrides_Handler,
+		},
+		{
+			MethodName: "DelegatorUnbondingTime",
+			Handler:    _Query_DelegatorUnbondingTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -19,14 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateValidator_FullMethodName           = "/cosmos.staking.v1beta1.Msg/CreateValidator"
-	Msg_EditValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/EditValidator"
-	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
-	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
-	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
-	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
-	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
-	Msg_RotateConsPubKey_FullMethodName          = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
+	Msg_CreateValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/CreateValidator"
+	Msg_EditValidator_FullMethodName               = "/cosmos.staking.v1beta1.Msg/EditValidator"
+	Msg_Delegate_FullMethodName                    = "/cosmos.staking.v1beta1.Msg/Delegate"
+	Msg_BeginRedelegate_FullMethodName             = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
+	Msg_Undelegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Undelegate"
+	Msg_CancelUnbondingDelegation_FullMethodName   = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
+	Msg_UpdateParams_FullMethodName                = "/cosmos.staking.v1beta1.Msg/UpdateParams"
+	Msg_RotateConsPubKey_FullMethodName            = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
+	Msg_SetUnbondingTimeOverride_FullMethodName    = "/cosmos.staking.v1beta1.Msg/SetUnbondingTimeOverride"
+	Msg_RemoveUnbondingTimeOverride_FullMethodName = "/cosmos.staking.v1beta1.Msg/RemoveUnbondingTimeOverride"
+	Msg_SetLiquidStaker_FullMethodName             = "/cosmos.staking.v1beta1.Msg/SetLiquidStaker"
 )
 
 // MsgClient is the client API for Msg service.
@@ -55,6 +58,15 @@ type MsgClient interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// SetUnbondingTimeOverride defines an operation for setting the unbonding
+	// time of the undelegations of a given delegator or account type.
+	SetUnbondingTimeOverride(ctx context.Context, in *MsgSetUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgSetUnbondingTimeOverrideResponse, error)
+	// RemoveUnbondingTimeOverride defines an operation for removing an unbonding
+	// time override.
+	RemoveUnbondingTimeOverride(ctx context.Context, in *MsgRemoveUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgRemoveUnbondingTimeOverrideResponse, error)
+	// SetLiquidStaker defines an operation for registering or unregistering an
+	// account as a liquid staking provider.
+	SetLiquidStaker(ctx context.Context, in *MsgSetLiquidStaker, opts ...grpc.CallOption) (*MsgSetLiquidStakerResponse, error)
 }
 
 type msgClient struct {
@@ -137,6 +149,33 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) SetUnbondingTimeOverride(ctx context.Context, in *MsgSetUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgSetUnbondingTimeOverrideResponse, error) {
+	out := new(MsgSetUnbondingTimeOverrideResponse)
+	err := c.cc.Invoke(ctx, Msg_SetUnbondingTimeOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveUnbondingTimeOverride(ctx context.Context, in *MsgRemoveUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgRemoveUnbondingTimeOverrideResponse, error) {
+	out := new(MsgRemoveUnbondingTimeOverrideResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveUnbondingTimeOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLiquidStaker(ctx context.Context, in *MsgSetLiquidStaker, opts ...grpc.CallOption) (*MsgSetLiquidStakerResponse, error) {
+	out := new(MsgSetLiquidStakerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetLiquidStaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -163,6 +202,15 @@ type MsgServer interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// SetUnbondingTimeOverride defines an operation for setting the unbonding
+	// time of the undelegations of a given delegator or account type.
+	SetUnbondingTimeOverride(context.Context, *MsgSetUnbondingTimeOverride) (*MsgSetUnbondingTimeOverrideResponse, error)
+	// RemoveUnbondingTimeOverride defines an operation for removing an unbonding
+	// time override.
+	RemoveUnbondingTimeOverride(context.Context, *MsgRemoveUnbondingTimeOverride) (*MsgRemoveUnbondingTimeOverrideResponse, error)
+	// SetLiquidStaker defines an operation for registering or unregistering an
+	// account as a liquid staking provider.
+	SetLiquidStaker(context.Context, *MsgSetLiquidStaker) (*MsgSetLiquidStakerResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -194,6 +242,15 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (UnimplementedMsgServer) SetUnbondingTimeOverride(context.Context, *MsgSetUnbondingTimeOverride) (*MsgSetUnbondingTimeOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnbondingTimeOverride not implemented")
+}
+func (UnimplementedMsgServer) RemoveUnbondingTimeOverride(context.Context, *MsgRemoveUnbondingTimeOverride) (*MsgRemoveUnbondingTimeOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUnbondingTimeOverride not implemented")
+}
+func (UnimplementedMsgServer) SetLiquidStaker(context.Context, *MsgSetLiquidStaker) (*MsgSetLiquidStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLiquidStaker not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -351,6 +408,60 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetUnbondingTimeOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnbondingTimeOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnbondingTimeOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetUnbondingTimeOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnbondingTimeOverride(ctx, req.(*MsgSetUnbondingTimeOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveUnbondingTimeOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveUnbondingTimeOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveUnbondingTimeOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveUnbondingTimeOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveUnbondingTimeOverride(ctx, req.(*MsgRemoveUnbondingTimeOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLiquidStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLiquidStaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLiquidStaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetLiquidStaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLiquidStaker(ctx, req.(*MsgSetLiquidStaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -390,6 +501,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "SetUnbondingTimeOverride",
+			Handler:    _Msg_SetUnbondingTimeOverride_Handler,
+		},
+		{
+			MethodName: "RemoveUnbondingTimeOverride",
+			Handler:    _Msg_RemoveUnbondingTimeOverride_Handler,
+		},
+		{
+			MethodName: "SetLiquidStaker",
+			Handler:    _Msg_SetLiquidStaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -24,6 +24,8 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_PlanBinaries_FullMethodName           = "/cosmos.upgrade.v1beta1.Query/PlanBinaries"
+	Query_UpgradeHistory_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/UpgradeHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +48,13 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// PlanBinaries queries the current upgrade plan along with the binaries listed
+	// in its info and their checksums, so that downloaded binaries can be verified
+	// against on-chain data.
+	PlanBinaries(ctx context.Context, in *QueryPlanBinariesRequest, opts ...grpc.CallOption) (*QueryPlanBinariesResponse, error)
+	// UpgradeHistory queries the upgrades applied on chain along with the height
+	// at which they were applied, ordered by height.
+	UpgradeHistory(ctx context.Context, in *QueryUpgradeHistoryRequest, opts ...grpc.CallOption) (*QueryUpgradeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -102,6 +111,24 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) PlanBinaries(ctx context.Context, in *QueryPlanBinariesRequest, opts ...grpc.CallOption) (*QueryPlanBinariesResponse, error) {
+	out := new(QueryPlanBinariesResponse)
+	err := c.cc.Invoke(ctx, Query_PlanBinaries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeHistory(ctx context.Context, in *QueryUpgradeHistoryRequest, opts ...grpc.CallOption) (*QueryUpgradeHistoryResponse, error) {
+	out := new(QueryUpgradeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_UpgradeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -122,6 +149,13 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// PlanBinaries queries the current upgrade plan along with the binaries listed
+	// in its info and their checksums, so that downloaded binaries can be verified
+	// against on-chain data.
+	PlanBinaries(context.Context, *QueryPlanBinariesRequest) (*QueryPlanBinariesResponse, error)
+	// UpgradeHistory queries the upgrades applied on chain along with the height
+	// at which they were applied, ordered by height.
+	UpgradeHistory(context.Context, *QueryUpgradeHistoryRequest) (*QueryUpgradeHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -144,6 +178,12 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) PlanBinaries(context.Context, *QueryPlanBinariesRequest) (*QueryPlanBinariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanBinaries not implemented")
+}
+func (UnimplementedQueryServer) UpgradeHistory(context.Context, *QueryUpgradeHistoryRequest) (*QueryUpgradeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -247,6 +287,42 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PlanBinaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanBinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanBinaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PlanBinaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanBinaries(ctx, req.(*QueryPlanBinariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpgradeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeHistory(ctx, req.(*QueryUpgradeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -274,6 +350,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "PlanBinaries",
+			Handler:    _Query_PlanBinaries_Handler,
+		},
+		{
+			MethodName: "UpgradeHistory",
+			Handler:    _Query_UpgradeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
@@ -811,6 +812,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	// Reset the gas meter so that the AnteHandlers aren't required to
 	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
+	app.nextTxIndex = 0
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
@@ -1238,7 +1240,7 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	cacheMS, err := app.queryMultiStore(qms, height, lastBlockHeight)
 	if err != nil {
 		return sdk.Context{},
 			errorsmod.Wrapf(
@@ -1271,6 +1273,47 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	return ctx, nil
 }
 
+// queryView holds the read-only view of the latest committed height.
+type queryView struct {
+	mtx sync.Mutex
+	ms  storetypes.MultiStore
+}
+
+// queryMultiStore branches the query multi-store at the given height.
+// When the multi-store can provide read-only views, the branch is taken from
+// an immutable view, which for the latest height is shared between the
+// concurrent queries instead of being loaded for each of them.
+// Tracing is only supported by regular branches, so they are used when it is enabled.
+func (app *BaseApp) queryMultiStore(qms storetypes.MultiStore, height, lastBlockHeight int64) (storetypes.CacheMultiStore, error) {
+	provider, ok := qms.(storetypes.ReadOnlyMultiStoreProvider)
+	if !ok || qms.TracingEnabled() {
+		return qms.CacheMultiStoreWithVersion(height)
+	}
+
+	if height != lastBlockHeight {
+		view, err := provider.ReadOnlyMultiStoreWithVersion(height)
+		if err != nil {
+			return nil, err
+		}
+
+		return view.CacheMultiStore(), nil
+	}
+
+	app.queryView.mtx.Lock()
+	defer app.queryView.mtx.Unlock()
+
+	if app.queryView.ms == nil || app.queryView.ms.LatestVersion() != height {
+		view, err := provider.ReadOnlyMultiStoreWithVersion(height)
+		if err != nil {
+			return nil, err
+		}
+
+		app.queryView.ms = view
+	}
+
+	return app.queryView.ms.CacheMultiStore(), nil
+}
+
 // GetBlockRetentionHeight returns the height for which all blocks below this height
 // are pruned from CometBFT. Given a commitment height and a non-zero local
 // minRetainBlocks configuration, the retentionHeight is the smallest height that
@@ -685,6 +685,68 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_TxIndexAndBlockGasUsed(t *testing.T) {
+	type txPosition struct {
+		index        int
+		blockGasUsed uint64
+	}
+
+	var positions []txPosition
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			positions = append(positions, txPosition{ctx.TxIndex(), ctx.BlockGasUsed()})
+
+			newCtx = ctx.WithGasMeter(storetypes.NewGasMeter(100))
+			count, _ := parseTxMemo(t, tx)
+			newCtx.GasMeter().ConsumeGas(uint64(count), "counter-ante")
+			return
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	encode := func(counter int64) []byte {
+		bz, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, counter, counter))
+		require.NoError(t, err)
+		return bz
+	}
+
+	// an undecodable tx still occupies a position in the block
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: 1,
+		Txs:    [][]byte{encode(1), []byte("invalid"), encode(2), encode(3)},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 4)
+	require.False(t, res.TxResults[1].IsOK())
+	require.Equal(t, []txPosition{{0, 0}, {2, 2}, {3, 6}}, positions)
+
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// the position restarts at zero in every block
+	positions = nil
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Height: 2,
+		Txs:    [][]byte{encode(4)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []txPosition{{0, 0}}, positions)
+
+	// txs which are not part of a block never observe a position
+	positions = nil
+	_, err = suite.baseApp.CheckTx(&abci.CheckTxRequest{Tx: encode(5), Type: abci.CHECK_TX_TYPE_CHECK})
+	require.NoError(t, err)
+	_, _, err = suite.baseApp.Simulate(encode(6))
+	require.NoError(t, err)
+	require.Equal(t, []txPosition{{-1, 0}, {-1, 0}}, positions)
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
@@ -122,6 +123,11 @@ type BaseApp struct {
 	processProposalState *state
 	finalizeBlockState   *state
 
+	// nextTxIndex is the position within the current block assigned to the next
+	// tx executed in FinalizeBlock. It is reset after BeginBlock and exposed to
+	// the tx through sdk.Context.TxIndex.
+	nextTxIndex int
+
 	// An inter-block write-through cache provided to the context during the ABCI
 	// FinalizeBlock call.
 	interBlockCache storetypes.MultiStorePersistentCache
@@ -133,6 +139,10 @@ type BaseApp struct {
 	// queryGasLimit defines the maximum gas for queries; unbounded if 0.
 	queryGasLimit uint64
 
+	// queryView is the read-only view of the latest committed height, shared
+	// by the queries served concurrently against that height.
+	queryView queryView
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -377,6 +387,12 @@ func (app *BaseApp) CommitMultiStore() storetypes.CommitMultiStore {
 	return app.cms
 }
 
+// StreamingManager returns the streaming manager.
+// App constructor can use this to register extra ABCI listeners.
+func (app *BaseApp) StreamingManager() storetypes.StreamingManager {
+	return app.streamingManager
+}
+
 // SnapshotManager returns the snapshot manager.
 // application use this to register extra extension snapshotters.
 func (app *BaseApp) SnapshotManager() *snapshots.Manager {
@@ -684,7 +700,12 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 
 	if mode == execModeSimulate {
 		ctx, _ = ctx.CacheContext()
-		ctx = ctx.WithExecMode(sdk.ExecMode(execModeSimulate))
+		// a simulated tx is not part of any block, so it must never observe a
+		// block position or the gas consumed by other txs, otherwise its gas
+		// estimate would depend on the mempool state of the queried node.
+		ctx = ctx.WithExecMode(sdk.ExecMode(execModeSimulate)).
+			WithTxIndex(-1).
+			WithBlockGasUsed(0)
 	}
 
 	return ctx
@@ -835,6 +856,13 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	var gasWanted uint64
 
 	ctx := app.getContextForTx(mode, txBytes)
+	if mode == execModeFinalize {
+		// Every tx delivered in the block consumes an index, even if it fails to
+		// decode, so that the index matches the tx position in the block.
+		ctx = ctx.WithTxIndex(app.nextTxIndex).
+			WithBlockGasUsed(ctx.BlockGasMeter().GasConsumedToLimit())
+		app.nextTxIndex++
+	}
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -1205,6 +1233,16 @@ func (app *BaseApp) TxEncode(tx sdk.Tx) ([]byte, error) {
 func (app *BaseApp) Close() error {
 	var errs []error
 
+	// Close the streaming listeners first, so that they can flush the data
+	// they buffered before the databases are closed.
+	for _, listener := range app.streamingManager.ABCIListeners {
+		if closer, ok := listener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	// Close app.db (opened by cosmos-sdk/server/start.go call to openDB)
 	if app.db != nil {
 		app.logger.Info("Closing application.db")
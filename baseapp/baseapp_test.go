@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -741,6 +742,45 @@ func TestABCI_CreateQueryContext(t *testing.T) {
 	}
 }
 
+func TestABCI_CreateQueryContext_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil)
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+
+	key, value := []byte("key"), []byte("value")
+	app.CommitMultiStore().GetKVStore(capKey1).Set(key, value)
+
+	_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	// concurrent queries against the same height each get their own branch
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			ctx, err := app.CreateQueryContext(0, false)
+			require.NoError(t, err)
+
+			store := ctx.KVStore(capKey1)
+			require.Equal(t, value, store.Get(key))
+			store.Set(key, []byte{byte(i)})
+			require.Equal(t, []byte{byte(i)}, store.Get(key))
+		}(i)
+	}
+	wg.Wait()
+
+	ctx, err := app.CreateQueryContext(1, false)
+	require.NoError(t, err)
+	require.Equal(t, value, ctx.KVStore(capKey1).Get(key))
+}
+
 func TestSetMinGasPrices(t *testing.T) {
 	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}
 	suite := NewBaseAppSuite(t, baseapp.SetMinGasPrices(minGasPrices.String()))
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/runtime/protoiface"
 
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
+	"github.com/cosmos/cosmos-sdk/client/grpc/batch"
 	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -34,6 +35,8 @@ type GRPCQueryRouter struct {
 	hybridHandlers map[string][]func(ctx context.Context, req, resp protoiface.MessageV1) error
 	// responseByRequestName maps the request name to the response name.
 	responseByRequestName map[string]string
+	// requestNameByRoute maps the query route path to the request name.
+	requestNameByRoute map[string]string
 	// binaryCodec is used to encode/decode binary protobuf messages.
 	binaryCodec codec.BinaryCodec
 	// cdc is the gRPC codec used by the router to correctly unmarshal messages.
@@ -59,6 +62,7 @@ func NewGRPCQueryRouter() *GRPCQueryRouter {
 		routes:                map[string]GRPCQueryHandler{},
 		hybridHandlers:        map[string][]func(ctx context.Context, req, resp protoiface.MessageV1) error{},
 		responseByRequestName: map[string]string{},
+		requestNameByRoute:    map[string]string{},
 	}
 }
 
@@ -151,6 +155,11 @@ func (qrt *GRPCQueryRouter) ResponseNameByRequestName(requestName string) string
 	return qrt.responseByRequestName[requestName]
 }
 
+// RequestNameByRoute returns the request name of the given query route path.
+func (qrt *GRPCQueryRouter) RequestNameByRoute(path string) string {
+	return qrt.requestNameByRoute[path]
+}
+
 func (qrt *GRPCQueryRouter) registerHybridHandler(sd *grpc.ServiceDesc, method grpc.MethodDesc, handler interface{}) error {
 	// extract message name from method descriptor
 	inputName, err := protocompat.RequestFullNameFromMethodDesc(sd, method)
@@ -167,12 +176,13 @@ func (qrt *GRPCQueryRouter) registerHybridHandler(sd *grpc.ServiceDesc, method g
 	}
 	// map input name to output name
 	qrt.responseByRequestName[string(inputName)] = string(outputName)
+	qrt.requestNameByRoute[fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)] = string(inputName)
 	qrt.hybridHandlers[string(inputName)] = append(qrt.hybridHandlers[string(inputName)], methodHandler)
 	return nil
 }
 
 // SetInterfaceRegistry sets the interface registry for the router. This will
-// also register the interface reflection gRPC service.
+// also register the interface reflection and batch query gRPC services.
 func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	qrt.binaryCodec = codec.NewProtoCodec(interfaceRegistry)
 	// instantiate the codec
@@ -180,4 +190,7 @@ func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.In
 	// Once we have an interface registry, we can register the interface
 	// registry reflection gRPC service.
 	reflection.RegisterReflectionServiceServer(qrt, reflection.NewReflectionServiceServer(interfaceRegistry))
+	// Register the batch query gRPC service, executing the batched queries
+	// with this router.
+	batch.RegisterBatchQueryServiceServer(qrt, batch.NewBatchQueryServiceServer(qrt))
 }
@@ -6,12 +6,14 @@ import (
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/grpc/batch"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -53,6 +55,79 @@ func TestGRPCQueryRouter(t *testing.T) {
 	require.Equal(t, spot, res3.HasAnimal.Animal.GetCachedValue())
 }
 
+func TestGRPCQueryRouterBatchQuery(t *testing.T) {
+	qr := baseapp.NewGRPCQueryRouter()
+	interfaceRegistry := testdata.NewTestInterfaceRegistry()
+	qr.SetInterfaceRegistry(interfaceRegistry)
+	testdata_pulsar.RegisterQueryServer(qr, testdata_pulsar.QueryImpl{})
+	helper := &baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: qr,
+		Ctx:             sdk.Context{}.WithContext(context.Background()).WithBlockHeight(5),
+	}
+	client := batch.NewBatchQueryServiceClient(helper)
+
+	newQuery := func(method string, req proto.Message) *batch.Query {
+		anyReq, err := types.NewAnyWithValue(req)
+		require.NoError(t, err)
+		return &batch.Query{Method: method, Request: anyReq}
+	}
+
+	res, err := client.BatchQuery(context.Background(), &batch.BatchQueryRequest{
+		Queries: []*batch.Query{
+			newQuery("/testpb.Query/Echo", &testdata.EchoRequest{Message: "hello"}),
+			newQuery("/testpb.Query/SayHello", &testdata.SayHelloRequest{Name: "Foo"}),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), res.Height)
+	require.Len(t, res.Responses, 2)
+
+	require.Equal(t, "/testpb.EchoResponse", res.Responses[0].TypeUrl)
+	var echoRes testdata.EchoResponse
+	require.NoError(t, proto.Unmarshal(res.Responses[0].Value, &echoRes))
+	require.Equal(t, "hello", echoRes.Message)
+
+	require.Equal(t, "/testpb.SayHelloResponse", res.Responses[1].TypeUrl)
+	var sayHelloRes testdata.SayHelloResponse
+	require.NoError(t, proto.Unmarshal(res.Responses[1].Value, &sayHelloRes))
+	require.Equal(t, "Hello Foo!", sayHelloRes.Greeting)
+
+	tooManyQueries := make([]*batch.Query, batch.MaxQueries+1)
+	for i := range tooManyQueries {
+		tooManyQueries[i] = newQuery("/testpb.Query/Echo", &testdata.EchoRequest{})
+	}
+
+	testCases := []struct {
+		name    string
+		queries []*batch.Query
+		expErr  string
+	}{
+		{"empty batch", nil, "empty batch"},
+		{"unknown method", []*batch.Query{newQuery("/testpb.Query/Unknown", &testdata.EchoRequest{})}, "query 0 (/testpb.Query/Unknown): unknown query method"},
+		{"empty request", []*batch.Query{{Method: "/testpb.Query/Echo"}}, "query 0 (/testpb.Query/Echo): empty query request"},
+		{
+			"invalid request type",
+			[]*batch.Query{
+				newQuery("/testpb.Query/Echo", &testdata.EchoRequest{}),
+				newQuery("/testpb.Query/Echo", &testdata.SayHelloRequest{}),
+			},
+			"query 1 (/testpb.Query/Echo): invalid request type testpb.SayHelloRequest, expected testpb.EchoRequest",
+		},
+		{
+			"nested batch",
+			[]*batch.Query{newQuery("/cosmos.base.batch.v1beta1.BatchQueryService/BatchQuery", &batch.BatchQueryRequest{})},
+			"batches can't be nested",
+		},
+		{"too many queries", tooManyQueries, "too many queries"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := client.BatchQuery(context.Background(), &batch.BatchQueryRequest{Queries: tc.queries})
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
 func TestGRPCRouterHybridHandlers(t *testing.T) {
 	assertRouterBehaviour := func(helper *baseapp.QueryServiceTestHelper) {
 		// test getting the handler by name
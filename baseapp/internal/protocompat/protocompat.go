@@ -6,7 +6,6 @@ import (
 	"reflect"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
-	"github.com/golang/protobuf/proto" // nolint: staticcheck // needed because gogoproto.Merge does not work consistently. See NOTE: comments.
 	"google.golang.org/grpc"
 	proto2 "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -125,20 +124,14 @@ func makeGogoHybridHandler(prefMethod protoreflect.MethodDescriptor, cdc codec.B
 				return fmt.Errorf("invalid request type %T, method %s does not accept protov2 messages", inReq, prefMethod.FullName())
 			}
 			resp, err := method.Handler(handler, ctx, func(msg any) error {
-				// merge! ref: https://github.com/cosmos/cosmos-sdk/issues/18003
-				// NOTE: using gogoproto.Merge will fail for some reason unknown to me, but
-				// using proto.Merge with gogo messages seems to work fine.
-				proto.Merge(msg.(gogoproto.Message), inReq)
-				return nil
+				// copy! ref: https://github.com/cosmos/cosmos-sdk/issues/18003
+				return copyGogo(cdc, msg.(gogoproto.Message), inReq)
 			}, nil)
 			if err != nil {
 				return err
 			}
-			// merge resp, ref: https://github.com/cosmos/cosmos-sdk/issues/18003
-			// NOTE: using gogoproto.Merge will fail for some reason unknown to me, but
-			// using proto.Merge with gogo messages seems to work fine.
-			proto.Merge(outResp.(gogoproto.Message), resp.(gogoproto.Message))
-			return nil
+			// copy resp, ref: https://github.com/cosmos/cosmos-sdk/issues/18003
+			return copyGogo(cdc, outResp.(gogoproto.Message), resp.(gogoproto.Message))
 		}, nil
 	}
 	// this is a gogo handler, and we have a protov2 counterparty.
@@ -170,26 +163,31 @@ func makeGogoHybridHandler(prefMethod protoreflect.MethodDescriptor, cdc codec.B
 			// we can just call the handler after making a copy of the message, for safety reasons.
 			resp, err := method.Handler(handler, ctx, func(msg any) error {
 				// ref: https://github.com/cosmos/cosmos-sdk/issues/18003
-				asGogoProto := msg.(gogoproto.Message)
-				// NOTE: using gogoproto.Merge will fail for some reason unknown to me, but
-				// using proto.Merge with gogo messages seems to work fine.
-				proto.Merge(asGogoProto, m)
-				return nil
+				return copyGogo(cdc, msg.(gogoproto.Message), m)
 			}, nil)
 			if err != nil {
 				return err
 			}
-			// merge on the resp, ref: https://github.com/cosmos/cosmos-sdk/issues/18003
-			// NOTE: using gogoproto.Merge will fail for some reason unknown to me, but
-			// using proto.Merge with gogo messages seems to work fine.
-			proto.Merge(outResp.(gogoproto.Message), resp.(gogoproto.Message))
-			return nil
+			// copy on the resp, ref: https://github.com/cosmos/cosmos-sdk/issues/18003
+			return copyGogo(cdc, outResp.(gogoproto.Message), resp.(gogoproto.Message))
 		default:
 			panic("unreachable")
 		}
 	}, nil
 }
 
+// copyGogo copies src into dst by round-tripping it through the codec.
+// NOTE: neither gogoproto.Merge nor proto.Merge can be used here, the latter
+// reflects on the generated Go types and panics on gogoproto customtype fields
+// (e.g. non-nullable math.LegacyDec).
+func copyGogo(cdc codec.BinaryCodec, dst, src gogoproto.Message) error {
+	bz, err := cdc.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return cdc.Unmarshal(bz, dst)
+}
+
 // isProtov2 returns true if the given method accepts protov2 messages.
 // Returns false if it does not.
 // It uses the decoder function passed to the method handler to determine
@@ -29,6 +29,10 @@ func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	return gasInfo, result, err
 }
 
+// SimDeliver executes a tx against the FinalizeBlock state outside of the ABCI
+// FinalizeBlock call, as done by simulations. The delivered txs are assigned
+// consecutive tx indices following the txs of the last finalized block, so
+// sdk.Context.TxIndex and sdk.Context.BlockGasUsed behave as in FinalizeBlock.
 func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	// See comment for Check().
 	bz, err := txEncoder(tx)
@@ -104,6 +104,12 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithOutputFormat(output)
 	}
 
+	switch clientCtx.OutputFormat {
+	case "", flags.OutputFormatText, flags.OutputFormatJSON:
+	default:
+		return clientCtx, fmt.Errorf("invalid output format %q, expected %s or %s", clientCtx.OutputFormat, flags.OutputFormatText, flags.OutputFormatJSON)
+	}
+
 	if clientCtx.HomeDir == "" || flagSet.Changed(flags.FlagHome) {
 		homeDir, _ := flagSet.GetString(flags.FlagHome)
 		clientCtx = clientCtx.WithHomeDir(homeDir)
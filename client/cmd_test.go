@@ -139,3 +139,58 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestReadPersistentCommandFlagsOutputFormat(t *testing.T) {
+	testCases := []struct {
+		name      string
+		clientCtx client.Context
+		args      []string
+		expOutput string
+		expErr    string
+	}{
+		{
+			"flag default",
+			client.Context{},
+			[]string{},
+			flags.OutputFormatText,
+			"",
+		},
+		{
+			"client config default",
+			client.Context{}.WithOutputFormat(flags.OutputFormatJSON),
+			[]string{},
+			flags.OutputFormatJSON,
+			"",
+		},
+		{
+			"flag set",
+			client.Context{}.WithOutputFormat(flags.OutputFormatJSON),
+			[]string{fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText)},
+			flags.OutputFormatText,
+			"",
+		},
+		{
+			"invalid output format",
+			client.Context{},
+			[]string{fmt.Sprintf("--%s=yaml", flags.FlagOutput)},
+			"",
+			"invalid output format \"yaml\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags.AddQueryFlagsToCmd(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			clientCtx, err := client.ReadPersistentCommandFlags(tc.clientCtx, cmd.Flags())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expOutput, clientCtx.OutputFormat)
+		})
+	}
+}
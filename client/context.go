@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/tx/payload"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -66,6 +67,10 @@ type Context struct {
 	LedgerHasProtobuf bool
 	PreprocessTxHook  PreprocessTxFn
 
+	// PayloadDecoders render the opaque payloads embedded in messages (e.g.
+	// CosmWasm execute messages) when decoding transactions.
+	PayloadDecoders *payload.Registry
+
 	// IsAux is true when the signer is an auxiliary signer (e.g. the tipper).
 	IsAux bool
 
@@ -323,6 +328,12 @@ func (ctx Context) WithPreprocessTxHook(preprocessFn PreprocessTxFn) Context {
 	return ctx
 }
 
+// WithPayloadDecoders returns the context with the provided payload decoders.
+func (ctx Context) WithPayloadDecoders(decoders *payload.Registry) Context {
+	ctx.PayloadDecoders = decoders
+	return ctx
+}
+
 // WithAddressCodec returns the context with the provided address codec.
 func (ctx Context) WithAddressCodec(addressCodec address.Codec) Context {
 	ctx.AddressCodec = addressCodec
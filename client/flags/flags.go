@@ -140,7 +140,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Bool(FlagUnordered, false, "Enable unordered transaction delivery; must be used in conjunction with --timeout-timestamp")
 	f.String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer")
 	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
-	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
+	f.String(FlagTip, "", "Tip is the amount that is going to be transferred from the signer to the fee payer on the target chain. The tx must be signed with direct or textual sign mode, and the tip is ignored if the target chain didn't enable the TipDecorator")
 	f.Bool(FlagAux, false, "Generate aux signer data instead of sending a tx")
 	f.String(FlagChainID, "", "The network chain ID")
 	// --gas can accept integers and "auto"
@@ -0,0 +1,109 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	gogoany "github.com/cosmos/gogoproto/types/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxQueries is the maximum number of queries in a batch.
+const MaxQueries = 100
+
+// QueryRouter routes the queries of a batch to their handlers.
+type QueryRouter interface {
+	// Route returns the handler of the given query method, or nil if not found.
+	Route(path string) func(ctx sdk.Context, req *abci.QueryRequest) (*abci.QueryResponse, error)
+	// RequestNameByRoute returns the full name of the request type of the
+	// given query method.
+	RequestNameByRoute(path string) string
+	// ResponseNameByRequestName returns the full name of the response type of
+	// the query methods having the given request type.
+	ResponseNameByRequestName(requestName string) string
+}
+
+type batchQueryServiceServer struct {
+	router QueryRouter
+}
+
+// NewBatchQueryServiceServer creates a new batchQueryServiceServer executing
+// the queries with the given router.
+func NewBatchQueryServiceServer(router QueryRouter) BatchQueryServiceServer {
+	return &batchQueryServiceServer{router: router}
+}
+
+var _ BatchQueryServiceServer = (*batchQueryServiceServer)(nil)
+
+// batchQueryMethod is the full method name of the BatchQuery RPC, batches
+// can't be nested.
+var batchQueryMethod = fmt.Sprintf("/%s/BatchQuery", _BatchQueryService_serviceDesc.ServiceName)
+
+// BatchQuery implements the BatchQuery method of the BatchQueryServiceServer
+// interface. All the queries are executed with the same context, hence against
+// the same state.
+func (s batchQueryServiceServer) BatchQuery(ctx context.Context, req *BatchQueryRequest) (*BatchQueryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Queries) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty batch")
+	}
+
+	if len(req.Queries) > MaxQueries {
+		return nil, status.Errorf(codes.InvalidArgument, "too many queries: %d > %d", len(req.Queries), MaxQueries)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	responses := make([]*gogoany.Any, len(req.Queries))
+	for i, query := range req.Queries {
+		res, err := s.query(sdkCtx, query)
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "query %d (%s): %s", i, query.Method, status.Convert(err).Message())
+		}
+
+		responses[i] = res
+	}
+
+	return &BatchQueryResponse{Height: sdkCtx.BlockHeight(), Responses: responses}, nil
+}
+
+func (s batchQueryServiceServer) query(ctx sdk.Context, query *Query) (*gogoany.Any, error) {
+	if query.Method == batchQueryMethod {
+		return nil, status.Error(codes.InvalidArgument, "batches can't be nested")
+	}
+
+	handler := s.router.Route(query.Method)
+	if handler == nil {
+		return nil, status.Error(codes.NotFound, "unknown query method")
+	}
+
+	if query.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty query request")
+	}
+
+	requestName := s.router.RequestNameByRoute(query.Method)
+	if typeName := query.Request.TypeUrl[strings.LastIndex(query.Request.TypeUrl, "/")+1:]; typeName != requestName {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request type %s, expected %s", typeName, requestName)
+	}
+
+	res, err := handler(ctx, &abci.QueryRequest{
+		Data:   query.Request.Value,
+		Path:   query.Method,
+		Height: ctx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gogoany.Any{
+		TypeUrl: "/" + s.router.ResponseNameByRequestName(requestName),
+		Value:   res.Value,
+	}, nil
+}
@@ -0,0 +1,886 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/batch/v1beta1/batch.proto
+
+package batch
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BatchQueryRequest is the request type of the BatchQuery RPC.
+type BatchQueryRequest struct {
+	// queries are the queries to execute, in order.
+	Queries []*Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (m *BatchQueryRequest) Reset()         { *m = BatchQueryRequest{} }
+func (m *BatchQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchQueryRequest) ProtoMessage()    {}
+func (*BatchQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed64df553280aef, []int{0}
+}
+func (m *BatchQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueryRequest.Merge(m, src)
+}
+func (m *BatchQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchQueryRequest proto.InternalMessageInfo
+
+func (m *BatchQueryRequest) GetQueries() []*Query {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+// Query is a query of a batch.
+type Query struct {
+	// method is the fully-qualified name of the query method, e.g.
+	// "/cosmos.bank.v1beta1.Query/Balance".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// request is the request of the query, it must be of the request type of the
+	// method.
+	Request *any.Any `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *Query) Reset()         { *m = Query{} }
+func (m *Query) String() string { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()    {}
+func (*Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed64df553280aef, []int{1}
+}
+func (m *Query) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(m, src)
+}
+func (m *Query) XXX_Size() int {
+	return m.Size()
+}
+func (m *Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_Query.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Query proto.InternalMessageInfo
+
+func (m *Query) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Query) GetRequest() *any.Any {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+// BatchQueryResponse is the response type of the BatchQuery RPC.
+type BatchQueryResponse struct {
+	// height is the height at which the queries were executed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// responses are the responses of the queries, in the order of the queries.
+	Responses []*any.Any `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *BatchQueryResponse) Reset()         { *m = BatchQueryResponse{} }
+func (m *BatchQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchQueryResponse) ProtoMessage()    {}
+func (*BatchQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ed64df553280aef, []int{2}
+}
+func (m *BatchQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueryResponse.Merge(m, src)
+}
+func (m *BatchQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchQueryResponse proto.InternalMessageInfo
+
+func (m *BatchQueryResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BatchQueryResponse) GetResponses() []*any.Any {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BatchQueryRequest)(nil), "cosmos.base.batch.v1beta1.BatchQueryRequest")
+	proto.RegisterType((*Query)(nil), "cosmos.base.batch.v1beta1.Query")
+	proto.RegisterType((*BatchQueryResponse)(nil), "cosmos.base.batch.v1beta1.BatchQueryResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/batch/v1beta1/batch.proto", fileDescriptor_7ed64df553280aef)
+}
+
+var fileDescriptor_7ed64df553280aef = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
+	0x18, 0x86, 0x39, 0x88, 0x10, 0x8e, 0xc1, 0x78, 0x1a, 0x53, 0x18, 0x9a, 0xa6, 0x89, 0x09, 0x83,
+	0xdc, 0x49, 0x89, 0x8b, 0x9b, 0x4c, 0x6e, 0xc4, 0xba, 0xb9, 0x68, 0x5b, 0x3e, 0xdb, 0x46, 0xe8,
+	0xc1, 0xdd, 0x95, 0x84, 0xc5, 0xdf, 0x60, 0xe2, 0x5f, 0xf1, 0x47, 0x18, 0x27, 0x46, 0x47, 0x03,
+	0x7f, 0xc4, 0xd0, 0x3b, 0x02, 0x89, 0xc1, 0x38, 0xbe, 0x5f, 0x9f, 0xf7, 0xfd, 0xde, 0x7e, 0x2d,
+	0x3e, 0x8b, 0xb8, 0x1c, 0x73, 0xc9, 0xc2, 0x40, 0x02, 0x0b, 0x03, 0x15, 0x25, 0x6c, 0xd6, 0x0d,
+	0x41, 0x05, 0x5d, 0xad, 0xe8, 0x44, 0x70, 0xc5, 0x49, 0x53, 0x63, 0x74, 0x8d, 0x51, 0xfd, 0xc0,
+	0x60, 0xad, 0x66, 0xcc, 0x79, 0x3c, 0x02, 0x56, 0x80, 0x61, 0xfe, 0xc4, 0x82, 0x6c, 0xae, 0x5d,
+	0x2d, 0xe3, 0x7a, 0x28, 0x14, 0x33, 0x11, 0x85, 0x70, 0x07, 0xf8, 0xa8, 0xbf, 0x8e, 0xb9, 0xcd,
+	0x41, 0xcc, 0x7d, 0x98, 0xe6, 0x20, 0x15, 0xb9, 0xc2, 0xb5, 0x69, 0x0e, 0x22, 0x05, 0x69, 0x21,
+	0xa7, 0xd2, 0x6e, 0x78, 0x0e, 0xdd, 0xbb, 0x97, 0x6a, 0xe7, 0xc6, 0xe0, 0x0e, 0xf0, 0x41, 0x31,
+	0x21, 0xa7, 0xb8, 0x3a, 0x06, 0x95, 0xf0, 0xa1, 0x85, 0x1c, 0xd4, 0xae, 0xfb, 0x46, 0x11, 0x8a,
+	0x6b, 0x42, 0xef, 0xb1, 0xca, 0x0e, 0x6a, 0x37, 0xbc, 0x13, 0xaa, 0x9b, 0xd3, 0x4d, 0x73, 0x7a,
+	0x9d, 0xcd, 0xfd, 0x0d, 0xe4, 0x3e, 0x62, 0xb2, 0xdb, 0x50, 0x4e, 0x78, 0x26, 0x61, 0x9d, 0x9e,
+	0x40, 0x1a, 0x27, 0xaa, 0x48, 0xaf, 0xf8, 0x46, 0x11, 0x0f, 0xd7, 0x85, 0x61, 0xa4, 0x55, 0x76,
+	0x2a, 0x7b, 0xf3, 0xb7, 0x98, 0xf7, 0x86, 0x76, 0x8f, 0x70, 0x07, 0x62, 0x96, 0x46, 0x40, 0x5e,
+	0x30, 0xde, 0x0e, 0xc9, 0xf9, 0x1f, 0x17, 0xf8, 0x75, 0xc0, 0x56, 0xe7, 0x9f, 0xb4, 0x6e, 0xe0,
+	0x1e, 0x7f, 0xbe, 0x77, 0x0e, 0xb5, 0xa3, 0x23, 0x87, 0xcf, 0xce, 0x05, 0xbd, 0xec, 0xf5, 0x6f,
+	0x3e, 0x96, 0x36, 0x5a, 0x2c, 0x6d, 0xf4, 0xbd, 0xb4, 0xd1, 0xeb, 0xca, 0x2e, 0x2d, 0x56, 0x76,
+	0xe9, 0x6b, 0x65, 0x97, 0xee, 0x69, 0x9c, 0xaa, 0x24, 0x0f, 0x69, 0xc4, 0xc7, 0xe6, 0x63, 0xb2,
+	0xad, 0x99, 0x45, 0xa3, 0x14, 0x32, 0xc5, 0x62, 0x31, 0x89, 0xf4, 0xaf, 0x13, 0x56, 0x8b, 0x17,
+	0xef, 0xfd, 0x0c, 0x00, 0xb1, 0xd6, 0x99, 0x72, 0x64, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BatchQueryServiceClient is the client API for BatchQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BatchQueryServiceClient interface {
+	// BatchQuery executes a batch of queries of any query services, at the same
+	// height. The batch fails if any of its queries fails.
+	BatchQuery(ctx context.Context, in *BatchQueryRequest, opts ...grpc.CallOption) (*BatchQueryResponse, error)
+}
+
+type batchQueryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBatchQueryServiceClient(cc grpc1.ClientConn) BatchQueryServiceClient {
+	return &batchQueryServiceClient{cc}
+}
+
+func (c *batchQueryServiceClient) BatchQuery(ctx context.Context, in *BatchQueryRequest, opts ...grpc.CallOption) (*BatchQueryResponse, error) {
+	out := new(BatchQueryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.batch.v1beta1.BatchQueryService/BatchQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchQueryServiceServer is the server API for BatchQueryService service.
+type BatchQueryServiceServer interface {
+	// BatchQuery executes a batch of queries of any query services, at the same
+	// height. The batch fails if any of its queries fails.
+	BatchQuery(context.Context, *BatchQueryRequest) (*BatchQueryResponse, error)
+}
+
+// UnimplementedBatchQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBatchQueryServiceServer struct {
+}
+
+func (*UnimplementedBatchQueryServiceServer) BatchQuery(ctx context.Context, req *BatchQueryRequest) (*BatchQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchQuery not implemented")
+}
+
+func RegisterBatchQueryServiceServer(s grpc1.Server, srv BatchQueryServiceServer) {
+	s.RegisterService(&_BatchQueryService_serviceDesc, srv)
+}
+
+func _BatchQueryService_BatchQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchQueryServiceServer).BatchQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.batch.v1beta1.BatchQueryService/BatchQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchQueryServiceServer).BatchQuery(ctx, req.(*BatchQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BatchQueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.batch.v1beta1.BatchQueryService",
+	HandlerType: (*BatchQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchQuery",
+			Handler:    _BatchQueryService_BatchQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/batch/v1beta1/batch.proto",
+}
+
+func (m *BatchQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Query) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBatch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BatchQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Query) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBatch(uint64(m.Height))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatch(x uint64) (n int) {
+	return sovBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BatchQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, &Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &any.Any{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &any.Any{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBatch = fmt.Errorf("proto: unexpected end of group")
+)
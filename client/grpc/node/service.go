@@ -2,6 +2,7 @@ package node
 
 import (
 	"context"
+	"sort"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -11,9 +12,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// HealthChecker returns the health of the application modules keyed by module
+// name, a nil error meaning the module is healthy.
+type HealthChecker func(ctx context.Context) map[string]error
+
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
+// The health checker, typically the module manager HealthCheck method, may be nil.
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config, healthChecker HealthChecker) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg, healthChecker))
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -25,14 +31,16 @@ func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.Serv
 var _ ServiceServer = queryServer{}
 
 type queryServer struct {
-	clientCtx client.Context
-	cfg       config.Config
+	clientCtx     client.Context
+	cfg           config.Config
+	healthChecker HealthChecker
 }
 
-func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
+func NewQueryServer(clientCtx client.Context, cfg config.Config, healthChecker HealthChecker) ServiceServer {
 	return queryServer{
-		clientCtx: clientCtx,
-		cfg:       cfg,
+		clientCtx:     clientCtx,
+		cfg:           cfg,
+		healthChecker: healthChecker,
 	}
 }
 
@@ -63,3 +71,24 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+func (s queryServer) Health(ctx context.Context, _ *HealthRequest) (*HealthResponse, error) {
+	resp := &HealthResponse{Healthy: true}
+	if s.healthChecker == nil {
+		return resp, nil
+	}
+
+	for module, err := range s.healthChecker(ctx) {
+		health := &ModuleHealth{Module: module, Healthy: err == nil}
+		if err != nil {
+			health.Error = err.Error()
+			resp.Healthy = false
+		}
+		resp.Modules = append(resp.Modules, health)
+	}
+	sort.Slice(resp.Modules, func(i, j int) bool {
+		return resp.Modules[i].Module < resp.Modules[j].Module
+	})
+
+	return resp, nil
+}
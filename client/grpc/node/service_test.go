@@ -1,6 +1,8 @@
 package node
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -15,7 +17,7 @@ func TestServiceServer_Config(t *testing.T) {
 	defaultCfg.PruningKeepRecent = "2000"
 	defaultCfg.PruningInterval = "10"
 	defaultCfg.HaltHeight = 100
-	svr := NewQueryServer(client.Context{}, *defaultCfg)
+	svr := NewQueryServer(client.Context{}, *defaultCfg, nil)
 	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)))
 
 	resp, err := svr.Config(ctx, &ConfigRequest{})
@@ -26,3 +28,25 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, defaultCfg.PruningInterval, resp.PruningInterval)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+func TestServiceServer_Health(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), nil)
+	resp, err := svr.Health(context.Background(), &HealthRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Healthy)
+	require.Empty(t, resp.Modules)
+
+	svr = NewQueryServer(client.Context{}, *config.DefaultConfig(), func(context.Context) map[string]error {
+		return map[string]error{
+			"group": nil,
+			"bank":  errors.New("supply mismatch"),
+		}
+	})
+	resp, err = svr.Health(context.Background(), &HealthRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.Healthy)
+	require.Equal(t, []*ModuleHealth{
+		{Module: "bank", Healthy: false, Error: "supply mismatch"},
+		{Module: "group", Healthy: true},
+	}, resp.Modules)
+}
@@ -420,7 +420,9 @@ func printCreate(ctx client.Context, cmd *cobra.Command, k *keyring.Record, show
 			return err
 		}
 
-		cmd.Println(string(jsonString))
+		if _, err := fmt.Fprintln(cmd.OutOrStdout(), string(jsonString)); err != nil {
+			return err
+		}
 
 	default:
 		return fmt.Errorf("invalid output format %s", outputFormat)
@@ -43,11 +43,12 @@ func runListCmd(cmd *cobra.Command, _ []string) error {
 		return printKeyringRecords(clientCtx, cmd.OutOrStdout(), records, clientCtx.OutputFormat)
 	}
 
-	for _, k := range records {
-		cmd.Println(k.Name)
+	names := make([]string, len(records))
+	for i, k := range records {
+		names[i] = k.Name
 	}
 
-	return nil
+	return printList(cmd.OutOrStdout(), names, clientCtx.OutputFormat)
 }
 
 // ListKeyTypesCmd lists all key types.
@@ -62,8 +63,17 @@ func ListKeyTypesCmd() *cobra.Command {
 				return err
 			}
 
-			cmd.Println("Supported key types/algos:")
 			keyring, _ := clientCtx.Keyring.SupportedAlgorithms()
+			if clientCtx.OutputFormat == flags.OutputFormatJSON {
+				algos := make([]string, len(keyring))
+				for i, algo := range keyring {
+					algos[i] = string(algo.Name())
+				}
+
+				return printList(cmd.OutOrStdout(), algos, clientCtx.OutputFormat)
+			}
+
+			cmd.Println("Supported key types/algos:")
 			cmd.Printf("%+q\n", keyring)
 			return nil
 		},
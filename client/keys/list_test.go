@@ -108,3 +108,32 @@ func Test_runListKeyTypeCmd(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Assert(t, strings.Contains(out.String(), string(hd.Secp256k1Type)))
 }
+
+func Test_runListCmdJSONOutput(t *testing.T) {
+	cmd := ListKeysCmd()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+
+	kbHome := t.TempDir()
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	assert.NilError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos"))
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON)})
+	assert.NilError(t, err)
+	assert.Equal(t, "[]", strings.TrimSpace(out.String()))
+
+	_, err = kb.NewAccount("something", testdata.TestMnemonic, "", "", hd.Secp256k1)
+	assert.NilError(t, err)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{
+		fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON),
+		fmt.Sprintf("--%s=true", flagListNames),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, `["something"]`, strings.TrimSpace(out.String()))
+}
@@ -73,6 +73,28 @@ func printTextRecords(w io.Writer, kos []KeyOutput) error {
 	return nil
 }
 
+// printList prints the items one per line in text format, or as a JSON array
+// in JSON format.
+func printList(w io.Writer, items []string, output string) error {
+	if output == flags.OutputFormatJSON {
+		out, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	}
+
+	for _, item := range items {
+		if _, err := fmt.Fprintln(w, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // printDiscreetly Print a secret string to an alternate screen, so the string isn't printed to the terminal.
 func printDiscreetly(clientCtx client.Context, w io.Writer, promptMsg, secretMsg string) error {
 	output := termenv.NewOutput(w)
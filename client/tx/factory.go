@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -45,6 +46,7 @@ type Factory struct {
 	fees               sdk.Coins
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
+	tip                *txtypes.Tip
 	gasPrices          sdk.DecCoins
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
@@ -122,6 +124,18 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasPricesStr := clientCtx.Viper.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	if tipStr := clientCtx.Viper.GetString(flags.FlagTip); tipStr != "" {
+		tipAmount, err := sdk.ParseCoinsNormalized(tipStr)
+		if err != nil {
+			return Factory{}, fmt.Errorf("invalid tip: %w", err)
+		}
+		tipper, err := clientCtx.AddressCodec.BytesToString(clientCtx.FromAddress)
+		if err != nil {
+			return Factory{}, fmt.Errorf("invalid tipper: %w", err)
+		}
+		f = f.WithTip(&txtypes.Tip{Amount: tipAmount, Tipper: tipper})
+	}
+
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
 	return f, nil
@@ -141,6 +155,7 @@ func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHe
 func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
 func (f Factory) Unordered() bool                           { return f.unordered }
 func (f Factory) FromName() string                          { return f.fromName }
+func (f Factory) Tip() *txtypes.Tip                         { return f.tip }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -277,6 +292,12 @@ func (f Factory) WithFeePayer(fp sdk.AccAddress) Factory {
 	return f
 }
 
+// WithTip returns a copy of the Factory with an updated tip.
+func (f Factory) WithTip(tip *txtypes.Tip) Factory {
+	f.tip = tip
+	return f
+}
+
 // WithPreprocessTxHook returns a copy of the Factory with an updated preprocess tx function,
 // allows for preprocessing of transaction data using the TxBuilder.
 func (f Factory) WithPreprocessTxHook(preprocessFn client.PreprocessTxFn) Factory {
@@ -378,6 +399,14 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		etx.SetExtensionOptions(f.extOptions...)
 	}
 
+	if f.tip != nil {
+		ttx, ok := tx.(client.TipTxBuilder)
+		if !ok {
+			return nil, errors.New("tx builder does not support tips")
+		}
+		ttx.SetTip(f.tip)
+	}
+
 	return tx, nil
 }
 
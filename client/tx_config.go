@@ -56,6 +56,12 @@ type (
 		AddAuxSignerData(tx.AuxSignerData) error
 	}
 
+	// TipTxBuilder extends the TxBuilder interface,
+	// which is used to set the tip paid by the tipper to the fee payer.
+	TipTxBuilder interface {
+		SetTip(tip *tx.Tip)
+	}
+
 	// ExtendedTxBuilder extends the TxBuilder interface,
 	// which is used to set extension options to be included in a transaction.
 	ExtendedTxBuilder interface {
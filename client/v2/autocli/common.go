@@ -242,13 +242,17 @@ func (b *Builder) outOrStdoutFormat(cmd *cobra.Command, out []byte) error {
 	outputType := clientCtx.OutputFormat
 	// if the output type is text, convert the json to yaml
 	// if output type is json or nil, default to json
-	if outputType == flags.OutputFormatText {
+	switch outputType {
+	case flags.OutputFormatText:
 		out, err = yaml.JSONToYAML(out)
 		if err != nil {
 			return err
 		}
+	case "", flags.OutputFormatJSON:
+	default:
+		return fmt.Errorf("invalid output format %q, expected %s or %s", outputType, flags.OutputFormatText, flags.OutputFormatJSON)
 	}
 
-	cmd.Println(strings.TrimSpace(string(out)))
-	return nil
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), strings.TrimSpace(string(out)))
+	return err
 }
@@ -619,6 +619,13 @@ func TestOutputFormat(t *testing.T) {
 	)
 	assert.NilError(t, err)
 	assert.Assert(t, strings.Contains(out.String(), "  positional1: 1"))
+
+	_, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--output", "yaml",
+	)
+	assert.ErrorContains(t, err, "invalid output format \"yaml\"")
 }
 
 func TestHelpQuery(t *testing.T) {
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// inFlightTracker keeps track of the requests being served by the API server,
+// so that they can be drained on shutdown.
+type inFlightTracker struct {
+	mtx      sync.Mutex
+	count    int
+	draining bool
+	idle     chan struct{}
+}
+
+// acquire registers a new in-flight request. It returns false if the server
+// is draining and the request must be rejected.
+func (t *inFlightTracker) acquire() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.draining {
+		return false
+	}
+
+	t.count++
+	return true
+}
+
+// release unregisters an in-flight request.
+func (t *inFlightTracker) release() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.count--
+	if t.draining && t.count == 0 {
+		close(t.idle)
+	}
+}
+
+// drain rejects new requests and waits for the in-flight ones to complete.
+// It returns false if they were not completed within the timeout.
+// A zero timeout waits indefinitely.
+func (t *inFlightTracker) drain(timeout time.Duration) bool {
+	t.mtx.Lock()
+	if !t.draining {
+		t.draining = true
+		if t.count > 0 {
+			t.idle = make(chan struct{})
+		}
+	}
+
+	if t.count == 0 {
+		t.mtx.Unlock()
+		return true
+	}
+
+	idle := t.idle
+	t.mtx.Unlock()
+
+	if timeout == 0 {
+		<-idle
+		return true
+	}
+
+	select {
+	case <-idle:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// handler wraps h so that its requests are tracked, and rejected with a
+// 503 Service Unavailable status once the server is draining.
+func (t *inFlightTracker) handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !t.acquire() {
+			w.Header().Set("Connection", "close")
+			http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer t.release()
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInFlightTrackerDrain(t *testing.T) {
+	var tracker inFlightTracker
+
+	started, release := make(chan struct{}), make(chan struct{})
+	handler := tracker.handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	inFlight := httptest.NewRecorder()
+	served := make(chan struct{})
+	go func() {
+		handler.ServeHTTP(inFlight, httptest.NewRequest(http.MethodGet, "/", nil))
+		close(served)
+	}()
+	<-started
+
+	// the in-flight request is not completed within the timeout
+	require.False(t, tracker.drain(10*time.Millisecond))
+
+	// new requests are rejected while draining
+	rejected := httptest.NewRecorder()
+	handler.ServeHTTP(rejected, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rejected.Code)
+
+	// the in-flight request completes while draining
+	drained := make(chan bool)
+	go func() { drained <- tracker.drain(0) }()
+	close(release)
+	require.True(t, <-drained)
+	<-served
+	require.Equal(t, http.StatusOK, inFlight.Code)
+
+	// draining an idle server returns immediately
+	require.True(t, tracker.drain(time.Millisecond))
+}
@@ -41,6 +41,8 @@ type Server struct {
 	// this mutex to avoid data races.
 	mtx      sync.Mutex
 	listener net.Listener
+
+	inFlight inFlightTracker
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -124,11 +126,12 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	go func(enableUnsafeCORS bool) {
 		s.logger.Info("starting API server...", "address", cfg.API.Address)
 
+		handler := s.inFlight.handler(s.Router)
 		if enableUnsafeCORS {
 			allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(s.Router), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, allowAllCORS(handler), servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		} else {
-			errCh <- tmrpcserver.Serve(s.listener, s.Router, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
+			errCh <- tmrpcserver.Serve(s.listener, handler, servercmtlog.CometLoggerWrapper{Logger: s.logger}, cmtCfg)
 		}
 	}(cfg.API.EnableUnsafeCORS)
 
@@ -137,9 +140,17 @@ func (s *Server) Start(ctx context.Context, cfg config.Config) error {
 	select {
 	case <-ctx.Done():
 		// The calling process canceled or closed the provided context, so we must
-		// gracefully stop the API server.
+		// gracefully stop the API server: stop accepting new connections, then
+		// wait for the in-flight requests to complete.
 		s.logger.Info("stopping API server...", "address", cfg.API.Address)
-		return s.Close()
+		err := s.Close()
+
+		timeout := time.Duration(cfg.API.ShutdownTimeout) * time.Second
+		if !s.inFlight.drain(timeout) {
+			s.logger.Info("API server shutdown timeout reached, dropping in-flight requests", "timeout", timeout)
+		}
+
+		return err
 
 	case err := <-errCh:
 		s.logger.Error("failed to start API server", "err", err)
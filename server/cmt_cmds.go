@@ -52,12 +52,8 @@ func StatusCommand() *cobra.Command {
 				return err
 			}
 
-			// In order to maintain backwards compatibility, the default json format output
-			outputFormat, _ := cmd.Flags().GetString(flags.FlagOutput)
-			if outputFormat == flags.OutputFormatJSON {
-				clientCtx = clientCtx.WithOutputFormat(flags.OutputFormatJSON)
-			}
-
+			// the output format follows the --output flag, or the client config
+			// if the flag is not set, as for any other query command
 			return clientCtx.PrintRaw(output)
 		},
 	}
@@ -29,6 +29,10 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// DefaultShutdownTimeout defines the default duration (in seconds) the gRPC
+	// and API servers wait for in-flight requests to complete on shutdown.
+	DefaultShutdownTimeout = 10
 )
 
 // BaseConfig defines the server's basic configuration
@@ -42,9 +46,10 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
-	Pruning           string `mapstructure:"pruning"`
-	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
-	PruningInterval   string `mapstructure:"pruning-interval"`
+	Pruning            string `mapstructure:"pruning"`
+	PruningKeepRecent  string `mapstructure:"pruning-keep-recent"`
+	PruningInterval    string `mapstructure:"pruning-interval"`
+	PruningMaxInterval string `mapstructure:"pruning-max-interval"`
 
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
@@ -119,6 +124,10 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the CometBFT maximum request body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// ShutdownTimeout defines the maximum duration (in seconds) to wait for
+	// in-flight requests to complete on shutdown. 0 waits indefinitely.
+	ShutdownTimeout uint `mapstructure:"shutdown-timeout"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -139,6 +148,10 @@ type GRPCConfig struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// ShutdownTimeout defines the maximum duration (in seconds) to wait for
+	// in-flight RPCs to complete on shutdown. 0 waits indefinitely.
+	ShutdownTimeout uint `mapstructure:"shutdown-timeout"`
 }
 
 // StateSyncConfig defines the state sync snapshot configuration.
@@ -218,6 +231,7 @@ func DefaultConfig() *Config {
 			Pruning:             pruningtypes.PruningOptionDefault,
 			PruningKeepRecent:   "0",
 			PruningInterval:     "0",
+			PruningMaxInterval:  "0",
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
@@ -235,12 +249,14 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			ShutdownTimeout:    DefaultShutdownTimeout,
 		},
 		GRPC: GRPCConfig{
-			Enable:         true,
-			Address:        DefaultGRPCAddress,
-			MaxRecvMsgSize: DefaultGRPCMaxRecvMsgSize,
-			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
+			Enable:          true,
+			Address:         DefaultGRPCAddress,
+			MaxRecvMsgSize:  DefaultGRPCMaxRecvMsgSize,
+			MaxSendMsgSize:  DefaultGRPCMaxSendMsgSize,
+			ShutdownTimeout: DefaultShutdownTimeout,
 		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
@@ -18,12 +18,17 @@ query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
 # custom: allow pruning options to be manually specified through 'pruning-keep-recent', and 'pruning-interval'
+# adaptive: like custom, but the pruning interval adapts between 'pruning-interval' and 'pruning-max-interval',
+# pruning being deferred while commits are slower than usual and less frequent while pruning is slower than a commit
 pruning = "{{ .BaseConfig.Pruning }}"
 
-# These are applied if and only if the pruning strategy is custom.
+# These are applied if and only if the pruning strategy is custom or adaptive.
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# This is applied if and only if the pruning strategy is adaptive.
+pruning-max-interval = "{{ .BaseConfig.PruningMaxInterval }}"
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -151,6 +156,11 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# ShutdownTimeout defines the maximum duration (in seconds) to wait for in-flight
+# requests to complete on shutdown. New requests are rejected while draining.
+# 0 waits until all in-flight requests are completed.
+shutdown-timeout = {{ .API.ShutdownTimeout }}
+
 ###############################################################################
 ###                           gRPC Configuration                            ###
 ###############################################################################
@@ -171,6 +181,11 @@ max-recv-msg-size = "{{ .GRPC.MaxRecvMsgSize }}"
 # The default value is math.MaxInt32.
 max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 
+# ShutdownTimeout defines the maximum duration (in seconds) to wait for in-flight
+# RPCs to complete on shutdown, after which remaining connections are closed.
+# New RPCs are rejected while draining. 0 waits until all in-flight RPCs are completed.
+shutdown-timeout = {{ .GRPC.ShutdownTimeout }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -238,3 +238,23 @@ func TestAppConfig(t *testing.T) {
 	require.NoError(t, v.Unmarshal(appCfg))
 	require.EqualValues(t, appCfg, defAppConfig)
 }
+
+func TestShutdownTimeoutWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	require.Equal(t, uint(DefaultShutdownTimeout), conf.API.ShutdownTimeout)
+	require.Equal(t, uint(DefaultShutdownTimeout), conf.GRPC.ShutdownTimeout)
+
+	conf.API.ShutdownTimeout = 5
+	conf.GRPC.ShutdownTimeout = 0
+	require.NoError(t, WriteConfigFile(confFile, conf))
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := GetConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, uint(5), cfg.API.ShutdownTimeout)
+	require.Equal(t, uint(0), cfg.GRPC.ShutdownTimeout)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -94,7 +95,7 @@ func StartGRPCServer(ctx context.Context, logger log.Logger, cfg config.GRPCConf
 		// The calling process canceled or closed the provided context, so we must
 		// gracefully stop the gRPC server.
 		logger.Info("stopping gRPC server...", "address", cfg.Address)
-		grpcSrv.GracefulStop()
+		gracefulStop(logger, grpcSrv, time.Duration(cfg.ShutdownTimeout)*time.Second)
 
 		return nil
 
@@ -103,3 +104,27 @@ func StartGRPCServer(ctx context.Context, logger log.Logger, cfg config.GRPCConf
 		return err
 	}
 }
+
+// gracefulStop stops the gRPC server from accepting new connections and RPCs
+// and waits for in-flight RPCs to complete. If they are not completed within the
+// timeout, the remaining connections are closed. A zero timeout waits indefinitely.
+func gracefulStop(logger log.Logger, grpcSrv *grpc.Server, timeout time.Duration) {
+	if timeout == 0 {
+		grpcSrv.GracefulStop()
+		return
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		logger.Info("gRPC server shutdown timeout reached, closing remaining connections", "timeout", timeout)
+		grpcSrv.Stop()
+		<-stopped
+	}
+}
@@ -33,6 +33,19 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 
 		return opts, nil
 
+	case pruningtypes.PruningOptionAdaptive:
+		opts := pruningtypes.NewAdaptivePruningOptions(
+			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
+			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(appOpts.Get(FlagPruningMaxInterval)),
+		)
+
+		if err := opts.Validate(); err != nil {
+			return opts, fmt.Errorf("invalid adaptive pruning options: %w", err)
+		}
+
+		return opts, nil
+
 	default:
 		return pruningtypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
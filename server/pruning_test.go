@@ -37,6 +37,32 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 			},
 			expectedOptions: pruningtypes.NewCustomPruningOptions(1234, 10),
 		},
+		{
+			name: "adaptive pruning options",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionAdaptive)
+				v.Set(FlagPruningKeepRecent, 1234)
+				v.Set(FlagPruningInterval, 10)
+				v.Set(FlagPruningMaxInterval, 100)
+
+				return v
+			},
+			expectedOptions: pruningtypes.NewAdaptivePruningOptions(1234, 10, 100),
+		},
+		{
+			name: "invalid adaptive pruning options",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, pruningtypes.PruningOptionAdaptive)
+				v.Set(FlagPruningKeepRecent, 1234)
+				v.Set(FlagPruningInterval, 100)
+				v.Set(FlagPruningMaxInterval, 10)
+
+				return v
+			},
+			wantErr: true,
+		},
 		{
 			name: pruningtypes.PruningOptionDefault,
 			initParams: func() *viper.Viper {
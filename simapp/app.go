@@ -125,7 +125,7 @@ var (
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:         nil,
-		distrtypes.ModuleName:              nil,
+		distrtypes.ModuleName:              {authtypes.Burner},
 		pooltypes.ModuleName:               nil,
 		pooltypes.StreamAccount:            nil,
 		pooltypes.ProtocolPoolDistrAccount: nil,
@@ -322,6 +322,25 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// optional: enable the balance history index of archival nodes
+	if cast.ToBool(appOpts.Get(bankkeeper.FlagBalanceHistory)) {
+		dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+		historyDB, err := dbm.NewDB("balance_history", server.GetAppDBBackend(appOpts), dataDir)
+		if err != nil {
+			panic(err)
+		}
+
+		balanceHistory, err := app.BankKeeper.EnableBalanceHistory(historyDB, banktypes.StoreKey, cast.ToInt64(appOpts.Get(bankkeeper.FlagBalanceHistoryKeepRecent)))
+		if err != nil {
+			panic(err)
+		}
+
+		bApp.CommitMultiStore().AddListeners([]storetypes.StoreKey{keys[banktypes.StoreKey]})
+		streamingManager := bApp.StreamingManager()
+		streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, balanceHistory)
+		bApp.SetStreamingManager(streamingManager)
+	}
+
 	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
 	enabledSignModes := append(authtx.DefaultSignModes, sigtypes.SignMode_SIGN_MODE_TEXTUAL)
 	txConfigOpts := authtx.ConfigOptions{
@@ -360,10 +379,13 @@ func NewSimApp(
 
 	app.PoolKeeper = poolkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), logger.With(log.ModuleKey, "x/protocolpool")), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
+	// route the configured share of account receipts to the community pool
+	app.BankKeeper.SetHooks(app.PoolKeeper.Hooks())
+
 	app.DistrKeeper = distrkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger.With(log.ModuleKey, "x/distribution")), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, cometService, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), logger.With(log.ModuleKey, "x/slashing")),
-		appCodec, legacyAmino, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		appCodec, legacyAmino, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AuthKeeper.AddressCodec(),
 	)
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[feegrant.StoreKey]), logger.With(log.ModuleKey, "x/feegrant")), appCodec, app.AuthKeeper)
@@ -377,7 +399,10 @@ func NewSimApp(
 	app.CircuitKeeper = circuitkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[circuittypes.StoreKey]), logger.With(log.ModuleKey, "x/circuit")), appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AuthKeeper.AddressCodec())
 	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
 
-	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), logger.With(log.ModuleKey, "x/authz"), runtime.EnvWithMsgRouterService(app.MsgServiceRouter()), runtime.EnvWithQueryRouterService(app.GRPCQueryRouter())), appCodec, app.AuthKeeper)
+	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), logger.With(log.ModuleKey, "x/authz"), runtime.EnvWithMsgRouterService(app.MsgServiceRouter()), runtime.EnvWithQueryRouterService(app.GRPCQueryRouter())), appCodec, app.AuthKeeper, authz.DefaultConfig())
+
+	// register the modules holding account state, so dormant accounts are only pruned once empty
+	app.AuthKeeper.SetPruneGuards(app.BankKeeper, app.StakingKeeper, app.AuthzKeeper, app.FeeGrantKeeper)
 
 	groupConfig := group.DefaultConfig()
 	/*
@@ -469,6 +494,9 @@ func NewSimApp(
 	app.ModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.ModuleManager.RegisterInterfaces(interfaceRegistry)
 
+	// register the modules implementing upgradetypes.PreUpgradeHandler with the upgrade keeper
+	upgrade.PopulatePreUpgradeHandlers(app.UpgradeKeeper, app.ModuleManager.Modules)
+
 	// NOTE: upgrade module is required to be prioritized
 	app.ModuleManager.SetOrderPreBlockers(
 		upgradetypes.ModuleName,
@@ -490,11 +518,16 @@ func NewSimApp(
 	)
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName,
+		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
+		authtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
 		pooltypes.ModuleName,
+		banktypes.ModuleName,
+		// the pre-upgrade handlers run after all the other end blockers
+		upgradetypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -514,6 +547,9 @@ func NewSimApp(
 	// Uncomment if you want to set a custom migration order here.
 	// app.ModuleManager.SetOrderMigrations(custom order)
 
+	// governance can allow skipping the begin and end blocker panics of some modules through x/consensus.
+	app.ModuleManager.SetBlockerPanicSkipper(app.ConsensusParamsKeeper)
+
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	err = app.ModuleManager.RegisterServices(app.configurator)
 	if err != nil {
@@ -634,6 +670,9 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 	// Set the AnteHandler for the app
 	app.SetAnteHandler(anteHandler)
+
+	// accounts can only change to the public keys accepted by the ante handler
+	app.AuthKeeper.SetPubKeyValidator(ante.NewPubKeyValidator(ante.DefaultSigVerificationGasConsumer))
 }
 
 func (app *SimApp) setPostHandler() {
@@ -794,7 +833,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 }
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
-	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg, app.ModuleManager.HealthCheck)
 }
 
 // GetMaccPerms returns a copy of the module account permissions
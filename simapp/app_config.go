@@ -78,7 +78,7 @@ var (
 	// module account permissions
 	moduleAccPerms = []*authmodulev1.ModuleAccountPermission{
 		{Account: authtypes.FeeCollectorName},
-		{Account: distrtypes.ModuleName},
+		{Account: distrtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: pooltypes.ModuleName},
 		{Account: pooltypes.StreamAccount},
 		{Account: pooltypes.ProtocolPoolDistrAccount},
@@ -129,10 +129,15 @@ var (
 					},
 					EndBlockers: []string{
 						govtypes.ModuleName,
+						distrtypes.ModuleName,
 						stakingtypes.ModuleName,
+						authtypes.ModuleName,
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
+						banktypes.ModuleName,
+						// the pre-upgrade handlers run after all the other end blockers
+						upgradetypes.ModuleName,
 					},
 					// The following is mostly only needed when ModuleName != StoreKey name.
 					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
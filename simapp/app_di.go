@@ -209,6 +209,9 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// register the modules holding account state, so dormant accounts are only pruned once empty
+	app.AuthKeeper.SetPruneGuards(app.BankKeeper, app.StakingKeeper, app.AuthzKeeper, app.FeeGrantKeeper)
+
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
 	// already set in the SDK's BaseApp, this shows an example of how to override
@@ -263,6 +266,9 @@ func NewSimApp(
 
 	/****  Module Options ****/
 
+	// governance can allow skipping the begin and end blocker panics of some modules through x/consensus.
+	app.ModuleManager.SetBlockerPanicSkipper(app.ConsensusParamsKeeper)
+
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	app.RegisterUpgradeHandlers()
 
@@ -343,6 +349,9 @@ func (app *SimApp) setCustomAnteHandler() {
 
 	// Set the AnteHandler for the app
 	app.SetAnteHandler(anteHandler)
+
+	// accounts can only change to the public keys accepted by the ante handler
+	app.AuthKeeper.SetPubKeyValidator(ante.NewPubKeyValidator(ante.DefaultSigVerificationGasConsumer))
 }
 
 // Close implements the Application interface and closes all necessary application
@@ -81,10 +81,17 @@ func initAppConfig() (string, interface{}) {
 		CustomField string `mapstructure:"custom-field"`
 	}
 
+	// BankConfig defines the app.toml settings of the x/bank balance history.
+	type BankConfig struct {
+		BalanceHistory           bool  `mapstructure:"balance-history"`
+		BalanceHistoryKeepRecent int64 `mapstructure:"balance-history-keep-recent"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
 
 		Custom CustomConfig `mapstructure:"custom"`
+		Bank   BankConfig   `mapstructure:"bank"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -120,7 +127,16 @@ func initAppConfig() (string, interface{}) {
 [custom]
 # That field will be parsed by server.InterceptConfigsPreRunHandler and held by viper.
 # Do not forget to add quotes around the value if it is a string.
-custom-field = "{{ .Custom.CustomField }}"`
+custom-field = "{{ .Custom.CustomField }}"
+
+[bank]
+# balance-history enables the node-local index of the balances at each height,
+# answering the BalanceHistory query. The first block committed after enabling it
+# indexes the balances of all the accounts, the history starts at its height.
+balance-history = {{ .Bank.BalanceHistory }}
+# balance-history-keep-recent is the number of recent heights the balance
+# history is kept for, 0 keeps the whole history.
+balance-history-keep-recent = {{ .Bank.BalanceHistoryKeepRecent }}`
 
 	return customAppTemplate, customAppConfig
 }
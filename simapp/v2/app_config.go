@@ -74,7 +74,7 @@ var (
 	// module account permissions
 	moduleAccPerms = []*authmodulev1.ModuleAccountPermission{
 		{Account: authtypes.FeeCollectorName},
-		{Account: distrtypes.ModuleName},
+		{Account: distrtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: pooltypes.ModuleName},
 		{Account: pooltypes.StreamAccount},
 		{Account: pooltypes.ProtocolPoolDistrAccount},
@@ -124,10 +124,15 @@ var (
 					},
 					EndBlockers: []string{
 						govtypes.ModuleName,
+						distrtypes.ModuleName,
 						stakingtypes.ModuleName,
+						authtypes.ModuleName,
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
+						banktypes.ModuleName,
+						// the pre-upgrade handlers run after all the other end blockers
+						upgradetypes.ModuleName,
 					},
 					OverrideStoreKeys: []*runtimev2.StoreKeyConfig{
 						{
@@ -183,6 +183,9 @@ func NewSimApp[T transaction.Tx](
 		panic(err)
 	}
 
+	// register the modules holding account state, so dormant accounts are only pruned once empty
+	app.AuthKeeper.SetPruneGuards(app.BankKeeper, app.StakingKeeper, app.AuthzKeeper, app.FeeGrantKeeper)
+
 	var err error
 	app.App, err = appBuilder.Build()
 	if err != nil {
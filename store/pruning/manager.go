@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 
@@ -27,8 +28,33 @@ type Manager struct {
 	// These are the heights that are multiples of snapshotInterval and kept for state sync snapshots.
 	// The heights are added to be pruned when a snapshot is complete.
 	pruneSnapshotHeights []int64
+	// adaptive is the state of the adaptive pruning strategy.
+	adaptive adaptiveState
 }
 
+// adaptiveState tracks the commit and pruning durations the interval of the
+// adaptive pruning strategy is adjusted with.
+type adaptiveState struct {
+	// interval is the current pruning interval, between the Interval and
+	// MaxInterval options.
+	interval uint64
+	// lastPruneHeight is the last height pruning was allowed at.
+	lastPruneHeight int64
+	// avgCommit is the moving average of the commit durations.
+	avgCommit time.Duration
+	// busy is true when the last commit was slower than usual.
+	busy bool
+}
+
+const (
+	// adaptiveBusyFactor is the factor of the average commit duration above
+	// which a commit is considered slow, and pruning is deferred.
+	adaptiveBusyFactor = 2
+	// adaptiveSmoothing is the weight of the last commit duration in the moving
+	// average of the commit durations.
+	adaptiveSmoothing = 0.1
+)
+
 // NegativeHeightsError is returned when a negative height is provided to the manager.
 type NegativeHeightsError struct {
 	Height int64
@@ -58,6 +84,7 @@ func NewManager(db dbm.DB, logger storetypes.Logger) *Manager {
 // SetOptions sets the pruning strategy on the manager.
 func (m *Manager) SetOptions(opts types.PruningOptions) {
 	m.opts = opts
+	m.adaptive = adaptiveState{interval: opts.Interval}
 }
 
 // GetOptions fetches the pruning strategy from the manager.
@@ -108,7 +135,14 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 		return 0
 	}
 
-	if height%int64(m.opts.Interval) != 0 || height <= int64(m.opts.KeepRecent) {
+	if height <= int64(m.opts.KeepRecent) {
+		return 0
+	}
+	if m.opts.GetPruningStrategy() == types.PruningAdaptive {
+		if !m.adaptivePruningDue(height) {
+			return 0
+		}
+	} else if height%int64(m.opts.Interval) != 0 {
 		return 0
 	}
 
@@ -136,6 +170,61 @@ func (m *Manager) GetPruningHeight(height int64) int64 {
 	return pruneHeight
 }
 
+// adaptivePruningDue returns true if the adaptive strategy allows pruning at the
+// given height: the current interval has elapsed since the last pruning, and the
+// node is not busy, unless the maximum interval has elapsed.
+func (m *Manager) adaptivePruningDue(height int64) bool {
+	elapsed := height - m.adaptive.lastPruneHeight
+	if elapsed < int64(m.adaptive.interval) {
+		return false
+	}
+	if m.adaptive.busy && elapsed < int64(m.opts.MaxInterval) {
+		m.logger.Debug("deferring pruning, last commit was slow", "height", height, "avg_commit", m.adaptive.avgCommit)
+		return false
+	}
+
+	m.adaptive.lastPruneHeight = height
+	return true
+}
+
+// ObserveCommit records the duration of a commit for the adaptive strategy: a
+// commit slower than usual defers pruning. It is a no-op for the other strategies.
+func (m *Manager) ObserveCommit(d time.Duration) {
+	if m.opts.GetPruningStrategy() != types.PruningAdaptive {
+		return
+	}
+
+	if m.adaptive.avgCommit == 0 {
+		m.adaptive.avgCommit = d
+		return
+	}
+
+	m.adaptive.busy = d > adaptiveBusyFactor*m.adaptive.avgCommit
+	m.adaptive.avgCommit += time.Duration(adaptiveSmoothing * float64(d-m.adaptive.avgCommit))
+}
+
+// ObservePruning records the duration of a pruning for the adaptive strategy: the
+// interval is doubled, up to the maximum interval, when pruning was slower than
+// the average commit, and halved, down to the minimum interval, otherwise. It is
+// a no-op for the other strategies.
+func (m *Manager) ObservePruning(d time.Duration) {
+	if m.opts.GetPruningStrategy() != types.PruningAdaptive {
+		return
+	}
+
+	interval := m.adaptive.interval
+	if d > m.adaptive.avgCommit {
+		interval = min(2*interval, m.opts.MaxInterval)
+	} else {
+		interval = max(interval/2, m.opts.Interval)
+	}
+
+	if interval != m.adaptive.interval {
+		m.logger.Debug("adjusting pruning interval", "interval", interval, "pruning", d, "avg_commit", m.adaptive.avgCommit)
+		m.adaptive.interval = interval
+	}
+}
+
 // LoadSnapshotHeights loads the snapshot heights from the database as a crash recovery.
 func (m *Manager) LoadSnapshotHeights(db dbm.DB) error {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	db "github.com/cosmos/cosmos-db"
 	"github.com/golang/mock/gomock"
@@ -196,6 +197,46 @@ func TestPruningHeight_Inputs(t *testing.T) {
 	}
 }
 
+func TestAdaptivePruning(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewAdaptivePruningOptions(2, 10, 40))
+
+	manager.ObserveCommit(10 * time.Millisecond)
+	require.Equal(t, int64(0), manager.GetPruningHeight(5))
+	require.Equal(t, int64(7), manager.GetPruningHeight(10))
+
+	// pruning faster than a commit keeps the minimum interval
+	manager.ObservePruning(5 * time.Millisecond)
+	require.Equal(t, int64(0), manager.GetPruningHeight(15))
+
+	// a slow commit defers pruning
+	manager.ObserveCommit(50 * time.Millisecond)
+	require.Equal(t, int64(0), manager.GetPruningHeight(20))
+	manager.ObserveCommit(10 * time.Millisecond)
+	require.Equal(t, int64(18), manager.GetPruningHeight(21))
+
+	// pruning slower than a commit doubles the interval up to the maximum
+	manager.ObservePruning(100 * time.Millisecond)
+	require.Equal(t, int64(0), manager.GetPruningHeight(31))
+	require.Equal(t, int64(38), manager.GetPruningHeight(41))
+	manager.ObservePruning(100 * time.Millisecond)
+	manager.ObservePruning(100 * time.Millisecond)
+	require.Equal(t, int64(0), manager.GetPruningHeight(71))
+
+	// pruning is not deferred past the maximum interval
+	manager.ObserveCommit(time.Second)
+	require.Equal(t, int64(0), manager.GetPruningHeight(80))
+	require.Equal(t, int64(78), manager.GetPruningHeight(81))
+
+	// fast pruning halves the interval down to the minimum
+	manager.ObserveCommit(10 * time.Millisecond)
+	manager.ObservePruning(time.Millisecond)
+	require.Equal(t, int64(98), manager.GetPruningHeight(101))
+	manager.ObservePruning(time.Millisecond)
+	manager.ObservePruning(time.Millisecond)
+	require.Equal(t, int64(108), manager.GetPruningHeight(111))
+}
+
 func TestHandleSnapshotHeight_DbErr_Panic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
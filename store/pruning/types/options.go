@@ -12,8 +12,13 @@ type PruningOptions struct {
 	KeepRecent uint64
 
 	// Interval defines when the pruned heights are removed from disk.
+	// With the adaptive strategy, it is the minimum interval.
 	Interval uint64
 
+	// MaxInterval defines the maximum interval of the adaptive strategy, up to
+	// which pruning is deferred when the node is busy.
+	MaxInterval uint64
+
 	// Strategy defines the kind of pruning strategy. See below for more information on each.
 	Strategy PruningStrategy
 }
@@ -26,6 +31,7 @@ const (
 	PruningOptionEverything = "everything"
 	PruningOptionNothing    = "nothing"
 	PruningOptionCustom     = "custom"
+	PruningOptionAdaptive   = "adaptive"
 )
 
 const (
@@ -45,6 +51,10 @@ const (
 	PruningCustom
 	// PruningUndefined defines an undefined pruning strategy. It is to be returned by stores that do not support pruning.
 	PruningUndefined
+	// PruningAdaptive defines a pruning strategy where the user specifies the heights to keep
+	// and the pruning interval adapts to the commit and pruning durations between a minimum
+	// and a maximum, deferring pruning while the node is busy.
+	PruningAdaptive
 )
 
 const (
@@ -53,9 +63,10 @@ const (
 )
 
 var (
-	ErrPruningIntervalZero       = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
-	ErrPruningIntervalTooSmall   = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
-	ErrPruningKeepRecentTooSmall = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+	ErrPruningIntervalZero        = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
+	ErrPruningIntervalTooSmall    = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
+	ErrPruningMaxIntervalTooSmall = errors.New("'pruning-max-interval' must not be less than 'pruning-interval'")
+	ErrPruningKeepRecentTooSmall  = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
 )
 
 func NewPruningOptions(pruningStrategy PruningStrategy) PruningOptions {
@@ -93,6 +104,15 @@ func NewCustomPruningOptions(keepRecent, interval uint64) PruningOptions {
 	}
 }
 
+func NewAdaptivePruningOptions(keepRecent, minInterval, maxInterval uint64) PruningOptions {
+	return PruningOptions{
+		KeepRecent:  keepRecent,
+		Interval:    minInterval,
+		MaxInterval: maxInterval,
+		Strategy:    PruningAdaptive,
+	}
+}
+
 func (po PruningOptions) GetPruningStrategy() PruningStrategy {
 	return po.Strategy
 }
@@ -110,6 +130,9 @@ func (po PruningOptions) Validate() error {
 	if po.KeepRecent < pruneEverythingKeepRecent {
 		return ErrPruningKeepRecentTooSmall
 	}
+	if po.Strategy == PruningAdaptive && po.MaxInterval < po.Interval {
+		return ErrPruningMaxIntervalTooSmall
+	}
 	return nil
 }
 
@@ -21,6 +21,11 @@ func TestPruningOptions_Validate(t *testing.T) {
 		{NewCustomPruningOptions(2, 9), ErrPruningIntervalTooSmall},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
 		{NewCustomPruningOptions(2, 0), ErrPruningIntervalZero},
+		{NewAdaptivePruningOptions(100, 10, 100), nil},
+		{NewAdaptivePruningOptions(100, 10, 10), nil},
+		{NewAdaptivePruningOptions(100, 10, 9), ErrPruningMaxIntervalTooSmall},
+		{NewAdaptivePruningOptions(100, 9, 100), ErrPruningIntervalTooSmall},
+		{NewAdaptivePruningOptions(1, 10, 100), ErrPruningKeepRecentTooSmall},
 	}
 
 	for _, tc := range testCases {
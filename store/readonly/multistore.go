@@ -0,0 +1,120 @@
+package readonly
+
+import (
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.MultiStore = &MultiStore{}
+
+// MultiStore is an immutable view over the substores of a multistore at a
+// given version.
+// It is safe to share a MultiStore between goroutines: every caller branches
+// it with CacheMultiStore to get its own write cache, which is never written
+// back to the view.
+type MultiStore struct {
+	db      *Store
+	stores  map[types.StoreKey]*Store
+	keys    map[string]types.StoreKey
+	version int64
+}
+
+// NewMultiStore returns a read-only view of the given substores at version.
+func NewMultiStore(db dbm.DB, stores map[types.StoreKey]types.KVStore, keys map[string]types.StoreKey, version int64) *MultiStore {
+	ms := &MultiStore{
+		db:      NewStore(dbadapter.Store{DB: db}),
+		stores:  make(map[types.StoreKey]*Store, len(stores)),
+		keys:    keys,
+		version: version,
+	}
+
+	for key, store := range stores {
+		ms.stores[key] = NewStore(store)
+	}
+
+	return ms
+}
+
+// GetStoreType implements Store.
+func (ms *MultiStore) GetStoreType() types.StoreType {
+	return types.StoreTypeMulti
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms *MultiStore) CacheWrap() types.CacheWrap {
+	return ms.newCacheMultiStore(nil, nil)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (ms *MultiStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return ms.newCacheMultiStore(w, tc)
+}
+
+// CacheMultiStore branches the view. The returned store can be written to,
+// but calling Write on it panics.
+func (ms *MultiStore) CacheMultiStore() types.CacheMultiStore {
+	return ms.newCacheMultiStore(nil, nil)
+}
+
+// CacheMultiStoreWithVersion branches the view. As a view is pinned to a
+// single version, any other version is rejected.
+func (ms *MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if version != ms.version {
+		return nil, fmt.Errorf("read-only multistore is at version %d, got %d", ms.version, version)
+	}
+
+	return ms.CacheMultiStore(), nil
+}
+
+func (ms *MultiStore) newCacheMultiStore(w io.Writer, tc types.TraceContext) cachemulti.Store {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(ms.stores))
+	for key, store := range ms.stores {
+		stores[key] = store
+	}
+
+	return cachemulti.NewFromKVStore(ms.db, stores, ms.keys, w, tc)
+}
+
+// GetStore returns the read-only view of a substore. It panics if the
+// substore does not exist.
+func (ms *MultiStore) GetStore(key types.StoreKey) types.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore returns the read-only view of a substore. It panics if the
+// substore does not exist.
+func (ms *MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
+	}
+
+	return store
+}
+
+// TracingEnabled implements MultiStore. Tracing is only available on the
+// branches of the view, see CacheWrapWithTrace.
+func (ms *MultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements MultiStore. The view is immutable, so this is a no-op.
+func (ms *MultiStore) SetTracer(_ io.Writer) types.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements MultiStore. The view is immutable, so this is a no-op.
+func (ms *MultiStore) SetTracingContext(_ types.TraceContext) types.MultiStore {
+	return ms
+}
+
+// LatestVersion returns the version of the view.
+func (ms *MultiStore) LatestVersion() int64 {
+	return ms.version
+}
@@ -0,0 +1,70 @@
+package readonly
+
+import (
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store is an immutable view over a KVStore.
+// All write operations panic. Reads are delegated to the parent store, so a
+// Store is safe for concurrent use as long as the parent store is not written
+// to, which is the case for a committed IAVL version.
+type Store struct {
+	parent types.KVStore
+}
+
+// NewStore returns a read-only view of the given store.
+func NewStore(parent types.KVStore) *Store {
+	return &Store{parent: parent}
+}
+
+// GetStoreType implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+// Has implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+// Set implements KVStore. It always panics as the store is read-only.
+func (s *Store) Set(_, _ []byte) {
+	panic("cannot call Set on a read-only store")
+}
+
+// Delete implements KVStore. It always panics as the store is read-only.
+func (s *Store) Delete(_ []byte) {
+	panic("cannot call Delete on a read-only store")
+}
+
+// Iterator implements KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
+
+// CacheWrap branches the store. Each branch has its own write cache, which
+// can be discarded but never written back to the read-only store.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
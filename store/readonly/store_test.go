@@ -0,0 +1,83 @@
+package readonly_test
+
+import (
+	"sync"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/readonly"
+	"cosmossdk.io/store/types"
+)
+
+func TestStore(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("key1"), []byte("value1"))
+	parent.Set([]byte("key2"), []byte("value2"))
+
+	store := readonly.NewStore(parent)
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key2")))
+	require.False(t, store.Has([]byte("key3")))
+
+	require.Panics(t, func() { store.Set([]byte("key3"), []byte("value3")) })
+	require.Panics(t, func() { store.Delete([]byte("key1")) })
+
+	iter := store.ReverseIterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("key2"), iter.Key())
+	require.NoError(t, iter.Close())
+
+	// a branch can be written to but not written back
+	branch := store.CacheWrap().(types.CacheKVStore)
+	branch.Set([]byte("key3"), []byte("value3"))
+	require.Equal(t, []byte("value3"), branch.Get([]byte("key3")))
+	require.False(t, store.Has([]byte("key3")))
+	require.Panics(t, branch.Write)
+}
+
+func TestMultiStore(t *testing.T) {
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+	store1 := dbadapter.Store{DB: dbm.NewMemDB()}
+	store1.Set([]byte("key"), []byte("value"))
+
+	ms := readonly.NewMultiStore(
+		dbm.NewMemDB(),
+		map[types.StoreKey]types.KVStore{key1: store1},
+		map[string]types.StoreKey{key1.Name(): key1},
+		5,
+	)
+	require.Equal(t, types.StoreTypeMulti, ms.GetStoreType())
+	require.Equal(t, int64(5), ms.LatestVersion())
+	require.False(t, ms.TracingEnabled())
+	require.Equal(t, []byte("value"), ms.GetKVStore(key1).Get([]byte("key")))
+	require.Panics(t, func() { ms.GetKVStore(key2) })
+	require.Panics(t, func() { ms.GetKVStore(key1).Set([]byte("key"), []byte("other")) })
+
+	_, err := ms.CacheMultiStoreWithVersion(4)
+	require.ErrorContains(t, err, "read-only multistore is at version 5")
+
+	// branches are isolated from each other and from the view
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			cms, err := ms.CacheMultiStoreWithVersion(5)
+			require.NoError(t, err)
+
+			store := cms.GetKVStore(key1)
+			require.Equal(t, []byte("value"), store.Get([]byte("key")))
+			store.Set([]byte("key"), []byte{byte(i)})
+			require.Equal(t, []byte{byte(i)}, store.Get([]byte("key")))
+			require.Panics(t, cms.Write)
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, []byte("value"), ms.GetKVStore(key1).Get([]byte("key")))
+}
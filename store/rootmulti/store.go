@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	dbm "github.com/cosmos/cosmos-db"
@@ -25,6 +26,7 @@ import (
 	"cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/readonly"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/transient"
@@ -76,8 +78,9 @@ type Store struct {
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore           = (*Store)(nil)
+	_ types.Queryable                  = (*Store)(nil)
+	_ types.ReadOnlyMultiStoreProvider = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -488,6 +491,7 @@ func (rs *Store) Commit() types.CommitID {
 		rs.logger.Debug("commit header and version mismatch", "header_height", rs.commitHeader.Height, "version", version)
 	}
 
+	start := time.Now()
 	func() { // ensure unpause
 		// set the committing flag on all stores to block the pruning
 		rs.PausePruning(true)
@@ -495,6 +499,7 @@ func (rs *Store) Commit() types.CommitID {
 		defer rs.PausePruning(false)
 		rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	}()
+	rs.pruningManager.ObserveCommit(time.Since(start))
 
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
@@ -586,7 +591,43 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // any store cannot be loaded. This should only be used for querying and
 // iterating at past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
-	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
+	versionedStores, err := rs.loadVersionedStores(version)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper, len(versionedStores))
+	for key, store := range versionedStores {
+		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
+		// set same listeners on cache store will observe duplicated writes.
+		if rs.ListeningEnabled(key) {
+			store = listenkv.NewStore(store, key, rs.listeners[key])
+		}
+
+		cachedStores[key] = store
+	}
+
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
+}
+
+// ReadOnlyMultiStoreWithVersion returns an immutable view of the multi-store
+// where each IAVL store is loaded at the given version. Contrary to the
+// branches returned by CacheMultiStoreWithVersion, the view can be shared
+// between goroutines, each of them branching it to get its own write cache.
+// Stores which are not versioned (e.g. transient and memory stores) are exposed
+// as is.
+func (rs *Store) ReadOnlyMultiStoreWithVersion(version int64) (types.MultiStore, error) {
+	versionedStores, err := rs.loadVersionedStores(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return readonly.NewMultiStore(rs.db, versionedStores, rs.keysByName, version), nil
+}
+
+// loadVersionedStores loads every IAVL store at the given version.
+func (rs *Store) loadVersionedStores(version int64) (map[types.StoreKey]types.KVStore, error) {
+	versionedStores := make(map[types.StoreKey]types.KVStore, len(rs.stores))
 	var commitInfo *types.CommitInfo
 	storeInfos := map[string]bool{}
 	for key, store := range rs.stores {
@@ -633,16 +674,10 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			cacheStore = store
 		}
 
-		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
-		// set same listeners on cache store will observe duplicated writes.
-		if rs.ListeningEnabled(key) {
-			cacheStore = listenkv.NewStore(cacheStore, key, rs.listeners[key])
-		}
-
-		cachedStores[key] = cacheStore
+		versionedStores[key] = cacheStore
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
+	return versionedStores, nil
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -687,6 +722,10 @@ func (rs *Store) handlePruning(version int64) error {
 	pruneHeight := rs.pruningManager.GetPruningHeight(version)
 	rs.logger.Debug("prune start", "height", version)
 	defer rs.logger.Debug("prune end", "height", version)
+	if pruneHeight > 0 {
+		start := time.Now()
+		defer func() { rs.pruningManager.ObservePruning(time.Since(start)) }()
+	}
 	return rs.PruneStores(pruneHeight)
 }
 
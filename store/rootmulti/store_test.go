@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -120,6 +121,56 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestReadOnlyMultiStoreWithVersion(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	k, v := []byte("wind"), []byte("blows")
+
+	store1 := ms.GetStoreByName("store1").(types.KVStore)
+	store1.Set(k, v)
+
+	cID := ms.Commit()
+	require.Equal(t, int64(1), cID.Version)
+
+	// require an error when given an invalid or pruned version
+	_, err = ms.ReadOnlyMultiStoreWithVersion(cID.Version + 1)
+	require.Error(t, err)
+
+	view, err := ms.ReadOnlyMultiStoreWithVersion(cID.Version)
+	require.NoError(t, err)
+	require.Equal(t, cID.Version, view.LatestVersion())
+
+	// the view is not affected by later writes
+	store1.Set(k, []byte("falls"))
+	ms.Commit()
+
+	kvStore := view.GetKVStore(ms.keysByName["store1"])
+	require.Equal(t, v, kvStore.Get(k))
+	require.Panics(t, func() { kvStore.Set(k, []byte("newValue")) })
+
+	// the view can be branched concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			cms := view.CacheMultiStore()
+			store := cms.GetKVStore(ms.keysByName["store1"])
+			require.Equal(t, v, store.Get(k))
+
+			iter := store.Iterator(nil, nil)
+			defer iter.Close()
+			require.True(t, iter.Valid())
+			require.Equal(t, k, iter.Key())
+		}()
+	}
+	wg.Wait()
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -170,6 +170,15 @@ type CacheMultiStore interface {
 	Write() // Writes operations to underlying KVStore
 }
 
+// ReadOnlyMultiStoreProvider is implemented by the multistores able to provide
+// an immutable view of a committed version. Such a view is safe for concurrent
+// use, e.g. by query handlers running on multiple goroutines.
+type ReadOnlyMultiStoreProvider interface {
+	// ReadOnlyMultiStoreWithVersion returns an immutable view of the
+	// MultiStore where each store is loaded at a specific version (height).
+	ReadOnlyMultiStoreWithVersion(version int64) (MultiStore, error)
+}
+
 // CommitMultiStore is an interface for a MultiStore without cache capabilities.
 type CommitMultiStore interface {
 	Committer
@@ -189,6 +189,16 @@ func (m *Metrics) Gather(format string) (GatherResponse, error) {
 	}
 }
 
+// Shutdown flushes the metrics buffered by the sinks (e.g. statsd) and stops
+// them. Metrics emitted afterwards are discarded.
+func (m *Metrics) Shutdown() {
+	if m == nil {
+		return
+	}
+
+	metrics.Shutdown()
+}
+
 // gatherPrometheus collects Prometheus metrics and returns a GatherResponse.
 // If Prometheus metrics are not enabled, it returns an error.
 func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
@@ -223,3 +223,91 @@ func (s *E2ETestSuite) TestPeriodicLockingAccount() {
 		require.True(t, delFree.AmountOf("stake").Equal(math.NewInt(100)))
 	})
 }
+
+func (s *E2ETestSuite) TestPeriodicLockingAccountClawback() {
+	t := s.T()
+	app := setupApp(t)
+	currentTime := time.Now()
+	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger()).WithHeaderInfo(header.Info{
+		Time: currentTime,
+	})
+	ownerAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(accOwner)
+	require.NoError(t, err)
+	s.fundAccount(app, ctx, accOwner, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000000))})
+	adminAcc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	adminAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(adminAcc)
+	require.NoError(t, err)
+
+	_, accountAddr, err := app.AccountsKeeper.Init(ctx, lockupaccount.PERIODIC_LOCKING_ACCOUNT, accOwner, &types.MsgInitPeriodicLockingAccount{
+		Owner:     ownerAddrStr,
+		Admin:     adminAddrStr,
+		StartTime: currentTime,
+		LockingPeriods: []types.Period{
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(500))),
+				Length: time.Minute,
+			},
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(500))),
+				Length: time.Minute,
+			},
+		},
+	}, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000))})
+	require.NoError(t, err)
+
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	val := vals[0]
+
+	t.Run("error - delegate locked coins of a clawback enabled account", func(t *testing.T) {
+		msg := &types.MsgDelegate{
+			Sender:           ownerAddrStr,
+			ValidatorAddress: val.OperatorAddress,
+			Amount:           sdk.NewCoin("stake", math.NewInt(100)),
+		}
+		err = s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		require.NotNil(t, err)
+	})
+
+	// After first period 500stake should be unlock
+	ctx = ctx.WithHeaderInfo(header.Info{
+		Time: currentTime.Add(time.Minute),
+	})
+
+	t.Run("error - clawback by the owner", func(t *testing.T) {
+		msg := &types.MsgClawback{
+			Admin:     ownerAddrStr,
+			ToAddress: ownerAddrStr,
+			Denoms:    []string{"stake"},
+		}
+		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		require.NotNil(t, err)
+	})
+	t.Run("ok - clawback by the admin", func(t *testing.T) {
+		msg := &types.MsgClawback{
+			Admin:     adminAddrStr,
+			ToAddress: adminAddrStr,
+			Denoms:    []string{"stake"},
+		}
+		err := s.executeTx(ctx, msg, app, accountAddr, adminAcc)
+		require.NoError(t, err)
+
+		balance := app.BankKeeper.GetBalance(ctx, adminAcc, "stake")
+		require.True(t, balance.Amount.Equal(math.NewInt(500)))
+		balance = app.BankKeeper.GetBalance(ctx, accountAddr, "stake")
+		require.True(t, balance.Amount.Equal(math.NewInt(500)))
+
+		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
+		require.Equal(t, adminAddrStr, lockupAccountInfoResponse.Admin)
+		require.True(t, lockupAccountInfoResponse.LockedCoins.AmountOf("stake").IsZero())
+	})
+	t.Run("ok - owner sends the unlocked coins", func(t *testing.T) {
+		msg := &types.MsgSend{
+			Sender:    ownerAddrStr,
+			ToAddress: ownerAddrStr,
+			Amount:    sdk.Coins{sdk.NewCoin("stake", math.NewInt(500))},
+		}
+		err := s.executeTx(ctx, msg, app, accountAddr, accOwner)
+		require.NoError(t, err)
+	})
+}
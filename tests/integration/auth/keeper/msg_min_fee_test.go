@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/accounts"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/auth"
+	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/authz"
+	authzmodule "cosmossdk.io/x/authz/module"
+	"cosmossdk.io/x/bank"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// Test the min fees of the msgs nested in an authz MsgExec or an x/accounts
+// MsgExecute are required, on top of the min fee of the wrapping msg.
+func TestMsgMinFeeNestedMsgs(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, bank.AppModule{}, accounts.AppModule{}, authzmodule.AppModule{})
+	antehandler := sdk.ChainAnteDecorators(ante.NewMsgMinFeeDecorator(f.authKeeper))
+
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+	send := &banktypes.MsgSend{
+		FromAddress: addrs[0].String(),
+		ToAddress:   addrs[1].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+
+	msgExec := authz.NewMsgExec(addrs[1].String(), []sdk.Msg{send})
+	nestedMsgExec := authz.NewMsgExec(addrs[1].String(), []sdk.Msg{&msgExec})
+
+	sendAny, err := codectypes.NewAnyWithValue(send)
+	assert.NilError(t, err)
+	msgExecute := &accountsv1.MsgExecute{
+		Sender:  addrs[0].String(),
+		Target:  addrs[1].String(),
+		Message: sendAny,
+	}
+
+	params := authtypes.DefaultParams()
+	params.MsgMinFees = []authtypes.MsgMinFee{
+		{MsgTypeUrl: sdk.MsgTypeURL(send), MinFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		{MsgTypeUrl: sdk.MsgTypeURL(&msgExec), MinFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+	}
+	assert.NilError(t, f.authKeeper.Params.Set(f.ctx, params))
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		fee    sdk.Coins
+		expErr bool
+	}{
+		{
+			name: "msg exec covering its nested msg",
+			msg:  &msgExec,
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("stake", 110)),
+		},
+		{
+			name:   "msg exec only covering itself",
+			msg:    &msgExec,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			expErr: true,
+		},
+		{
+			name: "nested msg exec covering all its msgs",
+			msg:  &nestedMsgExec,
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("stake", 120)),
+		},
+		{
+			name:   "nested msg exec not covering all its msgs",
+			msg:    &nestedMsgExec,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 110)),
+			expErr: true,
+		},
+		{
+			name: "account execute covering its msg",
+			msg:  msgExecute,
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+		{
+			name:   "account execute not covering its msg",
+			msg:    msgExecute,
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 99)),
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := encodingCfg.TxConfig.NewTxBuilder()
+			assert.NilError(t, txBuilder.SetMsgs(tc.msg))
+			txBuilder.SetFeeAmount(tc.fee)
+
+			_, err := antehandler(f.ctx, txBuilder.GetTx(), false)
+			if tc.expErr {
+				assert.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
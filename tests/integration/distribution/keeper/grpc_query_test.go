@@ -47,6 +47,7 @@ func TestGRPCParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 					WithdrawAddrEnabled: true,
+					FeeBurnPercentage:   math.LegacyNewDecWithPrec(1, 1),
 				}
 
 				assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
@@ -10,6 +10,7 @@ import (
 	"gotest.tools/v3/assert"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/log"
@@ -138,7 +139,7 @@ func initFixture(t *testing.T) *fixture {
 	poolKeeper := poolkeeper.NewKeeper(cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), log.NewNopLogger()), accountKeeper, bankKeeper, stakingKeeper, authority.String())
 
 	distrKeeper := distrkeeper.NewKeeper(
-		cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger), accountKeeper, bankKeeper, stakingKeeper, cometService, distrtypes.ModuleName, authority.String(),
+		cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger, runtime.EnvWithMsgRouterService(msgRouter)), accountKeeper, bankKeeper, stakingKeeper, cometService, distrtypes.ModuleName, authority.String(),
 	)
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts)
@@ -185,6 +186,7 @@ func initFixture(t *testing.T) *fixture {
 
 	// Register MsgServer and QueryServer
 	distrtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), distrkeeper.NewMsgServerImpl(distrKeeper))
+	stakingtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), stakingkeeper.NewMsgServerImpl(stakingKeeper))
 	distrtypes.RegisterQueryServer(integrationApp.QueryHelper(), distrkeeper.NewQuerier(distrKeeper))
 
 	qr := integrationApp.QueryHelper()
@@ -710,6 +712,7 @@ func TestMsgUpdateParams(t *testing.T) {
 				Params: distrtypes.Params{
 					CommunityTax:        math.LegacyNewDecWithPrec(2, 0),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnPercentage:   math.LegacyZeroDec(),
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 				},
@@ -724,6 +727,7 @@ func TestMsgUpdateParams(t *testing.T) {
 				Params: distrtypes.Params{
 					CommunityTax:        math.LegacyDec{},
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnPercentage:   math.LegacyZeroDec(),
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 				},
@@ -738,6 +742,7 @@ func TestMsgUpdateParams(t *testing.T) {
 				Params: distrtypes.Params{
 					CommunityTax:        math.LegacyNewDecWithPrec(2, 0),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnPercentage:   math.LegacyZeroDec(),
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 				},
@@ -752,6 +757,7 @@ func TestMsgUpdateParams(t *testing.T) {
 				Params: distrtypes.Params{
 					CommunityTax:        math.LegacyNewDecWithPrec(-2, 1),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnPercentage:   math.LegacyZeroDec(),
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 				},
@@ -759,6 +765,21 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "community tax must be positive: -0.200000000000000000",
 		},
+		{
+			name: "fee burn percentage > 1",
+			msg: &distrtypes.MsgUpdateParams{
+				Authority: f.distrKeeper.GetAuthority(),
+				Params: distrtypes.Params{
+					CommunityTax:        communityTax,
+					WithdrawAddrEnabled: withdrawAddrEnabled,
+					BaseProposerReward:  math.LegacyZeroDec(),
+					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnPercentage:   math.LegacyNewDecWithPrec(11, 1),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "fee burn percentage too large: 1.100000000000000000",
+		},
 		{
 			name: "base proposer reward set",
 			msg: &distrtypes.MsgUpdateParams{
@@ -768,6 +789,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyNewDecWithPrec(1, 2),
 					BonusProposerReward: math.LegacyZeroDec(),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnPercentage:   math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -782,6 +804,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyNewDecWithPrec(1, 2),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnPercentage:   math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -792,10 +815,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:             communityTax,
+					BaseProposerReward:       math.LegacyZeroDec(),
+					BonusProposerReward:      math.LegacyZeroDec(),
+					WithdrawAddrEnabled:      withdrawAddrEnabled,
+					FeeBurnPercentage:        math.LegacyZeroDec(),
+					MaxAutoCompoundsPerBlock: 100,
 				},
 			},
 			expErr: false,
@@ -1020,3 +1045,249 @@ func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoCompoundRewards(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	params := distrtypes.DefaultParams()
+	params.AutoCompoundFrequency = 5
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+
+	// create a validator with a self-delegation of 100 tokens
+	valAddr := f.valAddr
+	delAddr := sdk.AccAddress(valAddr)
+	initTokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, initTokens))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, delAddr, initTokens))
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, delAddr))
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddr, valConsPk0, math.NewInt(100), true)
+
+	_, err := f.app.RunMsg(distrtypes.NewMsgSetAutoCompound(delAddr.String(), valAddr.String(), true))
+	require.NoError(t, err)
+
+	// allocate 100 tokens of rewards, half of which goes to the delegator
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+	val, err := f.stakingKeeper.Validator(f.sdkCtx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	// the rewards are only compounded every 5 blocks
+	ctx := f.sdkCtx.WithHeaderInfo(header.Info{Height: 4})
+	require.NoError(t, f.distrKeeper.EndBlocker(ctx))
+	delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), delegation.Shares)
+
+	ctx = f.sdkCtx.WithHeaderInfo(header.Info{Height: 5})
+	require.NoError(t, f.distrKeeper.EndBlocker(ctx))
+	delegation, err = f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(150), delegation.Shares)
+
+	// removing the delegation opts it out of auto-compounding
+	_, err = f.stakingKeeper.Unbond(ctx, delAddr, valAddr, delegation.Shares)
+	require.NoError(t, err)
+	enabled, err := f.distrKeeper.IsAutoCompoundDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.False(t, enabled)
+}
+
+func TestAutoCompoundRewardsPerBlockCap(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	params := distrtypes.DefaultParams()
+	params.AutoCompoundFrequency = 5
+	params.MaxAutoCompoundsPerBlock = 2
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+
+	// create a validator with a self-delegation of 100 tokens, and two other
+	// delegations of 100 tokens
+	valAddr := f.valAddr
+	delAddrs := []sdk.AccAddress{sdk.AccAddress(valAddr), sdk.AccAddress("delegator1__________"), sdk.AccAddress("delegator2__________")}
+	initTokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	for _, delAddr := range delAddrs {
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, initTokens))
+		require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, delAddr, initTokens))
+		f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, delAddr))
+	}
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddr, valConsPk0, math.NewInt(100), true)
+	for _, delAddr := range delAddrs[1:] {
+		tstaking.Delegate(delAddr.String(), valAddr.String(), math.NewInt(100))
+	}
+
+	for _, delAddr := range delAddrs {
+		_, err := f.app.RunMsg(distrtypes.NewMsgSetAutoCompound(delAddr.String(), valAddr.String(), true))
+		require.NoError(t, err)
+	}
+
+	// allocate 300 tokens of rewards, half of which goes to the delegators
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(300)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+	val, err := f.stakingKeeper.Validator(f.sdkCtx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	compounded := func(ctx sdk.Context) int {
+		var n int
+		for _, delAddr := range delAddrs {
+			delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+			require.NoError(t, err)
+			if delegation.Shares.Equal(math.LegacyNewDec(150)) {
+				n++
+			} else {
+				require.Equal(t, math.LegacyNewDec(100), delegation.Shares)
+			}
+		}
+		return n
+	}
+
+	// the round starting at height 5 compounds 2 delegations per block
+	ctx := f.sdkCtx.WithHeaderInfo(header.Info{Height: 5})
+	require.NoError(t, f.distrKeeper.EndBlocker(ctx))
+	require.Equal(t, 2, compounded(ctx))
+	inProgress, err := f.distrKeeper.IsAutoCompoundInProgress(ctx)
+	require.NoError(t, err)
+	require.True(t, inProgress)
+
+	// and goes on at the next block, though it is not a compounding height
+	ctx = f.sdkCtx.WithHeaderInfo(header.Info{Height: 6})
+	require.NoError(t, f.distrKeeper.EndBlocker(ctx))
+	require.Equal(t, 3, compounded(ctx))
+	inProgress, err = f.distrKeeper.IsAutoCompoundInProgress(ctx)
+	require.NoError(t, err)
+	require.False(t, inProgress)
+}
+
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+
+	// create two validators with a self-delegation of 100 tokens each
+	valAddr0 := f.valAddr
+	valAddr1 := sdk.ValAddress(PKS[1].Address())
+	delAddr := sdk.AccAddress(valAddr0)
+	initTokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	for _, addr := range []sdk.AccAddress{sdk.AccAddress(valAddr0), sdk.AccAddress(valAddr1)} {
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, initTokens))
+		require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, addr, initTokens))
+		f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, addr))
+	}
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddr0, valConsPk0, math.NewInt(100), true)
+	tstaking.CreateValidator(valAddr1, PKS[1], math.NewInt(100), true)
+
+	// the delegator also delegates 100 tokens to the second validator
+	tstaking.Delegate(delAddr.String(), valAddr1.String(), math.NewInt(100))
+
+	// allocate 100 tokens of rewards to each validator, half of which goes to
+	// the delegators
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	for _, valAddr := range []sdk.ValAddress{valAddr0, valAddr1} {
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+		val, err := f.stakingKeeper.Validator(f.sdkCtx, valAddr)
+		require.NoError(t, err)
+		require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+	}
+
+	_, err := f.app.RunMsg(distrtypes.NewMsgWithdrawAllDelegatorRewards(emptyDelAddr.String()))
+	require.ErrorContains(t, err, "invalid delegator address")
+
+	initBalance := f.bankKeeper.GetAllBalances(f.sdkCtx, delAddr)
+
+	// the delegator receives all its rewards from the first validator and half
+	// of the rewards of the second validator, once the delegations are at least
+	// one block old
+	ctx := f.sdkCtx.WithHeaderInfo(header.Info{Height: f.sdkCtx.HeaderInfo().Height + 1})
+	res, err := distrkeeper.NewMsgServerImpl(f.distrKeeper).WithdrawAllDelegatorRewards(ctx, distrtypes.NewMsgWithdrawAllDelegatorRewards(delAddr.String()))
+	require.NoError(t, err)
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(75)))
+	require.Equal(t, expRewards, res.Amount)
+	require.Equal(t, initBalance.Add(expRewards...), f.bankKeeper.GetAllBalances(ctx, delAddr))
+
+	// only the commissions and the rewards of the second validator operator
+	// remain outstanding
+	for valAddr, expOutstanding := range map[string]int64{valAddr0.String(): 50, valAddr1.String(): 75} {
+		valBz, err := f.stakingKeeper.ValidatorAddressCodec().StringToBytes(valAddr)
+		require.NoError(t, err)
+		outstanding, err := f.distrKeeper.ValidatorOutstandingRewards.Get(ctx, valBz)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, expOutstanding)), outstanding.Rewards)
+	}
+}
+
+func TestCommissionAutoWithdraw(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+
+	// create a validator with a 50% commission
+	valAddr := f.valAddr
+	operatorAddr := sdk.AccAddress(valAddr)
+	initTokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, initTokens))
+	require.NoError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, operatorAddr, initTokens))
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, operatorAddr))
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddr, valConsPk0, math.NewInt(100), true)
+
+	threshold := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	_, err := f.app.RunMsg(distrtypes.NewMsgSetCommissionAutoWithdrawThreshold(valAddr.String(), threshold))
+	require.NoError(t, err)
+
+	allocate := func(amount int64) {
+		rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(amount)))
+		require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+		val, err := f.stakingKeeper.Validator(f.sdkCtx, valAddr)
+		require.NoError(t, err)
+		require.NoError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, val, sdk.NewDecCoinsFromCoins(rewards...)))
+	}
+
+	// the commission is below the threshold
+	allocate(100)
+	balance := f.bankKeeper.GetBalance(f.sdkCtx, operatorAddr, sdk.DefaultBondDenom)
+	require.NoError(t, f.distrKeeper.BeginBlocker(f.sdkCtx))
+	require.Equal(t, balance, f.bankKeeper.GetBalance(f.sdkCtx, operatorAddr, sdk.DefaultBondDenom))
+
+	// the commission reached the threshold and is withdrawn
+	allocate(100)
+	require.NoError(t, f.distrKeeper.BeginBlocker(f.sdkCtx))
+	require.Equal(t, balance.AddAmount(math.NewInt(100)), f.bankKeeper.GetBalance(f.sdkCtx, operatorAddr, sdk.DefaultBondDenom))
+	commission, err := f.distrKeeper.ValidatorsAccumulatedCommission.Get(f.sdkCtx, valAddr)
+	require.NoError(t, err)
+	require.True(t, commission.Commission.IsZero())
+
+	// the threshold is removed along with the validator
+	_, err = f.stakingKeeper.Unbond(f.sdkCtx, operatorAddr, valAddr, math.LegacyNewDec(100))
+	require.NoError(t, err)
+	_, err = f.stakingKeeper.EndBlocker(f.sdkCtx.WithHeaderInfo(header.Info{Time: f.sdkCtx.HeaderInfo().Time.Add(stakingtypes.DefaultUnbondingTime)}))
+	require.NoError(t, err)
+	_, err = f.stakingKeeper.GetValidator(f.sdkCtx, valAddr)
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+	has, err := f.distrKeeper.CommissionAutoWithdrawThresholds.Has(f.sdkCtx, valAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+}
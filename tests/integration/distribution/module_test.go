@@ -7,11 +7,17 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	authtypes "cosmossdk.io/x/auth/types"
+	distrkeeper "cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	mintkeeper "cosmossdk.io/x/mint/keeper"
+	minttypes "cosmossdk.io/x/mint/types"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
@@ -29,3 +35,35 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.ModuleName))
 	assert.Assert(t, acc != nil)
 }
+
+func TestDelegationTotalRewardsProjection(t *testing.T) {
+	var (
+		distrKeeper   distrkeeper.Keeper
+		mintKeeper    mintkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.SetupAtGenesis(
+		depinject.Configs(
+			AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		),
+		&distrKeeper, &mintKeeper, &stakingKeeper)
+	assert.NilError(t, err)
+
+	ctx := app.BaseApp.NewContext(false)
+	assert.NilError(t, mintKeeper.Minter.Set(ctx, minttypes.NewMinter(math.LegacyNewDecWithPrec(13, 2), math.LegacyNewDec(1_000_000_000))))
+	delegations, err := stakingKeeper.GetAllDelegations(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, len(delegations) > 0)
+
+	// the mint provisions are queried through the query router
+	res, err := distrkeeper.NewQuerier(distrKeeper).DelegationTotalRewardsProjection(ctx, &types.QueryDelegationTotalRewardsProjectionRequest{
+		DelegatorAddress: delegations[0].DelegatorAddress,
+		Blocks:           100,
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, len(res.Rewards) > 0)
+	assert.Assert(t, res.ProjectedTotal.AmountOf(sdk.DefaultBondDenom).GT(res.Total.AmountOf(sdk.DefaultBondDenom)))
+	assert.Assert(t, res.Rewards[0].Apr.IsPositive())
+}
@@ -408,6 +408,19 @@ func TestProposalPassedEndblocker(t *testing.T) {
 			macc = suite.GovKeeper.GetGovernanceAccount(ctx)
 			require.NotNil(t, macc)
 			require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).Equal(initialModuleAccCoins))
+
+			// the bonded tokens at the end of the voting period and the applied
+			// quorum are stored with the tally
+			totalBonded, err := suite.StakingKeeper.TotalBondedTokens(ctx)
+			require.NoError(t, err)
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, totalBonded.String(), proposal.FinalTotalBonded)
+			quorum := params.Quorum
+			if tc.proposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED {
+				quorum = params.ExpeditedQuorum
+			}
+			require.Equal(t, math.LegacyMustNewDecFromStr(quorum).String(), proposal.FinalQuorum)
 		})
 	}
 }
@@ -646,6 +659,124 @@ func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	}
 }
 
+func TestProposalDependenciesEndBlocker(t *testing.T) {
+	testcases := []struct {
+		name             string
+		dependencyPasses bool
+		expStatus        v1.ProposalStatus
+	}{
+		{
+			name:             "dependency passes - dependent executed",
+			dependencyPasses: true,
+			expStatus:        v1.StatusPassed,
+		},
+		{
+			name:             "dependency rejected - dependent fails",
+			dependencyPasses: false,
+			expStatus:        v1.StatusFailed,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.app
+			ctx := app.BaseApp.NewContext(false)
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+			acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+			suite.AccountKeeper.SetAccount(ctx, acc)
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			_, err := suite.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			addr0Str, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+			require.NoError(t, err)
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+
+			govAcct, err := suite.AccountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+			require.NoError(t, err)
+			updateConstitution := func(constitution string) []sdk.Msg {
+				return []sdk.Msg{&v1.MsgUpdateConstitution{Authority: govAcct, Constitution: constitution}}
+			}
+
+			// the dependency enters its voting period after the dependent,
+			// so the dependent is tallied first.
+			dependency, err := suite.GovKeeper.SubmitProposal(ctx, updateConstitution("step one"), "", "dependency", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			dependencyID := dependency.Id
+
+			dependentMsg, err := v1.NewMsgSubmitProposal(updateConstitution("step two"), proposalCoins, addr0Str, "", "dependent", "summary", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			dependentMsg.DependsOn = []uint64{dependencyID}
+			res, err := govMsgSvr.SubmitProposal(ctx, dependentMsg)
+			require.NoError(t, err)
+			dependentID := res.ProposalId
+
+			err = suite.GovKeeper.AddVote(ctx, dependentID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+			require.NoError(t, err)
+
+			params, _ := suite.GovKeeper.Params.Get(ctx)
+			newHeader := ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(time.Hour)
+			ctx = ctx.WithHeaderInfo(newHeader)
+
+			_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addr0Str, dependencyID, proposalCoins))
+			require.NoError(t, err)
+
+			if tc.dependencyPasses {
+				err = suite.GovKeeper.AddVote(ctx, dependencyID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.NoError(t, err)
+			}
+
+			// the dependent passes while its dependency is still being voted on
+			newHeader = ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(*params.VotingPeriod).Add(-time.Hour)
+			ctx = ctx.WithHeaderInfo(newHeader)
+
+			err = suite.GovKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			dependent, err := suite.GovKeeper.Proposals.Get(ctx, dependentID)
+			require.NoError(t, err)
+			require.Equal(t, v1.StatusAwaitingDependencies, dependent.Status)
+
+			has, err := suite.GovKeeper.ProposalsAwaitingDependencies.Has(ctx, dependentID)
+			require.NoError(t, err)
+			require.True(t, has)
+
+			// the dependency voting period ends
+			newHeader = ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(time.Hour)
+			ctx = ctx.WithHeaderInfo(newHeader)
+
+			err = suite.GovKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			dependent, err = suite.GovKeeper.Proposals.Get(ctx, dependentID)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, dependent.Status)
+			constitution, err := suite.GovKeeper.Constitution.Get(ctx)
+			require.NoError(t, err)
+			if tc.expStatus == v1.StatusPassed {
+				// the dependent is executed after its dependency
+				require.Equal(t, "step two", constitution)
+			} else {
+				require.Contains(t, dependent.FailedReason, "did not pass")
+				require.NotEqual(t, "step two", constitution)
+			}
+
+			has, err = suite.GovKeeper.ProposalsAwaitingDependencies.Has(ctx, dependentID)
+			require.NoError(t, err)
+			require.False(t, has)
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	t.Helper()
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
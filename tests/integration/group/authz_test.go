@@ -0,0 +1,255 @@
+package group_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authsims "cosmossdk.io/x/auth/simulation"
+	authtestutil "cosmossdk.io/x/auth/testutil"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/authz"
+	authzkeeper "cosmossdk.io/x/authz/keeper"
+	authzmodule "cosmossdk.io/x/authz/module"
+	"cosmossdk.io/x/bank"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/group"
+	groupkeeper "cosmossdk.io/x/group/keeper"
+	groupmodule "cosmossdk.io/x/group/module"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type fixture struct {
+	app *integration.App
+
+	ctx         sdk.Context
+	cdc         *moduletestutil.TestEncodingConfig
+	bankKeeper  bankkeeper.Keeper
+	authzKeeper authzkeeper.Keeper
+
+	addrs    []sdk.AccAddress
+	addrsStr []string
+}
+
+func initFixture(t *testing.T) *fixture {
+	t.Helper()
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, authzkeeper.StoreKey, group.StoreKey)
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{},
+		auth.AppModule{}, bank.AppModule{}, authzmodule.AppModule{}, groupmodule.AppModule{})
+	cdc := encodingCfg.Codec
+
+	logger := log.NewTestLogger(t)
+	cms := integration.CreateMultiStore(keys, logger)
+	newCtx := sdk.NewContext(cms, true, logger)
+
+	authority := authtypes.NewModuleAddress("gov")
+
+	ctrl := gomock.NewController(t)
+	acctsModKeeper := authtestutil.NewMockAccountsModKeeper(ctrl)
+	accNum := uint64(0)
+	acctsModKeeper.EXPECT().NextAccountNumber(gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context) (uint64, error) {
+		currentNum := accNum
+		accNum++
+		return currentNum, nil
+	})
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		cdc,
+		authtypes.ProtoBaseAccount,
+		acctsModKeeper,
+		map[string][]string{banktypes.MintModuleName: {authtypes.Minter}},
+		addresscodec.NewBech32Codec(sdk.Bech32MainPrefix),
+		sdk.Bech32MainPrefix,
+		authority.String(),
+	)
+
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[banktypes.StoreKey]), log.NewNopLogger()),
+		cdc,
+		accountKeeper,
+		map[string]bool{},
+		authority.String(),
+	)
+	assert.NilError(t, bankKeeper.SetParams(newCtx, banktypes.DefaultParams()))
+
+	// authz and group dispatch msgs, they share the router of the app.
+	router := baseapp.NewMsgServiceRouter()
+	queryRouter := baseapp.NewGRPCQueryRouter()
+
+	authzKeeper := authzkeeper.NewKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), log.NewNopLogger(), runtime.EnvWithMsgRouterService(router), runtime.EnvWithQueryRouterService(queryRouter)),
+		cdc,
+		accountKeeper,
+		authz.DefaultConfig(),
+	)
+	groupKeeper := groupkeeper.NewKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[group.StoreKey]), log.NewNopLogger(), runtime.EnvWithMsgRouterService(router), runtime.EnvWithQueryRouterService(queryRouter)),
+		cdc,
+		accountKeeper,
+		group.DefaultConfig(),
+	)
+
+	integrationApp := integration.NewIntegrationApp(newCtx, logger, keys, cdc,
+		encodingCfg.InterfaceRegistry.SigningContext().AddressCodec(),
+		encodingCfg.InterfaceRegistry.SigningContext().ValidatorAddressCodec(),
+		map[string]appmodule.AppModule{
+			authtypes.ModuleName: auth.NewAppModule(cdc, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts),
+			banktypes.ModuleName: bank.NewAppModule(cdc, bankKeeper, accountKeeper),
+			authz.ModuleName:     authzmodule.NewAppModule(cdc, authzKeeper, accountKeeper, bankKeeper, encodingCfg.InterfaceRegistry),
+			group.ModuleName:     groupmodule.NewAppModule(cdc, groupKeeper, accountKeeper, bankKeeper, encodingCfg.InterfaceRegistry),
+		},
+		router,
+		queryRouter,
+	)
+
+	banktypes.RegisterMsgServer(router, bankkeeper.NewMsgServerImpl(bankKeeper))
+	authz.RegisterMsgServer(router, authzKeeper)
+	group.RegisterMsgServer(router, groupKeeper)
+
+	ctx := sdk.UnwrapSDKContext(integrationApp.Context())
+	addrs := make([]sdk.AccAddress, 3)
+	addrsStr := make([]string, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress([]byte{byte(i + 1)})
+		addr, err := accountKeeper.AddressCodec().BytesToString(addrs[i])
+		assert.NilError(t, err)
+		addrsStr[i] = addr
+	}
+
+	return &fixture{
+		app:         integrationApp,
+		ctx:         ctx,
+		cdc:         &encodingCfg,
+		bankKeeper:  bankKeeper,
+		authzKeeper: authzKeeper,
+		addrs:       addrs,
+		addrsStr:    addrsStr,
+	}
+}
+
+// createGroupPolicy creates a group with addrs[0] as single member and admin,
+// and a group policy accepting the proposals voted yes by it.
+func (f *fixture) createGroupPolicy(t *testing.T) (sdk.AccAddress, string) {
+	t.Helper()
+	msg, err := group.NewMsgCreateGroupWithPolicy(
+		f.addrsStr[0],
+		[]group.MemberRequest{{Address: f.addrsStr[0], Weight: "1"}},
+		"", "", false,
+		group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+	)
+	assert.NilError(t, err)
+
+	res, err := f.app.RunMsg(msg)
+	assert.NilError(t, err)
+	var resp group.MsgCreateGroupWithPolicyResponse
+	assert.NilError(t, f.cdc.Codec.Unmarshal(res.Value, &resp))
+
+	policyAddr, err := f.cdc.InterfaceRegistry.SigningContext().AddressCodec().StringToBytes(resp.GroupPolicyAddress)
+	assert.NilError(t, err)
+	return policyAddr, resp.GroupPolicyAddress
+}
+
+// runProposal submits a proposal of the group policy carrying the msgs, votes
+// yes on it and executes it, returning its executor result.
+func (f *fixture) runProposal(t *testing.T, policyAddr string, msgs ...sdk.Msg) group.ProposalExecutorResult {
+	t.Helper()
+	submit, err := group.NewMsgSubmitProposal(policyAddr, []string{f.addrsStr[0]}, msgs, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+	assert.NilError(t, err)
+
+	res, err := f.app.RunMsg(submit)
+	assert.NilError(t, err)
+	var submitResp group.MsgSubmitProposalResponse
+	assert.NilError(t, f.cdc.Codec.Unmarshal(res.Value, &submitResp))
+
+	_, err = f.app.RunMsg(&group.MsgVote{ProposalId: submitResp.ProposalId, Voter: f.addrsStr[0], Option: group.VOTE_OPTION_YES})
+	assert.NilError(t, err)
+
+	res, err = f.app.RunMsg(&group.MsgExec{ProposalId: submitResp.ProposalId, Executor: f.addrsStr[0]})
+	assert.NilError(t, err)
+	var execResp group.MsgExecResponse
+	assert.NilError(t, f.cdc.Codec.Unmarshal(res.Value, &execResp))
+	return execResp.Result
+}
+
+func sendMsg(from, to string, amount int64) *banktypes.MsgSend {
+	return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+}
+
+func TestGroupPolicyAsAuthzGranter(t *testing.T) {
+	f := initFixture(t)
+	policyAcc, policyAddr := f.createGroupPolicy(t)
+	grantee, granteeAddr := f.addrs[1], f.addrsStr[1]
+	assert.NilError(t, banktestutil.FundAccount(f.ctx, f.bankKeeper, policyAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	// the group policy grants a send authorization through a proposal.
+	grant, err := authz.NewMsgGrant(policyAddr, granteeAddr, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), nil, nil), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, f.runProposal(t, policyAddr, grant))
+
+	authorization, _ := f.authzKeeper.GetAuthorization(f.ctx, grantee, policyAcc, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	assert.Assert(t, authorization != nil)
+
+	// a proposal granting to an invalid address fails.
+	invalidGrant, err := authz.NewMsgGrant(policyAddr, "invalid", banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), nil, nil), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, f.runProposal(t, policyAddr, invalidGrant))
+
+	// the grantee spends the funds of the group policy, within the spend limit.
+	exec := authz.NewMsgExec(granteeAddr, []sdk.Msg{sendMsg(policyAddr, granteeAddr, 30)})
+	_, err = f.app.RunMsg(&exec)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewInt64Coin("stake", 30), f.bankKeeper.GetBalance(f.ctx, grantee, "stake"))
+	assert.DeepEqual(t, sdk.NewInt64Coin("stake", 70), f.bankKeeper.GetBalance(f.ctx, policyAcc, "stake"))
+
+	exec = authz.NewMsgExec(granteeAddr, []sdk.Msg{sendMsg(policyAddr, granteeAddr, 30)})
+	_, err = f.app.RunMsg(&exec)
+	assert.ErrorContains(t, err, "requested amount is more than spend limit")
+}
+
+func TestGroupPolicyAsAuthzGrantee(t *testing.T) {
+	f := initFixture(t)
+	policyAcc, policyAddr := f.createGroupPolicy(t)
+	granter, granterAddr := f.addrs[1], f.addrsStr[1]
+	other, otherAddr := f.addrs[2], f.addrsStr[2]
+	assert.NilError(t, banktestutil.FundAccount(f.ctx, f.bankKeeper, granter, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	assert.NilError(t, banktestutil.FundAccount(f.ctx, f.bankKeeper, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	// the granter grants a send authorization to the group policy.
+	grant, err := authz.NewMsgGrant(granterAddr, policyAddr, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), nil, nil), nil)
+	assert.NilError(t, err)
+	_, err = f.app.RunMsg(grant)
+	assert.NilError(t, err)
+
+	// a proposal of the group policy executes the authorized msg.
+	exec := authz.NewMsgExec(policyAddr, []sdk.Msg{sendMsg(granterAddr, policyAddr, 30)})
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, f.runProposal(t, policyAddr, &exec))
+	assert.DeepEqual(t, sdk.NewInt64Coin("stake", 30), f.bankKeeper.GetBalance(f.ctx, policyAcc, "stake"))
+	assert.DeepEqual(t, sdk.NewInt64Coin("stake", 70), f.bankKeeper.GetBalance(f.ctx, granter, "stake"))
+
+	// exceeding the spend limit fails.
+	exec = authz.NewMsgExec(policyAddr, []sdk.Msg{sendMsg(granterAddr, policyAddr, 30)})
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, f.runProposal(t, policyAddr, &exec))
+
+	// msgs of an account which did not grant the group policy fail.
+	exec = authz.NewMsgExec(policyAddr, []sdk.Msg{sendMsg(otherAddr, policyAddr, 10)})
+	assert.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_FAILURE, f.runProposal(t, policyAddr, &exec))
+	assert.DeepEqual(t, sdk.NewInt64Coin("stake", 100), f.bankKeeper.GetBalance(f.ctx, other, "stake"))
+}
@@ -0,0 +1,232 @@
+package interchain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authsims "cosmossdk.io/x/auth/simulation"
+	authtestutil "cosmossdk.io/x/auth/testutil"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/bank"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	"github.com/cosmos/cosmos-sdk/testutil/interchain"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var authority = authtypes.NewModuleAddress("gov").String()
+
+type chainFixture struct {
+	*interchain.Chain
+
+	bankKeeper bankkeeper.BaseKeeper
+}
+
+// sendDisabled returns true if sending coins is disabled by default on the chain.
+func (f chainFixture) sendDisabled() bool {
+	return !f.bankKeeper.GetParams(f.Context()).DefaultSendEnabled
+}
+
+func initChainFixture(t *testing.T, id string) chainFixture {
+	t.Helper()
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey)
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, bank.AppModule{})
+	cdc := encodingCfg.Codec
+
+	logger := log.NewTestLogger(t)
+	cms := integration.CreateMultiStore(keys, logger)
+
+	newCtx := sdk.NewContext(cms, true, logger)
+
+	// gomock initializations
+	ctrl := gomock.NewController(t)
+	acctsModKeeper := authtestutil.NewMockAccountsModKeeper(ctrl)
+	accNum := uint64(0)
+	acctsModKeeper.EXPECT().NextAccountNumber(gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context) (uint64, error) {
+		currentNum := accNum
+		accNum++
+		return currentNum, nil
+	})
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		cdc,
+		authtypes.ProtoBaseAccount,
+		acctsModKeeper,
+		map[string][]string{},
+		addresscodec.NewBech32Codec(sdk.Bech32MainPrefix),
+		sdk.Bech32MainPrefix,
+		authority,
+	)
+
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[banktypes.StoreKey]), log.NewNopLogger()),
+		cdc,
+		accountKeeper,
+		map[string]bool{},
+		authority,
+	)
+
+	authModule := auth.NewAppModule(cdc, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts)
+	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper)
+
+	integrationApp := integration.NewIntegrationApp(newCtx, logger, keys, cdc,
+		encodingCfg.InterfaceRegistry.SigningContext().AddressCodec(),
+		encodingCfg.InterfaceRegistry.SigningContext().ValidatorAddressCodec(),
+		map[string]appmodule.AppModule{
+			authtypes.ModuleName: authModule,
+			banktypes.ModuleName: bankModule,
+		},
+		baseapp.NewMsgServiceRouter(),
+		baseapp.NewGRPCQueryRouter(),
+	)
+
+	banktypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), bankkeeper.NewMsgServerImpl(bankKeeper))
+
+	return chainFixture{
+		Chain:      interchain.NewChain(id, integrationApp),
+		bankKeeper: bankKeeper,
+	}
+}
+
+func disableSendMsg() *banktypes.MsgUpdateParams {
+	params := banktypes.DefaultParams()
+	params.DefaultSendEnabled = false
+	return &banktypes.MsgUpdateParams{Authority: authority, Params: params}
+}
+
+func TestRelayIndependentChains(t *testing.T) {
+	chainA, chainB := initChainFixture(t, "chain-a"), initChainFixture(t, "chain-b")
+
+	coordinator, err := interchain.NewCoordinator(interchain.DirectRelayer(), chainA.Chain, chainB.Chain)
+	assert.NilError(t, err)
+
+	packet, err := coordinator.Send("chain-a", "chain-b", disableSendMsg())
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), packet.Sequence)
+	assert.Equal(t, 1, len(coordinator.Pending()))
+
+	// nothing is executed until the packet is relayed
+	assert.Assert(t, !chainB.sendDisabled())
+
+	height := chainB.LastBlockHeight()
+	acks, err := coordinator.Relay()
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(acks))
+	assert.Assert(t, acks[0].Success())
+	assert.Equal(t, packet.Sequence, acks[0].Packet.Sequence)
+	assert.Equal(t, 0, len(coordinator.Pending()))
+	assert.Equal(t, height+1, chainB.LastBlockHeight())
+
+	// the stores of the chains are independent
+	assert.Assert(t, chainB.sendDisabled())
+	assert.Assert(t, !chainA.sendDisabled())
+
+	// a failed execution is acknowledged without aborting the round
+	invalid := disableSendMsg()
+	invalid.Authority = authtypes.NewModuleAddress("invalid").String()
+	_, err = coordinator.Send("chain-b", "chain-a", invalid)
+	assert.NilError(t, err)
+
+	acks, err = coordinator.Relay()
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(acks))
+	assert.ErrorContains(t, acks[0].Err, "invalid authority")
+	assert.Assert(t, !chainA.sendDisabled())
+}
+
+func TestProgrammableRelayer(t *testing.T) {
+	chainA, chainB, chainC := initChainFixture(t, "chain-a"), initChainFixture(t, "chain-b"), initChainFixture(t, "chain-c")
+
+	// the relayer holds packets for one round, then delivers them in reverse
+	// order and drops the ones destined to chain-c
+	round := 0
+	relayer := interchain.RelayerFunc(func(pending []interchain.Packet) (deliver, keep []interchain.Packet) {
+		round++
+		if round == 1 {
+			return nil, pending
+		}
+
+		for i := len(pending) - 1; i >= 0; i-- {
+			if pending[i].Destination != "chain-c" {
+				deliver = append(deliver, pending[i])
+			}
+		}
+		return deliver, nil
+	})
+
+	coordinator, err := interchain.NewCoordinator(relayer, chainA.Chain, chainB.Chain, chainC.Chain)
+	assert.NilError(t, err)
+
+	_, err = coordinator.Send("chain-a", "chain-b", disableSendMsg())
+	assert.NilError(t, err)
+	_, err = coordinator.Send("chain-b", "chain-a", disableSendMsg())
+	assert.NilError(t, err)
+	_, err = coordinator.Send("chain-a", "chain-c", disableSendMsg())
+	assert.NilError(t, err)
+
+	acks, err := coordinator.RelayAll(3)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(acks))
+	assert.Equal(t, uint64(2), acks[0].Packet.Sequence)
+	assert.Equal(t, uint64(1), acks[1].Packet.Sequence)
+	assert.Equal(t, 0, len(coordinator.Pending()))
+
+	assert.Assert(t, chainA.sendDisabled())
+	assert.Assert(t, chainB.sendDisabled())
+	assert.Assert(t, !chainC.sendDisabled())
+
+	// a relayer never delivering its packets is detected
+	coordinator, err = interchain.NewCoordinator(interchain.RelayerFunc(func(pending []interchain.Packet) (deliver, keep []interchain.Packet) {
+		return nil, pending
+	}), chainA.Chain, chainB.Chain)
+	assert.NilError(t, err)
+
+	_, err = coordinator.Send("chain-a", "chain-b", disableSendMsg())
+	assert.NilError(t, err)
+	_, err = coordinator.RelayAll(2)
+	assert.ErrorContains(t, err, "1 packets still pending after 2 relay rounds")
+}
+
+func TestCoordinatorValidation(t *testing.T) {
+	chainA, chainB := initChainFixture(t, "chain-a"), initChainFixture(t, "chain-b")
+
+	_, err := interchain.NewCoordinator(interchain.DirectRelayer(), chainA.Chain)
+	assert.ErrorContains(t, err, "at least two chains are required")
+
+	_, err = interchain.NewCoordinator(interchain.DirectRelayer(), chainA.Chain, chainA.Chain)
+	assert.ErrorContains(t, err, "duplicate chain id chain-a")
+
+	coordinator, err := interchain.NewCoordinator(interchain.DirectRelayer(), chainA.Chain, chainB.Chain)
+	assert.NilError(t, err)
+
+	_, err = coordinator.Send("chain-a", "chain-x", disableSendMsg())
+	assert.ErrorContains(t, err, "unknown destination chain chain-x")
+
+	_, err = coordinator.Send("chain-a", "chain-a", disableSendMsg())
+	assert.ErrorContains(t, err, "source and destination chains must differ")
+
+	// relayers cannot make up packets
+	coordinator, err = interchain.NewCoordinator(interchain.RelayerFunc(func(pending []interchain.Packet) (deliver, keep []interchain.Packet) {
+		return append(pending, interchain.Packet{Sequence: 42, Destination: "chain-b"}), nil
+	}), chainA.Chain, chainB.Chain)
+	assert.NilError(t, err)
+
+	_, err = coordinator.Relay()
+	assert.ErrorContains(t, err, "relayer returned unknown or duplicate packet 42")
+}
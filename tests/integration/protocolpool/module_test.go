@@ -14,10 +14,12 @@ import (
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	authtypes "cosmossdk.io/x/auth/types"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/mint/types"
 	protocolpoolkeeper "cosmossdk.io/x/protocolpool/keeper"
 	protocolpooltypes "cosmossdk.io/x/protocolpool/types"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -135,3 +137,115 @@ func TestExpireInTheMiddle(t *testing.T) {
 	endBalance := bankKeeper.GetBalance(ctx, testAddrs[0], sdk.DefaultBondDenom)
 	require.Equal(t, "237661stake", endBalance.String())
 }
+
+// TestCommunityPoolSpendWithMessages tests that the messages of a community pool
+// spend are executed on behalf of the recipient module account.
+func TestCommunityPoolSpendWithMessages(t *testing.T) {
+	var accountKeeper authkeeper.AccountKeeper
+	var protocolpoolKeeper protocolpoolkeeper.Keeper
+	var bankKeeper bankkeeper.Keeper
+	var stakingKeeper *stakingkeeper.Keeper
+
+	app, err := simtestutil.SetupAtGenesis(
+		depinject.Configs(
+			AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		), &accountKeeper, &protocolpoolKeeper, &bankKeeper, &stakingKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1})
+
+	testAddrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, math.NewInt(1000))
+	require.NoError(t, protocolpoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), testAddrs[0]))
+
+	// the recipient is a module account that is not blocked from receiving funds
+	grantsAcc := authtypes.NewEmptyModuleAccount("grants")
+	accountKeeper.SetModuleAccount(ctx, accountKeeper.NewAccount(ctx, grantsAcc).(sdk.ModuleAccountI))
+	grantsAddr, err := accountKeeper.AddressCodec().BytesToString(grantsAcc.GetAddress())
+	require.NoError(t, err)
+	testAddr1Str, err := accountKeeper.AddressCodec().BytesToString(testAddrs[1])
+	require.NoError(t, err)
+
+	msg := protocolpooltypes.NewMsgCommunityPoolSpend(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), protocolpoolKeeper.GetAuthority(), grantsAddr)
+	require.NoError(t, msg.SetMsgs([]sdk.Msg{
+		banktypes.NewMsgSend(grantsAddr, testAddr1Str, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))),
+		protocolpooltypes.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), grantsAddr),
+	}))
+
+	// messages must be allowed for the recipient
+	msgServer := protocolpoolkeeper.NewMsgServerImpl(protocolpoolKeeper)
+	_, err = msgServer.CommunityPoolSpend(ctx, msg)
+	require.ErrorContains(t, err, "is not allowed for the recipient")
+
+	// only the authority can update the allowlist
+	allowlistMsg := &protocolpooltypes.MsgUpdateSpendMsgAllowlist{
+		Authority: testAddr1Str,
+		Add: []*protocolpooltypes.SpendMsgPermission{
+			{Signer: grantsAddr, MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			{Signer: grantsAddr, MsgTypeUrl: sdk.MsgTypeURL(&protocolpooltypes.MsgFundCommunityPool{})},
+		},
+	}
+	_, err = msgServer.UpdateSpendMsgAllowlist(ctx, allowlistMsg)
+	require.ErrorContains(t, err, "invalid authority")
+
+	allowlistMsg.Authority = protocolpoolKeeper.GetAuthority()
+	_, err = msgServer.UpdateSpendMsgAllowlist(ctx, allowlistMsg)
+	require.NoError(t, err)
+
+	permissions, err := protocolpoolKeeper.GetSpendMsgPermissions(ctx)
+	require.NoError(t, err)
+	require.Len(t, permissions, 2)
+
+	res, err := msgServer.CommunityPoolSpend(ctx, msg)
+	require.NoError(t, err)
+	require.Len(t, res.MsgResponses, 2)
+
+	require.Equal(t, "100stake", bankKeeper.GetBalance(ctx, grantsAcc.GetAddress(), sdk.DefaultBondDenom).String())
+	require.Equal(t, "1300stake", bankKeeper.GetBalance(ctx, testAddrs[1], sdk.DefaultBondDenom).String())
+
+	pool, err := protocolpoolKeeper.GetCommunityPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "600stake", pool.String())
+
+	// messages must be signed by the recipient
+	msg = protocolpooltypes.NewMsgCommunityPoolSpend(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), protocolpoolKeeper.GetAuthority(), grantsAddr)
+	require.NoError(t, msg.SetMsgs([]sdk.Msg{
+		banktypes.NewMsgSend(testAddr1Str, grantsAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))),
+	}))
+	_, err = msgServer.CommunityPoolSpend(ctx, msg)
+	require.ErrorContains(t, err, "must be signed by the recipient only")
+
+	// a failed message fails the spend
+	msg = protocolpooltypes.NewMsgCommunityPoolSpend(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), protocolpoolKeeper.GetAuthority(), grantsAddr)
+	require.NoError(t, msg.SetMsgs([]sdk.Msg{
+		banktypes.NewMsgSend(grantsAddr, testAddr1Str, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))),
+	}))
+	_, err = msgServer.CommunityPoolSpend(ctx, msg)
+	require.ErrorContains(t, err, "failed to execute message 0")
+
+	// removed permissions cannot be used anymore
+	_, err = msgServer.UpdateSpendMsgAllowlist(ctx, &protocolpooltypes.MsgUpdateSpendMsgAllowlist{
+		Authority: protocolpoolKeeper.GetAuthority(),
+		Remove: []*protocolpooltypes.SpendMsgPermission{
+			{Signer: grantsAddr, MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		},
+	})
+	require.NoError(t, err)
+
+	msg = protocolpooltypes.NewMsgCommunityPoolSpend(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), protocolpoolKeeper.GetAuthority(), grantsAddr)
+	require.NoError(t, msg.SetMsgs([]sdk.Msg{
+		banktypes.NewMsgSend(grantsAddr, testAddr1Str, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+	}))
+	_, err = msgServer.CommunityPoolSpend(ctx, msg)
+	require.ErrorContains(t, err, "is not allowed for the recipient")
+
+	// a spend cannot move the funds held by the staking pools
+	bondedPoolAddr, err := accountKeeper.AddressCodec().BytesToString(accountKeeper.GetModuleAddress(stakingtypes.BondedPoolName))
+	require.NoError(t, err)
+	msg = protocolpooltypes.NewMsgCommunityPoolSpend(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), protocolpoolKeeper.GetAuthority(), bondedPoolAddr)
+	require.NoError(t, msg.SetMsgs([]sdk.Msg{
+		banktypes.NewMsgSend(bondedPoolAddr, testAddr1Str, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))),
+	}))
+	_, err = msgServer.CommunityPoolSpend(ctx, msg)
+	require.ErrorContains(t, err, "is not allowed for the recipient")
+}
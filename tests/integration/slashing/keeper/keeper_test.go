@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/comet"
 	coreheader "cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth"
 	authkeeper "cosmossdk.io/x/auth/keeper"
@@ -119,7 +120,7 @@ func initFixture(tb testing.TB) *fixture {
 
 	stakingKeeper := stakingkeeper.NewKeeper(cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[stakingtypes.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(queryRouter), runtime.EnvWithMsgRouterService(msgRouter)), accountKeeper, bankKeeper, authority.String(), addresscodec.NewBech32Codec(sdk.Bech32PrefixValAddr), addresscodec.NewBech32Codec(sdk.Bech32PrefixConsAddr), cometInfoService)
 
-	slashingKeeper := slashingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(queryRouter), runtime.EnvWithMsgRouterService(msgRouter)), cdc, &codec.LegacyAmino{}, stakingKeeper, authority.String())
+	slashingKeeper := slashingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(queryRouter), runtime.EnvWithMsgRouterService(msgRouter)), cdc, &codec.LegacyAmino{}, stakingKeeper, authority.String(), accountKeeper.AddressCodec())
 
 	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper)
 	stakingModule := staking.NewAppModule(cdc, stakingKeeper, accountKeeper, bankKeeper)
@@ -402,6 +403,164 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	// validator should not have been slashed twice
 	validator, _ = f.stakingKeeper.GetValidatorByConsAddr(f.ctx, sdk.GetConsAddress(val))
 	assert.DeepEqual(t, resultingTokens, validator.GetTokens())
+
+	// the slash and the jailing should have been recorded once
+	slashes, err := f.slashingKeeper.ValidatorSlashes(f.ctx, &slashingtypes.QueryValidatorSlashesRequest{ConsAddress: consaddr})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(slashes.Slashes))
+	assert.Equal(t, slashingtypes.AttributeValueMissingSignature, slashes.Slashes[0].Reason)
+	assert.DeepEqual(t, amt.Sub(resultingTokens), slashes.Slashes[0].BurnedAmount)
+
+	jails, err := f.slashingKeeper.ValidatorJailHistory(f.ctx, &slashingtypes.QueryValidatorJailHistoryRequest{ConsAddress: consaddr})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(jails.Records))
+	assert.Equal(t, slashingtypes.AttributeValueMissingSignature, jails.Records[0].Reason)
+}
+
+// Test the liveness thresholds of a validator class are used for the
+// validators falling into it, and switching class carries the missed blocks
+// over to the window of the new class.
+func TestHandleAbsentValidatorClass(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := f.valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	params, err := f.slashingKeeper.Params.Get(f.ctx)
+	assert.NilError(t, err)
+	params.ValidatorClasses = []slashingtypes.ValidatorClass{
+		{MaxPower: 1000, SignedBlocksWindow: 50, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+		{MaxPower: 200, SignedBlocksWindow: 20, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+	}
+	assert.NilError(t, f.slashingKeeper.Params.Set(f.ctx, params))
+
+	assert.NilError(t, f.slashingKeeper.AddrPubkeyRelation.Set(f.ctx, val.Address(), val))
+
+	consaddr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(val.Address())
+	assert.NilError(t, err)
+
+	info := slashingtypes.NewValidatorSigningInfo(consaddr, f.ctx.HeaderInfo().Height, time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.ctx, consAddr, info))
+
+	acc := f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(addr))
+	f.accountKeeper.SetAccount(f.ctx, acc)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// a missed block tracked against the window of the 1000 power class is
+	// carried over when the validator moves to the 200 power class, until its
+	// height leaves the new window
+	height := int64(0)
+	f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+	assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), 500, comet.BlockIDFlagAbsent))
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(50), info.SignedBlocksWindow)
+	assert.Equal(t, int64(1), info.MissedBlocksCounter)
+
+	// 20 first blocks OK
+	for height = 1; height <= 20; height++ {
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagCommit))
+	}
+	info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(20), info.SignedBlocksWindow)
+	assert.Equal(t, int64(0), info.MissedBlocksCounter)
+
+	// 10 blocks missed, the validator is still within the class threshold
+	for ; height <= 30; height++ {
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagAbsent))
+	}
+	validator, err := f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !validator.IsJailed())
+
+	// one more missed block goes over the threshold of the class, far below
+	// the threshold of the default window
+	f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+	assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagAbsent))
+
+	validator, err = f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, validator.IsJailed())
+	assert.DeepEqual(t, amt.Sub(f.stakingKeeper.TokensFromConsensusPower(f.ctx, 1)), validator.GetTokens())
+}
+
+// Test a validator toggling its power across the boundary of two validator
+// classes every block does not clear its missed blocks, and is jailed.
+func TestHandleAbsentValidatorClassToggle(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := f.valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	params, err := f.slashingKeeper.Params.Get(f.ctx)
+	assert.NilError(t, err)
+	params.ValidatorClasses = []slashingtypes.ValidatorClass{
+		{MaxPower: 1000, SignedBlocksWindow: 60, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+		{MaxPower: 200, SignedBlocksWindow: 40, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+	}
+	assert.NilError(t, f.slashingKeeper.Params.Set(f.ctx, params))
+
+	assert.NilError(t, f.slashingKeeper.AddrPubkeyRelation.Set(f.ctx, val.Address(), val))
+
+	consaddr, err := f.stakingKeeper.ConsensusAddressCodec().BytesToString(val.Address())
+	assert.NilError(t, err)
+
+	info := slashingtypes.NewValidatorSigningInfo(consaddr, f.ctx.HeaderInfo().Height, time.Unix(0, 0), false, int64(0))
+	assert.NilError(t, f.slashingKeeper.ValidatorSigningInfo.Set(f.ctx, consAddr, info))
+
+	acc := f.accountKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(addr))
+	f.accountKeeper.SetAccount(f.ctx, acc)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(f.ctx)
+	assert.NilError(t, err)
+
+	// miss every block while moving between the 200 and 1000 power classes
+	var height int64
+	for height = 0; height < 40; height++ {
+		togglePower := power
+		if height%2 == 1 {
+			togglePower = 500
+		}
+
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), togglePower, comet.BlockIDFlagAbsent))
+
+		info, err = f.slashingKeeper.ValidatorSigningInfo.Get(f.ctx, consAddr)
+		assert.NilError(t, err)
+		assert.Equal(t, height+1, info.MissedBlocksCounter)
+	}
+
+	validator, err := f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !validator.IsJailed())
+
+	// past the window of the 200 power class, the carried missed blocks go
+	// over its threshold
+	for ; height <= 41; height++ {
+		f.ctx = f.ctx.WithHeaderInfo(coreheader.Info{Height: height})
+		assert.NilError(t, f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, comet.BlockIDFlagAbsent))
+	}
+
+	validator, err = f.stakingKeeper.GetValidatorByConsAddr(f.ctx, consAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, validator.IsJailed())
 }
 
 // Test a validator dipping in and out of the validator set
@@ -0,0 +1,211 @@
+package interchain
+
+import (
+	"errors"
+	"fmt"
+
+	cmtabcitypes "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Chain is an in-process application taking part in an interchain test.
+type Chain struct {
+	*integration.App
+
+	ID string
+}
+
+// NewChain wraps an integration application into a chain identified by id.
+// Every chain must be created with its own stores.
+func NewChain(id string, app *integration.App) *Chain {
+	return &Chain{App: app, ID: id}
+}
+
+// NextBlock finalizes and commits a new block on the chain.
+func (c *Chain) NextBlock() error {
+	height := c.LastBlockHeight() + 1
+	if _, err := c.FinalizeBlock(&cmtabcitypes.FinalizeBlockRequest{Height: height, DecidedLastCommit: cmtabcitypes.CommitInfo{Votes: []cmtabcitypes.VoteInfo{{}}}}); err != nil {
+		return fmt.Errorf("failed to finalize block %d on chain %s: %w", height, c.ID, err)
+	}
+
+	if _, err := c.Commit(); err != nil {
+		return fmt.Errorf("failed to commit block %d on chain %s: %w", height, c.ID, err)
+	}
+
+	return nil
+}
+
+// Acknowledgement is the result of the execution of a packet on its destination chain.
+type Acknowledgement struct {
+	Packet   Packet
+	Response *codectypes.Any
+	Err      error
+}
+
+// Success returns true if the packet was executed successfully.
+func (a Acknowledgement) Success() bool {
+	return a.Err == nil
+}
+
+// Coordinator holds a set of chains and the packets in flight between them.
+// Chains are always processed in the order they were given to the coordinator
+// and packets in the order returned by the relayer, which makes the outcome of
+// a test deterministic.
+type Coordinator struct {
+	chains  []*Chain
+	byID    map[string]*Chain
+	relayer Relayer
+
+	sequence uint64
+	pending  []Packet
+}
+
+// NewCoordinator creates a coordinator relaying packets between the given chains
+// with the given relayer. At least two chains with distinct identifiers are required.
+func NewCoordinator(relayer Relayer, chains ...*Chain) (*Coordinator, error) {
+	if relayer == nil {
+		return nil, errors.New("relayer cannot be nil")
+	}
+
+	if len(chains) < 2 {
+		return nil, fmt.Errorf("at least two chains are required, got %d", len(chains))
+	}
+
+	byID := make(map[string]*Chain, len(chains))
+	for _, chain := range chains {
+		if chain.ID == "" {
+			return nil, errors.New("chain id cannot be empty")
+		}
+
+		if _, ok := byID[chain.ID]; ok {
+			return nil, fmt.Errorf("duplicate chain id %s", chain.ID)
+		}
+
+		byID[chain.ID] = chain
+	}
+
+	return &Coordinator{
+		chains:  chains,
+		byID:    byID,
+		relayer: relayer,
+	}, nil
+}
+
+// Chains returns the chains of the coordinator.
+func (c *Coordinator) Chains() []*Chain {
+	return c.chains
+}
+
+// Chain returns the chain identified by id, or nil if it is unknown.
+func (c *Coordinator) Chain(id string) *Chain {
+	return c.byID[id]
+}
+
+// Pending returns the packets sent but not delivered yet, in send order.
+func (c *Coordinator) Pending() []Packet {
+	return append([]Packet(nil), c.pending...)
+}
+
+// Send queues a message sent by the source chain to be executed on the destination chain.
+// The message is only executed once the packet is delivered by the relayer.
+func (c *Coordinator) Send(source, destination string, msg sdk.Msg) (Packet, error) {
+	if c.Chain(source) == nil {
+		return Packet{}, fmt.Errorf("unknown source chain %s", source)
+	}
+
+	if c.Chain(destination) == nil {
+		return Packet{}, fmt.Errorf("unknown destination chain %s", destination)
+	}
+
+	if source == destination {
+		return Packet{}, fmt.Errorf("source and destination chains must differ, got %s", source)
+	}
+
+	if msg == nil {
+		return Packet{}, errors.New("message cannot be nil")
+	}
+
+	c.sequence++
+	packet := Packet{
+		Sequence:    c.sequence,
+		Source:      source,
+		Destination: destination,
+		Msg:         msg,
+	}
+	c.pending = append(c.pending, packet)
+
+	return packet, nil
+}
+
+// Relay runs a single relay round: the relayer selects the pending packets to
+// deliver, which are executed on their destination chains, and a new block is
+// committed on every chain. A failed packet execution does not abort the round,
+// it is reported in the returned acknowledgement.
+func (c *Coordinator) Relay() ([]Acknowledgement, error) {
+	deliver, keep := c.relayer.Relay(c.Pending())
+
+	// the relayer may alter packets but not make up new ones or relay them twice
+	pending := make(map[uint64]bool, len(c.pending))
+	for _, packet := range c.pending {
+		pending[packet.Sequence] = true
+	}
+	for _, packet := range append(append([]Packet(nil), deliver...), keep...) {
+		if !pending[packet.Sequence] {
+			return nil, fmt.Errorf("relayer returned unknown or duplicate packet %d", packet.Sequence)
+		}
+		pending[packet.Sequence] = false
+
+		if c.Chain(packet.Destination) == nil {
+			return nil, fmt.Errorf("unknown destination chain %s for packet %d", packet.Destination, packet.Sequence)
+		}
+	}
+
+	c.pending = append([]Packet(nil), keep...)
+
+	acks := make([]Acknowledgement, 0, len(deliver))
+	for _, packet := range deliver {
+		res, err := c.Chain(packet.Destination).RunMsg(packet.Msg)
+		acks = append(acks, Acknowledgement{Packet: packet, Response: res, Err: err})
+	}
+
+	if err := c.NextBlock(); err != nil {
+		return nil, err
+	}
+
+	return acks, nil
+}
+
+// RelayAll runs relay rounds until no packet is pending, returning the
+// acknowledgements of all the rounds. It fails if packets are still pending
+// after maxRounds rounds.
+func (c *Coordinator) RelayAll(maxRounds int) ([]Acknowledgement, error) {
+	var acks []Acknowledgement
+	for round := 0; len(c.pending) > 0; round++ {
+		if round == maxRounds {
+			return acks, fmt.Errorf("%d packets still pending after %d relay rounds", len(c.pending), maxRounds)
+		}
+
+		roundAcks, err := c.Relay()
+		if err != nil {
+			return acks, err
+		}
+
+		acks = append(acks, roundAcks...)
+	}
+
+	return acks, nil
+}
+
+// NextBlock commits a new block on every chain.
+func (c *Coordinator) NextBlock() error {
+	for _, chain := range c.chains {
+		if err := chain.NextBlock(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
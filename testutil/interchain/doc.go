@@ -0,0 +1,5 @@
+// Package interchain contains a test harness running several in-process
+// integration applications, each with its own stores, and relaying messages
+// between them. It allows testing modules interacting across chains without
+// running docker based testnets.
+package interchain
@@ -0,0 +1,40 @@
+package interchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Packet is a message sent by a source chain to be executed on a destination chain.
+type Packet struct {
+	// Sequence is assigned by the coordinator when the packet is sent and is
+	// unique across all the chains of the coordinator.
+	Sequence    uint64
+	Source      string
+	Destination string
+	Msg         sdk.Msg
+}
+
+// Relayer decides which of the pending packets are delivered on a relay round.
+// Custom relayers can be used to delay, reorder, drop or alter packets in order
+// to exercise the failure modes of cross-chain interactions.
+type Relayer interface {
+	// Relay is called with the pending packets in send order. It returns the
+	// packets to deliver in this round, in delivery order, and the packets to
+	// keep pending for the next round. Packets returned in neither are dropped.
+	Relay(pending []Packet) (deliver, keep []Packet)
+}
+
+// RelayerFunc is an adapter allowing the use of a function as a Relayer.
+type RelayerFunc func(pending []Packet) (deliver, keep []Packet)
+
+// Relay implements Relayer.
+func (f RelayerFunc) Relay(pending []Packet) (deliver, keep []Packet) {
+	return f(pending)
+}
+
+// DirectRelayer returns a relayer delivering every pending packet in send order.
+func DirectRelayer() Relayer {
+	return RelayerFunc(func(pending []Packet) (deliver, keep []Packet) {
+		return pending, nil
+	})
+}
@@ -55,7 +55,9 @@ type Context struct {
 	minGasPrice          DecCoins
 	consParams           cmtproto.ConsensusParams
 	eventManager         EventManagerI
-	priority             int64 // The tx priority, only relevant in CheckTx
+	priority             int64  // The tx priority, only relevant in CheckTx
+	txIndex              int    // The position of the tx in the block, -1 outside of FinalizeBlock tx execution
+	blockGasUsed         uint64 // The block gas consumed by the txs preceding the current one
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
@@ -84,6 +86,8 @@ func (c Context) ExecMode() ExecMode                            { return c.execM
 func (c Context) MinGasPrices() DecCoins                        { return c.minGasPrice }
 func (c Context) EventManager() EventManagerI                   { return c.eventManager }
 func (c Context) Priority() int64                               { return c.priority }
+func (c Context) TxIndex() int                                  { return c.txIndex }
+func (c Context) BlockGasUsed() uint64                          { return c.blockGasUsed }
 func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGasConfig }
 func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
@@ -135,6 +139,7 @@ func NewContext(ms storetypes.MultiStore, isCheckTx bool, logger log.Logger) Con
 		gasMeter:             storetypes.NewInfiniteGasMeter(),
 		minGasPrice:          DecCoins{},
 		eventManager:         NewEventManager(),
+		txIndex:              -1,
 		kvGasConfig:          storetypes.KVGasConfig(),
 		transientKVGasConfig: storetypes.TransientGasConfig(),
 		headerInfo: header.Info{
@@ -294,6 +299,24 @@ func (c Context) WithPriority(p int64) Context {
 	return c
 }
 
+// WithTxIndex returns a Context with an updated position of the tx within the
+// block. BaseApp sets it for every tx executed in FinalizeBlock, counting from
+// zero in the order of the block, including txs that fail to decode or execute.
+// It is -1 in CheckTx, simulation and whenever no block tx is being executed.
+func (c Context) WithTxIndex(i int) Context {
+	c.txIndex = i
+	return c
+}
+
+// WithBlockGasUsed returns a Context with an updated amount of block gas
+// consumed by the txs executed before the current one in the same block. It is
+// a snapshot taken before the tx runs, so it does not change while the tx
+// executes and is always zero in CheckTx and simulation.
+func (c Context) WithBlockGasUsed(gas uint64) Context {
+	c.blockGasUsed = gas
+	return c
+}
+
 // WithStreamingManager returns a Context with an updated streaming manager
 func (c Context) WithStreamingManager(sm storetypes.StreamingManager) Context {
 	c.streamingManager = sm
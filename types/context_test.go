@@ -131,6 +131,13 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	s.Require().True(ctx.IsCheckTx())
 	s.Require().True(ctx.IsReCheckTx())
 
+	// test tx position in the block
+	s.Require().Equal(-1, ctx.TxIndex())
+	s.Require().Equal(uint64(0), ctx.BlockGasUsed())
+	ctx = ctx.WithTxIndex(3).WithBlockGasUsed(150)
+	s.Require().Equal(3, ctx.TxIndex())
+	s.Require().Equal(uint64(150), ctx.BlockGasUsed())
+
 	// test consensus param
 	s.Require().Equal(cmtproto.ConsensusParams{}, ctx.ConsensusParams())
 	cp := cmtproto.ConsensusParams{}
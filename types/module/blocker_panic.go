@@ -0,0 +1,139 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	blockerPhaseBeginBlock = "begin_block"
+	blockerPhaseEndBlock   = "end_block"
+)
+
+// consensusCriticalModules are the modules whose begin and end blocker panics
+// can never be skipped, as skipping them would leave the validator set, the
+// accounts or the upgrade plan in an inconsistent state.
+var consensusCriticalModules = map[string]struct{}{
+	"accounts":  {},
+	"auth":      {},
+	"bank":      {},
+	"consensus": {},
+	"evidence":  {},
+	"genutil":   {},
+	"slashing":  {},
+	"staking":   {},
+	"upgrade":   {},
+}
+
+// BlockerPanicSkipper decides whether a panic raised by the begin or end blocker
+// of a module can be skipped instead of halting the chain.
+// The decision is expected to be governance controlled (e.g. x/consensus).
+type BlockerPanicSkipper interface {
+	CanSkipBlockerPanic(ctx context.Context, moduleName string) (bool, error)
+}
+
+// ValidateBlockerPanicSkipList checks that the given modules can be put on a
+// blocker panic skip list: names must be non blank, unique and not consensus
+// critical.
+func ValidateBlockerPanicSkipList(moduleNames []string) error {
+	seen := make(map[string]struct{}, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		if strings.TrimSpace(moduleName) == "" {
+			return fmt.Errorf("module name cannot be blank")
+		}
+
+		if _, ok := consensusCriticalModules[moduleName]; ok {
+			return fmt.Errorf("cannot skip the blocker panics of consensus critical module %s", moduleName)
+		}
+
+		if _, ok := seen[moduleName]; ok {
+			return fmt.Errorf("duplicate module %s", moduleName)
+		}
+		seen[moduleName] = struct{}{}
+	}
+
+	return nil
+}
+
+// SetBlockerPanicSkipper sets the skipper consulted before running the begin
+// and end blockers. The blockers of the modules it allows to skip run on a
+// cached context; a panic is recovered, logged and counted in telemetry, and
+// the state changes of the module are discarded. Consensus critical modules are
+// never skipped.
+// The other blockers are called directly, as when no skipper is set; their
+// panics are logged and counted before being propagated.
+func (m *Manager) SetBlockerPanicSkipper(skipper BlockerPanicSkipper) {
+	m.blockerPanicSkipper = skipper
+}
+
+// canSkipBlockerPanic returns whether the blocker panics of a module are skipped.
+func (m *Manager) canSkipBlockerPanic(ctx sdk.Context, moduleName string) (bool, error) {
+	if m.blockerPanicSkipper == nil {
+		return false, nil
+	}
+
+	if _, ok := consensusCriticalModules[moduleName]; ok {
+		return false, nil
+	}
+
+	return m.blockerPanicSkipper.CanSkipBlockerPanic(ctx, moduleName)
+}
+
+// runBlocker runs the begin or end blocker of a module, isolating its panics
+// when the blocker panic skipper allows it.
+func (m *Manager) runBlocker(ctx sdk.Context, moduleName, phase string, blocker func(ctx sdk.Context) error) (err error) {
+	skip, err := m.canSkipBlockerPanic(ctx, moduleName)
+	if err != nil {
+		return err
+	}
+
+	if !skip {
+		defer func() {
+			if r := recover(); r != nil {
+				reportBlockerPanic(ctx, moduleName, phase, r)
+				panic(r)
+			}
+		}()
+
+		return blocker(ctx)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		reportBlockerPanic(ctx, moduleName, phase, r)
+		ctx.Logger().Error("skipped module blocker after panic", "module", moduleName, "phase", phase)
+		err = nil
+	}()
+
+	if err := blocker(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// reportBlockerPanic logs a panic raised by a module blocker and counts it in
+// telemetry.
+func reportBlockerPanic(ctx sdk.Context, moduleName, phase string, r any) {
+	ctx.Logger().Error("panic in module blocker", "module", moduleName, "phase", phase, "panic", r)
+	telemetry.IncrCounterWithLabels(
+		[]string{"blocker", "panic"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("module", moduleName),
+			telemetry.NewLabel("phase", phase),
+		},
+	)
+}
@@ -86,6 +86,15 @@ type HasInvariants interface {
 	RegisterInvariants(sdk.InvariantRegistry)
 }
 
+// HasHealthCheck is the interface for modules reporting their health, so that
+// operators get early warning of state corruption without running the crisis
+// invariants. Health checks run against the latest committed state and must be
+// cheap enough to be queried periodically.
+type HasHealthCheck interface {
+	// HealthCheck returns an error describing the problem if the module is unhealthy.
+	HealthCheck(ctx context.Context) error
+}
+
 // HasServices is the interface for modules to register services.
 type HasServices interface {
 	// RegisterServices allows a module to register services.
@@ -119,6 +128,8 @@ type Manager struct {
 	OrderPrepareCheckStaters []string
 	OrderPrecommiters        []string
 	OrderMigrations          []string
+
+	blockerPanicSkipper BlockerPanicSkipper
 }
 
 // NewManager creates a new Manager object.
@@ -376,6 +387,20 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
+// HealthCheck runs the health checks of all the modules implementing
+// HasHealthCheck and returns their results keyed by module name, a nil error
+// meaning the module is healthy.
+func (m *Manager) HealthCheck(ctx context.Context) map[string]error {
+	health := make(map[string]error)
+	for name, module := range m.Modules {
+		if module, ok := module.(HasHealthCheck); ok {
+			health[name] = module.HealthCheck(ctx)
+		}
+	}
+
+	return health
+}
+
 // RegisterServices registers all module services
 func (m *Manager) RegisterServices(cfg Configurator) error {
 	for _, module := range m.Modules {
@@ -722,7 +747,9 @@ func (m *Manager) BeginBlock(ctx sdk.Context) (sdk.BeginBlock, error) {
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	for _, moduleName := range m.OrderBeginBlockers {
 		if module, ok := m.Modules[moduleName].(appmodule.HasBeginBlocker); ok {
-			if err := module.BeginBlock(ctx); err != nil {
+			if err := m.runBlocker(ctx, moduleName, blockerPhaseBeginBlock, func(ctx sdk.Context) error {
+				return module.BeginBlock(ctx)
+			}); err != nil {
 				return sdk.BeginBlock{}, err
 			}
 		}
@@ -742,12 +769,18 @@ func (m *Manager) EndBlock(ctx sdk.Context) (sdk.EndBlock, error) {
 
 	for _, moduleName := range m.OrderEndBlockers {
 		if module, ok := m.Modules[moduleName].(appmodule.HasEndBlocker); ok {
-			err := module.EndBlock(ctx)
+			err := m.runBlocker(ctx, moduleName, blockerPhaseEndBlock, func(ctx sdk.Context) error {
+				return module.EndBlock(ctx)
+			})
 			if err != nil {
 				return sdk.EndBlock{}, err
 			}
 		} else if module, ok := m.Modules[moduleName].(HasABCIEndBlock); ok {
-			moduleValUpdates, err := module.EndBlock(ctx)
+			var moduleValUpdates []ValidatorUpdate
+			err := m.runBlocker(ctx, moduleName, blockerPhaseEndBlock, func(ctx sdk.Context) (err error) {
+				moduleValUpdates, err = module.EndBlock(ctx)
+				return err
+			})
 			if err != nil {
 				return sdk.EndBlock{}, err
 			}
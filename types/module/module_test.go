@@ -15,10 +15,12 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -448,6 +450,61 @@ func TestCoreAPIManager_BeginBlock(t *testing.T) {
 	require.EqualError(t, err, "some error")
 }
 
+type blockerPanicSkipper map[string]bool
+
+func (s blockerPanicSkipper) CanSkipBlockerPanic(_ context.Context, moduleName string) (bool, error) {
+	return s[moduleName], nil
+}
+
+func TestCoreAPIManager_BlockerPanicSkipper(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	mockAppModule1 := mock.NewMockCoreAppModule(mockCtrl)
+	mockAppModule2 := mock.NewMockCoreAppModule(mockCtrl)
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": mockAppModule1,
+		"module2": mockAppModule2,
+	})
+	mm.SetOrderBeginBlockers("module1", "module2")
+
+	panicking := func(ctx context.Context) error {
+		sdk.UnwrapSDKContext(ctx).KVStore(key).Set([]byte("key"), []byte("value"))
+		panic("boom")
+	}
+
+	// consensus critical and duplicate modules cannot be put on the skip list
+	require.ErrorContains(t, module.ValidateBlockerPanicSkipList([]string{"staking"}), "consensus critical")
+	require.ErrorContains(t, module.ValidateBlockerPanicSkipList([]string{"module1", "module1"}), "duplicate")
+	require.NoError(t, module.ValidateBlockerPanicSkipList([]string{"module1"}))
+
+	// without skipper, panics are propagated
+	mockAppModule1.EXPECT().BeginBlock(gomock.Any()).Times(1).DoAndReturn(panicking)
+	require.PanicsWithValue(t, "boom", func() { _, _ = mm.BeginBlock(ctx) })
+
+	// the panic of a module not on the skip list is propagated
+	mm.SetBlockerPanicSkipper(blockerPanicSkipper{"module2": true})
+	mockAppModule1.EXPECT().BeginBlock(gomock.Any()).Times(1).DoAndReturn(panicking)
+	require.PanicsWithValue(t, "boom", func() { _, _ = mm.BeginBlock(ctx) })
+
+	// the panic of a module on the skip list is skipped and its state changes are discarded
+	ctx.KVStore(key).Delete([]byte("key"))
+	mm.SetBlockerPanicSkipper(blockerPanicSkipper{"module1": true})
+	mockAppModule1.EXPECT().BeginBlock(gomock.Any()).Times(1).DoAndReturn(panicking)
+	mockAppModule2.EXPECT().BeginBlock(gomock.Any()).Times(1).Return(nil)
+	_, err := mm.BeginBlock(ctx)
+	require.NoError(t, err)
+	require.False(t, ctx.KVStore(key).Has([]byte("key")))
+
+	// errors are still returned
+	mockAppModule1.EXPECT().EndBlock(gomock.Any()).Times(1).Return(errors.New("some error"))
+	_, err = mm.EndBlock(ctx)
+	require.EqualError(t, err, "some error")
+}
+
 func TestCoreAPIManager_EndBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -519,6 +576,28 @@ func TestManager_Precommit(t *testing.T) {
 	require.EqualError(t, err, "some error")
 }
 
+func TestManager_HealthCheck(t *testing.T) {
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": healthCheckModule{},
+		"module2": healthCheckModule{err: errFoo},
+		"module3": MockCoreAppModule{},
+	})
+
+	require.Equal(t, map[string]error{
+		"module1": nil,
+		"module2": errFoo,
+	}, mm.HealthCheck(context.Background()))
+}
+
+// healthCheckModule is a module reporting the given health.
+type healthCheckModule struct {
+	MockCoreAppModule
+
+	err error
+}
+
+func (m healthCheckModule) HealthCheck(context.Context) error { return m.err }
+
 // MockCoreAppModule allows us to test functions like DefaultGenesis
 type MockCoreAppModule struct{}
 
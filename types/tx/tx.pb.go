@@ -486,11 +486,12 @@ type AuthInfo struct {
 	// based on the cost of evaluating the body and doing signature verification
 	// of the signers. This can be estimated via simulation.
 	Fee *Fee `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
-	// Tip is the optional tip used for transactions fees paid in another denom.
+	// Tip is the optional tip paid by the tipper to the fee payer, used for
+	// transactions fees paid in another denom or by a relayer.
 	//
 	// This field is ignored if the chain didn't enable tips, i.e. didn't add the
 	// `TipDecorator` in its posthandler.
-	Tip *Tip `protobuf:"bytes,3,opt,name=tip,proto3" json:"tip,omitempty"` // Deprecated: Do not use.
+	Tip *Tip `protobuf:"bytes,3,opt,name=tip,proto3" json:"tip,omitempty"`
 }
 
 func (m *AuthInfo) Reset()         { *m = AuthInfo{} }
@@ -540,7 +541,6 @@ func (m *AuthInfo) GetFee() *Fee {
 	return nil
 }
 
-// Deprecated: Do not use.
 func (m *AuthInfo) GetTip() *Tip {
 	if m != nil {
 		return m.Tip
@@ -624,7 +624,6 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -895,8 +894,6 @@ func (m *Fee) GetGranter() string {
 }
 
 // Tip is the tip used for meta-transactions.
-//
-// Deprecated: Do not use.
 type Tip struct {
 	// amount is the amount of the tip
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
@@ -1050,79 +1047,79 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0xe3, 0x24, 0x9b, 0xbc, 0xee, 0xb6, 0xbb, 0x43, 0x8b, 0xb2, 0x29, 0xcd, 0x2e, 0xa9,
-	0x0a, 0x51, 0xc5, 0xda, 0xed, 0x16, 0x41, 0xa9, 0x10, 0x25, 0x69, 0xa9, 0x5a, 0x95, 0x82, 0xf0,
-	0xee, 0xa9, 0x17, 0x6b, 0x62, 0xcf, 0x3a, 0xa3, 0xc6, 0x33, 0xc6, 0x33, 0x86, 0xf8, 0xc8, 0x05,
-	0x4e, 0x48, 0x15, 0x17, 0x24, 0x3e, 0x01, 0xe2, 0xd4, 0x43, 0x25, 0xbe, 0x42, 0xb9, 0x55, 0x3d,
-	0x21, 0x0e, 0x6d, 0xd5, 0x3d, 0xf4, 0x63, 0x80, 0x3c, 0x1e, 0x7b, 0xb7, 0xdd, 0x34, 0x0b, 0x02,
-	0x89, 0x4b, 0xe4, 0x79, 0xf3, 0x7b, 0x6f, 0x7e, 0xef, 0x7f, 0xa0, 0xe3, 0x71, 0x11, 0x72, 0x61,
-	0xcb, 0xa9, 0xfd, 0xd5, 0xf9, 0x11, 0x91, 0xf8, 0xbc, 0x2d, 0xa7, 0x56, 0x14, 0x73, 0xc9, 0xd1,
-	0x4a, 0x7e, 0x67, 0xc9, 0xa9, 0xa5, 0xef, 0x3a, 0xab, 0xb9, 0xc8, 0x55, 0x00, 0x5b, 0xdf, 0xab,
-	0x43, 0x67, 0x05, 0x87, 0x94, 0x71, 0x5b, 0xfd, 0x6a, 0xd1, 0xf1, 0x80, 0x07, 0x3c, 0x87, 0x66,
-	0x5f, 0x5a, 0xba, 0xa1, 0x9f, 0xf4, 0xe2, 0x34, 0x92, 0xdc, 0x0e, 0x93, 0x89, 0xa4, 0x82, 0x06,
-	0xe5, 0xfb, 0x85, 0x40, 0xc3, 0xbb, 0x1a, 0x3e, 0xc2, 0x82, 0x94, 0x18, 0x8f, 0x53, 0xa6, 0xef,
-	0xdf, 0xde, 0xf3, 0x40, 0xd0, 0x80, 0x51, 0xb6, 0x67, 0x49, 0x9f, 0x35, 0x70, 0x35, 0xe0, 0x3c,
-	0x98, 0x10, 0x5b, 0x9d, 0x46, 0xc9, 0x8e, 0x8d, 0x59, 0xaa, 0xaf, 0xd6, 0x5e, 0xbe, 0x92, 0x34,
-	0x24, 0x42, 0xe2, 0x30, 0xca, 0x01, 0xbd, 0xef, 0x0d, 0xa8, 0x6e, 0x4f, 0xd1, 0x06, 0xd4, 0x46,
-	0xdc, 0x4f, 0xdb, 0xc6, 0xba, 0xd1, 0x3f, 0xb2, 0xb9, 0x6a, 0x1d, 0x08, 0x90, 0xb5, 0x3d, 0x1d,
-	0x72, 0x3f, 0x75, 0x14, 0x0c, 0x5d, 0x84, 0x16, 0x4e, 0xe4, 0xd8, 0xa5, 0x6c, 0x87, 0xb7, 0xab,
-	0x4a, 0xe7, 0xe4, 0x0c, 0x9d, 0x41, 0x22, 0xc7, 0x37, 0xd8, 0x0e, 0x77, 0x9a, 0x58, 0x7f, 0xa1,
-	0x2e, 0x40, 0x46, 0x1e, 0xcb, 0x24, 0x26, 0xa2, 0x6d, 0xae, 0x9b, 0xfd, 0x45, 0x67, 0x9f, 0xa4,
-	0xc7, 0xa0, 0xbe, 0x3d, 0x75, 0xf0, 0xd7, 0xe8, 0x14, 0x40, 0xf6, 0x94, 0x3b, 0x4a, 0x25, 0x11,
-	0x8a, 0xd7, 0xa2, 0xd3, 0xca, 0x24, 0xc3, 0x4c, 0x80, 0xde, 0x82, 0x63, 0x25, 0x03, 0x8d, 0xa9,
-	0x2a, 0xcc, 0x52, 0xf1, 0x54, 0x8e, 0x3b, 0xec, 0xbd, 0x1f, 0x0c, 0x58, 0xd8, 0xa2, 0x01, 0xbb,
-	0xca, 0xbd, 0xff, 0xea, 0xc9, 0x55, 0x68, 0x7a, 0x63, 0x4c, 0x99, 0x4b, 0xfd, 0xb6, 0xb9, 0x6e,
-	0xf4, 0x5b, 0xce, 0x82, 0x3a, 0xdf, 0xf0, 0xd1, 0x19, 0x38, 0x8a, 0x3d, 0x8f, 0x27, 0x4c, 0xba,
-	0x2c, 0x09, 0x47, 0x24, 0x6e, 0xd7, 0xd6, 0x8d, 0x7e, 0xcd, 0x59, 0xd2, 0xd2, 0xcf, 0x94, 0xb0,
-	0xf7, 0x5d, 0x15, 0x96, 0x35, 0xa9, 0xab, 0x34, 0x26, 0x9e, 0x1c, 0x24, 0xd3, 0xc3, 0xd8, 0x5d,
-	0x00, 0x88, 0x92, 0xd1, 0x84, 0x7a, 0xee, 0x1d, 0x92, 0xea, 0x9c, 0x1c, 0xb7, 0xf2, 0xf4, 0x5b,
-	0x45, 0xfa, 0xad, 0x01, 0x4b, 0x9d, 0x56, 0x8e, 0xbb, 0x49, 0xd2, 0x7f, 0x4f, 0x15, 0x75, 0xa0,
-	0x29, 0xc8, 0x97, 0x09, 0x61, 0x1e, 0x69, 0xd7, 0x15, 0xa0, 0x3c, 0xa3, 0x77, 0xc0, 0x94, 0x34,
-	0x6a, 0x37, 0x14, 0x97, 0xd7, 0x67, 0xd5, 0x14, 0x8d, 0x86, 0xd5, 0xb6, 0xe1, 0x64, 0xb0, 0x4b,
-	0xaf, 0x3d, 0xba, 0xbf, 0x71, 0x2c, 0xc7, 0x6c, 0x08, 0xff, 0xce, 0xfa, 0x39, 0xeb, 0xdd, 0xf7,
-	0x7a, 0xdf, 0x9a, 0xd0, 0xc8, 0x2b, 0x0f, 0x9d, 0x83, 0x66, 0x48, 0x84, 0xc0, 0x81, 0xf2, 0xde,
-	0x7c, 0xa5, 0x7b, 0x25, 0x0a, 0x21, 0xa8, 0x85, 0x24, 0xcc, 0x0b, 0xb4, 0xe5, 0xa8, 0xef, 0xcc,
-	0xad, 0xac, 0x05, 0x78, 0x22, 0xdd, 0x31, 0xa1, 0xc1, 0x58, 0x2a, 0xbf, 0x6b, 0xce, 0x92, 0x96,
-	0x5e, 0x57, 0x42, 0xf4, 0x06, 0xb4, 0x12, 0xc6, 0x63, 0x9f, 0xc4, 0xc4, 0x57, 0x8e, 0x37, 0x9d,
-	0x3d, 0x01, 0xfa, 0x02, 0x56, 0x0a, 0x23, 0x65, 0x3f, 0x29, 0xef, 0x8f, 0x6c, 0x76, 0x0e, 0x70,
-	0xda, 0x2e, 0x10, 0xc3, 0xe6, 0x83, 0xc7, 0x6b, 0xc6, 0xdd, 0x27, 0x6b, 0x86, 0xb3, 0xac, 0xd5,
-	0xcb, 0x3b, 0x34, 0x84, 0x15, 0x32, 0x95, 0x84, 0x09, 0xca, 0x99, 0xcb, 0x23, 0x49, 0x39, 0x13,
-	0xed, 0x3f, 0x17, 0xe6, 0xf8, 0xb9, 0x5c, 0xe2, 0x3f, 0xcf, 0xe1, 0xe8, 0x36, 0x74, 0x19, 0x67,
-	0xae, 0x17, 0x53, 0x49, 0x3d, 0x3c, 0x71, 0x67, 0x18, 0x3c, 0x36, 0xc7, 0xe0, 0x49, 0xc6, 0xd9,
-	0x15, 0xad, 0xfb, 0xc9, 0x4b, 0xb6, 0x7b, 0xbf, 0x1a, 0xd0, 0x2c, 0xda, 0x19, 0x7d, 0x0c, 0x8b,
-	0x59, 0x0b, 0x91, 0x58, 0xf5, 0x42, 0x91, 0x8e, 0x53, 0x33, 0x32, 0xbc, 0xa5, 0x60, 0x6a, 0x06,
-	0x1c, 0x11, 0xe5, 0xb7, 0x40, 0x7d, 0x30, 0x77, 0x08, 0xd1, 0x65, 0x3a, 0xab, 0x34, 0xae, 0x11,
-	0xe2, 0x64, 0x10, 0x74, 0x39, 0x2f, 0x22, 0x73, 0x6e, 0x11, 0x9d, 0xf8, 0xe3, 0x60, 0xed, 0xe8,
-	0xba, 0xea, 0xfd, 0x68, 0x00, 0xec, 0xd1, 0x78, 0xa9, 0x4f, 0x8c, 0xbf, 0xd7, 0x27, 0x17, 0xa1,
-	0x15, 0x72, 0x9f, 0x1c, 0x36, 0xef, 0x6e, 0x71, 0x9f, 0xe4, 0xf3, 0x2e, 0xd4, 0x5f, 0x2f, 0xf4,
-	0x87, 0xf9, 0x62, 0x7f, 0xf4, 0x9e, 0x56, 0xa1, 0x59, 0xa8, 0xa0, 0x0f, 0xa1, 0x21, 0x28, 0x0b,
-	0x26, 0x44, 0x73, 0xea, 0xcd, 0xb1, 0x6f, 0x6d, 0x29, 0xe4, 0xf5, 0x8a, 0xa3, 0x75, 0xd0, 0x07,
-	0x50, 0x57, 0xdb, 0x45, 0x93, 0x7b, 0x73, 0x9e, 0xf2, 0xad, 0x0c, 0x78, 0xbd, 0xe2, 0xe4, 0x1a,
-	0x9d, 0x01, 0x34, 0x72, 0x73, 0xe8, 0x7d, 0xa8, 0x65, 0xbc, 0x15, 0x81, 0xa3, 0x9b, 0xa7, 0xf7,
-	0xd9, 0x28, 0xf6, 0xcd, 0xfe, 0xb4, 0x66, 0xf6, 0x1c, 0xa5, 0xd0, 0xb9, 0x6b, 0x40, 0x5d, 0x59,
-	0x45, 0x37, 0xa1, 0x39, 0xa2, 0x12, 0xc7, 0x31, 0x2e, 0x62, 0x6b, 0x17, 0x66, 0xf2, 0xad, 0x68,
-	0x95, 0x4b, 0xb0, 0xb0, 0x75, 0x85, 0x87, 0x11, 0xf6, 0xe4, 0x90, 0xca, 0x41, 0xa6, 0xe6, 0x94,
-	0x06, 0xd0, 0x25, 0x80, 0x32, 0xea, 0xd9, 0xac, 0x35, 0x0f, 0x0b, 0x7b, 0xab, 0x08, 0xbb, 0x18,
-	0xd6, 0xc1, 0x14, 0x49, 0xd8, 0xfb, 0xa6, 0x0a, 0xe6, 0x35, 0x42, 0x50, 0x0a, 0x0d, 0x1c, 0x66,
-	0x63, 0x4b, 0xd7, 0x6a, 0xb9, 0xe1, 0xb2, 0xe5, 0xbb, 0x8f, 0x0a, 0x65, 0xc3, 0x6b, 0x0f, 0x1e,
-	0xaf, 0x55, 0x7e, 0x79, 0xb2, 0xd6, 0x0f, 0xa8, 0x1c, 0x27, 0x23, 0xcb, 0xe3, 0xa1, 0x5d, 0x2c,
-	0xf6, 0xb2, 0xc2, 0x6c, 0x99, 0x46, 0x44, 0x28, 0x05, 0xf1, 0xd3, 0xf3, 0x7b, 0x67, 0x17, 0x27,
-	0x24, 0xc0, 0x5e, 0xea, 0x66, 0xeb, 0x5b, 0xfc, 0xfc, 0xfc, 0xde, 0x59, 0xc3, 0xd1, 0x0f, 0xa2,
-	0x93, 0xd0, 0x0a, 0xb0, 0x70, 0x27, 0x34, 0xa4, 0x52, 0xa5, 0xa7, 0xe6, 0x34, 0x03, 0x2c, 0x3e,
-	0xcd, 0xce, 0xc8, 0x82, 0x7a, 0x84, 0x53, 0x12, 0xe7, 0xd3, 0x77, 0xd8, 0x7e, 0x74, 0x7f, 0xe3,
-	0xb8, 0x66, 0x36, 0xf0, 0xfd, 0x98, 0x08, 0xb1, 0x25, 0x63, 0xca, 0x02, 0x27, 0x87, 0xa1, 0x4d,
-	0x58, 0x08, 0x62, 0xcc, 0xa4, 0x1e, 0xc7, 0xf3, 0x34, 0x0a, 0x60, 0xef, 0x37, 0x03, 0xcc, 0x6d,
-	0x1a, 0xfd, 0x9f, 0x31, 0x38, 0x07, 0x0d, 0x49, 0xa3, 0x88, 0xc4, 0xf9, 0x2c, 0x9e, 0xc3, 0x5a,
-	0xe3, 0x2e, 0x9d, 0x78, 0x34, 0xab, 0xa3, 0x7b, 0xbb, 0x06, 0x2c, 0x0d, 0x92, 0x69, 0xde, 0xcf,
-	0x57, 0xb1, 0xc4, 0x59, 0x44, 0x70, 0x6e, 0x41, 0x15, 0xdc, 0xdc, 0x88, 0x68, 0x20, 0xfa, 0x08,
-	0x9a, 0x59, 0x45, 0xbb, 0x3e, 0xf7, 0x74, 0xc3, 0x9c, 0x7e, 0xc5, 0xec, 0xda, 0xbf, 0x81, 0x9d,
-	0x05, 0xa1, 0xff, 0x28, 0x14, 0x8d, 0x62, 0xfe, 0xc3, 0x46, 0x41, 0xcb, 0x60, 0x0a, 0x1a, 0xa8,
-	0xd4, 0x2d, 0x3a, 0xd9, 0xe7, 0xcc, 0xad, 0x37, 0xbc, 0xfc, 0xe0, 0x59, 0xd7, 0x78, 0xf8, 0xac,
-	0x6b, 0x3c, 0x7d, 0xd6, 0x35, 0xee, 0xee, 0x76, 0x2b, 0x0f, 0x77, 0xbb, 0x95, 0xdf, 0x77, 0xbb,
-	0x95, 0xdb, 0x67, 0x0e, 0x4f, 0x88, 0x2d, 0xa7, 0xa3, 0x86, 0x1a, 0x64, 0x17, 0xfe, 0x0a, 0x00,
-	0x00, 0xff, 0xff, 0x72, 0x63, 0x4f, 0x0e, 0x05, 0x0b, 0x00, 0x00,
+	// 1137 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0x8f, 0xd7, 0x9b, 0xcd, 0xee, 0x23, 0x81, 0x64, 0x40, 0x5f, 0x39, 0xcb, 0x97, 0x4d, 0xba,
+	0x88, 0x76, 0x85, 0x1a, 0x1b, 0x42, 0xd5, 0x52, 0xd4, 0x5f, 0xbb, 0x50, 0x04, 0xa2, 0xb4, 0xaa,
+	0x93, 0x13, 0x17, 0x6b, 0xd6, 0x9e, 0x78, 0x47, 0xac, 0x67, 0x5c, 0xcf, 0xb8, 0x5d, 0x1f, 0x7b,
+	0x69, 0x4f, 0x95, 0x50, 0x2f, 0x95, 0xfa, 0x17, 0x54, 0x3d, 0x71, 0xa0, 0x7f, 0x43, 0x39, 0x22,
+	0x4e, 0x55, 0x0f, 0x80, 0xc8, 0x81, 0x3f, 0xa3, 0x95, 0xc7, 0x63, 0x27, 0xc0, 0xb2, 0xdb, 0xaa,
+	0x95, 0x7a, 0xb1, 0x3c, 0x6f, 0x3e, 0xef, 0xcd, 0xe7, 0xfd, 0x86, 0xb6, 0xcf, 0x45, 0xc4, 0x85,
+	0x23, 0x27, 0xce, 0x97, 0xe7, 0x87, 0x44, 0xe2, 0xf3, 0x8e, 0x9c, 0xd8, 0x71, 0xc2, 0x25, 0x47,
+	0x6b, 0xc5, 0x9d, 0x2d, 0x27, 0xb6, 0xbe, 0x6b, 0xaf, 0x17, 0x22, 0x4f, 0x01, 0x1c, 0x7d, 0xaf,
+	0x0e, 0xed, 0x35, 0x1c, 0x51, 0xc6, 0x1d, 0xf5, 0xd5, 0xa2, 0x13, 0x21, 0x0f, 0x79, 0x01, 0xcd,
+	0xff, 0xb4, 0x74, 0x4b, 0x3f, 0xe9, 0x27, 0x59, 0x2c, 0xb9, 0x13, 0xa5, 0x63, 0x49, 0x05, 0x0d,
+	0xab, 0xf7, 0x4b, 0x81, 0x86, 0x77, 0x34, 0x7c, 0x88, 0x05, 0xa9, 0x30, 0x3e, 0xa7, 0x4c, 0xdf,
+	0xbf, 0x71, 0xe0, 0x81, 0xa0, 0x21, 0xa3, 0xec, 0xc0, 0x92, 0x3e, 0x6b, 0xe0, 0x7a, 0xc8, 0x79,
+	0x38, 0x26, 0x8e, 0x3a, 0x0d, 0xd3, 0x3d, 0x07, 0xb3, 0x4c, 0x5f, 0x6d, 0xbc, 0x78, 0x25, 0x69,
+	0x44, 0x84, 0xc4, 0x51, 0x5c, 0x00, 0xba, 0xdf, 0x19, 0x50, 0xdb, 0x9d, 0xa0, 0x2d, 0xa8, 0x0f,
+	0x79, 0x90, 0x59, 0xc6, 0xa6, 0xd1, 0x3b, 0xb2, 0xbd, 0x6e, 0xbf, 0x14, 0x20, 0x7b, 0x77, 0x32,
+	0xe0, 0x41, 0xe6, 0x2a, 0x18, 0xba, 0x08, 0x2d, 0x9c, 0xca, 0x91, 0x47, 0xd9, 0x1e, 0xb7, 0x6a,
+	0x4a, 0xe7, 0xe4, 0x14, 0x9d, 0x7e, 0x2a, 0x47, 0xd7, 0xd9, 0x1e, 0x77, 0x9b, 0x58, 0xff, 0xa1,
+	0x0e, 0x40, 0x4e, 0x1e, 0xcb, 0x34, 0x21, 0xc2, 0x32, 0x37, 0xcd, 0xde, 0xb2, 0x7b, 0x48, 0xd2,
+	0x65, 0xb0, 0xb8, 0x3b, 0x71, 0xf1, 0x57, 0xe8, 0x14, 0x40, 0xfe, 0x94, 0x37, 0xcc, 0x24, 0x11,
+	0x8a, 0xd7, 0xb2, 0xdb, 0xca, 0x25, 0x83, 0x5c, 0x80, 0x5e, 0x87, 0x63, 0x15, 0x03, 0x8d, 0xa9,
+	0x29, 0xcc, 0x4a, 0xf9, 0x54, 0x81, 0x9b, 0xf7, 0xde, 0xf7, 0x06, 0x2c, 0xed, 0xd0, 0x90, 0x5d,
+	0xe1, 0xfe, 0xbf, 0xf5, 0xe4, 0x3a, 0x34, 0xfd, 0x11, 0xa6, 0xcc, 0xa3, 0x81, 0x65, 0x6e, 0x1a,
+	0xbd, 0x96, 0xbb, 0xa4, 0xce, 0xd7, 0x03, 0x74, 0x06, 0x8e, 0x62, 0xdf, 0xe7, 0x29, 0x93, 0x1e,
+	0x4b, 0xa3, 0x21, 0x49, 0xac, 0xfa, 0xa6, 0xd1, 0xab, 0xbb, 0x2b, 0x5a, 0xfa, 0xa9, 0x12, 0x76,
+	0xbf, 0xad, 0xc1, 0xaa, 0x26, 0x75, 0x85, 0x26, 0xc4, 0x97, 0xfd, 0x74, 0x32, 0x8f, 0xdd, 0x05,
+	0x80, 0x38, 0x1d, 0x8e, 0xa9, 0xef, 0xdd, 0x26, 0x99, 0xce, 0xc9, 0x09, 0xbb, 0x48, 0xbf, 0x5d,
+	0xa6, 0xdf, 0xee, 0xb3, 0xcc, 0x6d, 0x15, 0xb8, 0x1b, 0x24, 0xfb, 0xe7, 0x54, 0x51, 0x1b, 0x9a,
+	0x82, 0x7c, 0x91, 0x12, 0xe6, 0x13, 0x6b, 0x51, 0x01, 0xaa, 0x33, 0x7a, 0x13, 0x4c, 0x49, 0x63,
+	0xab, 0xa1, 0xb8, 0xfc, 0x6f, 0x5a, 0x4d, 0xd1, 0x78, 0x50, 0xb3, 0x0c, 0x37, 0x87, 0x5d, 0x3a,
+	0xfe, 0xf0, 0xde, 0xd6, 0xb1, 0x02, 0xb3, 0x25, 0x82, 0xdb, 0x9b, 0xe7, 0xec, 0xb7, 0xde, 0xee,
+	0x7e, 0x63, 0x42, 0xa3, 0xa8, 0x3c, 0x74, 0x0e, 0x9a, 0x11, 0x11, 0x02, 0x87, 0xca, 0x7b, 0xf3,
+	0x95, 0xee, 0x55, 0x28, 0x84, 0xa0, 0x1e, 0x91, 0xa8, 0x28, 0xd0, 0x96, 0xab, 0xfe, 0x73, 0xb7,
+	0xf2, 0x16, 0xe0, 0xa9, 0xf4, 0x46, 0x84, 0x86, 0x23, 0xa9, 0xfc, 0xae, 0xbb, 0x2b, 0x5a, 0x7a,
+	0x4d, 0x09, 0xd1, 0xff, 0xa1, 0x95, 0x32, 0x9e, 0x04, 0x24, 0x21, 0x81, 0x72, 0xbc, 0xe9, 0x1e,
+	0x08, 0xd0, 0xe7, 0xb0, 0x56, 0x1a, 0xa9, 0xfa, 0x49, 0x79, 0x7f, 0x64, 0xbb, 0xfd, 0x12, 0xa7,
+	0xdd, 0x12, 0x31, 0x68, 0xde, 0x7f, 0xb4, 0x61, 0xdc, 0x79, 0xbc, 0x61, 0xb8, 0xab, 0x5a, 0xbd,
+	0xba, 0x43, 0x03, 0x58, 0x23, 0x13, 0x49, 0x98, 0xa0, 0x9c, 0x79, 0x3c, 0x96, 0x94, 0x33, 0x61,
+	0xfd, 0xb1, 0x34, 0xc3, 0xcf, 0xd5, 0x0a, 0xff, 0x59, 0x01, 0x47, 0xb7, 0xa0, 0xc3, 0x38, 0xf3,
+	0xfc, 0x84, 0x4a, 0xea, 0xe3, 0xb1, 0x37, 0xc5, 0xe0, 0xb1, 0x19, 0x06, 0x4f, 0x32, 0xce, 0x2e,
+	0x6b, 0xdd, 0x8f, 0x5f, 0xb0, 0xdd, 0xfd, 0xc5, 0x80, 0x66, 0xd9, 0xce, 0xe8, 0x23, 0x58, 0xce,
+	0x5b, 0x88, 0x24, 0xaa, 0x17, 0xca, 0x74, 0x9c, 0x9a, 0x92, 0xe1, 0x1d, 0x05, 0x53, 0x33, 0xe0,
+	0x88, 0xa8, 0xfe, 0x05, 0xea, 0x81, 0xb9, 0x47, 0x88, 0x55, 0x7b, 0x65, 0x69, 0x5c, 0x25, 0xc4,
+	0xcd, 0x21, 0xe8, 0xfd, 0xa2, 0x88, 0xcc, 0x99, 0x45, 0x74, 0xfc, 0xf7, 0x97, 0x6b, 0x47, 0x55,
+	0x55, 0xf7, 0x07, 0x03, 0xe0, 0x80, 0xc4, 0x0b, 0x5d, 0x62, 0xfc, 0xb5, 0x2e, 0xb9, 0x08, 0xad,
+	0x88, 0x07, 0x64, 0xde, 0xb4, 0xbb, 0xc9, 0x03, 0x52, 0x4c, 0xbb, 0x48, 0xff, 0x3d, 0xd7, 0x1d,
+	0xe6, 0xf3, 0xdd, 0xd1, 0x7d, 0x52, 0x83, 0x66, 0xa9, 0x82, 0xde, 0x83, 0x86, 0xa0, 0x2c, 0x1c,
+	0x13, 0xcd, 0xa9, 0x3b, 0xc3, 0xbe, 0xbd, 0xa3, 0x90, 0xd7, 0x16, 0x5c, 0xad, 0x83, 0xde, 0x85,
+	0x45, 0xb5, 0x5b, 0x34, 0xb9, 0xd7, 0x66, 0x29, 0xdf, 0xcc, 0x81, 0xd7, 0x16, 0xdc, 0x42, 0xa3,
+	0xdd, 0x87, 0x46, 0x61, 0x0e, 0xbd, 0x03, 0xf5, 0x9c, 0xb7, 0x22, 0x70, 0x74, 0xfb, 0xf4, 0x21,
+	0x1b, 0xe5, 0xb6, 0x39, 0x9c, 0xd4, 0xdc, 0x9e, 0xab, 0x14, 0xda, 0x77, 0x0c, 0x58, 0x54, 0x56,
+	0xd1, 0x0d, 0x68, 0x0e, 0xa9, 0xc4, 0x49, 0x82, 0xcb, 0xd8, 0x3a, 0xa5, 0x99, 0x62, 0x27, 0xda,
+	0xd5, 0x0a, 0x2c, 0x6d, 0x5d, 0xe6, 0x51, 0x8c, 0x7d, 0x39, 0xa0, 0xb2, 0x9f, 0xab, 0xb9, 0x95,
+	0x01, 0x74, 0x09, 0xa0, 0x8a, 0x7a, 0x3e, 0x69, 0xcd, 0x79, 0x61, 0x6f, 0x95, 0x61, 0x17, 0x83,
+	0x45, 0x30, 0x45, 0x1a, 0x75, 0xbf, 0xae, 0x81, 0x79, 0x95, 0x10, 0x94, 0x41, 0x03, 0x47, 0xf9,
+	0xd0, 0xd2, 0x95, 0x5a, 0xed, 0xb7, 0x7c, 0xf5, 0x1e, 0xa2, 0x42, 0xd9, 0xe0, 0xea, 0xfd, 0x47,
+	0x1b, 0x0b, 0x3f, 0x3f, 0xde, 0xe8, 0x85, 0x54, 0x8e, 0xd2, 0xa1, 0xed, 0xf3, 0xc8, 0x29, 0xd7,
+	0x7a, 0x55, 0x5f, 0x8e, 0xcc, 0x62, 0x22, 0x94, 0x82, 0xf8, 0xf1, 0xd9, 0xdd, 0xb3, 0xcb, 0x63,
+	0x12, 0x62, 0x3f, 0xf3, 0xf2, 0xe5, 0x2d, 0x7e, 0x7a, 0x76, 0xf7, 0xac, 0xe1, 0xea, 0x07, 0xd1,
+	0x49, 0x68, 0x85, 0x58, 0x78, 0x63, 0x1a, 0x51, 0xa9, 0xd2, 0x53, 0x77, 0x9b, 0x21, 0x16, 0x9f,
+	0xe4, 0x67, 0x64, 0xc3, 0x62, 0x8c, 0x33, 0x92, 0x14, 0xb3, 0x77, 0x60, 0x3d, 0xbc, 0xb7, 0x75,
+	0x42, 0x33, 0xeb, 0x07, 0x41, 0x42, 0x84, 0xd8, 0x91, 0x09, 0x65, 0xa1, 0x5b, 0xc0, 0xd0, 0x36,
+	0x2c, 0x85, 0x09, 0x66, 0x52, 0x0f, 0xe3, 0x59, 0x1a, 0x25, 0xb0, 0xfb, 0xab, 0x01, 0xe6, 0x2e,
+	0x8d, 0xff, 0xcb, 0x18, 0x9c, 0x83, 0x86, 0xa4, 0x71, 0x4c, 0x12, 0xab, 0x36, 0x87, 0xb5, 0xc6,
+	0x4d, 0xdf, 0x05, 0xfb, 0x06, 0xac, 0xf4, 0xd3, 0x49, 0xd1, 0xcd, 0x57, 0xb0, 0xc4, 0x79, 0x3c,
+	0x70, 0xa1, 0x6f, 0x19, 0x73, 0x2c, 0x97, 0x40, 0xf4, 0x01, 0x34, 0xf3, 0x7a, 0xf6, 0x02, 0xee,
+	0xeb, 0x76, 0x39, 0xfd, 0x8a, 0xb9, 0x75, 0x78, 0xfb, 0xba, 0x4b, 0xa2, 0x90, 0x54, 0x6d, 0x62,
+	0xfe, 0xcd, 0x36, 0x41, 0xab, 0x60, 0x0a, 0x1a, 0xaa, 0xc4, 0x2d, 0xbb, 0xf9, 0xef, 0x54, 0x2f,
+	0x07, 0x1f, 0xde, 0x7f, 0xda, 0x31, 0x1e, 0x3c, 0xed, 0x18, 0x4f, 0x9e, 0x76, 0x8c, 0x3b, 0xfb,
+	0x9d, 0x85, 0x07, 0xfb, 0x9d, 0x85, 0xdf, 0xf6, 0x3b, 0x0b, 0xb7, 0xce, 0xcc, 0x4f, 0x87, 0x23,
+	0x27, 0xc3, 0x86, 0x1a, 0x63, 0x17, 0xfe, 0x1c, 0x00, 0x3a, 0xd7, 0x93, 0xe5, 0x01, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -234,3 +234,37 @@ func TestContinousAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestContinousAccountClawback(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	acc, err := NewContinuousLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	startTime := time.Now()
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		Admin:     "admin",
+		EndTime:   startTime.Add(time.Minute * 2),
+		StartTime: startTime,
+	})
+	require.NoError(t, err)
+
+	// half of the coins are unlocked
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+	resp, err := acc.ClawbackFunds(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "admin",
+		Denoms:    []string{"test"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))), resp.ReturnedCoins)
+
+	_, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").IsZero())
+}
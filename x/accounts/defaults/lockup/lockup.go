@@ -34,6 +34,7 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	AdminPrefix            = collections.NewPrefix(8)
 )
 
 var (
@@ -49,6 +50,7 @@ type getLockedCoinsFunc = func(ctx context.Context, time time.Time, denoms ...st
 func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 	BaseLockup := &BaseLockup{
 		Owner:            collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		Admin:            collections.NewItem(d.SchemaBuilder, AdminPrefix, "admin", collections.BytesValue),
 		OriginalLocking:  collections.NewMap(d.SchemaBuilder, OriginalLockingPrefix, "original_locking", collections.StringKey, sdk.IntValue),
 		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
 		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
@@ -72,6 +74,9 @@ type BaseLockup struct {
 	headerService    header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
+	// Admin is the address allowed to claw back the locked coins, unset if
+	// clawback is disabled.
+	Admin collections.Item[[]byte]
 }
 
 func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (
@@ -86,6 +91,11 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	err = bva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
 	funds := accountstd.Funds(ctx)
 
 	sortedAmt := funds.Sort()
@@ -153,6 +163,20 @@ func (bva *BaseLockup) Delegate(
 		return nil, err
 	}
 
+	// the locked coins of an account with clawback enabled must stay in its
+	// balance to be reclaimable, only its unlocked coins can be delegated.
+	clawbackEnabled, err := bva.Admin.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if clawbackEnabled {
+		err = bva.checkTokensSendable(ctx, delegatorAddress, sdk.Coins{msg.Amount}, lockedCoins)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = sdk.Coins{}
+	}
+
 	err = bva.TrackDelegation(
 		ctx,
 		sdk.Coins{*balance},
@@ -369,6 +393,116 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 	}, nil
 }
 
+// ClawbackFunds allow the admin of the lockup to reclaim the coins still locked for specific
+// denoms to an account of choice. The lockup of the clawed back denoms ends, the coins
+// already unlocked stay in the account.
+func (bva *BaseLockup) ClawbackFunds(
+	ctx context.Context, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	err := bva.checkAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Denoms) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("denoms cannot be empty")
+	}
+	seen := make(map[string]struct{}, len(msg.Denoms))
+	for _, denom := range msg.Denoms {
+		if _, ok := seen[denom]; ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.Coins{}
+	for _, denom := range msg.Denoms {
+		balance, err := bva.getBalance(ctx, fromAddress, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		// locked coins are never delegated when clawback is enabled, so the
+		// balance covers them.
+		clawbackAmt := math.MinInt(lockedCoins.AmountOf(denom), balance.Amount)
+		if clawbackAmt.IsZero() {
+			continue
+		}
+		amount = append(amount, sdk.NewCoin(denom, clawbackAmt))
+
+		// end the lockup of the denom, what is left in the account is unlocked.
+		err = bva.OriginalLocking.Set(ctx, denom, math.ZeroInt())
+		if err != nil {
+			return nil, err
+		}
+		err = bva.WithdrawedCoins.Set(ctx, denom, math.ZeroInt())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(amount) == 0 {
+		return nil, errors.New("no tokens available for clawback")
+	}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: fromAddress,
+		ToAddress:   msg.ToAddress,
+		Amount:      amount,
+	}
+	_, err = sendMessage(ctx, msgSend)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgClawbackResponse{ReturnedCoins: amount}, nil
+}
+
+// setAdmin sets the admin of the lockup, enabling clawback, if one is given.
+func (bva *BaseLockup) setAdmin(ctx context.Context, admin string) error {
+	if admin == "" {
+		return nil
+	}
+
+	adminAddr, err := bva.addressCodec.StringToBytes(admin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'admin' address: %s", err)
+	}
+
+	return bva.Admin.Set(ctx, adminAddr)
+}
+
+func (bva *BaseLockup) checkAdmin(ctx context.Context, sender string) error {
+	admin, err := bva.Admin.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errors.New("clawback is not enabled for this lockup account")
+	}
+	if err != nil {
+		return err
+	}
+	senderBytes, err := bva.addressCodec.StringToBytes(sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(admin, senderBytes) {
+		return errors.New("sender is not the admin of this lockup account")
+	}
+
+	return nil
+}
+
 func (bva *BaseLockup) checkSender(ctx context.Context, sender string) error {
 	owner, err := bva.Owner.Get(ctx)
 	if err != nil {
@@ -658,8 +792,21 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 	}
 	delegatedFree := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatedFreeAmt))
 
+	adminAddress := ""
+	admin, err := bva.Admin.Get(ctx)
+	switch {
+	case err == nil:
+		adminAddress, err = bva.addressCodec.BytesToString(admin)
+		if err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
 	return &lockuptypes.QueryLockupAccountInfoResponse{
 		Owner:            ownerAddress,
+		Admin:            adminAddress,
 		OriginalLocking:  originalLocking,
 		DelegatedLocking: delegatedLocking,
 		DelegatedFree:    delegatedFree,
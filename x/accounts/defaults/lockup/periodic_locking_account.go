@@ -118,6 +118,10 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 	if err != nil {
 		return nil, err
 	}
+	err = pva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
 
 	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
 }
@@ -140,6 +144,42 @@ func (pva *PeriodicLockingAccount) WithdrawUnlockedCoins(ctx context.Context, ms
 	return pva.BaseLockup.WithdrawUnlockedCoins(ctx, msg, pva.GetLockedCoinsWithDenoms)
 }
 
+func (pva *PeriodicLockingAccount) ClawbackFunds(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	resp, err := pva.BaseLockup.ClawbackFunds(ctx, msg, pva.GetLockedCoinsWithDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	// the original locking of the clawed back denoms is now zero, remove them
+	// from the periods so that they are not unlocked anymore.
+	n, err := pva.LockingPeriods.Len(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < n; i++ {
+		period, err := pva.LockingPeriods.Get(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := sdk.Coins{}
+		for _, coin := range period.Amount {
+			if resp.ReturnedCoins.AmountOf(coin.Denom).IsZero() {
+				amount = append(amount, coin)
+			}
+		}
+		period.Amount = amount
+		err = pva.LockingPeriods.Replace(ctx, i, period)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
 // IteratePeriods iterates over all the Periods entries.
 func (pva PeriodicLockingAccount) IteratePeriods(
 	ctx context.Context,
@@ -337,6 +377,7 @@ func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.Ex
 	accountstd.RegisterExecuteHandler(builder, pva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, pva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, pva.ClawbackFunds)
 	pva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
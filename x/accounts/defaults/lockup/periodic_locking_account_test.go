@@ -273,3 +273,86 @@ func TestPeriodicAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestPeriodicAccountClawback(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+
+	// clawback is disabled without an admin
+	acc := setupPeriodicAccount(t, sdkCtx, ss)
+	_, err := acc.ClawbackFunds(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "owner",
+		ToAddress: "receiver",
+		Denoms:    []string{"test"},
+	})
+	require.ErrorContains(t, err, "clawback is not enabled")
+
+	ctx, ss = newMockContext(t)
+	sdkCtx = sdkCtx.WithContext(ctx)
+	acc, err = NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	startTime := time.Now()
+	_, err = acc.Init(sdkCtx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:     "owner",
+		Admin:     "admin",
+		StartTime: startTime,
+		LockingPeriods: []lockuptypes.Period{
+			{Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))), Length: time.Minute},
+			{Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))), Length: time.Minute},
+		},
+	})
+	require.NoError(t, err)
+
+	info, err := acc.QueryLockupAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "admin", info.Admin)
+
+	// locked coins cannot be delegated, they must stay reclaimable
+	_, err = acc.Delegate(sdkCtx, &lockuptypes.MsgDelegate{
+		Sender:           "owner",
+		ValidatorAddress: "val_address",
+		Amount:           sdk.NewCoin("test", math.NewInt(1)),
+	})
+	require.ErrorContains(t, err, "spendable balance")
+
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{
+		Time: startTime.Add(time.Minute),
+	})
+
+	_, err = acc.ClawbackFunds(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "owner",
+		ToAddress: "receiver",
+		Denoms:    []string{"test"},
+	})
+	require.ErrorContains(t, err, "not the admin")
+
+	_, err = acc.ClawbackFunds(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+		Denoms:    []string{"test", "test"},
+	})
+	require.ErrorContains(t, err, "duplicate denom")
+
+	resp, err := acc.ClawbackFunds(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+		Denoms:    []string{"test"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))), resp.ReturnedCoins)
+
+	// the lockup of the denom has ended
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, startTime.Add(time.Minute*2))
+	require.NoError(t, err)
+	require.True(t, locked.AmountOf("test").IsZero())
+	require.True(t, unlocked.AmountOf("test").IsZero())
+
+	_, err = acc.ClawbackFunds(sdkCtx, &lockuptypes.MsgClawback{
+		Admin:     "admin",
+		ToAddress: "receiver",
+		Denoms:    []string{"test"},
+	})
+	require.ErrorContains(t, err, "no tokens available for clawback")
+}
@@ -42,6 +42,9 @@ type MsgInitLockupAccount struct {
 	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 	// start of lockup
 	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// admin is the optional address allowed to claw back the locked coins of the
+	// account, e.g. its funder. Clawback is disabled when empty.
+	Admin string `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (m *MsgInitLockupAccount) Reset()         { *m = MsgInitLockupAccount{} }
@@ -98,6 +101,13 @@ func (m *MsgInitLockupAccount) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *MsgInitLockupAccount) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 // MsgInitLockupAccountResponse defines the Msg/InitLockupAccount response type.
 type MsgInitLockupAccountResponse struct {
 }
@@ -143,6 +153,9 @@ type MsgInitPeriodicLockingAccount struct {
 	// start of lockup
 	StartTime      time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	LockingPeriods []Period  `protobuf:"bytes,3,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods"`
+	// admin is the optional address allowed to claw back the locked coins of the
+	// account, e.g. its funder. Clawback is disabled when empty.
+	Admin string `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (m *MsgInitPeriodicLockingAccount) Reset()         { *m = MsgInitPeriodicLockingAccount{} }
@@ -199,6 +212,13 @@ func (m *MsgInitPeriodicLockingAccount) GetLockingPeriods() []Period {
 	return nil
 }
 
+func (m *MsgInitPeriodicLockingAccount) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 // MsgInitPeriodicLockingAccountResponse defines the Msg/InitPeriodicLockingAccount
 // response type.
 type MsgInitPeriodicLockingAccountResponse struct {
@@ -536,6 +556,92 @@ func (m *MsgWithdrawResponse) GetAmountReceived() github_com_cosmos_cosmos_sdk_t
 	return nil
 }
 
+// MsgClawback defines a message that the admin of the lockup can perform to
+// reclaim the coins still locked, ending the lockup of the clawed back denoms.
+type MsgClawback struct {
+	Admin     string   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	ToAddress string   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Denoms    []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{11}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+// MsgClawbackResponse defines the response for MsgClawback
+type MsgClawbackResponse struct {
+	ReturnedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=returned_coins,json=returnedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"returned_coins"`
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{12}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackResponse) GetReturnedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReturnedCoins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgInitLockupAccount)(nil), "cosmos.accounts.defaults.lockup.MsgInitLockupAccount")
 	proto.RegisterType((*MsgInitLockupAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitLockupAccountResponse")
@@ -548,6 +654,8 @@ func init() {
 	proto.RegisterType((*MsgExecuteMessagesResponse)(nil), "cosmos.accounts.defaults.lockup.MsgExecuteMessagesResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "cosmos.accounts.defaults.lockup.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "cosmos.accounts.defaults.lockup.MsgWithdrawResponse")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.accounts.defaults.lockup.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "cosmos.accounts.defaults.lockup.MsgClawbackResponse")
 }
 
 func init() {
@@ -555,58 +663,63 @@ func init() {
 }
 
 var fileDescriptor_e5f39108a4d67f92 = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0xaa, 0xc1, 0x43, 0x81, 0xb2, 0x58, 0xc5, 0x58, 0x65, 0x97, 0x5a, 0x42, 0x58,
-	0x56, 0xbd, 0x5b, 0x68, 0xa5, 0x56, 0x56, 0x2f, 0xb8, 0xf4, 0x97, 0x54, 0x57, 0xc8, 0xf4, 0x87,
-	0xd4, 0x1c, 0xac, 0xf1, 0xee, 0x30, 0xac, 0xf0, 0xce, 0x58, 0x3b, 0x63, 0x1b, 0xdf, 0xa2, 0x28,
-	0x87, 0x88, 0x13, 0xe7, 0x9c, 0x38, 0x45, 0x11, 0x27, 0x47, 0xe2, 0x8f, 0xe0, 0x88, 0x38, 0x71,
-	0x0a, 0x91, 0x89, 0x64, 0xfe, 0x8c, 0x68, 0x77, 0x66, 0x89, 0x0d, 0x04, 0x1c, 0x1f, 0x12, 0x29,
-	0x17, 0xef, 0xcc, 0xbc, 0xef, 0xbd, 0xf7, 0xbd, 0x6f, 0xde, 0xdb, 0x35, 0xc8, 0x5a, 0x94, 0xb9,
-	0x94, 0x99, 0xd0, 0xb2, 0x68, 0x83, 0x70, 0x66, 0xda, 0x68, 0x0b, 0x36, 0x6a, 0x9c, 0x99, 0x35,
-	0x6a, 0xed, 0x34, 0xea, 0x26, 0xdf, 0x35, 0xea, 0x1e, 0xe5, 0x54, 0xd5, 0x05, 0xd2, 0x08, 0x91,
-	0x46, 0x88, 0x34, 0x04, 0x32, 0x3d, 0x03, 0x5d, 0x87, 0x50, 0x33, 0xf8, 0x15, 0x3e, 0x69, 0x4d,
-	0x46, 0xaf, 0x42, 0x86, 0xcc, 0xe6, 0x4a, 0x15, 0x71, 0xb8, 0x62, 0x5a, 0xd4, 0x21, 0xd2, 0xfe,
-	0xcd, 0x7d, 0xd9, 0xc5, 0x43, 0xa2, 0xe7, 0x24, 0xda, 0x65, 0xd8, 0x6c, 0xae, 0xf8, 0x0f, 0x69,
-	0x98, 0x17, 0x86, 0x4a, 0xb0, 0x33, 0x25, 0x4f, 0x61, 0x4a, 0x62, 0x8a, 0xa9, 0x38, 0xf7, 0x57,
-	0xa1, 0x03, 0xa6, 0x14, 0xd7, 0x90, 0x19, 0xec, 0xaa, 0x8d, 0x2d, 0x13, 0x92, 0xb6, 0x34, 0xe9,
-	0xd7, 0x4d, 0xdc, 0x71, 0x11, 0xe3, 0xd0, 0x95, 0x2c, 0x32, 0x0f, 0xa3, 0x20, 0x59, 0x62, 0xf8,
-	0x0f, 0xe2, 0xf0, 0x3f, 0x03, 0x76, 0x6b, 0x82, 0xbc, 0x6a, 0x80, 0xcf, 0x68, 0x8b, 0x20, 0x2f,
-	0xa5, 0x2c, 0x2a, 0xd9, 0x44, 0x31, 0x75, 0x7a, 0x94, 0x4f, 0x4a, 0x2e, 0x6b, 0xb6, 0xed, 0x21,
-	0xc6, 0x36, 0xb9, 0xe7, 0x10, 0x5c, 0x16, 0x30, 0x75, 0x1d, 0x8c, 0x23, 0x62, 0x57, 0xfc, 0xf8,
-	0xa9, 0xe8, 0xa2, 0x92, 0x9d, 0x58, 0x4d, 0x1b, 0x22, 0xb9, 0x11, 0x26, 0x37, 0xfe, 0x0e, 0x93,
-	0x17, 0x27, 0x8f, 0x5f, 0xea, 0x91, 0xfd, 0x73, 0x5d, 0x79, 0xde, 0xeb, 0xe4, 0x94, 0xf2, 0x18,
-	0x22, 0xb6, 0x6f, 0x54, 0x7f, 0x07, 0x80, 0x71, 0xe8, 0x71, 0x11, 0x27, 0xf6, 0xbe, 0x71, 0x12,
-	0x81, 0xb3, 0x6f, 0x2e, 0x64, 0x2f, 0x0f, 0x74, 0x65, 0xaf, 0xd7, 0xc9, 0xc9, 0x9b, 0xce, 0x33,
-	0x7b, 0xc7, 0xbc, 0xad, 0xd2, 0x8c, 0x06, 0xbe, 0xba, 0xed, 0xbc, 0x8c, 0x58, 0x9d, 0x12, 0x86,
-	0x32, 0xcf, 0xa2, 0x60, 0x41, 0x02, 0x36, 0x90, 0xe7, 0x50, 0xdb, 0xb1, 0x7c, 0xa0, 0x43, 0xf0,
-	0xa8, 0x5a, 0x0d, 0x56, 0x19, 0x1d, 0xbd, 0x4a, 0xf5, 0x01, 0x98, 0xae, 0x09, 0x2e, 0x95, 0x7a,
-	0xc0, 0x8d, 0xa5, 0x62, 0x8b, 0xb1, 0xec, 0xc4, 0xea, 0xb2, 0x71, 0x4f, 0x83, 0x1b, 0xa2, 0x96,
-	0x62, 0xc2, 0x8f, 0x2d, 0xe2, 0x4e, 0xc9, 0x50, 0xc2, 0xc2, 0x0a, 0xc6, 0xe5, 0x81, 0x1e, 0xf1,
-	0x25, 0x5c, 0xba, 0x29, 0xa1, 0xc0, 0x0c, 0x0a, 0xb9, 0x0c, 0x96, 0xee, 0xd4, 0xe9, 0x4a, 0xd1,
-	0xae, 0x02, 0x26, 0x4a, 0x0c, 0xaf, 0xa3, 0x1a, 0xc2, 0x90, 0x23, 0xf5, 0x5b, 0x10, 0x67, 0x88,
-	0xd8, 0x43, 0x08, 0x28, 0x71, 0xea, 0x5f, 0x60, 0xa6, 0x09, 0x6b, 0x8e, 0x0d, 0x39, 0xf5, 0x2a,
-	0x50, 0x40, 0x02, 0x21, 0x13, 0xc5, 0xaf, 0x4f, 0x8f, 0xf2, 0x0b, 0xd2, 0xf9, 0xdf, 0x10, 0x33,
-	0x18, 0xe5, 0x8b, 0xe6, 0xb5, 0x73, 0xf5, 0x27, 0x10, 0x87, 0xae, 0xcf, 0x51, 0xf6, 0xdc, 0x7c,
-	0x28, 0x9f, 0x3f, 0xeb, 0x86, 0x9c, 0x75, 0xe3, 0x67, 0xea, 0x90, 0x7e, 0xc1, 0xa4, 0x4f, 0x61,
-	0xf6, 0xc9, 0x81, 0x1e, 0xf1, 0xc5, 0x7a, 0xd4, 0xeb, 0xe4, 0x24, 0xc5, 0xcc, 0x6b, 0x05, 0x4c,
-	0x96, 0x18, 0xfe, 0x87, 0xd8, 0x9f, 0x74, 0x99, 0x87, 0x0a, 0x98, 0x29, 0x31, 0xfc, 0x9f, 0xc3,
-	0xb7, 0x6d, 0x0f, 0xb6, 0xca, 0xa8, 0x05, 0x3d, 0xfb, 0xe3, 0x97, 0x7a, 0x3b, 0xd9, 0xc7, 0x51,
-	0x30, 0x56, 0x62, 0x78, 0x13, 0x91, 0x51, 0x28, 0xfe, 0x00, 0x00, 0xa7, 0xd7, 0xb8, 0xbd, 0xdb,
-	0x2b, 0xc1, 0x69, 0x28, 0x7b, 0xbb, 0x4f, 0xf6, 0xd8, 0xdd, 0xb2, 0xff, 0xea, 0xcb, 0x7e, 0x78,
-	0xae, 0x67, 0xb1, 0xc3, 0xb7, 0x1b, 0x55, 0xc3, 0xa2, 0xae, 0xfc, 0x04, 0x98, 0x7d, 0x43, 0xc8,
-	0xdb, 0x75, 0xc4, 0x02, 0x07, 0xf6, 0xb4, 0xd7, 0xc9, 0x7d, 0xee, 0x37, 0x98, 0xd5, 0xae, 0xf8,
-	0xdf, 0x22, 0x36, 0xc4, 0x9d, 0x6d, 0x80, 0x74, 0x89, 0xe1, 0x5f, 0x76, 0x91, 0xd5, 0xe0, 0xa8,
-	0x84, 0x18, 0x83, 0x18, 0xb1, 0x70, 0x3a, 0xd5, 0x55, 0x90, 0xf0, 0xe4, 0x9a, 0xa5, 0x94, 0x80,
-	0x70, 0xf2, 0xc6, 0xcb, 0x69, 0x8d, 0xb4, 0xcb, 0x6f, 0x61, 0x99, 0x17, 0x62, 0xa2, 0xc3, 0x2e,
-	0x50, 0x7f, 0x04, 0xa0, 0x25, 0xd7, 0x43, 0x08, 0xdc, 0x87, 0x1d, 0x5d, 0xe4, 0x2f, 0x41, 0xdc,
-	0x46, 0x84, 0xba, 0xe2, 0x0d, 0x98, 0x28, 0xcb, 0x5d, 0x61, 0xae, 0x5f, 0x81, 0xbe, 0x4c, 0x99,
-	0x33, 0x05, 0xcc, 0x0e, 0x74, 0xae, 0xac, 0xff, 0x7b, 0x30, 0xee, 0x21, 0x0b, 0x39, 0xcd, 0x21,
-	0x98, 0x5f, 0x21, 0xd5, 0x3d, 0x05, 0x4c, 0x0b, 0xcd, 0x2b, 0xf2, 0xcc, 0x4e, 0x45, 0x3f, 0xd4,
-	0x6d, 0x4f, 0x89, 0xcc, 0x65, 0x99, 0xb8, 0xf8, 0xdb, 0x71, 0x57, 0x53, 0x4e, 0xba, 0x9a, 0xf2,
-	0xaa, 0xab, 0x29, 0xfb, 0x17, 0x5a, 0xe4, 0xe4, 0x42, 0x8b, 0x9c, 0x5d, 0x68, 0x91, 0xff, 0xf3,
-	0x22, 0x2e, 0xb3, 0x77, 0x0c, 0x87, 0x9a, 0xbb, 0x77, 0xfc, 0x53, 0xf2, 0x93, 0x56, 0xe3, 0xc1,
-	0x85, 0x7f, 0xf7, 0x26, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xb6, 0xac, 0x3d, 0x59, 0x09, 0x00, 0x00,
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xc7, 0x3d, 0x0e, 0x75, 0xeb, 0x09, 0x49, 0xc9, 0xd6, 0xa2, 0x5b, 0x8b, 0xee, 0x86, 0x95,
+	0xaa, 0x5a, 0x16, 0x9e, 0x25, 0x01, 0x09, 0x14, 0x71, 0x89, 0x5b, 0xde, 0x24, 0x8c, 0x2a, 0x97,
+	0x17, 0x09, 0x0e, 0xd6, 0x78, 0x67, 0x3a, 0x5d, 0xd9, 0x3b, 0x63, 0xed, 0x8c, 0xed, 0xf8, 0x8a,
+	0x38, 0x54, 0x3d, 0xf5, 0xcc, 0xc9, 0xe2, 0x84, 0x7a, 0x32, 0x52, 0x3f, 0x44, 0x8f, 0x55, 0x0f,
+	0xa8, 0x27, 0x8a, 0x1c, 0x24, 0xf7, 0x63, 0xa0, 0xdd, 0x99, 0x4d, 0xed, 0x34, 0x24, 0x6e, 0x40,
+	0x45, 0xe2, 0x12, 0xef, 0xce, 0xf3, 0x7f, 0x9e, 0xe7, 0x3f, 0xbf, 0x79, 0xd9, 0xc0, 0x4a, 0x20,
+	0x64, 0x24, 0xa4, 0x8f, 0x83, 0x40, 0xf4, 0xb9, 0x92, 0x3e, 0xa1, 0xb7, 0x70, 0xbf, 0xab, 0xa4,
+	0xdf, 0x15, 0x41, 0xa7, 0xdf, 0xf3, 0xd5, 0x1e, 0xea, 0xc5, 0x42, 0x09, 0xcb, 0xd5, 0x4a, 0x94,
+	0x29, 0x51, 0xa6, 0x44, 0x5a, 0x59, 0xde, 0xc0, 0x51, 0xc8, 0x85, 0x9f, 0xfe, 0xd5, 0x39, 0x65,
+	0xc7, 0x54, 0x6f, 0x63, 0x49, 0xfd, 0xc1, 0x56, 0x9b, 0x2a, 0xbc, 0xe5, 0x07, 0x22, 0xe4, 0x26,
+	0xfe, 0xce, 0x49, 0xdd, 0xf5, 0x8f, 0x51, 0x5f, 0x34, 0xea, 0x48, 0x32, 0x7f, 0xb0, 0x95, 0xfc,
+	0x98, 0xc0, 0x25, 0x1d, 0x68, 0xa5, 0x6f, 0xbe, 0xf1, 0xa9, 0x43, 0x25, 0x26, 0x98, 0xd0, 0xe3,
+	0xc9, 0x53, 0x96, 0xc0, 0x84, 0x60, 0x5d, 0xea, 0xa7, 0x6f, 0xed, 0xfe, 0x2d, 0x1f, 0xf3, 0x91,
+	0x09, 0xb9, 0x87, 0x43, 0x2a, 0x8c, 0xa8, 0x54, 0x38, 0x32, 0x2e, 0xbc, 0x49, 0x1e, 0x96, 0x1a,
+	0x92, 0x7d, 0xce, 0x43, 0xf5, 0x45, 0xea, 0x6e, 0x57, 0x9b, 0xb7, 0x10, 0x3c, 0x23, 0x86, 0x9c,
+	0xc6, 0x36, 0xd8, 0x04, 0x95, 0x62, 0xdd, 0x7e, 0xfc, 0xa0, 0x56, 0x32, 0x5e, 0x76, 0x09, 0x89,
+	0xa9, 0x94, 0x37, 0x55, 0x1c, 0x72, 0xd6, 0xd4, 0x32, 0xeb, 0x3a, 0x3c, 0x47, 0x39, 0x69, 0x25,
+	0xf5, 0xed, 0xfc, 0x26, 0xa8, 0xac, 0x6e, 0x97, 0x91, 0x6e, 0x8e, 0xb2, 0xe6, 0xe8, 0xab, 0xac,
+	0x79, 0x7d, 0xed, 0xe1, 0xef, 0x6e, 0xee, 0xde, 0x53, 0x17, 0xfc, 0x32, 0x9b, 0x54, 0x41, 0xf3,
+	0x2c, 0xe5, 0x24, 0x09, 0x5a, 0x9f, 0x41, 0x28, 0x15, 0x8e, 0x95, 0xae, 0xb3, 0xf2, 0xb2, 0x75,
+	0x8a, 0x69, 0x72, 0x5a, 0x09, 0xc1, 0x33, 0x98, 0x44, 0x21, 0xb7, 0x5f, 0x3b, 0xc9, 0x7f, 0x2a,
+	0xdb, 0xa9, 0x3c, 0x1b, 0xbb, 0xe0, 0xee, 0x6c, 0x52, 0x35, 0x3b, 0xa3, 0x26, 0x49, 0xc7, 0x3f,
+	0x8a, 0x8c, 0xe7, 0xc0, 0xb7, 0x8e, 0x1a, 0x6f, 0x52, 0xd9, 0x13, 0x5c, 0x52, 0xef, 0xb7, 0x3c,
+	0xbc, 0x6c, 0x04, 0x37, 0x68, 0x1c, 0x0a, 0x12, 0x06, 0x89, 0x30, 0xe4, 0xec, 0xb4, 0x6c, 0x17,
+	0xa9, 0xe4, 0xff, 0x01, 0x95, 0xef, 0xe1, 0xf9, 0xae, 0xf6, 0xd2, 0xea, 0xa5, 0xde, 0xa4, 0xbd,
+	0xb2, 0xb9, 0x52, 0x59, 0xdd, 0xbe, 0x8a, 0x4e, 0x38, 0x10, 0x48, 0xcf, 0xa5, 0x5e, 0x4c, 0x6a,
+	0xeb, 0xba, 0xeb, 0xa6, 0x94, 0x8e, 0xc8, 0x97, 0x46, 0x8e, 0x9e, 0x8d, 0xdd, 0x5c, 0x82, 0xfc,
+	0xca, 0x8b, 0xc8, 0x75, 0xcd, 0x45, 0xf0, 0x57, 0xe1, 0x95, 0x63, 0xb9, 0x1e, 0xac, 0xc0, 0x14,
+	0xc0, 0xd5, 0x86, 0x64, 0xd7, 0x69, 0x97, 0x32, 0xac, 0xa8, 0xf5, 0x2e, 0x2c, 0x48, 0xca, 0xc9,
+	0x12, 0xc0, 0x8d, 0xce, 0xfa, 0x12, 0x6e, 0x0c, 0x70, 0x37, 0x24, 0x58, 0x89, 0xb8, 0x85, 0xb5,
+	0x24, 0x05, 0x5f, 0xac, 0xbf, 0xfd, 0xf8, 0x41, 0xed, 0xb2, 0x49, 0xfe, 0x26, 0xd3, 0x2c, 0x56,
+	0x79, 0x63, 0x70, 0x68, 0xdc, 0xfa, 0x08, 0x16, 0x70, 0x94, 0x78, 0x34, 0x7b, 0xfa, 0x52, 0x86,
+	0x3b, 0xb9, 0x4b, 0x90, 0xb9, 0x4b, 0xd0, 0x35, 0x11, 0xf2, 0x79, 0xc0, 0x26, 0x67, 0xe7, 0xc2,
+	0x9d, 0xb1, 0x9b, 0x4b, 0x60, 0xfd, 0x30, 0x9b, 0x54, 0x8d, 0x45, 0xef, 0x4f, 0x00, 0xd7, 0x1a,
+	0x92, 0x7d, 0xcd, 0xc9, 0xff, 0x7a, 0x9a, 0xf7, 0x01, 0xdc, 0x68, 0x48, 0xf6, 0x6d, 0xa8, 0x6e,
+	0x93, 0x18, 0x0f, 0x9b, 0x74, 0x88, 0x63, 0xf2, 0xdf, 0x4f, 0xf5, 0x68, 0xb3, 0x3f, 0xe6, 0xe1,
+	0xd9, 0x86, 0x64, 0x37, 0x29, 0x3f, 0x8d, 0xc5, 0x0f, 0x20, 0x54, 0xe2, 0x90, 0xb7, 0xbf, 0xcf,
+	0x2a, 0x2a, 0x91, 0x61, 0x1f, 0xcd, 0x61, 0x5f, 0x39, 0x1e, 0xfb, 0x27, 0x09, 0xf6, 0xfb, 0x4f,
+	0xdd, 0x0a, 0x0b, 0xd5, 0xed, 0x7e, 0x1b, 0x05, 0x22, 0x32, 0x9f, 0x18, 0x7f, 0xee, 0x10, 0xaa,
+	0x51, 0x8f, 0xca, 0x34, 0x41, 0xfe, 0x34, 0x9b, 0x54, 0x5f, 0x4f, 0x36, 0x58, 0x30, 0x6a, 0x25,
+	0xdf, 0x3a, 0xb9, 0xc4, 0x9a, 0xdd, 0x80, 0xe5, 0x86, 0x64, 0x1f, 0xef, 0xd1, 0xa0, 0xaf, 0x68,
+	0x83, 0x4a, 0x89, 0x19, 0x95, 0xd9, 0xe9, 0xb4, 0xb6, 0x61, 0x31, 0x36, 0xcf, 0xd2, 0x06, 0xa9,
+	0xe1, 0xd2, 0x0b, 0x97, 0xd9, 0x2e, 0x1f, 0x35, 0x9f, 0xcb, 0xbc, 0x5f, 0xf5, 0x89, 0xce, 0x76,
+	0x81, 0xf5, 0x21, 0x84, 0x43, 0xf3, 0xbc, 0x04, 0xe0, 0x39, 0xed, 0xe9, 0x21, 0xbf, 0x09, 0x0b,
+	0x84, 0x72, 0x11, 0xe9, 0x1b, 0xb3, 0xd8, 0x34, 0x6f, 0x3b, 0x17, 0xe7, 0x09, 0xcc, 0x75, 0xf2,
+	0x9e, 0x00, 0x78, 0x61, 0x61, 0xe7, 0x9a, 0xf9, 0xbf, 0x0f, 0xcf, 0xc5, 0x34, 0xa0, 0xe1, 0x60,
+	0x09, 0xe7, 0x07, 0x4a, 0xeb, 0x2e, 0x80, 0xe7, 0x35, 0xf3, 0x96, 0x19, 0x23, 0x76, 0xfe, 0x55,
+	0xad, 0xf6, 0xba, 0xee, 0xdc, 0x34, 0x8d, 0xbd, 0x9f, 0xf5, 0x72, 0x5c, 0xeb, 0xe2, 0x61, 0x1b,
+	0x07, 0x9d, 0xe7, 0x37, 0x3f, 0x58, 0xea, 0xe6, 0xff, 0xf7, 0x17, 0xc1, 0x9a, 0x5f, 0x04, 0xdd,
+	0xc4, 0x1b, 0x6b, 0xfe, 0x99, 0xc9, 0x03, 0xfe, 0x77, 0x00, 0x5c, 0x8f, 0xa9, 0xea, 0xc7, 0x9c,
+	0x12, 0x3d, 0x49, 0x1b, 0xbc, 0x2a, 0x90, 0x6b, 0x59, 0xe3, 0x54, 0x54, 0xff, 0xf4, 0xe1, 0xd4,
+	0x01, 0x8f, 0xa6, 0x0e, 0xf8, 0x63, 0xea, 0x80, 0x7b, 0xfb, 0x4e, 0xee, 0xd1, 0xbe, 0x93, 0x7b,
+	0xb2, 0xef, 0xe4, 0xbe, 0xab, 0xe9, 0xb2, 0x92, 0x74, 0x50, 0x28, 0xfc, 0xbd, 0x63, 0xfe, 0xa3,
+	0x4d, 0x7a, 0xb6, 0x0b, 0xe9, 0xc1, 0x79, 0xef, 0xaf, 0x01, 0x00, 0x4d, 0xc8, 0x21, 0x5a, 0x01,
+	0x0b, 0x00, 0x00,
 }
 
 func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
@@ -637,6 +750,9 @@ func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
 	if !this.StartTime.Equal(that1.StartTime) {
 		return false
 	}
+	if this.Admin != that1.Admin {
+		return false
+	}
 	return true
 }
 func (m *MsgInitLockupAccount) Marshal() (dAtA []byte, err error) {
@@ -659,6 +775,13 @@ func (m *MsgInitLockupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x22
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
 	if err1 != nil {
 		return 0, err1
@@ -728,6 +851,13 @@ func (m *MsgInitPeriodicLockingAccount) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.LockingPeriods) > 0 {
 		for iNdEx := len(m.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1092,6 +1222,89 @@ func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnedCoins) > 0 {
+		for iNdEx := len(m.ReturnedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReturnedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1117,6 +1330,10 @@ func (m *MsgInitLockupAccount) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1147,6 +1364,10 @@ func (m *MsgInitPeriodicLockingAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1294,6 +1515,44 @@ func (m *MsgWithdrawResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReturnedCoins) > 0 {
+		for _, e := range m.ReturnedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1427,6 +1686,38 @@ func (m *MsgInitLockupAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1626,6 +1917,38 @@ func (m *MsgInitPeriodicLockingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2599,6 +2922,236 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnedCoins = append(m.ReturnedCoins, types.Coin{})
+			if err := m.ReturnedCoins[len(m.ReturnedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
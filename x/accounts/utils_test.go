@@ -67,6 +67,12 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 		&bankv1beta1.MsgSetSendEnabled{},
 		&bankv1beta1.MsgMultiSend{},
 		&bankv1beta1.MsgUpdateParams{},
+		&bankv1beta1.MsgUpdateDenomMetadata{},
+		&bankv1beta1.MsgCreatePeriodicPayment{},
+		&bankv1beta1.MsgCancelPeriodicPayment{},
+		&bankv1beta1.MsgCreateHTLC{},
+		&bankv1beta1.MsgClaimHTLC{},
+		&bankv1beta1.MsgRefundHTLC{},
 	)
 	queryRouter.RegisterService(&bankv1beta1.Query_ServiceDesc, &bankQueryServer{})
 	msgRouter.RegisterService(&bankv1beta1.Msg_ServiceDesc, &bankMsgServer{})
@@ -23,6 +23,9 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	// EnableTips adds the ValidateTipDecorator, it must be set when the
+	// TipDecorator post handler is enabled.
+	EnableTips bool
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -47,11 +50,17 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewValidateBasicDecorator(options.Environment),
 		NewTxTimeoutHeightDecorator(options.Environment),
 		NewValidateMemoDecorator(options.AccountKeeper),
+	}
+	if options.EnableTips {
+		anteDecorators = append(anteDecorators, NewValidateTipDecorator(options.AccountKeeper))
+	}
+	anteDecorators = append(anteDecorators,
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewMsgMinFeeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
@@ -59,7 +59,12 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 
 	fee := feeTx.GetFee()
 	if execMode != transaction.ExecModeSimulate {
-		fee, priority, err = dfd.txFeeChecker(ctx, tx)
+		feeCtx := ctx
+		if dfd.isMinGasPriceExempt(ctx, tx) {
+			feeCtx = ctx.WithMinGasPrices(nil)
+		}
+
+		fee, priority, err = dfd.txFeeChecker(feeCtx, tx)
 		if err != nil {
 			return ctx, err
 		}
@@ -73,6 +78,28 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 	return next(newCtx, tx, false)
 }
 
+// isMinGasPriceExempt returns true if all the msgs of the tx are exempt from the
+// validators minimum gas prices by the auth params.
+func (dfd DeductFeeDecorator) isMinGasPriceExempt(ctx sdk.Context, tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	params := dfd.accountKeeper.GetParams(ctx)
+	if len(params.MinGasPriceExemptMsgTypes) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !params.IsMinGasPriceExempt(sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
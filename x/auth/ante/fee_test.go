@@ -105,6 +105,40 @@ func TestEnsureMempoolFees(t *testing.T) {
 	require.Equal(t, int64(10), newCtx.Priority())
 }
 
+func TestDeductFeeDecorator_MinGasPriceExempt(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	accs := s.CreateTestAccounts(1)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(15)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	s.ctx = s.ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(20))})
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// exempt the msg type from the minimum gas prices, the fee is still deducted
+	params := authtypes.DefaultParams()
+	params.MinGasPriceExemptMsgTypes = []string{sdk.MsgTypeURL(msg)}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(1)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+}
+
 func TestDeductFees(t *testing.T) {
 	s := SetupTestSuite(t, false)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
@@ -0,0 +1,132 @@
+package ante
+
+import (
+	"context"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgMinFeeDecorator checks that the fee of a tx covers the minimum fees set by
+// governance for its msg types in the auth params, i.e. the sum of the min fee
+// of each of its msgs, including the msgs nested in them (e.g. in an authz
+// MsgExec, a group proposal or an x/accounts MsgExecute). It composes with the validators minimum gas prices
+// checked by the DeductFeeDecorator, both must be satisfied.
+// Unlike the minimum gas prices, the check is consensus critical and thus also
+// run in DeliverTx. It is skipped when simulating.
+// CONTRACT: Tx must implement FeeTx interface to use MsgMinFeeDecorator
+type MsgMinFeeDecorator struct {
+	ak AccountKeeper
+}
+
+func NewMsgMinFeeDecorator(ak AccountKeeper) MsgMinFeeDecorator {
+	return MsgMinFeeDecorator{
+		ak: ak,
+	}
+}
+
+// AnteHandle implements an AnteHandler decorator for the MsgMinFeeDecorator.
+func (mfd MsgMinFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err := mfd.ValidateTx(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateTx implements an TxValidator for MsgMinFeeDecorator
+func (mfd MsgMinFeeDecorator) ValidateTx(ctx context.Context, tx sdk.Tx) error {
+	if mfd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate {
+		return nil
+	}
+
+	params := mfd.ak.GetParams(ctx)
+	if len(params.MsgMinFees) == 0 {
+		return nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the FeeTx interface")
+	}
+
+	var requiredFee sdk.Coins
+	for _, msg := range tx.GetMsgs() {
+		fee, err := msgMinFee(params, msg, 0)
+		if err != nil {
+			return err
+		}
+		requiredFee = requiredFee.Add(fee...)
+	}
+
+	if !requiredFee.IsZero() && !feeTx.GetFee().IsAllGTE(requiredFee) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the msgs of the tx; got: %s required: %s", feeTx.GetFee(), requiredFee)
+	}
+
+	return nil
+}
+
+// maxNestedMsgsDepth is the maximum depth of nested msgs resolved when
+// computing the min fee of a msg.
+const maxNestedMsgsDepth = 8
+
+// msgMinFee returns the min fee of a msg, i.e. the sum of the min fee of its
+// type and of the min fees of the msgs nested in it.
+func msgMinFee(params types.Params, msg gogoproto.Message, depth int) (sdk.Coins, error) {
+	if depth > maxNestedMsgsDepth {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "msg nesting exceeds the maximum depth of %d", maxNestedMsgsDepth)
+	}
+
+	fee := params.MsgMinFee(sdk.MsgTypeURL(msg))
+
+	var nestedMsgs []sdk.Msg
+	switch msg := msg.(type) {
+	case interface{ GetMessages() ([]sdk.Msg, error) }: // e.g. authz MsgExec
+		msgs, err := msg.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+		nestedMsgs = msgs
+
+	case interface{ GetMsgs() ([]sdk.Msg, error) }: // e.g. group and gov MsgSubmitProposal
+		msgs, err := msg.GetMsgs()
+		if err != nil {
+			return nil, err
+		}
+		nestedMsgs = msgs
+
+	case interface{ GetMessage() *gogoprotoany.Any }: // e.g. x/accounts MsgExecute
+		anyMsg := msg.GetMessage()
+		if anyMsg == nil {
+			return fee, nil
+		}
+
+		// the msgs executed by an account are not necessarily registered sdk.Msg,
+		// their min fee is looked up by type URL.
+		if nested, ok := anyMsg.GetCachedValue().(gogoproto.Message); ok {
+			nestedFee, err := msgMinFee(params, nested, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			return fee.Add(nestedFee...), nil
+		}
+		return fee.Add(params.MsgMinFee(anyMsg.TypeUrl)...), nil
+	}
+
+	for _, nested := range nestedMsgs {
+		nestedFee, err := msgMinFee(params, nested, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		fee = fee.Add(nestedFee...)
+	}
+
+	return fee, nil
+}
@@ -0,0 +1,65 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestMsgMinFeeDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mmfd := ante.NewMsgMinFeeDecorator(s.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(mmfd)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// 2 msgs of the same type, paying a fee of 150atom
+	msg := testdata.NewTestMsg(addr1)
+	require.NoError(t, s.txBuilder.SetMsgs(msg, msg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// no msg min fees
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// the min fee is required for each msg
+	params := authtypes.DefaultParams()
+	params.MsgMinFees = []authtypes.MsgMinFee{{MsgTypeUrl: sdk.MsgTypeURL(msg), MinFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))}}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the check is skipped when simulating
+	_, err = antehandler(s.ctx.WithExecMode(sdk.ExecModeSimulate), tx, true)
+	require.NoError(t, err)
+
+	// all the denoms of the min fee are required
+	params.MsgMinFees = []authtypes.MsgMinFee{{MsgTypeUrl: sdk.MsgTypeURL(msg), MinFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 1))}}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	params.MsgMinFees = []authtypes.MsgMinFee{{MsgTypeUrl: sdk.MsgTypeURL(msg), MinFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 75))}}
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+}
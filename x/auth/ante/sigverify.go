@@ -534,6 +534,38 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 	}
 }
 
+// NewPubKeyValidator returns a function rejecting the public key types the given
+// SignatureVerificationGasConsumer rejects, to be set on the account keeper with
+// SetPubKeyValidator so that accounts can only change to keys accepted by the
+// ante handler. The consumed gas is discarded.
+func NewPubKeyValidator(sigGasConsumer SignatureVerificationGasConsumer) func(cryptotypes.PubKey) error {
+	return func(pubKey cryptotypes.PubKey) error {
+		sig := signing.SignatureV2{PubKey: pubKey, Data: pubKeySignatureData(pubKey)}
+		return sigGasConsumer(storetypes.NewInfiniteGasMeter(), sig, types.DefaultParams())
+	}
+}
+
+// pubKeySignatureData returns empty signature data with the shape of the
+// signatures of the given public key, one signature per multisig sub key.
+func pubKeySignatureData(pubKey cryptotypes.PubKey) signing.SignatureData {
+	multisigPubKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return &signing.SingleSignatureData{}
+	}
+
+	subKeys := multisigPubKey.GetPubKeys()
+	data := &signing.MultiSignatureData{
+		BitArray:   cryptotypes.NewCompactBitArray(len(subKeys)),
+		Signatures: make([]signing.SignatureData, len(subKeys)),
+	}
+	for i, subKey := range subKeys {
+		data.BitArray.SetIndex(i, true)
+		data.Signatures[i] = pubKeySignatureData(subKey)
+	}
+
+	return data
+}
+
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubKey signature.
 func ConsumeMultisignatureVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubKey multisig.PubKey,
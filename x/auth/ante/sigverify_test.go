@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/x/auth/types"
 	txsigning "cosmossdk.io/x/tx/signing"
 
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -93,6 +95,20 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}
 }
 
+func TestNewPubKeyValidator(t *testing.T) {
+	validate := ante.NewPubKeyValidator(ante.DefaultSigVerificationGasConsumer)
+	skR1, _ := secp256r1.GenPrivKey()
+	pkSet, _ := generatePubKeysAndSignatures(3, []byte{1, 2, 3, 4}, false)
+
+	require.NoError(t, validate(secp256k1.GenPrivKey().PubKey()))
+	require.NoError(t, validate(skR1.PubKey()))
+	require.NoError(t, validate(kmultisig.NewLegacyAminoPubKey(2, pkSet)))
+	require.ErrorIs(t, validate(ed25519.GenPrivKey().PubKey()), sdkerrors.ErrInvalidPubKey)
+
+	pkSet[1] = ed25519.GenPrivKey().PubKey()
+	require.ErrorIs(t, validate(kmultisig.NewLegacyAminoPubKey(2, pkSet)), sdkerrors.ErrInvalidPubKey)
+}
+
 func TestSigVerification(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 	suite.txBankKeeper.EXPECT().DenomMetadataV2(gomock.Any(), gomock.Any()).Return(&bankv1beta1.QueryDenomMetadataResponse{}, nil).AnyTimes()
@@ -236,6 +252,131 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationMultisigAccount(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	genKeys := func(n int) ([]cryptotypes.PrivKey, []cryptotypes.PubKey) {
+		privs := make([]cryptotypes.PrivKey, n)
+		pubKeys := make([]cryptotypes.PubKey, n)
+		for i := range privs {
+			privs[i] = secp256k1.GenPrivKey()
+			pubKeys[i] = privs[i].PubKey()
+		}
+		return privs, pubKeys
+	}
+
+	privs, pubKeys := genKeys(3)
+	addr, err := suite.accountKeeper.CreateMultisigAccount(suite.ctx, 2, pubKeys)
+	require.NoError(t, err)
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	// signs a tx for the multisig account with the given members of the given
+	// policy.
+	runTx := func(threshold int, memberKeys []cryptotypes.PubKey, signers []cryptotypes.PrivKey) error {
+		acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+		multisigPubKey := kmultisig.NewLegacyAminoPubKey(threshold, memberKeys)
+
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		signerData := authsign.SignerData{
+			Address:       addr.String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        multisigPubKey,
+		}
+		multisigSig := multisig.NewMultisig(len(memberKeys))
+		for _, priv := range signers {
+			sig, err := tx.SignWithPrivKey(suite.ctx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, suite.txBuilder, priv, suite.clientCtx.TxConfig, acc.GetSequence())
+			require.NoError(t, err)
+			require.NoError(t, multisig.AddSignatureV2(multisigSig, sig, memberKeys))
+		}
+		require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   multisigPubKey,
+			Data:     multisigSig,
+			Sequence: acc.GetSequence(),
+		}))
+
+		sdkTx := suite.txBuilder.GetTx()
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(sdkTx)
+		require.NoError(t, err)
+		_, err = antehandler(suite.ctx.WithTxBytes(txBytes), sdkTx, false)
+		return err
+	}
+
+	require.ErrorIs(t, runTx(2, pubKeys, privs[:1]), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, runTx(1, pubKeys, privs[:1]), sdkerrors.ErrUnauthorized)
+	require.NoError(t, runTx(2, pubKeys, privs[1:]))
+
+	// rotating the members keeps the address, the old policy cannot sign anymore.
+	newPrivs, newPubKeys := genKeys(2)
+	newPubKeys = append(newPubKeys, pubKeys[0])
+	require.NoError(t, suite.accountKeeper.UpdateMultisigPolicy(suite.ctx, addr, 2, newPubKeys))
+	require.ErrorIs(t, runTx(2, pubKeys, privs[1:]), sdkerrors.ErrUnauthorized)
+	require.NoError(t, runTx(2, newPubKeys, []cryptotypes.PrivKey{newPrivs[0], privs[0]}))
+
+	acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.Equal(t, uint64(2), acc.GetSequence())
+}
+
+func TestSigVerificationChangedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	oldPriv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(oldPriv.PubKey().Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(oldPriv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	runTx := func(priv cryptotypes.PrivKey) error {
+		acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		signerData := authsign.SignerData{
+			Address:       addr.String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        priv.PubKey(),
+		}
+		sig, err := tx.SignWithPrivKey(suite.ctx, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, suite.txBuilder, priv, suite.clientCtx.TxConfig, acc.GetSequence())
+		require.NoError(t, err)
+		require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+		sdkTx := suite.txBuilder.GetTx()
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(sdkTx)
+		require.NoError(t, err)
+		_, err = antehandler(suite.ctx.WithTxBytes(txBytes), sdkTx, false)
+		return err
+	}
+
+	require.NoError(t, runTx(oldPriv))
+
+	// after a rotation the account keeps its address but only the new key
+	// can sign for it.
+	newPriv := secp256k1.GenPrivKey()
+	require.NoError(t, suite.accountKeeper.ChangePubKey(suite.ctx, addr, newPriv.PubKey()))
+	require.ErrorIs(t, runTx(oldPriv), sdkerrors.ErrUnauthorized)
+	require.NoError(t, runTx(newPriv))
+
+	acc = suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.Equal(t, uint64(2), acc.GetSequence())
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -103,7 +103,7 @@ func SetupTestSuite(t *testing.T, isCheckTx bool) *AnteTestSuite {
 	suite.consensusKeeper.EXPECT().Params(gomock.Any(), gomock.Any()).Return(&consensustypes.QueryParamsResponse{
 		Params: simtestutil.DefaultConsensusParams,
 	}, nil).AnyTimes()
-	consensustypes.RegisterQueryServer(grpcQueryRouter, suite.consensusKeeper)
+	consensustypes.RegisterQueryServer(grpcQueryRouter, &consensusQueryServer{keeper: suite.consensusKeeper})
 
 	suite.env = runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(grpcQueryRouter), runtime.EnvWithMsgRouterService(msgRouter))
 	suite.accountKeeper = keeper.NewAccountKeeper(
@@ -292,3 +292,14 @@ func (suite *AnteTestSuite) CreateTestTx(
 
 	return suite.txBuilder.GetTx(), nil
 }
+
+// consensusQueryServer serves the consensus params queries with the mocked
+// consensus keeper.
+type consensusQueryServer struct {
+	consensustypes.UnimplementedQueryServer
+	keeper *antetestutil.MockConsensusKeeper
+}
+
+func (s consensusQueryServer) Params(ctx context.Context, req *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error) {
+	return s.keeper.Params(ctx, req)
+}
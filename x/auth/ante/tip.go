@@ -0,0 +1,97 @@
+package ante
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// TipTx defines a transaction carrying an optional tip, paid by the tipper to
+// the fee payer of the transaction.
+type TipTx interface {
+	sdk.FeeTx
+	GetTip() *txtypes.Tip
+}
+
+// ValidateTipDecorator checks the tip of a transaction, if any. The tipper must
+// be one of the signers of the transaction and must have signed over the tip,
+// i.e. with SIGN_MODE_DIRECT or SIGN_MODE_TEXTUAL, as the other sign modes do
+// not cover the tip and would let the fee payer change its amount.
+// The transfer of the tip itself is done by the TipDecorator post handler.
+// CONTRACT: Tx must implement TipTx interface to carry a tip.
+type ValidateTipDecorator struct {
+	ak AccountKeeper
+}
+
+func NewValidateTipDecorator(ak AccountKeeper) ValidateTipDecorator {
+	return ValidateTipDecorator{
+		ak: ak,
+	}
+}
+
+// AnteHandle implements an AnteHandler decorator for the ValidateTipDecorator.
+func (vtd ValidateTipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err := vtd.ValidateTx(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateTx implements an TxValidator for ValidateTipDecorator
+func (vtd ValidateTipDecorator) ValidateTx(ctx context.Context, tx sdk.Tx) error {
+	tipTx, ok := tx.(TipTx)
+	if !ok || tipTx.GetTip() == nil {
+		return nil
+	}
+	tip := tipTx.GetTip()
+
+	if tip.Amount.Empty() || !tip.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", tip.Amount)
+	}
+
+	tipper, err := vtd.ak.AddressCodec().StringToBytes(tip.Tipper)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address: %s", err)
+	}
+
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	for i, signer := range signers {
+		if !bytes.Equal(signer, tipper) {
+			continue
+		}
+		if i >= len(sigs) {
+			return errorsmod.Wrapf(sdkerrors.ErrNoSignatures, "missing signature of tipper %s", tip.Tipper)
+		}
+
+		data, ok := sigs[i].Data.(*signing.SingleSignatureData)
+		if !ok || (data.SignMode != signing.SignMode_SIGN_MODE_DIRECT && data.SignMode != signing.SignMode_SIGN_MODE_TEXTUAL) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tipper %s must sign with %s or %s",
+				tip.Tipper, signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_TEXTUAL)
+		}
+
+		return nil
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s is not a signer of the tx", tip.Tipper)
+}
@@ -0,0 +1,85 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestValidateTip(t *testing.T) {
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name     string
+		tip      *txtypes.Tip
+		signMode signing.SignMode
+		expErr   error
+	}{
+		{
+			name:     "no tip",
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		{
+			name:     "valid tip",
+			tip:      &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), Tipper: addr2.String()},
+			signMode: signing.SignMode_SIGN_MODE_DIRECT,
+		},
+		{
+			name:     "empty tip amount",
+			tip:      &txtypes.Tip{Amount: sdk.Coins{}, Tipper: addr2.String()},
+			signMode: signing.SignMode_SIGN_MODE_DIRECT,
+			expErr:   sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name:     "tipper is not a signer",
+			tip:      &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), Tipper: addr3.String()},
+			signMode: signing.SignMode_SIGN_MODE_DIRECT,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:     "tipper did not sign over the tip",
+			tip:      &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), Tipper: addr2.String()},
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.(client.TipTxBuilder).SetTip(tc.tip)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), tc.signMode)
+			require.NoError(t, err)
+			require.Equal(t, tc.tip, tx.(ante.TipTx).GetTip())
+
+			vtd := ante.NewValidateTipDecorator(suite.accountKeeper)
+			antehandler := sdk.ChainAnteDecorators(vtd)
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -74,6 +74,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod:      "MultisigPolicy",
+					Use:            "multisig-policy [address]",
+					Short:          "Query the current policy of a multisig account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "PubKeyHistory",
+					Use:            "pub-key-history [address]",
+					Short:          "Query the public key changes of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -87,6 +99,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "SetPruningOptOut",
+					Use:            "set-pruning-opt-out [opt-out]",
+					Short:          "Opt out of (or back into) dormant account pruning",
+					Example:        fmt.Sprintf(`%s tx auth set-pruning-opt-out true --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "opt_out"}},
+				},
+				{
+					RpcMethod:      "CreateMultisigAccount",
+					Use:            "create-multisig-account [threshold] [public-keys...]",
+					Short:          "Create a multisig account controlled by an on-chain policy",
+					Example:        fmt.Sprintf(`%s tx auth create-multisig-account 2 '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}' '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "threshold"}, {ProtoField: "public_keys", Varargs: true}},
+				},
+				{
+					RpcMethod:      "UpdateMultisigPolicy",
+					Use:            "update-multisig-policy [address] [threshold] [public-keys...]",
+					Short:          "Update the policy of a multisig account, the tx must be signed per the current policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "threshold"}, {ProtoField: "public_keys", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ChangePubKey",
+					Use:            "change-pub-key [address] [pub-key]",
+					Short:          "Rotate the public key of an account, the tx must be signed with the current key",
+					Example:        fmt.Sprintf(`%s tx auth change-pub-key cosmos1... '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "pub_key"}},
+				},
 			},
 		},
 	}
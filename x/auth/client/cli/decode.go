@@ -3,14 +3,23 @@ package cli
 import (
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/x/tx/payload"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const flagHex = "hex"
+const (
+	flagHex            = "hex"
+	flagDecodePayloads = "decode-payloads"
+)
 
 // GetDecodeCommand returns the decode command to take serialized bytes and turn
 // it into a JSON-encoded transaction.
@@ -37,18 +46,53 @@ func GetDecodeCommand() *cobra.Command {
 				return err
 			}
 
-			json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+			txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintBytes(json)
+			if decodePayloads, _ := cmd.Flags().GetBool(flagDecodePayloads); decodePayloads {
+				txJSON, err = withDecodedPayloads(clientCtx.PayloadDecoders, tx, txJSON)
+				if err != nil {
+					return err
+				}
+			}
+
+			return clientCtx.PrintBytes(txJSON)
 		},
 	}
 
 	cmd.Flags().BoolP(flagHex, "x", false, "Treat input as hexadecimal instead of base64")
+	cmd.Flags().Bool(flagDecodePayloads, false, "Also output the opaque payloads embedded in the messages (e.g. CosmWasm execute messages) rendered by the app payload decoders, the output is then {\"tx\": ..., \"payloads\": [...]}")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.Flags().MarkHidden(flags.FlagOutput) // decoding makes sense to output only json
 
 	return cmd
 }
+
+// withDecodedPayloads returns the JSON-encoded transaction along with the
+// payloads of its messages rendered by the given payload decoders.
+func withDecodedPayloads(decoders *payload.Registry, tx sdk.Tx, txJSON []byte) ([]byte, error) {
+	if decoders == nil {
+		return nil, errors.New("no payload decoders are registered by the app")
+	}
+
+	msgs, err := tx.GetReflectMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	payloads := []payload.DecodedPayload{}
+	for i, msg := range msgs {
+		msgPayloads, err := decoders.DecodeMessage(fmt.Sprintf("body.messages[%d]", i), msg)
+		if err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, msgPayloads...)
+	}
+
+	return json.Marshal(struct {
+		Tx       json.RawMessage          `json:"tx"`
+		Payloads []payload.DecodedPayload `json:"payloads"`
+	}{Tx: txJSON, Payloads: payloads})
+}
@@ -1,14 +1,18 @@
 package cli_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/auth"
 	"cosmossdk.io/x/auth/client/cli"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/tx/payload"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -81,3 +85,68 @@ func TestGetCommandDecode(t *testing.T) {
 	cmd.SetArgs([]string{base64Encoded})
 	require.NoError(t, cmd.ExecuteContext(ctx))
 }
+
+func TestGetCommandDecodePayloads(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
+	txConfig := encodingConfig.TxConfig
+
+	authority, err := encodingConfig.Codec.InterfaceRegistry().SigningContext().AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&authtypes.MsgUpdateParams{Authority: authority, Params: authtypes.DefaultParams()}))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	base64Encoded := base64.StdEncoding.EncodeToString(txBytes)
+
+	decoders := payload.NewRegistry(nil)
+	require.NoError(t, decoders.Register("cosmwasm.wasm.v1.MsgExecuteContract.msg", func(bz []byte) (string, error) {
+		return string(bz), nil
+	}))
+
+	testCases := []struct {
+		name     string
+		decoders *payload.Registry
+		expErr   string
+	}{
+		{
+			name:   "no payload decoders",
+			expErr: "no payload decoders are registered",
+		},
+		{
+			name:     "with payload decoders",
+			decoders: decoders,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			clientCtx := client.Context{}.
+				WithTxConfig(txConfig).
+				WithCodec(encodingConfig.Codec).
+				WithOutput(out).
+				WithPayloadDecoders(tc.decoders)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			cmd := cli.GetDecodeCommand()
+			_ = testutil.ApplyMockIODiscardOutErr(cmd)
+			cmd.SetArgs([]string{base64Encoded, "--decode-payloads"})
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var res struct {
+				Tx       json.RawMessage          `json:"tx"`
+				Payloads []payload.DecodedPayload `json:"payloads"`
+			}
+			require.NoError(t, json.Unmarshal(out.Bytes(), &res))
+			require.Contains(t, string(res.Tx), "/cosmos.auth.v1beta1.MsgUpdateParams")
+			require.NotNil(t, res.Payloads)
+			require.Empty(t, res.Payloads)
+		})
+	}
+}
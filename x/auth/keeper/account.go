@@ -55,6 +55,16 @@ func (ak AccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
 	if err != nil {
 		panic(err)
 	}
+
+	if err := ak.recordActivity(ctx, acc.GetAddress()); err != nil {
+		panic(err)
+	}
+
+	if _, ok := acc.(vestingAccount); ok {
+		if err := ak.VestingAccounts.Set(ctx, acc.GetAddress()); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // RemoveAccount removes an account for the account mapper store.
@@ -64,4 +74,14 @@ func (ak AccountKeeper) RemoveAccount(ctx context.Context, acc sdk.AccountI) {
 	if err != nil {
 		panic(err)
 	}
+
+	if err := ak.removeActivity(ctx, acc.GetAddress()); err != nil {
+		panic(err)
+	}
+
+	if _, ok := acc.(vestingAccount); ok {
+		if err := ak.VestingAccounts.Remove(ctx, acc.GetAddress()); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -247,3 +248,60 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// MultisigPolicy implements the MultisigPolicy query.
+func (s queryServer) MultisigPolicy(ctx context.Context, req *types.QueryMultisigPolicyRequest) (*types.QueryMultisigPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := s.k.GetMultisigAccount(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryMultisigPolicyResponse{
+		Threshold:  acc.Threshold,
+		PublicKeys: acc.PublicKeys,
+	}, nil
+}
+
+// PubKeyHistory implements the PubKeyHistory query.
+func (s queryServer) PubKeyHistory(ctx context.Context, req *types.QueryPubKeyHistoryRequest) (*types.QueryPubKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.PubKeyHistory,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], change types.PubKeyChange) (types.PubKeyChange, error) {
+			return change, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPubKeyHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
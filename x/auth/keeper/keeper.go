@@ -68,6 +68,28 @@ func NewAccountIndexes(sb *collections.SchemaBuilder) AccountsIndexes {
 	}
 }
 
+func NewLastActivityIndexes(sb *collections.SchemaBuilder) LastActivityIndexes {
+	return LastActivityIndexes{
+		Height: indexes.NewMulti(
+			sb, types.LastActivityByHeightKeyPrefix, "last_activity_by_height", collections.Uint64Key, sdk.AccAddressKey,
+			func(_ sdk.AccAddress, height uint64) (uint64, error) {
+				return height, nil
+			},
+		),
+	}
+}
+
+type LastActivityIndexes struct {
+	// Height is a multi index that indexes accounts by their last activity height.
+	Height *indexes.Multi[uint64, sdk.AccAddress, uint64]
+}
+
+func (a LastActivityIndexes) IndexesList() []collections.Index[sdk.AccAddress, uint64] {
+	return []collections.Index[sdk.AccAddress, uint64]{
+		a.Height,
+	}
+}
+
 type AccountsIndexes struct {
 	// Number is a unique index that indexes accounts by their account number.
 	Number *indexes.Unique[uint64, sdk.AccAddress, sdk.AccountI]
@@ -108,6 +130,25 @@ type AccountKeeper struct {
 	accountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// AccountsLastActivity key: AccAddr | value: height of the last account activity | index: LastActivityIndexes
+	AccountsLastActivity *collections.IndexedMap[sdk.AccAddress, uint64, LastActivityIndexes]
+	// PruningOptOut contains the accounts excluded from dormant account pruning.
+	PruningOptOut collections.KeySet[sdk.AccAddress]
+	// PubKeyHistory key: AccAddr+sequence | value: public key change of the account
+	PubKeyHistory collections.Map[collections.Pair[sdk.AccAddress, uint64], types.PubKeyChange]
+	// PubKeyHistorySequence numbers the public key changes.
+	PubKeyHistorySequence collections.Sequence
+	// VestingAccounts contains the addresses of the accounts whose coins may be
+	// locked by a vesting schedule.
+	VestingAccounts collections.KeySet[sdk.AccAddress]
+
+	// pruneGuards are consulted before pruning a dormant account. It is a
+	// pointer so that guards registered after the keeper is copied are shared.
+	pruneGuards *[]types.AccountPruneGuard
+	// pubKeyValidator rejects the public key types an account cannot change
+	// to, DefaultPubKeyValidator when nil. It is a pointer for the same reason
+	// as pruneGuards.
+	pubKeyValidator *func(cryptotypes.PubKey) error
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -141,6 +182,18 @@ func NewAccountKeeper(
 		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		accountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		AccountsLastActivity: collections.NewIndexedMap(
+			sb, types.LastActivityStoreKeyPrefix, "accounts_last_activity", sdk.AccAddressKey, collections.Uint64Value, NewLastActivityIndexes(sb),
+		),
+		PruningOptOut: collections.NewKeySet(sb, types.PruningOptOutKeyPrefix, "pruning_opt_out", sdk.AccAddressKey),
+		PubKeyHistory: collections.NewMap(
+			sb, types.PubKeyHistoryKeyPrefix, "pub_key_history",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.PubKeyChange](cdc),
+		),
+		PubKeyHistorySequence: collections.NewSequence(sb, types.PubKeyHistorySequenceKey, "pub_key_history_sequence"),
+		VestingAccounts:       collections.NewKeySet(sb, types.VestingAccountsKeyPrefix, "vesting_accounts", sdk.AccAddressKey),
+		pruneGuards:           new([]types.AccountPruneGuard),
+		pubKeyValidator:       new(func(cryptotypes.PubKey) error),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -45,6 +45,20 @@ func (m Migrator) Migrate4To5(ctx context.Context) error {
 	return v5.Migrate(ctx, m.keeper.KVStoreService, m.keeper.accountNumber)
 }
 
+// Migrate5to6 migrates the x/auth module state from the consensus version 5 to 6.
+// It records the upgrade height as the last activity height of every existing
+// account, so that the accounts written before their activity was tracked can
+// be pruned once dormant too.
+func (m Migrator) Migrate5to6(ctx context.Context) error {
+	return m.keeper.backfillAccountsActivity(ctx)
+}
+
+// Migrate6to7 migrates the x/auth module state from the consensus version 6 to 7.
+// It records the existing vesting accounts in the set of vesting accounts.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return m.keeper.backfillVestingAccounts(ctx)
+}
+
 // V45SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/x/auth/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -69,3 +70,89 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) SetPruningOptOut(ctx context.Context, msg *types.MsgSetPruningOptOut) (*types.MsgSetPruningOptOutResponse, error) {
+	addr, err := ms.ak.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if ms.ak.GetAccount(ctx, addr) == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Address)
+	}
+
+	if err := ms.ak.SetPruningOptOut(ctx, addr, msg.OptOut); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPruningOptOutResponse{}, nil
+}
+
+func (ms msgServer) CreateMultisigAccount(ctx context.Context, msg *types.MsgCreateMultisigAccount) (*types.MsgCreateMultisigAccountResponse, error) {
+	if _, err := ms.ak.AddressCodec().StringToBytes(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	pubKeys, err := types.UnpackMultisigPublicKeys(msg.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := ms.ak.CreateMultisigAccount(ctx, msg.Threshold, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	addrStr, err := ms.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMultisigAccountResponse{Address: addrStr}, nil
+}
+
+// UpdateMultisigPolicy updates the policy of a multisig account. The msg signer
+// is the multisig account itself, so the ante handler already verified the tx
+// against the current policy.
+func (ms msgServer) UpdateMultisigPolicy(ctx context.Context, msg *types.MsgUpdateMultisigPolicy) (*types.MsgUpdateMultisigPolicyResponse, error) {
+	addr, err := ms.ak.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	pubKeys, err := types.UnpackMultisigPublicKeys(msg.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ak.UpdateMultisigPolicy(ctx, addr, msg.Threshold, pubKeys); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMultisigPolicyResponse{}, nil
+}
+
+// ChangePubKey rotates the public key of an account. The msg signer is the
+// account itself, so the ante handler already verified the tx against the
+// current key.
+func (ms msgServer) ChangePubKey(ctx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	addr, err := ms.ak.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.PubKey == nil {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrap("public key cannot be empty")
+	}
+
+	pk, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrapf("invalid public key type %T", msg.PubKey.GetCachedValue())
+	}
+
+	if err := ms.ak.ChangePubKey(ctx, addr, pk); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateMultisigAccount creates a new multisig account controlled by the given
+// policy and returns its address. The address is derived from the account
+// number, and thus never changes when the policy is updated.
+func (ak AccountKeeper) CreateMultisigAccount(ctx context.Context, threshold uint32, pubKeys []cryptotypes.PubKey) (sdk.AccAddress, error) {
+	if err := ak.validateMultisigPolicy(ctx, threshold, pubKeys); err != nil {
+		return nil, err
+	}
+
+	acc, err := types.NewMultisigAccount(&types.BaseAccount{}, threshold, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	ak.NewAccount(ctx, acc)
+
+	addr := types.NewMultisigAccountAddress(acc.GetAccountNumber())
+	if ak.HasAccount(ctx, addr) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s already exists", addr)
+	}
+
+	if err := acc.SetAddress(addr); err != nil {
+		return nil, err
+	}
+	ak.SetAccount(ctx, acc)
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return addr, ak.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateMultisigAccount,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyAccountNumber, strconv.FormatUint(acc.GetAccountNumber(), 10)),
+		event.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(threshold), 10)),
+		event.NewAttribute(types.AttributeKeyPublicKeysCount, strconv.Itoa(len(pubKeys))),
+	)
+}
+
+// UpdateMultisigPolicy replaces the policy of the multisig account at the
+// given address. The caller is responsible for checking that the update was
+// authorized per the current policy.
+func (ak AccountKeeper) UpdateMultisigPolicy(ctx context.Context, addr sdk.AccAddress, threshold uint32, pubKeys []cryptotypes.PubKey) error {
+	acc, err := ak.GetMultisigAccount(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if err := ak.validateMultisigPolicy(ctx, threshold, pubKeys); err != nil {
+		return err
+	}
+
+	if err := acc.SetPolicy(threshold, pubKeys); err != nil {
+		return err
+	}
+	ak.SetAccount(ctx, acc)
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return ak.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUpdateMultisigPolicy,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(threshold), 10)),
+		event.NewAttribute(types.AttributeKeyPublicKeysCount, strconv.Itoa(len(pubKeys))),
+	)
+}
+
+// GetMultisigAccount returns the multisig account at the given address.
+func (ak AccountKeeper) GetMultisigAccount(ctx context.Context, addr sdk.AccAddress) (*types.MultisigAccount, error) {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", addr)
+	}
+
+	multisigAcc, ok := acc.(*types.MultisigAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("account %s is not a multisig account", addr)
+	}
+
+	return multisigAcc, nil
+}
+
+// validateMultisigPolicy validates a multisig policy, which must not have more
+// member keys than signatures allowed in a tx, otherwise the account could
+// never sign.
+func (ak AccountKeeper) validateMultisigPolicy(ctx context.Context, threshold uint32, pubKeys []cryptotypes.PubKey) error {
+	if err := types.ValidateMultisigPolicy(threshold, pubKeys); err != nil {
+		return err
+	}
+
+	if limit := ak.GetParams(ctx).TxSigLimit; uint64(len(pubKeys)) > limit {
+		return sdkerrors.ErrInvalidRequest.Wrapf("multisig policy has %d public keys, the tx signature limit is %d", len(pubKeys), limit)
+	}
+
+	return nil
+}
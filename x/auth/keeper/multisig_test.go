@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func multisigPubKeys(n int) ([]cryptotypes.PubKey, []*codectypes.Any) {
+	pubKeys := make([]cryptotypes.PubKey, n)
+	for i := range pubKeys {
+		pubKeys[i] = secp256k1.GenPrivKey().PubKey()
+	}
+
+	anys, err := types.PackMultisigPublicKeys(pubKeys)
+	if err != nil {
+		panic(err)
+	}
+
+	return pubKeys, anys
+}
+
+func (suite *KeeperTestSuite) TestMsgCreateMultisigAccount() {
+	ctx := suite.ctx
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	creator, err := suite.accountKeeper.AddressCodec().BytesToString(sdk.AccAddress("creator_____________"))
+	suite.Require().NoError(err)
+
+	pubKeys, anys := multisigPubKeys(3)
+	_, dupAnys := multisigPubKeys(1)
+	dupAnys = append(dupAnys, dupAnys[0])
+	_, tooManyAnys := multisigPubKeys(int(types.DefaultTxSigLimit) + 1)
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateMultisigAccount
+		expErrMsg string
+	}{
+		{
+			name:      "invalid creator",
+			msg:       &types.MsgCreateMultisigAccount{Creator: "foo", Threshold: 2, PublicKeys: anys},
+			expErrMsg: "invalid creator address",
+		},
+		{
+			name:      "zero threshold",
+			msg:       &types.MsgCreateMultisigAccount{Creator: creator, Threshold: 0, PublicKeys: anys},
+			expErrMsg: "threshold must be positive",
+		},
+		{
+			name:      "threshold above the number of keys",
+			msg:       &types.MsgCreateMultisigAccount{Creator: creator, Threshold: 4, PublicKeys: anys},
+			expErrMsg: "exceeds the number of public keys",
+		},
+		{
+			name:      "duplicate keys",
+			msg:       &types.MsgCreateMultisigAccount{Creator: creator, Threshold: 1, PublicKeys: dupAnys},
+			expErrMsg: "duplicate multisig public key",
+		},
+		{
+			name:      "more keys than the tx signature limit",
+			msg:       &types.MsgCreateMultisigAccount{Creator: creator, Threshold: 1, PublicKeys: tooManyAnys},
+			expErrMsg: "tx signature limit",
+		},
+		{
+			name: "valid",
+			msg:  &types.MsgCreateMultisigAccount{Creator: creator, Threshold: 2, PublicKeys: anys},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.msgServer.CreateMultisigAccount(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			addr, err := suite.accountKeeper.AddressCodec().StringToBytes(res.Address)
+			suite.Require().NoError(err)
+			acc, err := suite.accountKeeper.GetMultisigAccount(ctx, addr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.NewMultisigAccountAddress(acc.GetAccountNumber()), sdk.AccAddress(addr))
+			suite.Require().Equal(kmultisig.NewLegacyAminoPubKey(2, pubKeys), acc.GetPubKey())
+			suite.Require().Error(acc.SetPubKey(pubKeys[0]))
+			suite.Require().NoError(acc.Validate())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateMultisigPolicy() {
+	ctx := suite.ctx
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	pubKeys, _ := multisigPubKeys(3)
+	addr, err := suite.accountKeeper.CreateMultisigAccount(ctx, 2, pubKeys)
+	suite.Require().NoError(err)
+	addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+	suite.Require().NoError(err)
+
+	baseAddr := sdk.AccAddress("base________________")
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, baseAddr))
+	baseAddrStr, err := suite.accountKeeper.AddressCodec().BytesToString(baseAddr)
+	suite.Require().NoError(err)
+
+	newPubKeys, newAnys := multisigPubKeys(4)
+	_, err = suite.msgServer.UpdateMultisigPolicy(ctx, &types.MsgUpdateMultisigPolicy{Address: baseAddrStr, Threshold: 3, PublicKeys: newAnys})
+	suite.Require().ErrorContains(err, "is not a multisig account")
+
+	_, err = suite.msgServer.UpdateMultisigPolicy(ctx, &types.MsgUpdateMultisigPolicy{Address: addrStr, Threshold: 5, PublicKeys: newAnys})
+	suite.Require().ErrorContains(err, "exceeds the number of public keys")
+
+	_, err = suite.msgServer.UpdateMultisigPolicy(ctx, &types.MsgUpdateMultisigPolicy{Address: addrStr, Threshold: 3, PublicKeys: newAnys})
+	suite.Require().NoError(err)
+
+	acc, err := suite.accountKeeper.GetMultisigAccount(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr, acc.GetAddress())
+	suite.Require().Equal(kmultisig.NewLegacyAminoPubKey(3, newPubKeys), acc.GetPubKey())
+
+	res, err := suite.queryClient.MultisigPolicy(ctx, &types.QueryMultisigPolicyRequest{Address: addrStr})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint32(3), res.Threshold)
+	queried, err := types.UnpackMultisigPublicKeys(res.PublicKeys)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newPubKeys, queried)
+
+	_, err = suite.queryClient.MultisigPolicy(ctx, &types.QueryMultisigPolicyRequest{Address: baseAddrStr})
+	suite.Require().ErrorContains(err, "is not a multisig account")
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPrunedAccountsPerBlock is the maximum number of dormant accounts
+// inspected for pruning in a single block.
+const MaxPrunedAccountsPerBlock = 100
+
+// SetPruneGuards sets the guards consulted before pruning a dormant account.
+// Modules holding account related state (e.g. balances or delegations) must be
+// registered here, otherwise accounts holding such state could be pruned.
+func (ak AccountKeeper) SetPruneGuards(guards ...types.AccountPruneGuard) {
+	*ak.pruneGuards = guards
+}
+
+// SetPruningOptOut excludes (or re-includes) an account from dormant account pruning.
+func (ak AccountKeeper) SetPruningOptOut(ctx context.Context, addr sdk.AccAddress, optOut bool) error {
+	if !optOut {
+		if err := ak.PruningOptOut.Remove(ctx, addr); err != nil {
+			return err
+		}
+
+		return ak.recordActivity(ctx, addr)
+	}
+
+	if err := ak.PruningOptOut.Set(ctx, addr); err != nil {
+		return err
+	}
+
+	// opted out accounts are not tracked, so they are never inspected for pruning.
+	return ak.removeLastActivity(ctx, addr)
+}
+
+// recordActivity stores the current block height as the last activity height of
+// the given account. The activity is recorded even when account pruning is
+// disabled, so that enabling it covers every account.
+func (ak AccountKeeper) recordActivity(ctx context.Context, addr sdk.AccAddress) error {
+	optedOut, err := ak.PruningOptOut.Has(ctx, addr)
+	if err != nil || optedOut {
+		return err
+	}
+
+	height := ak.HeaderService.HeaderInfo(ctx).Height
+	return ak.AccountsLastActivity.Set(ctx, addr, uint64(height))
+}
+
+// backfillAccountsActivity records the current block height as the last
+// activity height of every account without one, e.g. the accounts written
+// before the account activity was tracked.
+func (ak AccountKeeper) backfillAccountsActivity(ctx context.Context) error {
+	iter, err := ak.Accounts.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// the addresses are collected first, as the store must not be written while
+	// it is iterated.
+	addrs, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		has, err := ak.AccountsLastActivity.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+
+		if err := ak.recordActivity(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeActivity deletes the pruning related state of an account.
+func (ak AccountKeeper) removeActivity(ctx context.Context, addr sdk.AccAddress) error {
+	if err := ak.removeLastActivity(ctx, addr); err != nil {
+		return err
+	}
+
+	return ak.PruningOptOut.Remove(ctx, addr)
+}
+
+// removeLastActivity deletes the last activity height of an account, if any.
+func (ak AccountKeeper) removeLastActivity(ctx context.Context, addr sdk.AccAddress) error {
+	err := ak.AccountsLastActivity.Remove(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// PruneDormantAccounts removes the accounts which had no activity during the
+// last AccountDormancyPeriod blocks and for which every prune guard agrees that
+// no state is left. Accounts still holding state have their activity height
+// bumped so they are inspected again only after another dormancy period.
+// Module accounts, multisig accounts, accounts whose public key was changed and
+// accounts which never had their activity recorded are never pruned.
+func (ak AccountKeeper) PruneDormantAccounts(ctx context.Context) error {
+	params := ak.GetParams(ctx)
+	if params.AccountDormancyPeriod == 0 {
+		return nil
+	}
+
+	height := uint64(ak.HeaderService.HeaderInfo(ctx).Height)
+	if height <= params.AccountDormancyPeriod {
+		return nil
+	}
+
+	rng := collections.NewPrefixUntilPairRange[uint64, sdk.AccAddress](height - params.AccountDormancyPeriod - 1)
+	iter, err := ak.AccountsLastActivity.Indexes.Height.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	var candidates []collections.Pair[uint64, sdk.AccAddress]
+	for ; iter.Valid() && len(candidates) < MaxPrunedAccountsPerBlock; iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		candidates = append(candidates, key)
+	}
+	iter.Close()
+
+	for _, candidate := range candidates {
+		if err := ak.pruneAccount(ctx, candidate.K2(), candidate.K1()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneAccount removes a dormant account if it can be pruned.
+func (ak AccountKeeper) pruneAccount(ctx context.Context, addr sdk.AccAddress, lastActivity uint64) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return ak.AccountsLastActivity.Remove(ctx, addr)
+	}
+
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return ak.AccountsLastActivity.Remove(ctx, addr)
+	}
+
+	// the address of a multisig account is not derived from a public key, a
+	// pruned multisig account could not be claimed back.
+	if _, ok := acc.(*types.MultisigAccount); ok {
+		return ak.AccountsLastActivity.Remove(ctx, addr)
+	}
+
+	// the same goes for an account whose public key was changed: a pruned
+	// account would be claimed back by its previous, possibly compromised, key
+	// and the new key could no longer sign for it.
+	if pubKey := acc.GetPubKey(); pubKey != nil && !bytes.Equal(pubKey.Address(), addr) {
+		return ak.AccountsLastActivity.Remove(ctx, addr)
+	}
+
+	rotated, err := ak.hasPubKeyHistory(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if rotated {
+		return ak.AccountsLastActivity.Remove(ctx, addr)
+	}
+
+	for _, guard := range *ak.pruneGuards {
+		canPrune, err := guard.CanPruneAccount(ctx, addr)
+		if err != nil {
+			return err
+		}
+
+		if !canPrune {
+			return ak.AccountsLastActivity.Set(ctx, addr, uint64(ak.HeaderService.HeaderInfo(ctx).Height))
+		}
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	ak.RemoveAccount(ctx, acc)
+
+	return ak.EventService.EventManager(ctx).EmitKV(
+		types.EventTypePruneAccount,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyAccountNumber, strconv.FormatUint(acc.GetAccountNumber(), 10)),
+		event.NewAttribute(types.AttributeKeyLastActivityHeight, strconv.FormatUint(lastActivity, 10)),
+	)
+}
+
+// hasPubKeyHistory returns whether the public key of an account was ever changed.
+func (ak AccountKeeper) hasPubKeyHistory(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	iter, err := ak.PubKeyHistory.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestPruneDormantAccounts() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1})
+	params := types.DefaultParams()
+	params.AccountDormancyPeriod = 10
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, params))
+
+	dormant := sdk.AccAddress("dormant_____________")
+	withState := sdk.AccAddress("with_state__________")
+	optedOut := sdk.AccAddress("opted_out___________")
+	active := sdk.AccAddress("active______________")
+	for _, addr := range []sdk.AccAddress{dormant, withState, optedOut} {
+		suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	macc := suite.accountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	suite.Require().NoError(suite.accountKeeper.SetPruningOptOut(ctx, optedOut, true))
+
+	suite.accountKeeper.SetPruneGuards(types.AccountPruneGuardFunc(func(_ context.Context, addr sdk.AccAddress) (bool, error) {
+		return !addr.Equals(withState), nil
+	}))
+
+	// not dormant yet
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11})
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, active))
+	suite.Require().NoError(suite.accountKeeper.PruneDormantAccounts(ctx))
+	suite.Require().NotNil(suite.accountKeeper.GetAccount(ctx, dormant))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12})
+	suite.Require().NoError(suite.accountKeeper.PruneDormantAccounts(ctx))
+	suite.Require().Nil(suite.accountKeeper.GetAccount(ctx, dormant))
+	suite.Require().NotNil(suite.accountKeeper.GetAccount(ctx, withState))
+	suite.Require().NotNil(suite.accountKeeper.GetAccount(ctx, optedOut))
+	suite.Require().NotNil(suite.accountKeeper.GetAccount(ctx, active))
+	suite.Require().NotNil(suite.accountKeeper.GetAccount(ctx, macc.GetAddress()))
+
+	// an account still holding state is inspected again after another dormancy period
+	height, err := suite.accountKeeper.AccountsLastActivity.Get(ctx, withState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(12), height)
+
+	has, err := suite.accountKeeper.AccountsLastActivity.Has(ctx, dormant)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestPruneDormantAccountsRotatedPubKey() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1})
+	params := types.DefaultParams()
+	params.AccountDormancyPeriod = 10
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, params))
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	suite.accountKeeper.SetPruneGuards()
+
+	// the key of the rotated account no longer derives its address
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	rotated := sdk.AccAddress(oldPubKey.Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, rotated)
+	suite.Require().NoError(acc.SetPubKey(oldPubKey))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, rotated, secp256k1.GenPrivKey().PubKey()))
+
+	// the key of the account rotated back derives its address again, but its key was changed
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	rotatedBack := sdk.AccAddress(pubKey.Address())
+	acc = suite.accountKeeper.NewAccountWithAddress(ctx, rotatedBack)
+	suite.Require().NoError(acc.SetPubKey(pubKey))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, rotatedBack, secp256k1.GenPrivKey().PubKey()))
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, rotatedBack, pubKey))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12})
+	suite.Require().NoError(suite.accountKeeper.PruneDormantAccounts(ctx))
+	for _, addr := range []sdk.AccAddress{rotated, rotatedBack} {
+		suite.Require().NotNil(suite.accountKeeper.GetAccount(ctx, addr))
+		has, err := suite.accountKeeper.AccountsLastActivity.Has(ctx, addr)
+		suite.Require().NoError(err)
+		suite.Require().False(has)
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneDormantAccountsDisabled() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1})
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+
+	// the activity is recorded even when pruning is disabled
+	addr := sdk.AccAddress("addr________________")
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	height, err := suite.accountKeeper.AccountsLastActivity.Get(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), height)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1000})
+	suite.Require().NoError(suite.accountKeeper.PruneDormantAccounts(ctx))
+	suite.Require().NotNil(suite.accountKeeper.GetAccount(ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestMigrate5to6BackfillsAccountsActivity() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1})
+	params := types.DefaultParams()
+	params.AccountDormancyPeriod = 10
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, params))
+
+	untracked := sdk.AccAddress("untracked___________")
+	tracked := sdk.AccAddress("tracked_____________")
+	optedOut := sdk.AccAddress("opted_out___________")
+	for _, addr := range []sdk.AccAddress{untracked, tracked, optedOut} {
+		suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	suite.Require().NoError(suite.accountKeeper.SetPruningOptOut(ctx, optedOut, true))
+
+	// the account was written before its activity was tracked
+	suite.Require().NoError(suite.accountKeeper.AccountsLastActivity.Remove(ctx, untracked))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 5})
+	suite.Require().NoError(keeper.NewMigrator(suite.accountKeeper).Migrate5to6(ctx))
+
+	height, err := suite.accountKeeper.AccountsLastActivity.Get(ctx, untracked)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), height)
+
+	height, err = suite.accountKeeper.AccountsLastActivity.Get(ctx, tracked)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), height)
+
+	has, err := suite.accountKeeper.AccountsLastActivity.Has(ctx, optedOut)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetPruningOptOut() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1})
+	params := types.DefaultParams()
+	params.AccountDormancyPeriod = 10
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, params))
+
+	addr := sdk.AccAddress("addr________________")
+	addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.SetPruningOptOut(ctx, &types.MsgSetPruningOptOut{Address: addrStr, OptOut: true})
+	suite.Require().ErrorContains(err, "does not exist")
+
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	_, err = suite.msgServer.SetPruningOptOut(ctx, &types.MsgSetPruningOptOut{Address: addrStr, OptOut: true})
+	suite.Require().NoError(err)
+	has, err := suite.accountKeeper.AccountsLastActivity.Has(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 5})
+	_, err = suite.msgServer.SetPruningOptOut(ctx, &types.MsgSetPruningOptOut{Address: addrStr, OptOut: false})
+	suite.Require().NoError(err)
+	height, err := suite.accountKeeper.AccountsLastActivity.Get(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), height)
+}
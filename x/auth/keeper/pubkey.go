@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetPubKeyValidator sets the function rejecting the public key types an
+// account cannot change to. Apps accepting other key types than the SDK's ante
+// handler must set it, e.g. with ante.NewPubKeyValidator, otherwise accounts
+// could change to keys that can never sign a transaction.
+func (ak AccountKeeper) SetPubKeyValidator(validator func(cryptotypes.PubKey) error) {
+	*ak.pubKeyValidator = validator
+}
+
+// DefaultPubKeyValidator accepts the public key types accepted by the SDK's
+// ante handler: secp256k1, secp256r1 and multisig keys made of those.
+func DefaultPubKeyValidator(pubKey cryptotypes.PubKey) error {
+	switch pubKey := pubKey.(type) {
+	case *secp256k1.PubKey, *secp256r1.PubKey:
+		return nil
+
+	case multisig.PubKey:
+		for _, subKey := range pubKey.GetPubKeys() {
+			if err := DefaultPubKeyValidator(subKey); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return sdkerrors.ErrInvalidPubKey.Wrapf("unsupported public key type: %T", pubKey)
+	}
+}
+
+// ChangePubKey replaces the public key of the account at the given address,
+// keeping its address, account number and sequence, and thus all the state
+// other modules hold for the account. The change costs the PubKeyChangeCost
+// param in gas and is appended to the public key history of the account. The
+// caller is responsible for checking that the change was authorized by the
+// account. Public key types rejected by the validator set by SetPubKeyValidator
+// are refused.
+func (ak AccountKeeper) ChangePubKey(ctx context.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", addr)
+	}
+
+	if pubKey == nil {
+		return sdkerrors.ErrInvalidPubKey.Wrap("public key cannot be empty")
+	}
+
+	// module credentials are not backed by a private key, the account could
+	// never sign again.
+	if _, ok := pubKey.(*types.ModuleCredential); ok {
+		return sdkerrors.ErrInvalidPubKey.Wrap("cannot change the public key of an account to a module credential")
+	}
+
+	validate := DefaultPubKeyValidator
+	if *ak.pubKeyValidator != nil {
+		validate = *ak.pubKeyValidator
+	}
+	if err := validate(pubKey); err != nil {
+		return err
+	}
+
+	previous := acc.GetPubKey()
+	if previous != nil && previous.Equals(pubKey) {
+		return sdkerrors.ErrInvalidPubKey.Wrap("new public key is the current public key of the account")
+	}
+
+	if err := ak.GasService.GasMeter(ctx).Consume(ak.GetParams(ctx).PubKeyChangeCost, "change pubkey"); err != nil {
+		return err
+	}
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("cannot change the public key of account %s: %s", addr, err)
+	}
+	ak.SetAccount(ctx, acc)
+
+	if err := ak.recordPubKeyChange(ctx, addr, previous, pubKey); err != nil {
+		return err
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	attrs := []event.Attribute{
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyNewPubKey, pubKey.String()),
+	}
+	if previous != nil {
+		attrs = append(attrs, event.NewAttribute(types.AttributeKeyPreviousPubKey, previous.String()))
+	}
+
+	return ak.EventService.EventManager(ctx).EmitKV(types.EventTypeChangePubKey, attrs...)
+}
+
+// recordPubKeyChange appends a public key change to the history of an account.
+func (ak AccountKeeper) recordPubKeyChange(ctx context.Context, addr sdk.AccAddress, previous, pubKey cryptotypes.PubKey) error {
+	var change types.PubKeyChange
+	if previous != nil {
+		previousAny, err := codectypes.NewAnyWithValue(previous)
+		if err != nil {
+			return err
+		}
+		change.PreviousPubKey = previousAny
+	}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return err
+	}
+	change.NewPubKey = pubKeyAny
+
+	headerInfo := ak.HeaderService.HeaderInfo(ctx)
+	change.Height = headerInfo.Height
+	change.Time = headerInfo.Time
+
+	seq, err := ak.PubKeyHistorySequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ak.PubKeyHistory.Set(ctx, collections.Join(addr, seq), change)
+}
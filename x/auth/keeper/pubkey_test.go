@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgChangePubKey() {
+	ctx := suite.ctx
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(oldPubKey))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+	suite.Require().NoError(err)
+
+	pubKeys, _ := multisigPubKeys(2)
+	multisigAddr, err := suite.accountKeeper.CreateMultisigAccount(ctx, 1, pubKeys)
+	suite.Require().NoError(err)
+	multisigAddrStr, err := suite.accountKeeper.AddressCodec().BytesToString(multisigAddr)
+	suite.Require().NoError(err)
+
+	unknownAddrStr, err := suite.accountKeeper.AddressCodec().BytesToString(sdk.AccAddress("unknown_____________"))
+	suite.Require().NoError(err)
+
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	packPubKey := func(pk cryptotypes.PubKey) *codectypes.Any {
+		any, err := codectypes.NewAnyWithValue(pk)
+		suite.Require().NoError(err)
+		return any
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgChangePubKey
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			msg:       &types.MsgChangePubKey{Address: "foo", PubKey: packPubKey(newPubKey)},
+			expErrMsg: "invalid address",
+		},
+		{
+			name:      "empty public key",
+			msg:       &types.MsgChangePubKey{Address: addrStr},
+			expErrMsg: "public key cannot be empty",
+		},
+		{
+			name:      "unknown account",
+			msg:       &types.MsgChangePubKey{Address: unknownAddrStr, PubKey: packPubKey(newPubKey)},
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "same public key",
+			msg:       &types.MsgChangePubKey{Address: addrStr, PubKey: packPubKey(oldPubKey)},
+			expErrMsg: "is the current public key",
+		},
+		{
+			name:      "module credential",
+			msg:       &types.MsgChangePubKey{Address: addrStr, PubKey: packPubKey(&types.ModuleCredential{ModuleName: "gov"})},
+			expErrMsg: "module credential",
+		},
+		{
+			name:      "unsupported key type",
+			msg:       &types.MsgChangePubKey{Address: addrStr, PubKey: packPubKey(ed25519.GenPrivKey().PubKey())},
+			expErrMsg: "unsupported public key type",
+		},
+		{
+			name:      "multisig account",
+			msg:       &types.MsgChangePubKey{Address: multisigAddrStr, PubKey: packPubKey(newPubKey)},
+			expErrMsg: "update its policy instead",
+		},
+		{
+			name: "valid",
+			msg:  &types.MsgChangePubKey{Address: addrStr, PubKey: packPubKey(newPubKey)},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.ChangePubKey(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	updated := suite.accountKeeper.GetAccount(ctx, addr)
+	suite.Require().Equal(newPubKey, updated.GetPubKey())
+	suite.Require().Equal(acc.GetAccountNumber(), updated.GetAccountNumber())
+
+	res, err := suite.queryClient.PubKeyHistory(ctx, &types.QueryPubKeyHistoryRequest{Address: addrStr})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Changes, 1)
+	suite.Require().Equal(oldPubKey, res.Changes[0].PreviousPubKey.GetCachedValue())
+	suite.Require().Equal(newPubKey, res.Changes[0].NewPubKey.GetCachedValue())
+
+	res, err = suite.queryClient.PubKeyHistory(ctx, &types.QueryPubKeyHistoryRequest{Address: multisigAddrStr})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Changes)
+}
+
+func (suite *KeeperTestSuite) TestChangePubKeyGasCost() {
+	ctx := suite.ctx
+	params := types.DefaultParams()
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, params))
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	addr := sdk.AccAddress("rotating____________")
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	before := ctx.GasMeter().GasConsumed()
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, addr, secp256k1.GenPrivKey().PubKey()))
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-before, params.PubKeyChangeCost)
+}
+
+func (suite *KeeperTestSuite) TestChangePubKeyValidator() {
+	ctx := suite.ctx
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+	suite.acctsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	addr := sdk.AccAddress("validated___________")
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	// an app accepting ed25519 keys in its ante handler lets accounts change to them
+	suite.accountKeeper.SetPubKeyValidator(func(pubKey cryptotypes.PubKey) error {
+		if _, ok := pubKey.(*ed25519.PubKey); ok {
+			return nil
+		}
+		return keeper.DefaultPubKeyValidator(pubKey)
+	})
+	defer suite.accountKeeper.SetPubKeyValidator(nil)
+
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, addr, ed25519.GenPrivKey().PubKey()))
+	suite.Require().NoError(suite.accountKeeper.ChangePubKey(ctx, addr, secp256k1.GenPrivKey().PubKey()))
+}
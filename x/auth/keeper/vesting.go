@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// vestingAccount is implemented by the accounts whose coins may be locked by a
+// vesting schedule, i.e. the x/auth/vesting accounts.
+type vestingAccount interface {
+	LockedCoins(blockTime time.Time) sdk.Coins
+}
+
+// IterateVestingAccounts iterates over the addresses of the vesting accounts,
+// stopping when the callback returns true.
+func (ak AccountKeeper) IterateVestingAccounts(ctx context.Context, cb func(addr sdk.AccAddress) (stop bool)) error {
+	return ak.VestingAccounts.Walk(ctx, nil, func(addr sdk.AccAddress) (bool, error) {
+		return cb(addr), nil
+	})
+}
+
+// backfillVestingAccounts records the existing vesting accounts in the set of
+// vesting accounts, e.g. the accounts written before the set was maintained.
+func (ak AccountKeeper) backfillVestingAccounts(ctx context.Context) error {
+	iter, err := ak.Accounts.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// the accounts are collected first, as the store must not be written while
+	// it is iterated.
+	accounts, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range accounts {
+		if _, ok := kv.Value.(vestingAccount); !ok {
+			continue
+		}
+		if err := ak.VestingAccounts.Set(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestVestingAccounts() {
+	ctx := suite.ctx
+
+	regular := sdk.AccAddress("regular_____________")
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, regular))
+
+	vestingAddr := sdk.AccAddress("vesting_____________")
+	baseAcc := suite.accountKeeper.NewAccountWithAddress(ctx, vestingAddr).(*types.BaseAccount)
+	vestingAcc, err := vestingtypes.NewDelayedVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1000)
+	suite.Require().NoError(err)
+	suite.accountKeeper.SetAccount(ctx, vestingAcc)
+
+	var addrs []sdk.AccAddress
+	suite.Require().NoError(suite.accountKeeper.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	}))
+	suite.Require().Equal([]sdk.AccAddress{vestingAddr}, addrs)
+
+	// the set is rebuilt for the accounts written before it was maintained
+	suite.Require().NoError(suite.accountKeeper.VestingAccounts.Remove(ctx, vestingAddr))
+	suite.Require().NoError(keeper.NewMigrator(suite.accountKeeper).Migrate6to7(ctx))
+	has, err := suite.accountKeeper.VestingAccounts.Has(ctx, vestingAddr)
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+	has, err = suite.accountKeeper.VestingAccounts.Has(ctx, regular)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	suite.accountKeeper.RemoveAccount(ctx, vestingAcc)
+	has, err = suite.accountKeeper.VestingAccounts.Has(ctx, vestingAddr)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -27,7 +27,7 @@ import (
 
 // ConsensusVersion defines the current x/auth module consensus version.
 const (
-	ConsensusVersion = 5
+	ConsensusVersion = 7
 	GovModuleName    = "gov"
 )
 
@@ -37,6 +37,7 @@ var (
 	_ appmodulev2.HasGenesis    = AppModule{}
 	_ appmodulev2.AppModule     = AppModule{}
 	_ appmodule.HasServices     = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 	_ appmodulev2.HasMigrations = AppModule{}
 )
 
@@ -113,6 +114,12 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 	if err := mr.Register(types.ModuleName, 4, m.Migrate4To5); err != nil {
 		return fmt.Errorf("failed to migrate x/%s from version 4 to 5: %w", types.ModuleName, err)
 	}
+	if err := mr.Register(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 5 to 6: %w", types.ModuleName, err)
+	}
+	if err := mr.Register(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 6 to 7: %w", types.ModuleName, err)
+	}
 
 	return nil
 }
@@ -176,6 +183,11 @@ func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 	return nil
 }
 
+// EndBlock prunes the dormant accounts.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.accountKeeper.PruneDormantAccounts(ctx)
+}
+
 // ConsensusVersion implements appmodule.HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
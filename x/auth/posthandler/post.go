@@ -1,15 +1,32 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// AccountKeeper and BankKeeper are optional, when both are set tips are
+	// enabled through the TipDecorator.
+	AccountKeeper ante.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain, which is empty unless tips are enabled.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if options.BankKeeper != nil {
+		if options.AccountKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for tips")
+		}
+		postDecorators = append(postDecorators, NewTipDecorator(options.AccountKeeper, options.BankKeeper))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
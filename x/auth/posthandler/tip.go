@@ -0,0 +1,47 @@
+package posthandler
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TipDecorator transfers the tip of a successfully executed transaction from
+// the tipper to the fee payer, letting relayers pay the fees of a tx in
+// exchange for a tip. The tip is validated beforehand by the
+// ValidateTipDecorator ante handler.
+type TipDecorator struct {
+	ak         ante.AccountKeeper
+	bankKeeper types.BankKeeper
+}
+
+// NewTipDecorator returns a new TipDecorator.
+func NewTipDecorator(ak ante.AccountKeeper, bk types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		ak:         ak,
+		bankKeeper: bk,
+	}
+}
+
+// PostHandle implements the PostDecorator interface.
+func (d TipDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	tipTx, ok := tx.(ante.TipTx)
+	if !success || !ok || tipTx.GetTip() == nil {
+		return next(ctx, tx, simulate, success)
+	}
+	tip := tipTx.GetTip()
+
+	tipper, err := d.ak.AddressCodec().StringToBytes(tip.Tipper)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address: %s", err)
+	}
+
+	if err := d.bankKeeper.SendCoins(ctx, tipper, tipTx.FeePayer(), tip.Amount); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to transfer tip")
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,73 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/posthandler"
+	authtestutil "cosmossdk.io/x/auth/testutil"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+type mockAccountKeeper struct {
+	ante.AccountKeeper
+}
+
+func (mockAccountKeeper) AddressCodec() address.Codec {
+	return codectestutil.CodecOptions{}.GetAddressCodec()
+}
+
+type mockTipTx struct {
+	sdk.FeeTx
+	feePayer []byte
+	tip      *txtypes.Tip
+}
+
+func (tx mockTipTx) FeePayer() []byte { return tx.feePayer }
+
+func (tx mockTipTx) GetTip() *txtypes.Tip { return tx.tip }
+
+func TestTipDecorator(t *testing.T) {
+	_, _, feePayer := testdata.KeyTestPubAddr()
+	_, _, tipper := testdata.KeyTestPubAddr()
+	tipAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	tx := mockTipTx{
+		feePayer: feePayer,
+		tip:      &txtypes.Tip{Amount: tipAmount, Tipper: tipper.String()},
+	}
+
+	ctrl := gomock.NewController(t)
+	bankKeeper := authtestutil.NewMockBankKeeper(ctrl)
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		AccountKeeper: mockAccountKeeper{},
+		BankKeeper:    bankKeeper,
+	})
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}
+
+	// tips of failed txs are not transferred
+	_, err = postHandler(ctx, tx, false, false)
+	require.NoError(t, err)
+
+	// txs without tip are skipped
+	_, err = postHandler(ctx, mockTipTx{feePayer: feePayer}, false, true)
+	require.NoError(t, err)
+
+	// the tip is transferred from the tipper to the fee payer
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), tipper, sdk.AccAddress(feePayer), tipAmount).Return(nil)
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	// tips are required to be enabled with an account keeper
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: bankKeeper})
+	require.Error(t, err)
+}
@@ -29,6 +29,7 @@ import (
 var (
 	_ client.TxBuilder          = &builder{}
 	_ ExtensionOptionsTxBuilder = &builder{}
+	_ client.TipTxBuilder       = &builder{}
 )
 
 func newBuilder(addressCodec address.Codec, decoder *decode.Decoder, codec codec.BinaryCodec) *builder {
@@ -69,6 +70,7 @@ func newBuilderFromDecodedTx(
 		memo:                        decoded.GetMemo(),
 		gasLimit:                    decoded.GetGas(),
 		fees:                        decoded.GetFee(),
+		tip:                         decoded.GetTip(),
 		signerInfos:                 sigInfos,
 		signatures:                  signatures,
 		extensionOptions:            decoded.GetExtensionOptions(),
@@ -90,6 +92,7 @@ type builder struct {
 	memo             string
 	gasLimit         uint64
 	fees             sdk.Coins
+	tip              *tx.Tip
 	signerInfos      []*tx.SignerInfo
 	signatures       [][]byte
 
@@ -131,7 +134,7 @@ func (w *builder) getTx() (*gogoTxWrapper, error) {
 	authInfo := &txv1beta1.AuthInfo{
 		SignerInfos: intoV2SignerInfo(w.signerInfos),
 		Fee:         fee,
-		Tip:         nil, // deprecated
+		Tip:         intoV2Tip(w.tip),
 	}
 
 	bodyBytes, err := marshalOption.Marshal(body)
@@ -185,6 +188,16 @@ func intoV2Fees(fees sdk.Coins) []*basev1beta1.Coin {
 	return coins
 }
 
+func intoV2Tip(tip *tx.Tip) *txv1beta1.Tip {
+	if tip == nil {
+		return nil
+	}
+	return &txv1beta1.Tip{
+		Amount: intoV2Fees(tip.Amount),
+		Tipper: tip.Tipper,
+	}
+}
+
 func (w *builder) SetMsgs(msgs ...sdk.Msg) error {
 	w.msgs = msgs
 	return nil
@@ -205,6 +218,9 @@ func (w *builder) SetFeeAmount(coins sdk.Coins) { w.fees = coins }
 
 func (w *builder) SetFeePayer(feePayer sdk.AccAddress) { w.payer = feePayer }
 
+// SetTip sets the tip paid by the tipper to the fee payer of the transaction.
+func (w *builder) SetTip(tip *tx.Tip) { w.tip = tip }
+
 func (w *builder) SetFeeGranter(feeGranter sdk.AccAddress) { w.granter = feeGranter }
 
 func (w *builder) SetSignatures(signatures ...signing.SignatureV2) error {
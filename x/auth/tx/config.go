@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	txdecode "cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/payload"
 	txsigning "cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
@@ -47,6 +48,9 @@ type ConfigOptions struct {
 	// TextualCoinMetadataQueryFn is the function that will be used to query coin metadata when constructing
 	// textual sign mode handler. This is required if SIGN_MODE_TEXTUAL is enabled.
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// TextualPayloadDecoders are the decoders used by the textual sign mode handler to render the opaque payloads
+	// embedded in messages (e.g. CosmWasm execute messages). It is optional.
+	TextualPayloadDecoders *payload.Registry
 	// CustomSignModes are the custom sign modes that will be added to the txsigning.HandlerMap.
 	CustomSignModes []txsigning.SignModeHandler
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
@@ -147,6 +151,7 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				CoinMetadataQuerier: configOpts.TextualCoinMetadataQueryFn,
 				FileResolver:        signingOpts.FileResolver,
 				TypeResolver:        signingOpts.TypeResolver,
+				PayloadDecoders:     configOpts.TextualPayloadDecoders,
 			})
 			if configOpts.TextualCoinMetadataQueryFn == nil {
 				return nil, errors.New("cannot enable SIGN_MODE_TEXTUAL without a TextualCoinMetadataQueryFn")
@@ -21,6 +21,7 @@ import (
 	"cosmossdk.io/x/auth/posthandler"
 	"cosmossdk.io/x/auth/tx"
 	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/tx/payload"
 	txsigning "cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/textual"
 
@@ -55,6 +56,7 @@ type ModuleInputs struct {
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
 	CustomGetSigners       []txsigning.CustomGetSigner        `optional:"true"`
+	PayloadDecoders        *payload.Registry                  `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -83,7 +85,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 			ValidatorAddressCodec: in.ValidatorAddressCodec,
 			CustomGetSigners:      make(map[protoreflect.FullName]txsigning.GetSignersFunc),
 		},
-		CustomSignModes: customSignModeHandlers,
+		CustomSignModes:        customSignModeHandlers,
+		TextualPayloadDecoders: in.PayloadDecoders,
 	}
 
 	for _, mode := range in.CustomGetSigners {
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -31,25 +32,9 @@ import (
 func newWrapperFromDecodedTx(
 	addrCodec address.Codec, cdc codec.BinaryCodec, decodedTx *decode.DecodedTx,
 ) (*gogoTxWrapper, error) {
-	var (
-		fees = make(sdk.Coins, len(decodedTx.Tx.AuthInfo.Fee.Amount))
-		err  error
-	)
-	for i, fee := range decodedTx.Tx.AuthInfo.Fee.Amount {
-		amtInt, ok := math.NewIntFromString(fee.Amount)
-		if !ok {
-			return nil, fmt.Errorf("invalid fee coin amount at index %d: %s", i, fee.Amount)
-		}
-		if err = sdk.ValidateDenom(fee.Denom); err != nil {
-			return nil, fmt.Errorf("invalid fee coin denom at index %d: %w", i, err)
-		}
-		fees[i] = sdk.Coin{
-			Denom:  fee.Denom,
-			Amount: amtInt,
-		}
-	}
-	if !fees.IsSorted() {
-		return nil, fmt.Errorf("invalid not sorted tx fees: %s", fees.String())
+	fees, err := decodeCoins("fee", decodedTx.Tx.AuthInfo.Fee.Amount)
+	if err != nil {
+		return nil, err
 	}
 	// set fee payer
 	var feePayer []byte
@@ -72,6 +57,19 @@ func newWrapperFromDecodedTx(
 		}
 	}
 
+	// tip
+	var tip *txtypes.Tip
+	if decodedTx.Tx.AuthInfo.Tip != nil {
+		tipAmount, err := decodeCoins("tip", decodedTx.Tx.AuthInfo.Tip.Amount)
+		if err != nil {
+			return nil, err
+		}
+		tip = &txtypes.Tip{
+			Amount: tipAmount,
+			Tipper: decodedTx.Tx.AuthInfo.Tip.Tipper,
+		}
+	}
+
 	// reflectMsgs
 	reflectMsgs := make([]protoreflect.Message, len(decodedTx.DynamicMessages))
 	for i, msg := range decodedTx.DynamicMessages {
@@ -85,9 +83,33 @@ func newWrapperFromDecodedTx(
 		fees:        fees,
 		feePayer:    feePayer,
 		feeGranter:  feeGranter,
+		tip:         tip,
 	}, nil
 }
 
+// decodeCoins converts the coins of the given kind (e.g. fee) into sdk.Coins,
+// checking that they are well-formed and sorted.
+func decodeCoins(kind string, coins []*basev1beta1.Coin) (sdk.Coins, error) {
+	decoded := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		amtInt, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s coin amount at index %d: %s", kind, i, coin.Amount)
+		}
+		if err := sdk.ValidateDenom(coin.Denom); err != nil {
+			return nil, fmt.Errorf("invalid %s coin denom at index %d: %w", kind, i, err)
+		}
+		decoded[i] = sdk.Coin{
+			Denom:  coin.Denom,
+			Amount: amtInt,
+		}
+	}
+	if !decoded.IsSorted() {
+		return nil, fmt.Errorf("invalid not sorted tx %ss: %s", kind, decoded.String())
+	}
+	return decoded, nil
+}
+
 // gogoTxWrapper is a gogoTxWrapper around the tx.Tx proto.Message which retain the raw
 // body and auth_info bytes.
 type gogoTxWrapper struct {
@@ -99,6 +121,7 @@ type gogoTxWrapper struct {
 	fees        sdk.Coins
 	feePayer    []byte
 	feeGranter  []byte
+	tip         *txtypes.Tip
 }
 
 func (w *gogoTxWrapper) String() string { return w.Tx.String() }
@@ -173,6 +196,9 @@ func (w *gogoTxWrapper) FeePayer() []byte { return w.feePayer }
 
 func (w *gogoTxWrapper) FeeGranter() []byte { return w.feeGranter }
 
+// GetTip returns the tip of the tx, or nil if the tx carries no tip.
+func (w *gogoTxWrapper) GetTip() *txtypes.Tip { return w.tip }
+
 func (w *gogoTxWrapper) GetMemo() string { return w.Tx.Body.Memo }
 
 // GetTimeoutHeight returns the transaction's timeout height (if set).
@@ -6,19 +6,25 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -109,6 +115,52 @@ func (m *ModuleAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleAccount proto.InternalMessageInfo
 
+// MultisigAccount defines an account controlled by an on-chain multisig
+// policy. Unlike a multisig public key, the account address is not derived
+// from the policy, so the threshold and the member keys can be updated without
+// changing the address.
+type MultisigAccount struct {
+	*BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
+	// threshold is the number of member signatures required to sign for the
+	// account.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// public_keys are the public keys of the members of the account.
+	PublicKeys []*any.Any `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (m *MultisigAccount) Reset()         { *m = MultisigAccount{} }
+func (m *MultisigAccount) String() string { return proto.CompactTextString(m) }
+func (*MultisigAccount) ProtoMessage()    {}
+func (*MultisigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+}
+func (m *MultisigAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigAccount.Merge(m, src)
+}
+func (m *MultisigAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigAccount proto.InternalMessageInfo
+
 // ModuleCredential represents a unclaimable pubkey for base accounts controlled by modules.
 type ModuleCredential struct {
 	// module_name is the name of the module used for address derivation (passed into address.Module).
@@ -122,7 +174,7 @@ func (m *ModuleCredential) Reset()         { *m = ModuleCredential{} }
 func (m *ModuleCredential) String() string { return proto.CompactTextString(m) }
 func (*ModuleCredential) ProtoMessage()    {}
 func (*ModuleCredential) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
 }
 func (m *ModuleCredential) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,13 +224,30 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// account_dormancy_period is the number of blocks without account activity
+	// after which an account holding no other state becomes eligible for pruning.
+	// Setting it to zero disables account pruning.
+	AccountDormancyPeriod uint64 `protobuf:"varint,6,opt,name=account_dormancy_period,json=accountDormancyPeriod,proto3" json:"account_dormancy_period,omitempty"`
+	// min_gas_price_exempt_msg_types is the list of msg type URLs, e.g.
+	// "/cosmos.slashing.v1beta1.MsgUnjail", exempt from the validators minimum
+	// gas prices, so that network critical operations are not blocked during fee
+	// spikes. A tx is exempt only if all its msgs are, it is still gas metered.
+	MinGasPriceExemptMsgTypes []string `protobuf:"bytes,7,rep,name=min_gas_price_exempt_msg_types,json=minGasPriceExemptMsgTypes,proto3" json:"min_gas_price_exempt_msg_types,omitempty"`
+	// pub_key_change_cost is the gas consumed by a MsgChangePubKey on top of the
+	// regular execution costs, so that key rotations are paid for.
+	PubKeyChangeCost uint64 `protobuf:"varint,8,opt,name=pub_key_change_cost,json=pubKeyChangeCost,proto3" json:"pub_key_change_cost,omitempty"`
+	// msg_min_fees is the list of minimum fees required per msg type URL, so that
+	// spammy or expensive msgs, e.g. "/cosmos.staking.v1beta1.MsgCreateValidator",
+	// can be priced differently. A tx must pay at least the sum of the min fees of
+	// its msgs, on top of the validators minimum gas prices check.
+	MsgMinFees []MsgMinFee `protobuf:"bytes,9,rep,name=msg_min_fees,json=msgMinFees,proto3" json:"msg_min_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,64 +311,249 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetAccountDormancyPeriod() uint64 {
+	if m != nil {
+		return m.AccountDormancyPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPriceExemptMsgTypes() []string {
+	if m != nil {
+		return m.MinGasPriceExemptMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetPubKeyChangeCost() uint64 {
+	if m != nil {
+		return m.PubKeyChangeCost
+	}
+	return 0
+}
+
+func (m *Params) GetMsgMinFees() []MsgMinFee {
+	if m != nil {
+		return m.MsgMinFees
+	}
+	return nil
+}
+
+// MsgMinFee is the minimum fee required for each msg of a given type in a tx.
+type MsgMinFee struct {
+	// msg_type_url is the type URL of the msg, e.g. "/cosmos.staking.v1beta1.MsgCreateValidator".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// min_fee is the minimum fee required for each msg of this type.
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee"`
+}
+
+func (m *MsgMinFee) Reset()         { *m = MsgMinFee{} }
+func (m *MsgMinFee) String() string { return proto.CompactTextString(m) }
+func (*MsgMinFee) ProtoMessage()    {}
+func (*MsgMinFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *MsgMinFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMinFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMinFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMinFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMinFee.Merge(m, src)
+}
+func (m *MsgMinFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMinFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMinFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMinFee proto.InternalMessageInfo
+
+func (m *MsgMinFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgMinFee) GetMinFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
+// PubKeyChange is an entry of the public key history of an account, recorded
+// when the account rotates its public key with MsgChangePubKey.
+type PubKeyChange struct {
+	// previous_pub_key is the public key replaced, empty if the account had none.
+	PreviousPubKey *any.Any `protobuf:"bytes,1,opt,name=previous_pub_key,json=previousPubKey,proto3" json:"previous_pub_key,omitempty"`
+	// new_pub_key is the public key set.
+	NewPubKey *any.Any `protobuf:"bytes,2,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// height is the block height of the change.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the change.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PubKeyChange) Reset()         { *m = PubKeyChange{} }
+func (m *PubKeyChange) String() string { return proto.CompactTextString(m) }
+func (*PubKeyChange) ProtoMessage()    {}
+func (*PubKeyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{6}
+}
+func (m *PubKeyChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyChange.Merge(m, src)
+}
+func (m *PubKeyChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyChange proto.InternalMessageInfo
+
+func (m *PubKeyChange) GetPreviousPubKey() *any.Any {
+	if m != nil {
+		return m.PreviousPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyChange) GetNewPubKey() *any.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PubKeyChange) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
+	proto.RegisterType((*MultisigAccount)(nil), "cosmos.auth.v1beta1.MultisigAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*MsgMinFee)(nil), "cosmos.auth.v1beta1.MsgMinFee")
+	proto.RegisterType((*PubKeyChange)(nil), "cosmos.auth.v1beta1.PubKeyChange")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xf3, 0x46,
-	0x10, 0x8e, 0x93, 0x94, 0xbf, 0x6c, 0xf8, 0xa1, 0x98, 0x94, 0x9a, 0xa8, 0x8a, 0x4d, 0xa4, 0x8a,
-	0x08, 0x35, 0x0e, 0x09, 0xa5, 0x15, 0xb9, 0x91, 0xb4, 0xaa, 0x10, 0x85, 0x22, 0x47, 0xe5, 0xc0,
-	0xc5, 0x5a, 0xdb, 0x8b, 0x59, 0x25, 0xf6, 0xba, 0xde, 0x35, 0x8a, 0x79, 0x02, 0xd4, 0x53, 0xd5,
-	0x4b, 0xaf, 0xb4, 0x4f, 0xc0, 0x81, 0x87, 0xa8, 0x7a, 0x42, 0x5c, 0xda, 0x53, 0x54, 0x85, 0x03,
-	0xa8, 0xea, 0x43, 0x54, 0xde, 0x75, 0x48, 0x82, 0x72, 0x89, 0x3c, 0xdf, 0xf7, 0xcd, 0xcc, 0x37,
-	0x93, 0xb1, 0x41, 0xd9, 0x26, 0xd4, 0x23, 0xb4, 0x0e, 0x23, 0x76, 0x59, 0xbf, 0x6a, 0x58, 0x88,
-	0xc1, 0x06, 0x0f, 0xf4, 0x20, 0x24, 0x8c, 0xc8, 0x6b, 0x82, 0xd7, 0x39, 0x94, 0xf2, 0xa5, 0x55,
-	0xe8, 0x61, 0x9f, 0xd4, 0xf9, 0xaf, 0xd0, 0x95, 0x36, 0x84, 0xce, 0xe4, 0x51, 0x3d, 0x4d, 0x12,
-	0x54, 0xd1, 0x25, 0x2e, 0x11, 0x78, 0xf2, 0x34, 0x4e, 0x70, 0x09, 0x71, 0xfb, 0xa8, 0xce, 0x23,
-	0x2b, 0xba, 0xa8, 0x43, 0x3f, 0x16, 0x54, 0xe5, 0xb7, 0x2c, 0x28, 0xb4, 0x21, 0x45, 0x07, 0xb6,
-	0x4d, 0x22, 0x9f, 0xc9, 0x4d, 0xf0, 0x0e, 0x3a, 0x4e, 0x88, 0x28, 0x55, 0x24, 0x4d, 0xaa, 0x2e,
-	0xb6, 0x95, 0xc7, 0xfb, 0x5a, 0x31, 0xed, 0x71, 0x20, 0x98, 0x2e, 0x0b, 0xb1, 0xef, 0x1a, 0x63,
-	0xa1, 0x7c, 0x06, 0xde, 0x05, 0x91, 0x65, 0xf6, 0x50, 0xac, 0x64, 0x35, 0xa9, 0x5a, 0x68, 0x16,
-	0x75, 0xd1, 0x50, 0x1f, 0x37, 0xd4, 0x0f, 0xfc, 0xb8, 0xbd, 0xf5, 0xef, 0x50, 0x2d, 0x06, 0x91,
-	0xd5, 0xc7, 0x76, 0xa2, 0xfd, 0x9c, 0x78, 0x98, 0x21, 0x2f, 0x60, 0xf1, 0xef, 0xcf, 0x77, 0xdb,
-	0x60, 0x42, 0x18, 0x0b, 0x41, 0x64, 0x1d, 0xa1, 0x58, 0xfe, 0x0c, 0x2c, 0x43, 0x61, 0xcb, 0xf4,
-	0x23, 0xcf, 0x42, 0xa1, 0x92, 0xd3, 0xa4, 0x6a, 0xde, 0x78, 0x9f, 0xa2, 0x27, 0x1c, 0x94, 0x4b,
-	0xe0, 0x43, 0x8a, 0x7e, 0x8c, 0x90, 0x6f, 0x23, 0x25, 0xcf, 0x05, 0xaf, 0x71, 0xab, 0x73, 0x73,
-	0xab, 0x66, 0x5e, 0x6e, 0xd5, 0xcc, 0x9f, 0xf7, 0xb5, 0x4f, 0xe7, 0xac, 0x57, 0x4f, 0xe7, 0x3e,
-	0xfc, 0xe9, 0xf9, 0x6e, 0x7b, 0x5d, 0x08, 0x6a, 0xd4, 0xe9, 0xd5, 0xa7, 0x76, 0x52, 0xf9, 0x4f,
-	0x02, 0xef, 0x8f, 0x89, 0x13, 0xf5, 0x5f, 0xb7, 0x74, 0x08, 0x96, 0x2c, 0x48, 0x91, 0x99, 0x1a,
-	0xe1, 0xab, 0x2a, 0x34, 0x35, 0x7d, 0x5e, 0x87, 0xa9, 0x4a, 0xed, 0xfc, 0xc3, 0x50, 0x95, 0x8c,
-	0x82, 0x35, 0xb5, 0x70, 0x19, 0xe4, 0x7d, 0xe8, 0x21, 0xbe, 0xb9, 0x45, 0x83, 0x3f, 0xcb, 0x1a,
-	0x28, 0x04, 0x28, 0xf4, 0x30, 0xa5, 0x98, 0xf8, 0x54, 0xc9, 0x69, 0xb9, 0xea, 0xa2, 0x31, 0x0d,
-	0xb5, 0xce, 0x6f, 0xc4, 0x4c, 0x95, 0x79, 0x1d, 0x67, 0xbc, 0xf2, 0xc9, 0x94, 0xa9, 0xc9, 0x66,
-	0xd8, 0x5f, 0x9e, 0xef, 0xb6, 0x97, 0x3d, 0x8e, 0x8c, 0x87, 0xa9, 0xfc, 0x2a, 0x81, 0x8f, 0x84,
-	0xa8, 0x13, 0x22, 0x07, 0xf9, 0x0c, 0xc3, 0xbe, 0xac, 0x82, 0x42, 0x2a, 0xe3, 0x6e, 0xf9, 0x6d,
-	0x18, 0x40, 0x40, 0x27, 0x89, 0xe7, 0x2d, 0xb0, 0xe2, 0xa0, 0x10, 0x5f, 0x41, 0x86, 0x89, 0x9f,
-	0xfc, 0x8d, 0x54, 0xc9, 0x6a, 0xb9, 0xea, 0x92, 0xb1, 0x3c, 0x81, 0x8f, 0x50, 0x4c, 0x5b, 0xfb,
-	0x8f, 0xf7, 0xb5, 0x95, 0x89, 0x1f, 0x6d, 0x47, 0xff, 0xe2, 0xab, 0xc4, 0xe3, 0xe6, 0x94, 0xc7,
-	0x6f, 0x43, 0x12, 0x05, 0xa9, 0xc5, 0x89, 0x89, 0xca, 0x5f, 0x59, 0xb0, 0x70, 0x0a, 0x43, 0xe8,
-	0x51, 0x59, 0x07, 0x6b, 0x1e, 0x1c, 0x98, 0x1e, 0xf2, 0x88, 0x69, 0x5f, 0xc2, 0x10, 0xda, 0x0c,
-	0x85, 0xe2, 0x66, 0xf3, 0xc6, 0xaa, 0x07, 0x07, 0xc7, 0xc8, 0x23, 0x9d, 0x57, 0x42, 0xd6, 0xc0,
-	0x12, 0x1b, 0x98, 0x14, 0xbb, 0x66, 0x1f, 0x7b, 0x98, 0xf1, 0x75, 0xe7, 0x0d, 0xc0, 0x06, 0x5d,
-	0xec, 0x7e, 0x97, 0x20, 0xf2, 0x0e, 0xf8, 0x98, 0x2b, 0xae, 0x91, 0x69, 0x13, 0xca, 0xcc, 0x00,
-	0x85, 0xa6, 0x15, 0x33, 0x94, 0x1e, 0xdd, 0x6a, 0x22, 0xbd, 0x46, 0x1d, 0x42, 0xd9, 0x29, 0x0a,
-	0xdb, 0x31, 0x43, 0xf2, 0xf7, 0xe0, 0x93, 0xa4, 0xe0, 0x15, 0x0a, 0xf1, 0x45, 0x2c, 0x92, 0x90,
-	0xd3, 0xdc, 0xdb, 0x6b, 0xec, 0x8b, 0x3b, 0x6c, 0x2b, 0xa3, 0xa1, 0x5a, 0xec, 0x62, 0xf7, 0x8c,
-	0x2b, 0x92, 0xd4, 0x6f, 0xbe, 0xe6, 0xbc, 0x51, 0xa4, 0x33, 0xa8, 0xc8, 0x92, 0x7f, 0x00, 0x1b,
-	0x6f, 0x0b, 0x52, 0x64, 0x07, 0xcd, 0xbd, 0x2f, 0x7b, 0x0d, 0xe5, 0x03, 0x5e, 0xb2, 0x34, 0x1a,
-	0xaa, 0xeb, 0x33, 0x25, 0xbb, 0x63, 0x85, 0xb1, 0x4e, 0xe7, 0xe2, 0xad, 0xcd, 0x97, 0x5b, 0x55,
-	0x7a, 0x7b, 0x06, 0x03, 0xf1, 0x19, 0x12, 0xeb, 0x6c, 0xef, 0xfe, 0x31, 0x2a, 0x4b, 0x0f, 0xa3,
-	0xb2, 0xf4, 0xcf, 0xa8, 0x2c, 0xfd, 0xfc, 0x54, 0xce, 0x3c, 0x3c, 0x95, 0x33, 0x7f, 0x3f, 0x95,
-	0x33, 0xe7, 0xe9, 0xc7, 0x86, 0x3a, 0x3d, 0x1d, 0x93, 0x71, 0x16, 0x8b, 0x03, 0x44, 0xad, 0x05,
-	0xfe, 0x7a, 0xef, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x84, 0x7c, 0x64, 0xd8, 0x04, 0x00,
-	0x00,
+	// 1144 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x4f, 0x1b, 0x47,
+	0x18, 0xf6, 0x82, 0x6b, 0xe2, 0xb1, 0x43, 0xc2, 0xe2, 0x90, 0xc5, 0x8a, 0xbc, 0x8e, 0xa5, 0x2a,
+	0x16, 0x2a, 0xeb, 0xe0, 0x94, 0xb4, 0xe1, 0x86, 0x9d, 0x0f, 0x45, 0xd4, 0xc4, 0x5a, 0x92, 0xa8,
+	0xca, 0x65, 0xb5, 0x5e, 0x0f, 0xeb, 0x11, 0x9e, 0x9d, 0xed, 0xce, 0x2c, 0xf1, 0xf2, 0x0b, 0xa2,
+	0x9c, 0x50, 0x2f, 0x95, 0x7a, 0xa2, 0x3d, 0x55, 0xbd, 0x94, 0x03, 0x3f, 0x22, 0xca, 0xa1, 0x42,
+	0x39, 0xf5, 0x44, 0x2a, 0x38, 0x10, 0x55, 0xfd, 0x11, 0xd5, 0xcc, 0xec, 0xda, 0x86, 0x12, 0x29,
+	0xaa, 0xd4, 0x8b, 0xb5, 0xf3, 0x3e, 0xef, 0xbc, 0xf3, 0x3c, 0xef, 0xc7, 0x8c, 0x41, 0xc9, 0x21,
+	0x14, 0x13, 0x5a, 0xb3, 0x43, 0xd6, 0xab, 0x6d, 0x2f, 0x75, 0x20, 0xb3, 0x97, 0xc4, 0xc2, 0xf0,
+	0x03, 0xc2, 0x88, 0x3a, 0x2b, 0x71, 0x43, 0x98, 0x62, 0xbc, 0x38, 0x63, 0x63, 0xe4, 0x91, 0x9a,
+	0xf8, 0x95, 0x7e, 0xc5, 0x24, 0x4e, 0xc7, 0xa6, 0x70, 0x18, 0xc7, 0x21, 0xc8, 0x8b, 0xf1, 0x79,
+	0x89, 0x5b, 0x62, 0x55, 0x8b, 0x83, 0x4a, 0xa8, 0xe0, 0x12, 0x97, 0x48, 0x3b, 0xff, 0x4a, 0x36,
+	0xb8, 0x84, 0xb8, 0x7d, 0x58, 0x13, 0xab, 0x4e, 0xb8, 0x59, 0xb3, 0xbd, 0x28, 0x86, 0xf4, 0xf3,
+	0x10, 0x43, 0x18, 0x52, 0x66, 0x63, 0x5f, 0x3a, 0x54, 0x7e, 0x9a, 0x00, 0xb9, 0x86, 0x4d, 0xe1,
+	0xaa, 0xe3, 0x90, 0xd0, 0x63, 0x6a, 0x1d, 0x4c, 0xd9, 0xdd, 0x6e, 0x00, 0x29, 0xd5, 0x94, 0xb2,
+	0x52, 0xcd, 0x36, 0xb4, 0x77, 0x07, 0x8b, 0x85, 0x98, 0xc4, 0xaa, 0x44, 0x36, 0x58, 0x80, 0x3c,
+	0xd7, 0x4c, 0x1c, 0xd5, 0xe7, 0x60, 0xca, 0x0f, 0x3b, 0xd6, 0x16, 0x8c, 0xb4, 0x89, 0xb2, 0x52,
+	0xcd, 0xd5, 0x0b, 0x86, 0x3c, 0xd6, 0x48, 0x8e, 0x35, 0x56, 0xbd, 0xa8, 0x71, 0xeb, 0xaf, 0x23,
+	0xbd, 0xe0, 0x87, 0x9d, 0x3e, 0x72, 0xb8, 0xef, 0x17, 0x04, 0x23, 0x06, 0xb1, 0xcf, 0xa2, 0x9f,
+	0x4f, 0xf7, 0x17, 0xc0, 0x08, 0x30, 0x33, 0x7e, 0xd8, 0x59, 0x83, 0x91, 0xfa, 0x39, 0x98, 0xb6,
+	0x25, 0x2d, 0xcb, 0x0b, 0x71, 0x07, 0x06, 0xda, 0x64, 0x59, 0xa9, 0xa6, 0xcd, 0xcb, 0xb1, 0x75,
+	0x5d, 0x18, 0xd5, 0x22, 0xb8, 0x44, 0xe1, 0x77, 0x21, 0xf4, 0x1c, 0xa8, 0xa5, 0x85, 0xc3, 0x70,
+	0xbd, 0xd2, 0x7c, 0xb5, 0xa7, 0xa7, 0x3e, 0xec, 0xe9, 0xa9, 0xb7, 0x07, 0x8b, 0x37, 0x2e, 0xa8,
+	0x8f, 0x11, 0xeb, 0x7e, 0xfc, 0xfa, 0x74, 0x7f, 0x61, 0x4e, 0x3a, 0x2c, 0xd2, 0xee, 0x56, 0x6d,
+	0x2c, 0x27, 0x95, 0xbf, 0x15, 0x70, 0xb9, 0x45, 0xba, 0x61, 0x7f, 0x98, 0xa5, 0xc7, 0x20, 0xcf,
+	0xab, 0x67, 0xc5, 0x44, 0x44, 0xaa, 0x72, 0xf5, 0xb2, 0x71, 0xd1, 0x09, 0x63, 0x91, 0x1a, 0xe9,
+	0xc3, 0x23, 0x5d, 0x31, 0x73, 0x9d, 0xb1, 0x84, 0xab, 0x20, 0xed, 0xd9, 0x18, 0x8a, 0xcc, 0x65,
+	0x4d, 0xf1, 0xad, 0x96, 0x41, 0xce, 0x87, 0x01, 0x46, 0x94, 0x22, 0xe2, 0x51, 0x6d, 0xb2, 0x3c,
+	0x59, 0xcd, 0x9a, 0xe3, 0xa6, 0x95, 0x17, 0xaf, 0xa4, 0xa6, 0xca, 0x45, 0x27, 0x9e, 0xe1, 0x2a,
+	0x94, 0x69, 0x63, 0xca, 0xce, 0xa0, 0xdf, 0x9f, 0xee, 0x2f, 0x4c, 0x63, 0x61, 0x49, 0xc4, 0x54,
+	0x76, 0x27, 0xc0, 0x95, 0x56, 0xd8, 0x67, 0x88, 0x22, 0xf7, 0x7f, 0x10, 0x7c, 0x03, 0x64, 0x59,
+	0x2f, 0x80, 0xb4, 0x47, 0xfa, 0x5d, 0xa1, 0xfa, 0xb2, 0x39, 0x32, 0xa8, 0x4f, 0x40, 0x6e, 0xd4,
+	0x09, 0x52, 0xfa, 0xc7, 0xfa, 0x49, 0x7b, 0x3b, 0xea, 0x4c, 0x27, 0x88, 0x7c, 0x46, 0x8c, 0xb6,
+	0x68, 0x1c, 0x33, 0x6e, 0xa6, 0x35, 0x18, 0x51, 0xd9, 0x01, 0x9f, 0x52, 0xfd, 0xe2, 0x78, 0x8e,
+	0xce, 0xca, 0xaf, 0xfc, 0xa0, 0x80, 0xab, 0x32, 0x6f, 0xcd, 0x00, 0x76, 0xa1, 0xc7, 0x90, 0xdd,
+	0x57, 0x75, 0x90, 0x8b, 0x33, 0x27, 0x0a, 0x28, 0xc6, 0xc5, 0x04, 0xd2, 0xb4, 0xce, 0xcb, 0x78,
+	0x0b, 0x5c, 0xe9, 0xc2, 0x00, 0x6d, 0xdb, 0x0c, 0x11, 0x4f, 0xea, 0x99, 0x28, 0x4f, 0x56, 0xf3,
+	0xe6, 0xf4, 0xc8, 0x2c, 0x38, 0xde, 0x7b, 0x77, 0xb0, 0x78, 0x65, 0x74, 0x7c, 0xf9, 0xb6, 0xf1,
+	0xe5, 0x57, 0x9c, 0xd2, 0xcd, 0x31, 0x4a, 0x8f, 0x02, 0x12, 0xfa, 0x31, 0x9f, 0x11, 0x89, 0xca,
+	0xef, 0x69, 0x90, 0x69, 0xdb, 0x81, 0x8d, 0xa9, 0x6a, 0x80, 0x59, 0x6c, 0x0f, 0x2c, 0x0c, 0x31,
+	0xb1, 0x9c, 0x9e, 0x1d, 0xd8, 0x0e, 0x83, 0x81, 0x1c, 0xe3, 0xb4, 0x39, 0x83, 0xed, 0x41, 0x0b,
+	0x62, 0xd2, 0x1c, 0x02, 0x6a, 0x19, 0xe4, 0xd9, 0xc0, 0xa2, 0xc8, 0xb5, 0xfa, 0x08, 0x23, 0x26,
+	0x6a, 0x91, 0x36, 0x01, 0x1b, 0x6c, 0x20, 0xf7, 0x1b, 0x6e, 0x51, 0x6f, 0x83, 0x6b, 0xc2, 0x63,
+	0x07, 0x5a, 0x0e, 0xa1, 0xcc, 0xf2, 0x61, 0x60, 0x75, 0x22, 0x06, 0xe3, 0x39, 0x9c, 0xe1, 0xae,
+	0x3b, 0xb0, 0x49, 0x28, 0x6b, 0xc3, 0xa0, 0x11, 0x31, 0xa8, 0x3e, 0x01, 0xd7, 0x79, 0xc0, 0x6d,
+	0x18, 0xa0, 0xcd, 0x48, 0x6e, 0x82, 0xdd, 0xfa, 0xf2, 0xf2, 0xd2, 0x3d, 0x39, 0x9a, 0x0d, 0xed,
+	0xf8, 0x48, 0x2f, 0x6c, 0x20, 0xf7, 0xb9, 0xf0, 0xe0, 0x5b, 0x1f, 0xdc, 0x17, 0xb8, 0x59, 0xa0,
+	0x67, 0xac, 0x72, 0x97, 0xfa, 0x0c, 0xcc, 0x9f, 0x0f, 0x48, 0xa1, 0xe3, 0xd7, 0x97, 0xef, 0x6e,
+	0x2d, 0x69, 0x9f, 0x89, 0x90, 0xc5, 0xe3, 0x23, 0x7d, 0xee, 0x4c, 0xc8, 0x8d, 0xc4, 0xc3, 0x9c,
+	0xa3, 0x17, 0xda, 0xd5, 0xbb, 0xe0, 0x7a, 0x72, 0xb5, 0x74, 0x49, 0x80, 0x6d, 0xcf, 0x89, 0xb8,
+	0x3a, 0x44, 0xba, 0x5a, 0x46, 0x68, 0xbb, 0x16, 0xc3, 0xf7, 0x63, 0xb4, 0x2d, 0x40, 0x75, 0x15,
+	0x94, 0x30, 0xf2, 0x2c, 0xd7, 0xe6, 0xd7, 0x33, 0x72, 0xa0, 0x05, 0x07, 0xfc, 0x0a, 0xb3, 0x30,
+	0x75, 0x2d, 0x16, 0xf9, 0x90, 0x6a, 0x53, 0x62, 0x58, 0xe7, 0x31, 0xf2, 0x1e, 0xd9, 0xb4, 0xcd,
+	0x7d, 0x1e, 0x08, 0x97, 0x16, 0x75, 0x9f, 0x72, 0x07, 0x75, 0x11, 0xcc, 0xc6, 0xb7, 0x25, 0xaf,
+	0x92, 0xe7, 0xca, 0xdc, 0x6a, 0x97, 0xc4, 0xb1, 0x57, 0xe5, 0xd5, 0xd7, 0x14, 0x00, 0xa7, 0xac,
+	0xae, 0x81, 0x3c, 0x0f, 0xce, 0x4f, 0xdd, 0x84, 0x90, 0x6a, 0x59, 0x31, 0x11, 0xa5, 0x0b, 0x27,
+	0xaf, 0x45, 0xdd, 0x16, 0xf2, 0x1e, 0x42, 0xd8, 0xc8, 0xbe, 0x39, 0xd2, 0x53, 0xbf, 0x9c, 0xee,
+	0x2f, 0x28, 0x26, 0xc0, 0x89, 0x95, 0xae, 0xdc, 0xfc, 0xb0, 0xa7, 0x2b, 0xe7, 0x2f, 0x84, 0x81,
+	0x7c, 0xd1, 0x64, 0x17, 0x55, 0x7e, 0x53, 0x40, 0x76, 0x18, 0x47, 0x2d, 0xcb, 0xd3, 0xb9, 0x34,
+	0x2b, 0x0c, 0xfa, 0xc3, 0x26, 0x97, 0x62, 0x9e, 0x05, 0x7d, 0x75, 0x07, 0x4c, 0xc5, 0xdc, 0x44,
+	0x73, 0xe7, 0xea, 0xf3, 0x09, 0x35, 0x3e, 0xf4, 0x43, 0x6a, 0x4d, 0x82, 0xbc, 0xc6, 0x43, 0xce,
+	0xea, 0xd7, 0xf7, 0x7a, 0xd5, 0x45, 0xac, 0x17, 0x76, 0x0c, 0x87, 0xe0, 0xf8, 0x7d, 0xab, 0x8d,
+	0xf1, 0x11, 0x79, 0x14, 0x1b, 0xe8, 0x8f, 0xa7, 0xfb, 0x0b, 0xf9, 0x3e, 0x74, 0x6d, 0x87, 0xd7,
+	0x1e, 0x79, 0x54, 0x4a, 0xca, 0x60, 0xc1, 0x6e, 0x25, 0xcd, 0xe5, 0x54, 0x5e, 0x4f, 0x80, 0x7c,
+	0x7b, 0x2c, 0x6d, 0xea, 0xb7, 0xe0, 0xaa, 0x1f, 0xc0, 0x6d, 0x44, 0x42, 0x6a, 0x25, 0x0f, 0x93,
+	0x52, 0x56, 0xfe, 0xc3, 0x45, 0x32, 0x9d, 0xc4, 0x91, 0x6b, 0x75, 0x1d, 0xe4, 0x3c, 0xf8, 0xd2,
+	0xfa, 0x94, 0xd7, 0xee, 0xe3, 0x41, 0xb3, 0x1e, 0x7c, 0x19, 0xc7, 0x9b, 0x03, 0x99, 0x1e, 0x44,
+	0x6e, 0x8f, 0x89, 0x89, 0x9a, 0x34, 0xe3, 0x95, 0xfa, 0x35, 0x48, 0xf3, 0x87, 0x5a, 0xcc, 0x4c,
+	0xae, 0x5e, 0xfc, 0xd7, 0x01, 0x4f, 0x93, 0x57, 0xbc, 0x71, 0x89, 0xa7, 0x74, 0xf7, 0xbd, 0xae,
+	0x98, 0x62, 0x47, 0xe3, 0xce, 0x9b, 0xe3, 0x92, 0x72, 0x78, 0x5c, 0x52, 0xfe, 0x3c, 0x2e, 0x29,
+	0xbb, 0x27, 0xa5, 0xd4, 0xe1, 0x49, 0x29, 0xf5, 0xc7, 0x49, 0x29, 0xf5, 0x22, 0xfe, 0x5b, 0x41,
+	0xbb, 0x5b, 0x06, 0x22, 0x49, 0xd1, 0x45, 0xae, 0x3b, 0x19, 0x11, 0xf8, 0xce, 0x3f, 0x03, 0x00,
+	0xae, 0x6f, 0x41, 0xcc, 0xe2, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +590,60 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.AccountDormancyPeriod != that1.AccountDormancyPeriod {
+		return false
+	}
+	if len(this.MinGasPriceExemptMsgTypes) != len(that1.MinGasPriceExemptMsgTypes) {
+		return false
+	}
+	for i := range this.MinGasPriceExemptMsgTypes {
+		if this.MinGasPriceExemptMsgTypes[i] != that1.MinGasPriceExemptMsgTypes[i] {
+			return false
+		}
+	}
+	if this.PubKeyChangeCost != that1.PubKeyChangeCost {
+		return false
+	}
+	if len(this.MsgMinFees) != len(that1.MsgMinFees) {
+		return false
+	}
+	for i := range this.MsgMinFees {
+		if !this.MsgMinFees[i].Equal(&that1.MsgMinFees[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgMinFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgMinFee)
+	if !ok {
+		that2, ok := that.(MsgMinFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if len(this.MinFee) != len(that1.MinFee) {
+		return false
+	}
+	for i := range this.MinFee {
+		if !this.MinFee[i].Equal(&that1.MinFee[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -441,6 +749,60 @@ func (m *ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MultisigAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublicKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ModuleCredential) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -500,6 +862,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgMinFees) > 0 {
+		for iNdEx := len(m.MsgMinFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgMinFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PubKeyChangeCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyChangeCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MinGasPriceExemptMsgTypes) > 0 {
+		for iNdEx := len(m.MinGasPriceExemptMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MinGasPriceExemptMsgTypes[iNdEx])
+			copy(dAtA[i:], m.MinGasPriceExemptMsgTypes[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.MinGasPriceExemptMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.AccountDormancyPeriod != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.AccountDormancyPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -528,38 +923,142 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMinFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgMinFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMinFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovAuth(uint64(l))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovAuth(uint64(m.AccountNumber))
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovAuth(uint64(m.Sequence))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *PubKeyChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PreviousPubKey != nil {
+		{
+			size, err := m.PreviousPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovAuth(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
+	return n
 }
 
 func (m *ModuleAccount) Size() (n int) {
@@ -585,6 +1084,28 @@ func (m *ModuleAccount) Size() (n int) {
 	return n
 }
 
+func (m *MultisigAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovAuth(uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ModuleCredential) Size() (n int) {
 	if m == nil {
 		return 0
@@ -625,6 +1146,65 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.AccountDormancyPeriod != 0 {
+		n += 1 + sovAuth(uint64(m.AccountDormancyPeriod))
+	}
+	if len(m.MinGasPriceExemptMsgTypes) > 0 {
+		for _, s := range m.MinGasPriceExemptMsgTypes {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.PubKeyChangeCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyChangeCost))
+	}
+	if len(m.MsgMinFees) > 0 {
+		for _, e := range m.MsgMinFees {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMinFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PubKeyChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPubKey != nil {
+		l = m.PreviousPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -940,7 +1520,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+func (m *MultisigAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,17 +1543,17 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultisigAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -983,29 +1563,52 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1015,23 +1618,25 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
-			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			m.PublicKeys = append(m.PublicKeys, &any.Any{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1054,7 +1659,7 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1077,17 +1682,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1097,54 +1702,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
-			}
-			m.TxSigLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.TxSizeCostPerByte = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
 			}
-			m.SigVerifyCostED25519 = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1154,18 +1734,157 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.SigVerifyCostSecp256k1 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			}
+			m.SigVerifyCostED25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			}
+			m.SigVerifyCostSecp256k1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowAuth
 				}
 				if iNdEx >= l {
@@ -1178,6 +1897,400 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDormancyPeriod", wireType)
+			}
+			m.AccountDormancyPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountDormancyPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceExemptMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPriceExemptMsgTypes = append(m.MinGasPriceExemptMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCost", wireType)
+			}
+			m.PubKeyChangeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyChangeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgMinFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgMinFees = append(m.MsgMinFees, MsgMinFee{})
+			if err := m.MsgMinFees[len(m.MsgMinFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMinFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMinFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMinFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = append(m.MinFee, types.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousPubKey == nil {
+				m.PreviousPubKey = &any.Any{}
+			}
+			if err := m.PreviousPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &any.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
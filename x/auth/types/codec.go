@@ -19,10 +19,15 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	cdc.RegisterInterface((*sdk.AccountI)(nil), nil)
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/BaseAccount")
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount")
+	cdc.RegisterConcrete(&MultisigAccount{}, "cosmos-sdk/MultisigAccount")
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/auth/Params")
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential")
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPruningOptOut{}, "cosmos-sdk/x/auth/MsgSetPruningOptOut")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateMultisigAccount{}, "cosmos-sdk/MsgCreateMultisigAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMultisigPolicy{}, "cosmos-sdk/MsgUpdateMultisigPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgChangePubKey{}, "cosmos-sdk/MsgChangePubKey")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -35,6 +40,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		(*AccountI)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&MultisigAccount{},
 	)
 
 	registrar.RegisterInterface(
@@ -42,6 +48,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		(*sdk.AccountI)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&MultisigAccount{},
 	)
 
 	registrar.RegisterInterface(
@@ -49,6 +56,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		(*GenesisAccount)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&MultisigAccount{},
 	)
 
 	registrar.RegisterInterface(
@@ -60,5 +68,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgNonAtomicExec{},
+		&MsgSetPruningOptOut{},
+		&MsgCreateMultisigAccount{},
+		&MsgUpdateMultisigPolicy{},
+		&MsgChangePubKey{},
 	)
 }
@@ -0,0 +1,17 @@
+package types
+
+// auth module event types
+const (
+	EventTypePruneAccount          = "prune_account"
+	EventTypeCreateMultisigAccount = "create_multisig_account"
+	EventTypeUpdateMultisigPolicy  = "update_multisig_policy"
+	EventTypeChangePubKey          = "change_pubkey"
+
+	AttributeKeyAddress            = "address"
+	AttributeKeyAccountNumber      = "account_number"
+	AttributeKeyLastActivityHeight = "last_activity_height"
+	AttributeKeyThreshold          = "threshold"
+	AttributeKeyPublicKeysCount    = "public_keys_count"
+	AttributeKeyPreviousPubKey     = "previous_pub_key"
+	AttributeKeyNewPubKey          = "new_pub_key"
+)
@@ -25,3 +25,18 @@ type AccountsModKeeper interface {
 	// of auth module current account number
 	InitAccountNumberSeqUnsafe(ctx context.Context, currentAccNum uint64) error
 }
+
+// AccountPruneGuard is implemented by modules holding account related state
+// (balances, delegations, grants...). A dormant account is only pruned when
+// every registered guard reports that it holds nothing for the account.
+type AccountPruneGuard interface {
+	CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error)
+}
+
+// AccountPruneGuardFunc is a function implementing AccountPruneGuard.
+type AccountPruneGuardFunc func(ctx context.Context, addr sdk.AccAddress) (bool, error)
+
+// CanPruneAccount implements AccountPruneGuard.
+func (f AccountPruneGuardFunc) CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return f(ctx, addr)
+}
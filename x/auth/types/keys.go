@@ -28,4 +28,22 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// LastActivityStoreKeyPrefix prefix for the account last activity height store
+	LastActivityStoreKeyPrefix = collections.NewPrefix(3)
+
+	// LastActivityByHeightKeyPrefix prefix for the last activity by height index
+	LastActivityByHeightKeyPrefix = collections.NewPrefix(4)
+
+	// PruningOptOutKeyPrefix prefix for the set of accounts opted out of pruning
+	PruningOptOutKeyPrefix = collections.NewPrefix(5)
+
+	// PubKeyHistoryKeyPrefix prefix for the public key changes of accounts
+	PubKeyHistoryKeyPrefix = collections.NewPrefix(6)
+
+	// PubKeyHistorySequenceKey identifies the sequence numbering public key changes
+	PubKeyHistorySequenceKey = collections.NewPrefix(7)
+
+	// VestingAccountsKeyPrefix prefix for the set of vesting accounts
+	VestingAccountsKeyPrefix = collections.NewPrefix(8)
 )
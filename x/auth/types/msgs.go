@@ -1,11 +1,20 @@
 package types
 
 import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
 	coretransaction "cosmossdk.io/core/transaction"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+var (
+	_ gogoprotoany.UnpackInterfacesMessage = MsgCreateMultisigAccount{}
+	_ gogoprotoany.UnpackInterfacesMessage = MsgUpdateMultisigPolicy{}
+	_ gogoprotoany.UnpackInterfacesMessage = MsgChangePubKey{}
+)
+
 // GetMessages returns the cache values from the MsgNonAtomicExec.Msgs if present.
 func (msg MsgNonAtomicExec) GetMessages() ([]coretransaction.Msg, error) {
 	msgs := make([]coretransaction.Msg, len(msg.Msgs))
@@ -19,3 +28,19 @@ func (msg MsgNonAtomicExec) GetMessages() ([]coretransaction.Msg, error) {
 
 	return msgs, nil
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateMultisigAccount) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return unpackMultisigPublicKeys(unpacker, msg.PublicKeys)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateMultisigPolicy) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return unpackMultisigPublicKeys(unpacker, msg.PublicKeys)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgChangePubKey) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pk)
+}
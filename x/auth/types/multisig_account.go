@@ -0,0 +1,194 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MultisigAccountDerivationKey is the derivation key of the multisig account
+// addresses.
+var MultisigAccountDerivationKey = []byte("multisig")
+
+var (
+	_ sdk.AccountI                         = (*MultisigAccount)(nil)
+	_ GenesisAccount                       = (*MultisigAccount)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*MultisigAccount)(nil)
+)
+
+// NewMultisigAccountAddress returns the address of the multisig account with
+// the given account number. The address only depends on the account number, so
+// it is kept when the policy of the account is updated.
+func NewMultisigAccountAddress(accountNumber uint64) sdk.AccAddress {
+	return address.Module(ModuleName, MultisigAccountDerivationKey, sdk.Uint64ToBigEndian(accountNumber))
+}
+
+// NewMultisigAccount creates a new MultisigAccount controlled by the given
+// policy.
+func NewMultisigAccount(ba *BaseAccount, threshold uint32, pubKeys []cryptotypes.PubKey) (*MultisigAccount, error) {
+	acc := &MultisigAccount{BaseAccount: ba}
+	if err := acc.SetPolicy(threshold, pubKeys); err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
+
+// GetPubKey returns the multisig public key built from the current policy of
+// the account. It is the key the signatures of the account are verified
+// against.
+func (acc MultisigAccount) GetPubKey() cryptotypes.PubKey {
+	pubKeys, err := acc.GetPublicKeys()
+	if err != nil || ValidateMultisigPolicy(acc.Threshold, pubKeys) != nil {
+		return nil
+	}
+
+	return kmultisig.NewLegacyAminoPubKey(int(acc.Threshold), pubKeys)
+}
+
+// SetPubKey - Implements sdk.AccountI. The public key of a multisig account is
+// derived from its policy and cannot be set.
+func (acc *MultisigAccount) SetPubKey(pubKey cryptotypes.PubKey) error {
+	if pubKey == nil {
+		return nil
+	}
+
+	return errors.New("cannot set the public key of a multisig account, update its policy instead")
+}
+
+// GetPublicKeys returns the public keys of the members of the account.
+func (acc MultisigAccount) GetPublicKeys() ([]cryptotypes.PubKey, error) {
+	return UnpackMultisigPublicKeys(acc.PublicKeys)
+}
+
+// SetPolicy replaces the policy of the account.
+func (acc *MultisigAccount) SetPolicy(threshold uint32, pubKeys []cryptotypes.PubKey) error {
+	if err := ValidateMultisigPolicy(threshold, pubKeys); err != nil {
+		return err
+	}
+
+	anys, err := PackMultisigPublicKeys(pubKeys)
+	if err != nil {
+		return err
+	}
+
+	acc.Threshold = threshold
+	acc.PublicKeys = anys
+	return nil
+}
+
+// Validate checks for errors on the account fields
+func (acc MultisigAccount) Validate() error {
+	if acc.BaseAccount == nil {
+		return errors.New("multisig account must have a base account")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(acc.Address); err != nil {
+		return err
+	}
+
+	if acc.BaseAccount.PubKey != nil {
+		return errors.New("multisig account cannot have a base account public key")
+	}
+
+	pubKeys, err := acc.GetPublicKeys()
+	if err != nil {
+		return err
+	}
+
+	return ValidateMultisigPolicy(acc.Threshold, pubKeys)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (acc MultisigAccount) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	if acc.BaseAccount != nil {
+		if err := acc.BaseAccount.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return unpackMultisigPublicKeys(unpacker, acc.PublicKeys)
+}
+
+// ValidateMultisigPolicy checks that the threshold can be reached by the
+// member keys, and that the member keys are unique single keys.
+func ValidateMultisigPolicy(threshold uint32, pubKeys []cryptotypes.PubKey) error {
+	if threshold == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("multisig threshold must be positive")
+	}
+
+	if int(threshold) > len(pubKeys) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("multisig threshold %d exceeds the number of public keys %d", threshold, len(pubKeys))
+	}
+
+	seen := make(map[string]struct{}, len(pubKeys))
+	for _, pk := range pubKeys {
+		if pk == nil {
+			return sdkerrors.ErrInvalidPubKey.Wrap("multisig public key cannot be empty")
+		}
+
+		if _, ok := pk.(multisig.PubKey); ok {
+			return sdkerrors.ErrInvalidPubKey.Wrap("multisig public keys cannot be nested")
+		}
+
+		key := string(pk.Bytes())
+		if _, ok := seen[key]; ok {
+			return sdkerrors.ErrInvalidPubKey.Wrapf("duplicate multisig public key %s", pk)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// PackMultisigPublicKeys packs the member keys of a multisig policy.
+func PackMultisigPublicKeys(pubKeys []cryptotypes.PubKey) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(pubKeys))
+	for i, pk := range pubKeys {
+		any, err := codectypes.NewAnyWithValue(pk)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+// UnpackMultisigPublicKeys returns the cached member keys of a multisig policy.
+func UnpackMultisigPublicKeys(anys []*codectypes.Any) ([]cryptotypes.PubKey, error) {
+	pubKeys := make([]cryptotypes.PubKey, len(anys))
+	for i, any := range anys {
+		if any == nil {
+			return nil, sdkerrors.ErrInvalidPubKey.Wrap("multisig public key cannot be empty")
+		}
+
+		pk, ok := any.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidPubKey.Wrapf("invalid multisig public key type %T", any.GetCachedValue())
+		}
+		pubKeys[i] = pk
+	}
+
+	return pubKeys, nil
+}
+
+func unpackMultisigPublicKeys(unpacker gogoprotoany.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var pk cryptotypes.PubKey
+		if err := unpacker.UnpackAny(any, &pk); err != nil {
+			return fmt.Errorf("invalid multisig public key: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Default parameter values
@@ -11,6 +14,7 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultPubKeyChangeCost       uint64 = 10000
 )
 
 // NewParams creates a new Params object
@@ -32,6 +36,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		PubKeyChangeCost:       DefaultPubKeyChangeCost,
 	}
 }
 
@@ -112,6 +117,74 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMinGasPriceExemptMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, msgType := range v {
+		if !strings.HasPrefix(msgType, "/") || len(msgType) == 1 {
+			return fmt.Errorf("invalid min gas price exempt msg type: %q", msgType)
+		}
+		if _, ok := seen[msgType]; ok {
+			return fmt.Errorf("duplicate min gas price exempt msg type: %s", msgType)
+		}
+		seen[msgType] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsMinGasPriceExempt returns true if the msg type URL is exempt from the
+// validators minimum gas prices.
+func (p Params) IsMinGasPriceExempt(msgTypeURL string) bool {
+	for _, msgType := range p.MinGasPriceExemptMsgTypes {
+		if msgType == msgTypeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateMsgMinFees(i interface{}) error {
+	v, ok := i.([]MsgMinFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, msgMinFee := range v {
+		if !strings.HasPrefix(msgMinFee.MsgTypeUrl, "/") || len(msgMinFee.MsgTypeUrl) == 1 {
+			return fmt.Errorf("invalid msg min fee msg type: %q", msgMinFee.MsgTypeUrl)
+		}
+		if _, ok := seen[msgMinFee.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate msg min fee msg type: %s", msgMinFee.MsgTypeUrl)
+		}
+		seen[msgMinFee.MsgTypeUrl] = struct{}{}
+
+		if msgMinFee.MinFee.Empty() || !msgMinFee.MinFee.IsValid() {
+			return fmt.Errorf("invalid min fee of msg type %s: %s", msgMinFee.MsgTypeUrl, msgMinFee.MinFee)
+		}
+	}
+
+	return nil
+}
+
+// MsgMinFee returns the minimum fee required for a msg of the given type URL,
+// nil if there is none.
+func (p Params) MsgMinFee(msgTypeURL string) sdk.Coins {
+	for _, msgMinFee := range p.MsgMinFees {
+		if msgMinFee.MsgTypeUrl == msgTypeURL {
+			return msgMinFee.MinFee
+		}
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +202,12 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateMinGasPriceExemptMsgTypes(p.MinGasPriceExemptMsgTypes); err != nil {
+		return err
+	}
+	if err := validateMsgMinFees(p.MsgMinFees); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -35,6 +37,34 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid tx size cost per byte: 0")},
+		{"invalid min gas price exempt msg type", types.Params{
+			MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit, TxSizeCostPerByte: types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519: types.DefaultSigVerifyCostED25519, SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+			MinGasPriceExemptMsgTypes: []string{"cosmos.slashing.v1beta1.MsgUnjail"},
+		}, errors.New(`invalid min gas price exempt msg type: "cosmos.slashing.v1beta1.MsgUnjail"`)},
+		{"duplicate min gas price exempt msg type", types.Params{
+			MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit, TxSizeCostPerByte: types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519: types.DefaultSigVerifyCostED25519, SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+			MinGasPriceExemptMsgTypes: []string{"/cosmos.slashing.v1beta1.MsgUnjail", "/cosmos.slashing.v1beta1.MsgUnjail"},
+		}, errors.New("duplicate min gas price exempt msg type: /cosmos.slashing.v1beta1.MsgUnjail")},
+		{"invalid msg min fee msg type", types.Params{
+			MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit, TxSizeCostPerByte: types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519: types.DefaultSigVerifyCostED25519, SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+			MsgMinFees: []types.MsgMinFee{{MsgTypeUrl: "cosmos.staking.v1beta1.MsgCreateValidator", MinFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}},
+		}, errors.New(`invalid msg min fee msg type: "cosmos.staking.v1beta1.MsgCreateValidator"`)},
+		{"duplicate msg min fee msg type", types.Params{
+			MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit, TxSizeCostPerByte: types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519: types.DefaultSigVerifyCostED25519, SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+			MsgMinFees: []types.MsgMinFee{
+				{MsgTypeUrl: "/cosmos.staking.v1beta1.MsgCreateValidator", MinFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+				{MsgTypeUrl: "/cosmos.staking.v1beta1.MsgCreateValidator", MinFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+			},
+		}, errors.New("duplicate msg min fee msg type: /cosmos.staking.v1beta1.MsgCreateValidator")},
+		{"empty msg min fee", types.Params{
+			MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit, TxSizeCostPerByte: types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519: types.DefaultSigVerifyCostED25519, SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+			MsgMinFees: []types.MsgMinFee{{MsgTypeUrl: "/cosmos.staking.v1beta1.MsgCreateValidator"}},
+		}, errors.New("invalid min fee of msg type /cosmos.staking.v1beta1.MsgCreateValidator: ")},
 	}
 	for _, tt := range tests {
 		tt := tt
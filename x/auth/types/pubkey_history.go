@@ -0,0 +1,18 @@
+package types
+
+import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = PubKeyChange{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c PubKeyChange) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var previous, pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(c.PreviousPubKey, &previous); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(c.NewPubKey, &pk)
+}
@@ -12,3 +12,20 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacke
 }
 
 var _ gogoprotoany.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryMultisigPolicyResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return unpackMultisigPublicKeys(unpacker, m.PublicKeys)
+}
+
+var _ gogoprotoany.UnpackInterfacesMessage = &QueryMultisigPolicyResponse{}
+
+func (m *QueryPubKeyHistoryResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, change := range m.Changes {
+		if err := change.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ gogoprotoany.UnpackInterfacesMessage = &QueryPubKeyHistoryResponse{}
@@ -932,6 +932,218 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryMultisigPolicyRequest is the Query/MultisigPolicy request type.
+type QueryMultisigPolicyRequest struct {
+	// address is the multisig account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryMultisigPolicyRequest) Reset()         { *m = QueryMultisigPolicyRequest{} }
+func (m *QueryMultisigPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMultisigPolicyRequest) ProtoMessage()    {}
+func (*QueryMultisigPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryMultisigPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultisigPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultisigPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultisigPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultisigPolicyRequest.Merge(m, src)
+}
+func (m *QueryMultisigPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultisigPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultisigPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultisigPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryMultisigPolicyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryMultisigPolicyResponse is the Query/MultisigPolicy response type.
+type QueryMultisigPolicyResponse struct {
+	// threshold is the number of member signatures required to sign for the
+	// account.
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// public_keys are the public keys of the members of the account.
+	PublicKeys []*any.Any `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (m *QueryMultisigPolicyResponse) Reset()         { *m = QueryMultisigPolicyResponse{} }
+func (m *QueryMultisigPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMultisigPolicyResponse) ProtoMessage()    {}
+func (*QueryMultisigPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryMultisigPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMultisigPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMultisigPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMultisigPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMultisigPolicyResponse.Merge(m, src)
+}
+func (m *QueryMultisigPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMultisigPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMultisigPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMultisigPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryMultisigPolicyResponse) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *QueryMultisigPolicyResponse) GetPublicKeys() []*any.Any {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+// QueryPubKeyHistoryRequest is the Query/PubKeyHistory request type.
+type QueryPubKeyHistoryRequest struct {
+	// address is the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryRequest) Reset()         { *m = QueryPubKeyHistoryRequest{} }
+func (m *QueryPubKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryRequest) ProtoMessage()    {}
+func (*QueryPubKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyHistoryResponse is the Query/PubKeyHistory response type.
+type QueryPubKeyHistoryResponse struct {
+	// changes are the public key changes of the account.
+	Changes []PubKeyChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyHistoryResponse) Reset()         { *m = QueryPubKeyHistoryResponse{} }
+func (m *QueryPubKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryResponse) ProtoMessage()    {}
+func (*QueryPubKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryResponse) GetChanges() []PubKeyChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryPubKeyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -953,84 +1165,100 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryMultisigPolicyRequest)(nil), "cosmos.auth.v1beta1.QueryMultisigPolicyRequest")
+	proto.RegisterType((*QueryMultisigPolicyResponse)(nil), "cosmos.auth.v1beta1.QueryMultisigPolicyResponse")
+	proto.RegisterType((*QueryPubKeyHistoryRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryRequest")
+	proto.RegisterType((*QueryPubKeyHistoryResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0xa1, 0x49, 0x5f, 0xd2, 0x54, 0x8c, 0x5d, 0x11, 0x36, 0xa9, 0x6d, 0x6d, 0x21,
-	0x5f, 0xd4, 0xbb, 0xf5, 0x47, 0x53, 0x88, 0xc4, 0x21, 0x2b, 0xd4, 0x2a, 0x42, 0x20, 0x77, 0x5b,
-	0x41, 0xe9, 0xc5, 0x5a, 0x67, 0xd7, 0xce, 0xaa, 0xf5, 0xae, 0xeb, 0x5d, 0xa3, 0x9a, 0xc8, 0x02,
-	0x21, 0x21, 0xf5, 0xc0, 0x01, 0x09, 0x6e, 0x5c, 0xf2, 0x27, 0x70, 0xb0, 0xc4, 0x01, 0x6e, 0x70,
-	0x28, 0x39, 0x55, 0xe1, 0x82, 0x38, 0x20, 0x94, 0x20, 0xc1, 0x9f, 0x81, 0x3c, 0xf3, 0xf6, 0xc3,
-	0xf1, 0xd8, 0xde, 0xb4, 0xb7, 0xf5, 0xcc, 0x7b, 0xbf, 0xf7, 0xfb, 0xbd, 0x79, 0xf3, 0xde, 0x18,
-	0x32, 0xbb, 0x8e, 0xdb, 0x70, 0x5c, 0x45, 0x6f, 0x7b, 0x7b, 0xca, 0xa7, 0xf9, 0xaa, 0xe9, 0xe9,
-	0x79, 0xe5, 0x71, 0xdb, 0x6c, 0x75, 0xe4, 0x66, 0xcb, 0xf1, 0x1c, 0x92, 0x64, 0x06, 0x72, 0xdf,
-	0x40, 0x46, 0x03, 0x71, 0x03, 0xbd, 0xaa, 0xba, 0x6b, 0x32, 0xeb, 0xc0, 0xb7, 0xa9, 0xd7, 0x2d,
-	0x5b, 0xf7, 0x2c, 0xc7, 0x66, 0x00, 0x62, 0xaa, 0xee, 0xd4, 0x1d, 0xfa, 0xa9, 0xf4, 0xbf, 0x70,
-	0xf5, 0xf5, 0xba, 0xe3, 0xd4, 0x1f, 0x99, 0x0a, 0xfd, 0x55, 0x6d, 0xd7, 0x14, 0xdd, 0xc6, 0x88,
-	0xe2, 0x32, 0x6e, 0xe9, 0x4d, 0x4b, 0xd1, 0x6d, 0xdb, 0xf1, 0x28, 0x9a, 0x8b, 0xbb, 0x69, 0x1e,
-	0x61, 0x4a, 0x0e, 0x81, 0xd9, 0x7e, 0x85, 0x45, 0x44, 0xf2, 0x6c, 0x6b, 0x09, 0x5d, 0x7d, 0xc2,
-	0x51, 0x9d, 0x92, 0x0b, 0xa9, 0x3b, 0xfd, 0x9f, 0xdb, 0xbb, 0xbb, 0x4e, 0xdb, 0xf6, 0x5c, 0xcd,
-	0x7c, 0xdc, 0x36, 0x5d, 0x8f, 0xdc, 0x02, 0x08, 0x25, 0x2d, 0x0a, 0x59, 0x61, 0x6d, 0xae, 0xb0,
-	0x22, 0x23, 0x6e, 0x5f, 0xbf, 0xcc, 0x50, 0x90, 0x8a, 0x5c, 0xd6, 0xeb, 0x26, 0xfa, 0x6a, 0x11,
-	0xcf, 0xad, 0xe4, 0x51, 0x2f, 0x77, 0x89, 0xb9, 0xe5, 0x5c, 0xe3, 0x61, 0xf6, 0xba, 0x5c, 0x2a,
-	0x4a, 0xbf, 0x09, 0x70, 0xf9, 0x54, 0x54, 0xb7, 0xe9, 0xd8, 0xae, 0x49, 0x34, 0x98, 0xd5, 0x71,
-	0x6d, 0x51, 0xc8, 0x9e, 0x5b, 0x9b, 0x2b, 0xa4, 0x64, 0x96, 0x17, 0xd9, 0x4f, 0x99, 0xbc, 0x6d,
-	0x77, 0xd4, 0xec, 0x61, 0x2f, 0xb7, 0xcc, 0x39, 0x22, 0x19, 0x11, 0x77, 0xb4, 0x00, 0x87, 0xdc,
-	0x1e, 0x90, 0x32, 0x45, 0xa5, 0xac, 0x4e, 0x94, 0xc2, 0x08, 0x4d, 0xd2, 0x72, 0x53, 0xba, 0x0b,
-	0xc9, 0xa8, 0x14, 0x3f, 0x7f, 0x05, 0x98, 0xd1, 0x0d, 0xa3, 0x65, 0xba, 0x2e, 0x4d, 0xde, 0x05,
-	0x75, 0xf1, 0xa8, 0x97, 0x4b, 0x61, 0xd0, 0x6d, 0xb6, 0x73, 0xd7, 0x6b, 0x59, 0x76, 0x5d, 0xf3,
-	0x0d, 0xb7, 0x66, 0x9f, 0x1e, 0x64, 0x12, 0xff, 0x1d, 0x64, 0x12, 0xd2, 0xde, 0xe0, 0xa9, 0x04,
-	0xe9, 0x29, 0xc3, 0x0c, 0xca, 0xc2, 0x23, 0x79, 0xd1, 0xec, 0xf8, 0x30, 0x52, 0x0a, 0x08, 0x8d,
-	0x54, 0xd6, 0x5b, 0x7a, 0xc3, 0x3f, 0x7d, 0xa9, 0x8c, 0xa2, 0xfc, 0x55, 0x0c, 0xff, 0x0e, 0x9c,
-	0x6f, 0xd2, 0x15, 0x8c, 0xbe, 0x24, 0xf3, 0x82, 0x30, 0x27, 0x75, 0xfa, 0xd9, 0x5f, 0x99, 0x84,
-	0x86, 0x0e, 0x52, 0x1e, 0x44, 0x8a, 0xf8, 0x81, 0x63, 0xb4, 0x1f, 0x99, 0xa7, 0xaa, 0x8d, 0x97,
-	0xd9, 0x4d, 0xe9, 0x6b, 0x01, 0x96, 0xb8, 0x3e, 0xc8, 0xe6, 0x7e, 0xcc, 0x5a, 0x59, 0x39, 0xec,
-	0xe5, 0x24, 0x1e, 0xd1, 0x01, 0xdc, 0x48, 0xc5, 0xf0, 0xe9, 0xdc, 0x80, 0xcc, 0x30, 0x1b, 0xb5,
-	0xf3, 0xa1, 0xde, 0xf0, 0x0b, 0x9f, 0x10, 0x98, 0xb6, 0xf5, 0x86, 0xc9, 0x4e, 0x5c, 0xa3, 0xdf,
-	0xd2, 0x67, 0x90, 0x1d, 0xed, 0x86, 0x4a, 0x3e, 0x8a, 0x77, 0xac, 0x71, 0x85, 0x04, 0x87, 0xbb,
-	0x01, 0x49, 0xd5, 0xdc, 0xdd, 0x2b, 0x16, 0xca, 0x2d, 0xb3, 0x66, 0x3d, 0x19, 0x9b, 0xed, 0x32,
-	0xa4, 0x06, 0x6d, 0x91, 0xdb, 0x55, 0xb8, 0x58, 0xa5, 0xeb, 0x95, 0x26, 0xdd, 0x40, 0x71, 0xf3,
-	0xd5, 0x88, 0x31, 0x1f, 0xf1, 0x63, 0x58, 0xc2, 0x42, 0x57, 0x3b, 0x9e, 0xe9, 0xde, 0x73, 0xb0,
-	0xde, 0x31, 0x59, 0x57, 0xe1, 0x22, 0x16, 0x7e, 0xa5, 0xda, 0xdf, 0xa7, 0xc0, 0xf3, 0xda, 0xbc,
-	0x1e, 0xf1, 0xe1, 0x03, 0x3f, 0x80, 0x65, 0x3e, 0x30, 0x52, 0x7e, 0x13, 0x16, 0x7c, 0x64, 0x97,
-	0xee, 0x20, 0x67, 0x3f, 0x1e, 0x33, 0xe7, 0x63, 0x7f, 0x12, 0x90, 0x66, 0x56, 0xf7, 0x1c, 0x1a,
-	0xc3, 0x27, 0xfd, 0x32, 0xd0, 0xf7, 0x03, 0xda, 0xa7, 0xa0, 0xc3, 0x4c, 0xbf, 0x60, 0x42, 0x3e,
-	0x87, 0x74, 0xb4, 0x5d, 0x04, 0xc9, 0xd9, 0x79, 0x2f, 0xac, 0xcc, 0x29, 0xcb, 0xa0, 0x80, 0xe7,
-	0xd4, 0xa9, 0x45, 0x41, 0x9b, 0xb2, 0x0c, 0x52, 0x00, 0xc0, 0x42, 0xa9, 0x58, 0x06, 0xed, 0x8b,
-	0xd3, 0x6a, 0xf2, 0xcf, 0xe1, 0x16, 0xa7, 0x5d, 0x40, 0xb3, 0x1d, 0x63, 0xeb, 0xf2, 0x51, 0x2f,
-	0xf7, 0xea, 0xa9, 0xf0, 0x72, 0x41, 0xda, 0xc7, 0xbb, 0xc1, 0x23, 0x80, 0xea, 0xb6, 0xe1, 0x92,
-	0x1f, 0x2d, 0x6e, 0x63, 0x5c, 0xd0, 0x07, 0xe0, 0x46, 0x05, 0xaf, 0xc2, 0x6b, 0xd1, 0xe0, 0x3b,
-	0x76, 0xcd, 0x79, 0x99, 0x2e, 0xcc, 0xed, 0xf2, 0x26, 0x2c, 0x0e, 0xc7, 0x40, 0x65, 0x25, 0x98,
-	0xb6, 0xec, 0x9a, 0x83, 0x57, 0x37, 0xcb, 0xed, 0x89, 0xaa, 0xee, 0xfa, 0xf7, 0x53, 0xa3, 0xd6,
-	0xdc, 0x30, 0x85, 0x2f, 0x16, 0xe0, 0x15, 0x1a, 0x87, 0x1c, 0x08, 0x30, 0xeb, 0x77, 0x3c, 0xb2,
-	0xce, 0xc5, 0xe4, 0xcd, 0x6d, 0x71, 0x23, 0x8e, 0x29, 0x23, 0x2e, 0xbd, 0x7b, 0x38, 0x3c, 0x9b,
-	0x9f, 0xfe, 0xfb, 0xc3, 0x86, 0xf0, 0xe5, 0xef, 0xff, 0x7c, 0x3b, 0x95, 0x21, 0x57, 0x14, 0xee,
-	0xa3, 0xc3, 0x67, 0xf5, 0x9d, 0x00, 0x33, 0x88, 0x49, 0xd6, 0x26, 0x86, 0xf5, 0x09, 0xae, 0xc7,
-	0xb0, 0x44, 0x7e, 0xa5, 0x90, 0xcc, 0x3a, 0x59, 0x1d, 0x4b, 0x46, 0xd9, 0xc7, 0xe3, 0xeb, 0x92,
-	0x23, 0x01, 0xc8, 0x70, 0x1d, 0x92, 0xe2, 0xc4, 0xb8, 0xc3, 0xd7, 0x46, 0x2c, 0x9d, 0xcd, 0x09,
-	0x79, 0xdf, 0x39, 0xe4, 0xd5, 0x69, 0x28, 0x26, 0x4f, 0x14, 0xbe, 0x98, 0xe0, 0xf6, 0x57, 0x2c,
-	0x43, 0xd9, 0x0f, 0x2f, 0x67, 0x97, 0x7c, 0x25, 0xc0, 0x79, 0x36, 0x57, 0xc9, 0xea, 0x68, 0x4e,
-	0x03, 0x43, 0x5c, 0x5c, 0x9b, 0x6c, 0x88, 0x84, 0xd7, 0x42, 0x6e, 0x57, 0xc8, 0x12, 0x97, 0x1b,
-	0x1b, 0xe3, 0xe4, 0x27, 0x01, 0x16, 0x06, 0xc7, 0x31, 0x51, 0x46, 0x87, 0xe1, 0x0e, 0x7b, 0xf1,
-	0x7a, 0x7c, 0x07, 0xe4, 0x77, 0x6b, 0x42, 0x42, 0x57, 0xc8, 0x1b, 0x5c, 0xd2, 0x0d, 0x0a, 0x57,
-	0x09, 0x2a, 0xf6, 0x67, 0x01, 0x92, 0x9c, 0x39, 0x4c, 0x4a, 0x31, 0x19, 0x0d, 0x4c, 0x7b, 0xf1,
-	0xc6, 0x19, 0xbd, 0x50, 0xcc, 0xdb, 0x21, 0xef, 0x1c, 0x79, 0x2b, 0x0e, 0x6f, 0x65, 0xbf, 0xff,
-	0x92, 0xe8, 0x92, 0xef, 0x05, 0x98, 0x8f, 0xce, 0xe8, 0x11, 0xb7, 0x8e, 0x33, 0xf2, 0x47, 0xdc,
-	0x3a, 0xde, 0xc0, 0x97, 0x36, 0x87, 0xbb, 0xc2, 0xe6, 0xd8, 0xd2, 0x60, 0x2f, 0x01, 0xf2, 0x8b,
-	0x00, 0x29, 0xde, 0x58, 0x26, 0xfc, 0xf3, 0x1e, 0xf3, 0x34, 0x10, 0xf3, 0x67, 0xf0, 0x88, 0x94,
-	0x08, 0x97, 0xf5, 0xa8, 0x1c, 0x33, 0xd6, 0x41, 0xdf, 0x60, 0x23, 0xb7, 0x4b, 0x7e, 0x0d, 0x55,
-	0x0c, 0x4c, 0xe9, 0xf1, 0x2a, 0x78, 0x6f, 0x85, 0xf1, 0x2a, 0xb8, 0x4f, 0x00, 0xe9, 0xf6, 0x28,
-	0x15, 0x32, 0xb9, 0x16, 0x4b, 0x05, 0x7b, 0x94, 0x74, 0xc9, 0x8f, 0x02, 0xcc, 0x45, 0x66, 0x15,
-	0xb9, 0x36, 0xb1, 0x91, 0x45, 0xc6, 0xa6, 0x98, 0x8b, 0x69, 0x8d, 0xac, 0xdf, 0x1f, 0x66, 0x7d,
-	0x73, 0x72, 0x91, 0x07, 0xed, 0xcd, 0xae, 0x39, 0x61, 0xfb, 0x56, 0x8b, 0xcf, 0x8e, 0xd3, 0xc2,
-	0xf3, 0xe3, 0xb4, 0xf0, 0xf7, 0x71, 0x5a, 0xf8, 0xe6, 0x24, 0x9d, 0x78, 0x7e, 0x92, 0x4e, 0xfc,
-	0x71, 0x92, 0x4e, 0x3c, 0xc0, 0xbf, 0xb8, 0xae, 0xf1, 0x50, 0xb6, 0x1c, 0xe5, 0x09, 0x43, 0xf3,
-	0x3a, 0x4d, 0xd3, 0xad, 0x9e, 0xa7, 0xef, 0xe4, 0xe2, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf9,
-	0xa5, 0x31, 0xbf, 0xd7, 0x0f, 0x00, 0x00,
+	// 1339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xc1, 0x6f, 0xdb, 0x64,
+	0x14, 0x8f, 0xb3, 0xb2, 0x6e, 0xaf, 0xed, 0x06, 0x5f, 0x32, 0x91, 0xb9, 0x5d, 0x12, 0x3c, 0xd8,
+	0xda, 0xb2, 0xd8, 0x4b, 0xda, 0x6d, 0xac, 0x12, 0x87, 0x86, 0x69, 0xa3, 0xaa, 0x80, 0xcc, 0x9b,
+	0x60, 0xec, 0x12, 0x39, 0x89, 0x9b, 0x58, 0x4d, 0xed, 0x2c, 0x76, 0xd0, 0x42, 0x55, 0x21, 0x21,
+	0x21, 0xed, 0xc0, 0x01, 0x09, 0x24, 0x0e, 0x5c, 0x7a, 0xe4, 0xc8, 0xa1, 0x02, 0x21, 0xb8, 0xc1,
+	0x61, 0xf4, 0x34, 0x95, 0x0b, 0xe2, 0x80, 0x50, 0x8b, 0x04, 0xff, 0x00, 0x77, 0x94, 0xef, 0x7b,
+	0x8e, 0xed, 0xe4, 0x4b, 0xe2, 0xb6, 0xdc, 0x52, 0x7f, 0xef, 0xfd, 0xde, 0xef, 0xf7, 0xbe, 0xe7,
+	0xe7, 0x9f, 0x0a, 0xa9, 0xb2, 0x65, 0x6f, 0x58, 0xb6, 0xa2, 0xb5, 0x9c, 0x9a, 0xf2, 0x41, 0xb6,
+	0xa4, 0x3b, 0x5a, 0x56, 0x79, 0xd4, 0xd2, 0x9b, 0x6d, 0xb9, 0xd1, 0xb4, 0x1c, 0x8b, 0xc4, 0x58,
+	0x80, 0xdc, 0x09, 0x90, 0x31, 0x40, 0x9c, 0xc7, 0xac, 0x92, 0x66, 0xeb, 0x2c, 0xba, 0x9b, 0xdb,
+	0xd0, 0xaa, 0x86, 0xa9, 0x39, 0x86, 0x65, 0x32, 0x00, 0x31, 0x5e, 0xb5, 0xaa, 0x16, 0xfd, 0xa9,
+	0x74, 0x7e, 0xe1, 0xd3, 0xf3, 0x55, 0xcb, 0xaa, 0xd6, 0x75, 0x85, 0xfe, 0x55, 0x6a, 0xad, 0x29,
+	0x9a, 0x89, 0x15, 0xc5, 0x19, 0x3c, 0xd2, 0x1a, 0x86, 0xa2, 0x99, 0xa6, 0xe5, 0x50, 0x34, 0x1b,
+	0x4f, 0x93, 0x3c, 0xc2, 0x94, 0x1c, 0x02, 0xb3, 0xf3, 0x22, 0xab, 0x88, 0xe4, 0xd9, 0xd1, 0x34,
+	0xa6, 0xba, 0x84, 0xfd, 0x3a, 0x25, 0x1b, 0xe2, 0x77, 0x3b, 0x7f, 0x2e, 0x97, 0xcb, 0x56, 0xcb,
+	0x74, 0x6c, 0x55, 0x7f, 0xd4, 0xd2, 0x6d, 0x87, 0xdc, 0x06, 0xf0, 0x24, 0x25, 0x84, 0xb4, 0x30,
+	0x3b, 0x91, 0xbb, 0x24, 0x23, 0x6e, 0x47, 0xbf, 0xcc, 0x50, 0x90, 0x8a, 0x5c, 0xd0, 0xaa, 0x3a,
+	0xe6, 0xaa, 0xbe, 0xcc, 0xa5, 0xd8, 0xde, 0x4e, 0xe6, 0x2c, 0x4b, 0xcb, 0xd8, 0x95, 0xf5, 0xf4,
+	0x55, 0x79, 0x71, 0x41, 0xfa, 0x45, 0x80, 0x73, 0x3d, 0x55, 0xed, 0x86, 0x65, 0xda, 0x3a, 0x51,
+	0xe1, 0x94, 0x86, 0xcf, 0x12, 0x42, 0xfa, 0xc4, 0xec, 0x44, 0x2e, 0x2e, 0xb3, 0xbe, 0xc8, 0x6e,
+	0xcb, 0xe4, 0x65, 0xb3, 0x9d, 0x4f, 0xef, 0xee, 0x64, 0x66, 0x38, 0x57, 0x24, 0x23, 0xe2, 0x8a,
+	0xda, 0xc5, 0x21, 0x77, 0x02, 0x52, 0xa2, 0x54, 0xca, 0xe5, 0x91, 0x52, 0x18, 0xa1, 0x51, 0x5a,
+	0x6e, 0x48, 0xf7, 0x20, 0xe6, 0x97, 0xe2, 0xf6, 0x2f, 0x07, 0xe3, 0x5a, 0xa5, 0xd2, 0xd4, 0x6d,
+	0x9b, 0x36, 0xef, 0x74, 0x3e, 0xb1, 0xb7, 0x93, 0x89, 0x63, 0xd1, 0x65, 0x76, 0x72, 0xcf, 0x69,
+	0x1a, 0x66, 0x55, 0x75, 0x03, 0x97, 0x4e, 0x3d, 0xd9, 0x4e, 0x45, 0xfe, 0xd9, 0x4e, 0x45, 0xa4,
+	0x5a, 0xf0, 0x56, 0xba, 0xed, 0x29, 0xc0, 0x38, 0xca, 0xc2, 0x2b, 0x39, 0x6a, 0x77, 0x5c, 0x18,
+	0x29, 0x0e, 0x84, 0x56, 0x2a, 0x68, 0x4d, 0x6d, 0xc3, 0xbd, 0x7d, 0xa9, 0x00, 0xb1, 0xc0, 0x53,
+	0x2c, 0x7f, 0x13, 0x4e, 0x36, 0xe8, 0x13, 0xac, 0x3e, 0x2d, 0xf3, 0x8a, 0xb0, 0xa4, 0xfc, 0xd8,
+	0xd3, 0x3f, 0x52, 0x11, 0x15, 0x13, 0xa4, 0x2c, 0x88, 0x14, 0xf1, 0x2d, 0xab, 0xd2, 0xaa, 0xeb,
+	0x3d, 0xd3, 0xc6, 0xeb, 0xec, 0x75, 0xe9, 0x53, 0x01, 0xa6, 0xb9, 0x39, 0xc8, 0xe6, 0x41, 0xc8,
+	0x59, 0xb9, 0xb4, 0xbb, 0x93, 0x91, 0x78, 0x44, 0x03, 0xb8, 0xbe, 0x89, 0xe1, 0xd3, 0xb9, 0x06,
+	0xa9, 0x7e, 0x36, 0xf9, 0xf6, 0xdb, 0xda, 0x86, 0x3b, 0xf8, 0x84, 0xc0, 0x98, 0xa9, 0x6d, 0xe8,
+	0xec, 0xc6, 0x55, 0xfa, 0x5b, 0xfa, 0x10, 0xd2, 0x83, 0xd3, 0x50, 0xc9, 0xbb, 0xe1, 0xae, 0x35,
+	0xac, 0x90, 0xee, 0xe5, 0xce, 0x43, 0x2c, 0xaf, 0x97, 0x6b, 0x0b, 0xb9, 0x42, 0x53, 0x5f, 0x33,
+	0x1e, 0x0f, 0xed, 0x76, 0x01, 0xe2, 0xc1, 0x58, 0xe4, 0x76, 0x11, 0xa6, 0x4a, 0xf4, 0x79, 0xb1,
+	0x41, 0x0f, 0x50, 0xdc, 0x64, 0xc9, 0x17, 0xcc, 0x47, 0x7c, 0x0f, 0xa6, 0x71, 0xd0, 0xf3, 0x6d,
+	0x47, 0xb7, 0xef, 0x5b, 0x38, 0xef, 0xd8, 0xac, 0x8b, 0x30, 0x85, 0x83, 0x5f, 0x2c, 0x75, 0xce,
+	0x29, 0xf0, 0xa4, 0x3a, 0xa9, 0xf9, 0x72, 0xf8, 0xc0, 0x0f, 0x61, 0x86, 0x0f, 0x8c, 0x94, 0x5f,
+	0x81, 0x33, 0x2e, 0xb2, 0x4d, 0x4f, 0x90, 0xb3, 0x5b, 0x8f, 0x85, 0xf3, 0xb1, 0xdf, 0xef, 0x92,
+	0x66, 0x51, 0xf7, 0x2d, 0x5a, 0xc3, 0x25, 0x7d, 0x1c, 0xe8, 0x07, 0x5d, 0xda, 0x3d, 0xd0, 0x5e,
+	0xa7, 0x8f, 0xd8, 0x90, 0x8f, 0x20, 0xe9, 0x5f, 0x17, 0xdd, 0xe6, 0xac, 0xdc, 0xf2, 0x26, 0x33,
+	0x6a, 0x54, 0x28, 0xe0, 0x89, 0x7c, 0x34, 0x21, 0xa8, 0x51, 0xa3, 0x42, 0x72, 0x00, 0x38, 0x28,
+	0x45, 0xa3, 0x42, 0xf7, 0xe2, 0x58, 0x3e, 0xf6, 0x7b, 0xff, 0x8a, 0x53, 0x4f, 0x63, 0xd8, 0x4a,
+	0x65, 0xe9, 0xdc, 0xde, 0x4e, 0xe6, 0x85, 0x9e, 0xf2, 0x72, 0x4e, 0xda, 0x84, 0xd4, 0x40, 0x02,
+	0xa8, 0x6e, 0x19, 0xce, 0xba, 0xd5, 0xc2, 0x2e, 0xc6, 0x33, 0x5a, 0x00, 0x6e, 0x50, 0xf1, 0x12,
+	0xbc, 0xe8, 0x2f, 0xbe, 0x62, 0xae, 0x59, 0xc7, 0xd9, 0xc2, 0xdc, 0x2d, 0xaf, 0x43, 0xa2, 0xbf,
+	0x06, 0x2a, 0x5b, 0x84, 0x31, 0xc3, 0x5c, 0xb3, 0xf0, 0xd5, 0x4d, 0x73, 0x77, 0x62, 0x5e, 0xb3,
+	0xdd, 0xf7, 0x53, 0xa5, 0xd1, 0xfc, 0x32, 0x05, 0x77, 0x4b, 0xb6, 0xea, 0x8e, 0x61, 0x1b, 0xd5,
+	0x82, 0x55, 0x37, 0xca, 0xed, 0x63, 0xa8, 0xf1, 0x2d, 0xd1, 0x1e, 0x48, 0x24, 0x3f, 0x03, 0xa7,
+	0x9d, 0x5a, 0x53, 0xb7, 0x6b, 0x56, 0x9d, 0xcd, 0xc7, 0x94, 0xea, 0x3d, 0x20, 0xef, 0xc0, 0x44,
+	0xa3, 0x55, 0xaa, 0x1b, 0xe5, 0xe2, 0xba, 0xde, 0xb6, 0x13, 0xd1, 0x21, 0x5b, 0x36, 0xb1, 0xeb,
+	0x71, 0x29, 0x37, 0xdb, 0x0d, 0xc7, 0x92, 0x0b, 0xad, 0xd2, 0xaa, 0xde, 0x56, 0x81, 0x41, 0xac,
+	0xea, 0x6d, 0x5b, 0xfa, 0x52, 0x80, 0xf3, 0xec, 0xcb, 0x42, 0xcf, 0xde, 0x34, 0x6c, 0xc7, 0x6a,
+	0x1e, 0x47, 0x60, 0x8f, 0x51, 0x89, 0x1e, 0xd5, 0xa8, 0x48, 0x5f, 0x0b, 0x20, 0xf2, 0x98, 0x75,
+	0xc7, 0x77, 0xbc, 0x5c, 0xd3, 0xcc, 0xaa, 0xee, 0x7e, 0x6b, 0x5e, 0xe2, 0x7f, 0xfb, 0x68, 0xf2,
+	0x1b, 0x34, 0x12, 0xbf, 0x80, 0x6e, 0xde, 0xff, 0xe6, 0x43, 0x72, 0xff, 0x3e, 0x0f, 0xcf, 0x51,
+	0xaa, 0x64, 0x5b, 0x80, 0x53, 0xee, 0x77, 0x91, 0xcc, 0x71, 0x19, 0xf1, 0xdc, 0x9d, 0x38, 0x1f,
+	0x26, 0x94, 0x55, 0x96, 0x5e, 0xdf, 0xed, 0x77, 0x70, 0x4f, 0xfe, 0xfe, 0x66, 0x5e, 0xf8, 0xf8,
+	0xd7, 0xbf, 0x3e, 0x8f, 0xa6, 0xc8, 0x05, 0x85, 0x6b, 0x4d, 0x5d, 0x56, 0x5f, 0x08, 0x30, 0x8e,
+	0x98, 0x64, 0x76, 0x64, 0x59, 0x97, 0xe0, 0x5c, 0x88, 0x48, 0xe4, 0xb7, 0xe8, 0x91, 0x99, 0x23,
+	0x97, 0x87, 0x92, 0x51, 0x36, 0x71, 0x6a, 0xb6, 0xc8, 0x9e, 0x00, 0xa4, 0x7f, 0x5b, 0x91, 0x85,
+	0x91, 0x75, 0xfb, 0x97, 0xab, 0xb8, 0x78, 0xb8, 0x24, 0xe4, 0x7d, 0x77, 0x97, 0xb7, 0xcd, 0x3c,
+	0x31, 0x59, 0xa2, 0xf0, 0xc5, 0x74, 0xbf, 0x11, 0x45, 0xa3, 0xa2, 0x6c, 0x7a, 0x2b, 0x7c, 0x8b,
+	0x7c, 0x22, 0xc0, 0x49, 0xe6, 0xbe, 0xc8, 0xe5, 0xc1, 0x9c, 0x02, 0x56, 0x4f, 0x9c, 0x1d, 0x1d,
+	0x88, 0x84, 0x67, 0x3d, 0x6e, 0x17, 0xc8, 0x34, 0x97, 0x1b, 0x33, 0x7b, 0xe4, 0x07, 0x01, 0xce,
+	0x04, 0x4d, 0x1b, 0x51, 0x06, 0x97, 0xe1, 0x5a, 0x42, 0xf1, 0x6a, 0xf8, 0x04, 0xe4, 0x77, 0x7b,
+	0x44, 0x43, 0x2f, 0x91, 0x97, 0xb9, 0xa4, 0x37, 0x28, 0x5c, 0xb1, 0x3b, 0xb1, 0x3f, 0x0a, 0x10,
+	0xe3, 0xb8, 0x35, 0xb2, 0x18, 0x92, 0x51, 0xc0, 0x13, 0x8a, 0xd7, 0x0e, 0x99, 0x85, 0x62, 0x5e,
+	0xf3, 0x78, 0x67, 0xc8, 0xab, 0x61, 0x78, 0x2b, 0x9b, 0x1d, 0xbf, 0xb9, 0x45, 0xbe, 0x12, 0x60,
+	0xd2, 0xef, 0xe4, 0x06, 0xbc, 0x75, 0x1c, 0x63, 0x28, 0xce, 0x85, 0x88, 0x44, 0x7e, 0xd7, 0xfb,
+	0xb7, 0xc2, 0xf5, 0xa1, 0xa3, 0xc1, 0xfc, 0x22, 0xf9, 0x49, 0x80, 0x38, 0xcf, 0xbc, 0x11, 0xfe,
+	0x7d, 0x0f, 0x31, 0x90, 0x62, 0xf6, 0x10, 0x19, 0xbe, 0x11, 0xe1, 0xb2, 0x1e, 0xd4, 0x63, 0xc6,
+	0x5a, 0xd9, 0x0c, 0x18, 0xb3, 0x2d, 0xf2, 0xb3, 0xa7, 0x22, 0xe0, 0xe5, 0x86, 0xab, 0xe0, 0x39,
+	0x4a, 0x31, 0x7b, 0x88, 0x0c, 0x54, 0x71, 0x67, 0x90, 0x0a, 0x99, 0x5c, 0x09, 0xa5, 0x82, 0x59,
+	0xd7, 0x2d, 0xf2, 0x9d, 0x00, 0x13, 0x3e, 0x47, 0x43, 0xae, 0x8c, 0x5c, 0x64, 0x3e, 0x73, 0x25,
+	0x66, 0x42, 0x46, 0x23, 0xeb, 0xd5, 0x7e, 0xd6, 0x37, 0x46, 0x0f, 0x79, 0x77, 0xbd, 0x99, 0x6b,
+	0x96, 0x6f, 0x7d, 0x7f, 0xdf, 0xd9, 0x30, 0x01, 0x47, 0x33, 0x74, 0xc3, 0xf0, 0xec, 0x94, 0x78,
+	0x35, 0x7c, 0x02, 0x4a, 0xb8, 0xe5, 0xf1, 0xbd, 0x49, 0x6e, 0xf0, 0x5f, 0x4a, 0xcc, 0x2c, 0xf6,
+	0x7f, 0x73, 0x94, 0x06, 0x23, 0xfa, 0xad, 0x00, 0x53, 0x01, 0x93, 0x41, 0xe4, 0x21, 0x3b, 0x98,
+	0xe3, 0x93, 0x44, 0x25, 0x74, 0x7c, 0x78, 0xe2, 0x3c, 0xbe, 0xad, 0x52, 0xc7, 0xf7, 0x15, 0x6b,
+	0x0c, 0x2d, 0xbf, 0xf0, 0x74, 0x3f, 0x29, 0x3c, 0xdb, 0x4f, 0x0a, 0x7f, 0xee, 0x27, 0x85, 0xcf,
+	0x0e, 0x92, 0x91, 0x67, 0x07, 0xc9, 0xc8, 0x6f, 0x07, 0xc9, 0xc8, 0x43, 0xfc, 0xef, 0x93, 0x5d,
+	0x59, 0x97, 0x0d, 0x4b, 0x79, 0xcc, 0x90, 0x9d, 0x76, 0x43, 0xb7, 0x4b, 0x27, 0xa9, 0x4b, 0x5c,
+	0xf8, 0x6f, 0x00, 0x7c, 0x8c, 0x7f, 0xb6, 0x72, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1067,6 +1295,10 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// MultisigPolicy queries the current policy of a multisig account.
+	MultisigPolicy(ctx context.Context, in *QueryMultisigPolicyRequest, opts ...grpc.CallOption) (*QueryMultisigPolicyResponse, error)
+	// PubKeyHistory queries the public key changes of an account, oldest first.
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1167,6 +1399,24 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) MultisigPolicy(ctx context.Context, in *QueryMultisigPolicyRequest, opts ...grpc.CallOption) (*QueryMultisigPolicyResponse, error) {
+	out := new(QueryMultisigPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/MultisigPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1191,6 +1441,10 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// MultisigPolicy queries the current policy of a multisig account.
+	MultisigPolicy(context.Context, *QueryMultisigPolicyRequest) (*QueryMultisigPolicyResponse, error)
+	// PubKeyHistory queries the public key changes of an account, oldest first.
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1227,6 +1481,12 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) MultisigPolicy(ctx context.Context, req *QueryMultisigPolicyRequest) (*QueryMultisigPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigPolicy not implemented")
+}
+func (*UnimplementedQueryServer) PubKeyHistory(ctx context.Context, req *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1412,6 +1672,42 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MultisigPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultisigPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultisigPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/MultisigPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultisigPolicy(ctx, req.(*QueryMultisigPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1456,6 +1752,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "MultisigPolicy",
+			Handler:    _Query_MultisigPolicy_Handler,
+		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2092,56 +2396,219 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMultisigPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryMultisigPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultisigPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMultisigPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMultisigPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMultisigPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublicKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPubKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -2359,6 +2826,73 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMultisigPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMultisigPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3976,6 +4510,429 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMultisigPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultisigPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultisigPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMultisigPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMultisigPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMultisigPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, &any.Any{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, PubKeyChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
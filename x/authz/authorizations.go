@@ -26,3 +26,13 @@ type Authorization interface {
 	// doesn't require access to any other information.
 	ValidateBasic() error
 }
+
+// SpendLimitAuthorization is implemented by the Authorizations limiting the
+// coins which can be spent with them.
+type SpendLimitAuthorization interface {
+	Authorization
+
+	// RemainingSpendLimit returns the coins which can still be spent with the
+	// authorization, nil if it is not limited.
+	RemainingSpendLimit() sdk.Coins
+}
@@ -28,6 +28,8 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagAllowedValues     = "allowed-values"
+	FlagMaxAmount         = "max-amount"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -101,6 +103,7 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %[1]s tx authz grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.bank.v1beta1.MsgSend --allowed-values=to_address=cosmos1ghe..,cosmos1fl4.. --max-amount=amount=1000stake --from=cosmos1sk..
 	`, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -167,7 +170,12 @@ Examples:
 					return err
 				}
 
-				authorization = authz.NewGenericAuthorization(msgType)
+				rules, err := getGenericAuthorizationRules(cmd)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewGenericAuthorization(msgType, rules...)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -252,10 +260,48 @@ Examples:
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
+	cmd.Flags().StringArray(FlagAllowedValues, []string{}, "Rule of a GenericAuthorization restricting a msg field to the given values, as field=value1,value2")
+	cmd.Flags().StringArray(FlagMaxAmount, []string{}, "Rule of a GenericAuthorization capping a msg coin field for each execution, as field=coins")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
 	return cmd
 }
 
+// getGenericAuthorizationRules returns the GenericAuthorization rules set by the
+// allowed values and max amount flags.
+func getGenericAuthorizationRules(cmd *cobra.Command) ([]*authz.Rule, error) {
+	var rules []*authz.Rule
+
+	allowedValues, err := cmd.Flags().GetStringArray(FlagAllowedValues)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range allowedValues {
+		field, values, ok := strings.Cut(rule, "=")
+		if !ok || field == "" || values == "" {
+			return nil, fmt.Errorf("invalid allowed values rule %q, expected field=value1,value2", rule)
+		}
+		rules = append(rules, &authz.Rule{Field: field, AllowedValues: strings.Split(values, ",")})
+	}
+
+	maxAmounts, err := cmd.Flags().GetStringArray(FlagMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range maxAmounts {
+		field, amount, ok := strings.Cut(rule, "=")
+		if !ok || field == "" {
+			return nil, fmt.Errorf("invalid max amount rule %q, expected field=coins", rule)
+		}
+		maxAmount, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &authz.Rule{Field: field, MaxAmount: maxAmount})
+	}
+
+	return rules, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
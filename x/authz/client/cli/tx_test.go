@@ -32,7 +32,10 @@ import (
 	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
-var typeMsgVote = sdk.MsgTypeURL(&govv1.MsgVote{})
+var (
+	typeMsgVote = sdk.MsgTypeURL(&govv1.MsgVote{})
+	typeMsgSend = sdk.MsgTypeURL(&banktypes.MsgSend{})
+)
 
 type CLITestSuite struct {
 	suite.Suite
@@ -430,6 +433,39 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"Valid tx generic authorization with rules",
+			[]string{
+				granteeAddr,
+				"generic",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=to_address=%s", cli.FlagAllowedValues, s.grantee[1]),
+				fmt.Sprintf("--%s=amount=100stake", cli.FlagMaxAmount),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, fromAddr),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"invalid generic authorization rule",
+			[]string{
+				granteeAddr,
+				"generic",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=to_address", cli.FlagAllowedValues),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, fromAddr),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			true,
+			"invalid allowed values rule",
+		},
 		{
 			"fail when granter = grantee",
 			[]string{
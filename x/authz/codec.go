@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/core/registry"
 	coretransaction "cosmossdk.io/core/transaction"
 	bank "cosmossdk.io/x/bank/types"
+	protocolpool "cosmossdk.io/x/protocolpool/types"
 	staking "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
@@ -30,9 +31,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgExec{},
 	)
 
-	// since bank.SendAuthorization and staking.StakeAuthorization both implement Authorization
-	// and authz depends on x/bank and x/staking in other places, these registrations are placed here
-	// to prevent a cyclic dependency.
+	// since bank.SendAuthorization, staking.StakeAuthorization and
+	// protocolpool.FundCommunityPoolAuthorization implement Authorization and authz depends on
+	// x/bank and x/staking in other places, these registrations are placed here to prevent a
+	// cyclic dependency.
 	// see: https://github.com/cosmos/cosmos-sdk/pull/16509
 	registrar.RegisterInterface(
 		"cosmos.authz.v1beta1.Authorization",
@@ -40,6 +42,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&GenericAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
+		&protocolpool.FundCommunityPoolAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, MsgServiceDesc())
 }
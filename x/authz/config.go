@@ -0,0 +1,25 @@
+package authz
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Config used to initialize x/authz module avoiding using global variable.
+type Config struct {
+	// ExpirationNoticeWindow defines the duration before the expiration of a
+	// grant from which its use emits an EventGrantExpiring. Zero disables it.
+	ExpirationNoticeWindow time.Duration
+
+	// SpendLimitNoticeThreshold defines, for each denom, the remaining spend
+	// limit of a grant at or below which its use emits an EventGrantExpiring.
+	// Empty disables it.
+	SpendLimitNoticeThreshold sdk.Coins
+}
+
+// DefaultConfig returns the default config for authz, which emits no
+// EventGrantExpiring.
+func DefaultConfig() Config {
+	return Config{}
+}
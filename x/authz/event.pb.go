@@ -6,16 +6,23 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -252,37 +259,134 @@ func (m *EventPruneExpiredGrants) GetPruner() string {
 	return ""
 }
 
+// EventGrantExpiring is emitted on Msg/Exec when a grant is used within the
+// configured notice window before its expiration, or when its remaining spend
+// limit is at or below the configured notice threshold.
+type EventGrantExpiring struct {
+	// Msg type URL of the grant
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Granter account address
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// Grantee account address
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Expiration of the grant, set if it expires within the notice window
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// Remaining spend limit of the grant, set if it is at or below the notice
+	// threshold in any denom
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *EventGrantExpiring) Reset()         { *m = EventGrantExpiring{} }
+func (m *EventGrantExpiring) String() string { return proto.CompactTextString(m) }
+func (*EventGrantExpiring) ProtoMessage()    {}
+func (*EventGrantExpiring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f88cbc71a8baf1f, []int{4}
+}
+func (m *EventGrantExpiring) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGrantExpiring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGrantExpiring.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGrantExpiring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGrantExpiring.Merge(m, src)
+}
+func (m *EventGrantExpiring) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGrantExpiring) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGrantExpiring.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGrantExpiring proto.InternalMessageInfo
+
+func (m *EventGrantExpiring) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventGrantExpiring) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *EventGrantExpiring) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *EventGrantExpiring) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *EventGrantExpiring) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventGrant)(nil), "cosmos.authz.v1beta1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "cosmos.authz.v1beta1.EventRevoke")
 	proto.RegisterType((*EventRevokeAll)(nil), "cosmos.authz.v1beta1.EventRevokeAll")
 	proto.RegisterType((*EventPruneExpiredGrants)(nil), "cosmos.authz.v1beta1.EventPruneExpiredGrants")
+	proto.RegisterType((*EventGrantExpiring)(nil), "cosmos.authz.v1beta1.EventGrantExpiring")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/event.proto", fileDescriptor_1f88cbc71a8baf1f) }
 
 var fileDescriptor_1f88cbc71a8baf1f = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xa8,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x84, 0x88, 0xc6, 0x83, 0xd5, 0xe8, 0x43, 0x95,
-	0x80, 0x39, 0x4a, 0xab, 0x19, 0xb9, 0xb8, 0x5c, 0x41, 0x06, 0xb8, 0x17, 0x25, 0xe6, 0x95, 0x08,
-	0x29, 0x70, 0xf1, 0xe4, 0x16, 0xa7, 0xc7, 0x97, 0x54, 0x16, 0xa4, 0xc6, 0x97, 0x16, 0xe5, 0x48,
-	0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x71, 0xe5, 0x16, 0xa7, 0x87, 0x54, 0x16, 0xa4, 0x86, 0x16,
-	0xe5, 0x08, 0x19, 0x71, 0xb1, 0xa7, 0x83, 0x94, 0xa6, 0x16, 0x49, 0x30, 0x83, 0x24, 0x9d, 0x24,
-	0x2e, 0x6d, 0xd1, 0x85, 0x59, 0xeb, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94,
-	0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93, 0x2a, 0xc1, 0x42, 0x9c, 0x9e, 0x54, 0x2b, 0xe1,
-	0x4b, 0x5b, 0x74, 0xf9, 0x21, 0x4a, 0x74, 0x8b, 0x53, 0xb2, 0x15, 0x0c, 0xf4, 0x4c, 0x8c, 0x95,
-	0xd6, 0x30, 0x72, 0x71, 0x83, 0x5d, 0x1b, 0x94, 0x5a, 0x96, 0x9f, 0x9d, 0x3a, 0xd8, 0x9d, 0x5b,
-	0xcd, 0xc5, 0x87, 0xe4, 0x5a, 0xc7, 0x9c, 0x1c, 0xda, 0x38, 0x18, 0x8b, 0xe5, 0xa6, 0x86, 0x4a,
-	0x71, 0x5c, 0xe2, 0x60, 0xcb, 0x03, 0x8a, 0x4a, 0xf3, 0x52, 0x5d, 0x2b, 0x0a, 0x32, 0x8b, 0x52,
-	0x53, 0xc0, 0x91, 0x5c, 0x2c, 0x64, 0xc0, 0xc5, 0x56, 0x00, 0x12, 0x2d, 0x82, 0xd8, 0x8f, 0xc7,
-	0x0a, 0xa8, 0x3a, 0x2b, 0xc1, 0x4b, 0x5b, 0x74, 0x79, 0x2b, 0x20, 0x09, 0x4f, 0xc1, 0x50, 0xcf,
-	0x40, 0xcf, 0xc0, 0x49, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
-	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xa0,
-	0x46, 0x15, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0x43, 0x75, 0x25, 0xb1, 0x81, 0x13, 0x9c, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xfe, 0x86, 0xc9, 0xc5, 0x02, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xd3, 0x52, 0xc4, 0x86, 0x1f, 0x61, 0x2a, 0xe1, 0xe6, 0xe0, 0x58, 0x39, 0xe5, 0x92,
+	0xb5, 0x93, 0xc2, 0xa5, 0x27, 0x1a, 0x54, 0x71, 0xe1, 0x80, 0x4c, 0xb9, 0x70, 0x20, 0xb2, 0xe3,
+	0x61, 0xbb, 0x8a, 0xed, 0xb5, 0x76, 0xd7, 0x51, 0x0b, 0x2f, 0xd1, 0xe7, 0x00, 0x8e, 0x79, 0x88,
+	0x1e, 0xab, 0x9c, 0x38, 0x51, 0x48, 0x5e, 0x04, 0xed, 0x7a, 0x4d, 0x2a, 0x51, 0x41, 0x85, 0x84,
+	0xd4, 0xdb, 0xee, 0xec, 0xf7, 0xcd, 0x7c, 0x33, 0xb3, 0x1f, 0xf2, 0x26, 0x4c, 0x64, 0x4c, 0xf8,
+	0x51, 0x29, 0x8f, 0x3e, 0xf8, 0xb3, 0x41, 0x0c, 0x32, 0x1a, 0xf8, 0x30, 0x83, 0x5c, 0xe2, 0x82,
+	0x33, 0xc9, 0xec, 0xed, 0x0a, 0x81, 0x35, 0x02, 0x1b, 0x44, 0x7b, 0xa7, 0x8a, 0x8e, 0x35, 0xc6,
+	0x37, 0x10, 0x7d, 0x69, 0xbb, 0x26, 0x65, 0x1c, 0x09, 0xf8, 0x95, 0x71, 0xc2, 0x68, 0x6e, 0xde,
+	0xb7, 0x09, 0x23, 0xac, 0xe2, 0xa9, 0x93, 0x89, 0x76, 0x08, 0x63, 0x24, 0x05, 0x5f, 0xdf, 0xe2,
+	0xf2, 0xbd, 0x2f, 0x69, 0x06, 0x42, 0x46, 0x59, 0x51, 0x01, 0xba, 0x9f, 0x2d, 0x84, 0x0e, 0x94,
+	0xae, 0x17, 0x3c, 0xca, 0xa5, 0xed, 0xa1, 0xbb, 0x99, 0x20, 0x63, 0x79, 0x52, 0xc0, 0xb8, 0xe4,
+	0xa9, 0xd3, 0xf4, 0xac, 0xde, 0x9d, 0x10, 0x65, 0x82, 0x1c, 0x9e, 0x14, 0xf0, 0x86, 0xa7, 0xf6,
+	0x10, 0xdd, 0x26, 0x0a, 0x0a, 0xdc, 0xd9, 0x50, 0x8f, 0x23, 0x67, 0x31, 0xef, 0xd7, 0xdd, 0xec,
+	0x27, 0x09, 0x07, 0x21, 0x5e, 0x4b, 0x4e, 0x73, 0x12, 0xd6, 0xc0, 0x35, 0x07, 0x9c, 0xcd, 0xeb,
+	0x71, 0x60, 0xef, 0xd1, 0x62, 0xde, 0x7f, 0x50, 0x41, 0xfa, 0x22, 0x99, 0x7a, 0x01, 0x7e, 0xb2,
+	0xdb, 0xfd, 0x62, 0xa1, 0x96, 0x56, 0x1b, 0xc2, 0x8c, 0x4d, 0xe1, 0xa6, 0xcb, 0xfd, 0x88, 0xee,
+	0x5f, 0x52, 0xbb, 0x9f, 0xa6, 0xff, 0x47, 0xf0, 0x15, 0xc5, 0x9f, 0x0e, 0xba, 0xef, 0xd0, 0x63,
+	0x5d, 0xfc, 0x15, 0x2f, 0x73, 0x38, 0x38, 0x2e, 0x28, 0x87, 0x44, 0x2f, 0x59, 0xd8, 0x01, 0xda,
+	0x2a, 0x54, 0x94, 0x3b, 0xcd, 0xbf, 0x94, 0x30, 0xb8, 0xbd, 0x87, 0x8b, 0x79, 0xff, 0xde, 0x71,
+	0xf5, 0x9f, 0xbd, 0x01, 0x0e, 0x70, 0xd0, 0xfd, 0xd1, 0x44, 0xf6, 0xfa, 0xe7, 0xe8, 0x02, 0x34,
+	0x27, 0xbf, 0x75, 0x68, 0xfd, 0xa9, 0xc3, 0xe6, 0x3f, 0xac, 0xe4, 0x9a, 0x53, 0x01, 0xfb, 0x19,
+	0x42, 0xa0, 0x54, 0x45, 0x92, 0xb2, 0x5c, 0x6f, 0xb2, 0x35, 0x6c, 0xe3, 0xca, 0x10, 0xb8, 0x36,
+	0x04, 0x3e, 0xac, 0x0d, 0x31, 0xda, 0x3c, 0xbd, 0xe8, 0x58, 0xe1, 0x25, 0x8e, 0x9d, 0xa2, 0x96,
+	0x28, 0x20, 0x4f, 0xc6, 0x29, 0xcd, 0xa8, 0x74, 0x6e, 0x79, 0x1b, 0xbd, 0xd6, 0x70, 0x07, 0x9b,
+	0xb2, 0xca, 0x89, 0xb5, 0x73, 0xf1, 0x73, 0x46, 0xf3, 0x51, 0x70, 0xf6, 0xad, 0xd3, 0xf8, 0x74,
+	0xd1, 0xe9, 0x11, 0x2a, 0x8f, 0xca, 0x18, 0x4f, 0x58, 0x66, 0x4c, 0xec, 0xaf, 0xb7, 0xe3, 0xab,
+	0xd1, 0x08, 0x4d, 0x10, 0x21, 0xd2, 0xf9, 0x5f, 0xaa, 0xf4, 0x57, 0xcc, 0x78, 0x84, 0xcf, 0x96,
+	0xae, 0x75, 0xbe, 0x74, 0xad, 0xef, 0x4b, 0xd7, 0x3a, 0x5d, 0xb9, 0x8d, 0xf3, 0x95, 0xdb, 0xf8,
+	0xba, 0x72, 0x1b, 0x6f, 0x4d, 0xef, 0x22, 0x99, 0x62, 0xca, 0x7c, 0xc3, 0x8a, 0xb7, 0x74, 0x5b,
+	0xbb, 0x3f, 0x07, 0x00, 0x14, 0xfc, 0x99, 0xd0, 0x80, 0x04, 0x00, 0x00,
 }
 
 func (m *EventGrant) Marshal() (dAtA []byte, err error) {
@@ -440,6 +544,74 @@ func (m *EventPruneExpiredGrants) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventGrantExpiring) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGrantExpiring) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGrantExpiring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvent(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -523,6 +695,37 @@ func (m *EventPruneExpiredGrants) Size() (n int) {
 	return n
 }
 
+func (m *EventGrantExpiring) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1017,6 +1220,222 @@ func (m *EventPruneExpiredGrants) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventGrantExpiring) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGrantExpiring: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGrantExpiring: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
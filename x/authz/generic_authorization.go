@@ -3,15 +3,29 @@ package authz
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+const coinFullName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
 // NewGenericAuthorization creates a new GenericAuthorization object.
-func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+func NewGenericAuthorization(msgTypeURL string, rules ...*Rule) *GenericAuthorization {
 	return &GenericAuthorization{
-		Msg: msgTypeURL,
+		Msg:   msgTypeURL,
+		Rules: rules,
 	}
 }
 
@@ -22,6 +36,21 @@ func (a GenericAuthorization) MsgTypeURL() string {
 
 // Accept implements Authorization.Accept.
 func (a GenericAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if len(a.Rules) == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	m, err := toProtoReflectMsg(msg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	for _, rule := range a.Rules {
+		if err := rule.check(m); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	}
+
 	return authz.AcceptResponse{Accept: true}, nil
 }
 
@@ -30,5 +59,148 @@ func (a GenericAuthorization) ValidateBasic() error {
 	if a.Msg == "" {
 		return errors.New("msg type cannot be empty")
 	}
+
+	if len(a.Rules) == 0 {
+		return nil
+	}
+
+	md, err := msgDescriptor(a.Msg)
+	if err != nil {
+		return err
+	}
+
+	fields := make(map[string]struct{}, len(a.Rules))
+	for _, rule := range a.Rules {
+		if rule == nil {
+			return errors.New("rule cannot be nil")
+		}
+		if _, ok := fields[rule.Field]; ok {
+			return fmt.Errorf("duplicate rule for field %s", rule.Field)
+		}
+		fields[rule.Field] = struct{}{}
+
+		if err := rule.validate(md); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the rule can be applied to the msgs of the given descriptor.
+func (r *Rule) validate(md protoreflect.MessageDescriptor) error {
+	fd := md.Fields().ByName(protoreflect.Name(r.Field))
+	if fd == nil {
+		return fmt.Errorf("field %q not found in %s", r.Field, md.FullName())
+	}
+
+	switch {
+	case len(r.AllowedValues) > 0 && len(r.MaxAmount) > 0:
+		return fmt.Errorf("rule for field %s cannot set both allowed values and a max amount", r.Field)
+	case len(r.AllowedValues) > 0:
+		if fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			return fmt.Errorf("allowed values rule requires a string field, %s is not", r.Field)
+		}
+	case len(r.MaxAmount) > 0:
+		if fd.Kind() != protoreflect.MessageKind || fd.Message().FullName() != coinFullName {
+			return fmt.Errorf("max amount rule requires a coin field, %s is not", r.Field)
+		}
+		if !r.MaxAmount.IsValid() {
+			return fmt.Errorf("invalid max amount for field %s: %s", r.Field, r.MaxAmount)
+		}
+	default:
+		return fmt.Errorf("rule for field %s must set allowed values or a max amount", r.Field)
+	}
+
+	return nil
+}
+
+// check returns an error if the msg does not satisfy the rule.
+func (r *Rule) check(msg protoreflect.Message) error {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(r.Field))
+	if fd == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("field %q not found in %s", r.Field, msg.Descriptor().FullName())
+	}
+
+	if len(r.AllowedValues) > 0 {
+		value := msg.Get(fd).String()
+		if !slices.Contains(r.AllowedValues, value) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s %s is not allowed", r.Field, value)
+		}
+		return nil
+	}
+
+	amount, err := coinsFromField(msg, fd)
+	if err != nil {
+		return err
+	}
+	if !amount.IsAllLTE(r.MaxAmount) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s %s exceeds the max amount %s", r.Field, amount, r.MaxAmount)
+	}
+
 	return nil
 }
+
+// coinsFromField returns the coins held by a Coin or repeated Coin field.
+func coinsFromField(msg protoreflect.Message, fd protoreflect.FieldDescriptor) (sdk.Coins, error) {
+	var coinMsgs []protoreflect.Message
+	if fd.IsList() {
+		list := msg.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			coinMsgs = append(coinMsgs, list.Get(i).Message())
+		}
+	} else if msg.Has(fd) {
+		coinMsgs = append(coinMsgs, msg.Get(fd).Message())
+	}
+
+	var coins sdk.Coins
+	for _, coinMsg := range coinMsgs {
+		fields := coinMsg.Descriptor().Fields()
+		amount, ok := math.NewIntFromString(coinMsg.Get(fields.ByName("amount")).String())
+		if !ok {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid %s amount", fd.Name())
+		}
+		coin := sdk.Coin{Denom: coinMsg.Get(fields.ByName("denom")).String(), Amount: amount}
+		if err := coin.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid %s: %s", fd.Name(), err)
+		}
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
+
+// msgDescriptor returns the descriptor of the msg identified by the type URL.
+func msgDescriptor(msgTypeURL string) (protoreflect.MessageDescriptor, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(msgTypeURL, "/")))
+	if err != nil {
+		return nil, fmt.Errorf("unknown msg type %s: %w", msgTypeURL, err)
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a msg type", msgTypeURL)
+	}
+
+	return md, nil
+}
+
+// toProtoReflectMsg converts a gogoproto msg to a dynamic protoreflect message.
+func toProtoReflectMsg(msg sdk.Msg) (protoreflect.Message, error) {
+	md, err := msgDescriptor(sdk.MsgTypeURL(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	m := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(bz, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
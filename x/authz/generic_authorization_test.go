@@ -1,12 +1,17 @@
 package authz_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/x/authz"
 	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestGenericAuthorization(t *testing.T) {
@@ -15,3 +20,145 @@ func TestGenericAuthorization(t *testing.T) {
 	require.NoError(t, a.ValidateBasic())
 	require.Equal(t, banktypes.SendAuthorization{}.MsgTypeURL(), a.Msg)
 }
+
+func TestGenericAuthorizationRulesValidateBasic(t *testing.T) {
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	maxAmount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name   string
+		auth   *authz.GenericAuthorization
+		expErr string
+	}{
+		{
+			name: "valid rules",
+			auth: authz.NewGenericAuthorization(sendMsgType,
+				&authz.Rule{Field: "to_address", AllowedValues: []string{"cosmos1recipient"}},
+				&authz.Rule{Field: "amount", MaxAmount: maxAmount},
+			),
+		},
+		{
+			name:   "unknown msg type",
+			auth:   authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgUnknown", &authz.Rule{Field: "amount", MaxAmount: maxAmount}),
+			expErr: "unknown msg type",
+		},
+		{
+			name:   "unknown field",
+			auth:   authz.NewGenericAuthorization(sendMsgType, &authz.Rule{Field: "recipient", AllowedValues: []string{"cosmos1recipient"}}),
+			expErr: `field "recipient" not found`,
+		},
+		{
+			name: "duplicate field",
+			auth: authz.NewGenericAuthorization(sendMsgType,
+				&authz.Rule{Field: "amount", MaxAmount: maxAmount},
+				&authz.Rule{Field: "amount", MaxAmount: maxAmount},
+			),
+			expErr: "duplicate rule for field amount",
+		},
+		{
+			name:   "empty rule",
+			auth:   authz.NewGenericAuthorization(sendMsgType, &authz.Rule{Field: "amount"}),
+			expErr: "must set allowed values or a max amount",
+		},
+		{
+			name:   "both allowed values and max amount",
+			auth:   authz.NewGenericAuthorization(sendMsgType, &authz.Rule{Field: "amount", AllowedValues: []string{"1stake"}, MaxAmount: maxAmount}),
+			expErr: "cannot set both",
+		},
+		{
+			name:   "allowed values on a coin field",
+			auth:   authz.NewGenericAuthorization(sendMsgType, &authz.Rule{Field: "amount", AllowedValues: []string{"1stake"}}),
+			expErr: "requires a string field",
+		},
+		{
+			name:   "max amount on a string field",
+			auth:   authz.NewGenericAuthorization(sendMsgType, &authz.Rule{Field: "to_address", MaxAmount: maxAmount}),
+			expErr: "requires a coin field",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenericAuthorizationRulesAccept(t *testing.T) {
+	sendAuth := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		&authz.Rule{Field: "to_address", AllowedValues: []string{"cosmos1recipient"}},
+		&authz.Rule{Field: "amount", MaxAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+	)
+	delegateAuth := authz.NewGenericAuthorization(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		&authz.Rule{Field: "validator_address", AllowedValues: []string{"cosmosvaloper1validator"}},
+		&authz.Rule{Field: "amount", MaxAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+	)
+
+	testCases := []struct {
+		name   string
+		auth   *authz.GenericAuthorization
+		msg    sdk.Msg
+		expErr error
+	}{
+		{
+			name: "send within the rules",
+			auth: sendAuth,
+			msg:  &banktypes.MsgSend{FromAddress: "cosmos1granter", ToAddress: "cosmos1recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		},
+		{
+			name:   "send to a recipient not allowed",
+			auth:   sendAuth,
+			msg:    &banktypes.MsgSend{FromAddress: "cosmos1granter", ToAddress: "cosmos1other", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "send above the max amount",
+			auth:   sendAuth,
+			msg:    &banktypes.MsgSend{FromAddress: "cosmos1granter", ToAddress: "cosmos1recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 101))},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "send of a denom not in the max amount",
+			auth:   sendAuth,
+			msg:    &banktypes.MsgSend{FromAddress: "cosmos1granter", ToAddress: "cosmos1recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "delegate within the rules",
+			auth: delegateAuth,
+			msg:  &stakingtypes.MsgDelegate{DelegatorAddress: "cosmos1granter", ValidatorAddress: "cosmosvaloper1validator", Amount: sdk.NewInt64Coin("stake", 10)},
+		},
+		{
+			name:   "delegate to a validator not allowed",
+			auth:   delegateAuth,
+			msg:    &stakingtypes.MsgDelegate{DelegatorAddress: "cosmos1granter", ValidatorAddress: "cosmosvaloper1other", Amount: sdk.NewInt64Coin("stake", 10)},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "delegate above the max amount",
+			auth:   delegateAuth,
+			msg:    &stakingtypes.MsgDelegate{DelegatorAddress: "cosmos1granter", ValidatorAddress: "cosmosvaloper1validator", Amount: sdk.NewInt64Coin("stake", 11)},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.auth.ValidateBasic())
+
+			resp, err := tc.auth.Accept(context.Background(), tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+		})
+	}
+}
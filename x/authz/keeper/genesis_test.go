@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/authz/keeper"
 	authzmodule "cosmossdk.io/x/authz/module"
 	authztestutil "cosmossdk.io/x/authz/testutil"
@@ -71,7 +72,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 	msr.SetInterfaceRegistry(suite.encCfg.InterfaceRegistry)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithMsgRouterService(msr))
 
-	suite.keeper = keeper.NewKeeper(env, suite.encCfg.Codec, suite.accountKeeper)
+	suite.keeper = keeper.NewKeeper(env, suite.encCfg.Codec, suite.accountKeeper, authz.DefaultConfig())
 }
 
 func (suite *GenesisTestSuite) TestImportExportGenesis() {
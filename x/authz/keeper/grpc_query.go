@@ -192,3 +192,100 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// ExpiringGrants implements the Query/ExpiringGrants gRPC method.
+// It returns the grants of a granter expiring before the requested time,
+// including the expired grants not pruned yet.
+func (k Keeper) ExpiringGrants(ctx context.Context, req *authz.QueryExpiringGrantsRequest) (*authz.QueryExpiringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	store := runtime.KVStoreAdapter(k.KVStoreService.OpenKVStore(ctx))
+	authzStore := prefix.NewStore(store, grantStoreKey(nil, granter, ""))
+
+	grants, pageRes, err := query.GenericFilteredPaginate(k.cdc, authzStore, req.Pagination, func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
+		if auth.Expiration == nil || !auth.Expiration.Before(req.ExpiresBefore) {
+			return nil, nil
+		}
+
+		auth1, err := auth.GetAuthorization()
+		if err != nil {
+			return nil, err
+		}
+
+		any, err := codectypes.NewAnyWithValue(auth1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		granteeAddr, err := k.authKeeper.AddressCodec().BytesToString(firstAddressFromGrantStoreKey(key))
+		if err != nil {
+			return nil, err
+		}
+
+		return &authz.GrantAuthorization{
+			Granter:       req.Granter,
+			Grantee:       granteeAddr,
+			Authorization: any,
+			Expiration:    auth.Expiration,
+		}, nil
+	}, func() *authz.Grant {
+		return &authz.Grant{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryExpiringGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SimulateExec implements the Query/SimulateExec gRPC method.
+// It checks whether the grantee could execute the msgs through a MsgExec at the
+// current block time, without executing them.
+func (k Keeper) SimulateExec(ctx context.Context, req *authz.QuerySimulateExecRequest) (*authz.QuerySimulateExecResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msgs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "messages cannot be empty")
+	}
+
+	msgs, err := req.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateMsgs(msgs); err != nil {
+		return nil, err
+	}
+
+	resp := &authz.QuerySimulateExecResponse{
+		Accepted: true,
+		Results:  make([]*authz.SimulateExecResult, len(msgs)),
+	}
+	for i, err := range k.simulateExec(ctx, grantee, msgs) {
+		result := &authz.SimulateExecResult{Accepted: err == nil}
+		if err != nil {
+			result.Error = err.Error()
+			resp.Accepted = false
+		}
+		resp.Results[i] = result
+	}
+
+	return resp, nil
+}
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/authz"
 	banktypes "cosmossdk.io/x/bank/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -290,6 +291,183 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryExpiringGrants() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+
+	addr0, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(err)
+
+	now := suite.ctx.HeaderInfo().Time
+	suite.createSendAuthorization(addrs[1], addrs[0]) // expires in an hour
+	later := now.Add(24 * time.Hour)
+	err = suite.authzKeeper.SaveGrant(suite.ctx, addrs[2], addrs[0], &banktypes.SendAuthorization{SpendLimit: coins10}, &later)
+	require.NoError(err)
+	err = suite.authzKeeper.SaveGrant(suite.ctx, addrs[3], addrs[0], &banktypes.SendAuthorization{SpendLimit: coins10}, nil)
+	require.NoError(err)
+
+	testCases := []struct {
+		msg      string
+		expError bool
+		request  authz.QueryExpiringGrantsRequest
+		numItems int
+	}{
+		{
+			"fail invalid granter addr",
+			true,
+			authz.QueryExpiringGrantsRequest{},
+			0,
+		},
+		{
+			"valid case, no grant expiring",
+			false,
+			authz.QueryExpiringGrantsRequest{
+				Granter:       addr0,
+				ExpiresBefore: now,
+			},
+			0,
+		},
+		{
+			"valid case, single grant expiring",
+			false,
+			authz.QueryExpiringGrantsRequest{
+				Granter:       addr0,
+				ExpiresBefore: now.Add(2 * time.Hour),
+			},
+			1,
+		},
+		{
+			"valid case, multiple grants expiring",
+			false,
+			authz.QueryExpiringGrantsRequest{
+				Granter:       addr0,
+				ExpiresBefore: now.Add(48 * time.Hour),
+			},
+			2,
+		},
+		{
+			"valid case, pagination",
+			false,
+			authz.QueryExpiringGrantsRequest{
+				Granter:       addr0,
+				ExpiresBefore: now.Add(48 * time.Hour),
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.ExpiringGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.True(grant.Expiration.Before(tc.request.ExpiresBefore))
+				}
+			}
+		})
+	}
+}
+
+func (suite *TestSuite) TestGRPCQuerySimulateExec() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+
+	addr0, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(err)
+	addr1, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[1])
+	require.NoError(err)
+	addr2, err := suite.accountKeeper.AddressCodec().BytesToString(addrs[2])
+	require.NoError(err)
+
+	authorization := suite.createSendAuthorization(addrs[1], addrs[0]) // 100steak
+
+	sendMsg := func(from string, amount int64) *codectypes.Any {
+		msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+			FromAddress: from,
+			ToAddress:   addr2,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", amount)),
+		})
+		require.NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		msg         string
+		request     authz.QuerySimulateExecRequest
+		expErr      string
+		expAccepted []bool
+	}{
+		{
+			msg:     "fail invalid grantee addr",
+			request: authz.QuerySimulateExecRequest{Msgs: []*codectypes.Any{sendMsg(addr0, 10)}},
+			expErr:  "empty address string is not allowed",
+		},
+		{
+			msg:     "fail no msgs",
+			request: authz.QuerySimulateExecRequest{Grantee: addr1},
+			expErr:  "messages cannot be empty",
+		},
+		{
+			msg:         "accepted msg",
+			request:     authz.QuerySimulateExecRequest{Grantee: addr1, Msgs: []*codectypes.Any{sendMsg(addr0, 60)}},
+			expAccepted: []bool{true},
+		},
+		{
+			msg:         "msg without grant",
+			request:     authz.QuerySimulateExecRequest{Grantee: addr1, Msgs: []*codectypes.Any{sendMsg(addr2, 10)}},
+			expAccepted: []bool{false},
+		},
+		{
+			msg:         "msg signed by the grantee",
+			request:     authz.QuerySimulateExecRequest{Grantee: addr1, Msgs: []*codectypes.Any{sendMsg(addr1, 10)}},
+			expAccepted: []bool{true},
+		},
+		{
+			msg:         "msgs exceeding the spend limit together",
+			request:     authz.QuerySimulateExecRequest{Grantee: addr1, Msgs: []*codectypes.Any{sendMsg(addr0, 60), sendMsg(addr0, 60), sendMsg(addr0, 40)}},
+			expAccepted: []bool{true, false, true},
+		},
+		{
+			msg:         "msg after the grant is used up",
+			request:     authz.QuerySimulateExecRequest{Grantee: addr1, Msgs: []*codectypes.Any{sendMsg(addr0, 100), sendMsg(addr0, 1)}},
+			expAccepted: []bool{true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.SimulateExec(gocontext.Background(), &tc.request)
+			if tc.expErr != "" {
+				require.ErrorContains(err, tc.expErr)
+				return
+			}
+
+			require.NoError(err)
+			require.Len(result.Results, len(tc.expAccepted))
+			accepted := true
+			for i, res := range result.Results {
+				require.Equal(tc.expAccepted[i], res.Accepted)
+				require.Equal(res.Accepted, res.Error == "")
+				accepted = accepted && res.Accepted
+			}
+			require.Equal(accepted, result.Accepted)
+		})
+	}
+
+	// the grant is left untouched
+	got, _ := suite.authzKeeper.GetAuthorization(suite.ctx, addrs[1], addrs[0], bankSendAuthMsgType)
+	require.Equal(authorization, got)
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -31,14 +31,16 @@ type Keeper struct {
 
 	cdc        codec.Codec
 	authKeeper authz.AccountKeeper
+	config     authz.Config
 }
 
 // NewKeeper constructs a message authorization Keeper
-func NewKeeper(env appmodule.Environment, cdc codec.Codec, ak authz.AccountKeeper) Keeper {
+func NewKeeper(env appmodule.Environment, cdc codec.Codec, ak authz.AccountKeeper, config authz.Config) Keeper {
 	return Keeper{
 		Environment: env,
 		cdc:         cdc,
 		authKeeper:  ak,
+		config:      config,
 	}
 }
 
@@ -126,6 +128,7 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				return nil, err
 			}
 
+			remaining := authorization
 			if resp.Delete {
 				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
 			} else if resp.Updated != nil {
@@ -134,6 +137,7 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 					return nil, fmt.Errorf("expected authz.Authorization but got %T", resp.Updated)
 				}
 				err = k.update(ctx, grantee, granter, updated)
+				remaining = updated
 			}
 			if err != nil {
 				return nil, err
@@ -142,6 +146,12 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			if !resp.Accept {
 				return nil, sdkerrors.ErrUnauthorized
 			}
+
+			if !resp.Delete {
+				if err := k.emitGrantExpiring(ctx, granter, grantee, grant.Expiration, authorization, remaining); err != nil {
+					return nil, err
+				}
+			}
 		}
 
 		// no need to use the branch service here, as if the transaction fails, the transaction will be reverted
@@ -154,6 +164,131 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 	return results, nil
 }
 
+// simulateExec checks, without executing them nor writing any state, whether
+// the grantee is authorized to execute the msgs at the current block time.
+// The msgs are checked in order and, as in DispatchActions, the authorization
+// updates made by a msg (e.g. a decreased spend limit) apply to the following
+// ones. It returns, for each msg, nil if it is accepted or the reason it is not.
+func (k Keeper) simulateExec(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) []error {
+	results := make([]error, len(msgs))
+	now := k.Environment.HeaderService.HeaderInfo(ctx).Time
+	// authorizations updated by the previous msgs, keyed by grant store key,
+	// a nil authorization meaning the grant was deleted.
+	updated := make(map[string]authz.Authorization)
+
+	for i, msg := range msgs {
+		results[i] = k.simulateAccept(ctx, grantee, msg, now, updated)
+	}
+
+	return results
+}
+
+// simulateAccept checks whether the grantee is authorized to execute the msg,
+// recording the resulting authorization update in updated.
+func (k Keeper) simulateAccept(ctx context.Context, grantee sdk.AccAddress, msg sdk.Msg, now time.Time, updated map[string]authz.Authorization) error {
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return err
+	}
+
+	if len(signers) != 1 {
+		return authz.ErrAuthorizationNumOfSigners
+	}
+
+	granter := signers[0]
+	if bytes.Equal(granter, grantee) {
+		return nil
+	}
+
+	skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
+	grant, found := k.getGrant(ctx, skey)
+	authorization, isUpdated := updated[string(skey)]
+	if !found || (isUpdated && authorization == nil) {
+		return errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
+			"failed to get grant with given granter: %s, grantee: %s & msgType: %s ", sdk.AccAddress(granter), grantee, sdk.MsgTypeURL(msg))
+	}
+
+	if grant.Expiration != nil && grant.Expiration.Before(now) {
+		return authz.ErrAuthorizationExpired
+	}
+
+	if !isUpdated {
+		authorization, err = grant.GetAuthorization()
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := authorization.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	if resp.Delete {
+		updated[string(skey)] = nil
+	} else if resp.Updated != nil {
+		authorization, ok := resp.Updated.(authz.Authorization)
+		if !ok {
+			return fmt.Errorf("expected authz.Authorization but got %T", resp.Updated)
+		}
+		updated[string(skey)] = authorization
+	}
+
+	return nil
+}
+
+// emitGrantExpiring emits an EventGrantExpiring if a grant used at the current
+// block time expires within the configured notice window, or if its remaining
+// spend limit is at or below the configured notice threshold in any denom it
+// was limited to before its use.
+func (k Keeper) emitGrantExpiring(ctx context.Context, granter, grantee sdk.AccAddress, expiration *time.Time,
+	before, after authz.Authorization,
+) error {
+	event := authz.EventGrantExpiring{MsgTypeUrl: after.MsgTypeURL()}
+	notice := false
+
+	window := k.config.ExpirationNoticeWindow
+	if window > 0 && expiration != nil && !expiration.After(k.HeaderService.HeaderInfo(ctx).Time.Add(window)) {
+		event.Expiration = expiration
+		notice = true
+	}
+
+	threshold := k.config.SpendLimitNoticeThreshold
+	limitedBefore, okBefore := before.(authz.SpendLimitAuthorization)
+	limitedAfter, okAfter := after.(authz.SpendLimitAuthorization)
+	if !threshold.Empty() && okBefore && okAfter {
+		remaining := limitedAfter.RemainingSpendLimit()
+		for _, coin := range limitedBefore.RemainingSpendLimit() {
+			amount := threshold.AmountOf(coin.Denom)
+			if amount.IsPositive() && remaining.AmountOf(coin.Denom).LTE(amount) {
+				event.SpendLimit = remaining
+				notice = true
+				break
+			}
+		}
+	}
+
+	if !notice {
+		return nil
+	}
+
+	granterAddr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+	if err != nil {
+		return err
+	}
+	granteeAddr, err := k.authKeeper.AddressCodec().BytesToString(grantee)
+	if err != nil {
+		return err
+	}
+	event.Granter, event.Grantee = granterAddr, granteeAddr
+
+	return k.EventService.EventManager(ctx).Emit(&event)
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
@@ -195,6 +330,11 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 		return err
 	}
 
+	err = store.Set(granteeIndexKey(grantee, granter, msgType), []byte{})
+	if err != nil {
+		return err
+	}
+
 	granterAddr, err := k.authKeeper.AddressCodec().BytesToString(granter)
 	if err != nil {
 		return err
@@ -244,6 +384,11 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 		return err
 	}
 
+	err = store.Delete(granteeIndexKey(grantee, granter, msgType))
+	if err != nil {
+		return err
+	}
+
 	granterAddr, err := k.authKeeper.AddressCodec().BytesToString(granter)
 	if err != nil {
 		return err
@@ -376,6 +521,26 @@ func (k Keeper) IterateGranterGrants(ctx context.Context, granter sdk.AccAddress
 	return nil
 }
 
+// CanPruneAccount implements the x/auth AccountPruneGuard interface, an
+// account can be pruned if it is neither the granter nor the grantee of a grant.
+func (k Keeper) CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	for _, prefix := range [][]byte{granterStoreKey(addr), granteeIndexPrefix(addr)} {
+		iter, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+		if err != nil {
+			return false, err
+		}
+
+		hasGrants := iter.Valid()
+		iter.Close()
+		if hasGrants {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 func (k Keeper) getGrantQueueItem(ctx context.Context, expiration time.Time, granter, grantee sdk.AccAddress) (*authz.GrantQueueItem, error) {
 	store := k.KVStoreService.OpenKVStore(ctx)
 	bz, err := store.Get(GrantQueueKey(expiration, granter, grantee))
@@ -490,6 +655,11 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context, limit int) er
 			if err != nil {
 				return err
 			}
+
+			err = store.Delete(granteeIndexKey(grantee, granter, typeURL))
+			if err != nil {
+				return err
+			}
 		}
 
 		// limit the amount of iterations to avoid taking too much time
@@ -77,7 +77,7 @@ func (s *TestSuite) SetupTest() {
 	banktypes.RegisterMsgServer(s.baseApp.MsgServiceRouter(), s.bankKeeper)
 
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(s.baseApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(s.baseApp.MsgServiceRouter()))
-	s.authzKeeper = authzkeeper.NewKeeper(env, s.encCfg.Codec, s.accountKeeper)
+	s.authzKeeper = authzkeeper.NewKeeper(env, s.encCfg.Codec, s.accountKeeper, authz.DefaultConfig())
 
 	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.encCfg.InterfaceRegistry)
 	authz.RegisterQueryServer(queryHelper, s.authzKeeper)
@@ -229,6 +229,33 @@ func (s *TestSuite) TestKeeperGranterGrantsIter() {
 	})
 }
 
+func (s *TestSuite) TestCanPruneAccount() {
+	ctx, addrs := s.ctx, s.addrs
+	require := s.Require()
+
+	granterAddr, granteeAddr, otherAddr := addrs[0], addrs[1], addrs[2]
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: coins100}
+	e := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	require.NoError(s.authzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, sendAuthz, &e))
+
+	for addr, expCanPrune := range map[string]bool{
+		string(granterAddr): false,
+		string(granteeAddr): false,
+		string(otherAddr):   true,
+	} {
+		canPrune, err := s.authzKeeper.CanPruneAccount(ctx, sdk.AccAddress(addr))
+		require.NoError(err)
+		require.Equal(expCanPrune, canPrune)
+	}
+
+	require.NoError(s.authzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, sendAuthz.MsgTypeURL()))
+	for _, addr := range []sdk.AccAddress{granterAddr, granteeAddr} {
+		canPrune, err := s.authzKeeper.CanPruneAccount(ctx, addr)
+		require.NoError(err)
+		require.True(canPrune)
+	}
+}
+
 func (s *TestSuite) TestDispatchAction() {
 	addrs := s.addrs
 	require := s.Require()
@@ -435,6 +462,74 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchedGrantExpiringEvents() {
+	require := s.Require()
+	granterAddr, granteeAddr, recipientAddr := s.addrs[0], s.addrs[1], s.addrs[2]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	granteeStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granteeAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(recipientAddr)
+	require.NoError(err)
+
+	k := authzkeeper.NewKeeper(s.authzKeeper.Environment, s.encCfg.Codec, s.accountKeeper, authz.Config{
+		ExpirationNoticeWindow:    24 * time.Hour,
+		SpendLimitNoticeThreshold: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	})
+
+	send := func(amount sdk.Coins) []*authz.EventGrantExpiring {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := k.DispatchActions(ctx, granteeAddr, []sdk.Msg{&banktypes.MsgSend{
+			FromAddress: granterStrAddr,
+			ToAddress:   recipientStrAddr,
+			Amount:      amount,
+		}})
+		require.NoError(err)
+
+		var events []*authz.EventGrantExpiring
+		for _, e := range ctx.EventManager().Events().ToABCIEvents() {
+			if e.Type != "cosmos.authz.v1beta1.EventGrantExpiring" {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(e)
+			require.NoError(err)
+			events = append(events, msg.(*authz.EventGrantExpiring))
+		}
+		return events
+	}
+
+	// far from the expiration and the spend limit
+	expiration := s.ctx.HeaderInfo().Time.Add(48 * time.Hour)
+	require.NoError(k.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: coins100}, &expiration))
+	require.Empty(send(coins10))
+
+	// the remaining spend limit reaches the threshold
+	events := send(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	require.Len(events, 1)
+	require.Equal(&authz.EventGrantExpiring{
+		MsgTypeUrl: bankSendAuthMsgType,
+		Granter:    granterStrAddr,
+		Grantee:    granteeStrAddr,
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	}, events[0])
+
+	// the grant expires within the window
+	expiration = s.ctx.HeaderInfo().Time.Add(time.Hour)
+	require.NoError(k.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: coins100}, &expiration))
+	events = send(coins10)
+	require.Len(events, 1)
+	require.Equal(&authz.EventGrantExpiring{
+		MsgTypeUrl: bankSendAuthMsgType,
+		Granter:    granterStrAddr,
+		Grantee:    granteeStrAddr,
+		Expiration: &expiration,
+		SpendLimit: sdk.Coins{},
+	}, events[0])
+
+	// no notice once the grant is fully consumed, it is revoked
+	require.Empty(send(sdk.NewCoins(sdk.NewInt64Coin("stake", 90))))
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	addrs := s.addrs
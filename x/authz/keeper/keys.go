@@ -16,9 +16,11 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<grantee_index_Bytes>: []byte{}
 var (
-	GrantKey         = []byte{0x01} // prefix for each key
-	GrantQueuePrefix = []byte{0x02}
+	GrantKey           = []byte{0x01} // prefix for each key
+	GrantQueuePrefix   = []byte{0x02}
+	GranteeIndexPrefix = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -45,6 +47,22 @@ func granterStoreKey(granter sdk.AccAddress) []byte {
 	return key
 }
 
+// granteeIndexKey - return the grantee index key of a grant
+// Items are stored with the following key: values
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func granteeIndexKey(grantee, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+	return sdk.AppendLengthPrefixedBytes(GranteeIndexPrefix, grantee, granter, m)
+}
+
+func granteeIndexPrefix(grantee sdk.AccAddress) []byte {
+	grantee = address.MustLengthPrefix(grantee)
+	return sdk.AppendLengthPrefixedBytes(GranteeIndexPrefix, grantee)
+}
+
 // parseGrantStoreKey - split granter, grantee address and msg type from the authorization key
 func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
 	// key is of format:
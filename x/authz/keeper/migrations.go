@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v2 "cosmossdk.io/x/authz/migrations/v2"
+	v3 "cosmossdk.io/x/authz/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +21,8 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx context.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.Environment, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx context.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.Environment)
+}
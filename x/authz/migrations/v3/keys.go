@@ -0,0 +1,42 @@
+package v3
+
+import (
+	"cosmossdk.io/x/authz/internal/conv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// Keys for store prefixes
+// Items are stored with the following key: values
+//
+// - 0x01<grant_Bytes>: Grant
+// - 0x03<grantee_index_Bytes>: []byte{}
+var (
+	GrantPrefix        = []byte{0x01}
+	GranteeIndexPrefix = []byte{0x03}
+)
+
+// GranteeIndexKey - return the grantee index key of a grant
+// Key format is
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{}
+func GranteeIndexKey(grantee, granter sdk.AccAddress, msgType string) []byte {
+	return sdk.AppendLengthPrefixedBytes(GranteeIndexPrefix, address.MustLengthPrefix(grantee), address.MustLengthPrefix(granter), conv.UnsafeStrToBytes(msgType))
+}
+
+// ParseGrantKey - split granter, grantee address and msg type from the authorization key
+func ParseGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
+	// key is of format:
+	// <granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	granterAddrLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, 2+granterAddrLen)
+	granterAddr = sdk.AccAddress(key[1 : 1+granterAddrLen])
+	granteeAddrLen := int(key[1+granterAddrLen])
+	kv.AssertKeyAtLeastLength(key, 2+granterAddrLen+granteeAddrLen)
+	granteeAddr = sdk.AccAddress(key[2+granterAddrLen : 2+granterAddrLen+granteeAddrLen])
+
+	return granterAddr, granteeAddr, conv.UnsafeBytesToStr(key[2+granterAddrLen+granteeAddrLen:])
+}
@@ -0,0 +1,34 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// MigrateStore performs in-place store migrations from version 2 to 3. The
+// migration includes:
+//
+// - create secondary index of the grants by grantee
+func MigrateStore(ctx context.Context, env appmodule.Environment) error {
+	store := runtime.KVStoreAdapter(env.KVStoreService.OpenKVStore(ctx))
+	grantsStore := prefix.NewStore(store, GrantPrefix)
+
+	grantsIter := grantsStore.Iterator(nil, nil)
+	defer grantsIter.Close()
+
+	var keys [][]byte
+	for ; grantsIter.Valid(); grantsIter.Next() {
+		granter, grantee, msgType := ParseGrantKey(grantsIter.Key())
+		keys = append(keys, GranteeIndexKey(grantee, granter, msgType))
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	storetypes "cosmossdk.io/store/types"
+	v3 "cosmossdk.io/x/authz/migrations/v3"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestMigration(t *testing.T) {
+	authzKey := storetypes.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, storetypes.NewTransientStoreKey("transient_test"))
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(authzKey), coretesting.NewNopLogger())
+
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+
+	store := ctx.KVStore(authzKey)
+	for _, grantee := range []sdk.AccAddress{grantee1, grantee2} {
+		key := sdk.AppendLengthPrefixedBytes(v3.GrantPrefix, address.MustLengthPrefix(granter), address.MustLengthPrefix(grantee), []byte(msgType))
+		store.Set(key, []byte("grant"))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, env))
+
+	for _, grantee := range []sdk.AccAddress{grantee1, grantee2} {
+		require.True(t, store.Has(v3.GranteeIndexKey(grantee, granter, msgType)))
+	}
+	require.False(t, store.Has(v3.GranteeIndexKey(granter, grantee1, msgType)))
+}
@@ -44,6 +44,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "ExpiringGrants",
+					Use:       "expiring-grants [granter-addr] [expires-before]",
+					Short:     "Query authorization grants granted by granter and expiring before a time",
+					Example:   fmt.Sprintf("%s query authz expiring-grants cosmos1skj.. 2025-01-01T00:00:00Z", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "expires_before"},
+					},
+				},
+				{
+					RpcMethod: "SimulateExec",
+					Use:       "simulate-exec [grantee-addr] [msg-json-file]",
+					Short:     "Check whether a grantee is authorized to execute msgs on behalf of their granters",
+					Long:      "Check, without executing them, whether a grantee could execute msgs through a MsgExec at the current block time: the grants must exist, not be expired and accept the msgs.",
+					Example:   fmt.Sprintf("$ %[1]s tx bank send [granter] [recipient] [amount] --generate-only | jq .body.messages > msg.json && %[1]s query authz simulate-exec cosmos1skj.. msg.json", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "grantee"},
+						{ProtoField: "msgs", Varargs: true},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
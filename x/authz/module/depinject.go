@@ -1,15 +1,19 @@
 package module
 
 import (
+	"fmt"
+
 	modulev1 "cosmossdk.io/api/cosmos/authz/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/authz/keeper"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -27,6 +31,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config        *modulev1.Module
 	Cdc           codec.Codec
 	AccountKeeper authz.AccountKeeper
 	BankKeeper    authz.BankKeeper
@@ -41,8 +46,18 @@ type ModuleOutputs struct {
 	Module      appmodule.AppModule
 }
 
-func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper)
+func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
+	config := authz.DefaultConfig()
+	config.ExpirationNoticeWindow = in.Config.ExpirationNoticeWindow.AsDuration()
+	for _, coin := range in.Config.SpendLimitNoticeThreshold {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return ModuleOutputs{}, fmt.Errorf("invalid spend limit notice threshold amount %q for denom %s", coin.Amount, coin.Denom)
+		}
+		config.SpendLimitNoticeThreshold = config.SpendLimitNoticeThreshold.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, config)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
-	return ModuleOutputs{AuthzKeeper: k, Module: m}
+	return ModuleOutputs{AuthzKeeper: k, Module: m}, nil
 }
@@ -25,7 +25,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.HasAminoCodec       = AppModule{}
@@ -90,6 +90,10 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", authz.ModuleName, err)
 	}
 
+	if err := mr.Register(authz.ModuleName, 2, m.Migrate2to3); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", authz.ModuleName, err)
+	}
+
 	return nil
 }
 
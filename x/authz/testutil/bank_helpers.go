@@ -27,3 +27,27 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) UpdateDenomMetadata(ctx context.Context, req *bank.MsgUpdateDenomMetadata) (*bank.MsgUpdateDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreatePeriodicPayment(ctx context.Context, req *bank.MsgCreatePeriodicPayment) (*bank.MsgCreatePeriodicPaymentResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CancelPeriodicPayment(ctx context.Context, req *bank.MsgCancelPeriodicPayment) (*bank.MsgCancelPeriodicPaymentResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateHTLC(ctx context.Context, req *bank.MsgCreateHTLC) (*bank.MsgCreateHTLCResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ClaimHTLC(ctx context.Context, req *bank.MsgClaimHTLC) (*bank.MsgClaimHTLCResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RefundHTLC(ctx context.Context, req *bank.MsgRefundHTLC) (*bank.MsgRefundHTLCResponse, error) {
+	return nil, nil
+}
@@ -54,6 +54,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query the supply of a single coin denom",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
 				},
+				{
+					RpcMethod:      "TotalSupplyOf",
+					Use:            "total-supply-by-prefix [denom-prefix]",
+					Short:          "Query the supply of the coins whose denom starts with the given prefix",
+					Example:        fmt.Sprintf("%s query bank total-supply-by-prefix ibc/", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom_prefix"}},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -87,6 +94,49 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "Escrow",
+					Use:            "escrow [module] [id]",
+					Short:          "Query an escrow of a module by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "Escrows",
+					Use:            "escrows [module]",
+					Short:          "Query all the escrows of a module",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}},
+				},
+				{
+					RpcMethod:      "PeriodicPayment",
+					Use:            "periodic-payment [id]",
+					Short:          "Query a periodic payment by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "PeriodicPaymentsBySender",
+					Use:            "periodic-payments-by-sender [sender]",
+					Short:          "Query the periodic payments of a sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+				},
+				{
+					RpcMethod:      "HTLC",
+					Use:            "htlc [id]",
+					Short:          "Query a hashed timelock transfer by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "HTLCsBySender",
+					Use:            "htlcs-by-sender [sender]",
+					Short:          "Query the pending hashed timelock transfers of a sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+				},
+				{
+					RpcMethod:      "BalanceHistory",
+					Use:            "balance-history [address] [denom]",
+					Short:          "Query an account balance by address and denom at a height from the balance history of the node",
+					Long:           "Query an account balance by address and denom at the height given by the --height flag, or at the latest indexed height, from the balance history index of the node. The index must be enabled in the app.toml of the node.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -109,6 +159,39 @@ Note: multiple coins can be send by space separated.`,
 					Short:          "Burns the amount specified from the given account.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "amount", Varargs: true}},
 				},
+				{
+					RpcMethod:      "CreatePeriodicPayment",
+					Use:            "create-periodic-payment [sender] [recipient] [period] [payments] [amount ...]",
+					Short:          "Create a payment sent from the sender to the recipient every period blocks.",
+					Long:           "Create a payment of amount sent from the sender to the recipient every period blocks, payments times. Note, the '--from' flag is ignored as it is implied from [sender].",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "recipient"}, {ProtoField: "period"}, {ProtoField: "payments"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "CancelPeriodicPayment",
+					Use:            "cancel-periodic-payment [sender] [id]",
+					Short:          "Cancel a periodic payment of the sender.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "CreateHTLC",
+					Use:            "create-htlc [sender] [recipient] [hash-lock] [timeout] [amount ...]",
+					Short:          "Lock coins of the sender until the recipient claims them with the preimage of the hash lock.",
+					Long:           "Lock amount of the sender under the hex encoded SHA-256 hash lock. The recipient receives the coins once the preimage is revealed before the timeout, after which they are refunded to the sender. Note, the '--from' flag is ignored as it is implied from [sender].",
+					Example:        fmt.Sprintf("%s tx bank create-htlc [sender] [recipient] 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 24h 1000stake", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "recipient"}, {ProtoField: "hash_lock"}, {ProtoField: "timeout"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ClaimHTLC",
+					Use:            "claim-htlc [claimer] [id] [preimage]",
+					Short:          "Send the coins of a hashed timelock transfer to its recipient by revealing the hex encoded preimage.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claimer"}, {ProtoField: "id"}, {ProtoField: "preimage"}},
+				},
+				{
+					RpcMethod:      "RefundHTLC",
+					Use:            "refund-htlc [sender] [id]",
+					Short:          "Refund the coins of an expired hashed timelock transfer to its sender.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "id"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -128,6 +211,14 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "UpdateDenomMetadata",
+					Use:            "update-denom-metadata-proposal [metadata]",
+					Short:          "Submit a proposal to set the metadata of a denom. Note: the entire metadata must be provided.",
+					Example:        fmt.Sprintf(`%s tx bank update-denom-metadata-proposal '{"base":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2","denom_units":[{"denom":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2","exponent":0},{"denom":"atom","exponent":6}],"display":"atom","name":"Cosmos Hub Atom","symbol":"ATOM"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -27,6 +27,7 @@ func init() {
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
 		appconfig.Invoke(InvokeSetSendRestrictions),
+		appconfig.Invoke(InvokeSetSendHooks),
 	)
 }
 
@@ -129,3 +130,42 @@ func InvokeSetSendRestrictions(
 
 	return nil
 }
+
+func InvokeSetSendHooks(
+	config *modulev1.Module,
+	keeper keeper.BaseKeeper,
+	sendHooks map[string]types.SendHooksWrapper,
+) error {
+	if config == nil {
+		return nil
+	}
+
+	modules := maps.Keys(sendHooks)
+	order := config.SendHooksOrder
+	if len(order) == 0 {
+		order = modules
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modules) {
+		return fmt.Errorf("len(send hooks order: %v) != len(send hooks modules: %v)", order, modules)
+	}
+
+	if len(modules) == 0 {
+		return nil
+	}
+
+	var multiHooks types.MultiSendHooks
+	for _, module := range order {
+		hooks, ok := sendHooks[module]
+		if !ok {
+			return fmt.Errorf("can't find send hooks for module %s", module)
+		}
+
+		multiHooks = append(multiHooks, hooks)
+	}
+
+	keeper.SetHooks(multiHooks)
+
+	return nil
+}
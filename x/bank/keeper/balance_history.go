@@ -0,0 +1,358 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagBalanceHistory is the app.toml key enabling the balance history index.
+	FlagBalanceHistory = "bank.balance-history"
+	// FlagBalanceHistoryKeepRecent is the app.toml key of the number of recent
+	// heights the balance history index is kept for, 0 keeps the whole history.
+	FlagBalanceHistoryKeepRecent = "bank.balance-history-keep-recent"
+)
+
+var (
+	balanceHistoryPrefix           = collections.NewPrefix(0)
+	balanceHistorySupersededPrefix = collections.NewPrefix(1)
+	balanceHistoryEarliestKey      = collections.NewPrefix(2)
+	balanceHistoryLatestKey        = collections.NewPrefix(3)
+)
+
+var (
+	// ErrBalanceHistoryDisabled is returned when querying the balance history
+	// of a node which does not index it.
+	ErrBalanceHistoryDisabled = errors.New("balance history is not enabled on this node")
+	// ErrBalanceHistoryUnavailable is returned when querying the balance history
+	// at a height which is not indexed.
+	ErrBalanceHistoryUnavailable = errors.New("balance history is not available")
+)
+
+var _ storetypes.ABCIListener = (*BalanceHistory)(nil)
+
+// BalanceHistory is a node-local index of the balances of the accounts at each
+// height, for archival nodes to answer the balance of an account at a height
+// without replaying the blocks. It is not part of the consensus state: it
+// listens to the balance changes committed at the end of each block and stores
+// them in its own database.
+type BalanceHistory struct {
+	keeper     BaseKeeper
+	storeKey   string
+	keepRecent int64
+
+	db dbm.DB
+	// History is the balance of an account in a denom since a height, it is
+	// only set at the heights the balance changes.
+	History collections.Map[collections.Triple[sdk.AccAddress, string, int64], math.Int]
+	// Superseded indexes the history entries by the height they were replaced
+	// at, to prune them once they are out of the kept heights.
+	Superseded collections.KeySet[collections.Pair[int64, collections.Triple[sdk.AccAddress, string, int64]]]
+	// EarliestHeight is the earliest height the history is available at.
+	EarliestHeight collections.Item[int64]
+	// LatestHeight is the latest indexed height.
+	LatestHeight collections.Item[int64]
+}
+
+// newBalanceHistory returns a disabled balance history for the keeper, see
+// EnableBalanceHistory.
+func newBalanceHistory(k BaseKeeper) *BalanceHistory {
+	return &BalanceHistory{keeper: k}
+}
+
+// EnableBalanceHistory enables the balance history index of the keeper, stored
+// in db, keeping the last keepRecent heights, or all of them if zero. storeKey
+// is the name of the bank module store. The returned listener must be
+// registered with the BaseApp streaming manager, listening to the bank store.
+//
+// The first block committed after the index is enabled stores the balances of
+// all the accounts, the history is available from its height.
+func (k BaseKeeper) EnableBalanceHistory(db dbm.DB, storeKey string, keepRecent int64) (*BalanceHistory, error) {
+	if keepRecent < 0 {
+		return nil, fmt.Errorf("invalid balance history keep recent %d", keepRecent)
+	}
+
+	h := k.balanceHistory
+	sb := collections.NewSchemaBuilder(dbStoreService{db})
+	historyKeyCodec := collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.Int64Key)
+	h.History = collections.NewMap(sb, balanceHistoryPrefix, "history", historyKeyCodec, types.BalanceValueCodec)
+	h.Superseded = collections.NewKeySet(sb, balanceHistorySupersededPrefix, "superseded", collections.PairKeyCodec(collections.Int64Key, historyKeyCodec))
+	h.EarliestHeight = collections.NewItem(sb, balanceHistoryEarliestKey, "earliest_height", collections.Int64Value)
+	h.LatestHeight = collections.NewItem(sb, balanceHistoryLatestKey, "latest_height", collections.Int64Value)
+	if _, err := sb.Build(); err != nil {
+		return nil, err
+	}
+
+	h.storeKey = storeKey
+	h.keepRecent = keepRecent
+	h.db = db
+
+	return h, nil
+}
+
+// Enabled returns true if the balance history index is enabled.
+func (h *BalanceHistory) Enabled() bool {
+	return h.db != nil
+}
+
+// Close closes the database of the balance history, the BaseApp closes the
+// ABCI listeners implementing io.Closer.
+func (h *BalanceHistory) Close() error {
+	if !h.Enabled() {
+		return nil
+	}
+
+	return h.db.Close()
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, the balances are
+// indexed on commit.
+func (h *BalanceHistory) ListenFinalizeBlock(context.Context, abci.FinalizeBlockRequest, abci.FinalizeBlockResponse) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, it indexes the balances
+// changed by the committed block and prunes the history out of the kept
+// heights.
+func (h *BalanceHistory) ListenCommit(ctx context.Context, _ abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	if !h.Enabled() {
+		return nil
+	}
+
+	height := h.keeper.HeaderService.HeaderInfo(ctx).Height
+	latest, err := h.LatestHeight.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		if err := h.bootstrap(ctx, height); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case height <= latest:
+		// the block is replayed, it is already indexed
+		return nil
+	default:
+		if err := h.indexChanges(ctx, height, changeSet); err != nil {
+			return err
+		}
+	}
+
+	if err := h.LatestHeight.Set(ctx, height); err != nil {
+		return err
+	}
+
+	return h.prune(ctx, height)
+}
+
+// bootstrap indexes the balances of all the accounts at the given height.
+func (h *BalanceHistory) bootstrap(ctx context.Context, height int64) error {
+	var err error
+	h.keeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		err = h.record(ctx, height, addr, coin.Denom, coin.Amount)
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.EarliestHeight.Set(ctx, height)
+}
+
+// indexChanges indexes the balance changes of the change set at the given
+// height, only the last change of a balance in the block is kept.
+func (h *BalanceHistory) indexChanges(ctx context.Context, height int64, changeSet []*storetypes.StoreKVPair) error {
+	type change struct {
+		key    collections.Pair[sdk.AccAddress, string]
+		amount math.Int
+	}
+
+	var (
+		changes []change
+		indexes = make(map[string]int)
+	)
+	for _, pair := range changeSet {
+		if pair.StoreKey != h.storeKey || !bytes.HasPrefix(pair.Key, types.BalancesPrefix) {
+			continue
+		}
+
+		_, key, err := h.keeper.Balances.KeyCodec().Decode(pair.Key[len(types.BalancesPrefix):])
+		if err != nil {
+			return err
+		}
+
+		amount := math.ZeroInt()
+		if !pair.Delete {
+			amount, err = h.keeper.Balances.ValueCodec().Decode(pair.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		if i, ok := indexes[string(pair.Key)]; ok {
+			changes[i].amount = amount
+			continue
+		}
+		indexes[string(pair.Key)] = len(changes)
+		changes = append(changes, change{key: key, amount: amount})
+	}
+
+	for _, c := range changes {
+		if err := h.record(ctx, height, c.key.K1(), c.key.K2(), c.amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// record indexes the balance of an account in a denom at the given height.
+func (h *BalanceHistory) record(ctx context.Context, height int64, addr sdk.AccAddress, denom string, amount math.Int) error {
+	prevHeight, _, found, err := h.balanceAt(ctx, addr, denom, height)
+	if err != nil {
+		return err
+	}
+
+	if found && prevHeight < height {
+		if err := h.Superseded.Set(ctx, collections.Join(height, collections.Join3(addr, denom, prevHeight))); err != nil {
+			return err
+		}
+	}
+
+	return h.History.Set(ctx, collections.Join3(addr, denom, height), amount)
+}
+
+// prune removes the history entries which were superseded before the kept
+// heights.
+func (h *BalanceHistory) prune(ctx context.Context, height int64) error {
+	if h.keepRecent == 0 || height <= h.keepRecent {
+		return nil
+	}
+
+	// an entry superseded at a height only serves the heights before it
+	earliest := height - h.keepRecent + 1
+	var keys []collections.Pair[int64, collections.Triple[sdk.AccAddress, string, int64]]
+	err := h.Superseded.Walk(ctx, collections.NewPrefixUntilPairRange[int64, collections.Triple[sdk.AccAddress, string, int64]](earliest), func(key collections.Pair[int64, collections.Triple[sdk.AccAddress, string, int64]]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := h.History.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := h.Superseded.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	current, err := h.EarliestHeight.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if earliest > current {
+		return h.EarliestHeight.Set(ctx, earliest)
+	}
+
+	return nil
+}
+
+// BalanceAt returns the balance of an account in a denom at the given height,
+// or at the latest indexed height if zero, along with the height.
+func (h *BalanceHistory) BalanceAt(ctx context.Context, addr sdk.AccAddress, denom string, height int64) (sdk.Coin, int64, error) {
+	if !h.Enabled() {
+		return sdk.Coin{}, 0, ErrBalanceHistoryDisabled
+	}
+
+	latest, err := h.LatestHeight.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, 0, fmt.Errorf("%w: no height is indexed yet", ErrBalanceHistoryUnavailable)
+		}
+		return sdk.Coin{}, 0, err
+	}
+	earliest, err := h.EarliestHeight.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	if height == 0 {
+		height = latest
+	}
+	if height < earliest || height > latest {
+		return sdk.Coin{}, 0, fmt.Errorf("%w at height %d: indexed heights are %d to %d", ErrBalanceHistoryUnavailable, height, earliest, latest)
+	}
+
+	_, amount, found, err := h.balanceAt(ctx, addr, denom, height)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if !found {
+		amount = math.ZeroInt()
+	}
+
+	return sdk.NewCoin(denom, amount), height, nil
+}
+
+// balanceAt returns the last history entry of an account in a denom at or
+// before the given height.
+func (h *BalanceHistory) balanceAt(ctx context.Context, addr sdk.AccAddress, denom string, height int64) (int64, math.Int, bool, error) {
+	rng := new(collections.Range[collections.Triple[sdk.AccAddress, string, int64]]).
+		StartInclusive(collections.Join3(addr, denom, int64(0))).
+		EndInclusive(collections.Join3(addr, denom, height)).
+		Descending()
+	iter, err := h.History.Iterate(ctx, rng)
+	if err != nil {
+		return 0, math.Int{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, math.Int{}, false, nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, math.Int{}, false, err
+	}
+
+	return kv.Key.K3(), kv.Value, true, nil
+}
+
+// dbStoreService is a store.KVStoreService over a database, the balance
+// history is not stored in the state of the application.
+type dbStoreService struct {
+	db dbm.DB
+}
+
+func (s dbStoreService) OpenKVStore(context.Context) store.KVStore {
+	return dbStore{s.db}
+}
+
+// dbStore wraps a database as a store.KVStore.
+type dbStore struct {
+	dbm.DB
+}
+
+func (s dbStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return s.DB.Iterator(start, end)
+}
+
+func (s dbStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return s.DB.ReverseIterator(start, end)
+}
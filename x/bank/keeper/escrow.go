@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EscrowKeeper defines the escrow APIs of the bank keeper, letting modules hold
+// coins on behalf of depositors (e.g. proposal deposits) without implementing
+// their own bookkeeping. Escrowed coins are held by the module account and
+// escrows are identified by the module name and an id chosen by the module.
+type EscrowKeeper interface {
+	EscrowCoins(ctx context.Context, moduleName, id string, depositor sdk.AccAddress, amt sdk.Coins) error
+	ReleaseEscrow(ctx context.Context, moduleName, id string, recipient sdk.AccAddress) error
+	RefundEscrow(ctx context.Context, moduleName, id string) error
+	GetEscrow(ctx context.Context, moduleName, id string) (types.Escrow, error)
+	IterateEscrows(ctx context.Context, cb func(escrow types.Escrow) (stop bool)) error
+}
+
+var _ EscrowKeeper = BaseKeeper{}
+
+// EscrowCoins moves coins from the depositor to the module account and records
+// them under the given escrow id, until they are released or refunded. It fails
+// if the module already has an escrow with the same id.
+func (k BaseKeeper) EscrowCoins(ctx context.Context, moduleName, id string, depositor sdk.AccAddress, amt sdk.Coins) error {
+	if id == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "escrow id cannot be empty")
+	}
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	key := collections.Join(moduleName, id)
+	has, err := k.BaseViewKeeper.Escrows.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(types.ErrEscrowExists, "module %s, id %s", moduleName, id)
+	}
+
+	depositorStr, err := k.ak.AddressCodec().BytesToString(depositor)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SendCoinsFromAccountToModule(ctx, depositor, moduleName, amt); err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.Escrows.Set(ctx, key, types.Escrow{
+		Module:    moduleName,
+		Id:        id,
+		Depositor: depositorStr,
+		Amount:    amt,
+	}); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeEscrow,
+		event.NewAttribute(sdk.AttributeKeyModule, moduleName),
+		event.NewAttribute(types.AttributeKeyEscrowID, id),
+		event.NewAttribute(types.AttributeKeyDepositor, depositorStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// ReleaseEscrow sends the escrowed coins to the recipient and deletes the
+// escrow.
+func (k BaseKeeper) ReleaseEscrow(ctx context.Context, moduleName, id string, recipient sdk.AccAddress) error {
+	escrow, err := k.GetEscrow(ctx, moduleName, id)
+	if err != nil {
+		return err
+	}
+
+	recipientStr, err := k.ak.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.settleEscrow(ctx, escrow, recipient); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeReleaseEscrow,
+		event.NewAttribute(sdk.AttributeKeyModule, moduleName),
+		event.NewAttribute(types.AttributeKeyEscrowID, id),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	)
+}
+
+// RefundEscrow sends the escrowed coins back to the depositor and deletes the
+// escrow.
+func (k BaseKeeper) RefundEscrow(ctx context.Context, moduleName, id string) error {
+	escrow, err := k.GetEscrow(ctx, moduleName, id)
+	if err != nil {
+		return err
+	}
+
+	depositor, err := k.ak.AddressCodec().StringToBytes(escrow.Depositor)
+	if err != nil {
+		return err
+	}
+
+	if err := k.settleEscrow(ctx, escrow, depositor); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRefundEscrow,
+		event.NewAttribute(sdk.AttributeKeyModule, moduleName),
+		event.NewAttribute(types.AttributeKeyEscrowID, id),
+		event.NewAttribute(types.AttributeKeyDepositor, escrow.Depositor),
+		event.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	)
+}
+
+// GetEscrow returns the escrow of the module with the given id.
+func (k BaseKeeper) GetEscrow(ctx context.Context, moduleName, id string) (types.Escrow, error) {
+	escrow, err := k.BaseViewKeeper.Escrows.Get(ctx, collections.Join(moduleName, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Escrow{}, errorsmod.Wrapf(types.ErrEscrowNotFound, "module %s, id %s", moduleName, id)
+		}
+		return types.Escrow{}, err
+	}
+
+	return escrow, nil
+}
+
+// IterateEscrows iterates over all the escrows, ordered by module name and id,
+// until the callback returns true.
+func (k BaseKeeper) IterateEscrows(ctx context.Context, cb func(escrow types.Escrow) (stop bool)) error {
+	return k.BaseViewKeeper.Escrows.Walk(ctx, nil, func(_ collections.Pair[string, string], escrow types.Escrow) (bool, error) {
+		return cb(escrow), nil
+	})
+}
+
+// settleEscrow deletes the escrow and sends its coins to the given address.
+func (k BaseKeeper) settleEscrow(ctx context.Context, escrow types.Escrow, to sdk.AccAddress) error {
+	if err := k.BaseViewKeeper.Escrows.Remove(ctx, collections.Join(escrow.Module, escrow.Id)); err != nil {
+		return err
+	}
+
+	return k.SendCoinsFromModuleToAccount(ctx, escrow.Module, to, escrow.Amount)
+}
@@ -14,37 +14,50 @@ import (
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisState) error {
 	var err error
-	if err = k.SetParams(ctx, genState.Params); err != nil {
+	genState.Balances, err = types.SanitizeGenesisBalances(genState.Balances, k.ak.AddressCodec())
+	if err != nil {
 		return err
 	}
 
-	for _, se := range genState.GetAllSendEnabled() {
-		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
-	}
 	totalSupplyMap := sdk.NewMapCoins(sdk.Coins{})
+	for _, balance := range genState.Balances {
+		if err := k.initGenesisBalance(ctx, balance); err != nil {
+			return err
+		}
 
-	genState.Balances, err = types.SanitizeGenesisBalances(genState.Balances, k.ak.AddressCodec())
+		totalSupplyMap.Add(balance.Coins...)
+	}
+
+	return k.initGenesisState(ctx, genState, totalSupplyMap.ToCoins())
+}
+
+// initGenesisBalance sets the balance of an account from genesis.
+func (k BaseKeeper) initGenesisBalance(ctx context.Context, balance types.Balance) error {
+	bz, err := k.ak.AddressCodec().StringToBytes(balance.GetAddress())
 	if err != nil {
 		return err
 	}
 
-	for _, balance := range genState.Balances {
-		addr := balance.GetAddress()
-		bz, err := k.ak.AddressCodec().StringToBytes(addr)
+	for _, coin := range balance.Coins {
+		err := k.Balances.Set(ctx, collections.Join(sdk.AccAddress(bz), coin.Denom), coin.Amount)
 		if err != nil {
 			return err
 		}
+	}
 
-		for _, coin := range balance.Coins {
-			err := k.Balances.Set(ctx, collections.Join(sdk.AccAddress(bz), coin.Denom), coin.Amount)
-			if err != nil {
-				return err
-			}
-		}
+	return nil
+}
 
-		totalSupplyMap.Add(balance.Coins...)
+// initGenesisState initializes the bank module's state, but the balances, from
+// a given genesis state. The total supply is the sum of the genesis balances.
+func (k BaseKeeper) initGenesisState(ctx context.Context, genState *types.GenesisState, totalSupply sdk.Coins) error {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, se := range genState.GetAllSendEnabled() {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
 	}
-	totalSupply := totalSupplyMap.ToCoins()
 
 	if !genState.Supply.Empty() && !genState.Supply.Equal(totalSupply) {
 		return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", genState.Supply, totalSupply)
@@ -57,11 +70,46 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
-	return nil
+
+	for _, escrow := range genState.Escrows {
+		if err := k.BaseViewKeeper.Escrows.Set(ctx, collections.Join(escrow.Module, escrow.Id), escrow); err != nil {
+			return err
+		}
+	}
+
+	for _, payment := range genState.PeriodicPayments {
+		if err := k.PeriodicPayments.Set(ctx, payment.Id, payment); err != nil {
+			return err
+		}
+	}
+
+	if err := k.PeriodicPaymentSeq.Set(ctx, genState.PeriodicPaymentSeq); err != nil {
+		return err
+	}
+
+	for _, htlc := range genState.Htlcs {
+		if err := k.HTLCs.Set(ctx, htlc.Id, htlc); err != nil {
+			return err
+		}
+	}
+
+	return k.HTLCSeq.Set(ctx, genState.HtlcSeq)
 }
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	rv, err := k.exportGenesisState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rv.Balances = k.GetAccountsBalances(ctx)
+	return rv, nil
+}
+
+// exportGenesisState returns the bank module's genesis state without the
+// balances.
+func (k BaseKeeper) exportGenesisState(ctx context.Context) (*types.GenesisState, error) {
 	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch total supply %w", err)
@@ -69,10 +117,45 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 
 	rv := types.NewGenesisState(
 		k.GetParams(ctx),
-		k.GetAccountsBalances(ctx),
+		nil,
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	err = k.IterateEscrows(ctx, func(escrow types.Escrow) bool {
+		rv.Escrows = append(rv.Escrows, escrow)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PeriodicPayments.Walk(ctx, nil, func(_ uint64, payment types.PeriodicPayment) (bool, error) {
+		rv.PeriodicPayments = append(rv.PeriodicPayments, payment)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rv.PeriodicPaymentSeq, err = k.PeriodicPaymentSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.HTLCs.Walk(ctx, nil, func(_ uint64, htlc types.HTLC) (bool, error) {
+		rv.Htlcs = append(rv.Htlcs, htlc)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rv.HtlcSeq, err = k.HTLCSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return rv, nil
 }
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// genesisStreamChunkSize is the number of balances written between two flushes
+// of the genesis stream.
+const genesisStreamChunkSize = 1000
+
+// ExportGenesisStream writes the bank module's genesis state as JSON to w,
+// iterating over the balances in the store instead of building them in memory,
+// so that chains with millions of accounts can export their state. The output
+// is decoded as the genesis state returned by ExportGenesis, the balances are
+// written first and flushed every genesisStreamChunkSize balances.
+func (k BaseKeeper) ExportGenesisStream(ctx context.Context, cdc codec.JSONCodec, w io.Writer) error {
+	genState, err := k.exportGenesisState(ctx)
+	if err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(genState)
+	if err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return err
+	}
+	delete(fields, "balances")
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(`{"balances":[`); err != nil {
+		return err
+	}
+
+	var (
+		count   int
+		current types.Balance
+		addr    sdk.AccAddress
+	)
+	writeBalance := func() error {
+		bz, err := cdc.MarshalJSON(&current)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err := bw.Write(bz); err != nil {
+			return err
+		}
+
+		count++
+		if count%genesisStreamChunkSize == 0 {
+			return bw.Flush()
+		}
+		return nil
+	}
+
+	err = k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		if !addr.Equals(key.K1()) {
+			if addr != nil {
+				if err := writeBalance(); err != nil {
+					return true, err
+				}
+			}
+
+			addrStr, err := k.ak.AddressCodec().BytesToString(key.K1())
+			if err != nil {
+				return true, err
+			}
+			addr = key.K1()
+			current = types.Balance{Address: addrStr}
+		}
+
+		// balances are iterated by denom, the coins are sorted
+		current.Coins = append(current.Coins, sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if addr != nil {
+		if err := writeBalance(); err != nil {
+			return err
+		}
+	}
+
+	if err := bw.WriteByte(']'); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := fmt.Fprintf(bw, ",%s:%s", strconv.Quote(name), fields[name]); err != nil {
+			return err
+		}
+	}
+
+	if err := bw.WriteByte('}'); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// InitGenesisStream initializes the bank module's state from the genesis state
+// JSON read from r. The balances are decoded, validated and stored one at a
+// time instead of being loaded in memory, the other fields are handled as in
+// InitGenesis.
+func (k BaseKeeper) InitGenesisStream(ctx context.Context, cdc codec.JSONCodec, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	totalSupplyMap := sdk.NewMapCoins(sdk.Coins{})
+	fields := make(map[string]json.RawMessage)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid genesis field name %v", tok)
+		}
+
+		if name != "balances" {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return fmt.Errorf("failed to decode genesis field %s: %w", name, err)
+			}
+			fields[name] = raw
+			continue
+		}
+
+		if err := k.initGenesisBalancesStream(ctx, cdc, dec, totalSupplyMap); err != nil {
+			return err
+		}
+	}
+	if err := expectJSONDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return err
+	}
+
+	return k.initGenesisState(ctx, &genState, totalSupplyMap.ToCoins())
+}
+
+// initGenesisBalancesStream decodes, validates and stores the balances of the
+// genesis array the decoder is at.
+func (k BaseKeeper) initGenesisBalancesStream(ctx context.Context, cdc codec.JSONCodec, dec *json.Decoder, totalSupplyMap sdk.MapCoins) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil { // null balances
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("invalid genesis balances: expected [, got %v", tok)
+	}
+
+	for dec.More() {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+
+		var balance types.Balance
+		if err := cdc.UnmarshalJSON(raw, &balance); err != nil {
+			return err
+		}
+		balance.Coins = balance.Coins.Sort()
+		if err := balance.Validate(); err != nil {
+			return err
+		}
+
+		addr, err := k.ak.AddressCodec().StringToBytes(balance.Address)
+		if err != nil {
+			return err
+		}
+		if !k.GetAllBalances(ctx, addr).IsZero() {
+			return fmt.Errorf("genesis state has a duplicate account: %q aka %x", balance.Address, addr)
+		}
+
+		if err := k.initGenesisBalance(ctx, balance); err != nil {
+			return err
+		}
+		totalSupplyMap.Add(balance.Coins...)
+	}
+
+	return expectJSONDelim(dec, ']')
+}
+
+// expectJSONDelim reads the next token of the decoder and checks it is the
+// given delimiter.
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("invalid genesis JSON: expected %s, got %v", delim, tok)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/bank/types"
 
@@ -49,6 +51,43 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *KeeperTestSuite) TestGenesisStream() {
+	balances, totalSupply := suite.getTestBalancesAndSupply()
+	genState := types.DefaultGenesisState()
+	genState.Balances = balances
+	genState.Supply = totalSupply
+	genState.DenomMetadata = suite.getTestMetadata()
+	suite.Require().NoError(suite.bankKeeper.InitGenesis(suite.ctx, genState))
+
+	expGenesis, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+
+	var buf bytes.Buffer
+	suite.Require().NoError(suite.bankKeeper.ExportGenesisStream(suite.ctx, suite.encCfg.Codec, &buf))
+
+	var streamed types.GenesisState
+	suite.Require().NoError(suite.encCfg.Codec.UnmarshalJSON(buf.Bytes(), &streamed))
+	suite.Require().Equal(suite.encCfg.Codec.MustMarshalJSON(expGenesis), suite.encCfg.Codec.MustMarshalJSON(&streamed))
+
+	// import the streamed genesis in a new store
+	suite.SetupTest()
+	suite.Require().NoError(suite.bankKeeper.InitGenesisStream(suite.ctx, suite.encCfg.Codec, bytes.NewReader(buf.Bytes())))
+
+	gotGenesis, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expGenesis, gotGenesis)
+
+	// duplicate balances are rejected
+	suite.SetupTest()
+	bz, err := suite.encCfg.Codec.MarshalJSON(&types.GenesisState{
+		Params:   types.DefaultParams(),
+		Balances: []types.Balance{balances[0], balances[0]},
+	})
+	suite.Require().NoError(err)
+	err = suite.bankKeeper.InitGenesisStream(suite.ctx, suite.encCfg.Codec, bytes.NewReader(bz))
+	suite.Require().ErrorContains(err, "duplicate account")
+}
+
 func (suite *KeeperTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
 	ac := codectestutil.CodecOptions{}.GetAddressCodec()
 	addr2, err := suite.authKeeper.AddressCodec().StringToBytes("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	v1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/bank/types"
@@ -156,6 +159,39 @@ func (k BaseKeeper) SupplyOf(ctx context.Context, req *types.QuerySupplyOfReques
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// maxDenomPrefixLength is the maximum length of a denom prefix, a longer prefix
+// can't match any valid denom.
+const maxDenomPrefixLength = 128
+
+// TotalSupplyOf implements the Query/TotalSupplyOf gRPC method
+func (k BaseKeeper) TotalSupplyOf(ctx context.Context, req *types.QueryTotalSupplyOfRequest) (*types.QueryTotalSupplyOfResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.DenomPrefix) > maxDenomPrefixLength {
+		return nil, status.Errorf(codes.InvalidArgument, "denom prefix length must be at most %d", maxDenomPrefixLength)
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[string])
+	if req.DenomPrefix != "" {
+		// denoms are the last part of the supply keys, so that only the
+		// supplies of the matching denoms are iterated over
+		opts = append(opts, func(o *query.CollectionsPaginateOptions[string]) {
+			o.Prefix = &req.DenomPrefix
+		})
+	}
+
+	supply, pageRes, err := query.CollectionPaginate(ctx, k.Supply, req.Pagination, func(denom string, amount math.Int) (sdk.Coin, error) {
+		return sdk.NewCoin(denom, amount), nil
+	}, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalSupplyOfResponse{Supply: supply, Pagination: pageRes}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -351,3 +387,247 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// Escrow implements the Query/Escrow gRPC method
+func (k BaseKeeper) Escrow(ctx context.Context, req *types.QueryEscrowRequest) (*types.QueryEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Module == "" {
+		return nil, status.Error(codes.InvalidArgument, "module cannot be empty")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be empty")
+	}
+
+	escrow, err := k.GetEscrow(ctx, req.Module, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrEscrowNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+}
+
+// Escrows implements the Query/Escrows gRPC method
+func (k BaseKeeper) Escrows(ctx context.Context, req *types.QueryEscrowsRequest) (*types.QueryEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Module == "" {
+		return nil, status.Error(codes.InvalidArgument, "module cannot be empty")
+	}
+
+	escrows, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.Escrows,
+		req.Pagination,
+		func(_ collections.Pair[string, string], escrow types.Escrow) (types.Escrow, error) {
+			return escrow, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Module),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowsResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+// PeriodicPayment implements the Query/PeriodicPayment gRPC method
+func (k BaseKeeper) PeriodicPayment(ctx context.Context, req *types.QueryPeriodicPaymentRequest) (*types.QueryPeriodicPaymentResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	payment, err := k.GetPeriodicPayment(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrPeriodicPaymentNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryPeriodicPaymentResponse{PeriodicPayment: payment}, nil
+}
+
+// PeriodicPaymentsBySender implements the Query/PeriodicPaymentsBySender gRPC method
+func (k BaseKeeper) PeriodicPaymentsBySender(ctx context.Context, req *types.QueryPeriodicPaymentsBySenderRequest) (*types.QueryPeriodicPaymentsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := k.ak.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	ids, pageRes, err := paginateBySender(ctx, k.PeriodicPayments.Indexes.Sender, sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]types.PeriodicPayment, 0, len(ids))
+	for _, id := range ids {
+		payment, err := k.PeriodicPayments.Get(ctx, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		payments = append(payments, payment)
+	}
+
+	return &types.QueryPeriodicPaymentsBySenderResponse{PeriodicPayments: payments, Pagination: pageRes}, nil
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method
+func (k BaseKeeper) BalanceHistory(ctx context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	address, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	balance, height, err := k.balanceHistory.BalanceAt(ctx, address, req.Denom, req.Height)
+	switch {
+	case errors.Is(err, ErrBalanceHistoryDisabled):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrBalanceHistoryUnavailable):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBalanceHistoryResponse{Balance: &balance, Height: height}, nil
+}
+
+// HTLC implements the Query/HTLC gRPC method
+func (k BaseKeeper) HTLC(ctx context.Context, req *types.QueryHTLCRequest) (*types.QueryHTLCResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	htlc, err := k.GetHTLC(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrHTLCNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryHTLCResponse{Htlc: htlc}, nil
+}
+
+// HTLCsBySender implements the Query/HTLCsBySender gRPC method
+func (k BaseKeeper) HTLCsBySender(ctx context.Context, req *types.QueryHTLCsBySenderRequest) (*types.QueryHTLCsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := k.ak.AddressCodec().StringToBytes(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	ids, pageRes, err := paginateBySender(ctx, k.HTLCs.Indexes.Sender, sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcs := make([]types.HTLC, 0, len(ids))
+	for _, id := range ids {
+		htlc, err := k.HTLCs.Get(ctx, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		htlcs = append(htlcs, htlc)
+	}
+
+	return &types.QueryHTLCsBySenderResponse{Htlcs: htlcs, Pagination: pageRes}, nil
+}
+
+// paginateBySender returns a page of the ids referenced by sender in a by
+// sender index, e.g. of the periodic payments or the HTLCs. The page key is
+// the big endian encoding of the first id of the page.
+func paginateBySender[V any](ctx context.Context, index *indexes.Multi[sdk.AccAddress, uint64, V], sender sdk.AccAddress, pageReq *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](sender)
+	if len(pageReq.Key) != 0 {
+		if pageReq.Offset > 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+		}
+		if len(pageReq.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		// the key based pagination does not count the total
+		countTotal = false
+		if start := binary.BigEndian.Uint64(pageReq.Key); pageReq.Reverse {
+			rng = rng.EndInclusive(start)
+		} else {
+			rng = rng.StartInclusive(start)
+		}
+	}
+	if pageReq.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := index.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var (
+		ids   []uint64
+		count uint64
+		res   = new(query.PageResponse)
+	)
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+
+		id, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if uint64(len(ids)) == limit {
+			if res.NextKey == nil {
+				res.NextKey = binary.BigEndian.AppendUint64(nil, id)
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if countTotal {
+		res.Total = count
+	}
+
+	return ids, res, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/core/header"
@@ -335,6 +336,62 @@ func (suite *KeeperTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(sdk.NewInt64Coin("bogus", 0), res.Amount)
 }
 
+func (suite *KeeperTestSuite) TestQueryTotalSupplyOfDenomPrefix() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	ibcCoins := sdk.NewCoins(
+		sdk.NewInt64Coin("ibc/1A2B", 100),
+		sdk.NewInt64Coin("ibc/3C4D", 200),
+		sdk.NewInt64Coin("ibc/5E6F", 300),
+	)
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin("ibcx", 400), sdk.NewInt64Coin("test", 500))
+
+	suite.mockMintCoins(mintAcc)
+	suite.Require().NoError(suite.bankKeeper.MintCoins(ctx, types.MintModuleName, ibcCoins.Add(otherCoins...)))
+
+	_, err := queryClient.TotalSupplyOf(gocontext.Background(), &types.QueryTotalSupplyOfRequest{DenomPrefix: strings.Repeat("a", 129)})
+	suite.Require().ErrorContains(err, "denom prefix length must be at most 128")
+
+	res, err := queryClient.TotalSupplyOf(gocontext.Background(), &types.QueryTotalSupplyOfRequest{DenomPrefix: "ibc/"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcCoins, res.Supply)
+
+	res, err = queryClient.TotalSupplyOf(gocontext.Background(), &types.QueryTotalSupplyOfRequest{DenomPrefix: "bogus"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Supply)
+
+	// an empty prefix matches all denoms
+	res, err = queryClient.TotalSupplyOf(gocontext.Background(), &types.QueryTotalSupplyOfRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcCoins.Add(otherCoins...), res.Supply)
+
+	// paginate over the matching denoms by key
+	res, err = queryClient.TotalSupplyOf(gocontext.Background(), &types.QueryTotalSupplyOfRequest{
+		DenomPrefix: "ibc/",
+		Pagination:  &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcCoins[:2], res.Supply)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.TotalSupplyOf(gocontext.Background(), &types.QueryTotalSupplyOfRequest{
+		DenomPrefix: "ibc/",
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcCoins[2:], res.Supply)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// paginate over the matching denoms by offset, in reverse order
+	res, err = queryClient.TotalSupplyOf(gocontext.Background(), &types.QueryTotalSupplyOfRequest{
+		DenomPrefix: "ibc/",
+		Pagination:  &query.PageRequest{Offset: 1, Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{ibcCoins[1]}, res.Supply)
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
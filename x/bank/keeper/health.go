@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// healthCheckSampleSize is the number of denoms whose supply is checked by
+// HealthCheck.
+const healthCheckSampleSize = 10
+
+// HealthCheck checks the supply of a sample of denoms against the sum of their
+// balances, the sampled supply invariant. The sample rotates with the block
+// height so that successive checks cover all the denoms, without iterating all
+// the balances as the TotalSupply invariant does.
+func (k BaseKeeper) HealthCheck(ctx context.Context) error {
+	var denoms []string
+	err := k.Supply.Walk(ctx, nil, func(denom string, _ math.Int) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(denoms) == 0 {
+		return nil
+	}
+
+	size := min(healthCheckSampleSize, len(denoms))
+	offset := int(uint64(k.HeaderService.HeaderInfo(ctx).Height) % uint64(len(denoms)))
+	for i := 0; i < size; i++ {
+		denom := denoms[(offset+i)%len(denoms)]
+		if err := k.checkDenomSupply(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkDenomSupply checks the supply of the denom against the sum of the
+// balances of its holders.
+func (k BaseKeeper) checkDenomSupply(ctx context.Context, denom string) error {
+	supply := k.GetSupply(ctx, denom).Amount
+
+	total := math.ZeroInt()
+	err := k.Balances.Indexes.Denom.Walk(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](denom), func(_ string, addr sdk.AccAddress) (bool, error) {
+		amt, err := k.Balances.Get(ctx, collections.Join(addr, denom))
+		if err != nil {
+			return true, err
+		}
+		total = total.Add(amt)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !total.Equal(supply) {
+		return fmt.Errorf("supply of %s is %s but the balances sum to %s", denom, supply, total)
+	}
+
+	return nil
+}
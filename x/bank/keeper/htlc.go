@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxHTLCRefundsPerBlock is the maximum number of expired hashed timelock
+// transfers refunded at the end of a block, the ones expired beyond it are
+// refunded at the end of the following blocks.
+const MaxHTLCRefundsPerBlock = 1000
+
+// CreateHTLC locks amount of the sender under the hash lock until the recipient
+// claims it with the preimage of the hash lock, or until timeout has elapsed
+// from the current block time and the coins are refunded to the sender. It
+// returns the id of the hashed timelock transfer.
+func (k BaseKeeper) CreateHTLC(ctx context.Context, sender, recipient sdk.AccAddress, amount sdk.Coins, hashLock []byte, timeout time.Duration) (uint64, error) {
+	if !amount.IsValid() || amount.IsZero() {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if err := types.ValidateHashLock(hashLock); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if timeout <= 0 {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid timeout %s", timeout)
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return 0, err
+	}
+
+	senderStr, err := k.ak.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+	recipientStr, err := k.ak.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.BlockedAddr(recipient) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientStr)
+	}
+
+	if err := k.SendCoins(ctx, sender, types.HTLCAddress, amount); err != nil {
+		return 0, err
+	}
+
+	id, err := k.HTLCSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	expirationTime := k.HeaderService.HeaderInfo(ctx).Time.Add(timeout)
+	err = k.HTLCs.Set(ctx, id, types.HTLC{
+		Id:             id,
+		Sender:         senderStr,
+		Recipient:      recipientStr,
+		Amount:         amount,
+		HashLock:       hashLock,
+		ExpirationTime: expirationTime,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateHTLC,
+		event.NewAttribute(types.AttributeKeyHTLCID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, senderStr),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		event.NewAttribute(types.AttributeKeyHashLock, hex.EncodeToString(hashLock)),
+		event.NewAttribute(types.AttributeKeyExpirationTime, expirationTime.Format(time.RFC3339Nano)),
+	)
+}
+
+// ClaimHTLC sends the coins locked by a hashed timelock transfer to its
+// recipient, provided the preimage of its hash lock and that it has not
+// expired. The preimage is emitted in the claim event, so that the
+// counterparty of a swap can use it in turn.
+func (k BaseKeeper) ClaimHTLC(ctx context.Context, id uint64, preimage []byte) error {
+	htlc, err := k.GetHTLC(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !k.HeaderService.HeaderInfo(ctx).Time.Before(htlc.ExpirationTime) {
+		return errorsmod.Wrapf(types.ErrHTLCExpired, "id %d", id)
+	}
+
+	if !htlc.Unlocks(preimage) {
+		return errorsmod.Wrapf(types.ErrInvalidPreimage, "id %d", id)
+	}
+
+	recipient, err := k.ak.AddressCodec().StringToBytes(htlc.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, types.HTLCAddress, recipient, htlc.Amount); err != nil {
+		return err
+	}
+
+	if err := k.HTLCs.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeClaimHTLC,
+		event.NewAttribute(types.AttributeKeyHTLCID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeyRecipient, htlc.Recipient),
+		event.NewAttribute(sdk.AttributeKeyAmount, htlc.Amount.String()),
+		event.NewAttribute(types.AttributeKeyPreimage, hex.EncodeToString(preimage)),
+	)
+}
+
+// RefundHTLC refunds the coins locked by an expired hashed timelock transfer
+// to its sender, only its sender can request it.
+func (k BaseKeeper) RefundHTLC(ctx context.Context, sender sdk.AccAddress, id uint64) error {
+	htlc, err := k.GetHTLC(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	senderStr, err := k.ak.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	if htlc.Sender != senderStr {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "htlc %d is not sent by %s", id, senderStr)
+	}
+
+	if k.HeaderService.HeaderInfo(ctx).Time.Before(htlc.ExpirationTime) {
+		return errorsmod.Wrapf(types.ErrHTLCNotExpired, "id %d expires at %s", id, htlc.ExpirationTime)
+	}
+
+	return k.refundHTLC(ctx, htlc)
+}
+
+// GetHTLC returns the hashed timelock transfer with the given id.
+func (k BaseKeeper) GetHTLC(ctx context.Context, id uint64) (types.HTLC, error) {
+	htlc, err := k.HTLCs.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.HTLC{}, errorsmod.Wrapf(types.ErrHTLCNotFound, "id %d", id)
+		}
+		return types.HTLC{}, err
+	}
+
+	return htlc, nil
+}
+
+// RefundExpiredHTLCs refunds the hashed timelock transfers expired at the
+// current block time, at most MaxHTLCRefundsPerBlock of them. The refunds start
+// after the HTLCRefundCursor, which is moved past the transfers walked, so that
+// a refund failing, e.g. because of a send restriction, emits an event and is
+// skipped instead of being retried at the head of the expiration index in every
+// block. The sender can still refund a skipped transfer with MsgRefundHTLC.
+func (k BaseKeeper) RefundExpiredHTLCs(ctx context.Context) error {
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(blockTime, uint64(math.MaxUint64)))
+	cursor, err := k.HTLCRefundCursor.Get(ctx)
+	switch {
+	case err == nil:
+		rng = rng.StartExclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	var keys []collections.Pair[time.Time, uint64]
+	err = k.HTLCs.Indexes.Expiration.Walk(ctx, rng, func(expiration time.Time, id uint64) (bool, error) {
+		keys = append(keys, collections.Join(expiration, id))
+		return len(keys) >= MaxHTLCRefundsPerBlock, nil
+	})
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+
+	if err := k.HTLCRefundCursor.Set(ctx, keys[len(keys)-1]); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		id := key.K2()
+		htlc, err := k.HTLCs.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		refundErr := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.refundHTLC(ctx, htlc)
+		})
+		if refundErr != nil {
+			err = k.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeRefundHTLCFailed,
+				event.NewAttribute(types.AttributeKeyHTLCID, strconv.FormatUint(id, 10)),
+				event.NewAttribute(types.AttributeKeyError, refundErr.Error()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// refundHTLC sends the coins locked by the hashed timelock transfer back to
+// its sender and deletes it.
+func (k BaseKeeper) refundHTLC(ctx context.Context, htlc types.HTLC) error {
+	sender, err := k.ak.AddressCodec().StringToBytes(htlc.Sender)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, types.HTLCAddress, sender, htlc.Amount); err != nil {
+		return err
+	}
+
+	if err := k.HTLCs.Remove(ctx, htlc.Id); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRefundHTLC,
+		event.NewAttribute(types.AttributeKeyHTLCID, strconv.FormatUint(htlc.Id, 10)),
+		event.NewAttribute(types.AttributeKeySender, htlc.Sender),
+		event.NewAttribute(sdk.AttributeKeyAmount, htlc.Amount.String()),
+	)
+}
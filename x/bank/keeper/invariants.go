@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,6 +14,7 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "escrows", EscrowsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
@@ -22,7 +24,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return TotalSupply(k)(ctx)
+		res, stop = TotalSupply(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return EscrowsInvariant(k)(ctx)
 	}
 }
 
@@ -76,3 +82,41 @@ func TotalSupply(k Keeper) sdk.Invariant {
 				expectedTotal, supply)), broken
 	}
 }
+
+// EscrowsInvariant checks that the module accounts hold at least the coins
+// escrowed by their module
+func EscrowsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		escrowed := make(map[string]sdk.Coins)
+		var modules []string
+		err := k.IterateEscrows(ctx, func(escrow types.Escrow) bool {
+			if _, ok := escrowed[escrow.Module]; !ok {
+				modules = append(modules, escrow.Module)
+			}
+			escrowed[escrow.Module] = escrowed[escrow.Module].Add(escrow.Amount...)
+			return false
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrows", fmt.Sprintf("failed to iterate escrows: %v", err)), true
+		}
+
+		var (
+			msg   string
+			count int
+		)
+		for _, module := range modules {
+			balance := k.GetAllBalances(ctx, authtypes.NewModuleAddress(module))
+			if !balance.IsAllGTE(escrowed[module]) {
+				count++
+				msg += fmt.Sprintf("\tmodule %s holds %s but escrows %s\n", module, balance, escrowed[module])
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrows",
+			fmt.Sprintf("amount of under-collateralized modules found %d\n%s", count, msg),
+		), broken
+	}
+}
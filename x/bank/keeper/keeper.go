@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
@@ -23,10 +24,13 @@ var _ Keeper = (*BaseKeeper)(nil)
 // between accounts.
 type Keeper interface {
 	SendKeeper
+	EscrowKeeper
 	WithMintCoinsRestriction(types.MintingRestrictionFn) BaseKeeper
 
 	InitGenesis(context.Context, *types.GenesisState) error
 	ExportGenesis(context.Context) (*types.GenesisState, error)
+	InitGenesisStream(context.Context, codec.JSONCodec, io.Reader) error
+	ExportGenesisStream(context.Context, codec.JSONCodec, io.Writer) error
 
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 	HasSupply(ctx context.Context, denom string) bool
@@ -49,6 +53,8 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error)
+
 	types.QueryServer
 }
 
@@ -60,6 +66,7 @@ type BaseKeeper struct {
 	ak                     types.AccountKeeper
 	cdc                    codec.BinaryCodec
 	mintCoinsRestrictionFn types.MintingRestrictionFn
+	balanceHistory         *BalanceHistory
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -79,25 +86,34 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *que
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The options can restrict
+// the denoms module accounts can receive (see WithModuleDenomDenylist).
 func NewBaseKeeper(
 	env appmodule.Environment,
 	cdc codec.BinaryCodec,
 	ak types.AccountKeeper,
 	blockedAddrs map[string]bool,
 	authority string,
+	opts ...Option,
 ) BaseKeeper {
 	if _, err := ak.AddressCodec().StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
-	return BaseKeeper{
+	k := BaseKeeper{
 		Environment:            env,
 		BaseSendKeeper:         NewBaseSendKeeper(env, cdc, ak, blockedAddrs, authority),
 		ak:                     ak,
 		cdc:                    cdc,
 		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
 	}
+	k.balanceHistory = newBalanceHistory(k)
+
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	return k
 }
 
 // WithMintCoinsRestriction restricts the bank Keeper used within a specific module to
@@ -125,6 +141,10 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.sendHooks.beforeSend(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
 	balances := sdk.NewCoins()
 
 	for _, coin := range amt {
@@ -181,6 +201,10 @@ func (k BaseKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegato
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.sendHooks.beforeSend(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt)
 	if err != nil {
 		return err
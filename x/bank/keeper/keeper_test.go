@@ -10,9 +10,14 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	coreevent "cosmossdk.io/core/event"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
@@ -375,6 +380,351 @@ func (suite *KeeperTestSuite) TestSupply() {
 	require.Equal(total, genesisSupply)
 }
 
+func (suite *KeeperTestSuite) TestHealthCheck() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	require.NoError(keeper.HealthCheck(ctx))
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newBarCoin(100), newFooCoin(50))))
+	require.NoError(keeper.HealthCheck(ctx))
+
+	// corrupt the supply of a denom
+	require.NoError(keeper.Supply.Set(ctx, barDenom, math.NewInt(99)))
+	require.ErrorContains(keeper.HealthCheck(ctx), "supply of bar is 99 but the balances sum to 100")
+}
+
+func (suite *KeeperTestSuite) TestPeriodicPayments() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10})
+	require := suite.Require()
+	bankKeeper := suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(25))))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	_, err = bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), keeper.MinPeriodicPaymentPeriod-1, 2)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), 100, 0)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[1], sdk.Coins{}, 100, 2)
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// the executions of the payments are charged on creation
+	gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	id, err := bankKeeper.CreatePeriodicPayment(gasCtx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), 100, 2)
+	require.NoError(err)
+	require.Equal(uint64(0), id)
+	require.GreaterOrEqual(gasCtx.GasMeter().GasConsumed(), storetypes.Gas(2*keeper.PeriodicPaymentExecutionGas))
+	id2, err := bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(1)), 1000, 10)
+	require.NoError(err)
+	require.Equal(uint64(1), id2)
+
+	payment, err := bankKeeper.GetPeriodicPayment(ctx, id)
+	require.NoError(err)
+	require.Equal(int64(110), payment.NextHeight)
+
+	res, err := bankKeeper.PeriodicPaymentsBySender(ctx, &banktypes.QueryPeriodicPaymentsBySenderRequest{Sender: acc0StrAddr})
+	require.NoError(err)
+	require.Len(res.PeriodicPayments, 2)
+
+	// nothing is due before the next height
+	require.NoError(bankKeeper.ExecutePeriodicPayments(ctx.WithHeaderInfo(header.Info{Height: 109})))
+	require.True(bankKeeper.GetAllBalances(ctx, accAddrs[1]).IsZero())
+
+	require.NoError(bankKeeper.ExecutePeriodicPayments(ctx.WithHeaderInfo(header.Info{Height: 110})))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	payment, err = bankKeeper.GetPeriodicPayment(ctx, id)
+	require.NoError(err)
+	require.Equal(int64(210), payment.NextHeight)
+	require.Equal(uint64(1), payment.RemainingPayments)
+
+	// the last payment removes the periodic payment
+	require.NoError(bankKeeper.ExecutePeriodicPayments(ctx.WithHeaderInfo(header.Info{Height: 210})))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	_, err = bankKeeper.GetPeriodicPayment(ctx, id)
+	require.ErrorIs(err, banktypes.ErrPeriodicPaymentNotFound)
+
+	// a payment failing for lack of funds stays scheduled
+	id, err = bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), 100, 1)
+	require.NoError(err)
+	require.NoError(bankKeeper.ExecutePeriodicPayments(ctx.WithHeaderInfo(header.Info{Height: 110})))
+	require.Equal(sdk.NewCoins(newFooCoin(5)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	payment, err = bankKeeper.GetPeriodicPayment(ctx, id)
+	require.NoError(err)
+	require.Equal(int64(210), payment.NextHeight)
+	require.Equal(uint64(1), payment.RemainingPayments)
+
+	// only the sender can cancel a periodic payment
+	require.ErrorIs(bankKeeper.CancelPeriodicPayment(ctx, accAddrs[1], id), sdkerrors.ErrUnauthorized)
+	require.NoError(bankKeeper.CancelPeriodicPayment(ctx, accAddrs[0], id))
+	require.ErrorIs(bankKeeper.CancelPeriodicPayment(ctx, accAddrs[0], id), banktypes.ErrPeriodicPaymentNotFound)
+
+	genState, err := bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genState.PeriodicPayments, 1)
+	require.Equal(id2, genState.PeriodicPayments[0].Id)
+	require.Equal(uint64(3), genState.PeriodicPaymentSeq)
+}
+
+func (suite *KeeperTestSuite) TestPeriodicPaymentsLimits() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 10})
+	require := suite.Require()
+	bankKeeper := suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(5))))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).AnyTimes()
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	// a periodic payment is cancelled once its payments failed in a row too
+	// many times
+	id, err := bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), 100, 10)
+	require.NoError(err)
+	height := int64(10)
+	for i := uint64(1); i < keeper.MaxPeriodicPaymentFailures; i++ {
+		height += 100
+		require.NoError(bankKeeper.ExecutePeriodicPayments(ctx.WithHeaderInfo(header.Info{Height: height})))
+		payment, err := bankKeeper.GetPeriodicPayment(ctx, id)
+		require.NoError(err)
+		require.Equal(i, payment.ConsecutiveFailures)
+	}
+	require.NoError(bankKeeper.ExecutePeriodicPayments(ctx.WithHeaderInfo(header.Info{Height: height + 100})))
+	_, err = bankKeeper.GetPeriodicPayment(ctx, id)
+	require.ErrorIs(err, banktypes.ErrPeriodicPaymentNotFound)
+	require.Equal(sdk.NewCoins(newFooCoin(5)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// a sender has a limited number of periodic payments
+	for i := 0; i < keeper.MaxPeriodicPaymentsPerSender; i++ {
+		_, err := bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1)), 100, 1)
+		require.NoError(err)
+	}
+	_, err = bankKeeper.CreatePeriodicPayment(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1)), 100, 1)
+	require.ErrorIs(err, banktypes.ErrTooManyPeriodicPayments)
+
+	// the periodic payments of the sender are paginated
+	res, err := bankKeeper.PeriodicPaymentsBySender(ctx, &banktypes.QueryPeriodicPaymentsBySenderRequest{Sender: acc0StrAddr, Pagination: &query.PageRequest{Limit: 30, CountTotal: true}})
+	require.NoError(err)
+	require.Len(res.PeriodicPayments, 30)
+	require.Equal(uint64(keeper.MaxPeriodicPaymentsPerSender), res.Pagination.Total)
+	require.NotNil(res.Pagination.NextKey)
+
+	res, err = bankKeeper.PeriodicPaymentsBySender(ctx, &banktypes.QueryPeriodicPaymentsBySenderRequest{Sender: acc0StrAddr, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 30}})
+	require.NoError(err)
+	require.Len(res.PeriodicPayments, keeper.MaxPeriodicPaymentsPerSender-30)
+	require.Nil(res.Pagination.NextKey)
+	require.Equal(id+31, res.PeriodicPayments[0].Id)
+}
+
+func (suite *KeeperTestSuite) TestBalanceHistory() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	bankKeeper := suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	_, err = bankKeeper.BalanceHistory(ctx, &banktypes.QueryBalanceHistoryRequest{Address: acc0StrAddr, Denom: fooDenom})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	history, err := bankKeeper.EnableBalanceHistory(dbm.NewMemDB(), banktypes.StoreKey, 4)
+	require.NoError(err)
+
+	balanceChange := func(addr sdk.AccAddress, amount int64) *storetypes.StoreKVPair {
+		key, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, bankKeeper.Balances.KeyCodec(), collections.Join(addr, fooDenom))
+		require.NoError(err)
+		if amount == 0 {
+			return &storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: key, Delete: true}
+		}
+		value, err := banktypes.BalanceValueCodec.Encode(math.NewInt(amount))
+		require.NoError(err)
+		return &storetypes.StoreKVPair{StoreKey: banktypes.StoreKey, Key: key, Value: value}
+	}
+	commit := func(height int64, changeSet ...*storetypes.StoreKVPair) {
+		require.NoError(history.ListenCommit(ctx.WithHeaderInfo(header.Info{Height: height}), abci.CommitResponse{}, changeSet))
+	}
+	balanceAt := func(addr string, height int64) (math.Int, error) {
+		res, err := bankKeeper.BalanceHistory(ctx, &banktypes.QueryBalanceHistoryRequest{Address: addr, Denom: fooDenom, Height: height})
+		if err != nil {
+			return math.Int{}, err
+		}
+		return res.Balance.Amount, nil
+	}
+
+	// the first commit indexes the balances of all the accounts
+	commit(10)
+	commit(11, balanceChange(accAddrs[0], 80), balanceChange(accAddrs[0], 60), balanceChange(accAddrs[1], 40))
+	commit(12, balanceChange(accAddrs[0], 0))
+	commit(13)
+
+	for _, tc := range []struct {
+		addr   string
+		height int64
+		amount int64
+	}{
+		{acc0StrAddr, 10, 100},
+		{acc0StrAddr, 11, 60},
+		{acc0StrAddr, 12, 0},
+		{acc0StrAddr, 0, 0},
+		{acc1StrAddr, 10, 0},
+		{acc1StrAddr, 13, 40},
+	} {
+		amount, err := balanceAt(tc.addr, tc.height)
+		require.NoError(err)
+		require.Equal(math.NewInt(tc.amount), amount, "height %d", tc.height)
+	}
+
+	_, err = balanceAt(acc0StrAddr, 9)
+	require.Equal(codes.NotFound, status.Code(err))
+	_, err = balanceAt(acc0StrAddr, 14)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// the heights before the last 4 ones are pruned
+	commit(14)
+	commit(15)
+	_, err = balanceAt(acc0StrAddr, 11)
+	require.Equal(codes.NotFound, status.Code(err))
+	_, err = history.History.Get(ctx, collections.Join3(accAddrs[0], fooDenom, int64(11)))
+	require.ErrorIs(err, collections.ErrNotFound)
+	amount, err := balanceAt(acc1StrAddr, 12)
+	require.NoError(err)
+	require.Equal(math.NewInt(40), amount)
+}
+
+func (suite *KeeperTestSuite) TestHTLC() {
+	now := time.Now().UTC()
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Time: now})
+	require := suite.Require()
+	bankKeeper := suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0])).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), banktypes.HTLCAddress).Return(authtypes.NewBaseAccountWithAddress(banktypes.HTLCAddress)).AnyTimes()
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	preimage := []byte("secret")
+	hashLock := sha256.Sum256(preimage)
+
+	_, err = bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), []byte("short"), time.Hour)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), hashLock[:], 0)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[1], sdk.Coins{}, hashLock[:], time.Hour)
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	id, err := bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), hashLock[:], time.Hour)
+	require.NoError(err)
+	require.Equal(uint64(0), id)
+	id2, err := bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(20)), hashLock[:], 2*time.Hour)
+	require.NoError(err)
+	require.Equal(uint64(1), id2)
+	id3, err := bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[2], sdk.NewCoins(newFooCoin(30)), hashLock[:], 3*time.Hour)
+	require.NoError(err)
+
+	// the coins are locked until the htlc is claimed or refunded
+	require.Equal(sdk.NewCoins(newFooCoin(40)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(60)), bankKeeper.GetAllBalances(ctx, banktypes.HTLCAddress))
+
+	htlc, err := bankKeeper.GetHTLC(ctx, id)
+	require.NoError(err)
+	require.Equal(now.Add(time.Hour), htlc.ExpirationTime)
+
+	res, err := bankKeeper.HTLCsBySender(ctx, &banktypes.QueryHTLCsBySenderRequest{Sender: acc0StrAddr})
+	require.NoError(err)
+	require.Len(res.Htlcs, 3)
+
+	// only the preimage of the hash lock unlocks the coins
+	require.ErrorIs(bankKeeper.ClaimHTLC(ctx, id, []byte("guess")), banktypes.ErrInvalidPreimage)
+	require.NoError(bankKeeper.ClaimHTLC(ctx, id, preimage))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.ErrorIs(bankKeeper.ClaimHTLC(ctx, id, preimage), banktypes.ErrHTLCNotFound)
+
+	// an htlc can only be refunded by its sender once expired
+	require.ErrorIs(bankKeeper.RefundHTLC(ctx, accAddrs[0], id2), banktypes.ErrHTLCNotExpired)
+	expiredCtx := ctx.WithHeaderInfo(header.Info{Time: now.Add(2 * time.Hour)})
+	require.ErrorIs(bankKeeper.ClaimHTLC(expiredCtx, id2, preimage), banktypes.ErrHTLCExpired)
+	require.ErrorIs(bankKeeper.RefundHTLC(expiredCtx, accAddrs[1], id2), sdkerrors.ErrUnauthorized)
+	require.NoError(bankKeeper.RefundHTLC(expiredCtx, accAddrs[0], id2))
+	require.Equal(sdk.NewCoins(newFooCoin(60)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// expired htlcs are refunded at the end of the block
+	require.NoError(bankKeeper.RefundExpiredHTLCs(ctx.WithHeaderInfo(header.Info{Time: now.Add(3*time.Hour - time.Second)})))
+	_, err = bankKeeper.GetHTLC(ctx, id3)
+	require.NoError(err)
+
+	genState, err := bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genState.Htlcs, 1)
+	require.Equal(id3, genState.Htlcs[0].Id)
+	require.Equal(uint64(3), genState.HtlcSeq)
+
+	require.NoError(bankKeeper.RefundExpiredHTLCs(ctx.WithHeaderInfo(header.Info{Time: now.Add(3 * time.Hour)})))
+	_, err = bankKeeper.GetHTLC(ctx, id3)
+	require.ErrorIs(err, banktypes.ErrHTLCNotFound)
+	require.Equal(sdk.NewCoins(newFooCoin(90)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.True(bankKeeper.GetAllBalances(ctx, banktypes.HTLCAddress).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRefundExpiredHTLCsSkipsFailures() {
+	now := time.Now().UTC()
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Time: now})
+	require := suite.Require()
+	bankKeeper := suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0])).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), banktypes.HTLCAddress).Return(authtypes.NewBaseAccountWithAddress(banktypes.HTLCAddress)).AnyTimes()
+
+	hashLock := sha256.Sum256([]byte("secret"))
+	failingID, err := bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)), hashLock[:], time.Hour)
+	require.NoError(err)
+	id, err := bankKeeper.CreateHTLC(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(20)), hashLock[:], time.Hour)
+	require.NoError(err)
+
+	// the refund of the first htlc fails, the second one is still refunded
+	bankKeeper.AppendSendRestriction(func(_ context.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if amt.Equal(sdk.NewCoins(newFooCoin(10))) {
+			return nil, errors.New("restricted")
+		}
+		return toAddr, nil
+	})
+	expiredCtx := ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)})
+	require.NoError(bankKeeper.RefundExpiredHTLCs(expiredCtx))
+	_, err = bankKeeper.GetHTLC(ctx, failingID)
+	require.NoError(err)
+	_, err = bankKeeper.GetHTLC(ctx, id)
+	require.ErrorIs(err, banktypes.ErrHTLCNotFound)
+	require.Equal(sdk.NewCoins(newFooCoin(90)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the failed refund does not block the htlcs expiring later, and is not retried
+	bankKeeper.SetSendRestriction(nil)
+	id, err = bankKeeper.CreateHTLC(expiredCtx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(30)), hashLock[:], time.Hour)
+	require.NoError(err)
+	require.NoError(bankKeeper.RefundExpiredHTLCs(ctx.WithHeaderInfo(header.Info{Time: now.Add(2 * time.Hour)})))
+	_, err = bankKeeper.GetHTLC(ctx, id)
+	require.ErrorIs(err, banktypes.ErrHTLCNotFound)
+	_, err = bankKeeper.GetHTLC(ctx, failingID)
+	require.NoError(err)
+
+	// the sender can still refund it
+	require.NoError(bankKeeper.RefundHTLC(expiredCtx, accAddrs[0], failingID))
+	require.Equal(sdk.NewCoins(newFooCoin(100)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsFromModuleToAccount_Blocklist() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -1228,6 +1578,195 @@ func (suite *KeeperTestSuite) TestSendCoinsWithRestrictions() {
 	}
 }
 
+// mockSendHooks blocks the sends of a denom and records the other sends.
+type mockSendHooks struct {
+	blockedDenom string
+	tracked      []sdk.Coins
+}
+
+func (h *mockSendHooks) BlockBeforeSend(_ context.Context, _, _ sdk.AccAddress, amount sdk.Coins) error {
+	if amount.AmountOf(h.blockedDenom).IsPositive() {
+		return fmt.Errorf("%s transfers are blocked", h.blockedDenom)
+	}
+	return nil
+}
+
+func (h *mockSendHooks) TrackBeforeSend(_ context.Context, _, _ sdk.AccAddress, amount sdk.Coins) {
+	h.tracked = append(h.tracked, amount)
+}
+
+func (suite *KeeperTestSuite) TestSendHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	hooks := &mockSendHooks{blockedDenom: barDenom}
+	suite.bankKeeper.SetHooks(hooks)
+	require.Panics(func() { suite.bankKeeper.SetHooks(hooks) }, "hooks can't be set twice")
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	// allowed sends are tracked
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.Equal([]sdk.Coins{sdk.NewCoins(newFooCoin(10))}, hooks.tracked)
+
+	// blocked sends are neither tracked nor performed
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	require.ErrorContains(err, "bar transfers are blocked")
+	require.Len(hooks.tracked, 1)
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(50)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// multi-sends call the hooks for every output
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	acc1StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+	acc2StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, nil)
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx,
+		banktypes.Input{Address: acc0StrAddr, Coins: sdk.NewCoins(newFooCoin(20))},
+		[]banktypes.Output{
+			{Address: acc1StrAddr, Coins: sdk.NewCoins(newFooCoin(5))},
+			{Address: acc2StrAddr, Coins: sdk.NewCoins(newFooCoin(15))},
+		},
+	))
+	require.Equal([]sdk.Coins{sdk.NewCoins(newFooCoin(10)), sdk.NewCoins(newFooCoin(5)), sdk.NewCoins(newFooCoin(15))}, hooks.tracked)
+
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, nil)
+	err = suite.bankKeeper.InputOutputCoins(ctx,
+		banktypes.Input{Address: acc0StrAddr, Coins: sdk.NewCoins(newBarCoin(20))},
+		[]banktypes.Output{{Address: acc1StrAddr, Coins: sdk.NewCoins(newBarCoin(20))}},
+	)
+	require.ErrorContains(err, "bar transfers are blocked")
+	require.Len(hooks.tracked, 3)
+}
+
+func (suite *KeeperTestSuite) TestModuleDenomRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	suite.authKeeper.EXPECT().GetModuleAddress(holder).Return(holderAcc.GetAddress()).Times(2)
+	suite.authKeeper.EXPECT().GetModuleAddress(randomPerm).Return(randomAcc.GetAddress())
+	bankKeeper := keeper.NewBaseKeeper(
+		suite.bankKeeper.Environment,
+		suite.encCfg.Codec,
+		suite.authKeeper,
+		suite.bankKeeper.GetBlockedAddresses(),
+		suite.bankKeeper.GetAuthority(),
+		keeper.WithModuleDenomDenylist(holder, barDenom),
+		keeper.WithModuleDenomDenylist(holder, "baz"),
+		keeper.WithModuleDenomAllowlist(randomPerm, fooDenom),
+	)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	// denied denoms can't be sent to the module account
+	suite.mockSendCoins(ctx, acc0, holderAcc.GetAddress())
+	require.NoError(bankKeeper.SendCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))))
+	err := bankKeeper.SendCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	require.ErrorIs(err, banktypes.ErrModuleDenomRestricted)
+	require.ErrorContains(err, "bar cannot be sent to the holder module account")
+
+	// only allowed denoms can be sent to the module account
+	suite.mockSendCoins(ctx, acc0, randomAcc.GetAddress())
+	require.NoError(bankKeeper.SendCoins(ctx, accAddrs[0], randomAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))))
+	require.ErrorIs(bankKeeper.SendCoins(ctx, accAddrs[0], randomAcc.GetAddress(), sdk.NewCoins(newBarCoin(10))), banktypes.ErrModuleDenomRestricted)
+
+	// other accounts aren't restricted
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+
+	// multi-sends are restricted too
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	holderStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(holderAcc.GetAddress())
+	require.NoError(err)
+
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, nil)
+	err = bankKeeper.InputOutputCoins(ctx,
+		banktypes.Input{Address: acc0StrAddr, Coins: sdk.NewCoins(newBarCoin(10))},
+		[]banktypes.Output{{Address: holderStrAddr, Coins: sdk.NewCoins(newBarCoin(10))}},
+	)
+	require.ErrorIs(err, banktypes.ErrModuleDenomRestricted)
+
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bankKeeper.GetAllBalances(ctx, randomAcc.GetAddress()))
+
+	// restrictions can't be set for unknown module accounts
+	suite.authKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+	require.Panics(func() {
+		keeper.NewBaseKeeper(
+			suite.bankKeeper.Environment,
+			suite.encCfg.Codec,
+			suite.authKeeper,
+			suite.bankKeeper.GetBlockedAddresses(),
+			suite.bankKeeper.GetAuthority(),
+			keeper.WithModuleDenomDenylist("unknown", barDenom),
+		)
+	})
+}
+
+func (suite *KeeperTestSuite) TestEscrow() {
+	ctx := suite.ctx
+	require := suite.Require()
+	bankKeeper := suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc0StrAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	suite.mockSendCoinsFromAccountToModule(acc0, holderAcc)
+	require.NoError(bankKeeper.EscrowCoins(ctx, holder, "1", accAddrs[0], sdk.NewCoins(newFooCoin(30))))
+	suite.mockSendCoinsFromAccountToModule(acc0, holderAcc)
+	require.NoError(bankKeeper.EscrowCoins(ctx, holder, "2", accAddrs[0], sdk.NewCoins(newFooCoin(20))))
+
+	require.ErrorIs(bankKeeper.EscrowCoins(ctx, holder, "1", accAddrs[0], sdk.NewCoins(newFooCoin(10))), banktypes.ErrEscrowExists)
+	require.ErrorContains(bankKeeper.EscrowCoins(ctx, holder, "", accAddrs[0], sdk.NewCoins(newFooCoin(10))), "escrow id cannot be empty")
+	require.ErrorIs(bankKeeper.EscrowCoins(ctx, holder, "3", accAddrs[0], sdk.Coins{}), sdkerrors.ErrInvalidCoins)
+
+	escrow, err := bankKeeper.GetEscrow(ctx, holder, "1")
+	require.NoError(err)
+	require.Equal(banktypes.Escrow{Module: holder, Id: "1", Depositor: acc0StrAddr, Amount: sdk.NewCoins(newFooCoin(30))}, escrow)
+	require.Equal(sdk.NewCoins(newFooCoin(50)), bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+
+	res, err := bankKeeper.Escrows(ctx, &banktypes.QueryEscrowsRequest{Module: holder})
+	require.NoError(err)
+	require.Len(res.Escrows, 2)
+	res, err = bankKeeper.Escrows(ctx, &banktypes.QueryEscrowsRequest{Module: randomPerm})
+	require.NoError(err)
+	require.Empty(res.Escrows)
+
+	_, broken := keeper.EscrowsInvariant(bankKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.False(broken)
+
+	// released coins are sent to the recipient
+	suite.mockSendCoinsFromModuleToAccount(holderAcc, accAddrs[1])
+	require.NoError(bankKeeper.ReleaseEscrow(ctx, holder, "1", accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(30)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// refunded coins are sent back to the depositor
+	suite.mockSendCoinsFromModuleToAccount(holderAcc, accAddrs[0])
+	require.NoError(bankKeeper.RefundEscrow(ctx, holder, "2"))
+	require.Equal(sdk.NewCoins(newFooCoin(70)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	require.True(bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()).IsZero())
+	_, err = bankKeeper.GetEscrow(ctx, holder, "1")
+	require.ErrorIs(err, banktypes.ErrEscrowNotFound)
+	require.ErrorIs(bankKeeper.ReleaseEscrow(ctx, holder, "1", accAddrs[1]), banktypes.ErrEscrowNotFound)
+	require.ErrorIs(bankKeeper.RefundEscrow(ctx, holder, "2"), banktypes.ErrEscrowNotFound)
+}
+
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	balances := sdk.NewCoins(newFooCoin(50))
 
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Option configures a BaseKeeper when it is created.
+type Option func(*BaseKeeper)
+
+// WithModuleDenomDenylist prevents the given module account from receiving the
+// given denoms through SendCoins and InputOutputCoins, which includes the
+// SendCoinsFromAccountToModule and SendCoinsFromModuleToModule transfers.
+// For instance, it can ensure the staking denom is never sent to the fee
+// collector directly. It panics if the module account has no address.
+func WithModuleDenomDenylist(moduleName string, denoms ...string) Option {
+	return func(k *BaseKeeper) {
+		restriction := k.moduleDenomRestriction(moduleName)
+		for _, denom := range denoms {
+			restriction.denied[denom] = true
+		}
+	}
+}
+
+// WithModuleDenomAllowlist restricts the denoms the given module account can
+// receive through SendCoins and InputOutputCoins to the given ones. Calling it
+// multiple times for the same module allows the union of the given denoms.
+// It panics if the module account has no address.
+func WithModuleDenomAllowlist(moduleName string, denoms ...string) Option {
+	return func(k *BaseKeeper) {
+		restriction := k.moduleDenomRestriction(moduleName)
+		if restriction.allowed == nil {
+			restriction.allowed = make(map[string]bool, len(denoms))
+		}
+		for _, denom := range denoms {
+			restriction.allowed[denom] = true
+		}
+	}
+}
+
+// moduleDenomRestriction returns the denom restriction of the given module
+// account, creating it if needed.
+func (k *BaseKeeper) moduleDenomRestriction(moduleName string) *moduleDenomRestriction {
+	addr := k.ak.GetModuleAddress(moduleName)
+	if addr == nil {
+		panic(fmt.Sprintf("module account %s does not exist", moduleName))
+	}
+
+	if k.moduleDenomRestrictions == nil {
+		k.moduleDenomRestrictions = make(map[string]*moduleDenomRestriction)
+	}
+
+	restriction, ok := k.moduleDenomRestrictions[string(addr)]
+	if !ok {
+		restriction = &moduleDenomRestriction{moduleName: moduleName, denied: make(map[string]bool)}
+		k.moduleDenomRestrictions[string(addr)] = restriction
+	}
+
+	return restriction
+}
+
+// moduleDenomRestriction is the set of denoms a module account can or can't
+// receive.
+type moduleDenomRestriction struct {
+	moduleName string
+	// allowed is nil if the module account has no allowlist
+	allowed map[string]bool
+	denied  map[string]bool
+}
+
+func (r *moduleDenomRestriction) isAllowed(denom string) bool {
+	if r.denied[denom] {
+		return false
+	}
+
+	return r.allowed == nil || r.allowed[denom]
+}
+
+// checkModuleDenomRestrictions returns an error if the recipient is a module
+// account which can't receive one of the given coins.
+func (k BaseSendKeeper) checkModuleDenomRestrictions(toAddr sdk.AccAddress, amt sdk.Coins) error {
+	restriction, ok := k.moduleDenomRestrictions[string(toAddr)]
+	if !ok {
+		return nil
+	}
+
+	for _, coin := range amt {
+		if !restriction.isAllowed(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrModuleDenomRestricted, "%s cannot be sent to the %s module account", coin.Denom, restriction.moduleName)
+		}
+	}
+
+	return nil
+}
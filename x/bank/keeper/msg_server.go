@@ -93,33 +93,24 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 		return nil, types.ErrNoOutputs
 	}
 
-	if err := types.ValidateInputOutputs(msg.Inputs[0], msg.Outputs); err != nil {
+	input := msg.Inputs[0]
+	if err := k.IsSendEnabledCoins(ctx, input.Coins...); err != nil {
 		return nil, err
 	}
 
-	// NOTE: totalIn == totalOut should already have been checked
-	for _, in := range msg.Inputs {
-		if err := k.IsSendEnabledCoins(ctx, in.Coins...); err != nil {
-			return nil, err
-		}
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
 	}
 
-	for _, out := range msg.Outputs {
-		if base, ok := k.Keeper.(BaseKeeper); ok {
-			accAddr, err := base.ak.AddressCodec().StringToBytes(out.Address)
-			if err != nil {
-				return nil, err
-			}
-
-			if k.BlockedAddr(accAddr) {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
-			}
-		} else {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	// the outputs are validated while being credited: as the input covers all
+	// the sent denoms, checking it for send enabled coins is enough.
+	err := base.multiSendCoins(ctx, input, msg.Outputs, func(address string, addr sdk.AccAddress) error {
+		if k.BlockedAddr(addr) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", address)
 		}
-	}
-
-	err := k.InputOutputCoins(ctx, msg.Inputs[0], msg.Outputs)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +168,20 @@ func (k msgServer) SetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnab
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
 
+func (k msgServer) UpdateDenomMetadata(ctx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid metadata for denom %q: %s", msg.Metadata.Base, err)
+	}
+
+	k.SetDenomMetaData(ctx, msg.Metadata)
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
 func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	var (
 		from []byte
@@ -212,3 +217,102 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) CreatePeriodicPayment(ctx context.Context, msg *types.MsgCreatePeriodicPayment) (*types.MsgCreatePeriodicPaymentResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.ak.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	recipient, err := base.ak.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	id, err := base.CreatePeriodicPayment(ctx, sender, recipient, msg.Amount, msg.Period, msg.Payments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicPaymentResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelPeriodicPayment(ctx context.Context, msg *types.MsgCancelPeriodicPayment) (*types.MsgCancelPeriodicPaymentResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.ak.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := base.CancelPeriodicPayment(ctx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPeriodicPaymentResponse{}, nil
+}
+
+func (k msgServer) CreateHTLC(ctx context.Context, msg *types.MsgCreateHTLC) (*types.MsgCreateHTLCResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.ak.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	recipient, err := base.ak.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	id, err := base.CreateHTLC(ctx, sender, recipient, msg.Amount, msg.HashLock, msg.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateHTLCResponse{Id: id}, nil
+}
+
+func (k msgServer) ClaimHTLC(ctx context.Context, msg *types.MsgClaimHTLC) (*types.MsgClaimHTLCResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	if _, err := base.ak.AddressCodec().StringToBytes(msg.Claimer); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid claimer address: %s", err)
+	}
+
+	if err := base.ClaimHTLC(ctx, msg.Id, msg.Preimage); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimHTLCResponse{}, nil
+}
+
+func (k msgServer) RefundHTLC(ctx context.Context, msg *types.MsgRefundHTLC) (*types.MsgRefundHTLCResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.ak.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := base.RefundHTLC(ctx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundHTLCResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 
@@ -182,6 +184,7 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 	testCases := []struct {
 		name      string
 		input     *banktypes.MsgMultiSend
+		mockInput bool
 		expErr    bool
 		expErrMsg string
 	}{
@@ -233,9 +236,54 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 					{Address: acc4Addr, Coins: sendCoins},
 				},
 			},
+			mockInput: true,
 			expErr:    true,
 			expErrMsg: "is not allowed to receive funds",
 		},
+		{
+			name: "outputs lower than input",
+			input: &banktypes.MsgMultiSend{
+				Inputs: []banktypes.Input{
+					{Address: minterAccAddr, Coins: origCoins},
+				},
+				Outputs: []banktypes.Output{
+					{Address: acc0Addr, Coins: sendCoins},
+				},
+			},
+			mockInput: true,
+			expErr:    true,
+			expErrMsg: "sum inputs != sum outputs",
+		},
+		{
+			name: "outputs greater than input",
+			input: &banktypes.MsgMultiSend{
+				Inputs: []banktypes.Input{
+					{Address: minterAccAddr, Coins: origCoins},
+				},
+				Outputs: []banktypes.Output{
+					{Address: acc0Addr, Coins: sendCoins},
+					{Address: acc1Addr, Coins: sendCoins},
+					{Address: acc1Addr, Coins: sendCoins},
+				},
+			},
+			mockInput: true,
+			expErr:    true,
+			expErrMsg: "sum inputs != sum outputs",
+		},
+		{
+			name: "invalid output coins",
+			input: &banktypes.MsgMultiSend{
+				Inputs: []banktypes.Input{
+					{Address: minterAccAddr, Coins: origCoins},
+				},
+				Outputs: []banktypes.Output{
+					{Address: acc0Addr, Coins: sdk.Coins{sdk.NewInt64Coin(origDenom, 0)}},
+				},
+			},
+			mockInput: true,
+			expErr:    true,
+			expErrMsg: "invalid coins",
+		},
 		{
 			name: "invalid send to blocked address",
 			input: &banktypes.MsgMultiSend{
@@ -247,7 +295,8 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 					{Address: acc1Addr, Coins: sendCoins},
 				},
 			},
-			expErr: false,
+			mockInput: true,
+			expErr:    false,
 		},
 	}
 
@@ -257,7 +306,7 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 			suite.mockMintCoins(minterAcc)
 			err := suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, origCoins)
 			suite.Require().NoError(err)
-			if !tc.expErr {
+			if tc.mockInput {
 				suite.mockInputOutputCoins([]sdk.AccountI{minterAcc}, accAddrs[:2])
 			}
 			_, err = suite.msgServer.MultiSend(suite.ctx, tc.input)
@@ -271,6 +320,41 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgMultiSendManyOutputs() {
+	const numOutputs = 200
+
+	origDenom := "sendableCoin"
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin(origDenom, numOutputs))
+	suite.bankKeeper.SetSendEnabled(suite.ctx, origDenom, true)
+
+	suite.mockMintCoins(minterAcc)
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, origCoins))
+
+	minterAccAddr, err := suite.authKeeper.AddressCodec().BytesToString(minterAcc.GetAddress())
+	suite.Require().NoError(err)
+
+	recipients := make([]sdk.AccAddress, numOutputs)
+	outputs := make([]banktypes.Output, numOutputs)
+	for i := range outputs {
+		recipients[i] = sdk.AccAddress(fmt.Sprintf("recipient%011d", i))
+		addr, err := suite.authKeeper.AddressCodec().BytesToString(recipients[i])
+		suite.Require().NoError(err)
+		outputs[i] = banktypes.Output{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin(origDenom, 1))}
+	}
+
+	suite.mockInputOutputCoins([]sdk.AccountI{minterAcc}, recipients)
+	_, err = suite.msgServer.MultiSend(suite.ctx, &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: minterAccAddr, Coins: origCoins}},
+		Outputs: outputs,
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.bankKeeper.GetBalance(suite.ctx, minterAcc.GetAddress(), origDenom).IsZero())
+	for _, recipient := range recipients {
+		suite.Require().Equal(int64(1), suite.bankKeeper.GetBalance(suite.ctx, recipient, origDenom).Amount.Int64())
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 	govAccAddr, err := suite.authKeeper.AddressCodec().BytesToString(govAcc.GetAddress())
 	suite.Require().NoError(err)
@@ -440,3 +524,73 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateDenomMetadata() {
+	govAccAddr, err := suite.authKeeper.AddressCodec().BytesToString(govAcc.GetAddress())
+	suite.Require().NoError(err)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	metadata := banktypes.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ibcDenom, Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    ibcDenom,
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+	}
+	updatedMetadata := metadata
+	updatedMetadata.Description = "The native staking token of the Cosmos Hub, transferred over IBC."
+	invalidMetadata := metadata
+	invalidMetadata.Symbol = ""
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgUpdateDenomMetadata
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name: "set metadata",
+			req:  banktypes.NewMsgUpdateDenomMetadata(govAccAddr, metadata),
+		},
+		{
+			name: "replace metadata",
+			req:  banktypes.NewMsgUpdateDenomMetadata(govAccAddr, updatedMetadata),
+		},
+		{
+			name:     "invalid metadata",
+			req:      banktypes.NewMsgUpdateDenomMetadata(govAccAddr, invalidMetadata),
+			isExpErr: true,
+			errMsg:   fmt.Sprintf("invalid metadata for denom %q: symbol field cannot be blank", ibcDenom),
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgUpdateDenomMetadata("invalid", metadata),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateDenomMetadata(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+				got, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, ibcDenom)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.req.Metadata, got)
+			}
+		})
+	}
+
+	// the metadata set last is kept
+	got, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, ibcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(updatedMetadata, got)
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxPeriodicPaymentsPerBlock is the maximum number of periodic payments
+// executed at the end of a block, the payments due beyond it are executed at
+// the end of the following blocks.
+const MaxPeriodicPaymentsPerBlock = 1000
+
+// MaxPeriodicPaymentsPerSender is the maximum number of periodic payments an
+// account can send at the same time.
+const MaxPeriodicPaymentsPerSender = 50
+
+// MaxPeriodicPaymentFailures is the number of payments of a periodic payment
+// failing in a row after which the periodic payment is cancelled.
+const MaxPeriodicPaymentFailures = 3
+
+// MinPeriodicPaymentPeriod is the minimum number of blocks between two payments
+// of a periodic payment.
+const MinPeriodicPaymentPeriod = 100
+
+// PeriodicPaymentExecutionGas is the gas charged on the creation of a periodic
+// payment for each of its payments, as they are executed at the end of blocks
+// where no gas is charged.
+const PeriodicPaymentExecutionGas = 30_000
+
+// CreatePeriodicPayment registers the payments of amount from the sender to
+// the recipient every period blocks, the first one period blocks after the
+// current one. The executions of the payments are prepaid with
+// PeriodicPaymentExecutionGas gas each. It returns the id of the periodic
+// payment.
+func (k BaseKeeper) CreatePeriodicPayment(ctx context.Context, sender, recipient sdk.AccAddress, amount sdk.Coins, period, payments uint64) (uint64, error) {
+	if !amount.IsValid() || amount.IsZero() {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if period < MinPeriodicPaymentPeriod || period > uint64(math.MaxInt64-height) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period %d, must be at least %d", period, MinPeriodicPaymentPeriod)
+	}
+	if payments == 0 || payments > math.MaxUint64/PeriodicPaymentExecutionGas {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid number of payments %d", payments)
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return 0, err
+	}
+
+	senderStr, err := k.ak.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+	recipientStr, err := k.ak.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.BlockedAddr(recipient) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientStr)
+	}
+
+	var sent int
+	err = k.PeriodicPayments.Indexes.Sender.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](sender), func(_ sdk.AccAddress, _ uint64) (bool, error) {
+		sent++
+		return sent >= MaxPeriodicPaymentsPerSender, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if sent >= MaxPeriodicPaymentsPerSender {
+		return 0, errorsmod.Wrapf(types.ErrTooManyPeriodicPayments, "%s already sends %d periodic payments", senderStr, MaxPeriodicPaymentsPerSender)
+	}
+
+	if err := k.GasService.GasMeter(ctx).Consume(payments*PeriodicPaymentExecutionGas, "periodic payment executions"); err != nil {
+		return 0, err
+	}
+
+	id, err := k.PeriodicPaymentSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.PeriodicPayments.Set(ctx, id, types.PeriodicPayment{
+		Id:                id,
+		Sender:            senderStr,
+		Recipient:         recipientStr,
+		Amount:            amount,
+		Period:            period,
+		NextHeight:        height + int64(period),
+		RemainingPayments: payments,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreatePeriodicPayment,
+		event.NewAttribute(types.AttributeKeyPeriodicPaymentID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, senderStr),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// CancelPeriodicPayment cancels a periodic payment, only its sender can cancel
+// it.
+func (k BaseKeeper) CancelPeriodicPayment(ctx context.Context, sender sdk.AccAddress, id uint64) error {
+	payment, err := k.GetPeriodicPayment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	senderStr, err := k.ak.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	if payment.Sender != senderStr {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "periodic payment %d is not sent by %s", id, senderStr)
+	}
+
+	if err := k.PeriodicPayments.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCancelPeriodicPayment,
+		event.NewAttribute(types.AttributeKeyPeriodicPaymentID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, senderStr),
+	)
+}
+
+// GetPeriodicPayment returns the periodic payment with the given id.
+func (k BaseKeeper) GetPeriodicPayment(ctx context.Context, id uint64) (types.PeriodicPayment, error) {
+	payment, err := k.PeriodicPayments.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PeriodicPayment{}, errorsmod.Wrapf(types.ErrPeriodicPaymentNotFound, "id %d", id)
+		}
+		return types.PeriodicPayment{}, err
+	}
+
+	return payment, nil
+}
+
+// ExecutePeriodicPayments executes the periodic payments due at the current
+// height, at most MaxPeriodicPaymentsPerBlock of them. A payment failing, e.g.
+// because the sender lacks funds, is skipped and the next one is still
+// scheduled, unless MaxPeriodicPaymentFailures payments failed in a row, in
+// which case the periodic payment is cancelled.
+func (k BaseKeeper) ExecutePeriodicPayments(ctx context.Context) error {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+
+	var ids []uint64
+	err := k.PeriodicPayments.Indexes.NextHeight.Walk(ctx, collections.NewPrefixUntilPairRange[int64, uint64](height), func(_ int64, id uint64) (bool, error) {
+		ids = append(ids, id)
+		return len(ids) >= MaxPeriodicPaymentsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		payment, err := k.PeriodicPayments.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := k.executePeriodicPayment(ctx, height, payment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executePeriodicPayment executes a periodic payment and schedules the next
+// one, if any.
+func (k BaseKeeper) executePeriodicPayment(ctx context.Context, height int64, payment types.PeriodicPayment) error {
+	sender, err := k.ak.AddressCodec().StringToBytes(payment.Sender)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.ak.AddressCodec().StringToBytes(payment.Recipient)
+	if err != nil {
+		return err
+	}
+
+	idStr := strconv.FormatUint(payment.Id, 10)
+	sendErr := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		if err := k.IsSendEnabledCoins(ctx, payment.Amount...); err != nil {
+			return err
+		}
+		if k.BlockedAddr(recipient) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", payment.Recipient)
+		}
+
+		return k.SendCoins(ctx, sender, recipient, payment.Amount)
+	})
+	if sendErr != nil {
+		err = k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypePeriodicPaymentFailed,
+			event.NewAttribute(types.AttributeKeyPeriodicPaymentID, idStr),
+			event.NewAttribute(types.AttributeKeyError, sendErr.Error()),
+		)
+	} else {
+		err = k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypePeriodicPayment,
+			event.NewAttribute(types.AttributeKeyPeriodicPaymentID, idStr),
+			event.NewAttribute(types.AttributeKeySender, payment.Sender),
+			event.NewAttribute(types.AttributeKeyRecipient, payment.Recipient),
+			event.NewAttribute(sdk.AttributeKeyAmount, payment.Amount.String()),
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	if sendErr != nil {
+		payment.ConsecutiveFailures++
+		if payment.ConsecutiveFailures >= MaxPeriodicPaymentFailures {
+			if err := k.PeriodicPayments.Remove(ctx, payment.Id); err != nil {
+				return err
+			}
+
+			return k.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeCancelPeriodicPayment,
+				event.NewAttribute(types.AttributeKeyPeriodicPaymentID, idStr),
+				event.NewAttribute(types.AttributeKeySender, payment.Sender),
+			)
+		}
+	} else {
+		payment.ConsecutiveFailures = 0
+		payment.RemainingPayments--
+		if payment.RemainingPayments == 0 {
+			return k.PeriodicPayments.Remove(ctx, payment.Id)
+		}
+	}
+
+	// the payment may be late if more payments than MaxPeriodicPaymentsPerBlock
+	// were due, the next one is scheduled from the current height.
+	if payment.Period > uint64(math.MaxInt64-height) {
+		return k.PeriodicPayments.Remove(ctx, payment.Id)
+	}
+	payment.NextHeight = height + int64(payment.Period)
+
+	return k.PeriodicPayments.Set(ctx, payment.Id, payment)
+}
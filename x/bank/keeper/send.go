@@ -25,6 +25,8 @@ type SendKeeper interface {
 	PrependSendRestriction(restriction types.SendRestrictionFn)
 	ClearSendRestriction()
 
+	SetHooks(hooks types.SendHooks)
+
 	InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -67,6 +69,11 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	sendHooks       *sendHooks
+
+	// moduleDenomRestrictions maps module account addresses to the denoms they
+	// can or can't receive, it is set by the BaseKeeper options
+	moduleDenomRestrictions map[string]*moduleDenomRestriction
 }
 
 func NewBaseSendKeeper(
@@ -88,6 +95,7 @@ func NewBaseSendKeeper(
 		blockedAddrs:    blockedAddrs,
 		authority:       authority,
 		sendRestriction: newSendRestriction(),
+		sendHooks:       &sendHooks{},
 	}
 }
 
@@ -106,6 +114,16 @@ func (k BaseSendKeeper) ClearSendRestriction() {
 	k.sendRestriction.clear()
 }
 
+// SetHooks sets the send hooks called before every transfer. It panics if
+// the hooks are already set.
+func (k BaseSendKeeper) SetHooks(hooks types.SendHooks) {
+	if k.sendHooks.hooks != nil {
+		panic("cannot set send hooks twice")
+	}
+
+	k.sendHooks.hooks = hooks
+}
+
 // GetAuthority returns the x/bank module's authority.
 func (k BaseSendKeeper) GetAuthority() string {
 	return k.authority
@@ -143,21 +161,54 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		return err
 	}
 
+	return k.multiSendCoins(ctx, input, outputs, nil)
+}
+
+// multiSendCoins debits the input once and then credits the outputs one by one.
+// Each output is decoded, validated against what is left of the input, checked
+// with checkRecipient (if any) and credited in a single pass, so that sending to
+// many outputs doesn't require a separate validation pass.
+//
+// CONTRACT: on error some outputs may already be credited, the caller must run
+// it on a cached context (as done for messages) or validate the input and
+// outputs beforehand.
+func (k BaseSendKeeper) multiSendCoins(
+	ctx context.Context,
+	input types.Input,
+	outputs []types.Output,
+	checkRecipient func(address string, addr sdk.AccAddress) error,
+) error {
 	inAddress, err := k.ak.AddressCodec().StringToBytes(input.Address)
 	if err != nil {
-		return err
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid input address: %s", err)
+	}
+	if !input.Coins.IsValid() || !input.Coins.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, input.Coins.String())
 	}
 
-	err = k.subUnlockedCoins(ctx, inAddress, input.Coins)
-	if err != nil {
+	if err := k.subUnlockedCoins(ctx, inAddress, input.Coins); err != nil {
 		return err
 	}
 
-	var outAddress sdk.AccAddress
+	remaining := input.Coins
 	for _, out := range outputs {
-		outAddress, err = k.ak.AddressCodec().StringToBytes(out.Address)
+		outAddress, err := k.ak.AddressCodec().StringToBytes(out.Address)
 		if err != nil {
-			return err
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid output address: %s", err)
+		}
+		if !out.Coins.IsValid() || !out.Coins.IsAllPositive() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, out.Coins.String())
+		}
+
+		var hasNeg bool
+		if remaining, hasNeg = remaining.SafeSub(out.Coins...); hasNeg {
+			return types.ErrInputOutputMismatch
+		}
+
+		if checkRecipient != nil {
+			if err := checkRecipient(out.Address, outAddress); err != nil {
+				return err
+			}
 		}
 
 		outAddress, err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
@@ -165,6 +216,14 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		if err := k.checkModuleDenomRestrictions(outAddress, out.Coins); err != nil {
+			return err
+		}
+
+		if err := k.sendHooks.beforeSend(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
@@ -178,6 +237,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		}
 	}
 
+	if !remaining.IsZero() {
+		return types.ErrInputOutputMismatch
+	}
+
 	return nil
 }
 
@@ -194,6 +257,14 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	if err := k.checkModuleDenomRestrictions(toAddr, amt); err != nil {
+		return err
+	}
+
+	if err := k.sendHooks.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -487,3 +558,22 @@ func (r *sendRestriction) apply(ctx context.Context, fromAddr, toAddr sdk.AccAdd
 	}
 	return r.fn(ctx, fromAddr, toAddr, amt)
 }
+
+// sendHooks is a struct that houses the SendHooks.
+// It exists so that the SendHooks can be set in the SendKeeper without needing to have a pointer receiver.
+type sendHooks struct {
+	hooks types.SendHooks
+}
+
+// beforeSend calls the send hooks, if any, before a transfer. The transfer
+// must be aborted if an error is returned.
+func (h *sendHooks) beforeSend(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if h == nil || h.hooks == nil {
+		return nil
+	}
+	if err := h.hooks.BlockBeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	h.hooks.TrackBeforeSend(ctx, fromAddr, toAddr, amt)
+	return nil
+}
@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -53,6 +56,66 @@ func (b BalancesIndexes) IndexesList() []collections.Index[collections.Pair[sdk.
 	return []collections.Index[collections.Pair[sdk.AccAddress, string], math.Int]{b.Denom}
 }
 
+func newPeriodicPaymentsIndexes(sb *collections.SchemaBuilder, ac address.Codec) PeriodicPaymentsIndexes {
+	return PeriodicPaymentsIndexes{
+		Sender: indexes.NewMulti(
+			sb, types.PeriodicPaymentsBySenderPrefix, "periodic_payments_by_sender",
+			sdk.AccAddressKey, collections.Uint64Key,
+			func(_ uint64, payment types.PeriodicPayment) (sdk.AccAddress, error) {
+				return ac.StringToBytes(payment.Sender)
+			},
+		),
+		NextHeight: indexes.NewMulti(
+			sb, types.PeriodicPaymentsByNextHeightPrefix, "periodic_payments_by_next_height",
+			collections.Int64Key, collections.Uint64Key,
+			func(_ uint64, payment types.PeriodicPayment) (int64, error) {
+				return payment.NextHeight, nil
+			},
+		),
+	}
+}
+
+// PeriodicPaymentsIndexes defines the indexes of the periodic payments, by
+// sender and by the height of their next payment.
+type PeriodicPaymentsIndexes struct {
+	Sender     *indexes.Multi[sdk.AccAddress, uint64, types.PeriodicPayment]
+	NextHeight *indexes.Multi[int64, uint64, types.PeriodicPayment]
+}
+
+func (i PeriodicPaymentsIndexes) IndexesList() []collections.Index[uint64, types.PeriodicPayment] {
+	return []collections.Index[uint64, types.PeriodicPayment]{i.Sender, i.NextHeight}
+}
+
+func newHTLCsIndexes(sb *collections.SchemaBuilder, ac address.Codec) HTLCsIndexes {
+	return HTLCsIndexes{
+		Sender: indexes.NewMulti(
+			sb, types.HTLCsBySenderPrefix, "htlcs_by_sender",
+			sdk.AccAddressKey, collections.Uint64Key,
+			func(_ uint64, htlc types.HTLC) (sdk.AccAddress, error) {
+				return ac.StringToBytes(htlc.Sender)
+			},
+		),
+		Expiration: indexes.NewMulti(
+			sb, types.HTLCsByExpirationPrefix, "htlcs_by_expiration",
+			sdk.TimeKey, collections.Uint64Key,
+			func(_ uint64, htlc types.HTLC) (time.Time, error) {
+				return htlc.ExpirationTime, nil
+			},
+		),
+	}
+}
+
+// HTLCsIndexes defines the indexes of the hashed timelock transfers, by
+// sender and by expiration time.
+type HTLCsIndexes struct {
+	Sender     *indexes.Multi[sdk.AccAddress, uint64, types.HTLC]
+	Expiration *indexes.Multi[time.Time, uint64, types.HTLC]
+}
+
+func (i HTLCsIndexes) IndexesList() []collections.Index[uint64, types.HTLC] {
+	return []collections.Index[uint64, types.HTLC]{i.Sender, i.Expiration}
+}
+
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
 	appmodule.Environment
@@ -66,6 +129,14 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	Escrows       collections.Map[collections.Pair[string, string], types.Escrow]
+
+	PeriodicPaymentSeq collections.Sequence
+	PeriodicPayments   *collections.IndexedMap[uint64, types.PeriodicPayment, PeriodicPaymentsIndexes]
+
+	HTLCSeq          collections.Sequence
+	HTLCs            *collections.IndexedMap[uint64, types.HTLC, HTLCsIndexes]
+	HTLCRefundCursor collections.Item[collections.Pair[time.Time, uint64]]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -80,6 +151,17 @@ func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak type
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Escrows:       collections.NewMap(sb, types.EscrowsPrefix, "escrows", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.Escrow](cdc)),
+
+		PeriodicPaymentSeq: collections.NewSequence(sb, types.PeriodicPaymentSeqKey, "periodic_payment_seq"),
+		PeriodicPayments:   collections.NewIndexedMap(sb, types.PeriodicPaymentsPrefix, "periodic_payments", collections.Uint64Key, codec.CollValue[types.PeriodicPayment](cdc), newPeriodicPaymentsIndexes(sb, ak.AddressCodec())),
+
+		HTLCSeq: collections.NewSequence(sb, types.HTLCSeqKey, "htlc_seq"),
+		HTLCs:   collections.NewIndexedMap(sb, types.HTLCsPrefix, "htlcs", collections.Uint64Key, codec.CollValue[types.HTLC](cdc), newHTLCsIndexes(sb, ak.AddressCodec())),
+		HTLCRefundCursor: collections.NewItem(
+			sb, types.HTLCRefundCursorKey, "htlc_refund_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -106,6 +188,12 @@ func (k BaseViewKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress)
 	return balances.Sort()
 }
 
+// CanPruneAccount implements the x/auth AccountPruneGuard interface, an account
+// can be pruned if it holds no balance.
+func (k BaseViewKeeper) CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.GetAllBalances(ctx, addr).IsZero(), nil
+}
+
 // GetAccountsBalances returns all the accounts balances from the store.
 func (k BaseViewKeeper) GetAccountsBalances(ctx context.Context) []types.Balance {
 	balances := make([]types.Balance, 0)
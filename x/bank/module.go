@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -32,12 +33,14 @@ var (
 	_ module.HasGRPCGateway      = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasInvariants       = AppModule{}
+	_ module.HasHealthCheck      = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasServices           = AppModule{}
 	_ appmodule.HasMigrations         = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
 	_ appmodule.HasRegisterInterfaces = AppModule{}
+	_ appmodule.HasEndBlocker         = AppModule{}
 )
 
 // AppModule implements an application module for the bank module.
@@ -117,6 +120,23 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
 }
 
+// HealthCheck implements module.HasHealthCheck, it checks the supply of a
+// sample of denoms against their balances.
+func (am AppModule) HealthCheck(ctx context.Context) error {
+	return am.keeper.(keeper.BaseKeeper).HealthCheck(ctx)
+}
+
+// EndBlock executes the periodic payments due at the current block height and
+// refunds the expired hashed timelock transfers.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	k := am.keeper.(keeper.BaseKeeper)
+	if err := k.ExecutePeriodicPayments(ctx); err != nil {
+		return err
+	}
+
+	return k.RefundExpiredHTLCs(ctx)
+}
+
 // DefaultGenesis returns default genesis state as raw bytes for the bank module.
 func (am AppModule) DefaultGenesis() json.RawMessage {
 	return am.cdc.MustMarshalJSON(types.DefaultGenesisState())
@@ -157,6 +177,21 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 // AppModuleSimulation functions
 
+// InitGenesisStream performs genesis initialization for the bank module from
+// the genesis state JSON read from r, without loading the balances in memory.
+// It is an alternative to InitGenesis for genesis files with millions of
+// accounts.
+func (am AppModule) InitGenesisStream(ctx context.Context, r io.Reader) error {
+	return am.keeper.InitGenesisStream(ctx, am.cdc, r)
+}
+
+// ExportGenesisStream writes the exported genesis state as JSON to w, without
+// building the balances in memory. It is an alternative to ExportGenesis for
+// chains with millions of accounts.
+func (am AppModule) ExportGenesisStream(ctx context.Context, w io.Writer) error {
+	return am.keeper.ExportGenesisStream(ctx, am.cdc, w)
+}
+
 // GenerateGenesisState creates a randomized GenState of the bank module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
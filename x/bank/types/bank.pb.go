@@ -12,15 +12,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -443,6 +447,289 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// Escrow holds coins escrowed by a module on behalf of a depositor, until they
+// are released to a recipient or refunded to the depositor. The escrowed coins
+// are held by the module account.
+type Escrow struct {
+	// module is the name of the module account holding the escrowed coins.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// id is the identifier of the escrow, unique within the module.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// depositor is the address of the account the coins were escrowed from.
+	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the escrowed coins.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *Escrow) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Escrow) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *Escrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// PeriodicPayment defines a recurring send of coins from a sender to a
+// recipient, executed at the end of the block every period blocks until the
+// sender cancels it or the requested number of payments is reached.
+type PeriodicPayment struct {
+	// id is the unique identifier of the periodic payment.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account paying.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account paid.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of each payment.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// period is the number of blocks between two payments.
+	Period uint64 `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`
+	// next_height is the height of the block at the end of which the next
+	// payment is executed.
+	NextHeight int64 `protobuf:"varint,6,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// remaining_payments is the number of payments left.
+	RemainingPayments uint64 `protobuf:"varint,7,opt,name=remaining_payments,json=remainingPayments,proto3" json:"remaining_payments,omitempty"`
+	// consecutive_failures is the number of payments which failed in a row, the
+	// periodic payment being cancelled once it reaches the maximum.
+	ConsecutiveFailures uint64 `protobuf:"varint,8,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+}
+
+func (m *PeriodicPayment) Reset()         { *m = PeriodicPayment{} }
+func (m *PeriodicPayment) String() string { return proto.CompactTextString(m) }
+func (*PeriodicPayment) ProtoMessage()    {}
+func (*PeriodicPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *PeriodicPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicPayment.Merge(m, src)
+}
+func (m *PeriodicPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicPayment proto.InternalMessageInfo
+
+func (m *PeriodicPayment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PeriodicPayment) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PeriodicPayment) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PeriodicPayment) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PeriodicPayment) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicPayment) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *PeriodicPayment) GetRemainingPayments() uint64 {
+	if m != nil {
+		return m.RemainingPayments
+	}
+	return 0
+}
+
+func (m *PeriodicPayment) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+// HTLC defines a hashed timelock transfer: coins locked by a sender which the
+// recipient receives once the preimage of the hash lock is revealed, or which
+// are refunded to the sender once the expiration time is reached.
+type HTLC struct {
+	// id is the unique identifier of the HTLC.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account which locked the coins.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account receiving the coins when the HTLC
+	// is claimed.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of coins locked.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// hash_lock is the SHA-256 hash of the preimage unlocking the coins.
+	HashLock []byte `protobuf:"bytes,5,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	// expiration_time is the time from which the HTLC can no longer be claimed
+	// and the coins are refunded to the sender.
+	ExpirationTime time.Time `protobuf:"bytes,6,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+}
+
+func (m *HTLC) Reset()         { *m = HTLC{} }
+func (m *HTLC) String() string { return proto.CompactTextString(m) }
+func (*HTLC) ProtoMessage()    {}
+func (*HTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{9}
+}
+func (m *HTLC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLC.Merge(m, src)
+}
+func (m *HTLC) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTLC proto.InternalMessageInfo
+
+func (m *HTLC) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HTLC) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *HTLC) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *HTLC) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *HTLC) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
+}
+
+func (m *HTLC) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -451,57 +738,79 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*Escrow)(nil), "cosmos.bank.v1beta1.Escrow")
+	proto.RegisterType((*PeriodicPayment)(nil), "cosmos.bank.v1beta1.PeriodicPayment")
+	proto.RegisterType((*HTLC)(nil), "cosmos.bank.v1beta1.HTLC")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x4b,
-	0x10, 0xf7, 0xfa, 0x3b, 0xeb, 0x3c, 0x3d, 0xbd, 0x8d, 0xa5, 0x77, 0xf1, 0xd3, 0x3b, 0x5b, 0x6e,
-	0x30, 0x06, 0xdb, 0xf9, 0x40, 0x48, 0xb8, 0x41, 0x38, 0x7c, 0xb9, 0x40, 0xa0, 0x8b, 0x22, 0x24,
-	0x1a, 0x6b, 0xed, 0x5b, 0xec, 0x55, 0xee, 0x76, 0x4f, 0xb7, 0x7b, 0x21, 0x6e, 0xa9, 0x10, 0x15,
-	0x35, 0x55, 0x44, 0x85, 0x10, 0x85, 0x8b, 0xf4, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5, 0x08, 0xc8,
-	0x29, 0x9c, 0x3f, 0x03, 0xdd, 0xde, 0x9d, 0xed, 0x08, 0xa7, 0x45, 0xa2, 0xb1, 0x77, 0xe6, 0xf7,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x2d, 0xd4, 0x7b, 0x5c, 0xd8, 0x5c, 0x34, 0xba, 0x98, 0xed, 0x36,
-	0xf6, 0xd6, 0xbb, 0x44, 0xe2, 0x75, 0x65, 0xd4, 0x1d, 0x97, 0x4b, 0x8e, 0x56, 0x02, 0xbc, 0xae,
-	0x5c, 0x21, 0x5e, 0xc8, 0xf7, 0x79, 0x9f, 0x2b, 0xbc, 0xe1, 0x9f, 0x02, 0x6a, 0x61, 0x35, 0xa0,
-	0x76, 0x02, 0x20, 0x8c, 0x0b, 0xa0, 0x59, 0x15, 0x41, 0xa6, 0x55, 0x7a, 0x9c, 0xb2, 0x10, 0xff,
-	0x37, 0xc4, 0x6d, 0xd1, 0x6f, 0xec, 0xad, 0xfb, 0x7f, 0x21, 0xf0, 0x0f, 0xb6, 0x29, 0xe3, 0x0d,
-	0xf5, 0x1b, 0xb8, 0xca, 0x1f, 0x00, 0x4c, 0x3f, 0xc3, 0x2e, 0xb6, 0x05, 0x7a, 0x04, 0x97, 0x05,
-	0x61, 0x66, 0x87, 0x30, 0xdc, 0xb5, 0x88, 0xa9, 0x81, 0x52, 0xa2, 0x92, 0xdb, 0x28, 0xd5, 0x17,
-	0x68, 0xae, 0x6f, 0x13, 0x66, 0x3e, 0x08, 0x78, 0xad, 0xb8, 0x06, 0x8c, 0x9c, 0x98, 0x39, 0xd0,
-	0x1a, 0xcc, 0x9b, 0xe4, 0x25, 0xf6, 0x2c, 0xd9, 0xb9, 0x94, 0x30, 0x5e, 0x02, 0x95, 0xac, 0x81,
-	0x42, 0x6c, 0x2e, 0x45, 0xf3, 0xff, 0xb7, 0x93, 0x51, 0x55, 0x0b, 0x0a, 0xd5, 0x84, 0xb9, 0xdb,
-	0xd8, 0x0f, 0x46, 0x18, 0x28, 0x2b, 0x6f, 0xc1, 0xdc, 0x1c, 0x1b, 0xe5, 0x61, 0xca, 0x24, 0x8c,
-	0xdb, 0x1a, 0x28, 0x81, 0xca, 0x92, 0x11, 0x18, 0x48, 0x83, 0x99, 0xcb, 0x85, 0x22, 0xb3, 0x99,
-	0xbc, 0x38, 0x28, 0x82, 0xf2, 0x31, 0x80, 0xa9, 0x36, 0x73, 0x3c, 0x89, 0x36, 0x60, 0x06, 0x9b,
-	0xa6, 0x4b, 0x84, 0x08, 0x32, 0xb4, 0xb4, 0xaf, 0x87, 0xb5, 0x7c, 0xd8, 0xe6, 0xbd, 0x00, 0xd9,
-	0x96, 0x2e, 0x65, 0x7d, 0x23, 0x22, 0xa2, 0x57, 0x30, 0xe5, 0x4f, 0x58, 0x68, 0x71, 0x35, 0x95,
-	0xd5, 0xd9, 0x54, 0x04, 0x99, 0x4e, 0x65, 0x8b, 0x53, 0xd6, 0x7a, 0x78, 0x74, 0x56, 0x8c, 0x7d,
-	0xfa, 0x5e, 0xac, 0xf4, 0xa9, 0x1c, 0x78, 0xdd, 0x7a, 0x8f, 0xdb, 0xe1, 0xf5, 0x35, 0xe6, 0x1a,
-	0x94, 0x43, 0x87, 0x08, 0x15, 0x20, 0xde, 0x4f, 0x46, 0xd5, 0x65, 0x8b, 0xf4, 0x71, 0x6f, 0xd8,
-	0x51, 0x35, 0x3e, 0x4e, 0x46, 0x55, 0x60, 0x04, 0xf5, 0x9a, 0xf9, 0x37, 0x07, 0xc5, 0xd8, 0xc5,
-	0x41, 0x31, 0xf6, 0x7a, 0x32, 0xaa, 0x46, 0x72, 0xca, 0x5f, 0x00, 0x4c, 0x3f, 0xf5, 0xe4, 0x1f,
-	0xd7, 0x4d, 0x36, 0xea, 0xa6, 0xfc, 0x19, 0xc0, 0xf4, 0xb6, 0xe7, 0x38, 0xd6, 0xd0, 0x57, 0x23,
-	0xb9, 0xc4, 0x56, 0xb8, 0x71, 0xbf, 0x43, 0x8d, 0xaa, 0xd7, 0xbc, 0x1e, 0xaa, 0x01, 0xc7, 0x87,
-	0xb5, 0xff, 0x16, 0xae, 0xb9, 0x12, 0xd8, 0xd6, 0x40, 0xf9, 0x39, 0x5c, 0xba, 0xef, 0xaf, 0xd9,
-	0x0e, 0xa3, 0xf2, 0x8a, 0x05, 0x2c, 0xc0, 0x2c, 0xd9, 0x77, 0x38, 0x23, 0x4c, 0xaa, 0x0d, 0xfc,
-	0xcb, 0x98, 0xda, 0xfe, 0x72, 0x62, 0x8b, 0x62, 0x41, 0x84, 0x96, 0x28, 0x25, 0x2a, 0x4b, 0x46,
-	0x64, 0x96, 0x4f, 0xe3, 0x30, 0xfb, 0x84, 0x48, 0x6c, 0x62, 0x89, 0x51, 0x09, 0xe6, 0x4c, 0x22,
-	0x7a, 0x2e, 0x75, 0x24, 0xe5, 0x2c, 0x4c, 0x3f, 0xef, 0x42, 0x77, 0x7d, 0x06, 0xe3, 0x76, 0xc7,
-	0x63, 0x54, 0x46, 0xf7, 0xa7, 0x2f, 0xfc, 0x46, 0xa7, 0x7a, 0x0d, 0x68, 0x46, 0x47, 0x81, 0x10,
-	0x4c, 0xfa, 0x73, 0xd5, 0x12, 0x2a, 0xb7, 0x3a, 0xfb, 0xea, 0x4c, 0x2a, 0x1c, 0x0b, 0x0f, 0xb5,
-	0xa4, 0x72, 0x47, 0x26, 0xba, 0x06, 0x93, 0x0c, 0xdb, 0x44, 0x4b, 0xa9, 0xcd, 0x5a, 0x39, 0x3d,
-	0xac, 0xfd, 0x3d, 0x1b, 0x74, 0x69, 0xad, 0x7e, 0x6b, 0xd3, 0x50, 0x04, 0x74, 0x03, 0xa6, 0xc5,
-	0xd0, 0xee, 0x72, 0x4b, 0x4b, 0x5f, 0x4d, 0x0d, 0x29, 0xe8, 0x26, 0x4c, 0x78, 0x2e, 0xd5, 0x32,
-	0x8a, 0x59, 0x18, 0x9f, 0x15, 0x13, 0x3b, 0x46, 0xfb, 0xd7, 0x80, 0xdb, 0x86, 0x4f, 0x43, 0x77,
-	0x60, 0xd6, 0x73, 0x69, 0x67, 0x80, 0xc5, 0x40, 0xcb, 0xaa, 0x10, 0x7d, 0x7c, 0x56, 0xcc, 0xec,
-	0x18, 0xed, 0xc7, 0x58, 0x0c, 0x16, 0x85, 0x65, 0x3c, 0x97, 0xfa, 0x58, 0x6b, 0xf3, 0x68, 0xac,
-	0x83, 0x93, 0xb1, 0x0e, 0x7e, 0x8c, 0x75, 0xf0, 0xee, 0x5c, 0x8f, 0x9d, 0x9c, 0xeb, 0xb1, 0x6f,
-	0xe7, 0x7a, 0xec, 0x45, 0xf8, 0xbc, 0x0a, 0x73, 0xb7, 0x4e, 0x79, 0xf4, 0xdc, 0xa8, 0xc5, 0xe9,
-	0xa6, 0xd5, 0xcb, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x68, 0x6d, 0x04, 0xcd, 0x05,
-	0x00, 0x00,
+	// 1012 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x16, 0x25, 0x59, 0x96, 0x4e, 0x76, 0x0c, 0x9f, 0x85, 0x96, 0x71, 0x50, 0x49, 0xd0, 0x52,
+	0xd7, 0xad, 0x28, 0x7f, 0x14, 0x01, 0xea, 0xa5, 0xa8, 0xdd, 0xa4, 0x36, 0x90, 0xa2, 0x06, 0x1d,
+	0xa3, 0x40, 0x17, 0xe2, 0x44, 0x9e, 0xa9, 0x83, 0xc9, 0x3b, 0x82, 0x77, 0x74, 0xac, 0xb5, 0x53,
+	0xd1, 0x29, 0x73, 0x27, 0xa3, 0x53, 0x51, 0x74, 0xf0, 0xe0, 0xbd, 0x6b, 0x90, 0x29, 0xc8, 0x54,
+	0x64, 0x70, 0x0a, 0x7b, 0x70, 0x7e, 0x41, 0xd7, 0x16, 0xf7, 0x41, 0xc9, 0x41, 0x15, 0x78, 0x4b,
+	0x51, 0x64, 0x91, 0xf8, 0xbe, 0xcf, 0x73, 0xf7, 0x7e, 0x3d, 0x77, 0x38, 0xd0, 0xf4, 0x19, 0x8f,
+	0x19, 0xef, 0xf5, 0x11, 0x3d, 0xec, 0x1d, 0xad, 0xf6, 0xb1, 0x40, 0xab, 0xca, 0x70, 0x92, 0x94,
+	0x09, 0x06, 0x17, 0x34, 0xee, 0x28, 0x97, 0xc1, 0x17, 0x1b, 0x21, 0x0b, 0x99, 0xc2, 0x7b, 0xf2,
+	0x4b, 0x53, 0x17, 0x6f, 0x6b, 0xaa, 0xa7, 0x01, 0xb3, 0x4e, 0x43, 0xe3, 0x28, 0x1c, 0x8f, 0xa2,
+	0xf8, 0x8c, 0x50, 0x83, 0xbf, 0x6f, 0xf0, 0x98, 0x87, 0xbd, 0xa3, 0x55, 0xf9, 0x67, 0x80, 0x79,
+	0x14, 0x13, 0xca, 0x7a, 0xea, 0xd7, 0xb8, 0x5a, 0x21, 0x63, 0x61, 0x84, 0x7b, 0xca, 0xea, 0x67,
+	0x07, 0x3d, 0x41, 0x62, 0xcc, 0x05, 0x8a, 0x13, 0x4d, 0xe8, 0xfc, 0x6c, 0x81, 0xca, 0x2e, 0x4a,
+	0x51, 0xcc, 0xe1, 0x57, 0x60, 0x86, 0x63, 0x1a, 0x78, 0x98, 0xa2, 0x7e, 0x84, 0x03, 0xdb, 0x6a,
+	0x97, 0x96, 0xea, 0x6b, 0x6d, 0x67, 0x42, 0x51, 0xce, 0x1e, 0xa6, 0xc1, 0x3d, 0xcd, 0xdb, 0x2c,
+	0xda, 0x96, 0x5b, 0xe7, 0x63, 0x07, 0x5c, 0x01, 0x8d, 0x00, 0x1f, 0xa0, 0x2c, 0x12, 0xde, 0x6b,
+	0x1b, 0x16, 0xdb, 0xd6, 0x52, 0xd5, 0x85, 0x06, 0xbb, 0xb6, 0xc5, 0xc6, 0x07, 0x3f, 0x5e, 0x9d,
+	0x2e, 0xdb, 0x3a, 0x50, 0x97, 0x07, 0x87, 0xbd, 0x63, 0xdd, 0x63, 0x9d, 0x59, 0x67, 0x0b, 0xd4,
+	0xaf, 0xb1, 0x61, 0x03, 0x4c, 0x05, 0x98, 0xb2, 0xd8, 0xb6, 0xda, 0xd6, 0x52, 0xcd, 0xd5, 0x06,
+	0xb4, 0xc1, 0xf4, 0xeb, 0x81, 0x72, 0x73, 0xa3, 0xfc, 0xea, 0xa4, 0x65, 0x75, 0x9e, 0x5a, 0x60,
+	0x6a, 0x87, 0x26, 0x99, 0x80, 0x6b, 0x60, 0x1a, 0x05, 0x41, 0x8a, 0x39, 0xd7, 0x3b, 0x6c, 0xda,
+	0xcf, 0xcf, 0xba, 0x0d, 0x53, 0xe6, 0x17, 0x1a, 0xd9, 0x13, 0x29, 0xa1, 0xa1, 0x9b, 0x13, 0xe1,
+	0x23, 0x30, 0x25, 0x47, 0xc0, 0xed, 0xa2, 0xea, 0xca, 0xed, 0x71, 0x57, 0x38, 0x1e, 0x75, 0x65,
+	0x8b, 0x11, 0xba, 0x79, 0xff, 0xc9, 0x79, 0xab, 0xf0, 0xeb, 0xcb, 0xd6, 0x52, 0x48, 0xc4, 0x20,
+	0xeb, 0x3b, 0x3e, 0x8b, 0xcd, 0x7c, 0x7b, 0xd7, 0x0a, 0x14, 0xc3, 0x04, 0x73, 0xb5, 0x80, 0xff,
+	0x74, 0x75, 0xba, 0x3c, 0x13, 0xe1, 0x10, 0xf9, 0x43, 0x4f, 0xc5, 0xf8, 0xe5, 0xea, 0x74, 0xd9,
+	0x72, 0x75, 0xbc, 0x8d, 0xc6, 0x0f, 0x27, 0xad, 0xc2, 0xab, 0x93, 0x56, 0xe1, 0xfb, 0xab, 0xd3,
+	0xe5, 0x3c, 0x9d, 0xce, 0xef, 0x16, 0xa8, 0x7c, 0x93, 0x89, 0xff, 0x5d, 0x35, 0xd5, 0xbc, 0x9a,
+	0xce, 0x6f, 0x16, 0xa8, 0xec, 0x65, 0x49, 0x12, 0x0d, 0x65, 0x36, 0x82, 0x09, 0x14, 0xd9, 0xd6,
+	0x5b, 0xcb, 0x46, 0xc5, 0xdb, 0xf8, 0xc8, 0x64, 0x63, 0x3d, 0x3d, 0xeb, 0xde, 0x99, 0x28, 0x73,
+	0x95, 0xe0, 0x8e, 0x6d, 0x75, 0xbe, 0x05, 0xb5, 0x2f, 0xa5, 0xcc, 0xf6, 0x29, 0x11, 0x6f, 0x10,
+	0xe0, 0x22, 0xa8, 0xe2, 0xe3, 0x84, 0x51, 0x4c, 0x85, 0x52, 0xe0, 0xac, 0x3b, 0xb2, 0xa5, 0x38,
+	0x51, 0x44, 0x10, 0xc7, 0xdc, 0x2e, 0xb5, 0x4b, 0x4b, 0x35, 0x37, 0x37, 0x3b, 0x2f, 0x8a, 0xa0,
+	0xfa, 0x35, 0x16, 0x28, 0x40, 0x02, 0xc1, 0x36, 0xa8, 0x07, 0x98, 0xfb, 0x29, 0x49, 0x04, 0x61,
+	0xd4, 0x6c, 0x7f, 0xdd, 0x05, 0x3f, 0x97, 0x0c, 0xca, 0x62, 0x2f, 0xa3, 0x44, 0xe4, 0xf3, 0x6b,
+	0x4e, 0x3c, 0xa3, 0xa3, 0x7c, 0x5d, 0x10, 0xe4, 0x9f, 0x1c, 0x42, 0x50, 0x96, 0x7d, 0xb5, 0x4b,
+	0x6a, 0x6f, 0xf5, 0x2d, 0xb3, 0x0b, 0x08, 0x4f, 0x22, 0x34, 0xb4, 0xcb, 0xca, 0x9d, 0x9b, 0xf0,
+	0x43, 0x50, 0xa6, 0x28, 0xc6, 0xf6, 0x94, 0x52, 0xd6, 0xc2, 0x8b, 0xb3, 0xee, 0xdc, 0xb8, 0xd1,
+	0xed, 0x15, 0xe7, 0xd3, 0x75, 0x57, 0x11, 0xe0, 0xc7, 0xa0, 0xc2, 0x87, 0x71, 0x9f, 0x45, 0x76,
+	0xe5, 0xcd, 0x54, 0x43, 0x81, 0x9f, 0x80, 0x52, 0x96, 0x12, 0x7b, 0x5a, 0x31, 0x17, 0x2f, 0xce,
+	0x5b, 0xa5, 0x7d, 0x77, 0xe7, 0xdf, 0x0b, 0xee, 0xba, 0x92, 0x06, 0x3f, 0x03, 0xd5, 0x2c, 0x25,
+	0xde, 0x00, 0xf1, 0x81, 0x5d, 0x55, 0x4b, 0x9a, 0x17, 0xe7, 0xad, 0xe9, 0x7d, 0x77, 0x67, 0x1b,
+	0xf1, 0xc1, 0xa4, 0x65, 0xd3, 0x59, 0x4a, 0x24, 0xd6, 0xf9, 0xcb, 0x02, 0x95, 0x7b, 0xdc, 0x4f,
+	0xd9, 0x23, 0xf8, 0x1e, 0xa8, 0xc4, 0x2c, 0xc8, 0x22, 0x6c, 0xba, 0x6a, 0x2c, 0x78, 0x0b, 0x14,
+	0x89, 0xbe, 0x31, 0x6a, 0x6e, 0x91, 0x04, 0xf0, 0x2e, 0xa8, 0x05, 0x38, 0x61, 0x9c, 0x08, 0x96,
+	0xda, 0xa5, 0x1b, 0x0e, 0xd4, 0x98, 0x0a, 0x87, 0xa0, 0x82, 0x62, 0x96, 0x51, 0x61, 0x97, 0xdf,
+	0x96, 0x8a, 0x4d, 0xc0, 0x8d, 0xf9, 0xe7, 0x67, 0xdd, 0x59, 0x7d, 0x63, 0xb6, 0x8f, 0x56, 0x9d,
+	0x15, 0x67, 0xa5, 0x73, 0x56, 0x02, 0x73, 0xbb, 0x38, 0x25, 0x2c, 0x20, 0xfe, 0x2e, 0x1a, 0xc6,
+	0x52, 0x83, 0xba, 0x52, 0x59, 0x7d, 0x59, 0x55, 0xba, 0x02, 0x2a, 0xf2, 0x7a, 0xc6, 0xa9, 0x5d,
+	0xbc, 0xa1, 0x4c, 0xc3, 0x93, 0xbd, 0x49, 0xb1, 0x4f, 0x12, 0x22, 0x25, 0x7e, 0x63, 0x6f, 0x46,
+	0xd4, 0xff, 0xb0, 0x37, 0x72, 0xec, 0x89, 0xea, 0x83, 0x92, 0x70, 0xd9, 0x35, 0x16, 0x6c, 0x81,
+	0x3a, 0xc5, 0xc7, 0xc2, 0x1b, 0x60, 0x12, 0x0e, 0x84, 0x12, 0x6d, 0xc9, 0x05, 0xd2, 0xb5, 0xad,
+	0x3c, 0xb0, 0x0b, 0x60, 0x8a, 0x63, 0x44, 0x28, 0xa1, 0xa1, 0x97, 0xe8, 0x16, 0x72, 0x25, 0xd9,
+	0xb2, 0x3b, 0x3f, 0x42, 0x4c, 0x6f, 0x39, 0x5c, 0x05, 0x0d, 0x9f, 0x51, 0x8e, 0xfd, 0x4c, 0x90,
+	0x23, 0xec, 0x1d, 0x20, 0x12, 0x65, 0x29, 0xe6, 0x4a, 0xb0, 0x65, 0x77, 0xe1, 0x1a, 0x76, 0xdf,
+	0x40, 0x93, 0xc6, 0xf6, 0x77, 0x11, 0x94, 0xb7, 0x1f, 0x3e, 0xd8, 0x7a, 0x37, 0x67, 0x75, 0x07,
+	0xd4, 0xe4, 0x21, 0xf7, 0x22, 0xe6, 0x1f, 0xaa, 0x71, 0xcd, 0xb8, 0x55, 0xe9, 0x78, 0xc0, 0xfc,
+	0x43, 0xe8, 0x82, 0x39, 0x7c, 0x9c, 0x90, 0x14, 0xc9, 0x6b, 0xd0, 0x93, 0xcf, 0x18, 0x35, 0xb4,
+	0xfa, 0xda, 0xa2, 0xa3, 0xdf, 0x38, 0x4e, 0xfe, 0xc6, 0x71, 0x1e, 0xe6, 0x6f, 0x9c, 0xcd, 0x59,
+	0x99, 0xe1, 0xe3, 0x97, 0x2d, 0x4b, 0x07, 0xba, 0x35, 0xde, 0x41, 0x72, 0x26, 0x4c, 0x60, 0x73,
+	0xfd, 0xc9, 0x45, 0xd3, 0x7a, 0x76, 0xd1, 0xb4, 0xfe, 0xbc, 0x68, 0x5a, 0x8f, 0x2f, 0x9b, 0x85,
+	0x67, 0x97, 0xcd, 0xc2, 0x1f, 0x97, 0xcd, 0xc2, 0x77, 0xe6, 0xc5, 0xc6, 0x83, 0x43, 0x87, 0xb0,
+	0xfc, 0x81, 0xa2, 0x8a, 0xeb, 0x57, 0x54, 0xe8, 0xf5, 0x7f, 0x06, 0x00, 0x8f, 0xcb, 0xd1, 0x04,
+	0x20, 0x0a, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -902,73 +1211,278 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBank(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SendEnabled) > 0 {
-		for _, e := range m.SendEnabled {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.DefaultSendEnabled {
-		n += 2
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SendEnabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PeriodicPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Enabled {
-		n += 2
+	if m.RemainingPayments != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.RemainingPayments))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NextHeight != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if m.Period != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Output) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HTLC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTLC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTLC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBank(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DefaultSendEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *SendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1065,6 +1579,103 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeriodicPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovBank(uint64(m.Period))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovBank(uint64(m.NextHeight))
+	}
+	if m.RemainingPayments != 0 {
+		n += 1 + sovBank(uint64(m.RemainingPayments))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovBank(uint64(m.ConsecutiveFailures))
+	}
+	return n
+}
+
+func (m *HTLC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2034,6 +2645,663 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPayments", wireType)
+			}
+			m.RemainingPayments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingPayments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTLC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTLC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTLC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashLock = append(m.HashLock[:0], dAtA[iNdEx:postIndex]...)
+			if m.HashLock == nil {
+				m.HashLock = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,12 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDenomMetadata{}, "cosmos-sdk/MsgUpdateDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgCreatePeriodicPayment{}, "cosmos-sdk/MsgCreatePeriodicPayment")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPeriodicPayment{}, "cosmos-sdk/MsgCancelPeriodicPayment")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateHTLC{}, "cosmos-sdk/MsgCreateHTLC")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimHTLC{}, "cosmos-sdk/MsgClaimHTLC")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundHTLC{}, "cosmos-sdk/MsgRefundHTLC")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization")
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params")
@@ -28,6 +34,12 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgUpdateDenomMetadata{},
+		&MsgCreatePeriodicPayment{},
+		&MsgCancelPeriodicPayment{},
+		&MsgCreateHTLC{},
+		&MsgClaimHTLC{},
+		&MsgRefundHTLC{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -4,13 +4,22 @@ import "cosmossdk.io/errors"
 
 // x/bank module sentinel errors
 var (
-	ErrNoInputs              = errors.Register(ModuleName, 2, "no inputs to send transaction")
-	ErrNoOutputs             = errors.Register(ModuleName, 3, "no outputs to send transaction")
-	ErrInputOutputMismatch   = errors.Register(ModuleName, 4, "sum inputs != sum outputs")
-	ErrSendDisabled          = errors.Register(ModuleName, 5, "send transactions are disabled")
-	ErrDenomMetadataNotFound = errors.Register(ModuleName, 6, "client denom metadata not found")
-	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
-	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
-	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
-	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrNoInputs                = errors.Register(ModuleName, 2, "no inputs to send transaction")
+	ErrNoOutputs               = errors.Register(ModuleName, 3, "no outputs to send transaction")
+	ErrInputOutputMismatch     = errors.Register(ModuleName, 4, "sum inputs != sum outputs")
+	ErrSendDisabled            = errors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrDenomMetadataNotFound   = errors.Register(ModuleName, 6, "client denom metadata not found")
+	ErrInvalidKey              = errors.Register(ModuleName, 7, "invalid key")
+	ErrDuplicateEntry          = errors.Register(ModuleName, 8, "duplicate entry")
+	ErrMultipleSenders         = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrInvalidSigner           = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrModuleDenomRestricted   = errors.Register(ModuleName, 11, "denom restricted for module account")
+	ErrEscrowNotFound          = errors.Register(ModuleName, 12, "escrow not found")
+	ErrEscrowExists            = errors.Register(ModuleName, 13, "escrow already exists")
+	ErrPeriodicPaymentNotFound = errors.Register(ModuleName, 14, "periodic payment not found")
+	ErrHTLCNotFound            = errors.Register(ModuleName, 15, "htlc not found")
+	ErrHTLCExpired             = errors.Register(ModuleName, 16, "htlc expired")
+	ErrHTLCNotExpired          = errors.Register(ModuleName, 17, "htlc not expired")
+	ErrInvalidPreimage         = errors.Register(ModuleName, 18, "invalid htlc preimage")
+	ErrTooManyPeriodicPayments = errors.Register(ModuleName, 19, "too many periodic payments")
 )
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the escrow.
+func (e Escrow) Validate() error {
+	if e.Module == "" {
+		return errors.New("escrow module cannot be empty")
+	}
+
+	if e.Id == "" {
+		return errors.New("escrow id cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(e.Depositor); err != nil {
+		return err
+	}
+
+	if e.Amount.Empty() {
+		return errors.New("escrow amount cannot be empty")
+	}
+
+	return e.Amount.Validate()
+}
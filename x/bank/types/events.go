@@ -21,4 +21,33 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// escrow events name and attributes
+	EventTypeEscrow        = "escrow"
+	EventTypeReleaseEscrow = "release_escrow"
+	EventTypeRefundEscrow  = "refund_escrow"
+
+	AttributeKeyEscrowID  = "escrow_id"
+	AttributeKeyDepositor = "depositor"
+
+	// periodic payments events name and attributes
+	EventTypeCreatePeriodicPayment = "create_periodic_payment"
+	EventTypeCancelPeriodicPayment = "cancel_periodic_payment"
+	EventTypePeriodicPayment       = "periodic_payment"
+	EventTypePeriodicPaymentFailed = "periodic_payment_failed"
+
+	AttributeKeyPeriodicPaymentID = "periodic_payment_id"
+	AttributeKeyError             = "error"
+
+	// hashed timelock transfers events name and attributes
+	EventTypeCreateHTLC = "create_htlc"
+	EventTypeClaimHTLC  = "claim_htlc"
+	EventTypeRefundHTLC = "refund_htlc"
+	// EventTypeRefundHTLCFailed is emitted when the refund of an expired htlc fails at the end of a block.
+	EventTypeRefundHTLCFailed = "refund_htlc_failed"
+
+	AttributeKeyHTLCID         = "htlc_id"
+	AttributeKeyHashLock       = "hash_lock"
+	AttributeKeyPreimage       = "preimage"
+	AttributeKeyExpirationTime = "expiration_time"
 )
@@ -62,6 +62,54 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenEscrows := make(map[[2]string]bool)
+	for _, escrow := range gs.Escrows {
+		key := [2]string{escrow.Module, escrow.Id}
+		if seenEscrows[key] {
+			return fmt.Errorf("duplicate escrow %s of module %s", escrow.Id, escrow.Module)
+		}
+
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+
+		seenEscrows[key] = true
+	}
+
+	seenPeriodicPayments := make(map[uint64]bool)
+	for _, payment := range gs.PeriodicPayments {
+		if seenPeriodicPayments[payment.Id] {
+			return fmt.Errorf("duplicate periodic payment %d", payment.Id)
+		}
+
+		if err := payment.Validate(); err != nil {
+			return err
+		}
+
+		if payment.Id >= gs.PeriodicPaymentSeq {
+			return fmt.Errorf("periodic payment id %d must be lower than the periodic payment sequence %d", payment.Id, gs.PeriodicPaymentSeq)
+		}
+
+		seenPeriodicPayments[payment.Id] = true
+	}
+
+	seenHTLCs := make(map[uint64]bool)
+	for _, htlc := range gs.Htlcs {
+		if seenHTLCs[htlc.Id] {
+			return fmt.Errorf("duplicate htlc %d", htlc.Id)
+		}
+
+		if err := htlc.Validate(); err != nil {
+			return err
+		}
+
+		if htlc.Id >= gs.HtlcSeq {
+			return fmt.Errorf("htlc id %d must be lower than the htlc sequence %d", htlc.Id, gs.HtlcSeq)
+		}
+
+		seenHTLCs[htlc.Id] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -40,6 +40,16 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// escrows is the list of pending escrows.
+	Escrows []Escrow `protobuf:"bytes,6,rep,name=escrows,proto3" json:"escrows"`
+	// periodic_payments is the list of active periodic payments.
+	PeriodicPayments []PeriodicPayment `protobuf:"bytes,7,rep,name=periodic_payments,json=periodicPayments,proto3" json:"periodic_payments"`
+	// periodic_payment_seq is the id of the next periodic payment.
+	PeriodicPaymentSeq uint64 `protobuf:"varint,8,opt,name=periodic_payment_seq,json=periodicPaymentSeq,proto3" json:"periodic_payment_seq,omitempty"`
+	// htlcs is the list of pending hashed timelock transfers.
+	Htlcs []HTLC `protobuf:"bytes,9,rep,name=htlcs,proto3" json:"htlcs"`
+	// htlc_seq is the id of the next hashed timelock transfer.
+	HtlcSeq uint64 `protobuf:"varint,10,opt,name=htlc_seq,json=htlcSeq,proto3" json:"htlc_seq,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +120,41 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeriodicPayments() []PeriodicPayment {
+	if m != nil {
+		return m.PeriodicPayments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeriodicPaymentSeq() uint64 {
+	if m != nil {
+		return m.PeriodicPaymentSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHtlcs() []HTLC {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHtlcSeq() uint64 {
+	if m != nil {
+		return m.HtlcSeq
+	}
+	return 0
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -160,37 +205,46 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0xfe, 0x49, 0xd2, 0x6b, 0x0b, 0xf4, 0xe8, 0xe0, 0x96, 0xe2, 0x98, 0x8a, 0x21,
+	0xaa, 0x88, 0x9d, 0xa4, 0x48, 0x48, 0x0c, 0x48, 0x38, 0x2a, 0x20, 0x01, 0xa2, 0x24, 0x4c, 0x2c,
+	0xd6, 0xd9, 0x3e, 0xb9, 0x56, 0xe2, 0x3b, 0xc7, 0xe7, 0xb6, 0xe4, 0x1b, 0x30, 0x32, 0x33, 0x75,
+	0x44, 0x4c, 0x1d, 0xfa, 0x01, 0x18, 0x18, 0x3a, 0x56, 0x4c, 0x88, 0x01, 0x50, 0x32, 0x94, 0x8f,
+	0x81, 0x7c, 0x77, 0xf9, 0x43, 0x9a, 0x76, 0x63, 0x49, 0xac, 0xbc, 0xcf, 0xfb, 0x7b, 0x9e, 0xf7,
+	0xf2, 0xfa, 0xc0, 0x1d, 0x8f, 0xb2, 0x88, 0x32, 0xcb, 0x45, 0xa4, 0x65, 0x1d, 0x54, 0x5d, 0x9c,
+	0xa2, 0xaa, 0x15, 0x60, 0x82, 0x59, 0xc8, 0xcc, 0x38, 0xa1, 0x29, 0x85, 0x37, 0x85, 0xc4, 0xcc,
+	0x24, 0xa6, 0x94, 0xac, 0xaf, 0x06, 0x34, 0xa0, 0xbc, 0x6e, 0x65, 0x4f, 0x42, 0xba, 0xae, 0x0f,
+	0x69, 0x0c, 0x0f, 0x69, 0x1e, 0x0d, 0xc9, 0x85, 0xfa, 0x98, 0x1b, 0xe7, 0x8a, 0xfa, 0x9a, 0xa8,
+	0x3b, 0x02, 0x2c, 0x7d, 0x45, 0x69, 0x05, 0x45, 0x21, 0xa1, 0x16, 0xff, 0x14, 0x3f, 0x6d, 0x7e,
+	0xcd, 0x81, 0xa5, 0xa7, 0x22, 0x6a, 0x33, 0x45, 0x29, 0x86, 0x8f, 0x40, 0x2e, 0x46, 0x09, 0x8a,
+	0x98, 0xa6, 0x1a, 0x6a, 0x69, 0xb1, 0x76, 0xcb, 0x9c, 0x12, 0xdd, 0xdc, 0xe5, 0x12, 0x7b, 0xe1,
+	0xf4, 0x67, 0x51, 0xf9, 0x74, 0x7e, 0xbc, 0xa5, 0x36, 0x64, 0x17, 0xac, 0x83, 0x82, 0x8b, 0xda,
+	0x88, 0x78, 0x98, 0x69, 0x33, 0xc6, 0x6c, 0x69, 0xb1, 0xb6, 0x31, 0x95, 0x60, 0x0b, 0xd1, 0x38,
+	0x62, 0xd8, 0x08, 0xbb, 0x20, 0xc7, 0xf6, 0xe3, 0xb8, 0xdd, 0xd5, 0x66, 0x39, 0x62, 0x6d, 0x84,
+	0x60, 0x78, 0x88, 0xa8, 0xd3, 0x90, 0xd8, 0x4f, 0xb2, 0xfe, 0xcf, 0xbf, 0x8a, 0xa5, 0x20, 0x4c,
+	0xf7, 0xf6, 0x5d, 0xd3, 0xa3, 0x91, 0x1c, 0x5a, 0x7e, 0x95, 0x99, 0xdf, 0xb2, 0xd2, 0x6e, 0x8c,
+	0x19, 0x6f, 0x60, 0x1f, 0xcf, 0x8f, 0xb7, 0x96, 0xda, 0x38, 0x40, 0x5e, 0xd7, 0xc9, 0x8e, 0x95,
+	0xc9, 0xfc, 0xc2, 0x10, 0xbe, 0x02, 0xd7, 0x7c, 0x4c, 0x68, 0xe4, 0x44, 0x38, 0x45, 0x3e, 0x4a,
+	0x91, 0x36, 0xc7, 0x23, 0xdc, 0x9e, 0x3a, 0xc5, 0x4b, 0x29, 0x1a, 0x1f, 0x63, 0x99, 0xf7, 0x0f,
+	0x2a, 0x10, 0x81, 0x25, 0x86, 0x89, 0xef, 0x60, 0x82, 0xdc, 0x36, 0xf6, 0xb5, 0x79, 0x8e, 0x33,
+	0xa6, 0xe2, 0x9a, 0x98, 0xf8, 0x3b, 0x42, 0x67, 0x6f, 0x64, 0xc4, 0x1f, 0x27, 0xe5, 0xeb, 0xa3,
+	0x31, 0x8c, 0x8a, 0x79, 0xff, 0x81, 0x30, 0x59, 0x64, 0x23, 0x29, 0x7c, 0x0d, 0xf2, 0x98, 0x79,
+	0x09, 0x3d, 0x64, 0x5a, 0xce, 0x98, 0xbd, 0xf4, 0x4f, 0xdb, 0xe1, 0x1a, 0x7b, 0x5d, 0x82, 0x97,
+	0xdf, 0xf1, 0xb5, 0x31, 0x0e, 0xaa, 0x66, 0xc5, 0xac, 0x08, 0xec, 0x80, 0x03, 0x23, 0xb0, 0x12,
+	0xe3, 0x24, 0xa4, 0x7e, 0xe8, 0x39, 0x31, 0xea, 0x46, 0x98, 0xa4, 0x4c, 0xcb, 0x73, 0xf8, 0xdd,
+	0xe9, 0x1b, 0x21, 0xd5, 0xbb, 0x42, 0x7c, 0xa5, 0xcb, 0x8d, 0xf8, 0x5f, 0x71, 0xb6, 0x35, 0xab,
+	0x93, 0x76, 0x0e, 0xc3, 0x1d, 0xad, 0x60, 0xa8, 0xa5, 0x39, 0x7b, 0xe5, 0x02, 0xa7, 0x01, 0x27,
+	0x10, 0x4d, 0xdc, 0x81, 0xcf, 0xc1, 0xfc, 0x5e, 0xda, 0xf6, 0x98, 0xb6, 0x30, 0xb9, 0x34, 0x63,
+	0x39, 0x9f, 0xbd, 0x79, 0x51, 0xbf, 0x32, 0x9c, 0x60, 0xc0, 0x7b, 0xa0, 0x90, 0x3d, 0xf0, 0x14,
+	0xe0, 0xb2, 0x14, 0xf9, 0x4c, 0xd2, 0xc4, 0x9d, 0xcd, 0x2f, 0x2a, 0xc8, 0xcb, 0x8d, 0x86, 0x35,
+	0x90, 0x47, 0xbe, 0x9f, 0x60, 0x26, 0x5e, 0xa1, 0x05, 0x5b, 0xfb, 0x76, 0x52, 0x5e, 0x95, 0x59,
+	0x1e, 0x8b, 0x4a, 0x33, 0x4d, 0x42, 0x12, 0x34, 0x06, 0x42, 0x78, 0x08, 0xe6, 0xf9, 0x2e, 0x6a,
+	0x33, 0x93, 0xd1, 0xff, 0xd7, 0xbe, 0x0b, 0xbf, 0x87, 0x85, 0xf7, 0x47, 0x45, 0xe5, 0xcf, 0x51,
+	0x51, 0xb1, 0xb7, 0x4f, 0x7b, 0xba, 0x7a, 0xd6, 0xd3, 0xd5, 0xdf, 0x3d, 0x5d, 0xfd, 0xd0, 0xd7,
+	0x95, 0xb3, 0xbe, 0xae, 0x7c, 0xef, 0xeb, 0xca, 0x5b, 0x79, 0xa3, 0x30, 0xbf, 0x65, 0x86, 0xd4,
+	0x12, 0x27, 0x20, 0x1c, 0xdc, 0x1c, 0xbf, 0x45, 0xb6, 0xff, 0x0e, 0x00, 0x67, 0xa0, 0x41, 0x93,
+	0x03, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +267,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HtlcSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HtlcSeq))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Htlcs) > 0 {
+		for iNdEx := len(m.Htlcs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Htlcs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PeriodicPaymentSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PeriodicPaymentSeq))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PeriodicPayments) > 0 {
+		for iNdEx := len(m.PeriodicPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodicPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -369,6 +475,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeriodicPayments) > 0 {
+		for _, e := range m.PeriodicPayments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PeriodicPaymentSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.PeriodicPaymentSeq))
+	}
+	if len(m.Htlcs) > 0 {
+		for _, e := range m.Htlcs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HtlcSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.HtlcSeq))
+	}
 	return n
 }
 
@@ -595,6 +725,146 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodicPayments = append(m.PeriodicPayments, PeriodicPayment{})
+			if err := m.PeriodicPayments[len(m.PeriodicPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicPaymentSeq", wireType)
+			}
+			m.PeriodicPaymentSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodicPaymentSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Htlcs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Htlcs = append(m.Htlcs, HTLC{})
+			if err := m.Htlcs[len(m.Htlcs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HtlcSeq", wireType)
+			}
+			m.HtlcSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HtlcSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -73,6 +74,85 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid escrows",
+			GenesisState{
+				Escrows: []Escrow{
+					{Module: "gov", Id: "1", Depositor: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}},
+					{Module: "group", Id: "1", Depositor: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}},
+				},
+			},
+			false,
+		},
+		{
+			"dup escrows",
+			GenesisState{
+				Escrows: []Escrow{
+					{Module: "gov", Id: "1", Depositor: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}},
+					{Module: "gov", Id: "1", Depositor: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 2)}},
+				},
+			},
+			true,
+		},
+		{
+			"valid periodic payments",
+			GenesisState{
+				PeriodicPayments: []PeriodicPayment{
+					{Id: 1, Sender: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Recipient: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, Period: 10, NextHeight: 5, RemainingPayments: 1},
+				},
+				PeriodicPaymentSeq: 2,
+			},
+			false,
+		},
+		{
+			"periodic payment id not lower than the sequence",
+			GenesisState{
+				PeriodicPayments: []PeriodicPayment{
+					{Id: 1, Sender: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Recipient: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, Period: 10, NextHeight: 5, RemainingPayments: 1},
+				},
+				PeriodicPaymentSeq: 1,
+			},
+			true,
+		},
+		{
+			"valid htlcs",
+			GenesisState{
+				Htlcs: []HTLC{
+					{Id: 1, Sender: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Recipient: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, HashLock: make([]byte, 32), ExpirationTime: time.Unix(1, 0)},
+				},
+				HtlcSeq: 2,
+			},
+			false,
+		},
+		{
+			"htlc with invalid hash lock",
+			GenesisState{
+				Htlcs: []HTLC{
+					{Id: 1, Sender: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Recipient: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, HashLock: []byte("short"), ExpirationTime: time.Unix(1, 0)},
+				},
+				HtlcSeq: 2,
+			},
+			true,
+		},
+		{
+			"periodic payment without period",
+			GenesisState{
+				PeriodicPayments: []PeriodicPayment{
+					{Id: 1, Sender: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Recipient: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.Coins{sdk.NewInt64Coin("uatom", 1)}, NextHeight: 5, RemainingPayments: 1},
+				},
+				PeriodicPaymentSeq: 2,
+			},
+			true,
+		},
+		{
+			"escrow without amount",
+			GenesisState{
+				Escrows: []Escrow{
+					{Module: "gov", Id: "1", Depositor: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+				},
+			},
+			true,
+		},
 		{
 			"0  balance",
 			GenesisState{
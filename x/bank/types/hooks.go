@@ -0,0 +1,58 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendHooks defines the hooks called by the bank keeper before every transfer
+// of coins between two accounts, including multi-sends, module account
+// transfers and delegations. They let other modules (e.g. token factories or
+// compliance modules) observe or veto sends of specific denoms.
+//
+// Hooks are also called for transfers initiated by modules (e.g. reward
+// distribution), so implementations should only block the denoms they manage,
+// an error returned while processing a block fails that block.
+type SendHooks interface {
+	// BlockBeforeSend is called before every transfer, the transfer is aborted
+	// if it returns an error.
+	BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+	// TrackBeforeSend is called before every transfer not blocked by
+	// BlockBeforeSend. It can't fail and is meant for observing transfers.
+	TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins)
+}
+
+// SendHooksWrapper is a wrapper for modules to inject SendHooks using depinject.
+type SendHooksWrapper struct{ SendHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (SendHooksWrapper) IsOnePerModuleType() {}
+
+var _ SendHooks = MultiSendHooks{}
+
+// MultiSendHooks combines multiple send hooks, all hook functions are run in array sequence.
+type MultiSendHooks []SendHooks
+
+// NewMultiSendHooks returns the given send hooks combined into one.
+func NewMultiSendHooks(hooks ...SendHooks) MultiSendHooks {
+	return hooks
+}
+
+// BlockBeforeSend runs the BlockBeforeSend hooks in order, stopping at the first error.
+func (h MultiSendHooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BlockBeforeSend(ctx, from, to, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TrackBeforeSend runs the TrackBeforeSend hooks in order.
+func (h MultiSendHooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	for i := range h {
+		h[i].TrackBeforeSend(ctx, from, to, amount)
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// HTLCAddress is the address holding the coins locked by the hashed timelock
+// transfers until they are claimed or refunded.
+var HTLCAddress = sdk.AccAddress(address.Module(ModuleName, []byte("htlc")))
+
+// ValidateHashLock checks that the hash lock is a SHA-256 hash.
+func ValidateHashLock(hashLock []byte) error {
+	if len(hashLock) != sha256.Size {
+		return fmt.Errorf("hash lock must be %d bytes long, got %d", sha256.Size, len(hashLock))
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the hashed timelock transfer.
+func (h HTLC) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Sender); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(h.Recipient); err != nil {
+		return err
+	}
+
+	if h.Amount.Empty() {
+		return errors.New("htlc amount cannot be empty")
+	}
+
+	if err := h.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateHashLock(h.HashLock); err != nil {
+		return err
+	}
+
+	if h.ExpirationTime.IsZero() {
+		return errors.New("htlc expiration time cannot be zero")
+	}
+
+	return nil
+}
+
+// Unlocks returns true if the preimage unlocks the hashed timelock transfer.
+func (h HTLC) Unlocks(preimage []byte) bool {
+	hash := sha256.Sum256(preimage)
+	return bytes.Equal(hash[:], h.HashLock)
+}
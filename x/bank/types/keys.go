@@ -39,6 +39,30 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// EscrowsPrefix is the prefix for the escrows, keyed by module name and escrow id.
+	EscrowsPrefix = collections.NewPrefix(6)
+
+	// PeriodicPaymentsPrefix is the prefix for the periodic payments, keyed by id.
+	PeriodicPaymentsPrefix = collections.NewPrefix(7)
+	// PeriodicPaymentSeqKey is the key of the sequence of the periodic payment ids.
+	PeriodicPaymentSeqKey = collections.NewPrefix(8)
+	// PeriodicPaymentsBySenderPrefix is the prefix for the index of the periodic payments by sender.
+	PeriodicPaymentsBySenderPrefix = collections.NewPrefix(9)
+	// PeriodicPaymentsByNextHeightPrefix is the prefix for the index of the periodic payments by next payment height.
+	PeriodicPaymentsByNextHeightPrefix = collections.NewPrefix(10)
+
+	// HTLCsPrefix is the prefix for the hashed timelock transfers, keyed by id.
+	HTLCsPrefix = collections.NewPrefix(11)
+	// HTLCSeqKey is the key of the sequence of the hashed timelock transfer ids.
+	HTLCSeqKey = collections.NewPrefix(12)
+	// HTLCsBySenderPrefix is the prefix for the index of the hashed timelock transfers by sender.
+	HTLCsBySenderPrefix = collections.NewPrefix(13)
+	// HTLCsByExpirationPrefix is the prefix for the index of the hashed timelock transfers by expiration time.
+	HTLCsByExpirationPrefix = collections.NewPrefix(14)
+	// HTLCRefundCursorKey is the key of the position in the index of the hashed timelock transfers by expiration time
+	// up to which the expired transfers were refunded, or failed to be.
+	HTLCRefundCursorKey = collections.NewPrefix(15)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
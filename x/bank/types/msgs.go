@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	coretransaction "cosmossdk.io/core/transaction"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +12,12 @@ var (
 	_ coretransaction.Msg = &MsgSend{}
 	_ coretransaction.Msg = &MsgMultiSend{}
 	_ coretransaction.Msg = &MsgUpdateParams{}
+	_ coretransaction.Msg = &MsgUpdateDenomMetadata{}
+	_ coretransaction.Msg = &MsgCreatePeriodicPayment{}
+	_ coretransaction.Msg = &MsgCancelPeriodicPayment{}
+	_ coretransaction.Msg = &MsgCreateHTLC{}
+	_ coretransaction.Msg = &MsgClaimHTLC{}
+	_ coretransaction.Msg = &MsgRefundHTLC{}
 )
 
 // NewMsgSend constructs a msg to send coins from one account to another.
@@ -30,3 +38,61 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgUpdateDenomMetadata constructs a message to set the metadata of a denom.
+func NewMsgUpdateDenomMetadata(authority string, metadata Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		Authority: authority,
+		Metadata:  metadata,
+	}
+}
+
+// NewMsgCreatePeriodicPayment constructs a message to pay the recipient every
+// period blocks, payments times.
+func NewMsgCreatePeriodicPayment(sender, recipient string, amount sdk.Coins, period, payments uint64) *MsgCreatePeriodicPayment {
+	return &MsgCreatePeriodicPayment{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		Period:    period,
+		Payments:  payments,
+	}
+}
+
+// NewMsgCancelPeriodicPayment constructs a message to cancel a periodic payment.
+func NewMsgCancelPeriodicPayment(sender string, id uint64) *MsgCancelPeriodicPayment {
+	return &MsgCancelPeriodicPayment{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+// NewMsgCreateHTLC constructs a message to lock coins of the sender under the
+// hash lock, until the recipient claims them or the timeout is reached.
+func NewMsgCreateHTLC(sender, recipient string, amount sdk.Coins, hashLock []byte, timeout time.Duration) *MsgCreateHTLC {
+	return &MsgCreateHTLC{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		HashLock:  hashLock,
+		Timeout:   timeout,
+	}
+}
+
+// NewMsgClaimHTLC constructs a message to claim an HTLC with the preimage of
+// its hash lock.
+func NewMsgClaimHTLC(claimer string, id uint64, preimage []byte) *MsgClaimHTLC {
+	return &MsgClaimHTLC{
+		Claimer:  claimer,
+		Id:       id,
+		Preimage: preimage,
+	}
+}
+
+// NewMsgRefundHTLC constructs a message to refund an expired HTLC.
+func NewMsgRefundHTLC(sender string, id uint64) *MsgRefundHTLC {
+	return &MsgRefundHTLC{
+		Sender: sender,
+		Id:     id,
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the periodic payment.
+func (p PeriodicPayment) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Sender); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return err
+	}
+
+	if p.Amount.Empty() {
+		return errors.New("periodic payment amount cannot be empty")
+	}
+
+	if err := p.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if p.Period == 0 {
+		return errors.New("periodic payment period cannot be zero")
+	}
+
+	if p.RemainingPayments == 0 {
+		return errors.New("periodic payment remaining payments cannot be zero")
+	}
+
+	if p.NextHeight <= 0 {
+		return errors.New("periodic payment next height must be positive")
+	}
+
+	return nil
+}
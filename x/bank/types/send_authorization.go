@@ -72,6 +72,12 @@ func (a SendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Accep
 	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft, AllowList: allowedList}}, nil
 }
 
+// RemainingSpendLimit returns the coins which can still be sent with the
+// authorization.
+func (a SendAuthorization) RemainingSpendLimit() sdk.Coins {
+	return a.SpendLimit
+}
+
 // ValidateBasic implements Authorization.ValidateBasic.
 func (a SendAuthorization) ValidateBasic() error {
 	if len(a.SpendLimit) == 0 {
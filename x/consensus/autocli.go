@@ -21,6 +21,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current consensus parameters",
 				},
+				{
+					RpcMethod: "BlockerPanicSkipList",
+					Use:       "blocker-panic-skip-list",
+					Short:     "Query the modules whose begin and end blocker panics are skipped",
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"comet": cmtservice.CometBFTAutoCLIDescriptor,
@@ -42,6 +47,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "UpdateBlockerPanicSkipList",
+					Use:       "update-blocker-panic-skip-list-proposal [module-names]",
+					Short:     "Submit a proposal to set the modules whose begin and end blocker panics are skipped instead of halting the chain",
+					Example:   fmt.Sprintf(`%s tx consensus update-blocker-panic-skip-list-proposal protocolpool,epochs`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "module_names"},
+					},
+					GovProposal: true,
+				},
 			},
 		},
 	}
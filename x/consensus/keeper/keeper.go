@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -17,6 +18,7 @@ import (
 	"cosmossdk.io/x/consensus/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 var StoreKey = "Consensus"
@@ -26,9 +28,14 @@ type Keeper struct {
 
 	authority   string
 	ParamsStore collections.Item[cmtproto.ConsensusParams]
+	// BlockerPanicSkipStore contains the modules whose begin and end blocker panics are skipped
+	BlockerPanicSkipStore collections.KeySet[string]
 }
 
-var _ exported.ConsensusParamSetter = Keeper{}.ParamsStore
+var (
+	_ exported.ConsensusParamSetter = Keeper{}.ParamsStore
+	_ module.BlockerPanicSkipper    = Keeper{}
+)
 
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority string) Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
@@ -36,6 +43,9 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, authority strin
 		Environment: env,
 		authority:   authority,
 		ParamsStore: collections.NewItem(sb, collections.NewPrefix("Consensus"), "params", codec.CollValue[cmtproto.ConsensusParams](cdc)),
+		BlockerPanicSkipStore: collections.NewKeySet(
+			sb, collections.NewPrefix("BlockerPanicSkipList"), "blocker_panic_skip_list", collections.StringKey,
+		),
 	}
 }
 
@@ -57,6 +67,26 @@ func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types
 	return &types.QueryParamsResponse{Params: &params}, nil
 }
 
+// BlockerPanicSkipList queries the modules whose begin and end blocker panics are skipped
+func (k Keeper) BlockerPanicSkipList(ctx context.Context, _ *types.QueryBlockerPanicSkipListRequest) (*types.QueryBlockerPanicSkipListResponse, error) {
+	var moduleNames []string
+	err := k.BlockerPanicSkipStore.Walk(ctx, nil, func(moduleName string) (bool, error) {
+		moduleNames = append(moduleNames, moduleName)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockerPanicSkipListResponse{ModuleNames: moduleNames}, nil
+}
+
+// CanSkipBlockerPanic returns whether the module is on the blocker panic skip list.
+// It implements the module manager BlockerPanicSkipper interface.
+func (k Keeper) CanSkipBlockerPanic(ctx context.Context, moduleName string) (bool, error) {
+	return k.BlockerPanicSkipStore.Has(ctx, moduleName)
+}
+
 // MsgServer
 
 var _ types.MsgServer = Keeper{}
@@ -107,3 +137,34 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateBlockerPanicSkipList overwrites the list of modules whose begin and end
+// blocker panics are skipped. Consensus critical modules cannot be skipped.
+func (k Keeper) UpdateBlockerPanicSkipList(ctx context.Context, msg *types.MsgUpdateBlockerPanicSkipList) (*types.MsgUpdateBlockerPanicSkipListResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, fmt.Errorf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := module.ValidateBlockerPanicSkipList(msg.ModuleNames); err != nil {
+		return nil, err
+	}
+
+	if err := k.BlockerPanicSkipStore.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	for _, moduleName := range msg.ModuleNames {
+		if err := k.BlockerPanicSkipStore.Set(ctx, moduleName); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		"update_blocker_panic_skip_list",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("module_names", strings.Join(msg.ModuleNames, ","))); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateBlockerPanicSkipListResponse{}, nil
+}
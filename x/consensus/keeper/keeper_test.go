@@ -603,3 +603,33 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateBlockerPanicSkipList() {
+	s.SetupTest(false)
+	authority := s.consensusParamsKeeper.GetAuthority()
+
+	_, err := s.consensusParamsKeeper.UpdateBlockerPanicSkipList(s.ctx, &types.MsgUpdateBlockerPanicSkipList{Authority: "invalid", ModuleNames: []string{"epochs"}})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.consensusParamsKeeper.UpdateBlockerPanicSkipList(s.ctx, &types.MsgUpdateBlockerPanicSkipList{Authority: authority, ModuleNames: []string{"staking"}})
+	s.Require().ErrorContains(err, "consensus critical")
+
+	_, err = s.consensusParamsKeeper.UpdateBlockerPanicSkipList(s.ctx, &types.MsgUpdateBlockerPanicSkipList{Authority: authority, ModuleNames: []string{"epochs", "protocolpool"}})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.BlockerPanicSkipList(s.ctx, &types.QueryBlockerPanicSkipListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"epochs", "protocolpool"}, res.ModuleNames)
+
+	skip, err := s.consensusParamsKeeper.CanSkipBlockerPanic(s.ctx, "epochs")
+	s.Require().NoError(err)
+	s.Require().True(skip)
+
+	// the list is overwritten
+	_, err = s.consensusParamsKeeper.UpdateBlockerPanicSkipList(s.ctx, &types.MsgUpdateBlockerPanicSkipList{Authority: authority, ModuleNames: []string{"protocolpool"}})
+	s.Require().NoError(err)
+
+	skip, err = s.consensusParamsKeeper.CanSkipBlockerPanic(s.ctx, "epochs")
+	s.Require().NoError(err)
+	s.Require().False(skip)
+}
@@ -13,6 +13,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations(
 		(*coretransaction.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateBlockerPanicSkipList{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -22,4 +23,5 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/consensus/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateBlockerPanicSkipList{}, "consensus/MsgUpdateBlockerPanicSkipList")
 }
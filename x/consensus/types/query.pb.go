@@ -114,33 +114,123 @@ func (m *QueryParamsResponse) GetParams() *v1.ConsensusParams {
 	return nil
 }
 
+// QueryBlockerPanicSkipListRequest is the request type for the Query/BlockerPanicSkipList RPC method.
+type QueryBlockerPanicSkipListRequest struct {
+}
+
+func (m *QueryBlockerPanicSkipListRequest) Reset()         { *m = QueryBlockerPanicSkipListRequest{} }
+func (m *QueryBlockerPanicSkipListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockerPanicSkipListRequest) ProtoMessage()    {}
+func (*QueryBlockerPanicSkipListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{2}
+}
+func (m *QueryBlockerPanicSkipListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockerPanicSkipListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockerPanicSkipListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockerPanicSkipListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockerPanicSkipListRequest.Merge(m, src)
+}
+func (m *QueryBlockerPanicSkipListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockerPanicSkipListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockerPanicSkipListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockerPanicSkipListRequest proto.InternalMessageInfo
+
+// QueryBlockerPanicSkipListResponse is the response type for the Query/BlockerPanicSkipList RPC method.
+type QueryBlockerPanicSkipListResponse struct {
+	ModuleNames []string `protobuf:"bytes,1,rep,name=module_names,json=moduleNames,proto3" json:"module_names,omitempty"`
+}
+
+func (m *QueryBlockerPanicSkipListResponse) Reset()         { *m = QueryBlockerPanicSkipListResponse{} }
+func (m *QueryBlockerPanicSkipListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockerPanicSkipListResponse) ProtoMessage()    {}
+func (*QueryBlockerPanicSkipListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf54d1e5df04cee9, []int{3}
+}
+func (m *QueryBlockerPanicSkipListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockerPanicSkipListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockerPanicSkipListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockerPanicSkipListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockerPanicSkipListResponse.Merge(m, src)
+}
+func (m *QueryBlockerPanicSkipListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockerPanicSkipListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockerPanicSkipListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockerPanicSkipListResponse proto.InternalMessageInfo
+
+func (m *QueryBlockerPanicSkipListResponse) GetModuleNames() []string {
+	if m != nil {
+		return m.ModuleNames
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.consensus.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.consensus.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlockerPanicSkipListRequest)(nil), "cosmos.consensus.v1.QueryBlockerPanicSkipListRequest")
+	proto.RegisterType((*QueryBlockerPanicSkipListResponse)(nil), "cosmos.consensus.v1.QueryBlockerPanicSkipListResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/query.proto", fileDescriptor_bf54d1e5df04cee9) }
 
 var fileDescriptor_bf54d1e5df04cee9 = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c,
-	0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0x68, 0x91,
-	0x92, 0x4b, 0xce, 0xcf, 0x4d, 0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0xa9, 0x2c, 0x48, 0x05, 0x9b,
-	0x58, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x93, 0x57, 0xc6, 0x66, 0x27, 0xc2, 0x7c, 0xb0, 0x22, 0x25,
-	0x11, 0x2e, 0xa1, 0x40, 0x90, 0x33, 0x02, 0xc0, 0x3a, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b,
-	0x94, 0x02, 0xb9, 0x84, 0x51, 0x44, 0x8b, 0x0b, 0x40, 0xda, 0x84, 0xac, 0xb8, 0xd8, 0x20, 0x36,
-	0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x29, 0xe9, 0xc1, 0x9c, 0xa0, 0x07, 0x76, 0x82, 0x5e,
-	0x99, 0xa1, 0x9e, 0x33, 0xcc, 0x02, 0xa8, 0x5e, 0xa8, 0x0e, 0xa3, 0x2e, 0x46, 0x2e, 0x56, 0xb0,
-	0x99, 0x42, 0x0d, 0x8c, 0x5c, 0x6c, 0x10, 0x49, 0x21, 0x75, 0x3d, 0x2c, 0xde, 0xd6, 0xc3, 0x74,
-	0x90, 0x94, 0x06, 0x61, 0x85, 0x10, 0x37, 0x2a, 0x29, 0x37, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x56,
-	0x48, 0x5a, 0x1f, 0x9b, 0xf7, 0x21, 0x8e, 0x71, 0xb2, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0x39, 0x88, 0xae, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x24,
-	0xdd, 0x60, 0x1f, 0x26, 0xb1, 0x81, 0x83, 0xcd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xb9,
-	0xf1, 0x10, 0xd1, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6f, 0x1a, 0x31,
+	0x18, 0xc7, 0x39, 0xaa, 0x22, 0xd5, 0x74, 0x32, 0x0c, 0xd5, 0xb5, 0x75, 0xe1, 0x18, 0xca, 0x50,
+	0xd9, 0x82, 0xbe, 0xa8, 0xea, 0x48, 0xa5, 0x4c, 0x51, 0x04, 0x64, 0xcb, 0x72, 0x3a, 0x0e, 0x07,
+	0x59, 0xf7, 0x62, 0x73, 0xf6, 0xa1, 0xb0, 0x45, 0xf9, 0x04, 0x91, 0xf2, 0x71, 0xf2, 0x05, 0x18,
+	0x91, 0xb2, 0x64, 0x8c, 0x20, 0x1f, 0x24, 0x3a, 0xfb, 0x2e, 0x2f, 0xca, 0x85, 0x28, 0xeb, 0xe3,
+	0xff, 0xcb, 0xef, 0x79, 0x64, 0xf0, 0xcd, 0xe7, 0x32, 0xe2, 0x92, 0xf8, 0x3c, 0x96, 0x34, 0x96,
+	0xa9, 0x24, 0x8b, 0x1e, 0x99, 0xa7, 0x34, 0x59, 0x62, 0x91, 0x70, 0xc5, 0x61, 0xc3, 0x08, 0xf0,
+	0xbd, 0x00, 0x2f, 0x7a, 0xf6, 0x97, 0x19, 0xe7, 0xb3, 0x90, 0x12, 0x4f, 0x30, 0xe2, 0xc5, 0x31,
+	0x57, 0x9e, 0x62, 0x3c, 0x96, 0xc6, 0x62, 0x23, 0x9f, 0x47, 0x54, 0x4d, 0x8e, 0x15, 0x51, 0x4b,
+	0x41, 0x75, 0xa2, 0xf0, 0x12, 0x2f, 0x2a, 0xde, 0x3b, 0x65, 0x9d, 0x0f, 0xf9, 0x5a, 0xe4, 0x34,
+	0x01, 0x1c, 0x65, 0x18, 0x43, 0xed, 0x1c, 0xd3, 0x79, 0x4a, 0xa5, 0x72, 0x46, 0xa0, 0xf1, 0x64,
+	0x2a, 0x45, 0x66, 0x83, 0xff, 0x40, 0xcd, 0x34, 0x7c, 0xb2, 0x5a, 0x56, 0xb7, 0xde, 0x77, 0x70,
+	0x81, 0x80, 0x35, 0x02, 0x5e, 0xf4, 0xf0, 0xff, 0xa2, 0x20, 0xf7, 0xe6, 0x0e, 0xc7, 0x01, 0x2d,
+	0x1d, 0x39, 0x08, 0xb9, 0x1f, 0xd0, 0x64, 0xe8, 0xc5, 0xcc, 0x3f, 0x0c, 0x98, 0xd8, 0x67, 0x52,
+	0x15, 0xb5, 0x7b, 0xa0, 0xbd, 0x43, 0x93, 0x43, 0xb4, 0xc1, 0xc7, 0x88, 0x4f, 0xd3, 0x90, 0xba,
+	0xb1, 0x17, 0xd1, 0x0c, 0xe5, 0x5d, 0xf7, 0xc3, 0xb8, 0x6e, 0x66, 0x07, 0xd9, 0xa8, 0xbf, 0xaa,
+	0x82, 0xf7, 0x3a, 0x08, 0x9e, 0x5a, 0xa0, 0x66, 0x40, 0xe0, 0x77, 0x5c, 0x72, 0x62, 0xfc, 0x7c,
+	0x79, 0xbb, 0xfb, 0xba, 0xd0, 0xa0, 0x38, 0x9d, 0xb3, 0xab, 0xdb, 0x8b, 0xea, 0x57, 0xf8, 0x99,
+	0x94, 0x9d, 0xda, 0x2c, 0x0e, 0x2f, 0x2d, 0xd0, 0x2c, 0x5b, 0x08, 0xfe, 0x7e, 0xb9, 0x67, 0xc7,
+	0x91, 0xec, 0x3f, 0x6f, 0xb5, 0xe5, 0xb0, 0xbf, 0x34, 0x2c, 0x86, 0x3f, 0x4a, 0x61, 0x27, 0xc6,
+	0xea, 0x8a, 0xcc, 0xeb, 0xca, 0x80, 0x09, 0x37, 0x64, 0x52, 0x0d, 0xfe, 0xae, 0x36, 0xc8, 0x5a,
+	0x6f, 0x90, 0x75, 0xb3, 0x41, 0xd6, 0xf9, 0x16, 0x55, 0xd6, 0x5b, 0x54, 0xb9, 0xde, 0xa2, 0xca,
+	0x11, 0x32, 0x31, 0x72, 0x1a, 0x60, 0xc6, 0xc9, 0xc9, 0xa3, 0x38, 0xfd, 0x17, 0x26, 0x35, 0xfd,
+	0xc1, 0x7e, 0xde, 0x0d, 0x00, 0x42, 0x39, 0x28, 0xd0, 0xfb, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +247,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of x/consensus module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlockerPanicSkipList returns the modules whose begin and end blocker panics are skipped.
+	BlockerPanicSkipList(ctx context.Context, in *QueryBlockerPanicSkipListRequest, opts ...grpc.CallOption) (*QueryBlockerPanicSkipListResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +268,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BlockerPanicSkipList(ctx context.Context, in *QueryBlockerPanicSkipListRequest, opts ...grpc.CallOption) (*QueryBlockerPanicSkipListResponse, error) {
+	out := new(QueryBlockerPanicSkipListResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Query/BlockerPanicSkipList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/consensus module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlockerPanicSkipList returns the modules whose begin and end blocker panics are skipped.
+	BlockerPanicSkipList(context.Context, *QueryBlockerPanicSkipListRequest) (*QueryBlockerPanicSkipListResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +292,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) BlockerPanicSkipList(ctx context.Context, req *QueryBlockerPanicSkipListRequest) (*QueryBlockerPanicSkipListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockerPanicSkipList not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +318,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockerPanicSkipList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockerPanicSkipListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockerPanicSkipList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Query/BlockerPanicSkipList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockerPanicSkipList(ctx, req.(*QueryBlockerPanicSkipListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +344,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BlockerPanicSkipList",
+			Handler:    _Query_BlockerPanicSkipList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/query.proto",
@@ -283,6 +411,61 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlockerPanicSkipListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockerPanicSkipListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockerPanicSkipListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockerPanicSkipListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockerPanicSkipListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockerPanicSkipListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleNames) > 0 {
+		for iNdEx := len(m.ModuleNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModuleNames[iNdEx])
+			copy(dAtA[i:], m.ModuleNames[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -316,6 +499,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBlockerPanicSkipListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockerPanicSkipListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleNames) > 0 {
+		for _, s := range m.ModuleNames {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +665,138 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlockerPanicSkipListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockerPanicSkipListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockerPanicSkipListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockerPanicSkipListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockerPanicSkipListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockerPanicSkipListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleNames = append(m.ModuleNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -171,47 +171,150 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateBlockerPanicSkipList is the Msg/UpdateBlockerPanicSkipList request type.
+type MsgUpdateBlockerPanicSkipList struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// module_names specifies the modules whose begin and end blocker panics are
+	// skipped. It overwrites the current list; an empty list restores the
+	// default behavior of halting the chain on any panic.
+	ModuleNames []string `protobuf:"bytes,2,rep,name=module_names,json=moduleNames,proto3" json:"module_names,omitempty"`
+}
+
+func (m *MsgUpdateBlockerPanicSkipList) Reset()         { *m = MsgUpdateBlockerPanicSkipList{} }
+func (m *MsgUpdateBlockerPanicSkipList) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlockerPanicSkipList) ProtoMessage()    {}
+func (*MsgUpdateBlockerPanicSkipList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{2}
+}
+func (m *MsgUpdateBlockerPanicSkipList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockerPanicSkipList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockerPanicSkipList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBlockerPanicSkipList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockerPanicSkipList.Merge(m, src)
+}
+func (m *MsgUpdateBlockerPanicSkipList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlockerPanicSkipList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockerPanicSkipList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBlockerPanicSkipList proto.InternalMessageInfo
+
+func (m *MsgUpdateBlockerPanicSkipList) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateBlockerPanicSkipList) GetModuleNames() []string {
+	if m != nil {
+		return m.ModuleNames
+	}
+	return nil
+}
+
+// MsgUpdateBlockerPanicSkipListResponse defines the response structure for
+// executing a MsgUpdateBlockerPanicSkipList message.
+type MsgUpdateBlockerPanicSkipListResponse struct {
+}
+
+func (m *MsgUpdateBlockerPanicSkipListResponse) Reset()         { *m = MsgUpdateBlockerPanicSkipListResponse{} }
+func (m *MsgUpdateBlockerPanicSkipListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlockerPanicSkipListResponse) ProtoMessage()    {}
+func (*MsgUpdateBlockerPanicSkipListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2135c60575ab504d, []int{3}
+}
+func (m *MsgUpdateBlockerPanicSkipListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockerPanicSkipListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockerPanicSkipListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBlockerPanicSkipListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockerPanicSkipListResponse.Merge(m, src)
+}
+func (m *MsgUpdateBlockerPanicSkipListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlockerPanicSkipListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockerPanicSkipListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBlockerPanicSkipListResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.consensus.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.consensus.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateBlockerPanicSkipList)(nil), "cosmos.consensus.v1.MsgUpdateBlockerPanicSkipList")
+	proto.RegisterType((*MsgUpdateBlockerPanicSkipListResponse)(nil), "cosmos.consensus.v1.MsgUpdateBlockerPanicSkipListResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/consensus/v1/tx.proto", fileDescriptor_2135c60575ab504d) }
 
 var fileDescriptor_2135c60575ab504d = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0xb3, 0x36, 0x69, 0xcd, 0x28, 0x14, 0x27, 0x4a, 0xa7, 0xa1, 0x0e, 0x31, 0x88, 0x94,
-	0x60, 0x66, 0x9b, 0x58, 0xff, 0x82, 0x60, 0x23, 0x8a, 0x1e, 0x8a, 0xb2, 0xa5, 0x1e, 0xbc, 0xc8,
-	0x64, 0x77, 0xba, 0x5d, 0xd2, 0xdd, 0x59, 0x76, 0x26, 0x6b, 0x73, 0x13, 0xc1, 0x8b, 0x27, 0xbf,
-	0x88, 0x90, 0x43, 0x3f, 0x84, 0x78, 0x2a, 0x9e, 0xc4, 0x93, 0x24, 0x87, 0x7c, 0x0d, 0xd9, 0x99,
-	0xdd, 0xac, 0x8d, 0x5b, 0xf0, 0x12, 0xc8, 0x3e, 0xcf, 0xef, 0x79, 0x66, 0xde, 0x7d, 0x17, 0x6c,
-	0xd8, 0x5c, 0xf8, 0x5c, 0x98, 0x36, 0x0f, 0x04, 0x0b, 0xc4, 0x50, 0x98, 0x71, 0xc7, 0x94, 0xc7,
-	0x24, 0x8c, 0xb8, 0xe4, 0xb0, 0xa6, 0x55, 0x32, 0x57, 0x49, 0xdc, 0xa9, 0x5f, 0xa1, 0xbe, 0x17,
-	0x70, 0x53, 0xfd, 0x6a, 0x5f, 0x7d, 0x5d, 0xfb, 0xde, 0xa9, 0x7f, 0x66, 0x0a, 0x69, 0x69, 0x2d,
-	0x2d, 0xf0, 0x85, 0x9b, 0x44, 0xfb, 0xc2, 0x4d, 0x05, 0x6c, 0x73, 0x9f, 0xc9, 0xfe, 0x81, 0x34,
-	0xe5, 0x28, 0x64, 0xaa, 0x37, 0xa4, 0x11, 0xf5, 0x33, 0x70, 0x63, 0xae, 0xd3, 0xbe, 0xed, 0xa9,
-	0x63, 0x25, 0x3e, 0xad, 0x36, 0xbf, 0x96, 0xc1, 0xea, 0xae, 0x70, 0xf7, 0x43, 0x87, 0x4a, 0xf6,
-	0x5a, 0x71, 0xf0, 0x1e, 0xa8, 0xd2, 0xa1, 0x3c, 0xe4, 0x91, 0x27, 0x47, 0xc8, 0x68, 0x18, 0x9b,
-	0xd5, 0x1e, 0xfa, 0x71, 0xd2, 0xbe, 0x9a, 0x9e, 0x67, 0xc7, 0x71, 0x22, 0x26, 0xc4, 0x9e, 0x8c,
-	0xbc, 0xc0, 0xb5, 0x72, 0x2b, 0xdc, 0x06, 0x95, 0xfe, 0x11, 0xb7, 0x07, 0xe8, 0x42, 0xc3, 0xd8,
-	0xbc, 0xd4, 0xc5, 0x24, 0x6b, 0x26, 0xba, 0x31, 0xee, 0x90, 0x5e, 0xa2, 0xeb, 0x1a, 0x4b, 0x9b,
-	0xe1, 0x63, 0x70, 0x91, 0xc5, 0x9e, 0xc3, 0x02, 0x9b, 0xa1, 0x25, 0x05, 0xde, 0x28, 0x00, 0x9f,
-	0xa5, 0x96, 0x94, 0x9d, 0x23, 0xf0, 0x09, 0xa8, 0xc6, 0xf4, 0xc8, 0x73, 0xa8, 0xe4, 0x11, 0x2a,
-	0x2b, 0xbe, 0x59, 0xc0, 0xbf, 0xc9, 0x3c, 0x69, 0x40, 0x0e, 0xc1, 0x17, 0xa0, 0x9c, 0x4c, 0x06,
-	0x55, 0x14, 0x7c, 0xbd, 0x00, 0xde, 0xe9, 0x3d, 0x7d, 0xa9, 0xb9, 0xde, 0xb5, 0x5f, 0x27, 0xed,
-	0x55, 0x3d, 0x88, 0xb6, 0x70, 0x06, 0x8d, 0x2d, 0x72, 0x77, 0x0b, 0x19, 0x96, 0x4a, 0x80, 0xfb,
-	0xa0, 0x2a, 0x46, 0x81, 0x7d, 0x18, 0xf1, 0x60, 0x84, 0x96, 0xcf, 0x3d, 0xcb, 0x5e, 0xe6, 0x49,
-	0x33, 0x6b, 0xff, 0x66, 0x76, 0xac, 0x3c, 0x09, 0xbe, 0x02, 0x2b, 0x07, 0x8c, 0xca, 0x61, 0xc4,
-	0xd0, 0x8a, 0x0a, 0x6d, 0x14, 0x84, 0x3e, 0xd7, 0x8e, 0xf3, 0x23, 0xbb, 0x56, 0x96, 0xf2, 0xe8,
-	0xe1, 0xc7, 0xd9, 0xb8, 0x95, 0xbf, 0xb8, 0xcf, 0xb3, 0x71, 0xeb, 0x56, 0x6e, 0x36, 0x8f, 0xff,
-	0xda, 0xe2, 0x85, 0xdd, 0x68, 0xae, 0x83, 0xb5, 0x85, 0x47, 0x16, 0x13, 0x61, 0x62, 0xef, 0x7e,
-	0x32, 0xc0, 0xd2, 0xae, 0x70, 0xe1, 0x7b, 0x70, 0xf9, 0xcc, 0x3a, 0xdd, 0x24, 0x05, 0xdb, 0x4f,
-	0x16, 0x52, 0xea, 0xb7, 0xff, 0xc7, 0x95, 0x75, 0x35, 0x6b, 0xdf, 0x17, 0xef, 0xb7, 0x7d, 0xbf,
-	0x5e, 0xf9, 0x30, 0x1b, 0xb7, 0x8c, 0xde, 0x83, 0x6f, 0x13, 0x6c, 0x9c, 0x4e, 0xb0, 0xf1, 0x7b,
-	0x82, 0x8d, 0x2f, 0x53, 0x5c, 0x3a, 0x9d, 0xe2, 0xd2, 0xcf, 0x29, 0x2e, 0xbd, 0xc5, 0x9a, 0x10,
-	0xce, 0x80, 0x78, 0xfc, 0xcc, 0x35, 0xd5, 0x18, 0xfb, 0xcb, 0xea, 0x9b, 0xb8, 0xf3, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x6c, 0x14, 0x46, 0x20, 0xcd, 0x03, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0xf9, 0xd3, 0x9a, 0x69, 0xa1, 0xb8, 0x51, 0xba, 0x5d, 0xda, 0x25, 0x0d, 0x6a,
+	0x43, 0x30, 0xbb, 0x4d, 0xac, 0xff, 0x02, 0x82, 0x8d, 0x28, 0x0a, 0x56, 0xcb, 0x86, 0x7a, 0xf0,
+	0x52, 0x26, 0xbb, 0xd3, 0x74, 0x48, 0x76, 0x67, 0xd9, 0x99, 0xc4, 0xe6, 0x26, 0x1e, 0xc5, 0x83,
+	0x07, 0xbf, 0x86, 0x90, 0x43, 0xf1, 0x33, 0x88, 0xa7, 0xe2, 0x49, 0x3c, 0x49, 0x72, 0xc8, 0xd7,
+	0x90, 0x9d, 0xd9, 0x4d, 0x6c, 0xba, 0x0d, 0xea, 0xa5, 0xd0, 0x79, 0x9e, 0xdf, 0xf3, 0xbe, 0xf3,
+	0xce, 0xbb, 0x01, 0x6b, 0x16, 0xa1, 0x0e, 0xa1, 0x86, 0x45, 0x5c, 0x8a, 0x5c, 0xda, 0xa1, 0x46,
+	0xb7, 0x6c, 0xb0, 0x63, 0xdd, 0xf3, 0x09, 0x23, 0x72, 0x56, 0xa8, 0xfa, 0x58, 0xd5, 0xbb, 0x65,
+	0xf5, 0x32, 0x74, 0xb0, 0x4b, 0x0c, 0xfe, 0x57, 0xf8, 0xd4, 0x55, 0xe1, 0x3b, 0xe0, 0xff, 0x19,
+	0x21, 0x24, 0xa4, 0x95, 0xb0, 0x80, 0x43, 0x9b, 0x41, 0xb4, 0x43, 0x9b, 0xa1, 0xa0, 0x59, 0xc4,
+	0x41, 0xac, 0x71, 0xc8, 0x0c, 0xd6, 0xf3, 0x10, 0xaf, 0xeb, 0x41, 0x1f, 0x3a, 0x11, 0xb8, 0x36,
+	0xd6, 0x61, 0xc3, 0xc2, 0xbc, 0xad, 0xc0, 0x27, 0xd4, 0xfc, 0xe7, 0x14, 0x58, 0xde, 0xa5, 0xcd,
+	0x7d, 0xcf, 0x86, 0x0c, 0xed, 0x71, 0x4e, 0xbe, 0x03, 0x32, 0xb0, 0xc3, 0x8e, 0x88, 0x8f, 0x59,
+	0x4f, 0x91, 0x72, 0x52, 0x21, 0x53, 0x53, 0xbe, 0x9f, 0x94, 0xae, 0x84, 0xfd, 0xec, 0xd8, 0xb6,
+	0x8f, 0x28, 0xad, 0x33, 0x1f, 0xbb, 0x4d, 0x73, 0x62, 0x95, 0xb7, 0x41, 0xba, 0xd1, 0x26, 0x56,
+	0x4b, 0x49, 0xe4, 0xa4, 0xc2, 0x62, 0x45, 0xd3, 0xa3, 0xca, 0xba, 0xa8, 0xd8, 0x2d, 0xeb, 0xb5,
+	0x40, 0x17, 0x65, 0x4c, 0x61, 0x96, 0x1f, 0x80, 0x4b, 0xa8, 0x8b, 0x6d, 0xe4, 0x5a, 0x48, 0x49,
+	0x72, 0x70, 0x23, 0x06, 0x7c, 0x1c, 0x5a, 0x42, 0x76, 0x8c, 0xc8, 0x0f, 0x41, 0xa6, 0x0b, 0xdb,
+	0xd8, 0x86, 0x8c, 0xf8, 0x4a, 0x8a, 0xf3, 0xf9, 0x18, 0xfe, 0x55, 0xe4, 0x09, 0x03, 0x26, 0x90,
+	0xfc, 0x14, 0xa4, 0x82, 0xc9, 0x28, 0x69, 0x0e, 0xaf, 0xc7, 0xc0, 0x3b, 0xb5, 0x47, 0xcf, 0x04,
+	0x57, 0xbb, 0xfa, 0xf3, 0xa4, 0xb4, 0x2c, 0x06, 0x51, 0xa2, 0x76, 0x2b, 0xb7, 0xa5, 0xdf, 0xde,
+	0x52, 0x24, 0x93, 0x27, 0xc8, 0xfb, 0x20, 0x43, 0x7b, 0xae, 0x75, 0xe4, 0x13, 0xb7, 0xa7, 0xcc,
+	0x5f, 0xd8, 0x4b, 0x3d, 0xf2, 0x84, 0x99, 0xd9, 0xf3, 0x99, 0x65, 0x73, 0x92, 0x24, 0xbf, 0x04,
+	0x0b, 0x87, 0x08, 0xb2, 0x8e, 0x8f, 0x94, 0x05, 0x1e, 0x9a, 0x8b, 0x09, 0x7d, 0x22, 0x1c, 0x17,
+	0x47, 0x56, 0xcc, 0x28, 0xa5, 0x7a, 0xff, 0xdd, 0xa8, 0x5f, 0x9c, 0x3c, 0xdc, 0xfb, 0x51, 0xbf,
+	0x78, 0x63, 0x62, 0x36, 0x8e, 0xff, 0xd8, 0xe2, 0xa9, 0xdd, 0xc8, 0xaf, 0x82, 0x95, 0xa9, 0x23,
+	0x13, 0x51, 0x2f, 0xb0, 0xe7, 0xbf, 0x48, 0x60, 0x7d, 0xac, 0xf1, 0x87, 0x46, 0xfe, 0x1e, 0x74,
+	0xb1, 0x55, 0x6f, 0x61, 0xef, 0x39, 0xa6, 0xec, 0xbf, 0x17, 0x6b, 0x03, 0x2c, 0x39, 0xc4, 0xee,
+	0xb4, 0xd1, 0x81, 0x0b, 0x1d, 0x44, 0x95, 0x44, 0x2e, 0x59, 0xc8, 0x98, 0x8b, 0xe2, 0xec, 0x45,
+	0x70, 0x54, 0xad, 0x9e, 0xbf, 0xd2, 0x66, 0xcc, 0x2d, 0xe2, 0xda, 0xca, 0x6f, 0x82, 0xeb, 0x33,
+	0x0d, 0xd1, 0x0d, 0x2b, 0x9f, 0x12, 0x20, 0xb9, 0x4b, 0x9b, 0xf2, 0x1b, 0xb0, 0x74, 0xe6, 0x83,
+	0xb9, 0xa6, 0xc7, 0x7c, 0xdf, 0xfa, 0xd4, 0x9c, 0xd4, 0x9b, 0x7f, 0xe3, 0x1a, 0x4f, 0x33, 0xfb,
+	0x6d, 0xfa, 0x05, 0xb7, 0xef, 0xca, 0x1f, 0x24, 0xa0, 0xce, 0x98, 0x6f, 0x65, 0x76, 0x85, 0x38,
+	0x46, 0xad, 0xfe, 0x3b, 0x13, 0xf5, 0xa8, 0xa6, 0xdf, 0x8e, 0xfa, 0x45, 0xa9, 0x76, 0xef, 0xeb,
+	0x40, 0x93, 0x4e, 0x07, 0x9a, 0xf4, 0x6b, 0xa0, 0x49, 0x1f, 0x87, 0xda, 0xdc, 0xe9, 0x50, 0x9b,
+	0xfb, 0x31, 0xd4, 0xe6, 0x5e, 0x6b, 0x22, 0x9b, 0xda, 0x2d, 0x1d, 0x93, 0x33, 0x7b, 0xc5, 0xf7,
+	0xb6, 0x31, 0xcf, 0x7f, 0x84, 0x6e, 0xfd, 0x1e, 0x00, 0xae, 0xda, 0x93, 0x7a, 0x3e, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -229,6 +332,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateBlockerPanicSkipList defines a governance operation for updating the
+	// list of modules whose begin and end blocker panics are skipped instead of
+	// halting the chain.
+	UpdateBlockerPanicSkipList(ctx context.Context, in *MsgUpdateBlockerPanicSkipList, opts ...grpc.CallOption) (*MsgUpdateBlockerPanicSkipListResponse, error)
 }
 
 type msgClient struct {
@@ -248,11 +355,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateBlockerPanicSkipList(ctx context.Context, in *MsgUpdateBlockerPanicSkipList, opts ...grpc.CallOption) (*MsgUpdateBlockerPanicSkipListResponse, error) {
+	out := new(MsgUpdateBlockerPanicSkipListResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.consensus.v1.Msg/UpdateBlockerPanicSkipList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/consensus module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateBlockerPanicSkipList defines a governance operation for updating the
+	// list of modules whose begin and end blocker panics are skipped instead of
+	// halting the chain.
+	UpdateBlockerPanicSkipList(context.Context, *MsgUpdateBlockerPanicSkipList) (*MsgUpdateBlockerPanicSkipListResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -262,6 +382,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateBlockerPanicSkipList(ctx context.Context, req *MsgUpdateBlockerPanicSkipList) (*MsgUpdateBlockerPanicSkipListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockerPanicSkipList not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -285,6 +408,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBlockerPanicSkipList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockerPanicSkipList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockerPanicSkipList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.consensus.v1.Msg/UpdateBlockerPanicSkipList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockerPanicSkipList(ctx, req.(*MsgUpdateBlockerPanicSkipList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.consensus.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -293,6 +434,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateBlockerPanicSkipList",
+			Handler:    _Msg_UpdateBlockerPanicSkipList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/consensus/v1/tx.proto",
@@ -423,6 +568,68 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateBlockerPanicSkipList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockerPanicSkipList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockerPanicSkipList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleNames) > 0 {
+		for iNdEx := len(m.ModuleNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModuleNames[iNdEx])
+			copy(dAtA[i:], m.ModuleNames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockerPanicSkipListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockerPanicSkipListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockerPanicSkipListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -480,6 +687,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateBlockerPanicSkipList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ModuleNames) > 0 {
+		for _, s := range m.ModuleNames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateBlockerPanicSkipListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -834,6 +1069,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateBlockerPanicSkipList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlockerPanicSkipList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlockerPanicSkipList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleNames = append(m.ModuleNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlockerPanicSkipListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlockerPanicSkipListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlockerPanicSkipListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -85,6 +85,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the amount of coins in the community pool",
 					Example:   fmt.Sprintf(`$ %s query distribution community-pool`, version.AppName),
 				},
+				{
+					RpcMethod: "BurnedFees",
+					Use:       "burned-fees",
+					Short:     "Query the cumulative amount of collected fees burned",
+					Example:   fmt.Sprintf(`$ %s query distribution burned-fees`, version.AppName),
+				},
+				{
+					RpcMethod: "DelegationTotalRewardsProjection",
+					Use:       "rewards-projection [delegator-addr] [blocks]",
+					Short:     "Query all distribution delegator rewards projected a number of blocks in the future",
+					Long:      "Query all rewards earned by a delegator, along with the rewards projected to be earned after the given number of blocks and the estimated APR of each delegation, assuming the current provisions, validator set and commission rates remain unchanged",
+					Example:   fmt.Sprintf("$ %s query distribution rewards-projection [delegator-address] 100000", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "blocks"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -103,6 +120,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "WithdrawDelegatorReward",
 					Use:       "withdraw-rewards [validator-addr]",
 					Short:     "Withdraw rewards from a given delegation address",
+					Long:      "Withdraw rewards from a given delegation address. The rewards are sent to the delegator withdraw address, unless a recipient address is provided with --recipient-address.",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-rewards cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp --recipient-address cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey", version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "validator_address"},
 					},
@@ -115,6 +134,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawAllDelegatorRewards",
+					Use:       "withdraw-all-delegator-rewards",
+					Short:     "Withdraw the rewards of all the delegations of a delegator in a single message",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-all-delegator-rewards --from mykey", version.AppName),
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool [validator-addr] [amount]",
@@ -125,6 +150,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [validator-addr] [enabled]",
+					Short:     "Opt a delegation in or out of the periodic re-delegation of its rewards",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "SetCommissionAutoWithdrawThreshold",
+					Use:       "set-commission-auto-withdraw-threshold [validator-addr] [threshold]",
+					Short:     "Set the accumulated commission at which the commission of a validator is automatically withdrawn, no threshold disables it",
+					Example:   fmt.Sprintf("%s tx distribution set-commission-auto-withdraw-threshold cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp 1000000uatom --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "threshold", Varargs: true},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
@@ -139,7 +184,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
 					Short:          "Submit a proposal to update distribution module params. Note: the entire params must be provided.",
-					Example:        fmt.Sprintf(`%s tx distribution update-params-proposal '{ "community_tax": "20000", "base_proposer_reward": "0", "bonus_proposer_reward": "0", "withdraw_addr_enabled": true }'`, version.AppName),
+					Example:        fmt.Sprintf(`%s tx distribution update-params-proposal '{ "community_tax": "20000", "base_proposer_reward": "0", "bonus_proposer_reward": "0", "withdraw_addr_enabled": true, "fee_burn_percentage": "0", "auto_compound_frequency": "0", "max_auto_compounds_per_block": "100" }'`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
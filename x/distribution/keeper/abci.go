@@ -9,7 +9,8 @@ import (
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block.
+// and distribute rewards for the previous block. The validator commissions
+// which reached their auto-withdraw threshold are then withdrawn.
 // TODO: use context.Context after including the comet service
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
@@ -37,5 +38,33 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return k.AutoWithdrawCommissions(ctx)
+}
+
+// EndBlocker starts a round compounding the rewards of the delegations opted
+// in to auto-compounding every AutoCompoundFrequency blocks, and goes on with
+// the round in progress, if any, at every block.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundFrequency == 0 {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	inProgress, err := k.IsAutoCompoundInProgress(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if !inProgress && (height <= 0 || uint64(height)%params.AutoCompoundFrequency != 0) {
+		return nil
+	}
+
+	return k.AutoCompoundRewards(ctx)
 }
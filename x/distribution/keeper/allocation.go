@@ -25,13 +25,19 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	if feesCollectedInt.Empty() {
 		return nil
 	}
-	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
 
 	// transfer collected fees to the distribution module account
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, feesCollectedInt); err != nil {
 		return err
 	}
 
+	// burn a fraction of the collected fees before allocating the remainder
+	burned, err := k.burnFees(ctx, feesCollectedInt)
+	if err != nil {
+		return err
+	}
+	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt.Sub(burned...)...)
+
 	feePool, err := k.FeePool.Get(ctx)
 	if err != nil {
 		return err
@@ -90,6 +96,69 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	return nil
 }
 
+// burnFees burns the fee burn percentage of the given collected fees, held by
+// the distribution module account, and adds them to the cumulative burned
+// fees. It returns the burned coins.
+func (k Keeper) burnFees(ctx context.Context, feesCollected sdk.Coins) (sdk.Coins, error) {
+	burnPercentage, err := k.GetFeeBurnPercentage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !burnPercentage.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	// amounts are truncated so that only whole coins are burned, the
+	// remainder is allocated as the rest of the fees
+	burned := sdk.NewCoins()
+	for _, fee := range feesCollected {
+		burned = burned.Add(sdk.NewCoin(fee.Denom, math.LegacyNewDecFromInt(fee.Amount).MulTruncate(burnPercentage).TruncateInt()))
+	}
+
+	if burned.IsZero() {
+		return burned, nil
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), burned); err != nil {
+		return nil, err
+	}
+
+	for _, coin := range burned {
+		total, err := k.CumulativeBurnedFees.Get(ctx, coin.Denom)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		if errors.Is(err, collections.ErrNotFound) {
+			total = math.ZeroInt()
+		}
+
+		if err := k.CumulativeBurnedFees.Set(ctx, coin.Denom, total.Add(coin.Amount)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBurnFees,
+		event.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return burned, nil
+}
+
+// GetCumulativeBurnedFees returns the cumulative amount of collected fees burned.
+func (k Keeper) GetCumulativeBurnedFees(ctx context.Context) (sdk.Coins, error) {
+	burned := sdk.NewCoins()
+	err := k.CumulativeBurnedFees.Walk(ctx, nil, func(denom string, amount math.Int) (stop bool, err error) {
+		burned = burned.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+
+	return burned, err
+}
+
 // AllocateTokensToValidator allocate tokens to a particular validator,
 // splitting according to commission.
 func (k Keeper) AllocateTokensToValidator(ctx context.Context, val sdk.ValidatorI, tokens sdk.DecCoins) error {
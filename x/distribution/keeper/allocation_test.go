@@ -375,3 +375,90 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+func TestAllocateTokensBurnsFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	cdcOpts := codectestutil.CodecOptions{}
+	encCfg := moduletestutil.MakeTestEncodingConfig(cdcOpts, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).AnyTimes()
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+
+	authorityAddr, err := cdcOpts.GetAddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	// burn 10% of the collected fees, without community tax
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyZeroDec()
+	params.FeeBurnPercentage = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator with no commission
+	valAddr0 := sdk.ValAddress(valConsAddr0)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val0, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	val0.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil).AnyTimes()
+
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+
+	// the burned amounts are truncated
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1009)), sdk.NewCoin("foo", math.NewInt(9)))
+	burned := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), distrAcc.GetAddress().Bytes(), burned)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, disttypes.ProtocolPoolDistrAccount, gomock.Any())
+
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	// the validator is allocated the fees which were not burned
+	outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees.Sub(burned...)...), outstanding.Rewards)
+
+	burnedFees, err := distrKeeper.GetCumulativeBurnedFees(ctx)
+	require.NoError(t, err)
+	require.Equal(t, burned, burnedFees)
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Equal(t, disttypes.EventTypeBurnFees, events[0].Type)
+	require.Equal(t, burned.String(), events[0].Attributes[0].Value)
+
+	// burned fees are accumulated
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), distrAcc.GetAddress().Bytes(), burned)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, disttypes.ProtocolPoolDistrAccount, gomock.Any())
+
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	res, err := keeper.NewQuerier(distrKeeper).BurnedFees(ctx, &disttypes.QueryBurnedFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, burned.Add(burned...), res.BurnedFees)
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompoundDelegation opts the delegation of delAddr to valAddr in or out
+// of auto-compounding. Only existing delegations can be opted in.
+func (k Keeper) SetAutoCompoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if enabled {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if params.AutoCompoundFrequency == 0 {
+			return types.ErrAutoCompoundDisabled
+		}
+
+		del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if del == nil {
+			return types.ErrNoDelegationExists
+		}
+
+		if err := k.AutoCompoundDelegations.Set(ctx, key); err != nil {
+			return err
+		}
+	} else if err := k.AutoCompoundDelegations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// IsAutoCompoundDelegation returns whether the delegation of delAddr to valAddr
+// is opted in to auto-compounding.
+func (k Keeper) IsAutoCompoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	return k.AutoCompoundDelegations.Has(ctx, collections.Join(delAddr, valAddr))
+}
+
+// IsAutoCompoundInProgress returns whether an auto-compounding round started
+// in a previous block still has delegations to compound.
+func (k Keeper) IsAutoCompoundInProgress(ctx context.Context) (bool, error) {
+	return k.AutoCompoundCursor.Has(ctx)
+}
+
+// AutoCompoundRewards withdraws the rewards of the delegations opted in to
+// auto-compounding and delegates the withdrawn bond denom tokens back to the
+// validator, at most MaxAutoCompoundsPerBlock of them. The round starts over
+// the delegations after the AutoCompoundCursor, which is updated so that the
+// round goes on in the next block when delegations are left, and cleared once
+// the round is over. Delegations whose rewards are withdrawn to another
+// address are skipped, and the failure to compound a delegation does not
+// affect the others.
+func (k Keeper) AutoCompoundRewards(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	rng := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]])
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	switch {
+	case err == nil:
+		rng = rng.StartExclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	var keys []collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	err = k.AutoCompoundDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		keys = append(keys, key)
+		return uint64(len(keys)) >= params.MaxAutoCompoundsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the round goes on in the next block if the cap was reached
+	if len(keys) > 0 && uint64(len(keys)) >= params.MaxAutoCompoundsPerBlock {
+		if err := k.AutoCompoundCursor.Set(ctx, keys[len(keys)-1]); err != nil {
+			return err
+		}
+	} else if err := k.AutoCompoundCursor.Remove(ctx); err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.autoCompoundDelegation(ctx, key.K1(), key.K2(), bondDenom)
+		}); err != nil {
+			k.Logger.Error("failed to auto-compound delegation rewards", "delegator", key.K1().String(), "validator", key.K2().String(), "err", err)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) autoCompoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string) error {
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// the delegation was removed
+	if err != nil || del == nil {
+		return k.AutoCompoundDelegations.Remove(ctx, collections.Join(delAddr, valAddr))
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return nil
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.MsgRouterService.InvokeTyped(
+		ctx,
+		stakingtypes.NewMsgDelegate(delegator, validator, amount),
+		&stakingtypes.MsgDelegateResponse{},
+	); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
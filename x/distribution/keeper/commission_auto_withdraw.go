@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCommissionAutoWithdrawalsPerBlock is the maximum number of validator
+// commissions automatically withdrawn in a single block. The commissions
+// above their threshold which are not withdrawn are withdrawn in the next
+// blocks.
+const MaxCommissionAutoWithdrawalsPerBlock = 100
+
+// SetCommissionAutoWithdrawThreshold sets the amount of accumulated commission
+// at which the commission of the validator is automatically withdrawn. An
+// empty threshold disables the automatic withdrawal.
+func (k Keeper) SetCommissionAutoWithdrawThreshold(ctx context.Context, valAddr sdk.ValAddress, threshold sdk.Coins) error {
+	if threshold.Empty() {
+		if err := k.CommissionAutoWithdrawThresholds.Remove(ctx, valAddr); err != nil {
+			return err
+		}
+	} else {
+		if err := threshold.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+
+		val, err := k.stakingKeeper.Validator(ctx, valAddr)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err != nil || val == nil {
+			return types.ErrNoValidatorExists
+		}
+
+		if err := k.CommissionAutoWithdrawThresholds.Set(ctx, valAddr, types.CommissionAutoWithdrawThreshold{Threshold: threshold}); err != nil {
+			return err
+		}
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetCommissionAutoWithdrawThreshold,
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(types.AttributeKeyThreshold, threshold.String()),
+	)
+}
+
+// GetCommissionAutoWithdrawThreshold returns the commission auto-withdraw
+// threshold of the validator, empty if none is set.
+func (k Keeper) GetCommissionAutoWithdrawThreshold(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	threshold, err := k.CommissionAutoWithdrawThresholds.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return threshold.Threshold, nil
+}
+
+// AutoWithdrawCommissions withdraws the commission of the validators whose
+// accumulated commission reached their auto-withdraw threshold, up to
+// MaxCommissionAutoWithdrawalsPerBlock validators. The failure to withdraw the
+// commission of a validator does not affect the others.
+func (k Keeper) AutoWithdrawCommissions(ctx context.Context) error {
+	var valAddrs []sdk.ValAddress
+	err := k.CommissionAutoWithdrawThresholds.Walk(ctx, nil, func(valAddr sdk.ValAddress, threshold types.CommissionAutoWithdrawThreshold) (stop bool, err error) {
+		accumCommission, err := k.ValidatorsAccumulatedCommission.Get(ctx, valAddr)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return true, err
+		}
+
+		commission, _ := accumCommission.Commission.TruncateDecimal()
+		if commission.IsAnyGTE(threshold.Threshold) {
+			valAddrs = append(valAddrs, valAddr)
+		}
+
+		return len(valAddrs) >= MaxCommissionAutoWithdrawalsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, valAddr := range valAddrs {
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.autoWithdrawCommission(ctx, valAddr)
+		}); err != nil {
+			k.Logger.Error("failed to auto-withdraw validator commission", "validator", valAddr.String(), "err", err)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) autoWithdrawCommission(ctx context.Context, valAddr sdk.ValAddress) error {
+	commission, err := k.WithdrawValidatorCommission(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoWithdrawCommission,
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+	)
+}
@@ -200,7 +200,9 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val sdk.Validato
 	return rewards, nil
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI) (sdk.Coins, error) {
+// withdrawDelegationRewards sends the rewards of the delegation to recipient,
+// or to the delegator withdraw address if recipient is nil.
+func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI, recipient sdk.AccAddress) (sdk.Coins, error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
 	if err != nil {
@@ -256,12 +258,14 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 
 	// add coins to user account
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
-		if err != nil {
-			return nil, err
+		if recipient == nil {
+			recipient, err = k.GetDelegatorWithdrawAddr(ctx, delAddr)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, finalRewards)
 		if err != nil {
 			return nil, err
 		}
@@ -20,9 +20,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -585,6 +587,190 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawDelegationRewardsTo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+
+	authorityAddr, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create validator with no commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+
+	addrStr, err := accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	valAddrStr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// delegation mock
+	del := stakingtypes.NewDelegation(addrStr, valAddrStr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+
+	// blocked addresses cannot receive rewards
+	bankKeeper.EXPECT().BlockedAddr(distrAcc.GetAddress()).Return(true)
+	_, err = distrKeeper.WithdrawDelegationRewardsTo(ctx, addr, valAddr, distrAcc.GetAddress())
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// rewards cannot be redirected when withdraw addresses are disabled
+	recipient := sdk.AccAddress(valConsAddr1)
+	bankKeeper.EXPECT().BlockedAddr(recipient).Return(false).AnyTimes()
+	params := disttypes.DefaultParams()
+	params.WithdrawAddrEnabled = false
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	_, err = distrKeeper.WithdrawDelegationRewardsTo(ctx, addr, valAddr, recipient)
+	require.ErrorIs(t, err, disttypes.ErrSetWithdrawAddrDisabled)
+
+	// the rewards are sent to the recipient, not the withdraw address
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, recipient, expRewards)
+	rewards, err := distrKeeper.WithdrawDelegationRewardsTo(ctx, addr, valAddr, recipient)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, rewards)
+
+	withdrawAddr, err := distrKeeper.GetDelegatorWithdrawAddr(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, addr, withdrawAddr)
+}
+
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+
+	authorityAddr, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	addr := sdk.AccAddress(valConsAddr2)
+	addrStr, err := accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+
+	// without delegations nothing is withdrawn
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addr).Return(nil, nil)
+	rewards, err := distrKeeper.WithdrawAllDelegationRewards(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+
+	// create two validators with no commission, fully delegated to by addr
+	var delegations []stakingtypes.Delegation
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		valAddr := sdk.ValAddress(pk.Address())
+		valAddrStr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		require.NoError(t, err)
+		val, err := distrtestutil.CreateValidator(pk, valAddrStr, math.NewInt(100))
+		require.NoError(t, err)
+
+		del := stakingtypes.NewDelegation(addrStr, valAddrStr, val.DelegatorShares)
+		delegations = append(delegations, del)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+		// run the necessary hooks manually (given that we are not running an actual staking module)
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+	}
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addr).Return(delegations, nil)
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	// allocate different rewards to each validator
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	val0, err := stakingKeeper.Validator(ctx, sdk.ValAddress(valConsPk0.Address()))
+	require.NoError(t, err)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val0, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+	val1, err := stakingKeeper.Validator(ctx, sdk.ValAddress(valConsPk1.Address()))
+	require.NoError(t, err)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val1, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.MulRaw(2))}))
+
+	// the rewards of each delegation are sent to the withdraw address
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)})
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(2))})
+	rewards, err = distrKeeper.WithdrawAllDelegationRewards(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(3))}, rewards)
+
+	// the outstanding rewards of both validators are withdrawn
+	for _, val := range []sdk.ValidatorI{val0, val1} {
+		valAddr, err := stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+		require.NoError(t, err)
+		outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+		require.NoError(t, err)
+		require.True(t, outstanding.Rewards.IsZero())
+	}
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
@@ -113,6 +113,38 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, coin := range data.BurnedFees {
+		if err := k.CumulativeBurnedFees.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, acd := range data.AutoCompoundDelegations {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(acd.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(acd.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		err = k.AutoCompoundDelegations.Set(ctx, collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, record := range data.CommissionAutoWithdrawThresholds {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		err = k.CommissionAutoWithdrawThresholds.Set(ctx, valAddr, types.CommissionAutoWithdrawThreshold{Threshold: record.Threshold})
+		if err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -285,5 +317,53 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	burnedFees, err := k.GetCumulativeBurnedFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acds := make([]types.AutoCompoundDelegation, 0)
+	err = k.AutoCompoundDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		acds = append(acds, types.AutoCompoundDelegation{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds := make([]types.CommissionAutoWithdrawThresholdRecord, 0)
+	err = k.CommissionAutoWithdrawThresholds.Walk(ctx, nil, func(key sdk.ValAddress, threshold types.CommissionAutoWithdrawThreshold) (stop bool, err error) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key)
+		if err != nil {
+			return true, err
+		}
+
+		thresholds = append(thresholds, types.CommissionAutoWithdrawThresholdRecord{
+			ValidatorAddress: valAddr,
+			Threshold:        threshold.Threshold,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes)
+	genState.BurnedFees = burnedFees
+	genState.AutoCompoundDelegations = acds
+	genState.CommissionAutoWithdrawThresholds = thresholds
+	return genState, nil
 }
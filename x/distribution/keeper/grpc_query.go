@@ -382,3 +382,94 @@ func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoo
 
 	return &types.QueryCommunityPoolResponse{Pool: sdk.NewDecCoinsFromCoins(balances...)}, nil
 }
+
+// BurnedFees queries the cumulative amount of collected fees burned
+func (k Querier) BurnedFees(ctx context.Context, req *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	burned, err := k.GetCumulativeBurnedFees(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnedFeesResponse{BurnedFees: burned}, nil
+}
+
+// DelegationTotalRewardsProjection queries the total rewards accrued by each
+// validator of a delegator, along with the rewards projected to be accrued
+// after the given number of blocks
+func (k Querier) DelegationTotalRewardsProjection(ctx context.Context, req *types.QueryDelegationTotalRewardsProjectionRequest) (*types.QueryDelegationTotalRewardsProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	projection, err := k.newRewardsProjection(ctx)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	total, projectedTotal := sdk.DecCoins{}, sdk.DecCoins{}
+	var delRewards []types.DelegationDelegatorRewardProjection
+
+	var iterErr error
+	err = k.stakingKeeper.IterateDelegations(
+		ctx, delAdr,
+		func(_ int64, del sdk.DelegationI) (stop bool) {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			val, err := k.stakingKeeper.Validator(ctx, valAddr)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			delReward, err := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			futureReward, apr := projection.project(val, del, req.Blocks)
+			projectedReward := delReward.Add(futureReward...)
+
+			delRewards = append(delRewards, types.DelegationDelegatorRewardProjection{
+				ValidatorAddress: del.GetValidatorAddr(),
+				Reward:           delReward,
+				ProjectedReward:  projectedReward,
+				Apr:              apr,
+			})
+			total = total.Add(delReward...)
+			projectedTotal = projectedTotal.Add(projectedReward...)
+			return false
+		},
+	)
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationTotalRewardsProjectionResponse{
+		Rewards:        delRewards,
+		Total:          total,
+		ProjectedTotal: projectedTotal,
+	}, nil
+}
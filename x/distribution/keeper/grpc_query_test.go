@@ -1,20 +1,32 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	mintv1beta1 "cosmossdk.io/api/cosmos/mint/v1beta1"
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/distribution"
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
 func TestQueryParams(t *testing.T) {
@@ -187,3 +199,165 @@ func TestQueryCommunityPool(t *testing.T) {
 		})
 	}
 }
+
+// mintQueryRouter answers the mint queries used to project rewards.
+type mintQueryRouter struct {
+	params           *mintv1beta1.Params
+	annualProvisions math.LegacyDec
+}
+
+func (r mintQueryRouter) CanInvoke(_ context.Context, _ string) error { return nil }
+
+func (r mintQueryRouter) InvokeTyped(_ context.Context, req, res gogoproto.Message) error {
+	switch req.(type) {
+	case *mintv1beta1.QueryParamsRequest:
+		res.(*mintv1beta1.QueryParamsResponse).Params = r.params
+	case *mintv1beta1.QueryAnnualProvisionsRequest:
+		bz, err := r.annualProvisions.Marshal()
+		if err != nil {
+			return err
+		}
+		res.(*mintv1beta1.QueryAnnualProvisionsResponse).AnnualProvisions = bz
+	default:
+		return fmt.Errorf("unknown request: %T", req)
+	}
+	return nil
+}
+
+func (r mintQueryRouter) InvokeUntyped(_ context.Context, req gogoproto.Message) (gogoproto.Message, error) {
+	return nil, fmt.Errorf("unknown request: %T", req)
+}
+
+func TestQueryDelegationTotalRewardsProjection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+
+	// 10 stake are minted per block
+	queryRouter := &mintQueryRouter{
+		params:           &mintv1beta1.Params{MintDenom: sdk.DefaultBondDenom, BlocksPerYear: 100},
+		annualProvisions: math.LegacyNewDec(1000),
+	}
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+	env.QueryRouterService = queryRouter
+
+	authorityAddr, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	params := types.DefaultParams()
+	params.CommunityTax = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// bonded validator with 10% commission, holding half of the bonded tokens
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	val.Status = stakingtypes.Bonded
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyZeroDec())
+
+	otherOperatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsAddr1)
+	require.NoError(t, err)
+	otherVal, err := distrtestutil.CreateValidator(valConsPk1, otherOperatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	otherVal.Status = stakingtypes.Bonded
+
+	addrStr, err := accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+
+	// the delegator holds half of the validator shares
+	del := stakingtypes.NewDelegation(addrStr, operatorAddr, math.LegacyNewDec(50))
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), addr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, sdk.DelegationI) bool) error {
+			fn(0, del)
+			return nil
+		},
+	).AnyTimes()
+	stakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(int64, sdk.ValidatorI) bool) error {
+			for i, v := range []sdk.ValidatorI{val, otherVal} {
+				if fn(int64(i), v) {
+					break
+				}
+			}
+			return nil
+		},
+	).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	// next block, allocate some rewards
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100))}))
+
+	_, err = queryServer.DelegationTotalRewardsProjection(ctx, &types.QueryDelegationTotalRewardsProjectionRequest{})
+	require.ErrorContains(t, err, "empty delegator address")
+
+	res, err := queryServer.DelegationTotalRewardsProjection(ctx, &types.QueryDelegationTotalRewardsProjectionRequest{
+		DelegatorAddress: addrStr,
+		Blocks:           20,
+	})
+	require.NoError(t, err)
+
+	// 90 stake accrued, half of the 90% left after commission
+	accrued := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(45))}
+	// 20 blocks * 10 stake * 90% after community tax * 50% of the bonded
+	// tokens * 90% after commission * 50% of the shares
+	projected := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("85.5"))}
+	require.Equal(t, &types.QueryDelegationTotalRewardsProjectionResponse{
+		Rewards: []types.DelegationDelegatorRewardProjection{{
+			ValidatorAddress: operatorAddr,
+			Reward:           accrued,
+			ProjectedReward:  projected,
+			// 1000 stake * 90% after community tax * 90% after commission / 200 bonded tokens
+			Apr: math.LegacyMustNewDecFromStr("4.05"),
+		}},
+		Total:          accrued,
+		ProjectedTotal: projected,
+	}, res)
+
+	// provisions in another denom do not have an APR
+	queryRouter.params.MintDenom = "other"
+	res, err = queryServer.DelegationTotalRewardsProjection(ctx, &types.QueryDelegationTotalRewardsProjectionRequest{
+		DelegatorAddress: addrStr,
+		Blocks:           20,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Rewards[0].Apr.IsZero())
+	require.Equal(t, math.LegacyMustNewDecFromStr("40.5"), res.ProjectedTotal.AmountOf("other"))
+
+	queryRouter.params.BlocksPerYear = 0
+	_, err = queryServer.DelegationTotalRewardsProjection(ctx, &types.QueryDelegationTotalRewardsProjectionRequest{
+		DelegatorAddress: addrStr,
+		Blocks:           20,
+	})
+	require.ErrorContains(t, err, "blocks per year must be positive")
+}
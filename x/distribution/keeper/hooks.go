@@ -126,6 +126,12 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 		return err
 	}
 
+	// clear commission auto-withdraw threshold
+	err = h.k.CommissionAutoWithdrawThresholds.Remove(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -152,7 +158,7 @@ func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.A
 		return err
 	}
 
-	if _, err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	if _, err := h.k.withdrawDelegationRewards(ctx, val, del, nil); err != nil {
 		return err
 	}
 
@@ -169,6 +175,12 @@ func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddres
 	return h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
 }
 
+// record the routing of the truncation dust, which decreases the value of the
+// validator shares like a slash does
+func (h Hooks) BeforeTruncationDustRouted(ctx context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) error {
+	return h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
+}
+
 func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
 	return nil
 }
@@ -181,8 +193,9 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// BeforeDelegationRemoved opts the removed delegation out of auto-compounding
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AutoCompoundDelegations.Remove(ctx, collections.Join(delAddr, valAddr))
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
@@ -181,27 +181,40 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of validator outstanding rewards
+// are exactly the sum of the validator outstanding rewards, the decimal pool
+// and the deprecated community pool, truncated to whole coins, and that the
+// decimal pool is not negative.
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		var expectedCoins sdk.DecCoins
+		var outstanding sdk.DecCoins
 		err := k.ValidatorOutstandingRewards.Walk(ctx, nil, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
-			expectedCoins = expectedCoins.Add(rewards.Rewards...)
+			outstanding = outstanding.Add(rewards.Rewards...)
 			return false, nil
 		})
 		if err != nil {
 			return sdk.FormatInvariant(types.ModuleName, "module account coins", err.Error()), true
 		}
 
-		expectedInt, _ := expectedCoins.TruncateDecimal()
+		feePool, err := k.FeePool.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module account coins", err.Error()), true
+		}
+
+		expectedInt, _ := outstanding.Add(feePool.DecimalPool...).Add(feePool.CommunityPool...).TruncateDecimal()
 
 		balances := k.bankKeeper.GetAllBalances(ctx, k.GetDistributionAccount(ctx).GetAddress())
-		broken := !balances.Equal(expectedInt)
+		unaccounted, _ := sdk.NewDecCoinsFromCoins(balances...).SafeSub(sdk.NewDecCoinsFromCoins(expectedInt...))
+		broken := !balances.Equal(expectedInt) || feePool.DecimalPool.IsAnyNegative()
 		return sdk.FormatInvariant(
 			types.ModuleName, "ModuleAccount coins",
-			fmt.Sprintf("\texpected ModuleAccount coins:     %s\n"+
-				"\tdistribution ModuleAccount coins: %s\n",
-				expectedInt, balances,
+			fmt.Sprintf("\toutstanding rewards:              %s\n"+
+				"\tdecimal pool:                     %s\n"+
+				"\tcommunity pool:                   %s\n"+
+				"\texpected ModuleAccount coins:     %s\n"+
+				"\tdistribution ModuleAccount coins: %s\n"+
+				"\tunaccounted coins:                %s\n",
+				outstanding, feePool.DecimalPool, feePool.CommunityPool,
+				expectedInt, balances, unaccounted,
 			),
 		), broken
 	}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/keeper"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestModuleAccountInvariant(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc).AnyTimes()
+
+	// 1.5stake of outstanding rewards and 0.75stake of dust add up to 2stake
+	outstanding := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(15, 1))}
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, sdk.ValAddress(addrs[0]), types.ValidatorOutstandingRewards{Rewards: outstanding}))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.FeePool{
+		DecimalPool: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(75, 2))},
+	}))
+
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)))
+	_, broken := keeper.ModuleAccountInvariant(distrKeeper)(ctx)
+	require.False(t, broken)
+
+	// the coin held on top of the expected ones is reported as unaccounted
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)))
+	msg, broken := keeper.ModuleAccountInvariant(distrKeeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "unaccounted coins:                1.000000000000000000stake")
+}
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -52,6 +53,15 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// CumulativeBurnedFees key: denom | value: cumulative amount of collected fees burned
+	CumulativeBurnedFees collections.Map[string, math.Int]
+	// AutoCompoundDelegations key: delAddr+valAddr | value: none (delegations opted in to auto-compounding)
+	AutoCompoundDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundCursor value: delAddr+valAddr of the last delegation compounded
+	// in the current auto-compounding round, unset if no round is in progress
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// CommissionAutoWithdrawThresholds key: valAddr | value: CommissionAutoWithdrawThreshold
+	CommissionAutoWithdrawThresholds collections.Map[sdk.ValAddress, types.CommissionAutoWithdrawThreshold]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -133,6 +143,26 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		CumulativeBurnedFees: collections.NewMap(sb, types.CumulativeBurnedFeesPrefix, "cumulative_burned_fees", collections.StringKey, sdk.IntValue),
+		AutoCompoundDelegations: collections.NewKeySet(
+			sb,
+			types.AutoCompoundDelegationsPrefix,
+			"auto_compound_delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+		),
+		AutoCompoundCursor: collections.NewItem(
+			sb,
+			types.AutoCompoundCursorKey,
+			"auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
+		CommissionAutoWithdrawThresholds: collections.NewMap(
+			sb,
+			types.CommissionAutoWithdrawThresholdsPrefix,
+			"commission_auto_withdraw_thresholds",
+			sdk.ValAddressKey,
+			codec.CollValue[types.CommissionAutoWithdrawThreshold](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -180,6 +210,30 @@ func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr
 
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	return k.WithdrawDelegationRewardsTo(ctx, delAddr, valAddr, nil)
+}
+
+// WithdrawDelegationRewardsTo withdraws the rewards from a delegation to the
+// given recipient instead of the delegator withdraw address. A nil recipient
+// falls back to the withdraw address. Sending rewards to an address other
+// than the delegator is subject to the same rules as setting a withdraw
+// address.
+func (k Keeper) WithdrawDelegationRewardsTo(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, recipient sdk.AccAddress) (sdk.Coins, error) {
+	if recipient != nil && !recipient.Equals(delAddr) {
+		if k.bankKeeper.BlockedAddr(recipient) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+		}
+
+		withdrawAddrEnabled, err := k.GetWithdrawAddrEnabled(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !withdrawAddrEnabled {
+			return nil, types.ErrSetWithdrawAddrDisabled
+		}
+	}
+
 	val, err := k.stakingKeeper.Validator(ctx, valAddr)
 	if err != nil {
 		return nil, err
@@ -199,7 +253,7 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	}
 
 	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	rewards, err := k.withdrawDelegationRewards(ctx, val, del, recipient)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +266,33 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator and returns the total amount withdrawn.
+func (k Keeper) WithdrawAllDelegationRewards(ctx context.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	// collect the delegations upfront as withdrawing rewards writes to the store
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			return nil, err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(rewards...)
+	}
+
+	return total, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -136,6 +136,48 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestAutoWithdrawCommissions(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	valAddr0, valAddr1 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	valCommission := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", math.LegacyNewDec(5).Quo(math.LegacyNewDec(4))),
+		sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(3).Quo(math.LegacyNewDec(2))),
+	}
+	for _, valAddr := range []sdk.ValAddress{valAddr0, valAddr1} {
+		require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission}))
+		require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: valCommission}))
+	}
+
+	// the commission of the first validator reached its threshold in one of
+	// the denoms, while the commission of the second validator did not
+	require.NoError(t, distrKeeper.CommissionAutoWithdrawThresholds.Set(ctx, valAddr0, types.CommissionAutoWithdrawThreshold{
+		Threshold: sdk.NewCoins(sdk.NewInt64Coin("mytoken", 2), sdk.NewInt64Coin("stake", 1)),
+	}))
+	require.NoError(t, distrKeeper.CommissionAutoWithdrawThresholds.Set(ctx, valAddr1, types.CommissionAutoWithdrawThreshold{
+		Threshold: sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+	}))
+
+	coins := sdk.NewCoins(sdk.NewCoin("mytoken", math.NewInt(1)), sdk.NewCoin("stake", math.NewInt(1)))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[0], coins).Return(nil)
+
+	require.NoError(t, distrKeeper.AutoWithdrawCommissions(ctx))
+
+	commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, valAddr0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", math.LegacyNewDec(1).Quo(math.LegacyNewDec(4))),
+		sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(1).Quo(math.LegacyNewDec(2))),
+	}, commission.Commission)
+
+	commission, err = distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, valCommission, commission.Commission)
+
+	// the remaining commission of the first validator is below its threshold
+	require.NoError(t, distrKeeper.AutoWithdrawCommissions(ctx))
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 
@@ -6,10 +6,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -101,6 +103,15 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 			},
 			errMsg: "invalid validator address",
 		},
+		{
+			name: "invalid recipient address",
+			msg: &types.MsgWithdrawDelegatorReward{
+				DelegatorAddress: addr0Str,
+				ValidatorAddress: valAddr1Str,
+				RecipientAddress: "invalid",
+			},
+			errMsg: "invalid recipient address",
+		},
 		{
 			name: "no validator",
 			msg: &types.MsgWithdrawDelegatorReward{
@@ -385,3 +396,147 @@ func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	delAddr, valAddr := addrs[0], sdk.ValAddress(addrs[1])
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+	valAddr1Str, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	valAddr0Str, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(stakingtypes.Delegation{}, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, sdk.ValAddress(addrs[0])).Return(nil, nil).AnyTimes()
+
+	params := types.DefaultParams()
+	params.AutoCompoundFrequency = 10
+
+	cases := []struct {
+		name       string
+		preRun     func()
+		msg        *types.MsgSetAutoCompound
+		expEnabled bool
+		errMsg     string
+	}{
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgSetAutoCompound("invalid", valAddr1Str, true),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetAutoCompound(addr0Str, "invalid", true),
+			errMsg: "invalid address",
+		},
+		{
+			name:   "auto-compounding disabled",
+			msg:    types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true),
+			errMsg: "auto-compounding disabled",
+		},
+		{
+			name: "no delegation",
+			preRun: func() {
+				require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			},
+			msg:    types.NewMsgSetAutoCompound(addr0Str, valAddr0Str, true),
+			errMsg: "delegation does not exist",
+		},
+		{
+			name:       "enable",
+			msg:        types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, true),
+			expEnabled: true,
+		},
+		{
+			name:       "disable",
+			msg:        types.NewMsgSetAutoCompound(addr0Str, valAddr1Str, false),
+			expEnabled: false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := msgServer.SetAutoCompound(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				enabled, err := distrKeeper.IsAutoCompoundDelegation(ctx, delAddr, valAddr)
+				require.NoError(t, err)
+				require.Equal(t, tc.expEnabled, enabled)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestMsgSetCommissionAutoWithdrawThreshold(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	valAddrStr, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	unknownValAddrStr, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(stakingtypes.Validator{}, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(addrs[1])).Return(nil, collections.ErrNotFound).AnyTimes()
+
+	threshold := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	cases := []struct {
+		name         string
+		msg          *types.MsgSetCommissionAutoWithdrawThreshold
+		expThreshold sdk.Coins
+		errMsg       string
+	}{
+		{
+			name:   "invalid validator address",
+			msg:    types.NewMsgSetCommissionAutoWithdrawThreshold("invalid", threshold),
+			errMsg: "invalid validator address",
+		},
+		{
+			name:   "invalid threshold",
+			msg:    types.NewMsgSetCommissionAutoWithdrawThreshold(valAddrStr, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}),
+			errMsg: "invalid coins",
+		},
+		{
+			name:   "validator does not exist",
+			msg:    types.NewMsgSetCommissionAutoWithdrawThreshold(unknownValAddrStr, threshold),
+			errMsg: "validator does not exist",
+		},
+		{
+			name:         "set threshold",
+			msg:          types.NewMsgSetCommissionAutoWithdrawThreshold(valAddrStr, threshold),
+			expThreshold: threshold,
+		},
+		{
+			name: "remove threshold",
+			msg:  types.NewMsgSetCommissionAutoWithdrawThreshold(valAddrStr, nil),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetCommissionAutoWithdrawThreshold(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				threshold, err := distrKeeper.GetCommissionAutoWithdrawThreshold(ctx, valAddr)
+				require.NoError(t, err)
+				require.Equal(t, tc.expThreshold, threshold)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
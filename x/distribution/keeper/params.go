@@ -26,3 +26,13 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx context.Context) (enabled bool, err e
 
 	return params.WithdrawAddrEnabled, nil
 }
+
+// GetFeeBurnPercentage returns the current distribution fee burn percentage.
+func (k Keeper) GetFeeBurnPercentage(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return params.FeeBurnPercentage, nil
+}
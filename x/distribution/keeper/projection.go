@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	mintv1beta1 "cosmossdk.io/api/cosmos/mint/v1beta1"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// rewardsProjection holds the network-wide values used to project the rewards
+// of delegations, assuming they remain unchanged over the projection.
+type rewardsProjection struct {
+	// blockProvisions are the tokens minted per block.
+	blockProvisions sdk.DecCoin
+	// annualProvisions are the tokens minted per year.
+	annualProvisions sdk.DecCoin
+	// allocatedFraction is the fraction of the provisions allocated to
+	// validators, once the fee burn and community tax are deducted.
+	allocatedFraction math.LegacyDec
+	// totalBonded is the amount of tokens bonded to active validators.
+	totalBonded math.Int
+	bondDenom   string
+}
+
+// newRewardsProjection returns the rewards projection for the current state,
+// using the annual provisions and blocks per year reported by the mint module.
+// Fees are not taken into account as they cannot be forecasted.
+func (k Keeper) newRewardsProjection(ctx context.Context) (rewardsProjection, error) {
+	var mintParams mintv1beta1.QueryParamsResponse
+	if err := k.QueryRouterService.InvokeTyped(ctx, &mintv1beta1.QueryParamsRequest{}, &mintParams); err != nil {
+		return rewardsProjection{}, fmt.Errorf("failed to query mint params: %w", err)
+	}
+
+	var annualProvisionsRes mintv1beta1.QueryAnnualProvisionsResponse
+	if err := k.QueryRouterService.InvokeTyped(ctx, &mintv1beta1.QueryAnnualProvisionsRequest{}, &annualProvisionsRes); err != nil {
+		return rewardsProjection{}, fmt.Errorf("failed to query mint annual provisions: %w", err)
+	}
+
+	blocksPerYear := mintParams.GetParams().GetBlocksPerYear()
+	if blocksPerYear == 0 {
+		return rewardsProjection{}, fmt.Errorf("mint blocks per year must be positive")
+	}
+
+	var annualProvisions math.LegacyDec
+	if err := annualProvisions.Unmarshal(annualProvisionsRes.AnnualProvisions); err != nil {
+		return rewardsProjection{}, err
+	}
+
+	burnPercentage, err := k.GetFeeBurnPercentage(ctx)
+	if err != nil {
+		return rewardsProjection{}, err
+	}
+
+	communityTax, err := k.GetCommunityTax(ctx)
+	if err != nil {
+		return rewardsProjection{}, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return rewardsProjection{}, err
+	}
+
+	totalBonded := math.ZeroInt()
+	err = k.stakingKeeper.IterateValidators(ctx, func(_ int64, val sdk.ValidatorI) (stop bool) {
+		if val.IsBonded() {
+			totalBonded = totalBonded.Add(val.GetBondedTokens())
+		}
+		return false
+	})
+	if err != nil {
+		return rewardsProjection{}, err
+	}
+
+	mintDenom := mintParams.GetParams().GetMintDenom()
+	return rewardsProjection{
+		blockProvisions:   sdk.NewDecCoinFromDec(mintDenom, annualProvisions.QuoInt64(int64(blocksPerYear))),
+		annualProvisions:  sdk.NewDecCoinFromDec(mintDenom, annualProvisions),
+		allocatedFraction: math.LegacyOneDec().Sub(burnPercentage).Mul(math.LegacyOneDec().Sub(communityTax)),
+		totalBonded:       totalBonded,
+		bondDenom:         bondDenom,
+	}, nil
+}
+
+// project returns the rewards expected to be accrued by the delegation over the
+// given number of blocks, along with the estimated annual percentage rate of
+// the delegation. Only delegations to bonded validators accrue rewards.
+func (p rewardsProjection) project(val sdk.ValidatorI, del sdk.DelegationI, blocks uint64) (sdk.DecCoins, math.LegacyDec) {
+	if !val.IsBonded() || !p.totalBonded.IsPositive() || !val.GetDelegatorShares().IsPositive() {
+		return sdk.DecCoins{}, math.LegacyZeroDec()
+	}
+
+	// fraction of the allocated provisions earned by the validator delegators
+	delegatorsFraction := p.allocatedFraction.
+		MulInt(val.GetBondedTokens()).QuoInt(p.totalBonded).
+		Mul(math.LegacyOneDec().Sub(val.GetCommission()))
+
+	delegationFraction := delegatorsFraction.Mul(del.GetShares()).Quo(val.GetDelegatorShares())
+	rewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec(
+		p.blockProvisions.Denom,
+		p.blockProvisions.Amount.MulInt(math.NewIntFromUint64(blocks)).MulTruncate(delegationFraction),
+	))
+
+	// the rate is only meaningful when rewards are paid in the bonded token
+	apr := math.LegacyZeroDec()
+	if p.annualProvisions.Denom == p.bondDenom {
+		apr = p.annualProvisions.Amount.Mul(p.allocatedFraction).
+			Mul(math.LegacyOneDec().Sub(val.GetCommission())).
+			QuoInt(p.totalBonded)
+	}
+
+	return rewards, apr
+}
@@ -0,0 +1,27 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The
+// migration includes:
+//
+// Setting the fee burn percentage parameter to zero, so that no collected fees
+// are burned until it is updated by governance.
+//
+// Setting the max auto-compounds per block parameter to its default value.
+func MigrateStore(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.FeeBurnPercentage = math.LegacyZeroDec()
+	p.MaxAutoCompoundsPerBlock = types.DefaultParams().MaxAutoCompoundsPerBlock
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,75 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution"
+	v5 "cosmossdk.io/x/distribution/migrations/v5"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigration(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey("distribution")
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// params stored before the fee burn percentage was introduced
+	bz, err := cdc.Marshal(&types.Params{
+		CommunityTax:        math.LegacyNewDecWithPrec(2, 2),
+		BaseProposerReward:  math.LegacyZeroDec(),
+		BonusProposerReward: math.LegacyZeroDec(),
+		WithdrawAddrEnabled: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, storeService.OpenKVStore(ctx).Set(types.ParamsKey, withoutField(t, bz, 5)))
+
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, got.FeeBurnPercentage.IsNil())
+
+	require.NoError(t, v5.MigrateStore(ctx, params))
+
+	got, err = params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), got)
+	require.NoError(t, got.ValidateBasic())
+}
+
+// withoutField removes the given field from the encoded message.
+func withoutField(t *testing.T, bz []byte, field protowire.Number) []byte {
+	t.Helper()
+
+	var res []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		if num != field {
+			res = append(res, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+
+	return res
+}
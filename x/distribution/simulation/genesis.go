@@ -13,8 +13,11 @@ import (
 
 // Simulation parameter constants
 const (
-	CommunityTax    = "community_tax"
-	WithdrawEnabled = "withdraw_enabled"
+	CommunityTax      = "community_tax"
+	WithdrawEnabled   = "withdraw_enabled"
+	FeeBurnPercentage = "fee_burn_percentage"
+	AutoCompoundFreq  = "auto_compound_frequency"
+	MaxAutoCompounds  = "max_auto_compounds_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -27,6 +30,21 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenFeeBurnPercentage randomized FeeBurnPercentage
+func GenFeeBurnPercentage(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// GenAutoCompoundFrequency randomized AutoCompoundFrequency
+func GenAutoCompoundFrequency(r *rand.Rand) uint64 {
+	return uint64(r.Intn(101))
+}
+
+// GenMaxAutoCompoundsPerBlock randomized MaxAutoCompoundsPerBlock
+func GenMaxAutoCompoundsPerBlock(r *rand.Rand) uint64 {
+	return uint64(r.Intn(200) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax math.LegacyDec
@@ -35,11 +53,23 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var withdrawEnabled bool
 	simState.AppParams.GetOrGenerate(WithdrawEnabled, &withdrawEnabled, simState.Rand, func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) })
 
+	var feeBurnPercentage math.LegacyDec
+	simState.AppParams.GetOrGenerate(FeeBurnPercentage, &feeBurnPercentage, simState.Rand, func(r *rand.Rand) { feeBurnPercentage = GenFeeBurnPercentage(r) })
+
+	var autoCompoundFrequency uint64
+	simState.AppParams.GetOrGenerate(AutoCompoundFreq, &autoCompoundFrequency, simState.Rand, func(r *rand.Rand) { autoCompoundFrequency = GenAutoCompoundFrequency(r) })
+
+	var maxAutoCompoundsPerBlock uint64
+	simState.AppParams.GetOrGenerate(MaxAutoCompounds, &maxAutoCompoundsPerBlock, simState.Rand, func(r *rand.Rand) { maxAutoCompoundsPerBlock = GenMaxAutoCompoundsPerBlock(r) })
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:             communityTax,
+			WithdrawAddrEnabled:      withdrawEnabled,
+			FeeBurnPercentage:        feeBurnPercentage,
+			AutoCompoundFrequency:    autoCompoundFrequency,
+			MaxAutoCompoundsPerBlock: maxAutoCompoundsPerBlock,
 		},
 	}
 
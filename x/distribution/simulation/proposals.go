@@ -40,6 +40,7 @@ func SimulateMsgUpdateParams(_ context.Context, r *rand.Rand, _ []simtypes.Accou
 	params := types.DefaultParams()
 	params.CommunityTax = simtypes.RandomDecAmount(r, sdkmath.LegacyNewDec(1))
 	params.WithdrawAddrEnabled = r.Intn(2) == 0
+	params.FeeBurnPercentage = simtypes.RandomDecAmount(r, sdkmath.LegacyNewDecWithPrec(1, 1))
 
 	authorityAddr, err := cdc.BytesToString(authority)
 	if err != nil {
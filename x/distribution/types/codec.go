@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/distr/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/distr/MsgWithdrawAllRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCommissionAutoWithdrawThreshold{}, "cosmos-sdk/distr/MsgSetCommAutoWithdraw")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params")
 }
@@ -30,6 +33,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSetWithdrawAddress{},
 		&MsgUpdateParams{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgSetAutoCompound{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgSetCommissionAutoWithdrawThreshold{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -38,6 +38,18 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// fee_burn_percentage is the fraction of the collected fees burned at the
+	// beginning of every block, before the remaining fees are allocated to the
+	// validators and the community pool.
+	FeeBurnPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fee_burn_percentage,json=feeBurnPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_percentage"`
+	// auto_compound_frequency is the number of blocks between two compoundings
+	// of the rewards of the delegations opted in to auto-compounding. Zero
+	// disables auto-compounding.
+	AutoCompoundFrequency uint64 `protobuf:"varint,6,opt,name=auto_compound_frequency,json=autoCompoundFrequency,proto3" json:"auto_compound_frequency,omitempty"`
+	// max_auto_compounds_per_block is the maximum number of delegations whose
+	// rewards are compounded in a single block. A compounding round over more
+	// delegations goes on in the next blocks.
+	MaxAutoCompoundsPerBlock uint64 `protobuf:"varint,7,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +92,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundFrequency() uint64 {
+	if m != nil {
+		return m.AutoCompoundFrequency
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -246,6 +272,54 @@ func (m *ValidatorAccumulatedCommission) GetCommission() github_com_cosmos_cosmo
 	return nil
 }
 
+// CommissionAutoWithdrawThreshold is the amount of accumulated commission at
+// which the commission of a validator is automatically withdrawn.
+type CommissionAutoWithdrawThreshold struct {
+	// threshold is the commission amount triggering the withdrawal, once
+	// reached in any of its denoms.
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+}
+
+func (m *CommissionAutoWithdrawThreshold) Reset()         { *m = CommissionAutoWithdrawThreshold{} }
+func (m *CommissionAutoWithdrawThreshold) String() string { return proto.CompactTextString(m) }
+func (*CommissionAutoWithdrawThreshold) ProtoMessage()    {}
+func (*CommissionAutoWithdrawThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{4}
+}
+func (m *CommissionAutoWithdrawThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionAutoWithdrawThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionAutoWithdrawThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionAutoWithdrawThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionAutoWithdrawThreshold.Merge(m, src)
+}
+func (m *CommissionAutoWithdrawThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionAutoWithdrawThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionAutoWithdrawThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionAutoWithdrawThreshold proto.InternalMessageInfo
+
+func (m *CommissionAutoWithdrawThreshold) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
+	}
+	return nil
+}
+
 // ValidatorOutstandingRewards represents outstanding (un-withdrawn) rewards
 // for a validator inexpensive to track, allows simple sanity checks.
 type ValidatorOutstandingRewards struct {
@@ -256,7 +330,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +379,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +424,7 @@ func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
 func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvents) ProtoMessage()    {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +471,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +536,7 @@ func (m *CommunityPoolSpendProposal) Reset()         { *m = CommunityPoolSpendPr
 func (m *CommunityPoolSpendProposal) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposal) ProtoMessage()    {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +581,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +635,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,6 +664,54 @@ func (m *DelegationDelegatorReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
 
+// DelegationDelegatorRewardProjection represents the accrued rewards of a
+// delegator's delegation, along with the rewards projected to be accrued in
+// the future.
+type DelegationDelegatorRewardProjection struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// reward defines the rewards accrued so far.
+	Reward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+	// projected_reward defines the rewards expected to be accrued at the end of
+	// the projection, including the rewards accrued so far.
+	ProjectedReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=projected_reward,json=projectedReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"projected_reward"`
+	// apr defines the estimated annual percentage rate of the delegation, from
+	// provisions only. It is zero when the minted denom is not the bond denom.
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+}
+
+func (m *DelegationDelegatorRewardProjection) Reset()         { *m = DelegationDelegatorRewardProjection{} }
+func (m *DelegationDelegatorRewardProjection) String() string { return proto.CompactTextString(m) }
+func (*DelegationDelegatorRewardProjection) ProtoMessage()    {}
+func (*DelegationDelegatorRewardProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *DelegationDelegatorRewardProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationDelegatorRewardProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationDelegatorRewardProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationDelegatorRewardProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationDelegatorRewardProjection.Merge(m, src)
+}
+func (m *DelegationDelegatorRewardProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationDelegatorRewardProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationDelegatorRewardProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationDelegatorRewardProjection proto.InternalMessageInfo
+
 // CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
 // with a deposit
 //
@@ -608,7 +730,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,6 +764,7 @@ func init() {
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
+	proto.RegisterType((*CommissionAutoWithdrawThreshold)(nil), "cosmos.distribution.v1beta1.CommissionAutoWithdrawThreshold")
 	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
 	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
@@ -649,6 +772,7 @@ func init() {
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
+	proto.RegisterType((*DelegationDelegatorRewardProjection)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorRewardProjection")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
 }
 
@@ -657,73 +781,86 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x24, 0x8e, 0xd3, 0x4c, 0x7f, 0xa4, 0xdd, 0x24, 0xed, 0x26, 0x6d, 0x6d, 0xd7, 0x50,
+	0x11, 0x02, 0xb1, 0x9b, 0x56, 0x42, 0xc8, 0x17, 0x54, 0x3b, 0xad, 0x40, 0x2a, 0xd4, 0x6c, 0x2b,
+	0x10, 0x70, 0x58, 0x8d, 0x77, 0x27, 0xf6, 0x34, 0xbb, 0x33, 0xdb, 0x99, 0x59, 0x37, 0x39, 0x15,
+	0x6e, 0x85, 0x03, 0x70, 0x03, 0x71, 0xaa, 0xe0, 0x52, 0x71, 0xca, 0x21, 0x57, 0xee, 0x05, 0x09,
+	0xa9, 0xaa, 0x00, 0xa1, 0x1e, 0x0a, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0xb5, 0x1d,
+	0x12, 0x48, 0x53, 0x05, 0xc4, 0x25, 0xca, 0xbc, 0xd9, 0xf9, 0xbe, 0xef, 0xcd, 0xbc, 0x5f, 0x86,
+	0x25, 0x8f, 0x89, 0x90, 0x89, 0xb2, 0x4f, 0x84, 0xe4, 0xa4, 0x11, 0x4b, 0xc2, 0x68, 0xb9, 0x3d,
+	0xdf, 0xc0, 0x12, 0xcd, 0xf7, 0x19, 0x4b, 0x11, 0x67, 0x92, 0x59, 0x27, 0xf5, 0xf7, 0xa5, 0xbe,
+	0x2d, 0xf3, 0xfd, 0xf4, 0x44, 0x93, 0x35, 0x59, 0xf2, 0x5d, 0x59, 0xfd, 0xa7, 0x8f, 0x4c, 0xe7,
+	0x0c, 0x45, 0x03, 0x09, 0x9c, 0x42, 0x7b, 0x8c, 0x18, 0xc8, 0xe9, 0x29, 0xbd, 0xef, 0xea, 0x83,
+	0x06, 0x5f, 0x6f, 0x1d, 0x43, 0x21, 0xa1, 0xac, 0x9c, 0xfc, 0xd5, 0xa6, 0xe2, 0xb7, 0xc3, 0x30,
+	0x5b, 0x47, 0x1c, 0x85, 0xc2, 0x7a, 0x0f, 0x1e, 0xf6, 0x58, 0x18, 0xc6, 0x94, 0xc8, 0x15, 0x57,
+	0xa2, 0x65, 0x1b, 0x14, 0xc0, 0xcc, 0x68, 0xf5, 0xa5, 0xfb, 0x8f, 0xf3, 0x03, 0x8f, 0x1e, 0xe7,
+	0x8d, 0x54, 0xe1, 0x2f, 0x95, 0x08, 0x2b, 0x87, 0x48, 0xb6, 0x4a, 0x57, 0x70, 0x13, 0x79, 0x2b,
+	0x0b, 0xd8, 0x7b, 0xb8, 0x36, 0x07, 0x0d, 0xd3, 0x02, 0xf6, 0xee, 0x6d, 0xae, 0xce, 0x02, 0xe7,
+	0x50, 0x0a, 0x76, 0x1d, 0x2d, 0x5b, 0x37, 0xe0, 0x84, 0x12, 0xac, 0x54, 0x45, 0x4c, 0x60, 0xee,
+	0x72, 0x7c, 0x0b, 0x71, 0xdf, 0x1e, 0x4c, 0x38, 0x5e, 0xde, 0x1b, 0x87, 0x0d, 0x1c, 0x4b, 0xa1,
+	0xd6, 0x0d, 0xa8, 0x93, 0x60, 0x5a, 0x01, 0x9c, 0x6c, 0x30, 0x1a, 0x8b, 0xbf, 0x90, 0x0d, 0x3d,
+	0x25, 0xd9, 0x78, 0x02, 0xbb, 0x85, 0xed, 0x3c, 0x9c, 0xbc, 0x45, 0x64, 0xcb, 0xe7, 0xe8, 0x96,
+	0x8b, 0x7c, 0x9f, 0xbb, 0x98, 0xa2, 0x46, 0x80, 0x7d, 0x3b, 0x53, 0x00, 0x33, 0x07, 0x9c, 0xf1,
+	0xce, 0xe6, 0x45, 0xdf, 0xe7, 0x97, 0xf4, 0x96, 0x75, 0x1b, 0x8e, 0x2f, 0x62, 0xec, 0x36, 0x62,
+	0x4e, 0xdd, 0x08, 0x73, 0x0f, 0x53, 0x89, 0x9a, 0xd8, 0x1e, 0x4e, 0xf4, 0x5d, 0x7d, 0x62, 0x7d,
+	0x8f, 0xd6, 0xe6, 0x26, 0x97, 0xfb, 0xc2, 0xab, 0xd0, 0x9e, 0x2f, 0x9d, 0x2b, 0x9d, 0xd3, 0x2f,
+	0x71, 0x6c, 0x11, 0xe3, 0x6a, 0xcc, 0x69, 0x3d, 0x65, 0xb2, 0xde, 0x84, 0x27, 0x50, 0x2c, 0x99,
+	0xeb, 0xb1, 0x30, 0x62, 0x31, 0xf5, 0xdd, 0x45, 0x8e, 0x6f, 0xc6, 0x98, 0x7a, 0x2b, 0x76, 0xb6,
+	0x00, 0x66, 0x32, 0xd5, 0xa9, 0x1d, 0x31, 0x9d, 0x49, 0x75, 0xb2, 0x66, 0x0e, 0x5e, 0xee, 0x9c,
+	0xb3, 0xde, 0x81, 0xa7, 0x42, 0xb4, 0xec, 0xf6, 0xc1, 0x0a, 0xe5, 0x9d, 0xdb, 0x08, 0x98, 0xb7,
+	0x64, 0x8f, 0xfc, 0x13, 0xae, 0x1d, 0xa2, 0xe5, 0x8b, 0x3d, 0xd0, 0xa2, 0x8e, 0x79, 0x55, 0x1d,
+	0xad, 0x9c, 0xfd, 0x68, 0x73, 0x75, 0xb6, 0xa0, 0xfd, 0x9d, 0x13, 0xfe, 0x52, 0xb9, 0x1f, 0xa0,
+	0xac, 0x03, 0xb8, 0xf8, 0x13, 0x80, 0xd3, 0x6f, 0xa1, 0x80, 0xf8, 0x48, 0x32, 0xfe, 0x2a, 0x11,
+	0x92, 0x71, 0xe2, 0xa1, 0x40, 0xbf, 0x93, 0xb0, 0x3e, 0x06, 0xf0, 0x84, 0x17, 0x87, 0x71, 0x80,
+	0x24, 0x69, 0x63, 0x13, 0x13, 0x2e, 0x47, 0x92, 0x30, 0x1b, 0x14, 0x86, 0x66, 0x0e, 0x9e, 0x3f,
+	0x65, 0xd2, 0xb7, 0xa4, 0x82, 0xaa, 0x93, 0x86, 0xea, 0x82, 0x6b, 0x8c, 0x50, 0x1d, 0x37, 0x5f,
+	0xff, 0x92, 0x7f, 0xa1, 0x49, 0x64, 0x2b, 0x6e, 0x94, 0x3c, 0x16, 0x9a, 0xf4, 0x2a, 0xf7, 0x48,
+	0x93, 0x2b, 0x11, 0x16, 0x9d, 0x33, 0x42, 0x3f, 0xc0, 0x64, 0x97, 0x56, 0x8b, 0x71, 0x14, 0xa9,
+	0xf5, 0x1c, 0x1c, 0xe3, 0x78, 0x11, 0x73, 0x4c, 0x3d, 0xec, 0x7a, 0x2c, 0xa6, 0x32, 0x49, 0x87,
+	0xc3, 0xce, 0x91, 0xd4, 0x5c, 0x53, 0xd6, 0xe2, 0x57, 0x00, 0x9e, 0x48, 0x1d, 0xab, 0xc5, 0x9c,
+	0x63, 0x2a, 0x3b, 0x5e, 0x45, 0x70, 0x44, 0x7b, 0x22, 0xf6, 0xd9, 0x89, 0x0e, 0x8d, 0x75, 0x1c,
+	0x66, 0x23, 0xcc, 0x09, 0xd3, 0xc9, 0x9b, 0x71, 0xcc, 0xaa, 0xf8, 0x39, 0x80, 0xb9, 0x54, 0xe5,
+	0x45, 0xcf, 0xf8, 0x8c, 0xfd, 0x1a, 0x0b, 0x43, 0x22, 0x04, 0x61, 0xd4, 0x6a, 0x43, 0xe8, 0xa5,
+	0xab, 0x7d, 0xd6, 0xdb, 0xc3, 0x54, 0xfc, 0x06, 0xc0, 0x7c, 0x57, 0x86, 0x0a, 0xb2, 0xb7, 0x4d,
+	0x56, 0x5e, 0x6f, 0x71, 0x2c, 0x5a, 0x2c, 0x50, 0x39, 0x39, 0x2a, 0x3b, 0x0b, 0x23, 0x6d, 0x6a,
+	0x5b, 0x69, 0x89, 0xae, 0xcb, 0x46, 0xd7, 0xcc, 0x2e, 0x74, 0x25, 0xa2, 0xbe, 0xd8, 0x5c, 0x9d,
+	0x3d, 0x14, 0x24, 0xf9, 0xeb, 0x7a, 0x5d, 0x95, 0x5d, 0xce, 0xca, 0xd4, 0xc3, 0x9d, 0x52, 0xa3,
+	0xf8, 0x09, 0x80, 0x27, 0xd3, 0xab, 0xbd, 0x1a, 0x4b, 0x21, 0x11, 0xf5, 0x09, 0x6d, 0xfe, 0x67,
+	0x41, 0xa0, 0x14, 0x8d, 0xa7, 0x8a, 0xae, 0x05, 0x48, 0xb4, 0x2e, 0xb5, 0x31, 0x95, 0xd6, 0xf3,
+	0xf0, 0x68, 0xbb, 0x63, 0x76, 0x4d, 0x98, 0x80, 0x24, 0x4c, 0xc6, 0x52, 0x7b, 0x3d, 0x31, 0x5b,
+	0xaf, 0xc3, 0x03, 0x8b, 0x1c, 0x79, 0xca, 0x4f, 0xd3, 0x06, 0xe6, 0x9f, 0xb8, 0xf2, 0x39, 0x29,
+	0x44, 0xf1, 0x43, 0x00, 0x27, 0xb6, 0x51, 0x24, 0xac, 0x9b, 0xf0, 0x78, 0x57, 0x92, 0x50, 0x1b,
+	0x2e, 0x4e, 0x76, 0xcc, 0x5d, 0x9d, 0x2b, 0xfd, 0x4d, 0x13, 0x2e, 0x6d, 0x03, 0x59, 0x1d, 0x55,
+	0x3a, 0xf5, 0x85, 0x4c, 0xb4, 0xb7, 0xa1, 0x2c, 0xbe, 0x3f, 0x08, 0x47, 0x2e, 0x63, 0x5c, 0x67,
+	0x2c, 0xb0, 0x6e, 0xc3, 0x23, 0xdd, 0xb6, 0x1a, 0x31, 0x16, 0xec, 0xea, 0x89, 0x2a, 0x7b, 0x7d,
+	0x22, 0x1b, 0x38, 0xdd, 0x36, 0x9e, 0x08, 0x90, 0xf0, 0x90, 0x8f, 0x3d, 0x12, 0xa2, 0x40, 0xd3,
+	0x0f, 0xee, 0x82, 0xfe, 0xc2, 0x1e, 0xe8, 0x9d, 0x83, 0x86, 0x46, 0xb1, 0x16, 0x3f, 0x1b, 0x84,
+	0xd3, 0xb5, 0x5e, 0x1d, 0xd7, 0x22, 0x4c, 0x7d, 0xdd, 0x3b, 0x51, 0x60, 0x4d, 0xc0, 0x61, 0x49,
+	0x64, 0x80, 0xf5, 0x90, 0xe1, 0xe8, 0x85, 0x55, 0x80, 0x07, 0x7d, 0x2c, 0x3c, 0x4e, 0xa2, 0x6e,
+	0x54, 0x38, 0xbd, 0x26, 0xeb, 0x14, 0x1c, 0xe5, 0xd8, 0x23, 0x11, 0xc1, 0x54, 0xea, 0x7e, 0xee,
+	0x74, 0x0d, 0xd6, 0x0a, 0xcc, 0xa2, 0x30, 0x29, 0xa4, 0x99, 0x7f, 0x2b, 0x81, 0x0d, 0x61, 0x65,
+	0xe6, 0xce, 0xdd, 0xfc, 0xc0, 0xef, 0x77, 0xf3, 0x03, 0xdf, 0xad, 0xcd, 0x4d, 0x1b, 0xd6, 0x26,
+	0x6b, 0xf7, 0x90, 0x52, 0xa9, 0x34, 0x83, 0xe2, 0x0f, 0x00, 0x4e, 0x2e, 0x60, 0x85, 0xa4, 0xa2,
+	0x46, 0x22, 0x2e, 0x09, 0x6d, 0xbe, 0x46, 0x17, 0x93, 0x86, 0x10, 0x71, 0xdc, 0x26, 0x2c, 0x16,
+	0xfd, 0xb9, 0x73, 0xa4, 0x63, 0x36, 0xa9, 0x73, 0x05, 0x0e, 0x0b, 0x89, 0x96, 0xb0, 0x3d, 0xf8,
+	0x54, 0x23, 0x9a, 0x06, 0xb1, 0x16, 0x60, 0xb6, 0x85, 0x49, 0xb3, 0xa5, 0x2f, 0x34, 0x53, 0x7d,
+	0xf1, 0x8f, 0xc7, 0xf9, 0x31, 0x8f, 0x63, 0xd5, 0xa4, 0xa8, 0xab, 0xb7, 0xbe, 0xdc, 0x5c, 0x9d,
+	0xdd, 0x6a, 0x33, 0x17, 0xa0, 0x17, 0xc5, 0xdf, 0x00, 0x9c, 0x32, 0x6e, 0x11, 0x46, 0x53, 0x07,
+	0xcd, 0x94, 0xf4, 0x06, 0x3c, 0xd6, 0x4d, 0x42, 0x35, 0x26, 0x61, 0x21, 0xcc, 0x80, 0x79, 0xe6,
+	0xe1, 0xda, 0xdc, 0x69, 0x23, 0xad, 0xdb, 0x3f, 0xf4, 0x27, 0xd7, 0x24, 0x57, 0x65, 0xee, 0x68,
+	0x7b, 0x8b, 0xdd, 0xa2, 0x30, 0x9b, 0x4e, 0x90, 0xfb, 0x59, 0xf0, 0x0c, 0x4b, 0x25, 0xa3, 0x9e,
+	0xb7, 0xf8, 0xfd, 0x10, 0x7c, 0x66, 0x47, 0x1f, 0xeb, 0x9c, 0xdd, 0xc0, 0x49, 0x2d, 0xfa, 0xbf,
+	0x7b, 0x6b, 0x7d, 0x00, 0xe0, 0xd1, 0x48, 0xbb, 0x83, 0xfd, 0xee, 0xf4, 0xbc, 0x9f, 0xd4, 0x63,
+	0x29, 0x9f, 0x89, 0x98, 0x1a, 0x1c, 0x42, 0x11, 0xb7, 0x33, 0x7b, 0xed, 0x0c, 0xea, 0x74, 0xe5,
+	0xb4, 0x7a, 0xb6, 0x9d, 0xfb, 0xea, 0x8f, 0x00, 0x9e, 0xdd, 0xb9, 0x48, 0xa9, 0x19, 0x61, 0x01,
+	0x47, 0x4c, 0x10, 0xb9, 0x4f, 0xf5, 0xea, 0x78, 0x4f, 0xbd, 0x52, 0x5b, 0x66, 0x65, 0xd9, 0x70,
+	0xc4, 0xd7, 0xc4, 0xfa, 0x37, 0x81, 0xd3, 0x59, 0x56, 0x9e, 0xbd, 0xb3, 0x8b, 0x12, 0x53, 0x7d,
+	0xe5, 0xde, 0x7a, 0x0e, 0xdc, 0x5f, 0xcf, 0x81, 0x07, 0xeb, 0x39, 0xf0, 0xeb, 0x7a, 0x0e, 0x7c,
+	0xba, 0x91, 0x1b, 0x78, 0xb0, 0x91, 0x1b, 0xf8, 0x79, 0x23, 0x37, 0xf0, 0xee, 0x99, 0xbe, 0x4b,
+	0xdc, 0x32, 0x4b, 0x27, 0x6f, 0xd3, 0xc8, 0x26, 0xbf, 0x0e, 0x2f, 0xfc, 0x39, 0x00, 0x6e, 0xce,
+	0xe8, 0xbc, 0xd0, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +894,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.FeeBurnPercentage.Equal(that1.FeeBurnPercentage) {
+		return false
+	}
+	if this.AutoCompoundFrequency != that1.AutoCompoundFrequency {
+		return false
+	}
+	if this.MaxAutoCompoundsPerBlock != that1.MaxAutoCompoundsPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -852,6 +998,35 @@ func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CommissionAutoWithdrawThreshold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionAutoWithdrawThreshold)
+	if !ok {
+		that2, ok := that.(CommissionAutoWithdrawThreshold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Threshold) != len(that1.Threshold) {
+		return false
+	}
+	for i := range this.Threshold {
+		if !this.Threshold[i].Equal(&that1.Threshold[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1036,6 +1211,49 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegationDelegatorRewardProjection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationDelegatorRewardProjection)
+	if !ok {
+		that2, ok := that.(DelegationDelegatorRewardProjection)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
+			return false
+		}
+	}
+	if len(this.ProjectedReward) != len(that1.ProjectedReward) {
+		return false
+	}
+	for i := range this.ProjectedReward {
+		if !this.ProjectedReward[i].Equal(&that1.ProjectedReward[i]) {
+			return false
+		}
+	}
+	if !this.Apr.Equal(that1.Apr) {
+		return false
+	}
+	return true
+}
 func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1092,6 +1310,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompoundFrequency != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundFrequency))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.FeeBurnPercentage.Size()
+		i -= size
+		if _, err := m.FeeBurnPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1256,6 +1494,43 @@ func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionAutoWithdrawThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionAutoWithdrawThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionAutoWithdrawThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1564,6 +1839,74 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationDelegatorRewardProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorRewardProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorRewardProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProjectedReward) > 0 {
+		for iNdEx := len(m.ProjectedReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1648,6 +1991,14 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.FeeBurnPercentage.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.AutoCompoundFrequency != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundFrequency))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundsPerBlock))
+	}
 	return n
 }
 
@@ -1702,6 +2053,21 @@ func (m *ValidatorAccumulatedCommission) Size() (n int) {
 	return n
 }
 
+func (m *CommissionAutoWithdrawThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewards) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1830,19 +2196,46 @@ func (m *DelegationDelegatorReward) Size() (n int) {
 	return n
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+func (m *DelegationDelegatorRewardProjection) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.ProjectedReward) > 0 {
+		for _, e := range m.ProjectedReward {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	l = m.Apr.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
 	}
 	l = len(m.Recipient)
 	if l > 0 {
@@ -2016,6 +2409,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFrequency", wireType)
+			}
+			m.AutoCompoundFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2327,6 +2792,90 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommissionAutoWithdrawThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionAutoWithdrawThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionAutoWithdrawThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3134,6 +3683,190 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegationDelegatorRewardProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationDelegatorRewardProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationDelegatorRewardProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedReward = append(m.ProjectedReward, types.DecCoin{})
+			if err := m.ProjectedReward[len(m.ProjectedReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
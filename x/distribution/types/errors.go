@@ -18,4 +18,5 @@ var (
 	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
 	ErrInvalidProposalContent  = errors.Register(ModuleName, 14, "invalid proposal content")
 	ErrInvalidSigner           = errors.Register(ModuleName, 15, "expected authority account as only signer for proposal message")
+	ErrAutoCompoundDisabled    = errors.Register(ModuleName, 16, "auto-compounding disabled")
 )
@@ -8,8 +8,16 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeBurnFees           = "burn_fees"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+
+	EventTypeSetCommissionAutoWithdrawThreshold = "set_commission_auto_withdraw_threshold"
+	EventTypeAutoWithdrawCommission             = "auto_withdraw_commission"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyThreshold       = "threshold"
 )
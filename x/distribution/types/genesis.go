@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
@@ -21,15 +27,18 @@ func NewGenesisState(
 // get raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		FeePool:                         InitialFeePool(),
-		Params:                          DefaultParams(),
-		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
-		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
-		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
-		ValidatorHistoricalRewards:      []ValidatorHistoricalRewardsRecord{},
-		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
-		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
-		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FeePool:                          InitialFeePool(),
+		Params:                           DefaultParams(),
+		DelegatorWithdrawInfos:           []DelegatorWithdrawInfo{},
+		OutstandingRewards:               []ValidatorOutstandingRewardsRecord{},
+		ValidatorAccumulatedCommissions:  []ValidatorAccumulatedCommissionRecord{},
+		ValidatorHistoricalRewards:       []ValidatorHistoricalRewardsRecord{},
+		ValidatorCurrentRewards:          []ValidatorCurrentRewardsRecord{},
+		DelegatorStartingInfos:           []DelegatorStartingInfoRecord{},
+		ValidatorSlashEvents:             []ValidatorSlashEventRecord{},
+		BurnedFees:                       sdk.NewCoins(),
+		AutoCompoundDelegations:          []AutoCompoundDelegation{},
+		CommissionAutoWithdrawThresholds: []CommissionAutoWithdrawThresholdRecord{},
 	}
 }
 
@@ -38,5 +47,16 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := gs.BurnedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid burned fees: %w", err)
+	}
+	for _, record := range gs.CommissionAutoWithdrawThresholds {
+		if record.Threshold.Empty() {
+			return fmt.Errorf("empty commission auto-withdraw threshold for validator %s", record.ValidatorAddress)
+		}
+		if err := record.Threshold.Validate(); err != nil {
+			return fmt.Errorf("invalid commission auto-withdraw threshold for validator %s: %w", record.ValidatorAddress, err)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
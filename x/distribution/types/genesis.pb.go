@@ -70,6 +70,90 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// AutoCompoundDelegation is a delegation whose rewards are periodically
+// re-delegated to its validator.
+type AutoCompoundDelegation struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundDelegation) Reset()         { *m = AutoCompoundDelegation{} }
+func (m *AutoCompoundDelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegation) ProtoMessage()    {}
+func (*AutoCompoundDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *AutoCompoundDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegation.Merge(m, src)
+}
+func (m *AutoCompoundDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegation proto.InternalMessageInfo
+
+// CommissionAutoWithdrawThresholdRecord is used for import/export via genesis
+// json.
+type CommissionAutoWithdrawThresholdRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// threshold is the commission amount triggering the automatic withdrawal.
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+}
+
+func (m *CommissionAutoWithdrawThresholdRecord) Reset()         { *m = CommissionAutoWithdrawThresholdRecord{} }
+func (m *CommissionAutoWithdrawThresholdRecord) String() string { return proto.CompactTextString(m) }
+func (*CommissionAutoWithdrawThresholdRecord) ProtoMessage()    {}
+func (*CommissionAutoWithdrawThresholdRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{2}
+}
+func (m *CommissionAutoWithdrawThresholdRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionAutoWithdrawThresholdRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionAutoWithdrawThresholdRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionAutoWithdrawThresholdRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionAutoWithdrawThresholdRecord.Merge(m, src)
+}
+func (m *CommissionAutoWithdrawThresholdRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionAutoWithdrawThresholdRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionAutoWithdrawThresholdRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionAutoWithdrawThresholdRecord proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -82,7 +166,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{3}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -124,7 +208,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +252,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+	return fileDescriptor_76eed0f9489db580, []int{5}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +293,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +336,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +381,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,13 +430,21 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// burned_fees defines the cumulative amount of collected fees burned at genesis.
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+	// auto_compound_delegations defines the delegations opted in to
+	// auto-compounding at genesis.
+	AutoCompoundDelegations []AutoCompoundDelegation `protobuf:"bytes,12,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations"`
+	// commission_auto_withdraw_thresholds defines the commission auto-withdraw
+	// thresholds of the validators at genesis.
+	CommissionAutoWithdrawThresholds []CommissionAutoWithdrawThresholdRecord `protobuf:"bytes,13,rep,name=commission_auto_withdraw_thresholds,json=commissionAutoWithdrawThresholds,proto3" json:"commission_auto_withdraw_thresholds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,6 +475,8 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*AutoCompoundDelegation)(nil), "cosmos.distribution.v1beta1.AutoCompoundDelegation")
+	proto.RegisterType((*CommissionAutoWithdrawThresholdRecord)(nil), "cosmos.distribution.v1beta1.CommissionAutoWithdrawThresholdRecord")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
@@ -397,65 +491,78 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0xcd, 0x4e, 0x8a, 0xd8, 0x9d, 0x6e, 0x17, 0xef, 0xb6, 0x75, 0x36, 0xa5,
-	0x87, 0x02, 0x5a, 0x87, 0x06, 0x04, 0x55, 0x11, 0xa0, 0x26, 0x6d, 0x81, 0x1e, 0xa0, 0x4a, 0x24,
-	0x10, 0x08, 0x29, 0x9a, 0xd8, 0x13, 0x67, 0xd4, 0xc4, 0x13, 0x79, 0x26, 0x0e, 0x20, 0x71, 0xe0,
-	0x54, 0xc4, 0x89, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x47, 0x54, 0xe2, 0x52,
-	0x55, 0x1c, 0x38, 0x01, 0xca, 0x1e, 0x40, 0x1c, 0xf9, 0x05, 0xc8, 0x9e, 0xb1, 0x3d, 0x59, 0xbb,
-	0xd9, 0xec, 0xd2, 0x5c, 0x76, 0xe3, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x3d, 0xbf, 0x67, 0xf0,
-	0x82, 0x45, 0xd9, 0x88, 0xb2, 0xba, 0x4d, 0x18, 0xf7, 0x48, 0x6f, 0xc2, 0x09, 0x75, 0xeb, 0xfe,
-	0xe5, 0x1e, 0xe6, 0xe8, 0x72, 0xdd, 0xc1, 0x2e, 0x66, 0x84, 0x99, 0x63, 0x8f, 0x72, 0x0a, 0xcf,
-	0x0a, 0x53, 0x53, 0x35, 0x35, 0xa5, 0xe9, 0xce, 0xa6, 0x43, 0x1d, 0x1a, 0xda, 0xd5, 0x83, 0x5f,
-	0xc2, 0x65, 0xc7, 0x90, 0xd1, 0x7b, 0x88, 0xe1, 0x38, 0xaa, 0x45, 0x89, 0x2b, 0xef, 0xcd, 0x45,
-	0xe8, 0x73, 0x38, 0xc2, 0x7e, 0x5b, 0xd8, 0x77, 0x05, 0x90, 0xe4, 0x23, 0xae, 0x36, 0xd0, 0x88,
-	0xb8, 0xb4, 0x1e, 0xfe, 0x15, 0x47, 0x17, 0x7e, 0xd2, 0xc0, 0x99, 0xeb, 0x78, 0x88, 0x1d, 0xc4,
-	0xa9, 0xf7, 0x11, 0xe1, 0x03, 0xdb, 0x43, 0xd3, 0xf7, 0xdc, 0x3e, 0x85, 0x37, 0xc0, 0x86, 0x1d,
-	0x5d, 0x74, 0x91, 0x6d, 0x7b, 0x98, 0x31, 0x5d, 0xdb, 0xd5, 0x2e, 0xad, 0x35, 0xf5, 0xc7, 0x0f,
-	0xf6, 0x36, 0x65, 0xe4, 0x6b, 0xe2, 0xa6, 0xc3, 0x3d, 0xe2, 0x3a, 0xed, 0xf5, 0xd8, 0x45, 0x9e,
-	0xc3, 0x16, 0x58, 0x9f, 0xca, 0xb0, 0x71, 0x94, 0xfc, 0x21, 0x51, 0x9e, 0x8d, 0x3c, 0xe4, 0xf1,
-	0xd5, 0xf2, 0xd7, 0xf7, 0xaa, 0xb9, 0xbf, 0xef, 0x55, 0x73, 0x17, 0xee, 0xe6, 0x41, 0xed, 0x43,
-	0x34, 0x24, 0x76, 0x80, 0xf1, 0xc1, 0x84, 0x33, 0x8e, 0x5c, 0x3b, 0xf0, 0xc1, 0x53, 0xe4, 0xd9,
-	0xac, 0x8d, 0x2d, 0xea, 0xd9, 0xf0, 0x7d, 0xb0, 0xe1, 0x47, 0x46, 0x07, 0xb8, 0xd7, 0x1e, 0x3f,
-	0xd8, 0x3b, 0x2f, 0x51, 0xe3, 0x40, 0x07, 0x44, 0xf8, 0x07, 0xce, 0xe1, 0x5d, 0x0d, 0x9c, 0xa6,
-	0x09, 0x58, 0xd7, 0x13, 0x68, 0x7a, 0x7e, 0xb7, 0x70, 0xa9, 0xd2, 0x38, 0x27, 0x4b, 0x64, 0x06,
-	0x25, 0x8c, 0xaa, 0x6d, 0x5e, 0xc7, 0x56, 0x8b, 0x12, 0xb7, 0x79, 0xe5, 0xe1, 0xef, 0xd5, 0xdc,
-	0x8f, 0x7f, 0x54, 0x5f, 0x72, 0x08, 0x1f, 0x4c, 0x7a, 0xa6, 0x45, 0x47, 0xb2, 0x2a, 0xf2, 0xdf,
-	0x1e, 0xb3, 0xef, 0xd4, 0xf9, 0xe7, 0x63, 0xcc, 0x22, 0x1f, 0x76, 0xff, 0xaf, 0x9f, 0x5f, 0xd4,
-	0xda, 0x90, 0xa6, 0xf4, 0x29, 0x99, 0xf8, 0x47, 0x03, 0x17, 0x13, 0x01, 0x96, 0x35, 0x19, 0x4d,
-	0x86, 0x88, 0x63, 0xbb, 0x45, 0x47, 0x23, 0xc2, 0x18, 0xa1, 0xee, 0x8a, 0x92, 0x31, 0x00, 0x15,
-	0x94, 0xc0, 0x85, 0xc5, 0xac, 0x34, 0xde, 0x30, 0x17, 0x74, 0xbe, 0xb9, 0x98, 0x67, 0x73, 0x2d,
-	0x48, 0x91, 0xd0, 0xac, 0x86, 0x56, 0xc4, 0xfe, 0xab, 0x81, 0xdd, 0x38, 0xc8, 0xbb, 0x84, 0x71,
-	0xea, 0x11, 0x0b, 0x0d, 0x57, 0x5b, 0xf5, 0x2d, 0x50, 0x1a, 0x63, 0x8f, 0x50, 0xa1, 0xb1, 0xd8,
-	0x96, 0x4f, 0xf0, 0x53, 0x70, 0x32, 0x6a, 0x80, 0x42, 0x28, 0xfe, 0xf5, 0xe5, 0xc4, 0xa7, 0x78,
-	0xab, 0xc2, 0xa3, 0x90, 0x8a, 0xe8, 0x5f, 0x35, 0x70, 0x3e, 0x76, 0x6e, 0x4d, 0x3c, 0x0f, 0xbb,
-	0x7c, 0xb5, 0x8a, 0x3f, 0x4e, 0x94, 0x89, 0xb2, 0xbe, 0xba, 0x9c, 0xb2, 0x79, 0x72, 0x87, 0xc8,
-	0xfa, 0x21, 0x0f, 0xce, 0xc6, 0x23, 0xa7, 0xc3, 0x91, 0xc7, 0x89, 0xeb, 0x04, 0x23, 0x47, 0x8a,
-	0x7a, 0x4a, 0x83, 0x27, 0x33, 0x37, 0xf9, 0xe3, 0xe7, 0xa6, 0x07, 0x9e, 0x61, 0x92, 0x6c, 0x97,
-	0xb8, 0x7d, 0x2a, 0x6b, 0xdf, 0x58, 0x98, 0xa1, 0x4c, 0x9d, 0x6a, 0x7e, 0x4e, 0x31, 0xe5, 0x42,
-	0x49, 0xd2, 0x77, 0x79, 0xb0, 0x1d, 0x53, 0xeb, 0x0c, 0x11, 0x1b, 0xdc, 0xf0, 0xc3, 0x0c, 0xaf,
-	0xaa, 0xd3, 0x07, 0x98, 0x38, 0x03, 0x1e, 0x75, 0xba, 0x78, 0x52, 0xde, 0x80, 0xc2, 0xdc, 0x1b,
-	0x40, 0xc1, 0x99, 0x04, 0x9f, 0x05, 0xec, 0xba, 0x38, 0xa0, 0xa7, 0x17, 0xc3, 0x9c, 0xbc, 0xbc,
-	0x5c, 0xd7, 0x24, 0xb2, 0xd4, 0x8c, 0x9c, 0xf6, 0xd3, 0xf7, 0x4a, 0x62, 0x7e, 0x29, 0x83, 0x53,
-	0xef, 0x88, 0x9d, 0xdb, 0xe1, 0x88, 0x63, 0x78, 0x13, 0x94, 0xc6, 0xc8, 0x43, 0x23, 0x91, 0x80,
-	0x4a, 0xe3, 0xf9, 0x85, 0xe0, 0xb7, 0x43, 0x53, 0x15, 0x4f, 0x7a, 0xc3, 0x5b, 0xa0, 0xdc, 0xc7,
-	0xb8, 0x3b, 0xa6, 0x74, 0x28, 0x9b, 0xff, 0xe2, 0xc2, 0x48, 0x37, 0x31, 0xbe, 0x4d, 0xe9, 0x70,
-	0xae, 0xd9, 0xfb, 0xe2, 0x0c, 0x4e, 0x81, 0x9e, 0xb4, 0x70, 0xbc, 0xfe, 0x82, 0xae, 0x09, 0x46,
-	0x46, 0x61, 0xf9, 0xb6, 0x51, 0x37, 0xb2, 0x8a, 0xb4, 0x65, 0x67, 0x59, 0x30, 0xf8, 0x45, 0xf6,
-	0x9e, 0x3a, 0x11, 0x62, 0xbe, 0xb5, 0x5c, 0x59, 0x9e, 0xb4, 0x55, 0x55, 0xfc, 0x8c, 0xd5, 0x04,
-	0xbf, 0xd7, 0x40, 0x4d, 0xe9, 0xca, 0x64, 0x8e, 0x77, 0xad, 0x78, 0xd4, 0x33, 0xbd, 0x14, 0x52,
-	0xb9, 0xf6, 0x3f, 0xd6, 0x45, 0x9a, 0x4d, 0xd5, 0x5f, 0xe8, 0xc0, 0xe0, 0x37, 0x1a, 0x38, 0x97,
-	0x50, 0x1b, 0xc4, 0x73, 0x38, 0x4e, 0xd0, 0xc9, 0x90, 0xd5, 0x9b, 0xc7, 0x9c, 0xe3, 0x69, 0x46,
-	0x3b, 0xfe, 0x13, 0x8d, 0xe1, 0x57, 0x1a, 0xd8, 0x4e, 0xc8, 0x58, 0x62, 0x74, 0xc6, 0x4c, 0xca,
-	0x21, 0x93, 0xab, 0xc7, 0x99, 0xbb, 0x69, 0x1a, 0xcf, 0xf9, 0xd9, 0x96, 0xf0, 0x4b, 0xb5, 0x41,
-	0xe7, 0xc6, 0x1a, 0xd3, 0xd7, 0x42, 0x06, 0x57, 0x8e, 0x3e, 0xd7, 0xd2, 0xf8, 0x49, 0x9b, 0xaa,
-	0x76, 0x0c, 0x4e, 0xc1, 0x56, 0xe6, 0xfc, 0x60, 0x3a, 0x08, 0xc1, 0x5f, 0x3b, 0xea, 0x00, 0x49,
-	0x43, 0x6f, 0x66, 0x8c, 0x11, 0x65, 0x0b, 0xdd, 0x2a, 0x96, 0x8b, 0xeb, 0x27, 0x9a, 0x6f, 0xdf,
-	0x9f, 0x19, 0xda, 0xc3, 0x99, 0xa1, 0x3d, 0x9a, 0x19, 0xda, 0x9f, 0x33, 0x43, 0xfb, 0x76, 0xdf,
-	0xc8, 0x3d, 0xda, 0x37, 0x72, 0xbf, 0xed, 0x1b, 0xb9, 0x4f, 0x6a, 0x82, 0x05, 0xb3, 0xef, 0x98,
-	0x84, 0xd6, 0x3f, 0x9b, 0xff, 0x04, 0x0f, 0xbf, 0xd8, 0x7a, 0xa5, 0xf0, 0x33, 0xfa, 0x95, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x9c, 0xf4, 0xd9, 0x24, 0x0c, 0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x69, 0x9a, 0x8c, 0x53, 0x91, 0x6c, 0x13, 0xb3, 0x4e, 0x5b, 0xff, 0x48, 0x8b,
+	0x14, 0x8a, 0xb2, 0x4e, 0x52, 0x04, 0x55, 0x10, 0xa0, 0xd8, 0x6d, 0x80, 0x1e, 0xa0, 0x4a, 0x10,
+	0x08, 0x84, 0xb4, 0x5a, 0xef, 0x8e, 0xed, 0x51, 0xed, 0x1d, 0x6b, 0x67, 0xd6, 0xa6, 0x48, 0x08,
+	0x71, 0x2a, 0xe2, 0x84, 0x84, 0x84, 0x10, 0xbd, 0x54, 0x9c, 0x2a, 0x24, 0xa4, 0x1e, 0x82, 0xf8,
+	0x17, 0x7a, 0xac, 0x22, 0x0e, 0x88, 0x03, 0xa0, 0xe4, 0x50, 0xc4, 0x91, 0xbf, 0x00, 0xed, 0xce,
+	0xec, 0xee, 0xb8, 0x5e, 0x6f, 0x9c, 0x80, 0xa5, 0x5e, 0xda, 0x78, 0xe6, 0xcd, 0x7b, 0xdf, 0xf7,
+	0xcd, 0xb7, 0x6f, 0x66, 0xe0, 0xf3, 0x26, 0xa1, 0x6d, 0x42, 0xcb, 0x16, 0xa6, 0xcc, 0xc1, 0x35,
+	0x97, 0x61, 0x62, 0x97, 0xbb, 0xeb, 0x35, 0xc4, 0x8c, 0xf5, 0x72, 0x03, 0xd9, 0x88, 0x62, 0xaa,
+	0x75, 0x1c, 0xc2, 0x88, 0x72, 0x8e, 0x87, 0x6a, 0x72, 0xa8, 0x26, 0x42, 0x97, 0x16, 0x1a, 0xa4,
+	0x41, 0xfc, 0xb8, 0xb2, 0xf7, 0x17, 0x5f, 0xb2, 0x94, 0x17, 0xd9, 0x6b, 0x06, 0x45, 0x61, 0x56,
+	0x93, 0x60, 0x5b, 0xcc, 0x6b, 0x49, 0xd5, 0xfb, 0xea, 0xf0, 0xf8, 0x1c, 0x8f, 0xd7, 0x79, 0x21,
+	0x81, 0x87, 0x4f, 0xcd, 0x1b, 0x6d, 0x6c, 0x93, 0xb2, 0xff, 0x2f, 0x1f, 0x5a, 0xfe, 0x11, 0xc0,
+	0xc5, 0x6b, 0xa8, 0x85, 0x1a, 0x06, 0x23, 0xce, 0xfb, 0x98, 0x35, 0x2d, 0xc7, 0xe8, 0xbd, 0x65,
+	0xd7, 0x89, 0x72, 0x1d, 0xce, 0x5b, 0xc1, 0x84, 0x6e, 0x58, 0x96, 0x83, 0x28, 0x55, 0x41, 0x11,
+	0xac, 0xcc, 0x54, 0xd4, 0xfd, 0xbd, 0xd5, 0x05, 0x91, 0x79, 0x8b, 0xcf, 0xec, 0x32, 0x07, 0xdb,
+	0x8d, 0x9d, 0xb9, 0x70, 0x89, 0x18, 0x57, 0xaa, 0x70, 0xae, 0x27, 0xd2, 0x86, 0x59, 0xd2, 0x47,
+	0x64, 0x79, 0x26, 0x58, 0x21, 0x86, 0x37, 0xa7, 0xbf, 0xb8, 0x57, 0x48, 0xfd, 0x75, 0xaf, 0x90,
+	0x5a, 0xde, 0x07, 0x30, 0xbb, 0xe5, 0x32, 0x52, 0x25, 0xed, 0x0e, 0x71, 0x6d, 0x4b, 0x60, 0xc7,
+	0xc4, 0xfe, 0xbf, 0x00, 0xbf, 0x0d, 0xe7, 0xbb, 0x46, 0x0b, 0x5b, 0x7d, 0x69, 0x38, 0xe2, 0xd2,
+	0xfe, 0xde, 0xea, 0x05, 0x91, 0xe6, 0xbd, 0x20, 0xe6, 0x89, 0x7c, 0xdd, 0x27, 0xc6, 0x37, 0x4b,
+	0x01, 0xf6, 0xfd, 0xbd, 0xd5, 0xc5, 0x8f, 0xfb, 0x76, 0xac, 0xd8, 0x5d, 0xd7, 0xd6, 0xb4, 0xb5,
+	0xe5, 0xbb, 0x69, 0xf8, 0x5c, 0x95, 0xb4, 0xdb, 0x98, 0x52, 0x4c, 0x6c, 0x8f, 0x5e, 0xb0, 0x13,
+	0xef, 0x36, 0x1d, 0x44, 0x9b, 0xa4, 0x65, 0xed, 0x20, 0x93, 0x38, 0x56, 0x3c, 0x38, 0x70, 0x62,
+	0x70, 0xca, 0x67, 0x70, 0x86, 0x05, 0x25, 0xd4, 0x74, 0x71, 0x62, 0x25, 0xb3, 0x91, 0x13, 0x86,
+	0xd3, 0x3c, 0x43, 0x06, 0xde, 0xd5, 0xaa, 0x04, 0xdb, 0x95, 0xed, 0x87, 0xbf, 0x17, 0x52, 0x3f,
+	0xfc, 0x51, 0x58, 0x69, 0x60, 0xd6, 0x74, 0x6b, 0x9a, 0x49, 0xda, 0xc2, 0x60, 0xe2, 0xbf, 0x55,
+	0x6a, 0xdd, 0x2a, 0xb3, 0xdb, 0x1d, 0x44, 0xfd, 0x05, 0xf4, 0xbb, 0xc7, 0x0f, 0x2e, 0xcf, 0x7a,
+	0xfa, 0x9a, 0xb7, 0x75, 0xcf, 0xd2, 0xf4, 0xfe, 0xe3, 0x07, 0x97, 0xc1, 0x4e, 0x54, 0x73, 0x14,
+	0x75, 0xee, 0xa4, 0x61, 0x29, 0x24, 0xf4, 0x8e, 0xcb, 0x28, 0x33, 0x6c, 0xcb, 0xa3, 0x83, 0x7a,
+	0x86, 0x63, 0xd1, 0x31, 0x29, 0x73, 0x07, 0xc0, 0xb3, 0x24, 0x2a, 0xa6, 0x3b, 0xbc, 0x9a, 0x10,
+	0xe9, 0x7c, 0xac, 0x48, 0xd7, 0x90, 0xe9, 0xeb, 0x74, 0x55, 0xe8, 0xf4, 0xc2, 0x08, 0x3a, 0x89,
+	0x35, 0x42, 0x19, 0x85, 0x0c, 0xf0, 0x93, 0xcc, 0xff, 0x37, 0x80, 0x97, 0x22, 0x02, 0xa6, 0xe9,
+	0xb6, 0xdd, 0x96, 0xc1, 0x90, 0x15, 0x79, 0x67, 0x4c, 0x62, 0x34, 0x61, 0xc6, 0x88, 0xca, 0xf9,
+	0x5f, 0x43, 0x66, 0xe3, 0x15, 0x2d, 0xa1, 0xd9, 0x69, 0xc9, 0x38, 0x2b, 0x33, 0x9e, 0x44, 0x9c,
+	0xb3, 0x9c, 0x5a, 0x22, 0xfb, 0x0f, 0x80, 0xc5, 0x30, 0xc9, 0x9b, 0x98, 0x32, 0xe2, 0x60, 0xd3,
+	0x68, 0x8d, 0x77, 0xd7, 0xb3, 0x70, 0xaa, 0x83, 0x1c, 0x4c, 0x38, 0xc7, 0xc9, 0x1d, 0xf1, 0x4b,
+	0xf9, 0x08, 0x9e, 0x0e, 0x0c, 0x30, 0xe1, 0x93, 0x7f, 0x79, 0x34, 0xf2, 0x03, 0xb8, 0x65, 0xe2,
+	0x41, 0x4a, 0x89, 0xf4, 0x2f, 0x00, 0x5e, 0x08, 0x17, 0x57, 0x5d, 0xc7, 0x41, 0x36, 0x1b, 0x2f,
+	0xe3, 0x0f, 0x22, 0x66, 0x7c, 0x5b, 0x5f, 0x1c, 0x8d, 0x59, 0x3f, 0xb8, 0x23, 0x68, 0x7d, 0x9f,
+	0x86, 0xe7, 0xc2, 0x53, 0x66, 0x97, 0x19, 0x0e, 0xc3, 0x76, 0xc3, 0x3b, 0x65, 0x04, 0xa9, 0xa7,
+	0xb3, 0x75, 0x2b, 0x35, 0x78, 0x86, 0x0a, 0xb0, 0x3a, 0xb6, 0xeb, 0x44, 0xec, 0xfd, 0x46, 0xa2,
+	0x42, 0xb1, 0x3c, 0x65, 0x7d, 0x66, 0xa9, 0x34, 0x21, 0x89, 0xf4, 0x4d, 0x1a, 0xe6, 0x42, 0x68,
+	0xbb, 0x2d, 0x83, 0x36, 0xaf, 0x77, 0x7d, 0x85, 0xc7, 0xe5, 0xf4, 0x26, 0xc2, 0x8d, 0x26, 0x0b,
+	0x9c, 0xce, 0x7f, 0x49, 0x5f, 0xc0, 0x44, 0xdf, 0x17, 0x40, 0xe0, 0x62, 0x54, 0x9f, 0x7a, 0xe8,
+	0x74, 0xe4, 0xc1, 0x53, 0x27, 0x7d, 0x4d, 0xd6, 0x46, 0x73, 0x4d, 0x44, 0x4b, 0x56, 0xe4, 0x6c,
+	0x77, 0x70, 0x5e, 0x12, 0xe6, 0xe7, 0x59, 0x38, 0xfb, 0x06, 0xbf, 0x66, 0xed, 0x32, 0x83, 0x21,
+	0x65, 0x1b, 0x4e, 0x75, 0x0c, 0xc7, 0x68, 0x73, 0x01, 0x32, 0x1b, 0x17, 0x13, 0x8b, 0xdf, 0xf4,
+	0x43, 0xe5, 0x7a, 0x62, 0xb5, 0x72, 0x03, 0x4e, 0xd7, 0x11, 0xd2, 0x3b, 0x84, 0xb4, 0x84, 0xf9,
+	0x2f, 0x25, 0x66, 0xda, 0x46, 0xe8, 0x26, 0x21, 0xad, 0x3e, 0xb3, 0xd7, 0xf9, 0x98, 0xd2, 0x83,
+	0x6a, 0x64, 0xe1, 0xf0, 0xc6, 0xe3, 0xb9, 0xc6, 0x6b, 0x19, 0x13, 0xa3, 0xdb, 0x46, 0xbe, 0x84,
+	0xc9, 0x95, 0xb2, 0x56, 0x5c, 0x04, 0x55, 0x3e, 0x89, 0x3f, 0xa7, 0x4e, 0xf9, 0x35, 0x5f, 0x1b,
+	0x6d, 0x5b, 0x86, 0x9d, 0xaa, 0x72, 0xfd, 0x98, 0xa3, 0x49, 0xf9, 0x16, 0xc0, 0x92, 0xe4, 0xca,
+	0xa8, 0x8f, 0xeb, 0x66, 0xd8, 0xea, 0xa9, 0x3a, 0xe5, 0x43, 0xd9, 0xfa, 0x0f, 0xc7, 0xc5, 0x20,
+	0x9a, 0x42, 0x37, 0x71, 0x01, 0x55, 0xbe, 0x04, 0xf0, 0x7c, 0x04, 0xad, 0x19, 0xf6, 0xe1, 0x50,
+	0xa0, 0xd3, 0x3e, 0xaa, 0x57, 0x4f, 0xd8, 0xc7, 0x07, 0x11, 0x2d, 0x75, 0x87, 0x06, 0x2b, 0x9f,
+	0x03, 0x98, 0x8b, 0xc0, 0x98, 0xbc, 0x75, 0x86, 0x48, 0xa6, 0x7d, 0x24, 0x9b, 0x27, 0xe9, 0xbb,
+	0x83, 0x30, 0x9e, 0xed, 0xc6, 0x47, 0x2a, 0x9f, 0xca, 0x06, 0xed, 0x6b, 0x6b, 0x54, 0x9d, 0xf1,
+	0x11, 0x5c, 0x3d, 0x7e, 0x5f, 0x1b, 0xac, 0x9f, 0xb5, 0xe2, 0xe2, 0xa8, 0xd2, 0x83, 0xd9, 0xd8,
+	0xfe, 0x41, 0x55, 0xe8, 0x17, 0x7f, 0xe9, 0xb8, 0x0d, 0x64, 0xb0, 0xf4, 0x42, 0x4c, 0x1b, 0xa1,
+	0xca, 0x5d, 0x00, 0x33, 0x35, 0xd7, 0xb1, 0x91, 0xa5, 0xd7, 0x11, 0xa2, 0x6a, 0xe6, 0xa8, 0x5b,
+	0xae, 0x7e, 0xdc, 0x5b, 0xee, 0x6f, 0xc3, 0xae, 0xae, 0x43, 0xae, 0xbf, 0x90, 0xc3, 0xd9, 0x46,
+	0x88, 0x2a, 0x5f, 0x03, 0x98, 0x33, 0x5c, 0x46, 0x74, 0x53, 0x3c, 0x68, 0x74, 0x2b, 0x7c, 0xd1,
+	0x50, 0x75, 0xd6, 0xc7, 0x7a, 0x25, 0x51, 0x9a, 0xf8, 0xd7, 0x50, 0x65, 0xd9, 0x63, 0x31, 0x14,
+	0x99, 0xf0, 0x8a, 0x11, 0xbb, 0x96, 0x2a, 0x3f, 0x01, 0x78, 0x31, 0xfa, 0x82, 0x75, 0x1f, 0x60,
+	0xd8, 0xd3, 0xc2, 0xcb, 0x3b, 0x55, 0xcf, 0xf8, 0xf8, 0x2a, 0x89, 0xf8, 0x46, 0x7a, 0xd8, 0x8c,
+	0x04, 0xb7, 0x68, 0x26, 0xa7, 0x92, 0x6e, 0x1c, 0x37, 0x26, 0xa7, 0x27, 0xe7, 0x4e, 0x55, 0x5e,
+	0xbf, 0x7f, 0x90, 0x07, 0x0f, 0x0f, 0xf2, 0xe0, 0xd1, 0x41, 0x1e, 0xfc, 0x79, 0x90, 0x07, 0x5f,
+	0x1d, 0xe6, 0x53, 0x8f, 0x0e, 0xf3, 0xa9, 0x5f, 0x0f, 0xf3, 0xa9, 0x0f, 0x4b, 0x1c, 0x36, 0xb5,
+	0x6e, 0x69, 0x98, 0x94, 0xfb, 0x0b, 0xf3, 0xfd, 0xad, 0x4d, 0xf9, 0xaf, 0xe4, 0x2b, 0xff, 0x0e,
+	0x00, 0x5e, 0x4a, 0x9f, 0x72, 0x03, 0x10, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -495,6 +602,87 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionAutoWithdrawThresholdRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionAutoWithdrawThresholdRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionAutoWithdrawThresholdRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -781,6 +969,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionAutoWithdrawThresholds) > 0 {
+		for iNdEx := len(m.CommissionAutoWithdrawThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionAutoWithdrawThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -930,6 +1160,42 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *CommissionAutoWithdrawThresholdRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1089,6 +1355,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommissionAutoWithdrawThresholds) > 0 {
+		for _, e := range m.CommissionAutoWithdrawThresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1212,7 +1496,7 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *AutoCompoundDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1235,15 +1519,15 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompoundDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompoundDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1271,13 +1555,13 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1287,25 +1571,23 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
-			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1328,7 +1610,7 @@ func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
+func (m *CommissionAutoWithdrawThresholdRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1351,10 +1633,10 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionAutoWithdrawThresholdRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionAutoWithdrawThresholdRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1391,7 +1673,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1418,7 +1700,8 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Accumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1443,7 +1726,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1466,10 +1749,10 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOutstandingRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1505,10 +1788,10 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1518,14 +1801,245 @@ func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingRewards = append(m.OutstandingRewards, types.DecCoin{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommissionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2325,6 +2839,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundDelegation{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionAutoWithdrawThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionAutoWithdrawThresholds = append(m.CommissionAutoWithdrawThresholds, CommissionAutoWithdrawThresholdRecord{})
+			if err := m.CommissionAutoWithdrawThresholds[len(m.CommissionAutoWithdrawThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -53,16 +53,28 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<denom_Bytes>: CumulativeBurnedFees
+//
+// - 0x0b<accAddrLen (1 Byte)><accAddr_Bytes><valAddr_Bytes>: AutoCompoundDelegations
+//
+// - 0x0c<valAddr_Bytes>: CommissionAutoWithdrawThreshold
+//
+// - 0x0d: AutoCompoundCursor
 var (
-	FeePoolKey                           = collections.NewPrefix(0) // key for global distribution state
-	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2) // key for outstanding rewards
-	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3) // key for delegator withdraw address
-	DelegatorStartingInfoPrefix          = collections.NewPrefix(4) // key for delegator starting info
-	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5) // key for historical validators rewards / stake
-	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6) // key for current validator rewards
-	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7) // key for accumulated validator commission
-	ValidatorSlashEventPrefix            = collections.NewPrefix(8) // key for validator slash fraction
-	ParamsKey                            = collections.NewPrefix(9) // key for distribution module params
+	FeePoolKey                             = collections.NewPrefix(0)  // key for global distribution state
+	ValidatorOutstandingRewardsPrefix      = collections.NewPrefix(2)  // key for outstanding rewards
+	DelegatorWithdrawAddrPrefix            = collections.NewPrefix(3)  // key for delegator withdraw address
+	DelegatorStartingInfoPrefix            = collections.NewPrefix(4)  // key for delegator starting info
+	ValidatorHistoricalRewardsPrefix       = collections.NewPrefix(5)  // key for historical validators rewards / stake
+	ValidatorCurrentRewardsPrefix          = collections.NewPrefix(6)  // key for current validator rewards
+	ValidatorAccumulatedCommissionPrefix   = collections.NewPrefix(7)  // key for accumulated validator commission
+	ValidatorSlashEventPrefix              = collections.NewPrefix(8)  // key for validator slash fraction
+	ParamsKey                              = collections.NewPrefix(9)  // key for distribution module params
+	CumulativeBurnedFeesPrefix             = collections.NewPrefix(10) // key for the cumulative burned fees
+	AutoCompoundDelegationsPrefix          = collections.NewPrefix(11) // key for the delegations opted in to auto-compounding
+	CommissionAutoWithdrawThresholdsPrefix = collections.NewPrefix(12) // key for the validator commission auto-withdraw thresholds
+	AutoCompoundCursorKey                  = collections.NewPrefix(13) // key for the last delegation compounded in the current auto-compounding round
 )
 
 // Reserved prefixes
@@ -13,6 +13,9 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
+	_ sdk.Msg = (*MsgSetCommissionAutoWithdrawThreshold)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr string) *MsgSetWithdrawAddress {
@@ -29,6 +32,14 @@ func NewMsgWithdrawDelegatorReward(delAddr, valAddr string) *MsgWithdrawDelegato
 	}
 }
 
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of all the delegations of delAddr.
+func NewMsgWithdrawAllDelegatorRewards(delAddr string) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr,
+	}
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr string) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr,
@@ -53,3 +64,23 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound opting the delegation
+// of delAddr to valAddr in or out of auto-compounding.
+func NewMsgSetAutoCompound(delAddr, valAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// NewMsgSetCommissionAutoWithdrawThreshold returns a new
+// MsgSetCommissionAutoWithdrawThreshold setting the commission auto-withdraw
+// threshold of valAddr.
+func NewMsgSetCommissionAutoWithdrawThreshold(valAddr string, threshold sdk.Coins) *MsgSetCommissionAutoWithdrawThreshold {
+	return &MsgSetCommissionAutoWithdrawThreshold{
+		ValidatorAddress: valAddr,
+		Threshold:        threshold,
+	}
+}
@@ -10,16 +10,31 @@ import (
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:             math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:       math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:      math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:      true,
+		FeeBurnPercentage:        math.LegacyZeroDec(),
+		AutoCompoundFrequency:    0, // disabled
+		MaxAutoCompoundsPerBlock: 100,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	if err := validateFeeBurnPercentage(p.FeeBurnPercentage); err != nil {
+		return err
+	}
+
+	if p.AutoCompoundFrequency > 0 && p.MaxAutoCompoundsPerBlock == 0 {
+		return errors.New("max auto-compounds per block must be positive when auto-compounding is enabled")
+	}
+
+	return nil
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -40,3 +55,17 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeBurnPercentage(v math.LegacyDec) error {
+	if v.IsNil() {
+		return errors.New("fee burn percentage must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn percentage must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee burn percentage too large: %s", v)
+	}
+
+	return nil
+}
@@ -17,25 +17,31 @@ func TestParams_ValidateBasic(t *testing.T) {
 		BaseProposerReward  sdkmath.LegacyDec
 		BonusProposerReward sdkmath.LegacyDec
 		WithdrawAddrEnabled bool
+		FeeBurnPercentage   sdkmath.LegacyDec
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false}, true},
-		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false}, true},
-		{"community tax nil", fields{sdkmath.LegacyDec{}, toDec("0"), toDec("0"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("0")}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false, toDec("0")}, true},
+		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, toDec("0")}, false},
+		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, toDec("0")}, false},
+		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, toDec("0")}, false},
+		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false, toDec("0")}, true},
+		{"community tax nil", fields{sdkmath.LegacyDec{}, toDec("0"), toDec("0"), false, toDec("0")}, true},
+		{"fee burn percentage of 1", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("1")}, false},
+		{"negative fee burn percentage", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("-0.1")}, true},
+		{"fee burn percentage greater than 1", fields{toDec("0.1"), toDec("0"), toDec("0"), false, toDec("1.1")}, true},
+		{"fee burn percentage nil", fields{toDec("0.1"), toDec("0"), toDec("0"), false, sdkmath.LegacyDec{}}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
 				CommunityTax:        tt.fields.CommunityTax,
 				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				FeeBurnPercentage:   tt.fields.FeeBurnPercentage,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
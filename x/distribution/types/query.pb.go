@@ -960,6 +960,206 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryBurnedFeesRequest is the request type for the Query/BurnedFees RPC
+// method.
+type QueryBurnedFeesRequest struct {
+}
+
+func (m *QueryBurnedFeesRequest) Reset()         { *m = QueryBurnedFeesRequest{} }
+func (m *QueryBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedFeesResponse is the response type for the Query/BurnedFees RPC
+// method.
+type QueryBurnedFeesResponse struct {
+	// burned_fees defines the cumulative amount of collected fees burned.
+	BurnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned_fees,json=burnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_fees"`
+}
+
+func (m *QueryBurnedFeesResponse) Reset()         { *m = QueryBurnedFeesResponse{} }
+func (m *QueryBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedFeesResponse) GetBurnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedFees
+	}
+	return nil
+}
+
+// QueryDelegationTotalRewardsProjectionRequest is the request type for the
+// Query/DelegationTotalRewardsProjection RPC method.
+type QueryDelegationTotalRewardsProjectionRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// blocks defines the number of blocks to project the rewards over.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryDelegationTotalRewardsProjectionRequest) Reset() {
+	*m = QueryDelegationTotalRewardsProjectionRequest{}
+}
+func (m *QueryDelegationTotalRewardsProjectionRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationTotalRewardsProjectionRequest) ProtoMessage() {}
+func (*QueryDelegationTotalRewardsProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryDelegationTotalRewardsProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationTotalRewardsProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationTotalRewardsProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationTotalRewardsProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationTotalRewardsProjectionRequest.Merge(m, src)
+}
+func (m *QueryDelegationTotalRewardsProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationTotalRewardsProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationTotalRewardsProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationTotalRewardsProjectionRequest proto.InternalMessageInfo
+
+// QueryDelegationTotalRewardsProjectionResponse is the response type for the
+// Query/DelegationTotalRewardsProjection RPC method.
+type QueryDelegationTotalRewardsProjectionResponse struct {
+	// rewards defines the accrued and projected rewards of each delegation.
+	Rewards []DelegationDelegatorRewardProjection `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total defines the sum of all the accrued rewards.
+	Total github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
+	// projected_total defines the sum of all the projected rewards.
+	ProjectedTotal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=projected_total,json=projectedTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"projected_total"`
+}
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) Reset() {
+	*m = QueryDelegationTotalRewardsProjectionResponse{}
+}
+func (m *QueryDelegationTotalRewardsProjectionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationTotalRewardsProjectionResponse) ProtoMessage() {}
+func (*QueryDelegationTotalRewardsProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryDelegationTotalRewardsProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationTotalRewardsProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationTotalRewardsProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationTotalRewardsProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationTotalRewardsProjectionResponse.Merge(m, src)
+}
+func (m *QueryDelegationTotalRewardsProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationTotalRewardsProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationTotalRewardsProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationTotalRewardsProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) GetRewards() []DelegationDelegatorRewardProjection {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) GetProjectedTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ProjectedTotal
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -981,6 +1181,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryBurnedFeesRequest)(nil), "cosmos.distribution.v1beta1.QueryBurnedFeesRequest")
+	proto.RegisterType((*QueryBurnedFeesResponse)(nil), "cosmos.distribution.v1beta1.QueryBurnedFeesResponse")
+	proto.RegisterType((*QueryDelegationTotalRewardsProjectionRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsProjectionRequest")
+	proto.RegisterType((*QueryDelegationTotalRewardsProjectionResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsProjectionResponse")
 }
 
 func init() {
@@ -988,89 +1192,103 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	// 1523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x69, 0x4a, 0x5f, 0x7f, 0x92, 0x4c, 0x7f, 0x70, 0xb6, 0xad, 0xe3, 0x3a, 0xb4,
+	0x89, 0x52, 0xec, 0x4d, 0x13, 0x28, 0x25, 0xa1, 0xa2, 0x75, 0x7e, 0x68, 0xa1, 0x4a, 0x53, 0xb7,
+	0x50, 0x01, 0xaa, 0xac, 0xb5, 0x77, 0xe2, 0x6c, 0x6b, 0xef, 0x38, 0xbb, 0xeb, 0x84, 0xa8, 0xaa,
+	0x90, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0x82, 0xb8, 0xd0, 0x23, 0xea, 0xa9, 0x87, 0x5c, 0x38, 0x81,
+	0x38, 0x55, 0xc0, 0xa1, 0x0a, 0x12, 0xe2, 0x04, 0x28, 0x45, 0x6a, 0x39, 0x80, 0xb8, 0x71, 0x45,
+	0x9e, 0x99, 0x5d, 0xef, 0xfa, 0x67, 0xfd, 0x57, 0x8b, 0x4b, 0xeb, 0xce, 0xce, 0x7b, 0xdf, 0xfb,
+	0xde, 0x7b, 0x33, 0xf3, 0x3e, 0x15, 0x46, 0xd2, 0xd4, 0xcc, 0x51, 0x53, 0x56, 0x35, 0xd3, 0x32,
+	0xb4, 0x54, 0xc1, 0xd2, 0xa8, 0x2e, 0xaf, 0x9e, 0x48, 0x11, 0x4b, 0x39, 0x21, 0xaf, 0x14, 0x88,
+	0xb1, 0x1e, 0xcb, 0x1b, 0xd4, 0xa2, 0xf8, 0x20, 0xdf, 0x18, 0x73, 0x6f, 0x8c, 0x89, 0x8d, 0xd2,
+	0x98, 0xf0, 0x92, 0x52, 0x4c, 0xc2, 0xad, 0x1c, 0x1f, 0x79, 0x25, 0xa3, 0xe9, 0x0a, 0xdb, 0xcd,
+	0x1c, 0x49, 0xfb, 0x32, 0x34, 0x43, 0xd9, 0x4f, 0xb9, 0xf8, 0x4b, 0xac, 0x1e, 0xca, 0x50, 0x9a,
+	0xc9, 0x12, 0x59, 0xc9, 0x6b, 0xb2, 0xa2, 0xeb, 0xd4, 0x62, 0x26, 0xa6, 0xf8, 0x1a, 0x72, 0xfb,
+	0xb7, 0x3d, 0xa7, 0xa9, 0x66, 0xfb, 0x8c, 0xf9, 0xb1, 0xf0, 0x44, 0xcc, 0xf7, 0x0f, 0xf2, 0xfd,
+	0x49, 0x1e, 0x86, 0x60, 0xc6, 0x3f, 0x0d, 0x28, 0x39, 0x4d, 0xa7, 0x32, 0xfb, 0x93, 0x2f, 0x45,
+	0xf6, 0x01, 0xbe, 0x54, 0xe4, 0xb4, 0xa8, 0x18, 0x4a, 0xce, 0x4c, 0x90, 0x95, 0x02, 0x31, 0xad,
+	0xc8, 0x35, 0xd8, 0xeb, 0x59, 0x35, 0xf3, 0x54, 0x37, 0x09, 0x9e, 0x87, 0xde, 0x3c, 0x5b, 0x09,
+	0xa2, 0x30, 0x1a, 0xdd, 0x39, 0x31, 0x1c, 0xf3, 0x49, 0x5c, 0x8c, 0x1b, 0xc7, 0x77, 0x3c, 0xfc,
+	0x75, 0xa8, 0xeb, 0xab, 0x27, 0x0f, 0xc6, 0x50, 0x42, 0x58, 0x47, 0xd6, 0xe0, 0x28, 0x73, 0xff,
+	0x8e, 0x92, 0xd5, 0x54, 0xc5, 0xa2, 0xc6, 0xac, 0xcb, 0xfe, 0xbc, 0xbe, 0x44, 0x45, 0x1c, 0x78,
+	0x01, 0x06, 0x56, 0xed, 0x3d, 0x49, 0x45, 0x55, 0x0d, 0x62, 0x72, 0xec, 0x1d, 0xf1, 0x23, 0x9b,
+	0x1b, 0xd1, 0xc3, 0x02, 0xde, 0xf1, 0x73, 0x96, 0x6f, 0xb9, 0x6c, 0x19, 0x9a, 0x9e, 0x49, 0xf4,
+	0xaf, 0x96, 0xad, 0x47, 0xfe, 0x0e, 0xc0, 0xb1, 0x7a, 0xc8, 0x82, 0xeb, 0x05, 0xe8, 0xa7, 0x79,
+	0x62, 0xb4, 0x86, 0xdc, 0x67, 0x9b, 0x8a, 0x65, 0x7c, 0x1b, 0xc1, 0x80, 0x49, 0xb2, 0x4b, 0xc9,
+	0x14, 0xd5, 0xd5, 0xa4, 0x41, 0xd6, 0x14, 0x43, 0x35, 0x83, 0x81, 0x70, 0xf7, 0xe8, 0xce, 0x89,
+	0x43, 0x76, 0x16, 0x8b, 0x1d, 0xe0, 0x64, 0x6f, 0x96, 0xa4, 0x67, 0xa8, 0xa6, 0xc7, 0x4f, 0x15,
+	0xd3, 0x77, 0xff, 0xb7, 0xa1, 0xe3, 0x19, 0xcd, 0x5a, 0x2e, 0xa4, 0x62, 0x69, 0x9a, 0x13, 0x45,
+	0x15, 0x7f, 0x45, 0x4d, 0xf5, 0x86, 0x6c, 0xad, 0xe7, 0x89, 0x69, 0xdb, 0x98, 0x3c, 0xdb, 0x7d,
+	0x45, 0xc0, 0x38, 0xd5, 0xd5, 0x04, 0x87, 0xc3, 0x2b, 0x00, 0x69, 0x9a, 0xcb, 0x69, 0xa6, 0xa9,
+	0x51, 0x3d, 0xd8, 0xdd, 0x00, 0xf8, 0x64, 0x0b, 0xe0, 0x09, 0x17, 0x48, 0x64, 0x1d, 0x46, 0xbc,
+	0xf9, 0xbe, 0x58, 0xb0, 0x4c, 0x4b, 0xd1, 0xd5, 0x62, 0x96, 0x78, 0x58, 0x9d, 0xaa, 0xf5, 0xc7,
+	0x08, 0x46, 0xeb, 0x63, 0x8b, 0x6a, 0x5f, 0x83, 0xed, 0x76, 0x51, 0x78, 0x6b, 0x9f, 0xf2, 0x6d,
+	0x6d, 0x1f, 0x97, 0xee, 0x7e, 0xb7, 0x7d, 0x46, 0x56, 0x60, 0xc8, 0x1b, 0xca, 0x8c, 0x93, 0xa2,
+	0x4e, 0xd1, 0xbf, 0x8b, 0x20, 0x5c, 0x1b, 0x53, 0xd0, 0x5e, 0xf2, 0x74, 0x04, 0x67, 0x3e, 0xdd,
+	0x18, 0xf3, 0xb3, 0xe9, 0x74, 0x21, 0x57, 0xc8, 0x2a, 0x16, 0x51, 0x4b, 0x8e, 0xdd, 0xe4, 0xdd,
+	0x6d, 0x70, 0x37, 0x00, 0x87, 0xbc, 0xc1, 0x5c, 0xce, 0x2a, 0xe6, 0x32, 0xe9, 0x54, 0xf1, 0xf1,
+	0x08, 0xf4, 0x99, 0x96, 0x62, 0x58, 0x9a, 0x9e, 0x49, 0x2e, 0x13, 0x2d, 0xb3, 0x6c, 0x05, 0x03,
+	0x61, 0x34, 0xda, 0x93, 0xd8, 0x63, 0x2f, 0x9f, 0x63, 0xab, 0x78, 0x18, 0x76, 0x13, 0x5d, 0x75,
+	0x6d, 0xeb, 0x66, 0xdb, 0x76, 0xf1, 0x45, 0xb1, 0x69, 0x1e, 0xa0, 0x74, 0xd5, 0x07, 0x7b, 0x58,
+	0x9a, 0x8e, 0x79, 0x0e, 0x0e, 0x7f, 0x4d, 0x4a, 0x37, 0x5f, 0x86, 0x08, 0x66, 0x09, 0x97, 0xe5,
+	0x54, 0xcf, 0x9d, 0x7b, 0x43, 0x5d, 0x91, 0x6f, 0x10, 0x1c, 0xae, 0x91, 0x0c, 0x51, 0x96, 0xb7,
+	0x61, 0xbb, 0xc9, 0x97, 0x82, 0x88, 0x9d, 0xd2, 0xf1, 0xc6, 0x6a, 0xc2, 0xfc, 0xcc, 0xad, 0x12,
+	0xdd, 0xf2, 0x74, 0xa1, 0xf0, 0x85, 0xdf, 0xf0, 0xd0, 0x08, 0x30, 0x1a, 0x23, 0x75, 0x69, 0xf0,
+	0x98, 0xdc, 0x3c, 0x22, 0xdf, 0xda, 0x0c, 0x66, 0x49, 0x96, 0x64, 0xd8, 0x5a, 0xd9, 0x61, 0x9e,
+	0x83, 0x01, 0x95, 0x7f, 0xab, 0xa8, 0x67, 0x70, 0x73, 0x23, 0xba, 0x4f, 0x80, 0x96, 0x95, 0xd1,
+	0x31, 0xb1, 0xcb, 0x58, 0xb5, 0x2d, 0x02, 0x2d, 0xb7, 0xc5, 0xd4, 0x73, 0xc5, 0x02, 0x3c, 0x2d,
+	0x16, 0xe1, 0x53, 0x04, 0xa1, 0x5a, 0x14, 0x44, 0x15, 0xf2, 0xee, 0x3b, 0xa1, 0x93, 0x17, 0xb5,
+	0x73, 0x4d, 0x14, 0x20, 0x52, 0x16, 0xd3, 0x15, 0x6a, 0x29, 0xd9, 0x8e, 0xe4, 0xd6, 0x95, 0x8b,
+	0x7f, 0x10, 0x0c, 0xfb, 0xe2, 0x8a, 0x84, 0xbc, 0x5f, 0x9e, 0x90, 0x93, 0xbe, 0x6d, 0x59, 0xf2,
+	0x36, 0x6b, 0x63, 0x73, 0x8f, 0xd5, 0xae, 0x48, 0x9c, 0x85, 0x6d, 0x56, 0x11, 0xb4, 0xc3, 0x8f,
+	0x22, 0x07, 0x89, 0x18, 0xe2, 0x42, 0x76, 0x22, 0x73, 0x5a, 0xa8, 0x73, 0x69, 0xbe, 0x00, 0xe1,
+	0xda, 0x98, 0x22, 0xc5, 0x21, 0x00, 0xa7, 0x69, 0x79, 0x96, 0x77, 0x24, 0x5c, 0x2b, 0x2e, 0x6f,
+	0x6b, 0xf0, 0x82, 0xd7, 0xdb, 0x55, 0xcd, 0x5a, 0x56, 0x0d, 0x65, 0x4d, 0x00, 0x77, 0x8c, 0xc6,
+	0x2a, 0x1c, 0xad, 0x03, 0x2c, 0xb8, 0xcc, 0x40, 0xff, 0x9a, 0xf8, 0xd4, 0x30, 0x70, 0xdf, 0x9a,
+	0xd7, 0x99, 0x0b, 0x77, 0x02, 0x06, 0x19, 0x6e, 0xf1, 0xb5, 0x29, 0xe8, 0x9a, 0xb5, 0xbe, 0x48,
+	0x69, 0x56, 0xb0, 0x9c, 0xda, 0xbf, 0xb9, 0x11, 0xed, 0x2b, 0x95, 0x3e, 0x3c, 0x1e, 0x7b, 0x79,
+	0x3c, 0x88, 0x22, 0x5f, 0x22, 0x90, 0xaa, 0x19, 0x89, 0x08, 0xaf, 0x43, 0x4f, 0x9e, 0xd2, 0x6c,
+	0x87, 0x8f, 0x37, 0xc3, 0xa8, 0x15, 0xe1, 0x24, 0x1c, 0x60, 0x01, 0xc6, 0x0b, 0x86, 0x4e, 0xd4,
+	0x79, 0xe2, 0x3c, 0x89, 0x53, 0x83, 0x9b, 0x1b, 0xd1, 0xfd, 0x1f, 0x78, 0x26, 0xfc, 0xf0, 0xea,
+	0x89, 0xd8, 0x78, 0x6c, 0x3c, 0xf2, 0x35, 0x82, 0xe7, 0x2b, 0xac, 0x04, 0xa7, 0xdb, 0x08, 0x76,
+	0xa6, 0xd8, 0x72, 0x72, 0x89, 0x38, 0x0f, 0xc8, 0x60, 0x55, 0x6e, 0x8c, 0xd8, 0xbc, 0x20, 0x36,
+	0xda, 0x00, 0x31, 0xc6, 0xea, 0x8b, 0x27, 0x0f, 0xc6, 0x76, 0x15, 0x6b, 0x9f, 0x5e, 0x4f, 0xa6,
+	0x4b, 0x34, 0x21, 0xe5, 0x04, 0xe3, 0x17, 0xfb, 0x03, 0x04, 0x2f, 0xfa, 0x5c, 0x36, 0x8b, 0x06,
+	0xbd, 0x4e, 0xd2, 0x96, 0x6b, 0x30, 0x7a, 0x46, 0x4f, 0xc9, 0x01, 0xe8, 0x4d, 0x65, 0x69, 0xfa,
+	0x86, 0x29, 0x06, 0x01, 0xf1, 0xaf, 0xa9, 0x23, 0x76, 0x83, 0xd5, 0x0e, 0xf9, 0x5e, 0x37, 0x44,
+	0x1b, 0x0c, 0x59, 0x14, 0x81, 0x94, 0xdf, 0x94, 0x67, 0x5a, 0xbb, 0x29, 0x4b, 0xae, 0xff, 0xff,
+	0x3b, 0x13, 0x7f, 0x08, 0x7d, 0x79, 0x1e, 0x0f, 0x51, 0x93, 0x1c, 0xb7, 0xbb, 0xa3, 0xb8, 0x7b,
+	0x1c, 0x38, 0x96, 0x6e, 0x9f, 0xae, 0x9a, 0xf8, 0x71, 0x3f, 0x6c, 0x63, 0x25, 0xc2, 0x9f, 0x23,
+	0xe8, 0xe5, 0xca, 0x13, 0xcb, 0xbe, 0x49, 0xaf, 0x94, 0xbd, 0xd2, 0x78, 0xe3, 0x06, 0xbc, 0xd0,
+	0x91, 0xe3, 0xb7, 0x7f, 0xfa, 0xe3, 0xb3, 0xc0, 0x51, 0x3c, 0x2c, 0xfb, 0xa9, 0x74, 0x2e, 0x7b,
+	0xf1, 0x9f, 0x08, 0x06, 0x6b, 0x0a, 0x4f, 0x1c, 0xaf, 0x0f, 0x5e, 0x4f, 0x2f, 0x4b, 0x33, 0x6d,
+	0xf9, 0x10, 0x9c, 0x66, 0x18, 0xa7, 0xd3, 0x78, 0xda, 0x97, 0x53, 0xe9, 0x51, 0x92, 0x6f, 0x56,
+	0xcc, 0x68, 0xb7, 0xf0, 0x47, 0x01, 0x38, 0xe8, 0xa3, 0x92, 0xf0, 0x6c, 0x13, 0x91, 0xd6, 0xd4,
+	0x8c, 0xd2, 0x5c, 0x9b, 0x5e, 0x04, 0xe3, 0xab, 0x8c, 0xf1, 0x25, 0x7c, 0xb1, 0x0d, 0xc6, 0x32,
+	0x2d, 0xf9, 0xb7, 0x05, 0x3e, 0xde, 0x42, 0xb0, 0xb7, 0x8a, 0xfe, 0xc2, 0xaf, 0x35, 0x11, 0x77,
+	0x85, 0x54, 0x94, 0x4e, 0xb7, 0x68, 0x2d, 0xd8, 0x2e, 0x30, 0xb6, 0xe7, 0xf0, 0x7c, 0x3b, 0x6c,
+	0x4b, 0xe2, 0x0e, 0xff, 0x8c, 0xa0, 0xbf, 0x5c, 0xca, 0xe0, 0x57, 0x9b, 0x88, 0xd1, 0xab, 0x05,
+	0xa5, 0xa9, 0x56, 0x4c, 0x05, 0xb7, 0xb7, 0x18, 0xb7, 0x39, 0x3c, 0xd3, 0x0e, 0x37, 0x5b, 0x2f,
+	0xfd, 0x85, 0x60, 0xa0, 0x42, 0x1e, 0xe0, 0x06, 0xc2, 0xab, 0x25, 0x8b, 0xa4, 0xe9, 0x96, 0x6c,
+	0x05, 0xb7, 0x24, 0xe3, 0xf6, 0x2e, 0xbe, 0xea, 0xcb, 0xcd, 0x79, 0xf8, 0x4c, 0xf9, 0x66, 0xc5,
+	0xbb, 0x79, 0x4b, 0x16, 0x9d, 0x59, 0xf5, 0xcc, 0x3e, 0x45, 0x70, 0xa0, 0xfa, 0x13, 0x87, 0x5f,
+	0x6f, 0x26, 0xf0, 0x2a, 0xa2, 0x45, 0x3a, 0xd3, 0xba, 0x83, 0xa6, 0x4a, 0xdb, 0x18, 0x7d, 0x76,
+	0x30, 0xab, 0xcc, 0xe1, 0x8d, 0x1c, 0xcc, 0xda, 0x92, 0x41, 0x3a, 0xdd, 0xa2, 0x75, 0x53, 0x07,
+	0xb3, 0x0e, 0xc3, 0x52, 0x6f, 0xe3, 0x7f, 0x11, 0x04, 0x6b, 0x4d, 0xe9, 0xf8, 0x6c, 0x13, 0xb1,
+	0x56, 0x97, 0x16, 0x52, 0xbc, 0x1d, 0x17, 0x82, 0xf3, 0x15, 0xc6, 0x79, 0x01, 0x5f, 0x68, 0x87,
+	0x73, 0xb9, 0xcc, 0xc0, 0x3f, 0x20, 0xd8, 0xed, 0x19, 0xf9, 0xf1, 0xc9, 0xfa, 0xb1, 0x56, 0x13,
+	0x16, 0xd2, 0x2b, 0x4d, 0xdb, 0x09, 0x62, 0xe7, 0xbf, 0xaf, 0x9c, 0xf7, 0x19, 0xd7, 0x28, 0x3e,
+	0xee, 0xcb, 0x35, 0x6d, 0xbb, 0x4b, 0x16, 0x75, 0xc3, 0x9d, 0x00, 0xc2, 0xdf, 0x21, 0x80, 0xd2,
+	0xa4, 0x8f, 0x27, 0xeb, 0x87, 0x54, 0xa1, 0x26, 0xa4, 0x97, 0x9a, 0x33, 0x72, 0x91, 0xa8, 0x3e,
+	0x71, 0x31, 0x2a, 0x63, 0x78, 0xd4, 0x97, 0x8a, 0x4b, 0x87, 0xe0, 0xfb, 0x01, 0x08, 0xd7, 0x9b,
+	0x9f, 0xf1, 0xf9, 0x56, 0x6f, 0x89, 0x0a, 0xd9, 0x20, 0xbd, 0xf9, 0x2c, 0x5c, 0x89, 0x34, 0x50,
+	0xff, 0x34, 0x2c, 0xe2, 0x85, 0x67, 0x71, 0x25, 0xe7, 0x4b, 0xc3, 0xfe, 0xf4, 0xc3, 0xad, 0x10,
+	0x7a, 0xb4, 0x15, 0x42, 0xbf, 0x6f, 0x85, 0xd0, 0x27, 0x8f, 0x43, 0x5d, 0x8f, 0x1e, 0x87, 0xba,
+	0x7e, 0x79, 0x1c, 0xea, 0x7a, 0xef, 0x08, 0x07, 0x32, 0xd5, 0x1b, 0x31, 0x8d, 0xca, 0xde, 0x98,
+	0xf8, 0x10, 0x9d, 0xea, 0x65, 0xff, 0xb3, 0x33, 0xf9, 0xdf, 0x00, 0xf9, 0xc3, 0x2a, 0x32, 0xff,
+	0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1108,6 +1326,13 @@ type QueryClient interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
+	// DelegationTotalRewardsProjection queries the total rewards accrued by each
+	// validator, along with the rewards projected to be accrued a given number of
+	// blocks in the future, assuming the current provisions, validator set and
+	// commission rates remain unchanged.
+	DelegationTotalRewardsProjection(ctx context.Context, in *QueryDelegationTotalRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -1209,6 +1434,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationTotalRewardsProjection(ctx context.Context, in *QueryDelegationTotalRewardsProjectionRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsProjectionResponse, error) {
+	out := new(QueryDelegationTotalRewardsProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegationTotalRewardsProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1234,6 +1477,13 @@ type QueryServer interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// BurnedFees queries the cumulative amount of collected fees burned.
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
+	// DelegationTotalRewardsProjection queries the total rewards accrued by each
+	// validator, along with the rewards projected to be accrued a given number of
+	// blocks in the future, assuming the current provisions, validator set and
+	// commission rates remain unchanged.
+	DelegationTotalRewardsProjection(context.Context, *QueryDelegationTotalRewardsProjectionRequest) (*QueryDelegationTotalRewardsProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1270,6 +1520,12 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
+func (*UnimplementedQueryServer) DelegationTotalRewardsProjection(ctx context.Context, req *QueryDelegationTotalRewardsProjectionRequest) (*QueryDelegationTotalRewardsProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewardsProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1455,6 +1711,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationTotalRewardsProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationTotalRewardsProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationTotalRewardsProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationTotalRewardsProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationTotalRewardsProjection(ctx, req.(*QueryDelegationTotalRewardsProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1499,6 +1791,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
+		{
+			MethodName: "DelegationTotalRewardsProjection",
+			Handler:    _Query_DelegationTotalRewardsProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2212,86 +2512,246 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.SelfBondRewards) > 0 {
-		for _, e := range m.SelfBondRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Commission) > 0 {
-		for _, e := range m.Commission {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BurnedFees) > 0 {
+		for iNdEx := len(m.BurnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationTotalRewardsProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationTotalRewardsProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationTotalRewardsProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectedTotal) > 0 {
+		for iNdEx := len(m.ProjectedTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for _, e := range m.SelfBondRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
@@ -2515,6 +2975,73 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BurnedFees) > 0 {
+		for _, e := range m.BurnedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProjectedTotal) > 0 {
+		for _, e := range m.ProjectedTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4352,6 +4879,393 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedFees = append(m.BurnedFees, types.Coin{})
+			if err := m.BurnedFees[len(m.BurnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DelegationDelegatorRewardProjection{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedTotal = append(m.ProjectedTotal, types.DecCoin{})
+			if err := m.ProjectedTotal[len(m.ProjectedTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -116,6 +116,10 @@ var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
 type MsgWithdrawDelegatorReward struct {
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// recipient_address is an optional address receiving the rewards of this
+	// withdrawal. When empty, the rewards are sent to the delegator withdraw
+	// address.
+	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
 }
 
 func (m *MsgWithdrawDelegatorReward) Reset()         { *m = MsgWithdrawDelegatorReward{} }
@@ -197,6 +201,94 @@ func (m *MsgWithdrawDelegatorRewardResponse) GetAmount() github_com_cosmos_cosmo
 	return nil
 }
 
+// MsgWithdrawAllDelegatorRewards represents the withdrawal of the rewards of
+// all the delegations of a delegator.
+type MsgWithdrawAllDelegatorRewards struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Reset()         { *m = MsgWithdrawAllDelegatorRewards{} }
+func (m *MsgWithdrawAllDelegatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{4}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewards proto.InternalMessageInfo
+
+// MsgWithdrawAllDelegatorRewardsResponse defines the
+// Msg/WithdrawAllDelegatorRewards response type.
+type MsgWithdrawAllDelegatorRewardsResponse struct {
+	// amount is the total amount of rewards withdrawn.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Reset() {
+	*m = MsgWithdrawAllDelegatorRewardsResponse{}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{5}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgWithdrawValidatorCommission withdraws the full commission to the validator
 // address.
 type MsgWithdrawValidatorCommission struct {
@@ -207,7 +299,7 @@ func (m *MsgWithdrawValidatorCommission) Reset()         { *m = MsgWithdrawValid
 func (m *MsgWithdrawValidatorCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommission) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{4}
+	return fileDescriptor_ed4f433d965e58ca, []int{6}
 }
 func (m *MsgWithdrawValidatorCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +341,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Reset() {
 func (m *MsgWithdrawValidatorCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommissionResponse) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{5}
+	return fileDescriptor_ed4f433d965e58ca, []int{7}
 }
 func (m *MsgWithdrawValidatorCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,7 +392,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{6}
+	return fileDescriptor_ed4f433d965e58ca, []int{8}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +433,7 @@ func (m *MsgFundCommunityPoolResponse) Reset()         { *m = MsgFundCommunityPo
 func (m *MsgFundCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPoolResponse) ProtoMessage()    {}
 func (*MsgFundCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{7}
+	return fileDescriptor_ed4f433d965e58ca, []int{9}
 }
 func (m *MsgFundCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +476,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{8}
+	return fileDescriptor_ed4f433d965e58ca, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +528,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{9}
+	return fileDescriptor_ed4f433d965e58ca, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,7 +571,7 @@ func (m *MsgCommunityPoolSpend) Reset()         { *m = MsgCommunityPoolSpend{} }
 func (m *MsgCommunityPoolSpend) String() string { return proto.CompactTextString(m) }
 func (*MsgCommunityPoolSpend) ProtoMessage()    {}
 func (*MsgCommunityPoolSpend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{10}
+	return fileDescriptor_ed4f433d965e58ca, []int{12}
 }
 func (m *MsgCommunityPoolSpend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +634,7 @@ func (m *MsgCommunityPoolSpendResponse) Reset()         { *m = MsgCommunityPoolS
 func (m *MsgCommunityPoolSpendResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCommunityPoolSpendResponse) ProtoMessage()    {}
 func (*MsgCommunityPoolSpendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{11}
+	return fileDescriptor_ed4f433d965e58ca, []int{13}
 }
 func (m *MsgCommunityPoolSpendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +675,7 @@ func (m *MsgDepositValidatorRewardsPool) Reset()         { *m = MsgDepositValida
 func (m *MsgDepositValidatorRewardsPool) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositValidatorRewardsPool) ProtoMessage()    {}
 func (*MsgDepositValidatorRewardsPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{12}
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
 }
 func (m *MsgDepositValidatorRewardsPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +715,7 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Reset() {
 func (m *MsgDepositValidatorRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositValidatorRewardsPoolResponse) ProtoMessage()    {}
 func (*MsgDepositValidatorRewardsPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{13}
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
 }
 func (m *MsgDepositValidatorRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,11 +744,176 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound opts a delegation in or out of auto-compounding.
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// enabled defines whether the rewards of the delegation are auto-compounded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgSetCommissionAutoWithdrawThreshold sets the commission auto-withdraw
+// threshold of a validator.
+type MsgSetCommissionAutoWithdrawThreshold struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// threshold is the commission amount at which the commission is
+	// automatically withdrawn, once reached in any of its denoms. An empty
+	// threshold disables the automatic withdrawal.
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+}
+
+func (m *MsgSetCommissionAutoWithdrawThreshold) Reset()         { *m = MsgSetCommissionAutoWithdrawThreshold{} }
+func (m *MsgSetCommissionAutoWithdrawThreshold) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionAutoWithdrawThreshold) ProtoMessage()    {}
+func (*MsgSetCommissionAutoWithdrawThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{18}
+}
+func (m *MsgSetCommissionAutoWithdrawThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionAutoWithdrawThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionAutoWithdrawThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionAutoWithdrawThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionAutoWithdrawThreshold.Merge(m, src)
+}
+func (m *MsgSetCommissionAutoWithdrawThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionAutoWithdrawThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionAutoWithdrawThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionAutoWithdrawThreshold proto.InternalMessageInfo
+
+// MsgSetCommissionAutoWithdrawThresholdResponse defines the
+// Msg/SetCommissionAutoWithdrawThreshold response type.
+type MsgSetCommissionAutoWithdrawThresholdResponse struct {
+}
+
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) Reset() {
+	*m = MsgSetCommissionAutoWithdrawThresholdResponse{}
+}
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetCommissionAutoWithdrawThresholdResponse) ProtoMessage() {}
+func (*MsgSetCommissionAutoWithdrawThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{19}
+}
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionAutoWithdrawThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionAutoWithdrawThresholdResponse.Merge(m, src)
+}
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionAutoWithdrawThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionAutoWithdrawThresholdResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewards)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewards")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewardsResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewardsResponse")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
@@ -667,6 +924,10 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgSetCommissionAutoWithdrawThreshold)(nil), "cosmos.distribution.v1beta1.MsgSetCommissionAutoWithdrawThreshold")
+	proto.RegisterType((*MsgSetCommissionAutoWithdrawThresholdResponse)(nil), "cosmos.distribution.v1beta1.MsgSetCommissionAutoWithdrawThresholdResponse")
 }
 
 func init() {
@@ -674,71 +935,86 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x22, 0x22, 0xd3, 0x4a, 0x69, 0xb6, 0x29, 0x71, 0x37, 0xed, 0x3a, 0xdd, 0x42,
-	0x88, 0x22, 0xb2, 0x9b, 0x84, 0xa6, 0x15, 0x46, 0xa8, 0x22, 0x29, 0x91, 0x38, 0x6c, 0xa9, 0x1c,
-	0x01, 0x82, 0x4b, 0xb4, 0xf6, 0x2e, 0x9b, 0x51, 0xb3, 0x3b, 0xab, 0x9d, 0x71, 0x5c, 0xdf, 0x10,
-	0x42, 0xa2, 0x42, 0x1c, 0x90, 0x38, 0xd1, 0x0b, 0x3d, 0x56, 0x9c, 0x2c, 0x61, 0x89, 0xfe, 0x01,
-	0x1c, 0xaa, 0x9e, 0x2a, 0x9f, 0x10, 0x07, 0xa8, 0x9c, 0x83, 0x91, 0xf8, 0x07, 0x38, 0x21, 0xb4,
-	0xbb, 0xb3, 0x6b, 0xef, 0x0f, 0xef, 0xda, 0x21, 0x02, 0x2e, 0xb6, 0x35, 0xf3, 0xbe, 0x37, 0xdf,
-	0x7c, 0xf3, 0xcd, 0x7b, 0x63, 0xf8, 0x72, 0x1d, 0x13, 0x13, 0x13, 0x59, 0x43, 0x84, 0x3a, 0xa8,
-	0xd6, 0xa0, 0x08, 0x5b, 0xf2, 0xd1, 0x46, 0x4d, 0xa7, 0xea, 0x86, 0x4c, 0xef, 0x49, 0xb6, 0x83,
-	0x29, 0xe6, 0x16, 0xfd, 0x28, 0x69, 0x38, 0x4a, 0x62, 0x51, 0xfc, 0xbc, 0x81, 0x0d, 0xec, 0xc5,
-	0xc9, 0xee, 0x2f, 0x1f, 0xc2, 0x0b, 0x2c, 0x71, 0x4d, 0x25, 0x7a, 0x98, 0xb0, 0x8e, 0x91, 0xc5,
-	0xe6, 0x2f, 0xfa, 0xf3, 0xfb, 0x3e, 0x90, 0xe5, 0xf7, 0xa7, 0x16, 0x18, 0xd4, 0x24, 0x86, 0x7c,
-	0xb4, 0xe1, 0x7e, 0xb1, 0x89, 0x39, 0xd5, 0x44, 0x16, 0x96, 0xbd, 0x4f, 0x36, 0x24, 0x65, 0xf1,
-	0x8f, 0xd0, 0xf5, 0xe2, 0xc5, 0x3f, 0x00, 0xbc, 0xa0, 0x10, 0x63, 0x4f, 0xa7, 0x1f, 0x22, 0x7a,
-	0xa0, 0x39, 0x6a, 0xf3, 0x6d, 0x4d, 0x73, 0x74, 0x42, 0xb8, 0x77, 0xe0, 0x9c, 0xa6, 0x1f, 0xea,
-	0x86, 0x4a, 0xb1, 0xb3, 0xaf, 0xfa, 0x83, 0x25, 0xb0, 0x04, 0x56, 0x66, 0xb6, 0x4b, 0xdd, 0xce,
-	0xda, 0x3c, 0xa3, 0xc8, 0xc2, 0xf7, 0xa8, 0x83, 0x2c, 0xa3, 0x7a, 0x2e, 0x84, 0x04, 0x69, 0x76,
-	0xe0, 0xb9, 0x26, 0xcb, 0x1c, 0x66, 0x29, 0xe6, 0x64, 0x99, 0x6d, 0x46, 0xb9, 0x54, 0x76, 0xef,
-	0x3f, 0x2c, 0x17, 0x7e, 0x7f, 0x58, 0x2e, 0x7c, 0xd6, 0x6f, 0xaf, 0x26, 0x69, 0x7d, 0xd9, 0x6f,
-	0xaf, 0x5e, 0xf5, 0x33, 0xad, 0x11, 0xed, 0xae, 0xac, 0x10, 0x43, 0xc1, 0x1a, 0xfa, 0xa4, 0x15,
-	0xdb, 0x93, 0x58, 0x86, 0x97, 0x53, 0x37, 0x5b, 0xd5, 0x89, 0x8d, 0x2d, 0xa2, 0x8b, 0x7f, 0x01,
-	0xc8, 0x2b, 0xc4, 0x08, 0xa6, 0x6f, 0x05, 0x2b, 0x55, 0xf5, 0xa6, 0xea, 0x68, 0xa7, 0xa5, 0xc9,
-	0x6d, 0x38, 0x77, 0xa4, 0x1e, 0x22, 0x2d, 0x92, 0xc6, 0x17, 0xe5, 0x4a, 0xb7, 0xb3, 0x76, 0x99,
-	0xa5, 0xf9, 0x20, 0x88, 0x89, 0xe5, 0x3b, 0x8a, 0x8d, 0x57, 0xde, 0xcd, 0x97, 0x67, 0x39, 0x2a,
-	0x4f, 0x6c, 0x83, 0x08, 0x5b, 0xfe, 0x0e, 0xc5, 0x1f, 0x00, 0x14, 0x47, 0x0b, 0x10, 0xe8, 0xc4,
-	0x7d, 0x05, 0xe0, 0xb4, 0x6a, 0xe2, 0x86, 0x45, 0x4b, 0x60, 0x69, 0x6a, 0xe5, 0xcc, 0xe6, 0x45,
-	0x66, 0x3c, 0xc9, 0xf5, 0x77, 0x70, 0x15, 0xa4, 0x1d, 0x8c, 0xac, 0xed, 0x8f, 0x9e, 0xfc, 0x5a,
-	0x2e, 0x7c, 0xff, 0x5b, 0x79, 0xc5, 0x40, 0xf4, 0xa0, 0x51, 0x93, 0xea, 0xd8, 0x64, 0xfe, 0x96,
-	0x87, 0x48, 0xd1, 0x96, 0xad, 0x13, 0x0f, 0x40, 0x7e, 0xe9, 0xac, 0xcd, 0x0e, 0x66, 0x96, 0xd6,
-	0xa5, 0x6b, 0x37, 0x1e, 0xf4, 0xdb, 0xab, 0x67, 0x5d, 0x2a, 0xf5, 0xd6, 0xbe, 0x7b, 0x69, 0xc8,
-	0xa3, 0x7e, 0x7b, 0x15, 0x54, 0x19, 0x07, 0xf1, 0x31, 0x80, 0xc2, 0x10, 0xeb, 0x50, 0xb8, 0x1d,
-	0x6c, 0x9a, 0x88, 0x10, 0x84, 0xad, 0x74, 0xcd, 0xc1, 0xc9, 0x35, 0x8f, 0x5a, 0x32, 0x91, 0x3a,
-	0xc5, 0x92, 0x43, 0xec, 0x06, 0xbc, 0xc4, 0x1f, 0x01, 0x5c, 0xce, 0xa6, 0xfe, 0x7f, 0x15, 0xfd,
-	0x41, 0x11, 0xce, 0x2b, 0xc4, 0xd8, 0x6d, 0x58, 0x9a, 0x4b, 0xb6, 0x61, 0x21, 0xda, 0xba, 0x83,
-	0xf1, 0x21, 0xd7, 0x1a, 0x9f, 0xe6, 0xee, 0xa4, 0x34, 0xb3, 0x39, 0x71, 0xd7, 0xe1, 0x8c, 0xa6,
-	0xdb, 0x98, 0x20, 0x8a, 0x9d, 0xdc, 0x32, 0x33, 0x08, 0xad, 0xbc, 0x17, 0x9c, 0x66, 0x37, 0x2e,
-	0xc3, 0xd6, 0xba, 0x7b, 0xc0, 0x83, 0x50, 0xf7, 0x60, 0xcb, 0xd1, 0x83, 0x4d, 0x28, 0x50, 0x02,
-	0xe2, 0x16, 0xbc, 0x94, 0x36, 0x13, 0x9c, 0x65, 0xe5, 0x42, 0xca, 0x42, 0x25, 0x20, 0x3e, 0x07,
-	0x70, 0x56, 0x21, 0xc6, 0xfb, 0xb6, 0xa6, 0x52, 0xfd, 0x8e, 0xea, 0xa8, 0x26, 0x71, 0xf7, 0xa4,
-	0x36, 0xe8, 0x01, 0x76, 0x10, 0x6d, 0xe5, 0x16, 0x9b, 0x41, 0x28, 0xb7, 0x0b, 0xa7, 0x6d, 0x2f,
-	0x83, 0x27, 0xc4, 0x99, 0xcd, 0xab, 0x52, 0x46, 0xd7, 0x92, 0xfc, 0xc5, 0xb6, 0x67, 0xdc, 0x03,
-	0x61, 0x9a, 0xfa, 0xe8, 0x8a, 0xd2, 0x4d, 0x5a, 0xc3, 0xd3, 0x24, 0x5c, 0xca, 0xd5, 0xe4, 0xd5,
-	0x21, 0x4d, 0x22, 0xcd, 0x27, 0xb6, 0x1d, 0x51, 0x82, 0x0b, 0xb1, 0xa1, 0x50, 0x94, 0xf3, 0x29,
-	0x2b, 0x89, 0x8f, 0x8b, 0x5e, 0x87, 0x8a, 0xc8, 0xb8, 0x67, 0xeb, 0x96, 0x76, 0x62, 0x61, 0x2e,
-	0xc1, 0x19, 0x47, 0xaf, 0x23, 0x1b, 0xe9, 0x16, 0xf5, 0x4d, 0x52, 0x1d, 0x0c, 0x0c, 0xb9, 0x77,
-	0xea, 0x5f, 0x76, 0x6f, 0xe5, 0xf6, 0x28, 0xf7, 0x45, 0x94, 0x5e, 0x8e, 0x2b, 0x2d, 0xa7, 0xca,
-	0x53, 0x02, 0xe2, 0x75, 0xaf, 0xdd, 0x25, 0xa7, 0xf2, 0x5c, 0xf8, 0x67, 0xd1, 0x2b, 0xa7, 0xb7,
-	0x7c, 0xcf, 0x87, 0x25, 0xc9, 0xef, 0x01, 0xc4, 0xbb, 0xe3, 0x91, 0x8b, 0x06, 0xc6, 0xbe, 0x68,
-	0xa7, 0xdd, 0xfa, 0xfe, 0xcb, 0xd3, 0xda, 0x9b, 0xa8, 0x66, 0xbc, 0x92, 0x76, 0x6a, 0x03, 0x85,
-	0x99, 0xb6, 0xe2, 0x5b, 0x5e, 0x37, 0xc8, 0x50, 0x3e, 0xe3, 0xb2, 0x6c, 0xad, 0x6f, 0xfe, 0xf4,
-	0x22, 0x9c, 0x52, 0x88, 0xc1, 0x7d, 0x0e, 0x20, 0x97, 0xf2, 0xa6, 0xdb, 0xcc, 0x2c, 0x01, 0xa9,
-	0x4f, 0x23, 0xbe, 0x32, 0x39, 0x26, 0xec, 0x58, 0xdf, 0x00, 0xb8, 0x30, 0xea, 0x2d, 0x75, 0x23,
-	0x2f, 0xef, 0x08, 0x20, 0x7f, 0xf3, 0x84, 0xc0, 0x90, 0xd5, 0x77, 0x00, 0x2e, 0x66, 0x3d, 0x15,
-	0xde, 0x1c, 0x77, 0x81, 0x14, 0x30, 0xbf, 0xf3, 0x0f, 0xc0, 0x21, 0xc3, 0x6f, 0x01, 0x9c, 0x4b,
-	0xf6, 0xd5, 0x8d, 0xbc, 0xd4, 0x09, 0x08, 0xff, 0xc6, 0xc4, 0x90, 0xf0, 0x29, 0xfc, 0xd2, 0xd3,
-	0xa4, 0xbf, 0xee, 0x17, 0x01, 0xf7, 0x05, 0x80, 0x67, 0x23, 0xfd, 0xe9, 0xb5, 0xbc, 0x35, 0x86,
-	0xa3, 0xf9, 0x6b, 0x93, 0x44, 0x87, 0x64, 0xce, 0x3f, 0x4d, 0x76, 0x06, 0x57, 0x25, 0x2e, 0xa5,
-	0x2d, 0xe4, 0x9a, 0x3c, 0x89, 0xc9, 0x37, 0xf9, 0xe8, 0x22, 0x9a, 0xc2, 0x6d, 0x6b, 0x9d, 0xeb,
-	0x00, 0xb8, 0x98, 0x55, 0x3f, 0x73, 0x3d, 0x96, 0x01, 0xce, 0xf7, 0xd8, 0x18, 0xf5, 0x23, 0x95,
-	0x36, 0xff, 0xc2, 0xa7, 0x6e, 0x89, 0xdb, 0xbe, 0xf9, 0xa8, 0x27, 0x80, 0x27, 0x3d, 0x01, 0x3c,
-	0xeb, 0x09, 0xe0, 0x79, 0x4f, 0x00, 0x5f, 0x1f, 0x0b, 0x85, 0x67, 0xc7, 0x42, 0xe1, 0xe7, 0x63,
-	0xa1, 0xf0, 0xf1, 0x15, 0x1f, 0x45, 0xb4, 0xbb, 0x12, 0xc2, 0xf2, 0xbd, 0x68, 0xbb, 0xf7, 0xea,
-	0x67, 0x6d, 0xda, 0xfb, 0x77, 0xf9, 0xfa, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xd3, 0xe1,
-	0xa5, 0x4f, 0x0f, 0x00, 0x00,
+	// 1260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6b, 0x1b, 0xc7,
+	0x1b, 0xd6, 0xc8, 0xfc, 0xfc, 0xab, 0x27, 0x06, 0xdb, 0x1b, 0xbb, 0x96, 0xd7, 0xb1, 0xe4, 0xac,
+	0x1b, 0xc7, 0x98, 0x6a, 0xd7, 0x72, 0xe3, 0x98, 0xaa, 0x94, 0x60, 0x2b, 0x35, 0x34, 0xa0, 0x34,
+	0xc8, 0xfd, 0xa0, 0x3d, 0xd4, 0xac, 0xb4, 0xdb, 0xf5, 0x10, 0x69, 0x47, 0xec, 0x8c, 0xec, 0xe8,
+	0xd4, 0x52, 0x0a, 0x0d, 0xa5, 0x87, 0x42, 0xa1, 0xd0, 0x5c, 0x9a, 0x53, 0x09, 0x3d, 0x19, 0x6a,
+	0x68, 0xe8, 0xa1, 0xe7, 0xe0, 0x1e, 0x1a, 0x7c, 0x2a, 0x81, 0xa6, 0xc1, 0x3e, 0xb8, 0xd0, 0xfe,
+	0x01, 0x3d, 0x96, 0xfd, 0xd4, 0x7e, 0x69, 0x77, 0x65, 0x9b, 0x24, 0x97, 0xc4, 0x9e, 0x79, 0x9f,
+	0x67, 0x9e, 0x79, 0xde, 0x79, 0x67, 0xde, 0x35, 0x7c, 0xa9, 0x86, 0x49, 0x03, 0x13, 0x41, 0x42,
+	0x84, 0x6a, 0xa8, 0xda, 0xa2, 0x08, 0xab, 0xc2, 0x56, 0xa1, 0x2a, 0x53, 0xb1, 0x20, 0xd0, 0x5b,
+	0x7c, 0x53, 0xc3, 0x14, 0x33, 0x93, 0x66, 0x14, 0xef, 0x8e, 0xe2, 0xad, 0x28, 0x76, 0x54, 0xc1,
+	0x0a, 0x36, 0xe2, 0x04, 0xfd, 0x27, 0x13, 0xc2, 0x66, 0x2d, 0xe2, 0xaa, 0x48, 0x64, 0x87, 0xb0,
+	0x86, 0x91, 0x6a, 0xcd, 0x4f, 0x98, 0xf3, 0x1b, 0x26, 0xd0, 0xe2, 0x37, 0xa7, 0xc6, 0x2d, 0x68,
+	0x83, 0x28, 0xc2, 0x56, 0x41, 0xff, 0xcf, 0x9a, 0x18, 0x11, 0x1b, 0x48, 0xc5, 0x82, 0xf1, 0xaf,
+	0x35, 0xc4, 0x47, 0xe9, 0xf7, 0xc8, 0x35, 0xe2, 0xb9, 0xbf, 0x01, 0x1c, 0x2b, 0x13, 0x65, 0x5d,
+	0xa6, 0xef, 0x21, 0xba, 0x29, 0x69, 0xe2, 0xf6, 0x8a, 0x24, 0x69, 0x32, 0x21, 0xcc, 0x1b, 0x70,
+	0x44, 0x92, 0xeb, 0xb2, 0x22, 0x52, 0xac, 0x6d, 0x88, 0xe6, 0x60, 0x06, 0x4c, 0x83, 0xb9, 0x81,
+	0xd5, 0xcc, 0xfe, 0x6e, 0x7e, 0xd4, 0x92, 0x68, 0x85, 0xaf, 0x53, 0x0d, 0xa9, 0x4a, 0x65, 0xd8,
+	0x81, 0xd8, 0x34, 0x25, 0x38, 0xbc, 0x6d, 0x31, 0x3b, 0x2c, 0xe9, 0x18, 0x96, 0xa1, 0x6d, 0xaf,
+	0x96, 0xe2, 0xda, 0xed, 0xbb, 0xb9, 0xd4, 0x5f, 0x77, 0x73, 0xa9, 0x4f, 0x8f, 0x76, 0xe6, 0x83,
+	0xb2, 0xbe, 0x38, 0xda, 0x99, 0x9f, 0x31, 0x99, 0xf2, 0x44, 0xba, 0x29, 0x94, 0x89, 0x52, 0xc6,
+	0x12, 0xfa, 0xa8, 0xed, 0xdb, 0x13, 0x97, 0x83, 0x53, 0xa1, 0x9b, 0xad, 0xc8, 0xa4, 0x89, 0x55,
+	0x22, 0x73, 0x8f, 0xd3, 0x90, 0x2d, 0x13, 0xc5, 0x9e, 0xbe, 0x6a, 0xaf, 0x54, 0x91, 0xb7, 0x45,
+	0x4d, 0x3a, 0x2d, 0x4f, 0xae, 0xc3, 0x91, 0x2d, 0xb1, 0x8e, 0x24, 0x0f, 0x8d, 0x69, 0xca, 0xf9,
+	0xfd, 0xdd, 0xfc, 0x94, 0x45, 0xf3, 0xae, 0x1d, 0xe3, 0xe3, 0xdb, 0xf2, 0x8d, 0x33, 0x1f, 0xc2,
+	0x11, 0x4d, 0xae, 0xa1, 0x26, 0x92, 0x55, 0xea, 0xf0, 0xf5, 0x19, 0x7c, 0x85, 0x6e, 0xb2, 0x1e,
+	0xed, 0xe6, 0xc7, 0x6e, 0x79, 0x4e, 0xc4, 0xf4, 0x56, 0x81, 0x5f, 0xe0, 0x17, 0x2a, 0xc3, 0x0e,
+	0x97, 0x6d, 0xff, 0x9b, 0xf1, 0xf6, 0xcf, 0x7a, 0xed, 0xf7, 0x19, 0x88, 0xb0, 0x6a, 0x3a, 0xc8,
+	0xfd, 0x08, 0x20, 0xd7, 0xdd, 0x60, 0x3b, 0x0f, 0xcc, 0x97, 0x00, 0xf6, 0x8b, 0x0d, 0xdc, 0x52,
+	0x69, 0x06, 0x4c, 0xf7, 0xcd, 0x9d, 0x59, 0x9c, 0xb0, 0x0e, 0x36, 0xaf, 0xd7, 0x8f, 0x5d, 0x6a,
+	0x7c, 0x09, 0x23, 0x75, 0xf5, 0xfd, 0x07, 0x8f, 0x73, 0xa9, 0x1f, 0xfe, 0xcc, 0xcd, 0x29, 0x88,
+	0x6e, 0xb6, 0xaa, 0x7c, 0x0d, 0x37, 0xac, 0xfa, 0x11, 0x5c, 0xa2, 0x68, 0xbb, 0x29, 0x13, 0x03,
+	0x40, 0x1e, 0xed, 0xe6, 0x87, 0x3a, 0x33, 0xd3, 0x0b, 0xfc, 0xa5, 0xe5, 0x3b, 0x47, 0x3b, 0xf3,
+	0x83, 0xba, 0x94, 0x5a, 0x7b, 0x43, 0x2f, 0x4a, 0x72, 0xef, 0x68, 0x67, 0x1e, 0x54, 0x2c, 0x0d,
+	0xdc, 0xaf, 0x00, 0x66, 0x5d, 0xaa, 0x57, 0xea, 0x75, 0x9f, 0xf0, 0xd3, 0x2a, 0x97, 0x62, 0xd5,
+	0xb6, 0x7a, 0xbf, 0x5b, 0x7e, 0x92, 0xe5, 0xc0, 0x00, 0x0a, 0x5e, 0xcd, 0x96, 0x54, 0xee, 0x17,
+	0x00, 0x67, 0xa3, 0x77, 0xe3, 0xe4, 0xa1, 0x9d, 0x3c, 0x0d, 0x6b, 0xbd, 0xa6, 0x21, 0xda, 0xf3,
+	0xe2, 0x44, 0x57, 0x07, 0xb8, 0xfb, 0xde, 0x74, 0x38, 0x75, 0x52, 0xc2, 0x8d, 0x06, 0x22, 0x04,
+	0x61, 0x35, 0xbc, 0xc4, 0xc0, 0xb1, 0x4b, 0xcc, 0x77, 0x03, 0x05, 0xa8, 0x43, 0x6e, 0x20, 0x97,
+	0xba, 0x8e, 0x2e, 0xee, 0x27, 0xaf, 0xf7, 0x21, 0xd2, 0x9f, 0xd7, 0x1a, 0xb8, 0x93, 0x86, 0xa3,
+	0x65, 0xa2, 0xac, 0xb5, 0x54, 0x49, 0x17, 0xdb, 0x52, 0x11, 0x6d, 0xdf, 0xc0, 0xb8, 0xfe, 0x0c,
+	0xcf, 0x08, 0x73, 0x19, 0x0e, 0x48, 0x72, 0x13, 0x13, 0x44, 0xb1, 0x16, 0xfb, 0xaa, 0x74, 0x42,
+	0x8b, 0x6f, 0xb9, 0xaa, 0xcc, 0x6b, 0xc3, 0x92, 0x51, 0x5f, 0x9d, 0x50, 0x3d, 0xb1, 0x39, 0x6f,
+	0x62, 0x03, 0x0e, 0x64, 0x00, 0xb7, 0x04, 0xcf, 0x85, 0xcd, 0xd8, 0xb9, 0x2c, 0x8e, 0x85, 0x2c,
+	0x94, 0x01, 0xdc, 0x13, 0x00, 0x87, 0xca, 0x44, 0x79, 0xa7, 0x29, 0x89, 0x54, 0xbe, 0x21, 0x6a,
+	0x62, 0x83, 0xe8, 0x7b, 0x12, 0x5b, 0x74, 0x13, 0x6b, 0x88, 0xb6, 0x63, 0x2f, 0x90, 0x4e, 0x28,
+	0xb3, 0x06, 0xfb, 0x9b, 0x06, 0x83, 0x61, 0xc4, 0x99, 0xc5, 0x19, 0x3e, 0xa2, 0x49, 0xe1, 0xcd,
+	0xc5, 0x56, 0x07, 0xf4, 0x84, 0x58, 0x9e, 0x9a, 0xe8, 0x62, 0x79, 0x3f, 0x78, 0x34, 0x0c, 0x4f,
+	0x9c, 0xa5, 0x74, 0x4f, 0x2e, 0xfa, 0xef, 0x1a, 0xbb, 0xd7, 0xf0, 0x6d, 0x87, 0xe3, 0xe1, 0xb8,
+	0x6f, 0xc8, 0x31, 0xe5, 0x6c, 0xc8, 0x4a, 0xdc, 0xfd, 0xb4, 0xd1, 0x90, 0x78, 0x6c, 0x5c, 0x6f,
+	0xca, 0xaa, 0x74, 0x6c, 0x63, 0xce, 0xc1, 0x01, 0xe7, 0x45, 0x33, 0x0f, 0x49, 0xa5, 0x33, 0xe0,
+	0x3a, 0xbd, 0x7d, 0x4f, 0xfb, 0x86, 0xbb, 0xde, 0xed, 0xf4, 0x79, 0x9c, 0x0e, 0xbd, 0xd5, 0x83,
+	0xf6, 0x64, 0x00, 0x77, 0x19, 0x4e, 0x85, 0x4e, 0xc5, 0x9d, 0xc2, 0x7f, 0xd3, 0xc6, 0x75, 0x7a,
+	0xd5, 0x3c, 0xf3, 0xce, 0x95, 0x64, 0xbd, 0x05, 0x46, 0x8d, 0x7b, 0x0a, 0x0d, 0x24, 0x2e, 0xb4,
+	0x53, 0xef, 0x74, 0x9e, 0x61, 0xb6, 0xd6, 0x7b, 0xba, 0x33, 0x2e, 0x84, 0x65, 0xad, 0xe3, 0xb0,
+	0xfd, 0x14, 0xbf, 0x0e, 0x67, 0x3d, 0xe3, 0x01, 0xe7, 0x23, 0x8a, 0x65, 0x69, 0x81, 0xfb, 0x3e,
+	0x0d, 0x19, 0xb3, 0xa1, 0x5d, 0x69, 0x51, 0x5c, 0xc2, 0x8d, 0x26, 0x6e, 0xa9, 0xcf, 0x6d, 0x9b,
+	0x9a, 0x81, 0xff, 0x97, 0x55, 0xb1, 0x5a, 0x97, 0x25, 0xa3, 0x39, 0x7d, 0xa1, 0x62, 0xff, 0x5a,
+	0xdc, 0x38, 0x41, 0xd7, 0x33, 0x13, 0xe6, 0xb4, 0xcf, 0x11, 0x6e, 0x19, 0xb2, 0xc1, 0x51, 0xc7,
+	0xdb, 0x88, 0x56, 0xe3, 0x8f, 0x34, 0xbc, 0x60, 0x22, 0x3b, 0x2f, 0xb4, 0xce, 0x61, 0x3f, 0xe0,
+	0x6f, 0x6f, 0x6a, 0x32, 0xd9, 0xc4, 0x75, 0xe9, 0xb4, 0x3b, 0x0e, 0xe6, 0x63, 0x38, 0x40, 0x6d,
+	0xf2, 0x4c, 0xfa, 0x69, 0x9d, 0xf6, 0xce, 0x9a, 0xc5, 0x5a, 0xb2, 0xa4, 0x84, 0xf6, 0x42, 0x17,
+	0xbb, 0x24, 0x45, 0x37, 0xd1, 0x6d, 0x1f, 0x77, 0x0d, 0xe6, 0x13, 0xd9, 0x9b, 0x20, 0x57, 0x8b,
+	0xff, 0x0c, 0xc2, 0xbe, 0x32, 0x51, 0x98, 0xcf, 0x00, 0x64, 0x42, 0x3e, 0x68, 0x17, 0x23, 0x1f,
+	0xc4, 0xd0, 0xef, 0x42, 0xb6, 0xd8, 0x3b, 0xc6, 0xe9, 0xdf, 0xbe, 0x06, 0x70, 0xbc, 0xdb, 0x87,
+	0xe4, 0x72, 0x1c, 0x6f, 0x17, 0x20, 0x7b, 0xe5, 0x98, 0x40, 0x47, 0xd5, 0xcf, 0x00, 0x4e, 0x46,
+	0x7d, 0xc7, 0xbc, 0x96, 0x74, 0x81, 0x10, 0x30, 0x5b, 0x3a, 0x01, 0xd8, 0xf9, 0x06, 0x9f, 0xd8,
+	0xeb, 0x96, 0x61, 0xe6, 0x3b, 0x97, 0xf8, 0xb0, 0xae, 0x3f, 0xb1, 0xf8, 0x10, 0x30, 0x5b, 0x3a,
+	0x01, 0xd8, 0xb1, 0xf7, 0x5b, 0x00, 0x47, 0x82, 0x2d, 0x72, 0x21, 0x8e, 0x3a, 0x00, 0x61, 0x5f,
+	0xed, 0x19, 0xe2, 0x18, 0xf8, 0xe2, 0x5e, 0xf0, 0xa9, 0xb8, 0x9d, 0x06, 0xcc, 0xe7, 0x00, 0x0e,
+	0x7a, 0x5a, 0xcd, 0x97, 0xe3, 0xd6, 0x70, 0x47, 0xb3, 0x97, 0x7a, 0x89, 0x76, 0xc4, 0x9c, 0xdd,
+	0x0b, 0x36, 0x79, 0xba, 0x4b, 0x4c, 0x48, 0x87, 0x17, 0x5b, 0xa1, 0x41, 0x0c, 0x5b, 0xec, 0x1d,
+	0x13, 0xa1, 0x6d, 0x69, 0x81, 0xd9, 0x05, 0x70, 0x32, 0xaa, 0x15, 0x8a, 0x3d, 0x63, 0x11, 0x60,
+	0xb6, 0x74, 0x02, 0x70, 0xb4, 0xec, 0x6f, 0x00, 0x1c, 0xf2, 0xf7, 0x01, 0x42, 0x82, 0xdb, 0xcb,
+	0x0d, 0x60, 0x97, 0x7b, 0x04, 0x24, 0xa9, 0xd9, 0xdf, 0x00, 0xe4, 0x12, 0x3c, 0x9f, 0xab, 0x09,
+	0x96, 0x8e, 0xe1, 0x60, 0xaf, 0x9d, 0x9c, 0x23, 0xc1, 0x8e, 0xd8, 0xff, 0x7d, 0xa2, 0x3f, 0x95,
+	0xab, 0x57, 0xee, 0x1d, 0x64, 0xc1, 0x83, 0x83, 0x2c, 0x78, 0x78, 0x90, 0x05, 0x4f, 0x0e, 0xb2,
+	0xe0, 0xab, 0xc3, 0x6c, 0xea, 0xe1, 0x61, 0x36, 0xf5, 0xfb, 0x61, 0x36, 0xf5, 0xc1, 0x79, 0x53,
+	0x0a, 0x91, 0x6e, 0xf2, 0x08, 0x0b, 0x5e, 0x22, 0xf3, 0x1d, 0xae, 0xf6, 0x1b, 0x7f, 0x82, 0x7d,
+	0xe5, 0xbf, 0x01, 0x00, 0xc9, 0xe7, 0x4b, 0x06, 0x74, 0x16, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -791,6 +1067,35 @@ func (this *MsgWithdrawDelegatorRewardResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawAllDelegatorRewardsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawAllDelegatorRewardsResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawAllDelegatorRewardsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *MsgWithdrawValidatorCommissionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -966,6 +1271,48 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSetCommissionAutoWithdrawThresholdResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetCommissionAutoWithdrawThresholdResponse)
+	if !ok {
+		that2, ok := that.(MsgSetCommissionAutoWithdrawThresholdResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -985,6 +1332,9 @@ type MsgClient interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single validator.
 	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of a
+	// delegator from all the validators it is delegating to.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
@@ -1006,6 +1356,13 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to opt a delegation in or out of the
+	// periodic re-delegation of its rewards.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// SetCommissionAutoWithdrawThreshold defines a method for a validator
+	// operator to set the amount of accumulated commission at which its
+	// commission is automatically withdrawn.
+	SetCommissionAutoWithdrawThreshold(ctx context.Context, in *MsgSetCommissionAutoWithdrawThreshold, opts ...grpc.CallOption) (*MsgSetCommissionAutoWithdrawThresholdResponse, error)
 }
 
 type msgClient struct {
@@ -1034,6 +1391,15 @@ func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdraw
 	return out, nil
 }
 
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error) {
 	out := new(MsgWithdrawValidatorCommissionResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission", in, out, opts...)
@@ -1080,6 +1446,24 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCommissionAutoWithdrawThreshold(ctx context.Context, in *MsgSetCommissionAutoWithdrawThreshold, opts ...grpc.CallOption) (*MsgSetCommissionAutoWithdrawThresholdResponse, error) {
+	out := new(MsgSetCommissionAutoWithdrawThresholdResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetCommissionAutoWithdrawThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1088,6 +1472,9 @@ type MsgServer interface {
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single validator.
 	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of a
+	// delegator from all the validators it is delegating to.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
 	// WithdrawValidatorCommission defines a method to withdraw the
 	// full commission to the validator address.
 	WithdrawValidatorCommission(context.Context, *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error)
@@ -1109,6 +1496,13 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to opt a delegation in or out of the
+	// periodic re-delegation of its rewards.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// SetCommissionAutoWithdrawThreshold defines a method for a validator
+	// operator to set the amount of accumulated commission at which its
+	// commission is automatically withdrawn.
+	SetCommissionAutoWithdrawThreshold(context.Context, *MsgSetCommissionAutoWithdrawThreshold) (*MsgSetCommissionAutoWithdrawThresholdResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1121,6 +1515,9 @@ func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawAllDelegatorRewards(ctx context.Context, req *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context, req *MsgWithdrawValidatorCommission) (*MsgWithdrawValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawValidatorCommission not implemented")
 }
@@ -1136,6 +1533,12 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) SetCommissionAutoWithdrawThreshold(ctx context.Context, req *MsgSetCommissionAutoWithdrawThreshold) (*MsgSetCommissionAutoWithdrawThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionAutoWithdrawThreshold not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1177,6 +1580,24 @@ func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawValidatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawValidatorCommission)
 	if err := dec(in); err != nil {
@@ -1267,6 +1688,42 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCommissionAutoWithdrawThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCommissionAutoWithdrawThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCommissionAutoWithdrawThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetCommissionAutoWithdrawThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCommissionAutoWithdrawThreshold(ctx, req.(*MsgSetCommissionAutoWithdrawThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1279,6 +1736,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawDelegatorReward",
 			Handler:    _Msg_WithdrawDelegatorReward_Handler,
 		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
 		{
 			MethodName: "WithdrawValidatorCommission",
 			Handler:    _Msg_WithdrawValidatorCommission_Handler,
@@ -1299,6 +1760,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetCommissionAutoWithdrawThreshold",
+			Handler:    _Msg_SetCommissionAutoWithdrawThreshold_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1384,6 +1853,13 @@ func (m *MsgWithdrawDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -1438,6 +1914,73 @@ func (m *MsgWithdrawDelegatorRewardResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawAllDelegatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWithdrawValidatorCommission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1783,6 +2326,143 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCommissionAutoWithdrawThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionAutoWithdrawThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionAutoWithdrawThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1834,6 +2514,10 @@ func (m *MsgWithdrawDelegatorReward) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1852,6 +2536,34 @@ func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawAllDelegatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgWithdrawValidatorCommission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1996,22 +2708,79 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCommissionAutoWithdrawThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2259,6 +3028,38 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2364,6 +3165,172 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawAllDelegatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3257,6 +4224,356 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCommissionAutoWithdrawThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCommissionAutoWithdrawThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCommissionAutoWithdrawThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCommissionAutoWithdrawThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCommissionAutoWithdrawThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCommissionAutoWithdrawThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
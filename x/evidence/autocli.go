@@ -25,8 +25,9 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "AllEvidence",
 					Use:       "list",
-					Short:     "Query all (paginated) submitted evidence",
-					Example:   fmt.Sprintf("%s query evidence --page=2 --page-limit=50", version.AppName),
+					Short:     "Query all (paginated) submitted evidence, optionally filtered by route",
+					Example: fmt.Sprintf(`%[1]s query evidence --page=2 --page-limit=50
+%[1]s query evidence list --route=equivocation`, version.AppName),
 				},
 			},
 		},
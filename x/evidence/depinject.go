@@ -1,6 +1,8 @@
 package evidence
 
 import (
+	"sort"
+
 	modulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -30,7 +32,8 @@ type ModuleInputs struct {
 
 	Environment      appmodule.Environment
 	Cdc              codec.Codec
-	EvidenceHandlers []eviclient.EvidenceHandler `optional:"true"`
+	EvidenceHandlers []eviclient.EvidenceHandler     `optional:"true"`
+	EvidenceRoutes   map[string]types.EvidenceRoutes `optional:"true"`
 	CometService     comet.Service
 
 	StakingKeeper  types.StakingKeeper
@@ -47,7 +50,37 @@ type ModuleOutputs struct {
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+	if len(in.EvidenceRoutes) > 0 {
+		k.SetRouter(newRouter(in.EvidenceRoutes))
+	}
 	m := NewAppModule(in.Cdc, *k, in.CometService, in.EvidenceHandlers...)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
 }
+
+// newRouter registers the evidence routes provided by the modules of the app
+// on a new router, in a deterministic order.
+func newRouter(moduleRoutes map[string]types.EvidenceRoutes) types.Router {
+	rtr := types.NewRouter()
+
+	modules := make([]string, 0, len(moduleRoutes))
+	for module := range moduleRoutes {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		routes := moduleRoutes[module]
+		paths := make([]string, 0, len(routes))
+		for path := range routes {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			rtr.AddRoute(path, routes[path])
+		}
+	}
+
+	return rtr
+}
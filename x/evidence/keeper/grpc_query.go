@@ -65,9 +65,14 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
-	evidences, pageRes, err := query.CollectionPaginate(ctx, k.k.Evidences, req.Pagination, func(_ []byte, value exported.Evidence) (*codectypes.Any, error) {
-		return codectypes.NewAnyWithValue(value)
-	})
+	evidences, pageRes, err := query.CollectionFilteredPaginate(ctx, k.k.Evidences, req.Pagination,
+		func(_ []byte, value exported.Evidence) (bool, error) {
+			return req.Route == "" || value.Route() == req.Route, nil
+		},
+		func(_ []byte, value exported.Evidence) (*codectypes.Any, error) {
+			return codectypes.NewAnyWithValue(value)
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +135,28 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 				suite.NotNil(res.Pagination.NextKey)
 			},
 		},
+		{
+			"success with route filter",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = &types.QueryAllEvidenceRequest{Route: types.RouteEquivocation}
+			},
+			true,
+			func(res *types.QueryAllEvidenceResponse) {
+				suite.Equal(len(res.Evidence), 10)
+			},
+		},
+		{
+			"success with route filter without matching evidence",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = &types.QueryAllEvidenceRequest{Route: "other"}
+			},
+			true,
+			func(res *types.QueryAllEvidenceResponse) {
+				suite.Empty(res.Evidence)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
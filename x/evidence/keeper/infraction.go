@@ -144,7 +144,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
-		err = k.slashingKeeper.Jail(ctx, consAddr)
+		err = k.slashingKeeper.JailWithInfractionReason(ctx, consAddr, st.Infraction_INFRACTION_DOUBLE_SIGN)
 		if err != nil {
 			return err
 		}
@@ -213,6 +213,28 @@ func (suite *KeeperTestSuite) TestSubmitInvalidEvidence() {
 	suite.Nil(res)
 }
 
+func (suite *KeeperTestSuite) TestSubmitEvidenceWithoutHandler() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	pk := ed25519.GenPrivKey()
+	consAddr, err := suite.consAddressCodec.BytesToString(pk.PubKey().Address())
+	suite.Require().NoError(err)
+	e := &types.Equivocation{
+		Height:           1,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: consAddr,
+	}
+
+	// a keeper without router has no evidence handler
+	k := keeper.NewKeeper(suite.encCfg.Codec, suite.evidenceKeeper.Environment, suite.stakingKeeper, suite.slashingKeeper, suite.addressCodec)
+	err = k.SubmitEvidence(ctx, e)
+	suite.ErrorIs(err, types.ErrNoEvidenceHandlerExists)
+
+	res, err := k.Evidences.Get(ctx, e.Hash())
+	suite.ErrorIs(err, collections.ErrNotFound)
+	suite.Nil(res)
+}
+
 func (suite *KeeperTestSuite) TestIterateEvidence() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	numEvidence := 100
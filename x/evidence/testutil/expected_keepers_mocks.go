@@ -163,6 +163,20 @@ func (mr *MockSlashingKeeperMockRecorder) JailUntil(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), arg0, arg1, arg2)
 }
 
+// JailWithInfractionReason mocks base method.
+func (m *MockSlashingKeeper) JailWithInfractionReason(arg0 context.Context, arg1 types0.ConsAddress, arg2 stakingv1beta1.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailWithInfractionReason", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailWithInfractionReason indicates an expected call of JailWithInfractionReason.
+func (mr *MockSlashingKeeperMockRecorder) JailWithInfractionReason(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailWithInfractionReason", reflect.TypeOf((*MockSlashingKeeper)(nil).JailWithInfractionReason), arg0, arg1, arg2)
+}
+
 // Slash mocks base method.
 func (m *MockSlashingKeeper) Slash(arg0 context.Context, arg1 types0.ConsAddress, arg2 math.LegacyDec, arg3, arg4 int64) error {
 	m.ctrl.T.Helper()
@@ -30,6 +30,7 @@ type SlashingKeeper interface {
 	SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction) error
 	SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
 	Jail(context.Context, sdk.ConsAddress) error
+	JailWithInfractionReason(context.Context, sdk.ConsAddress, st.Infraction) error
 	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 }
 
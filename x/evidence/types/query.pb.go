@@ -139,6 +139,9 @@ func (m *QueryEvidenceResponse) GetEvidence() *any.Any {
 type QueryAllEvidenceRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// route defines an optional evidence route to filter the evidence by, i.e.
+	// the type of the evidence (e.g. equivocation).
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
 }
 
 func (m *QueryAllEvidenceRequest) Reset()         { *m = QueryAllEvidenceRequest{} }
@@ -181,6 +184,13 @@ func (m *QueryAllEvidenceRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllEvidenceRequest) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
 // QueryAllEvidenceResponse is the response type for the Query/AllEvidence RPC
 // method.
 type QueryAllEvidenceResponse struct {
@@ -249,36 +259,38 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0xc7, 0x99, 0xfa, 0x91, 0xeb, 0xdc, 0x6b, 0x4c, 0xc6, 0x6b, 0x2e, 0xb7, 0xd1, 0x06, 0x4b,
-	0x22, 0x48, 0xc2, 0x4c, 0x41, 0x13, 0xd6, 0x90, 0xf8, 0xb5, 0xd3, 0x2e, 0xdd, 0x90, 0x29, 0x8c,
-	0x6d, 0x03, 0xce, 0x14, 0xa6, 0x10, 0x89, 0x71, 0xe3, 0x13, 0x98, 0x18, 0x57, 0xc6, 0xb7, 0xf0,
-	0x15, 0x4c, 0x5c, 0x92, 0xb8, 0x31, 0xae, 0x0c, 0xf8, 0x20, 0x86, 0x99, 0x29, 0x20, 0x1f, 0xa2,
-	0xcb, 0xe9, 0xf9, 0x9f, 0xff, 0xff, 0xd7, 0x73, 0x0e, 0x2c, 0x76, 0x84, 0x7c, 0x29, 0x24, 0x61,
-	0xe3, 0xb8, 0xcb, 0x78, 0x87, 0x91, 0x71, 0x2d, 0x60, 0x29, 0xad, 0x91, 0xc1, 0x88, 0x0d, 0x27,
-	0x38, 0x19, 0x8a, 0x54, 0xa0, 0x33, 0x2d, 0xc2, 0x99, 0x08, 0x1b, 0x91, 0x7d, 0xae, 0x0b, 0x6d,
-	0x25, 0x23, 0x46, 0xa5, 0x1e, 0x76, 0xc5, 0x18, 0x07, 0x54, 0x32, 0x6d, 0xb6, 0xb4, 0x4e, 0x68,
-	0x18, 0x73, 0x9a, 0xc6, 0x82, 0x1b, 0xed, 0x79, 0x28, 0x44, 0xd8, 0x67, 0x44, 0xbd, 0x82, 0xd1,
-	0x0b, 0x42, 0xb9, 0x89, 0xb6, 0x6f, 0x9a, 0x12, 0x4d, 0x62, 0x42, 0x39, 0x17, 0xa9, 0xea, 0x33,
-	0x21, 0x6e, 0x04, 0x4f, 0x9f, 0x2d, 0xac, 0x1f, 0x18, 0x30, 0x9f, 0x0d, 0x46, 0x4c, 0xa6, 0xa8,
-	0x04, 0xaf, 0x66, 0xac, 0xed, 0x88, 0xca, 0x28, 0x0f, 0x0a, 0xa0, 0x7c, 0xd2, 0xb2, 0xf2, 0xc0,
-	0x3f, 0xc9, 0x0a, 0x8f, 0xa9, 0x8c, 0x50, 0x09, 0x5e, 0x54, 0x75, 0xab, 0x00, 0xca, 0x57, 0x5a,
-	0xd7, 0x7f, 0x7c, 0xae, 0x5e, 0xd3, 0xdc, 0x55, 0xd9, 0xed, 0x15, 0x3c, 0x7c, 0xbf, 0xe1, 0x2b,
-	0x81, 0xfb, 0x04, 0xde, 0xd8, 0x48, 0x92, 0x89, 0xe0, 0x92, 0x21, 0x0f, 0x1e, 0x65, 0x8e, 0x2a,
-	0xe5, 0xb8, 0x7e, 0x8a, 0x35, 0x33, 0xce, 0x7e, 0x07, 0x37, 0xf9, 0xc4, 0x5f, 0xaa, 0x5c, 0x0a,
-	0xcf, 0x94, 0x55, 0xb3, 0xdf, 0xdf, 0xe4, 0x7e, 0x08, 0xe1, 0x6a, 0x38, 0xc6, 0xee, 0x0e, 0x36,
-	0x73, 0x5d, 0x4c, 0x12, 0xeb, 0xb5, 0x98, 0x49, 0xe2, 0xa7, 0x34, 0xcc, 0x7a, 0xfd, 0xb5, 0x4e,
-	0xf7, 0x03, 0x80, 0xf9, 0xed, 0x8c, 0x9d, 0xc4, 0x17, 0x0e, 0x13, 0xa3, 0x47, 0x7f, 0x60, 0x59,
-	0x0a, 0xab, 0x74, 0x10, 0x4b, 0xc7, 0xad, 0x73, 0xd5, 0xbf, 0x58, 0xf0, 0x92, 0xe2, 0x42, 0x1f,
-	0x01, 0x3c, 0xca, 0xc8, 0x50, 0x15, 0xef, 0x39, 0x30, 0xbc, 0x6b, 0xbb, 0x36, 0xfe, 0x57, 0xb9,
-	0x26, 0x70, 0xbd, 0xb7, 0xdf, 0x7e, 0xbd, 0xb7, 0x2a, 0xa8, 0x4c, 0xf6, 0x1d, 0xfb, 0xf2, 0xc3,
-	0xeb, 0xc5, 0xb2, 0xdf, 0xa0, 0x4f, 0x00, 0x1e, 0xaf, 0x8d, 0x0e, 0x79, 0x7f, 0x4f, 0xdc, 0xde,
-	0xa4, 0x5d, 0xfb, 0x8f, 0x0e, 0x83, 0x79, 0x57, 0x61, 0x16, 0xd1, 0xed, 0x83, 0x98, 0xad, 0xc6,
-	0xd7, 0x99, 0x03, 0xa6, 0x33, 0x07, 0xfc, 0x9c, 0x39, 0xe0, 0xdd, 0xdc, 0xc9, 0x4d, 0xe7, 0x4e,
-	0xee, 0xfb, 0xdc, 0xc9, 0x3d, 0xbf, 0xa5, 0x7b, 0x65, 0xb7, 0x87, 0x63, 0x41, 0x5e, 0xad, 0x3c,
-	0xd2, 0x49, 0xc2, 0x64, 0x70, 0x59, 0x6d, 0xf8, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76,
-	0x21, 0xac, 0x55, 0xf7, 0x03, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x1a, 0x8a, 0xca, 0xb6, 0x08, 0xb1, 0xb4, 0x6a, 0x6a, 0x81, 0x15, 0x5c, 0x89, 0x84,
+	0xa2, 0xcc, 0x3a, 0x01, 0xa9, 0xe7, 0x46, 0xe2, 0xef, 0x06, 0x3e, 0x72, 0xa9, 0x36, 0xcd, 0x62,
+	0x5b, 0x0d, 0xbb, 0x6e, 0xd6, 0x8e, 0x88, 0x10, 0x17, 0x1e, 0x00, 0x21, 0x21, 0x4e, 0x88, 0xb7,
+	0xe8, 0x2b, 0x20, 0x71, 0xac, 0xc4, 0x05, 0xf5, 0x84, 0x12, 0x1e, 0x04, 0x65, 0x77, 0xed, 0x86,
+	0xfe, 0x10, 0x7a, 0x5c, 0xcf, 0x37, 0xdf, 0xcf, 0xcc, 0x18, 0x6f, 0xec, 0x4a, 0xf5, 0x5a, 0x2a,
+	0xca, 0x87, 0x49, 0x8f, 0x8b, 0x5d, 0x4e, 0x87, 0xad, 0x2e, 0xcf, 0x58, 0x8b, 0xee, 0xe7, 0x7c,
+	0x30, 0x82, 0x74, 0x20, 0x33, 0x49, 0xd6, 0x0c, 0x08, 0x0a, 0x10, 0x58, 0x90, 0xbb, 0x6e, 0x0a,
+	0x3b, 0x1a, 0x46, 0x2d, 0x4a, 0x3f, 0xdc, 0x4d, 0x4b, 0xdc, 0x65, 0x8a, 0x1b, 0xb2, 0x92, 0x3a,
+	0x65, 0x51, 0x22, 0x58, 0x96, 0x48, 0x61, 0xb1, 0xeb, 0x91, 0x94, 0x51, 0x9f, 0x53, 0xfd, 0xea,
+	0xe6, 0xaf, 0x28, 0x13, 0x56, 0xda, 0xbd, 0x65, 0x4b, 0x2c, 0x4d, 0x28, 0x13, 0x42, 0x66, 0xba,
+	0xcf, 0x8a, 0xf8, 0x31, 0x5e, 0x79, 0x31, 0xa5, 0x7e, 0x64, 0x8d, 0x85, 0x7c, 0x3f, 0xe7, 0x2a,
+	0x23, 0x75, 0x7c, 0xad, 0xf0, 0xba, 0x13, 0x33, 0x15, 0x57, 0x51, 0x0d, 0x35, 0x96, 0x3b, 0x4e,
+	0x15, 0x85, 0xcb, 0x45, 0xe1, 0x29, 0x53, 0x31, 0xa9, 0xe3, 0xcb, 0xba, 0xee, 0xd4, 0x50, 0xe3,
+	0x6a, 0xe7, 0xe6, 0xd1, 0x41, 0xf3, 0xba, 0xf1, 0xdd, 0x54, 0xbd, 0xbd, 0x5a, 0x00, 0x0f, 0xb7,
+	0x42, 0x0d, 0xf0, 0x9f, 0xe1, 0xd5, 0x13, 0x4a, 0x2a, 0x95, 0x42, 0x71, 0x12, 0xe0, 0xc5, 0x82,
+	0x51, 0xab, 0x2c, 0xb5, 0x57, 0xc0, 0x78, 0x86, 0x22, 0x0e, 0x6c, 0x8b, 0x51, 0x58, 0xa2, 0xfc,
+	0x0f, 0x08, 0xaf, 0x69, 0xae, 0xed, 0x7e, 0xff, 0xa4, 0xf1, 0xc7, 0x18, 0x1f, 0x4f, 0xc7, 0xf2,
+	0xdd, 0x05, 0x3b, 0xd8, 0xe9, 0x28, 0xc1, 0xec, 0xc5, 0x8e, 0x12, 0x9e, 0xb3, 0xa8, 0xe8, 0x0d,
+	0x67, 0x3a, 0xc9, 0x7d, 0xbc, 0x30, 0x90, 0x79, 0xc6, 0x6d, 0xb0, 0xd5, 0xa3, 0x83, 0xe6, 0x8d,
+	0x37, 0xe5, 0x92, 0x6b, 0xc3, 0x00, 0xda, 0x10, 0x84, 0x06, 0xe3, 0x7f, 0x46, 0xb8, 0x7a, 0xda,
+	0xd0, 0x99, 0xf9, 0x2e, 0xcd, 0xcf, 0x47, 0x9e, 0xfc, 0x95, 0xc1, 0xd1, 0x19, 0xea, 0x73, 0x33,
+	0x18, 0xb9, 0xd9, 0x10, 0xed, 0x6f, 0x0e, 0x5e, 0xd0, 0xbe, 0xc8, 0x17, 0x84, 0x17, 0x0b, 0x67,
+	0xa4, 0x09, 0xe7, 0x9c, 0x23, 0x9c, 0x75, 0x0b, 0x2e, 0xfc, 0x2f, 0xdc, 0x38, 0xf0, 0x83, 0xf7,
+	0x3f, 0x7e, 0x7f, 0x72, 0x36, 0x49, 0x83, 0x9e, 0xf7, 0x6b, 0x94, 0x1f, 0xde, 0x4e, 0x4f, 0xe3,
+	0x1d, 0xf9, 0x8a, 0xf0, 0xd2, 0xcc, 0xe8, 0x48, 0xf0, 0x6f, 0xc5, 0xd3, 0x6b, 0x77, 0x5b, 0x17,
+	0xe8, 0xb0, 0x36, 0xef, 0x69, 0x9b, 0x1b, 0xe4, 0xce, 0x5c, 0x9b, 0x9d, 0xad, 0xef, 0x63, 0x0f,
+	0x1d, 0x8e, 0x3d, 0xf4, 0x6b, 0xec, 0xa1, 0x8f, 0x13, 0xaf, 0x72, 0x38, 0xf1, 0x2a, 0x3f, 0x27,
+	0x5e, 0xe5, 0xe5, 0x6d, 0xd3, 0xab, 0x7a, 0x7b, 0x90, 0x48, 0x7a, 0x7c, 0x20, 0x34, 0x1b, 0xa5,
+	0x5c, 0x75, 0xaf, 0xe8, 0x0d, 0x3f, 0xf8, 0x33, 0x00, 0x1d, 0x69, 0x54, 0xdf, 0x25, 0x04, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -295,7 +307,7 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
-	// AllEvidence queries all evidence.
+	// AllEvidence queries all evidence, optionally filtered by evidence route.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
 }
 
@@ -329,7 +341,7 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
-	// AllEvidence queries all evidence.
+	// AllEvidence queries all evidence, optionally filtered by evidence route.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
 }
 
@@ -493,6 +505,13 @@ func (m *QueryAllEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -608,6 +627,10 @@ func (m *QueryAllEvidenceRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -903,6 +926,38 @@ func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -30,8 +30,16 @@ type (
 		routes map[string]Handler
 		sealed bool
 	}
+
+	// EvidenceRoutes defines the evidence handlers a module registers on the
+	// x/evidence router, keyed by evidence route, when the app is wired with
+	// dependency injection.
+	EvidenceRoutes map[string]Handler
 )
 
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EvidenceRoutes) IsOnePerModuleType() {}
+
 func NewRouter() Router {
 	return &router{
 		routes: make(map[string]Handler),
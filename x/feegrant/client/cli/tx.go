@@ -19,11 +19,12 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration     = "expiration"
+	FlagPeriod         = "period"
+	FlagPeriodLimit    = "period-limit"
+	FlagSpendLimit     = "spend-limit"
+	FlagAllowedMsgs    = "allowed-messages"
+	FlagAllowSubGrants = "allow-sub-grants"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -39,6 +40,8 @@ func GetTxCmd() *cobra.Command {
 
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
+		NewCmdFeeSubGrant(),
+		NewCmdFeeGrantBatch(),
 	)
 
 	return feegrantTxCmd
@@ -60,8 +63,9 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
-				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allow-sub-grants
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -85,109 +89,266 @@ Examples:
 			if err != nil {
 				return err
 			}
-			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			grant, err := allowanceFromFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			// if `FlagSpendLimit` isn't set, limit will be nil.
-			// Hence, there won't be any spendlimit for the grantee.
-			limit, err := sdk.ParseCoinsNormalized(sl)
+			allowSubGrants, err := cmd.Flags().GetBool(FlagAllowSubGrants)
 			if err != nil {
 				return err
 			}
 
-			exp, err := cmd.Flags().GetString(FlagExpiration)
+			msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
 			if err != nil {
 				return err
 			}
+			msg.AllowSubGrants = allowSubGrants
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdFeeSubGrant returns a CLI command handler to create a MsgGrantSubAllowance transaction.
+func NewCmdFeeSubGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grant-sub [granter] [parent_grantee_key_or_address] [grantee]",
+		Aliases: []string{"grant-sub-allowance"},
+		Short:   "Grant a sub-allowance of a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Carve out a sub-allowance of the fee allowance granted by [granter] to your address.
+Fees paid with the sub-allowance are also deducted from your allowance. The parent allowance
+must have been granted with --allow-sub-grants. Note, the '--from' flag is ignored as it is
+implied from [parent_grantee].
 
-			basic := feegrant.BasicAllowance{
-				SpendLimit: limit,
+Examples:
+%s tx %s grant-sub cosmos1skjw... cosmos1skjw... cosmos1skjw... --spend-limit 10stake --expiration 2022-01-30T15:04:05Z
+				`, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
 			}
 
-			var expiresAtTime time.Time
-			if exp != "" {
-				expiresAtTime, err = time.Parse(time.RFC3339, exp)
-				if err != nil {
-					return err
-				}
-				basic.Expiration = &expiresAtTime
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			var grant feegrant.FeeAllowanceI
-			grant = &basic
+			_, err = clientCtx.AddressCodec.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
 
-			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+			_, err = clientCtx.AddressCodec.StringToBytes(args[2])
 			if err != nil {
 				return err
 			}
 
-			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			parentGranteeStr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
 
-			// check any of period or periodLimit flags are set,
-			// if set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
-				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
-				if err != nil {
-					return err
-				}
+			grant, err := allowanceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
 
-				if periodClock <= 0 {
-					return errors.New("period clock was not set")
-				}
+			allowSubGrants, err := cmd.Flags().GetBool(FlagAllowSubGrants)
+			if err != nil {
+				return err
+			}
 
-				if periodLimit == nil {
-					return errors.New("period limit was not set")
-				}
+			msg, err := feegrant.NewMsgGrantSubAllowance(grant, args[0], parentGranteeStr, args[2], allowSubGrants)
+			if err != nil {
+				return err
+			}
 
-				periodReset := getPeriodReset(periodClock)
-				if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
-					return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
-				}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-				periodic := feegrant.PeriodicAllowance{
-					Basic:            basic,
-					Period:           getPeriod(periodClock),
-					PeriodSpendLimit: periodLimit,
-					PeriodCanSpend:   periodLimit,
-				}
+	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
+
+	return cmd
+}
 
-				grant = &periodic
+// NewCmdFeeGrantBatch returns a CLI command handler to create a MsgGrantAllowanceBatch transaction.
+func NewCmdFeeGrantBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grant-batch [granter_key_or_address] [grantee1,grantee2,...]",
+		Aliases: []string{"grant-allowance-batch"},
+		Short:   "Grant the same fee allowance to several addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Grant the same authorization to pay fees from your address to each of the
+comma-separated grantees, in a single transaction. Note, the '--from' flag is
+ignored as it is implied from [granter].
+
+Examples:
+%s tx %s grant-batch cosmos1skjw... cosmos1skjw...,cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z
+				`, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
 			}
 
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
-				if err != nil {
+			grantees := strings.Split(args[1], ",")
+			for _, grantee := range grantees {
+				if _, err := clientCtx.AddressCodec.StringToBytes(grantee); err != nil {
 					return err
 				}
 			}
 
-			msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
+			granterStr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
 
+			grant, err := allowanceFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowSubGrants, err := cmd.Flags().GetBool(FlagAllowSubGrants)
+			if err != nil {
+				return err
+			}
+
+			msg, err := feegrant.NewMsgGrantAllowanceBatch(grant, granterStr, grantees)
+			if err != nil {
+				return err
+			}
+			msg.AllowSubGrants = allowSubGrants
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
+
+	return cmd
+}
+
+// addAllowanceFlags adds the flags describing a fee allowance to a grant command.
+func addAllowanceFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().Bool(FlagAllowSubGrants, false, "Allow the grantee to carve out sub-allowances of this allowance for other addresses")
+}
 
-	return cmd
+// allowanceFromFlags builds the fee allowance described by the allowance flags of a grant command.
+func allowanceFromFlags(cmd *cobra.Command) (feegrant.FeeAllowanceI, error) {
+	sl, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// if `FlagSpendLimit` isn't set, limit will be nil.
+	// Hence, there won't be any spendlimit for the grantee.
+	limit, err := sdk.ParseCoinsNormalized(sl)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := feegrant.BasicAllowance{
+		SpendLimit: limit,
+	}
+
+	var expiresAtTime time.Time
+	if exp != "" {
+		expiresAtTime, err = time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &expiresAtTime
+	}
+
+	var grant feegrant.FeeAllowanceI
+	grant = &basic
+
+	periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// check any of period or periodLimit flags are set,
+	// if set consider it as periodic fee allowance.
+	if periodClock > 0 || periodLimitVal != "" {
+		periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+		if err != nil {
+			return nil, err
+		}
+
+		if periodClock <= 0 {
+			return nil, errors.New("period clock was not set")
+		}
+
+		if periodLimit == nil {
+			return nil, errors.New("period limit was not set")
+		}
+
+		periodReset := getPeriodReset(periodClock)
+		if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
+			return nil, fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
+		}
+
+		periodic := feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           getPeriod(periodClock),
+			PeriodSpendLimit: periodLimit,
+			PeriodCanSpend:   periodLimit,
+		}
+
+		grant = &periodic
+	}
+
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedMsgs) > 0 {
+		grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return grant, nil
 }
 
 func getPeriodReset(duration int64) time.Time {
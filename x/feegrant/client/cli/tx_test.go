@@ -440,6 +440,81 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 	}
 }
 
+func (s *CLITestSuite) TestNewCmdFeeGrantBatch() {
+	granter := s.accounts[0]
+	clientCtx := s.clientCtx
+	granterAddr, err := s.baseCtx.AddressCodec.BytesToString(granter)
+	s.Require().NoError(err)
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"wrong granter address",
+			append(
+				[]string{
+					"wrong_granter",
+					"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl,cosmos16dun6ehcc86e03wreqqww89ey569wuj4em572w",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"wrong grantee address",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl,wrong_grantee",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"valid batch fee grant",
+			append(
+				[]string{
+					granterAddr,
+					"cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl,cosmos16dun6ehcc86e03wreqqww89ey569wuj4em572w",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagExpiration, getFormattedExpiration(oneYear)),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdFeeGrantBatch()
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestTxWithFeeGrant() {
 	clientCtx := s.clientCtx
 	granter := s.addedGranter
@@ -14,6 +14,10 @@ import (
 func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantSubAllowance{}, "cosmos-sdk/MsgGrantSubAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeSubAllowance{}, "cosmos-sdk/MsgRevokeSubAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowanceBatch{}, "cosmos-sdk/MsgGrantAllowanceBatch")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllAllowances{}, "cosmos-sdk/MsgRevokeAllAllowances")
 
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance")
@@ -26,6 +30,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgGrantSubAllowance{},
+		&MsgRevokeSubAllowance{},
+		&MsgGrantAllowanceBatch{},
+		&MsgRevokeAllAllowances{},
 	)
 
 	registrar.RegisterInterface(
@@ -20,4 +20,6 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrSubGrantNotAllowed error if the allowance does not permit carving out sub-allowances
+	ErrSubGrantNotAllowed = errors.Register(DefaultCodespace, 8, "sub-allowance not allowed")
 )
@@ -227,6 +227,12 @@ type Grant struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *any.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allow_sub_grants specifies whether the grantee can carve out sub-allowances
+	// of this allowance to third parties.
+	AllowSubGrants bool `protobuf:"varint,4,opt,name=allow_sub_grants,json=allowSubGrants,proto3" json:"allow_sub_grants,omitempty"`
+	// parent_grantee is the grantee of the allowance, from the same granter, this
+	// allowance was carved out of. It is empty for allowances issued by the granter.
+	ParentGrantee string `protobuf:"bytes,5,opt,name=parent_grantee,json=parentGrantee,proto3" json:"parent_grantee,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -283,6 +289,20 @@ func (m *Grant) GetAllowance() *any.Any {
 	return nil
 }
 
+func (m *Grant) GetAllowSubGrants() bool {
+	if m != nil {
+		return m.AllowSubGrants
+	}
+	return false
+}
+
+func (m *Grant) GetParentGrantee() string {
+	if m != nil {
+		return m.ParentGrantee
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
@@ -295,49 +315,52 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xde, 0xd9, 0x3f, 0xfc, 0x7e, 0x3b, 0x0b, 0x2b, 0x54, 0x8c, 0x5d, 0x62, 0xba, 0x9b, 0x4d,
+	0xd4, 0x85, 0x64, 0x5b, 0x58, 0x6f, 0x5c, 0x84, 0x62, 0x40, 0x0d, 0x24, 0x64, 0xe1, 0x64, 0x62,
+	0x9a, 0x69, 0x3b, 0xd4, 0x86, 0x6d, 0xa7, 0xe9, 0x74, 0x91, 0xbd, 0x7a, 0x32, 0x7a, 0x90, 0xa3,
+	0xf1, 0xc4, 0xd1, 0x78, 0xe2, 0xc0, 0x87, 0x20, 0x9e, 0x88, 0x27, 0xf5, 0x20, 0x06, 0x62, 0x38,
+	0xfb, 0x0d, 0x4c, 0x67, 0xa6, 0xbb, 0xcb, 0xbf, 0x08, 0x89, 0xe1, 0xb2, 0xdb, 0xbe, 0xf3, 0x3e,
+	0xcf, 0xfb, 0x3c, 0xcf, 0xbc, 0x49, 0xe1, 0x3d, 0x8b, 0x50, 0x8f, 0x50, 0x6d, 0x0d, 0x63, 0x27,
+	0x44, 0x7e, 0xa4, 0x6d, 0x4c, 0x99, 0x38, 0x42, 0x53, 0xdd, 0x82, 0x1a, 0x84, 0x24, 0x22, 0xd2,
+	0x6d, 0xde, 0xa7, 0x76, 0xcb, 0xa2, 0x6f, 0x6c, 0xd4, 0x21, 0x0e, 0x61, 0x3d, 0x5a, 0xfc, 0xc4,
+	0xdb, 0xc7, 0x4a, 0x0e, 0x21, 0x4e, 0x0b, 0x6b, 0xec, 0xcd, 0x6c, 0xaf, 0x69, 0xc8, 0xef, 0x24,
+	0x47, 0x9c, 0xc9, 0xe0, 0x18, 0x41, 0xcb, 0x8f, 0x14, 0x21, 0xc6, 0x44, 0x14, 0x77, 0x85, 0x58,
+	0xc4, 0xf5, 0xc5, 0xf9, 0x08, 0xf2, 0x5c, 0x9f, 0x68, 0xec, 0x57, 0x94, 0xca, 0xa7, 0x07, 0x45,
+	0xae, 0x87, 0x69, 0x84, 0xbc, 0x20, 0xe1, 0x3c, 0xdd, 0x60, 0xb7, 0x43, 0x14, 0xb9, 0x44, 0x70,
+	0x56, 0xb7, 0xd3, 0xb0, 0xa8, 0x23, 0xea, 0x5a, 0xb3, 0xad, 0x16, 0x79, 0x89, 0x7c, 0x0b, 0x4b,
+	0xaf, 0x00, 0x2c, 0xd0, 0x00, 0xfb, 0xb6, 0xd1, 0x72, 0x3d, 0x37, 0x92, 0x41, 0x25, 0x53, 0x2b,
+	0x34, 0x4a, 0xaa, 0xd0, 0x1a, 0xab, 0x4b, 0xec, 0xab, 0x73, 0xc4, 0xf5, 0xf5, 0xf9, 0xbd, 0x1f,
+	0xe5, 0xd4, 0xa7, 0x83, 0x72, 0xcd, 0x71, 0xa3, 0x17, 0x6d, 0x53, 0xb5, 0x88, 0x27, 0x8c, 0x89,
+	0xbf, 0x3a, 0xb5, 0xd7, 0xb5, 0xa8, 0x13, 0x60, 0xca, 0x00, 0xf4, 0xc3, 0xf1, 0xce, 0xc4, 0x60,
+	0x0b, 0x3b, 0xc8, 0xea, 0x18, 0xb1, 0x3f, 0xfa, 0xf1, 0x78, 0x67, 0x02, 0x34, 0x21, 0x9b, 0xba,
+	0x18, 0x0f, 0x95, 0x66, 0x20, 0xc4, 0x9b, 0x81, 0xcb, 0xb5, 0xca, 0xe9, 0x0a, 0xa8, 0x15, 0x1a,
+	0x63, 0x2a, 0x37, 0xa3, 0x26, 0x66, 0xd4, 0xd5, 0xc4, 0xad, 0x9e, 0xdd, 0x3a, 0x28, 0x83, 0x66,
+	0x1f, 0x66, 0x7a, 0xe1, 0xf3, 0x6e, 0xfd, 0xee, 0x05, 0xd7, 0xa6, 0xce, 0x63, 0xdc, 0x35, 0xfc,
+	0xe4, 0xcd, 0xf1, 0xce, 0x44, 0xa9, 0x4f, 0xe9, 0xc9, 0x3c, 0xaa, 0xdf, 0xb2, 0x70, 0x64, 0x19,
+	0x87, 0x2e, 0xb1, 0xfb, 0x53, 0x7a, 0x0c, 0x73, 0x66, 0xdc, 0x27, 0x03, 0xa6, 0xed, 0xbe, 0x7a,
+	0xd1, 0xa8, 0x93, 0x6c, 0x7a, 0x3e, 0x0e, 0x8b, 0xfb, 0xe5, 0x04, 0xd2, 0x0c, 0x1c, 0x08, 0x18,
+	0xbd, 0xb0, 0x59, 0x3a, 0x63, 0xf3, 0x91, 0xb8, 0x33, 0x7d, 0x28, 0x06, 0xbf, 0x3f, 0x28, 0x03,
+	0x4e, 0x20, 0x70, 0xd2, 0x3b, 0x00, 0x25, 0xfe, 0x68, 0xf4, 0x5f, 0x5c, 0xe6, 0xba, 0x2e, 0x6e,
+	0x98, 0x0f, 0x5f, 0xe9, 0x5d, 0xdf, 0x5b, 0x00, 0x45, 0xd1, 0xb0, 0x90, 0xcf, 0x55, 0xc9, 0xd9,
+	0xeb, 0xd2, 0x53, 0xe4, 0xa3, 0xe7, 0x90, 0xcf, 0x24, 0x49, 0x8b, 0x70, 0x50, 0x88, 0x09, 0x31,
+	0xc5, 0x91, 0x9c, 0xfb, 0xeb, 0x3a, 0xb1, 0xa0, 0xb7, 0xba, 0x41, 0x17, 0x38, 0xbc, 0x19, 0xa3,
+	0xa7, 0x9f, 0x5e, 0x69, 0xb1, 0xee, 0xf4, 0x29, 0x3f, 0xb3, 0x45, 0xd5, 0xdf, 0x00, 0xde, 0x64,
+	0x6f, 0xd8, 0x5e, 0xa2, 0x4e, 0x6f, 0xbb, 0x9e, 0xc3, 0x3c, 0x4a, 0x5e, 0xc4, 0x86, 0x8d, 0x9e,
+	0x91, 0x3b, 0xeb, 0x77, 0xf4, 0xf1, 0x4b, 0x8b, 0x69, 0xf6, 0x18, 0xa5, 0x71, 0x38, 0x8c, 0xf8,
+	0x54, 0xc3, 0xc3, 0x94, 0x22, 0x07, 0x53, 0x39, 0x5d, 0xc9, 0xd4, 0xf2, 0xcd, 0x1b, 0xa2, 0xbe,
+	0x24, 0xca, 0xd3, 0xcb, 0xaf, 0xb7, 0xcb, 0xa9, 0x2b, 0x39, 0x56, 0xfa, 0x1c, 0x9f, 0xe3, 0xad,
+	0xfa, 0x2b, 0x0d, 0x73, 0x0b, 0x31, 0x85, 0xd4, 0x80, 0xff, 0x31, 0x2e, 0x1c, 0x32, 0x8f, 0x79,
+	0x5d, 0xfe, 0xb2, 0x5b, 0x1f, 0x15, 0x83, 0x66, 0x6d, 0x3b, 0xc4, 0x94, 0xae, 0x44, 0xa1, 0xeb,
+	0x3b, 0xcd, 0xa4, 0xb1, 0x87, 0xc1, 0x72, 0xfa, 0x72, 0x98, 0x53, 0x69, 0x66, 0xfe, 0x79, 0x9a,
+	0x0f, 0x45, 0x9a, 0x06, 0x6d, 0x9b, 0x06, 0xc3, 0x50, 0x39, 0x5b, 0x01, 0xb5, 0xff, 0xf5, 0x5b,
+	0xdf, 0x77, 0xeb, 0x23, 0x9b, 0xdd, 0x8f, 0x49, 0x65, 0x63, 0x52, 0x6d, 0xa8, 0x93, 0xcd, 0x22,
+	0x6b, 0x5f, 0x69, 0x9b, 0x2c, 0x06, 0x2a, 0xad, 0xc2, 0x62, 0x80, 0x42, 0xec, 0x47, 0x46, 0x62,
+	0x2d, 0xc7, 0xac, 0xd5, 0x2f, 0xb2, 0x76, 0x3e, 0xed, 0x10, 0x27, 0x59, 0xe0, 0x1c, 0xfa, 0xd4,
+	0xde, 0xa1, 0x02, 0xf6, 0x0f, 0x15, 0xf0, 0xf3, 0x50, 0x01, 0x5b, 0x47, 0x4a, 0x6a, 0xff, 0x48,
+	0x49, 0x7d, 0x3d, 0x52, 0x52, 0xcf, 0xc4, 0xd7, 0x8c, 0xda, 0xeb, 0xaa, 0x4b, 0xb4, 0x1e, 0x91,
+	0x39, 0xc0, 0xd2, 0x78, 0xf0, 0x67, 0x00, 0x85, 0x5d, 0x89, 0x26, 0x17, 0x07, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -528,6 +551,23 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParentGrantee) > 0 {
+		i -= len(m.ParentGrantee)
+		copy(dAtA[i:], m.ParentGrantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.ParentGrantee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AllowSubGrants {
+		i--
+		if m.AllowSubGrants {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -651,6 +691,13 @@ func (m *Grant) Size() (n int) {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	if m.AllowSubGrants {
+		n += 2
+	}
+	l = len(m.ParentGrantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
 	return n
 }
 
@@ -1244,6 +1291,58 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSubGrants", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSubGrants = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGrantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentGrantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
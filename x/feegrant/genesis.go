@@ -2,6 +2,8 @@ package feegrant
 
 import (
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 var _ gogoprotoany.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +27,33 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	return validateSubGrants(data.Allowances)
+}
+
+// validateSubGrants ensures every sub-allowance has a parent allowance from the same
+// granter which allows sub-grants.
+func validateSubGrants(grants []Grant) error {
+	allowSubGrants := make(map[string]bool, len(grants))
+	for _, f := range grants {
+		allowSubGrants[f.Granter+"/"+f.Grantee] = f.AllowSubGrants
+	}
+
+	for _, f := range grants {
+		if f.ParentGrantee == "" {
+			continue
+		}
+
+		allowed, found := allowSubGrants[f.Granter+"/"+f.ParentGrantee]
+		if !found {
+			return errorsmod.Wrapf(ErrNoAllowance, "parent fee allowance of %s not found for sub-allowance of %s", f.ParentGrantee, f.Grantee)
+		}
+
+		if !allowed {
+			return errorsmod.Wrapf(ErrSubGrantNotAllowed, "parent fee allowance of %s", f.ParentGrantee)
+		}
+	}
+
 	return nil
 }
 
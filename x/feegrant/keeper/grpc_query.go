@@ -33,7 +33,12 @@ func (q Keeper) Allowance(ctx context.Context, req *feegrant.QueryAllowanceReque
 		return nil, err
 	}
 
-	feeAllowance, err := q.GetAllowance(ctx, granterAddr, granteeAddr)
+	grant, err := q.FeeAllowance.Get(ctx, collections.Join(sdk.AccAddress(granteeAddr), sdk.AccAddress(granterAddr)))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	feeAllowance, err := grant.GetGrant()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -50,9 +55,11 @@ func (q Keeper) Allowance(ctx context.Context, req *feegrant.QueryAllowanceReque
 
 	return &feegrant.QueryAllowanceResponse{
 		Allowance: &feegrant.Grant{
-			Granter:   req.Granter,
-			Grantee:   req.Grantee,
-			Allowance: feeAllowanceAny,
+			Granter:        req.Granter,
+			Grantee:        req.Grantee,
+			Allowance:      feeAllowanceAny,
+			AllowSubGrants: grant.AllowSubGrants,
+			ParentGrantee:  grant.ParentGrantee,
 		},
 	}, nil
 }
@@ -116,3 +123,39 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// SubAllowances queries the sub-allowances carved out by the grantee of an allowance issued by the granter
+func (q Keeper) SubAllowances(ctx context.Context, req *feegrant.QuerySubAllowancesRequest) (*feegrant.QuerySubAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, pageRes, err := query.CollectionFilteredPaginate(ctx, q.SubAllowancesByParent, req.Pagination,
+		func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (include bool, err error) {
+			return key.K2().Equals(sdk.AccAddress(granteeAddr)), nil
+		},
+		func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (*feegrant.Grant, error) {
+			grant, err := q.FeeAllowance.Get(ctx, collections.Join(key.K3(), key.K1()))
+			if err != nil {
+				return nil, err
+			}
+
+			return &grant, nil
+		}, query.WithCollectionPaginationTriplePrefix[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress](granterAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySubAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"cosmossdk.io/x/feegrant"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -241,3 +242,48 @@ func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress)
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestFeeSubAllowances() {
+	_, err := suite.feegrantKeeper.SubAllowances(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	_, err = suite.feegrantKeeper.SubAllowances(suite.ctx, &feegrant.QuerySubAllowancesRequest{Granter: invalidGranter, Grantee: suite.encodedAddrs[1]})
+	suite.Require().Error(err)
+
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+		Expiration: &exp,
+	}
+
+	any, err := codectypes.NewAnyWithValue(allowance)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantAllowance(suite.ctx, &feegrant.MsgGrantAllowance{
+		Granter:        suite.encodedAddrs[0],
+		Grantee:        suite.encodedAddrs[1],
+		Allowance:      any,
+		AllowSubGrants: true,
+	})
+	suite.Require().NoError(err)
+
+	resp, err := suite.feegrantKeeper.SubAllowances(suite.ctx, &feegrant.QuerySubAllowancesRequest{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Allowances)
+
+	suite.Require().NoError(suite.feegrantKeeper.GrantSubAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], suite.addrs[2], allowance, true))
+	suite.Require().NoError(suite.feegrantKeeper.GrantSubAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], suite.addrs[3], allowance, false))
+	suite.Require().NoError(suite.feegrantKeeper.GrantSubAllowance(suite.ctx, suite.addrs[0], suite.addrs[2], suite.addrs[4], allowance, false))
+
+	resp, err = suite.feegrantKeeper.SubAllowances(suite.ctx, &feegrant.QuerySubAllowancesRequest{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 2)
+	suite.Require().Equal(uint64(2), resp.Pagination.Total)
+	for _, grant := range resp.Allowances {
+		suite.Require().Equal(suite.encodedAddrs[1], grant.ParentGrantee)
+	}
+
+	allowanceResp, err := suite.feegrantKeeper.Allowance(suite.ctx, &feegrant.QueryAllowanceRequest{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[2]})
+	suite.Require().NoError(err)
+	suite.Require().True(allowanceResp.Allowance.AllowSubGrants)
+	suite.Require().Equal(suite.encodedAddrs[1], allowanceResp.Allowance.ParentGrantee)
+}
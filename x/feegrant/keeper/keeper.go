@@ -29,6 +29,10 @@ type Keeper struct {
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// SubAllowancesByParent key: granter+parent grantee+grantee | value: none
+	SubAllowancesByParent collections.KeySet[collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress]]
+	// FeeAllowancesByGranter key: granter+grantee | value: none
+	FeeAllowancesByGranter collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -55,11 +59,45 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.Acc
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		SubAllowancesByParent: collections.NewKeySet(
+			sb,
+			feegrant.SubAllowanceKeyPrefix,
+			"sub_allowances",
+			collections.TripleKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+		FeeAllowancesByGranter: collections.NewKeySet(
+			sb,
+			feegrant.FeeAllowanceByGranterKeyPrefix,
+			"allowances_by_granter",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
 	}
 }
 
 // GrantAllowance creates a new grant
 func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	return k.grantAllowance(ctx, granter, grantee, feeAllowance, false, nil)
+}
+
+// GrantSubAllowance carves out a sub-allowance of the allowance of the parent
+// grantee, on the granter's account, to the grantee. Fees used by the
+// sub-allowance are also deducted from the parent allowance.
+func (k Keeper) GrantSubAllowance(ctx context.Context, granter, parentGrantee, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, allowSubGrants bool) error {
+	parent, err := k.FeeAllowance.Get(ctx, collections.Join(parentGrantee, granter))
+	if err != nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "parent fee allowance not found")
+	}
+
+	if !parent.AllowSubGrants {
+		return errorsmod.Wrap(feegrant.ErrSubGrantNotAllowed, "parent fee allowance does not allow sub-allowances")
+	}
+
+	return k.grantAllowance(ctx, granter, grantee, feeAllowance, allowSubGrants, parentGrantee)
+}
+
+// grantAllowance creates a new grant, optionally allowing the grantee to carve
+// out sub-allowances, and tracks it as a sub-allowance if a parent grantee is given.
+func (k Keeper) grantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, allowSubGrants bool, parentGrantee sdk.AccAddress) error {
 	// Checking for duplicate entry
 	if f, _ := k.GetAllowance(ctx, granter, grantee); f != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
@@ -108,10 +146,26 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
+	grant.AllowSubGrants = allowSubGrants
+	if parentGrantee != nil {
+		grant.ParentGrantee, err = k.authKeeper.AddressCodec().BytesToString(parentGrantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SubAllowancesByParent.Set(ctx, collections.Join3(granter, parentGrantee, grantee)); err != nil {
+			return err
+		}
+	}
+
 	if err := k.FeeAllowance.Set(ctx, collections.Join(grantee, granter), grant); err != nil {
 		return err
 	}
 
+	if err := k.FeeAllowancesByGranter.Set(ctx, collections.Join(granter, grantee)); err != nil {
+		return err
+	}
+
 	return k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeSetFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
@@ -121,7 +175,7 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 
 // UpdateAllowance updates the existing grant.
 func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
-	_, err := k.GetAllowance(ctx, granter, grantee)
+	existing, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
 	if err != nil {
 		return err
 	}
@@ -140,6 +194,9 @@ func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	grant.AllowSubGrants = existing.AllowSubGrants
+	grant.ParentGrantee = existing.ParentGrantee
+
 	if err := k.FeeAllowance.Set(ctx, collections.Join(grantee, granter), grant); err != nil {
 		return err
 	}
@@ -151,9 +208,15 @@ func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 	)
 }
 
-// revokeAllowance removes an existing grant
+// revokeAllowance removes an existing grant, along with the sub-allowances
+// carved out of it
 func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error {
-	grant, err := k.GetAllowance(ctx, granter, grantee)
+	stored, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		return err
+	}
+
+	grant, err := stored.GetGrant()
 	if err != nil {
 		return err
 	}
@@ -162,6 +225,14 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	if err := k.FeeAllowancesByGranter.Remove(ctx, collections.Join(granter, grantee)); err != nil {
+		return err
+	}
+
+	if err := k.removeSubAllowances(ctx, granter, grantee, stored.ParentGrantee); err != nil {
+		return err
+	}
+
 	exp, err := grant.ExpiresAt()
 	if err != nil {
 		return err
@@ -189,6 +260,86 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 	)
 }
 
+// removeSubAllowances untracks the removed grant from its parent allowance, if
+// any, and revokes the sub-allowances carved out of it.
+func (k Keeper) removeSubAllowances(ctx context.Context, granter, grantee sdk.AccAddress, parentGrantee string) error {
+	if parentGrantee != "" {
+		parent, err := k.authKeeper.AddressCodec().StringToBytes(parentGrantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SubAllowancesByParent.Remove(ctx, collections.Join3(granter, sdk.AccAddress(parent), grantee)); err != nil {
+			return err
+		}
+	}
+
+	var children []sdk.AccAddress
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress](granter, grantee)
+	if err := k.SubAllowancesByParent.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		children = append(children, key.K3())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		has, err := k.FeeAllowance.Has(ctx, collections.Join(child, granter))
+		if err != nil {
+			return err
+		}
+
+		// the sub-allowance may already have been removed, e.g. pruned along with its parent
+		if !has {
+			if err := k.SubAllowancesByParent.Remove(ctx, collections.Join3(granter, grantee, child)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.revokeAllowance(ctx, granter, child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revokeAllAllowances removes all the grants issued by the granter, along with
+// the sub-allowances carved out of them
+func (k Keeper) revokeAllAllowances(ctx context.Context, granter sdk.AccAddress) error {
+	var grantees []sdk.AccAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](granter)
+	if err := k.FeeAllowancesByGranter.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		grantees = append(grantees, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if len(grantees) == 0 {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "granter has no fee allowances")
+	}
+
+	for _, grantee := range grantees {
+		has, err := k.FeeAllowance.Has(ctx, collections.Join(grantee, granter))
+		if err != nil {
+			return err
+		}
+
+		// the allowance may already have been revoked as a sub-allowance of a previous one
+		if !has {
+			continue
+		}
+
+		if err := k.revokeAllowance(ctx, granter, grantee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetAllowance returns the allowance between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
@@ -210,8 +361,55 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	})
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// CanPruneAccount implements the x/auth AccountPruneGuard interface, an
+// account can be pruned if it is neither the granter nor the grantee of an allowance.
+func (k Keeper) CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	iter, err := k.FeeAllowance.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](addr))
+	if err != nil {
+		return false, err
+	}
+	isGrantee := iter.Valid()
+	iter.Close()
+	if isGrantee {
+		return false, nil
+	}
+
+	granterIter, err := k.FeeAllowancesByGranter.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](addr))
+	if err != nil {
+		return false, err
+	}
+	defer granterIter.Close()
+
+	return !granterIter.Valid(), nil
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// Fees paid through a sub-allowance are also deducted from its parent allowances.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	stored, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		return err
+	}
+
+	if err := k.useGrantedFees(ctx, granter, grantee, fee, msgs); err != nil {
+		return err
+	}
+
+	if stored.ParentGrantee == "" {
+		return nil
+	}
+
+	parent, err := k.authKeeper.AddressCodec().StringToBytes(stored.ParentGrantee)
+	if err != nil {
+		return err
+	}
+
+	// sub-allowances are bounded by the remaining budget of their parent allowance
+	return k.UseGrantedFees(ctx, granter, parent, fee, msgs)
+}
+
+// useGrantedFees will try to pay the given fee from the allowance between the granter and the grantee
+func (k Keeper) useGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	grant, err := k.GetAllowance(ctx, granter, grantee)
 	if err != nil {
 		return err
@@ -269,7 +467,15 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 
-		err = k.GrantAllowance(ctx, granter, grantee, grant)
+		var parentGrantee sdk.AccAddress
+		if f.ParentGrantee != "" {
+			parentGrantee, err = k.authKeeper.AddressCodec().StringToBytes(f.ParentGrantee)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = k.grantAllowance(ctx, granter, grantee, grant, f.AllowSubGrants, parentGrantee)
 		if err != nil {
 			return err
 		}
@@ -298,14 +504,25 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 	count := 0
 
 	keysToRemove := []collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress]{}
+	removedGrants := []feegrant.Grant{}
 	err := k.FeeAllowanceQueue.Walk(ctx, rng, func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], value bool) (stop bool, err error) {
 		grantee, granter := key.K2(), key.K3()
 
+		grant, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+		if err != nil {
+			return true, err
+		}
+
 		if err := k.FeeAllowance.Remove(ctx, collections.Join(grantee, granter)); err != nil {
 			return true, err
 		}
 
+		if err := k.FeeAllowancesByGranter.Remove(ctx, collections.Join(granter, grantee)); err != nil {
+			return true, err
+		}
+
 		keysToRemove = append(keysToRemove, key)
+		removedGrants = append(removedGrants, grant)
 
 		// limit the amount of iterations to avoid taking too much time
 		count++
@@ -325,5 +542,12 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 		}
 	}
 
+	// revoke the sub-allowances carved out of the expired allowances
+	for i, grant := range removedGrants {
+		if err := k.removeSubAllowances(ctx, keysToRemove[i].K3(), keysToRemove[i].K2(), grant.ParentGrantee); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
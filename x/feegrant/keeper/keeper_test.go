@@ -18,6 +18,7 @@ import (
 
 	codecaddress "github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -331,6 +332,17 @@ func (suite *KeeperTestSuite) TestIterateGrants() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestCanPruneAccount() {
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+
+	for i, expCanPrune := range []bool{false, false, true} {
+		canPrune, err := suite.feegrantKeeper.CanPruneAccount(suite.ctx, suite.addrs[i])
+		suite.Require().NoError(err)
+		suite.Require().Equal(expCanPrune, canPrune)
+	}
+}
+
 func (suite *KeeperTestSuite) TestPruneGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	now := suite.ctx.HeaderInfo().Time
@@ -437,3 +449,60 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUseGrantedFeesSubAllowance() {
+	oneYear := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	parent := &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		Expiration: &oneYear,
+	}
+	sub := &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 80)),
+		Expiration: &oneYear,
+	}
+
+	any, err := codectypes.NewAnyWithValue(parent)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantAllowance(suite.ctx, &feegrant.MsgGrantAllowance{
+		Granter:        suite.encodedAddrs[0],
+		Grantee:        suite.encodedAddrs[1],
+		Allowance:      any,
+		AllowSubGrants: true,
+	})
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.GrantSubAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], suite.addrs[2], sub, false)
+	suite.Require().NoError(err)
+
+	// sub-allowances cannot be carved out of allowances which do not allow it
+	err = suite.feegrantKeeper.GrantSubAllowance(suite.ctx, suite.addrs[0], suite.addrs[2], suite.addrs[3], sub, false)
+	suite.Require().ErrorIs(err, feegrant.ErrSubGrantNotAllowed)
+
+	// fees paid through the sub-allowance are deducted from the parent allowance as well
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), []sdk.Msg{})
+	suite.Require().NoError(err)
+
+	loaded, err := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), loaded.(*feegrant.BasicAllowance).SpendLimit)
+
+	loaded, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 30)), loaded.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the sub-allowance is bounded by the remaining parent allowance
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), []sdk.Msg{})
+	suite.Require().NoError(err)
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+	err = suite.feegrantKeeper.UseGrantedFees(cacheCtx, suite.addrs[0], suite.addrs[2], sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), []sdk.Msg{})
+	suite.Require().Error(err)
+
+	// using up the parent allowance revokes the sub-allowance
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), []sdk.Msg{})
+	suite.Require().NoError(err)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().Error(err)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/feegrant"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -54,7 +56,7 @@ func (k msgServer) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAll
 		return nil, err
 	}
 
-	err = k.Keeper.GrantAllowance(ctx, granter, grantee, allowance)
+	err = k.Keeper.grantAllowance(ctx, granter, grantee, allowance, msg.AllowSubGrants, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +64,44 @@ func (k msgServer) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAll
 	return &feegrant.MsgGrantAllowanceResponse{}, nil
 }
 
+// GrantSubAllowance carves out a sub-allowance of the parent grantee's allowance to the grantee.
+func (k msgServer) GrantSubAllowance(ctx context.Context, msg *feegrant.MsgGrantSubAllowance) (*feegrant.MsgGrantSubAllowanceResponse, error) {
+	if strings.EqualFold(msg.Grantee, msg.ParentGrantee) || strings.EqualFold(msg.Grantee, msg.Granter) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	parentGrantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.ParentGrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.GrantSubAllowance(ctx, granter, parentGrantee, grantee, allowance, msg.AllowSubGrants)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantSubAllowanceResponse{}, nil
+}
+
 // RevokeAllowance revokes a fee allowance between a granter and grantee.
 func (k msgServer) RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeAllowance) (*feegrant.MsgRevokeAllowanceResponse, error) {
 	if msg.Grantee == msg.Granter {
@@ -86,6 +126,102 @@ func (k msgServer) RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeA
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
 
+// RevokeSubAllowance revokes a sub-allowance carved out by the parent grantee.
+func (k msgServer) RevokeSubAllowance(ctx context.Context, msg *feegrant.MsgRevokeSubAllowance) (*feegrant.MsgRevokeSubAllowanceResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	parentGrantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.ParentGrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	has, err := k.SubAllowancesByParent.Has(ctx, collections.Join3(sdk.AccAddress(granter), sdk.AccAddress(parentGrantee), sdk.AccAddress(grantee)))
+	if err != nil {
+		return nil, err
+	}
+
+	if !has {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "sub-allowance not found")
+	}
+
+	err = k.Keeper.revokeAllowance(ctx, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeSubAllowanceResponse{}, nil
+}
+
+// GrantAllowanceBatch grants the same allowance from the granter's funds to each of the grantees.
+func (k msgServer) GrantAllowanceBatch(ctx context.Context, msg *feegrant.MsgGrantAllowanceBatch) (*feegrant.MsgGrantAllowanceBatchResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Grantees) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "grantees cannot be empty")
+	}
+
+	grantees := make([]sdk.AccAddress, len(msg.Grantees))
+	seen := make(map[string]struct{}, len(msg.Grantees))
+	for i, g := range msg.Grantees {
+		if strings.EqualFold(g, msg.Granter) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+		}
+
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(g)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[string(grantee)]; ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate grantee %s", g)
+		}
+		seen[string(grantee)] = struct{}{}
+		grantees[i] = grantee
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	for _, grantee := range grantees {
+		if err := k.Keeper.grantAllowance(ctx, granter, grantee, allowance, msg.AllowSubGrants, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return &feegrant.MsgGrantAllowanceBatchResponse{}, nil
+}
+
+// RevokeAllAllowances revokes all the fee allowances issued by a granter.
+func (k msgServer) RevokeAllAllowances(ctx context.Context, msg *feegrant.MsgRevokeAllAllowances) (*feegrant.MsgRevokeAllAllowancesResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.revokeAllAllowances(ctx, granter); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeAllAllowancesResponse{}, nil
+}
+
 // PruneAllowances removes expired allowances from the store.
 func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAllowances) (*feegrant.MsgPruneAllowancesResponse, error) {
 	// 75 is an arbitrary value, we can change it later if needed
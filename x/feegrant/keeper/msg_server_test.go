@@ -373,3 +373,343 @@ func (suite *KeeperTestSuite) TestPruneAllowances() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(1, count)
 }
+
+func (suite *KeeperTestSuite) TestGrantSubAllowance() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	oneYear := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	newAllowance := func() *codectypes.Any {
+		any, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{
+			SpendLimit: suite.atom,
+			Expiration: &oneYear,
+		})
+		suite.Require().NoError(err)
+		return any
+	}
+
+	_, err := suite.msgSrvr.GrantAllowance(ctx, &feegrant.MsgGrantAllowance{
+		Granter:        suite.encodedAddrs[0],
+		Grantee:        suite.encodedAddrs[1],
+		Allowance:      newAllowance(),
+		AllowSubGrants: true,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.GrantAllowance(ctx, &feegrant.MsgGrantAllowance{
+		Granter:   suite.encodedAddrs[0],
+		Grantee:   suite.encodedAddrs[2],
+		Allowance: newAllowance(),
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.MsgGrantSubAllowance
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"invalid parent grantee address",
+			&feegrant.MsgGrantSubAllowance{
+				Granter:       suite.encodedAddrs[0],
+				ParentGrantee: invalidGrantee,
+				Grantee:       suite.encodedAddrs[3],
+				Allowance:     newAllowance(),
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"self sub-grant",
+			&feegrant.MsgGrantSubAllowance{
+				Granter:       suite.encodedAddrs[0],
+				ParentGrantee: suite.encodedAddrs[1],
+				Grantee:       suite.encodedAddrs[1],
+				Allowance:     newAllowance(),
+			},
+			true,
+			"cannot self-grant",
+		},
+		{
+			"parent allowance not found",
+			&feegrant.MsgGrantSubAllowance{
+				Granter:       suite.encodedAddrs[0],
+				ParentGrantee: suite.encodedAddrs[4],
+				Grantee:       suite.encodedAddrs[3],
+				Allowance:     newAllowance(),
+			},
+			true,
+			"parent fee allowance not found",
+		},
+		{
+			"parent allowance does not allow sub-grants",
+			&feegrant.MsgGrantSubAllowance{
+				Granter:       suite.encodedAddrs[0],
+				ParentGrantee: suite.encodedAddrs[2],
+				Grantee:       suite.encodedAddrs[3],
+				Allowance:     newAllowance(),
+			},
+			true,
+			"does not allow sub-allowances",
+		},
+		{
+			"valid sub-grant",
+			&feegrant.MsgGrantSubAllowance{
+				Granter:       suite.encodedAddrs[0],
+				ParentGrantee: suite.encodedAddrs[1],
+				Grantee:       suite.encodedAddrs[3],
+				Allowance:     newAllowance(),
+			},
+			false,
+			"",
+		},
+		{
+			"sub-grant already exists",
+			&feegrant.MsgGrantSubAllowance{
+				Granter:       suite.encodedAddrs[0],
+				ParentGrantee: suite.encodedAddrs[1],
+				Grantee:       suite.encodedAddrs[3],
+				Allowance:     newAllowance(),
+			},
+			true,
+			"fee allowance already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.GrantSubAllowance(ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			grant, err := suite.feegrantKeeper.FeeAllowance.Get(ctx, collections.Join(suite.addrs[3], suite.addrs[0]))
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.encodedAddrs[1], grant.ParentGrantee)
+			suite.Require().False(grant.AllowSubGrants)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevokeSubAllowance() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	oneYear := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	}
+
+	// addrs[0] -> addrs[1] -> addrs[2] -> addrs[3]
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], allowance))
+	_, err := suite.msgSrvr.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]})
+	suite.Require().NoError(err)
+	any, err := codectypes.NewAnyWithValue(allowance)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantAllowance(ctx, &feegrant.MsgGrantAllowance{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1], Allowance: any, AllowSubGrants: true})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.GrantSubAllowance(ctx, suite.addrs[0], suite.addrs[1], suite.addrs[2], allowance, true))
+	suite.Require().NoError(suite.feegrantKeeper.GrantSubAllowance(ctx, suite.addrs[0], suite.addrs[2], suite.addrs[3], allowance, false))
+
+	// only the parent grantee can revoke a sub-allowance
+	_, err = suite.msgSrvr.RevokeSubAllowance(ctx, &feegrant.MsgRevokeSubAllowance{
+		Granter:       suite.encodedAddrs[0],
+		ParentGrantee: suite.encodedAddrs[1],
+		Grantee:       suite.encodedAddrs[3],
+	})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	// revoking a sub-allowance revokes the sub-allowances carved out of it
+	_, err = suite.msgSrvr.RevokeSubAllowance(ctx, &feegrant.MsgRevokeSubAllowance{
+		Granter:       suite.encodedAddrs[0],
+		ParentGrantee: suite.encodedAddrs[1],
+		Grantee:       suite.encodedAddrs[2],
+	})
+	suite.Require().NoError(err)
+
+	for _, grantee := range suite.addrs[2:4] {
+		has, err := suite.feegrantKeeper.FeeAllowance.Has(ctx, collections.Join(grantee, suite.addrs[0]))
+		suite.Require().NoError(err)
+		suite.Require().False(has)
+	}
+
+	has, err := suite.feegrantKeeper.FeeAllowance.Has(ctx, collections.Join(suite.addrs[1], suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	iter, err := suite.feegrantKeeper.SubAllowancesByParent.Iterate(ctx, nil)
+	suite.Require().NoError(err)
+	keys, err := iter.Keys()
+	suite.Require().NoError(err)
+	suite.Require().Empty(keys)
+}
+
+func (suite *KeeperTestSuite) TestGrantAllowanceBatch() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	oneYear := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	newAllowance := func() *codectypes.Any {
+		any, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{
+			SpendLimit: suite.atom,
+			Expiration: &oneYear,
+		})
+		suite.Require().NoError(err)
+		return any
+	}
+
+	_, err := suite.msgSrvr.GrantAllowance(ctx, &feegrant.MsgGrantAllowance{
+		Granter:   suite.encodedAddrs[0],
+		Grantee:   suite.encodedAddrs[5],
+		Allowance: newAllowance(),
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *feegrant.MsgGrantAllowanceBatch
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"invalid granter address",
+			&feegrant.MsgGrantAllowanceBatch{
+				Granter:   invalidGranter,
+				Grantees:  suite.encodedAddrs[1:3],
+				Allowance: newAllowance(),
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"no grantees",
+			&feegrant.MsgGrantAllowanceBatch{
+				Granter:   suite.encodedAddrs[0],
+				Allowance: newAllowance(),
+			},
+			true,
+			"grantees cannot be empty",
+		},
+		{
+			"invalid grantee address",
+			&feegrant.MsgGrantAllowanceBatch{
+				Granter:   suite.encodedAddrs[0],
+				Grantees:  []string{suite.encodedAddrs[1], invalidGrantee},
+				Allowance: newAllowance(),
+			},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"self grant",
+			&feegrant.MsgGrantAllowanceBatch{
+				Granter:   suite.encodedAddrs[0],
+				Grantees:  []string{suite.encodedAddrs[1], suite.encodedAddrs[0]},
+				Allowance: newAllowance(),
+			},
+			true,
+			"cannot self-grant",
+		},
+		{
+			"duplicate grantee",
+			&feegrant.MsgGrantAllowanceBatch{
+				Granter:   suite.encodedAddrs[0],
+				Grantees:  []string{suite.encodedAddrs[1], suite.encodedAddrs[2], suite.encodedAddrs[1]},
+				Allowance: newAllowance(),
+			},
+			true,
+			"duplicate grantee",
+		},
+		{
+			"allowance already exists for one of the grantees",
+			&feegrant.MsgGrantAllowanceBatch{
+				Granter:   suite.encodedAddrs[0],
+				Grantees:  []string{suite.encodedAddrs[5], suite.encodedAddrs[1]},
+				Allowance: newAllowance(),
+			},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"valid batch grant",
+			&feegrant.MsgGrantAllowanceBatch{
+				Granter:        suite.encodedAddrs[0],
+				Grantees:       suite.encodedAddrs[1:4],
+				Allowance:      newAllowance(),
+				AllowSubGrants: true,
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.GrantAllowanceBatch(ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			for _, grantee := range suite.addrs[1:4] {
+				grant, err := suite.feegrantKeeper.FeeAllowance.Get(ctx, collections.Join(grantee, suite.addrs[0]))
+				suite.Require().NoError(err)
+				suite.Require().True(grant.AllowSubGrants)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevokeAllAllowances() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	oneYear := ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	}
+
+	_, err := suite.msgSrvr.RevokeAllAllowances(ctx, &feegrant.MsgRevokeAllAllowances{Granter: suite.encodedAddrs[0]})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	// addrs[0] -> addrs[1], addrs[2], addrs[3] -> addrs[4], and addrs[5] -> addrs[1]
+	any, err := codectypes.NewAnyWithValue(allowance)
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantAllowanceBatch(ctx, &feegrant.MsgGrantAllowanceBatch{
+		Granter:        suite.encodedAddrs[0],
+		Grantees:       suite.encodedAddrs[1:4],
+		Allowance:      any,
+		AllowSubGrants: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.GrantSubAllowance(ctx, suite.addrs[0], suite.addrs[3], suite.addrs[4], allowance, false))
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(ctx, suite.addrs[5], suite.addrs[1], allowance))
+
+	_, err = suite.msgSrvr.RevokeAllAllowances(ctx, &feegrant.MsgRevokeAllAllowances{Granter: invalidGranter})
+	suite.Require().ErrorContains(err, "decoding bech32 failed")
+
+	_, err = suite.msgSrvr.RevokeAllAllowances(ctx, &feegrant.MsgRevokeAllAllowances{Granter: suite.encodedAddrs[0]})
+	suite.Require().NoError(err)
+
+	for _, grantee := range suite.addrs[1:5] {
+		has, err := suite.feegrantKeeper.FeeAllowance.Has(ctx, collections.Join(grantee, suite.addrs[0]))
+		suite.Require().NoError(err)
+		suite.Require().False(has)
+	}
+
+	// allowances of other granters are left untouched
+	has, err := suite.feegrantKeeper.FeeAllowance.Has(ctx, collections.Join(suite.addrs[1], suite.addrs[5]))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	rng := collections.NewPrefixedPairRange[types.AccAddress, types.AccAddress](suite.addrs[0])
+	iter, err := suite.feegrantKeeper.FeeAllowancesByGranter.Iterate(ctx, rng)
+	suite.Require().NoError(err)
+	keys, err := iter.Keys()
+	suite.Require().NoError(err)
+	suite.Require().Empty(keys)
+}
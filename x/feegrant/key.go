@@ -20,4 +20,12 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// SubAllowanceKeyPrefix is the set of the kvstore for sub-allowance keys data
+	// - 0x02<granter_bytes><parent_grantee_bytes><grantee_bytes>: <empty value>
+	SubAllowanceKeyPrefix = collections.NewPrefix(2)
+
+	// FeeAllowanceByGranterKeyPrefix is the set of the kvstore for fee allowance keys indexed by granter
+	// - 0x03<granter_bytes><grantee_bytes>: <empty value>
+	FeeAllowanceByGranterKeyPrefix = collections.NewPrefix(3)
 )
@@ -0,0 +1,37 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+// The migration includes:
+//
+// - Index the existing fee allowances by granter.
+func MigrateStore(
+	ctx context.Context,
+	allowances collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant],
+	allowancesByGranter collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]],
+) error {
+	var keys []collections.Pair[sdk.AccAddress, sdk.AccAddress]
+	if err := allowances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ feegrant.Grant) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		// allowances are stored by grantee first, the index by granter first
+		if err := allowancesByGranter.Set(ctx, collections.Join(key.K2(), key.K1())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
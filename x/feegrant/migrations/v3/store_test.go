@@ -0,0 +1,73 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	coretesting "cosmossdk.io/core/testing"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/keeper"
+	v3 "cosmossdk.io/x/feegrant/migrations/v3"
+	"cosmossdk.io/x/feegrant/module"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigration(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{})
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	feegrantKey := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	ctx := testutil.DefaultContext(feegrantKey, storetypes.NewTransientStoreKey("transient_test"))
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(feegrantKey), coretesting.NewNopLogger())
+	k := keeper.NewKeeper(env, encodingConfig.Codec, nil)
+
+	granter1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granter2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	grants := []struct {
+		granter sdk.AccAddress
+		grantee sdk.AccAddress
+	}{
+		{granter: granter1, grantee: grantee1},
+		{granter: granter1, grantee: grantee2},
+		{granter: granter2, grantee: grantee1},
+	}
+
+	// write the allowances without indexing them, as in v2
+	for _, grant := range grants {
+		granterStr, err := ac.BytesToString(grant.granter)
+		require.NoError(t, err)
+		granteeStr, err := ac.BytesToString(grant.grantee)
+		require.NoError(t, err)
+		newGrant, err := feegrant.NewGrant(granterStr, granteeStr, &feegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000))),
+		})
+		require.NoError(t, err)
+		require.NoError(t, k.FeeAllowance.Set(ctx, collections.Join(grant.grantee, grant.granter), newGrant))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, k.FeeAllowance, k.FeeAllowancesByGranter))
+
+	for _, grant := range grants {
+		has, err := k.FeeAllowancesByGranter.Has(ctx, collections.Join(grant.granter, grant.grantee))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+
+	has, err := k.FeeAllowancesByGranter.Has(ctx, collections.Join(granter2, grantee2))
+	require.NoError(t, err)
+	require.False(t, has)
+}
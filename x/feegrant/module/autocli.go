@@ -44,6 +44,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "SubAllowances",
+					Use:       "sub-grants [granter] [grantee]",
+					Short:     "Query all sub-grants carved out of a grant",
+					Long:      "Queries all the sub-allowances carved out by the grantee of a grant issued by the granter.",
+					Example:   fmt.Sprintf(`$ %s query feegrant sub-grants [granter] [grantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -60,6 +71,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "RevokeSubAllowance",
+					Use:       "revoke-sub [granter] [parent_grantee] [grantee]",
+					Short:     "Revoke a fee sub-grant",
+					Long:      "Revoke a sub-allowance carved out by the parent grantee of a grant issued by the granter. Note, the '--from' flag is ignored as it is implied from [parent_grantee]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-sub [granter] [parent_grantee] [grantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "parent_grantee"},
+						{ProtoField: "grantee"},
+					},
+				},
+				{
+					RpcMethod: "RevokeAllAllowances",
+					Use:       "revoke-all [granter]",
+					Short:     "Revoke all the fee grants of a granter",
+					Long:      "Revoke all the fee grants issued by a granter, along with the sub-grants carved out of them. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-all [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
 				{
 					RpcMethod: "PruneAllowances",
 					Use:       "prune",
@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg                              = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_    gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_, _, _, _, _, _ sdk.Msg                              = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgGrantSubAllowance{}, &MsgRevokeSubAllowance{}, &MsgGrantAllowanceBatch{}, &MsgRevokeAllAllowances{}
+	_, _, _          gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}, &MsgGrantSubAllowance{}, &MsgGrantAllowanceBatch{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -55,3 +55,85 @@ func (msg MsgGrantAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker)
 func NewMsgRevokeAllowance(granter, grantee string) MsgRevokeAllowance {
 	return MsgRevokeAllowance{Granter: granter, Grantee: grantee}
 }
+
+// NewMsgGrantSubAllowance creates a new MsgGrantSubAllowance.
+func NewMsgGrantSubAllowance(feeAllowance FeeAllowanceI, granter, parentGrantee, grantee string, allowSubGrants bool) (*MsgGrantSubAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantSubAllowance{
+		Granter:        granter,
+		ParentGrantee:  parentGrantee,
+		Grantee:        grantee,
+		Allowance:      any,
+		AllowSubGrants: allowSubGrants,
+	}, nil
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantSubAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantSubAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeSubAllowance returns a message to revoke a sub-allowance carved
+// out by the parent grantee for a given granter and grantee
+func NewMsgRevokeSubAllowance(granter, parentGrantee, grantee string) MsgRevokeSubAllowance {
+	return MsgRevokeSubAllowance{Granter: granter, ParentGrantee: parentGrantee, Grantee: grantee}
+}
+
+// NewMsgGrantAllowanceBatch creates a new MsgGrantAllowanceBatch.
+func NewMsgGrantAllowanceBatch(feeAllowance FeeAllowanceI, granter string, grantees []string) (*MsgGrantAllowanceBatch, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantAllowanceBatch{
+		Granter:   granter,
+		Grantees:  grantees,
+		Allowance: any,
+	}, nil
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgGrantAllowanceBatch) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAllowanceBatch) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeAllAllowances returns a message to revoke all the fee allowances
+// of a given granter
+func NewMsgRevokeAllAllowances(granter string) MsgRevokeAllAllowances {
+	return MsgRevokeAllAllowances{Granter: granter}
+}
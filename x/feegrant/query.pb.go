@@ -349,6 +349,125 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySubAllowancesRequest is the request type for the Query/SubAllowances RPC method.
+type QuerySubAllowancesRequest struct {
+	// granter is the address of the user whose funds are used by the allowances.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the grantee of the parent allowance.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubAllowancesRequest) Reset()         { *m = QuerySubAllowancesRequest{} }
+func (m *QuerySubAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubAllowancesRequest) ProtoMessage()    {}
+func (*QuerySubAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QuerySubAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubAllowancesRequest.Merge(m, src)
+}
+func (m *QuerySubAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubAllowancesRequest proto.InternalMessageInfo
+
+func (m *QuerySubAllowancesRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QuerySubAllowancesRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QuerySubAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySubAllowancesResponse is the response type for the Query/SubAllowances RPC method.
+type QuerySubAllowancesResponse struct {
+	// allowances are the sub-allowances carved out of the parent allowance.
+	Allowances []*Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubAllowancesResponse) Reset()         { *m = QuerySubAllowancesResponse{} }
+func (m *QuerySubAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubAllowancesResponse) ProtoMessage()    {}
+func (*QuerySubAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QuerySubAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubAllowancesResponse.Merge(m, src)
+}
+func (m *QuerySubAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubAllowancesResponse proto.InternalMessageInfo
+
+func (m *QuerySubAllowancesResponse) GetAllowances() []*Grant {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func (m *QuerySubAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +475,8 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QuerySubAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QuerySubAllowancesRequest")
+	proto.RegisterType((*QuerySubAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QuerySubAllowancesResponse")
 }
 
 func init() {
@@ -363,41 +484,47 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xfd, 0xfd, 0x54, 0xf2, 0xf4, 0x20, 0x4c, 0xd5, 0xc6, 0x45, 0xd6, 0xb0, 0x42,
-	0xfd, 0x47, 0x66, 0x9a, 0xa8, 0xa5, 0x8a, 0x14, 0x1a, 0xc4, 0x5c, 0x35, 0x82, 0x07, 0x2f, 0x32,
-	0xe9, 0x3e, 0x2e, 0x4b, 0xe3, 0x4e, 0xba, 0xb3, 0x51, 0x8b, 0x14, 0xc1, 0x57, 0x20, 0xe8, 0x2b,
-	0xf0, 0xe0, 0xc9, 0x53, 0xe9, 0x0b, 0xf0, 0x28, 0x9e, 0x42, 0xbd, 0x78, 0x94, 0xc4, 0x17, 0x22,
-	0x99, 0x9d, 0xdd, 0x8d, 0x49, 0x96, 0x2e, 0xea, 0xc1, 0x5b, 0x26, 0xfb, 0xfd, 0x3e, 0xfb, 0xf9,
-	0x3e, 0xcf, 0xcc, 0x2c, 0x5c, 0xd8, 0x92, 0xea, 0xa9, 0x54, 0xfc, 0x09, 0xa2, 0x17, 0x8a, 0x20,
-	0xe2, 0xcf, 0xea, 0x1d, 0x8c, 0x44, 0x9d, 0xef, 0xf4, 0x31, 0xdc, 0x65, 0xbd, 0x50, 0x46, 0x92,
-	0x2e, 0xc7, 0x22, 0x96, 0x88, 0x98, 0x11, 0x59, 0x2b, 0x79, 0xee, 0x54, 0xa9, 0x0b, 0x58, 0x57,
-	0x8c, 0xae, 0x23, 0x14, 0xc6, 0x95, 0x53, 0x65, 0x4f, 0x78, 0x7e, 0x20, 0x22, 0x5f, 0x06, 0x46,
-	0x7b, 0xce, 0x93, 0xd2, 0xeb, 0x22, 0x17, 0x3d, 0x9f, 0x8b, 0x20, 0x90, 0x91, 0x7e, 0xa8, 0xcc,
-	0xd3, 0xb3, 0x71, 0xa5, 0xc7, 0x7a, 0xc5, 0x0d, 0x97, 0x5e, 0x38, 0xaf, 0xe0, 0xf4, 0xfd, 0x71,
-	0xe9, 0xcd, 0x6e, 0x57, 0x3e, 0x17, 0xc1, 0x16, 0xb6, 0x71, 0xa7, 0x8f, 0x2a, 0xa2, 0x0d, 0x38,
-	0xa1, 0x61, 0x30, 0xac, 0x90, 0x2a, 0xb9, 0x54, 0x6e, 0x56, 0x0e, 0x0f, 0x6a, 0xa7, 0x8c, 0x77,
-	0xd3, 0x75, 0x43, 0x54, 0xea, 0x41, 0x14, 0xfa, 0x81, 0xd7, 0x4e, 0x84, 0x99, 0x07, 0x2b, 0x0b,
-	0xc5, 0x3c, 0xe8, 0x3c, 0x84, 0x33, 0xd3, 0x00, 0xaa, 0x27, 0x03, 0x85, 0xf4, 0x36, 0x94, 0x45,
-	0xf2, 0xa7, 0x66, 0x58, 0x6c, 0xd8, 0x2c, 0xa7, 0xa9, 0xac, 0x35, 0x5e, 0xb5, 0x33, 0x83, 0xf3,
-	0x8e, 0x4c, 0x17, 0x56, 0x33, 0xd1, 0xb0, 0x68, 0x34, 0xa4, 0x77, 0x01, 0xb2, 0xa6, 0xeb, 0x74,
-	0x8b, 0x8d, 0x95, 0x84, 0x66, 0x3c, 0x21, 0x16, 0xcf, 0x3e, 0xe1, 0xb9, 0x27, 0xbc, 0xa4, 0x95,
-	0xed, 0x09, 0xa7, 0xf3, 0x9e, 0xc0, 0xf2, 0x0c, 0x96, 0x09, 0xbc, 0x01, 0x90, 0xf2, 0xab, 0x0a,
-	0xa9, 0xfe, 0x57, 0x20, 0xf1, 0x84, 0x83, 0xb6, 0xe6, 0x30, 0x5e, 0x3c, 0x92, 0x31, 0x7e, 0xf9,
-	0x2f, 0x90, 0xfb, 0x04, 0xce, 0x4f, 0x41, 0x36, 0x77, 0x5b, 0xf1, 0x90, 0xff, 0x64, 0x7f, 0xfc,
-	0xa5, 0x26, 0xde, 0x5a, 0x3a, 0x3c, 0xa8, 0x9d, 0x8c, 0x6d, 0x35, 0xe5, 0x6e, 0x57, 0x57, 0xd9,
-	0xf5, 0x35, 0xe7, 0x13, 0x81, 0x6a, 0x3e, 0xf4, 0x3f, 0xd6, 0xe2, 0xb9, 0x11, 0x1a, 0xfb, 0xff,
-	0xc3, 0x31, 0x1d, 0x81, 0x7e, 0x24, 0x50, 0x4e, 0x73, 0x50, 0x96, 0x4b, 0x38, 0xf7, 0xec, 0x5a,
-	0xbc, 0xb0, 0x3e, 0x26, 0x73, 0x36, 0x5e, 0x7f, 0xfd, 0xf1, 0x76, 0x61, 0x9d, 0xae, 0xf1, 0xbc,
-	0xbb, 0x29, 0xed, 0x01, 0x7f, 0x69, 0xa6, 0xb9, 0x97, 0xfc, 0xc2, 0x3d, 0xfa, 0x81, 0x00, 0x64,
-	0x6d, 0xa7, 0x45, 0xdf, 0x9f, 0x9c, 0x48, 0x6b, 0xb5, 0xb8, 0xc1, 0x10, 0xdf, 0xd0, 0xc4, 0x9c,
-	0xd6, 0x8e, 0x26, 0x56, 0x13, 0xa0, 0x03, 0x02, 0x4b, 0x73, 0xf6, 0x07, 0x5d, 0x2f, 0x0a, 0x30,
-	0x7d, 0x0e, 0xac, 0x9b, 0xbf, 0xe1, 0x34, 0x19, 0xee, 0x7c, 0x99, 0xdd, 0x03, 0x3a, 0xd6, 0x55,
-	0x7a, 0x39, 0x37, 0x96, 0xaf, 0x54, 0x1f, 0xdd, 0x6c, 0x0a, 0xcd, 0xfa, 0xe7, 0xa1, 0x4d, 0x06,
-	0x43, 0x9b, 0x7c, 0x1f, 0xda, 0xe4, 0xcd, 0xc8, 0x2e, 0x0d, 0x46, 0x76, 0xe9, 0xdb, 0xc8, 0x2e,
-	0x3d, 0x32, 0x5f, 0x20, 0xe5, 0x6e, 0x33, 0x5f, 0xf2, 0x17, 0x69, 0xad, 0xce, 0x71, 0x7d, 0xf7,
-	0x5f, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0x84, 0x03, 0x50, 0xd8, 0xc8, 0x06, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x2d, 0x56, 0x32, 0x45, 0xc4, 0xa9, 0xb5, 0xe9, 0x22, 0x6b, 0x58, 0xa1, 0xfe,
+	0x22, 0x33, 0xc9, 0x56, 0x4b, 0xfd, 0x55, 0x68, 0x10, 0x73, 0xd5, 0x14, 0x44, 0xbc, 0x94, 0x49,
+	0x33, 0x2e, 0x4b, 0xe3, 0x4e, 0xba, 0xb3, 0xa9, 0x16, 0x29, 0x82, 0x17, 0xaf, 0x82, 0xfe, 0x05,
+	0x1e, 0x3c, 0x79, 0x92, 0x1c, 0x3d, 0x78, 0x94, 0x9e, 0x42, 0xbc, 0x78, 0x94, 0xc4, 0x3f, 0x44,
+	0x32, 0x3b, 0xbb, 0x9b, 0x26, 0xbb, 0xed, 0x5a, 0x15, 0x7a, 0xcb, 0x64, 0xdf, 0xf7, 0xcd, 0xe7,
+	0xfb, 0xde, 0x9b, 0xd9, 0x85, 0x17, 0x37, 0xb8, 0x78, 0xc6, 0x05, 0x79, 0xca, 0x98, 0xe5, 0x52,
+	0xc7, 0x23, 0xdb, 0xa5, 0x1a, 0xf3, 0x68, 0x89, 0x6c, 0xb5, 0x98, 0xbb, 0x83, 0x9b, 0x2e, 0xf7,
+	0x38, 0x9a, 0xf3, 0x83, 0x70, 0x10, 0x84, 0x55, 0x90, 0xb6, 0x90, 0xa4, 0x0e, 0x23, 0x65, 0x02,
+	0xed, 0xaa, 0x8a, 0xab, 0x51, 0xc1, 0xfc, 0xcc, 0x61, 0x64, 0x93, 0x5a, 0xb6, 0x43, 0x3d, 0x9b,
+	0x3b, 0x2a, 0xf6, 0xbc, 0xc5, 0xb9, 0xd5, 0x60, 0x84, 0x36, 0x6d, 0x42, 0x1d, 0x87, 0x7b, 0xf2,
+	0xa1, 0x50, 0x4f, 0xe7, 0xfd, 0x4c, 0xeb, 0x72, 0x45, 0x14, 0x97, 0x5c, 0x18, 0xaf, 0xe0, 0xec,
+	0xc3, 0x41, 0xea, 0xd5, 0x46, 0x83, 0x3f, 0xa7, 0xce, 0x06, 0xab, 0xb2, 0xad, 0x16, 0x13, 0x1e,
+	0x32, 0xe1, 0x49, 0x09, 0xc3, 0xdc, 0x1c, 0xc8, 0x83, 0xcb, 0xd9, 0x72, 0xae, 0xdb, 0x2e, 0x9c,
+	0x55, 0xda, 0xd5, 0x7a, 0xdd, 0x65, 0x42, 0xac, 0x79, 0xae, 0xed, 0x58, 0xd5, 0x20, 0x30, 0xd2,
+	0xb0, 0xdc, 0x44, 0x3a, 0x0d, 0x33, 0x1e, 0xc1, 0x73, 0xa3, 0x00, 0xa2, 0xc9, 0x1d, 0xc1, 0xd0,
+	0x1d, 0x98, 0xa5, 0xc1, 0x9f, 0x92, 0x61, 0xda, 0xd4, 0x71, 0x42, 0x51, 0x71, 0x65, 0xb0, 0xaa,
+	0x46, 0x02, 0xe3, 0x3d, 0x18, 0x4d, 0x2c, 0xc6, 0xac, 0xb1, 0xb4, 0xd6, 0x18, 0xba, 0x0f, 0x61,
+	0x54, 0x74, 0xe9, 0x6e, 0xda, 0x5c, 0x08, 0x68, 0x06, 0x1d, 0xc2, 0x7e, 0xef, 0x03, 0x9e, 0x07,
+	0xd4, 0x0a, 0x4a, 0x59, 0x1d, 0x52, 0x1a, 0x1f, 0x00, 0x9c, 0x1b, 0xc3, 0x52, 0x86, 0x57, 0x20,
+	0x0c, 0xf9, 0x45, 0x0e, 0xe4, 0x27, 0x53, 0x38, 0x1e, 0x52, 0xa0, 0x4a, 0x0c, 0xe3, 0xa5, 0x43,
+	0x19, 0xfd, 0xcd, 0xf7, 0x41, 0x7e, 0x06, 0xf0, 0xc2, 0x08, 0x64, 0x79, 0xa7, 0xe2, 0x37, 0xf9,
+	0x6f, 0xe6, 0xe3, 0x1f, 0x15, 0xf1, 0xd6, 0x4c, 0xb7, 0x5d, 0x38, 0xed, 0xcb, 0x0a, 0xa2, 0xbe,
+	0x99, 0x2f, 0xe2, 0xeb, 0x4b, 0xc6, 0x57, 0x00, 0xf3, 0xc9, 0xd0, 0xc7, 0xac, 0xc4, 0xf1, 0x16,
+	0x7a, 0x00, 0xce, 0x4b, 0x0b, 0x6b, 0xad, 0xda, 0x01, 0x63, 0xfb, 0x7f, 0x4f, 0xe4, 0x48, 0x97,
+	0x26, 0x8f, 0xdc, 0xa5, 0xd9, 0x6e, 0xbb, 0x70, 0xe6, 0x45, 0x78, 0xab, 0xe5, 0xb7, 0x8b, 0xd8,
+	0xc4, 0x45, 0xe3, 0x0b, 0x80, 0x5a, 0x9c, 0xc9, 0xe3, 0xd6, 0xa1, 0x78, 0x7c, 0xf3, 0xcd, 0x14,
+	0x3c, 0x21, 0xf1, 0xd1, 0x27, 0x00, 0xb3, 0xa1, 0x01, 0x84, 0x13, 0x19, 0x63, 0xef, 0x57, 0x8d,
+	0xa4, 0x8e, 0xf7, 0xd9, 0x8c, 0x95, 0xd7, 0xdf, 0x7f, 0xbd, 0x9b, 0x58, 0x46, 0x4b, 0x24, 0xe9,
+	0xfd, 0x11, 0x56, 0x81, 0xbc, 0x54, 0xfd, 0xdf, 0x0d, 0x7e, 0xb1, 0x5d, 0xf4, 0x11, 0x40, 0x18,
+	0xd5, 0x1b, 0xa5, 0xdd, 0x3f, 0x18, 0x3f, 0xad, 0x98, 0x5e, 0xa0, 0x88, 0x6f, 0x48, 0x62, 0x82,
+	0x0a, 0x87, 0x13, 0x8b, 0x21, 0xd0, 0x0e, 0x80, 0x33, 0x31, 0x67, 0x18, 0x2d, 0xa7, 0x05, 0x18,
+	0xbd, 0xab, 0xb4, 0x9b, 0x47, 0x50, 0x2a, 0x0f, 0xf7, 0xf6, 0xc6, 0xcf, 0xa9, 0xb4, 0x75, 0x0d,
+	0x5d, 0x49, 0xb4, 0x65, 0x0b, 0xd1, 0x62, 0xf5, 0xa8, 0x0b, 0xa8, 0x0b, 0xe0, 0xa9, 0x7d, 0xe3,
+	0x8e, 0xcc, 0x83, 0x91, 0xe2, 0x2e, 0x00, 0x6d, 0xf1, 0x8f, 0x34, 0xca, 0xc0, 0xe3, 0xbd, 0xb8,
+	0x31, 0x96, 0x16, 0xee, 0xa2, 0xdb, 0x89, 0x16, 0x44, 0xab, 0xb6, 0x3e, 0xde, 0x9d, 0xe1, 0x81,
+	0x2a, 0x97, 0xbe, 0xf5, 0x74, 0xd0, 0xe9, 0xe9, 0xe0, 0x67, 0x4f, 0x07, 0x6f, 0xfb, 0x7a, 0xa6,
+	0xd3, 0xd7, 0x33, 0x3f, 0xfa, 0x7a, 0xe6, 0x89, 0xfa, 0xf4, 0x11, 0xf5, 0x4d, 0x6c, 0x73, 0x12,
+	0x6d, 0x5e, 0x9b, 0x92, 0x1f, 0x1d, 0x8b, 0xbf, 0x07, 0x00, 0xed, 0xc1, 0x7f, 0xe7, 0x41, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -418,6 +545,9 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// SubAllowances returns the sub-allowances carved out by a grantee of the
+	// allowance issued by the granter.
+	SubAllowances(ctx context.Context, in *QuerySubAllowancesRequest, opts ...grpc.CallOption) (*QuerySubAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -455,6 +585,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) SubAllowances(ctx context.Context, in *QuerySubAllowancesRequest, opts ...grpc.CallOption) (*QuerySubAllowancesResponse, error) {
+	out := new(QuerySubAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SubAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns granted allowance to the grantee by the granter.
@@ -463,6 +602,9 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// SubAllowances returns the sub-allowances carved out by a grantee of the
+	// allowance issued by the granter.
+	SubAllowances(context.Context, *QuerySubAllowancesRequest) (*QuerySubAllowancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -478,6 +620,9 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) SubAllowances(ctx context.Context, req *QuerySubAllowancesRequest) (*QuerySubAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubAllowances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -537,6 +682,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SubAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SubAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubAllowances(ctx, req.(*QuerySubAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -553,6 +716,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "SubAllowances",
+			Handler:    _Query_SubAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -812,6 +979,104 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySubAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -925,6 +1190,46 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySubAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1607,6 +1912,276 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySubAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
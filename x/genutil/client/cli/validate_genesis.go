@@ -7,18 +7,29 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md"
+const (
+	chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md"
+
+	flagStrict = "strict"
+)
 
 // ValidateGenesisCmd takes a genesis file, and makes sure that it is valid.
 func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "validate [file]",
 		Aliases: []string{"validate-genesis"},
 		Args:    cobra.RangeArgs(0, 1),
 		Short:   "Validates the genesis file at the default location or at the location passed as an arg",
+		Long: `Validates the genesis file at the default location or at the location passed as an arg.
+
+With --strict, the genesis state of each module is also validated against its proto schema
+(duplicate keys, unknown fields, wrong types, invalid addresses, decimals and integers) and
+against the other modules (group members must be genesis accounts). All the issues found are
+reported.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			cfg := client.GetConfigFromCmd(cmd)
 
@@ -44,6 +55,18 @@ func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
 				return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
 			}
 
+			if strict, _ := cmd.Flags().GetBool(flagStrict); strict {
+				clientCtx := client.GetClientContextFromCmd(cmd)
+				skipped, err := genutil.ValidateGenesisStrict(appGenesis.AppState,
+					clientCtx.AddressCodec, clientCtx.ValidatorAddressCodec, clientCtx.ConsensusAddressCodec)
+				for _, module := range skipped {
+					cmd.PrintErrf("no genesis schema found for module %s, skipping its strict validation\n", module)
+				}
+				if err != nil {
+					return fmt.Errorf("error validating genesis file %s:\n%w", genesis, err)
+				}
+			}
+
 			if genMM != nil {
 				if err = genMM.ValidateGenesis(genState); err != nil {
 					return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
@@ -54,4 +77,8 @@ func ValidateGenesisCmd(genMM genesisMM) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(flagStrict, false, "Validate the genesis state of the modules against their proto schema and each other")
+
+	return cmd
 }
@@ -1,12 +1,20 @@
 package cli_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	_ "cosmossdk.io/api/cosmos/auth/v1beta1"
+	_ "cosmossdk.io/api/cosmos/bank/v1beta1"
+	_ "cosmossdk.io/api/cosmos/group/v1"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -68,3 +76,91 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGenesisStrict(t *testing.T) {
+	addrCdc := addresscodec.NewBech32Codec("cosmos")
+	clientCtx := client.Context{}.
+		WithAddressCodec(addrCdc).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+
+	account, err := addrCdc.BytesToString(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, err)
+	member, err := addrCdc.BytesToString(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, err)
+
+	appGenesis, err := os.ReadFile("../../types/testdata/app_genesis.json")
+	require.NoError(t, err)
+
+	genesisWithAppState := func(appState string) string {
+		var genesis map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appGenesis, &genesis))
+		genesis["app_state"] = json.RawMessage(appState)
+		bz, err := json.Marshal(genesis)
+		require.NoError(t, err)
+		return string(bz)
+	}
+
+	auth := fmt.Sprintf(`{"accounts": [
+		{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": %q, "account_number": "0", "sequence": "0"},
+		{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": %q, "account_number": "1", "sequence": "0"}
+	]}`, account, member)
+	group := fmt.Sprintf(`{"group_seq": "1", "groups": [{"id": "1", "admin": %[1]q, "version": "1", "total_weight": "1"}],
+		"group_members": [{"group_id": "1", "member": {"address": %[2]q, "weight": "1"}}]}`, account, member)
+
+	testCases := []struct {
+		name     string
+		appState string
+		expErrs  []string
+	}{
+		{
+			name:     "valid genesis",
+			appState: fmt.Sprintf(`{"auth": %s, "bank": {"balances": [{"address": %q, "coins": [{"denom": "stake", "amount": "10"}]}]}, "group": %s, "custom": {}}`, auth, account, group),
+		},
+		{
+			name:     "duplicate keys",
+			appState: fmt.Sprintf(`{"auth": %s, "bank": {"send_enabled": [], "send_enabled": []}}`, auth),
+			expErrs:  []string{"bank.send_enabled: duplicate key"},
+		},
+		{
+			name:     "unknown field",
+			appState: `{"bank": {"unknown": true}}`,
+			expErrs:  []string{"bank.unknown: unknown field of cosmos.bank.v1beta1.GenesisState"},
+		},
+		{
+			name:     "wrong type",
+			appState: `{"bank": {"balances": {}, "params": {"default_send_enabled": "true"}}}`,
+			expErrs:  []string{"bank.balances: expected a list, got an object", "bank.params.default_send_enabled: expected a boolean, got a string"},
+		},
+		{
+			name:     "invalid address and amount",
+			appState: `{"bank": {"balances": [{"address": "osmo1invalid", "coins": [{"denom": "stake", "amount": "ten"}]}]}}`,
+			expErrs: []string{
+				`bank.balances[0].address: invalid address "osmo1invalid"`,
+				`bank.balances[0].coins[0].amount: invalid integer "ten"`,
+			},
+		},
+		{
+			name:     "group member not in auth",
+			appState: fmt.Sprintf(`{"auth": {"accounts": []}, "group": %s}`, group),
+			expErrs:  []string{fmt.Sprintf("group.group_members[0].member.address: group 1 member %s is not a genesis account", member)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesisFile := testutil.WriteToNewTempFile(t, genesisWithAppState(tc.appState))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.ValidateGenesisCmd(nil), []string{genesisFile.Name(), "--strict"})
+			if len(tc.expErrs) == 0 {
+				require.NoError(t, err)
+				require.Contains(t, out.String(), "no genesis schema found for module custom")
+				return
+			}
+
+			require.Error(t, err)
+			for _, expErr := range tc.expErrs {
+				require.Contains(t, err.Error(), expErr)
+			}
+		})
+	}
+}
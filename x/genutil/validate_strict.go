@@ -0,0 +1,599 @@
+package genutil
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	gogo "github.com/cosmos/gogoproto/gogoproto"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+)
+
+const (
+	authModuleName     = "auth"
+	accountsModuleName = "accounts"
+	groupModuleName    = "group"
+
+	rawJSONType = "encoding/json.RawMessage"
+
+	anyFullName      protoreflect.FullName = "google.protobuf.Any"
+	wellKnownPackage protoreflect.FullName = "google.protobuf"
+)
+
+// customTypeScalars are the cosmos_proto.scalar of the gogoproto custom types,
+// for the fields without the option.
+var customTypeScalars = map[string]string{
+	"cosmossdk.io/math.LegacyDec": "cosmos.Dec",
+	"cosmossdk.io/math.Int":       "cosmos.Int",
+}
+
+// protoVersionRegex matches the version component of a proto package name,
+// e.g. v1 or v1beta1.
+var protoVersionRegex = regexp.MustCompile(`^v(\d+)(?:(alpha|beta)(\d*))?$`)
+
+// ValidateGenesisStrict validates the app state of a genesis file against the
+// proto schema of the module genesis states, as registered in the gogoproto and
+// protoregistry global registries. Contrary to the module genesis validation,
+// it does not stop at the first issue and reports:
+//   - duplicate JSON keys, of which only the last value is used,
+//   - unknown fields and values of the wrong type,
+//   - addresses, decimals and integers which cannot be parsed,
+//   - group members which are not genesis accounts.
+//
+// The genesis state of a module is the GenesisState message of the proto
+// package named after it, e.g. cosmos.bank.v1beta1.GenesisState for the bank
+// module. The modules without such a message are not validated and returned.
+func ValidateGenesisStrict(
+	appState json.RawMessage, addressCodec, validatorAddressCodec, consensusAddressCodec address.Codec,
+) (skipped []string, err error) {
+	if addressCodec == nil || validatorAddressCodec == nil || consensusAddressCodec == nil {
+		return nil, errors.New("address codecs are required to validate the genesis addresses")
+	}
+
+	files, err := gogoproto.MergedRegistry()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the proto registry: %w", err)
+	}
+
+	v := &strictValidator{
+		types: dynamicpb.NewTypes(files),
+		addressCodecs: map[string]address.Codec{
+			"cosmos.AddressString":          addressCodec,
+			"cosmos.ValidatorAddressString": validatorAddressCodec,
+			"cosmos.ConsensusAddressString": consensusAddressCodec,
+		},
+	}
+
+	if err := v.checkDuplicateKeys(appState); err != nil {
+		return nil, fmt.Errorf("failed to parse the app state: %w", err)
+	}
+
+	var modules map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &modules); err != nil {
+		return nil, fmt.Errorf("failed to parse the app state: %w", err)
+	}
+
+	schemas := genesisSchemas(files)
+	states := make(map[string]protoreflect.Message, len(modules))
+	for _, name := range sortedKeys(modules) {
+		desc, ok := schemas[name]
+		if !ok {
+			skipped = append(skipped, name)
+			continue
+		}
+
+		if state, ok := v.decode(name, desc, modules[name]); ok {
+			states[name] = state
+		}
+	}
+
+	v.checkGroupMembers(states)
+
+	return skipped, errors.Join(v.errs...)
+}
+
+// strictValidator accumulates the issues found in a genesis app state.
+type strictValidator struct {
+	types *dynamicpb.Types
+	// addressCodecs are the address codecs by cosmos_proto.scalar option.
+	addressCodecs map[string]address.Codec
+
+	errs []error
+}
+
+func (v *strictValidator) errorf(path, format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+// decode checks the genesis state of a module against its proto schema and
+// decodes it.
+func (v *strictValidator) decode(name string, desc protoreflect.MessageDescriptor, bz json.RawMessage) (protoreflect.Message, bool) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		v.errorf(name, "invalid JSON: %v", err)
+		return nil, false
+	}
+
+	state := dynamicpb.NewMessage(desc)
+	if value == nil {
+		return state, true
+	}
+
+	errs := len(v.errs)
+	value = v.checkMessage(name, desc, value)
+	if len(v.errs) > errs {
+		return nil, false
+	}
+
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		v.errorf(name, "invalid JSON: %v", err)
+		return nil, false
+	}
+
+	opts := protojson.UnmarshalOptions{Resolver: v.types}
+	if err := opts.Unmarshal(normalized, state); err != nil {
+		v.errorf(name, "does not match the %s schema: %v", desc.FullName(), err)
+		return nil, false
+	}
+
+	return state, true
+}
+
+// checkDuplicateKeys reports the duplicate keys of a JSON document.
+func (v *strictValidator) checkDuplicateKeys(bz []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+
+	var walk func(path string) error
+	walk = func(path string) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'):
+			keys := make(map[string]bool)
+			for dec.More() {
+				tok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, ok := tok.(string)
+				if !ok {
+					return fmt.Errorf("invalid key %v at %s", tok, path)
+				}
+
+				keyPath := key
+				if path != "" {
+					keyPath = path + "." + key
+				}
+				if keys[key] {
+					v.errorf(keyPath, "duplicate key, only its last value is used")
+				}
+				keys[key] = true
+
+				if err := walk(keyPath); err != nil {
+					return err
+				}
+			}
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				if err := walk(fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		default:
+			return nil
+		}
+
+		// consume the closing delimiter
+		_, err = dec.Token()
+		return err
+	}
+
+	return walk("")
+}
+
+// checkMessage checks a JSON value against a message schema. It returns the
+// value normalized for protojson: gogoproto encodes the bytes fields of custom
+// types, such as legacy decimals, as strings rather than base64.
+func (v *strictValidator) checkMessage(path string, desc protoreflect.MessageDescriptor, value any) any {
+	if value == nil {
+		return nil
+	}
+
+	switch {
+	case desc.FullName() == anyFullName:
+		return v.checkAny(path, value)
+	case desc.ParentFile().Package() == wellKnownPackage:
+		// well-known types have their own JSON mapping
+		return value
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		v.errorf(path, "expected a %s object, got %s", desc.FullName(), jsonType(value))
+		return value
+	}
+
+	fields := desc.Fields()
+	for _, key := range sortedKeys(obj) {
+		fieldPath := path + "." + key
+		fd := fields.ByJSONName(key)
+		if fd == nil {
+			fd = fields.ByName(protoreflect.Name(key))
+		}
+		if fd == nil {
+			v.errorf(fieldPath, "unknown field of %s", desc.FullName())
+			continue
+		}
+
+		obj[key] = v.checkField(fieldPath, fd, obj[key])
+	}
+
+	return obj
+}
+
+// checkAny checks a JSON value against the schema of the message type packed
+// in an Any.
+func (v *strictValidator) checkAny(path string, value any) any {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		v.errorf(path, "expected a %s object, got %s", anyFullName, jsonType(value))
+		return value
+	}
+
+	typeURL, _ := obj["@type"].(string)
+	typ, err := v.types.FindMessageByURL(typeURL)
+	if err != nil {
+		v.errorf(path+".@type", "unknown type %q", typeURL)
+		return value
+	}
+
+	desc := typ.Descriptor()
+	if desc.ParentFile().Package() == wellKnownPackage {
+		return value
+	}
+
+	packed := make(map[string]any, len(obj))
+	for key, fieldValue := range obj {
+		if key != "@type" {
+			packed[key] = fieldValue
+		}
+	}
+	if packed, ok := v.checkMessage(path, desc, packed).(map[string]any); ok {
+		packed["@type"] = typeURL
+		return packed
+	}
+
+	return value
+}
+
+func (v *strictValidator) checkField(path string, fd protoreflect.FieldDescriptor, value any) any {
+	if value == nil {
+		return nil
+	}
+
+	switch {
+	case fd.IsList():
+		list, ok := value.([]any)
+		if !ok {
+			v.errorf(path, "expected a list, got %s", jsonType(value))
+			return value
+		}
+		for i, elem := range list {
+			list[i] = v.checkValue(fmt.Sprintf("%s[%d]", path, i), fd, elem)
+		}
+		return list
+	case fd.IsMap():
+		obj, ok := value.(map[string]any)
+		if !ok {
+			v.errorf(path, "expected a map, got %s", jsonType(value))
+			return value
+		}
+		for _, key := range sortedKeys(obj) {
+			obj[key] = v.checkValue(fmt.Sprintf("%s[%s]", path, key), fd.MapValue(), obj[key])
+		}
+		return obj
+	default:
+		return v.checkValue(path, fd, value)
+	}
+}
+
+func (v *strictValidator) checkValue(path string, fd protoreflect.FieldDescriptor, value any) any {
+	expected := ""
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return v.checkMessage(path, fd.Message(), value)
+	case protoreflect.StringKind:
+		if s, ok := value.(string); ok {
+			v.checkScalar(path, fd, s)
+			return value
+		}
+		expected = "a string"
+	case protoreflect.BytesKind:
+		if gogoOption(fd, gogo.E_Casttype) == rawJSONType {
+			// the value is embedded as is by gogoproto
+			bz, err := json.Marshal(value)
+			if err != nil {
+				v.errorf(path, "invalid JSON: %v", err)
+				return value
+			}
+			return base64.StdEncoding.EncodeToString(bz)
+		}
+		if s, ok := value.(string); ok {
+			if gogoOption(fd, gogo.E_Customtype) == "" {
+				return value
+			}
+			v.checkScalar(path, fd, s)
+			return base64.StdEncoding.EncodeToString([]byte(s))
+		}
+		expected = "a base64 string"
+	case protoreflect.BoolKind:
+		if _, ok := value.(bool); ok {
+			return value
+		}
+		expected = "a boolean"
+	case protoreflect.EnumKind:
+		switch value.(type) {
+		case string, json.Number:
+			return value
+		}
+		expected = fmt.Sprintf("a %s value", fd.Enum().FullName())
+	default:
+		switch value.(type) {
+		case string, json.Number:
+			return value
+		}
+		expected = "a number"
+	}
+
+	v.errorf(path, "expected %s, got %s", expected, jsonType(value))
+	return value
+}
+
+// checkScalar checks a string field annotated with a cosmos_proto.scalar
+// option can be parsed as such.
+func (v *strictValidator) checkScalar(path string, fd protoreflect.FieldDescriptor, value string) {
+	if value == "" {
+		return
+	}
+
+	scalar, _ := proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar).(string)
+	if scalar == "" {
+		scalar = customTypeScalars[gogoOption(fd, gogo.E_Customtype)]
+	}
+
+	switch scalar {
+	case "":
+		return
+	case "cosmos.Dec":
+		if _, err := math.LegacyNewDecFromStr(value); err != nil {
+			v.errorf(path, "invalid decimal %q: %v", value, err)
+		}
+	case "cosmos.Int":
+		if _, ok := math.NewIntFromString(value); !ok {
+			v.errorf(path, "invalid integer %q", value)
+		}
+	default:
+		if cdc, ok := v.addressCodecs[scalar]; ok {
+			if _, err := cdc.StringToBytes(value); err != nil {
+				v.errorf(path, "invalid address %q: %v", value, err)
+			}
+		}
+	}
+}
+
+// gogoOption returns a gogoproto string option of a field, such as
+// gogoproto.customtype. The gogoproto extensions are not registered with the
+// protobuf API v2, they are resolved from the merged registry.
+func gogoOption(fd protoreflect.FieldDescriptor, ext *gogoproto.ExtensionDesc) string {
+	var option string
+	fd.Options().ProtoReflect().Range(func(opt protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if opt.FullName() == protoreflect.FullName(ext.Name) {
+			option = value.String()
+			return false
+		}
+		return true
+	})
+	return option
+}
+
+// unpackAny returns the message packed in an Any, or nil if it cannot be
+// resolved.
+func (v *strictValidator) unpackAny(path string, msg protoreflect.Message) protoreflect.Message {
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName("type_url")).String()
+	typ, err := v.types.FindMessageByURL(typeURL)
+	if err != nil {
+		v.errorf(path, "unknown type %q: %v", typeURL, err)
+		return nil
+	}
+
+	packed := typ.New()
+	opts := proto.UnmarshalOptions{Resolver: v.types}
+	if err := opts.Unmarshal(msg.Get(fields.ByName("value")).Bytes(), packed.Interface()); err != nil {
+		v.errorf(path, "failed to unpack %q: %v", typeURL, err)
+		return nil
+	}
+
+	return packed
+}
+
+// checkGroupMembers checks the group members are accounts of the auth or
+// accounts module genesis, or group policies.
+func (v *strictValidator) checkGroupMembers(states map[string]protoreflect.Message) {
+	group, ok := states[groupModuleName]
+	if !ok {
+		return
+	}
+
+	accounts := make(map[string]bool)
+	for _, name := range []string{authModuleName, accountsModuleName} {
+		if state, ok := states[name]; ok {
+			for _, account := range listField(state, "accounts") {
+				if account.Descriptor().FullName() == anyFullName {
+					if account = v.unpackAny(name, account); account == nil {
+						continue
+					}
+				}
+				accounts[accountAddress(account)] = true
+			}
+		}
+	}
+	for _, policy := range listField(group, "group_policies") {
+		accounts[stringField(policy, "address")] = true
+	}
+
+	for i, member := range listField(group, "group_members") {
+		fd := member.Descriptor().Fields().ByName("member")
+		if fd == nil || !member.Has(fd) {
+			continue
+		}
+
+		addr := stringField(member.Get(fd).Message(), "address")
+		if !accounts[addr] {
+			v.errorf(fmt.Sprintf("%s.group_members[%d].member.address", groupModuleName, i),
+				"group %d member %s is not a genesis account, add it to the %s genesis",
+				member.Get(member.Descriptor().Fields().ByName("group_id")).Uint(), addr, authModuleName)
+		}
+	}
+}
+
+// genesisSchemas returns the GenesisState message descriptors by module name,
+// the name of their proto package without its version. When several versions
+// of a package define it, the most stable and recent one is used.
+func genesisSchemas(files *protoregistry.Files) map[string]protoreflect.MessageDescriptor {
+	schemas := make(map[string]protoreflect.MessageDescriptor)
+	ranks := make(map[string][3]int)
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		desc := fd.Messages().ByName("GenesisState")
+		if desc == nil {
+			return true
+		}
+
+		components := strings.Split(string(fd.Package()), ".")
+		name, rank := components[len(components)-1], [3]int{}
+		if matches := protoVersionRegex.FindStringSubmatch(name); matches != nil && len(components) > 1 {
+			name, rank = components[len(components)-2], versionRank(matches)
+		}
+
+		current, ok := schemas[name]
+		if !ok || rankLess(ranks[name], rank) ||
+			(ranks[name] == rank && desc.FullName() < current.FullName()) {
+			schemas[name] = desc
+			ranks[name] = rank
+		}
+		return true
+	})
+
+	return schemas
+}
+
+// versionRank ranks a proto package version, stable versions first, then the
+// major and minor version numbers.
+func versionRank(matches []string) [3]int {
+	major, _ := strconv.Atoi(matches[1])
+	minor, _ := strconv.Atoi(matches[3])
+	stability := 2
+	switch matches[2] {
+	case "alpha":
+		stability = 0
+	case "beta":
+		stability = 1
+	}
+
+	return [3]int{stability, major, minor}
+}
+
+func rankLess(a, b [3]int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+// accountAddress returns the address of an account, which may be held by one
+// of its nested base accounts.
+func accountAddress(msg protoreflect.Message) string {
+	if addr := stringField(msg, "address"); addr != "" {
+		return addr
+	}
+
+	var addr string
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			addr = accountAddress(val.Message())
+		}
+		return addr == ""
+	})
+	return addr
+}
+
+func stringField(msg protoreflect.Message, name protoreflect.Name) string {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return msg.Get(fd).String()
+}
+
+func listField(msg protoreflect.Message, name protoreflect.Name) []protoreflect.Message {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if fd == nil || !fd.IsList() || fd.Kind() != protoreflect.MessageKind {
+		return nil
+	}
+
+	list := msg.Get(fd).List()
+	msgs := make([]protoreflect.Message, list.Len())
+	for i := range msgs {
+		msgs[i] = list.Get(i).Message()
+	}
+	return msgs
+}
+
+func jsonType(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "a list"
+	case string:
+		return "a string"
+	case json.Number:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return "null"
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
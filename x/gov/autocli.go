@@ -89,11 +89,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "TallySummary",
+					Use:       "tally-summary [proposal-id]",
+					Short:     "Query the tally of a proposal vote with its turnout, quorum status and outcome",
+					Example:   fmt.Sprintf("%s query gov tally-summary 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "ProposalsByDepositor",
+					Use:       "proposals-by-depositor [depositor-addr]",
+					Short:     "Query the proposals an address has deposited on",
+					Example:   fmt.Sprintf("%s query gov proposals-by-depositor cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "depositor"},
+					},
+				},
+				{
+					RpcMethod: "ProposalsByVoter",
+					Use:       "proposals-by-voter [voter-addr]",
+					Short:     "Query the proposals an address has voted on",
+					Example:   fmt.Sprintf("%s query gov proposals-by-voter cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "voter"},
+					},
+				},
+				{
+					RpcMethod: "ProposalDependencies",
+					Use:       "proposal-dependencies [proposal-id]",
+					Short:     "Query the proposals a proposal depends on and the proposals depending on it",
+					Example:   fmt.Sprintf("%s query gov proposal-dependencies 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -154,6 +190,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "UpdateConstitution",
+					Use:            "update-constitution-proposal [constitution]",
+					Short:          "Submit a proposal to amend the chain constitution. Note: the entire constitution must be provided.",
+					Example:        fmt.Sprintf(`%s tx gov update-constitution-proposal "$(cat constitution.md)"`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "constitution"}},
+					GovProposal:    true,
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
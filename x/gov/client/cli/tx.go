@@ -112,6 +112,8 @@ Where proposal.json contains:
   "title": "My proposal",
   "summary": "A short summary of my proposal",
   "proposal_type": "standard",
+  // optional ids of the proposals that must pass before this proposal is executed
+  "depends_on": [1, 2],
 }
 
 metadata example: 
@@ -147,6 +149,7 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.DependsOn = proposal.DependsOn
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -95,6 +95,7 @@ type proposal struct {
 	Title           string            `json:"title"`
 	Summary         string            `json:"summary"`
 	ProposalTypeStr string            `json:"proposal_type,omitempty"`
+	DependsOn       []uint64          `json:"depends_on,omitempty"`
 
 	proposalType govv1.ProposalType
 }
@@ -188,7 +188,8 @@ func TestParseSubmitProposal(t *testing.T) {
 	"title": "My awesome title",
 	"summary": "My awesome summary",
 	"deposit": "1000test",
-	"proposal_type": "expedited"
+	"proposal_type": "expedited",
+	"depends_on": [1, 2]
 }
 `, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
 
@@ -228,6 +229,7 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Equal(t, "My awesome title", proposal.Title)
 	require.Equal(t, "My awesome summary", proposal.Summary)
 	require.Equal(t, v1.ProposalType_PROPOSAL_TYPE_EXPEDITED, proposal.proposalType)
+	require.Equal(t, []uint64{1, 2}, proposal.DependsOn)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
@@ -73,6 +73,8 @@ func NormalizeProposalStatus(status string) string {
 		return v1.StatusPassed.String()
 	case "Rejected", "rejected":
 		return v1.StatusRejected.String()
+	case "AwaitingDependencies", "awaiting_dependencies":
+		return v1.StatusAwaitingDependencies.String()
 	default:
 		return status
 	}
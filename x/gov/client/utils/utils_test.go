@@ -75,6 +75,8 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"Passed", args{"Passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Rejected", args{"Rejected"}, "PROPOSAL_STATUS_REJECTED"},
 		{"rejected", args{"rejected"}, "PROPOSAL_STATUS_REJECTED"},
+		{"AwaitingDependencies", args{"AwaitingDependencies"}, "PROPOSAL_STATUS_AWAITING_DEPENDENCIES"},
+		{"awaiting_dependencies", args{"awaiting_dependencies"}, "PROPOSAL_STATUS_AWAITING_DEPENDENCIES"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
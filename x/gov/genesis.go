@@ -53,6 +53,10 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		if err != nil {
 			return err
 		}
+		err = k.VoterProposals.Set(ctx, collections.Join(sdk.AccAddress(addr), vote.ProposalId))
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, proposal := range data.Proposals {
@@ -67,6 +71,15 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				return err
 			}
+		case v1.StatusAwaitingDependencies:
+			if err := k.ProposalsAwaitingDependencies.Set(ctx, proposal.Id); err != nil {
+				return err
+			}
+		}
+		for _, depID := range proposal.DependsOn {
+			if err := k.ProposalDependents.Set(ctx, collections.Join(depID, proposal.Id)); err != nil {
+				return err
+			}
 		}
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			return err
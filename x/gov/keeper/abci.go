@@ -92,12 +92,17 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 
+		minDeposit, err := k.getMinDeposit(ctx, params, proposal.ProposalType, proposal.Messages)
+		if err != nil {
+			return err
+		}
+
 		k.Logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
 			"proposal_type", proposal.ProposalType,
 			"title", proposal.Title,
-			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(params)...).String(),
+			"min_deposit", sdk.NewCoins(minDeposit...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 	}
@@ -145,6 +150,13 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
+		// the quorum depends on the proposal type, which changes if a failed
+		// expedited or optimistic proposal is converted to a regular one.
+		quorum, err := k.proposalQuorum(ctx, proposal, params)
+		if err != nil {
+			return err
+		}
+
 		// Deposits are always burned if tally said so, regardless of the proposal type.
 		// If a proposal passes, deposits are always refunded, regardless of the proposal type.
 		// If a proposal fails, and isn't spammy, deposits are refunded, unless the proposal is expedited or optimistic.
@@ -175,47 +187,29 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		switch {
 		case passes:
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
-			messages, err := proposal.GetMsgs()
+			// the execution of a proposal with dependencies is deferred
+			// until all of them have passed.
+			ready, failedReason, err := k.dependenciesStatus(ctx, proposal)
 			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
+				return err
 			}
 
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
-				// execute all messages
-				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
-						return err
-					}
-				}
-
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				return nil
-			})
-			if err != nil {
-				// `idx` and `err` are populated with the msg index and error.
+			switch {
+			case failedReason != "":
 				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
+				proposal.FailedReason = failedReason
 				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+				logMsg = fmt.Sprintf("passed, but %s", failedReason)
+			case !ready:
+				proposal.Status = v1.StatusAwaitingDependencies
+				tagValue = types.AttributeValueProposalAwaitingDeps
+				logMsg = "passed, awaiting dependencies"
 
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+				if err := k.ProposalsAwaitingDependencies.Set(ctx, proposal.Id); err != nil {
+					return err
+				}
+			default:
+				tagValue, logMsg = k.executeProposal(ctx, params, &proposal)
 			}
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
@@ -248,6 +242,13 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		proposal.FinalTallyResult = &tallyResults
 
+		totalBonded, err := k.sk.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+		proposal.FinalTotalBonded = totalBonded.String()
+		proposal.FinalQuorum = quorum.String()
+
 		if err = k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
 			return err
 		}
@@ -277,7 +278,124 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 	}
-	return nil
+
+	return k.processProposalsAwaitingDependencies(ctx, params)
+}
+
+// executeProposal executes the messages of a passed proposal and updates its
+// status accordingly. It returns the event tag value and the log message of
+// the execution.
+func (k Keeper) executeProposal(ctx context.Context, params v1.Params, proposal *v1.Proposal) (tagValue, logMsg string) {
+	var (
+		idx int
+		msg sdk.Msg
+	)
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
+		// execute all messages
+		for idx, msg = range messages {
+			if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// `idx` and `err` are populated with the msg index and error.
+		// We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	proposal.Status = v1.StatusPassed
+	return types.AttributeValueProposalPassed, "passed"
+}
+
+// processProposalsAwaitingDependencies executes the passed proposals whose
+// dependencies have all passed since, and fails the ones with a dependency
+// that can no longer pass. As executing a proposal can unblock its own
+// dependents, the awaiting proposals are processed until no more progress is made.
+func (k Keeper) processProposalsAwaitingDependencies(ctx context.Context, params v1.Params) error {
+	for {
+		iter, err := k.ProposalsAwaitingDependencies.Iterate(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		proposalIDs, err := iter.Keys()
+		if err != nil {
+			return err
+		}
+
+		progressed := false
+		for _, proposalID := range proposalIDs {
+			proposal, err := k.Proposals.Get(ctx, proposalID)
+			if err != nil {
+				return err
+			}
+
+			ready, failedReason, err := k.dependenciesStatus(ctx, proposal)
+			if err != nil {
+				return err
+			}
+
+			if !ready && failedReason == "" {
+				continue
+			}
+
+			var tagValue, logMsg string
+			if failedReason != "" {
+				proposal.Status = v1.StatusFailed
+				proposal.FailedReason = failedReason
+				tagValue = types.AttributeValueProposalFailed
+				logMsg = fmt.Sprintf("passed, but %s", failedReason)
+			} else {
+				tagValue, logMsg = k.executeProposal(ctx, params, &proposal)
+			}
+
+			if err := k.ProposalsAwaitingDependencies.Remove(ctx, proposalID); err != nil {
+				return err
+			}
+
+			if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+				return err
+			}
+			progressed = true
+
+			k.Logger.Info(
+				"proposal dependencies resolved",
+				"proposal", proposal.Id,
+				"status", proposal.Status.String(),
+				"title", proposal.Title,
+				"results", logMsg,
+			)
+
+			if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeActiveProposal,
+				event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				event.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				event.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+			); err != nil {
+				k.Logger.Error("failed to emit event", "error", err)
+			}
+		}
+
+		if !progressed {
+			return nil
+		}
+	}
 }
 
 // executes route(msg) and recovers from panic.
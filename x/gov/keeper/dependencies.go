@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// SetProposalDependencies records that the given proposal must not be executed
+// before all the proposals in dependsOn have passed.
+// Dependencies must exist, must not have already been rejected or failed and
+// must not introduce a cycle in the dependency graph.
+func (k Keeper) SetProposalDependencies(ctx context.Context, proposalID uint64, dependsOn []uint64) error {
+	if len(dependsOn) == 0 {
+		return nil
+	}
+
+	if err := v1.ValidateProposalDependencies(proposalID, dependsOn); err != nil {
+		return types.ErrInvalidDependencies.Wrap(err.Error())
+	}
+
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, depID := range dependsOn {
+		dep, err := k.Proposals.Get(ctx, depID)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return types.ErrInvalidDependencies.Wrapf("proposal %d doesn't exist", depID)
+			}
+			return err
+		}
+
+		if dep.Status == v1.StatusRejected || dep.Status == v1.StatusFailed {
+			return types.ErrInvalidDependencies.Wrapf("proposal %d has already %s", depID, dep.Status)
+		}
+	}
+
+	if err := k.checkDependencyCycle(ctx, proposalID, dependsOn); err != nil {
+		return err
+	}
+
+	proposal.DependsOn = dependsOn
+	if err := k.Proposals.Set(ctx, proposalID, proposal); err != nil {
+		return err
+	}
+
+	return k.setProposalDependents(ctx, proposal)
+}
+
+// setProposalDependents indexes the proposal under each of its dependencies.
+func (k Keeper) setProposalDependents(ctx context.Context, proposal v1.Proposal) error {
+	for _, depID := range proposal.DependsOn {
+		if err := k.ProposalDependents.Set(ctx, collections.Join(depID, proposal.Id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkDependencyCycle walks the dependency graph starting at dependsOn and
+// returns an error if proposalID is reachable from it.
+func (k Keeper) checkDependencyCycle(ctx context.Context, proposalID uint64, dependsOn []uint64) error {
+	visited := make(map[uint64]bool)
+	stack := append([]uint64{}, dependsOn...)
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if id == proposalID {
+			return types.ErrInvalidDependencies.Wrapf("dependency cycle detected on proposal %d", proposalID)
+		}
+
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		proposal, err := k.Proposals.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		stack = append(stack, proposal.DependsOn...)
+	}
+
+	return nil
+}
+
+// dependenciesStatus returns whether all the dependencies of the proposal have
+// passed. When a dependency can no longer pass, a non-empty failure reason is
+// returned instead.
+func (k Keeper) dependenciesStatus(ctx context.Context, proposal v1.Proposal) (ready bool, failedReason string, err error) {
+	ready = true
+	for _, depID := range proposal.DependsOn {
+		dep, err := k.Proposals.Get(ctx, depID)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, fmt.Sprintf("dependency proposal %d no longer exists", depID), nil
+			}
+			return false, "", err
+		}
+
+		switch dep.Status {
+		case v1.StatusPassed:
+		case v1.StatusRejected, v1.StatusFailed:
+			return false, fmt.Sprintf("dependency proposal %d did not pass", depID), nil
+		default:
+			ready = false
+		}
+	}
+
+	return ready, "", nil
+}
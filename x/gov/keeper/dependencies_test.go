@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestSetProposalDependencies() {
+	suite.reset()
+	ctx, addrs := suite.ctx, suite.addrs
+
+	var proposals []v1.Proposal
+	for i := 0; i < 4; i++ {
+		proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		suite.Require().NoError(err)
+		proposals = append(proposals, proposal)
+	}
+
+	rejected := proposals[2]
+	rejected.Status = v1.StatusRejected
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(ctx, rejected.Id, rejected))
+
+	tooMany := make([]uint64, v1.MaxProposalDependencies+1)
+	for i := range tooMany {
+		tooMany[i] = uint64(100 + i)
+	}
+
+	testCases := []struct {
+		name       string
+		proposalID uint64
+		dependsOn  []uint64
+		expErr     string
+	}{
+		{
+			name:       "no dependencies",
+			proposalID: proposals[1].Id,
+		},
+		{
+			name:       "depends on itself",
+			proposalID: proposals[1].Id,
+			dependsOn:  []uint64{proposals[1].Id},
+			expErr:     "cannot depend on itself",
+		},
+		{
+			name:       "duplicate dependency",
+			proposalID: proposals[1].Id,
+			dependsOn:  []uint64{proposals[0].Id, proposals[0].Id},
+			expErr:     "duplicate dependency",
+		},
+		{
+			name:       "too many dependencies",
+			proposalID: proposals[1].Id,
+			dependsOn:  tooMany,
+			expErr:     "maximum allowed",
+		},
+		{
+			name:       "non-existent dependency",
+			proposalID: proposals[1].Id,
+			dependsOn:  []uint64{100},
+			expErr:     "proposal 100 doesn't exist",
+		},
+		{
+			name:       "rejected dependency",
+			proposalID: proposals[1].Id,
+			dependsOn:  []uint64{rejected.Id},
+			expErr:     "has already",
+		},
+		{
+			name:       "valid dependencies",
+			proposalID: proposals[1].Id,
+			dependsOn:  []uint64{proposals[0].Id},
+		},
+		{
+			name:       "dependency cycle",
+			proposalID: proposals[0].Id,
+			dependsOn:  []uint64{proposals[3].Id, proposals[1].Id},
+			expErr:     "dependency cycle",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.govKeeper.SetProposalDependencies(ctx, tc.proposalID, tc.dependsOn)
+			if tc.expErr != "" {
+				suite.Require().ErrorIs(err, types.ErrInvalidDependencies)
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, err := suite.govKeeper.Proposals.Get(ctx, tc.proposalID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.dependsOn, proposal.DependsOn)
+
+			for _, depID := range tc.dependsOn {
+				has, err := suite.govKeeper.ProposalDependents.Has(ctx, collections.Join(depID, tc.proposalID))
+				suite.Require().NoError(err)
+				suite.Require().True(has)
+			}
+		})
+	}
+
+	// deleting a proposal removes it from the dependents index
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(ctx, proposals[1].Id))
+	has, err := suite.govKeeper.ProposalDependents.Has(ctx, collections.Join(proposals[0].Id, proposals[1].Id))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -13,6 +13,7 @@ import (
 	v1 "cosmossdk.io/x/gov/types/v1"
 	pooltypes "cosmossdk.io/x/protocolpool/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -23,7 +24,10 @@ func (k Keeper) SetDeposit(ctx context.Context, deposit v1.Deposit) error {
 	if err != nil {
 		return err
 	}
-	return k.Deposits.Set(ctx, collections.Join(deposit.ProposalId, sdk.AccAddress(depositor)), deposit)
+	if err := k.Deposits.Set(ctx, collections.Join(deposit.ProposalId, sdk.AccAddress(depositor)), deposit); err != nil {
+		return err
+	}
+	return k.DepositorProposals.Set(ctx, collections.Join(sdk.AccAddress(depositor), deposit.ProposalId))
 }
 
 // GetDeposits returns all the deposits of a proposal
@@ -92,7 +96,11 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		return false, err
 	}
 
-	minDepositAmount := proposal.GetMinDepositFromParams(params)
+	minDepositAmount, err := k.getMinDeposit(ctx, params, proposal.ProposalType, proposal.Messages)
+	if err != nil {
+		return false, err
+	}
+
 	minDepositRatio, err := sdkmath.LegacyNewDecFromStr(params.GetMinDepositRatio())
 	if err != nil {
 		return false, err
@@ -290,10 +298,30 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 	return nil
 }
 
+// getMinDeposit returns the minimum deposit required for a proposal of the given type and messages
+// to enter the voting period. Standard proposals whose message has message based params defining a
+// min deposit use it, otherwise the min deposit is determined by the governance params.
+func (k Keeper) getMinDeposit(ctx context.Context, params v1.Params, proposalType v1.ProposalType, messages []*codectypes.Any) (sdk.Coins, error) {
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED {
+		return params.ExpeditedMinDeposit, nil
+	}
+
+	if len(messages) > 0 {
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, messages[0].TypeUrl)
+		if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+			return nil, err
+		} else if err == nil && len(customMessageParams.MinDeposit) > 0 {
+			return customMessageParams.MinDeposit, nil
+		}
+	}
+
+	return params.MinDeposit, nil
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
 // the deposit parameters. Returns nil on success, error otherwise.
-func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coins, proposalType v1.ProposalType) error {
+func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit, minDeposit sdk.Coins) error {
 	if !initialDeposit.IsValid() || initialDeposit.IsAnyNegative() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, initialDeposit.String())
 	}
@@ -306,16 +334,9 @@ func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coin
 		return nil
 	}
 
-	var minDepositCoins sdk.Coins
-	switch proposalType {
-	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
-		minDepositCoins = params.ExpeditedMinDeposit
-	default:
-		minDepositCoins = params.MinDeposit
-	}
-
-	for i := range minDepositCoins {
-		minDepositCoins[i].Amount = sdkmath.LegacyNewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
+	minDepositCoins := make(sdk.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		minDepositCoins[i] = sdk.NewCoin(coin.Denom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(minInitialDepositRatio).RoundInt())
 	}
 	if !initialDeposit.IsAllGTE(minDepositCoins) {
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
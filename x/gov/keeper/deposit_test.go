@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -166,6 +167,45 @@ func TestDeposits(t *testing.T) {
 	}
 }
 
+func TestDepositsMessageBasedMinDeposit(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+	err := trackMockBalances(bankKeeper)
+	require.NoError(t, err)
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	// the message based min deposit is lower than the default min deposit
+	msgs := TestProposal[:1]
+	votingPeriod := time.Hour
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	err = govKeeper.MessageBasedParams.Set(ctx, sdk.MsgTypeURL(msgs[0]), v1.MessageBasedParams{
+		VotingPeriod:  &votingPeriod,
+		Quorum:        params.Quorum,
+		YesQuorum:     params.YesQuorum,
+		Threshold:     params.Threshold,
+		VetoThreshold: params.VetoThreshold,
+		MinDeposit:    minDeposit,
+	})
+	require.NoError(t, err)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", TestAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.True(t, minDeposit.IsAllLT(params.MinDeposit))
+
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, err = govKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, votingPeriod, proposal.VotingEndTime.Sub(*proposal.VotingStartTime))
+}
+
 func TestDepositAmount(t *testing.T) {
 	testcases := []struct {
 		name            string
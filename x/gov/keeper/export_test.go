@@ -14,5 +14,10 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 		return err
 	}
 
-	return k.validateInitialDeposit(params, initialDeposit, proposalType)
+	minDeposit, err := k.getMinDeposit(ctx, params, proposalType, nil)
+	if err != nil {
+		return err
+	}
+
+	return k.validateInitialDeposit(params, initialDeposit, minDeposit)
 }
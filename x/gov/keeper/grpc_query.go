@@ -226,22 +226,29 @@ func (q queryServer) MessageBasedParams(ctx context.Context, req *v1.QueryMessag
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	govParams, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	params, err := q.k.MessageBasedParams.Get(ctx, req.MsgUrl)
 	if err == nil {
+		// resolve the min deposit when it is not overridden by the message based params
+		if params.MinDeposit.Empty() {
+			params.MinDeposit = govParams.MinDeposit
+		}
+
 		return &v1.QueryMessageBasedParamsResponse{Params: &params}, nil
 	}
 
 	if errors.IsOf(err, collections.ErrNotFound) {
-		resp, err := q.Params(ctx, &v1.QueryParamsRequest{})
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-
 		return &v1.QueryMessageBasedParamsResponse{Params: &v1.MessageBasedParams{
-			VotingPeriod:  resp.Params.VotingPeriod,
-			Quorum:        resp.Params.Quorum,
-			Threshold:     resp.Params.Threshold,
-			VetoThreshold: resp.Params.VetoThreshold,
+			VotingPeriod:  govParams.VotingPeriod,
+			Quorum:        govParams.Quorum,
+			YesQuorum:     govParams.YesQuorum,
+			Threshold:     govParams.Threshold,
+			VetoThreshold: govParams.VetoThreshold,
+			MinDeposit:    govParams.MinDeposit,
 		}}, nil
 	}
 
@@ -319,7 +326,8 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	case proposal.Status == v1.StatusDepositPeriod:
 		tallyResult = v1.EmptyTallyResult()
 
-	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed:
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed ||
+		proposal.Status == v1.StatusAwaitingDependencies:
 		tallyResult = *proposal.FinalTallyResult
 
 	default:
@@ -334,6 +342,203 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// TallySummary queries the tally of a proposal vote along with its turnout, quorum status and outcome.
+func (q queryServer) TallySummary(ctx context.Context, req *v1.QueryTallySummaryRequest) (*v1.QueryTallySummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var (
+		tallyResult v1.TallyResult
+		passing     bool
+		totalBonded sdkmath.Int
+		quorum      sdkmath.LegacyDec
+	)
+
+	switch {
+	case proposal.Status == v1.StatusDepositPeriod:
+		tallyResult = v1.EmptyTallyResult()
+
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed ||
+		proposal.Status == v1.StatusAwaitingDependencies:
+		tallyResult = *proposal.FinalTallyResult
+		passing = proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusAwaitingDependencies
+
+		// the turnout of a finished proposal is computed against the bonded stake
+		// at the end of its voting period and compared to the quorum it was
+		// tallied against. Proposals tallied before they were stored fall back
+		// to the current bonded stake and params.
+		if proposal.FinalTotalBonded != "" {
+			var ok bool
+			totalBonded, ok = sdkmath.NewIntFromString(proposal.FinalTotalBonded)
+			if !ok {
+				return nil, status.Errorf(codes.Internal, "invalid final total bonded %s", proposal.FinalTotalBonded)
+			}
+		}
+		if proposal.FinalQuorum != "" {
+			quorum, err = sdkmath.LegacyNewDecFromStr(proposal.FinalQuorum)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "invalid final quorum %s", proposal.FinalQuorum)
+			}
+		}
+
+	default:
+		// proposal is in voting period
+		passing, _, tallyResult, err = q.k.Tally(ctx, proposal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if quorum.IsNil() {
+		params, err := q.k.Params.Get(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		quorum, err = q.k.proposalQuorum(ctx, proposal, params)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if totalBonded.IsNil() {
+		totalBonded, err = q.k.sk.TotalBondedTokens(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	totalVotingPower, err := tallyResult.TotalVotingPower()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	turnout := sdkmath.LegacyZeroDec()
+	if totalBonded.IsPositive() {
+		turnout = totalVotingPower.ToLegacyDec().Quo(totalBonded.ToLegacyDec())
+	}
+
+	return &v1.QueryTallySummaryResponse{
+		Tally:         &tallyResult,
+		TotalBonded:   totalBonded.String(),
+		Turnout:       turnout.String(),
+		Quorum:        quorum.String(),
+		QuorumReached: totalBonded.IsPositive() && turnout.GTE(quorum),
+		Passing:       passing,
+	}, nil
+}
+
+// ProposalsByDepositor queries the proposals a given address has deposited on.
+func (q queryServer) ProposalsByDepositor(ctx context.Context, req *v1.QueryProposalsByDepositorRequest) (*v1.QueryProposalsByDepositorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Depositor == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty depositor address")
+	}
+
+	depositor, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Depositor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proposals, pageRes, err := q.proposalsByAddress(ctx, q.k.DepositorProposals, depositor, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryProposalsByDepositorResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// ProposalsByVoter queries the proposals a given address has voted on.
+func (q queryServer) ProposalsByVoter(ctx context.Context, req *v1.QueryProposalsByVoterRequest) (*v1.QueryProposalsByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proposals, pageRes, err := q.proposalsByAddress(ctx, q.k.VoterProposals, voter, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryProposalsByVoterResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// ProposalDependencies returns the proposals a proposal depends on and the proposals depending on it.
+func (q queryServer) ProposalDependencies(ctx context.Context, req *v1.QueryProposalDependenciesRequest) (*v1.QueryProposalDependenciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dependents, pageRes, err := query.CollectionPaginate(ctx, q.k.ProposalDependents, req.Pagination, func(key collections.Pair[uint64, uint64], _ collections.NoValue) (uint64, error) {
+		return key.K2(), nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, uint64](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalDependenciesResponse{DependsOn: proposal.DependsOn, Dependents: dependents, Pagination: pageRes}, nil
+}
+
+// proposalsByAddress paginates over the proposals indexed under the given address.
+// Proposals which have since been deleted are skipped.
+func (q queryServer) proposalsByAddress(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]],
+	addr sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]*v1.Proposal, *query.PageResponse, error) {
+	proposals, pageRes, err := query.CollectionFilteredPaginate(ctx, index, pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+		return q.k.Proposals.Has(ctx, key.K2())
+	}, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (*v1.Proposal, error) {
+		proposal, err := q.k.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		return &proposal, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return proposals, pageRes, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	v3 "cosmossdk.io/x/gov/migrations/v3"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -988,6 +989,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryMessagedBasedParams() {
 					Quorum:        "0.4",
 					Threshold:     "0.5",
 					VetoThreshold: "0.66",
+					MinDeposit:    defaultGovParams.MinDeposit,
 				},
 			},
 		},
@@ -1000,8 +1002,10 @@ func (suite *KeeperTestSuite) TestGRPCQueryMessagedBasedParams() {
 				Params: &v1.MessageBasedParams{
 					VotingPeriod:  defaultGovParams.VotingPeriod,
 					Quorum:        defaultGovParams.Quorum,
+					YesQuorum:     defaultGovParams.YesQuorum,
 					Threshold:     defaultGovParams.Threshold,
 					VetoThreshold: defaultGovParams.VetoThreshold,
+					MinDeposit:    defaultGovParams.MinDeposit,
 				},
 			},
 		},
@@ -1738,6 +1742,146 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryTallySummary() {
+	suite.reset()
+	queryClient := suite.queryClient
+	defaultGovParams := v1.DefaultParams()
+	propTime := time.Now()
+
+	_, err := queryClient.TallySummary(suite.ctx, &v1.QueryTallySummaryRequest{})
+	suite.Require().ErrorContains(err, "proposal id can not be 0")
+
+	_, err = queryClient.TallySummary(suite.ctx, &v1.QueryTallySummaryRequest{ProposalId: 2})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	// total bonded tokens are 10000000, a finished proposal uses the bonded
+	// tokens at the end of its voting period
+	passed := v1.Proposal{
+		Id:               1,
+		Status:           v1.StatusPassed,
+		FinalTallyResult: &v1.TallyResult{OptionOneCount: "4000000", OptionTwoCount: "1000000", OptionThreeCount: "0", OptionFourCount: "0", SpamCount: "0"},
+		FinalTotalBonded: "20000000",
+		FinalQuorum:      "0.200000000000000000",
+		SubmitTime:       &propTime,
+		VotingStartTime:  &propTime,
+		VotingEndTime:    &propTime,
+	}
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, passed.Id, passed))
+
+	res, err := queryClient.TallySummary(suite.ctx, &v1.QueryTallySummaryRequest{ProposalId: passed.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(passed.FinalTallyResult, res.Tally)
+	suite.Require().Equal("20000000", res.TotalBonded)
+	suite.Require().Equal(math.LegacyNewDecWithPrec(25, 2).String(), res.Turnout)
+	suite.Require().Equal(passed.FinalQuorum, res.Quorum)
+	suite.Require().True(res.QuorumReached)
+	suite.Require().True(res.Passing)
+
+	// a proposal tallied before the bonded tokens and the quorum were stored
+	// uses the current ones
+	legacy := passed
+	legacy.Id = 3
+	legacy.FinalTotalBonded = ""
+	legacy.FinalQuorum = ""
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, legacy.Id, legacy))
+
+	res, err = queryClient.TallySummary(suite.ctx, &v1.QueryTallySummaryRequest{ProposalId: legacy.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal("10000000", res.TotalBonded)
+	suite.Require().Equal(math.LegacyNewDecWithPrec(5, 1).String(), res.Turnout)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr(defaultGovParams.Quorum).String(), res.Quorum)
+	suite.Require().True(res.QuorumReached)
+
+	// a proposal in voting period is tallied live
+	voting := v1.Proposal{
+		Id:              2,
+		Status:          v1.StatusVotingPeriod,
+		ProposalType:    v1.ProposalType_PROPOSAL_TYPE_EXPEDITED,
+		SubmitTime:      &propTime,
+		VotingStartTime: &propTime,
+		VotingEndTime:   &propTime,
+	}
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, voting.Id, voting))
+
+	res, err = queryClient.TallySummary(suite.ctx, &v1.QueryTallySummaryRequest{ProposalId: voting.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.EmptyTallyResult(), *res.Tally)
+	suite.Require().Equal(math.LegacyZeroDec().String(), res.Turnout)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr(defaultGovParams.ExpeditedQuorum).String(), res.Quorum)
+	suite.Require().False(res.QuorumReached)
+	suite.Require().False(res.Passing)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalsByDepositorAndVoter() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	depositorStr, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[1])
+	suite.Require().NoError(err)
+	voterStr, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[2])
+	suite.Require().NoError(err)
+
+	_, err = queryClient.ProposalsByDepositor(ctx, &v1.QueryProposalsByDepositorRequest{})
+	suite.Require().ErrorContains(err, "empty depositor address")
+	_, err = queryClient.ProposalsByVoter(ctx, &v1.QueryProposalsByVoterRequest{})
+	suite.Require().ErrorContains(err, "empty voter address")
+
+	var proposals []v1.Proposal
+	for i := 0; i < 3; i++ {
+		proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		suite.Require().NoError(err)
+		proposals = append(proposals, proposal)
+	}
+
+	// addrs[1] deposits on the first and the last proposal
+	for _, proposal := range []v1.Proposal{proposals[0], proposals[2]} {
+		suite.Require().NoError(suite.govKeeper.SetDeposit(ctx, v1.NewDeposit(proposal.Id, depositorStr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))))
+	}
+
+	// addrs[2] votes on the second proposal
+	proposals[1].Status = v1.StatusVotingPeriod
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(ctx, proposals[1].Id, proposals[1]))
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposals[1].Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	depRes, err := queryClient.ProposalsByDepositor(ctx, &v1.QueryProposalsByDepositorRequest{Depositor: depositorStr})
+	suite.Require().NoError(err)
+	suite.Require().Len(depRes.Proposals, 2)
+	suite.Require().Equal(proposals[0].Id, depRes.Proposals[0].Id)
+	suite.Require().Equal(proposals[2].Id, depRes.Proposals[1].Id)
+
+	// paginated
+	depRes, err = queryClient.ProposalsByDepositor(ctx, &v1.QueryProposalsByDepositorRequest{Depositor: depositorStr, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(depRes.Proposals, 1)
+	suite.Require().Equal(proposals[0].Id, depRes.Proposals[0].Id)
+	suite.Require().NotNil(depRes.Pagination.NextKey)
+	suite.Require().Equal(uint64(2), depRes.Pagination.Total)
+
+	depRes, err = queryClient.ProposalsByDepositor(ctx, &v1.QueryProposalsByDepositorRequest{Depositor: depositorStr, Pagination: &query.PageRequest{Key: depRes.Pagination.NextKey}})
+	suite.Require().NoError(err)
+	suite.Require().Len(depRes.Proposals, 1)
+	suite.Require().Equal(proposals[2].Id, depRes.Proposals[0].Id)
+
+	// the voter index is kept once the votes are pruned
+	suite.Require().NoError(suite.govKeeper.Votes.Remove(ctx, collections.Join(proposals[1].Id, addrs[2])))
+	voteRes, err := queryClient.ProposalsByVoter(ctx, &v1.QueryProposalsByVoterRequest{Voter: voterStr})
+	suite.Require().NoError(err)
+	suite.Require().Len(voteRes.Proposals, 1)
+	suite.Require().Equal(proposals[1].Id, voteRes.Proposals[0].Id)
+
+	// deleted proposals are skipped
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(ctx, proposals[2].Id))
+	depRes, err = queryClient.ProposalsByDepositor(ctx, &v1.QueryProposalsByDepositorRequest{Depositor: depositorStr})
+	suite.Require().NoError(err)
+	suite.Require().Len(depRes.Proposals, 1)
+	suite.Require().Equal(proposals[0].Id, depRes.Proposals[0].Id)
+
+	// an address without any interaction has no proposals
+	voteRes, err = queryClient.ProposalsByVoter(ctx, &v1.QueryProposalsByVoterRequest{Voter: depositorStr})
+	suite.Require().NoError(err)
+	suite.Require().Empty(voteRes.Proposals)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	queryClient := suite.legacyQueryClient
@@ -2041,3 +2185,40 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalDependencies() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.ProposalDependencies(ctx, &v1.QueryProposalDependenciesRequest{})
+	suite.Require().ErrorContains(err, "proposal id can not be 0")
+	_, err = queryClient.ProposalDependencies(ctx, &v1.QueryProposalDependenciesRequest{ProposalId: 10})
+	suite.Require().ErrorContains(err, "proposal 10 doesn't exist")
+
+	var proposals []v1.Proposal
+	for i := 0; i < 3; i++ {
+		proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		suite.Require().NoError(err)
+		proposals = append(proposals, proposal)
+	}
+
+	// the second and the third proposals depend on the first one
+	suite.Require().NoError(suite.govKeeper.SetProposalDependencies(ctx, proposals[1].Id, []uint64{proposals[0].Id}))
+	suite.Require().NoError(suite.govKeeper.SetProposalDependencies(ctx, proposals[2].Id, []uint64{proposals[0].Id, proposals[1].Id}))
+
+	res, err := queryClient.ProposalDependencies(ctx, &v1.QueryProposalDependenciesRequest{ProposalId: proposals[0].Id})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DependsOn)
+	suite.Require().Equal([]uint64{proposals[1].Id, proposals[2].Id}, res.Dependents)
+
+	res, err = queryClient.ProposalDependencies(ctx, &v1.QueryProposalDependenciesRequest{ProposalId: proposals[2].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{proposals[0].Id, proposals[1].Id}, res.DependsOn)
+	suite.Require().Empty(res.Dependents)
+
+	// paginated
+	res, err = queryClient.ProposalDependencies(ctx, &v1.QueryProposalDependenciesRequest{ProposalId: proposals[0].Id, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{proposals[1].Id}, res.Dependents)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
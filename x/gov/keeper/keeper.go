@@ -65,6 +65,17 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// DepositorProposals key: depositorAddr+proposalID
+	// Entries are kept after the deposits are refunded or burned.
+	DepositorProposals collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// VoterProposals key: voterAddr+proposalID
+	// Entries are kept after the votes are pruned on tally.
+	VoterProposals collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// ProposalDependents key: dependencyProposalID+proposalID
+	ProposalDependents collections.KeySet[collections.Pair[uint64, uint64]]
+	// ProposalsAwaitingDependencies key: proposalID
+	// Stores the passed proposals whose execution waits on their dependencies.
+	ProposalsAwaitingDependencies collections.KeySet[uint64]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -77,8 +88,6 @@ func (k Keeper) GetAuthority() string {
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
-//
-// CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.Codec, env appmodule.Environment, authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, sk types.StakingKeeper, pk types.PoolKeeper,
@@ -113,24 +122,28 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
-		Environment:            env,
-		authKeeper:             authKeeper,
-		bankKeeper:             bankKeeper,
-		sk:                     sk,
-		poolKeeper:             pk,
-		cdc:                    cdc,
-		config:                 config,
-		authority:              authority,
-		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
-		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
-		MessageBasedParams:     collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
-		Deposits:               collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
-		Votes:                  collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
-		ProposalID:             collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
-		Proposals:              collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
-		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
-		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
-		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		Environment:                   env,
+		authKeeper:                    authKeeper,
+		bankKeeper:                    bankKeeper,
+		sk:                            sk,
+		poolKeeper:                    pk,
+		cdc:                           cdc,
+		config:                        config,
+		authority:                     authority,
+		Constitution:                  collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
+		Params:                        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
+		MessageBasedParams:            collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
+		Deposits:                      collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		Votes:                         collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		ProposalID:                    collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
+		Proposals:                     collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
+		ProposalVoteOptions:           collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
+		ActiveProposalsQueue:          collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
+		InactiveProposalsQueue:        collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		DepositorProposals:            collections.NewKeySet(sb, types.DepositorProposalsKeyPrefix, "depositor_proposals", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		VoterProposals:                collections.NewKeySet(sb, types.VoterProposalsKeyPrefix, "voter_proposals", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		ProposalDependents:            collections.NewKeySet(sb, types.ProposalDependentsKeyPrefix, "proposal_dependents", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		ProposalsAwaitingDependencies: collections.NewKeySet(sb, types.AwaitingDependenciesPrefix, "proposals_awaiting_dependencies", collections.Uint64Key),
 	}
 	schema, err := sb.Build()
 	if err != nil {
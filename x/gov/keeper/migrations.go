@@ -5,6 +5,7 @@ import (
 
 	v5 "cosmossdk.io/x/gov/migrations/v5"
 	v6 "cosmossdk.io/x/gov/migrations/v6"
+	v7 "cosmossdk.io/x/gov/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -43,3 +44,8 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 func (m Migrator) Migrate5to6(ctx context.Context) error {
 	return v6.MigrateStore(ctx, m.keeper.KVStoreService, m.keeper.Params, m.keeper.Proposals)
 }
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.Deposits, m.keeper.Votes, m.keeper.DepositorProposals, m.keeper.VoterProposals)
+}
@@ -79,7 +79,12 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 	if msg.Expedited { // checking for backward compatibility
 		msg.ProposalType = v1.ProposalType_PROPOSAL_TYPE_EXPEDITED
 	}
-	if err := k.validateInitialDeposit(params, msg.GetInitialDeposit(), msg.ProposalType); err != nil {
+	minDeposit, err := k.getMinDeposit(ctx, params, msg.ProposalType, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateInitialDeposit(params, msg.GetInitialDeposit(), minDeposit); err != nil {
 		return nil, err
 	}
 
@@ -92,6 +97,11 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if err := k.Keeper.SetProposalDependencies(ctx, proposal.Id, msg.DependsOn); err != nil {
+		return nil, err
+	}
+	proposal.DependsOn = msg.DependsOn
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -334,7 +344,7 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 	}
 
 	// delete the message params if the params are empty
-	if msg.Params == nil || *msg.Params == (v1.MessageBasedParams{}) {
+	if msg.Params == nil || msg.Params.Size() == 0 {
 		if err := k.MessageBasedParams.Remove(ctx, msg.MsgUrl); err != nil {
 			return nil, err
 		}
@@ -346,6 +356,18 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 		return nil, err
 	}
 
+	// the min deposit override must only contain denoms accepted by the governance module
+	if len(msg.Params.MinDeposit) > 0 {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.validateDepositDenom(params, msg.Params.MinDeposit); err != nil {
+			return nil, err
+		}
+	}
+
 	// note: we don't need to validate the message URL here, as it is gov gated
 	// a chain may want to configure proposal messages before having an upgrade
 	// adding new messages.
@@ -357,6 +379,19 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 	return &v1.MsgUpdateMessageParamsResponse{}, nil
 }
 
+// UpdateConstitution implements the v1.MsgServer method
+func (k msgServer) UpdateConstitution(ctx context.Context, msg *v1.MsgUpdateConstitution) (*v1.MsgUpdateConstitutionResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Constitution.Set(ctx, msg.Constitution); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateConstitutionResponse{}, nil
+}
+
 // SudoExec implements the v1.MsgServer method
 func (k msgServer) SudoExec(ctx context.Context, msg *v1.MsgSudoExec) (*v1.MsgSudoExecResponse, error) {
 	if msg == nil || msg.Msg == nil {
@@ -2137,6 +2137,53 @@ func (suite *KeeperTestSuite) TestMsgUpdateMessageParams() {
 			},
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "invalid min deposit",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:        "0.334",
+					YesQuorum:     "0.5",
+					Threshold:     "0.5",
+					VetoThreshold: "0.334",
+					MinDeposit:    sdk.Coins{{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}},
+				},
+			},
+			expErrMsg: "invalid minimum deposit",
+		},
+		{
+			name: "min deposit with denom not accepted by gov",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:        "0.334",
+					YesQuorum:     "0.5",
+					Threshold:     "0.5",
+					VetoThreshold: "0.334",
+					MinDeposit:    sdk.NewCoins(sdk.NewInt64Coin("foo", 100)),
+				},
+			},
+			expErrMsg: "gov accepts only the following denom(s)",
+		},
+		{
+			name: "valid with min deposit",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				MsgUrl:    sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  func() *time.Duration { d := time.Hour; return &d }(),
+					Quorum:        "0.334",
+					YesQuorum:     "0",
+					Threshold:     "0.5",
+					VetoThreshold: "0.334",
+					MinDeposit:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)),
+				},
+			},
+		},
 		{
 			name: "valid",
 			input: &v1.MsgUpdateMessageParams{
@@ -2320,3 +2367,50 @@ func (suite *KeeperTestSuite) TestMsgSudoExec() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateConstitution() {
+	testCases := []struct {
+		name      string
+		input     *v1.MsgUpdateConstitution
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &v1.MsgUpdateConstitution{
+				Authority:    "invalid",
+				Constitution: "new constitution",
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "valid",
+			input: &v1.MsgUpdateConstitution{
+				Authority:    suite.govKeeper.GetAuthority(),
+				Constitution: "new constitution",
+			},
+		},
+		{
+			name: "valid empty constitution",
+			input: &v1.MsgUpdateConstitution{
+				Authority: suite.govKeeper.GetAuthority(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateConstitution(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			constitution, err := suite.govKeeper.Constitution.Get(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.input.Constitution, constitution)
+		})
+	}
+}
@@ -261,6 +261,12 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 		}
 	}
 
+	for _, depID := range proposal.DependsOn {
+		if err := k.ProposalDependents.Remove(ctx, collections.Join(depID, proposalID)); err != nil {
+			return err
+		}
+	}
+
 	return k.Proposals.Remove(ctx, proposalID)
 }
 
@@ -283,7 +289,7 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 
 		if len(proposal.Messages) > 0 {
 			// check if any of the message has message based params
-			customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
+			customMessageParams, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
 			if err == nil {
 				votingPeriod = customMessageParams.VotingPeriod
 			} else if !errors.Is(err, collections.ErrNotFound) {
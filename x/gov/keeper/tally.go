@@ -79,7 +79,7 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 
 	if len(proposal.Messages) > 0 {
 		// check if any of the message has message based params
-		customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
 		if err != nil && !errors.Is(err, collections.ErrNotFound) {
 			return false, false, tallyResults, err
 		} else if err == nil {
@@ -210,6 +210,30 @@ func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded
 	return true, false, tallyResults, nil
 }
 
+// proposalQuorum returns the quorum required for a proposal to be valid.
+// Optimistic proposals do not require a quorum.
+func (k Keeper) proposalQuorum(ctx context.Context, proposal v1.Proposal, params v1.Params) (math.LegacyDec, error) {
+	quorumStr := params.Quorum
+	switch proposal.ProposalType {
+	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
+		return math.LegacyZeroDec(), nil
+	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
+		quorumStr = params.ExpeditedQuorum
+	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
+	default:
+		if len(proposal.Messages) > 0 {
+			customMessageParams, err := k.MessageBasedParams.Get(ctx, proposal.Messages[0].TypeUrl)
+			if err != nil && !errors.Is(err, collections.ErrNotFound) {
+				return math.LegacyDec{}, err
+			} else if err == nil {
+				quorumStr = customMessageParams.GetQuorum()
+			}
+		}
+	}
+
+	return math.LegacyNewDecFromStr(quorumStr)
+}
+
 // getCurrentValidators fetches all the bonded validators, insert them into currValidators
 func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.ValidatorGovInfo, error) {
 	currValidators := make(map[string]v1.ValidatorGovInfo)
@@ -82,6 +82,10 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	if err != nil {
 		return err
 	}
+	err = k.VoterProposals.Set(ctx, collections.Join(voterAddr, proposalID))
+	if err != nil {
+		return err
+	}
 
 	// called after a vote on a proposal is cast
 	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
@@ -0,0 +1,33 @@
+package v7
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The
+// migration includes:
+//
+// Population of the depositor and voter proposal indexes from the deposits
+// and votes currently in state.
+func MigrateStore(
+	ctx context.Context,
+	deposits collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Deposit],
+	votes collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Vote],
+	depositorProposals, voterProposals collections.KeySet[collections.Pair[sdk.AccAddress, uint64]],
+) error {
+	err := deposits.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.AccAddress], _ v1.Deposit) (bool, error) {
+		return false, depositorProposals.Set(ctx, collections.Join(key.K2(), key.K1()))
+	})
+	if err != nil {
+		return err
+	}
+
+	return votes.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.AccAddress], _ v1.Vote) (bool, error) {
+		return false, voterProposals.Set(ctx, collections.Join(key.K2(), key.K1()))
+	})
+}
@@ -0,0 +1,59 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/gov"
+	v7 "cosmossdk.io/x/gov/migrations/v7"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, gov.AppModule{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(govKey)
+	sb := collections.NewSchemaBuilder(storeService)
+	deposits := collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)) //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+	votes := collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc))             //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+	depositorProposals := collections.NewKeySet(sb, types.DepositorProposalsKeyPrefix, "depositor_proposals", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key))
+	voterProposals := collections.NewKeySet(sb, types.VoterProposalsKeyPrefix, "voter_proposals", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	require.NoError(t, deposits.Set(ctx, collections.Join(uint64(1), addr1), v1.Deposit{ProposalId: 1}))
+	require.NoError(t, deposits.Set(ctx, collections.Join(uint64(2), addr2), v1.Deposit{ProposalId: 2}))
+	require.NoError(t, votes.Set(ctx, collections.Join(uint64(1), addr2), v1.Vote{ProposalId: 1}))
+
+	// Run migrations.
+	require.NoError(t, v7.MigrateStore(ctx, deposits, votes, depositorProposals, voterProposals))
+
+	for _, tc := range []struct {
+		index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+		key   collections.Pair[sdk.AccAddress, uint64]
+		has   bool
+	}{
+		{depositorProposals, collections.Join(addr1, uint64(1)), true},
+		{depositorProposals, collections.Join(addr2, uint64(2)), true},
+		{depositorProposals, collections.Join(addr2, uint64(1)), false},
+		{voterProposals, collections.Join(addr2, uint64(1)), true},
+		{voterProposals, collections.Join(addr1, uint64(1)), false},
+	} {
+		has, err := tc.index.Has(ctx, tc.key)
+		require.NoError(t, err)
+		require.Equal(t, tc.has, has)
+	}
+}
@@ -28,4 +28,5 @@ var (
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
 	ErrTooManyVoteOptions      = errors.Register(ModuleName, 26, "too many weighted vote options")
+	ErrInvalidDependencies     = errors.Register(ModuleName, 27, "invalid proposal dependencies")
 )
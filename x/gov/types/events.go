@@ -27,6 +27,7 @@ const (
 	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
 	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
 	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueProposalAwaitingDeps       = "proposal_awaiting_deps"       // passed, execution deferred until dependencies pass
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
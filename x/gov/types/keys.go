@@ -26,6 +26,10 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	DepositorProposalsKeyPrefix  = collections.NewPrefix(52) // DepositorProposalsKeyPrefix indexes proposals by depositor.
+	VoterProposalsKeyPrefix      = collections.NewPrefix(53) // VoterProposalsKeyPrefix indexes proposals by voter.
+	ProposalDependentsKeyPrefix  = collections.NewPrefix(54) // ProposalDependentsKeyPrefix indexes proposals by the proposals they depend on.
+	AwaitingDependenciesPrefix   = collections.NewPrefix(55) // AwaitingDependenciesPrefix stores the passed proposals waiting on their dependencies.
 )
 
 // Reserved kvstore keys
@@ -98,6 +98,11 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// verify proposal dependencies
+	errGroup.Go(func() error {
+		return validateGenesisDependencies(data.Proposals)
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -106,6 +111,52 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	return errGroup.Wait()
 }
 
+// validateGenesisDependencies checks the dependencies of each proposal and
+// makes sure the dependency graph of the proposals is acyclic.
+func validateGenesisDependencies(proposals []*Proposal) error {
+	dependsOn := make(map[uint64][]uint64, len(proposals))
+	for _, p := range proposals {
+		if err := ValidateProposalDependencies(p.Id, p.DependsOn); err != nil {
+			return err
+		}
+		dependsOn[p.Id] = p.DependsOn
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[uint64]int, len(proposals))
+
+	var visit func(id uint64) error
+	visit = func(id uint64) error {
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("dependency cycle detected on proposal %d", id)
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+		for _, depID := range dependsOn[id] {
+			if err := visit(depID); err != nil {
+				return err
+			}
+		}
+		state[id] = visited
+
+		return nil
+	}
+
+	for _, p := range proposals {
+		if err := visit(p.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 var _ gogoprotoany.UnpackInterfacesMessage = GenesisState{}
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
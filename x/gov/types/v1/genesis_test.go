@@ -172,6 +172,43 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid proposal dependencies",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals,
+					&v1.Proposal{Id: 1},
+					&v1.Proposal{Id: 2, DependsOn: []uint64{1}},
+					&v1.Proposal{Id: 3, DependsOn: []uint64{1, 2}},
+				)
+
+				return state
+			},
+		},
+		{
+			name: "proposal depending on itself",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1, DependsOn: []uint64{1}})
+
+				return state
+			},
+			expErrMsg: "proposal 1 cannot depend on itself",
+		},
+		{
+			name: "proposal dependency cycle",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals,
+					&v1.Proposal{Id: 1, DependsOn: []uint64{3}},
+					&v1.Proposal{Id: 2, DependsOn: []uint64{1}},
+					&v1.Proposal{Id: 3, DependsOn: []uint64{2}},
+				)
+
+				return state
+			},
+			expErrMsg: "dependency cycle detected",
+		},
 	}
 
 	for _, tc := range testCases {
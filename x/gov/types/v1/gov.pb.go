@@ -6,6 +6,7 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -154,6 +155,10 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_AWAITING_DEPENDENCIES defines a proposal status of a
+	// proposal that has passed but whose execution is deferred until all the
+	// proposals it depends on have passed.
+	ProposalStatus_PROPOSAL_STATUS_AWAITING_DEPENDENCIES ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,15 +168,17 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_AWAITING_DEPENDENCIES",
 }
 
 var ProposalStatus_value = map[string]int32{
-	"PROPOSAL_STATUS_UNSPECIFIED":    0,
-	"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
-	"PROPOSAL_STATUS_VOTING_PERIOD":  2,
-	"PROPOSAL_STATUS_PASSED":         3,
-	"PROPOSAL_STATUS_REJECTED":       4,
-	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_UNSPECIFIED":           0,
+	"PROPOSAL_STATUS_DEPOSIT_PERIOD":        1,
+	"PROPOSAL_STATUS_VOTING_PERIOD":         2,
+	"PROPOSAL_STATUS_PASSED":                3,
+	"PROPOSAL_STATUS_REJECTED":              4,
+	"PROPOSAL_STATUS_FAILED":                5,
+	"PROPOSAL_STATUS_AWAITING_DEPENDENCIES": 6,
 }
 
 func (x ProposalStatus) String() string {
@@ -342,6 +349,15 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// depends_on defines the ids of the proposals that must pass before this
+	// proposal's messages are executed.
+	DependsOn []uint64 `protobuf:"varint,17,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// final_total_bonded is the total bonded stake when the proposal was tallied
+	// at the end of its voting period, it is used to compute its turnout.
+	FinalTotalBonded string `protobuf:"bytes,18,opt,name=final_total_bonded,json=finalTotalBonded,proto3" json:"final_total_bonded,omitempty"`
+	// final_quorum is the quorum the proposal was tallied against at the end of
+	// its voting period.
+	FinalQuorum string `protobuf:"bytes,19,opt,name=final_quorum,json=finalQuorum,proto3" json:"final_quorum,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +506,27 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetDependsOn() []uint64 {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *Proposal) GetFinalTotalBonded() string {
+	if m != nil {
+		return m.FinalTotalBonded
+	}
+	return ""
+}
+
+func (m *Proposal) GetFinalQuorum() string {
+	if m != nil {
+		return m.FinalQuorum
+	}
+	return ""
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -956,15 +993,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +1012,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1209,6 +1246,9 @@ type MessageBasedParams struct {
 	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
 	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Minimum deposit for a proposal to enter voting period.
+	// If empty, the min_deposit of the governance params is used.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
 }
 
 func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
@@ -1279,6 +1319,13 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *MessageBasedParams) GetMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1299,133 +1346,141 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x14, 0x25, 0x3e, 0x91, 0xd4, 0x6a, 0x24, 0x59, 0x6b, 0x29, 0xfa, 0x63, 0xa1,
+	0x0d, 0x14, 0x27, 0x22, 0xa5, 0xa4, 0x6a, 0x53, 0x37, 0x06, 0x4a, 0x8a, 0x6b, 0x7b, 0x0d, 0x49,
+	0x64, 0x97, 0xb4, 0x6c, 0xb7, 0x28, 0x16, 0x2b, 0xee, 0x98, 0xda, 0x84, 0xbb, 0xc3, 0xee, 0x0e,
+	0xf5, 0xa7, 0xe8, 0xad, 0x5f, 0x20, 0xc7, 0x9e, 0x8a, 0x9e, 0xda, 0xa2, 0xa7, 0x1e, 0x8c, 0x7e,
+	0x85, 0x06, 0x3d, 0x05, 0x3e, 0x15, 0x01, 0xea, 0x14, 0xf6, 0xa1, 0x40, 0xbe, 0x41, 0x8b, 0x1e,
+	0x8a, 0x99, 0x9d, 0xe5, 0x2e, 0xff, 0xc8, 0xa2, 0x82, 0x5e, 0x6c, 0x6a, 0xe6, 0xf7, 0xfb, 0xcd,
+	0x9b, 0xf7, 0xde, 0xbc, 0xf7, 0x48, 0x58, 0x6c, 0x12, 0xdf, 0x21, 0x7e, 0xb1, 0x45, 0x4e, 0x8b,
+	0xa7, 0x3b, 0xec, 0xbf, 0x42, 0xc7, 0x23, 0x94, 0xa0, 0x5c, 0xb0, 0x51, 0x60, 0x2b, 0xa7, 0x3b,
+	0x4b, 0xab, 0x02, 0x77, 0x6c, 0xfa, 0xb8, 0x78, 0xba, 0x73, 0x8c, 0xa9, 0xb9, 0x53, 0x6c, 0x12,
+	0xdb, 0x0d, 0xe0, 0x4b, 0xf3, 0x2d, 0xd2, 0x22, 0xfc, 0x63, 0x91, 0x7d, 0x12, 0xab, 0x6b, 0x2d,
+	0x42, 0x5a, 0x6d, 0x5c, 0xe4, 0x7f, 0x1d, 0x77, 0x9f, 0x17, 0xa9, 0xed, 0x60, 0x9f, 0x9a, 0x4e,
+	0x47, 0x00, 0x6e, 0x0d, 0x02, 0x4c, 0xf7, 0x42, 0x6c, 0xad, 0x0e, 0x6e, 0x59, 0x5d, 0xcf, 0xa4,
+	0x36, 0x09, 0x4f, 0xbc, 0x15, 0x58, 0x64, 0x04, 0x87, 0x0a, 0x6b, 0x83, 0xad, 0x59, 0xd3, 0xb1,
+	0x5d, 0x52, 0xe4, 0xff, 0x06, 0x4b, 0x1b, 0x04, 0xd0, 0x13, 0x6c, 0xb7, 0x4e, 0x28, 0xb6, 0x8e,
+	0x08, 0xc5, 0xd5, 0x0e, 0x53, 0x42, 0x3b, 0x90, 0x26, 0xfc, 0x93, 0x22, 0xad, 0x4b, 0x9b, 0xf9,
+	0x0f, 0x6f, 0x15, 0xfa, 0x6e, 0x5d, 0x88, 0xa0, 0xba, 0x00, 0xa2, 0x77, 0x21, 0x7d, 0xc6, 0x85,
+	0x94, 0xc4, 0xba, 0xb4, 0x99, 0x29, 0xe7, 0x5f, 0xbe, 0xd8, 0x02, 0xc1, 0xaa, 0xe0, 0xa6, 0x2e,
+	0x76, 0x37, 0x7e, 0x27, 0xc1, 0x64, 0x05, 0x77, 0x88, 0x6f, 0x53, 0xb4, 0x06, 0xd3, 0x1d, 0x8f,
+	0x74, 0x88, 0x6f, 0xb6, 0x0d, 0xdb, 0xe2, 0x67, 0xa5, 0x74, 0x08, 0x97, 0x34, 0x0b, 0x7d, 0x1f,
+	0x32, 0x56, 0x80, 0x25, 0x9e, 0xd0, 0x55, 0x5e, 0xbe, 0xd8, 0x9a, 0x17, 0xba, 0x25, 0xcb, 0xf2,
+	0xb0, 0xef, 0xd7, 0xa9, 0x67, 0xbb, 0x2d, 0x3d, 0x82, 0xa2, 0x4f, 0x20, 0x6d, 0x3a, 0xa4, 0xeb,
+	0x52, 0x25, 0xb9, 0x9e, 0xdc, 0x9c, 0x8e, 0xec, 0x67, 0x61, 0x2a, 0x88, 0x30, 0x15, 0xf6, 0x88,
+	0xed, 0x96, 0x33, 0x5f, 0xbc, 0x5a, 0xbb, 0xf1, 0xc7, 0x7f, 0xfd, 0xf9, 0x8e, 0xa4, 0x0b, 0xce,
+	0xc6, 0xaf, 0x33, 0x30, 0x55, 0x13, 0x46, 0xa0, 0x3c, 0x24, 0x7a, 0xa6, 0x25, 0x6c, 0x0b, 0x6d,
+	0xc3, 0x94, 0x83, 0x7d, 0xdf, 0x6c, 0x61, 0x5f, 0x49, 0x70, 0xf1, 0xf9, 0x42, 0x10, 0x91, 0x42,
+	0x18, 0x91, 0x42, 0xc9, 0xbd, 0xd0, 0x7b, 0x28, 0xb4, 0x0b, 0x69, 0x9f, 0x9a, 0xb4, 0xeb, 0x2b,
+	0x49, 0xee, 0xcc, 0x95, 0x01, 0x67, 0x86, 0x47, 0xd5, 0x39, 0x48, 0x17, 0x60, 0xf4, 0x10, 0xd0,
+	0x73, 0xdb, 0x35, 0xdb, 0x06, 0x35, 0xdb, 0xed, 0x0b, 0xc3, 0xc3, 0x7e, 0xb7, 0x4d, 0x95, 0xd4,
+	0xba, 0xb4, 0x39, 0xfd, 0xe1, 0xd2, 0x80, 0x44, 0x83, 0x41, 0x74, 0x8e, 0xd0, 0x65, 0xce, 0x8a,
+	0xad, 0xa0, 0x12, 0x4c, 0xfb, 0xdd, 0x63, 0xc7, 0xa6, 0x06, 0x4b, 0x33, 0x65, 0x42, 0x48, 0x0c,
+	0x5a, 0xdd, 0x08, 0x73, 0xb0, 0x9c, 0xfa, 0xfc, 0xeb, 0x35, 0x49, 0x87, 0x80, 0xc4, 0x96, 0xd1,
+	0x23, 0x90, 0x85, 0x77, 0x0d, 0xec, 0x5a, 0x81, 0x4e, 0x7a, 0x4c, 0x9d, 0xbc, 0x60, 0xaa, 0xae,
+	0xc5, 0xb5, 0x34, 0xc8, 0x51, 0x42, 0xcd, 0xb6, 0x21, 0xd6, 0x95, 0xc9, 0x6b, 0xc4, 0x28, 0xcb,
+	0xa9, 0x61, 0x02, 0xed, 0xc3, 0xec, 0x29, 0xa1, 0xb6, 0xdb, 0x32, 0x7c, 0x6a, 0x7a, 0xe2, 0x7e,
+	0x53, 0x63, 0xda, 0x35, 0x13, 0x50, 0xeb, 0x8c, 0xc9, 0x0d, 0x7b, 0x08, 0x62, 0x29, 0xba, 0x63,
+	0x66, 0x4c, 0xad, 0x5c, 0x40, 0x0c, 0xaf, 0xb8, 0xc4, 0x92, 0x84, 0x9a, 0x96, 0x49, 0x4d, 0x05,
+	0x58, 0xda, 0xea, 0xbd, 0xbf, 0xd1, 0x7b, 0x30, 0x41, 0x6d, 0xda, 0xc6, 0xca, 0x34, 0xcf, 0xe7,
+	0xb9, 0xaf, 0x5e, 0x6c, 0xcd, 0x04, 0x37, 0xdf, 0xf2, 0xad, 0xcf, 0xd6, 0xb7, 0x0b, 0xdf, 0xfb,
+	0x81, 0x1e, 0x20, 0xd0, 0x16, 0x4c, 0xfa, 0x5d, 0xc7, 0x31, 0xbd, 0x0b, 0x25, 0x7b, 0x39, 0x38,
+	0xc4, 0xa0, 0x07, 0x30, 0x15, 0xbc, 0x1d, 0xec, 0x29, 0x39, 0x8e, 0x7f, 0xff, 0xb2, 0xc7, 0x32,
+	0x4a, 0xa7, 0x47, 0x46, 0x1f, 0x41, 0x06, 0x9f, 0x77, 0xb0, 0x65, 0x53, 0x6c, 0x29, 0xf9, 0x75,
+	0x69, 0x73, 0xaa, 0xbc, 0x30, 0xc4, 0xd8, 0xdd, 0x56, 0x24, 0x3d, 0xc2, 0xa1, 0x8f, 0x21, 0xf7,
+	0xdc, 0xb4, 0xdb, 0xd8, 0x32, 0x3c, 0x6c, 0xfa, 0xc4, 0x55, 0x66, 0x2e, 0x31, 0x79, 0x77, 0x5b,
+	0xcf, 0x06, 0x48, 0x9d, 0x03, 0x91, 0x0e, 0xb9, 0x5e, 0x19, 0xa0, 0x17, 0x1d, 0xac, 0xc8, 0xfc,
+	0x9d, 0x2c, 0x5f, 0xf2, 0x4e, 0x1a, 0x17, 0x1d, 0x5c, 0x96, 0xbf, 0x7a, 0xb1, 0x95, 0x3d, 0x67,
+	0x75, 0x79, 0xfd, 0x74, 0xbb, 0xf0, 0x61, 0x61, 0x5b, 0xcf, 0x76, 0x62, 0xfb, 0xa8, 0x08, 0x60,
+	0xe1, 0x0e, 0x76, 0x2d, 0xdf, 0x20, 0xae, 0x32, 0xbb, 0x9e, 0xdc, 0x4c, 0xf5, 0x71, 0x76, 0x0a,
+	0xdb, 0x85, 0x6d, 0x3d, 0x23, 0x30, 0x55, 0x17, 0xed, 0xf7, 0x9e, 0x1b, 0xcf, 0xcd, 0x63, 0xe2,
+	0x5a, 0xd8, 0x52, 0x10, 0xbf, 0xc3, 0x6a, 0xac, 0x96, 0x69, 0x2e, 0x1d, 0x92, 0x11, 0x4f, 0x8e,
+	0x11, 0xcb, 0x9c, 0x87, 0x4a, 0x90, 0x0d, 0xd4, 0x7e, 0xd1, 0x25, 0x5e, 0xd7, 0x51, 0xe6, 0x86,
+	0x74, 0x2a, 0xb8, 0x39, 0xa4, 0x33, 0xcd, 0x39, 0x3f, 0xe1, 0x94, 0x8d, 0xbf, 0x49, 0x30, 0x17,
+	0x5e, 0x39, 0xaa, 0xb7, 0x3e, 0x5a, 0x01, 0x08, 0x4a, 0xae, 0x41, 0x5c, 0xcc, 0x0b, 0x53, 0x46,
+	0xcf, 0x04, 0x2b, 0x55, 0x17, 0xc7, 0xb6, 0xe9, 0x19, 0x51, 0x12, 0xf1, 0xed, 0xc6, 0x19, 0x41,
+	0xb7, 0x21, 0x1b, 0x6e, 0x9f, 0x78, 0x18, 0xf3, 0x92, 0x94, 0xd1, 0xa7, 0x05, 0x80, 0x2d, 0xb1,
+	0xaa, 0x2c, 0x20, 0xcf, 0x49, 0xd7, 0xe3, 0x15, 0x27, 0xa3, 0x0b, 0xd1, 0xfb, 0xa4, 0xeb, 0xc5,
+	0x00, 0x7e, 0xc7, 0x74, 0x94, 0x89, 0x38, 0xa0, 0xde, 0x31, 0x9d, 0xbb, 0xf2, 0xcb, 0x81, 0xe0,
+	0x6c, 0xfc, 0x37, 0x09, 0xd3, 0xf1, 0x92, 0xb4, 0x05, 0x99, 0x0b, 0xec, 0x1b, 0x4d, 0x5e, 0xa3,
+	0xf9, 0x1d, 0xca, 0x72, 0xbf, 0x93, 0x15, 0x49, 0x9f, 0xba, 0xc0, 0xfe, 0x1e, 0x43, 0xa0, 0x5d,
+	0xc8, 0x99, 0xc7, 0x3e, 0x35, 0x6d, 0x57, 0x50, 0x12, 0x97, 0x50, 0xb2, 0x02, 0x16, 0xd0, 0xde,
+	0x87, 0x29, 0x97, 0x08, 0x46, 0xf2, 0x12, 0xc6, 0xa4, 0x4b, 0x02, 0xf0, 0x3d, 0x40, 0x2e, 0x31,
+	0xce, 0x6c, 0x7a, 0x62, 0x9c, 0x62, 0x1a, 0xd2, 0x52, 0x97, 0xd0, 0x66, 0x5c, 0xf2, 0xc4, 0xa6,
+	0x27, 0x47, 0x98, 0x0a, 0xfa, 0xc7, 0x20, 0x47, 0x61, 0x11, 0xe4, 0x89, 0xa1, 0x4e, 0xa8, 0xb9,
+	0x54, 0xcf, 0xf7, 0x82, 0x35, 0xc8, 0xa4, 0x67, 0xe1, 0xb1, 0xe9, 0xb7, 0x31, 0x1b, 0x67, 0xe2,
+	0xcc, 0x4f, 0x00, 0xc5, 0x83, 0x29, 0xb8, 0x93, 0x23, 0xb9, 0x72, 0x2c, 0xc4, 0x01, 0xfb, 0x2e,
+	0xcc, 0xc6, 0xe2, 0x2c, 0xc8, 0x53, 0x23, 0xc9, 0x33, 0x51, 0xf4, 0x03, 0xee, 0x16, 0x00, 0x8b,
+	0xbd, 0x20, 0x65, 0x46, 0x92, 0x32, 0x0c, 0xc1, 0xe1, 0x1b, 0x7f, 0x91, 0x20, 0xc5, 0x72, 0xf8,
+	0xea, 0x8e, 0x5f, 0x80, 0x89, 0x53, 0x42, 0xf1, 0xd5, 0xdd, 0x3e, 0x80, 0xa1, 0x1f, 0xc1, 0x64,
+	0x60, 0x9b, 0xaf, 0xa4, 0x78, 0x1b, 0xb9, 0x3d, 0x50, 0x35, 0x86, 0xa7, 0x1b, 0x3d, 0x64, 0xf4,
+	0x95, 0xe9, 0x89, 0xfe, 0x32, 0xfd, 0x28, 0x35, 0x95, 0x94, 0x53, 0x1b, 0xff, 0x90, 0x20, 0x27,
+	0x9a, 0x4d, 0xcd, 0xf4, 0x4c, 0xc7, 0x47, 0xcf, 0x60, 0xda, 0xb1, 0xdd, 0x5e, 0xef, 0x92, 0xae,
+	0xea, 0x5d, 0x2b, 0xac, 0x77, 0x7d, 0xf3, 0x6a, 0x6d, 0x21, 0xc6, 0xfa, 0x80, 0x38, 0x36, 0xc5,
+	0x4e, 0x87, 0x5e, 0xe8, 0xe0, 0xd8, 0x6e, 0xd8, 0xcd, 0x1c, 0x40, 0x8e, 0x79, 0x1e, 0x82, 0x8c,
+	0x0e, 0xf6, 0x6c, 0x62, 0x71, 0x47, 0xb0, 0x13, 0x06, 0x5b, 0x50, 0x45, 0x8c, 0x7d, 0xe5, 0xef,
+	0x7c, 0xf3, 0x6a, 0xed, 0x9d, 0x61, 0x62, 0x74, 0xc8, 0x6f, 0x58, 0x87, 0x92, 0x1d, 0xf3, 0x3c,
+	0xbc, 0x09, 0xdf, 0xbf, 0x9b, 0x50, 0xa4, 0x8d, 0xa7, 0x90, 0x3d, 0xe2, 0x9d, 0x4b, 0xdc, 0xae,
+	0x02, 0xa2, 0x93, 0x85, 0xa7, 0x4b, 0x57, 0x9d, 0x9e, 0xe2, 0xea, 0xd9, 0x80, 0x15, 0x53, 0xfe,
+	0xad, 0x24, 0x5e, 0xbc, 0x50, 0x7e, 0x17, 0xd2, 0xa2, 0x16, 0x4a, 0xa3, 0xe7, 0xc3, 0x60, 0x17,
+	0x7d, 0x00, 0x19, 0x96, 0xcc, 0xfe, 0x09, 0x69, 0x5b, 0x97, 0x8c, 0x92, 0x11, 0x00, 0xed, 0x42,
+	0x9e, 0x3f, 0xd6, 0x88, 0x92, 0x1c, 0x49, 0xc9, 0x31, 0x54, 0x23, 0x04, 0x71, 0x03, 0xff, 0x9a,
+	0x83, 0xb4, 0xb0, 0x4d, 0xbd, 0x66, 0x4c, 0x63, 0xf3, 0x48, 0x3c, 0x7e, 0x07, 0xdf, 0x2e, 0x7e,
+	0xa9, 0xd1, 0xf1, 0x19, 0x8e, 0x45, 0xf2, 0x5b, 0xc4, 0x22, 0xe6, 0xf7, 0xd4, 0xf8, 0x7e, 0x9f,
+	0xb8, 0xbe, 0xdf, 0xd3, 0x63, 0xf8, 0x1d, 0x69, 0x70, 0x8b, 0x39, 0xda, 0x76, 0x6d, 0x6a, 0x47,
+	0x03, 0xa0, 0xc1, 0xcd, 0x57, 0x26, 0x47, 0x2a, 0xdc, 0x74, 0x6c, 0x57, 0x0b, 0xf0, 0xc2, 0x3d,
+	0x3a, 0x43, 0xa3, 0xc7, 0xb0, 0xd0, 0xab, 0x24, 0x4d, 0xd3, 0x6d, 0xe2, 0xb6, 0x90, 0x09, 0x2a,
+	0xd8, 0xed, 0xa1, 0x56, 0x3b, 0x34, 0x84, 0xcc, 0x85, 0xfc, 0x3d, 0x4e, 0x0f, 0x64, 0x7f, 0x0e,
+	0xf3, 0x83, 0xb2, 0x16, 0xf6, 0xc3, 0x12, 0x37, 0xfe, 0x3c, 0xb5, 0xbb, 0xad, 0xa3, 0x7e, 0xfd,
+	0x0a, 0xf6, 0x29, 0xfa, 0x14, 0x16, 0x7b, 0x13, 0x93, 0xd1, 0x1f, 0x5d, 0xb8, 0x2a, 0xba, 0x8b,
+	0x2c, 0xba, 0xa3, 0x0e, 0x5a, 0xe8, 0x49, 0x1e, 0xc5, 0x23, 0xaf, 0xc3, 0x5c, 0x74, 0x56, 0x14,
+	0xa8, 0xe9, 0x71, 0xfd, 0x83, 0x7a, 0xec, 0x28, 0x80, 0x4f, 0x21, 0x3a, 0xcc, 0x88, 0xbf, 0x99,
+	0xec, 0x35, 0xde, 0x4c, 0x64, 0xd6, 0x41, 0xf4, 0x78, 0xee, 0x81, 0x7c, 0xdc, 0xf5, 0x5c, 0xe6,
+	0x14, 0x1c, 0x4e, 0x4d, 0x39, 0x3e, 0x7a, 0x8e, 0x1c, 0x7a, 0xf3, 0x0c, 0xcc, 0x6a, 0x7a, 0x30,
+	0x2d, 0xa1, 0x23, 0x58, 0xe1, 0xf4, 0x5e, 0xf0, 0x7a, 0xaf, 0xd0, 0xc3, 0x4c, 0x52, 0xc9, 0x5f,
+	0xae, 0xb5, 0xc4, 0x98, 0xe1, 0xa8, 0x15, 0xbe, 0xc1, 0x80, 0x86, 0x7e, 0x08, 0xf9, 0xc8, 0x2c,
+	0x96, 0xcc, 0xca, 0xcc, 0xe5, 0x42, 0xd9, 0xd0, 0x28, 0x36, 0x16, 0xa0, 0x03, 0x98, 0x8d, 0x79,
+	0x48, 0x64, 0xa7, 0x3c, 0xae, 0xf7, 0x67, 0xa2, 0xc2, 0x12, 0x64, 0xe6, 0xcf, 0x60, 0x69, 0x30,
+	0x33, 0x59, 0xb5, 0x11, 0xd9, 0x33, 0x7b, 0xc5, 0x80, 0x19, 0xcc, 0xc8, 0x8b, 0xfd, 0x29, 0x79,
+	0x60, 0x9e, 0x8b, 0x5c, 0xe9, 0xc0, 0x1a, 0x6b, 0x8a, 0x8e, 0xed, 0x53, 0xbb, 0x69, 0x98, 0x5d,
+	0x7a, 0x42, 0x3c, 0xfb, 0x97, 0xd8, 0x32, 0xcc, 0x20, 0xcb, 0xb1, 0xaf, 0xa0, 0xf5, 0xe4, 0x66,
+	0xa6, 0xbc, 0xf9, 0x96, 0x17, 0xd0, 0x7f, 0xd6, 0x4a, 0x24, 0x58, 0xea, 0xe9, 0x95, 0x42, 0x39,
+	0x74, 0x0c, 0x31, 0x80, 0xe1, 0xe1, 0x4f, 0x71, 0xb3, 0x3f, 0x4f, 0xe7, 0xc6, 0xba, 0xd1, 0x72,
+	0x24, 0xa2, 0x0b, 0x8d, 0x28, 0x5b, 0xef, 0x01, 0xb0, 0x29, 0x53, 0x64, 0xd3, 0xfc, 0x58, 0x82,
+	0x6c, 0x2e, 0x15, 0x39, 0xa5, 0x81, 0x1c, 0x25, 0xbb, 0x10, 0x59, 0x18, 0x6b, 0x90, 0x9f, 0xe9,
+	0xf1, 0x84, 0xd4, 0x7d, 0xb8, 0xd9, 0x0b, 0x1e, 0x3e, 0xc7, 0xcd, 0x2e, 0x9f, 0xbb, 0x5a, 0xa6,
+	0xaf, 0xdc, 0x5c, 0x97, 0x06, 0xbe, 0x9a, 0x04, 0x76, 0xf4, 0xca, 0x90, 0x1a, 0xc2, 0x1f, 0x98,
+	0xfe, 0xdd, 0xb9, 0x97, 0xc3, 0x69, 0xb7, 0xf1, 0xfb, 0x24, 0xa0, 0x83, 0xe0, 0xd7, 0x86, 0xb2,
+	0xe9, 0x63, 0xeb, 0xff, 0xd9, 0xcb, 0x63, 0xfd, 0x23, 0xf1, 0xd6, 0xfe, 0xb1, 0x35, 0xc2, 0xd7,
+	0x43, 0x0d, 0x24, 0xf2, 0x6d, 0x5f, 0xbb, 0x49, 0x5e, 0xbf, 0xdd, 0xa4, 0xc6, 0x69, 0x37, 0xbf,
+	0xea, 0xef, 0xeb, 0x13, 0x57, 0xd5, 0xa8, 0x1f, 0xb3, 0x1a, 0xf5, 0xa7, 0xaf, 0xd7, 0x36, 0x5b,
+	0x36, 0x3d, 0xe9, 0x1e, 0x17, 0x9a, 0xc4, 0x11, 0x3f, 0xa0, 0x15, 0x23, 0x9f, 0x17, 0xd9, 0x57,
+	0x54, 0x9f, 0x13, 0xfc, 0xa1, 0xc0, 0xc7, 0xc6, 0x81, 0xe1, 0x6f, 0x41, 0x77, 0xfe, 0x20, 0x41,
+	0x36, 0xfe, 0x2d, 0x16, 0xad, 0xc0, 0xad, 0x9a, 0x5e, 0xad, 0x55, 0xeb, 0xa5, 0x7d, 0xa3, 0xf1,
+	0xac, 0xa6, 0x1a, 0x8f, 0x0f, 0xeb, 0x35, 0x75, 0x4f, 0xbb, 0xaf, 0xa9, 0x15, 0xf9, 0x06, 0x5a,
+	0x82, 0x9b, 0xfd, 0xdb, 0xf5, 0x46, 0xe9, 0xb0, 0x52, 0xd2, 0x2b, 0xb2, 0x84, 0x6e, 0xc3, 0x4a,
+	0xff, 0xde, 0xc1, 0xe3, 0xfd, 0x86, 0x56, 0xdb, 0x57, 0x8d, 0xbd, 0x87, 0x55, 0x6d, 0x4f, 0x95,
+	0x13, 0xe8, 0x1d, 0x50, 0xfa, 0x21, 0xd5, 0x5a, 0x43, 0x3b, 0xd0, 0xea, 0x0d, 0x6d, 0x4f, 0x4e,
+	0xa2, 0x65, 0x58, 0xec, 0xdf, 0x55, 0x9f, 0xd6, 0xd4, 0x8a, 0xd6, 0x50, 0x2b, 0x72, 0xea, 0xce,
+	0x7f, 0x24, 0x80, 0xd8, 0xef, 0x81, 0xcb, 0xb0, 0x78, 0x54, 0x6d, 0x04, 0x02, 0xd5, 0xc3, 0x01,
+	0x2b, 0xe7, 0x60, 0x26, 0xbe, 0xf9, 0x4c, 0xad, 0xcb, 0xd2, 0xe0, 0x62, 0xf5, 0x50, 0x95, 0x25,
+	0xb4, 0x08, 0x73, 0xf1, 0xc5, 0x52, 0xb9, 0xde, 0x28, 0x69, 0x87, 0x72, 0x62, 0x10, 0xdd, 0x78,
+	0x52, 0x95, 0x13, 0x08, 0x41, 0x3e, 0xbe, 0x78, 0x58, 0x95, 0x93, 0x68, 0x01, 0x66, 0xfb, 0x80,
+	0x0f, 0x75, 0x55, 0x95, 0x93, 0xec, 0xa6, 0xfd, 0x50, 0xe3, 0x89, 0xd6, 0x78, 0x68, 0x1c, 0xa9,
+	0x8d, 0xaa, 0x9c, 0x42, 0xf3, 0x20, 0xc7, 0x77, 0xef, 0x57, 0x1f, 0xeb, 0xc3, 0xab, 0xf5, 0x5a,
+	0xe9, 0x40, 0x9e, 0x58, 0x4a, 0xc8, 0xd2, 0x9d, 0x7f, 0x4b, 0x90, 0xef, 0xff, 0x51, 0x0e, 0xad,
+	0xc1, 0x72, 0xcf, 0x59, 0xf5, 0x46, 0xa9, 0xf1, 0xb8, 0x3e, 0xe0, 0x84, 0x0d, 0x58, 0x1d, 0x04,
+	0x54, 0xd4, 0x5a, 0xb5, 0xae, 0x35, 0x8c, 0x9a, 0xaa, 0x6b, 0xd5, 0xc1, 0x90, 0x09, 0xcc, 0x51,
+	0xb5, 0xa1, 0x1d, 0x3e, 0x08, 0x21, 0x89, 0xbe, 0x88, 0x0b, 0x48, 0xad, 0x54, 0xaf, 0xab, 0x95,
+	0xe0, 0x92, 0x83, 0x7b, 0xba, 0xfa, 0x48, 0xdd, 0xe3, 0x11, 0x1b, 0xc5, 0xbc, 0x5f, 0xd2, 0xf6,
+	0xd5, 0x8a, 0x3c, 0x81, 0xde, 0x83, 0xef, 0x0e, 0xee, 0x95, 0x9e, 0x94, 0x34, 0x7e, 0x74, 0x45,
+	0xad, 0xa9, 0x87, 0x15, 0xf5, 0x70, 0x4f, 0x53, 0xeb, 0x72, 0xba, 0xbc, 0xfb, 0xc5, 0xeb, 0x55,
+	0xe9, 0xcb, 0xd7, 0xab, 0xd2, 0x3f, 0x5f, 0xaf, 0x4a, 0x9f, 0xbf, 0x59, 0xbd, 0xf1, 0xe5, 0x9b,
+	0xd5, 0x1b, 0x7f, 0x7f, 0xb3, 0x7a, 0xe3, 0xa7, 0xcb, 0xc1, 0x13, 0xf0, 0xad, 0xcf, 0x0a, 0x36,
+	0x29, 0xf2, 0xbc, 0x0e, 0x9e, 0x02, 0xfb, 0xd9, 0x3b, 0xcd, 0x8b, 0xc9, 0x47, 0xff, 0x1b, 0x00,
+	0x72, 0x70, 0x4e, 0x9f, 0x37, 0x17, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1532,6 +1587,44 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalQuorum) > 0 {
+		i -= len(m.FinalQuorum)
+		copy(dAtA[i:], m.FinalQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FinalQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.FinalTotalBonded) > 0 {
+		i -= len(m.FinalTotalBonded)
+		copy(dAtA[i:], m.FinalTotalBonded)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FinalTotalBonded)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DependsOn) > 0 {
+		dAtA2 := make([]byte, len(m.DependsOn)*10)
+		var j1 int
+		for _, num := range m.DependsOn {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1585,22 +1678,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1619,22 +1712,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1898,12 +1991,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1945,12 +2038,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2136,12 +2229,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2188,22 +2281,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2253,6 +2346,20 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -2275,12 +2382,12 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2406,6 +2513,21 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if len(m.DependsOn) > 0 {
+		l = 0
+		for _, e := range m.DependsOn {
+			l += sovGov(uint64(e))
+		}
+		n += 2 + sovGov(uint64(l)) + l
+	}
+	l = len(m.FinalTotalBonded)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.FinalQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2683,6 +2805,12 @@ func (m *MessageBasedParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	l = len(m.YesQuorum)
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
@@ -3446,6 +3574,146 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DependsOn = append(m.DependsOn, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DependsOn) == 0 {
+					m.DependsOn = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DependsOn = append(m.DependsOn, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTotalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalTotalBonded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalQuorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5416,6 +5684,40 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
@@ -330,5 +330,10 @@ func (p MessageBasedParams) ValidateBasic() error {
 		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
+	// an empty min deposit means the min deposit of the governance params is used
+	if !p.MinDeposit.Empty() && !p.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit: %s", p.MinDeposit)
+	}
+
 	return nil
 }
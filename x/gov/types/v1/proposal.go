@@ -21,6 +21,11 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+
+	StatusAwaitingDependencies = ProposalStatus_PROPOSAL_STATUS_AWAITING_DEPENDENCIES
+
+	// MaxProposalDependencies is the maximum number of proposals a proposal can depend on.
+	MaxProposalDependencies = 10
 )
 
 // NewProposal creates a new Proposal instance
@@ -130,6 +135,28 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 	}
 }
 
+// ValidateProposalDependencies performs a stateless validation of the
+// proposals the given proposal depends on.
+func ValidateProposalDependencies(proposalID uint64, dependsOn []uint64) error {
+	if len(dependsOn) > MaxProposalDependencies {
+		return fmt.Errorf("got %d dependencies, maximum allowed is %d", len(dependsOn), MaxProposalDependencies)
+	}
+
+	seen := make(map[uint64]struct{}, len(dependsOn))
+	for _, depID := range dependsOn {
+		if depID == proposalID {
+			return fmt.Errorf("proposal %d cannot depend on itself", proposalID)
+		}
+
+		if _, ok := seen[depID]; ok {
+			return fmt.Errorf("duplicate dependency %d", depID)
+		}
+		seen[depID] = struct{}{}
+	}
+
+	return nil
+}
+
 // ValidProposalStatus returns true if the proposal status is valid and false
 // otherwise.
 func ValidProposalStatus(status ProposalStatus) bool {
@@ -137,7 +164,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusAwaitingDependencies {
 		return true
 	}
 	return false
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -59,6 +61,24 @@ func EmptyTallyResult() TallyResult {
 	return NewTallyResult(math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
 }
 
+// TotalVotingPower returns the voting power of all the votes of the tally result.
+func (tr TallyResult) TotalVotingPower() (math.Int, error) {
+	total := math.ZeroInt()
+	for _, count := range []string{tr.OptionOneCount, tr.OptionTwoCount, tr.OptionThreeCount, tr.OptionFourCount, tr.SpamCount} {
+		if count == "" {
+			continue
+		}
+
+		c, ok := math.NewIntFromString(count)
+		if !ok {
+			return math.Int{}, fmt.Errorf("invalid tally count: %s", count)
+		}
+		total = total.Add(c)
+	}
+
+	return total, nil
+}
+
 // Equals returns if two tally results are equal.
 func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
@@ -61,6 +61,9 @@ type MsgSubmitProposal struct {
 	// proposal_type defines the type of proposal
 	// When not set defaults to PROPOSAL_TYPE_STANDARD
 	ProposalType ProposalType `protobuf:"varint,8,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// depends_on defines the ids of the proposals that must pass before this
+	// proposal's messages are executed.
+	DependsOn []uint64 `protobuf:"varint,9,rep,packed,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -153,6 +156,13 @@ func (m *MsgSubmitProposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *MsgSubmitProposal) GetDependsOn() []uint64 {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -1169,6 +1179,98 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgUpdateConstitution defines a message to amend the chain constitution.
+type MsgUpdateConstitution struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// constitution is the new constitution of the chain.
+	Constitution string `protobuf:"bytes,2,opt,name=constitution,proto3" json:"constitution,omitempty"`
+}
+
+func (m *MsgUpdateConstitution) Reset()         { *m = MsgUpdateConstitution{} }
+func (m *MsgUpdateConstitution) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConstitution) ProtoMessage()    {}
+func (*MsgUpdateConstitution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgUpdateConstitution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConstitution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConstitution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateConstitution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConstitution.Merge(m, src)
+}
+func (m *MsgUpdateConstitution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConstitution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConstitution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateConstitution proto.InternalMessageInfo
+
+func (m *MsgUpdateConstitution) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateConstitution) GetConstitution() string {
+	if m != nil {
+		return m.Constitution
+	}
+	return ""
+}
+
+// MsgUpdateConstitutionResponse defines the Msg/UpdateConstitution response type.
+type MsgUpdateConstitutionResponse struct {
+}
+
+func (m *MsgUpdateConstitutionResponse) Reset()         { *m = MsgUpdateConstitutionResponse{} }
+func (m *MsgUpdateConstitutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateConstitutionResponse) ProtoMessage()    {}
+func (*MsgUpdateConstitutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgUpdateConstitutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateConstitutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateConstitutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateConstitutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateConstitutionResponse.Merge(m, src)
+}
+func (m *MsgUpdateConstitutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateConstitutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateConstitutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateConstitutionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1292,113 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgUpdateConstitution)(nil), "cosmos.gov.v1.MsgUpdateConstitution")
+	proto.RegisterType((*MsgUpdateConstitutionResponse)(nil), "cosmos.gov.v1.MsgUpdateConstitutionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xae, 0xbd, 0xbb, 0x76, 0xd2, 0x22, 0x21, 0x2e, 0xad, 0xf7, 0x7d, 0xcd, 0x7b, 0xbf, 0x79, 0xf3,
-	0x7b, 0x2f, 0x60, 0xa9, 0x81, 0xb0, 0x89, 0x70, 0x51, 0x47, 0xdd, 0x62, 0xb7, 0x5c, 0xb4, 0x77,
-	0x64, 0xab, 0x8d, 0x6c, 0xc4, 0x1f, 0x73, 0xe4, 0xb2, 0x8e, 0xba, 0x72, 0xb7, 0x2c, 0x66, 0x98,
-	0xd9, 0x96, 0x8a, 0x61, 0xb1, 0x5b, 0xde, 0x82, 0xb6, 0x5a, 0x2e, 0x36, 0x90, 0xd1, 0x72, 0xcc,
-	0xc5, 0xe5, 0xe1, 0x30, 0xc4, 0xcb, 0x51, 0xa4, 0x75, 0xa4, 0x23, 0xfa, 0xb3, 0x48, 0x7e, 0x31,
-	0xe9, 0x8a, 0x63, 0x5e, 0x77, 0x14, 0xec, 0x28, 0xa6, 0xd2, 0x11, 0xd2, 0x9b, 0xb0, 0x48, 0xbf,
-	0xb6, 0x3a, 0x9f, 0x16, 0xd5, 0x56, 0x2f, 0x70, 0x88, 0x89, 0x75, 0x72, 0x88, 0x89, 0x75, 0xa6,
-	0x58, 0x50, 0x4d, 0xa3, 0x85, 0x8a, 0xf4, 0x5f, 0x26, 0xca, 0x06, 0xc3, 0xd8, 0x86, 0x09, 0xb1,
-	0xad, 0x9a, 0x96, 0x63, 0x20, 0xfd, 0x38, 0x05, 0x16, 0x6a, 0x58, 0xbf, 0xd1, 0xd9, 0x32, 0x0d,
-	0xfb, 0x7a, 0x1b, 0x59, 0x08, 0xab, 0x4d, 0xbe, 0x04, 0x92, 0x26, 0xc4, 0x58, 0xd5, 0x21, 0x16,
-	0xb8, 0x5c, 0x2c, 0x3f, 0xbb, 0x9e, 0x96, 0x9d, 0x48, 0xb2, 0x1b, 0x49, 0xbe, 0xd2, 0xea, 0x29,
-	0x9e, 0x15, 0x7f, 0x8f, 0x03, 0xf3, 0x46, 0xcb, 0xb0, 0x0d, 0xb5, 0x59, 0xd7, 0xa0, 0x85, 0xb0,
-	0x61, 0x0b, 0x93, 0xd4, 0x73, 0x45, 0x66, 0x85, 0x11, 0xd0, 0x64, 0x06, 0x9a, 0xbc, 0x89, 0x8c,
-	0x56, 0xf5, 0x9d, 0x07, 0x8f, 0xb3, 0x13, 0x3f, 0x3c, 0xc9, 0xe6, 0x75, 0xc3, 0xde, 0xee, 0x6c,
-	0xc9, 0x0d, 0x64, 0x32, 0x14, 0xd8, 0x7f, 0x05, 0xac, 0xdd, 0x2d, 0xda, 0x3d, 0x0b, 0x62, 0xea,
-	0x80, 0xbf, 0x39, 0xd8, 0x5d, 0x4b, 0x35, 0xa1, 0xae, 0x36, 0x7a, 0x75, 0x02, 0x3b, 0xfe, 0xee,
-	0x60, 0x77, 0x8d, 0x53, 0xe6, 0xd8, 0xc9, 0x6f, 0x3b, 0x07, 0xf3, 0x17, 0x40, 0xd2, 0xa2, 0xa5,
-	0xc0, 0xb6, 0x10, 0xcb, 0x71, 0xf9, 0x99, 0xaa, 0xb0, 0xdf, 0x2f, 0xa4, 0x59, 0x1e, 0x57, 0x34,
-	0xad, 0x0d, 0x31, 0xbe, 0x61, 0xb7, 0x8d, 0x96, 0xae, 0x78, 0x96, 0xbc, 0x48, 0x8a, 0xb6, 0x55,
-	0x4d, 0xb5, 0x55, 0x61, 0x8a, 0x78, 0x29, 0xde, 0x37, 0xff, 0x2a, 0x98, 0xb6, 0x0d, 0xbb, 0x09,
-	0x85, 0x69, 0x1a, 0x6e, 0xf1, 0x51, 0xbf, 0x30, 0xef, 0xa7, 0x98, 0x2b, 0xc9, 0x17, 0x5e, 0x57,
-	0x1c, 0x0b, 0xbe, 0x00, 0x12, 0xb8, 0x63, 0x9a, 0x6a, 0xbb, 0x27, 0xc4, 0x47, 0x1b, 0xbb, 0x36,
-	0xfc, 0x79, 0x30, 0x03, 0x77, 0x2c, 0xa8, 0x19, 0x36, 0xd4, 0x84, 0x44, 0x8e, 0xcb, 0x27, 0xab,
-	0x27, 0x42, 0x0e, 0x1b, 0x25, 0x81, 0x53, 0x7c, 0x3b, 0x5e, 0x01, 0xc7, 0x2c, 0x76, 0x57, 0x75,
-	0x02, 0x8f, 0x90, 0xcc, 0x71, 0xf9, 0xb9, 0xf5, 0x93, 0xf2, 0x50, 0xbb, 0xca, 0xee, 0x7d, 0xde,
-	0xec, 0x59, 0xb0, 0x7a, 0xfc, 0x51, 0xbf, 0x90, 0xda, 0x21, 0x3d, 0x99, 0xeb, 0x96, 0xe4, 0x75,
-	0xb9, 0xa4, 0xa4, 0xac, 0x01, 0x7d, 0xa5, 0xfc, 0xe5, 0xc1, 0xee, 0x9a, 0x87, 0xc6, 0xbd, 0x83,
-	0xdd, 0xb5, 0xec, 0xc0, 0x25, 0x74, 0xcb, 0xc5, 0x50, 0x9b, 0x48, 0x97, 0xc0, 0x4a, 0x48, 0xa8,
-	0x40, 0x6c, 0xa1, 0x16, 0x86, 0x7c, 0x16, 0xcc, 0x7a, 0x39, 0x1a, 0x9a, 0xc0, 0xe5, 0xb8, 0xfc,
-	0x94, 0x02, 0x5c, 0xd1, 0x35, 0x4d, 0xfa, 0x89, 0x03, 0xe9, 0x1a, 0xd6, 0xaf, 0xee, 0xc0, 0xc6,
-	0xfb, 0xf4, 0x4a, 0x37, 0x51, 0xcb, 0x86, 0x2d, 0x9b, 0xff, 0x00, 0x24, 0x1a, 0xce, 0x4f, 0xea,
-	0x35, 0xa2, 0xf9, 0xaa, 0x99, 0xbd, 0x7e, 0x41, 0x1c, 0x2a, 0xd8, 0x6d, 0x2d, 0xea, 0xab, 0xb8,
-	0x41, 0xf8, 0x55, 0x30, 0xa3, 0x76, 0xec, 0x6d, 0xd4, 0x36, 0xec, 0x9e, 0x30, 0x49, 0x6f, 0xd6,
-	0x17, 0x54, 0x36, 0x48, 0xdd, 0xfe, 0x37, 0x29, 0x5c, 0x0a, 0x15, 0x1e, 0x4a, 0x52, 0xca, 0x80,
-	0xd5, 0x28, 0xb9, 0x5b, 0xbe, 0xf4, 0x07, 0x07, 0x12, 0x35, 0xac, 0xdf, 0x46, 0x36, 0xe4, 0x37,
-	0x22, 0xa0, 0xa8, 0xa6, 0xff, 0x7a, 0x9c, 0x1d, 0x14, 0x3b, 0xad, 0x3c, 0x00, 0x10, 0x2f, 0x83,
-	0xe9, 0x2e, 0xb2, 0x61, 0xdb, 0xc9, 0x79, 0x4c, 0x0f, 0x3b, 0x66, 0x7c, 0x19, 0xc4, 0x91, 0x65,
-	0x1b, 0xa8, 0x45, 0x9b, 0x7e, 0xce, 0x7f, 0x79, 0xac, 0x1d, 0x48, 0x2e, 0x1f, 0x52, 0x03, 0x85,
-	0x19, 0x8e, 0xeb, 0xf9, 0xca, 0x4b, 0x04, 0x18, 0x27, 0x34, 0x01, 0xe5, 0x44, 0x08, 0x14, 0x12,
-	0x4f, 0x5a, 0x00, 0xf3, 0xec, 0xa7, 0x57, 0xfa, 0xdf, 0x9c, 0x27, 0xfb, 0x08, 0x1a, 0xfa, 0x36,
-	0xe9, 0xd8, 0xff, 0x08, 0x82, 0xb7, 0x40, 0xc2, 0xa9, 0x0c, 0x0b, 0x31, 0xca, 0x3e, 0xa7, 0x03,
-	0x18, 0xb8, 0x09, 0x0d, 0x60, 0xe1, 0x7a, 0x8c, 0x05, 0xe3, 0xb5, 0x61, 0x30, 0x4e, 0x45, 0x82,
-	0xe1, 0x06, 0x97, 0x56, 0xc0, 0x72, 0x40, 0xe4, 0x81, 0xf3, 0x27, 0x07, 0x40, 0x0d, 0xeb, 0x2e,
-	0x55, 0xbd, 0x20, 0x2e, 0x17, 0xc1, 0x0c, 0x63, 0x59, 0x74, 0x38, 0x36, 0xbe, 0x29, 0x7f, 0x09,
-	0xc4, 0x55, 0x13, 0x75, 0x5a, 0x36, 0x83, 0x67, 0x0c, 0x39, 0xcf, 0x10, 0x72, 0x76, 0x4e, 0x66,
-	0x3e, 0x95, 0x73, 0xf4, 0xa9, 0x78, 0xd1, 0x08, 0x10, 0x42, 0x08, 0x08, 0x56, 0x99, 0x94, 0x06,
-	0xbc, 0xff, 0xe5, 0x95, 0xff, 0x8b, 0xd3, 0x1b, 0xb7, 0x2c, 0x4d, 0xb5, 0xe1, 0x75, 0xb5, 0xad,
-	0x9a, 0x98, 0x14, 0xe3, 0xbf, 0x4f, 0xee, 0xb0, 0x62, 0x3c, 0x53, 0xfe, 0x0d, 0x10, 0xb7, 0x68,
-	0x04, 0x8a, 0xc0, 0xec, 0xfa, 0x89, 0x20, 0xfd, 0x51, 0xe5, 0x50, 0x21, 0x8e, 0x7d, 0xe5, 0xda,
-	0x7e, 0x98, 0x92, 0xc3, 0x34, 0x70, 0x66, 0xa0, 0xb6, 0x1d, 0x77, 0xa6, 0x07, 0x92, 0x97, 0x64,
-	0x7a, 0xd5, 0x83, 0x22, 0xb7, 0xd6, 0xca, 0x62, 0xc4, 0x29, 0xd2, 0xb7, 0x1c, 0x1d, 0xb8, 0x9b,
-	0x6a, 0xab, 0x01, 0x9b, 0x03, 0x03, 0x37, 0xa2, 0x0d, 0xe6, 0x03, 0x6d, 0x30, 0xd4, 0x01, 0x83,
-	0x33, 0x6e, 0xf2, 0xa8, 0x33, 0xae, 0x92, 0xdb, 0x0f, 0x8f, 0x96, 0x21, 0xde, 0x97, 0x7e, 0xe5,
-	0x28, 0xa9, 0x0f, 0xe7, 0xe7, 0x91, 0xfa, 0xf3, 0xe7, 0x79, 0x0d, 0x1c, 0x6b, 0xd0, 0x58, 0x50,
-	0xab, 0x93, 0xe5, 0x83, 0xdd, 0x95, 0x18, 0xa2, 0xf4, 0x9b, 0xee, 0x66, 0x52, 0x4d, 0x92, 0x0b,
-	0xbb, 0xff, 0x24, 0xcb, 0x29, 0x29, 0xd7, 0x95, 0x28, 0xf9, 0xb3, 0x60, 0xde, 0x0b, 0xb5, 0x4d,
-	0xdf, 0x15, 0x25, 0xba, 0x29, 0x65, 0xce, 0x15, 0xbf, 0x47, 0xa5, 0x11, 0xc0, 0x6f, 0x94, 0xa4,
-	0xaf, 0x62, 0x20, 0xeb, 0x4d, 0xab, 0x5a, 0xa7, 0x69, 0x1b, 0x56, 0x13, 0x6e, 0x6e, 0x23, 0xa3,
-	0x01, 0xbd, 0x6b, 0x88, 0xda, 0x62, 0xb8, 0xff, 0xc3, 0x16, 0x33, 0xf9, 0x42, 0x5b, 0x4c, 0x2c,
-	0xb0, 0xc5, 0xa4, 0xdd, 0x2d, 0xc6, 0x61, 0x37, 0xb6, 0xb0, 0x08, 0xfe, 0xc2, 0x42, 0xb7, 0x1b,
-	0x7f, 0x37, 0xb9, 0x0a, 0x52, 0x84, 0xf1, 0xea, 0x2e, 0xa5, 0xc6, 0xe9, 0xd5, 0x49, 0x23, 0xb6,
-	0x0c, 0x9f, 0x52, 0xb1, 0x32, 0xdb, 0xf5, 0x3f, 0x2a, 0xab, 0xfb, 0xfd, 0xc2, 0xac, 0xb3, 0x79,
-	0x94, 0xe5, 0x92, 0x1c, 0x68, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65,
-	0x3e, 0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a,
-	0xcb, 0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd,
-	0xe4, 0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63,
-	0x4c, 0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0xfc, 0x23, 0xa5, 0x32, 0xc8, 0x44, 0x17, 0xe1, 0x51,
-	0x4d, 0xa8, 0xf0, 0xef, 0x39, 0x30, 0x4b, 0x61, 0xd5, 0x10, 0xd9, 0x51, 0x5e, 0xb8, 0xda, 0x4d,
-	0x10, 0x33, 0xb1, 0xce, 0x1e, 0x6d, 0xf4, 0x1e, 0x76, 0x72, 0xaf, 0x5f, 0x58, 0x8e, 0x7a, 0x1d,
-	0x35, 0xac, 0x2b, 0xc4, 0xfb, 0xb0, 0xf2, 0x2e, 0x83, 0xc5, 0x81, 0x54, 0xbd, 0xdb, 0x5e, 0x02,
-	0xf1, 0x36, 0xc4, 0x9d, 0xa6, 0xb3, 0x05, 0xa6, 0x14, 0xf6, 0x15, 0xaa, 0x75, 0xfd, 0x5e, 0x12,
-	0xc4, 0x6a, 0x58, 0xe7, 0xef, 0x80, 0xb9, 0xc0, 0xdf, 0x31, 0xb9, 0xe0, 0x15, 0x04, 0xb7, 0x55,
-	0x31, 0x7f, 0x98, 0x85, 0x97, 0x0e, 0x04, 0x0b, 0xe1, 0x55, 0xf5, 0x4c, 0xd8, 0x3d, 0x64, 0x24,
-	0x9e, 0x3b, 0x82, 0x91, 0x77, 0xcc, 0x65, 0x30, 0x45, 0x77, 0xc6, 0xa5, 0xb0, 0x13, 0x91, 0x8b,
-	0x99, 0x68, 0xb9, 0xe7, 0x7f, 0x1b, 0xa4, 0x86, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65,
-	0xbc, 0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48,
-	0x95, 0x17, 0xe8, 0x2e, 0x48, 0x0d, 0x4d, 0xff, 0x88, 0x04, 0x07, 0xf5, 0x51, 0x09, 0x46, 0x4d,
-	0x5b, 0x69, 0x71, 0x2f, 0x3c, 0x6d, 0xf9, 0xcf, 0xc0, 0x5c, 0x60, 0xd2, 0x46, 0xb4, 0xc4, 0xb0,
-	0x45, 0x54, 0x4b, 0x44, 0x4f, 0xc3, 0x88, 0x23, 0x37, 0x4a, 0xfc, 0xd7, 0x1c, 0x58, 0x1d, 0x3b,
-	0x64, 0xe4, 0x51, 0x2d, 0x17, 0x6d, 0x2f, 0x5e, 0x7c, 0x3e, 0x7b, 0x2f, 0xbb, 0xe3, 0x7b, 0xfd,
-	0x42, 0x2a, 0x37, 0xf0, 0x50, 0xf8, 0xcf, 0xc1, 0x62, 0x14, 0x13, 0xbe, 0x3c, 0x0a, 0xe1, 0x21,
-	0x33, 0xb1, 0x70, 0x24, 0xb3, 0x31, 0xc7, 0xdf, 0x01, 0x49, 0x8f, 0x8f, 0xc4, 0xa8, 0xa2, 0x1c,
-	0x9d, 0x28, 0x8d, 0xd6, 0x8d, 0x8e, 0x2e, 0x4e, 0x7f, 0x41, 0xa6, 0x68, 0x75, 0xe3, 0xc1, 0xd3,
-	0x0c, 0xf7, 0xf0, 0x69, 0x86, 0xfb, 0xfd, 0x69, 0x86, 0xbb, 0xff, 0x2c, 0x33, 0xf1, 0xf0, 0x59,
-	0x66, 0xe2, 0xb7, 0x67, 0x99, 0x89, 0x8f, 0x4f, 0x3a, 0x51, 0xb1, 0x76, 0x57, 0x36, 0x10, 0x5b,
-	0xe8, 0xe8, 0x54, 0x2e, 0x76, 0xcb, 0x5b, 0x71, 0x4a, 0x69, 0xe7, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0xac, 0x54, 0xba, 0x09, 0x12, 0x00, 0x00,
+	// 1576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xc4, 0x89, 0x13, 0x9f, 0xb8, 0x49, 0x33, 0xf9, 0x9a, 0x4c, 0x53, 0xdb, 0x9d, 0xf6,
+	0xb5, 0x7e, 0x69, 0x3d, 0xb6, 0xd3, 0xa6, 0xef, 0x3d, 0xbf, 0xaa, 0xa2, 0x0e, 0x05, 0x2a, 0x61,
+	0x5a, 0x4d, 0x3f, 0x90, 0xa0, 0x92, 0x35, 0xf1, 0x5c, 0x26, 0xa3, 0x7a, 0xe6, 0x0e, 0xbe, 0x63,
+	0x2b, 0x5e, 0x20, 0x21, 0x24, 0x36, 0x5d, 0x75, 0x83, 0x84, 0x90, 0xd8, 0x03, 0xab, 0x2c, 0xc2,
+	0x8a, 0x25, 0x9b, 0x2a, 0x0b, 0x54, 0x65, 0x81, 0x50, 0x17, 0x2d, 0x6a, 0x05, 0x91, 0xe0, 0x8f,
+	0x00, 0xcd, 0x9d, 0x0f, 0x7b, 0x3c, 0x63, 0x3b, 0x0d, 0x12, 0x62, 0xd3, 0x7a, 0xce, 0xf9, 0x9d,
+	0x73, 0xef, 0xf9, 0xdd, 0x73, 0xcf, 0xfd, 0xb5, 0xb0, 0x58, 0xc3, 0x44, 0xc7, 0x24, 0xaf, 0xe2,
+	0x56, 0xbe, 0x55, 0xcc, 0x5b, 0xdb, 0xa2, 0xd9, 0xc0, 0x16, 0x66, 0x8f, 0x39, 0x76, 0x51, 0xc5,
+	0x2d, 0xb1, 0x55, 0xe4, 0x53, 0x2e, 0x6c, 0x53, 0x26, 0x28, 0xdf, 0x2a, 0x6e, 0x22, 0x4b, 0x2e,
+	0xe6, 0x6b, 0x58, 0x33, 0x1c, 0x38, 0xbf, 0x14, 0x4c, 0x63, 0x47, 0x39, 0x8e, 0x79, 0x15, 0xab,
+	0x98, 0xfe, 0xcc, 0xdb, 0xbf, 0x5c, 0xeb, 0xb2, 0x03, 0xaf, 0x3a, 0x0e, 0x77, 0x29, 0xd7, 0xa5,
+	0x62, 0xac, 0xd6, 0x51, 0x9e, 0x7e, 0x6d, 0x36, 0x3f, 0xc8, 0xcb, 0x46, 0xbb, 0x67, 0x11, 0x9d,
+	0xa8, 0xf6, 0x22, 0x3a, 0x51, 0x5d, 0xc7, 0xac, 0xac, 0x6b, 0x06, 0xce, 0xd3, 0x3f, 0x5d, 0x53,
+	0xba, 0x37, 0x8d, 0xa5, 0xe9, 0x88, 0x58, 0xb2, 0x6e, 0x3a, 0x00, 0xe1, 0xf7, 0x31, 0x98, 0xad,
+	0x10, 0xf5, 0x76, 0x73, 0x53, 0xd7, 0xac, 0x5b, 0x0d, 0x6c, 0x62, 0x22, 0xd7, 0xd9, 0x02, 0x4c,
+	0xea, 0x88, 0x10, 0x59, 0x45, 0x84, 0x63, 0x32, 0xb1, 0xec, 0xd4, 0xda, 0xbc, 0xe8, 0x64, 0x12,
+	0xbd, 0x4c, 0xe2, 0x35, 0xa3, 0x2d, 0xf9, 0x28, 0xf6, 0x21, 0x03, 0x33, 0x9a, 0xa1, 0x59, 0x9a,
+	0x5c, 0xaf, 0x2a, 0xc8, 0xc4, 0x44, 0xb3, 0xb8, 0x51, 0x1a, 0xb9, 0x2c, 0xba, 0x85, 0xd9, 0xa4,
+	0x89, 0x2e, 0x69, 0xe2, 0x06, 0xd6, 0x8c, 0xf2, 0x1b, 0x8f, 0x9f, 0xa5, 0x47, 0xbe, 0x79, 0x9e,
+	0xce, 0xaa, 0x9a, 0xb5, 0xd5, 0xdc, 0x14, 0x6b, 0x58, 0x77, 0x59, 0x70, 0xff, 0xca, 0x11, 0xe5,
+	0x41, 0xde, 0x6a, 0x9b, 0x88, 0xd0, 0x00, 0xf2, 0xc5, 0xc1, 0xce, 0x6a, 0xb2, 0x8e, 0x54, 0xb9,
+	0xd6, 0xae, 0xda, 0xb4, 0x93, 0xaf, 0x0e, 0x76, 0x56, 0x19, 0x69, 0xda, 0x5d, 0xf9, 0x75, 0x67,
+	0x61, 0xf6, 0x12, 0x4c, 0x9a, 0xb4, 0x14, 0xd4, 0xe0, 0x62, 0x19, 0x26, 0x9b, 0x28, 0x73, 0xfb,
+	0xbb, 0xb9, 0x79, 0x77, 0x1f, 0xd7, 0x14, 0xa5, 0x81, 0x08, 0xb9, 0x6d, 0x35, 0x34, 0x43, 0x95,
+	0x7c, 0x24, 0xcb, 0xdb, 0x45, 0x5b, 0xb2, 0x22, 0x5b, 0x32, 0x37, 0x66, 0x47, 0x49, 0xfe, 0x37,
+	0xfb, 0x6f, 0x18, 0xb7, 0x34, 0xab, 0x8e, 0xb8, 0x71, 0x9a, 0x6e, 0xee, 0xe9, 0x6e, 0x6e, 0xa6,
+	0xb3, 0xc5, 0x4c, 0x41, 0xbc, 0xf4, 0x1f, 0xc9, 0x41, 0xb0, 0x39, 0x98, 0x20, 0x4d, 0x5d, 0x97,
+	0x1b, 0x6d, 0x2e, 0xde, 0x1f, 0xec, 0x61, 0xd8, 0x8b, 0x90, 0x40, 0xdb, 0x26, 0x52, 0x34, 0x0b,
+	0x29, 0xdc, 0x44, 0x86, 0xc9, 0x4e, 0x96, 0x17, 0x42, 0x01, 0xeb, 0x05, 0x8e, 0x91, 0x3a, 0x38,
+	0x56, 0x82, 0x63, 0xa6, 0x7b, 0x56, 0x55, 0x9b, 0x1e, 0x6e, 0x32, 0xc3, 0x64, 0xa7, 0xd7, 0x4e,
+	0x88, 0x81, 0x76, 0x15, 0xbd, 0xf3, 0xbc, 0xd3, 0x36, 0x51, 0xf9, 0xf8, 0xd3, 0xdd, 0x5c, 0x72,
+	0xdb, 0xee, 0xc9, 0x4c, 0xab, 0x20, 0xae, 0x89, 0x05, 0x29, 0x69, 0x76, 0xf9, 0xd9, 0x3c, 0x80,
+	0x82, 0x4c, 0x64, 0x28, 0xa4, 0x8a, 0x0d, 0x2e, 0x91, 0x89, 0x65, 0xc7, 0x02, 0x31, 0x45, 0xb1,
+	0x20, 0x16, 0xa4, 0x84, 0x8b, 0xb9, 0x69, 0x94, 0x8a, 0x9f, 0x1c, 0xec, 0xac, 0xfa, 0xf4, 0x3d,
+	0x3c, 0xd8, 0x59, 0x4d, 0x77, 0x9d, 0x5a, 0xab, 0x98, 0x0f, 0xf5, 0x95, 0x70, 0x05, 0x96, 0x43,
+	0x46, 0x09, 0x11, 0x13, 0x1b, 0x04, 0xb1, 0x69, 0x98, 0xf2, 0x8b, 0xd2, 0x14, 0x8e, 0xc9, 0x30,
+	0xd9, 0x31, 0x09, 0x3c, 0xd3, 0x0d, 0x45, 0xf8, 0x8e, 0x81, 0xf9, 0x0a, 0x51, 0xaf, 0x6f, 0xa3,
+	0xda, 0xdb, 0xb4, 0x07, 0x36, 0xb0, 0x61, 0x21, 0xc3, 0x62, 0xdf, 0x81, 0x89, 0x9a, 0xf3, 0x93,
+	0x46, 0xf5, 0xe9, 0xd6, 0x72, 0x6a, 0x6f, 0x37, 0xc7, 0x07, 0x18, 0xf2, 0x7a, 0x91, 0xc6, 0x4a,
+	0x5e, 0x12, 0x76, 0x05, 0x12, 0x72, 0xd3, 0xda, 0xc2, 0x0d, 0xcd, 0x6a, 0x73, 0xa3, 0xb4, 0x15,
+	0x3a, 0x86, 0xd2, 0xba, 0x5d, 0x77, 0xe7, 0xdb, 0x2e, 0x5c, 0x08, 0x15, 0x1e, 0xda, 0xa4, 0x90,
+	0x82, 0x95, 0x28, 0xbb, 0x57, 0xbe, 0xf0, 0x0b, 0x03, 0x13, 0x15, 0xa2, 0xde, 0xc3, 0x16, 0x62,
+	0xd7, 0x23, 0xa8, 0x28, 0xcf, 0xff, 0xf6, 0x2c, 0xdd, 0x6d, 0x76, 0x7a, 0xbf, 0x8b, 0x20, 0x56,
+	0x84, 0xf1, 0x16, 0xb6, 0x50, 0x83, 0x1b, 0x1d, 0xd2, 0xf4, 0x0e, 0x8c, 0x2d, 0x42, 0x1c, 0x9b,
+	0x96, 0x86, 0x0d, 0x7a, 0x4b, 0xa6, 0x3b, 0x57, 0xd5, 0xed, 0x1f, 0x7b, 0x2f, 0x37, 0x29, 0x40,
+	0x72, 0x81, 0x83, 0x2e, 0x49, 0xe9, 0x8c, 0x4d, 0x8c, 0x93, 0xda, 0x26, 0x65, 0x21, 0x44, 0x8a,
+	0x9d, 0x4f, 0x98, 0x85, 0x19, 0xf7, 0xa7, 0x5f, 0xfa, 0x1f, 0x8c, 0x6f, 0x7b, 0x17, 0x69, 0xea,
+	0x96, 0xdd, 0xe2, 0x7f, 0x13, 0x05, 0xff, 0x87, 0x09, 0xa7, 0x32, 0xc2, 0xc5, 0xe8, 0xb8, 0x3a,
+	0xd5, 0xc3, 0x81, 0xb7, 0xa1, 0x2e, 0x2e, 0xbc, 0x88, 0x81, 0x64, 0x5c, 0x08, 0x92, 0x71, 0x32,
+	0x92, 0x0c, 0x2f, 0xb9, 0xb0, 0x0c, 0x4b, 0x3d, 0x26, 0x9f, 0x9c, 0x5f, 0x19, 0x80, 0x0a, 0x51,
+	0xbd, 0xd9, 0x76, 0x44, 0x5e, 0x2e, 0x43, 0xc2, 0x1d, 0xcb, 0x78, 0x38, 0x37, 0x1d, 0x28, 0x7b,
+	0x05, 0xe2, 0xb2, 0x8e, 0x9b, 0x86, 0xe5, 0xd2, 0x33, 0x60, 0x9a, 0x27, 0xec, 0x69, 0xee, 0xac,
+	0xec, 0xc6, 0x94, 0xce, 0xd3, 0xab, 0xe2, 0x67, 0xb3, 0x89, 0xe0, 0x42, 0x44, 0xb8, 0x95, 0x09,
+	0xf3, 0xc0, 0x76, 0xbe, 0xfc, 0xf2, 0x7f, 0x70, 0x7a, 0xe3, 0xae, 0xa9, 0xc8, 0x16, 0xba, 0x25,
+	0x37, 0x64, 0x9d, 0xd8, 0xc5, 0x74, 0xee, 0x27, 0x33, 0xac, 0x18, 0x1f, 0xca, 0xfe, 0x17, 0xe2,
+	0x26, 0xcd, 0x40, 0x19, 0x98, 0x5a, 0x5b, 0xe8, 0x9d, 0x97, 0xd4, 0x19, 0x28, 0xc4, 0xc1, 0x97,
+	0x6e, 0xec, 0x87, 0x67, 0x78, 0x78, 0x0c, 0x9c, 0xee, 0xaa, 0x6d, 0xdb, 0x13, 0x01, 0x3d, 0x9b,
+	0x17, 0x44, 0x58, 0xea, 0x31, 0x79, 0xb5, 0x96, 0xe6, 0x22, 0x56, 0x11, 0xbe, 0x64, 0xe8, 0x0b,
+	0xbd, 0x21, 0x1b, 0x35, 0x54, 0xef, 0x7a, 0xa1, 0x23, 0xda, 0x60, 0xa6, 0xa7, 0x0d, 0x02, 0x1d,
+	0xd0, 0xfd, 0x28, 0x8e, 0x1e, 0xf6, 0x51, 0x2c, 0x65, 0xf6, 0xc3, 0x6f, 0x51, 0x60, 0xee, 0x0b,
+	0x3f, 0x32, 0xb0, 0x1c, 0xda, 0x9f, 0x3f, 0xd4, 0x5f, 0x7d, 0x9f, 0x37, 0xe0, 0x58, 0x8d, 0xe6,
+	0x42, 0x4a, 0xd5, 0x56, 0x2b, 0xee, 0x59, 0xf1, 0xa1, 0x91, 0x7e, 0xc7, 0x93, 0x32, 0xe5, 0x49,
+	0xfb, 0xc0, 0x1e, 0x3d, 0x4f, 0x33, 0x52, 0xd2, 0x0b, 0xb5, 0x9d, 0xec, 0x39, 0x98, 0xf1, 0x53,
+	0x6d, 0xd1, 0x7b, 0x45, 0x07, 0xdd, 0x98, 0x34, 0xed, 0x99, 0xdf, 0xa2, 0xd6, 0x08, 0xe2, 0xd7,
+	0x0b, 0xc2, 0x67, 0x31, 0x48, 0xfb, 0xaf, 0x55, 0xa5, 0x59, 0xb7, 0x34, 0xb3, 0x8e, 0x36, 0xb6,
+	0xb0, 0x56, 0x43, 0xfe, 0x31, 0x44, 0xc9, 0x1e, 0xe6, 0x9f, 0x20, 0x7b, 0x46, 0x8f, 0x24, 0x7b,
+	0x62, 0x3d, 0xb2, 0x67, 0xde, 0x93, 0x3d, 0xce, 0x74, 0x73, 0x3e, 0x58, 0xae, 0xa3, 0x70, 0xa8,
+	0x1c, 0xea, 0x88, 0x99, 0xeb, 0x90, 0xb4, 0x27, 0x5e, 0xd5, 0x1b, 0xa9, 0x71, 0x7a, 0x74, 0x42,
+	0x1f, 0x59, 0xd2, 0x19, 0xa9, 0x44, 0x9a, 0x6a, 0x75, 0x3e, 0x4a, 0x2b, 0xfb, 0xbb, 0xb9, 0x29,
+	0x47, 0x76, 0x50, 0xd5, 0x11, 0x6c, 0xb8, 0xf7, 0xe1, 0xdc, 0x90, 0x63, 0x39, 0xb4, 0xa4, 0x28,
+	0xcd, 0xf4, 0xac, 0x24, 0x7c, 0xcf, 0xc0, 0xa2, 0x7f, 0x3d, 0x2b, 0x8e, 0xba, 0xfd, 0x8b, 0x53,
+	0x67, 0x09, 0x26, 0x74, 0xa2, 0x56, 0x9b, 0x8d, 0xba, 0xab, 0x25, 0xe2, 0x3a, 0x51, 0xef, 0x36,
+	0xea, 0xec, 0xff, 0xfc, 0x71, 0x14, 0xcb, 0x30, 0x11, 0x4f, 0x8f, 0xbb, 0x7c, 0x59, 0x26, 0x48,
+	0x71, 0x27, 0x85, 0x37, 0x8f, 0x4e, 0x46, 0x30, 0xd4, 0x59, 0x52, 0x28, 0x42, 0x2a, 0xba, 0x08,
+	0x7f, 0xd4, 0x84, 0x0a, 0xff, 0x9a, 0x81, 0x29, 0x4a, 0xab, 0x82, 0x6d, 0x8d, 0x72, 0xe4, 0x6a,
+	0x37, 0x20, 0xa6, 0x13, 0x95, 0x1b, 0x1d, 0xa0, 0xc3, 0x4e, 0xec, 0xed, 0xe6, 0x96, 0xa2, 0x6e,
+	0x47, 0x85, 0xa8, 0x92, 0x1d, 0x3d, 0xac, 0xbc, 0xab, 0x30, 0xd7, 0xb5, 0x55, 0xff, 0xb4, 0x17,
+	0x21, 0xde, 0x40, 0xa4, 0x59, 0x77, 0x54, 0x60, 0x52, 0x72, 0xbf, 0xc2, 0xb5, 0x7e, 0xcb, 0xc0,
+	0x82, 0xcf, 0xcf, 0x06, 0x36, 0x88, 0xa5, 0x59, 0x4d, 0x2a, 0x6f, 0x8e, 0x5a, 0xb5, 0x00, 0xc9,
+	0x5a, 0x57, 0x1e, 0xf7, 0xa0, 0x03, 0xb6, 0xd2, 0x6b, 0x03, 0x8b, 0xb2, 0xdf, 0x8f, 0x54, 0xf8,
+	0xd1, 0xe8, 0xde, 0x9d, 0x50, 0x80, 0x93, 0x91, 0x8e, 0xbe, 0xa7, 0xba, 0xf6, 0x69, 0x02, 0x62,
+	0x15, 0xa2, 0xb2, 0xf7, 0x61, 0xba, 0xe7, 0x9f, 0x78, 0x99, 0xde, 0x66, 0xeb, 0xd5, 0xe5, 0x7c,
+	0x76, 0x18, 0xc2, 0x27, 0x1e, 0xc1, 0x6c, 0x58, 0x94, 0x9f, 0x0e, 0x87, 0x87, 0x40, 0xfc, 0xf9,
+	0x43, 0x80, 0xfc, 0x65, 0xae, 0xc2, 0x18, 0x55, 0xc7, 0x8b, 0xe1, 0x20, 0xdb, 0xce, 0xa7, 0xa2,
+	0xed, 0x7e, 0xfc, 0x3d, 0x48, 0x06, 0x24, 0x66, 0x1f, 0xbc, 0xe7, 0xe7, 0xcf, 0x0e, 0xf6, 0xfb,
+	0x79, 0xdf, 0x84, 0x09, 0x6f, 0x04, 0x2f, 0x87, 0x43, 0x5c, 0x17, 0x7f, 0xaa, 0xaf, 0xcb, 0x4f,
+	0xf4, 0x00, 0x92, 0x01, 0x9d, 0x13, 0xb1, 0xc1, 0x6e, 0x3f, 0x7f, 0x76, 0xb0, 0xdf, 0xd7, 0x50,
+	0x73, 0x7b, 0x61, 0x5d, 0xc1, 0x7e, 0x08, 0xd3, 0x3d, 0x9a, 0x22, 0xa2, 0x25, 0x82, 0x08, 0x3e,
+	0x3b, 0x0c, 0x31, 0x60, 0xc9, 0xf5, 0x02, 0xfb, 0x39, 0x03, 0x2b, 0x03, 0x9f, 0x53, 0xb1, 0x5f,
+	0xcb, 0x45, 0xe3, 0xf9, 0xcb, 0xaf, 0x86, 0xf7, 0x77, 0x77, 0x7c, 0x6f, 0x37, 0x97, 0xcc, 0x74,
+	0x5d, 0x14, 0xf6, 0x23, 0x98, 0x8b, 0x9a, 0xf9, 0xff, 0xea, 0xc7, 0x70, 0x00, 0xc6, 0xe7, 0x0e,
+	0x05, 0x1b, 0xb0, 0xfc, 0x7d, 0x98, 0xf4, 0x27, 0x2f, 0x1f, 0x55, 0x94, 0xe3, 0xe3, 0x85, 0xfe,
+	0xbe, 0x01, 0xd9, 0xdb, 0xc0, 0x46, 0xcc, 0xba, 0x33, 0xfd, 0x36, 0xdd, 0x8d, 0xe2, 0x2f, 0x1c,
+	0x06, 0xe5, 0xaf, 0x3d, 0xb3, 0x17, 0x1c, 0x40, 0xfc, 0xf8, 0xc7, 0xb6, 0x54, 0x29, 0xaf, 0x3f,
+	0x7e, 0x91, 0x62, 0x9e, 0xbc, 0x48, 0x31, 0x3f, 0xbf, 0x48, 0x31, 0x8f, 0x5e, 0xa6, 0x46, 0x9e,
+	0xbc, 0x4c, 0x8d, 0xfc, 0xf4, 0x32, 0x35, 0xf2, 0xde, 0x09, 0x27, 0x3d, 0x51, 0x1e, 0x88, 0x1a,
+	0x76, 0x55, 0x33, 0x95, 0x3e, 0xf6, 0xff, 0xaf, 0xc5, 0xe9, 0xbb, 0x71, 0xf1, 0xcf, 0x01, 0x00,
+	0x2e, 0x4f, 0xca, 0x62, 0x9f, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1436,8 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// UpdateConstitution defines a method to amend the chain constitution when used in a governance proposal.
+	UpdateConstitution(ctx context.Context, in *MsgUpdateConstitution, opts ...grpc.CallOption) (*MsgUpdateConstitutionResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1538,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) UpdateConstitution(ctx context.Context, in *MsgUpdateConstitution, opts ...grpc.CallOption) (*MsgUpdateConstitutionResponse, error) {
+	out := new(MsgUpdateConstitutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UpdateConstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1572,8 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// UpdateConstitution defines a method to amend the chain constitution when used in a governance proposal.
+	UpdateConstitution(context.Context, *MsgUpdateConstitution) (*MsgUpdateConstitutionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1610,9 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) UpdateConstitution(ctx context.Context, req *MsgUpdateConstitution) (*MsgUpdateConstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConstitution not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1798,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateConstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateConstitution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConstitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UpdateConstitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConstitution(ctx, req.(*MsgUpdateConstitution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1716,6 +1860,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "UpdateConstitution",
+			Handler:    _Msg_UpdateConstitution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1741,6 +1889,24 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		dAtA2 := make([]byte, len(m.DependsOn)*10)
+		var j1 int
+		for _, num := range m.DependsOn {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -2252,12 +2418,12 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x18
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
@@ -2517,6 +2683,66 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateConstitution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConstitution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConstitution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constitution) > 0 {
+		i -= len(m.Constitution)
+		copy(dAtA[i:], m.Constitution)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Constitution)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConstitutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConstitutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConstitutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2568,6 +2794,13 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 1 + sovTx(uint64(m.ProposalType))
 	}
+	if len(m.DependsOn) > 0 {
+		l = 0
+		for _, e := range m.DependsOn {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -2871,6 +3104,32 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateConstitution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateConstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3141,6 +3400,82 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DependsOn = append(m.DependsOn, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DependsOn) == 0 {
+					m.DependsOn = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DependsOn = append(m.DependsOn, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5109,6 +5444,170 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateConstitution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConstitution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConstitution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constitution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConstitutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConstitutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConstitutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,10 +9,12 @@ import (
 
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/internal/math"
+	"cosmossdk.io/x/group/offchain"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -20,6 +22,8 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagLabel              = "label"
+	FlagSignaling          = "signaling"
 )
 
 var errZeroGroupID = errors.New("group id cannot be 0")
@@ -41,6 +45,7 @@ func TxCmd(name string) *cobra.Command {
 		MsgCreateGroupPolicyCmd(),
 		MsgUpdateGroupPolicyDecisionPolicyCmd(),
 		MsgSubmitProposalCmd(),
+		MsgSubmitOffchainApprovalCmd(),
 		NewCmdDraftProposal(),
 	)
 
@@ -380,10 +385,16 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
 				return err
 			}
 
+			msg.Label, _ = cmd.Flags().GetString(FlagLabel)
+			if err := group.ValidateGroupPolicyLabel(msg.Label); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagLabel, "", "Optional human-readable label of the group policy, unique within the group")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -530,11 +541,107 @@ metadata example:
 				return err
 			}
 
+			if signaling, _ := cmd.Flags().GetBool(FlagSignaling); signaling {
+				msg.Kind = group.PROPOSAL_KIND_SIGNALING
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(FlagExec, "", "Set to 1 to try to execute proposal immediately after creation (proposers signatures are considered as Yes votes)")
+	cmd.Flags().Bool(FlagSignaling, false, "Submit a signaling proposal, without messages, which is only tallied and never executed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgSubmitOffchainApprovalCmd creates a CLI command for Msg/SubmitOffchainApproval.
+//
+// The approval typed data is signed with the member key, and the resulting
+// approval is submitted by the --from account, which can be any account.
+func MsgSubmitOffchainApprovalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-offchain-approval [proposal-id] [member] [vote-option] [metadata]",
+		Short: "Sign the approval of a proposal with a member key and submit it",
+		Long: `Sign the approval of a proposal with a member key and submit it.
+The approval is a non-binding signal, it is not counted in the proposal tally.
+Parameters:
+	proposal-id: unique ID of the proposal
+	member: key name or address of the group member signing the approval, it must be in the keyring
+	vote-option: choice of the member
+		VOTE_OPTION_YES: yes
+		VOTE_OPTION_ABSTAIN: abstain
+		VOTE_OPTION_NO: no
+		VOTE_OPTION_NO_WITH_VETO: no-with-veto
+	metadata: metadata for the approval
+`,
+		Example: fmt.Sprintf("%s tx group submit-offchain-approval 1 alice VOTE_OPTION_YES \"\" --from relayer", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id: %w", err)
+			}
+
+			option, err := group.VoteOptionFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			memberAddr, memberName, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, args[1])
+			if err != nil {
+				return err
+			}
+
+			member, err := clientCtx.AddressCodec.BytesToString(memberAddr)
+			if err != nil {
+				return err
+			}
+
+			res, err := group.NewQueryClient(clientCtx).Proposal(cmd.Context(), &group.QueryProposalRequest{ProposalId: proposalID})
+			if err != nil {
+				return err
+			}
+
+			typedData, err := offchain.NewTypedData(clientCtx.Codec, clientCtx.ChainID, *res.Proposal, member, option, args[3])
+			if err != nil {
+				return err
+			}
+
+			signBytes, err := typedData.SignBytes()
+			if err != nil {
+				return err
+			}
+
+			signature, _, err := clientCtx.Keyring.Sign(memberName, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return err
+			}
+
+			submitter, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgSubmitOffchainApproval{
+				Submitter:  submitter,
+				ProposalId: proposalID,
+				Member:     member,
+				Option:     option,
+				Metadata:   args[3],
+				Signature:  signature,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
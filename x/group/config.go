@@ -20,6 +20,17 @@ type Config struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64
+
+	// MaxGroupNestingDepth defines the max depth of the group hierarchy, i.e.
+	// the max number of successive sub-groups (groups whose group policy
+	// accounts are members of another group) below a group.
+	// Defaults to 3 if not explicitly set.
+	MaxGroupNestingDepth uint64
+
+	// Authorities are the addresses of the group policy accounts configured as
+	// the authority of other modules, e.g. for their MsgUpdateParams. They are
+	// checked to be existing group policy accounts at genesis.
+	Authorities []string
 }
 
 // DefaultConfig returns the default config for group.
@@ -29,5 +40,6 @@ func DefaultConfig() Config {
 		MaxMetadataLen:        255,
 		MaxProposalTitleLen:   255,
 		MaxProposalSummaryLen: 10200,
+		MaxGroupNestingDepth:  3,
 	}
 }
@@ -17,4 +17,7 @@ var (
 	ErrMetadataTooLong = errors.Register(groupCodespace, 10, "metadata too long")
 	ErrSummaryTooLong  = errors.Register(groupCodespace, 11, "summary too long")
 	ErrTitleTooLong    = errors.Register(groupCodespace, 12, "title too long")
+
+	ErrLabelTaken        = errors.Register(groupCodespace, 13, "group policy label already taken")
+	ErrSignalingProposal = errors.Register(groupCodespace, 14, "signaling proposals cannot be executed")
 )
@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"errors"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Reason is a machine-readable reason for which a group message was rejected.
+// Reasons are stable identifiers meant to be used by clients (e.g. wallets) to
+// display translated messages. They are attached to the error, and returned in
+// the gRPC error details as the reason of a google.rpc.ErrorInfo; the ABCI code
+// and codespace of the error are left untouched.
+type Reason string
+
+const (
+	ReasonUnspecified           Reason = "REASON_UNSPECIFIED"
+	ReasonNotMember             Reason = "REASON_NOT_MEMBER"
+	ReasonNotGroupAdmin         Reason = "REASON_NOT_GROUP_ADMIN"
+	ReasonNotGroupPolicyAdmin   Reason = "REASON_NOT_GROUP_POLICY_ADMIN"
+	ReasonNotProposerOrAdmin    Reason = "REASON_NOT_PROPOSER_OR_ADMIN"
+	ReasonProposalNotOpen       Reason = "REASON_PROPOSAL_NOT_OPEN"
+	ReasonVotingPeriodEnded     Reason = "REASON_VOTING_PERIOD_ENDED"
+	ReasonPolicyVersionMismatch Reason = "REASON_POLICY_VERSION_MISMATCH"
+	ReasonMetadataMismatch      Reason = "REASON_METADATA_MISMATCH"
+	ReasonSameAdmin             Reason = "REASON_SAME_ADMIN"
+)
+
+// Wrapf wraps err with a formatted description and attaches the given reason.
+func Wrapf(err error, reason Reason, format string, args ...interface{}) error {
+	return &reasonError{err: errorsmod.Wrapf(err, format, args...), reason: reason}
+}
+
+// Wrap wraps err with a description, keeping the reason attached to err if any.
+func Wrap(err error, description string) error {
+	reason := ReasonOf(err)
+	if reason == ReasonUnspecified {
+		return errorsmod.Wrap(err, description)
+	}
+
+	return &reasonError{err: errorsmod.Wrap(err, description), reason: reason}
+}
+
+// ReasonOf returns the reason attached to err by Wrapf.
+// ReasonUnspecified is returned if err carries no reason.
+func ReasonOf(err error) Reason {
+	var reasonErr *reasonError
+	if errors.As(err, &reasonErr) {
+		return reasonErr.reason
+	}
+
+	return ReasonUnspecified
+}
+
+// reasonError is an error with a reason attached.
+type reasonError struct {
+	err    error
+	reason Reason
+}
+
+func (e *reasonError) Error() string { return e.err.Error() }
+
+// Cause is used by errorsmod.ABCIInfo to find the ABCI code and codespace.
+func (e *reasonError) Cause() error { return e.err }
+
+func (e *reasonError) Unwrap() error { return e.err }
+
+// GRPCStatus returns the gRPC status of the wrapped error, with the reason, the
+// codespace and the ABCI code of the error in a google.rpc.ErrorInfo detail.
+func (e *reasonError) GRPCStatus() *status.Status {
+	st := status.New(codes.Unknown, e.err.Error())
+	var grpcStatus interface{ GRPCStatus() *status.Status }
+	if errors.As(e.err, &grpcStatus) {
+		st = grpcStatus.GRPCStatus()
+	}
+
+	codespace, code, _ := errorsmod.ABCIInfo(e.err, false)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(e.reason),
+		Domain: groupCodespace,
+		Metadata: map[string]string{
+			"codespace": codespace,
+			"code":      strconv.FormatUint(uint64(code), 10),
+		},
+	})
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
@@ -0,0 +1,90 @@
+package errors_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/group/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestReasonOf(t *testing.T) {
+	testCases := map[string]struct {
+		err       error
+		expReason errors.Reason
+	}{
+		"nil": {
+			err:       nil,
+			expReason: errors.ReasonUnspecified,
+		},
+		"no reason": {
+			err:       sdkerrors.ErrUnauthorized.Wrap("not group admin"),
+			expReason: errors.ReasonUnspecified,
+		},
+		"with reason": {
+			err:       errors.Wrapf(sdkerrors.ErrNotFound, errors.ReasonNotMember, "%s is not part of group %d", "addr", 1),
+			expReason: errors.ReasonNotMember,
+		},
+		"wrapped with a description": {
+			err:       errors.Wrap(errors.Wrapf(sdkerrors.ErrNotFound, errors.ReasonNotMember, "unknown member %s", "addr"), "members updated"),
+			expReason: errors.ReasonNotMember,
+		},
+		"wrapped twice": {
+			err:       fmt.Errorf("exec: %w", errors.Wrapf(errors.ErrInvalid, errors.ReasonPolicyVersionMismatch, "proposal not open")),
+			expReason: errors.ReasonPolicyVersionMismatch,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expReason, errors.ReasonOf(tc.err))
+		})
+	}
+}
+
+func TestWrapf(t *testing.T) {
+	err := errors.Wrapf(sdkerrors.ErrUnauthorized, errors.ReasonNotGroupAdmin, "not group admin; got %s, expected %s", "a", "b")
+	require.Equal(t, "not group admin; got a, expected b: unauthorized", err.Error())
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	require.Equal(t, sdkerrors.ErrUnauthorized.Codespace(), codespace)
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), code)
+}
+
+func TestReasonNotParsedFromMessage(t *testing.T) {
+	// user provided text echoed in the error message cannot fake a reason
+	err := sdkerrors.ErrInvalidRequest.Wrapf("metadata %s", "REASON_NOT_MEMBER: ")
+	require.Equal(t, errors.ReasonUnspecified, errors.ReasonOf(err))
+}
+
+func TestGRPCStatus(t *testing.T) {
+	err := errors.Wrapf(sdkerrors.ErrUnauthorized, errors.ReasonNotGroupAdmin, "not group admin")
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, sdkerrors.ErrUnauthorized.GRPCStatus().Code(), st.Code())
+	require.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, string(errors.ReasonNotGroupAdmin), info.Reason)
+	require.Equal(t, "group", info.Domain)
+	require.Equal(t, map[string]string{
+		"codespace": sdkerrors.ErrUnauthorized.Codespace(),
+		"code":      strconv.FormatUint(uint64(sdkerrors.ErrUnauthorized.ABCICode()), 10),
+	}, info.Metadata)
+
+	// the detail is kept when the error is wrapped with a description
+	st, ok = status.FromError(errors.Wrap(err, "admin updated"))
+	require.True(t, ok)
+	require.Len(t, st.Details(), 1)
+	require.Equal(t, string(errors.ReasonNotGroupAdmin), st.Details()[0].(*errdetails.ErrorInfo).Reason)
+}
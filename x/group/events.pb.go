@@ -345,6 +345,62 @@ func (m *EventVote) GetProposalId() uint64 {
 	return 0
 }
 
+// EventOffchainApproval is an event emitted when an off-chain approval of a
+// proposal is submitted.
+type EventOffchainApproval struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// member is the account address of the group member who signed the approval.
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (m *EventOffchainApproval) Reset()         { *m = EventOffchainApproval{} }
+func (m *EventOffchainApproval) String() string { return proto.CompactTextString(m) }
+func (*EventOffchainApproval) ProtoMessage()    {}
+func (*EventOffchainApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{7}
+}
+func (m *EventOffchainApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOffchainApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOffchainApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOffchainApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOffchainApproval.Merge(m, src)
+}
+func (m *EventOffchainApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOffchainApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOffchainApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOffchainApproval proto.InternalMessageInfo
+
+func (m *EventOffchainApproval) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventOffchainApproval) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
 // EventExec is an event emitted when a proposal is executed.
 type EventExec struct {
 	// proposal_id is the unique ID of the proposal.
@@ -359,7 +415,7 @@ func (m *EventExec) Reset()         { *m = EventExec{} }
 func (m *EventExec) String() string { return proto.CompactTextString(m) }
 func (*EventExec) ProtoMessage()    {}
 func (*EventExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{7}
+	return fileDescriptor_e8d753981546f032, []int{8}
 }
 func (m *EventExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +477,7 @@ func (m *EventLeaveGroup) Reset()         { *m = EventLeaveGroup{} }
 func (m *EventLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*EventLeaveGroup) ProtoMessage()    {}
 func (*EventLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{8}
+	return fileDescriptor_e8d753981546f032, []int{9}
 }
 func (m *EventLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +534,7 @@ func (m *EventProposalPruned) Reset()         { *m = EventProposalPruned{} }
 func (m *EventProposalPruned) String() string { return proto.CompactTextString(m) }
 func (*EventProposalPruned) ProtoMessage()    {}
 func (*EventProposalPruned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{9}
+	return fileDescriptor_e8d753981546f032, []int{10}
 }
 func (m *EventProposalPruned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,6 +592,7 @@ func init() {
 	proto.RegisterType((*EventSubmitProposal)(nil), "cosmos.group.v1.EventSubmitProposal")
 	proto.RegisterType((*EventWithdrawProposal)(nil), "cosmos.group.v1.EventWithdrawProposal")
 	proto.RegisterType((*EventVote)(nil), "cosmos.group.v1.EventVote")
+	proto.RegisterType((*EventOffchainApproval)(nil), "cosmos.group.v1.EventOffchainApproval")
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
@@ -544,35 +601,37 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x8b, 0xda, 0x40,
-	0x18, 0xc6, 0x1d, 0x2b, 0x5a, 0xc7, 0x52, 0xcb, 0xf4, 0x0f, 0xd1, 0x4a, 0x14, 0x2f, 0xf5, 0x50,
-	0x13, 0xb4, 0xd0, 0xf6, 0x26, 0xb5, 0x48, 0x11, 0x3c, 0x48, 0xec, 0x1f, 0xe8, 0xc5, 0x46, 0x67,
-	0xb0, 0xa1, 0xd1, 0x09, 0x33, 0x93, 0x54, 0x8f, 0xfb, 0x0d, 0xf6, 0xa3, 0xec, 0x61, 0x3f, 0xc4,
-	0x1e, 0x65, 0x4f, 0x7b, 0x5c, 0xf4, 0x8b, 0x2c, 0x99, 0x4c, 0x54, 0x5c, 0x96, 0x08, 0x7b, 0x9b,
-	0x99, 0xf7, 0xf7, 0x3c, 0x79, 0x9f, 0x79, 0x33, 0xb0, 0x32, 0xa5, 0x7c, 0x4e, 0xb9, 0x39, 0x63,
-	0xd4, 0xf7, 0xcc, 0xa0, 0x65, 0x92, 0x80, 0x2c, 0x04, 0x37, 0x3c, 0x46, 0x05, 0x45, 0xc5, 0xa8,
-	0x6a, 0xc8, 0xaa, 0x11, 0xb4, 0xca, 0xa5, 0xe8, 0x60, 0x2c, 0xcb, 0xa6, 0xaa, 0xca, 0x4d, 0xf9,
-	0xed, 0xb1, 0x93, 0x58, 0x79, 0x44, 0x15, 0xeb, 0x4d, 0xf8, 0xa2, 0x17, 0x1a, 0x7f, 0x65, 0xc4,
-	0x16, 0xe4, 0x5b, 0x88, 0xa0, 0x12, 0x7c, 0x2a, 0xd9, 0xb1, 0x83, 0x35, 0x50, 0x03, 0x8d, 0x8c,
-	0x95, 0x93, 0xfb, 0x3e, 0xde, 0xe1, 0x3f, 0x3c, 0x7c, 0x0a, 0x3e, 0x80, 0x6f, 0x8e, 0xdd, 0x87,
-	0xd4, 0x75, 0xa6, 0x2b, 0xd4, 0x86, 0x39, 0x1b, 0x63, 0x46, 0x38, 0x97, 0x9a, 0x7c, 0x57, 0xbb,
-	0xbe, 0x6c, 0xbe, 0x52, 0x7d, 0x7f, 0x89, 0x2a, 0x23, 0xc1, 0x9c, 0xc5, 0xcc, 0x8a, 0xc1, 0x9d,
-	0xdb, 0xc1, 0xc7, 0x1f, 0xe1, 0xf6, 0x11, 0xbe, 0x94, 0x6e, 0x23, 0x7f, 0x32, 0x77, 0xc4, 0x90,
-	0x51, 0x8f, 0x72, 0xdb, 0x45, 0x55, 0x58, 0xf0, 0xd4, 0x7a, 0x1f, 0x08, 0xc6, 0x47, 0x7d, 0x5c,
-	0xff, 0x0c, 0x5f, 0x4b, 0xdd, 0x2f, 0x47, 0xfc, 0xc5, 0xcc, 0xfe, 0x7f, 0xba, 0xf2, 0x3d, 0xcc,
-	0x4b, 0xe5, 0x4f, 0x2a, 0x48, 0x32, 0x7d, 0x06, 0x14, 0xde, 0x5b, 0x92, 0x69, 0x22, 0x8e, 0x3a,
-	0x30, 0xcb, 0x08, 0xf7, 0x5d, 0xa1, 0xa5, 0x6b, 0xa0, 0xf1, 0xbc, 0xfd, 0xce, 0x38, 0xfa, 0x45,
-	0x8c, 0xb8, 0xd1, 0xd0, 0xcf, 0x17, 0x94, 0x59, 0x12, 0xb7, 0x94, 0x0c, 0x21, 0x98, 0x71, 0xe9,
-	0x8c, 0x6b, 0x4f, 0xc2, 0x0b, 0xb4, 0xe4, 0xba, 0xfe, 0x07, 0x16, 0x65, 0x0b, 0x03, 0x62, 0x07,
-	0x89, 0xd3, 0x3e, 0x9c, 0x42, 0xfa, 0xd4, 0x29, 0x5c, 0x00, 0x35, 0x86, 0xb8, 0xbb, 0x21, 0xf3,
-	0x17, 0x04, 0x27, 0xe7, 0xfd, 0x04, 0xb3, 0x5c, 0xd8, 0xc2, 0xe7, 0x2a, 0x6f, 0xf5, 0xc1, 0xbc,
-	0x23, 0x89, 0x59, 0x0a, 0x47, 0x1d, 0xf8, 0x4c, 0xd8, 0xae, 0xbb, 0x1a, 0xab, 0xeb, 0x0a, 0xf3,
-	0x16, 0xda, 0x95, 0x7b, 0xf2, 0xef, 0x21, 0xa4, 0xee, 0xa8, 0x20, 0xf6, 0x9b, 0xae, 0x71, 0xb5,
-	0xd1, 0xc1, 0x7a, 0xa3, 0x83, 0xdb, 0x8d, 0x0e, 0xce, 0xb7, 0x7a, 0x6a, 0xbd, 0xd5, 0x53, 0x37,
-	0x5b, 0x3d, 0xf5, 0x5b, 0x65, 0xe5, 0xf8, 0x9f, 0xe1, 0x50, 0x73, 0x19, 0xbd, 0xb8, 0x49, 0x56,
-	0xbe, 0xb4, 0x0f, 0x77, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x96, 0x2a, 0x36, 0xd2, 0x03, 0x00,
-	0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x4b, 0x95, 0xd2, 0x1b, 0xd4, 0xa2, 0xe1, 0xa1, 0xb4, 0x54, 0x6e, 0x95, 0x0d, 0x2c,
+	0xc8, 0x38, 0x0d, 0x12, 0x20, 0x36, 0x55, 0x8b, 0x2a, 0x54, 0xa9, 0x12, 0x91, 0xc3, 0x43, 0x62,
+	0x13, 0x26, 0x99, 0x69, 0x6a, 0x61, 0x7b, 0x46, 0x33, 0x63, 0xd3, 0x6c, 0x90, 0xf8, 0x03, 0x3e,
+	0x85, 0x45, 0x3f, 0x82, 0x65, 0xd5, 0x15, 0x4b, 0x94, 0xfc, 0x08, 0xf2, 0x78, 0xdc, 0x46, 0x41,
+	0xc8, 0x96, 0xba, 0x9b, 0x99, 0x7b, 0xce, 0xb9, 0xf7, 0x5c, 0x1f, 0xc3, 0xd6, 0x88, 0xab, 0x88,
+	0x2b, 0x6f, 0x2c, 0x79, 0x22, 0xbc, 0x74, 0xd7, 0x63, 0x29, 0x8b, 0xb5, 0xc2, 0x42, 0x72, 0xcd,
+	0xd1, 0x7a, 0x5e, 0xc5, 0xa6, 0x8a, 0xd3, 0xdd, 0xcd, 0x8d, 0xfc, 0x61, 0x60, 0xca, 0x9e, 0xad,
+	0x9a, 0xcb, 0xe6, 0xa3, 0x45, 0x25, 0x3d, 0x11, 0xcc, 0x16, 0x5b, 0x6d, 0xb8, 0x7b, 0x98, 0x09,
+	0xbf, 0x96, 0x8c, 0x68, 0xf6, 0x26, 0x83, 0xa0, 0x0d, 0xb8, 0x6d, 0xb0, 0x83, 0x80, 0x36, 0x9d,
+	0x1d, 0xe7, 0xc9, 0xb2, 0xbf, 0x62, 0xee, 0x47, 0xf4, 0x0a, 0xfe, 0x5e, 0xd0, 0x2a, 0xf0, 0x63,
+	0x78, 0xb8, 0xa8, 0xde, 0xe3, 0x61, 0x30, 0x9a, 0xa0, 0x2e, 0xac, 0x10, 0x4a, 0x25, 0x53, 0xca,
+	0x70, 0x56, 0x0f, 0x9a, 0x97, 0xe7, 0xed, 0xfb, 0x76, 0xee, 0xfd, 0xbc, 0xd2, 0xd7, 0x32, 0x88,
+	0xc7, 0x7e, 0x01, 0xbc, 0x52, 0x9b, 0x6b, 0x7e, 0x03, 0xb5, 0xe7, 0x70, 0xcf, 0xa8, 0xf5, 0x93,
+	0x61, 0x14, 0xe8, 0x9e, 0xe4, 0x82, 0x2b, 0x12, 0xa2, 0x6d, 0x68, 0x08, 0x7b, 0xbe, 0x36, 0x04,
+	0xc5, 0xd3, 0x11, 0x6d, 0xbd, 0x84, 0x07, 0x86, 0xf7, 0x31, 0xd0, 0xa7, 0x54, 0x92, 0xaf, 0xd5,
+	0x99, 0x4f, 0x61, 0xd5, 0x30, 0x3f, 0x70, 0xcd, 0xca, 0xd1, 0xdf, 0x6c, 0x9f, 0xb7, 0x27, 0x27,
+	0xa3, 0x53, 0x12, 0xc4, 0xfb, 0x42, 0x48, 0x9e, 0x56, 0xe8, 0x83, 0x3a, 0x50, 0x8f, 0x58, 0x34,
+	0x64, 0xb2, 0xb9, 0x54, 0xb2, 0x0c, 0x8b, 0x7b, 0x85, 0x2e, 0xcf, 0xdb, 0x6b, 0x67, 0x79, 0x42,
+	0x76, 0xd2, 0x0e, 0xee, 0xe2, 0x4e, 0xeb, 0xbb, 0x63, 0xc7, 0x3d, 0x3c, 0x63, 0xa3, 0xf2, 0xa6,
+	0x7b, 0x50, 0x97, 0x4c, 0x25, 0xa1, 0x36, 0x4d, 0xd7, 0xba, 0x8f, 0xf1, 0x42, 0x44, 0x71, 0xb1,
+	0xa8, 0x4c, 0x2f, 0xd1, 0x5c, 0xfa, 0x06, 0xee, 0x5b, 0x1a, 0x42, 0xb0, 0x1c, 0xf2, 0xb1, 0x6a,
+	0xde, 0xca, 0x66, 0xf6, 0xcd, 0xb9, 0xf5, 0x19, 0xd6, 0xcd, 0x08, 0xc7, 0x8c, 0xa4, 0xa5, 0x69,
+	0x9b, 0x4f, 0xc1, 0x52, 0xd5, 0x14, 0xfc, 0x74, 0x6c, 0x0c, 0x8a, 0xe9, 0x7a, 0x32, 0x89, 0x19,
+	0x2d, 0xf7, 0xfb, 0x02, 0xea, 0x4a, 0x13, 0x9d, 0x28, 0xeb, 0x77, 0xfb, 0xbf, 0x7e, 0xfb, 0x06,
+	0xe6, 0x5b, 0x38, 0xda, 0x83, 0x3b, 0x9a, 0x84, 0xe1, 0x64, 0x60, 0xd7, 0x95, 0xf9, 0x6d, 0x74,
+	0xb7, 0xfe, 0xa1, 0xbf, 0xcb, 0x40, 0x76, 0x47, 0x0d, 0x7d, 0x7d, 0x39, 0xc0, 0xbf, 0xa6, 0xae,
+	0x73, 0x31, 0x75, 0x9d, 0x3f, 0x53, 0xd7, 0xf9, 0x31, 0x73, 0x6b, 0x17, 0x33, 0xb7, 0xf6, 0x7b,
+	0xe6, 0xd6, 0x3e, 0x59, 0xaf, 0x8a, 0x7e, 0xc1, 0x01, 0xf7, 0xec, 0xf7, 0x1c, 0xd6, 0xcd, 0x9f,
+	0xfe, 0xec, 0xef, 0x00, 0x1c, 0xcc, 0xd8, 0x08, 0x52, 0x04, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -775,6 +834,41 @@ func (m *EventVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOffchainApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOffchainApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOffchainApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -992,6 +1086,22 @@ func (m *EventVote) Size() (n int) {
 	return n
 }
 
+func (m *EventOffchainApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventExec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1561,6 +1671,107 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOffchainApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOffchainApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOffchainApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
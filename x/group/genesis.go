@@ -6,6 +6,7 @@ import (
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/group/errors"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -20,6 +21,7 @@ func NewGenesisState() *GenesisState {
 func (s GenesisState) Validate() error {
 	groups := make(map[uint64]GroupInfo)
 	groupPolicies := make(map[string]GroupPolicyInfo)
+	groupPolicyLabels := make(map[string]struct{})
 	groupMembers := make(map[uint64]GroupMember)
 	proposals := make(map[uint64]Proposal)
 
@@ -40,6 +42,15 @@ func (s GenesisState) Validate() error {
 		if err := g.ValidateBasic(); err != nil {
 			return errorsmod.Wrap(err, "GroupPolicy validation failed")
 		}
+
+		// check that the group policy label is unique within its group
+		if g.Label != "" {
+			labelKey := fmt.Sprintf("%d/%s", g.GroupId, g.Label)
+			if _, exists := groupPolicyLabels[labelKey]; exists {
+				return errorsmod.Wrapf(errors.ErrLabelTaken, "label %s in group %d", g.Label, g.GroupId)
+			}
+			groupPolicyLabels[labelKey] = struct{}{}
+		}
 		groupPolicies[g.Address] = *g
 	}
 
@@ -80,6 +91,18 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, a := range s.OffchainApprovals {
+
+		if err := a.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "OffchainApproval validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[a.ProposalId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", a.ProposalId))
+		}
+	}
 	return nil
 }
 
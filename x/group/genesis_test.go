@@ -61,6 +61,14 @@ func TestGenesisStateValidate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// create labeled group policies to test label validation and uniqueness
+	labeledPolicy := *groupPolicy
+	labeledPolicy.Label = "treasury"
+	labeledPolicy2 := labeledPolicy
+	labeledPolicy2.Address = memberStrAdrr
+	invalidLabelPolicy := labeledPolicy
+	invalidLabelPolicy.Label = "Treasury"
+
 	proposal := &Proposal{
 		Id:                 1,
 		GroupPolicyAddress: accStrAddr,
@@ -96,14 +104,15 @@ func TestGenesisStateValidate(t *testing.T) {
 		{
 			"valid genesisState",
 			GenesisState{
-				GroupSeq:       2,
-				Groups:         []*GroupInfo{{Id: 1, Admin: accStrAddr, Metadata: "1", Version: 1, TotalWeight: "1"}, {Id: 2, Admin: accStrAddr, Metadata: "2", Version: 2, TotalWeight: "2"}},
-				GroupMembers:   []*GroupMember{{GroupId: 1, Member: &Member{Address: memberStrAdrr, Weight: "1", Metadata: "member metadata"}}, {GroupId: 2, Member: &Member{Address: memberStrAdrr, Weight: "2", Metadata: "member metadata"}}},
-				GroupPolicySeq: 1,
-				GroupPolicies:  []*GroupPolicyInfo{groupPolicy},
-				ProposalSeq:    1,
-				Proposals:      []*Proposal{proposal},
-				Votes:          []*Vote{{ProposalId: proposal.Id, Voter: memberStrAdrr, SubmitTime: submittedAt, Option: VOTE_OPTION_YES}},
+				GroupSeq:          2,
+				Groups:            []*GroupInfo{{Id: 1, Admin: accStrAddr, Metadata: "1", Version: 1, TotalWeight: "1"}, {Id: 2, Admin: accStrAddr, Metadata: "2", Version: 2, TotalWeight: "2"}},
+				GroupMembers:      []*GroupMember{{GroupId: 1, Member: &Member{Address: memberStrAdrr, Weight: "1", Metadata: "member metadata"}}, {GroupId: 2, Member: &Member{Address: memberStrAdrr, Weight: "2", Metadata: "member metadata"}}},
+				GroupPolicySeq:    1,
+				GroupPolicies:     []*GroupPolicyInfo{groupPolicy},
+				ProposalSeq:       1,
+				Proposals:         []*Proposal{proposal},
+				Votes:             []*Vote{{ProposalId: proposal.Id, Voter: memberStrAdrr, SubmitTime: submittedAt, Option: VOTE_OPTION_YES}},
+				OffchainApprovals: []*OffchainApproval{{ProposalId: proposal.Id, Member: memberStrAdrr, Option: VOTE_OPTION_YES, Signature: []byte("signature"), SubmitTime: submittedAt}},
 			},
 			false,
 		},
@@ -244,6 +253,38 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid group policy label",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accStrAddr,
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{&invalidLabelPolicy},
+			},
+			true,
+		},
+		{
+			"duplicate group policy label",
+			GenesisState{
+				Groups: []*GroupInfo{
+					{
+						Id:          1,
+						Admin:       accStrAddr,
+						Metadata:    "1",
+						Version:     1,
+						TotalWeight: "1",
+					},
+				},
+				GroupPolicies: []*GroupPolicyInfo{&labeledPolicy, &labeledPolicy2},
+			},
+			true,
+		},
 		{
 			"invalid group policy version",
 			GenesisState{
@@ -712,6 +753,39 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"off-chain approval on proposal that doesn't exist",
+			GenesisState{
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				OffchainApprovals: []*OffchainApproval{
+					{
+						ProposalId: 2,
+						Member:     memberStrAdrr,
+						Option:     VOTE_OPTION_YES,
+						Signature:  []byte("signature"),
+						SubmitTime: submittedAt,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"off-chain approval without signature",
+			GenesisState{
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				OffchainApprovals: []*OffchainApproval{
+					{
+						ProposalId: proposal.Id,
+						Member:     memberStrAdrr,
+						Option:     VOTE_OPTION_YES,
+						SubmitTime: submittedAt,
+					},
+				},
+			},
+			true,
+		},
 		{
 			"invalid vote option",
 			GenesisState{
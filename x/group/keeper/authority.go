@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// groupPolicyCredential returns the credential of the group policy account
+// derived from the given group policy sequence value.
+func groupPolicyCredential(seq uint64) (*authtypes.ModuleCredential, error) {
+	derivationKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(derivationKey, seq)
+
+	return authtypes.NewModuleCredential(group.ModuleName, []byte{GroupPolicyTablePrefix}, derivationKey)
+}
+
+// GroupPolicyAddress returns the address of the group policy account derived
+// from the given group policy sequence value, i.e. the address of the n-th
+// group policy account created on chain, unless an address collision occurred.
+// Apps can use it to configure the authority of a module with a group policy
+// account created at genesis.
+func GroupPolicyAddress(seq uint64) (sdk.AccAddress, error) {
+	ac, err := groupPolicyCredential(seq)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.AccAddress(ac.Address()), nil
+}
+
+// IsGroupPolicyAccount returns whether the given address is the address of an
+// existing group policy account.
+func (k Keeper) IsGroupPolicyAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	addrStr, err := k.accKeeper.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = k.getGroupPolicyInfo(ctx, addrStr)
+	if sdkerrors.ErrNotFound.Is(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// ValidateGroupPolicyAuthority checks that the given authority, e.g. the one
+// of another module, is the address of an existing group policy account, so
+// that the module is governed by the group.
+func (k Keeper) ValidateGroupPolicyAuthority(ctx context.Context, authority string) error {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "authority %s: %s", authority, err)
+	}
+
+	ok, err := k.IsGroupPolicyAccount(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errorsmod.Wrapf(errors.ErrInvalid, "authority %s is not a group policy account", authority)
+	}
+
+	return nil
+}
+
+// validateAuthorities checks that the authorities configured in the module
+// config are existing group policy accounts.
+func (k Keeper) validateAuthorities(ctx context.Context) error {
+	for _, authority := range k.config.Authorities {
+		if err := k.ValidateGroupPolicyAuthority(ctx, authority); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/keeper"
+)
+
+func (s *TestSuite) TestValidateGroupPolicyAuthority() {
+	addr, err := keeper.GroupPolicyAddress(1)
+	s.Require().NoError(err)
+	s.Require().Equal(s.groupPolicyAddr, addr)
+
+	ok, err := s.groupKeeper.IsGroupPolicyAccount(s.ctx, s.groupPolicyAddr)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+
+	ok, err = s.groupKeeper.IsGroupPolicyAccount(s.ctx, s.addrs[0])
+	s.Require().NoError(err)
+	s.Require().False(ok)
+
+	s.Require().NoError(s.groupKeeper.ValidateGroupPolicyAuthority(s.ctx, s.groupPolicyStrAddr))
+	s.Require().ErrorIs(s.groupKeeper.ValidateGroupPolicyAuthority(s.ctx, s.addrsStr[0]), errors.ErrInvalid)
+}
@@ -0,0 +1,4 @@
+package keeper
+
+// EnsureMsgAuthZ is exported for testing.
+var EnsureMsgAuthZ = ensureMsgAuthZ
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/x/group"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // InitGenesis initializes the group module's genesis state.
@@ -41,7 +42,30 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		return errors.Wrap(err, "votes")
 	}
 
-	return nil
+	if err := k.offchainApprovalTable.Import(store, genesisState.OffchainApprovals, 0); err != nil {
+		return errors.Wrap(err, "off-chain approvals")
+	}
+
+	// the group links are derived from the group members and policies
+	for _, gm := range genesisState.GroupMembers {
+		policyInfo, err := k.getGroupPolicyInfo(ctx, gm.Member.Address)
+		if sdkerrors.ErrNotFound.Is(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "group policy")
+		}
+
+		if err := k.setGroupLink(ctx, group.GroupLink{
+			ParentGroupId: gm.GroupId,
+			Member:        gm.Member.Address,
+			ChildGroupId:  policyInfo.GroupId,
+		}); err != nil {
+			return errors.Wrap(err, "group links")
+		}
+	}
+
+	return errors.Wrap(k.validateAuthorities(ctx), "authorities")
 }
 
 // ExportGenesis returns the group module's exported genesis.
@@ -89,5 +113,12 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) (*group.Ge
 	}
 	genesisState.Votes = votes
 
+	var approvals []*group.OffchainApproval
+	_, err = k.offchainApprovalTable.Export(store, &approvals)
+	if err != nil {
+		return nil, errors.Wrap(err, "off-chain approvals")
+	}
+	genesisState.OffchainApprovals = approvals
+
 	return genesisState, nil
 }
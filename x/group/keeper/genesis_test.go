@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	coreaddress "cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
@@ -33,11 +34,13 @@ import (
 type GenesisTestSuite struct {
 	suite.Suite
 
-	ctx          context.Context
-	sdkCtx       sdk.Context
-	keeper       keeper.Keeper
-	cdc          *codec.ProtoCodec
-	addressCodec coreaddress.Codec
+	ctx           context.Context
+	sdkCtx        sdk.Context
+	env           appmodule.Environment
+	accountKeeper *grouptestutil.MockAccountKeeper
+	keeper        keeper.Keeper
+	cdc           *codec.ProtoCodec
+	addressCodec  coreaddress.Codec
 }
 
 func TestGenesisTestSuite(t *testing.T) {
@@ -77,8 +80,9 @@ func (s *GenesisTestSuite) SetupTest() {
 	s.ctx = s.sdkCtx
 	s.addressCodec = address.NewBech32Codec("cosmos")
 
-	env := runtime.NewEnvironment(storeService, log.NewNopLogger(), runtime.EnvWithQueryRouterService(bApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(bApp.MsgServiceRouter()))
-	s.keeper = keeper.NewKeeper(env, s.cdc, accountKeeper, group.DefaultConfig())
+	s.env = runtime.NewEnvironment(storeService, log.NewNopLogger(), runtime.EnvWithQueryRouterService(bApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(bApp.MsgServiceRouter()))
+	s.accountKeeper = accountKeeper
+	s.keeper = keeper.NewKeeper(s.env, s.cdc, accountKeeper, group.DefaultConfig())
 }
 
 func (s *GenesisTestSuite) TestInitExportGenesis() {
@@ -137,14 +141,15 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	s.Require().NoError(err)
 
 	genesisState := &group.GenesisState{
-		GroupSeq:       2,
-		Groups:         []*group.GroupInfo{{Id: 1, Admin: accStrAddr, Metadata: "1", Version: 1, TotalWeight: "1"}, {Id: 2, Admin: accStrAddr, Metadata: "2", Version: 2, TotalWeight: "2"}},
-		GroupMembers:   []*group.GroupMember{{GroupId: 1, Member: &group.Member{Address: memberStrAddr, Weight: "1", Metadata: "member metadata"}}, {GroupId: 2, Member: &group.Member{Address: memberStrAddr, Weight: "2", Metadata: "member metadata"}}},
-		GroupPolicySeq: 1,
-		GroupPolicies:  []*group.GroupPolicyInfo{groupPolicy},
-		ProposalSeq:    1,
-		Proposals:      []*group.Proposal{proposal},
-		Votes:          []*group.Vote{{ProposalId: proposal.Id, Voter: memberStrAddr, SubmitTime: submittedAt, Option: group.VOTE_OPTION_YES}},
+		GroupSeq:          2,
+		Groups:            []*group.GroupInfo{{Id: 1, Admin: accStrAddr, Metadata: "1", Version: 1, TotalWeight: "1"}, {Id: 2, Admin: accStrAddr, Metadata: "2", Version: 2, TotalWeight: "2"}},
+		GroupMembers:      []*group.GroupMember{{GroupId: 1, Member: &group.Member{Address: memberStrAddr, Weight: "1", Metadata: "member metadata"}}, {GroupId: 2, Member: &group.Member{Address: memberStrAddr, Weight: "2", Metadata: "member metadata"}}},
+		GroupPolicySeq:    1,
+		GroupPolicies:     []*group.GroupPolicyInfo{groupPolicy},
+		ProposalSeq:       1,
+		Proposals:         []*group.Proposal{proposal},
+		Votes:             []*group.Vote{{ProposalId: proposal.Id, Voter: memberStrAddr, SubmitTime: submittedAt, Option: group.VOTE_OPTION_YES}},
+		OffchainApprovals: []*group.OffchainApproval{{ProposalId: proposal.Id, Member: memberStrAddr, Option: group.VOTE_OPTION_YES, Signature: []byte("signature"), SubmitTime: submittedAt}},
 	}
 	genesisBytes, err := cdc.MarshalJSON(genesisState)
 	s.Require().NoError(err)
@@ -220,12 +225,42 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.assertProposalsEqual(g, res)
 	}
 	s.Require().Equal(genesisState.Votes, exportedGenesisState.Votes)
+	s.Require().Equal(genesisState.OffchainApprovals, exportedGenesisState.OffchainApprovals)
 
 	s.Require().Equal(genesisState.GroupSeq, exportedGenesisState.GroupSeq)
 	s.Require().Equal(genesisState.GroupPolicySeq, exportedGenesisState.GroupPolicySeq)
 	s.Require().Equal(genesisState.ProposalSeq, exportedGenesisState.ProposalSeq)
 }
 
+func (s *GenesisTestSuite) TestInitGenesisAuthorities() {
+	accStrAddr, err := s.addressCodec.BytesToString(accAddr)
+	s.Require().NoError(err)
+	memberStrAddr, err := s.addressCodec.BytesToString(memberAddr)
+	s.Require().NoError(err)
+
+	groupPolicy := &group.GroupPolicyInfo{Address: accStrAddr, GroupId: 1, Admin: accStrAddr, Version: 1}
+	s.Require().NoError(groupPolicy.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Second, 0)))
+	genesisBytes, err := s.cdc.MarshalJSON(&group.GenesisState{
+		GroupSeq:       1,
+		Groups:         []*group.GroupInfo{{Id: 1, Admin: accStrAddr, Version: 1, TotalWeight: "0"}},
+		GroupPolicySeq: 1,
+		GroupPolicies:  []*group.GroupPolicyInfo{groupPolicy},
+	})
+	s.Require().NoError(err)
+
+	config := group.DefaultConfig()
+	config.Authorities = []string{accStrAddr, memberStrAddr}
+	ctx, _ := s.sdkCtx.CacheContext()
+	err = keeper.NewKeeper(s.env, s.cdc, s.accountKeeper, config).InitGenesis(ctx, s.cdc, genesisBytes)
+	s.Require().ErrorContains(err, "is not a group policy account")
+
+	config.Authorities = []string{accStrAddr}
+	k := keeper.NewKeeper(s.env, s.cdc, s.accountKeeper, config)
+	s.Require().NoError(k.InitGenesis(s.sdkCtx, s.cdc, genesisBytes))
+	s.Require().NoError(k.ValidateGroupPolicyAuthority(s.sdkCtx, accStrAddr))
+	s.Require().ErrorContains(k.ValidateGroupPolicyAuthority(s.sdkCtx, "invalid"), "invalid address")
+}
+
 func (s *GenesisTestSuite) assertGroupPoliciesEqual(g, other *group.GroupPolicyInfo) {
 	require := s.Require()
 	require.Equal(g.Address, other.Address)
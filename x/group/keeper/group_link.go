@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/orm"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// linkGroupMember links the group to the sub-group of its member if the member
+// is a group policy account, making sure that the group hierarchy stays
+// acyclic and within the max nesting depth.
+func (k Keeper) linkGroupMember(ctx context.Context, groupID uint64, member string) error {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, member)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return nil
+	case err != nil:
+		return errorsmod.Wrap(err, "get group policy")
+	}
+
+	if err := k.assertGroupLinkAllowed(ctx, groupID, policyInfo.GroupId); err != nil {
+		return err
+	}
+
+	return k.setGroupLink(ctx, group.GroupLink{
+		ParentGroupId: groupID,
+		Member:        member,
+		ChildGroupId:  policyInfo.GroupId,
+	})
+}
+
+// setGroupLink stores the group link if it does not exist yet.
+func (k Keeper) setGroupLink(ctx context.Context, link group.GroupLink) error {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	if k.groupLinkTable.Contains(kvStore, &link) {
+		return nil
+	}
+
+	return k.groupLinkTable.Create(kvStore, &link)
+}
+
+// unlinkGroupMember removes the link of the group to the sub-group of its
+// member, if any.
+func (k Keeper) unlinkGroupMember(ctx context.Context, groupID uint64, member string) error {
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	link := group.GroupLink{ParentGroupId: groupID, Member: member}
+	if !k.groupLinkTable.Contains(kvStore, &link) {
+		return nil
+	}
+
+	return k.groupLinkTable.Delete(kvStore, &link)
+}
+
+// assertGroupLinkAllowed checks that linking the parent group to the child
+// group neither creates a cycle nor exceeds the max nesting depth.
+func (k Keeper) assertGroupLinkAllowed(ctx context.Context, parentGroupID, childGroupID uint64) error {
+	if parentGroupID == childGroupID {
+		return errorsmod.Wrap(errors.ErrInvalid, "a group cannot be its own sub-group")
+	}
+
+	depth, err := k.parentGroupsDepth(ctx, parentGroupID, 0)
+	if err != nil {
+		return err
+	}
+
+	return k.assertSubGroupsDepth(ctx, childGroupID, parentGroupID, depth+1)
+}
+
+// parentGroupsDepth returns the number of successive parent groups above the
+// group, depth being the number of parent groups already traversed.
+func (k Keeper) parentGroupsDepth(ctx context.Context, groupID, depth uint64) (uint64, error) {
+	if depth > k.config.MaxGroupNestingDepth {
+		return 0, errors.ErrMaxLimit.Wrapf("group nesting depth cannot exceed %d", k.config.MaxGroupNestingDepth)
+	}
+
+	links, err := k.groupLinks(ctx, k.groupLinkByChildGroupIndex, groupID)
+	if err != nil {
+		return 0, err
+	}
+
+	maxDepth := depth
+	for _, link := range links {
+		d, err := k.parentGroupsDepth(ctx, link.ParentGroupId, depth+1)
+		if err != nil {
+			return 0, err
+		}
+		maxDepth = max(maxDepth, d)
+	}
+
+	return maxDepth, nil
+}
+
+// assertSubGroupsDepth checks that the successive sub-groups of the group,
+// found at the given depth of the hierarchy, neither include the root group
+// nor exceed the max nesting depth.
+func (k Keeper) assertSubGroupsDepth(ctx context.Context, groupID, rootGroupID, depth uint64) error {
+	if depth > k.config.MaxGroupNestingDepth {
+		return errors.ErrMaxLimit.Wrapf("group nesting depth cannot exceed %d", k.config.MaxGroupNestingDepth)
+	}
+
+	links, err := k.groupLinks(ctx, k.groupLinkByParentGroupIndex, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if link.ChildGroupId == rootGroupID {
+			return errorsmod.Wrapf(errors.ErrInvalid, "group %d is a sub-group of group %d", rootGroupID, groupID)
+		}
+
+		if err := k.assertSubGroupsDepth(ctx, link.ChildGroupId, rootGroupID, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// groupLinks returns the group links of the group from the given index.
+func (k Keeper) groupLinks(ctx context.Context, index orm.Index, groupID uint64) ([]group.GroupLink, error) {
+	it, err := index.Get(k.KVStoreService.OpenKVStore(ctx), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var links []group.GroupLink
+	for {
+		var link group.GroupLink
+		_, err := it.LoadNext(&link)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// groupIDsByMember returns the ids of the groups the address is a member of.
+func (k Keeper) groupIDsByMember(ctx context.Context, member []byte) ([]uint64, error) {
+	it, err := k.groupMemberByMemberIndex.Get(k.KVStoreService.OpenKVStore(ctx), member)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var groupIDs []uint64
+	for {
+		var groupMember group.GroupMember
+		_, err := it.LoadNext(&groupMember)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		groupIDs = append(groupIDs, groupMember.GroupId)
+	}
+
+	return groupIDs, nil
+}
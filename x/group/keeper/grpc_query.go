@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/x/group/internal/orm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -57,6 +58,45 @@ func (k Keeper) getGroupPolicyInfo(ctx context.Context, accountAddress string) (
 	return obj, k.groupPolicyTable.GetOne(k.KVStoreService.OpenKVStore(ctx), orm.PrimaryKey(&group.GroupPolicyInfo{Address: accountAddress}, k.accKeeper.AddressCodec()), &obj)
 }
 
+// GroupPolicyByLabel queries the group policy of a group with the given label.
+func (k Keeper) GroupPolicyByLabel(ctx context.Context, request *group.QueryGroupPolicyByLabelRequest) (*group.QueryGroupPolicyByLabelResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := group.ValidateGroupPolicyLabel(request.Label); err != nil {
+		return nil, err
+	}
+	if request.Label == "" {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "label")
+	}
+
+	groupPolicyInfo, err := k.getGroupPolicyByLabel(ctx, request.GroupId, request.Label)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "group policy")
+	}
+
+	return &group.QueryGroupPolicyByLabelResponse{Info: &groupPolicyInfo}, nil
+}
+
+// getGroupPolicyByLabel gets the group policy info of the given group with the given label.
+func (k Keeper) getGroupPolicyByLabel(ctx context.Context, groupID uint64, label string) (group.GroupPolicyInfo, error) {
+	var obj group.GroupPolicyInfo
+	it, err := k.groupPolicyByLabelIndex.Get(k.KVStoreService.OpenKVStore(ctx), groupPolicyLabelKey(groupID, label))
+	if err != nil {
+		return obj, err
+	}
+	defer it.Close()
+
+	if _, err := it.LoadNext(&obj); err != nil {
+		if errors.ErrORMIteratorDone.Is(err) {
+			return obj, sdkerrors.ErrNotFound.Wrapf("no group policy with label %s in group %d", label, groupID)
+		}
+		return obj, err
+	}
+	return obj, nil
+}
+
 // GroupMembers queries all members of a group.
 func (k Keeper) GroupMembers(ctx context.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
 	groupID := request.GroupId
@@ -271,6 +311,75 @@ func (k Keeper) VotesByVoter(ctx context.Context, request *group.QueryVotesByVot
 	}, nil
 }
 
+// OffchainApprovalsByProposal queries all off-chain approvals of a proposal.
+func (k Keeper) OffchainApprovalsByProposal(ctx context.Context, request *group.QueryOffchainApprovalsByProposalRequest) (*group.QueryOffchainApprovalsByProposalResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	it, err := k.offchainApprovalByProposalIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), request.ProposalId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var approvals []*group.OffchainApproval
+	pageRes, err := orm.Paginate(it, request.Pagination, &approvals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryOffchainApprovalsByProposalResponse{
+		Approvals:  approvals,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SubGroups queries the links of a group to its sub-groups.
+func (k Keeper) SubGroups(ctx context.Context, request *group.QuerySubGroupsRequest) (*group.QuerySubGroupsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	it, err := k.groupLinkByParentGroupIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), request.GroupId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []*group.GroupLink
+	pageRes, err := orm.Paginate(it, request.Pagination, &links)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QuerySubGroupsResponse{
+		Links:      links,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ParentGroups queries the links of a group to its parent groups.
+func (k Keeper) ParentGroups(ctx context.Context, request *group.QueryParentGroupsRequest) (*group.QueryParentGroupsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	it, err := k.groupLinkByChildGroupIndex.GetPaginated(k.KVStoreService.OpenKVStore(ctx), request.GroupId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []*group.GroupLink
+	pageRes, err := orm.Paginate(it, request.Pagination, &links)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryParentGroupsResponse{
+		Links:      links,
+		Pagination: pageRes,
+	}, nil
+}
+
 // GroupsByMember queries all groups where the given address is a member of.
 func (k Keeper) GroupsByMember(ctx context.Context, request *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error) {
 	if request == nil {
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HealthCheck checks the consistency of the group tables and their indexes:
+// the total weight of each group must be equal to the weights of the members
+// indexed by group, and each group policy must belong to an existing group.
+func (k Keeper) HealthCheck(ctx context.Context) error {
+	msg, broken := GroupTotalWeightInvariantHelper(sdk.UnwrapSDKContext(ctx), k.KVStoreService, k.groupTable, k.groupMemberByGroupIndex)
+	if broken || msg != "" {
+		return fmt.Errorf("%s", strings.TrimSpace(msg))
+	}
+
+	store := k.KVStoreService.OpenKVStore(ctx)
+	it, err := k.groupPolicyTable.PrefixScan(store, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for {
+		var policy group.GroupPolicyInfo
+		_, err := it.LoadNext(&policy)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if !k.groupTable.Has(store, policy.GroupId) {
+			return fmt.Errorf("group policy %s belongs to unknown group %d", policy.Address, policy.GroupId)
+		}
+	}
+
+	return nil
+}
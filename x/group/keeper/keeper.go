@@ -31,6 +31,7 @@ const (
 	GroupPolicyTableSeqPrefix     byte = 0x21
 	GroupPolicyByGroupIndexPrefix byte = 0x22
 	GroupPolicyByAdminIndexPrefix byte = 0x23
+	GroupPolicyByLabelIndexPrefix byte = 0x24
 
 	// Proposal Table
 	ProposalTablePrefix              byte = 0x30
@@ -42,6 +43,15 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Off-chain Approval Table
+	OffchainApprovalTablePrefix           byte = 0x50
+	OffchainApprovalByProposalIndexPrefix byte = 0x51
+
+	// Group Link Table
+	GroupLinkTablePrefix              byte = 0x60
+	GroupLinkByParentGroupIndexPrefix byte = 0x61
+	GroupLinkByChildGroupIndexPrefix  byte = 0x62
 )
 
 type Keeper struct {
@@ -62,6 +72,7 @@ type Keeper struct {
 	groupPolicyTable        orm.PrimaryKeyTable
 	groupPolicyByGroupIndex orm.Index
 	groupPolicyByAdminIndex orm.Index
+	groupPolicyByLabelIndex orm.Index
 
 	// Proposal Table
 	proposalTable              orm.AutoUInt64Table
@@ -73,6 +84,15 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Off-chain Approval Table
+	offchainApprovalTable           orm.PrimaryKeyTable
+	offchainApprovalByProposalIndex orm.Index
+
+	// Group Link Table
+	groupLinkTable              orm.PrimaryKeyTable
+	groupLinkByParentGroupIndex orm.Index
+	groupLinkByChildGroupIndex  orm.Index
+
 	config group.Config
 
 	cdc codec.Codec
@@ -92,6 +112,7 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 		config.MaxMetadataLen = 1000 			// example metadata length in bytes
 		config.MaxProposalTitleLen = 255 		// example max title length in characters
 		config.MaxProposalSummaryLen = 10200 	// example max summary length in characters
+		config.MaxGroupNestingDepth = 3 		// example max nesting depth of groups
 	*/
 
 	defaultConfig := group.DefaultConfig()
@@ -111,6 +132,10 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if config.MaxProposalSummaryLen <= 0 {
 		config.MaxProposalSummaryLen = defaultConfig.MaxProposalSummaryLen
 	}
+	// If MaxGroupNestingDepth not set by app developer, set to default value.
+	if config.MaxGroupNestingDepth <= 0 {
+		config.MaxGroupNestingDepth = defaultConfig.MaxGroupNestingDepth
+	}
 	k.config = config
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc, k.accKeeper.AddressCodec())
@@ -177,6 +202,17 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if err != nil {
 		panic(err.Error())
 	}
+	k.groupPolicyByLabelIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByLabelIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		policy := value.(*group.GroupPolicyInfo)
+		// group policies without a label are not indexed
+		if policy.Label == "" {
+			return nil, nil
+		}
+		return []interface{}{groupPolicyLabelKey(policy.GroupId, policy.Label)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	k.groupPolicyTable = *groupPolicyTable
 
 	// Proposal Table
@@ -227,6 +263,38 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	}
 	k.voteTable = *voteTable
 
+	// Off-chain Approval Table
+	offchainApprovalTable, err := orm.NewPrimaryKeyTable([2]byte{OffchainApprovalTablePrefix}, &group.OffchainApproval{}, cdc, k.accKeeper.AddressCodec())
+	if err != nil {
+		panic(err.Error())
+	}
+	k.offchainApprovalByProposalIndex, err = orm.NewIndex(offchainApprovalTable, OffchainApprovalByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.OffchainApproval).ProposalId}, nil
+	}, group.OffchainApproval{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.offchainApprovalTable = *offchainApprovalTable
+
+	// Group Link Table
+	groupLinkTable, err := orm.NewPrimaryKeyTable([2]byte{GroupLinkTablePrefix}, &group.GroupLink{}, cdc, k.accKeeper.AddressCodec())
+	if err != nil {
+		panic(err.Error())
+	}
+	k.groupLinkByParentGroupIndex, err = orm.NewIndex(groupLinkTable, GroupLinkByParentGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.GroupLink).ParentGroupId}, nil
+	}, group.GroupLink{}.ParentGroupId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.groupLinkByChildGroupIndex, err = orm.NewIndex(groupLinkTable, GroupLinkByChildGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.GroupLink).ChildGroupId}, nil
+	}, group.GroupLink{}.ChildGroupId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.groupLinkTable = *groupLinkTable
+
 	return k
 }
 
@@ -235,6 +303,12 @@ func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
 	return k.groupTable.Sequence().CurVal(k.KVStoreService.OpenKVStore(ctx))
 }
 
+// groupPolicyLabelKey returns the group policy label index key, made of the
+// big-endian encoded group id followed by the label.
+func groupPolicyLabelKey(groupID uint64, label string) []byte {
+	return append(orm.EncodeSequence(groupID), label...)
+}
+
 // GetGroupPolicySeq returns the current value of the group policy table sequence
 func (k Keeper) GetGroupPolicySeq(ctx sdk.Context) uint64 {
 	return k.groupPolicySeq.CurVal(k.KVStoreService.OpenKVStore(ctx))
@@ -329,7 +403,7 @@ func (k Keeper) proposalsByGroupPolicy(ctx context.Context, groupPolicyAddr sdk.
 	return proposals, nil
 }
 
-// pruneVotes prunes all votes for a proposal from state.
+// pruneVotes prunes all votes and off-chain approvals for a proposal from state.
 func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
 	votes, err := k.votesByProposal(ctx, proposalID)
 	if err != nil {
@@ -343,6 +417,18 @@ func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
 		}
 	}
 
+	approvals, err := k.offchainApprovalsByProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range approvals {
+		err = k.offchainApprovalTable.Delete(k.KVStoreService.OpenKVStore(ctx), &a)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -369,6 +455,29 @@ func (k Keeper) votesByProposal(ctx context.Context, proposalID uint64) ([]group
 	return votes, nil
 }
 
+// offchainApprovalsByProposal returns all off-chain approvals for a given proposal.
+func (k Keeper) offchainApprovalsByProposal(ctx context.Context, proposalID uint64) ([]group.OffchainApproval, error) {
+	it, err := k.offchainApprovalByProposalIndex.Get(k.KVStoreService.OpenKVStore(ctx), proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var approvals []group.OffchainApproval
+	for {
+		var approval group.OffchainApproval
+		_, err = it.LoadNext(&approval)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return approvals, err
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, nil
+}
+
 // PruneProposals prunes all proposals that are expired, i.e. whose
 // `voting_period + max_execution_period` is greater than the current block
 // time.
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"encoding/binary"
+	"fmt"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	minttypes "cosmossdk.io/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -38,6 +40,7 @@ type TestSuite struct {
 
 	sdkCtx             sdk.Context
 	ctx                context.Context
+	key                *storetypes.KVStoreKey
 	addrs              []sdk.AccAddress
 	addrsStr           []string
 	groupID            uint64
@@ -48,11 +51,13 @@ type TestSuite struct {
 	blockTime          time.Time
 	bankKeeper         *grouptestutil.MockBankKeeper
 	accountKeeper      *grouptestutil.MockAccountKeeper
+	cdc                codec.Codec
 }
 
 func (s *TestSuite) SetupTest() {
 	s.blockTime = time.Now().Round(0).UTC()
 	key := storetypes.NewKVStoreKey(group.StoreKey)
+	s.key = key
 
 	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{}, bank.AppModule{})
@@ -85,6 +90,7 @@ func (s *TestSuite) SetupTest() {
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger(), runtime.EnvWithQueryRouterService(bApp.GRPCQueryRouter()), runtime.EnvWithMsgRouterService(bApp.MsgServiceRouter()))
 	config := group.DefaultConfig()
 	s.groupKeeper = keeper.NewKeeper(env, encCfg.Codec, s.accountKeeper, config)
+	s.cdc = encCfg.Codec
 	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: s.blockTime})
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
@@ -297,6 +303,17 @@ func (s *TestSuite) TestProposalsByVPEnd() {
 	}
 }
 
+func (s *TestSuite) TestHealthCheck() {
+	s.Require().NoError(s.groupKeeper.HealthCheck(s.ctx))
+
+	// remove the group of the group policy
+	store := s.sdkCtx.KVStore(s.key)
+	store.Delete(append([]byte{keeper.GroupTablePrefix, 0}, sdk.Uint64ToBigEndian(s.groupID)...))
+
+	err := s.groupKeeper.HealthCheck(s.ctx)
+	s.Require().ErrorContains(err, fmt.Sprintf("group policy %s belongs to unknown group %d", s.groupPolicyStrAddr, s.groupID))
+}
+
 func (s *TestSuite) TestPruneProposals() {
 	expirationTime := time.Hour * 24 * 15 // 15 days
 	groupID := s.groupID
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v2 "cosmossdk.io/x/group/migrations/v2"
+	v3 "cosmossdk.io/x/group/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -26,3 +27,12 @@ func (m Migrator) Migrate1to2(ctx context.Context) error {
 		m.keeper.groupPolicyTable,
 	)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx context.Context) error {
+	return v3.Migrate(
+		ctx,
+		m.keeper.KVStoreService,
+		m.keeper.groupPolicyTable,
+	)
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"bytes"
 	"context"
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -15,6 +14,7 @@ import (
 	"cosmossdk.io/x/group/errors"
 	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/internal/orm"
+	"cosmossdk.io/x/group/offchain"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -89,6 +89,10 @@ func (k Keeper) CreateGroup(ctx context.Context, msg *group.MsgCreateGroup) (*gr
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "could not store member %d", i)
 		}
+
+		if err := k.linkGroupMember(ctx, groupID, m.Address); err != nil {
+			return nil, errorsmod.Wrapf(err, "could not link member %d", i)
+		}
 	}
 
 	if err := k.EventService.EventManager(ctx).Emit(&group.EventCreateGroup{GroupId: groupID}); err != nil {
@@ -152,7 +156,7 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 			if newMemberWeight.IsZero() {
 				// We can't delete a group member that doesn't already exist.
 				if !found {
-					return errorsmod.Wrap(sdkerrors.ErrNotFound, "unknown member")
+					return errors.Wrapf(sdkerrors.ErrNotFound, errors.ReasonNotMember, "unknown member %s", groupMember.Member.Address)
 				}
 
 				previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
@@ -170,6 +174,10 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 				if err := k.groupMemberTable.Delete(kvStore, &groupMember); err != nil {
 					return errorsmod.Wrap(err, "delete member")
 				}
+
+				if err := k.unlinkGroupMember(ctx, msg.GroupId, groupMember.Member.Address); err != nil {
+					return errorsmod.Wrap(err, "unlink member")
+				}
 				continue
 			}
 			// If group member already exists, handle update
@@ -193,6 +201,10 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 				if err := k.groupMemberTable.Create(kvStore, &groupMember); err != nil {
 					return errorsmod.Wrap(err, "add member")
 				}
+
+				if err := k.linkGroupMember(ctx, msg.GroupId, groupMember.Member.Address); err != nil {
+					return errorsmod.Wrap(err, "link member")
+				}
 			}
 			// In both cases (handle + update), we need to add the new member's weight to the group total weight.
 			totalWeight, err = totalWeight.Add(newMemberWeight)
@@ -224,7 +236,7 @@ func (k Keeper) UpdateGroupAdmin(ctx context.Context, msg *group.MsgUpdateGroupA
 	}
 
 	if strings.EqualFold(msg.Admin, msg.NewAdmin) {
-		return nil, errorsmod.Wrap(errors.ErrInvalid, "new and old admin are the same")
+		return nil, errors.Wrapf(errors.ErrInvalid, errors.ReasonSameAdmin, "new and old admin are the same")
 	}
 
 	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Admin); err != nil {
@@ -360,13 +372,17 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 
 	// Only current group admin is authorized to create a group policy for this
 	if !bytes.Equal(groupAdmin, reqGroupAdmin) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not group admin")
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, errors.ReasonNotGroupAdmin, "not group admin; got %s, expected %s", msg.GetAdmin(), groupInfo.Admin)
 	}
 
 	if err := policy.Validate(groupInfo, k.config); err != nil {
 		return nil, err
 	}
 
+	if err := k.assertGroupPolicyLabelAvailable(ctx, groupInfo.Id, msg.Label, ""); err != nil {
+		return nil, err
+	}
+
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
 
 	// Generate account address of group policy.
@@ -374,11 +390,7 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 	// loop here in the rare case where a ADR-028-derived address creates a
 	// collision with an existing address.
 	for {
-		nextAccVal := k.groupPolicySeq.NextVal(kvStore)
-		derivationKey := make([]byte, 8)
-		binary.BigEndian.PutUint64(derivationKey, nextAccVal)
-
-		ac, err := authtypes.NewModuleCredential(group.ModuleName, []byte{GroupPolicyTablePrefix}, derivationKey)
+		ac, err := groupPolicyCredential(k.groupPolicySeq.NextVal(kvStore))
 		if err != nil {
 			return nil, err
 		}
@@ -418,11 +430,23 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 	if err != nil {
 		return nil, err
 	}
+	groupPolicy.Label = msg.Label
 
 	if err := k.groupPolicyTable.Create(kvStore, &groupPolicy); err != nil {
 		return nil, errorsmod.Wrap(err, "could not create group policy")
 	}
 
+	// link the groups the group policy account is already a member of
+	parentGroupIDs, err := k.groupIDsByMember(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	for _, parentGroupID := range parentGroupIDs {
+		if err := k.linkGroupMember(ctx, parentGroupID, accountStrAddr); err != nil {
+			return nil, errorsmod.Wrapf(err, "could not link group %d", parentGroupID)
+		}
+	}
+
 	if err := k.EventService.EventManager(ctx).Emit(&group.EventCreateGroupPolicy{Address: accountStrAddr}); err != nil {
 		return nil, err
 	}
@@ -432,7 +456,7 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 
 func (k Keeper) UpdateGroupPolicyAdmin(ctx context.Context, msg *group.MsgUpdateGroupPolicyAdmin) (*group.MsgUpdateGroupPolicyAdminResponse, error) {
 	if strings.EqualFold(msg.Admin, msg.NewAdmin) {
-		return nil, errorsmod.Wrap(errors.ErrInvalid, "new and old admin are same")
+		return nil, errors.Wrapf(errors.ErrInvalid, errors.ReasonSameAdmin, "new and old admin are same")
 	}
 
 	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.NewAdmin); err != nil {
@@ -513,6 +537,44 @@ func (k Keeper) UpdateGroupPolicyMetadata(ctx context.Context, msg *group.MsgUpd
 	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
 }
 
+// UpdateGroupPolicyLabel sets, changes or clears the label of a group policy.
+// Unlike the other group policy updates, it neither bumps the group policy
+// version nor aborts its pending proposals, as the label is only an alias.
+func (k Keeper) UpdateGroupPolicyLabel(ctx context.Context, msg *group.MsgUpdateGroupPolicyLabel) (*group.MsgUpdateGroupPolicyLabelResponse, error) {
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.GroupPolicyAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "group policy address")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Admin); err != nil {
+		return nil, errorsmod.Wrap(err, "group policy admin")
+	}
+
+	groupPolicyInfo, err := k.getGroupPolicyInfo(ctx, msg.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	// Only current group policy admin is authorized to update a group policy.
+	if msg.Admin != groupPolicyInfo.Admin {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, errors.ReasonNotGroupPolicyAdmin, "not group policy admin; got %s, expected %s", msg.Admin, groupPolicyInfo.Admin)
+	}
+
+	if err := k.assertGroupPolicyLabelAvailable(ctx, groupPolicyInfo.GroupId, msg.Label, groupPolicyInfo.Address); err != nil {
+		return nil, err
+	}
+
+	groupPolicyInfo.Label = msg.Label
+	if err := k.groupPolicyTable.Update(k.KVStoreService.OpenKVStore(ctx), &groupPolicyInfo); err != nil {
+		return nil, errorsmod.Wrap(err, "group policy label updated")
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventUpdateGroupPolicy{Address: groupPolicyInfo.Address}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyLabelResponse{}, nil
+}
+
 func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
 	if len(msg.Proposers) == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposers")
@@ -544,11 +606,11 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		proposalMetadata := govtypes.ProposalMetadata{}
 		if err := json.Unmarshal([]byte(msg.Metadata), &proposalMetadata); err == nil {
 			if proposalMetadata.Title != msg.Title {
-				return nil, fmt.Errorf("metadata title '%s' must equal proposal title '%s'", proposalMetadata.Title, msg.Title)
+				return nil, errors.Wrapf(errors.ErrInvalid, errors.ReasonMetadataMismatch, "metadata title '%s' must equal proposal title '%s'", proposalMetadata.Title, msg.Title)
 			}
 
 			if proposalMetadata.Summary != msg.Summary {
-				return nil, fmt.Errorf("metadata summary '%s' must equal proposal summary '%s'", proposalMetadata.Summary, msg.Summary)
+				return nil, errors.Wrapf(errors.ErrInvalid, errors.ReasonMetadataMismatch, "metadata summary '%s' must equal proposal summary '%s'", proposalMetadata.Summary, msg.Summary)
 			}
 		}
 
@@ -565,6 +627,22 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, err
 	}
 
+	kind := msg.Kind
+	switch kind {
+	case group.PROPOSAL_KIND_UNSPECIFIED:
+		kind = group.PROPOSAL_KIND_EXECUTABLE
+	case group.PROPOSAL_KIND_EXECUTABLE:
+	case group.PROPOSAL_KIND_SIGNALING:
+		if len(msgs) != 0 {
+			return nil, errorsmod.Wrap(errors.ErrInvalid, "signaling proposals must not have messages")
+		}
+		if msg.Exec == group.Exec_EXEC_TRY {
+			return nil, errors.ErrSignalingProposal
+		}
+	default:
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "unknown proposal kind %s", kind)
+	}
+
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
 	policyAcc, err := k.getGroupPolicyInfo(ctx, msg.GroupPolicyAddress)
 	if err != nil {
@@ -579,7 +657,7 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 	// Only members of the group can submit a new proposal.
 	for _, proposer := range msg.Proposers {
 		if !k.groupMemberTable.Has(kvStore, orm.PrimaryKey(&group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: proposer}}, k.accKeeper.AddressCodec())) {
-			return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "not in group: %s", proposer)
+			return nil, errors.Wrapf(errors.ErrUnauthorized, errors.ReasonNotMember, "not in group: %s", proposer)
 		}
 	}
 
@@ -612,6 +690,7 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		FinalTallyResult:   group.DefaultTallyResult(),
 		Title:              msg.Title,
 		Summary:            msg.Summary,
+		Kind:               kind,
 	}
 
 	if err := m.SetMsgs(msgs); err != nil {
@@ -676,7 +755,7 @@ func (k Keeper) WithdrawProposal(ctx context.Context, msg *group.MsgWithdrawProp
 
 	// Ensure the proposal can be withdrawn.
 	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
-		return nil, errorsmod.Wrapf(errors.ErrInvalid, "cannot withdraw a proposal with the status of %s", proposal.Status.String())
+		return nil, errors.Wrapf(errors.ErrInvalid, errors.ReasonProposalNotOpen, "cannot withdraw a proposal with the status of %s", proposal.Status.String())
 	}
 
 	var policyInfo group.GroupPolicyInfo
@@ -686,7 +765,7 @@ func (k Keeper) WithdrawProposal(ctx context.Context, msg *group.MsgWithdrawProp
 
 	// check address is the group policy admin he is in proposers list..
 	if msg.Address != policyInfo.Admin && !isProposer(proposal, msg.Address) {
-		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "given address is neither group policy admin nor in proposers: %s", msg.Address)
+		return nil, errors.Wrapf(errors.ErrUnauthorized, errors.ReasonNotProposerOrAdmin, "given address is neither group policy admin nor in proposers: %s", msg.Address)
 	}
 
 	proposal.Status = group.PROPOSAL_STATUS_WITHDRAWN
@@ -731,11 +810,15 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 
 	// Ensure that we can still accept votes for this proposal.
 	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
-		return nil, errorsmod.Wrap(errors.ErrInvalid, "proposal not open for voting")
+		return nil, proposalNotOpenErr(proposal, "proposal not open for voting")
+	}
+
+	if msg.Exec == group.Exec_EXEC_TRY && proposal.IsSignaling() {
+		return nil, errorsmod.Wrapf(errors.ErrSignalingProposal, "proposal %d", proposal.Id)
 	}
 
 	if k.HeaderService.HeaderInfo(ctx).Time.After(proposal.VotingPeriodEnd) {
-		return nil, errorsmod.Wrap(errors.ErrExpired, "voting period has ended already")
+		return nil, errors.Wrapf(errors.ErrExpired, errors.ReasonVotingPeriodEnded, "voting period has ended already")
 	}
 
 	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
@@ -751,6 +834,9 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 	// Count and store votes.
 	voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
 	if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
+		if sdkerrors.ErrNotFound.Is(err) {
+			return nil, errors.Wrapf(err, errors.ReasonNotMember, "voter address: %s", msg.Voter)
+		}
 		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
 	}
 	newVote := group.Vote{
@@ -782,6 +868,106 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 	return &group.MsgVoteResponse{}, nil
 }
 
+// SubmitOffchainApproval verifies and stores the approval of a proposal signed
+// off-chain by a group member over the proposal typed data (see the offchain
+// package). Approvals are non-binding: they are not counted in the tally, and
+// are pruned together with the proposal votes.
+func (k Keeper) SubmitOffchainApproval(ctx context.Context, msg *group.MsgSubmitOffchainApproval) (*group.MsgSubmitOffchainApprovalResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if msg.Option == group.VOTE_OPTION_UNSPECIFIED {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "vote option")
+	}
+
+	if _, ok := group.VoteOption_name[int32(msg.Option)]; !ok {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "vote option")
+	}
+
+	if err := k.assertMetadataLength(msg.Metadata, "metadata"); err != nil {
+		return nil, err
+	}
+
+	if len(msg.Signature) == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "signature")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Submitter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address: %s", msg.Submitter)
+	}
+
+	memberAddr, err := k.accKeeper.AddressCodec().StringToBytes(msg.Member)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address: %s", msg.Member)
+	}
+
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, proposalNotOpenErr(proposal, "proposal not open for approvals")
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	if headerInfo.Time.After(proposal.VotingPeriodEnd) {
+		return nil, errors.Wrapf(errors.ErrExpired, errors.ReasonVotingPeriodEnded, "voting period has ended already")
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	member := group.GroupMember{GroupId: policyInfo.GroupId, Member: &group.Member{Address: msg.Member}}
+	if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&member, k.accKeeper.AddressCodec()), &member); err != nil {
+		if sdkerrors.ErrNotFound.Is(err) {
+			return nil, errors.Wrapf(err, errors.ReasonNotMember, "member address: %s", msg.Member)
+		}
+		return nil, errorsmod.Wrapf(err, "member address: %s", msg.Member)
+	}
+
+	// the member never signed a transaction if its account has no public key,
+	// its signature can't be verified
+	acc := k.accKeeper.GetAccount(ctx, memberAddr)
+	if acc == nil || acc.GetPubKey() == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "no public key for member %s", msg.Member)
+	}
+
+	typedData, err := offchain.NewTypedData(k.cdc, headerInfo.ChainID, proposal, msg.Member, msg.Option, msg.Metadata)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "approval typed data")
+	}
+
+	if err := typedData.VerifySignature(acc.GetPubKey(), msg.Signature); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "approval signature of %s: %s", msg.Member, err)
+	}
+
+	approval := group.OffchainApproval{
+		ProposalId: msg.ProposalId,
+		Member:     msg.Member,
+		Option:     msg.Option,
+		Metadata:   msg.Metadata,
+		Signature:  msg.Signature,
+		SubmitTime: headerInfo.Time,
+	}
+
+	// The ORM will return an error if the approval already exists,
+	// making sure that a member hasn't already approved.
+	if err := k.offchainApprovalTable.Create(kvStore, &approval); err != nil {
+		return nil, errorsmod.Wrap(err, "store off-chain approval")
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventOffchainApproval{ProposalId: msg.ProposalId, Member: msg.Member}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgSubmitOffchainApprovalResponse{}, nil
+}
+
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
 // - prune all the votes.
@@ -831,8 +1017,12 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		return nil, err
 	}
 
+	if proposal.IsSignaling() {
+		return nil, errorsmod.Wrapf(errors.ErrSignalingProposal, "proposal %d", proposal.Id)
+	}
+
 	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED && proposal.Status != group.PROPOSAL_STATUS_ACCEPTED {
-		return nil, errorsmod.Wrapf(errors.ErrInvalid, "not possible to exec with proposal status %s", proposal.Status.String())
+		return nil, proposalNotOpenErr(proposal, fmt.Sprintf("not possible to exec with proposal status %s", proposal.Status.String()))
 	}
 
 	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
@@ -957,6 +1147,10 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 		return nil, errorsmod.Wrap(err, "group member")
 	}
 
+	if err := k.unlinkGroupMember(ctx, msg.GroupId, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(err, "unlink group member")
+	}
+
 	// update group weight
 	groupInfo.TotalWeight = updatedWeight.String()
 	groupInfo.Version++
@@ -987,7 +1181,7 @@ func (k Keeper) getGroupMember(ctx context.Context, member *group.GroupMember) (
 	case err == nil:
 		break
 	case sdkerrors.ErrNotFound.Is(err):
-		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not part of group %d", member.Member.Address, member.GroupId)
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, errors.ReasonNotMember, "%s is not part of group %d", member.Member.Address, member.GroupId)
 	default:
 		return nil, err
 	}
@@ -1020,11 +1214,11 @@ func (k Keeper) doUpdateGroupPolicy(ctx context.Context, reqGroupPolicy, reqAdmi
 
 	// Only current group policy admin is authorized to update a group policy.
 	if reqAdmin != groupPolicyInfo.Admin {
-		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not group policy admin")
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, errors.ReasonNotGroupPolicyAdmin, "not group policy admin")
 	}
 
 	if err := action(&groupPolicyInfo); err != nil {
-		return errorsmod.Wrap(err, note)
+		return errors.Wrap(err, note)
 	}
 
 	if err = k.abortProposals(ctx, groupPolicyAddr); err != nil {
@@ -1038,6 +1232,31 @@ func (k Keeper) doUpdateGroupPolicy(ctx context.Context, reqGroupPolicy, reqAdmi
 	return nil
 }
 
+// assertGroupPolicyLabelAvailable validates the given group policy label and
+// makes sure no other group policy of the group uses it. The group policy
+// with address owner is allowed to already hold the label.
+func (k Keeper) assertGroupPolicyLabelAvailable(ctx context.Context, groupID uint64, label, owner string) error {
+	if err := group.ValidateGroupPolicyLabel(label); err != nil {
+		return errorsmod.Wrap(err, "group policy label")
+	}
+	if label == "" {
+		return nil
+	}
+
+	existing, err := k.getGroupPolicyByLabel(ctx, groupID, label)
+	switch {
+	case err == nil:
+		if existing.Address != owner {
+			return errors.ErrLabelTaken.Wrapf("label %s in group %d is used by %s", label, groupID, existing.Address)
+		}
+		return nil
+	case sdkerrors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return err
+	}
+}
+
 // doUpdateGroup first makes sure that the group admin initiated the group update,
 // before performing the group update and emitting an event.
 func (k Keeper) doUpdateGroup(ctx context.Context, groupID uint64, reqGroupAdmin string, action actionFn, errNote string) error {
@@ -1047,11 +1266,11 @@ func (k Keeper) doUpdateGroup(ctx context.Context, groupID uint64, reqGroupAdmin
 	}
 
 	if !strings.EqualFold(groupInfo.Admin, reqGroupAdmin) {
-		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not group admin; got %s, expected %s", reqGroupAdmin, groupInfo.Admin)
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, errors.ReasonNotGroupAdmin, "not group admin; got %s, expected %s", reqGroupAdmin, groupInfo.Admin)
 	}
 
 	if err := action(&groupInfo); err != nil {
-		return errorsmod.Wrap(err, errNote)
+		return errors.Wrap(err, errNote)
 	}
 
 	if err := k.EventService.EventManager(ctx).Emit(&group.EventUpdateGroup{GroupId: groupID}); err != nil {
@@ -1164,3 +1383,14 @@ func validateMsgs(msgs []sdk.Msg) error {
 
 	return nil
 }
+
+// proposalNotOpenErr returns the error of a proposal which cannot be voted on or
+// executed anymore. Proposals are aborted when their group policy is modified,
+// which is reported as a version mismatch.
+func proposalNotOpenErr(proposal group.Proposal, msg string) error {
+	if proposal.Status == group.PROPOSAL_STATUS_ABORTED {
+		return errors.Wrapf(errors.ErrInvalid, errors.ReasonPolicyVersionMismatch, "%s: proposal %d was aborted", msg, proposal.Id)
+	}
+
+	return errors.Wrapf(errors.ErrInvalid, errors.ReasonProposalNotOpen, "%s", msg)
+}
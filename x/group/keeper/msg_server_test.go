@@ -12,16 +12,21 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"cosmossdk.io/core/header"
+	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
+	grouperrors "cosmossdk.io/x/group/errors"
 	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/keeper"
+	"cosmossdk.io/x/group/offchain"
 	minttypes "cosmossdk.io/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -1509,6 +1514,145 @@ func (s *TestSuite) TestUpdateGroupPolicyMetadata() {
 	}
 }
 
+func (s *TestSuite) TestGroupPolicyLabels() {
+	admin := s.addrs[0]
+	adminAddr, err := s.accountKeeper.AddressCodec().BytesToString(admin)
+	s.Require().NoError(err)
+
+	policy := group.NewThresholdDecisionPolicy(
+		"1",
+		time.Second,
+		0,
+	)
+
+	s.setNextAccount()
+	unlabeledAddr, myGroupID := s.createGroupAndGroupPolicy(admin, nil, policy)
+
+	createReq := &group.MsgCreateGroupPolicy{
+		Admin:   adminAddr,
+		GroupId: myGroupID,
+		Label:   "treasury",
+	}
+	s.Require().NoError(createReq.SetDecisionPolicy(policy))
+	s.setNextAccount()
+	createRes, err := s.groupKeeper.CreateGroupPolicy(s.ctx, createReq)
+	s.Require().NoError(err)
+	treasuryAddr := createRes.Address
+
+	// the label can't be used twice in the same group
+	s.setNextAccount()
+	_, err = s.groupKeeper.CreateGroupPolicy(s.ctx, createReq)
+	s.Require().ErrorIs(err, grouperrors.ErrLabelTaken)
+
+	res, err := s.groupKeeper.GroupPolicyByLabel(s.ctx, &group.QueryGroupPolicyByLabelRequest{GroupId: myGroupID, Label: "treasury"})
+	s.Require().NoError(err)
+	s.Require().Equal(treasuryAddr, res.Info.Address)
+	s.Require().Equal("treasury", res.Info.Label)
+
+	specs := map[string]struct {
+		req       *group.MsgUpdateGroupPolicyLabel
+		expErrMsg string
+		expReason grouperrors.Reason
+	}{
+		"with wrong admin": {
+			req: &group.MsgUpdateGroupPolicyLabel{
+				Admin:              s.addrsStr[4],
+				GroupPolicyAddress: unlabeledAddr,
+				Label:              "ops",
+			},
+			expErrMsg: "not group policy admin",
+			expReason: grouperrors.ReasonNotGroupPolicyAdmin,
+		},
+		"with wrong group policy": {
+			req: &group.MsgUpdateGroupPolicyLabel{
+				Admin:              adminAddr,
+				GroupPolicyAddress: s.addrsStr[4],
+				Label:              "ops",
+			},
+			expErrMsg: "load group policy: not found",
+		},
+		"with invalid label": {
+			req: &group.MsgUpdateGroupPolicyLabel{
+				Admin:              adminAddr,
+				GroupPolicyAddress: unlabeledAddr,
+				Label:              "Ops!",
+			},
+			expErrMsg: "must start with a lowercase letter",
+		},
+		"with label too long": {
+			req: &group.MsgUpdateGroupPolicyLabel{
+				Admin:              adminAddr,
+				GroupPolicyAddress: unlabeledAddr,
+				Label:              strings.Repeat("a", group.MaxGroupPolicyLabelLen+1),
+			},
+			expErrMsg: "limit exceeded",
+		},
+		"with label taken": {
+			req: &group.MsgUpdateGroupPolicyLabel{
+				Admin:              adminAddr,
+				GroupPolicyAddress: unlabeledAddr,
+				Label:              "treasury",
+			},
+			expErrMsg: "group policy label already taken",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			_, err := s.groupKeeper.UpdateGroupPolicyLabel(s.ctx, spec.req)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), spec.expErrMsg)
+			if spec.expReason != "" {
+				s.Require().Equal(spec.expReason, grouperrors.ReasonOf(err))
+			}
+		})
+	}
+
+	// relabel the treasury policy, which frees its previous label
+	_, err = s.groupKeeper.UpdateGroupPolicyLabel(s.ctx, &group.MsgUpdateGroupPolicyLabel{
+		Admin:              adminAddr,
+		GroupPolicyAddress: treasuryAddr,
+		Label:              "treasury-v2",
+	})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.GroupPolicyByLabel(s.ctx, &group.QueryGroupPolicyByLabelRequest{GroupId: myGroupID, Label: "treasury"})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = s.groupKeeper.UpdateGroupPolicyLabel(s.ctx, &group.MsgUpdateGroupPolicyLabel{
+		Admin:              adminAddr,
+		GroupPolicyAddress: unlabeledAddr,
+		Label:              "treasury",
+	})
+	s.Require().NoError(err)
+	res, err = s.groupKeeper.GroupPolicyByLabel(s.ctx, &group.QueryGroupPolicyByLabelRequest{GroupId: myGroupID, Label: "treasury"})
+	s.Require().NoError(err)
+	s.Require().Equal(unlabeledAddr, res.Info.Address)
+	// relabeling doesn't bump the group policy version
+	s.Require().Equal(uint64(1), res.Info.Version)
+
+	// the same label can be used in another group
+	s.setNextAccount()
+	otherAddr, otherGroupID := s.createGroupAndGroupPolicy(admin, nil, policy)
+	_, err = s.groupKeeper.UpdateGroupPolicyLabel(s.ctx, &group.MsgUpdateGroupPolicyLabel{
+		Admin:              adminAddr,
+		GroupPolicyAddress: otherAddr,
+		Label:              "treasury",
+	})
+	s.Require().NoError(err)
+	res, err = s.groupKeeper.GroupPolicyByLabel(s.ctx, &group.QueryGroupPolicyByLabelRequest{GroupId: otherGroupID, Label: "treasury"})
+	s.Require().NoError(err)
+	s.Require().Equal(otherAddr, res.Info.Address)
+
+	// clearing the label removes it from the index
+	_, err = s.groupKeeper.UpdateGroupPolicyLabel(s.ctx, &group.MsgUpdateGroupPolicyLabel{
+		Admin:              adminAddr,
+		GroupPolicyAddress: unlabeledAddr,
+	})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.GroupPolicyByLabel(s.ctx, &group.QueryGroupPolicyByLabelRequest{GroupId: myGroupID, Label: "treasury"})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
 func (s *TestSuite) TestGroupPoliciesByAdminOrGroup() {
 	addrs := s.addrs
 
@@ -1684,6 +1828,7 @@ func (s *TestSuite) TestSubmitProposal() {
 		expProposal group.Proposal
 		expErr      bool
 		expErrMsg   string
+		expReason   grouperrors.Reason
 		postRun     func(sdkCtx sdk.Context)
 		preRun      func(msg []sdk.Msg)
 	}{
@@ -1791,6 +1936,7 @@ func (s *TestSuite) TestSubmitProposal() {
 			},
 			expErr:    true,
 			expErrMsg: "not in group",
+			expReason: grouperrors.ReasonNotMember,
 			postRun:   func(sdkCtx sdk.Context) {},
 		},
 		"all proposers must be in group": {
@@ -1800,6 +1946,7 @@ func (s *TestSuite) TestSubmitProposal() {
 			},
 			expErr:    true,
 			expErrMsg: "not in group",
+			expReason: grouperrors.ReasonNotMember,
 			postRun:   func(sdkCtx sdk.Context) {},
 		},
 		"admin that is not a group member can not create proposal": {
@@ -1809,6 +1956,7 @@ func (s *TestSuite) TestSubmitProposal() {
 			},
 			expErr:    true,
 			expErrMsg: "not in group",
+			expReason: grouperrors.ReasonNotMember,
 			postRun:   func(sdkCtx sdk.Context) {},
 		},
 		"reject msgs that are not authz by group policy": {
@@ -1891,6 +2039,9 @@ func (s *TestSuite) TestSubmitProposal() {
 			if spec.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), spec.expErrMsg)
+				if spec.expReason != "" {
+					s.Require().Equal(spec.expReason, grouperrors.ReasonOf(err))
+				}
 				return
 			}
 			s.Require().NoError(err)
@@ -1942,6 +2093,7 @@ func (s *TestSuite) TestWithdrawProposal() {
 		proposalID uint64
 		admin      string
 		expErrMsg  string
+		expReason  grouperrors.Reason
 		postRun    func(sdkCtx sdk.Context)
 	}{
 		"wrong admin": {
@@ -1950,6 +2102,7 @@ func (s *TestSuite) TestWithdrawProposal() {
 			},
 			admin:     s.addrsStr[4],
 			expErrMsg: "unauthorized",
+			expReason: grouperrors.ReasonNotProposerOrAdmin,
 			postRun:   func(sdkCtx sdk.Context) {},
 		},
 		"wrong proposal id": {
@@ -2015,6 +2168,9 @@ func (s *TestSuite) TestWithdrawProposal() {
 			if spec.expErrMsg != "" {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), spec.expErrMsg)
+				if spec.expReason != "" {
+					s.Require().Equal(spec.expReason, grouperrors.ReasonOf(err))
+				}
 				return
 			}
 
@@ -2128,6 +2284,7 @@ func (s *TestSuite) TestVote() {
 		expExecutorResult group.ProposalExecutorResult // expected after tallying
 		expErr            bool
 		expErrMsg         string
+		expReason         grouperrors.Reason
 	}{
 		"vote yes": {
 			req: &group.MsgVote{
@@ -2255,6 +2412,25 @@ func (s *TestSuite) TestVote() {
 			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
 			postRun:           func(sdkCtx sdk.Context) {},
 		},
+		"reject votes on proposal aborted by a group policy update": {
+			req: &group.MsgVote{
+				ProposalId: myProposalID,
+				Voter:      s.addrsStr[3],
+				Option:     group.VOTE_OPTION_YES,
+			},
+			doBefore: func(ctx context.Context) {
+				_, err := s.groupKeeper.UpdateGroupPolicyMetadata(ctx, &group.MsgUpdateGroupPolicyMetadata{
+					Admin:              s.addrsStr[0],
+					GroupPolicyAddress: accountAddr,
+					Metadata:           "updated",
+				})
+				s.Require().NoError(err)
+			},
+			expErr:    true,
+			expErrMsg: "was aborted",
+			expReason: grouperrors.ReasonPolicyVersionMismatch,
+			postRun:   func(sdkCtx sdk.Context) {},
+		},
 		"reject new votes when final decision is made already": {
 			req: &group.MsgVote{
 				ProposalId: myProposalID,
@@ -2322,6 +2498,7 @@ func (s *TestSuite) TestVote() {
 			},
 			expErr:    true,
 			expErrMsg: "not found",
+			expReason: grouperrors.ReasonNotMember,
 			postRun:   func(sdkCtx sdk.Context) {},
 		},
 		"admin that is not a group member can not vote": {
@@ -2332,6 +2509,7 @@ func (s *TestSuite) TestVote() {
 			},
 			expErr:    true,
 			expErrMsg: "not found",
+			expReason: grouperrors.ReasonNotMember,
 			postRun:   func(sdkCtx sdk.Context) {},
 		},
 		"on voting period end": {
@@ -2343,6 +2521,7 @@ func (s *TestSuite) TestVote() {
 			srcCtx:    s.sdkCtx.WithHeaderInfo(header.Info{Time: s.sdkCtx.HeaderInfo().Time.Add(time.Second)}),
 			expErr:    true,
 			expErrMsg: "voting period has ended already: expired",
+			expReason: grouperrors.ReasonVotingPeriodEnded,
 			postRun:   func(sdkCtx sdk.Context) {},
 		},
 		"vote closed already": {
@@ -2400,6 +2579,9 @@ func (s *TestSuite) TestVote() {
 			if spec.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), spec.expErrMsg)
+				if spec.expReason != "" {
+					s.Require().Equal(spec.expReason, grouperrors.ReasonOf(err))
+				}
 				return
 			}
 			s.Require().NoError(err)
@@ -2534,6 +2716,245 @@ func (s *TestSuite) TestVote() {
 	s.Require().NoError(err)
 	s.Require().NotEqual(tallyResult.String(), tallyResult1.String())
 }
+func (s *TestSuite) TestSubmitOffchainApproval() {
+	privKey := secp256k1.GenPrivKey()
+	memberAddr := sdk.AccAddress(privKey.PubKey().Address())
+	member, err := s.accountKeeper.AddressCodec().BytesToString(memberAddr)
+	s.Require().NoError(err)
+	memberAcc, err := authtypes.NewBaseAccountWithPubKey(privKey.PubKey())
+	s.Require().NoError(err)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), memberAddr).Return(memberAcc).AnyTimes()
+
+	groupRes, err := s.groupKeeper.CreateGroup(s.ctx, &group.MsgCreateGroup{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: member, Weight: "1"},
+			{Address: s.addrsStr[2], Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
+
+	policyReq := &group.MsgCreateGroupPolicy{
+		Admin:   s.addrsStr[0],
+		GroupId: groupRes.GroupId,
+	}
+	s.Require().NoError(policyReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", time.Hour, 0)))
+	s.setNextAccount()
+	policyRes, err := s.groupKeeper.CreateGroupPolicy(s.ctx, policyReq)
+	s.Require().NoError(err)
+
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyRes.Address,
+		Proposers:          []string{s.addrsStr[2]},
+		Title:              "Send",
+		Summary:            "Send 100test",
+	}
+	s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+		FromAddress: policyRes.Address,
+		ToAddress:   s.addrsStr[4],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}}))
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, proposalReq)
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime, ChainID: "test-chain"})
+	proposal, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+
+	sign := func(chainID string) []byte {
+		typedData, err := offchain.NewTypedData(s.cdc, chainID, *proposal.Proposal, member, group.VOTE_OPTION_YES, "lgtm")
+		s.Require().NoError(err)
+		signBytes, err := typedData.SignBytes()
+		s.Require().NoError(err)
+		signature, err := privKey.Sign(signBytes)
+		s.Require().NoError(err)
+		return signature
+	}
+	signature := sign("test-chain")
+
+	specs := map[string]struct {
+		req    *group.MsgSubmitOffchainApproval
+		ctx    sdk.Context
+		expErr error
+	}{
+		"signed for another chain": {
+			req: &group.MsgSubmitOffchainApproval{
+				Submitter: s.addrsStr[5], ProposalId: proposalID, Member: member,
+				Option: group.VOTE_OPTION_YES, Metadata: "lgtm", Signature: sign("other-chain"),
+			},
+			ctx:    ctx,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"option not signed": {
+			req: &group.MsgSubmitOffchainApproval{
+				Submitter: s.addrsStr[5], ProposalId: proposalID, Member: member,
+				Option: group.VOTE_OPTION_NO, Metadata: "lgtm", Signature: signature,
+			},
+			ctx:    ctx,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"empty signature": {
+			req: &group.MsgSubmitOffchainApproval{
+				Submitter: s.addrsStr[5], ProposalId: proposalID, Member: member,
+				Option: group.VOTE_OPTION_YES, Metadata: "lgtm",
+			},
+			ctx:    ctx,
+			expErr: grouperrors.ErrEmpty,
+		},
+		"not a member": {
+			req: &group.MsgSubmitOffchainApproval{
+				Submitter: s.addrsStr[5], ProposalId: proposalID, Member: s.addrsStr[5],
+				Option: group.VOTE_OPTION_YES, Metadata: "lgtm", Signature: signature,
+			},
+			ctx:    ctx,
+			expErr: sdkerrors.ErrNotFound,
+		},
+		"member without public key": {
+			req: &group.MsgSubmitOffchainApproval{
+				Submitter: s.addrsStr[5], ProposalId: proposalID, Member: s.addrsStr[2],
+				Option: group.VOTE_OPTION_YES, Metadata: "lgtm", Signature: signature,
+			},
+			ctx:    ctx,
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		"voting period ended": {
+			req: &group.MsgSubmitOffchainApproval{
+				Submitter: s.addrsStr[5], ProposalId: proposalID, Member: member,
+				Option: group.VOTE_OPTION_YES, Metadata: "lgtm", Signature: signature,
+			},
+			ctx:    ctx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(2 * time.Hour), ChainID: "test-chain"}),
+			expErr: grouperrors.ErrExpired,
+		},
+		"valid approval": {
+			req: &group.MsgSubmitOffchainApproval{
+				Submitter: s.addrsStr[5], ProposalId: proposalID, Member: member,
+				Option: group.VOTE_OPTION_YES, Metadata: "lgtm", Signature: signature,
+			},
+			ctx: ctx,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			cacheCtx, _ := spec.ctx.CacheContext()
+			_, err := s.groupKeeper.SubmitOffchainApproval(cacheCtx, spec.req)
+			if spec.expErr != nil {
+				s.Require().ErrorIs(err, spec.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.groupKeeper.OffchainApprovalsByProposal(cacheCtx, &group.QueryOffchainApprovalsByProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Len(res.Approvals, 1)
+			s.Require().Equal(member, res.Approvals[0].Member)
+			s.Require().Equal(group.VOTE_OPTION_YES, res.Approvals[0].Option)
+			s.Require().Equal(signature, res.Approvals[0].Signature)
+
+			// approvals are not counted in the tally
+			tally, err := s.groupKeeper.TallyResult(cacheCtx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal("0", tally.Tally.YesCount)
+
+			// a member approves a proposal at most once
+			_, err = s.groupKeeper.SubmitOffchainApproval(cacheCtx, spec.req)
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *TestSuite) TestSignalingProposal() {
+	votingPeriod := 4 * time.Minute
+
+	groupMsg := &group.MsgCreateGroupWithPolicy{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: s.addrsStr[0], Weight: "1"},
+			{Address: s.addrsStr[1], Weight: "1"},
+		},
+	}
+	s.Require().NoError(groupMsg.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", votingPeriod, 0)))
+	s.setNextAccount()
+	groupRes, err := s.groupKeeper.CreateGroupWithPolicy(s.ctx, groupMsg)
+	s.Require().NoError(err)
+	policyAddr := groupRes.GroupPolicyAddress
+
+	// signaling proposals cannot have messages
+	withMsgs := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{s.addrsStr[0]},
+		Kind:               group.PROPOSAL_KIND_SIGNALING,
+	}
+	s.Require().NoError(withMsgs.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}}))
+	_, err = s.groupKeeper.SubmitProposal(s.ctx, withMsgs)
+	s.Require().ErrorIs(err, grouperrors.ErrInvalid)
+
+	// nor be executed on submission
+	_, err = s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{s.addrsStr[0]},
+		Kind:               group.PROPOSAL_KIND_SIGNALING,
+		Exec:               group.Exec_EXEC_TRY,
+	})
+	s.Require().ErrorIs(err, grouperrors.ErrSignalingProposal)
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{s.addrsStr[0]},
+		Title:              "Signal",
+		Summary:            "Do we agree?",
+		Kind:               group.PROPOSAL_KIND_SIGNALING,
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// an unspecified kind defaults to executable
+	executableRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{s.addrsStr[0]},
+	})
+	s.Require().NoError(err)
+	executable, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: executableRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_KIND_EXECUTABLE, executable.Proposal.Kind)
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      s.addrsStr[1],
+		Option:     group.VOTE_OPTION_YES,
+		Exec:       group.Exec_EXEC_TRY,
+	})
+	s.Require().ErrorIs(err, grouperrors.ErrSignalingProposal)
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      s.addrsStr[1],
+		Option:     group.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: proposalID, Executor: s.addrsStr[1]})
+	s.Require().ErrorIs(err, grouperrors.ErrSignalingProposal)
+
+	// the tally is still recorded at the end of the voting period
+	ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.sdkCtx.HeaderInfo().Time.Add(votingPeriod + 1)})
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+	res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_KIND_SIGNALING, res.Proposal.Kind)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, res.Proposal.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Proposal.ExecutorResult)
+	s.Require().Equal("1", res.Proposal.FinalTallyResult.YesCount)
+
+	_, err = s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Executor: s.addrsStr[1]})
+	s.Require().ErrorIs(err, grouperrors.ErrSignalingProposal)
+}
 
 func (s *TestSuite) TestExecProposal() {
 	addrs := s.addrs
@@ -3408,3 +3829,82 @@ func eventTypeFound(events []abci.Event, eventType string) bool {
 	}
 	return eventTypeFound
 }
+
+func (s *TestSuite) TestGroupLinks() {
+	require := s.Require()
+
+	createGroupWithPolicy := func(ctx context.Context, members ...string) (uint64, string, error) {
+		reqMembers := make([]group.MemberRequest, len(members))
+		for i, member := range members {
+			reqMembers[i] = group.MemberRequest{Address: member, Weight: "1"}
+		}
+		req := &group.MsgCreateGroupWithPolicy{
+			Admin:   s.addrsStr[0],
+			Members: reqMembers,
+		}
+		require.NoError(req.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Second, 0)))
+		s.setNextAccount()
+		res, err := s.groupKeeper.CreateGroupWithPolicy(ctx, req)
+		if err != nil {
+			return 0, "", err
+		}
+		return res.GroupId, res.GroupPolicyAddress, nil
+	}
+
+	// the group of the suite is a sub-group of the parent group
+	parentGroupID, parentPolicyAddr, err := createGroupWithPolicy(s.ctx, s.addrsStr[1], s.groupPolicyStrAddr)
+	require.NoError(err)
+	expLink := group.GroupLink{ParentGroupId: parentGroupID, Member: s.groupPolicyStrAddr, ChildGroupId: s.groupID}
+
+	subGroups, err := s.groupKeeper.SubGroups(s.ctx, &group.QuerySubGroupsRequest{GroupId: parentGroupID})
+	require.NoError(err)
+	require.Equal([]*group.GroupLink{&expLink}, subGroups.Links)
+	parentGroups, err := s.groupKeeper.ParentGroups(s.ctx, &group.QueryParentGroupsRequest{GroupId: s.groupID})
+	require.NoError(err)
+	require.Equal([]*group.GroupLink{&expLink}, parentGroups.Links)
+
+	// a group cannot be its own sub-group
+	cacheCtx, _ := s.sdkCtx.CacheContext()
+	_, err = s.groupKeeper.UpdateGroupMembers(cacheCtx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addrsStr[0],
+		GroupId:       s.groupID,
+		MemberUpdates: []group.MemberRequest{{Address: s.groupPolicyStrAddr, Weight: "1"}},
+	})
+	require.ErrorIs(err, grouperrors.ErrInvalid)
+
+	// nor a sub-group of one of its sub-groups
+	grandParentGroupID, grandParentPolicyAddr, err := createGroupWithPolicy(s.ctx, parentPolicyAddr)
+	require.NoError(err)
+	cacheCtx, _ = s.sdkCtx.CacheContext()
+	_, err = s.groupKeeper.UpdateGroupMembers(cacheCtx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addrsStr[0],
+		GroupId:       s.groupID,
+		MemberUpdates: []group.MemberRequest{{Address: parentPolicyAddr, Weight: "1"}},
+	})
+	require.ErrorIs(err, grouperrors.ErrInvalid)
+
+	// the group hierarchy cannot exceed the max nesting depth of 3
+	_, topPolicyAddr, err := createGroupWithPolicy(s.ctx, grandParentPolicyAddr)
+	require.NoError(err)
+	cacheCtx, _ = s.sdkCtx.CacheContext()
+	_, _, err = createGroupWithPolicy(cacheCtx, topPolicyAddr)
+	require.ErrorIs(err, grouperrors.ErrMaxLimit)
+
+	// removing a member removes its link
+	_, err = s.groupKeeper.UpdateGroupMembers(s.ctx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addrsStr[0],
+		GroupId:       parentGroupID,
+		MemberUpdates: []group.MemberRequest{{Address: s.groupPolicyStrAddr, Weight: "0"}},
+	})
+	require.NoError(err)
+	subGroups, err = s.groupKeeper.SubGroups(s.ctx, &group.QuerySubGroupsRequest{GroupId: parentGroupID})
+	require.NoError(err)
+	require.Empty(subGroups.Links)
+
+	// leaving a group removes its link
+	_, err = s.groupKeeper.LeaveGroup(s.ctx, &group.MsgLeaveGroup{Address: parentPolicyAddr, GroupId: grandParentGroupID})
+	require.NoError(err)
+	parentGroups, err = s.groupKeeper.ParentGroups(s.ctx, &group.QueryParentGroupsRequest{GroupId: parentGroupID})
+	require.NoError(err)
+	require.Empty(parentGroups.Links)
+}
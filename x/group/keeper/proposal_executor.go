@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/authz"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
 
@@ -52,9 +55,65 @@ func (k Keeper) doExecuteMsgs(ctx context.Context, proposal group.Proposal, grou
 	return nil
 }
 
-// ensureMsgAuthZ checks that if a message requires signers that all of them
-// are equal to the given account address of group policy.
+// maxNestedMsgsDepth is the maximum depth of nested messages resolved when
+// checking the signers of the messages of a proposal.
+const maxNestedMsgsDepth = 8
+
+// nestedMsgs returns the messages nested in msg, and whether msg is a carrier
+// of nested messages at all: authz MsgExec, group and gov MsgSubmitProposal and
+// x/accounts MsgExecute.
+func nestedMsgs(msg sdk.Msg, cdc codec.Codec) ([]sdk.Msg, bool, error) {
+	switch msg := msg.(type) {
+	case interface{ GetMessages() ([]sdk.Msg, error) }: // e.g. authz MsgExec
+		msgs, err := msg.GetMessages()
+		return msgs, true, err
+
+	case interface{ GetMsgs() ([]sdk.Msg, error) }: // e.g. group and gov MsgSubmitProposal
+		msgs, err := msg.GetMsgs()
+		return msgs, true, err
+
+	case interface{ GetMessage() *gogoprotoany.Any }: // e.g. x/accounts MsgExecute
+		anyMsg := msg.GetMessage()
+		if anyMsg == nil {
+			return nil, true, nil
+		}
+
+		if nested, ok := anyMsg.GetCachedValue().(sdk.Msg); ok {
+			return []sdk.Msg{nested}, true, nil
+		}
+
+		// the signers of a msg which cannot be resolved cannot be checked.
+		var nested sdk.Msg
+		if err := cdc.UnpackAny(anyMsg, &nested); err != nil {
+			return nil, true, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot resolve nested msg %s: %s", anyMsg.TypeUrl, err)
+		}
+		return []sdk.Msg{nested}, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// ensureMsgAuthZ checks that all the messages require signers, and that all
+// of them are equal to the given account address of group policy.
+// As a defense in depth, the messages nested in any carrier (authz MsgExec,
+// group and gov MsgSubmitProposal, x/accounts MsgExecute) are checked
+// recursively, so that a group policy account may only ever sign for itself.
+// The only exception are
+// the messages nested in an authz MsgExec signed by the group policy account:
+// they may be signed by the granters of the group policy account, x/authz
+// checking their grants to it, as long as they nest no messages themselves.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Codec, addressCodec address.Codec) error {
+	return ensureNestedMsgAuthZ(msgs, groupPolicyAcc, cdc, addressCodec, 0, false)
+}
+
+// ensureNestedMsgAuthZ checks the messages at the given nesting depth, granted
+// being true when they are nested in an authz MsgExec signed by the group
+// policy account.
+func ensureNestedMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Codec, addressCodec address.Codec, depth int, granted bool) error {
+	if depth > maxNestedMsgsDepth {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "msg nesting exceeds the maximum depth of %d", maxNestedMsgsDepth)
+	}
+
 	for i := range msgs {
 		// In practice, GetMsgV1Signers should return a non-empty array without duplicates.
 		signers, _, err := cdc.GetMsgSigners(msgs[i])
@@ -62,10 +121,22 @@ func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Cod
 			return err
 		}
 
+		if len(signers) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "msg %s has no signers", sdk.MsgTypeURL(msgs[i]))
+		}
+
+		nested, hasNested, err := nestedMsgs(msgs[i], cdc)
+		if err != nil {
+			return err
+		}
+
 		// The code below should be equivalent to: `signers[0] == groupPolicyAcc`
 		// But here, we loop through all the signers just to be sure.
+		// A message signed by a granter of the group policy account is executed
+		// by x/authz only after checking its grant, and must not nest messages
+		// which would be dispatched on the granter's own authority.
 		for _, acct := range signers {
-			if !bytes.Equal(groupPolicyAcc, acct) {
+			if !bytes.Equal(groupPolicyAcc, acct) && (!granted || hasNested) {
 				groupPolicyAddr, err := addressCodec.BytesToString(groupPolicyAcc)
 				if err != nil {
 					return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "msg does not have group policy authorization; error retrieving group policy address")
@@ -73,6 +144,15 @@ func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Cod
 				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "msg does not have group policy authorization; expected %s, got %s", groupPolicyAddr, acct)
 			}
 		}
+
+		if hasNested {
+			// the msgs nested in a MsgExec signed by the group policy account are
+			// executed by x/authz with the group policy account as grantee.
+			_, isMsgExec := msgs[i].(*authz.MsgExec)
+			if err := ensureNestedMsgAuthZ(nested, groupPolicyAcc, cdc, addressCodec, depth+1, isMsgExec); err != nil {
+				return errorsmod.Wrapf(err, "nested in msg %s", sdk.MsgTypeURL(msgs[i]))
+			}
+		}
 	}
 	return nil
 }
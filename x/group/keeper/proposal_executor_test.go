@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/authz"
+	authzmodule "cosmossdk.io/x/authz/module"
+	"cosmossdk.io/x/bank"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/gov"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/keeper"
+	"cosmossdk.io/x/group/module"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func ensureMsgAuthZTestCodec(t testing.TB) codec.Codec {
+	t.Helper()
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{}, bank.AppModule{}, authzmodule.AppModule{}, gov.AppModule{}, accounts.AppModule{})
+	return encCfg.Codec
+}
+
+// nestInMsgExec wraps msg in depth nested authz MsgExec with the given grantee.
+func nestInMsgExec(grantee string, msg sdk.Msg, depth int) sdk.Msg {
+	for i := 0; i < depth; i++ {
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		msg = &msgExec
+	}
+	return msg
+}
+
+// nestInGroupProposal wraps msg in a group MsgSubmitProposal of the given
+// group policy, proposed by the given proposer.
+func nestInGroupProposal(t *testing.T, groupPolicy, proposer string, msg sdk.Msg) sdk.Msg {
+	t.Helper()
+	proposal, err := group.NewMsgSubmitProposal(groupPolicy, []string{proposer}, []sdk.Msg{msg}, "", group.Exec_EXEC_UNSPECIFIED, "title", "summary")
+	require.NoError(t, err)
+	return proposal
+}
+
+// nestInGovProposal wraps msg in a gov MsgSubmitProposal of the given proposer.
+func nestInGovProposal(t *testing.T, proposer string, msg sdk.Msg) sdk.Msg {
+	t.Helper()
+	proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, nil, proposer, "", "title", "summary", govv1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	return proposal
+}
+
+// nestInAccountExecute wraps msg in an x/accounts MsgExecute of the given
+// sender, dropping the cached value of the packed msg when uncached is set.
+func nestInAccountExecute(t *testing.T, sender string, msg sdk.Msg, uncached bool) sdk.Msg {
+	t.Helper()
+	anyMsg, err := gogoprotoany.NewAnyWithCacheWithValue(msg)
+	require.NoError(t, err)
+	if uncached {
+		anyMsg = &gogoprotoany.Any{TypeUrl: anyMsg.TypeUrl, Value: anyMsg.Value}
+	}
+	return &accountsv1.MsgExecute{Sender: sender, Target: sender, Message: anyMsg}
+}
+
+func TestEnsureMsgAuthZ(t *testing.T) {
+	cdc := ensureMsgAuthZTestCodec(t)
+	addressCodec := address.NewBech32Codec("cosmos")
+
+	groupPolicyAcc := sdk.AccAddress("group_policy________")
+	otherAcc := sdk.AccAddress("other_account_______")
+	groupPolicyAddr, err := addressCodec.BytesToString(groupPolicyAcc)
+	require.NoError(t, err)
+	otherAddr, err := addressCodec.BytesToString(otherAcc)
+	require.NoError(t, err)
+
+	send := func(from string) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: from, ToAddress: otherAddr, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1))}
+	}
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr string
+	}{
+		{
+			name: "signed by group policy",
+			msgs: []sdk.Msg{send(groupPolicyAddr)},
+		},
+		{
+			name:   "signed by another account",
+			msgs:   []sdk.Msg{send(groupPolicyAddr), send(otherAddr)},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name: "nested msg signed by group policy",
+			msgs: []sdk.Msg{nestInMsgExec(groupPolicyAddr, send(groupPolicyAddr), 2)},
+		},
+		{
+			name: "nested msg signed by a granter",
+			msgs: []sdk.Msg{nestInMsgExec(groupPolicyAddr, send(otherAddr), 1)},
+		},
+		{
+			name: "deeply nested msg signed by a granter",
+			msgs: []sdk.Msg{nestInMsgExec(groupPolicyAddr, send(otherAddr), 3)},
+		},
+		{
+			name:   "deeply nested msg signed by another account",
+			msgs:   []sdk.Msg{nestInMsgExec(groupPolicyAddr, nestInMsgExec(otherAddr, send(groupPolicyAddr), 1), 3)},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name:   "nested msg exec of a granter",
+			msgs:   []sdk.Msg{nestInMsgExec(groupPolicyAddr, nestInMsgExec(otherAddr, send(otherAddr), 1), 1)},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name:   "msg exec of another grantee",
+			msgs:   []sdk.Msg{nestInMsgExec(otherAddr, send(groupPolicyAddr), 1)},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name: "nested group proposal msg signed by group policy",
+			msgs: []sdk.Msg{nestInGroupProposal(t, groupPolicyAddr, groupPolicyAddr, send(groupPolicyAddr))},
+		},
+		{
+			name:   "nested group proposal msg signed by another account",
+			msgs:   []sdk.Msg{nestInGroupProposal(t, otherAddr, groupPolicyAddr, send(otherAddr))},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name:   "nested gov proposal msg signed by another account",
+			msgs:   []sdk.Msg{nestInGovProposal(t, groupPolicyAddr, send(otherAddr))},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name:   "granter msg nested in a gov proposal nested in a msg exec",
+			msgs:   []sdk.Msg{nestInMsgExec(groupPolicyAddr, nestInGovProposal(t, groupPolicyAddr, send(otherAddr)), 1)},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name: "nested account msg signed by group policy",
+			msgs: []sdk.Msg{nestInAccountExecute(t, groupPolicyAddr, send(groupPolicyAddr), false)},
+		},
+		{
+			name:   "nested account msg signed by another account",
+			msgs:   []sdk.Msg{nestInAccountExecute(t, groupPolicyAddr, send(otherAddr), false)},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name:   "uncached nested account msg signed by another account",
+			msgs:   []sdk.Msg{nestInAccountExecute(t, groupPolicyAddr, send(otherAddr), true)},
+			expErr: "msg does not have group policy authorization",
+		},
+		{
+			name: "unresolvable nested account msg",
+			msgs: []sdk.Msg{&accountsv1.MsgExecute{
+				Sender:  groupPolicyAddr,
+				Target:  groupPolicyAddr,
+				Message: &gogoprotoany.Any{TypeUrl: "/unknown.MsgUnknown"},
+			}},
+			expErr: "cannot resolve nested msg",
+		},
+		{
+			name:   "nesting too deep",
+			msgs:   []sdk.Msg{nestInMsgExec(groupPolicyAddr, send(groupPolicyAddr), 9)},
+			expErr: "msg nesting exceeds the maximum depth",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := keeper.EnsureMsgAuthZ(tc.msgs, groupPolicyAcc, cdc, addressCodec)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func FuzzEnsureMsgAuthZ(f *testing.F) {
+	if testing.Short() {
+		f.Skip()
+	}
+
+	cdc := ensureMsgAuthZTestCodec(f)
+	addressCodec := address.NewBech32Codec("cosmos")
+	groupPolicyAcc := sdk.AccAddress("group_policy________")
+	groupPolicyAddr, err := addressCodec.BytesToString(groupPolicyAcc)
+	require.NoError(f, err)
+
+	f.Add([]byte("group_policy________"), []byte("group_policy________"), uint8(0), uint8(0))
+	f.Add([]byte("group_policy________"), []byte("other_account_______"), uint8(1), uint8(2))
+	f.Add([]byte("other_account_______"), []byte("group_policy________"), uint8(3), uint8(1))
+	f.Add([]byte("group_policy________"), []byte("group_policy________"), uint8(5), uint8(4))
+
+	f.Fuzz(func(t *testing.T, signer, innerGrantee []byte, outerDepth, innerDepth uint8) {
+		if len(signer) == 0 || len(innerGrantee) == 0 || len(signer) > 255 || len(innerGrantee) > 255 {
+			t.Skip()
+		}
+		signerAddr, err := addressCodec.BytesToString(signer)
+		require.NoError(t, err)
+		innerGranteeAddr, err := addressCodec.BytesToString(innerGrantee)
+		require.NoError(t, err)
+
+		// the innermost msg is signed by signer, nested in innerDepth MsgExec with
+		// innerGrantee as grantee, themselves nested in outerDepth MsgExec with the
+		// group policy as grantee.
+		send := &banktypes.MsgSend{FromAddress: signerAddr, ToAddress: groupPolicyAddr, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1))}
+		msg := nestInMsgExec(groupPolicyAddr, nestInMsgExec(innerGranteeAddr, send, int(innerDepth%12)), int(outerDepth%12))
+
+		// all the MsgExec must be signed by the group policy, and the innermost
+		// msg too unless it is nested in one of them, being signed by a granter.
+		authorized := (innerDepth%12 == 0 || bytes.Equal(innerGrantee, groupPolicyAcc)) &&
+			(bytes.Equal(signer, groupPolicyAcc) || int(outerDepth%12)+int(innerDepth%12) > 0) &&
+			int(outerDepth%12)+int(innerDepth%12) <= 8
+
+		err = keeper.EnsureMsgAuthZ([]sdk.Msg{msg}, groupPolicyAcc, cdc, addressCodec)
+		if authorized {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	})
+}
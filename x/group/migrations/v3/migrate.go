@@ -0,0 +1,49 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/internal/orm"
+)
+
+// Migrate migrates the x/group module state from the consensus version 2 to version 3.
+// Specifically, it sets the quorum of the existing threshold decision policies to zero.
+func Migrate(
+	ctx context.Context,
+	storeService store.KVStoreService,
+	groupPolicyTable orm.PrimaryKeyTable,
+) error {
+	store := storeService.OpenKVStore(ctx)
+
+	// get all group policies
+	var groupPolicies []*group.GroupPolicyInfo
+	if _, err := groupPolicyTable.Export(store, &groupPolicies); err != nil {
+		return fmt.Errorf("failed to get group policies: %w", err)
+	}
+
+	for _, policy := range groupPolicies {
+		decisionPolicy, err := policy.GetDecisionPolicy()
+		if err != nil {
+			return err
+		}
+
+		thresholdPolicy, ok := decisionPolicy.(*group.ThresholdDecisionPolicy)
+		if !ok || thresholdPolicy.Quorum != "" {
+			continue
+		}
+
+		thresholdPolicy.Quorum = "0"
+		if err := policy.SetDecisionPolicy(thresholdPolicy); err != nil {
+			return err
+		}
+
+		if err := groupPolicyTable.Update(store, policy); err != nil {
+			return fmt.Errorf("failed to update group policy %s: %w", policy.Address, err)
+		}
+	}
+
+	return nil
+}
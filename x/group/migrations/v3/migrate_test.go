@@ -0,0 +1,63 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/internal/orm"
+	groupkeeper "cosmossdk.io/x/group/keeper"
+	v3 "cosmossdk.io/x/group/migrations/v3"
+	groupmodule "cosmossdk.io/x/group/module"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, groupmodule.AppModule{}).Codec
+	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	storeKey := storetypes.NewKVStoreKey(group.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{groupkeeper.GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc, addressCodec)
+	require.NoError(t, err)
+
+	decisionPolicies := []group.DecisionPolicy{
+		group.NewThresholdDecisionPolicy("1", time.Hour, 0),
+		&group.ThresholdDecisionPolicy{Threshold: "1", Quorum: "0.5", Windows: &group.DecisionPolicyWindows{VotingPeriod: time.Hour}},
+		group.NewPercentageDecisionPolicy("0.5", time.Hour, 0),
+	}
+	addrs := make([]string, len(decisionPolicies))
+	for i, decisionPolicy := range decisionPolicies {
+		addrs[i], err = addressCodec.BytesToString(sdk.AccAddress([]byte{byte(i + 1)}))
+		require.NoError(t, err)
+
+		policy, err := group.NewGroupPolicyInfo(addrs[i], 1, addrs[i], "", 1, decisionPolicy, ctx.HeaderInfo().Time)
+		require.NoError(t, err)
+		require.NoError(t, groupPolicyTable.Create(storeService.OpenKVStore(ctx), &policy))
+	}
+
+	require.NoError(t, v3.Migrate(ctx, storeService, *groupPolicyTable))
+
+	expected := []group.DecisionPolicy{
+		&group.ThresholdDecisionPolicy{Threshold: "1", Quorum: "0", Windows: &group.DecisionPolicyWindows{VotingPeriod: time.Hour}},
+		decisionPolicies[1],
+		decisionPolicies[2],
+	}
+	for i, addr := range addrs {
+		var policy group.GroupPolicyInfo
+		require.NoError(t, groupPolicyTable.GetOne(storeService.OpenKVStore(ctx), orm.PrimaryKey(&group.GroupPolicyInfo{Address: addr}, addressCodec), &policy))
+
+		decisionPolicy, err := policy.GetDecisionPolicy()
+		require.NoError(t, err)
+		require.Equal(t, expected[i], decisionPolicy)
+	}
+}
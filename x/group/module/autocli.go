@@ -27,6 +27,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "GroupPolicyByLabel",
+					Use:       "group-policy-by-label [group-id] [label]",
+					Short:     "Query for group policy info by group id and label",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "group_id"},
+						{ProtoField: "label"},
+					},
+				},
 				{
 					RpcMethod: "GroupMembers",
 					Use:       "group-members [group-id]",
@@ -100,6 +109,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "voter"},
 					},
 				},
+				{
+					RpcMethod: "OffchainApprovalsByProposal",
+					Use:       "offchain-approvals-by-proposal [proposal-id]",
+					Short:     "Query for off-chain approvals by proposal id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "SubGroups",
+					Use:       "sub-groups [group-id]",
+					Short:     "Query for the links of a group to its sub-groups",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "group_id"},
+					},
+				},
+				{
+					RpcMethod: "ParentGroups",
+					Use:       "parent-groups [group-id]",
+					Short:     "Query for the links of a group to its parent groups",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "group_id"},
+					},
+				},
 				{
 					RpcMethod: "GroupsByMember",
 					Use:       "groups-by-member [address]",
@@ -159,6 +192,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "metadata"},
 					},
 				},
+				{
+					RpcMethod: "UpdateGroupPolicyLabel",
+					Use:       "update-group-policy-label [admin] [group-policy-account] [label]",
+					Short:     "Set, change or clear (with an empty label) a group policy label",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "label"},
+					},
+				},
 				{
 					RpcMethod: "WithdrawProposal",
 					Use:       "withdraw-proposal [proposal-id] [group-policy-admin-or-proposer]",
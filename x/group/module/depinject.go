@@ -51,6 +51,8 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 			MaxMetadataLen:        in.Config.MaxMetadataLen,
 			MaxProposalTitleLen:   in.Config.MaxProposalTitleLen,
 			MaxProposalSummaryLen: in.Config.MaxProposalSummaryLen,
+			MaxGroupNestingDepth:  in.Config.MaxGroupNestingDepth,
+			Authorities:           in.Config.Authorities,
 		},
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
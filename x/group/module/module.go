@@ -26,13 +26,14 @@ import (
 )
 
 // ConsensusVersion defines the current x/group module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.HasAminoCodec       = AppModule{}
 	_ module.HasGRPCGateway      = AppModule{}
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasInvariants       = AppModule{}
+	_ module.HasHealthCheck      = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasEndBlocker         = AppModule{}
@@ -98,6 +99,12 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
 }
 
+// HealthCheck implements module.HasHealthCheck, it checks the consistency of
+// the group indexes.
+func (am AppModule) HealthCheck(ctx context.Context) error {
+	return am.keeper.HealthCheck(ctx)
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	group.RegisterMsgServer(registrar, am.keeper)
@@ -113,6 +120,10 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", group.ModuleName, err)
 	}
 
+	if err := mr.Register(group.ModuleName, 2, m.Migrate2to3); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", group.ModuleName, err)
+	}
+
 	return nil
 }
 
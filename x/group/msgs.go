@@ -21,9 +21,11 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupPolicyAdmin{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyLabel{}
 	_ sdk.Msg = &MsgLeaveGroup{}
 	_ sdk.Msg = &MsgExec{}
 	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgSubmitOffchainApproval{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
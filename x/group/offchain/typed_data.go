@@ -0,0 +1,210 @@
+// Package offchain defines the typed data group members sign off-chain to
+// approve a group proposal, in the spirit of EIP-712.
+//
+// The typed data describes its own structure (types), the context the
+// signature is bound to (domain) and the approval itself, including the
+// proposal messages rendered as proto JSON, so that wallets and tooling can
+// display what is being signed. The sign bytes are the canonical JSON encoding
+// of the typed data: keys sorted, no insignificant whitespace.
+//
+// The resulting signatures are relayed on-chain with MsgSubmitOffchainApproval,
+// where they are verified and stored as non-binding signals alongside the
+// proposal.
+package offchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/x/group"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// DomainName is the name of the typed data domain.
+	DomainName = "cosmos-sdk/x/group"
+	// DomainVersion is the version of the typed data specification, it must be
+	// bumped on any change of the typed data structure.
+	DomainVersion = "1"
+	// PrimaryType is the type of the typed data message.
+	PrimaryType = "OffchainApproval"
+)
+
+// Field describes a field of a typed data type.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Types describes the structure of the typed data. Integers are encoded as
+// strings and messages as their proto JSON encoding.
+var Types = map[string][]Field{
+	"Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "group_policy_address", Type: "string"},
+	},
+	"OffchainApproval": {
+		{Name: "proposal_id", Type: "uint64"},
+		{Name: "member", Type: "string"},
+		{Name: "option", Type: "string"},
+		{Name: "metadata", Type: "string"},
+		{Name: "proposal", Type: "Proposal"},
+	},
+	"Proposal": {
+		{Name: "title", Type: "string"},
+		{Name: "summary", Type: "string"},
+		{Name: "metadata", Type: "string"},
+		{Name: "proposers", Type: "string[]"},
+		{Name: "group_version", Type: "uint64"},
+		{Name: "group_policy_version", Type: "uint64"},
+		{Name: "voting_period_end", Type: "string"},
+		{Name: "messages", Type: "json[]"},
+	},
+}
+
+// TypedData is the typed data signed off-chain by a group member to approve a
+// proposal.
+type TypedData struct {
+	Types       map[string][]Field `json:"types"`
+	PrimaryType string             `json:"primary_type"`
+	Domain      Domain             `json:"domain"`
+	Message     Approval           `json:"message"`
+}
+
+// Domain binds an approval to a chain and a group policy.
+type Domain struct {
+	Name               string `json:"name"`
+	Version            string `json:"version"`
+	ChainID            string `json:"chain_id"`
+	GroupPolicyAddress string `json:"group_policy_address"`
+}
+
+// Approval is the approval of a proposal by a group member.
+type Approval struct {
+	ProposalID string   `json:"proposal_id"`
+	Member     string   `json:"member"`
+	Option     string   `json:"option"`
+	Metadata   string   `json:"metadata"`
+	Proposal   Proposal `json:"proposal"`
+}
+
+// Proposal is the content of the approved proposal.
+type Proposal struct {
+	Title              string            `json:"title"`
+	Summary            string            `json:"summary"`
+	Metadata           string            `json:"metadata"`
+	Proposers          []string          `json:"proposers"`
+	GroupVersion       string            `json:"group_version"`
+	GroupPolicyVersion string            `json:"group_policy_version"`
+	VotingPeriodEnd    string            `json:"voting_period_end"`
+	Messages           []json.RawMessage `json:"messages"`
+}
+
+// NewTypedData renders the approval of the given proposal by the given member
+// as typed data. The codec is used to render the proposal messages as proto
+// JSON, it must know all the message types of the proposal.
+func NewTypedData(cdc codec.JSONCodec, chainID string, proposal group.Proposal, member string, option group.VoteOption, metadata string) (TypedData, error) {
+	if chainID == "" {
+		return TypedData{}, errors.New("chain id cannot be empty")
+	}
+	if option == group.VOTE_OPTION_UNSPECIFIED {
+		return TypedData{}, errors.New("vote option cannot be unspecified")
+	}
+	if _, ok := group.VoteOption_name[int32(option)]; !ok {
+		return TypedData{}, fmt.Errorf("invalid vote option %d", option)
+	}
+
+	msgs := make([]json.RawMessage, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		bz, err := cdc.MarshalJSON(msg)
+		if err != nil {
+			return TypedData{}, fmt.Errorf("rendering proposal message %d: %w", i, err)
+		}
+		msgs[i] = bz
+	}
+
+	proposers := proposal.Proposers
+	if proposers == nil {
+		proposers = []string{}
+	}
+
+	return TypedData{
+		Types:       Types,
+		PrimaryType: PrimaryType,
+		Domain: Domain{
+			Name:               DomainName,
+			Version:            DomainVersion,
+			ChainID:            chainID,
+			GroupPolicyAddress: proposal.GroupPolicyAddress,
+		},
+		Message: Approval{
+			ProposalID: strconv.FormatUint(proposal.Id, 10),
+			Member:     member,
+			Option:     option.String(),
+			Metadata:   metadata,
+			Proposal: Proposal{
+				Title:              proposal.Title,
+				Summary:            proposal.Summary,
+				Metadata:           proposal.Metadata,
+				Proposers:          proposers,
+				GroupVersion:       strconv.FormatUint(proposal.GroupVersion, 10),
+				GroupPolicyVersion: strconv.FormatUint(proposal.GroupPolicyVersion, 10),
+				VotingPeriodEnd:    proposal.VotingPeriodEnd.UTC().Format(time.RFC3339Nano),
+				Messages:           msgs,
+			},
+		},
+	}, nil
+}
+
+// SignBytes returns the canonical JSON encoding of the typed data, which is
+// what group members sign.
+func (td TypedData) SignBytes() ([]byte, error) {
+	bz, err := json.Marshal(td)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortJSON(bz)
+}
+
+// VerifySignature verifies the signature of the typed data by the given public
+// key.
+func (td TypedData) VerifySignature(pubKey cryptotypes.PubKey, signature []byte) error {
+	if pubKey == nil {
+		return errors.New("public key cannot be nil")
+	}
+
+	signBytes, err := td.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// sortJSON returns the compact encoding of the given JSON with the keys of its
+// objects sorted. Numbers are kept as is.
+func sortJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	// maps are encoded with sorted keys
+	return json.Marshal(v)
+}
@@ -0,0 +1,65 @@
+package offchain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/bank"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/offchain"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestTypedData(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, bank.AppModule{}).Codec
+
+	proposal := group.Proposal{
+		Id:                 1,
+		GroupPolicyAddress: "cosmos1policy",
+		Title:              "Send",
+		Summary:            "Send 100stake",
+		Proposers:          []string{"cosmos1proposer"},
+		GroupVersion:       1,
+		GroupPolicyVersion: 2,
+		VotingPeriodEnd:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	require.NoError(t, proposal.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+		FromAddress: "cosmos1policy",
+		ToAddress:   "cosmos1recipient",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}}))
+
+	_, err := offchain.NewTypedData(cdc, "", proposal, "cosmos1member", group.VOTE_OPTION_YES, "")
+	require.ErrorContains(t, err, "chain id cannot be empty")
+	_, err = offchain.NewTypedData(cdc, "test-chain", proposal, "cosmos1member", group.VOTE_OPTION_UNSPECIFIED, "")
+	require.ErrorContains(t, err, "vote option cannot be unspecified")
+
+	typedData, err := offchain.NewTypedData(cdc, "test-chain", proposal, "cosmos1member", group.VOTE_OPTION_YES, "lgtm")
+	require.NoError(t, err)
+	signBytes, err := typedData.SignBytes()
+	require.NoError(t, err)
+
+	// the sign bytes are canonical: keys sorted, no whitespace
+	require.Contains(t, string(signBytes), `"domain":{"chain_id":"test-chain","group_policy_address":"cosmos1policy","name":"cosmos-sdk/x/group","version":"1"}`)
+	require.Contains(t, string(signBytes), `"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","amount":[{"amount":"100","denom":"stake"}],"from_address":"cosmos1policy","to_address":"cosmos1recipient"}]`)
+	require.Contains(t, string(signBytes), `"option":"VOTE_OPTION_YES","proposal":{`)
+	require.Contains(t, string(signBytes), `"voting_period_end":"2024-01-02T03:04:05Z"`)
+
+	privKey := secp256k1.GenPrivKey()
+	signature, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, typedData.VerifySignature(privKey.PubKey(), signature))
+	require.ErrorContains(t, typedData.VerifySignature(secp256k1.GenPrivKey().PubKey(), signature), "invalid signature")
+	require.ErrorContains(t, typedData.VerifySignature(nil, signature), "public key cannot be nil")
+
+	// the signature is bound to the approval and the proposal
+	typedData.Message.Option = group.VOTE_OPTION_NO.String()
+	require.ErrorContains(t, typedData.VerifySignature(privKey.PubKey(), signature), "invalid signature")
+}
@@ -22,6 +22,12 @@ func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
 	return nil
 }
 
+// IsSignaling returns true if the proposal is a signaling proposal, which is
+// never executed.
+func (p Proposal) IsSignaling() bool {
+	return p.Kind == PROPOSAL_KIND_SIGNALING
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return tx.UnpackInterfaces(unpacker, p.Messages)
@@ -216,6 +216,107 @@ func (m *QueryGroupPolicyInfoResponse) GetInfo() *GroupPolicyInfo {
 	return nil
 }
 
+// QueryGroupPolicyByLabelRequest is the Query/GroupPolicyByLabel request type.
+type QueryGroupPolicyByLabelRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// label is the label of the group policy.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *QueryGroupPolicyByLabelRequest) Reset()         { *m = QueryGroupPolicyByLabelRequest{} }
+func (m *QueryGroupPolicyByLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupPolicyByLabelRequest) ProtoMessage()    {}
+func (*QueryGroupPolicyByLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{4}
+}
+func (m *QueryGroupPolicyByLabelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupPolicyByLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupPolicyByLabelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupPolicyByLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupPolicyByLabelRequest.Merge(m, src)
+}
+func (m *QueryGroupPolicyByLabelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupPolicyByLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupPolicyByLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupPolicyByLabelRequest proto.InternalMessageInfo
+
+func (m *QueryGroupPolicyByLabelRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *QueryGroupPolicyByLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// QueryGroupPolicyByLabelResponse is the Query/GroupPolicyByLabel response type.
+type QueryGroupPolicyByLabelResponse struct {
+	// info is the GroupPolicyInfo of the group policy.
+	Info *GroupPolicyInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *QueryGroupPolicyByLabelResponse) Reset()         { *m = QueryGroupPolicyByLabelResponse{} }
+func (m *QueryGroupPolicyByLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupPolicyByLabelResponse) ProtoMessage()    {}
+func (*QueryGroupPolicyByLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{5}
+}
+func (m *QueryGroupPolicyByLabelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupPolicyByLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupPolicyByLabelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupPolicyByLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupPolicyByLabelResponse.Merge(m, src)
+}
+func (m *QueryGroupPolicyByLabelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupPolicyByLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupPolicyByLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupPolicyByLabelResponse proto.InternalMessageInfo
+
+func (m *QueryGroupPolicyByLabelResponse) GetInfo() *GroupPolicyInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
 // QueryGroupMembersRequest is the Query/GroupMembers request type.
 type QueryGroupMembersRequest struct {
 	// group_id is the unique ID of the group.
@@ -228,7 +329,7 @@ func (m *QueryGroupMembersRequest) Reset()         { *m = QueryGroupMembersReque
 func (m *QueryGroupMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersRequest) ProtoMessage()    {}
 func (*QueryGroupMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{4}
+	return fileDescriptor_0fcf9f1d74302290, []int{6}
 }
 func (m *QueryGroupMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +384,7 @@ func (m *QueryGroupMembersResponse) Reset()         { *m = QueryGroupMembersResp
 func (m *QueryGroupMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersResponse) ProtoMessage()    {}
 func (*QueryGroupMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{5}
+	return fileDescriptor_0fcf9f1d74302290, []int{7}
 }
 func (m *QueryGroupMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +439,7 @@ func (m *QueryGroupsByAdminRequest) Reset()         { *m = QueryGroupsByAdminReq
 func (m *QueryGroupsByAdminRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsByAdminRequest) ProtoMessage()    {}
 func (*QueryGroupsByAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{6}
+	return fileDescriptor_0fcf9f1d74302290, []int{8}
 }
 func (m *QueryGroupsByAdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +494,7 @@ func (m *QueryGroupsByAdminResponse) Reset()         { *m = QueryGroupsByAdminRe
 func (m *QueryGroupsByAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsByAdminResponse) ProtoMessage()    {}
 func (*QueryGroupsByAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{7}
+	return fileDescriptor_0fcf9f1d74302290, []int{9}
 }
 func (m *QueryGroupsByAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +549,7 @@ func (m *QueryGroupPoliciesByGroupRequest) Reset()         { *m = QueryGroupPoli
 func (m *QueryGroupPoliciesByGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupPoliciesByGroupRequest) ProtoMessage()    {}
 func (*QueryGroupPoliciesByGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{8}
+	return fileDescriptor_0fcf9f1d74302290, []int{10}
 }
 func (m *QueryGroupPoliciesByGroupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,7 +604,7 @@ func (m *QueryGroupPoliciesByGroupResponse) Reset()         { *m = QueryGroupPol
 func (m *QueryGroupPoliciesByGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupPoliciesByGroupResponse) ProtoMessage()    {}
 func (*QueryGroupPoliciesByGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{9}
+	return fileDescriptor_0fcf9f1d74302290, []int{11}
 }
 func (m *QueryGroupPoliciesByGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +659,7 @@ func (m *QueryGroupPoliciesByAdminRequest) Reset()         { *m = QueryGroupPoli
 func (m *QueryGroupPoliciesByAdminRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupPoliciesByAdminRequest) ProtoMessage()    {}
 func (*QueryGroupPoliciesByAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{10}
+	return fileDescriptor_0fcf9f1d74302290, []int{12}
 }
 func (m *QueryGroupPoliciesByAdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,7 +714,7 @@ func (m *QueryGroupPoliciesByAdminResponse) Reset()         { *m = QueryGroupPol
 func (m *QueryGroupPoliciesByAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupPoliciesByAdminResponse) ProtoMessage()    {}
 func (*QueryGroupPoliciesByAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{11}
+	return fileDescriptor_0fcf9f1d74302290, []int{13}
 }
 func (m *QueryGroupPoliciesByAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +767,7 @@ func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
 func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalRequest) ProtoMessage()    {}
 func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{12}
+	return fileDescriptor_0fcf9f1d74302290, []int{14}
 }
 func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +813,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{13}
+	return fileDescriptor_0fcf9f1d74302290, []int{15}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,7 +861,7 @@ func (m *QueryProposalsByGroupPolicyRequest) Reset()         { *m = QueryProposa
 func (m *QueryProposalsByGroupPolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsByGroupPolicyRequest) ProtoMessage()    {}
 func (*QueryProposalsByGroupPolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{14}
+	return fileDescriptor_0fcf9f1d74302290, []int{16}
 }
 func (m *QueryProposalsByGroupPolicyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +916,7 @@ func (m *QueryProposalsByGroupPolicyResponse) Reset()         { *m = QueryPropos
 func (m *QueryProposalsByGroupPolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalsByGroupPolicyResponse) ProtoMessage()    {}
 func (*QueryProposalsByGroupPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{15}
+	return fileDescriptor_0fcf9f1d74302290, []int{17}
 }
 func (m *QueryProposalsByGroupPolicyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +971,7 @@ func (m *QueryVoteByProposalVoterRequest) Reset()         { *m = QueryVoteByProp
 func (m *QueryVoteByProposalVoterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteByProposalVoterRequest) ProtoMessage()    {}
 func (*QueryVoteByProposalVoterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{16}
+	return fileDescriptor_0fcf9f1d74302290, []int{18}
 }
 func (m *QueryVoteByProposalVoterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1024,7 @@ func (m *QueryVoteByProposalVoterResponse) Reset()         { *m = QueryVoteByPro
 func (m *QueryVoteByProposalVoterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteByProposalVoterResponse) ProtoMessage()    {}
 func (*QueryVoteByProposalVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{17}
+	return fileDescriptor_0fcf9f1d74302290, []int{19}
 }
 func (m *QueryVoteByProposalVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1072,7 @@ func (m *QueryVotesByProposalRequest) Reset()         { *m = QueryVotesByProposa
 func (m *QueryVotesByProposalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByProposalRequest) ProtoMessage()    {}
 func (*QueryVotesByProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{18}
+	return fileDescriptor_0fcf9f1d74302290, []int{20}
 }
 func (m *QueryVotesByProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1127,7 @@ func (m *QueryVotesByProposalResponse) Reset()         { *m = QueryVotesByPropos
 func (m *QueryVotesByProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByProposalResponse) ProtoMessage()    {}
 func (*QueryVotesByProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{19}
+	return fileDescriptor_0fcf9f1d74302290, []int{21}
 }
 func (m *QueryVotesByProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1069,6 +1170,120 @@ func (m *QueryVotesByProposalResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOffchainApprovalsByProposalRequest is the Query/OffchainApprovalsByProposal request type.
+type QueryOffchainApprovalsByProposalRequest struct {
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOffchainApprovalsByProposalRequest) Reset() {
+	*m = QueryOffchainApprovalsByProposalRequest{}
+}
+func (m *QueryOffchainApprovalsByProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOffchainApprovalsByProposalRequest) ProtoMessage()    {}
+func (*QueryOffchainApprovalsByProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{22}
+}
+func (m *QueryOffchainApprovalsByProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffchainApprovalsByProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffchainApprovalsByProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOffchainApprovalsByProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffchainApprovalsByProposalRequest.Merge(m, src)
+}
+func (m *QueryOffchainApprovalsByProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffchainApprovalsByProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffchainApprovalsByProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOffchainApprovalsByProposalRequest proto.InternalMessageInfo
+
+func (m *QueryOffchainApprovalsByProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryOffchainApprovalsByProposalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOffchainApprovalsByProposalResponse is the Query/OffchainApprovalsByProposal response type.
+type QueryOffchainApprovalsByProposalResponse struct {
+	// approvals are the list of off-chain approvals for given proposal_id.
+	Approvals []*OffchainApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOffchainApprovalsByProposalResponse) Reset() {
+	*m = QueryOffchainApprovalsByProposalResponse{}
+}
+func (m *QueryOffchainApprovalsByProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOffchainApprovalsByProposalResponse) ProtoMessage()    {}
+func (*QueryOffchainApprovalsByProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{23}
+}
+func (m *QueryOffchainApprovalsByProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffchainApprovalsByProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffchainApprovalsByProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOffchainApprovalsByProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffchainApprovalsByProposalResponse.Merge(m, src)
+}
+func (m *QueryOffchainApprovalsByProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffchainApprovalsByProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffchainApprovalsByProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOffchainApprovalsByProposalResponse proto.InternalMessageInfo
+
+func (m *QueryOffchainApprovalsByProposalResponse) GetApprovals() []*OffchainApproval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *QueryOffchainApprovalsByProposalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryVotesByVoterRequest is the Query/VotesByVoter request type.
 type QueryVotesByVoterRequest struct {
 	// voter is a proposal voter account address.
@@ -1081,7 +1296,7 @@ func (m *QueryVotesByVoterRequest) Reset()         { *m = QueryVotesByVoterReque
 func (m *QueryVotesByVoterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByVoterRequest) ProtoMessage()    {}
 func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{20}
+	return fileDescriptor_0fcf9f1d74302290, []int{24}
 }
 func (m *QueryVotesByVoterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1351,7 @@ func (m *QueryVotesByVoterResponse) Reset()         { *m = QueryVotesByVoterResp
 func (m *QueryVotesByVoterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesByVoterResponse) ProtoMessage()    {}
 func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{21}
+	return fileDescriptor_0fcf9f1d74302290, []int{25}
 }
 func (m *QueryVotesByVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1179,26 +1394,26 @@ func (m *QueryVotesByVoterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryGroupsByMemberRequest is the Query/GroupsByMember request type.
-type QueryGroupsByMemberRequest struct {
-	// address is the group member address.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// QuerySubGroupsRequest is the Query/SubGroups request type.
+type QuerySubGroupsRequest struct {
+	// group_id is the unique ID of the parent group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGroupsByMemberRequest) Reset()         { *m = QueryGroupsByMemberRequest{} }
-func (m *QueryGroupsByMemberRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGroupsByMemberRequest) ProtoMessage()    {}
-func (*QueryGroupsByMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{22}
+func (m *QuerySubGroupsRequest) Reset()         { *m = QuerySubGroupsRequest{} }
+func (m *QuerySubGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubGroupsRequest) ProtoMessage()    {}
+func (*QuerySubGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{26}
 }
-func (m *QueryGroupsByMemberRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGroupsByMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGroupsByMemberRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubGroupsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1208,52 +1423,52 @@ func (m *QueryGroupsByMemberRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGroupsByMemberRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGroupsByMemberRequest.Merge(m, src)
+func (m *QuerySubGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubGroupsRequest.Merge(m, src)
 }
-func (m *QueryGroupsByMemberRequest) XXX_Size() int {
+func (m *QuerySubGroupsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGroupsByMemberRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGroupsByMemberRequest.DiscardUnknown(m)
+func (m *QuerySubGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubGroupsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGroupsByMemberRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySubGroupsRequest proto.InternalMessageInfo
 
-func (m *QueryGroupsByMemberRequest) GetAddress() string {
+func (m *QuerySubGroupsRequest) GetGroupId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryGroupsByMemberRequest) GetPagination() *query.PageRequest {
+func (m *QuerySubGroupsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGroupsByMemberResponse is the Query/GroupsByMember response type.
-type QueryGroupsByMemberResponse struct {
-	// groups are the groups info with the provided group member.
-	Groups []*GroupInfo `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+// QuerySubGroupsResponse is the Query/SubGroups response type.
+type QuerySubGroupsResponse struct {
+	// links are the links of the group to its sub-groups.
+	Links []*GroupLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGroupsByMemberResponse) Reset()         { *m = QueryGroupsByMemberResponse{} }
-func (m *QueryGroupsByMemberResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGroupsByMemberResponse) ProtoMessage()    {}
-func (*QueryGroupsByMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{23}
+func (m *QuerySubGroupsResponse) Reset()         { *m = QuerySubGroupsResponse{} }
+func (m *QuerySubGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubGroupsResponse) ProtoMessage()    {}
+func (*QuerySubGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{27}
 }
-func (m *QueryGroupsByMemberResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGroupsByMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGroupsByMemberResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubGroupsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1263,50 +1478,52 @@ func (m *QueryGroupsByMemberResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGroupsByMemberResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGroupsByMemberResponse.Merge(m, src)
+func (m *QuerySubGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubGroupsResponse.Merge(m, src)
 }
-func (m *QueryGroupsByMemberResponse) XXX_Size() int {
+func (m *QuerySubGroupsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGroupsByMemberResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGroupsByMemberResponse.DiscardUnknown(m)
+func (m *QuerySubGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubGroupsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGroupsByMemberResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySubGroupsResponse proto.InternalMessageInfo
 
-func (m *QueryGroupsByMemberResponse) GetGroups() []*GroupInfo {
+func (m *QuerySubGroupsResponse) GetLinks() []*GroupLink {
 	if m != nil {
-		return m.Groups
+		return m.Links
 	}
 	return nil
 }
 
-func (m *QueryGroupsByMemberResponse) GetPagination() *query.PageResponse {
+func (m *QuerySubGroupsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryTallyResultRequest is the Query/TallyResult request type.
-type QueryTallyResultRequest struct {
-	// proposal_id is the unique id of a proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+// QueryParentGroupsRequest is the Query/ParentGroups request type.
+type QueryParentGroupsRequest struct {
+	// group_id is the unique ID of the child group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest{} }
-func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTallyResultRequest) ProtoMessage()    {}
-func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{24}
+func (m *QueryParentGroupsRequest) Reset()         { *m = QueryParentGroupsRequest{} }
+func (m *QueryParentGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParentGroupsRequest) ProtoMessage()    {}
+func (*QueryParentGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
 }
-func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryParentGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTallyResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParentGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTallyResultRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParentGroupsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1316,36 +1533,254 @@ func (m *QueryTallyResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryTallyResultRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTallyResultRequest.Merge(m, src)
+func (m *QueryParentGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParentGroupsRequest.Merge(m, src)
 }
-func (m *QueryTallyResultRequest) XXX_Size() int {
+func (m *QueryParentGroupsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTallyResultRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTallyResultRequest.DiscardUnknown(m)
+func (m *QueryParentGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParentGroupsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTallyResultRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryParentGroupsRequest proto.InternalMessageInfo
 
-func (m *QueryTallyResultRequest) GetProposalId() uint64 {
+func (m *QueryParentGroupsRequest) GetGroupId() uint64 {
 	if m != nil {
-		return m.ProposalId
+		return m.GroupId
 	}
 	return 0
 }
 
-// QueryTallyResultResponse is the Query/TallyResult response type.
-type QueryTallyResultResponse struct {
-	// tally defines the requested tally.
-	Tally TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+func (m *QueryParentGroupsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParentGroupsResponse is the Query/ParentGroups response type.
+type QueryParentGroupsResponse struct {
+	// links are the links of the group to its parent groups.
+	Links []*GroupLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParentGroupsResponse) Reset()         { *m = QueryParentGroupsResponse{} }
+func (m *QueryParentGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParentGroupsResponse) ProtoMessage()    {}
+func (*QueryParentGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryParentGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParentGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParentGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParentGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParentGroupsResponse.Merge(m, src)
+}
+func (m *QueryParentGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParentGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParentGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParentGroupsResponse proto.InternalMessageInfo
+
+func (m *QueryParentGroupsResponse) GetLinks() []*GroupLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *QueryParentGroupsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGroupsByMemberRequest is the Query/GroupsByMember request type.
+type QueryGroupsByMemberRequest struct {
+	// address is the group member address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGroupsByMemberRequest) Reset()         { *m = QueryGroupsByMemberRequest{} }
+func (m *QueryGroupsByMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupsByMemberRequest) ProtoMessage()    {}
+func (*QueryGroupsByMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryGroupsByMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupsByMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupsByMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupsByMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupsByMemberRequest.Merge(m, src)
+}
+func (m *QueryGroupsByMemberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupsByMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupsByMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupsByMemberRequest proto.InternalMessageInfo
+
+func (m *QueryGroupsByMemberRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryGroupsByMemberRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGroupsByMemberResponse is the Query/GroupsByMember response type.
+type QueryGroupsByMemberResponse struct {
+	// groups are the groups info with the provided group member.
+	Groups []*GroupInfo `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGroupsByMemberResponse) Reset()         { *m = QueryGroupsByMemberResponse{} }
+func (m *QueryGroupsByMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupsByMemberResponse) ProtoMessage()    {}
+func (*QueryGroupsByMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryGroupsByMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupsByMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupsByMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupsByMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupsByMemberResponse.Merge(m, src)
+}
+func (m *QueryGroupsByMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupsByMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupsByMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupsByMemberResponse proto.InternalMessageInfo
+
+func (m *QueryGroupsByMemberResponse) GetGroups() []*GroupInfo {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *QueryGroupsByMemberResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTallyResultRequest is the Query/TallyResult request type.
+type QueryTallyResultRequest struct {
+	// proposal_id is the unique id of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest{} }
+func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultRequest) ProtoMessage()    {}
+func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{32}
+}
+func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyResultRequest.Merge(m, src)
+}
+func (m *QueryTallyResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyResultRequest proto.InternalMessageInfo
+
+func (m *QueryTallyResultRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyResultResponse is the Query/TallyResult response type.
+type QueryTallyResultResponse struct {
+	// tally defines the requested tally.
+	Tally TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
 }
 
 func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultResponse{} }
 func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultResponse) ProtoMessage()    {}
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{25}
+	return fileDescriptor_0fcf9f1d74302290, []int{33}
 }
 func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,7 +1826,7 @@ func (m *QueryGroupsRequest) Reset()         { *m = QueryGroupsRequest{} }
 func (m *QueryGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsRequest) ProtoMessage()    {}
 func (*QueryGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{26}
+	return fileDescriptor_0fcf9f1d74302290, []int{34}
 }
 func (m *QueryGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1874,7 @@ func (m *QueryGroupsResponse) Reset()         { *m = QueryGroupsResponse{} }
 func (m *QueryGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsResponse) ProtoMessage()    {}
 func (*QueryGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fcf9f1d74302290, []int{27}
+	return fileDescriptor_0fcf9f1d74302290, []int{35}
 }
 func (m *QueryGroupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,6 +1922,8 @@ func init() {
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
 	proto.RegisterType((*QueryGroupPolicyInfoRequest)(nil), "cosmos.group.v1.QueryGroupPolicyInfoRequest")
 	proto.RegisterType((*QueryGroupPolicyInfoResponse)(nil), "cosmos.group.v1.QueryGroupPolicyInfoResponse")
+	proto.RegisterType((*QueryGroupPolicyByLabelRequest)(nil), "cosmos.group.v1.QueryGroupPolicyByLabelRequest")
+	proto.RegisterType((*QueryGroupPolicyByLabelResponse)(nil), "cosmos.group.v1.QueryGroupPolicyByLabelResponse")
 	proto.RegisterType((*QueryGroupMembersRequest)(nil), "cosmos.group.v1.QueryGroupMembersRequest")
 	proto.RegisterType((*QueryGroupMembersResponse)(nil), "cosmos.group.v1.QueryGroupMembersResponse")
 	proto.RegisterType((*QueryGroupsByAdminRequest)(nil), "cosmos.group.v1.QueryGroupsByAdminRequest")
@@ -1503,8 +1940,14 @@ func init() {
 	proto.RegisterType((*QueryVoteByProposalVoterResponse)(nil), "cosmos.group.v1.QueryVoteByProposalVoterResponse")
 	proto.RegisterType((*QueryVotesByProposalRequest)(nil), "cosmos.group.v1.QueryVotesByProposalRequest")
 	proto.RegisterType((*QueryVotesByProposalResponse)(nil), "cosmos.group.v1.QueryVotesByProposalResponse")
+	proto.RegisterType((*QueryOffchainApprovalsByProposalRequest)(nil), "cosmos.group.v1.QueryOffchainApprovalsByProposalRequest")
+	proto.RegisterType((*QueryOffchainApprovalsByProposalResponse)(nil), "cosmos.group.v1.QueryOffchainApprovalsByProposalResponse")
 	proto.RegisterType((*QueryVotesByVoterRequest)(nil), "cosmos.group.v1.QueryVotesByVoterRequest")
 	proto.RegisterType((*QueryVotesByVoterResponse)(nil), "cosmos.group.v1.QueryVotesByVoterResponse")
+	proto.RegisterType((*QuerySubGroupsRequest)(nil), "cosmos.group.v1.QuerySubGroupsRequest")
+	proto.RegisterType((*QuerySubGroupsResponse)(nil), "cosmos.group.v1.QuerySubGroupsResponse")
+	proto.RegisterType((*QueryParentGroupsRequest)(nil), "cosmos.group.v1.QueryParentGroupsRequest")
+	proto.RegisterType((*QueryParentGroupsResponse)(nil), "cosmos.group.v1.QueryParentGroupsResponse")
 	proto.RegisterType((*QueryGroupsByMemberRequest)(nil), "cosmos.group.v1.QueryGroupsByMemberRequest")
 	proto.RegisterType((*QueryGroupsByMemberResponse)(nil), "cosmos.group.v1.QueryGroupsByMemberResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.group.v1.QueryTallyResultRequest")
@@ -1516,90 +1959,109 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0x26, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x85,
-	0xfc, 0xce, 0x6e, 0xec, 0xa4, 0x09, 0x2a, 0x54, 0xa8, 0x96, 0x20, 0xe4, 0x50, 0x94, 0x9a, 0x0a,
-	0x09, 0x2e, 0xd1, 0xa6, 0xde, 0x58, 0xab, 0xda, 0x3b, 0xae, 0xd7, 0x89, 0xb0, 0x22, 0x5f, 0x90,
-	0x40, 0x02, 0x71, 0xa0, 0x14, 0xa1, 0x12, 0x71, 0xe8, 0x01, 0x89, 0x72, 0xe3, 0x50, 0x84, 0xc4,
-	0xad, 0xb7, 0x8a, 0x53, 0x55, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x37, 0xd0, 0xce, 0xbc, 0xb5,
-	0xf7, 0xc7, 0xec, 0xda, 0x11, 0x16, 0xcd, 0xa5, 0xaa, 0x77, 0xde, 0x9b, 0xf9, 0xcc, 0xf7, 0xbd,
-	0x9d, 0xfd, 0x4e, 0xe0, 0xd2, 0x6d, 0xe6, 0x94, 0x98, 0xa3, 0x17, 0x2a, 0x6c, 0xb7, 0xac, 0xef,
-	0xa5, 0xf5, 0xbb, 0xbb, 0x66, 0xa5, 0xa6, 0x95, 0x2b, 0xac, 0xca, 0xe8, 0x90, 0x18, 0xd4, 0xf8,
-	0xa0, 0xb6, 0x97, 0x56, 0x86, 0x0b, 0xac, 0xc0, 0xf8, 0x98, 0xee, 0xfe, 0x4f, 0x84, 0x29, 0xe3,
-	0x05, 0xc6, 0x0a, 0x45, 0x53, 0x37, 0xca, 0x96, 0x6e, 0xd8, 0x36, 0xab, 0x1a, 0x55, 0x8b, 0xd9,
-	0x0e, 0x8e, 0x46, 0x56, 0xa8, 0xd6, 0xca, 0xa6, 0x37, 0x38, 0x87, 0x83, 0xdb, 0x86, 0x63, 0x8a,
-	0xa5, 0xf5, 0xbd, 0xf4, 0xb6, 0x59, 0x35, 0xd2, 0x7a, 0xd9, 0x28, 0x58, 0x36, 0x9f, 0x09, 0x63,
-	0xc7, 0x44, 0xec, 0x96, 0x58, 0x1f, 0xd1, 0xc4, 0xd0, 0x39, 0xa3, 0x64, 0xd9, 0x4c, 0xe7, 0xff,
-	0x8a, 0x47, 0x6a, 0x06, 0x46, 0x6e, 0xba, 0xf3, 0xad, 0xbb, 0xcb, 0x6e, 0xd8, 0x3b, 0x2c, 0x67,
-	0xde, 0xdd, 0x35, 0x9d, 0x2a, 0x1d, 0x83, 0x7e, 0x8e, 0xb2, 0x65, 0xe5, 0x47, 0xc9, 0x24, 0x99,
-	0xe9, 0xce, 0xf5, 0xf1, 0xdf, 0x1b, 0x79, 0xf5, 0x1d, 0xb8, 0x10, 0xce, 0x71, 0xca, 0xcc, 0x76,
-	0x4c, 0xaa, 0x41, 0xb7, 0x65, 0xef, 0x30, 0x9e, 0x30, 0x98, 0x51, 0xb4, 0x90, 0x30, 0x5a, 0x33,
-	0x83, 0xc7, 0xa9, 0x37, 0xe1, 0x52, 0x73, 0xa6, 0x4d, 0x56, 0xb4, 0x6e, 0xd7, 0xfc, 0x0c, 0x19,
-	0xe8, 0x33, 0xf2, 0xf9, 0x8a, 0xe9, 0x38, 0x7c, 0xc6, 0x81, 0xec, 0xe8, 0xf3, 0xc7, 0x8b, 0xc3,
-	0x38, 0xe9, 0x75, 0x31, 0xf2, 0x5e, 0xb5, 0x62, 0xd9, 0x85, 0x9c, 0x17, 0xa8, 0xde, 0x82, 0x71,
-	0xf9, 0x94, 0x88, 0xb8, 0x12, 0x40, 0x9c, 0x94, 0x23, 0xfa, 0xf2, 0x04, 0x68, 0x1d, 0x46, 0x9b,
-	0xb3, 0xde, 0x30, 0x4b, 0xdb, 0x66, 0xc5, 0x69, 0xad, 0x14, 0x7d, 0x1b, 0xa0, 0x59, 0x9f, 0xd1,
-	0x53, 0x7c, 0xc9, 0x29, 0x6f, 0x49, 0xb7, 0x98, 0x9a, 0xe8, 0x23, 0x2c, 0xa6, 0xb6, 0x69, 0x14,
-	0x4c, 0x9c, 0x36, 0xe7, 0xcb, 0x54, 0xbf, 0x23, 0x30, 0x26, 0x59, 0x1f, 0xb7, 0xb4, 0x0a, 0x7d,
-	0x25, 0xf1, 0x68, 0x94, 0x4c, 0xbe, 0x34, 0x33, 0x98, 0x19, 0x97, 0xef, 0x4a, 0xe4, 0xe5, 0xbc,
-	0x60, 0xba, 0x2e, 0xa1, 0x9b, 0x6e, 0x49, 0x27, 0x16, 0x0d, 0xe0, 0xdd, 0x0f, 0xe0, 0x39, 0xd9,
-	0xda, 0xf5, 0x7c, 0xc9, 0xb2, 0x3d, 0x7d, 0x34, 0xe8, 0x31, 0xdc, 0xdf, 0x2d, 0x6b, 0x28, 0xc2,
-	0x3a, 0x26, 0xda, 0xb7, 0x04, 0x14, 0x19, 0x15, 0xaa, 0x96, 0x81, 0x5e, 0x2e, 0x8f, 0x27, 0x5a,
-	0x52, 0xb7, 0x62, 0x64, 0xe7, 0x14, 0xfb, 0x84, 0xc0, 0x64, 0xa8, 0x4d, 0x2d, 0xd3, 0xc9, 0x8a,
-	0x9f, 0xff, 0x63, 0x63, 0xfd, 0x4c, 0xe0, 0xe5, 0x04, 0x0e, 0x94, 0x6a, 0x1d, 0xce, 0x0a, 0x90,
-	0x32, 0x06, 0xa0, 0x64, 0xad, 0xdf, 0x9e, 0x33, 0x05, 0xff, 0xbc, 0x9d, 0xd3, 0xef, 0x20, 0x46,
-	0xbf, 0x13, 0xd1, 0x78, 0x71, 0xa2, 0x06, 0xfb, 0xef, 0xe4, 0x89, 0xba, 0x06, 0xc3, 0x1c, 0x7b,
-	0xb3, 0xc2, 0xca, 0xcc, 0x31, 0x8a, 0x9e, 0x8e, 0x13, 0x30, 0x58, 0xc6, 0x47, 0xcd, 0x56, 0x04,
-	0xef, 0xd1, 0x46, 0x5e, 0x7d, 0x17, 0x3f, 0x22, 0xcd, 0x44, 0xdc, 0xe3, 0x15, 0xe8, 0xf7, 0xc2,
-	0xf0, 0xc0, 0x1d, 0x8b, 0xec, 0xae, 0x91, 0xd4, 0x08, 0x55, 0x1f, 0x12, 0x50, 0x03, 0x13, 0x7a,
-	0x1d, 0x29, 0x44, 0xf8, 0x0f, 0x9f, 0x87, 0x8e, 0xd5, 0xf8, 0x07, 0x02, 0x97, 0x13, 0x11, 0x51,
-	0x81, 0x35, 0x18, 0xf0, 0xb6, 0xe5, 0x15, 0x38, 0x41, 0x82, 0x66, 0x6c, 0xe7, 0xaa, 0x5a, 0x81,
-	0x09, 0x0e, 0xfa, 0x3e, 0xab, 0x9a, 0xd9, 0x06, 0xae, 0xfb, 0xab, 0xd2, 0x6e, 0x81, 0xdd, 0x37,
-	0x69, 0xcf, 0x4d, 0xe0, 0x1c, 0x89, 0x6f, 0x12, 0x0f, 0x53, 0x6f, 0xe0, 0xdb, 0x29, 0x5d, 0x13,
-	0x95, 0x99, 0x85, 0x6e, 0x37, 0x18, 0xfb, 0x62, 0x24, 0x22, 0x8a, 0x1b, 0x9d, 0xe3, 0x21, 0xea,
-	0xa7, 0x04, 0x7d, 0x82, 0xfb, 0xcc, 0xc9, 0x1e, 0xbb, 0x41, 0x3b, 0x56, 0xf5, 0xaf, 0x09, 0xba,
-	0x8b, 0x08, 0x08, 0x6e, 0x6a, 0x5e, 0x08, 0xe5, 0x95, 0x3a, 0x66, 0x57, 0x22, 0xa6, 0x73, 0x25,
-	0xfe, 0x8a, 0xa0, 0x3d, 0x41, 0xac, 0x40, 0x71, 0x1b, 0xb5, 0x23, 0x6d, 0xd5, 0xae, 0x63, 0x5a,
-	0xdd, 0xf3, 0x4c, 0x41, 0x10, 0xea, 0x85, 0x0a, 0xf5, 0x20, 0x6c, 0x09, 0xd0, 0x12, 0x9d, 0x80,
-	0x03, 0xe5, 0x80, 0xf8, 0xbd, 0xb0, 0x0f, 0xed, 0x24, 0xd8, 0x95, 0xab, 0x70, 0x91, 0xb3, 0xdd,
-	0x32, 0x8a, 0x45, 0xf7, 0x6c, 0xdb, 0x2d, 0x56, 0xdb, 0xfe, 0x38, 0x7c, 0x80, 0xbd, 0x19, 0xc8,
-	0xc5, 0x4d, 0x5d, 0x83, 0x9e, 0xaa, 0xfb, 0x18, 0x0f, 0x81, 0xa8, 0x6f, 0xf5, 0x25, 0x65, 0x07,
-	0x9e, 0xfe, 0x39, 0xd1, 0xf5, 0xe8, 0x9f, 0x9f, 0xe6, 0x48, 0x4e, 0x64, 0xa9, 0x0e, 0x50, 0x9f,
-	0x64, 0x1e, 0x51, 0x87, 0x2a, 0x72, 0x75, 0xe4, 0xf9, 0xe3, 0xc5, 0x73, 0x22, 0x6d, 0xd1, 0xc9,
-	0xdf, 0x99, 0x5c, 0xd2, 0x56, 0xd6, 0xb4, 0xb4, 0xfa, 0x23, 0x81, 0xf3, 0x81, 0x55, 0x4f, 0x40,
-	0x81, 0x62, 0x58, 0x33, 0xf7, 0x28, 0xf4, 0x70, 0x56, 0xfa, 0x05, 0x81, 0x81, 0xc6, 0xfa, 0x74,
-	0x2a, 0xc2, 0x26, 0xbd, 0x04, 0x2a, 0xd3, 0x2d, 0xe3, 0x04, 0x8b, 0xaa, 0x7d, 0xfc, 0xfb, 0xdf,
-	0xf7, 0x4f, 0xcd, 0xd0, 0x29, 0x3d, 0x7c, 0x8d, 0x45, 0x07, 0x6b, 0xef, 0x30, 0x7d, 0xdf, 0x73,
-	0xb3, 0x75, 0xfa, 0x3d, 0x81, 0xa1, 0x90, 0xad, 0xa1, 0x0b, 0x09, 0x8b, 0x45, 0xee, 0x86, 0xca,
-	0x62, 0x9b, 0xd1, 0x08, 0xb8, 0xc2, 0x01, 0x35, 0xba, 0x10, 0x03, 0xc8, 0x4d, 0x58, 0x0d, 0x39,
-	0xf1, 0xdd, 0xae, 0xd3, 0x07, 0x04, 0x4e, 0xfb, 0xaf, 0x5c, 0x74, 0x36, 0x61, 0xd5, 0xe0, 0xb5,
-	0x50, 0x99, 0x6b, 0x27, 0x14, 0xe9, 0xd2, 0x9c, 0x6e, 0x9e, 0xce, 0xc6, 0xd0, 0xe1, 0x8d, 0xcd,
-	0xaf, 0xe0, 0x01, 0x81, 0x33, 0x81, 0x8b, 0x0d, 0x4d, 0x5a, 0x30, 0x64, 0x8d, 0x95, 0xf9, 0xb6,
-	0x62, 0x91, 0x6e, 0x89, 0xd3, 0xcd, 0xd1, 0x19, 0x39, 0x9d, 0xb3, 0xb5, 0x5d, 0xdb, 0xe2, 0x0e,
-	0xda, 0x55, 0xae, 0x64, 0xd9, 0x75, 0xfa, 0x2b, 0x81, 0x61, 0xd9, 0x8d, 0x82, 0xa6, 0x5b, 0x55,
-	0x2d, 0x72, 0x0b, 0x52, 0x32, 0xc7, 0x49, 0x41, 0xe2, 0xd7, 0x39, 0xf1, 0x15, 0xba, 0x9c, 0x54,
-	0x6d, 0xcb, 0xe4, 0xe4, 0x62, 0xc8, 0xa7, 0xec, 0x2f, 0x51, 0x78, 0x21, 0x70, 0x7b, 0xf0, 0x01,
-	0x9d, 0x33, 0xc7, 0x49, 0x41, 0xf8, 0xd7, 0x38, 0x7c, 0x86, 0x2e, 0xb5, 0x01, 0x1f, 0x94, 0xfd,
-	0x73, 0x02, 0xfd, 0x9e, 0x25, 0xa1, 0xaf, 0xca, 0x97, 0x0e, 0x79, 0x27, 0x65, 0xaa, 0x55, 0x18,
-	0x52, 0xe9, 0x9c, 0x6a, 0x96, 0x4e, 0x47, 0xa8, 0xbc, 0xb3, 0x5e, 0xdf, 0xf7, 0x7d, 0x08, 0xea,
-	0xf4, 0x09, 0x81, 0x0b, 0x72, 0x73, 0x4c, 0x97, 0x93, 0xd7, 0x94, 0xba, 0x7d, 0x65, 0xe5, 0x78,
-	0x49, 0x88, 0xfd, 0x06, 0xc7, 0x5e, 0xa5, 0x2b, 0xb1, 0xd8, 0xcd, 0x26, 0xc0, 0x43, 0xc0, 0xf7,
-	0xfe, 0x3f, 0x21, 0x70, 0x5e, 0xe2, 0x61, 0xe9, 0x92, 0x9c, 0x25, 0xde, 0x62, 0x2b, 0xe9, 0x63,
-	0x64, 0x20, 0xfa, 0x5b, 0x1c, 0xfd, 0x4d, 0x7a, 0x2d, 0x82, 0xee, 0xba, 0x22, 0x97, 0xba, 0xa1,
-	0x37, 0x77, 0x6e, 0x41, 0xfd, 0xf5, 0x7d, 0xfe, 0xb0, 0x4e, 0x1f, 0x11, 0x18, 0x0a, 0xd9, 0xd5,
-	0xb8, 0xa3, 0x56, 0x6e, 0xaf, 0xe3, 0x8e, 0xda, 0x18, 0x0f, 0x9c, 0xd0, 0xbf, 0xdc, 0xcd, 0xf9,
-	0xc1, 0x43, 0x2d, 0xf3, 0x0d, 0x81, 0xd3, 0x7e, 0xb7, 0x18, 0x77, 0xdc, 0x4a, 0x6c, 0x6e, 0xdc,
-	0x71, 0x2b, 0x33, 0x9f, 0x09, 0xbd, 0xdc, 0x20, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0x06,
-	0x7d, 0x19, 0x6d, 0x71, 0x82, 0x06, 0x8c, 0xa5, 0xb2, 0xd0, 0x5e, 0x30, 0xe2, 0x2d, 0x73, 0xbc,
-	0x45, 0x3a, 0x9f, 0x70, 0xde, 0x8a, 0x2f, 0x82, 0xaf, 0x55, 0x0f, 0x08, 0x0c, 0xfa, 0xdc, 0x12,
-	0x9d, 0x91, 0x2f, 0x19, 0x75, 0x70, 0xca, 0x6c, 0x1b, 0x91, 0x48, 0xb6, 0xca, 0xc9, 0x96, 0xa8,
-	0x16, 0xff, 0x36, 0x85, 0xba, 0x90, 0x1b, 0x35, 0xfa, 0x19, 0x81, 0x5e, 0xb1, 0x59, 0x7a, 0x39,
-	0x49, 0x0a, 0x0f, 0xe9, 0x95, 0xe4, 0x20, 0xef, 0x9b, 0xfe, 0x9b, 0xcc, 0xf4, 0x70, 0xc4, 0x31,
-	0x7a, 0x31, 0x46, 0xbc, 0xac, 0xf6, 0xf4, 0x30, 0x45, 0x9e, 0x1d, 0xa6, 0xc8, 0x5f, 0x87, 0x29,
-	0xf2, 0xe5, 0x51, 0xaa, 0xeb, 0xd9, 0x51, 0xaa, 0xeb, 0x8f, 0xa3, 0x54, 0xd7, 0x87, 0xe8, 0xf4,
-	0x9d, 0xfc, 0x1d, 0xcd, 0x62, 0xfa, 0x47, 0x22, 0x61, 0xbb, 0x97, 0xff, 0xa1, 0x7c, 0xf9, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x6a, 0xbb, 0x90, 0x03, 0x18, 0x00, 0x00,
+	// 1627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdd, 0x6f, 0x14, 0xd5,
+	0x1b, 0xc7, 0x7b, 0xf8, 0x51, 0xa0, 0x4f, 0x79, 0x09, 0x87, 0x16, 0xb6, 0xd3, 0xa6, 0x94, 0xe1,
+	0x67, 0x5f, 0xe9, 0x4c, 0x77, 0x5b, 0xde, 0x25, 0xc8, 0x1a, 0x45, 0x12, 0xd0, 0xb2, 0x10, 0x8d,
+	0xde, 0x34, 0xb3, 0xec, 0x74, 0x9d, 0x74, 0x3b, 0xb3, 0xec, 0x6c, 0x1b, 0x9b, 0xa6, 0x37, 0x26,
+	0x9a, 0x68, 0x8c, 0x11, 0x31, 0x06, 0x1b, 0x62, 0xb8, 0x30, 0x82, 0x5c, 0x79, 0x81, 0x31, 0xf1,
+	0x8e, 0x3b, 0x42, 0xbc, 0x20, 0x78, 0xe3, 0x95, 0x31, 0x60, 0xe2, 0x85, 0xff, 0x84, 0x99, 0x73,
+	0x9e, 0xd9, 0x9d, 0x97, 0x33, 0x2f, 0x95, 0x15, 0xf7, 0x86, 0x74, 0xe7, 0x3c, 0xcf, 0x79, 0x3e,
+	0xe7, 0x7b, 0x9e, 0xf3, 0xf2, 0x1c, 0xa0, 0xff, 0x8a, 0x65, 0x2f, 0x5a, 0xb6, 0x5a, 0xae, 0x59,
+	0x4b, 0x55, 0x75, 0x39, 0xab, 0x5e, 0x5d, 0xd2, 0x6b, 0x2b, 0x4a, 0xb5, 0x66, 0xd5, 0x2d, 0xba,
+	0x8b, 0x37, 0x2a, 0xac, 0x51, 0x59, 0xce, 0x4a, 0x3d, 0x65, 0xab, 0x6c, 0xb1, 0x36, 0xd5, 0xf9,
+	0x8b, 0x9b, 0x49, 0x03, 0x65, 0xcb, 0x2a, 0x57, 0x74, 0x55, 0xab, 0x1a, 0xaa, 0x66, 0x9a, 0x56,
+	0x5d, 0xab, 0x1b, 0x96, 0x69, 0x63, 0x6b, 0x28, 0x42, 0x7d, 0xa5, 0xaa, 0xbb, 0x8d, 0xe3, 0xd8,
+	0x58, 0xd4, 0x6c, 0x9d, 0x87, 0x56, 0x97, 0xb3, 0x45, 0xbd, 0xae, 0x65, 0xd5, 0xaa, 0x56, 0x36,
+	0x4c, 0xd6, 0x13, 0xda, 0xf6, 0x71, 0xdb, 0x39, 0x1e, 0x9f, 0xff, 0xc0, 0xa6, 0xdd, 0xda, 0xa2,
+	0x61, 0x5a, 0x2a, 0xfb, 0x97, 0x7f, 0x92, 0x73, 0xd0, 0x7b, 0xd1, 0xe9, 0xef, 0xac, 0x13, 0xf6,
+	0x9c, 0x39, 0x6f, 0x15, 0xf4, 0xab, 0x4b, 0xba, 0x5d, 0xa7, 0x7d, 0xb0, 0x8d, 0xa1, 0xcc, 0x19,
+	0xa5, 0x0c, 0x19, 0x22, 0xa3, 0x9b, 0x0b, 0x5b, 0xd9, 0xef, 0x73, 0x25, 0xf9, 0x35, 0xd8, 0x1b,
+	0xf4, 0xb1, 0xab, 0x96, 0x69, 0xeb, 0x54, 0x81, 0xcd, 0x86, 0x39, 0x6f, 0x31, 0x87, 0xee, 0x9c,
+	0xa4, 0x04, 0x84, 0x51, 0x9a, 0x1e, 0xcc, 0x4e, 0xbe, 0x08, 0xfd, 0xcd, 0x9e, 0x66, 0xad, 0x8a,
+	0x71, 0x65, 0xc5, 0xcb, 0x90, 0x83, 0xad, 0x5a, 0xa9, 0x54, 0xd3, 0x6d, 0x9b, 0xf5, 0xd8, 0x95,
+	0xcf, 0x3c, 0xbe, 0x37, 0xd9, 0x83, 0x9d, 0x9e, 0xe1, 0x2d, 0x97, 0xea, 0x35, 0xc3, 0x2c, 0x17,
+	0x5c, 0x43, 0xf9, 0x32, 0x0c, 0x88, 0xbb, 0x44, 0xc4, 0x19, 0x1f, 0xe2, 0x90, 0x18, 0xd1, 0xe3,
+	0xc7, 0x41, 0x75, 0x18, 0x0c, 0xf6, 0x9a, 0x5f, 0x39, 0xaf, 0x15, 0xf5, 0x4a, 0xb2, 0x5e, 0xb4,
+	0x07, 0x3a, 0x2b, 0x8e, 0x69, 0x66, 0x93, 0x33, 0x88, 0x02, 0xff, 0x71, 0x82, 0x3e, 0xbe, 0x37,
+	0xb9, 0xf3, 0x3d, 0x3e, 0xdf, 0x43, 0xcb, 0x53, 0x4a, 0x4e, 0x99, 0x92, 0x17, 0x60, 0x7f, 0x64,
+	0x98, 0x67, 0xe1, 0x17, 0x06, 0x5b, 0x83, 0x4c, 0x33, 0xd8, 0x05, 0x7d, 0xb1, 0xa8, 0xd7, 0xec,
+	0x14, 0xa3, 0x79, 0x15, 0xa0, 0x99, 0x73, 0x6c, 0x48, 0xdd, 0xb9, 0x61, 0x17, 0xc3, 0x49, 0x50,
+	0x85, 0xaf, 0x0d, 0x4c, 0x50, 0x65, 0x56, 0x2b, 0xeb, 0xd8, 0x6d, 0xc1, 0xe3, 0x29, 0xdf, 0x24,
+	0xd0, 0x27, 0x88, 0x8f, 0xc3, 0x3c, 0x02, 0x5b, 0x17, 0xf9, 0xa7, 0x0c, 0x19, 0xfa, 0xdf, 0x68,
+	0x77, 0x6e, 0x40, 0x3c, 0x52, 0xee, 0x57, 0x70, 0x8d, 0xe9, 0x59, 0x01, 0xdd, 0x48, 0x22, 0x1d,
+	0x0f, 0xea, 0xc3, 0xbb, 0xee, 0xc3, 0xb3, 0xf3, 0x2b, 0x67, 0x4a, 0x8b, 0x86, 0xe9, 0xea, 0xa3,
+	0x40, 0xa7, 0xe6, 0xfc, 0x4e, 0xcc, 0x4b, 0x6e, 0xd6, 0x32, 0xd1, 0xbe, 0x22, 0x20, 0x89, 0xa8,
+	0x50, 0xb5, 0x1c, 0x6c, 0x61, 0xf2, 0xb8, 0xa2, 0xc5, 0xad, 0x40, 0xb4, 0x6c, 0x9d, 0x62, 0x1f,
+	0x10, 0x18, 0x0a, 0x64, 0xaf, 0xa1, 0xdb, 0x79, 0xfe, 0xf3, 0x39, 0x26, 0xd6, 0x0f, 0x04, 0x0e,
+	0xc4, 0x70, 0xa0, 0x54, 0x67, 0x61, 0x27, 0x07, 0xa9, 0xa2, 0x01, 0x4a, 0x96, 0xbc, 0xa2, 0x76,
+	0x94, 0xbd, 0xfd, 0xb6, 0x4e, 0xbf, 0xf5, 0x08, 0xfd, 0xda, 0x22, 0xf1, 0xa2, 0x44, 0xf5, 0xe7,
+	0x5f, 0xfb, 0x89, 0x7a, 0x14, 0x7a, 0x18, 0xf6, 0x6c, 0xcd, 0xaa, 0x5a, 0xb6, 0xd6, 0xd8, 0xae,
+	0xf7, 0x43, 0x77, 0x15, 0x3f, 0x35, 0x53, 0x11, 0xdc, 0x4f, 0xe7, 0x4a, 0xf2, 0xeb, 0xd0, 0x1b,
+	0x70, 0xc4, 0x31, 0x1e, 0x86, 0x6d, 0xae, 0x19, 0x6e, 0xc2, 0x7d, 0xa1, 0xd1, 0x35, 0x9c, 0x1a,
+	0xa6, 0xf2, 0x2d, 0x02, 0xb2, 0xaf, 0x43, 0x37, 0x23, 0xb9, 0x08, 0xcf, 0x70, 0xe4, 0xb5, 0x6c,
+	0x8e, 0x6f, 0x13, 0x38, 0x18, 0x8b, 0x88, 0x0a, 0x1c, 0x85, 0x2e, 0x77, 0x58, 0xee, 0x04, 0xc7,
+	0x48, 0xd0, 0xb4, 0x6d, 0xdd, 0xac, 0xd6, 0xf0, 0x9c, 0x7c, 0xd3, 0xaa, 0xeb, 0xf9, 0x06, 0xae,
+	0xf3, 0xab, 0x96, 0x76, 0x82, 0x9d, 0x95, 0xb4, 0xec, 0x38, 0x64, 0x36, 0x25, 0xe8, 0xcc, 0xcd,
+	0xe4, 0x0b, 0xb8, 0x3a, 0x85, 0x31, 0x51, 0x99, 0x31, 0xd8, 0xec, 0x18, 0x63, 0x5e, 0xf4, 0x86,
+	0x44, 0x71, 0xac, 0x0b, 0xcc, 0x44, 0xfe, 0x90, 0xe0, 0xdd, 0xc7, 0xf9, 0x66, 0xe7, 0x37, 0x9c,
+	0xa0, 0x2d, 0x9b, 0xf5, 0x2f, 0x08, 0x0c, 0x88, 0x41, 0x70, 0x50, 0x13, 0x5c, 0x28, 0x77, 0xaa,
+	0x23, 0x46, 0xc5, 0x6d, 0x5a, 0x37, 0xc5, 0xdf, 0x12, 0x18, 0x61, 0x58, 0x6f, 0xcc, 0xcf, 0x5f,
+	0x79, 0x57, 0x33, 0xcc, 0x33, 0xd5, 0x6a, 0xcd, 0x5a, 0xd6, 0x2a, 0x3e, 0xc4, 0xe7, 0xab, 0x95,
+	0xf0, 0x1a, 0xf5, 0x80, 0xc0, 0x68, 0x32, 0x28, 0x6a, 0x79, 0x1a, 0xba, 0x34, 0xb7, 0x19, 0xf5,
+	0x3c, 0x10, 0xd2, 0x33, 0xd8, 0x51, 0xa1, 0xe9, 0xd3, 0x32, 0x7d, 0x85, 0x43, 0xf9, 0x9c, 0xe0,
+	0x95, 0x10, 0x53, 0xc1, 0xb7, 0xa0, 0x1a, 0xeb, 0x85, 0xa4, 0x5a, 0x2f, 0x2d, 0xcb, 0xcf, 0x6b,
+	0xee, 0x45, 0xcc, 0x0f, 0xf5, 0x9f, 0x26, 0xe7, 0xa7, 0x04, 0x4f, 0x87, 0x4b, 0x4b, 0x45, 0x7e,
+	0x13, 0x7b, 0x7e, 0xf7, 0x1b, 0xe1, 0xcc, 0xdd, 0x26, 0xb0, 0x37, 0x08, 0x84, 0x0a, 0x4d, 0x41,
+	0x67, 0xc5, 0x30, 0x17, 0x12, 0xae, 0x84, 0xe7, 0x0d, 0x73, 0xa1, 0xc0, 0x0d, 0xff, 0xdd, 0x1c,
+	0xbb, 0xe6, 0xe6, 0xd8, 0xac, 0x56, 0xd3, 0xcd, 0x7a, 0x5b, 0xa8, 0x77, 0xd7, 0x4d, 0x31, 0x3f,
+	0x53, 0x7b, 0x0a, 0x78, 0x23, 0x58, 0x02, 0x60, 0x09, 0xd4, 0x06, 0x17, 0x88, 0x75, 0x02, 0xfd,
+	0x42, 0xb4, 0x76, 0x28, 0x4f, 0x4e, 0xc0, 0x3e, 0xc6, 0x76, 0x59, 0xab, 0x54, 0x9c, 0xbb, 0xcc,
+	0x52, 0xa5, 0x9e, 0xfa, 0x32, 0xf8, 0x36, 0x64, 0xc2, 0xbe, 0x38, 0xa8, 0x53, 0xd0, 0x59, 0x77,
+	0x3e, 0xe3, 0xa1, 0x1f, 0xae, 0x53, 0x3d, 0x4e, 0xf9, 0xae, 0x07, 0xbf, 0xed, 0xef, 0xb8, 0xf3,
+	0xe7, 0xf7, 0xe3, 0xa4, 0xc0, 0xbd, 0x64, 0x1b, 0xa8, 0x47, 0x32, 0x97, 0xa8, 0x55, 0xd9, 0xde,
+	0xfb, 0xf8, 0xde, 0xe4, 0x6e, 0xee, 0x36, 0x69, 0x97, 0x16, 0x86, 0xa6, 0x94, 0x99, 0xa3, 0x4a,
+	0x56, 0xfe, 0x8e, 0xc0, 0x1e, 0x5f, 0xd4, 0x36, 0x98, 0xa0, 0x08, 0xd6, 0xdc, 0xcd, 0x0c, 0x74,
+	0x32, 0x56, 0xfa, 0x09, 0x81, 0xae, 0x46, 0x7c, 0x3a, 0x1c, 0x62, 0x13, 0x3e, 0x64, 0x49, 0x23,
+	0x89, 0x76, 0x9c, 0x45, 0x56, 0xde, 0xff, 0xe5, 0x8f, 0xeb, 0x9b, 0x46, 0xe9, 0xb0, 0x1a, 0x7c,
+	0x8a, 0xc3, 0x3d, 0xc9, 0x9c, 0xb7, 0xd4, 0x55, 0xfc, 0xbb, 0xb4, 0x46, 0xbf, 0x21, 0xb0, 0x2b,
+	0x50, 0xc6, 0xd0, 0x43, 0x31, 0xc1, 0x42, 0xef, 0x5b, 0xd2, 0x64, 0x4a, 0x6b, 0x04, 0x9c, 0x61,
+	0x80, 0x0a, 0x3d, 0x14, 0x01, 0xc8, 0x8a, 0xae, 0x15, 0xe4, 0xc4, 0xb5, 0xbd, 0x46, 0x7f, 0x26,
+	0x40, 0xc3, 0xef, 0x49, 0x54, 0x4d, 0x8c, 0xed, 0x7f, 0xe0, 0x92, 0xa6, 0xd2, 0x3b, 0x20, 0xef,
+	0xa5, 0x87, 0xa1, 0xdd, 0x8b, 0x8d, 0xe0, 0x24, 0x3d, 0x1e, 0x3f, 0x82, 0xe2, 0xca, 0x1c, 0x7b,
+	0x23, 0xf3, 0xa8, 0xad, 0xae, 0xb2, 0x2f, 0x6b, 0xf4, 0x06, 0x81, 0xed, 0xde, 0x17, 0x23, 0x3a,
+	0x16, 0xc3, 0xe5, 0x7f, 0xd5, 0x92, 0xc6, 0xd3, 0x98, 0x22, 0x7c, 0x96, 0xa1, 0x4e, 0xd0, 0xb1,
+	0x08, 0x54, 0x7c, 0x70, 0xf2, 0x26, 0xc4, 0x3a, 0x81, 0x1d, 0xbe, 0x77, 0x19, 0x1a, 0x17, 0x30,
+	0x50, 0xd9, 0x4b, 0x13, 0xa9, 0x6c, 0x91, 0x8e, 0x0b, 0x39, 0x4e, 0x47, 0xc5, 0x74, 0xb6, 0x23,
+	0x21, 0x7b, 0x00, 0x70, 0x12, 0x61, 0xd1, 0x30, 0xd7, 0xe8, 0x4f, 0x04, 0x7a, 0x44, 0x0f, 0x22,
+	0x34, 0x9b, 0x34, 0xaf, 0xa1, 0x47, 0x1c, 0x29, 0xb7, 0x11, 0x17, 0x24, 0x3e, 0xc9, 0x88, 0x0f,
+	0xd3, 0xe9, 0xb8, 0xa9, 0x37, 0x74, 0x46, 0xce, 0x9b, 0x3c, 0xca, 0xfe, 0x18, 0x86, 0xe7, 0x02,
+	0xa7, 0x83, 0xf7, 0xe9, 0x9c, 0xdb, 0x88, 0x0b, 0xc2, 0x1f, 0x63, 0xf0, 0x39, 0x3a, 0x95, 0x02,
+	0xde, 0x2f, 0xfb, 0xc7, 0x04, 0xb6, 0xb9, 0x55, 0x00, 0x7d, 0x41, 0x1c, 0x3a, 0x50, 0xce, 0x48,
+	0xc3, 0x49, 0x66, 0x48, 0xa5, 0x32, 0xaa, 0x31, 0x3a, 0x12, 0xa2, 0x72, 0x8f, 0x2e, 0x75, 0xd5,
+	0x73, 0xae, 0xad, 0xd1, 0xfb, 0x04, 0xf6, 0x8a, 0x6b, 0x7b, 0x3a, 0x1d, 0x1f, 0x53, 0xf8, 0x58,
+	0x21, 0xcd, 0x6c, 0xcc, 0x09, 0xb1, 0x5f, 0x64, 0xd8, 0x47, 0xe8, 0x4c, 0x24, 0x76, 0x33, 0x09,
+	0x70, 0x47, 0xf0, 0x6c, 0x67, 0xf7, 0x09, 0xec, 0x11, 0x94, 0xe0, 0x34, 0x62, 0x7b, 0x8a, 0x7e,
+	0x21, 0x90, 0xb2, 0x1b, 0xf0, 0x40, 0xf4, 0x57, 0x18, 0xfa, 0x69, 0x7a, 0x2a, 0x84, 0xee, 0x14,
+	0x18, 0x0e, 0x75, 0x43, 0x6f, 0xe7, 0x43, 0xcd, 0xaf, 0xbf, 0xba, 0xca, 0x3e, 0xae, 0xd1, 0x3b,
+	0x04, 0x76, 0x05, 0xaa, 0xed, 0xa8, 0x93, 0x43, 0xfc, 0x3a, 0x20, 0x4d, 0xa6, 0xb4, 0x4e, 0xcc,
+	0x5f, 0x87, 0xc8, 0xf6, 0x82, 0x07, 0x52, 0xe6, 0x4b, 0x02, 0xdb, 0xbd, 0x85, 0x57, 0xd4, 0x76,
+	0x2b, 0xa8, 0x18, 0xa3, 0xb6, 0x5b, 0x51, 0x1d, 0x17, 0x93, 0xcb, 0x0d, 0x42, 0x54, 0x14, 0x35,
+	0xfc, 0x8b, 0x40, 0x7f, 0x4c, 0xc5, 0x4d, 0x8f, 0x89, 0x83, 0x27, 0xbf, 0x26, 0x48, 0xc7, 0xff,
+	0x81, 0x27, 0x8e, 0xe2, 0xad, 0x88, 0x13, 0x4f, 0x94, 0x31, 0x16, 0xf6, 0x37, 0xd7, 0x28, 0xee,
+	0x63, 0xa6, 0xe1, 0x6b, 0x02, 0x5d, 0x8d, 0xd2, 0x2e, 0xea, 0xea, 0x13, 0x2c, 0x46, 0xa5, 0x91,
+	0x44, 0x3b, 0xe4, 0x7e, 0x29, 0x82, 0x5b, 0x74, 0x19, 0xb2, 0x97, 0x8a, 0x7c, 0x6d, 0xfa, 0xce,
+	0xbe, 0xbb, 0x04, 0xb6, 0x7b, 0xab, 0xa7, 0xa8, 0x3c, 0x11, 0x54, 0x7d, 0xd2, 0x78, 0x1a, 0x53,
+	0x24, 0x7d, 0x39, 0x82, 0x54, 0x74, 0x50, 0x57, 0x59, 0x07, 0x02, 0xd8, 0x5b, 0x04, 0x76, 0xfa,
+	0x4b, 0x14, 0x9a, 0x70, 0xfa, 0xfa, 0x6a, 0x2c, 0xe9, 0x50, 0x3a, 0x63, 0x44, 0x9e, 0x66, 0x80,
+	0x93, 0x74, 0x22, 0xe6, 0xac, 0xe6, 0xb7, 0x09, 0xcf, 0x36, 0xb7, 0x4e, 0xa0, 0xdb, 0x53, 0x38,
+	0xd0, 0x51, 0x71, 0xc8, 0x70, 0x31, 0x23, 0x8d, 0xa5, 0xb0, 0x44, 0xb2, 0x23, 0x8c, 0x6c, 0x8a,
+	0x2a, 0xd1, 0x3b, 0x71, 0x60, 0x07, 0x63, 0x35, 0x0b, 0xfd, 0x88, 0xc0, 0x16, 0x9c, 0xe6, 0x83,
+	0x71, 0x52, 0xb8, 0x48, 0xff, 0x8f, 0x37, 0x72, 0xaf, 0xb7, 0x0f, 0x45, 0xf7, 0x7f, 0x86, 0xd8,
+	0x47, 0xf7, 0x45, 0x88, 0x97, 0x57, 0x1e, 0x3c, 0x19, 0x24, 0x8f, 0x9e, 0x0c, 0x92, 0xdf, 0x9f,
+	0x0c, 0x92, 0xcf, 0x9e, 0x0e, 0x76, 0x3c, 0x7a, 0x3a, 0xd8, 0xf1, 0xeb, 0xd3, 0xc1, 0x8e, 0x77,
+	0xb0, 0xe8, 0xb5, 0x4b, 0x0b, 0x8a, 0x61, 0xa9, 0x98, 0x33, 0xc5, 0x2d, 0xec, 0xff, 0xbd, 0xa7,
+	0xff, 0x1e, 0x00, 0x93, 0xc2, 0xfb, 0x06, 0xd2, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1618,6 +2080,8 @@ type QueryClient interface {
 	GroupInfo(ctx context.Context, in *QueryGroupInfoRequest, opts ...grpc.CallOption) (*QueryGroupInfoResponse, error)
 	// GroupPolicyInfo queries group policy info based on account address of group policy.
 	GroupPolicyInfo(ctx context.Context, in *QueryGroupPolicyInfoRequest, opts ...grpc.CallOption) (*QueryGroupPolicyInfoResponse, error)
+	// GroupPolicyByLabel resolves a group policy from its group id and label.
+	GroupPolicyByLabel(ctx context.Context, in *QueryGroupPolicyByLabelRequest, opts ...grpc.CallOption) (*QueryGroupPolicyByLabelResponse, error)
 	// GroupMembers queries members of a group by group id.
 	GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error)
 	// GroupsByAdmin queries groups by admin address.
@@ -1636,6 +2100,14 @@ type QueryClient interface {
 	VotesByProposal(ctx context.Context, in *QueryVotesByProposalRequest, opts ...grpc.CallOption) (*QueryVotesByProposalResponse, error)
 	// VotesByVoter queries a vote by voter.
 	VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error)
+	// OffchainApprovalsByProposal queries the off-chain approvals of a proposal.
+	OffchainApprovalsByProposal(ctx context.Context, in *QueryOffchainApprovalsByProposalRequest, opts ...grpc.CallOption) (*QueryOffchainApprovalsByProposalResponse, error)
+	// SubGroups queries the links of a group to its sub-groups, i.e. the groups
+	// whose group policy accounts are members of the group.
+	SubGroups(ctx context.Context, in *QuerySubGroupsRequest, opts ...grpc.CallOption) (*QuerySubGroupsResponse, error)
+	// ParentGroups queries the links of a group to its parent groups, i.e. the
+	// groups having a group policy account of the group as member.
+	ParentGroups(ctx context.Context, in *QueryParentGroupsRequest, opts ...grpc.CallOption) (*QueryParentGroupsResponse, error)
 	// GroupsByMember queries groups by member address.
 	GroupsByMember(ctx context.Context, in *QueryGroupsByMemberRequest, opts ...grpc.CallOption) (*QueryGroupsByMemberResponse, error)
 	// TallyResult returns the tally result of a proposal. If the proposal is
@@ -1674,6 +2146,15 @@ func (c *queryClient) GroupPolicyInfo(ctx context.Context, in *QueryGroupPolicyI
 	return out, nil
 }
 
+func (c *queryClient) GroupPolicyByLabel(ctx context.Context, in *QueryGroupPolicyByLabelRequest, opts ...grpc.CallOption) (*QueryGroupPolicyByLabelResponse, error) {
+	out := new(QueryGroupPolicyByLabelResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupPolicyByLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error) {
 	out := new(QueryGroupMembersResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupMembers", in, out, opts...)
@@ -1755,6 +2236,33 @@ func (c *queryClient) VotesByVoter(ctx context.Context, in *QueryVotesByVoterReq
 	return out, nil
 }
 
+func (c *queryClient) OffchainApprovalsByProposal(ctx context.Context, in *QueryOffchainApprovalsByProposalRequest, opts ...grpc.CallOption) (*QueryOffchainApprovalsByProposalResponse, error) {
+	out := new(QueryOffchainApprovalsByProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/OffchainApprovalsByProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubGroups(ctx context.Context, in *QuerySubGroupsRequest, opts ...grpc.CallOption) (*QuerySubGroupsResponse, error) {
+	out := new(QuerySubGroupsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/SubGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParentGroups(ctx context.Context, in *QueryParentGroupsRequest, opts ...grpc.CallOption) (*QueryParentGroupsResponse, error) {
+	out := new(QueryParentGroupsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ParentGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GroupsByMember(ctx context.Context, in *QueryGroupsByMemberRequest, opts ...grpc.CallOption) (*QueryGroupsByMemberResponse, error) {
 	out := new(QueryGroupsByMemberResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupsByMember", in, out, opts...)
@@ -1788,6 +2296,8 @@ type QueryServer interface {
 	GroupInfo(context.Context, *QueryGroupInfoRequest) (*QueryGroupInfoResponse, error)
 	// GroupPolicyInfo queries group policy info based on account address of group policy.
 	GroupPolicyInfo(context.Context, *QueryGroupPolicyInfoRequest) (*QueryGroupPolicyInfoResponse, error)
+	// GroupPolicyByLabel resolves a group policy from its group id and label.
+	GroupPolicyByLabel(context.Context, *QueryGroupPolicyByLabelRequest) (*QueryGroupPolicyByLabelResponse, error)
 	// GroupMembers queries members of a group by group id.
 	GroupMembers(context.Context, *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error)
 	// GroupsByAdmin queries groups by admin address.
@@ -1806,6 +2316,14 @@ type QueryServer interface {
 	VotesByProposal(context.Context, *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error)
 	// VotesByVoter queries a vote by voter.
 	VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error)
+	// OffchainApprovalsByProposal queries the off-chain approvals of a proposal.
+	OffchainApprovalsByProposal(context.Context, *QueryOffchainApprovalsByProposalRequest) (*QueryOffchainApprovalsByProposalResponse, error)
+	// SubGroups queries the links of a group to its sub-groups, i.e. the groups
+	// whose group policy accounts are members of the group.
+	SubGroups(context.Context, *QuerySubGroupsRequest) (*QuerySubGroupsResponse, error)
+	// ParentGroups queries the links of a group to its parent groups, i.e. the
+	// groups having a group policy account of the group as member.
+	ParentGroups(context.Context, *QueryParentGroupsRequest) (*QueryParentGroupsResponse, error)
 	// GroupsByMember queries groups by member address.
 	GroupsByMember(context.Context, *QueryGroupsByMemberRequest) (*QueryGroupsByMemberResponse, error)
 	// TallyResult returns the tally result of a proposal. If the proposal is
@@ -1828,6 +2346,9 @@ func (*UnimplementedQueryServer) GroupInfo(ctx context.Context, req *QueryGroupI
 func (*UnimplementedQueryServer) GroupPolicyInfo(ctx context.Context, req *QueryGroupPolicyInfoRequest) (*QueryGroupPolicyInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupPolicyInfo not implemented")
 }
+func (*UnimplementedQueryServer) GroupPolicyByLabel(ctx context.Context, req *QueryGroupPolicyByLabelRequest) (*QueryGroupPolicyByLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupPolicyByLabel not implemented")
+}
 func (*UnimplementedQueryServer) GroupMembers(ctx context.Context, req *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupMembers not implemented")
 }
@@ -1855,6 +2376,15 @@ func (*UnimplementedQueryServer) VotesByProposal(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) VotesByVoter(ctx context.Context, req *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotesByVoter not implemented")
 }
+func (*UnimplementedQueryServer) OffchainApprovalsByProposal(ctx context.Context, req *QueryOffchainApprovalsByProposalRequest) (*QueryOffchainApprovalsByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffchainApprovalsByProposal not implemented")
+}
+func (*UnimplementedQueryServer) SubGroups(ctx context.Context, req *QuerySubGroupsRequest) (*QuerySubGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubGroups not implemented")
+}
+func (*UnimplementedQueryServer) ParentGroups(ctx context.Context, req *QueryParentGroupsRequest) (*QueryParentGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParentGroups not implemented")
+}
 func (*UnimplementedQueryServer) GroupsByMember(ctx context.Context, req *QueryGroupsByMemberRequest) (*QueryGroupsByMemberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GroupsByMember not implemented")
 }
@@ -1905,6 +2435,24 @@ func _Query_GroupPolicyInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GroupPolicyByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupPolicyByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupPolicyByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/GroupPolicyByLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupPolicyByLabel(ctx, req.(*QueryGroupPolicyByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGroupMembersRequest)
 	if err := dec(in); err != nil {
@@ -2067,64 +2615,118 @@ func _Query_VotesByVoter_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_GroupsByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsByMemberRequest)
+func _Query_OffchainApprovalsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffchainApprovalsByProposalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).GroupsByMember(ctx, in)
+		return srv.(QueryServer).OffchainApprovalsByProposal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.group.v1.Query/GroupsByMember",
+		FullMethod: "/cosmos.group.v1.Query/OffchainApprovalsByProposal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GroupsByMember(ctx, req.(*QueryGroupsByMemberRequest))
+		return srv.(QueryServer).OffchainApprovalsByProposal(ctx, req.(*QueryOffchainApprovalsByProposalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTallyResultRequest)
+func _Query_SubGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TallyResult(ctx, in)
+		return srv.(QueryServer).SubGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.group.v1.Query/TallyResult",
+		FullMethod: "/cosmos.group.v1.Query/SubGroups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TallyResult(ctx, req.(*QueryTallyResultRequest))
+		return srv.(QueryServer).SubGroups(ctx, req.(*QuerySubGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGroupsRequest)
+func _Query_ParentGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParentGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Groups(ctx, in)
+		return srv.(QueryServer).ParentGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.group.v1.Query/Groups",
+		FullMethod: "/cosmos.group.v1.Query/ParentGroups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Groups(ctx, req.(*QueryGroupsRequest))
+		return srv.(QueryServer).ParentGroups(ctx, req.(*QueryParentGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.group.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _Query_GroupsByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsByMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupsByMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/GroupsByMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupsByMember(ctx, req.(*QueryGroupsByMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/TallyResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyResult(ctx, req.(*QueryTallyResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Groups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/Groups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Groups(ctx, req.(*QueryGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.group.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "GroupInfo",
 			Handler:    _Query_GroupInfo_Handler,
@@ -2133,6 +2735,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GroupPolicyInfo",
 			Handler:    _Query_GroupPolicyInfo_Handler,
 		},
+		{
+			MethodName: "GroupPolicyByLabel",
+			Handler:    _Query_GroupPolicyByLabel_Handler,
+		},
 		{
 			MethodName: "GroupMembers",
 			Handler:    _Query_GroupMembers_Handler,
@@ -2169,6 +2775,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VotesByVoter",
 			Handler:    _Query_VotesByVoter_Handler,
 		},
+		{
+			MethodName: "OffchainApprovalsByProposal",
+			Handler:    _Query_OffchainApprovalsByProposal_Handler,
+		},
+		{
+			MethodName: "SubGroups",
+			Handler:    _Query_SubGroups_Handler,
+		},
+		{
+			MethodName: "ParentGroups",
+			Handler:    _Query_ParentGroups_Handler,
+		},
 		{
 			MethodName: "GroupsByMember",
 			Handler:    _Query_GroupsByMember_Handler,
@@ -2314,6 +2932,76 @@ func (m *QueryGroupPolicyInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGroupPolicyByLabelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupPolicyByLabelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupPolicyByLabelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupPolicyByLabelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupPolicyByLabelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupPolicyByLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGroupMembersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2987,7 +3675,7 @@ func (m *QueryVotesByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOffchainApprovalsByProposalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2997,12 +3685,12 @@ func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVotesByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOffchainApprovalsByProposalRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOffchainApprovalsByProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3019,17 +3707,15 @@ func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOffchainApprovalsByProposalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3039,12 +3725,12 @@ func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVotesByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOffchainApprovalsByProposalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOffchainApprovalsByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3061,10 +3747,10 @@ func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3078,7 +3764,7 @@ func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsByMemberRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3088,12 +3774,12 @@ func (m *QueryGroupsByMemberRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3110,17 +3796,17 @@ func (m *QueryGroupsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsByMemberResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3130,12 +3816,12 @@ func (m *QueryGroupsByMemberResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3152,10 +3838,10 @@ func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3169,7 +3855,7 @@ func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySubGroupsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3179,25 +3865,37 @@ func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySubGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySubGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySubGroupsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3207,30 +3905,46 @@ func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTallyResultResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySubGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySubGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParentGroupsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3240,12 +3954,12 @@ func (m *QueryGroupsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParentGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParentGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3262,10 +3976,15 @@ func (m *QueryGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParentGroupsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3275,12 +3994,12 @@ func (m *QueryGroupsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParentGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParentGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3297,10 +4016,10 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3314,23 +4033,259 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGroupsByMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *QueryGroupsByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsByMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.GroupId != 0 {
 		n += 1 + sovQuery(uint64(m.GroupId))
 	}
@@ -3376,7 +4331,7 @@ func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGroupMembersRequest) Size() (n int) {
+func (m *QueryGroupPolicyByLabelRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3385,26 +4340,55 @@ func (m *QueryGroupMembersRequest) Size() (n int) {
 	if m.GroupId != 0 {
 		n += 1 + sovQuery(uint64(m.GroupId))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	l = len(m.Label)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGroupMembersResponse) Size() (n int) {
+func (m *QueryGroupPolicyByLabelResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -3643,6 +4627,41 @@ func (m *QueryVotesByProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOffchainApprovalsByProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffchainApprovalsByProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryVotesByVoterRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3679,6 +4698,76 @@ func (m *QueryVotesByVoterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySubGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParentGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParentGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGroupsByMemberRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3786,30 +4875,679 @@ func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &GroupInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &GroupPolicyInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyByLabelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyByLabelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyByLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyByLabelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyByLabelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyByLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &GroupPolicyInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Members = append(m.Members, &GroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3819,11 +5557,28 @@ func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3845,7 +5600,7 @@ func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,15 +5623,47 @@ func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,10 +5690,10 @@ func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &GroupInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3931,7 +5718,7 @@ func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3954,17 +5741,17 @@ func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3974,23 +5761,61 @@ func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, &GroupInfo{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4013,7 +5838,7 @@ func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4036,15 +5861,34 @@ func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4071,10 +5915,10 @@ func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &GroupPolicyInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4099,7 +5943,7 @@ func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4122,17 +5966,17 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4142,11 +5986,26 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
+			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4177,7 +6036,7 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4204,7 +6063,7 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4227,17 +6086,17 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4247,25 +6106,23 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &GroupMember{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4297,7 +6154,7 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4324,7 +6181,7 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4347,17 +6204,17 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsByAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4367,23 +6224,25 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
+			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4415,7 +6274,7 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4442,7 +6301,7 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4465,17 +6324,17 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsByAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4485,29 +6344,64 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &GroupInfo{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4534,10 +6428,10 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4562,7 +6456,7 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4585,17 +6479,17 @@ func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4605,11 +6499,24 @@ func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4667,7 +6574,7 @@ func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4690,15 +6597,15 @@ func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4725,8 +6632,8 @@ func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
-			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4787,7 +6694,7 @@ func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4810,17 +6717,17 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4830,29 +6737,16 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4862,27 +6756,23 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4905,7 +6795,7 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4928,15 +6818,15 @@ func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4963,11 +6853,82 @@ func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
-			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vote == nil {
+				m.Vote = &Vote{}
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4998,7 +6959,7 @@ func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5025,7 +6986,7 @@ func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5048,17 +7009,17 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5068,64 +7029,29 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Votes = append(m.Votes, &Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5152,10 +7078,10 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &Proposal{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5180,7 +7106,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOffchainApprovalsByProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5203,17 +7129,17 @@ func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOffchainApprovalsByProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOffchainApprovalsByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5223,24 +7149,11 @@ func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5298,7 +7211,7 @@ func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOffchainApprovalsByProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5321,15 +7234,15 @@ func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOffchainApprovalsByProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOffchainApprovalsByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5356,8 +7269,8 @@ func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, &Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Approvals = append(m.Approvals, &OffchainApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5418,7 +7331,7 @@ func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5441,17 +7354,17 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5461,16 +7374,29 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5480,23 +7406,27 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5519,7 +7449,7 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5542,15 +7472,15 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5577,10 +7507,44 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vote == nil {
-				m.Vote = &Vote{}
+			m.Votes = append(m.Votes, &Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5605,7 +7569,7 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5628,17 +7592,17 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.ProposalId = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5648,7 +7612,7 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5710,7 +7674,7 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5733,15 +7697,15 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5768,8 +7732,8 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, &Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Links = append(m.Links, &GroupLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5830,7 +7794,7 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParentGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5853,17 +7817,17 @@ func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParentGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParentGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var stringLen uint64
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5873,24 +7837,11 @@ func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GroupId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5948,7 +7899,7 @@ func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParentGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,15 +7922,15 @@ func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParentGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParentGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6006,8 +7957,8 @@ func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, &Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Links = append(m.Links, &GroupLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -141,6 +141,66 @@ func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
 }
 
+// CancelPeriodicPayment mocks base method.
+func (m *MockBankKeeper) CancelPeriodicPayment(arg0 context.Context, arg1 *types.MsgCancelPeriodicPayment) (*types.MsgCancelPeriodicPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPeriodicPayment", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCancelPeriodicPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPeriodicPayment indicates an expected call of CancelPeriodicPayment.
+func (mr *MockBankKeeperMockRecorder) CancelPeriodicPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPeriodicPayment", reflect.TypeOf((*MockBankKeeper)(nil).CancelPeriodicPayment), arg0, arg1)
+}
+
+// ClaimHTLC mocks base method.
+func (m *MockBankKeeper) ClaimHTLC(arg0 context.Context, arg1 *types.MsgClaimHTLC) (*types.MsgClaimHTLCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimHTLC", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgClaimHTLCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimHTLC indicates an expected call of ClaimHTLC.
+func (mr *MockBankKeeperMockRecorder) ClaimHTLC(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimHTLC", reflect.TypeOf((*MockBankKeeper)(nil).ClaimHTLC), arg0, arg1)
+}
+
+// CreateHTLC mocks base method.
+func (m *MockBankKeeper) CreateHTLC(arg0 context.Context, arg1 *types.MsgCreateHTLC) (*types.MsgCreateHTLCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHTLC", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCreateHTLCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHTLC indicates an expected call of CreateHTLC.
+func (mr *MockBankKeeperMockRecorder) CreateHTLC(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHTLC", reflect.TypeOf((*MockBankKeeper)(nil).CreateHTLC), arg0, arg1)
+}
+
+// CreatePeriodicPayment mocks base method.
+func (m *MockBankKeeper) CreatePeriodicPayment(arg0 context.Context, arg1 *types.MsgCreatePeriodicPayment) (*types.MsgCreatePeriodicPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePeriodicPayment", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCreatePeriodicPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePeriodicPayment indicates an expected call of CreatePeriodicPayment.
+func (mr *MockBankKeeperMockRecorder) CreatePeriodicPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePeriodicPayment", reflect.TypeOf((*MockBankKeeper)(nil).CreatePeriodicPayment), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -184,6 +244,21 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// RefundHTLC mocks base method.
+func (m *MockBankKeeper) RefundHTLC(arg0 context.Context, arg1 *types.MsgRefundHTLC) (*types.MsgRefundHTLCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundHTLC", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgRefundHTLCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundHTLC indicates an expected call of RefundHTLC.
+func (mr *MockBankKeeperMockRecorder) RefundHTLC(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundHTLC", reflect.TypeOf((*MockBankKeeper)(nil).RefundHTLC), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types.MsgSend) (*types.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -242,6 +317,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// UpdateDenomMetadata mocks base method.
+func (m *MockBankKeeper) UpdateDenomMetadata(arg0 context.Context, arg1 *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgUpdateDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDenomMetadata indicates an expected call of UpdateDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) UpdateDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).UpdateDenomMetadata), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -488,6 +488,8 @@ type MsgCreateGroupPolicy struct {
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// decision_policy specifies the group policy's decision policy.
 	DecisionPolicy *any.Any `protobuf:"bytes,4,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// label is an optional human-readable name of the group policy, unique within the group.
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (m *MsgCreateGroupPolicy) Reset()         { *m = MsgCreateGroupPolicy{} }
@@ -960,6 +962,107 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupPolicyMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateGroupPolicyLabel is the Msg/UpdateGroupPolicyLabel request type.
+type MsgUpdateGroupPolicyLabel struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// label is the new label of the group policy, an empty label clears it.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *MsgUpdateGroupPolicyLabel) Reset()         { *m = MsgUpdateGroupPolicyLabel{} }
+func (m *MsgUpdateGroupPolicyLabel) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyLabel) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{18}
+}
+func (m *MsgUpdateGroupPolicyLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyLabel.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyLabel proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicyLabel) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyLabel) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyLabel) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// MsgUpdateGroupPolicyLabelResponse is the Msg/UpdateGroupPolicyLabel response type.
+type MsgUpdateGroupPolicyLabelResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicyLabelResponse) Reset()         { *m = MsgUpdateGroupPolicyLabelResponse{} }
+func (m *MsgUpdateGroupPolicyLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyLabelResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{19}
+}
+func (m *MsgUpdateGroupPolicyLabelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyLabelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyLabelResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyLabelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyLabelResponse proto.InternalMessageInfo
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	// group_policy_address is the account address of group policy.
@@ -979,13 +1082,17 @@ type MsgSubmitProposal struct {
 	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
 	// summary is the summary of the proposal.
 	Summary string `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	// kind defines whether the proposal is executable or signaling only. An
+	// unspecified kind submits an executable proposal. Signaling proposals must
+	// not have messages and cannot be executed, including with EXEC_TRY.
+	Kind ProposalKind `protobuf:"varint,8,opt,name=kind,proto3,enum=cosmos.group.v1.ProposalKind" json:"kind,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{18}
+	return fileDescriptor_6b8d3d629f136420, []int{20}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1131,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{19}
+	return fileDescriptor_6b8d3d629f136420, []int{21}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,7 +1179,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{20}
+	return fileDescriptor_6b8d3d629f136420, []int{22}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1230,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{21}
+	return fileDescriptor_6b8d3d629f136420, []int{23}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1278,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{22}
+	return fileDescriptor_6b8d3d629f136420, []int{24}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1350,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{23}
+	return fileDescriptor_6b8d3d629f136420, []int{25}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1272,6 +1379,136 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgSubmitOffchainApproval is the Msg/SubmitOffchainApproval request type.
+type MsgSubmitOffchainApproval struct {
+	// submitter is the account address relaying the approval, it can be any
+	// account.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// member is the account address of the group member who signed the approval.
+	Member string `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	// option is the member's choice on the proposal.
+	Option VoteOption `protobuf:"varint,4,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata attached to the approval.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// signature is the member's signature of the approval typed data, see the
+	// x/group/offchain package.
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgSubmitOffchainApproval) Reset()         { *m = MsgSubmitOffchainApproval{} }
+func (m *MsgSubmitOffchainApproval) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOffchainApproval) ProtoMessage()    {}
+func (*MsgSubmitOffchainApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{26}
+}
+func (m *MsgSubmitOffchainApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitOffchainApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOffchainApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitOffchainApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOffchainApproval.Merge(m, src)
+}
+func (m *MsgSubmitOffchainApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitOffchainApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOffchainApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitOffchainApproval proto.InternalMessageInfo
+
+func (m *MsgSubmitOffchainApproval) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitOffchainApproval) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgSubmitOffchainApproval) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *MsgSubmitOffchainApproval) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *MsgSubmitOffchainApproval) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgSubmitOffchainApproval) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgSubmitOffchainApprovalResponse is the Msg/SubmitOffchainApproval response type.
+type MsgSubmitOffchainApprovalResponse struct {
+}
+
+func (m *MsgSubmitOffchainApprovalResponse) Reset()         { *m = MsgSubmitOffchainApprovalResponse{} }
+func (m *MsgSubmitOffchainApprovalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOffchainApprovalResponse) ProtoMessage()    {}
+func (*MsgSubmitOffchainApprovalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{27}
+}
+func (m *MsgSubmitOffchainApprovalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitOffchainApprovalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOffchainApprovalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitOffchainApprovalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOffchainApprovalResponse.Merge(m, src)
+}
+func (m *MsgSubmitOffchainApprovalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitOffchainApprovalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOffchainApprovalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitOffchainApprovalResponse proto.InternalMessageInfo
+
 // MsgExec is the Msg/Exec request type.
 type MsgExec struct {
 	// proposal is the unique ID of the proposal.
@@ -1284,7 +1521,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1574,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1385,7 +1622,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{30}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1673,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{31}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1485,12 +1722,16 @@ func init() {
 	proto.RegisterType((*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadata)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadata")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadataResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicyLabel)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyLabel")
+	proto.RegisterType((*MsgUpdateGroupPolicyLabelResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyLabelResponse")
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.group.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.group.v1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos.group.v1.MsgWithdrawProposal")
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos.group.v1.MsgWithdrawProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.group.v1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
+	proto.RegisterType((*MsgSubmitOffchainApproval)(nil), "cosmos.group.v1.MsgSubmitOffchainApproval")
+	proto.RegisterType((*MsgSubmitOffchainApprovalResponse)(nil), "cosmos.group.v1.MsgSubmitOffchainApprovalResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
@@ -1500,98 +1741,111 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xda, 0xce, 0xdb, 0x93, 0x7f, 0x9d, 0x64, 0x93, 0xb4, 0xce, 0xb6, 0xb5, 0xdd, 0xe9,
-	0x4b, 0x52, 0xab, 0x5e, 0x37, 0x4e, 0xdb, 0xbf, 0x30, 0x48, 0xa8, 0x49, 0x03, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xbb, 0x5d, 0x56, 0xb5, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x2f, 0x17, 0xde, 0x24, 0x90, 0xe0, 0x03, 0xc0, 0x8d, 0x63, 0x91, 0x7a, 0xe0, 0xc6, 0x0d, 0x55,
-	0xe5, 0x52, 0x71, 0x42, 0x1c, 0x10, 0x6a, 0x85, 0x7a, 0xe3, 0x2b, 0x80, 0x76, 0x66, 0x77, 0xec,
-	0xf1, 0xee, 0x7a, 0xb7, 0x96, 0x05, 0x97, 0xc8, 0x3b, 0xcf, 0xef, 0x79, 0xfb, 0x3d, 0xcf, 0x3c,
-	0x33, 0x13, 0xc8, 0xec, 0x59, 0xb8, 0x61, 0xe1, 0x92, 0xd1, 0xb6, 0x3a, 0xad, 0xd2, 0xfe, 0x5a,
-	0xc9, 0x3e, 0x90, 0x5b, 0x6d, 0xcb, 0xb6, 0xc4, 0x59, 0x2a, 0x91, 0x89, 0x44, 0xde, 0x5f, 0x93,
-	0x16, 0x0d, 0xcb, 0xb0, 0x88, 0xac, 0xe4, 0xfc, 0xa2, 0x30, 0x69, 0x99, 0xc2, 0x6a, 0x54, 0xe0,
-	0xea, 0xb8, 0x22, 0xc3, 0xb2, 0x8c, 0xba, 0x5e, 0x22, 0x5f, 0xbb, 0x9d, 0xdb, 0x25, 0xb5, 0x79,
-	0xe8, 0x8a, 0x8e, 0xfb, 0xdc, 0x1e, 0xb6, 0x74, 0x4f, 0xef, 0x98, 0x2b, 0x6c, 0x60, 0xc3, 0x11,
-	0x35, 0xb0, 0xe1, 0x0a, 0xe6, 0xd5, 0x86, 0xd9, 0xb4, 0x4a, 0xe4, 0x2f, 0x5d, 0x42, 0x3f, 0x0b,
-	0x90, 0xae, 0x62, 0x63, 0xb3, 0xad, 0xab, 0xb6, 0xfe, 0xb2, 0x63, 0x4d, 0x94, 0x61, 0x5c, 0xd5,
-	0x1a, 0x66, 0x33, 0x23, 0xe4, 0x85, 0xd5, 0xe9, 0x8d, 0xcc, 0x2f, 0xf7, 0x8b, 0x8b, 0x6e, 0x5c,
-	0x57, 0x35, 0xad, 0xad, 0x63, 0x7c, 0xdd, 0x6e, 0x9b, 0x4d, 0x43, 0xa1, 0x30, 0x71, 0x13, 0x26,
-	0x1b, 0x7a, 0x63, 0x57, 0x6f, 0xe3, 0x4c, 0x22, 0x9f, 0x5c, 0x9d, 0x29, 0x67, 0xe5, 0xbe, 0xd4,
-	0xe5, 0x2a, 0x91, 0x2b, 0xfa, 0x7b, 0x1d, 0x1d, 0xdb, 0x1b, 0xd3, 0x0f, 0x7e, 0xcf, 0x8d, 0x7d,
-	0xf7, 0xf4, 0x5e, 0x41, 0x50, 0x3c, 0x4d, 0x51, 0x82, 0xa9, 0x86, 0x6e, 0xab, 0x9a, 0x6a, 0xab,
-	0x99, 0xa4, 0xe3, 0x57, 0x61, 0xdf, 0x95, 0xd5, 0x0f, 0x9f, 0xde, 0x2b, 0x50, 0x67, 0x9f, 0x3e,
-	0xbd, 0x57, 0x70, 0x19, 0x2b, 0x62, 0xed, 0x4e, 0x89, 0x0f, 0x1d, 0xad, 0xc3, 0x51, 0x7e, 0x45,
-	0xd1, 0x71, 0xcb, 0x6a, 0x62, 0x5d, 0x5c, 0x86, 0x29, 0x12, 0x4d, 0xcd, 0xd4, 0x48, 0x5e, 0x29,
-	0x65, 0x92, 0x7c, 0x6f, 0x6b, 0xe8, 0x4f, 0x01, 0x96, 0xaa, 0xd8, 0xb8, 0xd9, 0xd2, 0x3c, 0xad,
-	0xaa, 0x1b, 0xd4, 0xb3, 0x32, 0xd1, 0xeb, 0x24, 0xc1, 0x39, 0x11, 0x77, 0x20, 0x4d, 0x53, 0xad,
-	0x75, 0x88, 0x1f, 0x9c, 0x49, 0x3e, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x94, 0x78,
-	0x56, 0xf2, 0x3c, 0x2b, 0xfe, 0x6c, 0x50, 0x0e, 0x4e, 0x06, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x2c, 0xf0, 0x88, 0xab, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x19, 0xa6, 0x9b, 0xfa, 0xdd, 0x1a, 0x35,
-	0x97, 0x8c, 0x30, 0x37, 0xd5, 0xd4, 0xef, 0x92, 0x08, 0x2a, 0x45, 0x3e, 0xd7, 0x6c, 0x68, 0xae,
-	0x04, 0x8e, 0x4e, 0xc2, 0xf1, 0x80, 0x65, 0x96, 0xe7, 0xf7, 0x02, 0x69, 0x13, 0x8e, 0x09, 0xda,
-	0x6a, 0xa3, 0x4c, 0x75, 0x50, 0x47, 0x5f, 0xe4, 0xf3, 0x39, 0x35, 0xa0, 0x76, 0x54, 0x03, 0xe5,
-	0x21, 0x1b, 0x2c, 0x61, 0x59, 0x7d, 0x9d, 0x80, 0x45, 0xbe, 0xf9, 0x77, 0xac, 0xba, 0xb9, 0x77,
-	0xf8, 0x2f, 0xe5, 0x24, 0xaa, 0x30, 0xab, 0xe9, 0x7b, 0x26, 0x36, 0xad, 0x66, 0xad, 0x45, 0x3c,
-	0x67, 0x52, 0x79, 0x61, 0x75, 0xa6, 0xbc, 0x28, 0xd3, 0x39, 0x26, 0x7b, 0x73, 0x4c, 0xbe, 0xda,
-	0x3c, 0xdc, 0x40, 0x0f, 0xef, 0x17, 0xb3, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57, 0xd2,
-	0x1a, 0xf7, 0x5d, 0x29, 0x7f, 0xfc, 0x4d, 0x6e, 0x8c, 0xa7, 0x2e, 0x17, 0x3a, 0x0c, 0xa8, 0x0e,
-	0x52, 0xe0, 0x44, 0xd0, 0x3a, 0x1b, 0x0c, 0x65, 0x98, 0x54, 0x29, 0x0b, 0x91, 0xfc, 0x78, 0x40,
-	0xf4, 0x51, 0x02, 0x96, 0xf9, 0x6a, 0x50, 0xa3, 0xc3, 0x6d, 0x97, 0x57, 0x60, 0x91, 0xf2, 0x4d,
-	0x59, 0xab, 0x79, 0xe1, 0x24, 0x22, 0xd4, 0x45, 0xa3, 0xd7, 0x33, 0x91, 0x0c, 0xbb, 0xbf, 0xd6,
-	0x79, 0x52, 0xcf, 0x84, 0xf6, 0x63, 0x4f, 0x9e, 0xe8, 0x34, 0x9c, 0x0a, 0x15, 0xb2, 0xae, 0xfc,
-	0x21, 0x09, 0x19, 0x9e, 0xff, 0x5b, 0xa6, 0xfd, 0xee, 0x90, 0x9d, 0x39, 0x92, 0x93, 0xe6, 0x2c,
-	0xa4, 0x29, 0xdd, 0x7d, 0x9d, 0x7c, 0xc4, 0xe0, 0x26, 0x41, 0x19, 0x96, 0xb8, 0xaa, 0x30, 0x74,
-	0x8a, 0xa0, 0x17, 0x7a, 0xc8, 0x67, 0x3a, 0x6b, 0x7d, 0x3a, 0x2a, 0x76, 0x2b, 0x31, 0x9e, 0x17,
-	0x56, 0xa7, 0xf8, 0x82, 0x61, 0xda, 0x2c, 0x01, 0xbb, 0x66, 0x62, 0xc4, 0xbb, 0xe6, 0x8a, 0x7f,
-	0xd7, 0x9c, 0x0e, 0xdd, 0x35, 0xdd, 0xea, 0xa0, 0x4f, 0x04, 0xc8, 0x87, 0x09, 0x63, 0x9c, 0xab,
-	0xa3, 0xec, 0x6b, 0xf4, 0x63, 0x02, 0x50, 0x50, 0xb3, 0xf1, 0xa9, 0xff, 0xa7, 0x5b, 0x2f, 0xa0,
-	0x92, 0xc9, 0x11, 0x57, 0xb2, 0xe2, 0xaf, 0xe4, 0x4a, 0xe8, 0x56, 0xe5, 0x6d, 0xa1, 0x0b, 0x50,
-	0x88, 0x26, 0x90, 0x6d, 0xdb, 0xbf, 0x04, 0x32, 0x36, 0x7d, 0xf0, 0xa1, 0x0f, 0xca, 0x51, 0x32,
-	0x3d, 0xe8, 0x64, 0xbd, 0x12, 0x97, 0x1e, 0x3e, 0x1f, 0x74, 0x0e, 0xce, 0x0c, 0x92, 0x33, 0x62,
-	0x3e, 0x4b, 0xc2, 0x7c, 0x15, 0x1b, 0xd7, 0x3b, 0xbb, 0x0d, 0xd3, 0xde, 0x69, 0x5b, 0x2d, 0x0b,
-	0xab, 0xf5, 0xd0, 0xec, 0x84, 0x21, 0xb2, 0x3b, 0x01, 0xd3, 0x2d, 0x62, 0xd7, 0x1b, 0x73, 0xd3,
-	0x4a, 0x77, 0x61, 0xe0, 0x09, 0x7c, 0xd1, 0x91, 0x61, 0xac, 0x1a, 0x3a, 0xce, 0xa4, 0xc8, 0x7c,
-	0x0c, 0x6c, 0x3d, 0x85, 0xa1, 0xc4, 0xf3, 0x90, 0xd2, 0x0f, 0xf4, 0x3d, 0x32, 0x9f, 0xd2, 0xe5,
-	0x25, 0xdf, 0x34, 0xdd, 0x3a, 0xd0, 0xf7, 0x14, 0x02, 0x11, 0xcf, 0xc3, 0xb8, 0x6d, 0xda, 0x75,
-	0x9d, 0x8c, 0xa7, 0xe9, 0x8d, 0x85, 0xdf, 0xee, 0x17, 0x67, 0xbb, 0xe4, 0xe6, 0x2f, 0xca, 0x97,
-	0xfe, 0xaf, 0x50, 0x84, 0x58, 0x84, 0x49, 0xdc, 0x69, 0x34, 0xd4, 0xf6, 0x61, 0x66, 0x32, 0x1c,
-	0xec, 0x61, 0x2a, 0xcf, 0x79, 0x5d, 0xdd, 0x4d, 0xd3, 0x29, 0x1d, 0xea, 0x29, 0x1d, 0x7d, 0xe6,
-	0xf8, 0x78, 0x47, 0x2f, 0x90, 0x73, 0x98, 0x5f, 0x64, 0xa3, 0x29, 0x07, 0x33, 0x2d, 0x77, 0xad,
-	0x3b, 0x9d, 0xc0, 0x5b, 0xda, 0xd6, 0xd0, 0xb7, 0xf4, 0xbe, 0xeb, 0x4c, 0x35, 0xad, 0xad, 0xde,
-	0x65, 0xd5, 0x8c, 0x52, 0xec, 0xbd, 0x33, 0x24, 0x62, 0xde, 0x19, 0x2a, 0x97, 0x9d, 0x0c, 0xbd,
-	0xaf, 0xfe, 0x43, 0x96, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x95, 0xed, 0x5f, 0x66, 0xed, 0xf8, 0xb7,
-	0x00, 0x93, 0x55, 0x6c, 0xbc, 0x61, 0xd9, 0xd1, 0xf9, 0x3a, 0x7b, 0x76, 0xdf, 0xb2, 0xf5, 0x76,
-	0x64, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb2, 0x4d, 0x8b, 0xde, 0x24, 0xd2, 0xe5, 0xe3,
-	0xbe, 0xfe, 0x70, 0xfc, 0xbe, 0x4e, 0x20, 0x8a, 0x0b, 0xe5, 0x1a, 0x34, 0xd5, 0xd7, 0xa0, 0xf1,
-	0xdb, 0xad, 0xb2, 0x42, 0xf6, 0x31, 0x89, 0xc3, 0x21, 0x2b, 0x13, 0x44, 0x96, 0xe3, 0x1d, 0xcd,
-	0xc3, 0xac, 0xfb, 0x93, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4d, 0xca, 0x25, 0x98, 0x72,
-	0x1c, 0x76, 0x6c, 0x2b, 0x9a, 0x17, 0x86, 0xac, 0x14, 0x9c, 0xf0, 0xd8, 0x67, 0x68, 0x84, 0x4e,
-	0x08, 0x48, 0x21, 0x11, 0x92, 0xdc, 0xbc, 0xd6, 0x7c, 0x11, 0x26, 0xda, 0x3a, 0xee, 0xd4, 0x6d,
-	0xe2, 0x32, 0x5d, 0x5e, 0xf1, 0x51, 0xe1, 0x55, 0x7a, 0xcb, 0x75, 0xa1, 0x10, 0xb8, 0xe2, 0xaa,
-	0xa1, 0x2f, 0x04, 0x38, 0x52, 0xc5, 0xc6, 0xab, 0xba, 0xba, 0xef, 0xbe, 0xda, 0x87, 0xb8, 0xc7,
-	0x0e, 0xb8, 0xe9, 0xd3, 0xd7, 0x65, 0x6f, 0xbb, 0x66, 0x83, 0xf2, 0xeb, 0xfa, 0x47, 0xc7, 0xc8,
-	0x23, 0xba, 0xbb, 0xe0, 0xe5, 0x5a, 0x28, 0x40, 0x8a, 0x54, 0x62, 0x11, 0xe6, 0xb6, 0xde, 0xdc,
-	0xda, 0xac, 0xdd, 0x7c, 0xed, 0xfa, 0xce, 0xd6, 0xe6, 0xf6, 0x4b, 0xdb, 0x5b, 0xd7, 0xe6, 0xc6,
-	0xc4, 0xff, 0xc1, 0x14, 0x59, 0xbd, 0xa1, 0xbc, 0x35, 0x27, 0x94, 0x1f, 0xce, 0x40, 0xb2, 0x8a,
-	0x0d, 0xf1, 0x16, 0xcc, 0xf4, 0xfe, 0x47, 0x22, 0xe7, 0xbf, 0xe6, 0x71, 0xf7, 0x12, 0x69, 0x25,
-	0x02, 0xc0, 0x88, 0xaf, 0x83, 0x18, 0xf0, 0xce, 0x3f, 0x17, 0xa4, 0xee, 0xc7, 0x49, 0x72, 0x3c,
-	0x1c, 0xf3, 0x76, 0x1b, 0xe6, 0x7c, 0x8f, 0xe9, 0x33, 0x11, 0x36, 0x08, 0x4a, 0xba, 0x10, 0x07,
-	0xc5, 0xfc, 0x58, 0xb0, 0x10, 0xf4, 0x98, 0x5d, 0x89, 0x0c, 0x97, 0x02, 0xa5, 0x52, 0x4c, 0x20,
-	0x73, 0x68, 0xc2, 0xbc, 0xff, 0x9d, 0x79, 0x36, 0xa2, 0x08, 0x14, 0x26, 0x15, 0x63, 0xc1, 0x98,
-	0xab, 0x0e, 0x2c, 0x05, 0x3f, 0x1e, 0xce, 0x47, 0xd8, 0xe9, 0x42, 0xa5, 0xb5, 0xd8, 0x50, 0xe6,
-	0xf6, 0x00, 0x8e, 0x86, 0x3c, 0xef, 0x0a, 0x11, 0x64, 0xf5, 0x60, 0xa5, 0x72, 0x7c, 0x2c, 0xf3,
-	0xfc, 0x95, 0x00, 0xb9, 0xa8, 0x7b, 0xee, 0x7a, 0x2c, 0xbb, 0xbc, 0x92, 0xf4, 0xfc, 0x10, 0x4a,
-	0x2c, 0xaa, 0x0f, 0x04, 0x58, 0x0e, 0xbf, 0x0d, 0x16, 0x63, 0x99, 0x66, 0xfd, 0x76, 0xf9, 0x99,
-	0xe0, 0x2c, 0x86, 0x77, 0x20, 0xdd, 0x77, 0xef, 0x42, 0x41, 0x86, 0x78, 0x8c, 0x54, 0x88, 0xc6,
-	0xf4, 0x6e, 0x58, 0xdf, 0x6d, 0x20, 0x70, 0xc3, 0xf6, 0xa3, 0x82, 0x37, 0x6c, 0xd8, 0xb1, 0x2d,
-	0x6e, 0x40, 0x8a, 0x1c, 0xd9, 0x99, 0x20, 0x2d, 0x47, 0x22, 0xe5, 0xc3, 0x24, 0xbd, 0x36, 0xc8,
-	0x5c, 0x0d, 0xb4, 0xe1, 0x48, 0x82, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x80, 0x9e, 0x23, 0x24, 0x1b,
-	0x84, 0xef, 0xca, 0xa5, 0x73, 0x83, 0xe5, 0x9e, 0x55, 0x69, 0xfc, 0x7d, 0xe7, 0xc5, 0xbd, 0x21,
-	0x3f, 0x78, 0x9c, 0x15, 0x1e, 0x3d, 0xce, 0x0a, 0x7f, 0x3c, 0xce, 0x0a, 0x5f, 0x3e, 0xc9, 0x8e,
-	0x3d, 0x7a, 0x92, 0x1d, 0xfb, 0xf5, 0x49, 0x76, 0xec, 0x6d, 0xf7, 0x58, 0xc2, 0xda, 0x1d, 0xd9,
-	0xb4, 0x4a, 0x07, 0xf4, 0x3c, 0xd9, 0x9d, 0x20, 0x97, 0xd4, 0xf5, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x59, 0x7d, 0x05, 0x2f, 0x53, 0x17, 0x00, 0x00,
+	// 1664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0xdb, 0x46,
+	0x13, 0x37, 0x25, 0xf9, 0xa1, 0x71, 0x2c, 0xdb, 0xf4, 0x23, 0x32, 0xe3, 0xc8, 0x0a, 0xf3, 0xb0,
+	0x23, 0x44, 0x94, 0x2d, 0xe7, 0x81, 0x4f, 0x5f, 0x81, 0xc2, 0x76, 0xd4, 0xc2, 0x6d, 0xd4, 0x18,
+	0x4c, 0xd2, 0xb4, 0xbd, 0xb8, 0xb4, 0x49, 0x33, 0x44, 0x24, 0x51, 0xd5, 0x52, 0x8e, 0x7d, 0xeb,
+	0xe3, 0xd2, 0x16, 0x01, 0x5a, 0xa0, 0xff, 0x40, 0x7b, 0xeb, 0x31, 0x05, 0x7c, 0xe8, 0xa9, 0xbd,
+	0x15, 0x41, 0x7a, 0x09, 0x72, 0x2a, 0x72, 0x28, 0x82, 0x04, 0x45, 0x7a, 0xea, 0xa9, 0xf7, 0x16,
+	0xdc, 0x25, 0x57, 0xe2, 0x4b, 0xa4, 0x05, 0xa3, 0xb9, 0x08, 0xe2, 0xce, 0x6f, 0x77, 0x66, 0x7e,
+	0x33, 0x3b, 0xb3, 0xbb, 0x90, 0xde, 0xd6, 0x51, 0x4d, 0x47, 0x05, 0xb5, 0xa9, 0xb7, 0x1a, 0x85,
+	0xdd, 0xa5, 0x82, 0xb1, 0x27, 0x34, 0x9a, 0xba, 0xa1, 0xb3, 0xa3, 0x44, 0x22, 0x60, 0x89, 0xb0,
+	0xbb, 0xc4, 0x4d, 0xaa, 0xba, 0xaa, 0x63, 0x59, 0xc1, 0xfc, 0x47, 0x60, 0xdc, 0x0c, 0x81, 0x6d,
+	0x12, 0x81, 0x35, 0xc7, 0x12, 0xa9, 0xba, 0xae, 0x56, 0x95, 0x02, 0xfe, 0xda, 0x6a, 0xed, 0x14,
+	0xa4, 0xfa, 0xbe, 0x25, 0x3a, 0xe1, 0x51, 0xbb, 0xdf, 0x50, 0xec, 0x79, 0xc7, 0x2d, 0x61, 0x0d,
+	0xa9, 0xa6, 0xa8, 0x86, 0x54, 0x4b, 0x30, 0x2e, 0xd5, 0xb4, 0xba, 0x5e, 0xc0, 0xbf, 0x64, 0x88,
+	0xff, 0x95, 0x81, 0x54, 0x05, 0xa9, 0x6b, 0x4d, 0x45, 0x32, 0x94, 0x37, 0xcd, 0xd5, 0x58, 0x01,
+	0xfa, 0x25, 0xb9, 0xa6, 0xd5, 0xd3, 0x4c, 0x96, 0x59, 0x48, 0xae, 0xa6, 0x9f, 0x1c, 0xe4, 0x27,
+	0x2d, 0xbb, 0x56, 0x64, 0xb9, 0xa9, 0x20, 0x74, 0xc3, 0x68, 0x6a, 0x75, 0x55, 0x24, 0x30, 0x76,
+	0x0d, 0x06, 0x6b, 0x4a, 0x6d, 0x4b, 0x69, 0xa2, 0x74, 0x2c, 0x1b, 0x5f, 0x18, 0x2e, 0x66, 0x04,
+	0x97, 0xeb, 0x42, 0x05, 0xcb, 0x45, 0xe5, 0xa3, 0x96, 0x82, 0x8c, 0xd5, 0xe4, 0xc3, 0xdf, 0xe7,
+	0xfa, 0xbe, 0x7f, 0xf9, 0x20, 0xc7, 0x88, 0xf6, 0x4c, 0x96, 0x83, 0xa1, 0x9a, 0x62, 0x48, 0xb2,
+	0x64, 0x48, 0xe9, 0xb8, 0xa9, 0x57, 0xa4, 0xdf, 0xa5, 0x85, 0x4f, 0x5f, 0x3e, 0xc8, 0x11, 0x65,
+	0x5f, 0xbe, 0x7c, 0x90, 0xb3, 0x18, 0xcb, 0x23, 0xf9, 0x6e, 0xc1, 0x69, 0x3a, 0xbf, 0x0c, 0xd3,
+	0xce, 0x11, 0x51, 0x41, 0x0d, 0xbd, 0x8e, 0x14, 0x76, 0x06, 0x86, 0xb0, 0x35, 0x9b, 0x9a, 0x8c,
+	0xfd, 0x4a, 0x88, 0x83, 0xf8, 0x7b, 0x5d, 0xe6, 0xff, 0x60, 0x60, 0xaa, 0x82, 0xd4, 0x5b, 0x0d,
+	0xd9, 0x9e, 0x55, 0xb1, 0x8c, 0x3a, 0x2c, 0x13, 0x9d, 0x4a, 0x62, 0x0e, 0x25, 0xec, 0x06, 0xa4,
+	0x88, 0xab, 0x9b, 0x2d, 0xac, 0x07, 0xa5, 0xe3, 0x87, 0xe5, 0x6a, 0x84, 0x2c, 0x40, 0xec, 0x44,
+	0xa5, 0x82, 0x93, 0x95, 0xac, 0x93, 0x15, 0xaf, 0x37, 0xfc, 0x1c, 0x9c, 0xf4, 0x15, 0xd8, 0x1c,
+	0xf1, 0xbf, 0x30, 0x30, 0xe1, 0x44, 0xac, 0x60, 0xb7, 0x8e, 0x90, 0x86, 0x4b, 0x90, 0xac, 0x2b,
+	0xf7, 0x36, 0xc9, 0x72, 0xf1, 0x90, 0xe5, 0x86, 0xea, 0xca, 0x3d, 0x6c, 0x41, 0x29, 0xef, 0xf4,
+	0x35, 0x13, 0xe8, 0x2b, 0x86, 0xf3, 0x27, 0xe1, 0x84, 0xcf, 0x30, 0xf5, 0xf3, 0x07, 0x06, 0xa6,
+	0x9d, 0xf2, 0x8a, 0x95, 0x6a, 0x47, 0xe9, 0x6a, 0xb7, 0x8c, 0x5e, 0x74, 0xfa, 0x73, 0xaa, 0x4b,
+	0xec, 0xc8, 0x0c, 0x3e, 0x0b, 0x19, 0x7f, 0x09, 0xf5, 0xea, 0xa7, 0x18, 0x4c, 0x3a, 0x93, 0x7f,
+	0x43, 0xaf, 0x6a, 0xdb, 0xfb, 0xff, 0x91, 0x4f, 0xac, 0x04, 0xa3, 0xb2, 0xb2, 0xad, 0x21, 0x4d,
+	0xaf, 0x6f, 0x36, 0xb0, 0xe6, 0x74, 0x22, 0xcb, 0x2c, 0x0c, 0x17, 0x27, 0x05, 0x52, 0xc7, 0x04,
+	0xbb, 0x8e, 0x09, 0x2b, 0xf5, 0xfd, 0x55, 0xfe, 0xd1, 0x41, 0x3e, 0xe3, 0xce, 0xfd, 0xab, 0xd6,
+	0x02, 0xc4, 0x72, 0x31, 0x25, 0x3b, 0xbe, 0xd9, 0x05, 0xe8, 0xaf, 0x4a, 0x5b, 0x4a, 0x35, 0xdd,
+	0x8f, 0x3d, 0x61, 0x9f, 0x1e, 0xe4, 0x53, 0x7b, 0xa4, 0x06, 0x66, 0x77, 0x17, 0x85, 0xa2, 0xb0,
+	0x28, 0x12, 0x40, 0xa9, 0xf8, 0xf9, 0xb7, 0x73, 0x7d, 0x4e, 0x92, 0xe7, 0x02, 0xcb, 0x06, 0x59,
+	0x9d, 0x17, 0x61, 0xd6, 0x6f, 0x9c, 0x96, 0x90, 0x22, 0x0c, 0x4a, 0x84, 0xaf, 0x50, 0x26, 0x6d,
+	0x20, 0xff, 0x59, 0x0c, 0x66, 0x9c, 0x71, 0x23, 0x8b, 0xf6, 0xb6, 0xb1, 0xde, 0x82, 0x49, 0x12,
+	0x19, 0xc2, 0xef, 0xa6, 0x6d, 0x4e, 0x2c, 0x64, 0x3a, 0xab, 0x76, 0x6a, 0xc6, 0x92, 0x5e, 0x77,
+	0xe2, 0xb2, 0x93, 0xd4, 0x33, 0x81, 0x99, 0xdb, 0xe1, 0x27, 0x7f, 0x1a, 0x4e, 0x05, 0x0a, 0x69,
+	0xfe, 0xfe, 0x18, 0x87, 0xb4, 0x93, 0xff, 0xdb, 0x9a, 0x71, 0xa7, 0xc7, 0x1c, 0x3e, 0x92, 0x9e,
+	0x74, 0x16, 0x52, 0x84, 0x6e, 0x57, 0xce, 0x8f, 0xa8, 0x8e, 0x9a, 0x51, 0x84, 0x29, 0x47, 0x54,
+	0x28, 0x3a, 0x81, 0xd1, 0x13, 0x1d, 0xe4, 0xd3, 0x39, 0x4b, 0xae, 0x39, 0x12, 0xb2, 0x22, 0x61,
+	0x66, 0xf6, 0x90, 0x33, 0x60, 0x88, 0x24, 0x8b, 0xcf, 0xfe, 0x1a, 0x38, 0xda, 0xfd, 0x55, 0xba,
+	0xec, 0xdd, 0x35, 0xa7, 0x03, 0x77, 0x4d, 0x3b, 0x3a, 0xfc, 0x17, 0x0c, 0x64, 0x83, 0x84, 0x11,
+	0x3a, 0xf0, 0x51, 0xe6, 0x35, 0xff, 0x73, 0x0c, 0x78, 0xbf, 0x64, 0x73, 0xba, 0xfe, 0x4a, 0xb7,
+	0x9e, 0x4f, 0x24, 0xe3, 0x47, 0x1c, 0xc9, 0x92, 0x37, 0x92, 0xf3, 0x81, 0x5b, 0xd5, 0xb9, 0x16,
+	0x7f, 0x01, 0x72, 0xe1, 0x04, 0xd2, 0x6d, 0xfb, 0x17, 0x03, 0xb3, 0x7e, 0xf0, 0x9e, 0x5b, 0xea,
+	0x51, 0x32, 0xdd, 0xad, 0x07, 0x5f, 0x8e, 0x4a, 0x8f, 0xd3, 0x1f, 0xfe, 0x1c, 0x9c, 0xe9, 0x26,
+	0xa7, 0xc4, 0xfc, 0xcd, 0xf8, 0x97, 0xfe, 0x6b, 0x66, 0x83, 0x7a, 0xa5, 0xac, 0x4c, 0xda, 0x6d,
+	0x94, 0x50, 0x62, 0xb5, 0xcc, 0xf2, 0x13, 0x4f, 0x37, 0x3d, 0x5c, 0xad, 0xc7, 0x8e, 0xf1, 0x57,
+	0xe0, 0x54, 0xa0, 0xd0, 0xe6, 0xa6, 0xc4, 0x7a, 0x75, 0xf1, 0x4f, 0xe2, 0x30, 0x5e, 0x41, 0xea,
+	0x8d, 0xd6, 0x56, 0x4d, 0x33, 0x36, 0x9a, 0x7a, 0x43, 0x47, 0x52, 0x35, 0xd0, 0x6f, 0xa6, 0x07,
+	0xbf, 0x67, 0x21, 0xd9, 0xc0, 0xeb, 0xda, 0x6d, 0x21, 0x29, 0xb6, 0x07, 0xba, 0x9e, 0x6d, 0x16,
+	0x4d, 0x19, 0x42, 0x92, 0xaa, 0xa0, 0x74, 0x22, 0x1b, 0x0f, 0xda, 0xaa, 0x22, 0x45, 0xb1, 0xe7,
+	0x21, 0xa1, 0xec, 0x29, 0xdb, 0xb8, 0x9e, 0xa7, 0x8a, 0x53, 0x9e, 0xee, 0x53, 0xde, 0x53, 0xb6,
+	0x45, 0x0c, 0x61, 0xcf, 0x43, 0xbf, 0xa1, 0x19, 0x55, 0x05, 0x97, 0xf3, 0xe4, 0xea, 0xc4, 0xd3,
+	0x83, 0xfc, 0x68, 0x9b, 0xea, 0xec, 0xa2, 0x70, 0xf1, 0x8a, 0x48, 0x10, 0x6c, 0x1e, 0x06, 0x51,
+	0xab, 0x56, 0x93, 0x9a, 0xfb, 0xe9, 0xc1, 0x60, 0xb0, 0x8d, 0x61, 0xcb, 0x90, 0xb8, 0xab, 0xd5,
+	0xe5, 0xf4, 0x10, 0x36, 0xe2, 0xa4, 0xc7, 0x08, 0x9b, 0xe5, 0xb7, 0xb5, 0xba, 0xec, 0x7b, 0x9a,
+	0xc2, 0xd3, 0x4b, 0xff, 0xb3, 0x8b, 0x49, 0x9b, 0x2d, 0x33, 0x1f, 0xf8, 0x8e, 0x7c, 0x20, 0xf7,
+	0x50, 0x4f, 0xf8, 0xf8, 0xd7, 0x60, 0xc6, 0x33, 0x48, 0x3b, 0xc2, 0x1c, 0x0c, 0x37, 0xac, 0xb1,
+	0x76, 0x53, 0x00, 0x7b, 0x68, 0x5d, 0xe6, 0xbf, 0x23, 0x17, 0x12, 0xb3, 0x99, 0xc8, 0x4d, 0xe9,
+	0x1e, 0x4d, 0x8a, 0xb0, 0x89, 0x9d, 0x47, 0xb5, 0x58, 0xc4, 0xa3, 0x5a, 0xe9, 0x92, 0xe9, 0xa1,
+	0xfd, 0xe5, 0xce, 0x77, 0xea, 0x9f, 0xdb, 0x16, 0xeb, 0xae, 0xe1, 0x1e, 0xa6, 0x55, 0xe0, 0x1f,
+	0x06, 0x06, 0x2b, 0x48, 0x7d, 0x57, 0x37, 0xc2, 0xfd, 0x35, 0x8b, 0xc2, 0xae, 0x6e, 0x28, 0xcd,
+	0x50, 0xa3, 0x09, 0x8c, 0x5d, 0x86, 0x01, 0xbd, 0x61, 0x68, 0x3a, 0x39, 0xc0, 0xa5, 0x8a, 0x27,
+	0x3c, 0x11, 0x36, 0xf5, 0x5e, 0xc7, 0x10, 0xd1, 0x82, 0x3a, 0xf2, 0x3c, 0xe1, 0xca, 0xf3, 0xe8,
+	0x59, 0x5b, 0x9a, 0xc7, 0xc5, 0x01, 0xdb, 0x61, 0x92, 0x95, 0xf6, 0x23, 0xcb, 0xd4, 0xce, 0x8f,
+	0xc3, 0xa8, 0xf5, 0x97, 0x92, 0xf2, 0x2c, 0xd6, 0x91, 0x16, 0xd7, 0x77, 0x76, 0xb6, 0xef, 0x48,
+	0x5a, 0x7d, 0xa5, 0xd1, 0x68, 0xea, 0xbb, 0x52, 0x95, 0xbd, 0x0c, 0x49, 0x84, 0x25, 0x26, 0x13,
+	0x61, 0xfb, 0xbc, 0x0d, 0x75, 0xd3, 0x1b, 0xf3, 0xd0, 0xbb, 0x08, 0x03, 0xe4, 0x68, 0x17, 0x7a,
+	0xde, 0xb5, 0x70, 0x1d, 0x04, 0x27, 0x7a, 0x23, 0xb8, 0xdf, 0x45, 0xf0, 0x2c, 0x24, 0x91, 0xa6,
+	0xd6, 0x25, 0xa3, 0xd5, 0x24, 0xfb, 0xfd, 0x98, 0xd8, 0x1e, 0x28, 0xad, 0xfb, 0x97, 0xe0, 0xb6,
+	0x8f, 0x81, 0x69, 0xe9, 0x26, 0xd1, 0x2a, 0xc3, 0xfe, 0x0c, 0x77, 0x2d, 0xc3, 0xf7, 0x49, 0xc2,
+	0x9a, 0x91, 0x0e, 0x4f, 0xd8, 0x8b, 0x30, 0x64, 0x26, 0x43, 0xcb, 0xd0, 0xc3, 0x73, 0x96, 0x22,
+	0x4b, 0x39, 0xd3, 0x29, 0xfa, 0x19, 0x98, 0x3d, 0xa6, 0x09, 0xbc, 0x08, 0xa3, 0xd6, 0x5f, 0x5a,
+	0x36, 0x5e, 0x87, 0x81, 0xa6, 0x82, 0x5a, 0x55, 0x03, 0xab, 0x4c, 0x15, 0xe7, 0x03, 0xeb, 0x5a,
+	0xd9, 0x52, 0x21, 0x62, 0xb8, 0x68, 0x4d, 0xe3, 0xbf, 0x62, 0x60, 0xa4, 0x82, 0xd4, 0x6b, 0x8a,
+	0xb4, 0x6b, 0x3d, 0x79, 0xf5, 0x70, 0xb5, 0xeb, 0x72, 0x4d, 0x26, 0x4f, 0x33, 0x9d, 0xa5, 0x24,
+	0xe3, 0xe7, 0x5f, 0x5b, 0x3f, 0x7f, 0x1c, 0xa6, 0x1c, 0x03, 0xb6, 0xaf, 0xb9, 0x1c, 0x24, 0x70,
+	0x24, 0x26, 0x61, 0xac, 0xfc, 0x5e, 0x79, 0x6d, 0xf3, 0xd6, 0x3b, 0x37, 0x36, 0xca, 0x6b, 0xeb,
+	0x6f, 0xac, 0x97, 0xaf, 0x8e, 0xf5, 0xb1, 0xc7, 0x60, 0x08, 0x8f, 0xde, 0x14, 0xdf, 0x1f, 0x63,
+	0x8a, 0x7f, 0x8e, 0x40, 0xbc, 0x82, 0x54, 0xf6, 0x36, 0x0c, 0x77, 0x3e, 0xe7, 0xcd, 0x79, 0x6f,
+	0x3e, 0x8e, 0xa3, 0x3a, 0x37, 0x1f, 0x02, 0xa0, 0xc4, 0x57, 0x81, 0xf5, 0x79, 0x24, 0x3b, 0xe7,
+	0x37, 0xdd, 0x8b, 0xe3, 0x84, 0x68, 0x38, 0xaa, 0x6d, 0x07, 0xc6, 0x3c, 0x2f, 0x51, 0x67, 0x42,
+	0xd6, 0xc0, 0x28, 0xee, 0x42, 0x14, 0x14, 0xd5, 0xa3, 0xc3, 0x84, 0xdf, 0x4b, 0xd0, 0x7c, 0xa8,
+	0xb9, 0x04, 0xc8, 0x15, 0x22, 0x02, 0xa9, 0x42, 0x0d, 0xc6, 0xbd, 0x8f, 0x34, 0x67, 0x43, 0x82,
+	0x40, 0x60, 0x5c, 0x3e, 0x12, 0x8c, 0xaa, 0x6a, 0xc1, 0x94, 0xff, 0x7d, 0xfa, 0x7c, 0xc8, 0x3a,
+	0x6d, 0x28, 0xb7, 0x14, 0x19, 0x4a, 0xd5, 0xee, 0xc1, 0x74, 0xc0, 0x8b, 0x47, 0x2e, 0x84, 0xac,
+	0x0e, 0x2c, 0x57, 0x8c, 0x8e, 0xa5, 0x9a, 0xbf, 0x61, 0x60, 0x2e, 0xec, 0xea, 0xb7, 0x1c, 0x69,
+	0x5d, 0xe7, 0x24, 0xee, 0xff, 0x3d, 0x4c, 0xa2, 0x56, 0x7d, 0xc2, 0xc0, 0x4c, 0xf0, 0x05, 0x29,
+	0x1f, 0x69, 0x69, 0x9a, 0x6f, 0x97, 0x0e, 0x05, 0xa7, 0x36, 0xdc, 0x67, 0x60, 0xda, 0xff, 0x54,
+	0x1e, 0x31, 0x28, 0x18, 0xcb, 0x15, 0xa3, 0x63, 0x69, 0xbb, 0x67, 0x1f, 0x79, 0xda, 0x0c, 0xfb,
+	0x21, 0xa4, 0x5c, 0x27, 0x7d, 0xde, 0x6f, 0x65, 0x27, 0x86, 0xcb, 0x85, 0x63, 0x3a, 0xeb, 0x87,
+	0xe7, 0xe0, 0xe8, 0x5b, 0x3f, 0xdc, 0x28, 0xee, 0x42, 0x14, 0x14, 0xd5, 0xb3, 0x0a, 0x09, 0x7c,
+	0xba, 0x4b, 0xfb, 0xcd, 0x32, 0x25, 0x5c, 0x36, 0x48, 0xe2, 0x08, 0x4e, 0xc0, 0x69, 0xa8, 0x8b,
+	0xcb, 0x6e, 0x2c, 0x57, 0x8c, 0x8e, 0xed, 0x1a, 0x9c, 0x55, 0xab, 0xeb, 0xf8, 0xba, 0x64, 0x4a,
+	0xb8, 0x6c, 0x90, 0x84, 0xba, 0x74, 0x13, 0xa0, 0xa3, 0xc1, 0x66, 0xfc, 0xf0, 0x6d, 0x39, 0x77,
+	0xae, 0xbb, 0xdc, 0x5e, 0x95, 0xeb, 0xff, 0xd8, 0x7c, 0xa2, 0x5b, 0x15, 0x1e, 0x3e, 0xcf, 0x30,
+	0x8f, 0x9f, 0x67, 0x98, 0x67, 0xcf, 0x33, 0xcc, 0xd7, 0x2f, 0x32, 0x7d, 0x8f, 0x5f, 0x64, 0xfa,
+	0x7e, 0x7b, 0x91, 0xe9, 0xfb, 0xc0, 0x6a, 0xda, 0x48, 0xbe, 0x2b, 0x68, 0x7a, 0xc1, 0xf2, 0x6b,
+	0x6b, 0x00, 0xdf, 0xd2, 0x96, 0xff, 0x1d, 0x00, 0x89, 0xc9, 0xf1, 0xee, 0xae, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1624,12 +1878,18 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyLabel sets or clears the label of a group policy.
+	UpdateGroupPolicyLabel(ctx context.Context, in *MsgUpdateGroupPolicyLabel, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyLabelResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// SubmitOffchainApproval relays a group member's approval of a proposal
+	// signed off-chain. Approvals are non-binding signals, they are not counted
+	// in the proposal tally.
+	SubmitOffchainApproval(ctx context.Context, in *MsgSubmitOffchainApproval, opts ...grpc.CallOption) (*MsgSubmitOffchainApprovalResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
@@ -1725,6 +1985,15 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyLabel(ctx context.Context, in *MsgUpdateGroupPolicyLabel, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyLabelResponse, error) {
+	out := new(MsgUpdateGroupPolicyLabelResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicyLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitProposal", in, out, opts...)
@@ -1752,6 +2021,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) SubmitOffchainApproval(ctx context.Context, in *MsgSubmitOffchainApproval, opts ...grpc.CallOption) (*MsgSubmitOffchainApprovalResponse, error) {
+	out := new(MsgSubmitOffchainApprovalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitOffchainApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
 	out := new(MsgExecResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/Exec", in, out, opts...)
@@ -1790,12 +2068,18 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyLabel sets or clears the label of a group policy.
+	UpdateGroupPolicyLabel(context.Context, *MsgUpdateGroupPolicyLabel) (*MsgUpdateGroupPolicyLabelResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// SubmitOffchainApproval relays a group member's approval of a proposal
+	// signed off-chain. Approvals are non-binding signals, they are not counted
+	// in the proposal tally.
+	SubmitOffchainApproval(context.Context, *MsgSubmitOffchainApproval) (*MsgSubmitOffchainApprovalResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
@@ -1833,6 +2117,9 @@ func (*UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(ctx context.Conte
 func (*UnimplementedMsgServer) UpdateGroupPolicyMetadata(ctx context.Context, req *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGroupPolicyLabel(ctx context.Context, req *MsgUpdateGroupPolicyLabel) (*MsgUpdateGroupPolicyLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyLabel not implemented")
+}
 func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
@@ -1842,6 +2129,9 @@ func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWit
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) SubmitOffchainApproval(ctx context.Context, req *MsgSubmitOffchainApproval) (*MsgSubmitOffchainApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOffchainApproval not implemented")
+}
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
@@ -2015,6 +2305,24 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyLabel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicyLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyLabel(ctx, req.(*MsgUpdateGroupPolicyLabel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -2069,6 +2377,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitOffchainApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitOffchainApproval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitOffchainApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/SubmitOffchainApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitOffchainApproval(ctx, req.(*MsgSubmitOffchainApproval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExec)
 	if err := dec(in); err != nil {
@@ -2145,6 +2471,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyLabel",
+			Handler:    _Msg_UpdateGroupPolicyLabel_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
@@ -2157,6 +2487,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "SubmitOffchainApproval",
+			Handler:    _Msg_SubmitOffchainApproval_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
@@ -2471,6 +2805,13 @@ func (m *MsgCreateGroupPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.DecisionPolicy != nil {
 		{
 			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -2856,7 +3197,7 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupPolicyLabel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2866,74 +3207,146 @@ func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupPolicyLabel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupPolicyLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Exec != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Proposers) > 0 {
-		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proposers[iNdEx])
-			copy(dAtA[i:], m.Proposers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proposers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	if len(m.GroupPolicyAddress) > 0 {
 		i -= len(m.GroupPolicyAddress)
 		copy(dAtA[i:], m.GroupPolicyAddress)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyLabelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyLabelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Exec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *MsgSubmitProposalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -3096,6 +3509,90 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitOffchainApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOffchainApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOffchainApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitOffchainApprovalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOffchainApprovalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOffchainApprovalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3373,6 +3870,10 @@ func (m *MsgCreateGroupPolicy) Size() (n int) {
 		l = m.DecisionPolicy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3529,6 +4030,36 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGroupPolicyLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyLabelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3566,6 +4097,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Kind != 0 {
+		n += 1 + sovTx(uint64(m.Kind))
+	}
 	return n
 }
 
@@ -3641,6 +4175,46 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitOffchainApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitOffchainApprovalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgExec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4616,21 +5190,53 @@ func (m *MsgCreateGroupPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -5648,6 +6254,202 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGroupPolicyLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyLabelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyLabelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5890,6 +6692,25 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProposalKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6352,6 +7173,274 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitOffchainApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitOffchainApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitOffchainApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitOffchainApprovalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitOffchainApprovalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitOffchainApprovalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -54,7 +54,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{Threshold: threshold, Windows: &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -73,6 +73,10 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(err, "threshold")
 	}
 
+	if _, err := p.quorum(); err != nil {
+		return err
+	}
+
 	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
@@ -80,12 +84,34 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	return nil
 }
 
-// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+// quorum returns the quorum of the policy, zero if empty.
+func (p ThresholdDecisionPolicy) quorum() (math.Dec, error) {
+	if p.Quorum == "" {
+		return math.NewDecFromInt64(0), nil
+	}
+
+	quorum, err := math.NewNonNegativeDecFromString(p.Quorum)
+	if err != nil {
+		return math.Dec{}, errorsmod.Wrap(err, "quorum")
+	}
+	if quorum.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return math.Dec{}, errorsmod.Wrap(errors.ErrInvalid, "quorum must be >= 0 and <= 1")
+	}
+
+	return quorum, nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout,
+// provided the tally of all votes reaches the quorum.
 func (p ThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
 	threshold, err := math.NewPositiveDecFromString(p.Threshold)
 	if err != nil {
 		return DecisionPolicyResult{}, errorsmod.Wrap(err, "threshold")
 	}
+	quorum, err := p.quorum()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
 	yesCount, err := math.NewNonNegativeDecFromString(tallyResult.YesCount)
 	if err != nil {
 		return DecisionPolicyResult{}, errorsmod.Wrap(err, "yes count")
@@ -103,14 +129,28 @@ func (p ThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower strin
 	// `yesCount`==`realThreshold`), then the proposal still passes.
 	realThreshold := min(threshold, totalPowerDec)
 
-	if yesCount.Cmp(realThreshold) >= 0 {
-		return DecisionPolicyResult{Allow: true, Final: true}, nil
-	}
-
 	totalCounts, err := tallyResult.TotalCounts()
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
+
+	if yesCount.Cmp(realThreshold) >= 0 {
+		// the quorum can always be reached by the undecided members, the
+		// proposal is not rejected while it is not.
+		if quorum.IsZero() || totalPowerDec.IsZero() {
+			return DecisionPolicyResult{Allow: true, Final: true}, nil
+		}
+
+		participation, err := totalCounts.Quo(totalPowerDec)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if participation.Cmp(quorum) >= 0 {
+			return DecisionPolicyResult{Allow: true, Final: true}, nil
+		}
+
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
 	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
 	if err != nil {
 		return DecisionPolicyResult{}, err
@@ -143,6 +183,9 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "threshold")
 	}
+	if _, err := p.quorum(); err != nil {
+		return err
+	}
 	_, err = math.NewNonNegativeDecFromString(g.TotalWeight)
 	if err != nil {
 		return errorsmod.Wrap(err, "group total weight")
@@ -354,6 +397,34 @@ func (g GroupPolicyInfo) ValidateBasic() error {
 	if err := policy.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "group policy's decision policy")
 	}
+	if err := ValidateGroupPolicyLabel(g.Label); err != nil {
+		return errorsmod.Wrap(err, "group policy label")
+	}
+	return nil
+}
+
+// MaxGroupPolicyLabelLen is the maximum length of a group policy label.
+const MaxGroupPolicyLabelLen = 32
+
+// ValidateGroupPolicyLabel checks that a group policy label is made of at most
+// MaxGroupPolicyLabelLen lowercase letters, digits, '-' or '_', starting with a letter.
+// An empty label is valid and means the group policy has no label.
+func ValidateGroupPolicyLabel(label string) error {
+	if label == "" {
+		return nil
+	}
+	if len(label) > MaxGroupPolicyLabelLen {
+		return errors.ErrMaxLimit.Wrapf("label length must be at most %d, got %d", MaxGroupPolicyLabelLen, len(label))
+	}
+	for i, c := range label {
+		isLetter := c >= 'a' && c <= 'z'
+		if i == 0 && !isLetter {
+			return errors.ErrInvalid.Wrapf("label %q must start with a lowercase letter", label)
+		}
+		if !isLetter && !(c >= '0' && c <= '9') && c != '-' && c != '_' {
+			return errors.ErrInvalid.Wrapf("label %q must only contain lowercase letters, digits, '-' or '_'", label)
+		}
+	}
 	return nil
 }
 
@@ -458,6 +529,38 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (a OffchainApproval) PrimaryKeyFields(addressCodec address.Codec) ([]interface{}, error) {
+	addr, err := addressCodec.StringToBytes(a.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{a.ProposalId, addr}, nil
+}
+
+var _ orm.Validateable = OffchainApproval{}
+
+// ValidateBasic does basic validation on an off-chain approval.
+func (a OffchainApproval) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(a.Member)
+	if err != nil {
+		return errorsmod.Wrap(err, "member")
+	}
+	if a.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "approval ProposalId")
+	}
+	if a.Option == VOTE_OPTION_UNSPECIFIED {
+		return errorsmod.Wrap(errors.ErrEmpty, "approval vote option")
+	}
+	if _, ok := VoteOption_name[int32(a.Option)]; !ok {
+		return errorsmod.Wrap(errors.ErrInvalid, "vote option")
+	}
+	if len(a.Signature) == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "approval signature")
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
@@ -636,3 +739,32 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	}
 	return VoteOption(vo), nil
 }
+
+func (l GroupLink) PrimaryKeyFields(addressCodec address.Codec) ([]interface{}, error) {
+	addr, err := addressCodec.StringToBytes(l.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{l.ParentGroupId, addr}, nil
+}
+
+var _ orm.Validateable = GroupLink{}
+
+// ValidateBasic does basic validation on a group link.
+func (l GroupLink) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(l.Member)
+	if err != nil {
+		return errorsmod.Wrap(err, "member")
+	}
+	if l.ParentGroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "link's parent group id")
+	}
+	if l.ChildGroupId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "link's child group id")
+	}
+	if l.ParentGroupId == l.ChildGroupId {
+		return errorsmod.Wrap(errors.ErrInvalid, "a group cannot be its own sub-group")
+	}
+	return nil
+}
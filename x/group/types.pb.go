@@ -72,6 +72,39 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
 }
 
+// ProposalKind defines the kinds of proposals.
+type ProposalKind int32
+
+const (
+	// An unspecified kind is treated as executable.
+	PROPOSAL_KIND_UNSPECIFIED ProposalKind = 0
+	// An executable proposal has its messages executed when accepted.
+	PROPOSAL_KIND_EXECUTABLE ProposalKind = 1
+	// A signaling proposal has no messages and is never executed, its tally
+	// being recorded as a governance signal.
+	PROPOSAL_KIND_SIGNALING ProposalKind = 2
+)
+
+var ProposalKind_name = map[int32]string{
+	0: "PROPOSAL_KIND_UNSPECIFIED",
+	1: "PROPOSAL_KIND_EXECUTABLE",
+	2: "PROPOSAL_KIND_SIGNALING",
+}
+
+var ProposalKind_value = map[string]int32{
+	"PROPOSAL_KIND_UNSPECIFIED": 0,
+	"PROPOSAL_KIND_EXECUTABLE":  1,
+	"PROPOSAL_KIND_SIGNALING":   2,
+}
+
+func (x ProposalKind) String() string {
+	return proto.EnumName(ProposalKind_name, int32(x))
+}
+
+func (ProposalKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+}
+
 // ProposalStatus defines proposal statuses.
 type ProposalStatus int32
 
@@ -117,7 +150,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -153,7 +186,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -297,10 +330,12 @@ func (m *MemberRequest) GetMetadata() string {
 }
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
-// satisfies the two following conditions:
+// satisfies the three following conditions:
 //  1. The sum of all `YES` voter's weights is greater or equal than the defined
 //     `threshold`.
-//  2. The voting and execution periods of the proposal respect the parameters
+//  2. The sum of all voter's weights, whatever their vote, is greater or equal
+//     than the defined `quorum` of the total group weight.
+//  3. The voting and execution periods of the proposal respect the parameters
 //     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum weighted sum of `YES` votes that must be met or
@@ -308,6 +343,10 @@ type ThresholdDecisionPolicy struct {
 	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
 	// windows defines the different windows for voting and execution.
 	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+	// quorum is the minimum percentage of the total group weight, between 0 and
+	// 1, that must have voted, whatever the vote, for a proposal to succeed. An
+	// empty quorum is a zero quorum.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
 }
 
 func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
@@ -357,6 +396,13 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+func (m *ThresholdDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
 //  1. The percentage of all `YES` voters' weights out of the total group weight
@@ -653,6 +699,9 @@ type GroupPolicyInfo struct {
 	DecisionPolicy *any.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 	// created_at is a timestamp specifying when a group policy was created.
 	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// label is an optional human-readable name of the group policy (e.g. "treasury"),
+	// unique within the group.
+	Label string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
@@ -734,6 +783,10 @@ type Proposal struct {
 	Title string `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
 	// summary is a short summary of the proposal
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// kind defines whether the proposal is executable or signaling only.
+	// Proposals submitted before the kind was introduced are unspecified and
+	// treated as executable.
+	Kind ProposalKind `protobuf:"varint,15,opt,name=kind,proto3,enum=cosmos.group.v1.ProposalKind" json:"kind,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -897,8 +950,170 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// OffchainApproval is a group member's approval of a proposal signed off-chain
+// over the proposal typed data and relayed on-chain. It is a non-binding
+// signal: it is not counted in the proposal tally.
+type OffchainApproval struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// member is the account address of the group member who signed the approval.
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// option is the member's choice on the proposal.
+	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata attached to the approval.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// signature is the member's signature of the approval typed data.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// submit_time is the timestamp when the approval was submitted on-chain.
+	SubmitTime time.Time `protobuf:"bytes,6,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+}
+
+func (m *OffchainApproval) Reset()         { *m = OffchainApproval{} }
+func (m *OffchainApproval) String() string { return proto.CompactTextString(m) }
+func (*OffchainApproval) ProtoMessage()    {}
+func (*OffchainApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
+}
+func (m *OffchainApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OffchainApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OffchainApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OffchainApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OffchainApproval.Merge(m, src)
+}
+func (m *OffchainApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *OffchainApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_OffchainApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OffchainApproval proto.InternalMessageInfo
+
+func (m *OffchainApproval) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *OffchainApproval) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *OffchainApproval) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *OffchainApproval) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *OffchainApproval) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *OffchainApproval) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return time.Time{}
+}
+
+// GroupLink links a parent group to one of its members which is a group policy
+// account of another group, called the child group (or sub-group). The
+// sub-group votes on the proposals of the parent group through the proposals
+// of its group policy, with the weight of the member in the parent group.
+type GroupLink struct {
+	// parent_group_id is the unique ID of the parent group.
+	ParentGroupId uint64 `protobuf:"varint,1,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
+	// member is the address of the parent group member, a group policy account
+	// of the child group.
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// child_group_id is the unique ID of the child group.
+	ChildGroupId uint64 `protobuf:"varint,3,opt,name=child_group_id,json=childGroupId,proto3" json:"child_group_id,omitempty"`
+}
+
+func (m *GroupLink) Reset()         { *m = GroupLink{} }
+func (m *GroupLink) String() string { return proto.CompactTextString(m) }
+func (*GroupLink) ProtoMessage()    {}
+func (*GroupLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
+}
+func (m *GroupLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupLink.Merge(m, src)
+}
+func (m *GroupLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupLink proto.InternalMessageInfo
+
+func (m *GroupLink) GetParentGroupId() uint64 {
+	if m != nil {
+		return m.ParentGroupId
+	}
+	return 0
+}
+
+func (m *GroupLink) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *GroupLink) GetChildGroupId() uint64 {
+	if m != nil {
+		return m.ChildGroupId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.group.v1.ProposalKind", ProposalKind_name, ProposalKind_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
@@ -912,98 +1127,113 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*OffchainApproval)(nil), "cosmos.group.v1.OffchainApproval")
+	proto.RegisterType((*GroupLink)(nil), "cosmos.group.v1.GroupLink")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0x3a, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x04, 0xc5, 0x6e, 0x53, 0x44, 0xa5, 0x9e, 0xb0, 0x9d, 0x2d, 0x75, 0xd4, 0xda, 0xd6,
-	0x7a, 0x9d, 0xd0, 0x5e, 0x56, 0x1b, 0xef, 0xd4, 0x59, 0xd5, 0xde, 0x31, 0xbb, 0xe3, 0xa4, 0xfe,
-	0x0f, 0x2a, 0x2e, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0x97, 0x1e, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0x84, 0x38, 0x00, 0x6a, 0x0f, 0xe5, 0xc4, 0x89, 0x2b, 0x12, 0xda, 0x99,
-	0x59, 0xc7, 0x1f, 0xb1, 0x4b, 0xaa, 0x8a, 0x4b, 0x94, 0x99, 0xe7, 0x79, 0xdf, 0x79, 0x9f, 0xf7,
-	0x6b, 0x13, 0x58, 0x6d, 0x10, 0xb7, 0x4d, 0xdc, 0x5c, 0xd3, 0x21, 0xdd, 0x4e, 0xee, 0xf0, 0x72,
-	0x8e, 0xf6, 0x3a, 0xd8, 0xcd, 0x76, 0x1c, 0x42, 0x09, 0x4a, 0x70, 0x30, 0xcb, 0xc0, 0xec, 0xe1,
-	0xe5, 0x95, 0xc5, 0x26, 0x69, 0x12, 0x86, 0xe5, 0xbc, 0xdf, 0x38, 0x6d, 0x25, 0xd5, 0x24, 0xa4,
-	0xd9, 0xc2, 0x39, 0x76, 0xda, 0xef, 0xde, 0xcd, 0x99, 0x5d, 0xc7, 0xa0, 0x16, 0xb1, 0x05, 0x9e,
-	0x1e, 0xc5, 0xa9, 0xd5, 0xc6, 0x2e, 0x35, 0xda, 0x1d, 0x41, 0x58, 0xe6, 0xef, 0xe8, 0xdc, 0xb3,
-	0x78, 0x54, 0x40, 0xa3, 0xb6, 0x86, 0xdd, 0x13, 0xd0, 0x19, 0xa3, 0x6d, 0xd9, 0x24, 0xc7, 0x7e,
-	0xf2, 0xab, 0xcc, 0x37, 0x12, 0x84, 0x6e, 0xe1, 0xf6, 0x3e, 0x76, 0xd0, 0x16, 0x84, 0x0d, 0xd3,
-	0x74, 0xb0, 0xeb, 0xca, 0xd2, 0x9a, 0xb4, 0x1e, 0x2d, 0xc8, 0x3f, 0x3d, 0xdd, 0x5c, 0x14, 0xbe,
-	0xf3, 0x1c, 0xa9, 0x51, 0xc7, 0xb2, 0x9b, 0xaa, 0x4f, 0x44, 0xe7, 0x20, 0x74, 0x84, 0xad, 0xe6,
-	0x01, 0x95, 0x03, 0x9e, 0x89, 0x2a, 0x4e, 0x68, 0x05, 0x22, 0x6d, 0x4c, 0x0d, 0xd3, 0xa0, 0x86,
-	0x3c, 0xcb, 0x90, 0xfe, 0x19, 0x6d, 0x43, 0xc4, 0x30, 0x4d, 0x6c, 0xea, 0x06, 0x95, 0x83, 0x6b,
-	0xd2, 0x7a, 0x6c, 0x6b, 0x25, 0xcb, 0x63, 0xce, 0xfa, 0x31, 0x67, 0x35, 0x5f, 0x6f, 0x61, 0xe1,
-	0xd9, 0xaf, 0xe9, 0x99, 0x87, 0xbf, 0xa5, 0xa5, 0xc7, 0xaf, 0x9e, 0x6c, 0x48, 0xec, 0x65, 0x6c,
-	0xe6, 0x69, 0xe6, 0x08, 0x16, 0x78, 0xdc, 0x2a, 0xfe, 0xac, 0x8b, 0x5d, 0xfa, 0x5f, 0x85, 0x9f,
-	0xf9, 0x5e, 0x82, 0x25, 0xed, 0xc0, 0xc1, 0xee, 0x01, 0x69, 0x99, 0xdb, 0xb8, 0x61, 0xb9, 0x16,
-	0xb1, 0xab, 0xa4, 0x65, 0x35, 0x7a, 0xe8, 0x3c, 0x44, 0xa9, 0x0f, 0xf1, 0x28, 0xd4, 0xe3, 0x0b,
-	0xf4, 0x31, 0x84, 0x8f, 0x2c, 0xdb, 0x24, 0x47, 0x2e, 0x7b, 0x2e, 0xb6, 0xf5, 0x6e, 0x76, 0xa4,
-	0x5d, 0xb2, 0xc3, 0xfe, 0xf6, 0x38, 0x5b, 0xf5, 0xcd, 0xae, 0x95, 0x7e, 0x78, 0xba, 0x99, 0x9a,
-	0x6e, 0xf3, 0xf9, 0xab, 0x27, 0x1b, 0x19, 0x4e, 0xd9, 0x74, 0xcd, 0x7b, 0xb9, 0x09, 0xa1, 0x66,
-	0x9e, 0x49, 0x20, 0x57, 0xb1, 0xd3, 0xc0, 0x36, 0x35, 0x9a, 0x78, 0x44, 0x47, 0x0a, 0xa0, 0xd3,
-	0xc7, 0x84, 0x90, 0x81, 0x9b, 0xb7, 0xa0, 0x64, 0xe7, 0xdf, 0x29, 0xb9, 0x30, 0xa0, 0x64, 0x52,
-	0xb4, 0x99, 0xef, 0x24, 0xf8, 0xdf, 0x89, 0xcf, 0xa1, 0x5b, 0xb0, 0x70, 0x48, 0xa8, 0x65, 0x37,
-	0xf5, 0x0e, 0x76, 0x2c, 0xc2, 0x6b, 0x12, 0xdb, 0x5a, 0x1e, 0xeb, 0xb7, 0x6d, 0x31, 0x7f, 0xbc,
-	0xdd, 0xbe, 0xec, 0xb7, 0xdb, 0x3c, 0x37, 0xaf, 0x32, 0x6b, 0x74, 0x07, 0x16, 0xdb, 0x96, 0xad,
-	0xe3, 0xfb, 0xb8, 0xd1, 0xf5, 0xd8, 0xbe, 0xd7, 0xc0, 0x29, 0xbd, 0xa2, 0xb6, 0x65, 0x2b, 0xbe,
-	0x13, 0xee, 0x3b, 0xf3, 0xa7, 0x04, 0xd1, 0x4f, 0xbc, 0x44, 0x94, 0xec, 0xbb, 0x04, 0xc5, 0x21,
-	0x60, 0xf1, 0x68, 0x83, 0x6a, 0xc0, 0x32, 0x51, 0x16, 0xe6, 0x0c, 0xb3, 0x6d, 0xd9, 0xbc, 0x4f,
-	0xa7, 0xb4, 0x36, 0xa7, 0x4d, 0x9d, 0x3f, 0x19, 0xc2, 0x87, 0xd8, 0xf1, 0x92, 0xc5, 0xc6, 0x2f,
-	0xa8, 0xfa, 0x47, 0xf4, 0x0e, 0xcc, 0x53, 0x42, 0x8d, 0x96, 0x2e, 0x86, 0x62, 0x8e, 0x59, 0xc6,
-	0xd8, 0xdd, 0x1e, 0x9f, 0x8c, 0x1b, 0x00, 0x0d, 0x07, 0x1b, 0x94, 0x8f, 0x6f, 0xe8, 0xb4, 0xe3,
-	0x1b, 0x15, 0xc6, 0x79, 0x9a, 0xb9, 0x0d, 0x31, 0xa6, 0x57, 0x6c, 0x9f, 0x65, 0x88, 0xb0, 0x3e,
-	0xd0, 0xfb, 0xba, 0xc3, 0xec, 0x5c, 0x32, 0x51, 0x0e, 0x42, 0x6d, 0x46, 0x12, 0x89, 0x5e, 0x1a,
-	0x6b, 0x36, 0xb1, 0x09, 0x04, 0x2d, 0xf3, 0x77, 0x00, 0x12, 0xcc, 0x37, 0xef, 0x06, 0x96, 0xd1,
-	0x37, 0x59, 0x0f, 0x83, 0x31, 0x05, 0x86, 0x63, 0xea, 0x17, 0x64, 0xf6, 0xf4, 0x05, 0x09, 0x4e,
-	0x2e, 0xc8, 0xdc, 0x70, 0x41, 0x0c, 0x48, 0x98, 0xa2, 0xb1, 0xf5, 0x0e, 0xd3, 0x22, 0x52, 0xbe,
-	0x38, 0x96, 0xf2, 0xbc, 0xdd, 0x2b, 0x64, 0x5e, 0x3f, 0x54, 0x6a, 0xdc, 0x1c, 0x1e, 0xf5, 0xe1,
-	0x82, 0x86, 0xdf, 0xbc, 0xa0, 0xd7, 0x22, 0x0f, 0x1e, 0xa5, 0x67, 0xfe, 0x78, 0x94, 0x96, 0x32,
-	0x8f, 0x43, 0x10, 0xa9, 0x3a, 0xa4, 0x43, 0x5c, 0xa3, 0x35, 0xd6, 0xca, 0x3b, 0xb0, 0xc8, 0x93,
-	0xca, 0x05, 0xe9, 0x7e, 0x55, 0x5e, 0xd7, 0xd9, 0xa8, 0x79, 0x5c, 0x51, 0x81, 0x4c, 0x6d, 0xf3,
-	0x8f, 0x20, 0xda, 0x61, 0x31, 0x60, 0xc7, 0x95, 0x83, 0x6b, 0xb3, 0x53, 0x9d, 0x1f, 0x53, 0xd1,
-	0x0e, 0xc4, 0xdc, 0xee, 0x7e, 0xdb, 0xa2, 0xba, 0xf7, 0xd1, 0x65, 0x15, 0x39, 0x55, 0x46, 0x80,
-	0x5b, 0x7b, 0x38, 0xba, 0x00, 0x0b, 0x5c, 0xab, 0x5f, 0xdf, 0x10, 0x4b, 0xc3, 0x3c, 0xbb, 0xdc,
-	0x15, 0x45, 0xbe, 0x34, 0x92, 0x10, 0x9f, 0x1b, 0x66, 0xdc, 0x41, 0xd9, 0xbe, 0xc5, 0x55, 0x08,
-	0xb9, 0xd4, 0xa0, 0x5d, 0x57, 0x8e, 0xac, 0x49, 0xeb, 0xf1, 0xad, 0xf4, 0xd8, 0x40, 0xf8, 0xd9,
-	0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0e, 0xe8, 0xae, 0x65, 0x1b, 0x2d, 0x9d, 0x1a, 0xad, 0x56,
-	0x4f, 0x77, 0xb0, 0xdb, 0x6d, 0x51, 0x39, 0xca, 0x24, 0x9e, 0x1f, 0x73, 0xa2, 0x79, 0x24, 0x95,
-	0x71, 0x0a, 0x51, 0x4f, 0x24, 0x17, 0x98, 0x64, 0x2e, 0x06, 0x40, 0x54, 0x87, 0x33, 0x43, 0x6b,
-	0x56, 0xc7, 0xb6, 0x29, 0xc3, 0x69, 0x13, 0x97, 0x18, 0xdc, 0xb5, 0x8a, 0x6d, 0xa2, 0x2a, 0x24,
-	0xf8, 0xaa, 0x25, 0x8e, 0x1f, 0x6a, 0x8c, 0xe9, 0x7d, 0x6f, 0xa2, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x1c, 0x0f, 0x9d, 0xd1, 0x25, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x62, 0x57, 0x9e, 0x5f, 0x9b,
-	0x9d, 0x34, 0x48, 0x6a, 0x9f, 0x85, 0xde, 0x87, 0x39, 0x6a, 0xd1, 0x16, 0x96, 0x17, 0x58, 0x7b,
-	0x9e, 0xfd, 0xe5, 0xe9, 0x66, 0xe2, 0xf8, 0x9b, 0xb4, 0x76, 0x29, 0xfb, 0xe1, 0x55, 0x95, 0x33,
-	0xd0, 0x26, 0x84, 0xdd, 0x6e, 0xbb, 0x6d, 0x38, 0x3d, 0x39, 0x3e, 0x99, 0xec, 0x73, 0xae, 0x05,
-	0xbd, 0x71, 0xc9, 0x7c, 0x25, 0x41, 0x6c, 0x30, 0x95, 0xab, 0x10, 0xed, 0x61, 0x57, 0x6f, 0x90,
-	0xae, 0x4d, 0xc5, 0x87, 0x37, 0xd2, 0xc3, 0x6e, 0xd1, 0x3b, 0x7b, 0xed, 0x64, 0xec, 0xbb, 0xd4,
-	0xb0, 0x6c, 0x41, 0xe0, 0x7f, 0xb5, 0xcc, 0x8b, 0x4b, 0x4e, 0x5a, 0x86, 0x88, 0x4d, 0x04, 0xce,
-	0x67, 0x22, 0x6c, 0x13, 0x0e, 0x7d, 0x00, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c,
-	0x7d, 0x12, 0x5f, 0x47, 0x09, 0x9b, 0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xf1, 0xfd,
-	0x25, 0x41, 0x70, 0x97, 0x50, 0x8c, 0xd2, 0x10, 0xeb, 0x88, 0x24, 0x1f, 0xaf, 0x68, 0xf0, 0xaf,
-	0xf8, 0x46, 0x3c, 0x24, 0x54, 0x2c, 0xe9, 0xa9, 0x1b, 0x91, 0xd1, 0xd0, 0x15, 0x08, 0x91, 0x8e,
-	0xf7, 0x01, 0x64, 0x51, 0xc6, 0xb7, 0x56, 0xc7, 0x8a, 0xea, 0xbd, 0x5b, 0x61, 0x14, 0x55, 0x50,
-	0xa7, 0xae, 0xd1, 0xb7, 0x38, 0xb8, 0x1b, 0x5f, 0x48, 0x00, 0xc7, 0xcf, 0xa3, 0x55, 0x58, 0xda,
-	0xad, 0x68, 0x8a, 0x5e, 0xa9, 0x6a, 0xa5, 0x4a, 0x59, 0xaf, 0x97, 0x6b, 0x55, 0xa5, 0x58, 0xba,
-	0x5e, 0x52, 0xb6, 0x93, 0x33, 0xe8, 0x2c, 0x24, 0x06, 0xc1, 0xdb, 0x4a, 0x2d, 0x29, 0xa1, 0x25,
-	0x38, 0x3b, 0x78, 0x99, 0x2f, 0xd4, 0xb4, 0x7c, 0xa9, 0x9c, 0x0c, 0x20, 0x04, 0xf1, 0x41, 0xa0,
-	0x5c, 0x49, 0xce, 0xa2, 0xf3, 0x20, 0x0f, 0xdf, 0xe9, 0x7b, 0x25, 0xed, 0x86, 0xbe, 0xab, 0x68,
-	0x95, 0x64, 0x70, 0x25, 0xf8, 0xe0, 0xeb, 0xd4, 0xcc, 0xc6, 0x8f, 0x12, 0xc4, 0x87, 0xa7, 0x1a,
-	0xa5, 0x61, 0xb5, 0xaa, 0x56, 0xaa, 0x95, 0x5a, 0xfe, 0xa6, 0x5e, 0xd3, 0xf2, 0x5a, 0xbd, 0x36,
-	0x12, 0xd9, 0xff, 0x61, 0x79, 0x94, 0x50, 0xab, 0x17, 0x6e, 0x95, 0x34, 0x4d, 0xd9, 0x4e, 0x4a,
-	0xde, 0xb3, 0xa3, 0x70, 0xbe, 0x58, 0x54, 0xaa, 0x1e, 0x1a, 0x38, 0x09, 0x55, 0x95, 0x1d, 0xa5,
-	0xe8, 0xa1, 0xb3, 0x5e, 0x46, 0xc6, 0x6c, 0x0b, 0x15, 0xd5, 0x03, 0x83, 0x27, 0xbd, 0xeb, 0x09,
-	0xda, 0x56, 0xf3, 0x7b, 0xe5, 0xe4, 0x9c, 0x10, 0xf4, 0xad, 0x04, 0xe7, 0x4e, 0x1e, 0x5b, 0xb4,
-	0x0e, 0x17, 0xfb, 0xf6, 0xca, 0xa7, 0x4a, 0xb1, 0xae, 0x55, 0x54, 0x5d, 0x55, 0x6a, 0xf5, 0x9b,
-	0xda, 0x88, 0xc2, 0x8b, 0xb0, 0x36, 0x91, 0x59, 0xae, 0x68, 0xba, 0x5a, 0x2f, 0x27, 0xa5, 0xa9,
-	0xac, 0x5a, 0xbd, 0x58, 0x54, 0x6a, 0xb5, 0x64, 0x60, 0x2a, 0xeb, 0x7a, 0xbe, 0x74, 0xb3, 0xae,
-	0x2a, 0xc9, 0x59, 0x1e, 0x7c, 0x21, 0xfb, 0xec, 0x45, 0x4a, 0x7a, 0xfe, 0x22, 0x25, 0xfd, 0xfe,
-	0x22, 0x25, 0x3d, 0x7c, 0x99, 0x9a, 0x79, 0xfe, 0x32, 0x35, 0xf3, 0xf3, 0xcb, 0xd4, 0xcc, 0x1d,
-	0xd1, 0xf3, 0xae, 0x79, 0x2f, 0x6b, 0x91, 0xdc, 0x7d, 0xfe, 0x6f, 0xe2, 0x7e, 0x88, 0xb5, 0xdf,
-	0x95, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x6e, 0xa9, 0xde, 0x3d, 0x0e, 0x00, 0x00,
+	// 1582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x22, 0xc9,
+	0x15, 0x77, 0x03, 0xe6, 0xe3, 0x61, 0x03, 0x5b, 0xe3, 0xac, 0xdb, 0x1f, 0x0b, 0x0e, 0x3b, 0xda,
+	0x38, 0x8e, 0x0c, 0x5e, 0x6f, 0x94, 0x95, 0x7c, 0x0a, 0xe0, 0x5e, 0x2f, 0x5e, 0x0f, 0xa0, 0x06,
+	0xec, 0xec, 0x5e, 0x5a, 0x6d, 0xba, 0x8c, 0x5b, 0x86, 0x2e, 0xb6, 0xbb, 0xc0, 0xcb, 0x7f, 0xb0,
+	0xca, 0x25, 0x7b, 0xcc, 0x25, 0xca, 0x48, 0x39, 0x24, 0xc7, 0x39, 0x58, 0x39, 0xe4, 0x98, 0xd3,
+	0x28, 0x87, 0x68, 0x34, 0xa7, 0x68, 0x0e, 0x49, 0x34, 0x73, 0x98, 0x9c, 0x92, 0x1c, 0xf2, 0x07,
+	0x44, 0x5d, 0x55, 0xcd, 0xa7, 0xc1, 0xf1, 0xcc, 0x28, 0x17, 0xcb, 0xfd, 0x7e, 0xbf, 0xf7, 0xea,
+	0xfd, 0x5e, 0xbd, 0xf7, 0x0a, 0xd8, 0x68, 0x10, 0xa7, 0x4d, 0x9c, 0x6c, 0xd3, 0x26, 0xdd, 0x4e,
+	0xb6, 0xf7, 0x71, 0x96, 0xf6, 0x3b, 0xd8, 0xc9, 0x74, 0x6c, 0x42, 0x09, 0x8a, 0x73, 0x30, 0xc3,
+	0xc0, 0x4c, 0xef, 0xe3, 0xf5, 0x95, 0x26, 0x69, 0x12, 0x86, 0x65, 0xdd, 0xff, 0x38, 0x6d, 0x3d,
+	0xd9, 0x24, 0xa4, 0xd9, 0xc2, 0x59, 0xf6, 0x75, 0xde, 0xbd, 0xc8, 0x1a, 0x5d, 0x5b, 0xa7, 0x26,
+	0xb1, 0x04, 0x9e, 0x9a, 0xc4, 0xa9, 0xd9, 0xc6, 0x0e, 0xd5, 0xdb, 0x1d, 0x41, 0x58, 0xe3, 0xe7,
+	0x68, 0x3c, 0xb2, 0x38, 0x54, 0x40, 0x93, 0xbe, 0xba, 0xd5, 0x17, 0xd0, 0x7b, 0x7a, 0xdb, 0xb4,
+	0x48, 0x96, 0xfd, 0xe5, 0xa6, 0xf4, 0xef, 0x25, 0x08, 0x3e, 0xc2, 0xed, 0x73, 0x6c, 0xa3, 0x7d,
+	0x08, 0xe9, 0x86, 0x61, 0x63, 0xc7, 0x91, 0xa5, 0x2d, 0x69, 0x3b, 0x92, 0x97, 0x9f, 0xdf, 0xec,
+	0xae, 0x88, 0xd8, 0x39, 0x8e, 0x54, 0xa9, 0x6d, 0x5a, 0x4d, 0xd5, 0x23, 0xa2, 0xf7, 0x21, 0x78,
+	0x8d, 0xcd, 0xe6, 0x25, 0x95, 0x7d, 0xae, 0x8b, 0x2a, 0xbe, 0xd0, 0x3a, 0x84, 0xdb, 0x98, 0xea,
+	0x86, 0x4e, 0x75, 0xd9, 0xcf, 0x90, 0xc1, 0x37, 0x3a, 0x84, 0xb0, 0x6e, 0x18, 0xd8, 0xd0, 0x74,
+	0x2a, 0x07, 0xb6, 0xa4, 0xed, 0xe8, 0xfe, 0x7a, 0x86, 0xe7, 0x9c, 0xf1, 0x72, 0xce, 0xd4, 0x3c,
+	0xbd, 0xf9, 0xe5, 0xa7, 0x7f, 0x4d, 0x2d, 0x7c, 0xf7, 0xb7, 0x94, 0xf4, 0xbb, 0xd7, 0x4f, 0x76,
+	0x24, 0x76, 0x32, 0x36, 0x72, 0x34, 0x7d, 0x0d, 0xcb, 0x3c, 0x6f, 0x15, 0x7f, 0xdd, 0xc5, 0x0e,
+	0xfd, 0x7f, 0xa5, 0x9f, 0xfe, 0xb7, 0x04, 0xab, 0xb5, 0x4b, 0x1b, 0x3b, 0x97, 0xa4, 0x65, 0x1c,
+	0xe2, 0x86, 0xe9, 0x98, 0xc4, 0xaa, 0x90, 0x96, 0xd9, 0xe8, 0xa3, 0x4d, 0x88, 0x50, 0x0f, 0xe2,
+	0x59, 0xa8, 0x43, 0x03, 0xfa, 0x29, 0x84, 0xae, 0x4d, 0xcb, 0x20, 0xd7, 0x0e, 0x3b, 0x2e, 0xba,
+	0xff, 0x51, 0x66, 0xa2, 0x5d, 0x32, 0xe3, 0xf1, 0xce, 0x38, 0x5b, 0xf5, 0xdc, 0xd0, 0x0e, 0x04,
+	0xbf, 0xee, 0x12, 0xbb, 0xdb, 0xe6, 0x59, 0xe5, 0xd1, 0x8b, 0x9b, 0xdd, 0xd8, 0x37, 0xbc, 0x15,
+	0xb7, 0x7a, 0x7b, 0x99, 0xfd, 0xcc, 0x9e, 0x2a, 0x18, 0x07, 0xc5, 0x3f, 0xdd, 0xec, 0x26, 0xe7,
+	0xc7, 0xff, 0xf9, 0xeb, 0x27, 0x3b, 0x69, 0x4e, 0xd9, 0x75, 0x8c, 0xab, 0xec, 0x0c, 0x59, 0xe9,
+	0xa7, 0x12, 0xc8, 0x15, 0x6c, 0x37, 0xb0, 0x45, 0xf5, 0x26, 0x9e, 0xd0, 0x9c, 0x04, 0xe8, 0x0c,
+	0x30, 0x21, 0x7a, 0xc4, 0xf2, 0xf6, 0xaa, 0x0f, 0x8e, 0xff, 0x37, 0x25, 0x1f, 0x8e, 0x28, 0x99,
+	0x95, 0x6d, 0xfa, 0x8f, 0x12, 0x7c, 0xef, 0xd6, 0xe3, 0xd0, 0x23, 0x58, 0xee, 0x11, 0x6a, 0x5a,
+	0x4d, 0xad, 0x83, 0x6d, 0x93, 0xf0, 0xfb, 0x8b, 0xee, 0xaf, 0x4d, 0xf5, 0xe6, 0xa1, 0x98, 0x55,
+	0xde, 0x9a, 0xbf, 0x1c, 0xb4, 0xe6, 0x12, 0x77, 0xaf, 0x30, 0x6f, 0xf4, 0x15, 0xac, 0xb4, 0x4d,
+	0x4b, 0xc3, 0xdf, 0xe0, 0x46, 0xd7, 0x65, 0x7b, 0x51, 0x7d, 0xf7, 0x8c, 0x8a, 0xda, 0xa6, 0xa5,
+	0x78, 0x41, 0x78, 0xec, 0xf4, 0x3f, 0x25, 0x88, 0x1c, 0xb9, 0x85, 0x28, 0x5a, 0x17, 0x04, 0xc5,
+	0xc0, 0x67, 0xf2, 0x6c, 0x03, 0xaa, 0xcf, 0x34, 0x50, 0x06, 0x16, 0x75, 0xa3, 0x6d, 0x5a, 0xb2,
+	0xef, 0x8e, 0x31, 0xe0, 0xb4, 0xb9, 0xb3, 0x2a, 0x43, 0xa8, 0x87, 0x6d, 0xb7, 0x58, 0x6c, 0x54,
+	0x03, 0xaa, 0xf7, 0x89, 0xbe, 0x0f, 0x4b, 0x94, 0x50, 0xbd, 0xa5, 0x89, 0x01, 0x5a, 0x64, 0x9e,
+	0x51, 0x66, 0x3b, 0xe3, 0x53, 0xf4, 0x39, 0x40, 0xc3, 0xc6, 0x3a, 0xe5, 0xa3, 0x1e, 0xbc, 0xef,
+	0xa8, 0x47, 0x84, 0x73, 0x8e, 0xa6, 0xbf, 0x84, 0x28, 0xd3, 0x2b, 0x36, 0xd5, 0x1a, 0x84, 0x59,
+	0x1f, 0x68, 0x03, 0xdd, 0x21, 0xf6, 0x5d, 0x34, 0x50, 0x16, 0x82, 0x6d, 0x46, 0x12, 0x85, 0x5e,
+	0x9d, 0x6a, 0x36, 0xb1, 0x35, 0x04, 0x2d, 0xfd, 0x5b, 0x3f, 0xc4, 0x59, 0x6c, 0xde, 0x0d, 0xac,
+	0xa2, 0x6f, 0xb2, 0x4a, 0x46, 0x73, 0xf2, 0x8d, 0xe7, 0x34, 0xb8, 0x10, 0xff, 0xfd, 0x2f, 0x24,
+	0x30, 0xfb, 0x42, 0x16, 0xc7, 0x2f, 0x44, 0x87, 0xb8, 0x21, 0x1a, 0x5b, 0xeb, 0x30, 0x2d, 0xa2,
+	0xe4, 0x2b, 0x53, 0x25, 0xcf, 0x59, 0xfd, 0x7c, 0xfa, 0xee, 0xa1, 0x52, 0x63, 0xc6, 0xf8, 0xa8,
+	0x8f, 0x5f, 0x68, 0xe8, 0xcd, 0x2f, 0x14, 0x6d, 0xc3, 0x62, 0x4b, 0x3f, 0xc7, 0x2d, 0x39, 0x3c,
+	0x73, 0x8f, 0x71, 0xc2, 0x41, 0xf8, 0xdb, 0xc7, 0xa9, 0x85, 0x7f, 0x3c, 0x4e, 0x49, 0xe9, 0x7f,
+	0x05, 0x21, 0x5c, 0xb1, 0x49, 0x87, 0x38, 0x7a, 0x6b, 0xaa, 0xe9, 0x8f, 0x61, 0x85, 0x97, 0x9f,
+	0x4b, 0xd7, 0xbc, 0xfb, 0xbb, 0x6b, 0x06, 0x50, 0x73, 0x78, 0xf7, 0x02, 0x99, 0x3b, 0x10, 0x3f,
+	0x81, 0x48, 0x87, 0xe5, 0x80, 0x6d, 0x47, 0x0e, 0x6c, 0xf9, 0xe7, 0x06, 0x1f, 0x52, 0xd1, 0x31,
+	0x44, 0x9d, 0xee, 0x79, 0xdb, 0xa4, 0x9a, 0xfb, 0x94, 0xcb, 0x8b, 0xf7, 0xad, 0x1d, 0x70, 0x6f,
+	0x17, 0x47, 0x1f, 0xc2, 0x32, 0xd7, 0xea, 0x75, 0x42, 0x90, 0x95, 0x61, 0x89, 0x19, 0x4f, 0x45,
+	0x3b, 0xec, 0x4d, 0x14, 0xc4, 0xe3, 0x86, 0x18, 0x77, 0x54, 0xb6, 0xe7, 0xf1, 0x29, 0x04, 0x1d,
+	0xaa, 0xd3, 0xae, 0xc3, 0x2e, 0x25, 0xb6, 0x9f, 0x9a, 0x1a, 0x1d, 0xaf, 0xfa, 0x55, 0x46, 0x53,
+	0x05, 0x1d, 0xd5, 0x01, 0x5d, 0x98, 0x96, 0xde, 0xd2, 0xa8, 0xde, 0x6a, 0xf5, 0x35, 0x1b, 0x3b,
+	0xdd, 0x16, 0x95, 0x23, 0x4c, 0xe2, 0xe6, 0x54, 0x90, 0x9a, 0x4b, 0x52, 0x19, 0x27, 0x1f, 0x71,
+	0x45, 0x72, 0x81, 0x09, 0x16, 0x62, 0x04, 0x44, 0x75, 0x78, 0x6f, 0x6c, 0x21, 0x6b, 0xd8, 0x32,
+	0x64, 0xb8, 0x6f, 0xe1, 0xe2, 0xa3, 0x5b, 0x59, 0xb1, 0x0c, 0x54, 0x81, 0x38, 0x5f, 0xca, 0xc4,
+	0xf6, 0x52, 0x8d, 0x32, 0xbd, 0x3f, 0x98, 0xa9, 0x57, 0x11, 0x7c, 0x9e, 0x98, 0x1a, 0xc3, 0x63,
+	0xdf, 0x68, 0xcf, 0xed, 0x17, 0xc7, 0xd1, 0x9b, 0xd8, 0x91, 0x97, 0xb6, 0xfc, 0xb3, 0x46, 0x4e,
+	0x1d, 0xb0, 0xd0, 0x0f, 0x61, 0x91, 0x9a, 0xb4, 0x85, 0xe5, 0x65, 0xd6, 0x9e, 0x0f, 0x5e, 0xdc,
+	0xec, 0xc6, 0x87, 0xaf, 0xd7, 0xd6, 0x5e, 0xe6, 0xc7, 0x9f, 0xaa, 0x9c, 0x81, 0x76, 0x21, 0xe4,
+	0x74, 0xdb, 0x6d, 0xdd, 0xee, 0xcb, 0xb1, 0xd9, 0x64, 0x8f, 0x83, 0x14, 0x08, 0x5c, 0x99, 0x96,
+	0x21, 0xc7, 0x99, 0xa4, 0x0f, 0x66, 0x4a, 0xfa, 0xc2, 0xb4, 0x8c, 0x5b, 0xc7, 0x8e, 0xb9, 0x1f,
+	0x04, 0xdc, 0xa9, 0x4b, 0xff, 0x4a, 0x82, 0xe8, 0xe8, 0x8d, 0x6c, 0x40, 0xa4, 0x8f, 0x1d, 0xad,
+	0x41, 0xba, 0x16, 0x15, 0x2f, 0x7d, 0xb8, 0x8f, 0x9d, 0x82, 0xfb, 0xed, 0x76, 0xa5, 0x7e, 0xee,
+	0x50, 0xdd, 0xb4, 0x04, 0x81, 0xff, 0xa4, 0x5a, 0x12, 0x46, 0x4e, 0x5a, 0x83, 0xb0, 0x45, 0x04,
+	0xce, 0x47, 0x2b, 0x64, 0x11, 0x0e, 0xfd, 0x08, 0x90, 0x45, 0xb4, 0x6b, 0x93, 0x5e, 0x6a, 0x3d,
+	0x4c, 0x3d, 0x12, 0xdf, 0x7f, 0x71, 0x8b, 0x9c, 0x99, 0xf4, 0xf2, 0x14, 0x53, 0x4e, 0x16, 0xf9,
+	0xfd, 0x47, 0x82, 0xc0, 0x29, 0xa1, 0x18, 0xa5, 0x20, 0xda, 0x11, 0xc2, 0x86, 0x6f, 0x02, 0x78,
+	0x26, 0xbe, 0x82, 0x7b, 0x84, 0x8a, 0x57, 0x61, 0xee, 0x0a, 0x66, 0x34, 0xf4, 0x09, 0x04, 0x49,
+	0xc7, 0x7d, 0x71, 0x59, 0x96, 0xb1, 0xfd, 0x8d, 0xa9, 0x42, 0xba, 0xe7, 0x96, 0x19, 0x45, 0x15,
+	0xd4, 0xb9, 0x7b, 0xfb, 0x1d, 0xce, 0x7f, 0xfa, 0xc6, 0x07, 0x89, 0xf2, 0xc5, 0x45, 0xe3, 0x52,
+	0x37, 0xad, 0x5c, 0xa7, 0x63, 0x93, 0x9e, 0xde, 0xba, 0xbb, 0x04, 0x7b, 0x63, 0x2f, 0xe3, 0xbc,
+	0x1a, 0x08, 0xde, 0xbb, 0x2f, 0xc2, 0x26, 0x44, 0x1c, 0xb3, 0x69, 0xe9, 0xb4, 0x6b, 0xf3, 0x12,
+	0x2c, 0xa9, 0x43, 0xc3, 0x64, 0x89, 0x82, 0x6f, 0x51, 0xa2, 0x03, 0xf4, 0x7c, 0xaa, 0xb3, 0xd3,
+	0xbf, 0xf6, 0x7e, 0x35, 0x9d, 0x98, 0xd6, 0x15, 0xfa, 0x08, 0xe2, 0x1d, 0xdd, 0xc6, 0x16, 0xd5,
+	0x26, 0x7e, 0x4a, 0x2c, 0x73, 0xf3, 0x91, 0x78, 0xbc, 0xef, 0x5f, 0xb6, 0x87, 0x10, 0x6b, 0x5c,
+	0x9a, 0x2d, 0x63, 0x18, 0xd8, 0xcf, 0xf7, 0x33, 0xb3, 0x8a, 0xb8, 0xb7, 0x65, 0xb8, 0xf3, 0x0b,
+	0x09, 0x60, 0x58, 0x52, 0xb4, 0x01, 0xab, 0xa7, 0xe5, 0x9a, 0xa2, 0x95, 0x2b, 0xb5, 0x62, 0xb9,
+	0xa4, 0xd5, 0x4b, 0xd5, 0x8a, 0x52, 0x28, 0x7e, 0x56, 0x54, 0x0e, 0x13, 0x0b, 0xe8, 0x01, 0xc4,
+	0x47, 0xc1, 0x2f, 0x95, 0x6a, 0x42, 0x42, 0xab, 0xf0, 0x60, 0xd4, 0x98, 0xcb, 0x57, 0x6b, 0xb9,
+	0x62, 0x29, 0xe1, 0x43, 0x08, 0x62, 0xa3, 0x40, 0xa9, 0x9c, 0xf0, 0xa3, 0x4d, 0x90, 0xc7, 0x6d,
+	0xda, 0x59, 0xb1, 0xf6, 0xb9, 0x76, 0xaa, 0xd4, 0xca, 0x89, 0xc0, 0x7a, 0xe0, 0xdb, 0xdf, 0x24,
+	0x17, 0x76, 0x2c, 0x58, 0x1a, 0xdd, 0x18, 0xe8, 0x03, 0x58, 0xab, 0xa8, 0xe5, 0x4a, 0xb9, 0x9a,
+	0x3b, 0xd1, 0xbe, 0x28, 0x96, 0x0e, 0x27, 0x92, 0xda, 0x04, 0x79, 0x1c, 0x56, 0x7e, 0xa6, 0x14,
+	0xea, 0xb5, 0x5c, 0xfe, 0x44, 0x49, 0x48, 0xae, 0x9e, 0x71, 0xb4, 0x5a, 0x3c, 0x2a, 0xe5, 0x4e,
+	0x8a, 0xa5, 0xa3, 0x84, 0x4f, 0x9c, 0xf7, 0x67, 0x09, 0x62, 0xe3, 0xaf, 0x0c, 0x4a, 0xc1, 0xc6,
+	0xc0, 0xab, 0x5a, 0xcb, 0xd5, 0xea, 0xd5, 0x89, 0x43, 0x47, 0x73, 0x12, 0x84, 0x6a, 0x3d, 0xff,
+	0xa8, 0x58, 0xab, 0x29, 0x87, 0x09, 0x69, 0x2c, 0x27, 0x01, 0xe7, 0x0a, 0x05, 0xa5, 0xe2, 0xa2,
+	0xbe, 0xdb, 0x50, 0x55, 0x39, 0x56, 0x0a, 0x2e, 0xea, 0x1f, 0xcb, 0xd8, 0xf3, 0xcd, 0x97, 0x55,
+	0x17, 0x0c, 0xdc, 0x76, 0xae, 0x5b, 0xc0, 0x43, 0x35, 0x77, 0x56, 0x4a, 0x2c, 0x0a, 0x41, 0x7f,
+	0x90, 0xe0, 0xfd, 0xdb, 0x9f, 0x11, 0xb4, 0x0d, 0x0f, 0x07, 0xfe, 0xbc, 0x4e, 0x65, 0x55, 0x53,
+	0x95, 0x6a, 0xfd, 0xa4, 0x36, 0xa1, 0xf0, 0x21, 0x6c, 0xcd, 0x64, 0x96, 0xca, 0x35, 0x4d, 0xad,
+	0x97, 0x12, 0xd2, 0x5c, 0x56, 0xb5, 0x5e, 0x28, 0x28, 0xd5, 0x6a, 0xc2, 0x37, 0x97, 0xf5, 0x59,
+	0xae, 0x78, 0x52, 0x57, 0x95, 0x84, 0x9f, 0x27, 0x9f, 0xcf, 0x3c, 0x7d, 0x99, 0x94, 0x9e, 0xbd,
+	0x4c, 0x4a, 0x7f, 0x7f, 0x99, 0x94, 0xbe, 0x7b, 0x95, 0x5c, 0x78, 0xf6, 0x2a, 0xb9, 0xf0, 0x97,
+	0x57, 0xc9, 0x85, 0xaf, 0xc4, 0x04, 0x38, 0xc6, 0x55, 0xc6, 0x24, 0x59, 0xd1, 0xc6, 0xe7, 0x41,
+	0x36, 0xa4, 0x9f, 0xfc, 0x77, 0x00, 0x10, 0xc6, 0xe9, 0xef, 0x23, 0x11, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1046,6 +1276,9 @@ func (this *GroupPolicyInfo) Equal(that interface{}) bool {
 	if !this.CreatedAt.Equal(that1.CreatedAt) {
 		return false
 	}
+	if this.Label != that1.Label {
+		return false
+	}
 	return true
 }
 func (m *Member) Marshal() (dAtA []byte, err error) {
@@ -1164,6 +1397,13 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Windows != nil {
 		{
 			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
@@ -1389,6 +1629,13 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x42
+	}
 	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
 	if err8 != nil {
 		return 0, err8
@@ -1463,6 +1710,11 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1674,6 +1926,108 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OffchainApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OffchainApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OffchainApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChildGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChildGroupId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ParentGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1743,6 +2097,10 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 		l = m.Windows.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1851,6 +2209,10 @@ func (m *GroupPolicyInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1909,6 +2271,9 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Kind != 0 {
+		n += 1 + sovTypes(uint64(m.Kind))
+	}
 	return n
 }
 
@@ -1962,6 +2327,54 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *OffchainApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GroupLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.ParentGroupId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ChildGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.ChildGroupId))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2390,11 +2803,43 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
@@ -3199,6 +3644,38 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3637,6 +4114,25 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProposalKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4021,6 +4517,345 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OffchainApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OffchainApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OffchainApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGroupId", wireType)
+			}
+			m.ParentGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildGroupId", wireType)
+			}
+			m.ChildGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,6 +41,39 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"quorum",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Quorum:    "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+			false,
+		},
+		{
+			"quorum greater than 1",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Quorum:    "1.1",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+			true,
+		},
+		{
+			"negative quorum",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Quorum:    "-0.1",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Hour,
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -373,6 +406,72 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"YesCount >= threshold, quorum not reached",
+			&group.ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Second * 100,
+				},
+			},
+			&group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "0",
+				AbstainCount:    "0",
+				NoWithVetoCount: "0",
+			},
+			"5",
+			time.Second * 50,
+			group.DecisionPolicyResult{
+				Allow: false,
+				Final: false,
+			},
+			false,
+		},
+		{
+			"YesCount >= threshold, quorum reached with other votes",
+			&group.ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Second * 100,
+				},
+			},
+			&group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "0",
+				AbstainCount:    "1",
+				NoWithVetoCount: "0",
+			},
+			"5",
+			time.Second * 50,
+			group.DecisionPolicyResult{
+				Allow: true,
+				Final: true,
+			},
+			false,
+		},
+		{
+			"invalid quorum",
+			&group.ThresholdDecisionPolicy{
+				Threshold: "2",
+				Quorum:    "1.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod: time.Second * 100,
+				},
+			},
+			&group.TallyResult{
+				YesCount:        "2",
+				NoCount:         "0",
+				AbstainCount:    "0",
+				NoWithVetoCount: "0",
+			},
+			"3",
+			time.Second * 50,
+			group.DecisionPolicyResult{},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -64,6 +64,25 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(err)
 	}
 
+	if in.MintFn != nil && in.InflationCalculationFn != nil {
+		panic("MintFn and InflationCalculationFn cannot both be set")
+	}
+
+	// if no mintFn is provided, the keeper uses the default minting function
+	var opts []keeper.Option
+	if in.MintFn != nil {
+		opts = append(opts, keeper.WithMintFn(in.MintFn, types.MintFunction{}))
+	}
+
+	// if no inflationCalculationFn is provided, the keeper uses the default inflation calculation function
+	if in.InflationCalculationFn != nil {
+		opts = append(opts, keeper.WithInflationCalculationFn(types.CustomMintFnName, in.InflationCalculationFn, nil))
+	}
+
+	if in.Config.EpochIdentifier != "" {
+		opts = append(opts, keeper.WithEpochMinting(in.Config.EpochIdentifier, in.Config.EpochsPerYear))
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Environment,
@@ -72,23 +91,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		feeCollectorName,
 		as,
+		opts...,
 	)
 
-	if in.MintFn != nil && in.InflationCalculationFn != nil {
-		panic("MintFn and InflationCalculationFn cannot both be set")
-	}
-
-	// if no mintFn is provided, use the default minting function
-	if in.MintFn == nil {
-		// if no inflationCalculationFn is provided, use the default inflation calculation function
-		if in.InflationCalculationFn == nil {
-			in.InflationCalculationFn = types.DefaultInflationCalculationFn
-		}
-
-		in.MintFn = k.DefaultMintFn(in.InflationCalculationFn)
-	}
-
-	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.MintFn)
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, nil)
 
 	return ModuleOutputs{MintKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: m}}
 }
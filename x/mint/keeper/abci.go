@@ -22,7 +22,7 @@ func (k Keeper) BeginBlocker(ctx context.Context, mintFn types.MintFn) error {
 
 	// we pass -1 as epoch number to indicate that this is not an epoch minting,
 	// but a regular block minting. Same with epoch id "block".
-	err = mintFn(ctx, k.Environment, &minter, "block", -1)
+	err = mintFn(ctx, k.Environment, &minter, types.BlockEpochIdentifier, -1)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,10 @@ func (keeper Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, da
 		return err
 	}
 
+	if err := keeper.validateDestinations(data.Params.Destinations); err != nil {
+		return err
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -23,7 +23,7 @@ func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*
 		return nil, err
 	}
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, MintFunction: q.k.mintFunction}, nil
 }
 
 // Inflation returns minter.Inflation of the mint module.
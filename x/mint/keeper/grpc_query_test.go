@@ -71,6 +71,7 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	kparams, err := suite.mintKeeper.Params.Get(suite.ctx)
 	suite.Require().NoError(err)
 	suite.Require().Equal(params.Params, kparams)
+	suite.Require().Equal(types.MintFunction{Name: types.DefaultMintFnName, EpochIdentifier: types.BlockEpochIdentifier}, params.MintFunction)
 
 	inflation, err := suite.queryClient.Inflation(gocontext.Background(), &types.QueryInflationRequest{})
 	suite.Require().NoError(err)
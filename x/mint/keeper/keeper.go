@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/mint/types"
 
@@ -21,12 +22,30 @@ type Keeper struct {
 
 	cdc              codec.BinaryCodec
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
 
+	// mintFn is the custom mint function set by WithMintFn, nil when the SDK's
+	// mint function is used.
+	mintFn types.MintFn
+	// inflationFn is the inflation calculation function of the SDK's mint
+	// function set by WithInflationCalculationFn.
+	inflationFn types.InflationCalculationFn
+	// epochIdentifier is the identifier of the epochs at the start of which the
+	// SDK's mint function mints, set by WithEpochMinting.
+	epochIdentifier string
+	// epochsPerYear is the number of epochs per year set by WithEpochMinting.
+	epochsPerYear uint64
+	// mintFunction describes the mint function in Query/Params.
+	mintFunction types.MintFunction
+	// blockedDestinations are the module accounts set by WithBlockedDestinations
+	// which cannot receive minted coins.
+	blockedDestinations map[string]struct{}
+
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 	Minter collections.Item[types.Minter]
@@ -41,6 +60,7 @@ func NewKeeper(
 	bk types.BankKeeper,
 	feeCollectorName string,
 	authority string,
+	opts ...Option,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -52,11 +72,25 @@ func NewKeeper(
 		Environment:      env,
 		cdc:              cdc,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		epochIdentifier:  types.BlockEpochIdentifier,
+		mintFunction: types.MintFunction{
+			Name:            types.DefaultMintFnName,
+			EpochIdentifier: types.BlockEpochIdentifier,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	if err := k.validateMintFnOptions(); err != nil {
+		panic(err)
 	}
 
 	schema, err := sb.Build()
@@ -101,11 +135,55 @@ func (k Keeper) AddCollectedFees(ctx context.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
 
+// DistributeMintedCoins sends to every module account of the destinations param
+// its proportion of the minted coins, and the remainder to the fee collector.
+func (k Keeper) DistributeMintedCoins(ctx context.Context, mintedCoins sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := mintedCoins
+	for _, destination := range params.Destinations {
+		share, _ := sdk.NewDecCoinsFromCoins(mintedCoins...).MulDecTruncate(destination.Proportion).TruncateDecimal()
+		if share.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.ModuleName, share); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(share...)
+	}
+
+	return k.AddCollectedFees(ctx, remaining)
+}
+
+// validateDestinations checks that the destinations of the minted coins are
+// module accounts, which can receive coins from the mint module account, and
+// that none of them was blocked by WithBlockedDestinations.
+func (k Keeper) validateDestinations(destinations []types.MintDestination) error {
+	for _, destination := range destinations {
+		if _, ok := k.blockedDestinations[destination.ModuleName]; ok {
+			return errors.Wrapf(types.ErrInvalidDestination, "module account %s cannot receive minted coins", destination.ModuleName)
+		}
+		if k.authKeeper.GetModuleAddress(destination.ModuleName) == nil {
+			return errors.Wrapf(types.ErrInvalidDestination, "module account %s does not exist", destination.ModuleName)
+		}
+	}
+
+	return nil
+}
+
+// DefaultMintFn returns the SDK's mint function computing the inflation rate
+// with the given function. It mints every block, or at the start of every
+// epoch when the keeper was created with WithEpochMinting.
 func (k Keeper) DefaultMintFn(ic types.InflationCalculationFn) types.MintFn {
 	return func(ctx context.Context, env appmodule.Environment, minter *types.Minter, epochId string, epochNumber int64) error {
-		// the default mint function is called every block, so we only check if epochId is "block" which is
-		// a special value to indicate that this is not an epoch minting, but a regular block minting.
-		if epochId != "block" {
+		// the default mint function is called every block with the "block" epochId, which is a
+		// special value to indicate that this is not an epoch minting but a regular block minting,
+		// and at the start of every epoch. Only the configured one mints.
+		if epochId != k.epochIdentifier {
 			return nil
 		}
 
@@ -124,10 +202,20 @@ func (k Keeper) DefaultMintFn(ic types.InflationCalculationFn) types.MintFn {
 			return err
 		}
 
-		minter.Inflation = ic(ctx, *minter, params, bondedRatio)
+		// with epoch minting, the inflation rate is updated once per epoch, so
+		// its step is scaled to an epoch instead of a block.
+		icParams := params
+		if k.epochIdentifier != types.BlockEpochIdentifier {
+			icParams = params.PerEpoch(k.epochsPerYear)
+		}
+		minter.Inflation = ic(ctx, *minter, icParams, bondedRatio)
+		minter.LastBondedRatio = bondedRatio
 		minter.AnnualProvisions = minter.NextAnnualProvisions(params, stakingTokenSupply)
 
 		mintedCoin := minter.BlockProvision(params)
+		if k.epochIdentifier != types.BlockEpochIdentifier {
+			mintedCoin = minter.EpochProvision(params, k.epochsPerYear)
+		}
 		mintedCoins := sdk.NewCoins(mintedCoin)
 		maxSupply := params.MaxSupply
 		totalSupply := stakingTokenSupply
@@ -162,9 +250,8 @@ func (k Keeper) DefaultMintFn(ic types.InflationCalculationFn) types.MintFn {
 			}
 		}
 
-		// send the minted coins to the fee collector account
-		// TODO: figure out a better way to do this
-		err = k.AddCollectedFees(ctx, mintedCoins)
+		// send the minted coins to the destinations and the fee collector account
+		err = k.DistributeMintedCoins(ctx, mintedCoins)
 		if err != nil {
 			return err
 		}
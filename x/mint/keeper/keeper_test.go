@@ -34,6 +34,7 @@ type KeeperTestSuite struct {
 	msgServer     types.MsgServer
 	stakingKeeper *minttestutil.MockStakingKeeper
 	bankKeeper    *minttestutil.MockBankKeeper
+	accountKeeper *minttestutil.MockAccountKeeper
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -67,6 +68,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	)
 	s.stakingKeeper = stakingKeeper
 	s.bankKeeper = bankKeeper
+	s.accountKeeper = accountKeeper
 
 	err := s.mintKeeper.Params.Set(s.ctx, types.DefaultParams())
 	s.NoError(err)
@@ -98,6 +100,33 @@ func (s *KeeperTestSuite) TestAliasFunctions() {
 	s.Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
 
+func (s *KeeperTestSuite) TestDistributeMintedCoins() {
+	fees := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1001)))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
+	s.NoError(s.mintKeeper.DistributeMintedCoins(s.ctx, fees))
+
+	params, err := s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+	params.Destinations = []types.MintDestination{
+		{ModuleName: "protocolpool", Proportion: math.LegacyNewDecWithPrec(15, 2)},
+		{ModuleName: "developers", Proportion: math.LegacyNewDecWithPrec(5, 2)},
+	}
+	s.NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	gomock.InOrder(
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, "protocolpool", sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(150)))).Return(nil),
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, "developers", sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(50)))).Return(nil),
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(801)))).Return(nil),
+	)
+	s.NoError(s.mintKeeper.DistributeMintedCoins(s.ctx, fees))
+
+	// shares truncated to zero are not sent
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(6)))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, "protocolpool", gomock.Any()).Times(0)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, "developers", gomock.Any()).Times(0)
+	s.NoError(s.mintKeeper.DistributeMintedCoins(s.ctx, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(6)))))
+}
+
 func (s *KeeperTestSuite) TestDefaultMintFn() {
 	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
 	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
@@ -110,6 +139,7 @@ func (s *KeeperTestSuite) TestDefaultMintFn() {
 
 	err = s.mintKeeper.DefaultMintFn(types.DefaultInflationCalculationFn)(s.ctx, s.mintKeeper.Environment, &minter, "block", 0)
 	s.NoError(err)
+	s.Equal(bondedRatio, minter.LastBondedRatio)
 
 	// set a maxsupply and call again
 	params, err := s.mintKeeper.Params.Get(s.ctx)
@@ -136,6 +166,83 @@ func (s *KeeperTestSuite) TestDefaultMintFn() {
 	s.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestMintFnOptions() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, mint.AppModule{})
+	accountKeeper := minttestutil.NewMockAccountKeeper(gomock.NewController(s.T()))
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{}).AnyTimes()
+	newKeeper := func(opts ...keeper.Option) keeper.Keeper {
+		return keeper.NewKeeper(encCfg.Codec, s.mintKeeper.Environment, s.stakingKeeper, accountKeeper, s.bankKeeper, authtypes.FeeCollectorName, govModuleNameStr, opts...)
+	}
+
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(15, 2), nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).AnyTimes()
+
+	params, err := s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+
+	s.Equal(types.MintFunction{Name: types.DefaultMintFnName, EpochIdentifier: types.BlockEpochIdentifier}, newKeeper().MintFunction())
+
+	// the epoch based mint function only mints at the start of its epochs
+	k := newKeeper(keeper.WithEpochMinting("day", 365))
+	s.Equal(types.MintFunction{
+		Name:            types.DefaultMintFnName,
+		EpochIdentifier: "day",
+		Parameters:      map[string]string{types.EpochsPerYearParameter: "365"},
+	}, k.MintFunction())
+
+	minter := types.DefaultInitialMinter()
+	s.NoError(k.MintFn()(s.ctx, k.Environment, &minter, types.BlockEpochIdentifier, -1))
+	s.NoError(k.MintFn()(s.ctx, k.Environment, &minter, "week", 1))
+	s.Equal(types.DefaultInitialMinter(), minter)
+
+	var minted sdk.Coins
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, coins sdk.Coins) error {
+		minted = coins
+		return nil
+	})
+	minter.Inflation = math.LegacyNewDecWithPrec(3, 2)
+	previous := minter
+	s.NoError(k.MintFn()(s.ctx, k.Environment, &minter, "day", 1))
+	s.Equal(sdk.NewCoins(minter.EpochProvision(params, 365)), minted)
+	// the inflation rate changes once per epoch by the change of a whole epoch
+	s.Equal(previous.NextInflationRate(params.PerEpoch(365), math.LegacyNewDecWithPrec(15, 2)), minter.Inflation)
+	s.NotEqual(previous.NextInflationRate(params, math.LegacyNewDecWithPrec(15, 2)), minter.Inflation)
+
+	// a custom inflation calculation function is used by the default mint function
+	fixedInflation := math.LegacyNewDecWithPrec(1, 1)
+	k = newKeeper(keeper.WithInflationCalculationFn("fixed", func(context.Context, types.Minter, types.Params, math.LegacyDec) math.LegacyDec {
+		return fixedInflation
+	}, map[string]string{"inflation": fixedInflation.String()}))
+	s.Equal(types.MintFunction{
+		Name:            "fixed",
+		EpochIdentifier: types.BlockEpochIdentifier,
+		Parameters:      map[string]string{"inflation": fixedInflation.String()},
+	}, k.MintFunction())
+
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, gomock.Any()).Return(nil)
+	minter = types.DefaultInitialMinter()
+	s.NoError(k.MintFn()(s.ctx, k.Environment, &minter, types.BlockEpochIdentifier, -1))
+	s.Equal(fixedInflation, minter.Inflation)
+
+	// a custom mint function replaces the default one
+	called := false
+	k = newKeeper(keeper.WithMintFn(func(context.Context, appmodule.Environment, *types.Minter, string, int64) error {
+		called = true
+		return nil
+	}, types.MintFunction{}))
+	s.Equal(types.MintFunction{Name: types.CustomMintFnName}, k.MintFunction())
+	s.NoError(k.MintFn()(s.ctx, k.Environment, &minter, types.BlockEpochIdentifier, -1))
+	s.True(called)
+
+	// inconsistent options are rejected
+	s.Panics(func() {
+		newKeeper(keeper.WithMintFn(k.MintFn(), types.MintFunction{}), keeper.WithEpochMinting("day", 365))
+	})
+	s.Panics(func() { newKeeper(keeper.WithEpochMinting("day", 0)) })
+	s.Panics(func() { newKeeper(keeper.WithEpochMinting(types.BlockEpochIdentifier, 365)) })
+}
+
 func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
 	bondedRatio := math.LegacyNewDecWithPrec(15, 2)
@@ -182,4 +289,22 @@ func (s *KeeperTestSuite) TestMigrator() {
 	newParams, err := s.mintKeeper.Params.Get(s.ctx)
 	s.NoError(err)
 	s.Equal(math.ZeroInt(), newParams.MaxSupply)
+
+	// unset the inflation controller fields and migrate (should get them to zero)
+	newParams.ProportionalGain = math.LegacyDec{}
+	newParams.MaxInflationStep = math.LegacyDec{}
+	s.NoError(s.mintKeeper.Params.Set(s.ctx, newParams))
+	s.NoError(s.mintKeeper.Minter.Set(s.ctx, types.Minter{Inflation: math.LegacyNewDecWithPrec(13, 2), AnnualProvisions: math.LegacyZeroDec()}))
+
+	s.NoError(m.Migrate3to4(s.ctx))
+
+	newParams, err = s.mintKeeper.Params.Get(s.ctx)
+	s.NoError(err)
+	s.NoError(newParams.Validate())
+	s.True(newParams.ProportionalGain.IsZero())
+	s.True(newParams.MaxInflationStep.IsZero())
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+	s.True(minter.LastBondedRatio.IsZero())
 }
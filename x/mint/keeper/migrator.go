@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/mint/types"
 )
 
@@ -46,3 +47,28 @@ func (m Migrator) Migrate2to3(ctx context.Context) error {
 
 	return nil
 }
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it initializes the new inflation controller
+// parameters to values which keep the previous inflation curve.
+func (m Migrator) Migrate3to4(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaultParams := types.DefaultParams()
+	params.ProportionalGain = defaultParams.ProportionalGain
+	params.MaxInflationStep = defaultParams.MaxInflationStep
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	minter, err := m.keeper.Minter.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	minter.LastBondedRatio = math.LegacyZeroDec()
+	return m.keeper.Minter.Set(ctx, minter)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/x/mint/types"
+)
+
+// Option configures a Keeper when it is created.
+type Option func(*Keeper)
+
+// WithMintFn replaces the SDK's mint function with the given one, so that apps
+// can customize the minting process without forking the module. The given
+// description is exposed in Query/Params, its name defaults to "custom". It
+// cannot be combined with WithInflationCalculationFn or WithEpochMinting, which
+// configure the SDK's mint function.
+func WithMintFn(mintFn types.MintFn, description types.MintFunction) Option {
+	return func(k *Keeper) {
+		if description.Name == "" {
+			description.Name = types.CustomMintFnName
+		}
+
+		k.mintFn = mintFn
+		k.mintFunction = description
+	}
+}
+
+// WithInflationCalculationFn sets the function computing the inflation rate of
+// the SDK's mint function. The given name and parameters describe the function
+// in Query/Params.
+func WithInflationCalculationFn(name string, ic types.InflationCalculationFn, parameters map[string]string) Option {
+	return func(k *Keeper) {
+		k.inflationFn = ic
+		k.mintFunction.Name = name
+		for key, value := range parameters {
+			k.setMintFunctionParameter(key, value)
+		}
+	}
+}
+
+// WithEpochMinting makes the SDK's mint function mint at the start of the
+// epochs with the given identifier instead of every block. The annual
+// provisions are split among the given number of epochs per year. The module
+// must be registered in the epoch hooks of x/epochs, which depinject does.
+func WithEpochMinting(epochIdentifier string, epochsPerYear uint64) Option {
+	return func(k *Keeper) {
+		k.epochIdentifier = epochIdentifier
+		k.epochsPerYear = epochsPerYear
+		k.mintFunction.EpochIdentifier = epochIdentifier
+		k.setMintFunctionParameter(types.EpochsPerYearParameter, strconv.FormatUint(epochsPerYear, 10))
+	}
+}
+
+// WithBlockedDestinations prevents the given module accounts from being set as
+// destinations of the minted coins, e.g. the module accounts whose balance
+// backs the module state. The staking pools are always rejected.
+func WithBlockedDestinations(moduleNames ...string) Option {
+	return func(k *Keeper) {
+		if k.blockedDestinations == nil {
+			k.blockedDestinations = make(map[string]struct{}, len(moduleNames))
+		}
+		for _, name := range moduleNames {
+			k.blockedDestinations[name] = struct{}{}
+		}
+	}
+}
+
+// MintFn returns the mint function configured by the keeper options, the SDK's
+// mint function with the default inflation calculation when none was set.
+func (k Keeper) MintFn() types.MintFn {
+	if k.mintFn != nil {
+		return k.mintFn
+	}
+
+	ic := k.inflationFn
+	if ic == nil {
+		ic = types.DefaultInflationCalculationFn
+	}
+
+	return k.DefaultMintFn(ic)
+}
+
+// MintFunction returns the description of the mint function configured by the
+// keeper options.
+func (k Keeper) MintFunction() types.MintFunction {
+	return k.mintFunction
+}
+
+// validateMintFnOptions checks that the keeper options are consistent.
+func (k Keeper) validateMintFnOptions() error {
+	if k.mintFn != nil {
+		if k.inflationFn != nil || k.epochsPerYear != 0 {
+			return types.ErrInvalidMintFn.Wrap("a custom mint function cannot be combined with the options of the default mint function")
+		}
+		return nil
+	}
+
+	if k.epochIdentifier == "" || k.epochIdentifier == types.BlockEpochIdentifier {
+		if k.epochsPerYear != 0 {
+			return types.ErrInvalidMintFn.Wrapf("invalid epoch identifier %q", k.epochIdentifier)
+		}
+		return nil
+	}
+
+	if k.epochsPerYear == 0 {
+		return types.ErrInvalidMintFn.Wrapf("epochs per year must be positive to mint at the start of the %q epochs", k.epochIdentifier)
+	}
+
+	return nil
+}
+
+func (k *Keeper) setMintFunctionParameter(key, value string) {
+	if k.mintFunction.Parameters == nil {
+		k.mintFunction.Parameters = make(map[string]string)
+	}
+	k.mintFunction.Parameters[key] = value
+}
@@ -31,6 +31,10 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, err
 	}
 
+	if err := ms.validateDestinations(msg.Params.Destinations); err != nil {
+		return nil, err
+	}
+
 	if err := ms.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -2,12 +2,25 @@ package keeper_test
 
 import (
 	sdkmath "cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/mint"
+	"cosmossdk.io/x/mint/keeper"
 	"cosmossdk.io/x/mint/types"
 
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
+	s.accountKeeper.EXPECT().GetModuleAddress("protocolpool").Return(sdk.AccAddress("protocolpool")).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil).AnyTimes()
+
+	validParams := types.DefaultParams()
+	validParams.Destinations = []types.MintDestination{{ModuleName: "protocolpool", Proportion: sdkmath.LegacyNewDecWithPrec(15, 2)}}
+	unknownDestinationParams := types.DefaultParams()
+	unknownDestinationParams.Destinations = []types.MintDestination{{ModuleName: "unknown", Proportion: sdkmath.LegacyNewDecWithPrec(15, 2)}}
+
 	testCases := []struct {
 		name      string
 		request   *types.MsgUpdateParams
@@ -54,10 +67,28 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
 					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
 					MaxSupply:           sdkmath.ZeroInt(), // infinite supply
+					ProportionalGain:    sdkmath.LegacyNewDecWithPrec(5, 2),
+					MaxInflationStep:    sdkmath.LegacyNewDecWithPrec(1, 6),
 				},
 			},
 			expectErr: false,
 		},
+		{
+			name: "set params with a destination which is not a module account",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params:    unknownDestinationParams,
+			},
+			expectErr: true,
+		},
+		{
+			name: "set params with a destination",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params:    validParams,
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -72,3 +103,15 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParamsBlockedDestination() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, mint.AppModule{})
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+	s.accountKeeper.EXPECT().GetModuleAddress("protocolpool").Return(sdk.AccAddress("protocolpool")).AnyTimes()
+	k := keeper.NewKeeper(encCfg.Codec, s.mintKeeper.Environment, s.stakingKeeper, s.accountKeeper, s.bankKeeper, authtypes.FeeCollectorName, govModuleNameStr, keeper.WithBlockedDestinations("protocolpool"))
+
+	params := types.DefaultParams()
+	params.Destinations = []types.MintDestination{{ModuleName: "protocolpool", Proportion: sdkmath.LegacyNewDecWithPrec(15, 2)}}
+	_, err := keeper.NewMsgServerImpl(k).UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	s.Require().ErrorIs(err, types.ErrInvalidDestination)
+}
@@ -22,7 +22,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/mint module consensus version.
-const ConsensusVersion = 3
+const ConsensusVersion = 4
 
 var (
 	_ module.HasAminoCodec       = AppModule{}
@@ -45,22 +45,23 @@ type AppModule struct {
 
 	// mintFn is used to mint new coins during BeginBlock. This function is in charge of
 	// minting new coins based on arbitrary logic, previously done through InflationCalculationFn.
-	// If mintFn is nil, the default minting logic is used.
+	// If mintFn is nil, the mint function configured by the keeper options is used.
 	mintFn types.MintFn
 }
 
 // NewAppModule creates a new AppModule object.
-// If the mintFn argument is nil, then the SDK's default minting function will be used.
+// If the mintFn argument is nil, then the mint function configured by the keeper
+// options, by default the SDK's minting function, will be used. A non-nil mintFn
+// is not described in Query/Params, prefer keeper.WithMintFn instead.
 func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
 	ak types.AccountKeeper,
 	mintFn types.MintFn,
 ) AppModule {
-	// If mintFn is nil, use the default minting function.
-	// This check also happens in ProvideModule when used with depinject.
+	// If mintFn is nil, use the mint function of the keeper.
 	if mintFn == nil {
-		mintFn = keeper.DefaultMintFn(types.DefaultInflationCalculationFn)
+		mintFn = keeper.MintFn()
 	}
 
 	return AppModule{
@@ -117,6 +118,10 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/%s from version 2 to 3: %w", types.ModuleName, err)
 	}
 
+	if err := mr.Register(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 3 to 4: %w", types.ModuleName, err)
+	}
+
 	return nil
 }
 
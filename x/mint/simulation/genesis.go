@@ -18,6 +18,8 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+	ProportionalGain    = "proportional_gain"
+	MaxInflationStep    = "max_inflation_step"
 )
 
 // GenInflation randomized Inflation
@@ -45,6 +47,16 @@ func GenGoalBonded(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDecWithPrec(67, 2)
 }
 
+// GenProportionalGain randomized ProportionalGain
+func GenProportionalGain(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+// GenMaxInflationStep randomized MaxInflationStep
+func GenMaxInflationStep(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(100)), 5)
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -64,9 +76,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var goalBonded math.LegacyDec
 	simState.AppParams.GetOrGenerate(GoalBonded, &goalBonded, simState.Rand, func(r *rand.Rand) { goalBonded = GenGoalBonded(r) })
 
+	var proportionalGain math.LegacyDec
+	simState.AppParams.GetOrGenerate(ProportionalGain, &proportionalGain, simState.Rand, func(r *rand.Rand) { proportionalGain = GenProportionalGain(r) })
+
+	var maxInflationStep math.LegacyDec
+	simState.AppParams.GetOrGenerate(MaxInflationStep, &maxInflationStep, simState.Rand, func(r *rand.Rand) { maxInflationStep = GenMaxInflationStep(r) })
+
 	mintDenom := simState.BondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
 	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear, math.ZeroInt())
+	params.ProportionalGain = proportionalGain
+	params.MaxInflationStep = maxInflationStep
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
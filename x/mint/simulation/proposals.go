@@ -49,6 +49,8 @@ func SimulateMsgUpdateParams(_ context.Context, r *rand.Rand, _ []simtypes.Accou
 	params.InflationMax = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 50, 100)), 2)
 	params.InflationRateChange = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.MintDenom = simtypes.RandStringOfLength(r, 10)
+	params.ProportionalGain = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 50)), 2)
+	params.MaxInflationStep = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 5)
 
 	return &types.MsgUpdateParams{
 		Authority: authorityAddr,
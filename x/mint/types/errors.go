@@ -3,3 +3,7 @@ package types
 import "cosmossdk.io/errors"
 
 var ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+
+var ErrInvalidMintFn = errors.Register(ModuleName, 2, "invalid mint function")
+
+var ErrInvalidDestination = errors.Register(ModuleName, 3, "invalid mint destination")
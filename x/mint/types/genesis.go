@@ -18,6 +18,20 @@ type InflationCalculationFn func(ctx context.Context, minter Minter, params Para
 // MintFn defines the function that needs to be implemented in order to customize the minting process.
 type MintFn func(ctx context.Context, env appmodule.Environment, minter *Minter, epochId string, epochNumber int64) error
 
+const (
+	// DefaultMintFnName is the name of the SDK's mint function.
+	DefaultMintFnName = "default"
+	// CustomMintFnName is the name of a custom mint function which was not
+	// given a description.
+	CustomMintFnName = "custom"
+	// BlockEpochIdentifier is the epoch identifier the mint function is called
+	// with every block, as opposed to the start of an epoch.
+	BlockEpochIdentifier = "block"
+	// EpochsPerYearParameter is the parameter of the default mint function
+	// holding the number of epochs per year, when it mints once per epoch.
+	EpochsPerYearParameter = "epochs_per_year"
+)
+
 // DefaultInflationCalculationFn is the default function used to calculate inflation.
 // Deprecated: use DefaultMintFn instead.
 func DefaultInflationCalculationFn(_ context.Context, minter Minter, params Params, bondedRatio math.LegacyDec) math.LegacyDec {
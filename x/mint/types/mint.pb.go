@@ -36,6 +36,9 @@ type Minter struct {
 	// data is any custom data that the user might want to put in the minter, to
 	// be used in the minting process.
 	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// last_bonded_ratio is the bonded ratio observed at the previous inflation
+	// update, used by the proportional term of the inflation controller.
+	LastBondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=last_bonded_ratio,json=lastBondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_bonded_ratio"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -94,6 +97,15 @@ type Params struct {
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 	// maximum supply for the token
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// proportional gain applied to the change of the bonded ratio between two
+	// blocks, damping the inflation adjustment. 0 disables the proportional term.
+	ProportionalGain cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=proportional_gain,json=proportionalGain,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proportional_gain"`
+	// maximum change of the inflation rate in a single block. 0 means no limit.
+	MaxInflationStep cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=max_inflation_step,json=maxInflationStep,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_inflation_step"`
+	// module accounts receiving a proportion of the minted coins, the remainder
+	// is sent to the fee collector. Empty sends all the minted coins to the fee
+	// collector.
+	Destinations []MintDestination `protobuf:"bytes,10,rep,name=destinations,proto3" json:"destinations"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -143,46 +155,190 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetDestinations() []MintDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// MintDestination defines a module account receiving a proportion of the
+// minted coins.
+type MintDestination struct {
+	// module_name is the name of the module account receiving the coins.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// proportion is the proportion of the minted coins sent to the module
+	// account.
+	Proportion cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=proportion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proportion"`
+}
+
+func (m *MintDestination) Reset()         { *m = MintDestination{} }
+func (m *MintDestination) String() string { return proto.CompactTextString(m) }
+func (*MintDestination) ProtoMessage()    {}
+func (*MintDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *MintDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintDestination.Merge(m, src)
+}
+func (m *MintDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintDestination proto.InternalMessageInfo
+
+func (m *MintDestination) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// MintFunction describes the mint function of the x/mint module.
+type MintFunction struct {
+	// name identifies the mint function, it is "default" for the SDK's mint
+	// function and "custom" for an undescribed custom function.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// epoch_identifier is the identifier of the epochs at the start of which the
+	// function mints, it is "block" when the function mints every block.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// parameters are the parameters of the mint function which are not part of
+	// the module params.
+	Parameters map[string]string `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *MintFunction) Reset()         { *m = MintFunction{} }
+func (m *MintFunction) String() string { return proto.CompactTextString(m) }
+func (*MintFunction) ProtoMessage()    {}
+func (*MintFunction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *MintFunction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintFunction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintFunction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintFunction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintFunction.Merge(m, src)
+}
+func (m *MintFunction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintFunction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintFunction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintFunction proto.InternalMessageInfo
+
+func (m *MintFunction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MintFunction) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *MintFunction) GetParameters() map[string]string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*MintDestination)(nil), "cosmos.mint.v1beta1.MintDestination")
+	proto.RegisterType((*MintFunction)(nil), "cosmos.mint.v1beta1.MintFunction")
+	proto.RegisterMapType((map[string]string)(nil), "cosmos.mint.v1beta1.MintFunction.ParametersEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x10, 0x82, 0x72, 0xb4, 0x82, 0x5e, 0xa9, 0xe4, 0x16, 0xd5, 0x8d, 0x3a, 0xa0,
-	0xa8, 0xa8, 0xb1, 0xa2, 0x4a, 0x0c, 0x8c, 0x21, 0x4b, 0x11, 0x15, 0x91, 0x19, 0x10, 0x20, 0x61,
-	0xbd, 0xb6, 0xaf, 0xee, 0x11, 0xdf, 0x9d, 0xe5, 0xbb, 0x54, 0xf6, 0x57, 0x60, 0xe2, 0x63, 0x30,
-	0x76, 0x60, 0xe1, 0x1b, 0x74, 0x41, 0xaa, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0x5f, 0x03, 0xf9,
-	0xce, 0xa4, 0xfc, 0x99, 0x08, 0x5d, 0xac, 0xbb, 0xf7, 0x79, 0xdf, 0xdf, 0xf3, 0xd8, 0xf2, 0x8b,
-	0xdd, 0x48, 0x2a, 0x2e, 0x95, 0xc7, 0x99, 0xd0, 0xde, 0x71, 0x3f, 0xa4, 0x1a, 0xfa, 0xe6, 0xd2,
-	0xcb, 0x72, 0xa9, 0x25, 0x59, 0xb5, 0x7a, 0xcf, 0x94, 0x6a, 0x7d, 0xe3, 0x6e, 0x22, 0x13, 0x69,
-	0x74, 0xaf, 0x3a, 0xd9, 0xd6, 0x8d, 0x75, 0xdb, 0x1a, 0x58, 0xa1, 0x9e, 0xb3, 0xd2, 0x0a, 0x70,
-	0x26, 0xa4, 0x67, 0x9e, 0x3f, 0xbb, 0x13, 0x29, 0x93, 0x94, 0x7a, 0xe6, 0x16, 0x4e, 0x0e, 0x3d,
-	0x10, 0xa5, 0x95, 0xb6, 0x3f, 0x23, 0xdc, 0x3a, 0x60, 0x42, 0xd3, 0x9c, 0x3c, 0xc3, 0x6d, 0x26,
-	0x0e, 0x53, 0xd0, 0x4c, 0x0a, 0x07, 0x75, 0x50, 0xb7, 0x3d, 0xe8, 0x9f, 0x9e, 0x6f, 0x35, 0xbe,
-	0x9d, 0x6f, 0xdd, 0xb3, 0x0e, 0x2a, 0x1e, 0xf7, 0x98, 0xf4, 0x38, 0xe8, 0xa3, 0xde, 0x53, 0x9a,
-	0x40, 0x54, 0x0e, 0x69, 0xf4, 0xe5, 0xe3, 0x2e, 0xae, 0x03, 0x0c, 0x69, 0xe4, 0x5f, 0x32, 0xc8,
-	0x1b, 0xbc, 0x02, 0x42, 0x4c, 0x20, 0xad, 0x62, 0x1e, 0x33, 0xc5, 0xa4, 0x50, 0xce, 0xb5, 0x45,
-	0xc1, 0x77, 0x2c, 0x6b, 0x34, 0x47, 0x11, 0x82, 0x9b, 0x31, 0x68, 0x70, 0xae, 0x77, 0x50, 0x77,
-	0xc9, 0x37, 0xe7, 0xed, 0x4f, 0x4d, 0xdc, 0x1a, 0x41, 0x0e, 0x5c, 0x91, 0x4d, 0x8c, 0xab, 0x2f,
-	0x19, 0xc4, 0x54, 0x48, 0x6e, 0x5f, 0xc8, 0x6f, 0x57, 0x95, 0x61, 0x55, 0x20, 0x6f, 0xf1, 0xda,
-	0x3c, 0x6a, 0x90, 0x83, 0xa6, 0x41, 0x74, 0x04, 0x22, 0xa1, 0x75, 0xc2, 0x87, 0xff, 0x9c, 0xf0,
-	0xc3, 0xc5, 0xc9, 0x0e, 0xf2, 0x57, 0xe7, 0x50, 0x1f, 0x34, 0x7d, 0x6c, 0x90, 0xe4, 0x35, 0x5e,
-	0xbe, 0xf4, 0xe2, 0x50, 0x98, 0xc8, 0x8b, 0x7b, 0x2c, 0xcd, 0x61, 0x07, 0x50, 0xfc, 0x01, 0x67,
-	0xc2, 0x69, 0x5e, 0x15, 0x9c, 0x09, 0xf2, 0x02, 0xdf, 0x4a, 0x24, 0xa4, 0x41, 0x28, 0x45, 0x4c,
-	0x63, 0xe7, 0xc6, 0x7f, 0xa1, 0x71, 0x85, 0x1a, 0x18, 0x12, 0xb9, 0x8f, 0x6f, 0x87, 0xa9, 0x8c,
-	0xc6, 0x2a, 0xc8, 0x68, 0x1e, 0x94, 0x14, 0x72, 0xa7, 0xd5, 0x41, 0xdd, 0xa6, 0xbf, 0x6c, 0xcb,
-	0x23, 0x9a, 0xbf, 0xa4, 0x90, 0x93, 0x27, 0x18, 0x73, 0x28, 0x02, 0x35, 0xc9, 0xb2, 0xb4, 0x74,
-	0x6e, 0x1a, 0xff, 0x07, 0xb5, 0xff, 0xda, 0xdf, 0xfe, 0xfb, 0x42, 0xff, 0xe2, 0xbc, 0x2f, 0xb4,
-	0xdf, 0xe6, 0x50, 0x3c, 0x37, 0xd3, 0x8f, 0x36, 0xdf, 0x5d, 0x9c, 0xec, 0x38, 0x56, 0xdb, 0x55,
-	0xf1, 0xd8, 0x2b, 0xec, 0x2e, 0xda, 0x1f, 0x66, 0xb0, 0x77, 0x3a, 0x75, 0xd1, 0xd9, 0xd4, 0x45,
-	0xdf, 0xa7, 0x2e, 0x7a, 0x3f, 0x73, 0x1b, 0x67, 0x33, 0xb7, 0xf1, 0x75, 0xe6, 0x36, 0x5e, 0xad,
-	0xff, 0x66, 0x54, 0x4f, 0xe9, 0x32, 0xa3, 0x2a, 0x6c, 0x99, 0x3d, 0xda, 0xfb, 0x11, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x44, 0x4d, 0x1a, 0xdd, 0x03, 0x00, 0x00,
+	// 762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x4f, 0x3b, 0x45,
+	0x14, 0xc7, 0xbb, 0xb4, 0xbf, 0x62, 0x1f, 0x25, 0x6d, 0x07, 0x48, 0x96, 0x1a, 0x4a, 0xd3, 0x18,
+	0x53, 0x31, 0xec, 0x52, 0x49, 0x8c, 0x21, 0xf1, 0x52, 0x51, 0x52, 0x23, 0x5a, 0x97, 0x44, 0xa3,
+	0x26, 0x6e, 0xa6, 0xbb, 0xc3, 0x32, 0x76, 0x77, 0x66, 0xb3, 0x3b, 0x6d, 0xda, 0x7f, 0xc1, 0x78,
+	0xf0, 0x1f, 0xf0, 0xee, 0x91, 0x03, 0x57, 0xef, 0x1c, 0x09, 0x27, 0xc3, 0x81, 0x18, 0x38, 0x70,
+	0xf7, 0x2f, 0x30, 0x3b, 0xb3, 0xb4, 0xa5, 0xe2, 0x01, 0xcb, 0x85, 0xcc, 0xbc, 0x37, 0xf3, 0xf9,
+	0x7e, 0xdf, 0x7b, 0xcc, 0x16, 0x6a, 0x0e, 0x8f, 0x03, 0x1e, 0x9b, 0x01, 0x65, 0xc2, 0x1c, 0xb6,
+	0x7a, 0x44, 0xe0, 0x96, 0xdc, 0x18, 0x61, 0xc4, 0x05, 0x47, 0x6b, 0x2a, 0x6f, 0xc8, 0x50, 0x9a,
+	0xaf, 0xae, 0x7b, 0xdc, 0xe3, 0x32, 0x6f, 0x26, 0x2b, 0x75, 0xb4, 0xba, 0xa9, 0x8e, 0xda, 0x2a,
+	0x91, 0xde, 0x53, 0xa9, 0x0a, 0x0e, 0x28, 0xe3, 0xa6, 0xfc, 0xfb, 0x78, 0xda, 0xe3, 0xdc, 0xf3,
+	0x89, 0x29, 0x77, 0xbd, 0xc1, 0xa9, 0x89, 0xd9, 0x58, 0xa5, 0x1a, 0x7f, 0x2c, 0x41, 0xfe, 0x98,
+	0x32, 0x41, 0x22, 0xf4, 0x15, 0x14, 0x28, 0x3b, 0xf5, 0xb1, 0xa0, 0x9c, 0xe9, 0x5a, 0x5d, 0x6b,
+	0x16, 0xda, 0xad, 0xcb, 0xdb, 0xed, 0xcc, 0xcd, 0xed, 0xf6, 0xdb, 0x4a, 0x21, 0x76, 0xfb, 0x06,
+	0xe5, 0x66, 0x80, 0xc5, 0x99, 0xf1, 0x05, 0xf1, 0xb0, 0x33, 0x3e, 0x24, 0xce, 0xf5, 0xc5, 0x2e,
+	0xa4, 0x06, 0x0e, 0x89, 0x63, 0x4d, 0x19, 0xe8, 0x47, 0xa8, 0x60, 0xc6, 0x06, 0xd8, 0x4f, 0x6c,
+	0x0e, 0x69, 0x4c, 0x39, 0x8b, 0xf5, 0xa5, 0xff, 0x0b, 0x2e, 0x2b, 0x56, 0x77, 0x82, 0x42, 0x08,
+	0x72, 0x2e, 0x16, 0x58, 0xcf, 0xd6, 0xb5, 0x66, 0xd1, 0x92, 0x6b, 0xc4, 0xa0, 0xe2, 0xe3, 0x58,
+	0xd8, 0x3d, 0xce, 0x5c, 0xe2, 0xda, 0x51, 0xe2, 0x44, 0xcf, 0x49, 0xcd, 0xf6, 0x8b, 0x35, 0x6f,
+	0x2e, 0x76, 0x57, 0x47, 0x72, 0x42, 0xf5, 0x61, 0xcb, 0xd8, 0x33, 0xf6, 0xac, 0x52, 0x02, 0x6f,
+	0x4b, 0xb6, 0x95, 0xa0, 0x1b, 0xbf, 0x2c, 0x43, 0xbe, 0x8b, 0x23, 0x1c, 0xc4, 0x68, 0x0b, 0x20,
+	0x39, 0x6a, 0xbb, 0x84, 0xf1, 0x40, 0x35, 0xd0, 0x2a, 0x24, 0x91, 0xc3, 0x24, 0x80, 0x7e, 0x82,
+	0x8d, 0x49, 0x6b, 0x12, 0x5f, 0xc4, 0x76, 0xce, 0x30, 0xf3, 0x48, 0xda, 0x91, 0x0f, 0x5f, 0xec,
+	0xee, 0xf7, 0x87, 0xf3, 0x1d, 0xcd, 0x5a, 0x9b, 0x40, 0x2d, 0x2c, 0xc8, 0x27, 0x12, 0x89, 0x7e,
+	0x80, 0xd5, 0xa9, 0x56, 0x80, 0x47, 0x7a, 0x76, 0x21, 0x8d, 0xe2, 0x04, 0x76, 0x8c, 0x47, 0x73,
+	0x70, 0xca, 0xf4, 0xdc, 0x6b, 0xc1, 0x29, 0x43, 0xdf, 0xc2, 0x8a, 0xc7, 0xb1, 0x9f, 0xce, 0x4f,
+	0x7f, 0xb3, 0x10, 0x1a, 0x12, 0x94, 0x9a, 0x16, 0x7a, 0x17, 0x4a, 0x3d, 0x9f, 0x3b, 0xfd, 0xd8,
+	0x0e, 0x49, 0x64, 0x8f, 0x09, 0x8e, 0xf4, 0x7c, 0x5d, 0x6b, 0xe6, 0xac, 0x55, 0x15, 0xee, 0x92,
+	0xe8, 0x3b, 0x82, 0x23, 0xf4, 0x39, 0x40, 0x80, 0x47, 0x76, 0x3c, 0x08, 0x43, 0x7f, 0xac, 0x2f,
+	0x4b, 0xfd, 0xf7, 0x53, 0xfd, 0x8d, 0x7f, 0xeb, 0x77, 0x98, 0x98, 0x51, 0xee, 0x30, 0x61, 0x15,
+	0x02, 0x3c, 0x3a, 0x91, 0xb7, 0x91, 0x80, 0x4a, 0x18, 0xf1, 0x90, 0x47, 0x49, 0x75, 0xd8, 0xb7,
+	0x3d, 0x4c, 0x99, 0xfe, 0x96, 0x44, 0x1e, 0x2d, 0xfe, 0xcf, 0xa8, 0x6a, 0x2c, 0xcf, 0x2a, 0x1c,
+	0x61, 0xca, 0xd0, 0x00, 0x50, 0x52, 0xc1, 0x74, 0x46, 0xb1, 0x20, 0xa1, 0x5e, 0x78, 0x65, 0xd9,
+	0x00, 0x8f, 0x3a, 0x8f, 0x0a, 0x27, 0x82, 0x84, 0xc8, 0x85, 0xa2, 0x4b, 0x62, 0x41, 0x99, 0x0c,
+	0xc5, 0x3a, 0xd4, 0xb3, 0xcd, 0x95, 0x0f, 0xde, 0x31, 0x9e, 0xf9, 0xa8, 0x19, 0xc7, 0xf2, 0x55,
+	0x4c, 0x0e, 0xb7, 0xab, 0xd2, 0xd6, 0xf3, 0x4a, 0x4f, 0xa8, 0x07, 0x5b, 0x3f, 0x3f, 0x9c, 0xef,
+	0xe8, 0x8a, 0xb9, 0x1b, 0xbb, 0x7d, 0x53, 0xdd, 0x30, 0xd5, 0x1b, 0x6c, 0xfc, 0xa6, 0x41, 0x69,
+	0x0e, 0x8e, 0xb6, 0x61, 0x25, 0xe0, 0xee, 0xc0, 0x27, 0x36, 0xc3, 0x01, 0x49, 0x1f, 0x26, 0xa8,
+	0xd0, 0x97, 0x38, 0x20, 0xe8, 0x1b, 0x80, 0x69, 0x13, 0x17, 0x7c, 0x8e, 0x33, 0xa4, 0x83, 0xca,
+	0xf5, 0x7c, 0x45, 0x8d, 0xbf, 0x35, 0x28, 0x26, 0xfe, 0x3e, 0x1b, 0x30, 0x47, 0x9a, 0x43, 0x90,
+	0x9b, 0x71, 0x25, 0xd7, 0xe8, 0x3d, 0x28, 0x93, 0x90, 0x3b, 0x67, 0x36, 0x75, 0x09, 0x13, 0xf4,
+	0x94, 0x92, 0x48, 0xb9, 0xb2, 0x4a, 0x32, 0xde, 0x99, 0x84, 0xd1, 0xd7, 0x00, 0x61, 0x52, 0x39,
+	0x11, 0x24, 0x8a, 0xf5, 0xac, 0x6c, 0x79, 0xeb, 0x3f, 0x5b, 0xfe, 0xa8, 0x6a, 0x74, 0x27, 0x77,
+	0x3e, 0x65, 0x22, 0x1a, 0x5b, 0x33, 0x90, 0xea, 0xc7, 0x50, 0x9a, 0x4b, 0xa3, 0x32, 0x64, 0xfb,
+	0x64, 0x9c, 0x7a, 0x4c, 0x96, 0x68, 0x1d, 0xde, 0x0c, 0xb1, 0x3f, 0x48, 0x3f, 0x5e, 0x96, 0xda,
+	0x1c, 0x2c, 0x7d, 0xa4, 0x3d, 0x53, 0x74, 0x7b, 0xff, 0xf2, 0xae, 0xa6, 0x5d, 0xdd, 0xd5, 0xb4,
+	0xbf, 0xee, 0x6a, 0xda, 0xaf, 0xf7, 0xb5, 0xcc, 0xd5, 0x7d, 0x2d, 0xf3, 0xe7, 0x7d, 0x2d, 0xf3,
+	0xfd, 0xe6, 0x93, 0xee, 0xa6, 0xa3, 0x14, 0xe3, 0x90, 0xc4, 0xbd, 0xbc, 0xfc, 0x7d, 0xda, 0xff,
+	0x67, 0x00, 0x3a, 0xed, 0xde, 0xac, 0x35, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -205,6 +361,16 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LastBondedRatio.Size()
+		i -= size
+		if _, err := m.LastBondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -255,6 +421,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.MaxInflationStep.Size()
+		i -= size
+		if _, err := m.MaxInflationStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ProportionalGain.Size()
+		i -= size
+		if _, err := m.ProportionalGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -320,6 +520,102 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintFunction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintFunction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for k := range m.Parameters {
+			v := m.Parameters[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -345,6 +641,8 @@ func (m *Minter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMint(uint64(l))
 	}
+	l = m.LastBondedRatio.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -371,6 +669,56 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = m.ProportionalGain.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MaxInflationStep.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintFunction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for k, v := range m.Parameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMint(uint64(len(k))) + 1 + len(v) + sovMint(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMint(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -511,6 +859,40 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastBondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -782,6 +1164,465 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProportionalGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProportionalGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflationStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflationStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, MintDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintFunction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintFunction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parameters == nil {
+				m.Parameters = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMint
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMint
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMint
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMint
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMint
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMint
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMint
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMint(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMint
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Parameters[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
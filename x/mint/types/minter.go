@@ -15,6 +15,7 @@ func NewMinter(inflation, annualProvisions math.LegacyDec) Minter {
 	return Minter{
 		Inflation:        inflation,
 		AnnualProvisions: annualProvisions,
+		LastBondedRatio:  math.LegacyZeroDec(),
 	}
 }
 
@@ -40,16 +41,24 @@ func ValidateMinter(minter Minter) error {
 		return fmt.Errorf("mint parameter Inflation should be positive, is %s",
 			minter.Inflation.String())
 	}
+	if !minter.LastBondedRatio.IsNil() && (minter.LastBondedRatio.IsNegative() || minter.LastBondedRatio.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("mint parameter LastBondedRatio should be between 0 and 1, is %s",
+			minter.LastBondedRatio.String())
+	}
 	return nil
 }
 
 // NextInflationRate returns the new inflation rate for the next block.
 func (m Minter) NextInflationRate(params Params, bondedRatio math.LegacyDec) math.LegacyDec {
-	// The target annual inflation rate is recalculated for each block. The inflation
-	// is also subject to a rate change (positive or negative) depending on the
-	// distance from the desired ratio (67%). The maximum rate change possible is
-	// defined to be 13% per year, however the annual inflation is capped as between
-	// 7% and 20%.
+	// The target annual inflation rate is recalculated for each block by a
+	// controller targeting the goal bonded ratio (67% by default):
+	//
+	// - the integral term changes the inflation depending on the distance from
+	//   the goal bonded ratio, by at most InflationRateChange per year;
+	// - the proportional term counteracts the change of the bonded ratio since
+	//   the previous block, damping the oscillations around the goal;
+	// - the change in a single block is capped by MaxInflationStep;
+	// - the annual inflation is capped between InflationMin and InflationMax.
 
 	// (1 - bondedRatio/GoalBonded) * InflationRateChange
 	inflationRateChangePerYear := math.LegacyOneDec().
@@ -57,6 +66,22 @@ func (m Minter) NextInflationRate(params Params, bondedRatio math.LegacyDec) mat
 		Mul(params.InflationRateChange)
 	inflationRateChange := inflationRateChangePerYear.Quo(math.LegacyNewDec(int64(params.BlocksPerYear)))
 
+	// - (bondedRatio - lastBondedRatio)/GoalBonded * ProportionalGain
+	if isSet(params.ProportionalGain) && isSet(m.LastBondedRatio) {
+		inflationRateChange = inflationRateChange.Sub(
+			bondedRatio.Sub(m.LastBondedRatio).Quo(params.GoalBonded).Mul(params.ProportionalGain),
+		)
+	}
+
+	if isSet(params.MaxInflationStep) {
+		if inflationRateChange.GT(params.MaxInflationStep) {
+			inflationRateChange = params.MaxInflationStep
+		}
+		if inflationRateChange.LT(params.MaxInflationStep.Neg()) {
+			inflationRateChange = params.MaxInflationStep.Neg()
+		}
+	}
+
 	// adjust the new annual inflation for this next block
 	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
 	if inflation.GT(params.InflationMax) {
@@ -69,6 +94,12 @@ func (m Minter) NextInflationRate(params Params, bondedRatio math.LegacyDec) mat
 	return inflation
 }
 
+// isSet returns true if the decimal is neither nil nor zero. Fields added after
+// genesis are nil until migrated or updated.
+func isSet(d math.LegacyDec) bool {
+	return !d.IsNil() && !d.IsZero()
+}
+
 // NextAnnualProvisions returns the annual provisions based on current total
 // supply and inflation rate.
 func (m Minter) NextAnnualProvisions(_ Params, totalSupply math.Int) math.LegacyDec {
@@ -82,6 +113,13 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
 
+// EpochProvision returns the provisions for an epoch based on the annual
+// provisions rate and the number of epochs per year.
+func (m Minter) EpochProvision(params Params, epochsPerYear uint64) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.QuoInt(math.NewIntFromUint64(epochsPerYear))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
+
 // IsEqual returns true if two minters are equal, it checks all the fields
 func (m Minter) IsEqual(minter Minter) bool {
 	if !m.Inflation.Equal(minter.Inflation) {
@@ -96,5 +134,10 @@ func (m Minter) IsEqual(minter Minter) bool {
 		return false
 	}
 
+	if isSet(m.LastBondedRatio) != isSet(minter.LastBondedRatio) ||
+		(isSet(m.LastBondedRatio) && !m.LastBondedRatio.Equal(minter.LastBondedRatio)) {
+		return false
+	}
+
 	return true
 }
@@ -63,6 +63,107 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestNextInflationController(t *testing.T) {
+	params := DefaultParams()
+	params.ProportionalGain = math.LegacyNewDecWithPrec(1, 1)
+	params.MaxInflationStep = math.LegacyNewDecWithPrec(1, 2)
+	blocksPerYr := math.LegacyNewDec(int64(params.BlocksPerYear))
+
+	minter := DefaultInitialMinter()
+	minter.Inflation = math.LegacyNewDecWithPrec(2, 2)
+
+	// no previous bonded ratio, only the integral term applies
+	inflation := minter.NextInflationRate(params, math.LegacyNewDecWithPrec(5, 1))
+	expChange := math.LegacyOneDec().Sub(math.LegacyNewDecWithPrec(5, 1).Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(blocksPerYr)
+	require.Equal(t, minter.Inflation.Add(expChange), inflation)
+
+	// the bonded ratio going up reduces the inflation through the proportional term
+	minter.LastBondedRatio = math.LegacyNewDecWithPrec(49, 2)
+	inflation = minter.NextInflationRate(params, math.LegacyNewDecWithPrec(5, 1))
+	expChange = expChange.Sub(math.LegacyNewDecWithPrec(1, 2).Quo(params.GoalBonded).Mul(params.ProportionalGain))
+	require.Equal(t, minter.Inflation.Add(expChange), inflation)
+
+	// large changes are capped by the max inflation step
+	minter.LastBondedRatio = math.LegacyNewDecWithPrec(9, 1)
+	inflation = minter.NextInflationRate(params, math.LegacyNewDecWithPrec(1, 1))
+	require.Equal(t, minter.Inflation.Add(params.MaxInflationStep), inflation)
+
+	minter.LastBondedRatio = math.LegacyNewDecWithPrec(1, 1)
+	inflation = minter.NextInflationRate(params, math.LegacyNewDecWithPrec(9, 1))
+	require.Equal(t, minter.Inflation.Sub(params.MaxInflationStep), inflation)
+}
+
+// simulateBondedRatio runs the inflation controller against a simple model of a
+// chain where the bonded ratio moves at every block by a fraction of the distance
+// to an equilibrium proportional to the inflation.
+// It returns the bonded ratio at every block.
+func simulateBondedRatio(params Params, blocks int, onBlock func(prev, next math.LegacyDec)) []math.LegacyDec {
+	var (
+		minter      = InitialMinter(math.LegacyNewDecWithPrec(5, 2))
+		bondedRatio = math.LegacyNewDecWithPrec(2, 1)
+		sensitivity = math.LegacyNewDec(4)            // equilibrium bonded ratio per unit of inflation
+		reactivity  = math.LegacyNewDecWithPrec(1, 2) // share of the distance to the equilibrium covered per block
+		ratios      = make([]math.LegacyDec, 0, blocks)
+	)
+
+	for i := 0; i < blocks; i++ {
+		next := minter.NextInflationRate(params, bondedRatio)
+		if onBlock != nil {
+			onBlock(minter.Inflation, next)
+		}
+		minter.Inflation = next
+		minter.LastBondedRatio = bondedRatio
+
+		equilibrium := math.LegacyMinDec(minter.Inflation.Mul(sensitivity), math.LegacyOneDec())
+		bondedRatio = bondedRatio.Add(equilibrium.Sub(bondedRatio).Mul(reactivity))
+		ratios = append(ratios, bondedRatio)
+	}
+
+	return ratios
+}
+
+func TestNextInflationConvergence(t *testing.T) {
+	const blocks = 20000
+
+	params := DefaultParams()
+	params.InflationMin = math.LegacyZeroDec()
+	params.InflationMax = math.LegacyNewDecWithPrec(3, 1)
+	params.BlocksPerYear = 100
+
+	overshootAndSettling := func(ratios []math.LegacyDec) (math.LegacyDec, int) {
+		overshoot, settledAt := math.LegacyZeroDec(), 0
+		tolerance := math.LegacyNewDecWithPrec(1, 3)
+		for i, ratio := range ratios {
+			overshoot = math.LegacyMaxDec(overshoot, ratio.Sub(params.GoalBonded))
+			if ratio.Sub(params.GoalBonded).Abs().GT(tolerance) {
+				settledAt = i + 1
+			}
+		}
+		return overshoot, settledAt
+	}
+
+	// integral term only: converges after overshooting the goal
+	ratios := simulateBondedRatio(params, blocks, nil)
+	integralOvershoot, integralSettling := overshootAndSettling(ratios)
+	require.True(t, integralOvershoot.GT(math.LegacyNewDecWithPrec(5, 2)), "overshoot: %s", integralOvershoot)
+	require.Less(t, integralSettling, blocks)
+
+	// with the proportional term: converges without noticeable overshoot and faster
+	params.ProportionalGain = math.LegacyNewDecWithPrec(1, 1)
+	ratios = simulateBondedRatio(params, blocks, nil)
+	pOvershoot, pSettling := overshootAndSettling(ratios)
+	require.True(t, pOvershoot.LT(math.LegacyNewDecWithPrec(1, 3)), "overshoot: %s", pOvershoot)
+	require.Less(t, pSettling, integralSettling)
+	require.True(t, ratios[blocks-1].Sub(params.GoalBonded).Abs().LT(math.LegacyNewDecWithPrec(1, 6)))
+
+	// with a max inflation step: every change stays within the step and the ratio still converges
+	params.MaxInflationStep = math.LegacyNewDecWithPrec(5, 4)
+	ratios = simulateBondedRatio(params, blocks, func(prev, next math.LegacyDec) {
+		require.True(t, next.Sub(prev).Abs().LTE(params.MaxInflationStep))
+	})
+	require.True(t, ratios[blocks-1].Sub(params.GoalBonded).Abs().LT(math.LegacyNewDecWithPrec(1, 6)))
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(math.LegacyNewDecWithPrec(1, 1))
 	params := DefaultParams()
@@ -91,6 +192,31 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestEpochProvision(t *testing.T) {
+	minter := InitialMinter(math.LegacyNewDecWithPrec(1, 1))
+	params := DefaultParams()
+
+	tests := []struct {
+		annualProvisions int64
+		epochsPerYear    uint64
+		expProvisions    int64
+	}{
+		{365, 365, 1},
+		{365*2 + 1, 365, 2},
+		{364, 365, 0},
+		{520, 52, 10},
+	}
+	for i, tc := range tests {
+		minter.AnnualProvisions = math.LegacyNewDec(tc.annualProvisions)
+		provisions := minter.EpochProvision(params, tc.epochsPerYear)
+
+		expProvisions := sdk.NewCoin(params.MintDenom, math.NewInt(tc.expProvisions))
+		require.True(t, expProvisions.IsEqual(provisions),
+			"test: %v\n\tExp: %v\n\tGot: %v\n",
+			i, tc.expProvisions, provisions)
+	}
+}
+
 func TestValidateMinter(t *testing.T) {
 	tests := []struct {
 		minter Minter
@@ -99,6 +225,8 @@ func TestValidateMinter(t *testing.T) {
 		{InitialMinter(math.LegacyNewDecWithPrec(1, 1)), false},
 		{InitialMinter(math.LegacyNewDecWithPrec(-1, 1)), true},
 		{InitialMinter(math.LegacyZeroDec()), false},
+		{Minter{Inflation: math.LegacyZeroDec(), LastBondedRatio: math.LegacyNewDecWithPrec(5, 1)}, false},
+		{Minter{Inflation: math.LegacyZeroDec(), LastBondedRatio: math.LegacyNewDec(2)}, true},
 	}
 	for i, tc := range tests {
 		err := ValidateMinter(tc.minter)
@@ -20,6 +20,8 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
 		MaxSupply:           maxSupply,
+		ProportionalGain:    math.LegacyZeroDec(),
+		MaxInflationStep:    math.LegacyZeroDec(),
 	}
 }
 
@@ -33,9 +35,24 @@ func DefaultParams() Params {
 		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
 		MaxSupply:           math.ZeroInt(),             // assuming zero is infinite
+		ProportionalGain:    math.LegacyZeroDec(),       // zero disables the proportional term
+		MaxInflationStep:    math.LegacyZeroDec(),       // zero means no limit
 	}
 }
 
+// PerEpoch returns the params of the inflation calculation when the inflation
+// rate is updated once per epoch instead of once per block: a year counts
+// epochsPerYear updates and the maximum step covers the blocks of an epoch.
+func (p Params) PerEpoch(epochsPerYear uint64) Params {
+	if isSet(p.MaxInflationStep) {
+		blocksPerEpoch := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.BlocksPerYear)).QuoInt(math.NewIntFromUint64(epochsPerYear))
+		p.MaxInflationStep = p.MaxInflationStep.Mul(blocksPerEpoch)
+	}
+	p.BlocksPerYear = epochsPerYear
+
+	return p
+}
+
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
@@ -59,6 +76,15 @@ func (p Params) Validate() error {
 	if err := validateMaxSupply(p.MaxSupply); err != nil {
 		return err
 	}
+	if err := validateProportionalGain(p.ProportionalGain); err != nil {
+		return err
+	}
+	if err := validateMaxInflationStep(p.MaxInflationStep); err != nil {
+		return err
+	}
+	if err := validateDestinations(p.Destinations); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -151,3 +177,69 @@ func validateMaxSupply(v math.Int) error {
 
 	return nil
 }
+
+func validateProportionalGain(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("proportional gain cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("proportional gain cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxInflationStep(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("max inflation step cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max inflation step cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max inflation step too large: %s", v)
+	}
+
+	return nil
+}
+
+// The staking pools track the bonded and unbonding tokens of the validators,
+// coins sent to them would not be backed by any delegation.
+const (
+	bondedPoolName    = "bonded_tokens_pool"
+	notBondedPoolName = "not_bonded_tokens_pool"
+)
+
+func validateDestinations(destinations []MintDestination) error {
+	total := math.LegacyZeroDec()
+	seen := make(map[string]struct{}, len(destinations))
+	for _, d := range destinations {
+		if strings.TrimSpace(d.ModuleName) == "" {
+			return errors.New("mint destination module name cannot be blank")
+		}
+		if d.ModuleName == ModuleName {
+			return fmt.Errorf("mint destination cannot be the %s module account", ModuleName)
+		}
+		if d.ModuleName == bondedPoolName || d.ModuleName == notBondedPoolName {
+			return fmt.Errorf("mint destination cannot be the %s staking pool", d.ModuleName)
+		}
+		if _, ok := seen[d.ModuleName]; ok {
+			return fmt.Errorf("duplicate mint destination: %s", d.ModuleName)
+		}
+		seen[d.ModuleName] = struct{}{}
+
+		if d.Proportion.IsNil() {
+			return fmt.Errorf("mint destination %s proportion cannot be nil", d.ModuleName)
+		}
+		if !d.Proportion.IsPositive() {
+			return fmt.Errorf("mint destination %s proportion must be positive: %s", d.ModuleName, d.Proportion)
+		}
+		total = total.Add(d.Proportion)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("mint destination proportions sum to more than one: %s", total)
+	}
+
+	return nil
+}
@@ -104,9 +104,85 @@ func TestValidate(t *testing.T) {
 	err = params.Validate()
 	require.Error(t, err)
 
+	params = DefaultParams()
+	params.ProportionalGain = math.LegacyNewDec(-1)
+	err = params.Validate()
+	require.Error(t, err)
+
+	params = DefaultParams()
+	params.ProportionalGain = math.LegacyDec{}
+	err = params.Validate()
+	require.Error(t, err)
+
+	params = DefaultParams()
+	params.MaxInflationStep = math.LegacyNewDec(2)
+	err = params.Validate()
+	require.Error(t, err)
+
+	params = DefaultParams()
+	params.MaxInflationStep = math.LegacyNewDec(-1)
+	err = params.Validate()
+	require.Error(t, err)
+
+	params = DefaultParams()
+	params.MaxInflationStep = math.LegacyDec{}
+	err = params.Validate()
+	require.Error(t, err)
+
 	params = DefaultParams()
 	params.InflationMax = math.LegacyNewDecWithPrec(1, 2)
 	params.InflationMin = math.LegacyNewDecWithPrec(2, 2)
 	err = params.Validate()
 	require.Error(t, err)
+
+	params = DefaultParams()
+	params.Destinations = []MintDestination{
+		{ModuleName: "protocolpool", Proportion: math.LegacyNewDecWithPrec(15, 2)},
+		{ModuleName: "developers", Proportion: math.LegacyNewDecWithPrec(85, 2)},
+	}
+	require.NoError(t, params.Validate())
+
+	params.Destinations[1].Proportion = math.LegacyNewDecWithPrec(86, 2)
+	require.ErrorContains(t, params.Validate(), "sum to more than one")
+
+	params.Destinations[1] = MintDestination{ModuleName: "protocolpool", Proportion: math.LegacyNewDecWithPrec(5, 2)}
+	require.ErrorContains(t, params.Validate(), "duplicate mint destination")
+
+	params.Destinations[1] = MintDestination{ModuleName: ModuleName, Proportion: math.LegacyNewDecWithPrec(5, 2)}
+	require.Error(t, params.Validate())
+
+	params.Destinations[1] = MintDestination{ModuleName: "bonded_tokens_pool", Proportion: math.LegacyNewDecWithPrec(5, 2)}
+	require.ErrorContains(t, params.Validate(), "staking pool")
+
+	params.Destinations[1] = MintDestination{ModuleName: "not_bonded_tokens_pool", Proportion: math.LegacyNewDecWithPrec(5, 2)}
+	require.ErrorContains(t, params.Validate(), "staking pool")
+
+	params.Destinations[1] = MintDestination{ModuleName: " ", Proportion: math.LegacyNewDecWithPrec(5, 2)}
+	require.Error(t, params.Validate())
+
+	params.Destinations[1] = MintDestination{ModuleName: "developers", Proportion: math.LegacyZeroDec()}
+	require.Error(t, params.Validate())
+
+	params.Destinations[1] = MintDestination{ModuleName: "developers"}
+	require.Error(t, params.Validate())
+}
+
+func TestParamsPerEpoch(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 3650
+	params.MaxInflationStep = math.LegacyNewDecWithPrec(1, 4)
+
+	perEpoch := params.PerEpoch(365)
+	require.Equal(t, uint64(365), perEpoch.BlocksPerYear)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 3), perEpoch.MaxInflationStep)
+	require.Equal(t, uint64(3650), params.BlocksPerYear)
+
+	// the integral step of an epoch is the one of its blocks
+	minter := InitialMinter(math.LegacyNewDecWithPrec(3, 2))
+	bondedRatio := math.LegacyNewDecWithPrec(5, 1)
+	blockStep := minter.NextInflationRate(DefaultParams(), bondedRatio).Sub(minter.Inflation)
+	defaultPerEpoch := DefaultParams().PerEpoch(DefaultParams().BlocksPerYear / 10)
+	epochStep := minter.NextInflationRate(defaultPerEpoch, bondedRatio).Sub(minter.Inflation)
+	require.True(t, blockStep.IsPositive())
+	require.True(t, epochStep.Sub(blockStep.MulInt64(10)).Abs().LTE(math.LegacyNewDecWithPrec(1, 15)))
 }
@@ -73,6 +73,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// mint_function describes the mint function used by the module.
+	MintFunction MintFunction `protobuf:"bytes,2,opt,name=mint_function,json=mintFunction,proto3" json:"mint_function"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -115,6 +117,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetMintFunction() MintFunction {
+	if m != nil {
+		return m.MintFunction
+	}
+	return MintFunction{}
+}
+
 // QueryInflationRequest is the request type for the Query/Inflation RPC method.
 type QueryInflationRequest struct {
 }
@@ -282,37 +291,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x15, 0x03, 0x19, 0x5b, 0x68, 0xa7, 0x55, 0xdb, 0x4d, 0x3b, 0x89, 0x2b, 0xd4,
+	0x50, 0xe9, 0x4c, 0x93, 0x82, 0x47, 0xc1, 0x50, 0x04, 0x41, 0xa1, 0x16, 0x4f, 0x5e, 0xc2, 0x64,
+	0x9d, 0xae, 0x8b, 0xd9, 0x99, 0x6d, 0x66, 0x12, 0xcc, 0x4d, 0xc4, 0xa3, 0x07, 0xc1, 0x2f, 0xa1,
+	0x37, 0x0f, 0xbd, 0xf8, 0x01, 0x84, 0x1e, 0x8b, 0x5e, 0xc4, 0x43, 0x91, 0x44, 0xf0, 0x6b, 0xc8,
+	0xce, 0x4c, 0xa2, 0x4d, 0x77, 0x51, 0xe9, 0x25, 0x24, 0xef, 0xfd, 0xdf, 0x7b, 0xbf, 0x97, 0xff,
+	0xdb, 0x85, 0x95, 0x40, 0xaa, 0x58, 0x2a, 0x1a, 0x47, 0x42, 0xd3, 0x7e, 0xbd, 0xcd, 0x35, 0xab,
+	0xd3, 0x83, 0x1e, 0xef, 0x0e, 0x48, 0xd2, 0x95, 0x5a, 0xa2, 0x45, 0x2b, 0x20, 0xa9, 0x80, 0x38,
+	0x81, 0xb7, 0x14, 0xca, 0x50, 0x9a, 0x3c, 0x4d, 0xbf, 0x59, 0xa9, 0xb7, 0x1a, 0x4a, 0x19, 0x76,
+	0x38, 0x65, 0x49, 0x44, 0x99, 0x10, 0x52, 0x33, 0x1d, 0x49, 0xa1, 0x5c, 0x16, 0x67, 0x4d, 0x32,
+	0x5d, 0x6d, 0x7e, 0x81, 0xc5, 0x91, 0x90, 0xd4, 0x7c, 0xba, 0xd0, 0x8a, 0x2d, 0x69, 0xd9, 0x49,
+	0x0e, 0xc4, 0xfc, 0xf0, 0x97, 0x20, 0x7a, 0x98, 0x52, 0xee, 0xb2, 0x2e, 0x8b, 0xd5, 0x1e, 0x3f,
+	0xe8, 0x71, 0xa5, 0xfd, 0x8f, 0x00, 0x2e, 0x9e, 0x0a, 0xab, 0x44, 0x0a, 0xc5, 0xd1, 0x6d, 0x58,
+	0x4c, 0x4c, 0x64, 0x19, 0x54, 0x41, 0xed, 0x52, 0xa3, 0x4c, 0x32, 0xb6, 0x22, 0xb6, 0xa8, 0x59,
+	0x3a, 0x3a, 0xa9, 0x14, 0xde, 0xfd, 0xfc, 0xb0, 0x01, 0xf6, 0x5c, 0x15, 0x6a, 0xc3, 0xb9, 0x54,
+	0xd9, 0xda, 0xef, 0x89, 0x20, 0xdd, 0x69, 0x79, 0xc6, 0xb4, 0xb9, 0x96, 0xd9, 0xe6, 0x41, 0x24,
+	0xf4, 0x5d, 0x27, 0x6c, 0x7a, 0x69, 0xb3, 0x6f, 0x87, 0x9b, 0x73, 0xcf, 0xcd, 0xae, 0xd5, 0x7e,
+	0x9d, 0x6c, 0x91, 0x2d, 0xdb, 0x7d, 0x36, 0xfe, 0x43, 0xe9, 0x5f, 0x85, 0x97, 0x0d, 0xfa, 0x3d,
+	0xb1, 0xdf, 0x31, 0x7f, 0xdc, 0x78, 0x29, 0x01, 0xaf, 0x4c, 0x27, 0xdc, 0x5a, 0x8f, 0x60, 0x29,
+	0x1a, 0x07, 0xcd, 0x66, 0xb3, 0xcd, 0x5b, 0x66, 0xde, 0x49, 0xa5, 0x6c, 0xc9, 0xd4, 0x93, 0x67,
+	0x24, 0x92, 0x34, 0x66, 0xfa, 0x29, 0xb9, 0xcf, 0x43, 0x16, 0x0c, 0x76, 0x78, 0xf0, 0xf9, 0x70,
+	0x13, 0x3a, 0xf0, 0x1d, 0x1e, 0x58, 0x96, 0xdf, 0x8d, 0x7c, 0x0c, 0x57, 0xcd, 0xbc, 0x3b, 0x42,
+	0xf4, 0x58, 0x67, 0xb7, 0x2b, 0xfb, 0x91, 0x4a, 0x7d, 0x1c, 0xf3, 0xbc, 0x02, 0x70, 0x2d, 0x47,
+	0xe0, 0xb8, 0x02, 0xb8, 0xc0, 0x4c, 0xae, 0x95, 0x4c, 0x92, 0xe7, 0xe4, 0x9b, 0x67, 0x53, 0xc3,
+	0x1a, 0x9f, 0x2e, 0xc0, 0x8b, 0x06, 0x03, 0xbd, 0x00, 0xb0, 0x68, 0xbd, 0x43, 0x37, 0x32, 0x1d,
+	0x39, 0x7b, 0x29, 0x5e, 0xed, 0xef, 0x42, 0xbb, 0x8c, 0x7f, 0xfd, 0xe5, 0x97, 0x1f, 0x6f, 0x67,
+	0xd6, 0x50, 0x99, 0x66, 0x1d, 0xb0, 0x3b, 0x90, 0xd7, 0x00, 0x96, 0x26, 0xfe, 0xa0, 0x8d, 0xfc,
+	0xe6, 0xd3, 0xee, 0x7a, 0x37, 0xff, 0x49, 0xeb, 0x58, 0xd6, 0x0d, 0x4b, 0x15, 0xe1, 0x4c, 0x96,
+	0x89, 0x85, 0xe8, 0x3d, 0x80, 0xf3, 0xd3, 0xee, 0xa0, 0x7a, 0xfe, 0xa4, 0x1c, 0xab, 0xbd, 0xc6,
+	0xff, 0x94, 0x38, 0x46, 0x62, 0x18, 0x6b, 0x68, 0x3d, 0x93, 0xf1, 0xcc, 0x5d, 0x34, 0xb7, 0x8f,
+	0x86, 0x18, 0x1c, 0x0f, 0x31, 0xf8, 0x3e, 0xc4, 0xe0, 0xcd, 0x08, 0x17, 0x8e, 0x47, 0xb8, 0xf0,
+	0x75, 0x84, 0x0b, 0x8f, 0x57, 0x4e, 0xdd, 0x88, 0x7d, 0x80, 0xa8, 0x1e, 0x24, 0x5c, 0xb5, 0x8b,
+	0xe6, 0x2d, 0xb0, 0xfd, 0x6b, 0x00, 0x5e, 0xe5, 0x6a, 0x3c, 0xbf, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -516,6 +528,16 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.MintFunction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -669,6 +691,8 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintFunction.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -830,6 +854,39 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFunction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFunction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -28,6 +28,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "ReceiptShare",
+					Use:            "receipt-share [account]",
+					Short:          "Query the share of the receipts of an account routed to the community pool",
+					Example:        fmt.Sprintf(`$ %s query protocolpool receipt-share cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod: "SpendMsgAllowlist",
+					Use:       "spend-msg-allowlist",
+					Short:     "Query the messages community pool spend recipients can execute",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +101,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "SetReceiptShare",
+					Use:       "set-receipt-share [account] [share]",
+					Short:     "Route a share of the coins received by an account to the community pool",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool set-receipt-share cosmos1... 0.1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "account"},
+						{ProtoField: "share"},
+					},
+				},
+				{
+					RpcMethod: "UpdateSpendMsgAllowlist",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 		},
 	}
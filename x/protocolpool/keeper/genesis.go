@@ -69,6 +69,26 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		return errors.New("total to be distributed is greater than the last balance")
 	}
 
+	for _, rs := range data.ReceiptShares {
+		account, err := k.authKeeper.AddressCodec().StringToBytes(rs.Account)
+		if err != nil {
+			return fmt.Errorf("failed to decode receipt share account address: %w", err)
+		}
+		if err := k.ReceiptShares.Set(ctx, account, rs.Share); err != nil {
+			return fmt.Errorf("failed to set receipt share for account %s: %w", rs.Account, err)
+		}
+	}
+
+	for _, p := range data.SpendMsgAllowlist {
+		signer, err := k.authKeeper.AddressCodec().StringToBytes(p.Signer)
+		if err != nil {
+			return fmt.Errorf("failed to decode spend msg permission signer address: %w", err)
+		}
+		if err := k.SetSpendMsgPermission(ctx, signer, p.MsgTypeUrl); err != nil {
+			return fmt.Errorf("failed to set spend msg permission for signer %s: %w", p.Signer, err)
+		}
+	}
+
 	return nil
 }
 
@@ -129,5 +149,25 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.ReceiptShares.Walk(ctx, nil, func(key sdk.AccAddress, value math.LegacyDec) (stop bool, err error) {
+		account, err := k.authKeeper.AddressCodec().BytesToString(key)
+		if err != nil {
+			return true, err
+		}
+		genState.ReceiptShares = append(genState.ReceiptShares, &types.ReceiptShare{
+			Account: account,
+			Share:   value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.SpendMsgAllowlist, err = k.GetSpendMsgPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genState, nil
 }
@@ -41,6 +41,12 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 
 	// Set last balance
 	gs.LastBalance = math.NewInt(1)
+	gs.ReceiptShares = []*types.ReceiptShare{
+		{Account: "cosmos1qy3529yj3v4xw2z3vz3vz3vz3vz3vz3v3k0vyf", Share: math.LegacyMustNewDecFromStr("0.1")},
+	}
+	gs.SpendMsgAllowlist = []*types.SpendMsgPermission{
+		{Signer: "cosmos1qy3529yj3v4xw2z3vz3vz3vz3vz3vz3v3k0vyf", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+	}
 	err = suite.poolKeeper.InitGenesis(suite.ctx, gs)
 	suite.Require().NoError(err)
 
@@ -50,4 +56,6 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(gs.ContinuousFund, exportedGenState.ContinuousFund)
 	suite.Require().Equal(gs.Budget, exportedGenState.Budget)
 	suite.Require().Equal(math.OneInt(), exportedGenState.LastBalance)
+	suite.Require().Equal(gs.ReceiptShares, exportedGenState.ReceiptShares)
+	suite.Require().Equal(gs.SpendMsgAllowlist, exportedGenState.SpendMsgAllowlist)
 }
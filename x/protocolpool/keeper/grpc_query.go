@@ -73,3 +73,37 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// ReceiptShare queries the share of the receipts of an account routed to the
+// community pool.
+func (k Querier) ReceiptShare(ctx context.Context, req *types.QueryReceiptShareRequest) (*types.QueryReceiptShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	account, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %s", err.Error())
+	}
+
+	share, err := k.Keeper.GetReceiptShare(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReceiptShareResponse{Share: share}, nil
+}
+
+// SpendMsgAllowlist queries the messages community pool spend recipients can
+// execute on their behalf.
+func (k Querier) SpendMsgAllowlist(ctx context.Context, req *types.QuerySpendMsgAllowlistRequest) (*types.QuerySpendMsgAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	permissions, err := k.Keeper.GetSpendMsgPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySpendMsgAllowlistResponse{Permissions: permissions}, nil
+}
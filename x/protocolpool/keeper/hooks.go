@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks wraps the protocolpool keeper to implement the bank send hooks routing
+// a share of the coins received by accounts to the community pool.
+type Hooks struct {
+	k Keeper
+}
+
+var _ banktypes.SendHooks = Hooks{}
+
+// Hooks returns the bank send hooks of the protocolpool keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BlockBeforeSend implements banktypes.SendHooks, it never blocks a transfer.
+func (h Hooks) BlockBeforeSend(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	return nil
+}
+
+// TrackBeforeSend implements banktypes.SendHooks, it records the share of the
+// transferred coins to be routed to the community pool if the recipient routes
+// its receipts.
+func (h Hooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	if from.Equals(to) {
+		return
+	}
+
+	if err := h.k.trackReceipt(ctx, to, amount); err != nil {
+		h.k.Logger.Error("failed to track receipt", "account", to, "amount", amount, "err", err)
+	}
+}
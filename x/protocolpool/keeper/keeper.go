@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"sort"
 	"time"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
@@ -14,6 +18,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -37,6 +42,17 @@ type Keeper struct {
 	RecipientFundDistribution collections.Map[sdk.AccAddress, math.Int]
 	Distributions             collections.Map[time.Time, math.Int] // key: time.Time | value: amount
 	LastBalance               collections.Item[math.Int]
+	// ReceiptShares key: Account | value: share of the receipts routed to the community pool
+	ReceiptShares collections.Map[sdk.AccAddress, math.LegacyDec]
+	// PendingReceipts key: Account, Denom | value: amount to route to the community pool at the end of the block
+	PendingReceipts collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+	// SpendMsgPermissions key: Signer, MsgTypeURL | the messages recipients can execute as part of a community pool spend
+	SpendMsgPermissions collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+}
+
+// msgSignersCodec is implemented by the codecs able to extract the signers of a message.
+type msgSignersCodec interface {
+	GetMsgSigners(msg gogoproto.Message) ([][]byte, protoreflect.Message, error)
 }
 
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string,
@@ -68,6 +84,9 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		RecipientFundDistribution: collections.NewMap(sb, types.RecipientFundDistributionKey, "recipient_fund_distribution", sdk.AccAddressKey, sdk.IntValue),
 		Distributions:             collections.NewMap(sb, types.DistributionsKey, "distributions", sdk.TimeKey, sdk.IntValue),
 		LastBalance:               collections.NewItem(sb, types.LastBalanceKey, "last_balance", sdk.IntValue),
+		ReceiptShares:             collections.NewMap(sb, types.ReceiptSharesKey, "receipt_shares", sdk.AccAddressKey, sdk.LegacyDecValue),
+		PendingReceipts:           collections.NewMap(sb, types.PendingReceiptsKey, "pending_receipts", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), sdk.IntValue),
+		SpendMsgPermissions:       collections.NewKeySet(sb, types.SpendMsgAllowlistKey, "spend_msg_allowlist", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)),
 	}
 
 	schema, err := sb.Build()
@@ -89,6 +108,82 @@ func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender
 	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
 }
 
+// FundCommunityPoolFromModule allows a module to directly fund the community
+// fund pool from its module account.
+func (k Keeper) FundCommunityPoolFromModule(ctx context.Context, amount sdk.Coins, senderModule string) error {
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, amount)
+}
+
+// validateRecipientMsgs checks that the messages of a community pool spend can
+// be executed on behalf of the recipient. Only module accounts can execute
+// messages, each of them having the recipient as its only signer and a type
+// allowed for the recipient in the spend msg allowlist.
+func (k Keeper) validateRecipientMsgs(ctx context.Context, recipient sdk.AccAddress, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if _, ok := k.authKeeper.GetAccount(ctx, recipient).(sdk.ModuleAccountI); !ok {
+		return sdkerrors.ErrInvalidRequest.Wrap("recipient must be a module account to execute messages")
+	}
+
+	signersCodec, ok := k.cdc.(msgSignersCodec)
+	if !ok {
+		return fmt.Errorf("codec %T cannot extract message signers", k.cdc)
+	}
+
+	for i, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid message %d: %s", i, err)
+			}
+		}
+
+		signers, _, err := signersCodec.GetMsgSigners(msg)
+		if err != nil {
+			return err
+		}
+
+		if len(signers) != 1 || !bytes.Equal(signers[0], recipient) {
+			return sdkerrors.ErrUnauthorized.Wrapf("message %d (%s) must be signed by the recipient only", i, sdk.MsgTypeURL(msg))
+		}
+
+		allowed, err := k.SpendMsgPermissions.Has(ctx, collections.Join(recipient, sdk.MsgTypeURL(msg)))
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return sdkerrors.ErrUnauthorized.Wrapf("message %d (%s) is not allowed for the recipient", i, sdk.MsgTypeURL(msg))
+		}
+
+		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("message %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// executeRecipientMsgs executes the messages of a community pool spend, once
+// the funds have been transferred to the recipient, and returns their responses.
+func (k Keeper) executeRecipientMsgs(ctx context.Context, msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	msgResponses := make([]*codectypes.Any, 0, len(msgs))
+	for i, msg := range msgs {
+		res, err := k.MsgRouterService.InvokeUntyped(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d (%s)", i, sdk.MsgTypeURL(msg))
+		}
+
+		resAny, err := codectypes.NewAnyWithValue(res)
+		if err != nil {
+			return nil, err
+		}
+		msgResponses = append(msgResponses, resAny)
+	}
+
+	return msgResponses, nil
+}
+
 // DistributeFromCommunityPool distributes funds from the protocolpool module account to
 // a receiver address.
 func (k Keeper) DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr []byte) error {
@@ -480,3 +575,36 @@ func (k Keeper) validateContinuousFund(ctx context.Context, msg types.MsgCreateC
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	return k.SetToDistribute(ctx)
 }
+
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	return k.RoutePendingReceipts(ctx)
+}
+
+// SetSpendMsgPermission allows the signer to execute messages of the given type
+// as part of a community pool spend.
+func (k Keeper) SetSpendMsgPermission(ctx context.Context, signer sdk.AccAddress, msgTypeURL string) error {
+	if msgTypeURL == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type url cannot be empty")
+	}
+
+	return k.SpendMsgPermissions.Set(ctx, collections.Join(signer, msgTypeURL))
+}
+
+// GetSpendMsgPermissions returns the messages community pool spend recipients
+// can execute, ordered by signer.
+func (k Keeper) GetSpendMsgPermissions(ctx context.Context) ([]*types.SpendMsgPermission, error) {
+	var permissions []*types.SpendMsgPermission
+	err := k.SpendMsgPermissions.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string]) (stop bool, err error) {
+		signer, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		permissions = append(permissions, &types.SpendMsgPermission{
+			Signer:     signer,
+			MsgTypeUrl: key.K2(),
+		})
+		return false, nil
+	})
+
+	return permissions, err
+}
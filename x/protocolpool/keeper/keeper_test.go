@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
@@ -160,3 +161,37 @@ func (s *KeeperTestSuite) TestIterateAndUpdateFundsDistribution() {
 	})
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestRoutePendingReceipts() {
+	treasury := sdk.AccAddress([]byte("treasuryAddr1___________________"))
+	sender := sdk.AccAddress([]byte("senderAddr1_____________________"))
+	other := sdk.AccAddress([]byte("otherAddr1______________________"))
+	s.Require().NoError(s.poolKeeper.UpdateReceiptShare(s.ctx, treasury, math.LegacyMustNewDecFromStr("0.1")))
+
+	hooks := s.poolKeeper.Hooks()
+	hooks.TrackBeforeSend(s.ctx, sender, treasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 1005), sdk.NewInt64Coin("foo", 3)))
+	hooks.TrackBeforeSend(s.ctx, sender, treasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)))
+	// receipts of accounts without share and self transfers are not routed
+	hooks.TrackBeforeSend(s.ctx, sender, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	hooks.TrackBeforeSend(s.ctx, treasury, treasury, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+
+	// the share of each receipt is truncated
+	pending, err := s.poolKeeper.PendingReceipts.Get(s.ctx, collections.Join(treasury, "stake"))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(105), pending)
+	has, err := s.poolKeeper.PendingReceipts.Has(s.ctx, collections.Join(treasury, "foo"))
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = s.poolKeeper.PendingReceipts.Has(s.ctx, collections.Join(other, "stake"))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the routed amount is capped by the spendable balance
+	s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), treasury).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 80)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), treasury, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 80))).Return(nil)
+	s.Require().NoError(s.poolKeeper.EndBlocker(s.ctx))
+
+	has, err = s.poolKeeper.PendingReceipts.Has(s.ctx, collections.Join(treasury, "stake"))
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
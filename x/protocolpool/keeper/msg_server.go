@@ -5,6 +5,7 @@ import (
 	errorspkg "errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
@@ -90,6 +91,15 @@ func (k MsgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 		return nil, err
 	}
 
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid messages: %s", err)
+	}
+
+	if err := k.validateRecipientMsgs(ctx, recipient, msgs); err != nil {
+		return nil, err
+	}
+
 	// distribute funds from community pool module account
 	if err := k.Keeper.DistributeFromCommunityPool(ctx, msg.Amount, recipient); err != nil {
 		return nil, err
@@ -97,7 +107,12 @@ func (k MsgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 
 	k.Logger.Info("transferred from the community pool to recipient", "amount", msg.Amount.String(), "recipient", msg.Recipient)
 
-	return &types.MsgCommunityPoolSpendResponse{}, nil
+	msgResponses, err := k.executeRecipientMsgs(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommunityPoolSpendResponse{MsgResponses: msgResponses}, nil
 }
 
 func (k MsgServer) CreateContinuousFund(ctx context.Context, msg *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
@@ -225,6 +240,19 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) SetReceiptShare(ctx context.Context, msg *types.MsgSetReceiptShare) (*types.MsgSetReceiptShareResponse, error) {
+	account, err := k.authKeeper.AddressCodec().StringToBytes(msg.Account)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	if err := k.UpdateReceiptShare(ctx, account, msg.Share); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReceiptShareResponse{}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
@@ -248,3 +276,39 @@ func validateAmount(amount sdk.Coins) error {
 
 	return nil
 }
+
+// UpdateSpendMsgAllowlist updates the messages community pool spend recipients
+// can execute on their behalf.
+func (k MsgServer) UpdateSpendMsgAllowlist(ctx context.Context, msg *types.MsgUpdateSpendMsgAllowlist) (*types.MsgUpdateSpendMsgAllowlistResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, p := range msg.Remove {
+		signer, err := k.authKeeper.AddressCodec().StringToBytes(p.Signer)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+		}
+
+		if err := k.SpendMsgPermissions.Remove(ctx, collections.Join(sdk.AccAddress(signer), p.MsgTypeUrl)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range msg.Add {
+		signer, err := k.authKeeper.AddressCodec().StringToBytes(p.Signer)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+		}
+
+		if err := k.MsgRouterService.CanInvoke(ctx, p.MsgTypeUrl); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("msg type %s: %s", p.MsgTypeUrl, err)
+		}
+
+		if err := k.SetSpendMsgPermission(ctx, signer, p.MsgTypeUrl); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateSpendMsgAllowlistResponse{}, nil
+}
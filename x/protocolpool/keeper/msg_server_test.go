@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/protocolpool/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -1023,6 +1024,61 @@ func (suite *KeeperTestSuite) TestFundCommunityPool() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestSetReceiptShare() {
+	account := []byte("treasuryAddr1___________________")
+	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	accountAddr, err := addrCodec.BytesToString(account)
+	suite.Require().NoError(err)
+
+	testCases := map[string]struct {
+		account  string
+		share    math.LegacyDec
+		expErr   bool
+		expShare math.LegacyDec
+	}{
+		"invalid account": {
+			account: "invalid",
+			share:   math.LegacyMustNewDecFromStr("0.1"),
+			expErr:  true,
+		},
+		"negative share": {
+			account: accountAddr,
+			share:   math.LegacyMustNewDecFromStr("-0.1"),
+			expErr:  true,
+		},
+		"share greater than one": {
+			account: accountAddr,
+			share:   math.LegacyMustNewDecFromStr("1.1"),
+			expErr:  true,
+		},
+		"set share": {
+			account:  accountAddr,
+			share:    math.LegacyMustNewDecFromStr("0.1"),
+			expShare: math.LegacyMustNewDecFromStr("0.1"),
+		},
+		"zero share removes the share": {
+			account:  accountAddr,
+			share:    math.LegacyZeroDec(),
+			expShare: math.LegacyZeroDec(),
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			_, err := suite.msgServer.SetReceiptShare(suite.ctx, types.NewMsgSetReceiptShare(tc.account, tc.share))
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			res, err := suite.queryServer.ReceiptShare(suite.ctx, &types.QueryReceiptShareRequest{Account: accountAddr})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expShare, res.Share)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestCommunityPoolSpend() {
 	recipient := []byte("fundingAddr1____________________")
 	addrCodec := codectestutil.CodecOptions{}.GetAddressCodec()
@@ -1038,4 +1094,38 @@ func (suite *KeeperTestSuite) TestCommunityPoolSpend() {
 		Amount:    amount,
 	})
 	suite.Require().NoError(err)
+
+	// only module accounts can execute messages
+	msg := types.NewMsgCommunityPoolSpend(amount, suite.poolKeeper.GetAuthority(), recipientAddr)
+	suite.Require().NoError(msg.SetMsgs([]sdk.Msg{types.NewMsgFundCommunityPool(amount, recipientAddr)}))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), sdk.AccAddress(recipient)).Return(authtypes.NewBaseAccountWithAddress(recipient)).Times(1)
+
+	_, err = suite.msgServer.CommunityPoolSpend(suite.ctx, msg)
+	suite.Require().ErrorContains(err, "recipient must be a module account to execute messages")
+}
+
+func (suite *KeeperTestSuite) TestUpdateSpendMsgAllowlist() {
+	signer := []byte("fundingAddr1____________________")
+	signerAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(signer)
+	suite.Require().NoError(err)
+	msgTypeURL := sdk.MsgTypeURL(&types.MsgFundCommunityPool{})
+
+	_, err = suite.msgServer.UpdateSpendMsgAllowlist(suite.ctx, &types.MsgUpdateSpendMsgAllowlist{
+		Authority: signerAddr,
+		Remove:    []*types.SpendMsgPermission{{Signer: signerAddr, MsgTypeUrl: msgTypeURL}},
+	})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	suite.Require().NoError(suite.poolKeeper.SetSpendMsgPermission(suite.ctx, signer, msgTypeURL))
+	suite.Require().ErrorContains(suite.poolKeeper.SetSpendMsgPermission(suite.ctx, signer, ""), "msg type url cannot be empty")
+
+	_, err = suite.msgServer.UpdateSpendMsgAllowlist(suite.ctx, &types.MsgUpdateSpendMsgAllowlist{
+		Authority: suite.poolKeeper.GetAuthority(),
+		Remove:    []*types.SpendMsgPermission{{Signer: signerAddr, MsgTypeUrl: msgTypeURL}},
+	})
+	suite.Require().NoError(err)
+
+	permissions, err := suite.poolKeeper.GetSpendMsgPermissions(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(permissions)
 }
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateReceiptShare sets the share of the coins received by the account which is
+// routed to the community pool. A zero share stops routing the receipts of the
+// account.
+func (k Keeper) UpdateReceiptShare(ctx context.Context, account sdk.AccAddress, share math.LegacyDec) error {
+	if share.IsNil() || share.IsZero() {
+		return k.ReceiptShares.Remove(ctx, account)
+	}
+
+	if err := types.ValidateReceiptShare(share); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return k.ReceiptShares.Set(ctx, account, share)
+}
+
+// GetReceiptShare returns the share of the coins received by the account which
+// is routed to the community pool, zero if its receipts are not routed.
+func (k Keeper) GetReceiptShare(ctx context.Context, account sdk.AccAddress) (math.LegacyDec, error) {
+	share, err := k.ReceiptShares.Get(ctx, account)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return share, err
+}
+
+// trackReceipt records the share of the coins received by the account to be
+// routed to the community pool at the end of the block. The share of each
+// receipt is truncated.
+func (k Keeper) trackReceipt(ctx context.Context, account sdk.AccAddress, amount sdk.Coins) error {
+	share, err := k.ReceiptShares.Get(ctx, account)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	for _, coin := range amount {
+		routed := math.LegacyNewDecFromInt(coin.Amount).Mul(share).TruncateInt()
+		if !routed.IsPositive() {
+			continue
+		}
+
+		key := collections.Join(account, coin.Denom)
+		pending, err := k.PendingReceipts.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			pending = math.ZeroInt()
+		}
+
+		if err := k.PendingReceipts.Set(ctx, key, pending.Add(routed)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RoutePendingReceipts transfers the pending receipts of the accounts to the
+// community pool. The routed amounts are capped by the spendable balances of
+// the accounts, as the received coins may have been spent in the meantime. A
+// failed transfer is logged and skipped.
+func (k Keeper) RoutePendingReceipts(ctx context.Context) error {
+	type pendingReceipts struct {
+		account sdk.AccAddress
+		amount  sdk.Coins
+	}
+
+	// pending receipts are walked in order, grouping the denoms of an account
+	var pending []pendingReceipts
+	err := k.PendingReceipts.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (stop bool, err error) {
+		coin := sdk.NewCoin(key.K2(), amount)
+		if n := len(pending); n > 0 && pending[n-1].account.Equals(key.K1()) {
+			pending[n-1].amount = pending[n-1].amount.Add(coin)
+		} else {
+			pending = append(pending, pendingReceipts{account: key.K1(), amount: sdk.NewCoins(coin)})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.PendingReceipts.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, p := range pending {
+		spendable := k.bankKeeper.SpendableCoins(ctx, p.account)
+
+		routed := sdk.NewCoins()
+		for _, coin := range p.amount {
+			routed = routed.Add(sdk.NewCoin(coin.Denom, math.MinInt(coin.Amount, spendable.AmountOf(coin.Denom))))
+		}
+		if routed.IsZero() {
+			continue
+		}
+
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.FundCommunityPool(ctx, routed, p.account)
+		}); err != nil {
+			k.Logger.Error("failed to route receipts to the community pool", "account", p.account, "amount", routed, "err", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFundCommunityPoolAuthorization creates a new FundCommunityPoolAuthorization object.
+func NewFundCommunityPoolAuthorization(spendLimit sdk.Coins) *FundCommunityPoolAuthorization {
+	return &FundCommunityPoolAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FundCommunityPoolAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgFundCommunityPool{})
+}
+
+// Accept implements Authorization.Accept.
+func (a FundCommunityPoolAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mFund, ok := msg.(*MsgFundCommunityPool)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(mFund.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &FundCommunityPoolAuthorization{SpendLimit: limitLeft}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FundCommunityPoolAuthorization) ValidateBasic() error {
+	if len(a.SpendLimit) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit %s", a.SpendLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/protocolpool/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FundCommunityPoolAuthorization allows the grantee to fund the community pool
+// with up to spend_limit coins from the granter's account.
+type FundCommunityPoolAuthorization struct {
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *FundCommunityPoolAuthorization) Reset()         { *m = FundCommunityPoolAuthorization{} }
+func (m *FundCommunityPoolAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FundCommunityPoolAuthorization) ProtoMessage()    {}
+func (*FundCommunityPoolAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cde22464d4cf20a, []int{0}
+}
+func (m *FundCommunityPoolAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundCommunityPoolAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundCommunityPoolAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundCommunityPoolAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundCommunityPoolAuthorization.Merge(m, src)
+}
+func (m *FundCommunityPoolAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundCommunityPoolAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundCommunityPoolAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundCommunityPoolAuthorization proto.InternalMessageInfo
+
+func (m *FundCommunityPoolAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FundCommunityPoolAuthorization)(nil), "cosmos.protocolpool.v1.FundCommunityPoolAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/protocolpool/v1/authz.proto", fileDescriptor_8cde22464d4cf20a)
+}
+
+var fileDescriptor_8cde22464d4cf20a = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0xbd, 0x4a, 0x03, 0x31,
+	0x1c, 0xbf, 0x43, 0x70, 0xb8, 0x6e, 0x45, 0xc4, 0xde, 0x90, 0x6a, 0x07, 0xe9, 0xd2, 0xc4, 0xd3,
+	0x4d, 0x27, 0x5b, 0x70, 0x72, 0x10, 0x47, 0x97, 0x72, 0x5f, 0xdc, 0x85, 0x26, 0xf9, 0x1f, 0x4d,
+	0x72, 0xd8, 0x3e, 0x85, 0xcf, 0xe1, 0x2c, 0x3e, 0x43, 0x71, 0xea, 0xe8, 0xa4, 0x72, 0xf7, 0x22,
+	0x72, 0x49, 0x0a, 0xed, 0x94, 0xf0, 0xfb, 0xfc, 0xf3, 0x0b, 0x46, 0x29, 0x48, 0x0e, 0x92, 0x54,
+	0x4b, 0x50, 0x90, 0x02, 0xab, 0x00, 0x18, 0xa9, 0x23, 0x12, 0x6b, 0x55, 0xae, 0xb1, 0x41, 0xfb,
+	0xa7, 0x56, 0x83, 0xf7, 0x35, 0xb8, 0x8e, 0xc2, 0x93, 0x02, 0x0a, 0x30, 0x20, 0xe9, 0x7e, 0x96,
+	0x0f, 0x07, 0x56, 0x3d, 0xb7, 0xc4, 0xbe, 0x35, 0x44, 0xae, 0x2c, 0x89, 0x65, 0x4e, 0xea, 0x28,
+	0xc9, 0x55, 0x1c, 0x91, 0x14, 0xa8, 0xb0, 0xfc, 0xe8, 0xd3, 0x0f, 0xd0, 0x83, 0x16, 0xd9, 0x0c,
+	0x38, 0xd7, 0x82, 0xaa, 0xd5, 0x13, 0x00, 0xbb, 0xd7, 0xaa, 0x84, 0x25, 0x5d, 0xc7, 0x8a, 0x82,
+	0xe8, 0xb3, 0xa0, 0x27, 0xab, 0x5c, 0x64, 0x73, 0x46, 0x39, 0x55, 0x67, 0xfe, 0xf9, 0xd1, 0xb8,
+	0x77, 0x3d, 0xc0, 0xae, 0xa6, 0x0b, 0xc6, 0x2e, 0x18, 0xcf, 0x80, 0x8a, 0xe9, 0xd5, 0xe6, 0x67,
+	0xe8, 0xbd, 0xff, 0x0e, 0xc7, 0x05, 0x55, 0xa5, 0x4e, 0x70, 0x0a, 0xdc, 0xdd, 0xe4, 0x9e, 0x89,
+	0xcc, 0x16, 0x44, 0xad, 0xaa, 0x5c, 0x1a, 0x83, 0x7c, 0x0e, 0x4c, 0xfe, 0x63, 0x17, 0x7f, 0x7b,
+	0xf9, 0xf5, 0x31, 0x71, 0x0b, 0x61, 0xbb, 0xc8, 0x2e, 0xfc, 0xe0, 0xaa, 0xe9, 0xdd, 0xa6, 0x41,
+	0xfe, 0xb6, 0x41, 0xfe, 0x5f, 0x83, 0xfc, 0xb7, 0x16, 0x79, 0xdb, 0x16, 0x79, 0xdf, 0x2d, 0xf2,
+	0x5e, 0x2e, 0xac, 0x5b, 0x66, 0x0b, 0x4c, 0x81, 0xbc, 0x1e, 0xee, 0x6c, 0x6a, 0x93, 0x63, 0x83,
+	0xdd, 0xfc, 0x0f, 0x00, 0xe1, 0x80, 0xdf, 0xad, 0x8b, 0x01, 0x00, 0x00,
+}
+
+func (m *FundCommunityPoolAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundCommunityPoolAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundCommunityPoolAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FundCommunityPoolAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FundCommunityPoolAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundCommunityPoolAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundCommunityPoolAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFundCommunityPoolAuthorization(t *testing.T) {
+	ctx := context.Background()
+	depositor := "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4"
+	auth := types.NewFundCommunityPoolAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "/cosmos.protocolpool.v1.MsgFundCommunityPool", auth.MsgTypeURL())
+
+	// fund less than the spend limit
+	resp, err := auth.Accept(ctx, types.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), depositor))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewFundCommunityPoolAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 60))), resp.Updated)
+
+	// fund more than the spend limit
+	_, err = auth.Accept(ctx, types.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), depositor))
+	require.Error(t, err)
+
+	// fund a denom not in the spend limit
+	_, err = auth.Accept(ctx, types.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), depositor))
+	require.Error(t, err)
+
+	// fund the whole spend limit
+	resp, err = auth.Accept(ctx, types.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), depositor))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// wrong msg type
+	_, err = auth.Accept(ctx, types.NewMsgCommunityPoolSpend(nil, depositor, depositor))
+	require.Error(t, err)
+
+	require.Error(t, types.NewFundCommunityPoolAuthorization(nil).ValidateBasic())
+}
@@ -17,6 +17,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgCreateContinuousFund{},
 		&MsgCancelContinuousFund{},
 		&MsgWithdrawContinuousFund{},
+		&MsgSetReceiptShare{},
+		&MsgUpdateSpendMsgAllowlist{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -37,6 +38,44 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+
+	seenAccounts := make(map[string]bool, len(gs.ReceiptShares))
+	for _, rs := range gs.ReceiptShares {
+		if seenAccounts[rs.Account] {
+			return fmt.Errorf("duplicate receipt share for account %s", rs.Account)
+		}
+		seenAccounts[rs.Account] = true
+
+		if err := ValidateReceiptShare(rs.Share); err != nil {
+			return err
+		}
+	}
+
+	seenPermissions := make(map[SpendMsgPermission]bool, len(gs.SpendMsgAllowlist))
+	for _, p := range gs.SpendMsgAllowlist {
+		if p.Signer == "" {
+			return errors.New("spend msg permission signer cannot be empty")
+		}
+		if p.MsgTypeUrl == "" {
+			return errors.New("spend msg permission msg type url cannot be empty")
+		}
+		if seenPermissions[*p] {
+			return fmt.Errorf("duplicate spend msg permission for signer %s and msg %s", p.Signer, p.MsgTypeUrl)
+		}
+		seenPermissions[*p] = true
+	}
+	return nil
+}
+
+// ValidateReceiptShare validates the share of receipts routed to the community
+// pool, which must be a fraction in (0, 1].
+func ValidateReceiptShare(share math.LegacyDec) error {
+	if share.IsNil() || !share.IsPositive() {
+		return errors.New("receipt share must be positive")
+	}
+	if share.GT(math.LegacyOneDec()) {
+		return errors.New("receipt share cannot be greater than one")
+	}
 	return nil
 }
 
@@ -42,6 +42,12 @@ type GenesisState struct {
 	// funds and budgets. It contains time in order to distribute to non-expired
 	// funds only.
 	Distributions []*Distribution `protobuf:"bytes,4,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	// receipt_shares contains the shares of the receipts of accounts routed to
+	// the community pool.
+	ReceiptShares []*ReceiptShare `protobuf:"bytes,5,rep,name=receipt_shares,json=receiptShares,proto3" json:"receipt_shares,omitempty"`
+	// spend_msg_allowlist contains the messages community pool spend recipients
+	// can execute on their behalf.
+	SpendMsgAllowlist []*SpendMsgPermission `protobuf:"bytes,6,rep,name=spend_msg_allowlist,json=spendMsgAllowlist,proto3" json:"spend_msg_allowlist,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +104,20 @@ func (m *GenesisState) GetDistributions() []*Distribution {
 	return nil
 }
 
+func (m *GenesisState) GetReceiptShares() []*ReceiptShare {
+	if m != nil {
+		return m.ReceiptShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpendMsgAllowlist() []*SpendMsgPermission {
+	if m != nil {
+		return m.SpendMsgAllowlist
+	}
+	return nil
+}
+
 type Distribution struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	Time   *time.Time            `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time,omitempty"`
@@ -153,32 +173,37 @@ func init() {
 }
 
 var fileDescriptor_72560a99455b4146 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xca, 0xd3, 0x40,
-	0x14, 0xc5, 0x33, 0x5f, 0x4b, 0xc0, 0x69, 0x55, 0x08, 0x2a, 0x31, 0x8b, 0xa4, 0x96, 0x22, 0x05,
-	0x71, 0x42, 0xab, 0xb8, 0x71, 0x97, 0x8a, 0x52, 0x17, 0x0a, 0xd1, 0x95, 0x9b, 0x92, 0x3f, 0xd3,
-	0x18, 0x4c, 0xe6, 0x86, 0xce, 0x4c, 0xd1, 0x47, 0x70, 0xd7, 0x77, 0xd1, 0x87, 0xe8, 0xb2, 0xb8,
-	0x12, 0x17, 0x55, 0xda, 0x17, 0x91, 0xce, 0xa4, 0x92, 0x80, 0xdd, 0x7c, 0xbb, 0xc9, 0xbd, 0xbf,
-	0x73, 0x72, 0xe0, 0x5c, 0x3c, 0x4a, 0x80, 0x97, 0xc0, 0xfd, 0x6a, 0x05, 0x02, 0x12, 0x28, 0x2a,
-	0x80, 0xc2, 0x5f, 0x4f, 0xfc, 0x8c, 0x32, 0xca, 0x73, 0x4e, 0xd4, 0xdc, 0xba, 0xa7, 0x29, 0xd2,
-	0xa4, 0xc8, 0x7a, 0xe2, 0x0c, 0x2f, 0xa8, 0xc5, 0x97, 0x8a, 0xd6, 0xb4, 0x73, 0x27, 0x83, 0x0c,
-	0xd4, 0xd3, 0x3f, 0xbd, 0xea, 0xe9, 0x7d, 0xad, 0x5c, 0xe8, 0x45, 0xd3, 0xde, 0xf1, 0x32, 0x80,
-	0xac, 0xa0, 0xda, 0x34, 0x96, 0x4b, 0x5f, 0xe4, 0x25, 0xe5, 0x22, 0x2a, 0x2b, 0x0d, 0x0c, 0xbf,
-	0x5d, 0xe1, 0xfe, 0x2b, 0x9d, 0xef, 0x9d, 0x88, 0x04, 0xb5, 0xde, 0xe2, 0xdb, 0x09, 0x30, 0x91,
-	0x33, 0x09, 0x92, 0x2f, 0x96, 0x92, 0xa5, 0x36, 0x1a, 0x74, 0xc6, 0xbd, 0xe9, 0x43, 0xf2, 0xff,
-	0xe0, 0x64, 0xf6, 0x0f, 0x7f, 0x29, 0x59, 0x1a, 0xde, 0x4a, 0x5a, 0xdf, 0xd6, 0x33, 0x6c, 0xc6,
-	0x32, 0xcd, 0xa8, 0xb0, 0xaf, 0x94, 0x8f, 0x7b, 0xc9, 0x27, 0x50, 0x54, 0x58, 0xd3, 0xd6, 0x1b,
-	0xdc, 0x2f, 0x22, 0x2e, 0x16, 0x71, 0x54, 0x44, 0x2c, 0xa1, 0x76, 0x67, 0x80, 0xc6, 0x37, 0x82,
-	0x47, 0xdb, 0xbd, 0x67, 0xfc, 0xda, 0x7b, 0x77, 0xb5, 0x09, 0x4f, 0x3f, 0x91, 0x1c, 0xfc, 0x32,
-	0x12, 0x1f, 0xc9, 0x9c, 0x89, 0x1f, 0xdf, 0x1f, 0xe3, 0xda, 0x7d, 0xce, 0x44, 0xd8, 0x3b, 0x19,
-	0x04, 0x5a, 0x6f, 0xbd, 0xc6, 0x37, 0xd3, 0x9c, 0x8b, 0x55, 0x1e, 0x4b, 0x91, 0x03, 0xe3, 0x76,
-	0x57, 0xc5, 0x19, 0x5d, 0x8a, 0xf3, 0xa2, 0x01, 0x87, 0x6d, 0xe9, 0xf0, 0x2b, 0xc2, 0xfd, 0xe6,
-	0xde, 0x9a, 0x61, 0x33, 0x2a, 0x41, 0x32, 0x71, 0x9d, 0x98, 0xb5, 0xd4, 0x7a, 0x8a, 0xbb, 0xa7,
-	0x7a, 0x6c, 0x73, 0x80, 0xc6, 0xbd, 0xa9, 0x43, 0x74, 0x77, 0xe4, 0xdc, 0x1d, 0x79, 0x7f, 0xee,
-	0x2e, 0xe8, 0x6e, 0x7e, 0x7b, 0x28, 0x54, 0x74, 0xf0, 0x7c, 0x7b, 0x70, 0xd1, 0xee, 0xe0, 0xa2,
-	0x3f, 0x07, 0x17, 0x6d, 0x8e, 0xae, 0xb1, 0x3b, 0xba, 0xc6, 0xcf, 0xa3, 0x6b, 0x7c, 0x78, 0xd0,
-	0xfa, 0xf9, 0xe7, 0xf6, 0x65, 0xa9, 0xb3, 0x8a, 0x4d, 0x35, 0x7b, 0xf2, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x0c, 0x77, 0xdd, 0xf7, 0xbb, 0x02, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x1b, 0x5b, 0x03, 0x4e, 0xbb, 0x2b, 0x8e, 0x2f, 0xc4, 0x1e, 0xd2, 0x5a, 0x16, 0x29,
+	0x8a, 0x13, 0x76, 0x15, 0x2f, 0x9e, 0xcc, 0x8a, 0xb2, 0x8a, 0x2f, 0xa4, 0x9e, 0xf6, 0x12, 0x26,
+	0xc9, 0x6c, 0x76, 0x30, 0x99, 0x09, 0x79, 0x26, 0xab, 0x7e, 0x04, 0x6f, 0xfb, 0x61, 0xfc, 0x10,
+	0x7b, 0x5c, 0x3c, 0x89, 0x48, 0x95, 0xf6, 0x8b, 0x48, 0x66, 0x52, 0x49, 0xc1, 0x7a, 0xd8, 0xdb,
+	0xcc, 0xd3, 0xdf, 0xff, 0xd7, 0x27, 0xe1, 0x1f, 0xb4, 0x13, 0x4b, 0xc8, 0x25, 0x78, 0x45, 0x29,
+	0x95, 0x8c, 0x65, 0x56, 0x48, 0x99, 0x79, 0x27, 0xbb, 0x5e, 0xca, 0x04, 0x03, 0x0e, 0x44, 0xcf,
+	0xf1, 0x2d, 0x43, 0x91, 0x36, 0x45, 0x4e, 0x76, 0x87, 0x93, 0x0d, 0x69, 0xf5, 0xb9, 0x60, 0x0d,
+	0x3d, 0xbc, 0x91, 0xca, 0x54, 0xea, 0xa3, 0x57, 0x9f, 0x9a, 0xe9, 0x6d, 0x93, 0x0c, 0xcd, 0x0f,
+	0x6d, 0xfd, 0x70, 0x94, 0x4a, 0x99, 0x66, 0xcc, 0x48, 0xa3, 0xea, 0xc8, 0x53, 0x3c, 0x67, 0xa0,
+	0x68, 0x5e, 0x18, 0x60, 0xf2, 0xb3, 0x8b, 0x06, 0x2f, 0xcc, 0x7e, 0x33, 0x45, 0x15, 0xc3, 0x6f,
+	0xd1, 0xd5, 0x58, 0x0a, 0xc5, 0x45, 0x25, 0x2b, 0x08, 0x8f, 0x2a, 0x91, 0x38, 0xd6, 0xb8, 0x3b,
+	0xed, 0xef, 0xdd, 0x25, 0xff, 0x5e, 0x9c, 0xec, 0xff, 0xc5, 0x9f, 0x57, 0x22, 0x09, 0xb6, 0xe3,
+	0xb5, 0x3b, 0x7e, 0x8c, 0xec, 0xa8, 0x4a, 0x52, 0xa6, 0x9c, 0x4b, 0xda, 0xe3, 0x6e, 0xf2, 0xf8,
+	0x9a, 0x0a, 0x1a, 0x1a, 0xbf, 0x41, 0x83, 0x8c, 0x82, 0x0a, 0x23, 0x9a, 0x51, 0x11, 0x33, 0xa7,
+	0x3b, 0xb6, 0xa6, 0x57, 0xfc, 0xfb, 0x67, 0xf3, 0x51, 0xe7, 0xc7, 0x7c, 0x74, 0xd3, 0x48, 0x20,
+	0xf9, 0x40, 0xb8, 0xf4, 0x72, 0xaa, 0x8e, 0xc9, 0x81, 0x50, 0xdf, 0xbe, 0x3e, 0x40, 0x8d, 0xfd,
+	0x40, 0xa8, 0xa0, 0x5f, 0x0b, 0x7c, 0x93, 0xc7, 0x2f, 0xd1, 0x56, 0xc2, 0x41, 0x95, 0x3c, 0xaa,
+	0x14, 0x97, 0x02, 0x9c, 0x9e, 0x5e, 0x67, 0x67, 0xd3, 0x3a, 0xcf, 0x5a, 0x70, 0xb0, 0x1e, 0xc5,
+	0xaf, 0xd0, 0x76, 0xc9, 0x62, 0xc6, 0x0b, 0x15, 0xc2, 0x31, 0x2d, 0x19, 0x38, 0x97, 0xff, 0x2f,
+	0x0b, 0x0c, 0x3d, 0xab, 0xe1, 0x60, 0xab, 0x6c, 0xdd, 0x00, 0x1f, 0xa2, 0xeb, 0x50, 0x30, 0x91,
+	0x84, 0x39, 0xa4, 0x21, 0xcd, 0x32, 0xf9, 0x31, 0xe3, 0xa0, 0x1c, 0x5b, 0x1b, 0xef, 0x6d, 0x32,
+	0xce, 0xea, 0xc8, 0x6b, 0x48, 0xdf, 0xb1, 0x32, 0xe7, 0x00, 0xf5, 0x92, 0xd7, 0xa0, 0x99, 0x3d,
+	0x5d, 0x49, 0x26, 0x5f, 0x2c, 0x34, 0x68, 0x3f, 0x08, 0xde, 0x47, 0x36, 0xcd, 0x65, 0x25, 0xd4,
+	0x45, 0xde, 0x67, 0x13, 0xc5, 0x8f, 0x50, 0xaf, 0xee, 0x91, 0x63, 0x8f, 0xad, 0x69, 0x7f, 0x6f,
+	0x48, 0x4c, 0xc9, 0xc8, 0xaa, 0x64, 0xe4, 0xfd, 0xaa, 0x64, 0x7e, 0xef, 0xf4, 0xd7, 0xc8, 0x0a,
+	0x34, 0xed, 0x3f, 0x39, 0x5b, 0xb8, 0xd6, 0xf9, 0xc2, 0xb5, 0x7e, 0x2f, 0x5c, 0xeb, 0x74, 0xe9,
+	0x76, 0xce, 0x97, 0x6e, 0xe7, 0xfb, 0xd2, 0xed, 0x1c, 0xde, 0x59, 0xfb, 0xf3, 0x4f, 0xeb, 0x9f,
+	0x80, 0xee, 0x7f, 0x64, 0xeb, 0xd9, 0xc3, 0x3f, 0x03, 0x00, 0x1b, 0x64, 0x98, 0x30, 0x64, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -201,6 +226,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpendMsgAllowlist) > 0 {
+		for iNdEx := len(m.SpendMsgAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendMsgAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReceiptShares) > 0 {
+		for iNdEx := len(m.ReceiptShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Distributions) > 0 {
 		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -336,6 +389,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ReceiptShares) > 0 {
+		for _, e := range m.ReceiptShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpendMsgAllowlist) > 0 {
+		for _, e := range m.SpendMsgAllowlist {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -525,6 +590,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptShares = append(m.ReceiptShares, &ReceiptShare{})
+			if err := m.ReceiptShares[len(m.ReceiptShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendMsgAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendMsgAllowlist = append(m.SpendMsgAllowlist, &SpendMsgPermission{})
+			if err := m.SpendMsgAllowlist[len(m.SpendMsgAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
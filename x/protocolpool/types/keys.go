@@ -31,4 +31,7 @@ var (
 	RecipientFundDistributionKey = collections.NewPrefix(5)
 	DistributionsKey             = collections.NewPrefix(6)
 	LastBalanceKey               = collections.NewPrefix(7)
+	ReceiptSharesKey             = collections.NewPrefix(8)
+	PendingReceiptsKey           = collections.NewPrefix(9)
+	SpendMsgAllowlistKey         = collections.NewPrefix(10)
 )
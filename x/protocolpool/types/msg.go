@@ -1,12 +1,19 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgSetReceiptShare)(nil)
+
+	_ gogoprotoany.UnpackInterfacesMessage = (*MsgCommunityPoolSpend)(nil)
 )
 
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
@@ -27,3 +34,33 @@ func NewMsgCommunityPoolSpend(amount sdk.Coins, authority, recipient string) *Ms
 		Amount:    amount,
 	}
 }
+
+// NewMsgSetReceiptShare returns a new MsgSetReceiptShare routing the given share
+// of the coins received by the account to the community pool.
+func NewMsgSetReceiptShare(account string, share math.LegacyDec) *MsgSetReceiptShare {
+	return &MsgSetReceiptShare{
+		Account: account,
+		Share:   share,
+	}
+}
+
+// SetMsgs packs the given messages into the messages executed after the transfer.
+func (m *MsgCommunityPoolSpend) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	m.Messages = anys
+	return nil
+}
+
+// GetMsgs unpacks the messages executed after the transfer.
+func (m *MsgCommunityPoolSpend) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "MsgCommunityPoolSpend")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *MsgCommunityPoolSpend) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -253,11 +254,188 @@ func (m *QueryUnclaimedBudgetResponse) GetTranchesLeft() uint64 {
 	return 0
 }
 
+// QueryReceiptShareRequest is the request type for the Query/ReceiptShare RPC
+// method.
+type QueryReceiptShareRequest struct {
+	// account is the address to query the receipt share for.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryReceiptShareRequest) Reset()         { *m = QueryReceiptShareRequest{} }
+func (m *QueryReceiptShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptShareRequest) ProtoMessage()    {}
+func (*QueryReceiptShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{4}
+}
+func (m *QueryReceiptShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptShareRequest.Merge(m, src)
+}
+func (m *QueryReceiptShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptShareRequest proto.InternalMessageInfo
+
+func (m *QueryReceiptShareRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// QueryReceiptShareResponse is the response type for the Query/ReceiptShare
+// RPC method.
+type QueryReceiptShareResponse struct {
+	// share is the fraction of the received coins routed to the community pool,
+	// zero if the receipts of the account are not routed.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *QueryReceiptShareResponse) Reset()         { *m = QueryReceiptShareResponse{} }
+func (m *QueryReceiptShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptShareResponse) ProtoMessage()    {}
+func (*QueryReceiptShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{5}
+}
+func (m *QueryReceiptShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptShareResponse.Merge(m, src)
+}
+func (m *QueryReceiptShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptShareResponse proto.InternalMessageInfo
+
+// QuerySpendMsgAllowlistRequest is the request type for the
+// Query/SpendMsgAllowlist RPC method.
+type QuerySpendMsgAllowlistRequest struct {
+}
+
+func (m *QuerySpendMsgAllowlistRequest) Reset()         { *m = QuerySpendMsgAllowlistRequest{} }
+func (m *QuerySpendMsgAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendMsgAllowlistRequest) ProtoMessage()    {}
+func (*QuerySpendMsgAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{6}
+}
+func (m *QuerySpendMsgAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendMsgAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendMsgAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendMsgAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendMsgAllowlistRequest.Merge(m, src)
+}
+func (m *QuerySpendMsgAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendMsgAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendMsgAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendMsgAllowlistRequest proto.InternalMessageInfo
+
+// QuerySpendMsgAllowlistResponse is the response type for the
+// Query/SpendMsgAllowlist RPC method.
+type QuerySpendMsgAllowlistResponse struct {
+	// permissions are the messages recipients can execute, ordered by signer.
+	Permissions []*SpendMsgPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (m *QuerySpendMsgAllowlistResponse) Reset()         { *m = QuerySpendMsgAllowlistResponse{} }
+func (m *QuerySpendMsgAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendMsgAllowlistResponse) ProtoMessage()    {}
+func (*QuerySpendMsgAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{7}
+}
+func (m *QuerySpendMsgAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendMsgAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendMsgAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendMsgAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendMsgAllowlistResponse.Merge(m, src)
+}
+func (m *QuerySpendMsgAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendMsgAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendMsgAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendMsgAllowlistResponse proto.InternalMessageInfo
+
+func (m *QuerySpendMsgAllowlistResponse) GetPermissions() []*SpendMsgPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolResponse")
 	proto.RegisterType((*QueryUnclaimedBudgetRequest)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetRequest")
 	proto.RegisterType((*QueryUnclaimedBudgetResponse)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetResponse")
+	proto.RegisterType((*QueryReceiptShareRequest)(nil), "cosmos.protocolpool.v1.QueryReceiptShareRequest")
+	proto.RegisterType((*QueryReceiptShareResponse)(nil), "cosmos.protocolpool.v1.QueryReceiptShareResponse")
+	proto.RegisterType((*QuerySpendMsgAllowlistRequest)(nil), "cosmos.protocolpool.v1.QuerySpendMsgAllowlistRequest")
+	proto.RegisterType((*QuerySpendMsgAllowlistResponse)(nil), "cosmos.protocolpool.v1.QuerySpendMsgAllowlistResponse")
 }
 
 func init() {
@@ -265,46 +443,58 @@ func init() {
 }
 
 var fileDescriptor_51500a0a77d57843 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xd3, 0xb4, 0x9f, 0xbe, 0x29, 0xa1, 0x68, 0x54, 0xa1, 0xd4, 0xad, 0x9c, 0x12, 0x24,
-	0x14, 0x09, 0x75, 0x46, 0x49, 0x2b, 0x21, 0xc1, 0x86, 0xa6, 0x15, 0x62, 0xc1, 0x82, 0x1a, 0xd8,
-	0xb0, 0xb1, 0xfc, 0x33, 0x71, 0xad, 0xda, 0x73, 0x5d, 0xcf, 0xb8, 0x6a, 0x85, 0xd8, 0xc0, 0x0b,
-	0x54, 0x62, 0xc3, 0x13, 0xb0, 0x60, 0xcd, 0x82, 0x47, 0xe8, 0x0a, 0x55, 0xb0, 0x61, 0x45, 0x51,
-	0xcb, 0x8e, 0x97, 0x40, 0x1e, 0x8f, 0xa1, 0x8d, 0x12, 0x50, 0x57, 0x49, 0xee, 0xb9, 0xe7, 0xdc,
-	0x73, 0x72, 0xef, 0xa0, 0x8e, 0x0f, 0x22, 0x01, 0x41, 0xd3, 0x0c, 0x24, 0xf8, 0x10, 0xa7, 0x00,
-	0x31, 0xdd, 0xeb, 0xd1, 0xdd, 0x9c, 0x65, 0x07, 0x44, 0x55, 0xf1, 0xf5, 0xb2, 0x87, 0x9c, 0xef,
-	0x21, 0x7b, 0x3d, 0x73, 0x3e, 0x84, 0x10, 0x54, 0x91, 0x16, 0xdf, 0x4a, 0xdc, 0x5c, 0x0a, 0x01,
-	0xc2, 0x98, 0x51, 0x37, 0x8d, 0xa8, 0xcb, 0x39, 0x48, 0x57, 0x46, 0xc0, 0x35, 0xdb, 0xb4, 0xf4,
-	0x3c, 0xcf, 0x15, 0x8c, 0xee, 0xf5, 0x3c, 0x26, 0xdd, 0x1e, 0xf5, 0x21, 0xe2, 0x1a, 0x5f, 0x28,
-	0x71, 0xa7, 0x94, 0x3d, 0x3f, 0xd8, 0x6c, 0x6b, 0x61, 0xf5, 0xcb, 0xcb, 0x87, 0x54, 0x46, 0x09,
-	0x13, 0xd2, 0x4d, 0xd2, 0x4a, 0x7b, 0xb4, 0x21, 0xc8, 0x33, 0x35, 0xbc, 0xc4, 0x3b, 0x8b, 0x68,
-	0x61, 0xab, 0x88, 0xb5, 0x01, 0x49, 0x92, 0xf3, 0x48, 0x1e, 0x3c, 0x06, 0x88, 0x6d, 0xb6, 0x9b,
-	0x33, 0x21, 0x3b, 0xaf, 0x0d, 0x64, 0x8e, 0x43, 0x45, 0x0a, 0x5c, 0x30, 0xcc, 0x50, 0xa3, 0x88,
-	0xdd, 0x32, 0x96, 0xa7, 0xba, 0xb3, 0xfd, 0x25, 0xa2, 0x9d, 0x15, 0x31, 0x88, 0x8e, 0x41, 0x36,
-	0x99, 0xbf, 0x01, 0x11, 0x1f, 0xac, 0x1e, 0x7d, 0x6b, 0xd7, 0xde, 0x9f, 0xb4, 0x6f, 0x87, 0x91,
-	0xdc, 0xce, 0x3d, 0xe2, 0x43, 0xa2, 0x93, 0xe8, 0x8f, 0x15, 0x11, 0xec, 0x50, 0x79, 0x90, 0x32,
-	0x51, 0x71, 0x84, 0xad, 0xe4, 0x3b, 0x5b, 0x68, 0x51, 0x99, 0x78, 0xc6, 0xfd, 0xd8, 0x8d, 0x12,
-	0x16, 0x0c, 0xf2, 0x20, 0x64, 0x52, 0x9b, 0xc4, 0x7d, 0xf4, 0x9f, 0x1b, 0x04, 0x19, 0x13, 0xa2,
-	0x65, 0x2c, 0x1b, 0xdd, 0xff, 0x07, 0xad, 0xcf, 0x1f, 0x56, 0xe6, 0xb5, 0x97, 0xf5, 0x12, 0x79,
-	0x22, 0xb3, 0x88, 0x87, 0x76, 0xd5, 0xd8, 0xf9, 0x54, 0x47, 0x4b, 0xe3, 0x35, 0x75, 0xb4, 0xfb,
-	0xe8, 0xaa, 0x06, 0x1c, 0x37, 0x81, 0x9c, 0x4b, 0xa5, 0x3d, 0xdb, 0x5f, 0x18, 0x1b, 0xb2, 0x70,
-	0x6b, 0x37, 0x35, 0x61, 0x5d, 0xf5, 0xe3, 0x4d, 0x74, 0x2d, 0xe7, 0x23, 0x1a, 0xf5, 0x7f, 0x69,
-	0xcc, 0xfd, 0xa6, 0x68, 0x95, 0x87, 0x68, 0x8e, 0xb3, 0x7d, 0xe9, 0xa8, 0xaa, 0x33, 0xcc, 0x20,
-	0x69, 0x4d, 0x29, 0x11, 0x93, 0x94, 0x8b, 0x25, 0xd5, 0x62, 0xc9, 0xd3, 0x6a, 0xf3, 0x83, 0xc6,
-	0xe1, 0x49, 0xdb, 0xb0, 0x9b, 0x05, 0x71, 0xa3, 0xe0, 0x3d, 0xc8, 0x20, 0xc1, 0x77, 0xd0, 0x4c,
-	0xca, 0xb2, 0x08, 0x82, 0x56, 0x43, 0xbb, 0x18, 0x15, 0xd8, 0xd4, 0x97, 0x31, 0x68, 0xbc, 0x2d,
-	0xf8, 0xba, 0x1d, 0xdf, 0x44, 0x4d, 0x99, 0xb9, 0xdc, 0xdf, 0x66, 0xc2, 0x89, 0xd9, 0x50, 0xb6,
-	0xa6, 0x97, 0x8d, 0x6e, 0xc3, 0xbe, 0x52, 0x15, 0x1f, 0xb1, 0xa1, 0xec, 0xff, 0xac, 0xa3, 0x69,
-	0xf5, 0x87, 0xe2, 0x77, 0x06, 0x6a, 0x5e, 0x38, 0x17, 0xdc, 0x23, 0xe3, 0xdf, 0x0a, 0x99, 0x78,
-	0x78, 0x66, 0xff, 0x32, 0x94, 0x72, 0x65, 0x1d, 0xf2, 0xea, 0xcb, 0x8f, 0x37, 0xf5, 0x2e, 0xbe,
-	0x45, 0x27, 0x3c, 0x5f, 0xbf, 0xa2, 0x39, 0x45, 0x05, 0x7f, 0x34, 0xd0, 0xdc, 0xc8, 0xfa, 0xf1,
-	0xea, 0x5f, 0xe7, 0x8e, 0x3f, 0x40, 0x73, 0xed, 0x72, 0x24, 0x6d, 0xf7, 0xae, 0xb2, 0xbb, 0x86,
-	0xfb, 0x93, 0xec, 0xfe, 0xb9, 0x1e, 0x4f, 0x31, 0xe9, 0x0b, 0x7d, 0xbd, 0x2f, 0x07, 0xf7, 0x8e,
-	0x4e, 0x2d, 0xe3, 0xf8, 0xd4, 0x32, 0xbe, 0x9f, 0x5a, 0xc6, 0xe1, 0x99, 0x55, 0x3b, 0x3e, 0xb3,
-	0x6a, 0x5f, 0xcf, 0xac, 0xda, 0xf3, 0x1b, 0xa5, 0x98, 0x08, 0x76, 0x48, 0x04, 0x74, 0xff, 0xa2,
-	0xa8, 0x7a, 0x5d, 0xde, 0x8c, 0xaa, 0xad, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x33, 0x02,
-	0x59, 0xe6, 0x04, 0x00, 0x00,
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6e, 0xf3, 0x44,
+	0x10, 0x8e, 0xfb, 0x27, 0x45, 0x6c, 0x1b, 0x0a, 0xab, 0x0a, 0x25, 0x6e, 0x71, 0x8a, 0x91, 0x50,
+	0x44, 0x15, 0x1b, 0x27, 0x85, 0x4a, 0x70, 0xa1, 0x69, 0x04, 0x1c, 0x0a, 0x6a, 0x5d, 0xb8, 0x70,
+	0xb1, 0x1c, 0x7b, 0xe3, 0x58, 0xb5, 0x77, 0x5d, 0xef, 0xba, 0x34, 0xaa, 0x7a, 0x81, 0x17, 0xa8,
+	0xc4, 0x85, 0x27, 0xe0, 0x80, 0x38, 0x56, 0x82, 0x47, 0xe8, 0x09, 0x55, 0x85, 0x03, 0xe2, 0xd0,
+	0xa2, 0x96, 0x07, 0x41, 0x5e, 0xaf, 0x4b, 0x12, 0xe2, 0xa0, 0x9c, 0x92, 0xcc, 0xcc, 0xf7, 0xcd,
+	0x37, 0x9e, 0xf9, 0x1c, 0xa0, 0x3a, 0x84, 0x86, 0x84, 0xea, 0x51, 0x4c, 0x18, 0x71, 0x48, 0x10,
+	0x11, 0x12, 0xe8, 0x67, 0x86, 0x7e, 0x9a, 0xa0, 0x78, 0xa4, 0xf1, 0x28, 0x7c, 0x3d, 0xab, 0xd1,
+	0xc6, 0x6b, 0xb4, 0x33, 0x43, 0x5e, 0xf7, 0x88, 0x47, 0x78, 0x50, 0x4f, 0xbf, 0x65, 0x79, 0x79,
+	0xd3, 0x23, 0xc4, 0x0b, 0x90, 0x6e, 0x47, 0xbe, 0x6e, 0x63, 0x4c, 0x98, 0xcd, 0x7c, 0x82, 0x05,
+	0x5a, 0x56, 0x44, 0xbf, 0xbe, 0x4d, 0x91, 0x7e, 0x66, 0xf4, 0x11, 0xb3, 0x0d, 0xdd, 0x21, 0x3e,
+	0x16, 0xf9, 0x7a, 0x96, 0xb7, 0x32, 0xda, 0xf1, 0xc6, 0x72, 0x43, 0x10, 0xf3, 0x5f, 0xfd, 0x64,
+	0xa0, 0x33, 0x3f, 0x44, 0x94, 0xd9, 0x61, 0x94, 0x73, 0x4f, 0x17, 0xb8, 0x49, 0xcc, 0x9b, 0x8b,
+	0x7c, 0xd1, 0xac, 0x6c, 0x14, 0x21, 0xd1, 0x44, 0xdd, 0x00, 0xf5, 0xa3, 0x74, 0xf4, 0x7d, 0x12,
+	0x86, 0x09, 0xf6, 0xd9, 0xe8, 0x90, 0x90, 0xc0, 0x44, 0xa7, 0x09, 0xa2, 0x4c, 0xfd, 0x56, 0x02,
+	0xf2, 0xac, 0x2c, 0x8d, 0x08, 0xa6, 0x08, 0x22, 0x50, 0x4e, 0x29, 0x6b, 0xd2, 0xd6, 0x8b, 0xe6,
+	0x4a, 0x7b, 0x53, 0x13, 0xea, 0xd3, 0x51, 0x35, 0x31, 0xaa, 0xd6, 0x43, 0xce, 0x3e, 0xf1, 0x71,
+	0xb7, 0x73, 0x73, 0xdf, 0x28, 0xfd, 0xf8, 0xd0, 0xd8, 0xf6, 0x7c, 0x36, 0x4c, 0xfa, 0x9a, 0x43,
+	0x42, 0x31, 0xad, 0xf8, 0x68, 0x51, 0xf7, 0x44, 0x28, 0x13, 0x18, 0x6a, 0x72, 0x7a, 0xf5, 0x08,
+	0x6c, 0x70, 0x11, 0x5f, 0x62, 0x27, 0xb0, 0xfd, 0x10, 0xb9, 0xdd, 0xc4, 0xf5, 0x10, 0x13, 0x22,
+	0x61, 0x1b, 0xbc, 0x64, 0xbb, 0x6e, 0x8c, 0x28, 0xad, 0x49, 0x5b, 0x52, 0xf3, 0xe5, 0x6e, 0xed,
+	0xee, 0xba, 0xb5, 0x2e, 0xb4, 0xec, 0x65, 0x99, 0x63, 0x16, 0xfb, 0xd8, 0x33, 0xf3, 0x42, 0xf5,
+	0xd7, 0x25, 0xb0, 0x39, 0x9b, 0x53, 0x8c, 0xf6, 0x11, 0x78, 0x45, 0x24, 0x2c, 0x3b, 0x24, 0x09,
+	0x66, 0x9c, 0x7b, 0xa5, 0x5d, 0x9f, 0x39, 0x64, 0xaa, 0xd6, 0xac, 0x0a, 0xc0, 0x1e, 0xaf, 0x87,
+	0x3d, 0xf0, 0x6a, 0x82, 0xa7, 0x38, 0x96, 0xfe, 0x8f, 0x63, 0x2d, 0xc1, 0x93, 0x2c, 0x9f, 0x82,
+	0x35, 0x8c, 0xce, 0x99, 0xc5, 0xa3, 0xd6, 0x20, 0x26, 0x61, 0xed, 0x05, 0x27, 0x91, 0xb5, 0x6c,
+	0xf9, 0x5a, 0xbe, 0x7c, 0xed, 0x8b, 0xfc, 0x3a, 0xba, 0xe5, 0xab, 0x87, 0x86, 0x64, 0x56, 0x53,
+	0xe0, 0x7e, 0x8a, 0xfb, 0x38, 0x26, 0x21, 0xdc, 0x05, 0xcb, 0x11, 0x8a, 0x7d, 0xe2, 0xd6, 0xca,
+	0x42, 0xc5, 0x34, 0x41, 0x4f, 0x5c, 0x4f, 0xb7, 0xfc, 0x7d, 0x8a, 0x17, 0xe5, 0xf0, 0x2d, 0x50,
+	0x65, 0xb1, 0x8d, 0x9d, 0x21, 0xa2, 0x56, 0x80, 0x06, 0xac, 0x56, 0xd9, 0x92, 0x9a, 0x65, 0x73,
+	0x35, 0x0f, 0x1e, 0xa0, 0x01, 0x53, 0x3f, 0x07, 0x35, 0xfe, 0x3c, 0x4d, 0xe4, 0x20, 0x3f, 0x62,
+	0xc7, 0x43, 0x3b, 0x46, 0xe3, 0x0b, 0x72, 0x9c, 0xe7, 0x87, 0x38, 0x7f, 0x41, 0x59, 0xa1, 0xea,
+	0x82, 0xfa, 0x0c, 0x3e, 0xb1, 0x9c, 0x4f, 0x40, 0x85, 0xa6, 0x01, 0x41, 0x67, 0xa4, 0xa7, 0xf5,
+	0xe7, 0x7d, 0x63, 0x23, 0xa3, 0xa4, 0xee, 0x89, 0xe6, 0x13, 0x3d, 0xb4, 0xd9, 0x50, 0x3b, 0x40,
+	0x9e, 0xed, 0x8c, 0x7a, 0xc8, 0xb9, 0xbb, 0x6e, 0x01, 0xd1, 0xb1, 0x87, 0x1c, 0x33, 0xc3, 0xab,
+	0x0d, 0xf0, 0x06, 0xef, 0x72, 0x1c, 0x21, 0xec, 0x7e, 0x46, 0xbd, 0xbd, 0x20, 0x20, 0x5f, 0x07,
+	0x3e, 0xcd, 0x6f, 0x4b, 0xc5, 0x40, 0x29, 0x2a, 0x10, 0x5a, 0x0e, 0xc0, 0x4a, 0x84, 0xe2, 0xd0,
+	0xa7, 0x34, 0x35, 0xbd, 0xb0, 0xc2, 0x3b, 0xda, 0xec, 0x37, 0x88, 0x96, 0xf3, 0x1c, 0x3e, 0x43,
+	0xcc, 0x71, 0x78, 0xfb, 0xf7, 0x0a, 0xa8, 0xf0, 0x86, 0xf0, 0x07, 0x09, 0x54, 0x27, 0x5c, 0x07,
+	0x8d, 0x22, 0xd2, 0x42, 0xff, 0xca, 0xed, 0x45, 0x20, 0xd9, 0x40, 0xaa, 0xf6, 0xcd, 0x6f, 0x7f,
+	0x7f, 0xb7, 0xd4, 0x84, 0x6f, 0xeb, 0x05, 0x6f, 0x0f, 0x27, 0x87, 0x59, 0x69, 0x04, 0xfe, 0x22,
+	0x81, 0xb5, 0x29, 0x17, 0xc1, 0xce, 0xdc, 0xbe, 0xb3, 0x7d, 0x2c, 0xef, 0x2c, 0x06, 0x12, 0x72,
+	0x3f, 0xe0, 0x72, 0x77, 0x60, 0xbb, 0x48, 0xee, 0xbf, 0x26, 0xec, 0x73, 0xa4, 0x7e, 0x21, 0x5e,
+	0x02, 0x97, 0xf0, 0x27, 0x09, 0xac, 0x8e, 0x1f, 0x18, 0x7c, 0x77, 0xae, 0x84, 0x19, 0xb7, 0x2d,
+	0x1b, 0x0b, 0x20, 0x84, 0xe2, 0x5d, 0xae, 0xd8, 0x80, 0x7a, 0x91, 0xe2, 0x38, 0x43, 0x59, 0xfc,
+	0x46, 0xf5, 0x0b, 0x61, 0x89, 0x4b, 0xf8, 0xb3, 0x04, 0x5e, 0xfb, 0xcf, 0x21, 0xc2, 0xf7, 0xe6,
+	0x2a, 0x28, 0xba, 0x6c, 0xf9, 0xfd, 0x45, 0x61, 0x42, 0x7d, 0x87, 0xab, 0x6f, 0xc1, 0xed, 0x22,
+	0xf5, 0x34, 0x85, 0x5a, 0x21, 0xf5, 0x2c, 0x3b, 0x07, 0x77, 0x3f, 0xbc, 0x79, 0x54, 0xa4, 0xdb,
+	0x47, 0x45, 0xfa, 0xeb, 0x51, 0x91, 0xae, 0x9e, 0x94, 0xd2, 0xed, 0x93, 0x52, 0xfa, 0xe3, 0x49,
+	0x29, 0x7d, 0xf5, 0xe6, 0x84, 0x67, 0xcf, 0x27, 0xd9, 0xf8, 0xbf, 0x41, 0x7f, 0x99, 0xc7, 0x3a,
+	0xff, 0x0c, 0x00, 0x79, 0xb7, 0xc3, 0x96, 0xba, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -323,6 +513,12 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// ReceiptShare queries the share of the receipts of an account routed to the
+	// community pool.
+	ReceiptShare(ctx context.Context, in *QueryReceiptShareRequest, opts ...grpc.CallOption) (*QueryReceiptShareResponse, error)
+	// SpendMsgAllowlist queries the messages community pool spend recipients can
+	// execute on their behalf.
+	SpendMsgAllowlist(ctx context.Context, in *QuerySpendMsgAllowlistRequest, opts ...grpc.CallOption) (*QuerySpendMsgAllowlistResponse, error)
 }
 
 type queryClient struct {
@@ -351,12 +547,36 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) ReceiptShare(ctx context.Context, in *QueryReceiptShareRequest, opts ...grpc.CallOption) (*QueryReceiptShareResponse, error) {
+	out := new(QueryReceiptShareResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/ReceiptShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendMsgAllowlist(ctx context.Context, in *QuerySpendMsgAllowlistRequest, opts ...grpc.CallOption) (*QuerySpendMsgAllowlistResponse, error) {
+	out := new(QuerySpendMsgAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/SpendMsgAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// ReceiptShare queries the share of the receipts of an account routed to the
+	// community pool.
+	ReceiptShare(context.Context, *QueryReceiptShareRequest) (*QueryReceiptShareResponse, error)
+	// SpendMsgAllowlist queries the messages community pool spend recipients can
+	// execute on their behalf.
+	SpendMsgAllowlist(context.Context, *QuerySpendMsgAllowlistRequest) (*QuerySpendMsgAllowlistResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -369,6 +589,12 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) UnclaimedBudget(ctx context.Context, req *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (*UnimplementedQueryServer) ReceiptShare(ctx context.Context, req *QueryReceiptShareRequest) (*QueryReceiptShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiptShare not implemented")
+}
+func (*UnimplementedQueryServer) SpendMsgAllowlist(ctx context.Context, req *QuerySpendMsgAllowlistRequest) (*QuerySpendMsgAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendMsgAllowlist not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -410,6 +636,42 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReceiptShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReceiptShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/ReceiptShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReceiptShare(ctx, req.(*QueryReceiptShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendMsgAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendMsgAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendMsgAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/SpendMsgAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendMsgAllowlist(ctx, req.(*QuerySpendMsgAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.protocolpool.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -422,6 +684,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "ReceiptShare",
+			Handler:    _Query_ReceiptShare_Handler,
+		},
+		{
+			MethodName: "SpendMsgAllowlist",
+			Handler:    _Query_SpendMsgAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -589,6 +859,129 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryReceiptShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendMsgAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendMsgAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendMsgAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendMsgAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendMsgAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendMsgAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -665,11 +1058,59 @@ func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryReceiptShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryReceiptShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Share.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpendMsgAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySpendMsgAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1100,6 +1541,306 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReceiptShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendMsgAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendMsgAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendMsgAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendMsgAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendMsgAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendMsgAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, &SpendMsgPermission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
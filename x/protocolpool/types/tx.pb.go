@@ -15,6 +15,7 @@ import (
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -118,11 +119,18 @@ var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 // MsgCommunityPoolSpend defines a message for sending tokens from the community
 // pool to another account. This message is typically executed via a governance
 // proposal with the governance module being the executing authority.
+// When messages are provided, the recipient must be a module account and the
+// messages are executed on its behalf once the funds are transferred. Each
+// message type must be allowed for the recipient in the spend msg allowlist.
 type MsgCommunityPoolSpend struct {
 	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string                                   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Recipient string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// messages are the messages to be executed after the transfer.
+	// The recipient module account must be the only signer of each message, and
+	// must be allowed to execute its type in the spend msg allowlist.
+	Messages []*any.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
 func (m *MsgCommunityPoolSpend) Reset()         { *m = MsgCommunityPoolSpend{} }
@@ -179,9 +187,18 @@ func (m *MsgCommunityPoolSpend) GetAmount() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+func (m *MsgCommunityPoolSpend) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // MsgCommunityPoolSpendResponse defines the response to executing a
 // MsgCommunityPoolSpend message.
 type MsgCommunityPoolSpendResponse struct {
+	// msg_responses are the responses of the executed messages, in order.
+	MsgResponses []*any.Any `protobuf:"bytes,1,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
 }
 
 func (m *MsgCommunityPoolSpendResponse) Reset()         { *m = MsgCommunityPoolSpendResponse{} }
@@ -217,6 +234,13 @@ func (m *MsgCommunityPoolSpendResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCommunityPoolSpendResponse proto.InternalMessageInfo
 
+func (m *MsgCommunityPoolSpendResponse) GetMsgResponses() []*any.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
 // MsgSubmitBudgetProposal defines budget proposal type.
 type MsgSubmitBudgetProposal struct {
 	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -766,6 +790,198 @@ func (m *MsgWithdrawContinuousFundResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgSetReceiptShare defines a message for an account to route a share of the
+// coins it receives to the community pool, e.g. a group policy account acting
+// as a treasury. The routed coins are transferred at the end of the block.
+type MsgSetReceiptShare struct {
+	// account is the address of the account whose receipts are routed.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// share is the fraction of the received coins routed to the community pool.
+	// A zero share stops routing the receipts of the account.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *MsgSetReceiptShare) Reset()         { *m = MsgSetReceiptShare{} }
+func (m *MsgSetReceiptShare) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiptShare) ProtoMessage()    {}
+func (*MsgSetReceiptShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{14}
+}
+func (m *MsgSetReceiptShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiptShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiptShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiptShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiptShare.Merge(m, src)
+}
+func (m *MsgSetReceiptShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiptShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiptShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiptShare proto.InternalMessageInfo
+
+func (m *MsgSetReceiptShare) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// MsgSetReceiptShareResponse defines the response to executing a
+// MsgSetReceiptShare message.
+type MsgSetReceiptShareResponse struct {
+}
+
+func (m *MsgSetReceiptShareResponse) Reset()         { *m = MsgSetReceiptShareResponse{} }
+func (m *MsgSetReceiptShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiptShareResponse) ProtoMessage()    {}
+func (*MsgSetReceiptShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{15}
+}
+func (m *MsgSetReceiptShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiptShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiptShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiptShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiptShareResponse.Merge(m, src)
+}
+func (m *MsgSetReceiptShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiptShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiptShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiptShareResponse proto.InternalMessageInfo
+
+// MsgUpdateSpendMsgAllowlist defines a message for updating the messages that
+// community pool spend recipients can execute on their behalf.
+type MsgUpdateSpendMsgAllowlist struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// add are the permissions added to the allowlist.
+	Add []*SpendMsgPermission `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	// remove are the permissions removed from the allowlist.
+	Remove []*SpendMsgPermission `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgUpdateSpendMsgAllowlist) Reset()         { *m = MsgUpdateSpendMsgAllowlist{} }
+func (m *MsgUpdateSpendMsgAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpendMsgAllowlist) ProtoMessage()    {}
+func (*MsgUpdateSpendMsgAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{16}
+}
+func (m *MsgUpdateSpendMsgAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSpendMsgAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpendMsgAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpendMsgAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpendMsgAllowlist.Merge(m, src)
+}
+func (m *MsgUpdateSpendMsgAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpendMsgAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpendMsgAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSpendMsgAllowlist proto.InternalMessageInfo
+
+func (m *MsgUpdateSpendMsgAllowlist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateSpendMsgAllowlist) GetAdd() []*SpendMsgPermission {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *MsgUpdateSpendMsgAllowlist) GetRemove() []*SpendMsgPermission {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+// MsgUpdateSpendMsgAllowlistResponse defines the response to executing a
+// MsgUpdateSpendMsgAllowlist message.
+type MsgUpdateSpendMsgAllowlistResponse struct {
+}
+
+func (m *MsgUpdateSpendMsgAllowlistResponse) Reset()         { *m = MsgUpdateSpendMsgAllowlistResponse{} }
+func (m *MsgUpdateSpendMsgAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpendMsgAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateSpendMsgAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{17}
+}
+func (m *MsgUpdateSpendMsgAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSpendMsgAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpendMsgAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpendMsgAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpendMsgAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateSpendMsgAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpendMsgAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpendMsgAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSpendMsgAllowlistResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPoolResponse")
@@ -781,75 +997,92 @@ func init() {
 	proto.RegisterType((*MsgCancelContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFundResponse")
 	proto.RegisterType((*MsgWithdrawContinuousFund)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFund")
 	proto.RegisterType((*MsgWithdrawContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFundResponse")
+	proto.RegisterType((*MsgSetReceiptShare)(nil), "cosmos.protocolpool.v1.MsgSetReceiptShare")
+	proto.RegisterType((*MsgSetReceiptShareResponse)(nil), "cosmos.protocolpool.v1.MsgSetReceiptShareResponse")
+	proto.RegisterType((*MsgUpdateSpendMsgAllowlist)(nil), "cosmos.protocolpool.v1.MsgUpdateSpendMsgAllowlist")
+	proto.RegisterType((*MsgUpdateSpendMsgAllowlistResponse)(nil), "cosmos.protocolpool.v1.MsgUpdateSpendMsgAllowlistResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/protocolpool/v1/tx.proto", fileDescriptor_09efe14517e7f6dc) }
 
 var fileDescriptor_09efe14517e7f6dc = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc4, 0xae, 0x55, 0x4f, 0x21, 0xb4, 0xab, 0xe0, 0x6e, 0x96, 0xe2, 0x4d, 0x7c, 0x80,
-	0xa8, 0x22, 0xbb, 0xb8, 0x40, 0x0b, 0xe1, 0x80, 0xea, 0x84, 0x5f, 0x12, 0x96, 0xc2, 0xa6, 0x12,
-	0x12, 0x17, 0x6b, 0xbc, 0x3b, 0x5d, 0x8f, 0xba, 0xbb, 0xb3, 0xda, 0x99, 0x4d, 0xe2, 0x4a, 0x48,
-	0x15, 0x12, 0xd0, 0x63, 0x8f, 0x1c, 0x7b, 0x42, 0x88, 0x53, 0x0f, 0xfd, 0x23, 0x2a, 0x71, 0xa9,
-	0x7a, 0x42, 0x1c, 0x5a, 0x94, 0x20, 0x95, 0xbf, 0x81, 0x13, 0xda, 0x9d, 0xf1, 0xda, 0x8e, 0xd7,
-	0x9b, 0xba, 0x4a, 0x38, 0xd9, 0x7e, 0xf3, 0xbe, 0xf7, 0xbe, 0xf7, 0xcd, 0x7b, 0x33, 0x63, 0xa8,
-	0xdb, 0x94, 0xf9, 0x94, 0x99, 0x61, 0x44, 0x39, 0xb5, 0xa9, 0x17, 0x52, 0xea, 0x99, 0xbb, 0x2d,
-	0x93, 0xef, 0x1b, 0xa9, 0x49, 0xa9, 0x0b, 0x07, 0x63, 0xdc, 0xc1, 0xd8, 0x6d, 0x69, 0x4b, 0x2e,
-	0x75, 0x69, 0x6a, 0x34, 0x93, 0x6f, 0x62, 0x5d, 0x6b, 0xc8, 0x70, 0x3d, 0xc4, 0xb0, 0xb9, 0xdb,
-	0xea, 0x61, 0x8e, 0x5a, 0xa6, 0x4d, 0x49, 0x20, 0xd7, 0x97, 0xc5, 0x7a, 0x57, 0x00, 0xc7, 0x43,
-	0x6b, 0x17, 0x25, 0xd4, 0x67, 0x6e, 0x42, 0xc0, 0x67, 0xae, 0x5c, 0xd0, 0x5d, 0x4a, 0x5d, 0x0f,
-	0x0b, 0x8a, 0xbd, 0xf8, 0xa6, 0xc9, 0x89, 0x8f, 0x19, 0x47, 0x7e, 0x38, 0x4c, 0x7a, 0xd4, 0xc1,
-	0x89, 0x23, 0xc4, 0x09, 0x95, 0x49, 0x9b, 0xbf, 0x03, 0xb8, 0xd4, 0x61, 0xee, 0x67, 0x71, 0xe0,
-	0x6c, 0x52, 0xdf, 0x8f, 0x03, 0xc2, 0x07, 0xdb, 0x94, 0x7a, 0x8a, 0x0d, 0xab, 0xc8, 0xa7, 0x71,
-	0xc0, 0x55, 0xb0, 0x52, 0x5e, 0x3b, 0x77, 0x65, 0xd9, 0x90, 0x8c, 0x12, 0xfa, 0x86, 0xa4, 0x6f,
-	0x6c, 0x52, 0x12, 0xb4, 0xdf, 0x7d, 0xf4, 0x54, 0x2f, 0xfd, 0xf6, 0x4c, 0x5f, 0x73, 0x09, 0xef,
-	0xc7, 0x3d, 0xc3, 0xa6, 0xbe, 0xa4, 0x2f, 0x3f, 0xd6, 0x99, 0x73, 0xcb, 0xe4, 0x83, 0x10, 0xb3,
-	0x14, 0xc0, 0x2c, 0x19, 0x5a, 0xb9, 0x0a, 0x6b, 0x0e, 0x0e, 0x29, 0x23, 0x9c, 0x46, 0xea, 0xc2,
-	0x0a, 0x58, 0xab, 0xb5, 0xd5, 0x27, 0x0f, 0xd7, 0x97, 0x64, 0xaa, 0xeb, 0x8e, 0x13, 0x61, 0xc6,
-	0x76, 0x78, 0x44, 0x02, 0xd7, 0x1a, 0xb9, 0x6e, 0xd4, 0xef, 0xde, 0xd7, 0x4b, 0xff, 0xdc, 0xd7,
-	0x4b, 0xdf, 0x3f, 0x7f, 0x70, 0x79, 0x64, 0x6f, 0x36, 0xe0, 0xa5, 0xbc, 0x62, 0x2c, 0xcc, 0x42,
-	0x1a, 0x30, 0xdc, 0x3c, 0x00, 0xf0, 0xf5, 0x0e, 0x73, 0x27, 0x16, 0x77, 0x42, 0x1c, 0x38, 0x09,
-	0x13, 0x14, 0xf3, 0x3e, 0x8d, 0x08, 0x1f, 0xa8, 0xe0, 0x38, 0x26, 0x99, 0xab, 0x72, 0x09, 0xd6,
-	0x22, 0x6c, 0x93, 0x90, 0xe0, 0x80, 0x8b, 0x0a, 0xac, 0x91, 0x61, 0x4c, 0xc4, 0xf2, 0xa9, 0x89,
-	0xb8, 0xb1, 0x98, 0x8a, 0x90, 0x51, 0x6a, 0xea, 0xf0, 0xcd, 0xdc, 0x1a, 0x33, 0x15, 0x7e, 0x2a,
-	0xc3, 0x8b, 0x1d, 0xe6, 0xee, 0xc4, 0x3d, 0x9f, 0xf0, 0x76, 0xec, 0xb8, 0x98, 0x6f, 0x47, 0x34,
-	0xa4, 0x0c, 0x79, 0x2f, 0xad, 0xc3, 0xa7, 0xf0, 0x42, 0x56, 0x76, 0x17, 0x09, 0xaf, 0x63, 0x77,
-	0xf4, 0x7c, 0x06, 0x91, 0x76, 0xe5, 0x73, 0xa8, 0xf4, 0x52, 0x42, 0xdd, 0x10, 0x47, 0x5d, 0x1e,
-	0xa1, 0xc0, 0xee, 0x63, 0xb5, 0xbc, 0x02, 0x0a, 0xc5, 0xb3, 0xce, 0x0b, 0xd0, 0x36, 0x8e, 0x6e,
-	0x08, 0x88, 0xf2, 0x09, 0x84, 0x8c, 0xa3, 0x88, 0x77, 0x93, 0x81, 0x50, 0x2b, 0x69, 0x00, 0xcd,
-	0x10, 0xc3, 0x60, 0x0c, 0x87, 0xc1, 0xb8, 0x31, 0x9c, 0x96, 0x76, 0xe5, 0xde, 0x33, 0x1d, 0x58,
-	0xb5, 0x14, 0x93, 0x58, 0x15, 0x0d, 0x9e, 0x95, 0xe9, 0x99, 0x7a, 0x66, 0x05, 0xac, 0x55, 0xac,
-	0xec, 0xb7, 0x72, 0x0d, 0x56, 0x43, 0x1c, 0x11, 0xea, 0xa8, 0x55, 0xc9, 0xec, 0x68, 0xe0, 0x2d,
-	0x39, 0x65, 0xed, 0xca, 0xcf, 0x49, 0x5c, 0xe9, 0x3e, 0xb5, 0x55, 0xab, 0x50, 0x9f, 0xb1, 0x11,
-	0xd9, 0x66, 0x51, 0xb8, 0x98, 0xec, 0xa6, 0x87, 0x88, 0x2f, 0x3c, 0xf2, 0xa5, 0x06, 0xf3, 0x4a,
-	0xbd, 0x51, 0x4f, 0xb8, 0x4c, 0x47, 0x6a, 0x7e, 0x07, 0xeb, 0x93, 0x09, 0x87, 0x54, 0x26, 0x8e,
-	0x04, 0x70, 0x4a, 0xdd, 0xdc, 0xfc, 0x65, 0x21, 0x6d, 0xce, 0xcd, 0x08, 0x23, 0x8e, 0x37, 0x69,
-	0xc0, 0x49, 0x10, 0xd3, 0x98, 0x25, 0x33, 0xfd, 0xd2, 0xcd, 0x79, 0x75, 0x6a, 0x48, 0x8b, 0x70,
-	0xa3, 0xf1, 0xfd, 0x1a, 0xc2, 0x10, 0x47, 0x36, 0x0e, 0x38, 0x72, 0x45, 0x17, 0xd6, 0xda, 0xad,
-	0xa4, 0xb2, 0x3f, 0x9f, 0xea, 0x6f, 0x08, 0x30, 0x73, 0x6e, 0x19, 0x84, 0x9a, 0x3e, 0xe2, 0x7d,
-	0xe3, 0x2b, 0xec, 0x22, 0x7b, 0xb0, 0x85, 0xed, 0x27, 0x0f, 0xd7, 0xa1, 0x8c, 0xbd, 0x85, 0x6d,
-	0x6b, 0x2c, 0x88, 0xf2, 0x21, 0xac, 0xe2, 0xfd, 0x90, 0x44, 0x83, 0x17, 0xee, 0x49, 0xe9, 0x3f,
-	0xa3, 0x77, 0xf2, 0x74, 0xca, 0x7a, 0xe7, 0x57, 0x20, 0xb4, 0x44, 0x81, 0x8d, 0xbd, 0x13, 0xd2,
-	0xf2, 0x64, 0x06, 0x7d, 0xaa, 0x9a, 0xbf, 0x17, 0x44, 0x39, 0x39, 0x54, 0xb3, 0xfe, 0xfb, 0x12,
-	0xbe, 0x6a, 0xa7, 0xeb, 0xd8, 0x11, 0x63, 0x0d, 0x8e, 0x95, 0xf0, 0x6c, 0xb2, 0x5b, 0xa9, 0x8c,
-	0xaf, 0x0c, 0xa1, 0xe9, 0x74, 0xbf, 0x0d, 0x5f, 0xcb, 0x42, 0xf5, 0x31, 0x71, 0xfb, 0xa2, 0x2f,
-	0x2a, 0xd6, 0xe2, 0xd0, 0xfc, 0x45, 0x6a, 0xcd, 0x2f, 0xb7, 0x3c, 0xf7, 0xb9, 0xf6, 0x03, 0x80,
-	0xea, 0x1e, 0xe1, 0x7d, 0x27, 0x42, 0x7b, 0x41, 0x17, 0x79, 0x1e, 0xb5, 0x11, 0xc7, 0x4e, 0xf7,
-	0x66, 0x1c, 0x38, 0xb2, 0x13, 0x4e, 0x74, 0x9a, 0xea, 0x59, 0xb2, 0xeb, 0xc3, 0x5c, 0x89, 0x94,
-	0xcd, 0xdb, 0x70, 0xb9, 0xc3, 0xdc, 0x6f, 0xe4, 0xe2, 0x91, 0x96, 0x38, 0xe5, 0x83, 0xe5, 0x2e,
-	0x80, 0xab, 0x33, 0x93, 0xff, 0xaf, 0x87, 0xcc, 0x95, 0x7f, 0xab, 0xb0, 0xdc, 0x61, 0xae, 0xb2,
-	0x07, 0x2f, 0x4c, 0xbf, 0x7c, 0xde, 0x31, 0xf2, 0x9f, 0x75, 0x46, 0xde, 0xd3, 0x42, 0x7b, 0x7f,
-	0x1e, 0xef, 0xac, 0xca, 0xdb, 0x50, 0xc9, 0x79, 0x84, 0xac, 0x17, 0xc4, 0x9a, 0x76, 0xd7, 0x3e,
-	0x98, 0xcb, 0x3d, 0xcb, 0x7d, 0x07, 0xc0, 0xa5, 0xdc, 0xbb, 0xdf, 0x2c, 0x88, 0x97, 0x07, 0xd0,
-	0xae, 0xcd, 0x09, 0xc8, 0x28, 0x60, 0x78, 0x6e, 0xfc, 0x46, 0x7b, 0xab, 0xa8, 0x90, 0x91, 0x9f,
-	0x66, 0xbc, 0x98, 0xdf, 0x44, 0xa5, 0xb9, 0x17, 0x49, 0x51, 0xa5, 0x79, 0x80, 0xc2, 0x4a, 0x8b,
-	0x8e, 0x60, 0xe5, 0x47, 0x00, 0xeb, 0x33, 0xc6, 0xad, 0x55, 0x10, 0x33, 0x1f, 0xa2, 0x7d, 0x34,
-	0x37, 0x64, 0x52, 0x8b, 0xbc, 0x8b, 0xa0, 0x50, 0x8b, 0x1c, 0x40, 0xb1, 0x16, 0x05, 0xe7, 0xb7,
-	0x76, 0xe6, 0xce, 0xf3, 0x07, 0x97, 0x41, 0xfb, 0xe3, 0x47, 0x07, 0x0d, 0xf0, 0xf8, 0xa0, 0x01,
-	0xfe, 0x3a, 0x68, 0x80, 0x7b, 0x87, 0x8d, 0xd2, 0xe3, 0xc3, 0x46, 0xe9, 0x8f, 0xc3, 0x46, 0xe9,
-	0xdb, 0xd5, 0x89, 0x3b, 0x75, 0x7f, 0xf2, 0x8f, 0x57, 0x3a, 0xc7, 0xbd, 0x6a, 0x6a, 0x7b, 0xef,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xbb, 0x27, 0x2e, 0x9c, 0x0d, 0x00, 0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xd8, 0xae, 0x49, 0x5e, 0xbf, 0x57, 0xc1, 0xdd, 0x2c, 0xc5, 0x4e, 0x2c, 0x04, 0x51,
+	0x44, 0xd6, 0x75, 0x80, 0x86, 0x06, 0x24, 0x14, 0x27, 0x50, 0x90, 0xb0, 0x14, 0x9c, 0x22, 0xa4,
+	0x5e, 0xac, 0xf1, 0xee, 0x74, 0x3d, 0xaa, 0x77, 0x67, 0xd9, 0x99, 0x4d, 0xe2, 0x4a, 0x48, 0x15,
+	0x12, 0x50, 0x6e, 0x3d, 0x70, 0xe0, 0x46, 0x4f, 0x08, 0x71, 0xea, 0xa1, 0x7f, 0x44, 0x25, 0x2e,
+	0x55, 0x4f, 0x88, 0x43, 0x8b, 0x12, 0x44, 0xf9, 0x17, 0xb8, 0xa1, 0xfd, 0x8c, 0x1d, 0xaf, 0x37,
+	0x71, 0x94, 0x70, 0x6a, 0x3d, 0xf3, 0xfb, 0xbd, 0x8f, 0xdf, 0xbc, 0xf7, 0xf6, 0x29, 0x50, 0xd6,
+	0x18, 0x37, 0x19, 0xaf, 0xda, 0x0e, 0x13, 0x4c, 0x63, 0x5d, 0x9b, 0xb1, 0x6e, 0x75, 0xb3, 0x56,
+	0x15, 0xdb, 0xaa, 0x7f, 0x24, 0x15, 0x03, 0x80, 0xda, 0x0f, 0x50, 0x37, 0x6b, 0xca, 0x94, 0xc1,
+	0x0c, 0xe6, 0x1f, 0x56, 0xbd, 0xff, 0x05, 0xf7, 0x4a, 0x29, 0x34, 0xd7, 0xc6, 0x9c, 0x54, 0x37,
+	0x6b, 0x6d, 0x22, 0x70, 0xad, 0xaa, 0x31, 0x6a, 0x85, 0xf7, 0xd3, 0xc1, 0x7d, 0x2b, 0x20, 0xf6,
+	0x9b, 0x56, 0x2e, 0x85, 0x54, 0x93, 0x1b, 0x5e, 0x00, 0x26, 0x37, 0xc2, 0x8b, 0xb2, 0xc1, 0x98,
+	0xd1, 0x25, 0x41, 0x88, 0x6d, 0xf7, 0x56, 0x55, 0x50, 0x93, 0x70, 0x81, 0x4d, 0x3b, 0x72, 0xba,
+	0x1f, 0xa0, 0xbb, 0x0e, 0x16, 0x94, 0x45, 0x4e, 0x2b, 0xa3, 0x72, 0xec, 0xd9, 0x24, 0xf2, 0x3e,
+	0xbd, 0xdf, 0x06, 0xb6, 0x7a, 0xc1, 0x55, 0xe5, 0x37, 0x04, 0x53, 0x0d, 0x6e, 0x7c, 0xe4, 0x5a,
+	0xfa, 0x2a, 0x33, 0x4d, 0xd7, 0xa2, 0xa2, 0xb7, 0xce, 0x58, 0x57, 0xd2, 0xa0, 0x80, 0x4d, 0xe6,
+	0x5a, 0x42, 0x46, 0x33, 0xb9, 0xb9, 0xd3, 0x8b, 0xd3, 0x6a, 0x98, 0x90, 0x97, 0xbd, 0x1a, 0x66,
+	0xaf, 0xae, 0x32, 0x6a, 0xd5, 0xaf, 0x3c, 0x7e, 0x56, 0xce, 0xfc, 0xfa, 0xbc, 0x3c, 0x67, 0x50,
+	0xd1, 0x71, 0xdb, 0xaa, 0xc6, 0xcc, 0x30, 0xfb, 0xf0, 0x9f, 0x05, 0xae, 0xdf, 0x0e, 0x03, 0xf2,
+	0x08, 0xbc, 0x19, 0x9a, 0x96, 0xae, 0xc2, 0xa4, 0x4e, 0x6c, 0xc6, 0xa9, 0x60, 0x8e, 0x9c, 0x9d,
+	0x41, 0x73, 0x93, 0x75, 0xf9, 0xe9, 0xa3, 0x85, 0xa9, 0xd0, 0xd5, 0x8a, 0xae, 0x3b, 0x84, 0xf3,
+	0x0d, 0xe1, 0x50, 0xcb, 0x68, 0xee, 0x41, 0x97, 0x8b, 0xf7, 0x1e, 0x94, 0x33, 0xff, 0x3c, 0x28,
+	0x67, 0xbe, 0x7e, 0xf1, 0x70, 0x7e, 0xef, 0xbc, 0x52, 0x82, 0xcb, 0x49, 0xc9, 0x34, 0x09, 0xb7,
+	0x99, 0xc5, 0x49, 0xe5, 0x87, 0x2c, 0xbc, 0xdc, 0xe0, 0xc6, 0xc0, 0xe5, 0x86, 0x4d, 0x2c, 0xdd,
+	0x8b, 0x04, 0xbb, 0xa2, 0xc3, 0x1c, 0x2a, 0x7a, 0x32, 0x3a, 0x28, 0x92, 0x18, 0x2a, 0x5d, 0x86,
+	0x49, 0x87, 0x68, 0xd4, 0xa6, 0xc4, 0x12, 0x41, 0x06, 0xcd, 0xbd, 0x83, 0x3e, 0x11, 0x73, 0x27,
+	0x27, 0xe2, 0x15, 0x98, 0x30, 0x09, 0xe7, 0xd8, 0x20, 0x5c, 0xce, 0xfb, 0x6e, 0xa6, 0xd4, 0xe0,
+	0xc1, 0xd5, 0xe8, 0xc1, 0xd5, 0x15, 0xab, 0xd7, 0x8c, 0x51, 0xcb, 0xe7, 0x7c, 0xd9, 0xe2, 0x24,
+	0x2a, 0x37, 0xe1, 0xd5, 0x44, 0x55, 0x22, 0xdd, 0xa4, 0x6b, 0x70, 0xd6, 0xe4, 0x46, 0xcb, 0x09,
+	0x7f, 0x73, 0x19, 0xa5, 0xf8, 0x39, 0x63, 0x72, 0x23, 0x62, 0xf2, 0xca, 0x77, 0x39, 0xb8, 0xd4,
+	0xe0, 0xc6, 0x86, 0xdb, 0x36, 0xa9, 0xa8, 0xbb, 0xba, 0x41, 0xc4, 0xba, 0xc3, 0x6c, 0xc6, 0x71,
+	0xf7, 0xc8, 0xa2, 0x7f, 0x08, 0x17, 0x63, 0x8d, 0x5b, 0x38, 0x40, 0x1d, 0x58, 0x3e, 0x17, 0x62,
+	0x4a, 0x78, 0x2e, 0x5d, 0x07, 0xa9, 0xed, 0x07, 0xd4, 0xb2, 0x89, 0xd3, 0x12, 0x0e, 0xb6, 0xb4,
+	0x0e, 0x91, 0x73, 0x33, 0x28, 0xf5, 0xa5, 0x9a, 0x17, 0x02, 0xd2, 0x3a, 0x71, 0x6e, 0x04, 0x14,
+	0xe9, 0x03, 0x00, 0x2e, 0xb0, 0x23, 0x5a, 0x5e, 0xf3, 0xca, 0x79, 0xdf, 0x80, 0x32, 0xa4, 0xcd,
+	0x8d, 0xa8, 0xb3, 0xeb, 0xf9, 0xfb, 0xcf, 0xcb, 0xa8, 0x39, 0xe9, 0x73, 0xbc, 0x53, 0x49, 0x81,
+	0x89, 0xd0, 0x3d, 0x97, 0x4f, 0xcd, 0xa0, 0xb9, 0x7c, 0x33, 0xfe, 0x2d, 0x2d, 0x41, 0xc1, 0x26,
+	0x0e, 0x65, 0xba, 0x5c, 0x08, 0x23, 0xdb, 0x6f, 0x78, 0x2d, 0x9c, 0x08, 0xf5, 0xfc, 0x8f, 0x9e,
+	0xdd, 0x10, 0x3e, 0xf4, 0xca, 0xb3, 0x50, 0x1e, 0xf1, 0x10, 0x71, 0x7f, 0x30, 0x38, 0xe7, 0x15,
+	0x42, 0x17, 0x53, 0x33, 0x40, 0x24, 0x4b, 0x8d, 0xc6, 0x95, 0x7a, 0xb9, 0xe8, 0xc5, 0x32, 0x6c,
+	0xa9, 0xf2, 0x15, 0x14, 0x07, 0x1d, 0xc6, 0x25, 0xd7, 0x3f, 0x7f, 0xd0, 0x09, 0xb5, 0x4e, 0xe5,
+	0xe7, 0xac, 0x5f, 0x9c, 0xab, 0x0e, 0xc1, 0x82, 0xac, 0x32, 0x4b, 0x50, 0xcb, 0x65, 0x2e, 0xf7,
+	0x06, 0xc8, 0x91, 0x8b, 0xf3, 0xea, 0xd0, 0x44, 0x48, 0xe3, 0xed, 0xcd, 0x8a, 0xcf, 0x00, 0x6c,
+	0xe2, 0x68, 0xc4, 0x12, 0xd8, 0x08, 0xaa, 0x70, 0xb2, 0x5e, 0xf3, 0x32, 0xfb, 0xe3, 0x59, 0xf9,
+	0x95, 0x80, 0xcc, 0xf5, 0xdb, 0x2a, 0x65, 0x55, 0x13, 0x8b, 0x8e, 0xfa, 0x29, 0x31, 0xb0, 0xd6,
+	0x5b, 0x23, 0xda, 0xd3, 0x47, 0x0b, 0x10, 0xda, 0x5e, 0x23, 0x5a, 0xb3, 0xcf, 0x88, 0xf4, 0x2e,
+	0x14, 0xc8, 0xb6, 0x4d, 0x9d, 0xde, 0xa1, 0x6b, 0x32, 0xc4, 0x8f, 0xa8, 0x9d, 0x24, 0x9d, 0xe2,
+	0xda, 0xf9, 0x05, 0x05, 0x5a, 0x62, 0x4b, 0x23, 0xdd, 0x63, 0xd2, 0xf2, 0x78, 0x1a, 0x7d, 0x28,
+	0x9b, 0xbf, 0xb2, 0x50, 0x1e, 0x11, 0x6a, 0x5c, 0x7f, 0x9f, 0xc0, 0x59, 0xcd, 0xbf, 0x27, 0x7a,
+	0xd0, 0xd6, 0xe8, 0x40, 0x09, 0x27, 0xbc, 0xd7, 0xf2, 0x65, 0x3c, 0x13, 0x51, 0xfd, 0xee, 0x7e,
+	0x03, 0xce, 0xc7, 0xa6, 0x3a, 0x84, 0x1a, 0x9d, 0xa0, 0x2e, 0xf2, 0xcd, 0x73, 0xd1, 0xf1, 0xc7,
+	0xfe, 0x69, 0x72, 0xba, 0xb9, 0xb1, 0xe7, 0xda, 0x37, 0x08, 0xe4, 0x2d, 0x2a, 0x3a, 0xba, 0x83,
+	0xb7, 0xac, 0x16, 0xee, 0x76, 0x99, 0x86, 0x05, 0xd1, 0x5b, 0xb7, 0x5c, 0x4b, 0x97, 0xf3, 0xc7,
+	0xdf, 0x4d, 0xc5, 0xd8, 0xd9, 0x4a, 0xe4, 0xcb, 0x93, 0xb2, 0x72, 0x07, 0xa6, 0x1b, 0xdc, 0xf8,
+	0x22, 0xbc, 0xdc, 0x57, 0x12, 0x27, 0x3c, 0x58, 0xee, 0x21, 0x98, 0x1d, 0xe9, 0xfc, 0xff, 0x1d,
+	0x32, 0x3f, 0x21, 0x90, 0xbc, 0xc1, 0xeb, 0x0d, 0x37, 0x8d, 0x50, 0x5b, 0x6c, 0x74, 0xb0, 0x43,
+	0xa4, 0x45, 0x78, 0x09, 0x6b, 0x5a, 0xec, 0x3c, 0x2d, 0xed, 0x08, 0x28, 0x5d, 0x87, 0x53, 0xdc,
+	0x23, 0xcb, 0xd9, 0xa3, 0x8e, 0x87, 0x80, 0xbf, 0x7c, 0xc6, 0x93, 0x2d, 0x32, 0x5b, 0xb9, 0x0c,
+	0xca, 0x70, 0x80, 0x71, 0x63, 0xff, 0x8d, 0xfc, 0xeb, 0xcf, 0x6d, 0x1d, 0x0b, 0xe2, 0xef, 0x05,
+	0x0d, 0x6e, 0x78, 0x2f, 0xbd, 0xd5, 0xa5, 0x5c, 0x1c, 0xb9, 0xb7, 0xdf, 0x87, 0x1c, 0xd6, 0x75,
+	0x39, 0xeb, 0x6f, 0x12, 0xf3, 0x6a, 0xf2, 0x26, 0xae, 0x46, 0xfe, 0xd6, 0x89, 0x63, 0x52, 0xce,
+	0x29, 0xb3, 0x9a, 0x1e, 0x4d, 0xaa, 0x43, 0xc1, 0x21, 0x26, 0xdb, 0x24, 0x72, 0x6e, 0x6c, 0x03,
+	0x21, 0x73, 0x68, 0x2c, 0xbc, 0x06, 0x95, 0xd1, 0x79, 0x46, 0x72, 0x2c, 0xfe, 0x3b, 0x01, 0xb9,
+	0x06, 0x37, 0xa4, 0x2d, 0xb8, 0x38, 0xbc, 0x35, 0xbf, 0x39, 0x2a, 0x8c, 0xa4, 0xb5, 0x54, 0x79,
+	0x7b, 0x1c, 0x74, 0x5c, 0xb4, 0x77, 0x40, 0x4a, 0x58, 0x60, 0x17, 0x52, 0x6c, 0x0d, 0xc3, 0x95,
+	0x77, 0xc6, 0x82, 0xc7, 0xbe, 0xef, 0x22, 0x98, 0x4a, 0x5c, 0xe5, 0xaa, 0x29, 0xf6, 0x92, 0x08,
+	0xca, 0xd2, 0x98, 0x84, 0x38, 0x04, 0x02, 0xa7, 0xfb, 0x17, 0x94, 0xd7, 0xd3, 0x12, 0xd9, 0xc3,
+	0x29, 0xea, 0xe1, 0x70, 0x03, 0x99, 0x26, 0xee, 0x05, 0x69, 0x99, 0x26, 0x11, 0x94, 0xa5, 0x31,
+	0x09, 0x71, 0x08, 0xdf, 0x22, 0x28, 0x8e, 0x98, 0x9e, 0xb5, 0x14, 0x9b, 0xc9, 0x14, 0xe5, 0xda,
+	0xd8, 0x94, 0x41, 0x2d, 0x92, 0xbe, 0xeb, 0xa9, 0x5a, 0x24, 0x10, 0x94, 0xa5, 0x31, 0x09, 0x71,
+	0x08, 0x5f, 0xc2, 0xf9, 0xfd, 0x03, 0x74, 0x3e, 0xad, 0x82, 0x06, 0xb1, 0xca, 0xe2, 0xe1, 0xb1,
+	0xb1, 0xcb, 0xef, 0x11, 0x5c, 0x1a, 0x35, 0xf4, 0xd2, 0xec, 0x8d, 0xe0, 0x28, 0xcb, 0xe3, 0x73,
+	0xa2, 0x58, 0x94, 0x53, 0x77, 0x5f, 0x3c, 0x9c, 0x47, 0xf5, 0xf7, 0x1e, 0xef, 0x94, 0xd0, 0x93,
+	0x9d, 0x12, 0xfa, 0x73, 0xa7, 0x84, 0xee, 0xef, 0x96, 0x32, 0x4f, 0x76, 0x4b, 0x99, 0xdf, 0x77,
+	0x4b, 0x99, 0x9b, 0xb3, 0x03, 0x9f, 0x80, 0xed, 0xc1, 0x3f, 0x07, 0xf8, 0x5f, 0xa5, 0x76, 0xc1,
+	0x3f, 0x7b, 0xeb, 0xbf, 0x01, 0x00, 0xe7, 0xde, 0x36, 0x9b, 0x16, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -882,6 +1115,13 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// SetReceiptShare defines a method for an account to route a share of the
+	// coins it receives to the community pool.
+	SetReceiptShare(ctx context.Context, in *MsgSetReceiptShare, opts ...grpc.CallOption) (*MsgSetReceiptShareResponse, error)
+	// UpdateSpendMsgAllowlist defines a governance operation for updating the
+	// messages recipients can execute as part of a community pool spend. The
+	// authority is defined in the keeper.
+	UpdateSpendMsgAllowlist(ctx context.Context, in *MsgUpdateSpendMsgAllowlist, opts ...grpc.CallOption) (*MsgUpdateSpendMsgAllowlistResponse, error)
 }
 
 type msgClient struct {
@@ -955,6 +1195,24 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) SetReceiptShare(ctx context.Context, in *MsgSetReceiptShare, opts ...grpc.CallOption) (*MsgSetReceiptShareResponse, error) {
+	out := new(MsgSetReceiptShareResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/SetReceiptShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSpendMsgAllowlist(ctx context.Context, in *MsgUpdateSpendMsgAllowlist, opts ...grpc.CallOption) (*MsgUpdateSpendMsgAllowlistResponse, error) {
+	out := new(MsgUpdateSpendMsgAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/UpdateSpendMsgAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
@@ -975,6 +1233,13 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// SetReceiptShare defines a method for an account to route a share of the
+	// coins it receives to the community pool.
+	SetReceiptShare(context.Context, *MsgSetReceiptShare) (*MsgSetReceiptShareResponse, error)
+	// UpdateSpendMsgAllowlist defines a governance operation for updating the
+	// messages recipients can execute as part of a community pool spend. The
+	// authority is defined in the keeper.
+	UpdateSpendMsgAllowlist(context.Context, *MsgUpdateSpendMsgAllowlist) (*MsgUpdateSpendMsgAllowlistResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1002,6 +1267,12 @@ func (*UnimplementedMsgServer) WithdrawContinuousFund(ctx context.Context, req *
 func (*UnimplementedMsgServer) CancelContinuousFund(ctx context.Context, req *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (*UnimplementedMsgServer) SetReceiptShare(ctx context.Context, req *MsgSetReceiptShare) (*MsgSetReceiptShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiptShare not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSpendMsgAllowlist(ctx context.Context, req *MsgUpdateSpendMsgAllowlist) (*MsgUpdateSpendMsgAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpendMsgAllowlist not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1133,6 +1404,42 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetReceiptShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReceiptShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReceiptShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/SetReceiptShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReceiptShare(ctx, req.(*MsgSetReceiptShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSpendMsgAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSpendMsgAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpendMsgAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/UpdateSpendMsgAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpendMsgAllowlist(ctx, req.(*MsgUpdateSpendMsgAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.protocolpool.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1165,6 +1472,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "SetReceiptShare",
+			Handler:    _Msg_SetReceiptShare_Handler,
+		},
+		{
+			MethodName: "UpdateSpendMsgAllowlist",
+			Handler:    _Msg_UpdateSpendMsgAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
@@ -1257,6 +1572,20 @@ func (m *MsgCommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1308,6 +1637,20 @@ func (m *MsgCommunityPoolSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1704,54 +2047,198 @@ func (m *MsgWithdrawContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetReceiptShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFundCommunityPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetReceiptShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiptShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetReceiptShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCommunityPoolSpend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetReceiptShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiptShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpendMsgAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpendMsgAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpendMsgAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remove[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Add[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpendMsgAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpendMsgAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpendMsgAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommunityPoolSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.Recipient)
 	if l > 0 {
@@ -1763,6 +2250,12 @@ func (m *MsgCommunityPoolSpend) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1772,6 +2265,12 @@ func (m *MsgCommunityPoolSpendResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1933,6 +2432,64 @@ func (m *MsgWithdrawContinuousFundResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetReceiptShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetReceiptShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSpendMsgAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, e := range m.Add {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, e := range m.Remove {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateSpendMsgAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2232,6 +2789,40 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2282,6 +2873,40 @@ func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &any.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3439,6 +4064,372 @@ func (m *MsgWithdrawContinuousFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetReceiptShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReceiptShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReceiptShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReceiptShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReceiptShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReceiptShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpendMsgAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpendMsgAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpendMsgAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, &SpendMsgPermission{})
+			if err := m.Add[len(m.Add)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, &SpendMsgPermission{})
+			if err := m.Remove[len(m.Remove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpendMsgAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpendMsgAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpendMsgAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -182,9 +182,116 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
+// SpendMsgPermission allows a community pool spend recipient to execute a
+// message type on its behalf as part of a MsgCommunityPoolSpend.
+type SpendMsgPermission struct {
+	// signer is the address of the recipient module account executing the message.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// msg_type_url is the type URL of the message the signer can execute.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *SpendMsgPermission) Reset()         { *m = SpendMsgPermission{} }
+func (m *SpendMsgPermission) String() string { return proto.CompactTextString(m) }
+func (*SpendMsgPermission) ProtoMessage()    {}
+func (*SpendMsgPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *SpendMsgPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendMsgPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendMsgPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendMsgPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendMsgPermission.Merge(m, src)
+}
+func (m *SpendMsgPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendMsgPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendMsgPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendMsgPermission proto.InternalMessageInfo
+
+func (m *SpendMsgPermission) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *SpendMsgPermission) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// ReceiptShare defines the share of the coins received by an account which is
+// routed to the community pool.
+type ReceiptShare struct {
+	// account is the address of the account whose receipts are routed.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// share is the fraction of the received coins routed to the community pool.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *ReceiptShare) Reset()         { *m = ReceiptShare{} }
+func (m *ReceiptShare) String() string { return proto.CompactTextString(m) }
+func (*ReceiptShare) ProtoMessage()    {}
+func (*ReceiptShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{3}
+}
+func (m *ReceiptShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptShare.Merge(m, src)
+}
+func (m *ReceiptShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptShare proto.InternalMessageInfo
+
+func (m *ReceiptShare) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*SpendMsgPermission)(nil), "cosmos.protocolpool.v1.SpendMsgPermission")
+	proto.RegisterType((*ReceiptShare)(nil), "cosmos.protocolpool.v1.ReceiptShare")
 }
 
 func init() {
@@ -192,39 +299,44 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x69, 0x88, 0x94, 0x83, 0x94, 0x72, 0xaa, 0x90, 0x1b, 0x24, 0x27, 0x84, 0x25, 0x4b,
-	0xcf, 0x0a, 0x48, 0x80, 0xc4, 0x42, 0x9d, 0xf0, 0x31, 0x74, 0x80, 0xd0, 0x89, 0xc5, 0x3a, 0xdb,
-	0x6f, 0xdc, 0x13, 0xf6, 0x9d, 0x75, 0x77, 0x8e, 0x9a, 0x95, 0x5f, 0xd0, 0x91, 0x1f, 0xd2, 0x1f,
-	0xd1, 0xb1, 0xaa, 0x84, 0x84, 0x18, 0x0a, 0x4a, 0xfe, 0x08, 0x8a, 0xef, 0x12, 0xd2, 0x2e, 0x65,
-	0xb3, 0xde, 0xe7, 0xc3, 0xcf, 0xf3, 0x9e, 0x5e, 0xd4, 0x8b, 0x85, 0xca, 0x85, 0xf2, 0x0b, 0x29,
-	0xb4, 0x88, 0x45, 0x56, 0x08, 0x91, 0xf9, 0xd3, 0x81, 0xaf, 0x67, 0x05, 0x28, 0x52, 0x4d, 0xf1,
-	0x23, 0xc3, 0x21, 0x9b, 0x1c, 0x32, 0x1d, 0xb4, 0x77, 0x53, 0x91, 0x8a, 0x6a, 0xe8, 0x2f, 0xbf,
-	0x0c, 0xde, 0xde, 0x33, 0xec, 0xd0, 0x00, 0x9b, 0xd2, 0xb6, 0x67, 0x7f, 0x16, 0x51, 0x05, 0xfe,
-	0x74, 0x10, 0x81, 0xa6, 0x03, 0x3f, 0x16, 0x8c, 0x5b, 0xbc, 0x93, 0x0a, 0x91, 0x66, 0x60, 0xc2,
-	0x44, 0xe5, 0xc4, 0xd7, 0x2c, 0x07, 0xa5, 0x69, 0x5e, 0xac, 0x0c, 0x6e, 0x12, 0x92, 0x52, 0x52,
-	0xcd, 0x84, 0x35, 0xe8, 0x7d, 0xdb, 0x42, 0x8d, 0xa0, 0x4c, 0x52, 0xd0, 0xf8, 0x2d, 0x7a, 0x28,
-	0x21, 0x66, 0x05, 0x03, 0xae, 0x43, 0x9a, 0x24, 0x12, 0x94, 0x72, 0x9d, 0xae, 0xd3, 0x6f, 0x06,
-	0xee, 0xe5, 0xd9, 0xfe, 0xae, 0x0d, 0x76, 0x60, 0x90, 0xcf, 0x5a, 0x32, 0x9e, 0x8e, 0x77, 0xd6,
-	0x12, 0x3b, 0xc7, 0x6f, 0xd0, 0x76, 0x9c, 0x51, 0x96, 0x43, 0x12, 0xd2, 0x5c, 0x94, 0x5c, 0xbb,
-	0x77, 0xba, 0x4e, 0xff, 0xde, 0xb3, 0x3d, 0x62, 0x0d, 0x96, 0x5d, 0x88, 0xed, 0x42, 0x86, 0x82,
-	0xf1, 0x71, 0xcb, 0x0a, 0x0e, 0x2a, 0x3e, 0xfe, 0x80, 0x1e, 0x64, 0x54, 0xe9, 0x70, 0x6d, 0xa3,
-	0xdd, 0xad, 0xca, 0xa2, 0x4d, 0x4c, 0x1b, 0xb2, 0x6a, 0x43, 0x8e, 0x56, 0x75, 0x83, 0xfa, 0xe9,
-	0xef, 0x8e, 0x33, 0x6e, 0x2d, 0x85, 0x43, 0xeb, 0xa6, 0xf1, 0x53, 0xd4, 0xd2, 0x92, 0xf2, 0xf8,
-	0x18, 0x54, 0x98, 0xc1, 0x44, 0xbb, 0xf5, 0xae, 0xd3, 0xaf, 0x8f, 0xef, 0xaf, 0x86, 0x87, 0x30,
-	0xd1, 0xf8, 0x3d, 0xc2, 0x51, 0xb5, 0x81, 0xb0, 0x00, 0x19, 0x5a, 0xc8, 0xbd, 0x7b, 0x5b, 0xe8,
-	0x1d, 0x23, 0xfa, 0x08, 0xf2, 0xc8, 0x48, 0xf0, 0x4b, 0xd4, 0x28, 0x40, 0x32, 0x91, 0xb8, 0x0d,
-	0x2b, 0xbe, 0x19, 0x77, 0x64, 0x97, 0x1f, 0xd4, 0xbf, 0x2f, 0xd3, 0x5a, 0x7a, 0xef, 0x87, 0x83,
-	0xb6, 0x87, 0x82, 0x6b, 0xc6, 0x4b, 0x51, 0xaa, 0x77, 0x25, 0x4f, 0xf0, 0x0b, 0xd4, 0x5c, 0x6f,
-	0xf6, 0xd6, 0x47, 0xf8, 0x47, 0xc5, 0x9f, 0x10, 0x2a, 0x40, 0xc6, 0xc0, 0x35, 0x4d, 0xa1, 0xda,
-	0x7c, 0x33, 0x18, 0x9c, 0x5f, 0x75, 0x6a, 0xbf, 0xae, 0x3a, 0x8f, 0x8d, 0x58, 0x25, 0x5f, 0x09,
-	0x13, 0x7e, 0x4e, 0xf5, 0x31, 0x39, 0x84, 0x94, 0xc6, 0xb3, 0x11, 0xc4, 0x97, 0x67, 0xfb, 0xc8,
-	0x7a, 0x8f, 0x20, 0x1e, 0x6f, 0x98, 0xe0, 0x57, 0xa8, 0x01, 0x27, 0x05, 0x93, 0xb3, 0xff, 0x7e,
-	0x05, 0xcb, 0x0f, 0x5e, 0x9f, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0x7f, 0xe6, 0x9e, 0x73, 0xba,
-	0xf0, 0x6a, 0x17, 0x0b, 0xaf, 0xf6, 0x73, 0xe1, 0xd5, 0xbe, 0x3c, 0xb9, 0x16, 0xe5, 0xe4, 0xfa,
-	0x31, 0x55, 0x97, 0x14, 0x35, 0xaa, 0xd9, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x82,
-	0x01, 0x87, 0x70, 0x03, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x52, 0x6a, 0x18, 0x01, 0x71, 0x42, 0xcc, 0x52, 0x93, 0x6d, 0xad, 0x17, 0x2e,
+	0xec, 0x5a, 0x4c, 0xd4, 0xc4, 0x8b, 0x14, 0x14, 0x0f, 0x98, 0xe0, 0x82, 0x17, 0x2f, 0x9b, 0xe9,
+	0xec, 0x63, 0x3b, 0x71, 0x77, 0x66, 0x32, 0x33, 0x4b, 0xe8, 0xd5, 0xab, 0x17, 0x8e, 0x7e, 0x10,
+	0x3e, 0x04, 0x47, 0x42, 0x62, 0x62, 0x3c, 0xa0, 0x81, 0x2f, 0x62, 0xba, 0x33, 0xc5, 0xc2, 0xa5,
+	0xc6, 0x5b, 0xf3, 0xde, 0xff, 0xf7, 0x9f, 0xf7, 0x7f, 0xd3, 0x1d, 0xd4, 0xa1, 0x42, 0x17, 0x42,
+	0x47, 0x52, 0x09, 0x23, 0xa8, 0xc8, 0xa5, 0x10, 0x79, 0x74, 0xd8, 0x8d, 0xcc, 0x50, 0x82, 0x0e,
+	0xab, 0x2a, 0x7e, 0x68, 0x35, 0xe1, 0xa4, 0x26, 0x3c, 0xec, 0x36, 0x97, 0x33, 0x91, 0x89, 0xaa,
+	0x18, 0x8d, 0x7e, 0xd9, 0x7e, 0x73, 0xc5, 0xaa, 0x13, 0xdb, 0x98, 0x44, 0x9b, 0x81, 0x3b, 0xac,
+	0x4f, 0x34, 0x44, 0x87, 0xdd, 0x3e, 0x18, 0xd2, 0x8d, 0xa8, 0x60, 0xdc, 0xf5, 0x5b, 0x99, 0x10,
+	0x59, 0x0e, 0x76, 0x98, 0x7e, 0x79, 0x10, 0x19, 0x56, 0x80, 0x36, 0xa4, 0x90, 0x63, 0x83, 0xdb,
+	0x82, 0xb4, 0x54, 0xc4, 0x30, 0xe1, 0x0c, 0x3a, 0x5f, 0x66, 0x50, 0xa3, 0x57, 0xa6, 0x19, 0x18,
+	0xfc, 0x06, 0x3d, 0x50, 0x40, 0x99, 0x64, 0xc0, 0x4d, 0x42, 0xd2, 0x54, 0x81, 0xd6, 0xbe, 0xd7,
+	0xf6, 0x56, 0xe7, 0x7a, 0xfe, 0xf9, 0xc9, 0xda, 0xb2, 0x1b, 0x6c, 0xc3, 0x76, 0xf6, 0x8c, 0x62,
+	0x3c, 0x8b, 0x97, 0xae, 0x11, 0x57, 0xc7, 0xaf, 0xd1, 0x22, 0xcd, 0x09, 0x2b, 0x20, 0x4d, 0x48,
+	0x21, 0x4a, 0x6e, 0xfc, 0x3b, 0x6d, 0x6f, 0xf5, 0xde, 0xfa, 0x4a, 0xe8, 0x0c, 0x46, 0x59, 0x42,
+	0x97, 0x25, 0xdc, 0x14, 0x8c, 0xc7, 0x0b, 0x0e, 0xd8, 0xa8, 0xf4, 0xf8, 0x1d, 0xba, 0x9f, 0x13,
+	0x6d, 0x92, 0x6b, 0x1b, 0xe3, 0xcf, 0x54, 0x16, 0xcd, 0xd0, 0xa6, 0x09, 0xc7, 0x69, 0xc2, 0xfd,
+	0x71, 0xdc, 0x5e, 0xfd, 0xf8, 0x57, 0xcb, 0x8b, 0x17, 0x46, 0xe0, 0xa6, 0x73, 0x33, 0xf8, 0x09,
+	0x5a, 0x30, 0x8a, 0x70, 0x3a, 0x00, 0x9d, 0xe4, 0x70, 0x60, 0xfc, 0x7a, 0xdb, 0x5b, 0xad, 0xc7,
+	0xf3, 0xe3, 0xe2, 0x0e, 0x1c, 0x18, 0xbc, 0x8d, 0x70, 0xbf, 0xda, 0x40, 0x22, 0x41, 0x25, 0xae,
+	0xe5, 0xcf, 0x4e, 0x1b, 0x7a, 0xc9, 0x42, 0xbb, 0xa0, 0xf6, 0x2d, 0x82, 0x5f, 0xa0, 0x86, 0x04,
+	0xc5, 0x44, 0xea, 0x37, 0x1c, 0x7c, 0x7b, 0xdc, 0x2d, 0xb7, 0xfc, 0x5e, 0xfd, 0xdb, 0x68, 0x5a,
+	0x27, 0xef, 0x7c, 0xf7, 0xd0, 0xe2, 0xa6, 0xe0, 0x86, 0xf1, 0x52, 0x94, 0xfa, 0x6d, 0xc9, 0x53,
+	0xfc, 0x1c, 0xcd, 0x5d, 0x6f, 0x76, 0xea, 0x25, 0xfc, 0x95, 0xe2, 0x0f, 0x08, 0x49, 0x50, 0x14,
+	0xb8, 0x21, 0x19, 0x54, 0x9b, 0x9f, 0xeb, 0x75, 0x4f, 0x2f, 0x5a, 0xb5, 0x9f, 0x17, 0xad, 0x47,
+	0x16, 0xd6, 0xe9, 0xe7, 0x90, 0x89, 0xa8, 0x20, 0x66, 0x10, 0xee, 0x40, 0x46, 0xe8, 0x70, 0x0b,
+	0xe8, 0xf9, 0xc9, 0x1a, 0x72, 0xde, 0x5b, 0x40, 0xe3, 0x09, 0x13, 0xfc, 0x12, 0x35, 0xe0, 0x48,
+	0x32, 0x35, 0xfc, 0xe7, 0x5b, 0x70, 0xfa, 0xce, 0x00, 0xe1, 0x3d, 0x09, 0x3c, 0x7d, 0xaf, 0xb3,
+	0x5d, 0x50, 0x05, 0xd3, 0x9a, 0x09, 0x8e, 0x9f, 0xa2, 0x86, 0x66, 0x19, 0x07, 0x35, 0x35, 0x97,
+	0xd3, 0xe1, 0x36, 0x9a, 0x2f, 0x74, 0x96, 0x8c, 0xbe, 0xb0, 0xa4, 0x54, 0xb9, 0x8d, 0x15, 0xa3,
+	0x42, 0x67, 0xfb, 0x43, 0x09, 0x1f, 0x55, 0xde, 0xf9, 0xea, 0xa1, 0xf9, 0x18, 0x28, 0x30, 0x69,
+	0xf6, 0x06, 0x44, 0x01, 0x5e, 0x47, 0x77, 0x09, 0xa5, 0xd5, 0xdf, 0x6f, 0xda, 0x29, 0x63, 0x21,
+	0xde, 0x46, 0xb3, 0x7a, 0x04, 0xff, 0xff, 0xda, 0x2c, 0xdf, 0x7b, 0x75, 0x7a, 0x19, 0x78, 0x67,
+	0x97, 0x81, 0xf7, 0xfb, 0x32, 0xf0, 0x8e, 0xaf, 0x82, 0xda, 0xd9, 0x55, 0x50, 0xfb, 0x71, 0x15,
+	0xd4, 0x3e, 0x3d, 0xbe, 0xe1, 0x75, 0x74, 0xf3, 0x11, 0xa9, 0x5e, 0x90, 0x7e, 0xa3, 0xaa, 0x3d,
+	0xfb, 0x33, 0x00, 0x2b, 0xad, 0xa5, 0x72, 0x68, 0x04, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -356,6 +468,83 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpendMsgPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendMsgPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendMsgPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -418,6 +607,38 @@ func (m *ContinuousFund) Size() (n int) {
 	return n
 }
 
+func (m *SpendMsgPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceiptShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -821,6 +1042,236 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpendMsgPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendMsgPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendMsgPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
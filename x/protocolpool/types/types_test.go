@@ -24,6 +24,7 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgWithdrawContinuousFund{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgSetReceiptShare{}))
 }
 
 func TestNewMsgFundCommunityPool(t *testing.T) {
@@ -81,6 +82,56 @@ func TestValidateGenesis(t *testing.T) {
 	require.EqualError(t, err, "recipient cannot be empty")
 }
 
+func TestValidateReceiptShare(t *testing.T) {
+	testCases := []struct {
+		name      string
+		share     math.LegacyDec
+		expErrMsg string
+	}{
+		{
+			name:  "valid share",
+			share: math.LegacyMustNewDecFromStr("0.1"),
+		},
+		{
+			name:  "whole receipts",
+			share: math.LegacyOneDec(),
+		},
+		{
+			name:      "zero share",
+			share:     math.LegacyZeroDec(),
+			expErrMsg: "receipt share must be positive",
+		},
+		{
+			name:      "negative share",
+			share:     math.LegacyMustNewDecFromStr("-0.1"),
+			expErrMsg: "receipt share must be positive",
+		},
+		{
+			name:      "share greater than one",
+			share:     math.LegacyMustNewDecFromStr("1.1"),
+			expErrMsg: "receipt share cannot be greater than one",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateReceiptShare(tc.share)
+			if tc.expErrMsg != "" {
+				require.EqualError(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	gs := DefaultGenesisState()
+	gs.ReceiptShares = []*ReceiptShare{
+		{Account: "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4", Share: math.LegacyMustNewDecFromStr("0.1")},
+		{Account: "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4", Share: math.LegacyMustNewDecFromStr("0.2")},
+	}
+	require.ErrorContains(t, ValidateGenesis(gs), "duplicate receipt share")
+}
+
 func TestValidateBudget(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -34,6 +34,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "SlashesByDelegator",
+					Use:       "slashes-by-delegator [delegator-address]",
+					Short:     "Query the slashes which reduced the stake of a delegator",
+					Example:   fmt.Sprintf("%s query slashing slashes-by-delegator cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "TombstoneHistory",
+					Use:       "tombstone-history [validator-cons-address]",
+					Short:     "Query the changes of the tombstone flag of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorSlashes",
+					Use:       "validator-slashes [validator-cons-address]",
+					Short:     "Query the slashes of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorJailHistory",
+					Use:       "jail-history [validator-cons-address]",
+					Short:     "Query the jailings of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -54,6 +87,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "UnjailTombstoned",
+					Use:            "unjail-tombstoned-proposal [validator-cons-address] [reason]",
+					Short:          "Submit a proposal to clear the tombstone of a validator, for cases of provable infrastructure bugs.",
+					Example:        fmt.Sprintf(`%s tx slashing unjail-tombstoned-proposal cosmosvalcons1... "double sign caused by a key management bug"`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "cons_address"}, {ProtoField: "reason"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
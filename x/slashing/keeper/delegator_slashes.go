@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// slashAndRecord slashes a validator through the staking module and records
+// the slash in the history of the validator, with the bonded tokens each
+// delegator share of the validator lost. The losses of the delegators are
+// derived from the slash history at query time, so that a slash does the same
+// amount of work whatever the number of delegations to the validator.
+func (k Keeper) slashAndRecord(
+	ctx context.Context,
+	consAddr sdk.ConsAddress,
+	distributionHeight, power int64,
+	fraction sdkmath.LegacyDec,
+	infraction st.Infraction,
+) (sdkmath.Int, error) {
+	before, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			// the staking module ignores slashes of unknown validators, so they
+			// are not recorded either
+			return k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+		}
+		return sdkmath.Int{}, err
+	}
+
+	coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	after, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	// the delegator shares are left untouched by a slash, only the tokens
+	// backing them decrease
+	tokensPerShareSlashed := tokensPerShare(before).Sub(tokensPerShare(after))
+	if err := k.recordValidatorSlash(ctx, consAddr, distributionHeight, power, fraction, coinsBurned, tokensPerShareSlashed, infraction); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventValidatorSlashed{
+		ValidatorAddress:      before.GetOperator(),
+		Reason:                infractionReason(infraction),
+		SlashFraction:         fraction,
+		TokensPerShareSlashed: tokensPerShareSlashed,
+	}); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return coinsBurned, nil
+}
+
+// tokensPerShare returns the bonded tokens backing each delegator share of a
+// validator.
+func tokensPerShare(validator sdk.ValidatorI) sdkmath.LegacyDec {
+	if !validator.GetDelegatorShares().IsPositive() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return validator.TokensFromShares(sdkmath.LegacyOneDec())
+}
+
+// delegatorSlashes computes the tokens the current delegations, unbonding
+// delegations and redelegations of a delegator lost to the slashes recorded in
+// the history of their validators, from the oldest to the most recent slash.
+//
+// The loss of a delegation is computed from its current shares, the loss of an
+// unbonding delegation or redelegation entry the way the staking module slashes
+// it: from its initial balance, for the infractions committed before it was
+// created and punished before it completed.
+func (k Keeper) delegatorSlashes(ctx context.Context, delegator sdk.AccAddress) ([]types.DelegatorSlash, error) {
+	var slashes []types.DelegatorSlash
+	appendSlash := func(valAddr, source string, slash types.ValidatorSlash, lost sdkmath.Int) {
+		if !lost.IsPositive() {
+			return
+		}
+		slashes = append(slashes, types.DelegatorSlash{
+			ValidatorAddress: valAddr,
+			Height:           slash.Height,
+			Time:             slash.Time,
+			Reason:           slash.Reason,
+			SlashFraction:    slash.SlashFraction,
+			Amount:           lost,
+			Source:           source,
+		})
+	}
+
+	delegations, err := k.sk.GetAllDelegatorDelegations(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+	for _, delegation := range delegations {
+		err := k.walkValidatorSlashes(ctx, delegation.ValidatorAddress, func(slash types.ValidatorSlash) {
+			lost := slash.TokensPerShareSlashed.Mul(delegation.Shares).TruncateInt()
+			appendSlash(delegation.ValidatorAddress, types.DelegatorSlashSourceDelegation, slash, lost)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ubds, err := k.sk.GetAllUnbondingDelegations(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+	for _, ubd := range ubds {
+		err := k.walkValidatorSlashes(ctx, ubd.ValidatorAddress, func(slash types.ValidatorSlash) {
+			for _, entry := range ubd.Entries {
+				lost := entryLoss(slash, entry.CreationHeight, entry.CompletionTime, entry.InitialBalance)
+				appendSlash(ubd.ValidatorAddress, types.DelegatorSlashSourceUnbondingDelegation, slash, lost)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reds, err := k.sk.GetAllRedelegations(ctx, delegator, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, red := range reds {
+		err := k.walkValidatorSlashes(ctx, red.ValidatorSrcAddress, func(slash types.ValidatorSlash) {
+			for _, entry := range red.Entries {
+				lost := entryLoss(slash, entry.CreationHeight, entry.CompletionTime, entry.InitialBalance)
+				appendSlash(red.ValidatorSrcAddress, types.DelegatorSlashSourceRedelegation, slash, lost)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortStableFunc(slashes, func(a, b types.DelegatorSlash) int {
+		return cmp.Compare(a.Height, b.Height)
+	})
+	return slashes, nil
+}
+
+// walkValidatorSlashes calls fn for every slash in the history of the given
+// validator. The history of a validator no longer known to the staking module
+// cannot be resolved and is skipped.
+func (k Keeper) walkValidatorSlashes(ctx context.Context, valAddr string, fn func(types.ValidatorSlash)) error {
+	addr, err := k.sk.ValidatorAddressCodec().StringToBytes(valAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.sk.Validator(ctx, addr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil
+		}
+		return err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](consAddr)
+	return k.ValidatorSlashRecords.Walk(ctx, rng, func(_ collections.Pair[sdk.ConsAddress, uint64], slash types.ValidatorSlash) (bool, error) {
+		fn(slash)
+		return false, nil
+	})
+}
+
+// entryLoss returns the tokens an unbonding delegation or redelegation entry
+// lost to a slash. The staking module only slashes the entries created at or
+// after the infraction height, which are not mature yet.
+func entryLoss(slash types.ValidatorSlash, creationHeight int64, completionTime time.Time, initialBalance sdkmath.Int) sdkmath.Int {
+	if slash.InfractionHeight >= slash.Height || creationHeight < slash.InfractionHeight ||
+		creationHeight > slash.Height || !completionTime.After(slash.Time) {
+		return sdkmath.ZeroInt()
+	}
+	return slash.SlashFraction.MulInt(initialBalance).TruncateInt()
+}
+
+// infractionReason returns the event attribute value describing an infraction.
+func infractionReason(infraction st.Infraction) string {
+	switch infraction {
+	case st.Infraction_INFRACTION_DOUBLE_SIGN:
+		return types.AttributeValueDoubleSign
+	case st.Infraction_INFRACTION_DOWNTIME:
+		return types.AttributeValueMissingSignature
+	default:
+		return types.AttributeValueUnspecified
+	}
+}
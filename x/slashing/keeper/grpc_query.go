@@ -2,14 +2,18 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -80,3 +84,174 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// SlashesByDelegator returns the slashes applied to the delegations of a delegator.
+func (k Keeper) SlashesByDelegator(ctx context.Context, req *types.QuerySlashesByDelegatorRequest) (*types.QuerySlashesByDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	delegator, err := k.addressCodec.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+
+	slashes, err := k.delegatorSlashes(ctx, delegator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	slashes, pageRes, err := paginateDelegatorSlashes(slashes, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashesByDelegatorResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// TombstoneHistory returns the changes of the tombstone flag of a validator.
+func (k Keeper) TombstoneHistory(ctx context.Context, req *types.QueryTombstoneHistoryRequest) (*types.QueryTombstoneHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.TombstoneRecords,
+		req.Pagination,
+		func(_ collections.Pair[sdk.ConsAddress, uint64], record types.TombstoneRecord) (types.TombstoneRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.ConsAddress, uint64](consAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTombstoneHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// ValidatorSlashes returns the slashes of a validator.
+func (k Keeper) ValidatorSlashes(ctx context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	slashes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.ValidatorSlashRecords,
+		req.Pagination,
+		func(_ collections.Pair[sdk.ConsAddress, uint64], slash types.ValidatorSlash) (types.ValidatorSlash, error) {
+			return slash, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.ConsAddress, uint64](consAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorSlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// ValidatorJailHistory returns the jailings of a validator.
+func (k Keeper) ValidatorJailHistory(ctx context.Context, req *types.QueryValidatorJailHistoryRequest) (*types.QueryValidatorJailHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.JailRecords,
+		req.Pagination,
+		func(_ collections.Pair[sdk.ConsAddress, uint64], record types.JailRecord) (types.JailRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.ConsAddress, uint64](consAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorJailHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// paginateDelegatorSlashes returns a page of the slashes of a delegator, which
+// are computed rather than read from a store. The page key is the big endian
+// encoding of the position of the first slash of the page.
+func paginateDelegatorSlashes(slashes []types.DelegatorSlash, pageReq *query.PageRequest) ([]types.DelegatorSlash, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+
+	if pageReq.Reverse {
+		slashes = slices.Clone(slashes)
+		slices.Reverse(slashes)
+	}
+
+	start := pageReq.Offset
+	if len(pageReq.Key) != 0 {
+		if pageReq.Offset > 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+		}
+		if len(pageReq.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		// the key based pagination does not count the total
+		start, countTotal = binary.BigEndian.Uint64(pageReq.Key), false
+	}
+
+	res := new(query.PageResponse)
+	if countTotal {
+		res.Total = uint64(len(slashes))
+	}
+
+	total := uint64(len(slashes))
+	if start >= total {
+		return []types.DelegatorSlash{}, res, nil
+	}
+
+	end := total
+	if limit < total-start {
+		end = start + limit
+		res.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	}
+
+	return slashes[start:end], res, nil
+}
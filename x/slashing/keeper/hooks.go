@@ -94,6 +94,10 @@ func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ sdk
 	return nil
 }
 
+func (h Hooks) BeforeTruncationDustRouted(_ context.Context, _ sdk.ValAddress, _ sdkmath.LegacyDec) error {
+	return nil
+}
+
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
@@ -52,7 +52,33 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		return err
 	}
 
-	signedBlocksWindow := params.SignedBlocksWindow
+	signedBlocksWindow, minSignedPerWindow := params.LivenessParams(power)
+
+	// The missed block bitmap and counter are tracked against the window of the
+	// validator class, so the bitmap of a validator moving to a class with
+	// another window is carried over to the new window. Its missed blocks are
+	// kept, up to the size of the new window, and its start height is kept so
+	// that changing class neither clears its downtime nor grants a new grace
+	// period.
+	modifiedSignInfo := false
+	classWindow := signedBlocksWindow
+	if classWindow == params.SignedBlocksWindow {
+		classWindow = 0
+	}
+	if signInfo.SignedBlocksWindow != classWindow {
+		oldWindow := signInfo.SignedBlocksWindow
+		if oldWindow == 0 {
+			oldWindow = params.SignedBlocksWindow
+		}
+
+		signInfo.MissedBlocksCounter, err = k.carryMissedBlockBitmap(ctx, consAddr, signInfo.StartHeight, height, oldWindow, signedBlocksWindow, signInfo.MissedBlocksCounter)
+		if err != nil {
+			return err
+		}
+
+		signInfo.SignedBlocksWindow = classWindow
+		modifiedSignInfo = true
+	}
 
 	// Compute the relative index, so we count the blocks the validator *should*
 	// have signed. We will also use the 0-value default signing info if not present.
@@ -77,7 +103,6 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		return fmt.Errorf("failed to get the validator's bitmap value: %w", err)
 	}
 
-	modifiedSignInfo := false
 	missed := signed == comet.BlockIDFlagAbsent
 	switch {
 	case !previous && missed:
@@ -104,8 +129,6 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		// bitmap value at this index has not changed, no need to update counter
 	}
 
-	minSignedPerWindow := params.MinSignedPerWindowInt()
-
 	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
 	if err != nil {
 		return err
@@ -154,7 +177,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				return err
 			}
 
-			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
+			coinsBurned, err := k.slashAndRecord(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
 			if err != nil {
 				return err
 			}
@@ -174,6 +197,9 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
+			if err := k.recordJail(ctx, consAddr, st.Infraction_INFRACTION_DOWNTIME); err != nil {
+				return err
+			}
 			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
 			if err != nil {
 				return err
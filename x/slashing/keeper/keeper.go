@@ -6,6 +6,7 @@ import (
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
 	sdkmath "cosmossdk.io/math"
@@ -25,6 +26,8 @@ type Keeper struct {
 	legacyAmino *codec.LegacyAmino
 	sk          types.StakingKeeper
 
+	addressCodec address.Codec
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -36,18 +39,31 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// TombstoneRecords key: ConsAddr | Sequence | value: TombstoneRecord
+	TombstoneRecords collections.Map[collections.Pair[sdk.ConsAddress, uint64], types.TombstoneRecord]
+	// TombstoneHistorySequence is the sequence used to order the tombstone records
+	TombstoneHistorySequence collections.Sequence
+	// ValidatorSlashRecords key: ConsAddr | Sequence | value: ValidatorSlash
+	ValidatorSlashRecords collections.Map[collections.Pair[sdk.ConsAddress, uint64], types.ValidatorSlash]
+	// ValidatorSlashSequence is the sequence used to order the validator slashes
+	ValidatorSlashSequence collections.Sequence
+	// JailRecords key: ConsAddr | Sequence | value: JailRecord
+	JailRecords collections.Map[collections.Pair[sdk.ConsAddress, uint64], types.JailRecord]
+	// JailHistorySequence is the sequence used to order the jail records
+	JailHistorySequence collections.Sequence
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, sk types.StakingKeeper, authority string) Keeper {
+func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, sk types.StakingKeeper, authority string, addressCodec address.Codec) Keeper {
 	sb := collections.NewSchemaBuilder(environment.KVStoreService)
 	k := Keeper{
-		Environment: environment,
-		cdc:         cdc,
-		legacyAmino: legacyAmino,
-		sk:          sk,
-		authority:   authority,
-		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Environment:  environment,
+		cdc:          cdc,
+		legacyAmino:  legacyAmino,
+		sk:           sk,
+		authority:    authority,
+		addressCodec: addressCodec,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		ValidatorSigningInfo: collections.NewMap(
 			sb,
 			types.ValidatorSigningInfoKeyPrefix,
@@ -69,6 +85,30 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		TombstoneRecords: collections.NewMap(
+			sb,
+			types.TombstoneHistoryKeyPrefix,
+			"tombstone_history",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key),
+			codec.CollValue[types.TombstoneRecord](cdc),
+		),
+		TombstoneHistorySequence: collections.NewSequence(sb, types.TombstoneHistorySequenceKey, "tombstone_history_sequence"),
+		ValidatorSlashRecords: collections.NewMap(
+			sb,
+			types.ValidatorSlashesKeyPrefix,
+			"validator_slashes",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key),
+			codec.CollValue[types.ValidatorSlash](cdc),
+		),
+		ValidatorSlashSequence: collections.NewSequence(sb, types.ValidatorSlashSequenceKey, "validator_slash_sequence"),
+		JailRecords: collections.NewMap(
+			sb,
+			types.JailHistoryKeyPrefix,
+			"jail_history",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key),
+			codec.CollValue[types.JailRecord](cdc),
+		),
+		JailHistorySequence: collections.NewSequence(sb, types.JailHistorySequenceKey, "jail_history_sequence"),
 	}
 
 	schema, err := sb.Build()
@@ -98,18 +138,12 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction sd
 // SlashWithInfractionReason attempts to slash a validator. The slash is delegated to the staking
 // module to make the necessary validator changes. It specifies an intraction reason.
 func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction st.Infraction) error {
-	coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+	coinsBurned, err := k.slashAndRecord(ctx, consAddr, distributionHeight, power, fraction, infraction)
 	if err != nil {
 		return err
 	}
 
-	reasonAttr := event.NewAttribute(types.AttributeKeyReason, types.AttributeValueUnspecified)
-	switch infraction {
-	case st.Infraction_INFRACTION_DOUBLE_SIGN:
-		reasonAttr = event.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign)
-	case st.Infraction_INFRACTION_DOWNTIME:
-		reasonAttr = event.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature)
-	}
+	reasonAttr := event.NewAttribute(types.AttributeKeyReason, infractionReason(infraction))
 
 	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
 	if err != nil {
@@ -126,12 +160,24 @@ func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.Cons
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
-// to make the necessary validator changes.
+// to make the necessary validator changes. It specifies no infraction reason.
 func (k Keeper) Jail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	return k.JailWithInfractionReason(ctx, consAddr, st.Infraction_INFRACTION_UNSPECIFIED)
+}
+
+// JailWithInfractionReason attempts to jail a validator. The jailing is delegated
+// to the staking module to make the necessary validator changes. It specifies an
+// infraction reason.
+func (k Keeper) JailWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, infraction st.Infraction) error {
 	err := k.sk.Jail(ctx, consAddr)
 	if err != nil {
 		return err
 	}
+
+	if err := k.recordJail(ctx, consAddr, infraction); err != nil {
+		return err
+	}
+
 	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
 	if err != nil {
 		return err
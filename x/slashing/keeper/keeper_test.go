@@ -17,16 +17,19 @@ import (
 	slashingkeeper "cosmossdk.io/x/slashing/keeper"
 	slashingtestutil "cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	addresstypes "github.com/cosmos/cosmos-sdk/types/address"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var consAddr = sdk.ConsAddress(sdk.AccAddress([]byte("addr1_______________")))
@@ -67,6 +70,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		encCfg.Amino,
 		s.stakingKeeper,
 		authStr,
+		address.NewBech32Codec("cosmos"),
 	)
 	// set test params
 	err = s.slashingKeeper.Params.Set(ctx, slashingtestutil.TestParams())
@@ -95,6 +99,7 @@ func (s *KeeperTestSuite) TestJailAndSlash() {
 	slashFractionDoubleSign, err := s.slashingKeeper.SlashFractionDoubleSign(s.ctx)
 	s.Require().NoError(err)
 
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
 	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx,
 		consAddr,
 		s.ctx.BlockHeight(),
@@ -119,6 +124,7 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	slashFractionDoubleSign, err := s.slashingKeeper.SlashFractionDoubleSign(s.ctx)
 	s.Require().NoError(err)
 
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
 	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx,
 		consAddr,
 		s.ctx.BlockHeight(),
@@ -140,6 +146,159 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	s.Require().NoError(s.slashingKeeper.Jail(s.ctx, consAddr))
 }
 
+func (s *KeeperTestSuite) TestSlashesByDelegator() {
+	require := s.Require()
+	slashFraction := sdkmath.LegacyNewDecWithPrec(5, 2)
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+
+	consPk := ed25519.GenPrivKey().PubKey()
+	valConsAddr := sdk.ConsAddress(consPk.Address())
+	valAddr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(sdk.ValAddress(valConsAddr))
+	require.NoError(err)
+	addrCodec := address.NewBech32Codec("cosmos")
+	del1Acc, del2Acc := sdk.AccAddress("delegator1__________"), sdk.AccAddress("delegator2__________")
+	del1, err := addrCodec.BytesToString(del1Acc)
+	require.NoError(err)
+	del2, err := addrCodec.BytesToString(del2Acc)
+	require.NoError(err)
+
+	before, err := stakingtypes.NewValidator(valAddr, consPk, stakingtypes.Description{})
+	require.NoError(err)
+	before.Tokens, before.DelegatorShares = sdkmath.NewInt(100), sdkmath.LegacyNewDec(100)
+	after := before
+	after.Tokens = sdkmath.NewInt(95)
+
+	// the slash does not depend on the delegations to the validator
+	gomock.InOrder(
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, valConsAddr).Return(before, nil),
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(ctx, valConsAddr, int64(1), int64(1), slashFraction, st.Infraction_INFRACTION_DOUBLE_SIGN).Return(sdkmath.NewInt(5), nil),
+		s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, valConsAddr).Return(after, nil),
+	)
+	require.NoError(s.slashingKeeper.SlashWithInfractionReason(ctx, valConsAddr, slashFraction, 1, 1, st.Infraction_INFRACTION_DOUBLE_SIGN))
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr)).Return(after, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), del1Acc).Return([]stakingtypes.Delegation{
+		stakingtypes.NewDelegation(del1, valAddr, sdkmath.LegacyNewDec(60)),
+	}, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().GetAllUnbondingDelegations(gomock.Any(), del1Acc).Return(nil, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().GetAllRedelegations(gomock.Any(), del1Acc, nil, nil).Return(nil, nil).AnyTimes()
+
+	// the entries created before the infraction or completed before the slash
+	// were not slashed
+	completion := ctx.HeaderInfo().Time.Add(time.Hour)
+	s.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), del2Acc).Return([]stakingtypes.Delegation{
+		stakingtypes.NewDelegation(del2, valAddr, sdkmath.LegacyNewDec(40)),
+	}, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().GetAllUnbondingDelegations(gomock.Any(), del2Acc).Return([]stakingtypes.UnbondingDelegation{
+		{DelegatorAddress: del2, ValidatorAddress: valAddr, Entries: []stakingtypes.UnbondingDelegationEntry{
+			stakingtypes.NewUnbondingDelegationEntry(5, completion, sdkmath.NewInt(40), 1),
+			stakingtypes.NewUnbondingDelegationEntry(0, completion, sdkmath.NewInt(40), 2),
+			stakingtypes.NewUnbondingDelegationEntry(5, ctx.HeaderInfo().Time, sdkmath.NewInt(40), 3),
+		}},
+	}, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().GetAllRedelegations(gomock.Any(), del2Acc, nil, nil).Return([]stakingtypes.Redelegation{
+		{DelegatorAddress: del2, ValidatorSrcAddress: valAddr, Entries: []stakingtypes.RedelegationEntry{
+			stakingtypes.NewRedelegationEntry(8, completion, sdkmath.NewInt(20), sdkmath.LegacyNewDec(20), 4),
+		}},
+	}, nil).AnyTimes()
+
+	res, err := s.queryClient.SlashesByDelegator(ctx, &slashingtypes.QuerySlashesByDelegatorRequest{DelegatorAddress: del1})
+	require.NoError(err)
+	require.Len(res.Slashes, 1)
+	require.Equal(valAddr, res.Slashes[0].ValidatorAddress)
+	require.Equal(int64(10), res.Slashes[0].Height)
+	require.Equal(slashingtypes.AttributeValueDoubleSign, res.Slashes[0].Reason)
+	require.Equal(slashFraction, res.Slashes[0].SlashFraction)
+	require.Equal(sdkmath.NewInt(3), res.Slashes[0].Amount)
+	require.Equal(slashingtypes.DelegatorSlashSourceDelegation, res.Slashes[0].Source)
+
+	res, err = s.queryClient.SlashesByDelegator(ctx, &slashingtypes.QuerySlashesByDelegatorRequest{DelegatorAddress: del2})
+	require.NoError(err)
+	require.Len(res.Slashes, 3)
+	require.Equal(sdkmath.NewInt(2), res.Slashes[0].Amount)
+	require.Equal(slashingtypes.DelegatorSlashSourceDelegation, res.Slashes[0].Source)
+	require.Equal(sdkmath.NewInt(2), res.Slashes[1].Amount)
+	require.Equal(slashingtypes.DelegatorSlashSourceUnbondingDelegation, res.Slashes[1].Source)
+	require.Equal(sdkmath.NewInt(1), res.Slashes[2].Amount)
+	require.Equal(slashingtypes.DelegatorSlashSourceRedelegation, res.Slashes[2].Source)
+
+	page, err := s.queryClient.SlashesByDelegator(ctx, &slashingtypes.QuerySlashesByDelegatorRequest{
+		DelegatorAddress: del2,
+		Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(res.Slashes[:2], page.Slashes)
+	require.Equal(uint64(3), page.Pagination.Total)
+
+	page, err = s.queryClient.SlashesByDelegator(ctx, &slashingtypes.QuerySlashesByDelegatorRequest{
+		DelegatorAddress: del2,
+		Pagination:       &query.PageRequest{Key: page.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(err)
+	require.Equal(res.Slashes[2:], page.Slashes)
+	require.Nil(page.Pagination.NextKey)
+
+	_, err = s.queryClient.SlashesByDelegator(ctx, &slashingtypes.QuerySlashesByDelegatorRequest{})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestValidatorSlashesAndJailHistory() {
+	require := s.Require()
+	slashFraction := sdkmath.LegacyNewDecWithPrec(5, 2)
+
+	consStr, err := address.NewBech32Codec("cosmosvalcons").BytesToString(consAddr)
+	require.NoError(err)
+	valAddr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(sdk.ValAddress(consAddr))
+	require.NoError(err)
+	val := stakingtypes.Validator{OperatorAddress: valAddr, Tokens: sdkmath.NewInt(100), DelegatorShares: sdkmath.LegacyNewDec(100)}
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(s.ctx, consAddr).Return(val, nil).Times(2)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(s.ctx, consAddr, int64(1), int64(10), slashFraction, st.Infraction_INFRACTION_DOUBLE_SIGN).Return(sdkmath.NewInt(5), nil)
+	s.stakingKeeper.EXPECT().Jail(s.ctx, consAddr).Return(nil).Times(2)
+
+	require.NoError(s.slashingKeeper.SlashWithInfractionReason(s.ctx, consAddr, slashFraction, 10, 1, st.Infraction_INFRACTION_DOUBLE_SIGN))
+	require.NoError(s.slashingKeeper.JailWithInfractionReason(s.ctx, consAddr, st.Infraction_INFRACTION_DOUBLE_SIGN))
+	require.NoError(s.slashingKeeper.Jail(s.ctx, consAddr))
+
+	slashes, err := s.queryClient.ValidatorSlashes(s.ctx, &slashingtypes.QueryValidatorSlashesRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Len(slashes.Slashes, 1)
+	require.Equal(slashingtypes.ValidatorSlash{
+		Height:           s.ctx.HeaderInfo().Height,
+		Time:             s.ctx.HeaderInfo().Time,
+		Reason:           slashingtypes.AttributeValueDoubleSign,
+		InfractionHeight: 1,
+		Power:            10,
+		SlashFraction:    slashFraction,
+		BurnedAmount:     sdkmath.NewInt(5),
+		// the validator tokens are left untouched by the mocked slash
+		TokensPerShareSlashed: sdkmath.LegacyZeroDec(),
+	}, slashes.Slashes[0])
+
+	jails, err := s.queryClient.ValidatorJailHistory(s.ctx, &slashingtypes.QueryValidatorJailHistoryRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Len(jails.Records, 2)
+	require.Equal(slashingtypes.AttributeValueDoubleSign, jails.Records[0].Reason)
+	require.Equal(slashingtypes.AttributeValueUnspecified, jails.Records[1].Reason)
+
+	jails, err = s.queryClient.ValidatorJailHistory(s.ctx, &slashingtypes.QueryValidatorJailHistoryRequest{
+		ConsAddress: consStr,
+		Pagination:  &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(err)
+	require.Len(jails.Records, 1)
+	require.Equal(slashingtypes.AttributeValueUnspecified, jails.Records[0].Reason)
+
+	otherConsStr, err := address.NewBech32Codec("cosmosvalcons").BytesToString(sdk.ConsAddress("addr2_______________"))
+	require.NoError(err)
+	slashes, err = s.queryClient.ValidatorSlashes(s.ctx, &slashingtypes.QueryValidatorSlashesRequest{ConsAddress: otherConsStr})
+	require.NoError(err)
+	require.Empty(slashes.Slashes)
+
+	_, err = s.queryClient.ValidatorJailHistory(s.ctx, &slashingtypes.QueryValidatorJailHistoryRequest{})
+	require.Error(err)
+}
+
 // ValidatorMissedBlockBitmapKey returns the key for a validator's missed block
 // bitmap chunk.
 func validatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
@@ -54,3 +54,22 @@ func (k msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// UnjailTombstoned implements MsgServer.UnjailTombstoned method.
+// It defines a governance operation clearing the tombstone of a validator.
+func (k msgServer) UnjailTombstoned(ctx context.Context, msg *types.MsgUnjailTombstoned) (*types.MsgUnjailTombstonedResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(msg.ConsAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid consensus address: %s", err)
+	}
+
+	if err := k.ClearTombstone(ctx, consAddr, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailTombstonedResponse{}, nil
+}
@@ -118,6 +118,43 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set duplicate validator class",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(10),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					ValidatorClasses: []slashingtypes.ValidatorClass{
+						{MaxPower: 100, SignedBlocksWindow: 250, MinSignedPerWindow: minSignedPerWindow},
+						{MaxPower: 100, SignedBlocksWindow: 500, MinSignedPerWindow: minSignedPerWindow},
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "duplicate validator class max power",
+		},
+		{
+			name: "set invalid validator class window",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(10),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					ValidatorClasses: []slashingtypes.ValidatorClass{
+						{MaxPower: 100, SignedBlocksWindow: 0, MinSignedPerWindow: minSignedPerWindow},
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "signed blocks window must be positive",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
@@ -359,3 +396,64 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUnjailTombstoned() {
+	require := s.Require()
+	ctx, keeper := s.ctx, s.slashingKeeper
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 1, time.Unix(0, 0), false, 0)))
+
+	// a validator which is not tombstoned cannot have its tombstone cleared
+	_, err = s.msgServer.UnjailTombstoned(ctx, slashingtypes.NewMsgUnjailTombstoned(keeper.GetAuthority(), consStr, "bug"))
+	require.ErrorIs(err, slashingtypes.ErrValidatorNotTombstoned)
+
+	require.NoError(keeper.Tombstone(ctx, consAddr))
+	require.NoError(keeper.JailUntil(ctx, consAddr, time.Unix(253402300799, 0)))
+
+	testCases := []struct {
+		name      string
+		request   *slashingtypes.MsgUnjailTombstoned
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			request:   slashingtypes.NewMsgUnjailTombstoned("invalid", consStr, "bug"),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "invalid consensus address",
+			request:   slashingtypes.NewMsgUnjailTombstoned(keeper.GetAuthority(), "invalid", "bug"),
+			expErrMsg: "invalid consensus address",
+		},
+		{
+			name:    "success",
+			request: slashingtypes.NewMsgUnjailTombstoned(keeper.GetAuthority(), consStr, "infrastructure bug"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UnjailTombstoned(ctx, tc.request)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.False(info.Tombstoned)
+	require.Equal(ctx.HeaderInfo().Time, info.JailedUntil)
+
+	res, err := s.queryClient.TombstoneHistory(ctx, &slashingtypes.QueryTombstoneHistoryRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Len(res.Records, 2)
+	require.True(res.Records[0].Tombstoned)
+	require.False(res.Records[1].Tombstoned)
+	require.Equal("infrastructure bug", res.Records[1].Reason)
+}
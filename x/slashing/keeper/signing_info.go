@@ -55,7 +55,71 @@ func (k Keeper) Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
 	}
 
 	signInfo.Tombstoned = true
-	return k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo)
+	if err := k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo); err != nil {
+		return err
+	}
+
+	if err := k.addTombstoneRecord(ctx, consAddr, true, ""); err != nil {
+		return err
+	}
+
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&types.EventValidatorTombstoned{ConsAddress: consStr})
+}
+
+// ClearTombstone clears the tombstone of a validator and lifts its jail
+// period, so that its operator can unjail it with MsgUnjail. It is meant to be
+// used by governance for cases of provable infrastructure bugs.
+func (k Keeper) ClearTombstone(ctx context.Context, consAddr sdk.ConsAddress, reason string) error {
+	signInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrNoSigningInfoFound.Wrap("cannot clear the tombstone of a validator without signing information")
+		}
+		return err
+	}
+
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned
+	}
+
+	signInfo.Tombstoned = false
+	signInfo.JailedUntil = k.HeaderService.HeaderInfo(ctx).Time
+	if err := k.ValidatorSigningInfo.Set(ctx, consAddr, signInfo); err != nil {
+		return err
+	}
+
+	if err := k.addTombstoneRecord(ctx, consAddr, false, reason); err != nil {
+		return err
+	}
+
+	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).Emit(&types.EventTombstoneCleared{ConsAddress: consStr, Reason: reason})
+}
+
+// addTombstoneRecord appends a change of the tombstone flag of a validator to
+// its tombstone history.
+func (k Keeper) addTombstoneRecord(ctx context.Context, consAddr sdk.ConsAddress, tombstoned bool, reason string) error {
+	seq, err := k.TombstoneHistorySequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	return k.TombstoneRecords.Set(ctx, collections.Join(consAddr, seq), types.TombstoneRecord{
+		Tombstoned: tombstoned,
+		Height:     headerInfo.Height,
+		Time:       headerInfo.Time,
+		Reason:     reason,
+	})
 }
 
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
@@ -193,6 +257,72 @@ func (k Keeper) DeleteMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddres
 	return k.ValidatorMissedBlockBitmap.Clear(ctx, rng)
 }
 
+// carryMissedBlockBitmap moves the missed block bitmap of a validator from a
+// window of oldWindow blocks to one of newWindow blocks, when the validator
+// changes class. The missed blocks of the previous heights covered by both
+// windows keep their height, and the rest of the missedBlocks counter, capped
+// to the previous heights in the new window, is carried to the oldest of them
+// so that changing class never clears the missed blocks of a validator. It
+// returns the missed blocks counter matching the new bitmap.
+func (k Keeper) carryMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddress, startHeight, height, oldWindow, newWindow, missedBlocks int64) (int64, error) {
+	prevAddr, err := k.getPreviousConsKey(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+
+	// the lowest previous height covered by the new window
+	lowest := max(startHeight, height-newWindow+1)
+
+	missedHeights := make(map[int64]bool)
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](prevAddr.Bytes())
+	err = k.ValidatorMissedBlockBitmap.Walk(ctx, rng, func(key collections.Pair[[]byte, uint64], value []byte) (bool, error) {
+		bs := bitset.New(uint(types.MissedBlockBitmapChunkSize))
+		if err := bs.UnmarshalBinary(value); err != nil {
+			return true, errorsmod.Wrapf(err, "failed to decode bitmap chunk; index: %v", key)
+		}
+
+		for i, ok := bs.NextSet(0); ok; i, ok = bs.NextSet(i + 1) {
+			index := int64(key.K2())*types.MissedBlockBitmapChunkSize + int64(i)
+			if index >= oldWindow {
+				break
+			}
+
+			// the last height before the current one mapped to the index
+			offset := ((height-1-startHeight-index)%oldWindow + oldWindow) % oldWindow
+			if h := height - 1 - offset; h >= lowest {
+				missedHeights[h] = true
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.DeleteMissedBlockBitmap(ctx, addr); err != nil {
+		return 0, err
+	}
+
+	carried := min(missedBlocks, height-lowest)
+	var counter int64
+	for h := lowest; h < height; h++ {
+		if !missedHeights[h] {
+			if counter+int64(len(missedHeights)) >= carried {
+				continue
+			}
+		} else {
+			delete(missedHeights, h)
+		}
+
+		if err := k.SetMissedBlockBitmapValue(ctx, addr, (h-startHeight)%newWindow, true); err != nil {
+			return 0, err
+		}
+		counter++
+	}
+
+	return counter, nil
+}
+
 // IterateMissedBlockBitmap iterates over a validator's signed blocks window
 // bitmap and performs a callback function on each index, i.e. block height, in
 // the range [0, SignedBlocksWindow).
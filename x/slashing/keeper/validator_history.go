@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordValidatorSlash appends a slash to the slash history of a validator.
+func (k Keeper) recordValidatorSlash(
+	ctx context.Context,
+	consAddr sdk.ConsAddress,
+	infractionHeight, power int64,
+	fraction sdkmath.LegacyDec,
+	burned sdkmath.Int,
+	tokensPerShareSlashed sdkmath.LegacyDec,
+	infraction st.Infraction,
+) error {
+	seq, err := k.ValidatorSlashSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	return k.ValidatorSlashRecords.Set(ctx, collections.Join(consAddr, seq), types.ValidatorSlash{
+		Height:                headerInfo.Height,
+		Time:                  headerInfo.Time,
+		Reason:                infractionReason(infraction),
+		InfractionHeight:      infractionHeight,
+		Power:                 power,
+		SlashFraction:         fraction,
+		BurnedAmount:          burned,
+		TokensPerShareSlashed: tokensPerShareSlashed,
+	})
+}
+
+// recordJail appends a jailing to the jail history of a validator.
+func (k Keeper) recordJail(ctx context.Context, consAddr sdk.ConsAddress, infraction st.Infraction) error {
+	seq, err := k.JailHistorySequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	return k.JailRecords.Set(ctx, collections.Join(consAddr, seq), types.JailRecord{
+		Height: headerInfo.Height,
+		Time:   headerInfo.Time,
+		Reason: infractionReason(infraction),
+	})
+}
@@ -198,6 +198,51 @@ func (mr *MockStakingKeeperMockRecorder) Delegation(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), arg0, arg1, arg2)
 }
 
+// GetAllDelegatorDelegations mocks base method.
+func (m *MockStakingKeeper) GetAllDelegatorDelegations(ctx context.Context, delegator types0.AccAddress) ([]types.Delegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDelegatorDelegations", ctx, delegator)
+	ret0, _ := ret[0].([]types.Delegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDelegatorDelegations indicates an expected call of GetAllDelegatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetAllDelegatorDelegations(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDelegatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllDelegatorDelegations), ctx, delegator)
+}
+
+// GetAllRedelegations mocks base method.
+func (m *MockStakingKeeper) GetAllRedelegations(ctx context.Context, delegator types0.AccAddress, srcValAddress, dstValAddress types0.ValAddress) ([]types.Redelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRedelegations", ctx, delegator, srcValAddress, dstValAddress)
+	ret0, _ := ret[0].([]types.Redelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRedelegations indicates an expected call of GetAllRedelegations.
+func (mr *MockStakingKeeperMockRecorder) GetAllRedelegations(ctx, delegator, srcValAddress, dstValAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRedelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllRedelegations), ctx, delegator, srcValAddress, dstValAddress)
+}
+
+// GetAllUnbondingDelegations mocks base method.
+func (m *MockStakingKeeper) GetAllUnbondingDelegations(ctx context.Context, delegator types0.AccAddress) ([]types.UnbondingDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUnbondingDelegations", ctx, delegator)
+	ret0, _ := ret[0].([]types.UnbondingDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUnbondingDelegations indicates an expected call of GetAllUnbondingDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetAllUnbondingDelegations(ctx, delegator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUnbondingDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllUnbondingDelegations), ctx, delegator)
+}
+
 // GetAllValidators mocks base method.
 func (m *MockStakingKeeper) GetAllValidators(ctx context.Context) ([]types.Validator, error) {
 	m.ctrl.T.Helper()
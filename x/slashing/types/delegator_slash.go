@@ -0,0 +1,8 @@
+package types
+
+// The stakes of a delegator which can lose tokens when a validator is slashed.
+const (
+	DelegatorSlashSourceDelegation          = "delegation"
+	DelegatorSlashSourceUnbondingDelegation = "unbonding_delegation"
+	DelegatorSlashSourceRedelegation        = "redelegation"
+)
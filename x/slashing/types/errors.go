@@ -14,4 +14,5 @@ var (
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
 	ErrInvalidSigner                = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
 	ErrInvalidConsPubKey            = errors.Register(ModuleName, 11, "invalid consensus pubkey")
+	ErrValidatorNotTombstoned       = errors.Register(ModuleName, 12, "validator not tombstoned")
 )
@@ -45,6 +45,9 @@ type StakingKeeper interface {
 	// Delegation allows for getting a particular delegation for a given validator
 	// and delegator outside the scope of the staking module.
 	Delegation(context.Context, sdk.AccAddress, sdk.ValAddress) (sdk.DelegationI, error)
+	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+	GetAllUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.UnbondingDelegation, error)
+	GetAllRedelegations(ctx context.Context, delegator sdk.AccAddress, srcValAddress, dstValAddress sdk.ValAddress) ([]stakingtypes.Redelegation, error)
 	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 
 	// MaxValidators returns the maximum amount of bonded validators
@@ -72,4 +75,5 @@ type StakingHooks interface {
 	BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
+	BeforeTruncationDustRouted(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error // Must be called before the truncation dust of a validator is routed to the community pool
 }
@@ -62,5 +62,9 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateValidatorClasses(data.Params.ValidatorClasses); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -50,12 +50,30 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x06<consAddrLen (1 Byte)><consAddress_Bytes><sequence>: TombstoneRecord
+//
+// - 0x07: sequence of the tombstone records
+//
+// - 0x08<consAddrLen (1 Byte)><consAddress_Bytes><sequence>: ValidatorSlash
+//
+// - 0x09: sequence of the validator slashes
+//
+// - 0x0a<consAddrLen (1 Byte)><consAddress_Bytes><sequence>: JailRecord
+//
+// - 0x0b: sequence of the jail records
 
 var (
-	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
-	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
-	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
-	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	ParamsKey                           = collections.NewPrefix(0)  // Prefix for params key
+	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1)  // Prefix for signing info
+	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2)  // Prefix for missed block bitmap
+	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3)  // Prefix for address-pubkey relation
+	TombstoneHistoryKeyPrefix           = collections.NewPrefix(6)  // Prefix for the tombstone history
+	TombstoneHistorySequenceKey         = collections.NewPrefix(7)  // Key for the tombstone history sequence
+	ValidatorSlashesKeyPrefix           = collections.NewPrefix(8)  // Prefix for the validator slashes history
+	ValidatorSlashSequenceKey           = collections.NewPrefix(9)  // Key for the validator slashes sequence
+	JailHistoryKeyPrefix                = collections.NewPrefix(10) // Prefix for the jail history
+	JailHistorySequenceKey              = collections.NewPrefix(11) // Key for the jail history sequence
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -8,6 +8,7 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUnjailTombstoned{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +17,12 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgUnjailTombstoned creates a new MsgUnjailTombstoned instance
+func NewMsgUnjailTombstoned(authority, consAddr, reason string) *MsgUnjailTombstoned {
+	return &MsgUnjailTombstoned{
+		Authority:   authority,
+		ConsAddress: consAddr,
+		Reason:      reason,
+	}
+}
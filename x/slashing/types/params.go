@@ -61,6 +61,9 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateValidatorClasses(p.ValidatorClasses); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +150,55 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateValidatorClasses(i interface{}) error {
+	v, ok := i.([]ValidatorClass)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	maxPowers := make(map[int64]struct{}, len(v))
+	for _, class := range v {
+		if class.MaxPower <= 0 {
+			return fmt.Errorf("validator class max power must be positive: %d", class.MaxPower)
+		}
+		if _, ok := maxPowers[class.MaxPower]; ok {
+			return fmt.Errorf("duplicate validator class max power: %d", class.MaxPower)
+		}
+		maxPowers[class.MaxPower] = struct{}{}
+
+		if err := validateSignedBlocksWindow(class.SignedBlocksWindow); err != nil {
+			return fmt.Errorf("validator class with max power %d: %w", class.MaxPower, err)
+		}
+		if err := validateMinSignedPerWindow(class.MinSignedPerWindow); err != nil {
+			return fmt.Errorf("validator class with max power %d: %w", class.MaxPower, err)
+		}
+	}
+
+	return nil
+}
+
+// LivenessParams returns the signed blocks window and the minimum number of
+// blocks to sign in it for a validator with the given consensus power, taken
+// from the validator class with the lowest max power the validator does not
+// exceed, or from the module wide params if it matches no class.
+func (p *Params) LivenessParams(power int64) (signedBlocksWindow, minSignedPerWindow int64) {
+	var class *ValidatorClass
+	for i := range p.ValidatorClasses {
+		c := &p.ValidatorClasses[i]
+		if power <= c.MaxPower && (class == nil || c.MaxPower < class.MaxPower) {
+			class = c
+		}
+	}
+
+	if class == nil {
+		return p.SignedBlocksWindow, p.MinSignedPerWindowInt()
+	}
+
+	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
+	//       less than 1.
+	return class.SignedBlocksWindow, class.MinSignedPerWindow.MulInt64(class.SignedBlocksWindow).RoundInt64()
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
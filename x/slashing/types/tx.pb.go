@@ -201,47 +201,160 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUnjailTombstoned is the Msg/UnjailTombstoned request type.
+type MsgUnjailTombstoned struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// cons_address is the consensus address of the tombstoned validator.
+	ConsAddress string `protobuf:"bytes,2,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// reason explains why the tombstone is cleared, it is recorded in the
+	// tombstone history of the validator.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgUnjailTombstoned) Reset()         { *m = MsgUnjailTombstoned{} }
+func (m *MsgUnjailTombstoned) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailTombstoned) ProtoMessage()    {}
+func (*MsgUnjailTombstoned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgUnjailTombstoned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailTombstoned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailTombstoned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailTombstoned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailTombstoned.Merge(m, src)
+}
+func (m *MsgUnjailTombstoned) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailTombstoned) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailTombstoned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailTombstoned proto.InternalMessageInfo
+
+func (m *MsgUnjailTombstoned) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnjailTombstoned) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *MsgUnjailTombstoned) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgUnjailTombstonedResponse defines the response structure for executing a
+// MsgUnjailTombstoned message.
+type MsgUnjailTombstonedResponse struct {
+}
+
+func (m *MsgUnjailTombstonedResponse) Reset()         { *m = MsgUnjailTombstonedResponse{} }
+func (m *MsgUnjailTombstonedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailTombstonedResponse) ProtoMessage()    {}
+func (*MsgUnjailTombstonedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgUnjailTombstonedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailTombstonedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailTombstonedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailTombstonedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailTombstonedResponse.Merge(m, src)
+}
+func (m *MsgUnjailTombstonedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailTombstonedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailTombstonedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailTombstonedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUnjailTombstoned)(nil), "cosmos.slashing.v1beta1.MsgUnjailTombstoned")
+	proto.RegisterType((*MsgUnjailTombstonedResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailTombstonedResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xb7, 0x18, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xf2, 0xbe, 0xb4, 0x45, 0x97, 0x1f, 0xe1, 0x7c, 0x05,
-	0x03, 0x3d, 0x13, 0x73, 0x90, 0x2f, 0x11, 0xd6, 0x80, 0x3c, 0xa8, 0x8a, 0xe4, 0xc1, 0x0a, 0x44,
-	0x2c, 0xa3, 0x79, 0x44, 0x49, 0x8f, 0x4b, 0x1c, 0x4d, 0x08, 0xe6, 0x6f, 0x2b, 0x61, 0x2c, 0xf6,
-	0x18, 0x3d, 0x67, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a, 0xe0, 0x62, 0x83, 0xc6, 0xa5, 0x12,
-	0x4e, 0x2f, 0xc0, 0x83, 0x52, 0x4a, 0x8b, 0xb0, 0x1a, 0x98, 0xb5, 0x42, 0xf5, 0x5c, 0x3c, 0x28,
-	0x41, 0xad, 0x81, 0x57, 0x2f, 0x92, 0x4a, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xa3, 0x56, 0xf8, 0x14,
-	0xa6, 0x17, 0xa5, 0x58, 0x1b, 0x40, 0xc1, 0xed, 0x64, 0xbd, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x41, 0x93, 0x66, 0x71, 0x4a, 0xb6, 0x5e, 0x66,
-	0x3e, 0x72, 0x00, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x53, 0xbd, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x2d, 0x0b, 0x6f, 0x20, 0xb7, 0x03, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x2d, 0x46, 0x32, 0xad, 0xd6, 0x6c, 0x5a, 0x1b, 0x57, 0xdc, 0xc4, 0x05, 0x25,
+	0x04, 0x33, 0x9b, 0xd4, 0xa0, 0x10, 0xf1, 0x60, 0x14, 0x2f, 0x52, 0x90, 0xf8, 0x03, 0xf1, 0x12,
+	0x26, 0xdd, 0x65, 0xbb, 0x36, 0xd9, 0x09, 0x3b, 0xd3, 0xd0, 0x9e, 0x14, 0x4f, 0x22, 0x08, 0xfe,
+	0x09, 0xa2, 0x97, 0x1e, 0x73, 0xc8, 0x1f, 0x51, 0x7a, 0x2a, 0x39, 0x89, 0x87, 0x22, 0xc9, 0x21,
+	0xe0, 0xd5, 0x83, 0x57, 0x99, 0xcc, 0x64, 0x37, 0x3f, 0x36, 0x56, 0xbd, 0x24, 0x99, 0x37, 0x9f,
+	0xf7, 0xe3, 0xfb, 0xde, 0x9b, 0xc0, 0xf4, 0x16, 0xa1, 0x0d, 0x42, 0x0d, 0x5a, 0xc7, 0x74, 0xdb,
+	0x71, 0x6d, 0xa3, 0x55, 0xa8, 0x59, 0x0c, 0x17, 0x0c, 0xb6, 0x87, 0x9a, 0x1e, 0x61, 0x44, 0x59,
+	0x17, 0x04, 0x1a, 0x11, 0x48, 0x12, 0xea, 0xaa, 0x4d, 0x6c, 0x32, 0x64, 0x0c, 0xfe, 0x4b, 0xe0,
+	0xea, 0xf5, 0x79, 0x01, 0x7d, 0x7f, 0xc1, 0x5d, 0x12, 0x5c, 0x55, 0x04, 0x90, 0x39, 0xc4, 0x95,
+	0xcc, 0x68, 0x34, 0x28, 0xf7, 0xe6, 0x5f, 0xf2, 0x22, 0x8e, 0x1b, 0x8e, 0x4b, 0x8c, 0xe1, 0xa7,
+	0x30, 0xe9, 0x5f, 0x00, 0x8c, 0x6d, 0x52, 0xfb, 0x99, 0xfb, 0x0a, 0x3b, 0x75, 0xc5, 0x84, 0xe7,
+	0x5b, 0xb8, 0xee, 0x98, 0x98, 0x11, 0xaf, 0x8a, 0x4d, 0xd3, 0x4b, 0x82, 0x34, 0xc8, 0xc4, 0xca,
+	0x77, 0x7f, 0x9c, 0xa4, 0xce, 0xf2, 0xb3, 0x45, 0x69, 0xb7, 0x93, 0xbb, 0x22, 0xd3, 0x3d, 0x1f,
+	0xb1, 0xf7, 0xc4, 0xd5, 0x13, 0xe6, 0x39, 0xae, 0xfd, 0x79, 0xd0, 0xce, 0x8e, 0xe0, 0x83, 0x41,
+	0x3b, 0x0b, 0x2a, 0xe7, 0x5a, 0xe3, 0x60, 0x29, 0xff, 0xee, 0x53, 0x2a, 0xf2, 0x76, 0xd0, 0xce,
+	0x4e, 0x25, 0x7b, 0x3f, 0x68, 0x67, 0x57, 0x45, 0xe8, 0x1c, 0x35, 0x77, 0x0c, 0xbf, 0x2e, 0x3d,
+	0x01, 0xe3, 0xfe, 0xa1, 0x62, 0xd1, 0x26, 0x71, 0xa9, 0xa5, 0x7f, 0x03, 0x70, 0x85, 0x5b, 0x9b,
+	0x26, 0x66, 0xd6, 0x63, 0xec, 0xe1, 0x06, 0x55, 0x6e, 0xc1, 0x18, 0xde, 0x65, 0xdb, 0xc4, 0x73,
+	0xd8, 0xbe, 0xac, 0x3d, 0xd9, 0xed, 0xe4, 0x64, 0x54, 0x34, 0x51, 0x67, 0x25, 0x40, 0x95, 0x32,
+	0x8c, 0x36, 0x87, 0x11, 0x92, 0x0b, 0x69, 0x90, 0x59, 0xda, 0x48, 0xa1, 0x39, 0x53, 0x43, 0x22,
+	0x51, 0x39, 0x76, 0x78, 0x92, 0x8a, 0x08, 0x75, 0xd2, 0xb3, 0xf4, 0xa8, 0xdb, 0xc9, 0xad, 0x04,
+	0xe5, 0xa7, 0xf3, 0xa8, 0x78, 0x9b, 0xab, 0x0c, 0xd2, 0x70, 0x81, 0xd7, 0xc6, 0x04, 0xee, 0x05,
+	0x53, 0x9e, 0x12, 0xa2, 0x23, 0xb8, 0x3e, 0x65, 0x1a, 0xe9, 0x2e, 0x25, 0x42, 0xf2, 0xe8, 0x3f,
+	0x01, 0x4c, 0xf8, 0x2d, 0x7a, 0x4a, 0x1a, 0x35, 0xca, 0x88, 0x6b, 0x99, 0xff, 0xdd, 0x90, 0x07,
+	0x70, 0x79, 0x8b, 0xb8, 0xb4, 0x2a, 0xe7, 0x38, 0x6c, 0x4b, 0xac, 0x7c, 0x75, 0x6c, 0xf8, 0xf7,
+	0x79, 0x31, 0x2e, 0xdd, 0xa5, 0x93, 0x31, 0x96, 0xb8, 0x9b, 0x34, 0x29, 0x17, 0x61, 0xd4, 0xb3,
+	0x30, 0x25, 0x6e, 0x72, 0x91, 0xfb, 0x57, 0xe4, 0xa9, 0xf4, 0xb0, 0xdb, 0xc9, 0xc5, 0x03, 0xf5,
+	0xe9, 0x56, 0x01, 0xe5, 0x51, 0x7e, 0xb6, 0x59, 0x5a, 0xd8, 0x36, 0x04, 0xea, 0xf4, 0x22, 0xbc,
+	0x1c, 0x62, 0xf6, 0x3b, 0xb5, 0x16, 0x9a, 0x66, 0xe3, 0xd7, 0x02, 0x5c, 0xdc, 0xa4, 0xb6, 0xf2,
+	0x02, 0x46, 0xe5, 0xde, 0xeb, 0x73, 0xc7, 0xed, 0x87, 0x57, 0xb3, 0xa7, 0x33, 0xa3, 0xc4, 0xca,
+	0x6b, 0xb8, 0x3c, 0xb1, 0x96, 0x99, 0x3f, 0xfa, 0x8e, 0x91, 0x6a, 0xfe, 0x6f, 0x49, 0xff, 0x19,
+	0x24, 0x8e, 0x66, 0xd7, 0x41, 0xf9, 0x00, 0xe0, 0x85, 0x99, 0x5d, 0xb8, 0x71, 0xba, 0x82, 0x80,
+	0x56, 0x8b, 0xff, 0x42, 0xfb, 0xd5, 0xac, 0x1d, 0x85, 0xb5, 0x5c, 0x3d, 0xf3, 0x86, 0x3f, 0x95,
+	0xf2, 0x9d, 0x83, 0x9e, 0x06, 0x0e, 0x7b, 0x1a, 0x38, 0xee, 0x69, 0xe0, 0x7b, 0x4f, 0x03, 0x1f,
+	0xfb, 0x5a, 0xe4, 0xb8, 0xaf, 0x45, 0xbe, 0xf6, 0xb5, 0xc8, 0x4b, 0xb9, 0x59, 0xd4, 0xdc, 0x41,
+	0x0e, 0x19, 0x7f, 0x1c, 0x6c, 0xbf, 0x69, 0xd1, 0x5a, 0x74, 0xf8, 0x8f, 0x75, 0xf3, 0xf7, 0x00,
+	0x30, 0x59, 0xc9, 0xf3, 0x73, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -337,6 +450,57 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUnjailTombstoned) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUnjailTombstoned)
+	if !ok {
+		that2, ok := that.(MsgUnjailTombstoned)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *MsgUnjailTombstonedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUnjailTombstonedResponse)
+	if !ok {
+		that2, ok := that.(MsgUnjailTombstonedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -357,6 +521,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UnjailTombstoned defines a governance operation for clearing the tombstone
+	// of a validator, for cases of provable infrastructure bugs. The authority
+	// defaults to the x/gov module account.
+	UnjailTombstoned(ctx context.Context, in *MsgUnjailTombstoned, opts ...grpc.CallOption) (*MsgUnjailTombstonedResponse, error)
 }
 
 type msgClient struct {
@@ -385,6 +553,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UnjailTombstoned(ctx context.Context, in *MsgUnjailTombstoned, opts ...grpc.CallOption) (*MsgUnjailTombstonedResponse, error) {
+	out := new(MsgUnjailTombstonedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/UnjailTombstoned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -394,6 +571,10 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UnjailTombstoned defines a governance operation for clearing the tombstone
+	// of a validator, for cases of provable infrastructure bugs. The authority
+	// defaults to the x/gov module account.
+	UnjailTombstoned(context.Context, *MsgUnjailTombstoned) (*MsgUnjailTombstonedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -406,6 +587,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UnjailTombstoned(ctx context.Context, req *MsgUnjailTombstoned) (*MsgUnjailTombstonedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailTombstoned not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -447,6 +631,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnjailTombstoned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailTombstoned)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailTombstoned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/UnjailTombstoned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailTombstoned(ctx, req.(*MsgUnjailTombstoned))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -459,6 +661,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UnjailTombstoned",
+			Handler:    _Msg_UnjailTombstoned_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -580,6 +786,73 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUnjailTombstoned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailTombstoned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailTombstoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailTombstonedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailTombstonedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailTombstonedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -637,6 +910,36 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnjailTombstoned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailTombstonedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -940,6 +1243,202 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnjailTombstoned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailTombstoned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailTombstoned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailTombstonedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailTombstonedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailTombstonedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
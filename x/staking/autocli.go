@@ -125,12 +125,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "height"},
 					},
 				},
+				{
+					RpcMethod: "HistoricalValidatorSet",
+					Use:       "historical-validator-set",
+					Short:     "Query the bonded validator set at a given height or time",
+					Long:      "Query the bonded validator set at a given height, or at the latest height at or before a given time, among the tracked historical validator sets.",
+					Example:   fmt.Sprintf("$ %s query staking historical-validator-set --height 5\n$ %s query staking historical-validator-set --time 2024-01-01T00:00:00Z", version.AppName, version.AppName),
+				},
+				{
+					RpcMethod: "UpcomingUnbondingDelegations",
+					Use:       "upcoming-unbonding-delegations [end-time]",
+					Short:     "Query the unbonding delegation entries completing before a given time",
+					Long:      "Query the unbonding delegation entries completing between the current block time, or the given start time, and the given end time.",
+					Example:   fmt.Sprintf("$ %s query staking upcoming-unbonding-delegations 2024-01-01T00:00:00Z --start-time 2023-12-01T00:00:00Z", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "end_time"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorsByMoniker",
+					Use:       "validators-by-moniker [moniker-prefix]",
+					Short:     "Query the validators whose moniker starts with a given prefix",
+					Long:      "Query the validators whose moniker starts with a given case-insensitive prefix, ordered by moniker.",
+					Example:   fmt.Sprintf("$ %s query staking validators-by-moniker cosmos", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "moniker_prefix"},
+					},
+				},
+				{
+					RpcMethod: "UnbondingTimeOverrides",
+					Use:       "unbonding-time-overrides",
+					Short:     "Query the unbonding time overrides",
+					Long:      "Query the unbonding times applying to the undelegations of given delegators or account types instead of the unbonding time param.",
+				},
+				{
+					RpcMethod: "DelegatorUnbondingTime",
+					Use:       "unbonding-time [delegator-addr]",
+					Short:     "Query the unbonding time of the undelegations of a delegator",
+					Long:      "Query the unbonding time applying to the undelegations of a delegator, taking the unbonding time overrides into account.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_addr"},
+					},
+				},
 				{
 					RpcMethod: "Pool",
 					Use:       "pool",
 					Short:     "Query the current staking pool values",
 					Long:      "Query values for amounts stored in the staking pool.",
 				},
+				{
+					RpcMethod: "SupplyBreakdown",
+					Use:       "supply-breakdown",
+					Short:     "Query the breakdown of the bond denom supply",
+					Long:      "Query the bond denom supply broken down into bonded, unbonding, community pool, vesting locked and liquid tokens.",
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -154,17 +202,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "BeginRedelegate",
 					Use:            "redelegate [src-validator-addr] [dst-validator-addr] [amount] --from [delegator]",
 					Short:          "Generate multisig signatures for transactions generated offline",
-					Long:           "Redelegate an amount of illiquid staking tokens from one validator to another.",
-					Example:        fmt.Sprintf(`%s tx staking redelegate cosmosvaloper... cosmosvaloper... 100stake --from mykey`, version.AppName),
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_src_address"}, {ProtoField: "validator_dst_address"}, {ProtoField: "amount"}},
+					Long:           "Redelegate an amount of illiquid staking tokens from one validator to another. The amount can be omitted in favor of --shares-percentage to redelegate a fraction of the delegation.",
+					Example:        fmt.Sprintf("%s tx staking redelegate cosmosvaloper... cosmosvaloper... 100stake --from mykey\n%s tx staking redelegate cosmosvaloper... cosmosvaloper... --shares-percentage 0.5 --from mykey", version.AppName, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_src_address"}, {ProtoField: "validator_dst_address"}, {ProtoField: "amount", Optional: true}},
 				},
 				{
 					RpcMethod:      "Undelegate",
 					Use:            "unbond [validator-addr] [amount] --from [delegator_address]",
 					Short:          "Unbond shares from a validator",
-					Long:           "Unbond an amount of bonded shares from a validator.",
-					Example:        fmt.Sprintf(`%s tx staking unbond cosmosvaloper... 100stake --from mykey`, version.AppName),
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+					Long:           "Unbond an amount of bonded shares from a validator. The amount can be omitted in favor of --shares-percentage to unbond a fraction of the delegation.",
+					Example:        fmt.Sprintf("%s tx staking unbond cosmosvaloper... 100stake --from mykey\n%s tx staking unbond cosmosvaloper... --shares-percentage 0.5 --from mykey", version.AppName, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount", Optional: true}},
 				},
 				{
 					RpcMethod:      "CancelUnbondingDelegation",
@@ -189,6 +237,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "SetUnbondingTimeOverride",
+					Use:            "set-unbonding-time-override-proposal [override]",
+					Short:          "Submit a proposal to set the unbonding time of the undelegations of a delegator or account type",
+					Long:           "Submit a proposal to set the unbonding time of the undelegations of a delegator address, or of all the delegators of an account type, instead of the unbonding time param. Exactly one of address or account_type must be set.",
+					Example:        fmt.Sprintf(`%s tx staking set-unbonding-time-override-proposal '{ "account_type": "/cosmos.auth.v1beta1.ModuleAccount", "unbonding_time": "2419200s" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "override"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:   "RemoveUnbondingTimeOverride",
+					Use:         "remove-unbonding-time-override-proposal",
+					Short:       "Submit a proposal to remove the unbonding time override of a delegator or account type",
+					Example:     fmt.Sprintf(`%s tx staking remove-unbonding-time-override-proposal --account-type /cosmos.auth.v1beta1.ModuleAccount`, version.AppName),
+					GovProposal: true,
+				},
+				{
+					RpcMethod:      "SetLiquidStaker",
+					Use:            "set-liquid-staker-proposal [address] [liquid-staker]",
+					Short:          "Submit a proposal to register or unregister an account as a liquid staking provider",
+					Long:           "Submit a proposal to register or unregister an account as a liquid staking provider, whose delegations count towards the liquid staking caps. The account must have no delegation.",
+					Example:        fmt.Sprintf(`%s tx staking set-liquid-staker-proposal cosmos1... true`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "liquid_staker"}},
+					GovProposal:    true,
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -21,6 +21,8 @@ const (
 	FlagWebsite         = "website"
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
+	FlagWebsiteProof    = "website-proof"
+	FlagPGPProof        = "pgp-proof"
 
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
@@ -89,6 +91,8 @@ func flagSetDescriptionEdit() *flag.FlagSet {
 	fs.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
 	fs.String(FlagSecurityContact, types.DoNotModifyDesc, "The validator's (optional) security contact email")
 	fs.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
+	fs.String(FlagWebsiteProof, types.DoNotModifyDesc, "The (optional) https URL on the validator's website serving a proof of ownership of the operator address")
+	fs.String(FlagPGPProof, types.DoNotModifyDesc, "The (optional) ASCII-armored PGP signature of the operator address")
 
 	return fs
 }
@@ -72,6 +72,8 @@ Where validator.json contains:
 	"website": "validator's (optional) website",
 	"security": "validator's (optional) security contact email",
 	"details": "validator's (optional) details",
+	"website-proof": "validator's (optional) https URL serving a proof of ownership, on its website",
+	"pgp-proof": "validator's (optional) ASCII-armored PGP signature of the operator address",
 	"commission-rate": "0.1",
 	"commission-max-rate": "0.2",
 	"commission-max-change-rate": "0.01",
@@ -130,6 +132,8 @@ func NewEditValidatorCmd() *cobra.Command {
 			security, _ := cmd.Flags().GetString(FlagSecurityContact)
 			details, _ := cmd.Flags().GetString(FlagDetails)
 			description := types.NewDescription(moniker, identity, website, security, details)
+			description.WebsiteProof, _ = cmd.Flags().GetString(FlagWebsiteProof)
+			description.PgpProof, _ = cmd.Flags().GetString(FlagPGPProof)
 
 			var newRate *math.LegacyDec
 
@@ -184,6 +188,8 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 		val.Security,
 		val.Details,
 	)
+	description.WebsiteProof = val.WebsiteProof
+	description.PgpProof = val.PGPProof
 
 	valStr, err := valAc.BytesToString(sdk.ValAddress(valAddr))
 	if err != nil {
@@ -24,6 +24,8 @@ type validator struct {
 	Website           string
 	Security          string
 	Details           string
+	WebsiteProof      string
+	PGPProof          string
 	CommissionRates   types.CommissionRates
 	MinSelfDelegation math.Int
 }
@@ -37,6 +39,8 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		Website             string          `json:"website,omitempty"`
 		Security            string          `json:"security,omitempty"`
 		Details             string          `json:"details,omitempty"`
+		WebsiteProof        string          `json:"website-proof,omitempty"`
+		PGPProof            string          `json:"pgp-proof,omitempty"`
 		CommissionRate      string          `json:"commission-rate"`
 		CommissionMaxRate   string          `json:"commission-max-rate"`
 		CommissionMaxChange string          `json:"commission-max-change-rate"`
@@ -95,6 +99,8 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		Website:           v.Website,
 		Security:          v.Security,
 		Details:           v.Details,
+		WebsiteProof:      v.WebsiteProof,
+		PGPProof:          v.PGPProof,
 		CommissionRates:   commissionRates,
 		MinSelfDelegation: minSelfDelegation,
 	}, nil
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"fmt"
+	"time"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -55,9 +56,63 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTruncationDust(data.TruncationDust); err != nil {
+		return err
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("negative total liquid staked tokens in genesis state: %s", data.TotalLiquidStakedTokens)
+	}
+
+	if err := validateGenesisStateUnbondingTimeOverrides(data.UnbondingTimeOverrides, data.Params.UnbondingTime); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateLiquidStakers(data.LiquidStakers); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTruncationDust(truncationDust []types.ValidatorTruncationDust) error {
+	seen := make(map[string]bool, len(truncationDust))
+	for _, dust := range truncationDust {
+		if seen[dust.ValidatorAddress] {
+			return fmt.Errorf("duplicate truncation dust in genesis state for validator %s", dust.ValidatorAddress)
+		}
+
+		if dust.Dust.IsNil() || dust.Dust.IsNegative() {
+			return fmt.Errorf("invalid truncation dust in genesis state for validator %s: %s", dust.ValidatorAddress, dust.Dust)
+		}
+
+		seen[dust.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateUnbondingTimeOverrides(overrides []types.UnbondingTimeOverride, unbondingTime time.Duration) error {
+	seen := make(map[string]bool, len(overrides))
+	for _, override := range overrides {
+		if err := override.Validate(); err != nil {
+			return fmt.Errorf("invalid unbonding time override in genesis state: %w", err)
+		}
+
+		if override.UnbondingTime < unbondingTime {
+			return fmt.Errorf("unbonding time override in genesis state for %s is shorter than the unbonding time param: %s", override.Key(), override.UnbondingTime)
+		}
+
+		if seen[override.Key()] {
+			return fmt.Errorf("duplicate unbonding time override in genesis state for %s", override.Key())
+		}
+
+		seen[override.Key()] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -95,3 +150,16 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateLiquidStakers(liquidStakers []string) error {
+	seen := make(map[string]bool, len(liquidStakers))
+	for _, liquidStaker := range liquidStakers {
+		if seen[liquidStaker] {
+			return fmt.Errorf("duplicate liquid staker in genesis state: %s", liquidStaker)
+		}
+
+		seen[liquidStaker] = true
+	}
+
+	return nil
+}
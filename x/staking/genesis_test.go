@@ -2,6 +2,7 @@ package staking_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -41,6 +42,34 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis truncation dust
+		{"truncation dust", func(data *types.GenesisState) {
+			data.TruncationDust = []types.ValidatorTruncationDust{{ValidatorAddress: "cosmosvaloper1", Dust: math.LegacyNewDecWithPrec(5, 1)}}
+		}, false},
+		{"negative truncation dust", func(data *types.GenesisState) {
+			data.TruncationDust = []types.ValidatorTruncationDust{{ValidatorAddress: "cosmosvaloper1", Dust: math.LegacyNewDec(-1)}}
+		}, true},
+		{"duplicate truncation dust", func(data *types.GenesisState) {
+			dust := types.ValidatorTruncationDust{ValidatorAddress: "cosmosvaloper1", Dust: math.LegacyNewDecWithPrec(5, 1)}
+			data.TruncationDust = []types.ValidatorTruncationDust{dust, dust}
+		}, true},
+		// validate genesis unbonding time overrides
+		{"unbonding time override", func(data *types.GenesisState) {
+			data.UnbondingTimeOverrides = []types.UnbondingTimeOverride{types.NewUnbondingTimeOverride("", "/cosmos.auth.v1beta1.ModuleAccount", data.Params.UnbondingTime+time.Hour)}
+		}, false},
+		{"unbonding time override without target", func(data *types.GenesisState) {
+			data.UnbondingTimeOverrides = []types.UnbondingTimeOverride{types.NewUnbondingTimeOverride("", "", time.Hour)}
+		}, true},
+		{"non positive unbonding time override", func(data *types.GenesisState) {
+			data.UnbondingTimeOverrides = []types.UnbondingTimeOverride{types.NewUnbondingTimeOverride("", "/cosmos.auth.v1beta1.ModuleAccount", 0)}
+		}, true},
+		{"unbonding time override shorter than the param", func(data *types.GenesisState) {
+			data.UnbondingTimeOverrides = []types.UnbondingTimeOverride{types.NewUnbondingTimeOverride("", "/cosmos.auth.v1beta1.ModuleAccount", time.Hour)}
+		}, true},
+		{"duplicate unbonding time override", func(data *types.GenesisState) {
+			override := types.NewUnbondingTimeOverride("", "/cosmos.auth.v1beta1.ModuleAccount", data.Params.UnbondingTime+time.Hour)
+			data.UnbondingTimeOverrides = []types.UnbondingTimeOverride{override, override}
+		}, true},
 	}
 
 	for _, tt := range tests {
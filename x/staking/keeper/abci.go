@@ -13,5 +13,14 @@ import (
 func (k *Keeper) EndBlocker(ctx context.Context) ([]appmodule.ValidatorUpdate, error) {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
-	return k.BlockValidatorUpdates(ctx)
+	updates, err := k.BlockValidatorUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.TrackHistoricalValidatorSet(ctx); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
 }
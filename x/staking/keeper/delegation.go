@@ -162,6 +162,22 @@ func (k Keeper) GetUnbondingDelegation(ctx context.Context, delAddr sdk.AccAddre
 	return ubd, nil
 }
 
+// CanPruneAccount implements the x/auth AccountPruneGuard interface, an account
+// can be pruned if it has neither delegations nor unbonding delegations.
+func (k Keeper) CanPruneAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	delegations, err := k.GetDelegatorDelegations(ctx, addr, 1)
+	if err != nil || len(delegations) > 0 {
+		return false, err
+	}
+
+	ubds, err := k.GetUnbondingDelegations(ctx, addr, 1)
+	if err != nil || len(ubds) > 0 {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // GetUnbondingDelegationsFromValidator returns all unbonding delegations from a
 // particular validator.
 func (k Keeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr sdk.ValAddress) (ubds []types.UnbondingDelegation, err error) {
@@ -782,6 +798,22 @@ func (k Keeper) Delegate(
 		return newShares, err
 	}
 
+	if err := k.trackLiquidDelegation(ctx, delAddr, valbz, bondAmt, newShares); err != nil {
+		return newShares, err
+	}
+
+	// redelegations and canceled unbondings are reported by their own events
+	if subtractAccount {
+		if err := k.EventService.EventManager(ctx).Emit(&types.EventDelegate{
+			DelegatorAddress: delegation.DelegatorAddress,
+			ValidatorAddress: delegation.ValidatorAddress,
+			Tokens:           bondAmt,
+			Shares:           newShares,
+		}); err != nil {
+			return newShares, err
+		}
+	}
+
 	return newShares, nil
 }
 
@@ -864,11 +896,17 @@ func (k Keeper) Unbond(
 
 	// remove the shares and coins from the validator
 	// NOTE that the amount is later (in keeper.Delegation) moved between staking module pools
+	unbondedTokens := validator.TokensFromShares(shares)
 	validator, amount, err = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
 	if err != nil {
 		return amount, err
 	}
 
+	// the fraction of token truncated from the returned amount is left in the validator
+	if err = k.trackTruncationDust(ctx, validator, unbondedTokens.Sub(math.LegacyNewDecFromInt(amount))); err != nil {
+		return amount, err
+	}
+
 	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
 		if err = k.RemoveValidator(ctx, valbz); err != nil {
@@ -876,21 +914,25 @@ func (k Keeper) Unbond(
 		}
 	}
 
+	if err = k.trackLiquidUnbonding(ctx, delAddr, valbz, amount, shares); err != nil {
+		return amount, err
+	}
+
 	return amount, nil
 }
 
 // getBeginInfo returns the completion time and height of a redelegation, along
 // with a boolean signaling if the redelegation is complete based on the source
-// validator.
+// validator. The unbonding time override of the delegator, if any, applies.
 func (k Keeper) getBeginInfo(
-	ctx context.Context, valSrcAddr sdk.ValAddress,
+	ctx context.Context, delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress,
 ) (completionTime time.Time, height int64, completeNow bool, err error) {
 	validator, err := k.GetValidator(ctx, valSrcAddr)
 	if err != nil && errors.Is(err, types.ErrNoValidatorFound) {
 		return completionTime, height, false, nil
 	}
 	headerInfo := k.HeaderService.HeaderInfo(ctx)
-	unbondingTime, err := k.UnbondingTime(ctx)
+	unbondingTime, err := k.GetDelegatorUnbondingTime(ctx, delAddr)
 	if err != nil {
 		return completionTime, height, false, err
 	}
@@ -942,6 +984,10 @@ func (k Keeper) Undelegate(
 		return time.Time{}, math.Int{}, err
 	}
 
+	if err := k.checkSelfBondDecrease(ctx, delAddr, valAddr); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
 	// transfer the validator tokens to the not bonded pool
 	if validator.IsBonded() {
 		err = k.bondedTokensToNotBonded(ctx, returnAmount)
@@ -950,7 +996,7 @@ func (k Keeper) Undelegate(
 		}
 	}
 
-	unbondingTime, err := k.UnbondingTime(ctx)
+	unbondingTime, err := k.GetDelegatorUnbondingTime(ctx, delAddr)
 	if err != nil {
 		return time.Time{}, math.Int{}, err
 	}
@@ -967,6 +1013,17 @@ func (k Keeper) Undelegate(
 		return time.Time{}, math.Int{}, err
 	}
 
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventUnbond{
+		DelegatorAddress: ubd.DelegatorAddress,
+		ValidatorAddress: ubd.ValidatorAddress,
+		Tokens:           returnAmount,
+		Shares:           sharesAmount,
+		CreationHeight:   headerInfo.Height,
+		CompletionTime:   completionTime,
+	}); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
 	return completionTime, returnAmount, nil
 }
 
@@ -1014,6 +1071,17 @@ func (k Keeper) CompleteUnbonding(ctx context.Context, delAddr sdk.AccAddress, v
 
 				balances = balances.Add(amt)
 			}
+
+			if err := k.EventService.EventManager(ctx).Emit(&types.EventUnbondingCompleted{
+				DelegatorAddress: ubd.DelegatorAddress,
+				ValidatorAddress: ubd.ValidatorAddress,
+				CreationHeight:   entry.CreationHeight,
+				CompletionTime:   entry.CompletionTime,
+				InitialBalance:   entry.InitialBalance,
+				Balance:          entry.Balance,
+			}); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -1078,6 +1146,10 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, err
 	}
 
+	if err := k.checkSelfBondDecrease(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, err
+	}
+
 	if returnAmount.IsZero() {
 		return time.Time{}, types.ErrTinyRedelegationAmount
 	}
@@ -1088,25 +1160,41 @@ func (k Keeper) BeginRedelegation(
 	}
 
 	// create the unbonding delegation
-	completionTime, height, completeNow, err := k.getBeginInfo(ctx, valSrcAddr)
+	completionTime, height, completeNow, err := k.getBeginInfo(ctx, delAddr, valSrcAddr)
 	if err != nil {
 		return time.Time{}, err
 	}
 
-	if completeNow { // no need to create the redelegation object
-		return completionTime, nil
+	if !completeNow { // otherwise there is no need to create the redelegation object
+		red, err := k.SetRedelegationEntry(
+			ctx, delAddr, valSrcAddr, valDstAddr,
+			height, completionTime, returnAmount, sharesAmount, sharesCreated,
+		)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		err = k.InsertRedelegationQueue(ctx, red, completionTime)
+		if err != nil {
+			return time.Time{}, err
+		}
 	}
 
-	red, err := k.SetRedelegationEntry(
-		ctx, delAddr, valSrcAddr, valDstAddr,
-		height, completionTime, returnAmount, sharesAmount, sharesCreated,
-	)
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
 	if err != nil {
 		return time.Time{}, err
 	}
 
-	err = k.InsertRedelegationQueue(ctx, red, completionTime)
-	if err != nil {
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventRedelegate{
+		DelegatorAddress:    delAddrStr,
+		ValidatorSrcAddress: srcValidator.GetOperator(),
+		ValidatorDstAddress: dstValidator.GetOperator(),
+		Tokens:              returnAmount,
+		Shares:              sharesAmount,
+		SharesDst:           sharesCreated,
+		CreationHeight:      height,
+		CompletionTime:      completionTime,
+	}); err != nil {
 		return time.Time{}, err
 	}
 
@@ -1146,6 +1234,18 @@ func (k Keeper) CompleteRedelegation(
 			if !entry.InitialBalance.IsZero() {
 				balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
 			}
+
+			if err := k.EventService.EventManager(ctx).Emit(&types.EventRedelegationCompleted{
+				DelegatorAddress:    red.DelegatorAddress,
+				ValidatorSrcAddress: red.ValidatorSrcAddress,
+				ValidatorDstAddress: red.ValidatorDstAddress,
+				CreationHeight:      entry.CreationHeight,
+				CompletionTime:      entry.CompletionTime,
+				InitialBalance:      entry.InitialBalance,
+				SharesDst:           entry.SharesDst,
+			}); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -1208,3 +1308,34 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// ValidateUnbondPercentage validates that the given percentage of the delegation
+// shares can be unbonded and returns the corresponding amount of shares.
+func (k Keeper) ValidateUnbondPercentage(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, pct math.LegacyDec,
+) (shares math.LegacyDec, err error) {
+	if pct.IsNil() || !pct.IsPositive() || pct.GT(math.LegacyOneDec()) {
+		return shares, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "shares percentage must be in (0, 1]")
+	}
+
+	if _, err := k.GetValidator(ctx, valAddr); err != nil {
+		return shares, err
+	}
+
+	del, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if err != nil {
+		return shares, err
+	}
+
+	delShares := del.GetShares()
+	if pct.Equal(math.LegacyOneDec()) {
+		return delShares, nil
+	}
+
+	shares = delShares.MulTruncate(pct)
+	if !shares.IsPositive() {
+		return shares, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	return shares, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	"github.com/golang/mock/gomock"
 
 	"cosmossdk.io/collections"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func createValAddrs(count int) ([]sdk.AccAddress, []sdk.ValAddress) {
@@ -1256,3 +1258,143 @@ func (s *KeeperTestSuite) TestUndelegateWithDustShare() {
 	require.Equal(1, len(delegations))
 	require.Equal(delegations[0].DelegatorAddress, s.addressToString(addrDels[1]))
 }
+
+func (s *KeeperTestSuite) TestUnbondTruncationDust() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(3)
+
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	// 20 tokens for 6 shares, so that unbonding leaves fractions of tokens in the validator
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator.Tokens = math.NewInt(20)
+	validator.DelegatorShares = math.LegacyNewDec(6)
+
+	// the validator has not enough tokens to be bonded
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	for i, shares := range []int64{1, 2, 3} {
+		delegation := stakingtypes.NewDelegation(s.addressToString(delAddrs[i]), s.valAddressToString(valAddrs[0]), math.LegacyNewDec(shares))
+		require.NoError(keeper.SetDelegation(ctx, delegation))
+	}
+
+	// 1 share is worth 3.33 tokens, the dust is attributed to the validator
+	amount, err := keeper.Unbond(ctx, delAddrs[0], valAddrs[0], math.LegacyNewDec(1))
+	require.NoError(err)
+	require.Equal(math.NewInt(3), amount)
+
+	dust, err := keeper.GetValidatorTruncationDust(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(math.LegacyMustNewDecFromStr("0.333333333333333333"), dust)
+
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(math.NewInt(17), validator.Tokens)
+
+	// route the dust to the community pool, 2 shares are worth 6.8 tokens
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.TruncationDustToCommunityPool = true
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// routing the dust is not a slash, only the dedicated hook is called
+	hooks := testutil.NewMockStakingHooks(gomock.NewController(s.T()))
+	hooks.EXPECT().BeforeDelegationSharesModified(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	hooks.EXPECT().BeforeDelegationRemoved(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	hooks.EXPECT().AfterDelegationModified(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	hooks.EXPECT().AfterValidatorRemoved(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	hooks.EXPECT().BeforeValidatorSlashed(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	hooks.EXPECT().BeforeTruncationDustRouted(gomock.Any(), valAddrs[0], math.LegacyOneDec().QuoRoundUp(math.LegacyNewDec(11))).Times(1)
+	keeper.SetHooks(hooks)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.PoolModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))).Times(1)
+	amount, err = keeper.Unbond(ctx, delAddrs[1], valAddrs[0], math.LegacyNewDec(2))
+	require.NoError(err)
+	require.Equal(math.NewInt(6), amount)
+
+	dust, err = keeper.GetValidatorTruncationDust(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(math.LegacyMustNewDecFromStr("0.133333333333333333"), dust)
+
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(math.NewInt(10), validator.Tokens)
+
+	// the last delegator receives all the tokens left, including the dust
+	amount, err = keeper.Unbond(ctx, delAddrs[2], valAddrs[0], math.LegacyNewDec(3))
+	require.NoError(err)
+	require.Equal(math.NewInt(10), amount)
+
+	_, err = keeper.ValidatorTruncationDust.Get(ctx, valAddrs[0])
+	require.ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestUpcomingUnbondingDelegations() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	valAc, delAc := address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos")
+	blockTime := ctx.HeaderInfo().Time
+	firstTime, secondTime := blockTime.Add(time.Hour), blockTime.Add(2*time.Hour)
+
+	ubd0 := stakingtypes.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 10, firstTime, math.NewInt(5), 1, valAc, delAc)
+	ubd0.AddEntry(11, secondTime, math.NewInt(7), 2)
+	ubd1 := stakingtypes.NewUnbondingDelegation(delAddrs[1], valAddrs[0], 10, firstTime, math.NewInt(3), 3, valAc, delAc)
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd0))
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd1))
+	require.NoError(keeper.InsertUBDQueue(ctx, ubd0, firstTime))
+	require.NoError(keeper.InsertUBDQueue(ctx, ubd0, secondTime))
+	require.NoError(keeper.InsertUBDQueue(ctx, ubd1, firstTime))
+
+	res, err := queryClient.UpcomingUnbondingDelegations(ctx, &stakingtypes.QueryUpcomingUnbondingDelegationsRequest{EndTime: firstTime})
+	require.NoError(err)
+	require.Len(res.UnbondingResponses, 2)
+	require.Equal([]stakingtypes.UnbondingDelegationEntry{ubd0.Entries[0]}, res.UnbondingResponses[0].Entries)
+	require.Equal(ubd1.Entries, res.UnbondingResponses[1].Entries)
+
+	// the pagination applies to the completion times
+	res, err = queryClient.UpcomingUnbondingDelegations(ctx, &stakingtypes.QueryUpcomingUnbondingDelegationsRequest{
+		EndTime:    secondTime,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(err)
+	require.Len(res.UnbondingResponses, 2)
+	require.NotNil(res.Pagination.NextKey)
+
+	startTime := firstTime.Add(time.Minute)
+	res, err = queryClient.UpcomingUnbondingDelegations(ctx, &stakingtypes.QueryUpcomingUnbondingDelegationsRequest{StartTime: &startTime, EndTime: secondTime})
+	require.NoError(err)
+	require.Len(res.UnbondingResponses, 1)
+	require.Equal([]stakingtypes.UnbondingDelegationEntry{ubd0.Entries[1]}, res.UnbondingResponses[0].Entries)
+
+	_, err = queryClient.UpcomingUnbondingDelegations(ctx, &stakingtypes.QueryUpcomingUnbondingDelegationsRequest{EndTime: blockTime.Add(-time.Hour)})
+	require.ErrorContains(err, "end time cannot be before start time")
+
+	// completing the unbonding emits an event with the original entry
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Time: firstTime}).WithEventManager(sdk.NewEventManager())
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddrs[0], gomock.Any()).Return(nil)
+	_, err = keeper.CompleteUnbonding(ctx, delAddrs[0], valAddrs[0])
+	require.NoError(err)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "cosmos.staking.v1beta1.EventUnbondingCompleted" {
+			continue
+		}
+		found = true
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(err)
+		require.Equal(&stakingtypes.EventUnbondingCompleted{
+			DelegatorAddress: ubd0.DelegatorAddress,
+			ValidatorAddress: ubd0.ValidatorAddress,
+			CreationHeight:   10,
+			CompletionTime:   firstTime,
+			InitialBalance:   math.NewInt(5),
+			Balance:          math.NewInt(5),
+		}, msg)
+	}
+	require.True(found)
+}
@@ -41,6 +41,12 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		return nil, err
 	}
 
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		if err := k.TotalLiquidStakedTokens.Set(ctx, data.TotalLiquidStakedTokens); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, validator := range data.Validators {
 		if err := k.SetValidator(ctx, validator); err != nil {
 			return nil, err
@@ -51,6 +57,10 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 			return nil, err
 		}
 
+		if err := k.SetValidatorByMoniker(ctx, validator); err != nil {
+			return nil, err
+		}
+
 		if err := k.SetValidatorByPowerIndex(ctx, validator); err != nil {
 			return nil, err
 		}
@@ -195,6 +205,34 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, dust := range data.TruncationDust {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(dust.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.ValidatorTruncationDust.Set(ctx, valAddr, dust.Dust); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, override := range data.UnbondingTimeOverrides {
+		if err := k.SetUnbondingTimeOverride(ctx, override); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, liquidStaker := range data.LiquidStakers {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(liquidStaker)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.LiquidStakers.Set(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -286,6 +324,11 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	allDelegations, err := k.GetAllDelegations(ctx)
 	if err != nil {
 		return nil, err
@@ -331,17 +374,61 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	truncationDust := []types.ValidatorTruncationDust{}
+	err = k.ValidatorTruncationDust.Walk(ctx, nil, func(valAddr []byte, dust math.LegacyDec) (stop bool, err error) {
+		addr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		truncationDust = append(truncationDust, types.ValidatorTruncationDust{
+			ValidatorAddress: addr,
+			Dust:             dust,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingTimeOverrides := []types.UnbondingTimeOverride{}
+	err = k.UnbondingTimeOverrides.Walk(ctx, nil, func(_ string, override types.UnbondingTimeOverride) (stop bool, err error) {
+		unbondingTimeOverrides = append(unbondingTimeOverrides, override)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	liquidStakers := []string{}
+	err = k.LiquidStakers.Walk(ctx, nil, func(addr sdk.AccAddress) (stop bool, err error) {
+		liquidStaker, err := k.authKeeper.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+
+		liquidStakers = append(liquidStakers, liquidStaker)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
-		RotationIndexRecords: rotationIndex,
-		RotationHistory:      conspubKeyRotationHistory,
-		RotationQueue:        rotationQueue,
+		Params:                  params,
+		LastTotalPower:          totalPower,
+		LastValidatorPowers:     lastValidatorPowers,
+		Validators:              allValidators,
+		Delegations:             allDelegations,
+		UnbondingDelegations:    unbondingDelegations,
+		Redelegations:           redelegations,
+		Exported:                true,
+		RotationIndexRecords:    rotationIndex,
+		RotationHistory:         conspubKeyRotationHistory,
+		RotationQueue:           rotationQueue,
+		TruncationDust:          truncationDust,
+		TotalLiquidStakedTokens: totalLiquidStakedTokens,
+		UnbondingTimeOverrides:  unbondingTimeOverrides,
+		LiquidStakers:           liquidStakers,
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -497,6 +498,16 @@ func (k Querier) Pool(ctx context.Context, _ *types.QueryPoolRequest) (*types.Qu
 	return &types.QueryPoolResponse{Pool: pool}, nil
 }
 
+// SupplyBreakdown queries the breakdown of the bond denom supply
+func (k Querier) SupplyBreakdown(ctx context.Context, _ *types.QuerySupplyBreakdownRequest) (*types.QuerySupplyBreakdownResponse, error) {
+	breakdown, err := k.Keeper.SupplyBreakdown(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &breakdown, nil
+}
+
 // Params queries the staking parameters
 func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	params, err := k.Keeper.Params.Get(ctx)
@@ -657,3 +668,206 @@ func redelegationsToRedelegationResponses(ctx context.Context, k *Keeper, redels
 
 	return resp, nil
 }
+
+// HistoricalValidatorSet queries the bonded validator set at a given height or time
+func (k Querier) HistoricalValidatorSet(ctx context.Context, req *types.QueryHistoricalValidatorSetRequest) (*types.QueryHistoricalValidatorSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	height := req.Height
+	switch {
+	case height < 0:
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	case height == 0 && req.Time == nil:
+		return nil, status.Error(codes.InvalidArgument, "height or time cannot be empty")
+	case height == 0:
+		var err error
+		height, err = k.GetHistoricalHeightAt(ctx, *req.Time)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "historical validator set not found at time %s", req.Time)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	blockTime, err := k.HistoricalTime.Get(ctx, height)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "historical validator set not found for height %d", height)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	validators, pageRes, err := query.CollectionPaginate(ctx, k.HistoricalValidators, req.Pagination,
+		func(_ collections.Pair[int64, []byte], validator types.Validator) (types.Validator, error) {
+			return validator, nil
+		}, query.WithCollectionPaginationPairPrefix[int64, []byte](height),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalValidatorSetResponse{
+		Height:     height,
+		Time:       blockTime,
+		Validators: validators,
+		Pagination: pageRes,
+	}, nil
+}
+
+// UpcomingUnbondingDelegations queries the unbonding delegation entries completing in a time window
+func (k Querier) UpcomingUnbondingDelegations(ctx context.Context, req *types.QueryUpcomingUnbondingDelegationsRequest) (*types.QueryUpcomingUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	startTime := k.HeaderService.HeaderInfo(ctx).Time
+	if req.StartTime != nil {
+		startTime = *req.StartTime
+	}
+	if req.EndTime.Before(startTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time cannot be before start time")
+	}
+
+	timeSlices, pageRes, err := query.CollectionFilteredPaginate(ctx, k.UnbondingQueue, req.Pagination,
+		func(completionTime time.Time, _ types.DVPairs) (bool, error) {
+			return !completionTime.Before(startTime) && !completionTime.After(req.EndTime), nil
+		},
+		func(completionTime time.Time, dvPairs types.DVPairs) ([]types.UnbondingDelegation, error) {
+			return unbondingDelegationsCompletingAt(ctx, k.Keeper, completionTime, dvPairs.Pairs)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var unbondingResponses []types.UnbondingDelegation
+	for _, ubds := range timeSlices {
+		unbondingResponses = append(unbondingResponses, ubds...)
+	}
+
+	return &types.QueryUpcomingUnbondingDelegationsResponse{
+		UnbondingResponses: unbondingResponses,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// unbondingDelegationsCompletingAt returns the unbonding delegations of an
+// unbonding queue time slice, each one only containing its entries completing
+// at the time of the slice.
+func unbondingDelegationsCompletingAt(ctx context.Context, k *Keeper, completionTime time.Time, dvPairs []types.DVPair) ([]types.UnbondingDelegation, error) {
+	var ubds []types.UnbondingDelegation
+	seen := make(map[types.DVPair]bool, len(dvPairs))
+	for _, dvPair := range dvPairs {
+		// a pair is queued once per entry
+		if seen[dvPair] {
+			continue
+		}
+		seen[dvPair] = true
+
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(dvPair.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		valAddr, err := k.validatorAddressCodec.StringToBytes(dvPair.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		ubd, err := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+		if errors.Is(err, types.ErrNoUnbondingDelegation) {
+			// the unbonding delegation has been canceled
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		entries := make([]types.UnbondingDelegationEntry, 0, len(ubd.Entries))
+		for _, entry := range ubd.Entries {
+			if entry.CompletionTime.Equal(completionTime) {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		ubd.Entries = entries
+		ubds = append(ubds, ubd)
+	}
+
+	return ubds, nil
+}
+
+// ValidatorsByMoniker queries the validators whose moniker starts with a given prefix
+func (k Querier) ValidatorsByMoniker(ctx context.Context, req *types.QueryValidatorsByMonikerRequest) (*types.QueryValidatorsByMonikerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.MonikerPrefix) > types.MaxMonikerLength {
+		return nil, status.Errorf(codes.InvalidArgument, "moniker prefix cannot be longer than %d", types.MaxMonikerLength)
+	}
+
+	store := runtime.KVStoreAdapter(k.KVStoreService.OpenKVStore(ctx))
+	monikerStore := prefix.NewStore(store, types.GetValidatorsByMonikerPrefix(req.MonikerPrefix))
+
+	var validators []types.Validator
+	pageRes, err := query.Paginate(monikerStore, req.Pagination, func(_, value []byte) error {
+		validator, err := k.GetValidator(ctx, value)
+		if err != nil {
+			return err
+		}
+
+		validators = append(validators, validator)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorsByMonikerResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
+// UnbondingTimeOverrides queries the unbonding time overrides
+func (k Querier) UnbondingTimeOverrides(ctx context.Context, req *types.QueryUnbondingTimeOverridesRequest) (*types.QueryUnbondingTimeOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	overrides, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.UnbondingTimeOverrides, req.Pagination,
+		func(_ string, override types.UnbondingTimeOverride) (types.UnbondingTimeOverride, error) {
+			return override, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingTimeOverridesResponse{Overrides: overrides, Pagination: pageRes}, nil
+}
+
+// DelegatorUnbondingTime queries the unbonding time applying to the undelegations of a delegator
+func (k Querier) DelegatorUnbondingTime(ctx context.Context, req *types.QueryDelegatorUnbondingTimeRequest) (*types.QueryDelegatorUnbondingTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	unbondingTime, err := k.GetDelegatorUnbondingTime(ctx, delAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorUnbondingTimeResponse{UnbondingTime: unbondingTime}, nil
+}
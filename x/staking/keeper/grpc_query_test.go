@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (s *KeeperTestSuite) TestGRPCQueryValidator() {
@@ -122,3 +123,43 @@ func (s *KeeperTestSuite) TestGRPCQueryValidators() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorsByMoniker() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	monikers := []string{"Bob", "alpha", "Alice"}
+	validators := make([]types.Validator, len(monikers))
+	for i, moniker := range monikers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i].Description.Moniker = moniker
+		require.NoError(keeper.SetValidator(ctx, validators[i]))
+		require.NoError(keeper.SetValidatorByMoniker(ctx, validators[i]))
+	}
+
+	res, err := queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{MonikerPrefix: "AL"})
+	require.NoError(err)
+	require.Len(res.Validators, 2)
+	require.Equal("Alice", res.Validators[0].GetMoniker())
+	require.Equal("alpha", res.Validators[1].GetMoniker())
+
+	res, err = queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Validators, 2)
+	require.Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{MonikerPrefix: "alic"})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+
+	// removing the validator removes it from the index
+	valAddr, err := keeper.ValidatorAddressCodec().StringToBytes(validators[2].GetOperator())
+	require.NoError(err)
+	require.NoError(keeper.RemoveValidator(ctx, valAddr))
+
+	res, err = queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{MonikerPrefix: "alic"})
+	require.NoError(err)
+	require.Empty(res.Validators)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+)
+
+// TrackHistoricalValidatorSet saves the bonded validator set at the end of the
+// current block, and prunes the validator sets older than the last
+// HistoricalEntries heights.
+func (k Keeper) TrackHistoricalValidatorSet(ctx context.Context) error {
+	entries, err := k.HistoricalEntries(ctx)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+
+	// Prune the validator sets until one is not found, so that all the extra
+	// validator sets are pruned if HistoricalEntries has been decreased. The
+	// current height has no validator set yet, so the pruning starts from the
+	// previous one if the tracking is disabled.
+	pruneHeight := headerInfo.Height - int64(entries)
+	if entries == 0 {
+		pruneHeight--
+	}
+	for height := pruneHeight; height >= 0; height-- {
+		found, err := k.pruneHistoricalValidatorSet(ctx, height)
+		if err != nil {
+			return err
+		}
+		if !found {
+			break
+		}
+	}
+
+	if entries == 0 {
+		return nil
+	}
+
+	validators, err := k.GetLastValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.HistoricalTime.Set(ctx, headerInfo.Height, headerInfo.Time); err != nil {
+		return err
+	}
+	if err := k.HistoricalHeightByTime.Set(ctx, headerInfo.Time, headerInfo.Height); err != nil {
+		return err
+	}
+	for _, validator := range validators {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+		if err := k.HistoricalValidators.Set(ctx, collections.Join(headerInfo.Height, valAddr), validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneHistoricalValidatorSet deletes the validator set saved at the height,
+// and returns whether it was found.
+func (k Keeper) pruneHistoricalValidatorSet(ctx context.Context, height int64) (bool, error) {
+	blockTime, err := k.HistoricalTime.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if err := k.HistoricalTime.Remove(ctx, height); err != nil {
+		return false, err
+	}
+	if err := k.HistoricalHeightByTime.Remove(ctx, blockTime); err != nil {
+		return false, err
+	}
+
+	return true, k.HistoricalValidators.Clear(ctx, collections.NewPrefixedPairRange[int64, []byte](height))
+}
+
+// GetHistoricalHeightAt returns the latest height at or before the time whose
+// validator set is saved, or collections.ErrNotFound if there is none.
+func (k Keeper) GetHistoricalHeightAt(ctx context.Context, t time.Time) (int64, error) {
+	rng := new(collections.Range[time.Time]).EndInclusive(t).Descending()
+	iter, err := k.HistoricalHeightByTime.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, collections.ErrNotFound
+	}
+
+	return iter.Value()
+}
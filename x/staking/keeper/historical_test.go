@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *KeeperTestSuite) TestHistoricalValidatorSet() {
+	keeper, queryClient := s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	params, err := keeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.HistoricalEntries = 2
+	require.NoError(keeper.Params.Set(s.ctx, params))
+
+	validators := make([]stakingtypes.Validator, 2)
+	for i := range validators {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validators[i] = testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validators[i].Status = stakingtypes.Bonded
+		require.NoError(keeper.SetValidator(s.ctx, validators[i]))
+		require.NoError(keeper.SetLastValidatorPower(s.ctx, valAddr, 1))
+	}
+
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTime := func(height int64) time.Time {
+		return genesisTime.Add(time.Duration(height) * 5 * time.Second)
+	}
+	for height := int64(1); height <= 3; height++ {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: height, Time: blockTime(height)})
+		require.NoError(keeper.TrackHistoricalValidatorSet(ctx))
+	}
+
+	// only the validator sets of the last 2 heights are kept
+	_, err = queryClient.HistoricalValidatorSet(s.ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{Height: 1})
+	require.ErrorContains(err, "historical validator set not found for height 1")
+	res, err := queryClient.HistoricalValidatorSet(s.ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{Height: 2})
+	require.NoError(err)
+	require.Equal(int64(2), res.Height)
+	require.Equal(blockTime(2), res.Time)
+	require.Len(res.Validators, 2)
+
+	// the validator sets can be looked up by time and paginated
+	lookupTime := blockTime(3).Add(time.Second)
+	res, err = queryClient.HistoricalValidatorSet(s.ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{
+		Time:       &lookupTime,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(int64(3), res.Height)
+	require.Len(res.Validators, 1)
+	require.Equal(uint64(2), res.Pagination.Total)
+
+	lookupTime = blockTime(1)
+	_, err = queryClient.HistoricalValidatorSet(s.ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{Time: &lookupTime})
+	require.ErrorContains(err, "historical validator set not found at time")
+
+	_, err = queryClient.HistoricalValidatorSet(s.ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{})
+	require.ErrorContains(err, "height or time cannot be empty")
+
+	// all the validator sets are pruned once the tracking is disabled
+	params.HistoricalEntries = 0
+	require.NoError(keeper.Params.Set(s.ctx, params))
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 4, Time: blockTime(4)})
+	require.NoError(keeper.TrackHistoricalValidatorSet(ctx))
+	for height := int64(2); height <= 4; height++ {
+		has, err := keeper.HistoricalTime.Has(ctx, height)
+		require.NoError(err)
+		require.False(has)
+	}
+}
@@ -21,6 +21,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "truncation-dust",
+		TruncationDustInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -41,7 +43,12 @@ func AllInvariants(k *Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TruncationDustInvariant(k)(ctx)
 	}
 }
 
@@ -224,3 +231,40 @@ func DelegatorSharesInvariant(k *Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// TruncationDustInvariant checks that the truncation dust is only tracked for
+// validators having delegator shares, and is never negative.
+func TruncationDustInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		err := k.ValidatorTruncationDust.Walk(ctx, nil, func(valAddr []byte, dust math.LegacyDec) (stop bool, err error) {
+			if dust.IsNegative() {
+				broken = true
+				msg += fmt.Sprintf("\tnegative truncation dust %v for validator %X\n", dust, valAddr)
+			}
+
+			validator, err := k.GetValidator(ctx, valAddr)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\ttruncation dust tracked for unknown validator %X\n", valAddr)
+				return false, nil
+			}
+
+			if !validator.DelegatorShares.IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\ttruncation dust tracked for validator %s without delegator shares\n", validator.GetOperator())
+			}
+
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "truncation dust", msg), broken
+	}
+}
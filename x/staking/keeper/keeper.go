@@ -62,6 +62,7 @@ type Keeper struct {
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
 	cometInfoService      comet.Service
+	supplyBreakdownCache  *supplyBreakdownCache
 
 	Schema collections.Schema
 
@@ -108,6 +109,20 @@ type Keeper struct {
 	ConsAddrToValidatorIdentifierMap collections.Map[[]byte, []byte]
 	// OldToNewConsAddrMap: maps the old cons addr to the new cons addr
 	OldToNewConsAddrMap collections.Map[[]byte, []byte]
+	// ValidatorTruncationDust key: valAddr | value: dust (the fractions of tokens left in the validator when unbonding)
+	ValidatorTruncationDust collections.Map[[]byte, math.LegacyDec]
+	// TotalLiquidStakedTokens value: the tokens delegated by liquid staking providers
+	TotalLiquidStakedTokens collections.Item[math.Int]
+	// LiquidStakers key: the address of the accounts registered as liquid staking providers
+	LiquidStakers collections.KeySet[sdk.AccAddress]
+	// HistoricalTime key: height | value: block time of the historical validator set
+	HistoricalTime collections.Map[int64, time.Time]
+	// HistoricalHeightByTime key: block time | value: height of the historical validator set
+	HistoricalHeightByTime collections.Map[time.Time, int64]
+	// HistoricalValidators key: height+valAddr | value: validator bonded at the height
+	HistoricalValidators collections.Map[collections.Pair[int64, []byte], types.Validator]
+	// UnbondingTimeOverrides key: delegator address or account type URL | value: unbonding time override
+	UnbondingTimeOverrides collections.Map[string, types.UnbondingTimeOverride]
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
@@ -153,6 +168,7 @@ func NewKeeper(
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
 		cometInfoService:      cometInfoService,
+		supplyBreakdownCache:  &supplyBreakdownCache{},
 		LastTotalPower:        collections.NewItem(sb, types.LastTotalPowerKey, "last_total_power", sdk.IntValue),
 		Delegations: collections.NewMap(
 			sb, types.DelegationKey, "delegations",
@@ -275,6 +291,46 @@ func NewKeeper(
 			collections.BytesKey,
 			collections.BytesValue,
 		),
+		ValidatorTruncationDust: collections.NewMap(
+			sb, types.ValidatorTruncationDustKey,
+			"validator_truncation_dust",
+			collections.BytesKey,
+			sdk.LegacyDecValue,
+		),
+		TotalLiquidStakedTokens: collections.NewItem(
+			sb, types.TotalLiquidStakedTokensKey,
+			"total_liquid_staked_tokens",
+			sdk.IntValue,
+		),
+		LiquidStakers: collections.NewKeySet(
+			sb, types.LiquidStakersKey,
+			"liquid_stakers",
+			sdk.AccAddressKey,
+		),
+		HistoricalTime: collections.NewMap(
+			sb, types.HistoricalTimeKey,
+			"historical_time",
+			collections.Int64Key,
+			collcodec.KeyToValueCodec(sdk.TimeKey),
+		),
+		HistoricalHeightByTime: collections.NewMap(
+			sb, types.HistoricalHeightByTimeKey,
+			"historical_height_by_time",
+			sdk.TimeKey,
+			collections.Int64Value,
+		),
+		HistoricalValidators: collections.NewMap(
+			sb, types.HistoricalValidatorsKey,
+			"historical_validators",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+			codec.CollValue[types.Validator](cdc),
+		),
+		UnbondingTimeOverrides: collections.NewMap(
+			sb, types.UnbondingTimeOverridesKey,
+			"unbonding_time_overrides",
+			collections.StringKey,
+			codec.CollValue[types.UnbondingTimeOverride](cdc),
+		),
 
 		// key format is : 101 | rotation history
 		// index is : 102 | rotation history
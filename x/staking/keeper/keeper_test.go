@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -78,6 +79,9 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(bondedAcc.GetAddress())
 	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress())
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+		return authtypes.NewBaseAccountWithAddress(addr)
+	}).AnyTimes()
 
 	// create consensus keeper
 	ck := stakingtestutil.NewMockConsensusKeeper(ctrl)
@@ -85,7 +89,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		Params: simtestutil.DefaultConsensusParams,
 	}, nil).AnyTimes()
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
-	consensustypes.RegisterQueryServer(queryHelper, ck)
+	consensustypes.RegisterQueryServer(queryHelper, &consensusQueryServer{keeper: ck})
 
 	bankKeeper := stakingtestutil.NewMockBankKeeper(ctrl)
 	env := runtime.NewEnvironment(storeService, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(queryHelper.GRPCQueryRouter), runtime.EnvWithMsgRouterService(s.baseApp.MsgServiceRouter()))
@@ -287,7 +291,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"ab5c07b83934c465ed756ff296a033323f7b19ab0ec5c3d99e6e1b9b4762d631",
 	)
 	s.Require().NoError(err)
 
@@ -302,7 +306,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"d9690cb1904ab91c618a3f6d27ef90bfe6fb57a2c01970b7c088ec4ecd0613eb",
+		"ab5c07b83934c465ed756ff296a033323f7b19ab0ec5c3d99e6e1b9b4762d631",
 	)
 	s.Require().NoError(err)
 }
@@ -320,7 +324,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"db9a687d7222c2ef67702f50ef7070f2a58d0e358cac1f3592f04620e0a0637d",
 	)
 	s.Require().NoError(err)
 
@@ -333,7 +337,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"43ab9766738a05bfe5f1fd5dd0fb01c05b574f7d43c004dbf228deb437e0eb7c",
+		"db9a687d7222c2ef67702f50ef7070f2a58d0e358cac1f3592f04620e0a0637d",
 	)
 
 	s.Require().NoError(err)
@@ -352,7 +356,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1", // this hash obtained when ran this test in main branch
+		"eace031321738aa193185cdeb31373aef441776f755faee03dd2cc272c64f09d", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -365,7 +369,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"70c00b5171cbef019742d236096df60fc423cd7568c4933ab165baa3c68a64a1",
+		"eace031321738aa193185cdeb31373aef441776f755faee03dd2cc272c64f09d",
 	)
 
 	s.Require().NoError(err)
@@ -396,7 +400,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"ad475de20ebe21975f508ccca8c4c61148ee1d60c7b71286f59338c7f6095a1a",
 	)
 	s.Require().NoError(err)
 
@@ -420,7 +424,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"bae8a1f2070bea541bfeca8e7e4a1203cb316126451325b846b303897e8e7082",
+		"ad475de20ebe21975f508ccca8c4c61148ee1d60c7b71286f59338c7f6095a1a",
 	)
 	s.Require().NoError(err)
 }
@@ -437,7 +441,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"3b286438576d1ed6ad485799758178d90d82bf0379c543887e98aa8f6950cc8d",
 	)
 	s.Require().NoError(err)
 
@@ -450,7 +454,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"3f2de3f984c99cce5307db45961237220212c02981654b01b7b52f7a68b5b21b",
+		"3b286438576d1ed6ad485799758178d90d82bf0379c543887e98aa8f6950cc8d",
 	)
 	s.Require().NoError(err)
 }
@@ -484,7 +488,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"8096f1675d40d15c46b1dd1227171aada769b6f9926b7e110ad9fbf4618c6f45",
 	)
 	s.Require().NoError(err)
 
@@ -510,7 +514,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"d8acdcf8b7c8e17f3e83f0a4c293f89ad619a5dcb14d232911ccc5da15653177",
+		"8096f1675d40d15c46b1dd1227171aada769b6f9926b7e110ad9fbf4618c6f45",
 	)
 	s.Require().NoError(err)
 }
@@ -533,7 +537,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"9b3d933d382e323fd6edd7f2207d05b184c910ef20d0fa3174b0c07c218d41cf",
 	)
 	s.Require().NoError(err)
 
@@ -548,7 +552,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"a631942cd94450d778706c98afc4f83231524e3e94c88474cdab79a01a4899a0",
+		"9b3d933d382e323fd6edd7f2207d05b184c910ef20d0fa3174b0c07c218d41cf",
 	)
 	s.Require().NoError(err)
 }
@@ -576,7 +580,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"de51396bd7be4cb01520f36e8d087c0f6ab4d6c389548e3640e614a0f18a168b",
 	)
 	s.Require().NoError(err)
 
@@ -598,7 +602,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"58722ccde0cacda42aa81d71d7da1123b2c4a8e35d961d55f1507c3f10ffbc96",
+		"de51396bd7be4cb01520f36e8d087c0f6ab4d6c389548e3640e614a0f18a168b",
 	)
 	s.Require().NoError(err)
 }
@@ -606,3 +610,14 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+// consensusQueryServer serves the consensus params queries with the mocked
+// consensus keeper.
+type consensusQueryServer struct {
+	consensustypes.UnimplementedQueryServer
+	keeper *stakingtestutil.MockConsensusKeeper
+}
+
+func (s consensusQueryServer) Params(ctx context.Context, req *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error) {
+	return s.keeper.Params(ctx, req)
+}
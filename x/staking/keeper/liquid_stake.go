@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegatorIsLiquidStaker returns true if the delegator is registered as a
+// liquid staking provider.
+func (k Keeper) DelegatorIsLiquidStaker(ctx context.Context, delegator sdk.AccAddress) (bool, error) {
+	return k.LiquidStakers.Has(ctx, delegator)
+}
+
+// SetLiquidStaker registers or unregisters an account as a liquid staking
+// provider. The account must have no delegation nor unbonding delegation, so
+// that the liquid staked tokens and the liquid shares of the validators remain
+// consistent with the delegations tracked while it is registered.
+func (k Keeper) SetLiquidStaker(ctx context.Context, addr sdk.AccAddress, liquidStaker bool) error {
+	hasDelegations := false
+	err := k.Delegations.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](addr),
+		func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], _ types.Delegation) (stop bool, err error) {
+			hasDelegations = true
+			return true, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !hasDelegations {
+		err = k.UnbondingDelegations.Walk(ctx, collections.NewPrefixedPairRange[[]byte, []byte](addr),
+			func(_ collections.Pair[[]byte, []byte], _ types.UnbondingDelegation) (stop bool, err error) {
+				hasDelegations = true
+				return true, nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if hasDelegations {
+		return types.ErrLiquidStakerHasDelegations.Wrapf("account %s", addr)
+	}
+
+	if liquidStaker {
+		return k.LiquidStakers.Set(ctx, addr)
+	}
+
+	return k.LiquidStakers.Remove(ctx, addr)
+}
+
+// GetTotalLiquidStakedTokens returns the tokens delegated by liquid staking
+// providers.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total, err := k.TotalLiquidStakedTokens.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return total, err
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens increases the tokens delegated by
+// liquid staking providers, failing if they exceed the global liquid staking
+// cap fraction of the bonded tokens.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx context.Context, amount math.Int) error {
+	total, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	total = total.Add(amount)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if liquidStakingLimitEnabled(params.GlobalLiquidStakingCap) {
+		bonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		if math.LegacyNewDecFromInt(total).GT(params.GlobalLiquidStakingCap.MulInt(bonded)) {
+			return types.ErrGlobalLiquidStakingCapExceeded.Wrapf(
+				"%s liquid staked tokens would exceed %s of the %s bonded tokens",
+				total, params.GlobalLiquidStakingCap, bonded,
+			)
+		}
+	}
+
+	return k.TotalLiquidStakedTokens.Set(ctx, total)
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the tokens delegated by liquid
+// staking providers.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx context.Context, amount math.Int) error {
+	total, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the rounding of the slashed tokens may leave the total short
+	return k.TotalLiquidStakedTokens.Set(ctx, math.MaxInt(total.Sub(amount), math.ZeroInt()))
+}
+
+// SafelyIncreaseValidatorLiquidShares increases the shares of a validator
+// delegated by liquid staking providers, failing if they exceed the validator
+// liquid staking cap fraction of its shares, or the validator bond factor times
+// the shares self-delegated by its operator.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	liquidShares := validator.GetLiquidShares().Add(shares)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if liquidStakingLimitEnabled(params.ValidatorLiquidStakingCap) &&
+		liquidShares.GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares)) {
+		return types.ErrValidatorLiquidStakingCapExceeded.Wrapf(
+			"%s liquid shares would exceed %s of the %s shares of validator %s",
+			liquidShares, params.ValidatorLiquidStakingCap, validator.DelegatorShares, validator.GetOperator(),
+		)
+	}
+
+	if err := k.checkValidatorBond(ctx, params.ValidatorBondFactor, valAddr, liquidShares); err != nil {
+		return err
+	}
+
+	validator.LiquidShares = liquidShares
+	return k.SetValidator(ctx, validator)
+}
+
+// DecreaseValidatorLiquidShares decreases the shares of a validator delegated
+// by liquid staking providers.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		// the validator was removed along with its last delegation
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	validator.LiquidShares = math.LegacyMaxDec(validator.GetLiquidShares().Sub(shares), math.LegacyZeroDec())
+	return k.SetValidator(ctx, validator)
+}
+
+// checkValidatorBond checks the liquid shares of a validator do not exceed the
+// validator bond factor times the shares self-delegated by its operator.
+func (k Keeper) checkValidatorBond(ctx context.Context, factor math.LegacyDec, valAddr sdk.ValAddress, liquidShares math.LegacyDec) error {
+	if !liquidStakingLimitEnabled(factor) || !liquidShares.IsPositive() {
+		return nil
+	}
+
+	selfBondShares := math.LegacyZeroDec()
+	selfBond, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(valAddr), valAddr))
+	switch {
+	case err == nil:
+		selfBondShares = selfBond.Shares
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if liquidShares.GT(factor.Mul(selfBondShares)) {
+		return types.ErrInsufficientValidatorBondShares.Wrapf(
+			"%s liquid shares would exceed %s times the %s shares self-delegated by the operator of validator %s",
+			liquidShares, factor, selfBondShares, valAddr,
+		)
+	}
+
+	return nil
+}
+
+// trackLiquidDelegation tracks the tokens and shares delegated to a validator
+// if the delegator is a liquid staking provider.
+func (k Keeper) trackLiquidDelegation(ctx context.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, tokens math.Int, shares math.LegacyDec) error {
+	isLiquidStaker, err := k.DelegatorIsLiquidStaker(ctx, delegator)
+	if err != nil || !isLiquidStaker {
+		return err
+	}
+
+	if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+		return err
+	}
+
+	return k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, shares)
+}
+
+// trackLiquidUnbonding tracks the tokens and shares unbonded from a validator
+// if the delegator is a liquid staking provider.
+func (k Keeper) trackLiquidUnbonding(ctx context.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, tokens math.Int, shares math.LegacyDec) error {
+	isLiquidStaker, err := k.DelegatorIsLiquidStaker(ctx, delegator)
+	if err != nil || !isLiquidStaker {
+		return err
+	}
+
+	if err := k.DecreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+		return err
+	}
+
+	return k.DecreaseValidatorLiquidShares(ctx, valAddr, shares)
+}
+
+// checkSelfBondDecrease checks the validator bond still covers the liquid
+// shares of a validator when its operator decreases its self-delegation.
+func (k Keeper) checkSelfBondDecrease(ctx context.Context, delegator []byte, valAddr sdk.ValAddress) error {
+	if !bytes.Equal(delegator, valAddr) {
+		return nil
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.checkValidatorBond(ctx, params.ValidatorBondFactor, valAddr, validator.GetLiquidShares())
+}
+
+// slashLiquidStakedTokens decreases the liquid staked tokens by the share of
+// the tokens slashed from a validator delegated by liquid staking providers.
+func (k Keeper) slashLiquidStakedTokens(ctx context.Context, validator types.Validator, tokensToBurn math.Int) error {
+	liquidShares := validator.GetLiquidShares()
+	if !liquidShares.IsPositive() || !validator.DelegatorShares.IsPositive() {
+		return nil
+	}
+
+	liquidTokensToBurn := liquidShares.MulInt(tokensToBurn).Quo(validator.DelegatorShares).TruncateInt()
+	return k.DecreaseTotalLiquidStakedTokens(ctx, liquidTokensToBurn)
+}
+
+// liquidStakingLimitEnabled returns true if a liquid staking cap or factor is
+// set, zero disabling them.
+func liquidStakingLimitEnabled(limit math.LegacyDec) bool {
+	return !limit.IsNil() && limit.IsPositive()
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func (s *KeeperTestSuite) TestLiquidStaking() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	// accounts are liquid stakers only once registered by the authority
+	liquidStaker := sdk.AccAddress(address.Module("liquid_staker", []byte("ica")))
+	isLiquidStaker, err := keeper.DelegatorIsLiquidStaker(ctx, liquidStaker)
+	require.NoError(err)
+	require.False(isLiquidStaker)
+
+	_, err = msgServer.SetLiquidStaker(ctx, &types.MsgSetLiquidStaker{
+		Authority:    s.addressToString(Addr),
+		Address:      s.addressToString(liquidStaker),
+		LiquidStaker: true,
+	})
+	require.ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = msgServer.SetLiquidStaker(ctx, &types.MsgSetLiquidStaker{
+		Authority:    keeper.GetAuthority(),
+		Address:      s.addressToString(liquidStaker),
+		LiquidStaker: true,
+	})
+	require.NoError(err)
+	isLiquidStaker, err = keeper.DelegatorIsLiquidStaker(ctx, liquidStaker)
+	require.NoError(err)
+	require.True(isLiquidStaker)
+
+	tokens := func(power int64) math.Int { return keeper.TokensFromConsensusPower(ctx, power) }
+	coin := func(power int64) sdk.Coin { return sdk.NewCoin(sdk.DefaultBondDenom, tokens(power)) }
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), liquidStaker, types.NotBondedPoolName, gomock.Any()).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(types.BondedPoolName).Return(bondedAcc.GetAddress()).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(types.NotBondedPoolName).Return(notBondedAcc.GetAddress()).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(coin(100)).AnyTimes()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	params.ValidatorBondFactor = math.LegacyNewDec(2)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	createMsg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, coin(20), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	// delegations from unregistered accounts are not tracked
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(s.addressToString(Addr), s.valAddressToString(ValAddr), coin(5)))
+	require.NoError(err)
+	total, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(total.IsZero())
+
+	// delegations from liquid stakers are tracked, including the ones made
+	// through the keeper by other modules
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	_, err = keeper.Delegate(ctx, liquidStaker, tokens(10), types.Unbonded, validator, true)
+	require.NoError(err)
+	total, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(tokens(10), total)
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokens(10)), validator.GetLiquidShares())
+
+	// half of the 100 bonded tokens can be liquid staked
+	err = keeper.SafelyIncreaseTotalLiquidStakedTokens(ctx, tokens(41))
+	require.ErrorIs(err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// half of the 35 shares of the validator can be liquid staked
+	err = keeper.SafelyIncreaseValidatorLiquidShares(ctx, ValAddr, math.LegacyNewDecFromInt(tokens(8)))
+	require.ErrorIs(err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the liquid shares cannot exceed 40% of the 25 self-delegated shares
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.ValidatorBondFactor = math.LegacyNewDecWithPrec(4, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	err = keeper.SafelyIncreaseValidatorLiquidShares(ctx, ValAddr, math.LegacyNewDecFromInt(tokens(1)))
+	require.ErrorIs(err, types.ErrInsufficientValidatorBondShares)
+
+	// nor can the operator undelegate below the validator bond
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.Undelegate(cacheCtx, types.NewMsgUndelegate(s.addressToString(Addr), s.valAddressToString(ValAddr), coin(1)))
+	require.ErrorIs(err, types.ErrInsufficientValidatorBondShares)
+
+	params.ValidatorBondFactor = math.LegacyZeroDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the registration of a liquid staker with delegations cannot change
+	err = keeper.SetLiquidStaker(ctx, liquidStaker, false)
+	require.ErrorIs(err, types.ErrLiquidStakerHasDelegations)
+
+	// undelegations from liquid stakers are tracked
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(s.addressToString(liquidStaker), s.valAddressToString(ValAddr), coin(5)))
+	require.NoError(err)
+	total, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(tokens(5), total)
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokens(5)), validator.GetLiquidShares())
+
+	// slashing 20% of the 30 tokens of the validator slashes 1 of the 5 liquid staked tokens
+	validator.Status = types.Bonded
+	require.NoError(keeper.SetValidator(ctx, validator))
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), bondedAcc.GetAddress(), sdk.NewCoins(coin(6))).Return(nil)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	_, err = keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 30, math.LegacyNewDecWithPrec(2, 1))
+	require.NoError(err)
+	total, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(tokens(4), total)
+}
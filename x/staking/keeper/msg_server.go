@@ -6,8 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/go-metrics"
 	"google.golang.org/grpc/codes"
@@ -106,7 +104,7 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		)
 	}
 
-	if _, err := msg.Description.EnsureLength(); err != nil {
+	if _, err := msg.Description.Validate(); err != nil {
 		return nil, err
 	}
 
@@ -137,6 +135,11 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, err
 	}
 
+	err = k.SetValidatorByMoniker(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.SetNewValidatorByPowerIndex(ctx, validator)
 	if err != nil {
 		return nil, err
@@ -211,8 +214,16 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 		return nil, err
 	}
 
+	if err := k.DeleteValidatorByMoniker(ctx, validator); err != nil {
+		return nil, err
+	}
+
 	validator.Description = description
 
+	if err := k.SetValidatorByMoniker(ctx, validator); err != nil {
+		return nil, err
+	}
+
 	if msg.CommissionRate != nil {
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
@@ -291,7 +302,7 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 	}
 
 	// NOTE: source funds are always unbonded
-	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
+	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
 	if err != nil {
 		return nil, err
 	}
@@ -307,16 +318,6 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 		}()
 	}
 
-	if err := k.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeDelegate,
-		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
-		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
-		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-		event.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
-	); err != nil {
-		return nil, err
-	}
-
 	return &types.MsgDelegateResponse{}, nil
 }
 
@@ -337,31 +338,11 @@ func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedel
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
-	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
-		return nil, errorsmod.Wrap(
-			sdkerrors.ErrInvalidRequest,
-			"invalid shares amount",
-		)
-	}
-
-	shares, err := k.ValidateUnbondAmount(
-		ctx, delegatorAddress, valSrcAddr, msg.Amount.Amount,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	bondDenom, err := k.BondDenom(ctx)
+	shares, amount, err := k.unbondShares(ctx, delegatorAddress, valSrcAddr, msg.Amount, msg.SharesPercentage)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
-	}
-
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -369,67 +350,89 @@ func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedel
 		return nil, err
 	}
 
-	if msg.Amount.Amount.IsInt64() {
+	if amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "redelegate")
 			telemetry.SetGaugeWithLabels(
 				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
-				float32(msg.Amount.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+				float32(amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
 			)
 		}()
 	}
 
-	if err := k.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeRedelegate,
-		event.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress),
-		event.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddress),
-		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-		event.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
-	); err != nil {
-		return nil, err
-	}
-
 	return &types.MsgBeginRedelegateResponse{
 		CompletionTime: completionTime,
 	}, nil
 }
 
-// Undelegate defines a method for performing an undelegation from a delegate and a validator
-func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
-	addr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+// unbondShares returns the delegation shares to unbond or redelegate from the
+// given validator, computed either from a token amount or from a percentage of
+// the delegation shares, along with the corresponding amount of bond denom tokens.
+func (k msgServer) unbondShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, pct math.LegacyDec,
+) (math.LegacyDec, sdk.Coin, error) {
+	bondDenom, err := k.BondDenom(ctx)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		return math.LegacyDec{}, sdk.Coin{}, err
 	}
 
-	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
-	if err != nil {
-		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	if !pct.IsNil() && !pct.IsZero() {
+		if !amount.Amount.IsNil() && !amount.Amount.IsZero() {
+			return math.LegacyDec{}, sdk.Coin{}, errorsmod.Wrap(
+				sdkerrors.ErrInvalidRequest, "amount and shares percentage cannot both be set",
+			)
+		}
+
+		shares, err := k.ValidateUnbondPercentage(ctx, delAddr, valAddr, pct)
+		if err != nil {
+			return math.LegacyDec{}, sdk.Coin{}, err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return math.LegacyDec{}, sdk.Coin{}, err
+		}
+
+		return shares, sdk.NewCoin(bondDenom, validator.TokensFromShares(shares).TruncateInt()), nil
 	}
 
-	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
-		return nil, errorsmod.Wrap(
+	if !amount.IsValid() || !amount.Amount.IsPositive() {
+		return math.LegacyDec{}, sdk.Coin{}, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
 			"invalid shares amount",
 		)
 	}
 
-	shares, err := k.ValidateUnbondAmount(
-		ctx, delegatorAddress, addr, msg.Amount.Amount,
-	)
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
 	if err != nil {
-		return nil, err
+		return math.LegacyDec{}, sdk.Coin{}, err
 	}
 
-	bondDenom, err := k.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return math.LegacyDec{}, sdk.Coin{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom,
+		)
+	}
+
+	return shares, amount, nil
+}
+
+// Undelegate defines a method for performing an undelegation from a delegate and a validator
+func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	addr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
 	if err != nil {
-		return nil, err
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
 	}
 
-	if msg.Amount.Denom != bondDenom {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
-		)
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	shares, amount, err := k.unbondShares(ctx, delegatorAddress, addr, msg.Amount, msg.SharesPercentage)
+	if err != nil {
+		return nil, err
 	}
 
 	completionTime, undelegatedAmt, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
@@ -437,29 +440,19 @@ func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*t
 		return nil, err
 	}
 
-	undelegatedCoin := sdk.NewCoin(msg.Amount.Denom, undelegatedAmt)
+	undelegatedCoin := sdk.NewCoin(amount.Denom, undelegatedAmt)
 
-	if msg.Amount.Amount.IsInt64() {
+	if amount.Amount.IsInt64() {
 		defer func() {
 			telemetry.IncrCounter(1, types.ModuleName, "undelegate")
 			telemetry.SetGaugeWithLabels(
 				[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
-				float32(msg.Amount.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+				float32(amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
 			)
 		}()
 	}
 
-	if err := k.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeUnbond,
-		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
-		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
-		event.NewAttribute(sdk.AttributeKeyAmount, undelegatedCoin.String()),
-		event.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
-	); err != nil {
-		return nil, err
-	}
-
 	return &types.MsgUndelegateResponse{
 		CompletionTime: completionTime,
 		Amount:         undelegatedCoin,
@@ -555,7 +548,7 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 	}
 
 	// delegate back the unbonding delegation amount to the validator
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
+	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
 	if err != nil {
 		return nil, err
 	}
@@ -581,13 +574,13 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	if err := k.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeCancelUnbondingDelegation,
-		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
-		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
-		event.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
-	); err != nil {
+	if err := k.EventService.EventManager(ctx).Emit(&types.EventCancelUnbondingDelegation{
+		DelegatorAddress: msg.DelegatorAddress,
+		ValidatorAddress: msg.ValidatorAddress,
+		Tokens:           msg.Amount.Amount,
+		Shares:           newShares,
+		CreationHeight:   msg.CreationHeight,
+	}); err != nil {
 		return nil, err
 	}
 
@@ -644,6 +637,57 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// SetUnbondingTimeOverride defines a method for setting the unbonding time of
+// the undelegations of a given delegator or account type.
+func (k msgServer) SetUnbondingTimeOverride(ctx context.Context, msg *types.MsgSetUnbondingTimeOverride) (*types.MsgSetUnbondingTimeOverrideResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.SetUnbondingTimeOverride(ctx, msg.Override); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetUnbondingTimeOverrideResponse{}, nil
+}
+
+// RemoveUnbondingTimeOverride defines a method for removing an unbonding time
+// override.
+func (k msgServer) RemoveUnbondingTimeOverride(ctx context.Context, msg *types.MsgRemoveUnbondingTimeOverride) (*types.MsgRemoveUnbondingTimeOverrideResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidUnbondingTimeOverride, err.Error())
+	}
+
+	if err := k.Keeper.RemoveUnbondingTimeOverride(ctx, msg.Address, msg.AccountType); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveUnbondingTimeOverrideResponse{}, nil
+}
+
+// SetLiquidStaker defines a method for registering or unregistering an account
+// as a liquid staking provider.
+func (k msgServer) SetLiquidStaker(ctx context.Context, msg *types.MsgSetLiquidStaker) (*types.MsgSetLiquidStakerResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid liquid staker address: %s", err)
+	}
+
+	if err := k.Keeper.SetLiquidStaker(ctx, addr, msg.LiquidStaker); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetLiquidStakerResponse{}, nil
+}
+
 func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateConsPubKey) (res *types.MsgRotateConsPubKeyResponse, err error) {
 	cv := msg.NewPubkey.GetCachedValue()
 	pk, ok := cv.(cryptotypes.PubKey)
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 
 	"cosmossdk.io/collections"
@@ -769,6 +771,29 @@ func (s *KeeperTestSuite) TestMsgBeginRedelegate() {
 			expErr:    true,
 			expErrMsg: "invalid coin denomination",
 		},
+		{
+			name: "amount and shares percentage both set",
+			input: &types.MsgBeginRedelegate{
+				DelegatorAddress:    s.addressToString(Addr),
+				ValidatorSrcAddress: s.valAddressToString(srcValAddr),
+				ValidatorDstAddress: s.valAddressToString(dstValAddr),
+				Amount:              sdk.NewCoin(sdk.DefaultBondDenom, shares.RoundInt()),
+				SharesPercentage:    math.LegacyNewDecWithPrec(5, 1),
+			},
+			expErr:    true,
+			expErrMsg: "amount and shares percentage cannot both be set",
+		},
+		{
+			name: "shares percentage greater than one",
+			input: &types.MsgBeginRedelegate{
+				DelegatorAddress:    s.addressToString(Addr),
+				ValidatorSrcAddress: s.valAddressToString(srcValAddr),
+				ValidatorDstAddress: s.valAddressToString(dstValAddr),
+				SharesPercentage:    math.LegacyNewDecWithPrec(15, 1),
+			},
+			expErr:    true,
+			expErrMsg: "shares percentage must be in (0, 1]",
+		},
 		{
 			name: "valid msg",
 			input: &types.MsgBeginRedelegate{
@@ -894,6 +919,27 @@ func (s *KeeperTestSuite) TestMsgUndelegate() {
 			expErr:    true,
 			expErrMsg: "invalid coin denomination",
 		},
+		{
+			name: "amount and shares percentage both set",
+			input: &types.MsgUndelegate{
+				DelegatorAddress: s.addressToString(Addr),
+				ValidatorAddress: s.valAddressToString(ValAddr),
+				Amount:           sdk.NewCoin(sdk.DefaultBondDenom, shares.RoundInt()),
+				SharesPercentage: math.LegacyNewDecWithPrec(5, 1),
+			},
+			expErr:    true,
+			expErrMsg: "amount and shares percentage cannot both be set",
+		},
+		{
+			name: "negative shares percentage",
+			input: &types.MsgUndelegate{
+				DelegatorAddress: s.addressToString(Addr),
+				ValidatorAddress: s.valAddressToString(ValAddr),
+				SharesPercentage: math.LegacyNewDecWithPrec(-5, 1),
+			},
+			expErr:    true,
+			expErrMsg: "shares percentage must be in (0, 1]",
+		},
 		{
 			name: "valid msg",
 			input: &types.MsgUndelegate{
@@ -919,6 +965,164 @@ func (s *KeeperTestSuite) TestMsgUndelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgUndelegateSharesPercentage() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	del := types.NewDelegation(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))
+	require.NoError(keeper.SetDelegation(ctx, del))
+
+	// unbond a quarter of the delegation
+	res, err := msgServer.Undelegate(ctx, &types.MsgUndelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		SharesPercentage: math.LegacyNewDecWithPrec(25, 2),
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25), res.Amount)
+
+	del, err = keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(75), del.Shares)
+
+	// unbond the remaining delegation
+	res, err = msgServer.Undelegate(ctx, &types.MsgUndelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		SharesPercentage: math.LegacyOneDec(),
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 75), res.Amount)
+
+	_, err = keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+	require.ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestMsgDelegationTypedEvents() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	headerInfo := ctx.HeaderInfo()
+	headerInfo.Height = 10
+	ctx = ctx.WithHeaderInfo(headerInfo)
+
+	// lastTypedEvent returns the last typed event of the given type emitted in ctx
+	lastTypedEvent := func(ctx sdk.Context, eventType string) proto.Message {
+		var msg proto.Message
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != eventType {
+				continue
+			}
+			var err error
+			msg, err = sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(err)
+		}
+		require.NotNil(msg, "no %s event emitted", eventType)
+		return msg
+	}
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	srcValAddr, dstValAddr := ValAddr, sdk.ValAddress(PKS[1].Address())
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(dstValAddr), types.NotBondedPoolName, gomock.Any()).AnyTimes()
+	for _, valAddr := range []sdk.ValAddress{srcValAddr, dstValAddr} {
+		msg, err := types.NewMsgCreateValidator(s.valAddressToString(valAddr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+		require.NoError(err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		require.NoError(err)
+	}
+
+	// the self-delegation of a new validator is reported
+	require.Equal(&types.EventDelegate{
+		DelegatorAddress: s.addressToString(dstValAddr),
+		ValidatorAddress: s.valAddressToString(dstValAddr),
+		Tokens:           math.NewInt(10),
+		Shares:           math.LegacyNewDec(10),
+	}, lastTypedEvent(ctx, "cosmos.staking.v1beta1.EventDelegate"))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.Delegate(ctx, &types.MsgDelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(srcValAddr),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	})
+	require.NoError(err)
+	require.Equal(&types.EventDelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(srcValAddr),
+		Tokens:           math.NewInt(100),
+		Shares:           math.LegacyNewDec(100),
+	}, lastTypedEvent(ctx, "cosmos.staking.v1beta1.EventDelegate"))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.Undelegate(ctx, &types.MsgUndelegate{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(srcValAddr),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 30),
+	})
+	require.NoError(err)
+	require.Equal(&types.EventUnbond{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(srcValAddr),
+		Tokens:           math.NewInt(30),
+		Shares:           math.LegacyNewDec(30),
+		CreationHeight:   ctx.HeaderInfo().Height,
+		CompletionTime:   res.CompletionTime,
+	}, lastTypedEvent(ctx, "cosmos.staking.v1beta1.EventUnbond"))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.CancelUnbondingDelegation(ctx, &types.MsgCancelUnbondingDelegation{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(srcValAddr),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+		CreationHeight:   ctx.HeaderInfo().Height,
+	})
+	require.NoError(err)
+	require.Equal(&types.EventCancelUnbondingDelegation{
+		DelegatorAddress: s.addressToString(Addr),
+		ValidatorAddress: s.valAddressToString(srcValAddr),
+		Tokens:           math.NewInt(10),
+		Shares:           math.LegacyNewDec(10),
+		CreationHeight:   ctx.HeaderInfo().Height,
+	}, lastTypedEvent(ctx, "cosmos.staking.v1beta1.EventCancelUnbondingDelegation"))
+	// delegating back the tokens does not report a new delegation
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual("cosmos.staking.v1beta1.EventDelegate", event.Type)
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	redRes, err := msgServer.BeginRedelegate(ctx, &types.MsgBeginRedelegate{
+		DelegatorAddress:    s.addressToString(Addr),
+		ValidatorSrcAddress: s.valAddressToString(srcValAddr),
+		ValidatorDstAddress: s.valAddressToString(dstValAddr),
+		Amount:              sdk.NewInt64Coin(sdk.DefaultBondDenom, 50),
+	})
+	require.NoError(err)
+	require.Equal(&types.EventRedelegate{
+		DelegatorAddress:    s.addressToString(Addr),
+		ValidatorSrcAddress: s.valAddressToString(srcValAddr),
+		ValidatorDstAddress: s.valAddressToString(dstValAddr),
+		Tokens:              math.NewInt(50),
+		Shares:              math.LegacyNewDec(50),
+		SharesDst:           math.LegacyNewDec(50),
+		CompletionTime:      redRes.CompletionTime,
+	}, lastTypedEvent(ctx, "cosmos.staking.v1beta1.EventRedelegate"))
+
+	del, err := keeper.Delegations.Get(ctx, collections.Join(Addr, dstValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), del.Shares)
+}
+
 func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegation() {
 	ctx, keeper, msgServer, ak := s.ctx, s.stakingKeeper, s.msgServer, s.accountKeeper
 	require := s.Require()
@@ -28,7 +28,7 @@ func (k Keeper) MaxEntries(ctx context.Context) (uint32, error) {
 	return params.MaxEntries, err
 }
 
-// HistoricalEntries = number of historical info entries
+// HistoricalEntries = number of historical validator sets
 // to persist in store
 func (k Keeper) HistoricalEntries(ctx context.Context) (uint32, error) {
 	params, err := k.Params.Get(ctx)
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// supplyBreakdownCache caches the supply breakdown of the last computed height,
+// as computing it requires iterating over all the vesting accounts.
+type supplyBreakdownCache struct {
+	mtx       sync.Mutex
+	breakdown *types.QuerySupplyBreakdownResponse
+}
+
+// SupplyBreakdown returns the breakdown of the bond denom supply into bonded,
+// unbonding, community pool, vesting locked and liquid tokens.
+// The breakdown is computed once per block height and cached.
+func (k Keeper) SupplyBreakdown(ctx context.Context) (types.QuerySupplyBreakdownResponse, error) {
+	height := k.HeaderService.HeaderInfo(ctx).Height
+
+	k.supplyBreakdownCache.mtx.Lock()
+	defer k.supplyBreakdownCache.mtx.Unlock()
+
+	if cached := k.supplyBreakdownCache.breakdown; cached != nil && cached.Height == height {
+		return *cached, nil
+	}
+
+	breakdown, err := k.computeSupplyBreakdown(ctx)
+	if err != nil {
+		return types.QuerySupplyBreakdownResponse{}, err
+	}
+	breakdown.Height = height
+
+	k.supplyBreakdownCache.breakdown = &breakdown
+	return breakdown, nil
+}
+
+func (k Keeper) computeSupplyBreakdown(ctx context.Context) (types.QuerySupplyBreakdownResponse, error) {
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return types.QuerySupplyBreakdownResponse{}, err
+	}
+
+	moduleBalance := func(moduleName string) math.Int {
+		addr := k.authKeeper.GetModuleAddress(moduleName)
+		if addr == nil {
+			return math.ZeroInt()
+		}
+		return k.bankKeeper.GetBalance(ctx, addr, bondDenom).Amount
+	}
+
+	total := k.bankKeeper.GetSupply(ctx, bondDenom).Amount
+	bonded := moduleBalance(types.BondedPoolName)
+	unbonding := moduleBalance(types.NotBondedPoolName)
+	communityPool := moduleBalance(types.PoolModuleName)
+
+	// the locked coins of a vesting account exclude its delegated vesting coins,
+	// which are already accounted in the bonded and unbonding amounts.
+	vestingLocked := math.ZeroInt()
+	err = k.authKeeper.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+		locked := k.bankKeeper.LockedCoins(ctx, addr).AmountOf(bondDenom)
+		if locked.IsZero() {
+			return false
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, addr, bondDenom).Amount
+		vestingLocked = vestingLocked.Add(math.MinInt(locked, balance))
+		return false
+	})
+	if err != nil {
+		return types.QuerySupplyBreakdownResponse{}, err
+	}
+
+	liquid := total.Sub(bonded).Sub(unbonding).Sub(communityPool).Sub(vestingLocked)
+
+	return types.QuerySupplyBreakdownResponse{
+		Denom:         bondDenom,
+		Total:         total,
+		Bonded:        bonded,
+		Unbonding:     unbonding,
+		CommunityPool: communityPool,
+		VestingLocked: vestingLocked,
+		Liquid:        liquid,
+	}, nil
+}
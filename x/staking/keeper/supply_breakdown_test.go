@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestSupplyBreakdown() {
+	ctx, keeper := s.ctx.WithHeaderInfo(header.Info{Height: 10}), s.stakingKeeper
+	require := s.Require()
+
+	bondDenom, err := keeper.BondDenom(ctx)
+	require.NoError(err)
+
+	poolAcc := authtypes.NewEmptyModuleAccount(stakingtypes.PoolModuleName)
+	vestingAddr, vestedAddr := sdk.AccAddress(PKs[0].Address()), sdk.AccAddress(PKs[1].Address())
+
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(bondedAcc.GetAddress()).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress()).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.PoolModuleName).Return(poolAcc.GetAddress()).AnyTimes()
+
+	// the breakdown is computed once per height
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), bondDenom).Return(sdk.NewInt64Coin(bondDenom, 1000)).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), bondDenom).Return(sdk.NewInt64Coin(bondDenom, 400)).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), notBondedAcc.GetAddress(), bondDenom).Return(sdk.NewInt64Coin(bondDenom, 100)).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), poolAcc.GetAddress(), bondDenom).Return(sdk.NewInt64Coin(bondDenom, 50)).Times(2)
+	s.accountKeeper.EXPECT().IterateVestingAccounts(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, cb func(sdk.AccAddress) bool) error {
+		cb(vestingAddr)
+		cb(vestedAddr)
+		return nil
+	}).Times(2)
+	s.bankKeeper.EXPECT().LockedCoins(gomock.Any(), vestingAddr).Return(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 150), sdk.NewInt64Coin("other", 500))).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), vestingAddr, bondDenom).Return(sdk.NewInt64Coin(bondDenom, 200)).Times(2)
+	s.bankKeeper.EXPECT().LockedCoins(gomock.Any(), vestedAddr).Return(sdk.NewCoins()).Times(2)
+
+	expected := &stakingtypes.QuerySupplyBreakdownResponse{
+		Denom:         bondDenom,
+		Total:         math.NewInt(1000),
+		Bonded:        math.NewInt(400),
+		Unbonding:     math.NewInt(100),
+		CommunityPool: math.NewInt(50),
+		VestingLocked: math.NewInt(150),
+		Liquid:        math.NewInt(300),
+		Height:        10,
+	}
+
+	querier := stakingkeeper.Querier{Keeper: keeper}
+	res, err := querier.SupplyBreakdown(ctx, &stakingtypes.QuerySupplyBreakdownRequest{})
+	require.NoError(err)
+	require.Equal(expected, res)
+
+	// cached for the same height
+	res, err = querier.SupplyBreakdown(ctx, &stakingtypes.QuerySupplyBreakdownRequest{})
+	require.NoError(err)
+	require.Equal(expected, res)
+
+	// recomputed for the next height
+	res, err = querier.SupplyBreakdown(ctx.WithHeaderInfo(header.Info{Height: 11}), &stakingtypes.QuerySupplyBreakdownRequest{})
+	require.NoError(err)
+	expected.Height = 11
+	require.Equal(expected, res)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetValidatorTruncationDust returns the truncation dust tracked for the given
+// validator, i.e. the fractions of tokens left in the validator because the
+// tokens returned to unbonding delegators are truncated.
+func (k Keeper) GetValidatorTruncationDust(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	dust, err := k.ValidatorTruncationDust.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return dust, err
+}
+
+// trackTruncationDust records the dust left in the validator by the truncation
+// of the tokens returned when unbonding shares from it. The dust is attributed
+// to the validator, i.e. it increases the value of the remaining shares, unless
+// the TruncationDustToCommunityPool parameter is set, in which case it is
+// routed to the community pool once it adds up to whole tokens.
+func (k Keeper) trackTruncationDust(ctx context.Context, validator types.Validator, dust math.LegacyDec) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	if validator.DelegatorShares.IsZero() {
+		// the last delegator received all the tokens left in the validator,
+		// including its dust
+		return k.ValidatorTruncationDust.Remove(ctx, valAddr)
+	}
+
+	if !dust.IsPositive() {
+		return nil
+	}
+
+	total, err := k.GetValidatorTruncationDust(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	total = total.Add(dust)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.TruncationDustToCommunityPool {
+		routed := math.MinInt(total.TruncateInt(), validator.Tokens)
+		if routed.IsPositive() {
+			if err := k.routeTruncationDust(ctx, validator, valAddr, routed, params.BondDenom); err != nil {
+				return err
+			}
+			total = total.Sub(math.LegacyNewDecFromInt(routed))
+		}
+	}
+
+	return k.ValidatorTruncationDust.Set(ctx, valAddr, total)
+}
+
+// routeTruncationDust moves the given amount of dust tokens from the validator
+// to the community pool.
+func (k Keeper) routeTruncationDust(ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, amount math.Int, bondDenom string) error {
+	// removing the dust decreases the value of the validator shares, so
+	// x/distribution must account for it. This is not a slash, a dedicated
+	// hook is called so that slashing observers are not notified.
+	fraction := math.LegacyNewDecFromInt(amount).QuoRoundUp(math.LegacyNewDecFromInt(validator.Tokens))
+	if err := k.Hooks().BeforeTruncationDustRouted(ctx, valAddr, fraction); err != nil {
+		return fmt.Errorf("failed to call before truncation dust routed hook: %w", err)
+	}
+
+	validator, err := k.RemoveValidatorTokens(ctx, validator, amount)
+	if err != nil {
+		return err
+	}
+
+	pool := types.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = types.BondedPoolName
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.PoolModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRouteTruncationDust,
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+	)
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetDelegatorUnbondingTime returns the unbonding time applying to the
+// undelegations and redelegations of a delegator. An unbonding time override
+// set for the delegator address takes precedence over an override set for its
+// account type, which takes precedence over the unbonding time param. An
+// override never shortens the unbonding time param, which may have been raised
+// after the override was set.
+func (k Keeper) GetDelegatorUnbondingTime(ctx context.Context, delAddr sdk.AccAddress) (time.Duration, error) {
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	override, err := k.UnbondingTimeOverrides.Get(ctx, delegator)
+	if err == nil {
+		return max(override.UnbondingTime, unbondingTime), nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	if acc := k.authKeeper.GetAccount(ctx, delAddr); acc != nil {
+		override, err = k.UnbondingTimeOverrides.Get(ctx, sdk.MsgTypeURL(acc))
+		if err == nil {
+			return max(override.UnbondingTime, unbondingTime), nil
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return 0, err
+		}
+	}
+
+	return unbondingTime, nil
+}
+
+// SetUnbondingTimeOverride sets an unbonding time override, replacing any
+// existing override for the same delegator address or account type. The
+// override cannot be shorter than the unbonding time param, so that the
+// delegations remain slashable for the evidences of infractions committed
+// while they were bonded.
+func (k Keeper) SetUnbondingTimeOverride(ctx context.Context, override types.UnbondingTimeOverride) error {
+	if err := override.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidUnbondingTimeOverride, err.Error())
+	}
+
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	if override.UnbondingTime < unbondingTime {
+		return errorsmod.Wrapf(
+			types.ErrInvalidUnbondingTimeOverride,
+			"unbonding time %s is shorter than the unbonding time param %s", override.UnbondingTime, unbondingTime,
+		)
+	}
+
+	key, err := k.unbondingTimeOverrideKey(override.Address, override.AccountType)
+	if err != nil {
+		return err
+	}
+
+	if override.Address != "" {
+		override.Address = key
+	}
+
+	return k.UnbondingTimeOverrides.Set(ctx, key, override)
+}
+
+// RemoveUnbondingTimeOverride removes the unbonding time override of a
+// delegator address or account type.
+func (k Keeper) RemoveUnbondingTimeOverride(ctx context.Context, address, accountType string) error {
+	key, err := k.unbondingTimeOverrideKey(address, accountType)
+	if err != nil {
+		return err
+	}
+
+	has, err := k.UnbondingTimeOverrides.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return errorsmod.Wrapf(types.ErrUnbondingTimeOverrideNotFound, "no unbonding time override for %s", key)
+	}
+
+	return k.UnbondingTimeOverrides.Remove(ctx, key)
+}
+
+// unbondingTimeOverrideKey returns the key of the unbonding time override of a
+// delegator address or account type, the address being re-encoded in its
+// canonical form.
+func (k Keeper) unbondingTimeOverrideKey(address, accountType string) (string, error) {
+	if address == "" {
+		return types.NewUnbondingTimeOverride(address, accountType, 0).Key(), nil
+	}
+
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return k.authKeeper.AddressCodec().BytesToString(addr)
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/staking/testutil"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestUnbondingTimeOverrides() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	baseAccountType := sdk.MsgTypeURL(&authtypes.BaseAccount{})
+	unbondingTime, err := keeper.UnbondingTime(ctx)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	otherAddr := sdk.AccAddress(PKs[2].Address())
+
+	// only the authority can set an override
+	_, err = msgServer.SetUnbondingTimeOverride(ctx, &types.MsgSetUnbondingTimeOverride{
+		Authority: s.addressToString(delAddr),
+		Override:  types.NewUnbondingTimeOverride("", baseAccountType, time.Hour),
+	})
+	require.ErrorIs(err, types.ErrInvalidSigner)
+
+	invalidOverrides := []types.UnbondingTimeOverride{
+		types.NewUnbondingTimeOverride("", "", time.Hour),
+		types.NewUnbondingTimeOverride(s.addressToString(delAddr), baseAccountType, time.Hour),
+		types.NewUnbondingTimeOverride("", "cosmos.auth.v1beta1.BaseAccount", time.Hour),
+		types.NewUnbondingTimeOverride("", baseAccountType, 0),
+		types.NewUnbondingTimeOverride("", baseAccountType, unbondingTime-time.Second),
+	}
+	for _, override := range invalidOverrides {
+		_, err = msgServer.SetUnbondingTimeOverride(ctx, &types.MsgSetUnbondingTimeOverride{
+			Authority: keeper.GetAuthority(),
+			Override:  override,
+		})
+		require.ErrorIs(err, types.ErrInvalidUnbondingTimeOverride)
+	}
+
+	_, err = msgServer.SetUnbondingTimeOverride(ctx, &types.MsgSetUnbondingTimeOverride{
+		Authority: keeper.GetAuthority(),
+		Override:  types.NewUnbondingTimeOverride("invalid", "", unbondingTime),
+	})
+	require.ErrorContains(err, "invalid delegator address")
+
+	// the account type override applies to all the delegators of the type
+	_, err = msgServer.SetUnbondingTimeOverride(ctx, &types.MsgSetUnbondingTimeOverride{
+		Authority: keeper.GetAuthority(),
+		Override:  types.NewUnbondingTimeOverride("", baseAccountType, unbondingTime+2*time.Hour),
+	})
+	require.NoError(err)
+
+	for _, addr := range []sdk.AccAddress{delAddr, otherAddr} {
+		got, err := keeper.GetDelegatorUnbondingTime(ctx, addr)
+		require.NoError(err)
+		require.Equal(unbondingTime+2*time.Hour, got)
+	}
+
+	// the delegator address override takes precedence over the account type override
+	_, err = msgServer.SetUnbondingTimeOverride(ctx, &types.MsgSetUnbondingTimeOverride{
+		Authority: keeper.GetAuthority(),
+		Override:  types.NewUnbondingTimeOverride(s.addressToString(delAddr), "", unbondingTime+time.Hour),
+	})
+	require.NoError(err)
+
+	res, err := s.queryClient.DelegatorUnbondingTime(ctx, &types.QueryDelegatorUnbondingTimeRequest{DelegatorAddr: s.addressToString(delAddr)})
+	require.NoError(err)
+	require.Equal(unbondingTime+time.Hour, res.UnbondingTime)
+
+	res, err = s.queryClient.DelegatorUnbondingTime(ctx, &types.QueryDelegatorUnbondingTimeRequest{DelegatorAddr: s.addressToString(otherAddr)})
+	require.NoError(err)
+	require.Equal(unbondingTime+2*time.Hour, res.UnbondingTime)
+
+	overridesRes, err := s.queryClient.UnbondingTimeOverrides(ctx, &types.QueryUnbondingTimeOverridesRequest{})
+	require.NoError(err)
+	require.Len(overridesRes.Overrides, 2)
+
+	// the override is enforced on the undelegations of the delegator
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(ctx, int64(100))}
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), ed25519.GenPrivKey().PubKey(), amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(delAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))))
+	undelegateRes, err := msgServer.Undelegate(ctx, &types.MsgUndelegate{
+		DelegatorAddress: s.addressToString(delAddr),
+		ValidatorAddress: s.valAddressToString(ValAddr),
+		SharesPercentage: math.LegacyOneDec(),
+	})
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Time.Add(unbondingTime+time.Hour), undelegateRes.CompletionTime)
+
+	// and on its redelegations
+	srcVal, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	srcVal.Status = types.Bonded
+	require.NoError(keeper.SetValidator(ctx, srcVal))
+	dstAddr := sdk.ValAddress(PKs[3].Address())
+	dstVal := testutil.NewValidator(s.T(), dstAddr, PKs[3])
+	dstVal.Status = types.Bonded
+	require.NoError(keeper.SetValidator(ctx, dstVal))
+
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(s.addressToString(delAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))))
+	redelegateRes, err := msgServer.BeginRedelegate(ctx, &types.MsgBeginRedelegate{
+		DelegatorAddress:    s.addressToString(delAddr),
+		ValidatorSrcAddress: s.valAddressToString(ValAddr),
+		ValidatorDstAddress: s.valAddressToString(dstAddr),
+		SharesPercentage:    math.LegacyOneDec(),
+	})
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Time.Add(unbondingTime+time.Hour), redelegateRes.CompletionTime)
+
+	// removing the overrides restores the unbonding time param
+	_, err = msgServer.RemoveUnbondingTimeOverride(ctx, &types.MsgRemoveUnbondingTimeOverride{
+		Authority: keeper.GetAuthority(),
+		Address:   s.addressToString(delAddr),
+	})
+	require.NoError(err)
+
+	_, err = msgServer.RemoveUnbondingTimeOverride(ctx, &types.MsgRemoveUnbondingTimeOverride{
+		Authority:   keeper.GetAuthority(),
+		AccountType: baseAccountType,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.RemoveUnbondingTimeOverride(ctx, &types.MsgRemoveUnbondingTimeOverride{
+		Authority:   keeper.GetAuthority(),
+		AccountType: baseAccountType,
+	})
+	require.ErrorIs(err, types.ErrUnbondingTimeOverrideNotFound)
+
+	got, err := keeper.GetDelegatorUnbondingTime(ctx, delAddr)
+	require.NoError(err)
+	require.Equal(unbondingTime, got)
+}
@@ -11,7 +11,6 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
-	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/types"
@@ -61,19 +60,10 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 			return nil, err
 		}
 
-		balances, err := k.CompleteUnbonding(ctx, delegatorAddress, addr)
-		if err != nil {
+		// CompleteUnbonding emits an EventUnbondingCompleted per completed entry
+		if _, err := k.CompleteUnbonding(ctx, delegatorAddress, addr); err != nil {
 			continue
 		}
-
-		if err := k.EventService.EventManager(ctx).EmitKV(
-			types.EventTypeCompleteUnbonding,
-			event.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
-			event.NewAttribute(types.AttributeKeyValidator, dvPair.ValidatorAddress),
-			event.NewAttribute(types.AttributeKeyDelegator, dvPair.DelegatorAddress),
-		); err != nil {
-			return nil, err
-		}
 	}
 
 	// Remove all mature redelegations from the red queue.
@@ -96,25 +86,10 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 			return nil, err
 		}
 
-		balances, err := k.CompleteRedelegation(
-			ctx,
-			delegatorAddress,
-			valSrcAddr,
-			valDstAddr,
-		)
-		if err != nil {
+		// CompleteRedelegation emits an EventRedelegationCompleted per completed entry
+		if _, err := k.CompleteRedelegation(ctx, delegatorAddress, valSrcAddr, valDstAddr); err != nil {
 			continue
 		}
-
-		if err := k.EventService.EventManager(ctx).EmitKV(
-			types.EventTypeCompleteRedelegation,
-			event.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
-			event.NewAttribute(types.AttributeKeyDelegator, dvvTriplet.DelegatorAddress),
-			event.NewAttribute(types.AttributeKeySrcValidator, dvvTriplet.ValidatorSrcAddress),
-			event.NewAttribute(types.AttributeKeyDstValidator, dvvTriplet.ValidatorDstAddress),
-		); err != nil {
-			return nil, err
-		}
 	}
 
 	err = k.PurgeAllMaturedConsKeyRotatedKeys(ctx, time)
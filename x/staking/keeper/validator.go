@@ -93,6 +93,26 @@ func (k Keeper) SetValidatorByConsAddr(ctx context.Context, validator types.Vali
 	return k.ValidatorByConsensusAddress.Set(ctx, consPk, bz)
 }
 
+// SetValidatorByMoniker sets a validator by moniker index
+func (k Keeper) SetValidatorByMoniker(ctx context.Context, validator types.Validator) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	str, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+	return store.Set(types.GetValidatorsByMonikerKey(validator.GetMoniker(), str), str)
+}
+
+// DeleteValidatorByMoniker deletes a record by moniker index
+func (k Keeper) DeleteValidatorByMoniker(ctx context.Context, validator types.Validator) error {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	str, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+	return store.Delete(types.GetValidatorsByMonikerKey(validator.GetMoniker(), str))
+}
+
 // SetValidatorByPowerIndex sets a validator by power index
 func (k Keeper) SetValidatorByPowerIndex(ctx context.Context, validator types.Validator) error {
 	// jailed validators are not kept in the power index
@@ -240,10 +260,18 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
+	if err = k.ValidatorTruncationDust.Remove(ctx, address); err != nil {
+		return err
+	}
+
 	if err = store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx), k.validatorAddressCodec)); err != nil {
 		return err
 	}
 
+	if err = k.DeleteValidatorByMoniker(ctx, validator); err != nil {
+		return err
+	}
+
 	str, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
 	if err != nil {
 		return err
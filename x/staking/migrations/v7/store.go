@@ -0,0 +1,25 @@
+package v7
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The
+// migration includes:
+//
+// Setting the TruncationDustToCommunityPool parameter to false, so that the
+// truncation dust keeps being attributed to the validators until governance
+// decides otherwise. The dust left in the validators before the upgrade can't
+// be told apart from their tokens, it is tracked from the upgrade onwards.
+func MigrateStore(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.TruncationDustToCommunityPool = false
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,45 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/staking"
+	v7 "cosmossdk.io/x/staking/migrations/v7"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, staking.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey("staking")
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	p := types.DefaultParams()
+	p.MaxValidators = 50
+	p.TruncationDustToCommunityPool = true
+	require.NoError(t, params.Set(ctx, p))
+
+	require.NoError(t, v7.MigrateStore(ctx, params))
+
+	got, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.False(t, got.TruncationDustToCommunityPool)
+	require.Equal(t, uint32(50), got.MaxValidators)
+	require.NoError(t, got.Validate())
+}
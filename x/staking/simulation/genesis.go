@@ -20,6 +20,8 @@ const (
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
 	keyRotationFee    = "cons_pubkey_rotation_fee"
+
+	truncationDustToCommunityPool = "truncation_dust_to_community_pool"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -51,6 +53,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		histEntries       uint32
 		minCommissionRate sdkmath.LegacyDec
 		rotationFee       sdk.Coin
+		dustToPool        bool
 	)
 
 	simState.AppParams.GetOrGenerate(unbondingTime, &unbondTime, simState.Rand, func(r *rand.Rand) { unbondTime = genUnbondingTime(r) })
@@ -61,10 +64,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	simState.AppParams.GetOrGenerate(keyRotationFee, &histEntries, simState.Rand, func(r *rand.Rand) { rotationFee = getKeyRotationFee(r) })
 
+	simState.AppParams.GetOrGenerate(truncationDustToCommunityPool, &dustToPool, simState.Rand, func(r *rand.Rand) { dustToPool = r.Intn(2) == 0 })
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
 	params := types.NewParams(simState.UnbondTime, maxVals, 7, simState.BondDenom, minCommissionRate, rotationFee)
+	params.TruncationDustToCommunityPool = dustToPool
 
 	// validators & delegations
 	var (
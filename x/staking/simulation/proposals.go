@@ -42,6 +42,7 @@ func SimulateMsgUpdateParams(_ context.Context, r *rand.Rand, _ []simtypes.Accou
 	params.MaxEntries = uint32(simtypes.RandIntBetween(r, 1, 1000))
 	params.MaxValidators = uint32(simtypes.RandIntBetween(r, 1, 1000))
 	params.UnbondingTime = time.Duration(simtypes.RandTimestamp(r).UnixNano())
+	params.TruncationDustToCommunityPool = r.Intn(2) == 0
 	// changes to MinCommissionRate or BondDenom create issues for in flight messages or state operations
 
 	addr, err := addressCodec.BytesToString(authority)
@@ -97,6 +97,20 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), name)
 }
 
+// IterateVestingAccounts mocks base method.
+func (m *MockAccountKeeper) IterateVestingAccounts(ctx context.Context, cb func(types2.AccAddress) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateVestingAccounts", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateVestingAccounts indicates an expected call of IterateVestingAccounts.
+func (mr *MockAccountKeeperMockRecorder) IterateVestingAccounts(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateVestingAccounts", reflect.TypeOf((*MockAccountKeeper)(nil).IterateVestingAccounts), ctx, cb)
+}
+
 // SetModuleAccount mocks base method.
 func (m *MockAccountKeeper) SetModuleAccount(arg0 context.Context, arg1 types2.ModuleAccountI) {
 	m.ctrl.T.Helper()
@@ -714,6 +728,20 @@ func (mr *MockStakingHooksMockRecorder) BeforeDelegationSharesModified(ctx, delA
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeDelegationSharesModified", reflect.TypeOf((*MockStakingHooks)(nil).BeforeDelegationSharesModified), ctx, delAddr, valAddr)
 }
 
+// BeforeTruncationDustRouted mocks base method.
+func (m *MockStakingHooks) BeforeTruncationDustRouted(ctx context.Context, valAddr types2.ValAddress, fraction math.LegacyDec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeforeTruncationDustRouted", ctx, valAddr, fraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeforeTruncationDustRouted indicates an expected call of BeforeTruncationDustRouted.
+func (mr *MockStakingHooksMockRecorder) BeforeTruncationDustRouted(ctx, valAddr, fraction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeTruncationDustRouted", reflect.TypeOf((*MockStakingHooks)(nil).BeforeTruncationDustRouted), ctx, valAddr, fraction)
+}
+
 // BeforeValidatorModified mocks base method.
 func (m *MockStakingHooks) BeforeValidatorModified(ctx context.Context, valAddr types2.ValAddress) error {
 	m.ctrl.T.Helper()
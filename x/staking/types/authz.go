@@ -59,6 +59,16 @@ func (a StakeAuthorization) MsgTypeURL() string {
 	return authzType
 }
 
+// RemainingSpendLimit returns the tokens which can still be delegated, undelegated
+// or redelegated with the authorization, nil if it is not limited.
+func (a StakeAuthorization) RemainingSpendLimit() sdk.Coins {
+	if a.MaxTokens == nil {
+		return nil
+	}
+
+	return sdk.NewCoins(*a.MaxTokens)
+}
+
 // ValidateBasic performs a stateless validation of the fields.
 // It fails if MaxTokens is either undefined or negative or if the authorization
 // is unspecified.
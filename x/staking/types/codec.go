@@ -20,6 +20,9 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgSetUnbondingTimeOverride{}, "cosmos-sdk/MsgSetUnbondingTimeOverride")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveUnbondingTimeOverride{}, "cosmos-sdk/MsgRemoveUnbondingOverride")
+	legacy.RegisterAminoMsg(cdc, &MsgSetLiquidStaker{}, "cosmos-sdk/MsgSetLiquidStaker")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList")
@@ -38,6 +41,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgSetUnbondingTimeOverride{},
+		&MsgRemoveUnbondingTimeOverride{},
+		&MsgSetLiquidStaker{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
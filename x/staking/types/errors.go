@@ -50,4 +50,14 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 48, "delegation exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 49, "delegation exceeds the validator liquid staking cap")
+	ErrInsufficientValidatorBondShares   = errors.Register(ModuleName, 50, "insufficient validator bond shares")
+	ErrLiquidStakerHasDelegations        = errors.Register(ModuleName, 53, "liquid staker registration cannot change while the account has delegations")
+
+	// unbonding time override errors
+	ErrInvalidUnbondingTimeOverride  = errors.Register(ModuleName, 51, "invalid unbonding time override")
+	ErrUnbondingTimeOverrideNotFound = errors.Register(ModuleName, 52, "unbonding time override not found")
 )
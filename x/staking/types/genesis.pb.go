@@ -53,6 +53,17 @@ type GenesisState struct {
 	RotationIndexRecords []RotationIndexRecord       `protobuf:"bytes,9,rep,name=rotation_index_records,json=rotationIndexRecords,proto3" json:"rotation_index_records"`
 	RotationHistory      []ConsPubKeyRotationHistory `protobuf:"bytes,10,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 	RotationQueue        []RotationQueueRecord       `protobuf:"bytes,11,rep,name=rotation_queue,json=rotationQueue,proto3" json:"rotation_queue"`
+	// truncation_dust defines the truncation dust tracked for each validator.
+	TruncationDust []ValidatorTruncationDust `protobuf:"bytes,12,rep,name=truncation_dust,json=truncationDust,proto3" json:"truncation_dust"`
+	// total_liquid_staked_tokens defines the tokens delegated by liquid staking
+	// providers.
+	TotalLiquidStakedTokens cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"total_liquid_staked_tokens"`
+	// unbonding_time_overrides defines the unbonding time overrides applying to
+	// given delegators or account types.
+	UnbondingTimeOverrides []UnbondingTimeOverride `protobuf:"bytes,14,rep,name=unbonding_time_overrides,json=unbondingTimeOverrides,proto3" json:"unbonding_time_overrides"`
+	// liquid_stakers defines the addresses of the accounts registered as liquid
+	// staking providers.
+	LiquidStakers []string `protobuf:"bytes,15,rep,name=liquid_stakers,json=liquidStakers,proto3" json:"liquid_stakers,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,6 +169,77 @@ func (m *GenesisState) GetRotationQueue() []RotationQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetTruncationDust() []ValidatorTruncationDust {
+	if m != nil {
+		return m.TruncationDust
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingTimeOverrides() []UnbondingTimeOverride {
+	if m != nil {
+		return m.UnbondingTimeOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidStakers() []string {
+	if m != nil {
+		return m.LiquidStakers
+	}
+	return nil
+}
+
+// ValidatorTruncationDust defines the truncation dust of a validator, i.e. the
+// fractions of tokens left in the validator by the truncation of the tokens
+// returned to unbonding delegators.
+type ValidatorTruncationDust struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// dust is the amount of tokens of dust not yet routed to the community pool.
+	Dust cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=dust,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dust"`
+}
+
+func (m *ValidatorTruncationDust) Reset()         { *m = ValidatorTruncationDust{} }
+func (m *ValidatorTruncationDust) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTruncationDust) ProtoMessage()    {}
+func (*ValidatorTruncationDust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{1}
+}
+func (m *ValidatorTruncationDust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTruncationDust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTruncationDust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorTruncationDust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTruncationDust.Merge(m, src)
+}
+func (m *ValidatorTruncationDust) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTruncationDust) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTruncationDust.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTruncationDust proto.InternalMessageInfo
+
+func (m *ValidatorTruncationDust) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -170,7 +252,7 @@ func (m *LastValidatorPower) Reset()         { *m = LastValidatorPower{} }
 func (m *LastValidatorPower) String() string { return proto.CompactTextString(m) }
 func (*LastValidatorPower) ProtoMessage()    {}
 func (*LastValidatorPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b3dec8894f2831b, []int{1}
+	return fileDescriptor_9b3dec8894f2831b, []int{2}
 }
 func (m *LastValidatorPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +290,7 @@ func (m *RotationIndexRecord) Reset()         { *m = RotationIndexRecord{} }
 func (m *RotationIndexRecord) String() string { return proto.CompactTextString(m) }
 func (*RotationIndexRecord) ProtoMessage()    {}
 func (*RotationIndexRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b3dec8894f2831b, []int{2}
+	return fileDescriptor_9b3dec8894f2831b, []int{3}
 }
 func (m *RotationIndexRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +328,7 @@ func (m *RotationQueueRecord) Reset()         { *m = RotationQueueRecord{} }
 func (m *RotationQueueRecord) String() string { return proto.CompactTextString(m) }
 func (*RotationQueueRecord) ProtoMessage()    {}
 func (*RotationQueueRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b3dec8894f2831b, []int{3}
+	return fileDescriptor_9b3dec8894f2831b, []int{4}
 }
 func (m *RotationQueueRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,6 +373,7 @@ func (m *RotationQueueRecord) GetTime() *time.Time {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
+	proto.RegisterType((*ValidatorTruncationDust)(nil), "cosmos.staking.v1beta1.ValidatorTruncationDust")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
 	proto.RegisterType((*RotationIndexRecord)(nil), "cosmos.staking.v1beta1.RotationIndexRecord")
 	proto.RegisterType((*RotationQueueRecord)(nil), "cosmos.staking.v1beta1.RotationQueueRecord")
@@ -301,51 +384,65 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xfc, 0x29, 0xed, 0x14, 0xf8, 0xf1, 0x1b, 0x0a, 0x59, 0x1b, 0xd3, 0xd6, 0x86,
-	0x43, 0x83, 0x61, 0xd7, 0xa2, 0xf1, 0xe0, 0x8d, 0x6a, 0xa2, 0x04, 0x22, 0xb8, 0x80, 0x07, 0x12,
-	0xb3, 0x99, 0xb2, 0xc3, 0xb2, 0x61, 0xbb, 0x53, 0x67, 0xa6, 0x95, 0xbe, 0x03, 0x8f, 0xdc, 0xbd,
-	0x70, 0xf4, 0xe8, 0x81, 0x17, 0xc1, 0xc5, 0x84, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x41, 0x5f, 0x86,
-	0xd9, 0x99, 0xdd, 0x65, 0x9a, 0xb2, 0x44, 0x2f, 0x4d, 0xa7, 0xcf, 0xf7, 0xfb, 0xf9, 0x3e, 0xcf,
-	0x76, 0xe7, 0x01, 0x0b, 0x7b, 0x84, 0xb5, 0x09, 0x33, 0x19, 0x47, 0x87, 0x5e, 0xe0, 0x9a, 0xbd,
-	0x46, 0x0b, 0x73, 0xd4, 0x30, 0x5d, 0x1c, 0x60, 0xe6, 0x31, 0xa3, 0x43, 0x09, 0x27, 0x70, 0x5e,
-	0xaa, 0x8c, 0x48, 0x65, 0x44, 0xaa, 0x52, 0xd1, 0x25, 0x2e, 0x11, 0x12, 0x33, 0xfc, 0x26, 0xd5,
-	0xa5, 0x34, 0x66, 0xec, 0x96, 0xaa, 0x7b, 0x52, 0x65, 0x4b, 0x7b, 0x14, 0x20, 0x4b, 0xff, 0xa3,
-	0xb6, 0x17, 0x10, 0x53, 0x7c, 0x46, 0x3f, 0x55, 0x5c, 0x42, 0x5c, 0x1f, 0x9b, 0xe2, 0xd4, 0xea,
-	0xee, 0x9b, 0xdc, 0x6b, 0x63, 0xc6, 0x51, 0xbb, 0x23, 0x05, 0xb5, 0xaf, 0x13, 0x60, 0xf2, 0xa5,
-	0x6c, 0x7a, 0x8b, 0x23, 0x8e, 0xe1, 0x0a, 0xc8, 0x76, 0x10, 0x45, 0x6d, 0xa6, 0x6b, 0x55, 0xad,
-	0x5e, 0x58, 0x2e, 0x1b, 0xb7, 0x0f, 0x61, 0x6c, 0x0a, 0x55, 0x33, 0x7f, 0x76, 0x59, 0xc9, 0x7c,
-	0xfe, 0xf5, 0x65, 0x51, 0xb3, 0x22, 0x23, 0xdc, 0x05, 0x33, 0x3e, 0x62, 0xdc, 0xe6, 0x84, 0x23,
-	0xdf, 0xee, 0x90, 0x0f, 0x98, 0xea, 0x23, 0x55, 0xad, 0x3e, 0xd9, 0x7c, 0x14, 0x8a, 0xbf, 0x5f,
-	0x56, 0xe6, 0x24, 0x93, 0x39, 0x87, 0x86, 0x47, 0xcc, 0x36, 0xe2, 0x07, 0xc6, 0x6a, 0xc0, 0x2f,
-	0x4e, 0x97, 0x40, 0x14, 0xb6, 0x1a, 0x70, 0xc9, 0x9c, 0x0e, 0x49, 0xdb, 0x21, 0x68, 0x33, 0xe4,
-	0x40, 0x0f, 0xcc, 0x09, 0x76, 0x0f, 0xf9, 0x9e, 0x83, 0x38, 0xa1, 0x92, 0xcf, 0xf4, 0xd1, 0xea,
-	0x68, 0xbd, 0xb0, 0xbc, 0x98, 0xd6, 0xed, 0x3a, 0x62, 0xfc, 0x6d, 0xec, 0x11, 0x28, 0xb5, 0xf3,
-	0x59, 0x7f, 0xa8, 0xcc, 0xe0, 0x3a, 0x00, 0x49, 0x0a, 0xd3, 0xc7, 0x04, 0xff, 0x41, 0x1a, 0x3f,
-	0x31, 0xab, 0x58, 0xc5, 0x0f, 0x37, 0x40, 0xc1, 0xc1, 0x3e, 0x76, 0x11, 0xf7, 0x48, 0xc0, 0xf4,
-	0x71, 0x81, 0xab, 0xa5, 0xe1, 0x5e, 0x24, 0x52, 0x95, 0xa7, 0x12, 0xe0, 0x21, 0x98, 0xeb, 0x06,
-	0x2d, 0x12, 0x38, 0x5e, 0xe0, 0xda, 0x2a, 0x3a, 0x2b, 0xd0, 0x0f, 0xd3, 0xd0, 0x3b, 0xb1, 0xe9,
-	0xf6, 0x8c, 0x62, 0x77, 0xb8, 0xce, 0xe0, 0x0e, 0x98, 0xa2, 0x58, 0x0d, 0x99, 0x10, 0x21, 0x0b,
-	0x69, 0x21, 0x96, 0x22, 0x56, 0xe9, 0x83, 0x14, 0x58, 0x02, 0x39, 0x7c, 0xd4, 0x21, 0x94, 0x63,
-	0x47, 0xcf, 0x55, 0xb5, 0x7a, 0xce, 0x4a, 0xce, 0xd0, 0x07, 0xf3, 0x94, 0x70, 0x21, 0xb4, 0xbd,
-	0xc0, 0xc1, 0x47, 0x36, 0xc5, 0x7b, 0x84, 0x3a, 0x4c, 0xcf, 0xdf, 0x3d, 0xa0, 0x15, 0xb9, 0x56,
-	0x43, 0x93, 0x25, 0x3c, 0x03, 0x03, 0xd2, 0xe1, 0x3a, 0x83, 0x2e, 0x98, 0x49, 0xd2, 0x0e, 0x3c,
-	0xc6, 0x09, 0xed, 0xeb, 0x40, 0xe4, 0x34, 0xd2, 0x72, 0x9e, 0x93, 0x80, 0x6d, 0x76, 0x5b, 0x6b,
-	0xb8, 0x1f, 0x27, 0xbe, 0x92, 0x46, 0x35, 0xed, 0x3f, 0x3a, 0x58, 0x83, 0xef, 0xc0, 0x74, 0x12,
-	0xf4, 0xbe, 0x8b, 0xbb, 0x58, 0x2f, 0xfc, 0xdd, 0x38, 0x6f, 0x42, 0xf1, 0xf0, 0x38, 0x53, 0x54,
-	0xad, 0xd7, 0x0e, 0x00, 0x1c, 0x7e, 0xd5, 0xe1, 0x32, 0x98, 0x40, 0x8e, 0x43, 0x31, 0x93, 0xb7,
-	0x3a, 0xdf, 0xd4, 0x2f, 0x4e, 0x97, 0x8a, 0x51, 0xe0, 0x8a, 0xac, 0x6c, 0x71, 0xea, 0x05, 0xae,
-	0x15, 0x0b, 0x61, 0x11, 0x8c, 0xdf, 0x5c, 0xdd, 0x51, 0x4b, 0x1e, 0x9e, 0xe5, 0x3e, 0x9e, 0x54,
-	0x32, 0xbf, 0x4f, 0x2a, 0x99, 0x1a, 0x01, 0xb3, 0xb7, 0x3c, 0x69, 0xa8, 0x0f, 0x46, 0x4d, 0xde,
-	0x00, 0x9f, 0x80, 0xb1, 0x70, 0xfb, 0xe8, 0x59, 0xb1, 0x57, 0x4a, 0x86, 0x5c, 0x4d, 0x46, 0xbc,
-	0x9a, 0x8c, 0xed, 0x78, 0x35, 0x35, 0xc7, 0x8e, 0x7f, 0x54, 0x34, 0x4b, 0xa8, 0x95, 0xc0, 0x4f,
-	0xda, 0x4d, 0xa2, 0xf2, 0x30, 0xe0, 0x6b, 0x90, 0xef, 0x21, 0xdf, 0x0e, 0x63, 0xe2, 0xa5, 0xd5,
-	0xb8, 0xe3, 0x9a, 0x86, 0xe3, 0xb2, 0x8d, 0x7d, 0x41, 0xc2, 0x4e, 0xf8, 0x27, 0xae, 0xe1, 0x3e,
-	0xb3, 0x72, 0xbd, 0xa8, 0x94, 0xf4, 0x39, 0xf2, 0x2f, 0x7d, 0x36, 0x9f, 0x9e, 0x5d, 0x95, 0xb5,
-	0xf3, 0xab, 0xb2, 0xf6, 0xf3, 0xaa, 0xac, 0x1d, 0x5f, 0x97, 0x33, 0xe7, 0xd7, 0xe5, 0xcc, 0xb7,
-	0xeb, 0x72, 0x66, 0xf7, 0xfe, 0xc0, 0xb2, 0x3b, 0x4a, 0xf6, 0x3b, 0xef, 0x77, 0x30, 0x6b, 0x65,
-	0x05, 0xf7, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7b, 0xa0, 0x65, 0x52, 0x06, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x6f, 0xdb, 0x36,
+	0x18, 0xc6, 0xad, 0x24, 0x4d, 0x6d, 0x3a, 0xff, 0xca, 0x3a, 0x29, 0xe7, 0xad, 0xb6, 0x6b, 0xf4,
+	0x60, 0x74, 0xb3, 0x14, 0x67, 0x43, 0x0f, 0xbd, 0x0c, 0xf5, 0x02, 0x6c, 0x59, 0x83, 0x26, 0x53,
+	0xdc, 0x1d, 0x0a, 0x0c, 0x02, 0x6d, 0xb1, 0x8a, 0x10, 0x59, 0x74, 0x49, 0xca, 0x8d, 0x81, 0x9d,
+	0x87, 0x1d, 0x7b, 0xdf, 0xa5, 0xd8, 0x69, 0xc7, 0x1d, 0xf2, 0x21, 0x0a, 0xec, 0x52, 0xe4, 0x34,
+	0xec, 0xd0, 0x0d, 0xc9, 0x61, 0xfb, 0x18, 0x83, 0x48, 0x49, 0x96, 0x67, 0xa9, 0x59, 0x2f, 0x86,
+	0x25, 0x3e, 0xcf, 0xef, 0xe1, 0x4b, 0xea, 0x25, 0xc1, 0xdd, 0x01, 0xe5, 0x43, 0xca, 0x0d, 0x2e,
+	0xf0, 0x89, 0xeb, 0x3b, 0xc6, 0xb8, 0xd3, 0x27, 0x02, 0x77, 0x0c, 0x87, 0xf8, 0x84, 0xbb, 0x5c,
+	0x1f, 0x31, 0x2a, 0x28, 0xdc, 0x52, 0x2a, 0x3d, 0x52, 0xe9, 0x91, 0xaa, 0x5a, 0x71, 0xa8, 0x43,
+	0xa5, 0xc4, 0x08, 0xff, 0x29, 0x75, 0x35, 0x8f, 0x19, 0xbb, 0x95, 0xea, 0x03, 0xa5, 0xb2, 0x94,
+	0x3d, 0x0a, 0x50, 0x43, 0x37, 0xf0, 0xd0, 0xf5, 0xa9, 0x21, 0x7f, 0xa3, 0x57, 0x75, 0x87, 0x52,
+	0xc7, 0x23, 0x86, 0x7c, 0xea, 0x07, 0xcf, 0x0c, 0xe1, 0x0e, 0x09, 0x17, 0x78, 0x38, 0x52, 0x82,
+	0xe6, 0xcf, 0x65, 0xb0, 0xf2, 0xa5, 0x9a, 0xf4, 0x91, 0xc0, 0x82, 0xc0, 0x87, 0x60, 0x79, 0x84,
+	0x19, 0x1e, 0x72, 0xa4, 0x35, 0xb4, 0x56, 0x79, 0xa7, 0xa6, 0x67, 0x17, 0xa1, 0x1f, 0x4a, 0x55,
+	0xb7, 0xf4, 0xfa, 0x6d, 0xbd, 0xf0, 0xcb, 0xdf, 0xbf, 0xde, 0xd3, 0xcc, 0xc8, 0x08, 0x9f, 0x82,
+	0x0d, 0x0f, 0x73, 0x61, 0x09, 0x2a, 0xb0, 0x67, 0x8d, 0xe8, 0x0b, 0xc2, 0xd0, 0x42, 0x43, 0x6b,
+	0xad, 0x74, 0xb7, 0x43, 0xf1, 0x1f, 0x6f, 0xeb, 0x9b, 0x8a, 0xc9, 0xed, 0x13, 0xdd, 0xa5, 0xc6,
+	0x10, 0x8b, 0x63, 0x7d, 0xcf, 0x17, 0xe7, 0x67, 0x6d, 0x10, 0x85, 0xed, 0xf9, 0x42, 0x31, 0xd7,
+	0x42, 0x52, 0x2f, 0x04, 0x1d, 0x86, 0x1c, 0xe8, 0x82, 0x4d, 0xc9, 0x1e, 0x63, 0xcf, 0xb5, 0xb1,
+	0xa0, 0x4c, 0xf1, 0x39, 0x5a, 0x6c, 0x2c, 0xb6, 0xca, 0x3b, 0xf7, 0xf2, 0x66, 0xbb, 0x8f, 0xb9,
+	0xf8, 0x36, 0xf6, 0x48, 0x54, 0x7a, 0xe6, 0x37, 0xbd, 0xb9, 0x61, 0x0e, 0xf7, 0x01, 0x48, 0x52,
+	0x38, 0x5a, 0x92, 0xfc, 0x3b, 0x79, 0xfc, 0xc4, 0x9c, 0xc6, 0xa6, 0xfc, 0xf0, 0x00, 0x94, 0x6d,
+	0xe2, 0x11, 0x07, 0x0b, 0x97, 0xfa, 0x1c, 0x5d, 0x93, 0xb8, 0x66, 0x1e, 0x6e, 0x37, 0x91, 0xa6,
+	0x79, 0x69, 0x02, 0x3c, 0x01, 0x9b, 0x81, 0xdf, 0xa7, 0xbe, 0xed, 0xfa, 0x8e, 0x95, 0x46, 0x2f,
+	0x4b, 0xf4, 0xc7, 0x79, 0xe8, 0x27, 0xb1, 0x29, 0x3b, 0xa3, 0x12, 0xcc, 0x8f, 0x73, 0xf8, 0x04,
+	0xac, 0x32, 0x92, 0x0e, 0xb9, 0x2e, 0x43, 0xee, 0xe6, 0x85, 0x98, 0xc4, 0xce, 0xa4, 0xcf, 0x52,
+	0x60, 0x15, 0x14, 0xc9, 0xe9, 0x88, 0x32, 0x41, 0x6c, 0x54, 0x6c, 0x68, 0xad, 0xa2, 0x99, 0x3c,
+	0x43, 0x0f, 0x6c, 0x31, 0x2a, 0xa4, 0xd0, 0x72, 0x7d, 0x9b, 0x9c, 0x5a, 0x8c, 0x0c, 0x28, 0xb3,
+	0x39, 0x2a, 0xbd, 0xbb, 0x40, 0x33, 0x72, 0xed, 0x85, 0x26, 0x53, 0x7a, 0x66, 0x0a, 0x64, 0xf3,
+	0xe3, 0x1c, 0x3a, 0x60, 0x23, 0x49, 0x3b, 0x76, 0xb9, 0xa0, 0x6c, 0x82, 0x80, 0xcc, 0xe9, 0xe4,
+	0xe5, 0x7c, 0x41, 0x7d, 0x7e, 0x18, 0xf4, 0x1f, 0x91, 0x49, 0x9c, 0xf8, 0x95, 0x32, 0xa6, 0xd3,
+	0xd6, 0xd9, 0xec, 0x18, 0xfc, 0x0e, 0xac, 0x25, 0x41, 0xcf, 0x03, 0x12, 0x10, 0x54, 0xfe, 0x7f,
+	0xe5, 0x7c, 0x13, 0x8a, 0xe7, 0xcb, 0x59, 0x65, 0xe9, 0x71, 0xf8, 0x02, 0xac, 0x0b, 0x16, 0xf8,
+	0x03, 0x15, 0x60, 0x07, 0x5c, 0xa0, 0x15, 0xc9, 0x37, 0xae, 0xfc, 0x72, 0x7b, 0x89, 0x6f, 0x37,
+	0xe0, 0xa2, 0x7b, 0x5b, 0xf6, 0xea, 0x59, 0x7b, 0xe3, 0x34, 0x3e, 0x81, 0x1a, 0xe3, 0x8e, 0xbe,
+	0xad, 0x6f, 0x47, 0x8d, 0x29, 0x66, 0xe4, 0xf0, 0x7b, 0x50, 0x55, 0xfd, 0xee, 0xb9, 0xcf, 0x03,
+	0xd7, 0xb6, 0x42, 0x0f, 0xb1, 0x2d, 0x41, 0x4f, 0x88, 0xcf, 0xd1, 0x6a, 0x43, 0x6b, 0x95, 0xba,
+	0x9f, 0xbf, 0x47, 0xfb, 0x67, 0x25, 0x9b, 0xb7, 0x64, 0xc4, 0xbe, 0x4c, 0x38, 0x92, 0x01, 0x3d,
+	0xc9, 0x87, 0x3f, 0x68, 0x00, 0x4d, 0xbb, 0x21, 0x3c, 0xe4, 0x2c, 0x3a, 0x26, 0x8c, 0xb9, 0x36,
+	0xe1, 0x68, 0x4d, 0x2e, 0x40, 0xfb, 0xca, 0x86, 0xe8, 0xb9, 0x43, 0x72, 0x10, 0xb9, 0xae, 0x2a,
+	0x7f, 0x2b, 0xc8, 0x72, 0x71, 0x78, 0x04, 0xd6, 0xd2, 0x0b, 0xc0, 0x38, 0x5a, 0x6f, 0x2c, 0xb6,
+	0x4a, 0xdd, 0x4f, 0xce, 0xcf, 0xda, 0x95, 0x68, 0x02, 0x0f, 0x6d, 0x9b, 0x11, 0xce, 0x8f, 0x04,
+	0x73, 0x7d, 0x27, 0xb3, 0xce, 0x55, 0x6f, 0x5a, 0x22, 0xe3, 0xcd, 0xdf, 0x34, 0x70, 0x2b, 0x67,
+	0x9b, 0xe0, 0x63, 0x70, 0x63, 0x7a, 0x16, 0x62, 0x45, 0x96, 0x47, 0x77, 0xa9, 0x7b, 0xe7, 0xfc,
+	0xac, 0x7d, 0x3b, 0xca, 0x4c, 0xec, 0x33, 0xe1, 0xe6, 0xc6, 0xf8, 0x3f, 0xef, 0xe1, 0xd7, 0x60,
+	0x49, 0x7e, 0x35, 0x0b, 0x12, 0x71, 0x3f, 0xda, 0xb1, 0x0f, 0xe7, 0x77, 0x6c, 0x9f, 0x38, 0x78,
+	0x30, 0xd9, 0x25, 0x83, 0xd4, 0xbe, 0xed, 0x92, 0x81, 0x5a, 0x1e, 0xc9, 0x78, 0x50, 0x39, 0xcf,
+	0x28, 0xae, 0x79, 0x0c, 0xe0, 0xfc, 0x69, 0x0c, 0x77, 0xc0, 0xf5, 0xd9, 0xd9, 0xa3, 0xbc, 0x15,
+	0x33, 0x63, 0x21, 0xac, 0x80, 0x6b, 0xd3, 0xdb, 0x65, 0xd1, 0x54, 0x0f, 0x0f, 0x8a, 0x3f, 0xbe,
+	0xaa, 0x17, 0xfe, 0x79, 0x55, 0x2f, 0x34, 0x29, 0xb8, 0x99, 0x71, 0x18, 0x40, 0x34, 0x1b, 0xb5,
+	0x32, 0x05, 0x7e, 0x06, 0x96, 0xc2, 0x6f, 0x07, 0x2d, 0xcb, 0xab, 0xaf, 0xaa, 0xab, 0xdb, 0x53,
+	0x8f, 0x6f, 0x4f, 0xbd, 0x17, 0xdf, 0x9e, 0xdd, 0xa5, 0x97, 0x7f, 0xd6, 0x35, 0x53, 0xaa, 0x53,
+	0x81, 0x3f, 0x69, 0xd3, 0xc4, 0x54, 0xbf, 0xc2, 0xc7, 0xa0, 0x34, 0xc6, 0x9e, 0xdc, 0x9e, 0xf8,
+	0x5e, 0xed, 0xbc, 0xa3, 0x1f, 0xc3, 0x72, 0xf9, 0xc1, 0x33, 0x49, 0x22, 0x76, 0x78, 0xce, 0x3c,
+	0x22, 0x13, 0x6e, 0x16, 0xc7, 0xd1, 0x50, 0x32, 0xcf, 0x85, 0xf7, 0x99, 0x67, 0xf7, 0xfe, 0xeb,
+	0x8b, 0x9a, 0xf6, 0xe6, 0xa2, 0xa6, 0xfd, 0x75, 0x51, 0xd3, 0x5e, 0x5e, 0xd6, 0x0a, 0x6f, 0x2e,
+	0x6b, 0x85, 0xdf, 0x2f, 0x6b, 0x85, 0xa7, 0x1f, 0xcd, 0x6c, 0x6f, 0xb2, 0x63, 0x86, 0x98, 0x8c,
+	0x08, 0xef, 0x2f, 0x4b, 0xee, 0xa7, 0xff, 0x0e, 0x00, 0x50, 0x0b, 0x20, 0x80, 0xf5, 0x08, 0x00,
 	0x00,
 }
 
@@ -369,6 +466,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LiquidStakers) > 0 {
+		for iNdEx := len(m.LiquidStakers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LiquidStakers[iNdEx])
+			copy(dAtA[i:], m.LiquidStakers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LiquidStakers[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.UnbondingTimeOverrides) > 0 {
+		for iNdEx := len(m.UnbondingTimeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTimeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.TruncationDust) > 0 {
+		for iNdEx := len(m.TruncationDust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TruncationDust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RotationQueue) > 0 {
 		for iNdEx := len(m.RotationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +658,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorTruncationDust) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorTruncationDust) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorTruncationDust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Dust.Size()
+		i -= size
+		if _, err := m.Dust.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LastValidatorPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -706,6 +890,41 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TruncationDust) > 0 {
+		for _, e := range m.TruncationDust {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UnbondingTimeOverrides) > 0 {
+		for _, e := range m.UnbondingTimeOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidStakers) > 0 {
+		for _, s := range m.LiquidStakers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorTruncationDust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Dust.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1152,6 +1371,256 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncationDust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TruncationDust = append(m.TruncationDust, ValidatorTruncationDust{})
+			if err := m.TruncationDust[len(m.TruncationDust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTimeOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTimeOverrides = append(m.UnbondingTimeOverrides, UnbondingTimeOverride{})
+			if err := m.UnbondingTimeOverrides[len(m.UnbondingTimeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidStakers = append(m.LiquidStakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorTruncationDust) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorTruncationDust: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorTruncationDust: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
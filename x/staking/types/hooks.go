@@ -109,6 +109,15 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx context.Context, valAddr s
 	return nil
 }
 
+func (h MultiStakingHooks) BeforeTruncationDustRouted(ctx context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) error {
+	for i := range h {
+		if err := h[i].BeforeTruncationDustRouted(ctx, valAddr, fraction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiStakingHooks) AfterUnbondingInitiated(ctx context.Context, id uint64) error {
 	for i := range h {
 		if err := h[i].AfterUnbondingInitiated(ctx, id); err != nil {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"strings"
 
 	"cosmossdk.io/collections"
 	addresscodec "cosmossdk.io/core/address"
@@ -40,6 +41,7 @@ var (
 	ValidatorsKey             = collections.NewPrefix(33) // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = collections.NewPrefix(34) // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23}              // prefix for each key to a validator index, sorted by power
+	ValidatorsByMonikerKey    = []byte{0x70}              // prefix for each key to a validator index, sorted by moniker
 
 	DelegationKey                    = collections.NewPrefix(49) // key for a delegation
 	UnbondingDelegationKey           = collections.NewPrefix(50) // key for an unbonding-delegation
@@ -67,6 +69,17 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	ValidatorTruncationDustKey = collections.NewPrefix(107) // prefix for the truncation dust tracked for each validator
+
+	TotalLiquidStakedTokensKey = collections.NewPrefix(108) // key for the total tokens delegated by liquid staking providers
+	LiquidStakersKey           = collections.NewPrefix(115) // prefix for the accounts registered as liquid staking providers
+
+	HistoricalTimeKey         = collections.NewPrefix(109) // prefix for the block time of each historical validator set, by height
+	HistoricalHeightByTimeKey = collections.NewPrefix(110) // prefix for the height of each historical validator set, by block time
+	HistoricalValidatorsKey   = collections.NewPrefix(111) // prefix for the validators of each historical validator set
+
+	UnbondingTimeOverridesKey = collections.NewPrefix(114) // prefix for the unbonding time overrides, by delegator address or account type
 )
 
 // Reserved kvstore keys
@@ -102,6 +115,21 @@ func AddressFromLastValidatorPowerKey(key []byte) []byte {
 	return key[2:] // remove prefix bytes and address length
 }
 
+// GetValidatorsByMonikerKey creates the validator by moniker index key.
+// The moniker is lowercased so that lookups by moniker prefix are case-insensitive.
+func GetValidatorsByMonikerKey(moniker string, operAddr sdk.ValAddress) []byte {
+	// key is of format prefix || lowercase moniker || 0x00 || addrBytes
+	return append(append(GetValidatorsByMonikerPrefix(moniker), 0x00), operAddr...)
+}
+
+// GetValidatorsByMonikerPrefix creates the prefix of the validator by moniker
+// index keys for the monikers starting with the given prefix.
+func GetValidatorsByMonikerPrefix(monikerPrefix string) []byte {
+	// null bytes are reserved for separating the moniker from the address
+	monikerPrefix = strings.ReplaceAll(strings.ToLower(monikerPrefix), "\x00", "")
+	return append(sdk.CopyBytes(ValidatorsByMonikerKey), monikerPrefix...)
+}
+
 // GetValidatorsByPowerIndexKey creates the validator by power index.
 // Power index is the key used in the power-store, and represents the relative
 // power ranking of the validator.
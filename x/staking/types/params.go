@@ -32,6 +32,15 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 0, disabling the cap
+	DefaultGlobalLiquidStakingCap = math.LegacyZeroDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 0, disabling the cap
+	DefaultValidatorLiquidStakingCap = math.LegacyZeroDec()
+
+	// DefaultValidatorBondFactor is set to 0, disabling the limit
+	DefaultValidatorBondFactor = math.LegacyZeroDec()
 )
 
 // NewParams creates a new Params instance
@@ -48,6 +57,10 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+		ValidatorBondFactor:       DefaultValidatorBondFactor,
 	}
 }
 
@@ -113,6 +126,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid global liquid staking cap: %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid validator liquid staking cap: %w", err)
+	}
+
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -228,3 +253,39 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+// validateLiquidStakingCap validates a liquid staking cap, a nil cap is
+// disabled, as the zero cap.
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+// validateValidatorBondFactor validates the validator bond factor, a nil
+// factor is disabled, as the zero factor.
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("validator bond factor cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -46,4 +46,22 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// validate liquid staking caps and validator bond factor
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	params.ValidatorBondFactor = math.LegacyNewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDec(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorBondFactor = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
 }
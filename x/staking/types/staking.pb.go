@@ -270,6 +270,12 @@ type Description struct {
 	SecurityContact string `protobuf:"bytes,4,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
 	// details define other optional details.
 	Details string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	// website_proof defines an optional https URL, hosted on the validator's
+	// website, serving a proof of ownership of the validator operator address.
+	WebsiteProof string `protobuf:"bytes,6,opt,name=website_proof,json=websiteProof,proto3" json:"website_proof,omitempty"`
+	// pgp_proof defines an optional ASCII-armored PGP (ex. Keybase) signature of
+	// the validator operator address.
+	PgpProof string `protobuf:"bytes,7,opt,name=pgp_proof,json=pgpProof,proto3" json:"pgp_proof,omitempty"`
 }
 
 func (m *Description) Reset()         { *m = Description{} }
@@ -340,6 +346,20 @@ func (m *Description) GetDetails() string {
 	return ""
 }
 
+func (m *Description) GetWebsiteProof() string {
+	if m != nil {
+		return m.WebsiteProof
+	}
+	return ""
+}
+
+func (m *Description) GetPgpProof() string {
+	if m != nil {
+		return m.PgpProof
+	}
+	return ""
+}
+
 // Validator defines a validator, together with the total amount of the
 // Validator's bond shares and their exchange rate to coins. Slashing results in
 // a decrease in the exchange rate, allowing correct calculation of future
@@ -375,6 +395,9 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// list of unbonding ids, each uniquely identifying an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// liquid_shares defines the shares of the validator delegated by liquid staking
+	// providers.
+	LiquidShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=liquid_shares,json=liquidShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquid_shares"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -926,8 +949,9 @@ type Params struct {
 	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
 	// max_entries is the max entries for either unbonding delegation or redelegation (per pair/trio).
 	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
-	// historical_entries is the number of historical entries to persist.
-	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"` // Deprecated: Do not use.
+	// historical_entries is the number of historical validator sets to persist,
+	// the historical validator sets are not tracked if it is 0.
+	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
@@ -935,6 +959,22 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// truncation_dust_to_community_pool defines whether the truncation dust left
+	// in validators when unbonding is routed to the community pool once it adds
+	// up to whole tokens. Otherwise the dust is attributed to the validator, i.e.
+	// shared by its remaining delegators.
+	TruncationDustToCommunityPool bool `protobuf:"varint,8,opt,name=truncation_dust_to_community_pool,json=truncationDustToCommunityPool,proto3" json:"truncation_dust_to_community_pool,omitempty"`
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens which
+	// can be delegated by liquid staking providers, zero disables the cap.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the shares of a
+	// validator which can be delegated by liquid staking providers, zero disables
+	// the cap.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// validator_bond_factor is the maximum ratio of the liquid shares of a
+	// validator to the shares self-delegated by its operator, zero disables the
+	// limit.
+	ValidatorBondFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -991,7 +1031,6 @@ func (m *Params) GetMaxEntries() uint32 {
 	return 0
 }
 
-// Deprecated: Do not use.
 func (m *Params) GetHistoricalEntries() uint32 {
 	if m != nil {
 		return m.HistoricalEntries
@@ -1013,6 +1052,13 @@ func (m *Params) GetKeyRotationFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetTruncationDustToCommunityPool() bool {
+	if m != nil {
+		return m.TruncationDustToCommunityPool
+	}
+	return false
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1351,6 +1397,76 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// UnbondingTimeOverride defines an unbonding time applying to the
+// undelegations of a given delegator, or of the delegators of a given account
+// type, instead of the unbonding_time param. Exactly one of address or
+// account_type must be set.
+type UnbondingTimeOverride struct {
+	// address is the address of the delegator the override applies to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// account_type is the type URL of the accounts the override applies to, e.g.
+	// "/cosmos.auth.v1beta1.ModuleAccount". An override for a delegator address
+	// takes precedence over an override for its account type.
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// unbonding_time is the time duration of the undelegations the override
+	// applies to.
+	UnbondingTime time.Duration `protobuf:"bytes,3,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time"`
+}
+
+func (m *UnbondingTimeOverride) Reset()         { *m = UnbondingTimeOverride{} }
+func (m *UnbondingTimeOverride) String() string { return proto.CompactTextString(m) }
+func (*UnbondingTimeOverride) ProtoMessage()    {}
+func (*UnbondingTimeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *UnbondingTimeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingTimeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingTimeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingTimeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingTimeOverride.Merge(m, src)
+}
+func (m *UnbondingTimeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingTimeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingTimeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingTimeOverride proto.InternalMessageInfo
+
+func (m *UnbondingTimeOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UnbondingTimeOverride) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+func (m *UnbondingTimeOverride) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1377,6 +1493,7 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*UnbondingTimeOverride)(nil), "cosmos.staking.v1beta1.UnbondingTimeOverride")
 }
 
 func init() {
@@ -1384,137 +1501,152 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xd6, 0x92, 0x0c, 0x25, 0xfd, 0x94, 0x44, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x92, 0x19, 0xb7,
-	0x91, 0xdd, 0x9a, 0x8a, 0xdc, 0xc2, 0x05, 0x84, 0x20, 0x85, 0x29, 0xd2, 0x31, 0xf3, 0x90, 0xd4,
-	0xa5, 0xa4, 0x3e, 0xd0, 0x66, 0x31, 0xdc, 0x1d, 0x52, 0x5b, 0x91, 0xb3, 0xec, 0xce, 0x50, 0x36,
-	0xef, 0x3d, 0x04, 0x2e, 0x0a, 0xe4, 0x54, 0x04, 0x28, 0x8c, 0x1a, 0xe8, 0x25, 0xbd, 0xe5, 0x60,
-	0xf4, 0xde, 0x5b, 0x5a, 0xa0, 0x80, 0xe1, 0x53, 0x11, 0xa0, 0x6e, 0x61, 0x1f, 0x12, 0x34, 0x97,
-	0xa2, 0xa7, 0x1e, 0x8b, 0x79, 0xec, 0x83, 0xa2, 0x68, 0x59, 0x72, 0x50, 0x04, 0xed, 0x45, 0xe0,
-	0xcc, 0xfc, 0xff, 0xb7, 0xf3, 0x7f, 0xf3, 0x3f, 0x66, 0x7e, 0xc1, 0x25, 0xdb, 0x63, 0x1d, 0x8f,
-	0x2d, 0x33, 0x8e, 0xf7, 0x5c, 0xda, 0x5a, 0xde, 0x5f, 0x69, 0x10, 0x8e, 0x57, 0x82, 0x71, 0xa9,
-	0xeb, 0x7b, 0xdc, 0x43, 0x67, 0x95, 0x54, 0x29, 0x98, 0xd5, 0x52, 0x85, 0xd3, 0x2d, 0xaf, 0xe5,
-	0x49, 0x91, 0x65, 0xf1, 0x4b, 0x49, 0x17, 0xe6, 0x5a, 0x9e, 0xd7, 0x6a, 0x93, 0x65, 0x39, 0x6a,
-	0xf4, 0x9a, 0xcb, 0x98, 0xf6, 0xf5, 0xd2, 0xfc, 0xc1, 0x25, 0xa7, 0xe7, 0x63, 0xee, 0x7a, 0x54,
-	0xaf, 0x2f, 0x1c, 0x5c, 0xe7, 0x6e, 0x87, 0x30, 0x8e, 0x3b, 0xdd, 0x00, 0x5b, 0xed, 0xc4, 0x52,
-	0x1f, 0xd5, 0xdb, 0xd2, 0xd8, 0xda, 0x94, 0x06, 0x66, 0x24, 0xb4, 0xc3, 0xf6, 0xdc, 0x00, 0x7b,
-	0x16, 0x77, 0x5c, 0xea, 0x2d, 0xcb, 0xbf, 0x7a, 0xea, 0x82, 0xed, 0x75, 0x08, 0x6f, 0x34, 0xf9,
-	0x32, 0xef, 0x77, 0x09, 0x5b, 0xde, 0x5f, 0x51, 0x3f, 0xf4, 0xf2, 0xcb, 0xe1, 0x32, 0x6e, 0xd8,
-	0xee, 0x81, 0xd5, 0xe2, 0x87, 0x06, 0xcc, 0xdc, 0x72, 0x19, 0xf7, 0x7c, 0xd7, 0xc6, 0xed, 0x1a,
-	0x6d, 0x7a, 0xe8, 0x75, 0x48, 0xef, 0x12, 0xec, 0x10, 0x3f, 0x6f, 0x2c, 0x1a, 0x4b, 0x99, 0x6b,
-	0x73, 0xa5, 0x00, 0xa1, 0xa4, 0x34, 0xf7, 0x57, 0x4a, 0xb7, 0xa4, 0x40, 0x79, 0xf2, 0x93, 0xc7,
-	0x0b, 0x63, 0x1f, 0x7d, 0xf6, 0xf1, 0x15, 0xc3, 0xd4, 0x3a, 0xa8, 0x02, 0xe9, 0x7d, 0xdc, 0x66,
-	0x84, 0xe7, 0x13, 0x8b, 0xc9, 0xa5, 0xcc, 0xb5, 0x8b, 0xa5, 0xc3, 0x69, 0x2f, 0xed, 0xe0, 0xb6,
-	0xeb, 0x60, 0xee, 0x0d, 0xa2, 0x28, 0xdd, 0xd5, 0x44, 0xde, 0x28, 0xfe, 0x2a, 0x01, 0xd9, 0x35,
-	0xaf, 0xd3, 0x71, 0x19, 0x73, 0x3d, 0x6a, 0x62, 0x4e, 0x18, 0x7a, 0x0b, 0x52, 0x3e, 0xe6, 0x44,
-	0xee, 0x6c, 0xb2, 0x7c, 0x5d, 0x28, 0x7e, 0xfa, 0x78, 0xe1, 0xbc, 0xfa, 0x04, 0x73, 0xf6, 0x4a,
-	0xae, 0xb7, 0xdc, 0xc1, 0x7c, 0xb7, 0xf4, 0x0e, 0x69, 0x61, 0xbb, 0x5f, 0x21, 0xf6, 0xa3, 0x07,
-	0x57, 0x41, 0xef, 0xa0, 0x42, 0x6c, 0xf5, 0x15, 0x89, 0x81, 0xbe, 0x07, 0x13, 0x1d, 0x7c, 0xc7,
-	0x92, 0x78, 0x89, 0x17, 0xc2, 0x1b, 0xef, 0xe0, 0x3b, 0x62, 0x7f, 0xe8, 0x3d, 0xc8, 0x0a, 0x48,
-	0x7b, 0x17, 0xd3, 0x16, 0x51, 0xc8, 0xc9, 0x17, 0x42, 0x9e, 0xee, 0xe0, 0x3b, 0x6b, 0x12, 0x4d,
-	0xe0, 0xaf, 0xa6, 0x3e, 0xbf, 0xbf, 0x60, 0x14, 0xff, 0x60, 0x00, 0x44, 0xc4, 0x20, 0x0c, 0x39,
-	0x3b, 0x1c, 0xc9, 0x8f, 0x32, 0x7d, 0x72, 0xaf, 0x8e, 0xe2, 0xfe, 0x00, 0xad, 0xe5, 0x69, 0xb1,
-	0xbd, 0x87, 0x8f, 0x17, 0x0c, 0xf5, 0xd5, 0xac, 0x3d, 0x44, 0x7b, 0xa6, 0xd7, 0x75, 0x30, 0x27,
-	0x96, 0x70, 0x65, 0xc9, 0x56, 0xe6, 0x5a, 0xa1, 0xa4, 0xfc, 0xbc, 0x14, 0xf8, 0x79, 0x69, 0x2b,
-	0xf0, 0x73, 0x05, 0xf8, 0xc1, 0xdf, 0x02, 0x40, 0x50, 0xda, 0x62, 0x5d, 0xdb, 0xf0, 0x91, 0x01,
-	0x99, 0x0a, 0x61, 0xb6, 0xef, 0x76, 0x45, 0xe4, 0xa0, 0x3c, 0x8c, 0x77, 0x3c, 0xea, 0xee, 0x69,
-	0xaf, 0x9b, 0x34, 0x83, 0x21, 0x2a, 0xc0, 0x84, 0xeb, 0x10, 0xca, 0x5d, 0xde, 0x57, 0xc7, 0x64,
-	0x86, 0x63, 0xa1, 0x75, 0x9b, 0x34, 0x98, 0x1b, 0xf0, 0x6c, 0x06, 0x43, 0x74, 0x19, 0x72, 0x8c,
-	0xd8, 0x3d, 0xdf, 0xe5, 0x7d, 0xcb, 0xf6, 0x28, 0xc7, 0x36, 0xcf, 0xa7, 0xa4, 0x48, 0x36, 0x98,
-	0x5f, 0x53, 0xd3, 0x02, 0xc4, 0x21, 0x1c, 0xbb, 0x6d, 0x96, 0x7f, 0x49, 0x81, 0xe8, 0xa1, 0xde,
-	0xea, 0xbd, 0x71, 0x98, 0x0c, 0x9d, 0x15, 0xad, 0x41, 0xce, 0xeb, 0x12, 0x5f, 0xfc, 0xb6, 0xb0,
-	0xe3, 0xf8, 0x84, 0x31, 0xed, 0x8d, 0xf9, 0x47, 0x0f, 0xae, 0x9e, 0xd6, 0x84, 0xdf, 0x50, 0x2b,
-	0x75, 0xee, 0xbb, 0xb4, 0x65, 0x66, 0x03, 0x0d, 0x3d, 0x8d, 0x7e, 0x28, 0x8e, 0x8c, 0x32, 0x42,
-	0x59, 0x8f, 0x59, 0xdd, 0x5e, 0x63, 0x8f, 0xf4, 0x35, 0xa9, 0xa7, 0x87, 0x48, 0xbd, 0x41, 0xfb,
-	0xe5, 0xfc, 0x9f, 0x22, 0x68, 0xdb, 0xef, 0x77, 0xb9, 0x57, 0xda, 0xec, 0x35, 0xde, 0x26, 0x7d,
-	0x71, 0x54, 0x1a, 0x67, 0x53, 0xc2, 0xa0, 0xb3, 0x90, 0xfe, 0x29, 0x76, 0xdb, 0xc4, 0x91, 0x8c,
-	0x4c, 0x98, 0x7a, 0x84, 0x56, 0x21, 0xcd, 0x38, 0xe6, 0x3d, 0x26, 0x69, 0x98, 0xb9, 0x56, 0x1c,
-	0xe5, 0x1b, 0x65, 0x8f, 0x3a, 0x75, 0x29, 0x69, 0x6a, 0x0d, 0xb4, 0x06, 0x69, 0xee, 0xed, 0x11,
-	0xaa, 0x09, 0x2a, 0x7f, 0x43, 0x7b, 0xf3, 0x99, 0x61, 0x6f, 0xae, 0x51, 0x1e, 0xf3, 0xe3, 0x1a,
-	0xe5, 0xa6, 0x56, 0x45, 0x3f, 0x86, 0x9c, 0x43, 0xda, 0xa4, 0x25, 0x99, 0x63, 0xbb, 0xd8, 0x27,
-	0x2c, 0x9f, 0x96, 0x70, 0x2b, 0xc7, 0x0e, 0x0e, 0x33, 0x1b, 0x42, 0xd5, 0x25, 0x12, 0xda, 0x84,
-	0x8c, 0x13, 0xb9, 0x53, 0x7e, 0x5c, 0x92, 0xf9, 0xca, 0x28, 0x1b, 0x63, 0x9e, 0x17, 0xcf, 0x3e,
-	0x71, 0x08, 0xe1, 0x41, 0x3d, 0xda, 0xf0, 0xa8, 0xe3, 0xd2, 0x96, 0xb5, 0x4b, 0xdc, 0xd6, 0x2e,
-	0xcf, 0x4f, 0x2c, 0x1a, 0x4b, 0x49, 0x33, 0x1b, 0xce, 0xdf, 0x92, 0xd3, 0x68, 0x13, 0x66, 0x22,
-	0x51, 0x19, 0x21, 0x93, 0xc7, 0x8d, 0x90, 0xe9, 0x10, 0x40, 0x88, 0xa0, 0x77, 0x01, 0xa2, 0x18,
-	0xcc, 0x83, 0x44, 0x2b, 0x1e, 0x1d, 0xcd, 0x71, 0x63, 0x62, 0x00, 0x88, 0xc2, 0xa9, 0x8e, 0x4b,
-	0x2d, 0x46, 0xda, 0x4d, 0x4b, 0x33, 0x27, 0x70, 0x33, 0x92, 0xfe, 0x37, 0x8e, 0x71, 0x9a, 0x9f,
-	0x3e, 0xb8, 0x9a, 0x55, 0xa3, 0xab, 0xcc, 0xd9, 0x5b, 0x7c, 0xad, 0xf4, 0xed, 0xef, 0x98, 0xb3,
-	0x1d, 0x97, 0xd6, 0x49, 0xbb, 0x59, 0x09, 0x81, 0xd1, 0xeb, 0x70, 0x3e, 0x22, 0xc4, 0xa3, 0xd6,
-	0xae, 0xd7, 0x76, 0x2c, 0x9f, 0x34, 0x2d, 0xdb, 0xeb, 0x51, 0x9e, 0x9f, 0x92, 0x34, 0x9e, 0x0b,
-	0x45, 0x36, 0xe8, 0x2d, 0xaf, 0xed, 0x98, 0xa4, 0xb9, 0x26, 0x96, 0xd1, 0x2b, 0x10, 0xb1, 0x61,
-	0xb9, 0x0e, 0xcb, 0x4f, 0x2f, 0x26, 0x97, 0x52, 0xe6, 0x54, 0x38, 0x59, 0x73, 0xd8, 0xea, 0xc4,
-	0xfb, 0xf7, 0x17, 0xc6, 0x3e, 0xbf, 0xbf, 0x30, 0x56, 0xbc, 0x09, 0x53, 0x3b, 0xb8, 0xad, 0x43,
-	0x8b, 0x30, 0x74, 0x1d, 0x26, 0x71, 0x30, 0xc8, 0x1b, 0x8b, 0xc9, 0x67, 0x86, 0x66, 0x24, 0x5a,
-	0xfc, 0x9d, 0x01, 0xe9, 0xca, 0xce, 0x26, 0x76, 0x7d, 0x54, 0x85, 0xd9, 0xc8, 0x57, 0x9f, 0x37,
-	0xca, 0x23, 0xf7, 0x0e, 0xc2, 0x7c, 0x1d, 0x66, 0xf7, 0x83, 0xc4, 0x11, 0xc2, 0xa8, 0x52, 0x73,
-	0xf1, 0xd1, 0x83, 0xab, 0x17, 0x34, 0x4c, 0x98, 0x5c, 0x0e, 0xe0, 0xed, 0x1f, 0x98, 0x8f, 0xd9,
-	0xfc, 0x16, 0x8c, 0xab, 0xad, 0x32, 0xf4, 0x5d, 0x78, 0xa9, 0x2b, 0x7e, 0x48, 0x53, 0x33, 0xd7,
-	0xe6, 0x47, 0xfa, 0xbc, 0x94, 0x8f, 0x7b, 0x88, 0xd2, 0x2b, 0xfe, 0x22, 0x01, 0x50, 0xd9, 0xd9,
-	0xd9, 0xf2, 0xdd, 0x6e, 0x9b, 0xf0, 0x2f, 0xcb, 0xf6, 0x6d, 0x38, 0x13, 0xd9, 0xce, 0x7c, 0xfb,
-	0xf8, 0xf6, 0x9f, 0x0a, 0xf5, 0xeb, 0xbe, 0x7d, 0x28, 0xac, 0xc3, 0x78, 0x08, 0x9b, 0x3c, 0x3e,
-	0x6c, 0x85, 0xf1, 0x61, 0x66, 0x7f, 0x00, 0x99, 0x88, 0x0c, 0x86, 0x6a, 0x30, 0xc1, 0xf5, 0x6f,
-	0x4d, 0x70, 0x71, 0x34, 0xc1, 0x81, 0x5a, 0x9c, 0xe4, 0x50, 0xbd, 0xf8, 0x6f, 0x03, 0x20, 0x16,
-	0x23, 0x5f, 0x4d, 0x1f, 0x43, 0x35, 0x48, 0xeb, 0xe4, 0x9c, 0x3c, 0x69, 0x72, 0xd6, 0x00, 0x31,
-	0x52, 0x7f, 0x99, 0x80, 0x53, 0xdb, 0x41, 0xf4, 0x7e, 0xf5, 0x39, 0xd8, 0x86, 0x71, 0x42, 0xb9,
-	0xef, 0x4a, 0x12, 0xc4, 0x99, 0xbf, 0x36, 0xea, 0xcc, 0x0f, 0x31, 0xaa, 0x4a, 0xb9, 0xdf, 0x8f,
-	0x7b, 0x40, 0x80, 0x15, 0xe3, 0xe3, 0xd7, 0x49, 0xc8, 0x8f, 0x52, 0x45, 0xaf, 0x42, 0xd6, 0xf6,
-	0x89, 0x9c, 0x08, 0xea, 0x8e, 0x21, 0x13, 0xe6, 0x4c, 0x30, 0xad, 0xcb, 0x8e, 0x09, 0xe2, 0xa2,
-	0x26, 0x9c, 0x4b, 0x88, 0x9e, 0xec, 0x66, 0x36, 0x13, 0x21, 0xc8, 0xc2, 0xb3, 0x05, 0x59, 0x97,
-	0xba, 0xdc, 0xc5, 0x6d, 0xab, 0x81, 0xdb, 0x98, 0xda, 0xc1, 0x0d, 0xf6, 0x58, 0x35, 0x7f, 0x46,
-	0x63, 0x94, 0x15, 0x04, 0xaa, 0xc2, 0x78, 0x80, 0x96, 0x3a, 0x3e, 0x5a, 0xa0, 0x8b, 0x2e, 0xc2,
-	0x54, 0xbc, 0x30, 0xc8, 0xdb, 0x48, 0xca, 0xcc, 0xc4, 0xea, 0xc2, 0x51, 0x95, 0x27, 0xfd, 0xcc,
-	0xca, 0xa3, 0x2f, 0x7c, 0xbf, 0x49, 0xc2, 0xac, 0x49, 0x9c, 0xff, 0xfd, 0x63, 0xd9, 0x04, 0x50,
-	0xa1, 0x2a, 0x32, 0xa9, 0x3e, 0x99, 0x13, 0xc4, 0xfb, 0xa4, 0x02, 0xa9, 0x30, 0xfe, 0xdf, 0x3a,
-	0xa1, 0xbf, 0x26, 0x60, 0x2a, 0x7e, 0x42, 0xff, 0x97, 0x45, 0x0b, 0xad, 0x47, 0x69, 0x2a, 0x25,
-	0xd3, 0xd4, 0xe5, 0x51, 0x69, 0x6a, 0xc8, 0x9b, 0x8f, 0xc8, 0x4f, 0x5f, 0x24, 0x21, 0xbd, 0x89,
-	0x7d, 0xdc, 0x61, 0x68, 0x63, 0xe8, 0x6e, 0x1b, 0x74, 0x05, 0x0e, 0x3a, 0x73, 0x45, 0x77, 0x41,
-	0x94, 0x2f, 0x7f, 0x38, 0xea, 0x6a, 0xfb, 0x35, 0x98, 0x11, 0x6f, 0xe4, 0xd0, 0x20, 0x45, 0xee,
-	0xb4, 0x7c, 0xea, 0x86, 0xd6, 0x33, 0xb4, 0x00, 0x19, 0x21, 0x16, 0xe5, 0x61, 0x21, 0x03, 0x1d,
-	0x7c, 0xa7, 0xaa, 0x66, 0xd0, 0x0a, 0xa0, 0xdd, 0xb0, 0x71, 0x61, 0x45, 0x44, 0x18, 0x4b, 0xd3,
-	0xe5, 0x44, 0xde, 0x30, 0x67, 0xa3, 0xd5, 0x40, 0xe5, 0x02, 0x80, 0xd8, 0x89, 0xe5, 0x10, 0xea,
-	0x75, 0xf4, 0x63, 0x6f, 0x52, 0xcc, 0x54, 0xc4, 0x04, 0xfa, 0xb9, 0xa1, 0xae, 0xc9, 0x07, 0x5e,
-	0xd3, 0xfa, 0x95, 0xb2, 0xf5, 0x1c, 0x81, 0xf1, 0xaf, 0xc7, 0x0b, 0x85, 0x3e, 0xee, 0xb4, 0x57,
-	0x8b, 0x87, 0xe0, 0x14, 0x0f, 0x7b, 0xe0, 0x8b, 0xcb, 0xf3, 0xe0, 0x6b, 0x1c, 0xd5, 0x20, 0xb7,
-	0x47, 0xfa, 0x96, 0xef, 0x71, 0x95, 0x6c, 0x9a, 0x84, 0xe8, 0xf7, 0xcc, 0x5c, 0x70, 0xbe, 0x0d,
-	0xcc, 0x48, 0xec, 0xfa, 0xef, 0xd2, 0x72, 0x4a, 0xec, 0xce, 0x9c, 0xd9, 0x23, 0x7d, 0x53, 0xeb,
-	0xdd, 0x24, 0x64, 0xf5, 0x92, 0x88, 0x96, 0xbb, 0x9f, 0x7d, 0x7c, 0xe5, 0x7c, 0x74, 0x69, 0x5f,
-	0xbe, 0x13, 0xf6, 0xc9, 0xd4, 0x11, 0x8b, 0x8b, 0x2f, 0x8a, 0x8a, 0x90, 0x49, 0x58, 0x57, 0xbc,
-	0x29, 0xc5, 0x1b, 0x24, 0xf6, 0x56, 0x30, 0x9e, 0xfd, 0x06, 0x89, 0xf4, 0x07, 0xde, 0x20, 0xb1,
-	0x10, 0x7d, 0x23, 0xaa, 0x01, 0x89, 0xa3, 0xac, 0x89, 0x7b, 0xa7, 0x56, 0x92, 0x91, 0x3f, 0x56,
-	0xfc, 0xb3, 0x01, 0x73, 0x43, 0xde, 0x1c, 0x6e, 0xd9, 0x06, 0xe4, 0xc7, 0x16, 0xa5, 0x57, 0xf4,
-	0xf5, 0xd6, 0x4f, 0x16, 0x1c, 0xb3, 0xfe, 0x50, 0x21, 0xf8, 0x72, 0x8a, 0x99, 0xce, 0x64, 0x7f,
-	0x34, 0xe0, 0x74, 0x7c, 0x03, 0xa1, 0x29, 0x75, 0x98, 0x8a, 0x7f, 0x5a, 0x1b, 0x71, 0xe9, 0x79,
-	0x8c, 0x88, 0xef, 0x7f, 0x00, 0x04, 0xed, 0x44, 0x19, 0x43, 0x75, 0xe7, 0x56, 0x9e, 0x9b, 0x94,
-	0x60, 0x63, 0x87, 0x66, 0x0e, 0x75, 0x36, 0x5f, 0x18, 0x90, 0xda, 0xf4, 0xbc, 0x36, 0xfa, 0x19,
-	0xcc, 0x52, 0x8f, 0x5b, 0x22, 0xb2, 0x88, 0x63, 0xe9, 0xd6, 0x81, 0xca, 0xc6, 0xd5, 0x67, 0x72,
-	0xf5, 0x8f, 0xc7, 0x0b, 0xc3, 0x9a, 0x83, 0x04, 0xea, 0x0e, 0x15, 0xf5, 0x78, 0x59, 0x0a, 0x6d,
-	0xa9, 0xee, 0x42, 0x13, 0xa6, 0x07, 0x3f, 0xa7, 0x32, 0xf6, 0x8d, 0xa3, 0x3e, 0x37, 0x7d, 0xe4,
-	0xa7, 0xa6, 0x1a, 0xb1, 0xef, 0xac, 0x4e, 0x88, 0x53, 0xfb, 0xa7, 0x38, 0xb9, 0xf7, 0x20, 0x17,
-	0xa6, 0xab, 0x6d, 0xd9, 0xde, 0x62, 0xe8, 0x26, 0x8c, 0xab, 0x4e, 0x57, 0xf0, 0x58, 0xb8, 0x18,
-	0xf5, 0x4e, 0x71, 0xc3, 0x76, 0x4b, 0xfb, 0xb1, 0xbe, 0xa7, 0x52, 0x1a, 0xe0, 0x53, 0x2b, 0xcb,
-	0xf6, 0xe7, 0xc3, 0x04, 0xcc, 0xad, 0x79, 0x94, 0xe9, 0x46, 0x8f, 0x8e, 0x6a, 0xd5, 0xab, 0xed,
-	0xa3, 0xcb, 0x23, 0xda, 0x50, 0x53, 0xc3, 0xcd, 0xa6, 0x1d, 0xc8, 0x8a, 0x12, 0x6b, 0x7b, 0xf4,
-	0x05, 0x7b, 0x4d, 0xd3, 0x5e, 0xdb, 0xd1, 0x3b, 0xda, 0x23, 0x7d, 0x81, 0x4b, 0xc9, 0xed, 0x01,
-	0xdc, 0xe4, 0xc9, 0x70, 0x29, 0xb9, 0x1d, 0xc3, 0x3d, 0x0b, 0x69, 0x7d, 0xbf, 0x4a, 0xc9, 0xdb,
-	0x83, 0x1e, 0xa1, 0xeb, 0x90, 0x14, 0xa9, 0xf0, 0xa5, 0x63, 0x24, 0x0f, 0xa1, 0x10, 0x2b, 0x6b,
-	0x75, 0x98, 0xd3, 0x9d, 0x02, 0xb6, 0xd1, 0x94, 0x8c, 0x12, 0x69, 0xd0, 0xdb, 0xa4, 0x7f, 0x48,
-	0xdb, 0x60, 0xea, 0xb9, 0xda, 0x06, 0x57, 0x7e, 0x6f, 0x00, 0x44, 0x3d, 0x33, 0xf4, 0x4d, 0x38,
-	0x57, 0xde, 0x58, 0xaf, 0x58, 0xf5, 0xad, 0x1b, 0x5b, 0xdb, 0x75, 0x6b, 0x7b, 0xbd, 0xbe, 0x59,
-	0x5d, 0xab, 0xdd, 0xac, 0x55, 0x2b, 0xb9, 0xb1, 0x42, 0xf6, 0xee, 0xbd, 0xc5, 0xcc, 0x36, 0x65,
-	0x5d, 0x62, 0xbb, 0x4d, 0x97, 0x38, 0xe8, 0xeb, 0x70, 0x7a, 0x50, 0x5a, 0x8c, 0xaa, 0x95, 0x9c,
-	0x51, 0x98, 0xba, 0x7b, 0x6f, 0x71, 0x42, 0xbd, 0x11, 0x88, 0x83, 0x96, 0xe0, 0xcc, 0xb0, 0x5c,
-	0x6d, 0xfd, 0xcd, 0x5c, 0xa2, 0x30, 0x7d, 0xf7, 0xde, 0xe2, 0x64, 0xf8, 0x98, 0x40, 0x45, 0x40,
-	0x71, 0x49, 0x8d, 0x97, 0x2c, 0xc0, 0xdd, 0x7b, 0x8b, 0x69, 0x15, 0x32, 0x85, 0xd4, 0xfb, 0xbf,
-	0x9d, 0x1f, 0xbb, 0xf2, 0x13, 0x80, 0x1a, 0x6d, 0xfa, 0xd8, 0x96, 0xa9, 0xa1, 0x00, 0x67, 0x6b,
-	0xeb, 0x37, 0xcd, 0x1b, 0x6b, 0x5b, 0xb5, 0x8d, 0xf5, 0xc1, 0x6d, 0x1f, 0x58, 0xab, 0x6c, 0x6c,
-	0x97, 0xdf, 0xa9, 0x5a, 0xf5, 0xda, 0x9b, 0xeb, 0x39, 0x03, 0x9d, 0x83, 0x53, 0x03, 0x6b, 0xdf,
-	0x5f, 0xdf, 0xaa, 0xbd, 0x5b, 0xcd, 0x25, 0xca, 0xd7, 0x3f, 0x79, 0x32, 0x6f, 0x3c, 0x7c, 0x32,
-	0x6f, 0xfc, 0xfd, 0xc9, 0xbc, 0xf1, 0xc1, 0xd3, 0xf9, 0xb1, 0x87, 0x4f, 0xe7, 0xc7, 0xfe, 0xf2,
-	0x74, 0x7e, 0xec, 0x47, 0x2f, 0x0f, 0x04, 0x63, 0x54, 0x8e, 0xe4, 0x7f, 0x17, 0x1a, 0x69, 0xe9,
-	0x35, 0xdf, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xd1, 0x83, 0x1f, 0xd5, 0x19, 0x00,
-	0x00,
+	// 2308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x34, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x96, 0x6d, 0x4a, 0xb6, 0x25, 0x99, 0x71,
+	0x1b, 0xc7, 0xad, 0x28, 0x4b, 0x2d, 0x5c, 0x54, 0x08, 0x52, 0x98, 0xa2, 0x14, 0xd3, 0x71, 0x24,
+	0x75, 0xf5, 0xd3, 0x1f, 0xb4, 0x59, 0x0c, 0x77, 0x87, 0xd4, 0x46, 0xe4, 0x0e, 0xb3, 0x33, 0x94,
+	0xcd, 0x4b, 0x4f, 0x2d, 0x90, 0x3a, 0x28, 0x90, 0x53, 0x10, 0xa0, 0x30, 0x6a, 0xa0, 0x97, 0xf6,
+	0x96, 0x83, 0xd1, 0x7b, 0x6f, 0x69, 0x81, 0x02, 0x86, 0x81, 0x02, 0x85, 0x81, 0xb8, 0x85, 0x7d,
+	0x48, 0xd0, 0x5e, 0x8a, 0x9e, 0x7a, 0x2c, 0xe6, 0x67, 0x7f, 0x28, 0x51, 0x96, 0x64, 0x1b, 0x45,
+	0xd0, 0x5e, 0x08, 0xce, 0xcc, 0x7b, 0xdf, 0xbc, 0x79, 0x7f, 0xf3, 0xe6, 0x2d, 0x5c, 0xb4, 0x29,
+	0x6b, 0x52, 0x36, 0xcb, 0x38, 0xde, 0x71, 0xbd, 0xfa, 0xec, 0xee, 0x5c, 0x95, 0x70, 0x3c, 0x17,
+	0x8c, 0x8b, 0x2d, 0x9f, 0x72, 0x8a, 0x4e, 0x2b, 0xaa, 0x62, 0x30, 0xab, 0xa9, 0x26, 0xc6, 0xea,
+	0xb4, 0x4e, 0x25, 0xc9, 0xac, 0xf8, 0xa7, 0xa8, 0x27, 0xc6, 0xeb, 0x94, 0xd6, 0x1b, 0x64, 0x56,
+	0x8e, 0xaa, 0xed, 0xda, 0x2c, 0xf6, 0x3a, 0x7a, 0x69, 0x72, 0xef, 0x92, 0xd3, 0xf6, 0x31, 0x77,
+	0xa9, 0xa7, 0xd7, 0xa7, 0xf6, 0xae, 0x73, 0xb7, 0x49, 0x18, 0xc7, 0xcd, 0x56, 0x80, 0xad, 0x24,
+	0xb1, 0xd4, 0xa6, 0x5a, 0x2c, 0x8d, 0xad, 0x8f, 0x52, 0xc5, 0x8c, 0x84, 0xe7, 0xb0, 0xa9, 0x1b,
+	0x60, 0x8f, 0xe2, 0xa6, 0xeb, 0xd1, 0x59, 0xf9, 0xab, 0xa7, 0xce, 0xdb, 0xb4, 0x49, 0x78, 0xb5,
+	0xc6, 0x67, 0x79, 0xa7, 0x45, 0xd8, 0xec, 0xee, 0x9c, 0xfa, 0xa3, 0x97, 0xcf, 0x85, 0xcb, 0xb8,
+	0x6a, 0xbb, 0x7b, 0x56, 0x0b, 0x1f, 0x1b, 0x30, 0x72, 0xdd, 0x65, 0x9c, 0xfa, 0xae, 0x8d, 0x1b,
+	0x15, 0xaf, 0x46, 0xd1, 0xeb, 0x90, 0xde, 0x26, 0xd8, 0x21, 0x7e, 0xde, 0x98, 0x36, 0x2e, 0x65,
+	0xe6, 0xc7, 0x8b, 0x01, 0x42, 0x51, 0x71, 0xee, 0xce, 0x15, 0xaf, 0x4b, 0x82, 0xd2, 0xe0, 0xa7,
+	0x8f, 0xa7, 0xfa, 0x7e, 0xf3, 0xf9, 0x27, 0x97, 0x0d, 0x53, 0xf3, 0xa0, 0x32, 0xa4, 0x77, 0x71,
+	0x83, 0x11, 0x9e, 0x4f, 0x4c, 0x27, 0x2f, 0x65, 0xe6, 0x2f, 0x14, 0x7b, 0xab, 0xbd, 0xb8, 0x85,
+	0x1b, 0xae, 0x83, 0x39, 0xed, 0x46, 0x51, 0xbc, 0x0b, 0x89, 0xbc, 0x51, 0xf8, 0x28, 0x01, 0xd9,
+	0x45, 0xda, 0x6c, 0xba, 0x8c, 0xb9, 0xd4, 0x33, 0x31, 0x27, 0x0c, 0xdd, 0x80, 0x94, 0x8f, 0x39,
+	0x91, 0x92, 0x0d, 0x96, 0xae, 0x0a, 0xc6, 0x47, 0x8f, 0xa7, 0xce, 0xaa, 0x2d, 0x98, 0xb3, 0x53,
+	0x74, 0xe9, 0x6c, 0x13, 0xf3, 0xed, 0xe2, 0x4d, 0x52, 0xc7, 0x76, 0xa7, 0x4c, 0xec, 0x87, 0xf7,
+	0x67, 0x40, 0x4b, 0x50, 0x26, 0xb6, 0xda, 0x45, 0x62, 0xa0, 0xef, 0xc2, 0x40, 0x13, 0xdf, 0xb6,
+	0x24, 0x5e, 0xe2, 0x85, 0xf0, 0xfa, 0x9b, 0xf8, 0xb6, 0x90, 0x0f, 0xbd, 0x03, 0x59, 0x01, 0x69,
+	0x6f, 0x63, 0xaf, 0x4e, 0x14, 0x72, 0xf2, 0x85, 0x90, 0x87, 0x9b, 0xf8, 0xf6, 0xa2, 0x44, 0x13,
+	0xf8, 0x0b, 0xa9, 0x2f, 0xee, 0x4d, 0x19, 0x85, 0xdf, 0x1b, 0x00, 0x91, 0x62, 0x10, 0x86, 0x9c,
+	0x1d, 0x8e, 0xe4, 0xa6, 0x4c, 0x5b, 0xee, 0xd5, 0x83, 0x74, 0xbf, 0x47, 0xad, 0xa5, 0x61, 0x21,
+	0xde, 0x83, 0xc7, 0x53, 0x86, 0xda, 0x35, 0x6b, 0xef, 0x53, 0x7b, 0xa6, 0xdd, 0x72, 0x30, 0x27,
+	0x96, 0x70, 0x65, 0xa9, 0xad, 0xcc, 0xfc, 0x44, 0x51, 0xf9, 0x79, 0x31, 0xf0, 0xf3, 0xe2, 0x46,
+	0xe0, 0xe7, 0x0a, 0xf0, 0xc3, 0xbf, 0x06, 0x80, 0xa0, 0xb8, 0xc5, 0xba, 0x3e, 0xc3, 0x47, 0x09,
+	0xc8, 0x94, 0x09, 0xb3, 0x7d, 0xb7, 0x25, 0x22, 0x07, 0xe5, 0xa1, 0xbf, 0x49, 0x3d, 0x77, 0x47,
+	0x7b, 0xdd, 0xa0, 0x19, 0x0c, 0xd1, 0x04, 0x0c, 0xb8, 0x0e, 0xf1, 0xb8, 0xcb, 0x3b, 0xca, 0x4c,
+	0x66, 0x38, 0x16, 0x5c, 0xb7, 0x48, 0x95, 0xb9, 0x81, 0x9e, 0xcd, 0x60, 0x88, 0x5e, 0x83, 0x1c,
+	0x23, 0x76, 0xdb, 0x77, 0x79, 0xc7, 0xb2, 0xa9, 0xc7, 0xb1, 0xcd, 0xf3, 0x29, 0x49, 0x92, 0x0d,
+	0xe6, 0x17, 0xd5, 0xb4, 0x00, 0x71, 0x08, 0xc7, 0x6e, 0x83, 0xe5, 0x4f, 0x28, 0x10, 0x3d, 0x44,
+	0xdf, 0x86, 0x61, 0x8d, 0x27, 0x42, 0x95, 0xd6, 0xf2, 0x69, 0x69, 0xcc, 0xb1, 0x47, 0xf7, 0x67,
+	0x72, 0xb7, 0x83, 0xec, 0x32, 0xbd, 0x3b, 0x57, 0xbc, 0x52, 0xbc, 0x62, 0x0e, 0x69, 0xd2, 0x35,
+	0x41, 0x89, 0xe6, 0x60, 0xb0, 0x55, 0x6f, 0x69, 0xb6, 0xfe, 0x67, 0xb0, 0x0d, 0xb4, 0xea, 0x2d,
+	0xc9, 0xa2, 0x15, 0xf3, 0xf3, 0x01, 0x18, 0x0c, 0x43, 0x03, 0x2d, 0x42, 0x8e, 0xb6, 0x88, 0x2f,
+	0xfe, 0x5b, 0xd8, 0x71, 0x7c, 0xc2, 0x98, 0xf6, 0xfd, 0xfc, 0xc3, 0xfb, 0x33, 0x63, 0xda, 0xbc,
+	0xd7, 0xd4, 0xca, 0x3a, 0xf7, 0x5d, 0xaf, 0x6e, 0x66, 0x03, 0x0e, 0x3d, 0x8d, 0x7e, 0x20, 0x1c,
+	0xc4, 0x63, 0xc4, 0x63, 0x6d, 0x66, 0xb5, 0xda, 0xd5, 0x1d, 0xd2, 0xd1, 0x26, 0x1c, 0xdb, 0x67,
+	0xc2, 0x6b, 0x5e, 0xa7, 0x94, 0xff, 0x63, 0x04, 0x6d, 0xfb, 0x9d, 0x16, 0xa7, 0xc5, 0xb5, 0x76,
+	0xf5, 0x2d, 0xd2, 0x31, 0xb3, 0x21, 0xce, 0x9a, 0x84, 0x41, 0xa7, 0x21, 0xfd, 0x2e, 0x76, 0x1b,
+	0xc4, 0x91, 0xfa, 0x1f, 0x30, 0xf5, 0x08, 0x2d, 0x40, 0x9a, 0x71, 0xcc, 0xdb, 0x4c, 0x2a, 0x7d,
+	0x64, 0xbe, 0x70, 0x90, 0x27, 0x96, 0xa8, 0xe7, 0xac, 0x4b, 0x4a, 0x53, 0x73, 0xa0, 0x45, 0x48,
+	0x73, 0xba, 0x43, 0x3c, 0x6d, 0x8e, 0xd2, 0xd7, 0x74, 0xec, 0x9c, 0xda, 0x1f, 0x3b, 0x15, 0x8f,
+	0xc7, 0xa2, 0xa6, 0xe2, 0x71, 0x53, 0xb3, 0xa2, 0x1f, 0x41, 0xce, 0x21, 0x0d, 0x52, 0x97, 0x9a,
+	0x63, 0xdb, 0xd8, 0x27, 0x4c, 0x5b, 0x6f, 0xee, 0xd8, 0xa1, 0x68, 0x66, 0x43, 0xa8, 0x75, 0x89,
+	0x84, 0xd6, 0x20, 0xe3, 0x44, 0xce, 0x2b, 0xed, 0x9b, 0x99, 0x7f, 0xe5, 0xa0, 0x33, 0xc6, 0xfc,
+	0x3c, 0x9e, 0xeb, 0xe2, 0x10, 0xc2, 0x5f, 0xdb, 0x5e, 0x95, 0x7a, 0x8e, 0xeb, 0xd5, 0xad, 0x6d,
+	0xe2, 0xd6, 0xb7, 0x79, 0x7e, 0x60, 0xda, 0xb8, 0x94, 0x34, 0xb3, 0xe1, 0xfc, 0x75, 0x39, 0x8d,
+	0xd6, 0x60, 0x24, 0x22, 0x95, 0xf1, 0x38, 0x78, 0xdc, 0x78, 0x1c, 0x0e, 0x01, 0x04, 0x09, 0x7a,
+	0x1b, 0x20, 0x8a, 0xf8, 0x3c, 0x48, 0xb4, 0xc2, 0xe1, 0xb9, 0x23, 0x7e, 0x98, 0x18, 0x00, 0xf2,
+	0xe0, 0x64, 0xd3, 0xf5, 0x2c, 0x46, 0x1a, 0x35, 0x4b, 0x6b, 0x4e, 0xe0, 0x66, 0xa4, 0xfa, 0xdf,
+	0x38, 0x86, 0x35, 0x1f, 0xdd, 0x9f, 0xc9, 0xaa, 0xd1, 0x0c, 0x73, 0x76, 0xa6, 0xaf, 0x14, 0xbf,
+	0xf9, 0x2d, 0x73, 0xb4, 0xe9, 0x7a, 0xeb, 0xa4, 0x51, 0x2b, 0x87, 0xc0, 0xe8, 0x75, 0x38, 0x1b,
+	0x29, 0x84, 0x7a, 0xd6, 0x36, 0x6d, 0x38, 0x96, 0x4f, 0x6a, 0x96, 0x4d, 0xdb, 0x1e, 0xcf, 0x0f,
+	0x49, 0x35, 0x9e, 0x09, 0x49, 0x56, 0xbd, 0xeb, 0xb4, 0xe1, 0x98, 0xa4, 0xb6, 0x28, 0x96, 0xd1,
+	0x2b, 0x10, 0x69, 0xc3, 0x72, 0x1d, 0x96, 0x1f, 0x9e, 0x4e, 0x5e, 0x4a, 0x99, 0x43, 0xe1, 0x64,
+	0xc5, 0x61, 0xe8, 0x5d, 0x18, 0x6e, 0xb8, 0xef, 0xb5, 0x5d, 0x27, 0xf0, 0xa5, 0x11, 0x79, 0x98,
+	0xa5, 0x63, 0xfb, 0x52, 0xef, 0xd4, 0xa1, 0xb0, 0x95, 0x73, 0x2d, 0x0c, 0xbc, 0x7f, 0x6f, 0xaa,
+	0xef, 0x8b, 0x7b, 0x53, 0x7d, 0x85, 0x65, 0x18, 0xda, 0xc2, 0x0d, 0x1d, 0xc6, 0x84, 0xa1, 0xab,
+	0x30, 0x88, 0x83, 0x41, 0xde, 0x98, 0x4e, 0x3e, 0x33, 0x0d, 0x44, 0xa4, 0x85, 0xdf, 0x1a, 0x90,
+	0x2e, 0x6f, 0xad, 0x61, 0xd7, 0x47, 0x4b, 0x30, 0x1a, 0xc5, 0xc5, 0x51, 0x33, 0x4a, 0x14, 0x4a,
+	0x7a, 0x1e, 0xad, 0xc0, 0xe8, 0x6e, 0x90, 0xa4, 0x42, 0x18, 0x75, 0x89, 0x5e, 0x78, 0x78, 0x7f,
+	0xe6, 0xbc, 0x86, 0x09, 0x13, 0xd9, 0x1e, 0xbc, 0xdd, 0x3d, 0xf3, 0xb1, 0x33, 0xdf, 0x80, 0x7e,
+	0x25, 0x2a, 0x43, 0xdf, 0x81, 0x13, 0x2d, 0xf1, 0x47, 0x1e, 0x35, 0x33, 0x3f, 0x79, 0x60, 0x7c,
+	0x49, 0xfa, 0xb8, 0x37, 0x2a, 0xbe, 0xc2, 0x07, 0x09, 0x80, 0xf2, 0xd6, 0xd6, 0x86, 0xef, 0xb6,
+	0x1a, 0x84, 0xbf, 0xac, 0xb3, 0x6f, 0xc2, 0xa9, 0xe8, 0xec, 0xcc, 0xb7, 0x8f, 0x7f, 0xfe, 0x93,
+	0x21, 0xff, 0xba, 0x6f, 0xf7, 0x84, 0x75, 0x18, 0x0f, 0x61, 0x93, 0xc7, 0x87, 0x2d, 0x33, 0xbe,
+	0x5f, 0xb3, 0xdf, 0x87, 0x4c, 0xa4, 0x0c, 0x86, 0x2a, 0x30, 0xc0, 0xf5, 0x7f, 0xad, 0xe0, 0xc2,
+	0xc1, 0x0a, 0x0e, 0xd8, 0xe2, 0x4a, 0x0e, 0xd9, 0x0b, 0xff, 0x36, 0x00, 0x62, 0xf1, 0xf8, 0xe5,
+	0xf4, 0x31, 0x54, 0x81, 0xb4, 0x0e, 0xde, 0xe4, 0xf3, 0x5e, 0x04, 0x1a, 0x20, 0xa6, 0xd4, 0x5f,
+	0x24, 0xe0, 0xe4, 0x66, 0x90, 0x29, 0xbe, 0xfc, 0x3a, 0xd8, 0x84, 0x7e, 0xe2, 0x71, 0xdf, 0x95,
+	0x4a, 0x10, 0x36, 0xbf, 0x72, 0x90, 0xcd, 0x7b, 0x1c, 0x6a, 0xc9, 0xe3, 0x7e, 0x27, 0xee, 0x01,
+	0x01, 0x56, 0x4c, 0x1f, 0xbf, 0x4c, 0x42, 0xfe, 0x20, 0x56, 0xf4, 0x2a, 0x64, 0x6d, 0x9f, 0xc8,
+	0x89, 0xe0, 0x8e, 0x33, 0x64, 0x72, 0x1e, 0x09, 0xa6, 0xf5, 0x15, 0x67, 0x82, 0x28, 0x41, 0x85,
+	0x73, 0x09, 0xd2, 0xe7, 0xab, 0x39, 0x47, 0x22, 0x04, 0x79, 0xc9, 0x6d, 0x40, 0xd6, 0xf5, 0x5c,
+	0xee, 0xe2, 0x86, 0x55, 0xc5, 0x0d, 0xec, 0xd9, 0x41, 0x6d, 0x7e, 0xac, 0xfa, 0x62, 0x44, 0x63,
+	0x94, 0x14, 0x04, 0x5a, 0x82, 0xfe, 0x00, 0x2d, 0x75, 0x7c, 0xb4, 0x80, 0x17, 0x5d, 0x80, 0xa1,
+	0xf8, 0x25, 0x24, 0x2b, 0x9f, 0x94, 0x99, 0x89, 0xdd, 0x41, 0x87, 0xdd, 0x72, 0xe9, 0x67, 0xde,
+	0x72, 0xba, 0xb8, 0xfc, 0x55, 0x12, 0x46, 0x4d, 0xe2, 0xfc, 0xef, 0x9b, 0x65, 0x0d, 0x40, 0x85,
+	0xaa, 0xc8, 0xa4, 0xf9, 0xd4, 0xf3, 0xc6, 0xfb, 0xa0, 0x02, 0x29, 0x33, 0xfe, 0xdf, 0xb2, 0xd0,
+	0x67, 0x09, 0x18, 0x8a, 0x5b, 0xe8, 0xff, 0xf2, 0xd2, 0x42, 0x2b, 0x51, 0x9a, 0x4a, 0xc9, 0x34,
+	0xf5, 0xda, 0x41, 0x69, 0x6a, 0x9f, 0x37, 0x1f, 0x92, 0x9f, 0x3e, 0xeb, 0x87, 0xf4, 0x1a, 0xf6,
+	0x71, 0x93, 0xa1, 0xd5, 0x7d, 0x75, 0x74, 0xd0, 0xef, 0xd8, 0xeb, 0xcc, 0x65, 0xdd, 0xdf, 0x51,
+	0xbe, 0xfc, 0xf1, 0x41, 0x65, 0xf4, 0x57, 0x60, 0x44, 0xbc, 0xfe, 0xc3, 0x03, 0x29, 0xe5, 0x0e,
+	0xcb, 0x47, 0x7c, 0x78, 0x7a, 0x86, 0xa6, 0x20, 0x23, 0xc8, 0xa2, 0x3c, 0x2c, 0x68, 0xa0, 0x89,
+	0x6f, 0x2f, 0xa9, 0x19, 0x34, 0x03, 0x68, 0x3b, 0x6c, 0xc9, 0x58, 0x91, 0x22, 0x04, 0xdd, 0x68,
+	0xb4, 0x12, 0x90, 0x9f, 0x07, 0x10, 0x52, 0x58, 0x0e, 0xf1, 0x68, 0x53, 0x3f, 0x61, 0x07, 0xc5,
+	0x4c, 0x59, 0x4c, 0xa0, 0x9f, 0x1a, 0xaa, 0x1c, 0xdf, 0xd3, 0x23, 0xd0, 0xaf, 0xa1, 0x8d, 0x23,
+	0x04, 0xc5, 0xbf, 0x1e, 0x4f, 0x4d, 0x74, 0x70, 0xb3, 0xb1, 0x50, 0xe8, 0x81, 0x53, 0xe8, 0xd5,
+	0xb6, 0x10, 0x45, 0x7a, 0x77, 0x8f, 0x01, 0x55, 0x20, 0xb7, 0x43, 0x3a, 0x96, 0x4f, 0xb9, 0x4a,
+	0x34, 0x35, 0x42, 0xf2, 0xfd, 0x61, 0x7f, 0x49, 0xb2, 0x8b, 0x9e, 0x57, 0xec, 0x99, 0xe1, 0x7a,
+	0xa5, 0x94, 0x90, 0xce, 0x1c, 0xd9, 0x21, 0x1d, 0x53, 0xf3, 0x2d, 0x13, 0xd1, 0x65, 0xb9, 0xc0,
+	0xfd, 0xb6, 0x67, 0x2b, 0x20, 0xa7, 0xcd, 0xb8, 0xc5, 0xa9, 0x94, 0xab, 0xed, 0x89, 0xf7, 0x7e,
+	0x8b, 0xd2, 0x86, 0x7c, 0x3c, 0x0d, 0x1c, 0xf0, 0xe6, 0x3e, 0x1f, 0xb1, 0x97, 0xdb, 0x8c, 0x6f,
+	0xd0, 0xc5, 0x80, 0x77, 0x8d, 0xd2, 0x06, 0xfa, 0x99, 0x01, 0xe3, 0xf5, 0x06, 0xad, 0xe2, 0x86,
+	0x15, 0x14, 0xfd, 0x8a, 0xdf, 0xb2, 0x71, 0x4b, 0x3e, 0xb6, 0x06, 0x4b, 0x37, 0x5e, 0x4a, 0xe5,
+	0xaf, 0xb4, 0x75, 0x5a, 0x6d, 0x76, 0x53, 0x3d, 0x02, 0x14, 0xc1, 0x22, 0x6e, 0xa1, 0x0f, 0x0c,
+	0x38, 0x17, 0x45, 0x57, 0x0f, 0x51, 0xe0, 0xa5, 0x8b, 0x32, 0x1e, 0xee, 0xb7, 0x4f, 0x9a, 0x9f,
+	0xc4, 0x43, 0x5d, 0x3a, 0x5c, 0x0d, 0xdb, 0x9c, 0xfa, 0xf9, 0xcc, 0x4b, 0x97, 0x22, 0xca, 0x09,
+	0xa2, 0x3d, 0xb0, 0x2c, 0xb7, 0x59, 0xb8, 0x28, 0xf2, 0xe3, 0x9d, 0xcf, 0x3f, 0xb9, 0x7c, 0x36,
+	0x7a, 0x12, 0xce, 0x86, 0xfc, 0xb3, 0x2a, 0xa8, 0xc5, 0x53, 0x07, 0x45, 0x65, 0x87, 0x49, 0x58,
+	0x8b, 0x7a, 0x4c, 0xbe, 0x70, 0x63, 0x2f, 0x51, 0xe3, 0xd9, 0x2f, 0xdc, 0x88, 0xbf, 0xeb, 0x85,
+	0x1b, 0x4b, 0xca, 0x6f, 0x44, 0xb7, 0x7e, 0xe2, 0x30, 0x1f, 0x8e, 0xe7, 0x23, 0xcd, 0x24, 0x73,
+	0x7d, 0x5f, 0xe1, 0x4f, 0x06, 0x8c, 0xef, 0xcb, 0x5f, 0xa1, 0xc8, 0x36, 0x20, 0x3f, 0xb6, 0x28,
+	0xf3, 0x40, 0x47, 0x8b, 0xfe, 0x7c, 0xe9, 0x70, 0xd4, 0xdf, 0xbb, 0xfa, 0x92, 0xca, 0x17, 0x7d,
+	0x77, 0xfd, 0xc1, 0x80, 0xb1, 0xb8, 0x00, 0xe1, 0x51, 0xd6, 0x61, 0x28, 0xbe, 0xb5, 0x3e, 0xc4,
+	0xc5, 0xa3, 0x1c, 0x22, 0x2e, 0x7f, 0x17, 0x08, 0xda, 0x8a, 0xee, 0x08, 0xd5, 0x69, 0x9e, 0x3b,
+	0xb2, 0x52, 0x02, 0xc1, 0x7a, 0xde, 0x15, 0xca, 0x36, 0xff, 0x30, 0x20, 0x25, 0x93, 0xc1, 0x7b,
+	0x30, 0xea, 0x51, 0x2e, 0x1d, 0x9e, 0x38, 0x96, 0x6e, 0x4c, 0x19, 0x5d, 0xaf, 0xff, 0xde, 0xba,
+	0xfa, 0xfb, 0xe3, 0xa9, 0xfd, 0x9c, 0xdd, 0x0a, 0xd4, 0xdd, 0x56, 0x8f, 0xf2, 0x92, 0x24, 0xda,
+	0x90, 0x34, 0xa8, 0x06, 0xc3, 0xdd, 0xdb, 0xa9, 0x3b, 0xfa, 0xda, 0x61, 0xdb, 0x0d, 0x1f, 0xba,
+	0xd5, 0x50, 0x35, 0xb6, 0xcf, 0xc2, 0x80, 0xb0, 0xda, 0x3f, 0x85, 0xe5, 0xde, 0x81, 0x5c, 0x78,
+	0x41, 0x6d, 0xca, 0x56, 0x2d, 0x43, 0xcb, 0xd0, 0xaf, 0xba, 0xb6, 0xc1, 0xf3, 0xf0, 0x42, 0xf4,
+	0x1d, 0x40, 0x7c, 0x49, 0x10, 0x9f, 0x01, 0xf6, 0x30, 0x75, 0xe9, 0x53, 0x33, 0xcb, 0x56, 0xfe,
+	0x83, 0x04, 0x8c, 0x2f, 0x52, 0x8f, 0xe9, 0x36, 0xa2, 0xce, 0xe5, 0xea, 0xbb, 0x43, 0x47, 0xf4,
+	0xbe, 0x7a, 0x36, 0x39, 0x87, 0xf6, 0xb7, 0x32, 0xb7, 0x20, 0x2b, 0x8a, 0x2a, 0x9b, 0x7a, 0x2f,
+	0xd8, 0xc9, 0x1c, 0xa6, 0x0d, 0x47, 0x4b, 0x24, 0xfa, 0x98, 0x5b, 0x90, 0xf5, 0xc8, 0xad, 0x2e,
+	0xdc, 0xe4, 0xf3, 0xe1, 0x7a, 0xe4, 0x56, 0x0c, 0xf7, 0xb4, 0xf8, 0x96, 0x22, 0x2b, 0xea, 0x94,
+	0xac, 0x17, 0xf5, 0x08, 0x5d, 0x85, 0xa4, 0xb8, 0x00, 0x4f, 0x1c, 0x23, 0x79, 0x08, 0x86, 0x58,
+	0x21, 0xb3, 0x0e, 0xe3, 0xba, 0x37, 0xc4, 0x56, 0x6b, 0x52, 0xa3, 0x44, 0x1e, 0xe8, 0x2d, 0xd2,
+	0xe9, 0xd1, 0x28, 0x1a, 0x3a, 0x5a, 0xa3, 0xe8, 0xcf, 0x06, 0x9c, 0xda, 0x8c, 0xd7, 0x34, 0xab,
+	0xbb, 0xc4, 0xf7, 0x5d, 0x87, 0xa0, 0x79, 0xe8, 0x3f, 0x6a, 0xf1, 0x19, 0x10, 0x8a, 0x92, 0x19,
+	0xdb, 0xb2, 0xf6, 0xb5, 0xc4, 0x87, 0x23, 0xdd, 0xbd, 0xcf, 0xe8, 0xb9, 0x8d, 0x4e, 0x8b, 0xf4,
+	0xa8, 0xc1, 0x92, 0x2f, 0x54, 0x83, 0x2d, 0x8c, 0x3d, 0xec, 0x71, 0xab, 0x5c, 0xfe, 0x9d, 0x01,
+	0x10, 0x75, 0x9a, 0xd1, 0xd7, 0xe1, 0x4c, 0x69, 0x75, 0xa5, 0x6c, 0xad, 0x6f, 0x5c, 0xdb, 0xd8,
+	0x5c, 0xb7, 0x36, 0x57, 0xd6, 0xd7, 0x96, 0x16, 0x2b, 0xcb, 0x95, 0xa5, 0x72, 0xae, 0x6f, 0x22,
+	0x7b, 0xe7, 0xee, 0x74, 0x66, 0xd3, 0x63, 0x2d, 0x62, 0xbb, 0x35, 0x97, 0x38, 0xe8, 0xab, 0x30,
+	0xd6, 0x4d, 0x2d, 0x46, 0x4b, 0xe5, 0x9c, 0x31, 0x31, 0x74, 0xe7, 0xee, 0xf4, 0x80, 0xd2, 0x17,
+	0x71, 0xd0, 0x25, 0x38, 0xb5, 0x9f, 0xae, 0xb2, 0xf2, 0x66, 0x2e, 0x31, 0x31, 0x7c, 0xe7, 0xee,
+	0xf4, 0x60, 0xa8, 0x58, 0x54, 0x00, 0x14, 0xa7, 0xd4, 0x78, 0xc9, 0x09, 0xb8, 0x73, 0x77, 0x3a,
+	0xad, 0x52, 0xc1, 0x44, 0xea, 0xfd, 0x5f, 0x4f, 0xf6, 0x5d, 0xfe, 0x31, 0x40, 0xc5, 0xab, 0xf9,
+	0xd8, 0x96, 0x29, 0x6f, 0x02, 0x4e, 0x57, 0x56, 0x96, 0xcd, 0x6b, 0x8b, 0x1b, 0x95, 0xd5, 0x95,
+	0x6e, 0xb1, 0xf7, 0xac, 0x95, 0x57, 0x37, 0x4b, 0x37, 0x97, 0xac, 0xf5, 0xca, 0x9b, 0x2b, 0x39,
+	0x03, 0x9d, 0x81, 0x93, 0x5d, 0x6b, 0xdf, 0x5b, 0xd9, 0xa8, 0xbc, 0xbd, 0x94, 0x4b, 0x94, 0xae,
+	0x7e, 0xfa, 0x64, 0xd2, 0x78, 0xf0, 0x64, 0xd2, 0xf8, 0xdb, 0x93, 0x49, 0xe3, 0xc3, 0xa7, 0x93,
+	0x7d, 0x0f, 0x9e, 0x4e, 0xf6, 0xfd, 0xe5, 0xe9, 0x64, 0xdf, 0x0f, 0xcf, 0x75, 0x25, 0x99, 0xe8,
+	0x9a, 0x15, 0x86, 0x64, 0xd5, 0xb4, 0x34, 0xcb, 0x37, 0xfe, 0x33, 0x00, 0xa6, 0xc5, 0xdc, 0x68,
+	0x79, 0x1d, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1523,733 +1655,818 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11605 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x23, 0x57,
-		0x76, 0x1f, 0x3c, 0x0d, 0x80, 0x20, 0x70, 0x00, 0x10, 0xcd, 0x4b, 0xce, 0x0c, 0x87, 0x23, 0x0d,
-		0x39, 0x18, 0x49, 0x33, 0x1a, 0x49, 0x1c, 0xcd, 0x48, 0x1a, 0x49, 0xd0, 0xcb, 0x00, 0x09, 0x72,
-		0x30, 0xe2, 0x6b, 0x1b, 0x20, 0x57, 0x92, 0x1f, 0xed, 0x66, 0xe3, 0x92, 0x6c, 0x0d, 0xd0, 0xdd,
-		0xdb, 0xdd, 0x98, 0x21, 0xf5, 0x7d, 0xf5, 0xd5, 0xfa, 0xf3, 0xe3, 0xb3, 0xe5, 0xc7, 0xb7, 0x8e,
-		0x1d, 0x7b, 0xbd, 0xbb, 0xb3, 0x5e, 0xdb, 0xb1, 0x77, 0xd7, 0x4e, 0x1c, 0x3b, 0xbb, 0x7e, 0xc5,
-		0x95, 0x87, 0x53, 0x89, 0x63, 0x3b, 0x15, 0x67, 0xe3, 0xaa, 0x24, 0x2e, 0x57, 0x59, 0xb1, 0x77,
-		0x5d, 0xe5, 0x8d, 0x77, 0xed, 0xd8, 0xce, 0xae, 0xcb, 0x95, 0xad, 0xa4, 0x52, 0xf7, 0xd5, 0x0f,
-		0xa0, 0x41, 0x80, 0x23, 0x69, 0xed, 0xd8, 0xf9, 0x67, 0x86, 0x7d, 0xef, 0x39, 0xbf, 0xbe, 0xf7,
-		0xdc, 0x73, 0xcf, 0x39, 0xf7, 0xdc, 0xdb, 0x17, 0xf0, 0xfb, 0x55, 0x98, 0xdf, 0xb3, 0xac, 0xbd,
-		0x36, 0xbe, 0x62, 0x3b, 0x96, 0x67, 0xed, 0x74, 0x77, 0xaf, 0xb4, 0xb0, 0xab, 0x3b, 0x86, 0xed,
-		0x59, 0xce, 0x02, 0x2d, 0x43, 0x45, 0x46, 0xb1, 0x20, 0x28, 0x4a, 0x6b, 0x30, 0xb9, 0x6c, 0xb4,
-		0xf1, 0x92, 0x4f, 0xd8, 0xc0, 0x1e, 0x7a, 0x06, 0x52, 0xbb, 0x46, 0x1b, 0xcf, 0x48, 0xf3, 0xc9,
-		0x4b, 0xb9, 0x6b, 0x0f, 0x2c, 0xf4, 0x30, 0x2d, 0x44, 0x39, 0x36, 0x49, 0xb1, 0x42, 0x39, 0x4a,
-		0xff, 0x33, 0x05, 0x53, 0x31, 0xb5, 0x08, 0x41, 0xca, 0xd4, 0x3a, 0x04, 0x51, 0xba, 0x94, 0x55,
-		0xe8, 0xdf, 0x68, 0x06, 0xc6, 0x6d, 0x4d, 0xbf, 0xa5, 0xed, 0xe1, 0x99, 0x04, 0x2d, 0x16, 0x8f,
-		0xe8, 0x1c, 0x40, 0x0b, 0xdb, 0xd8, 0x6c, 0x61, 0x53, 0x3f, 0x9c, 0x49, 0xce, 0x27, 0x2f, 0x65,
-		0x95, 0x50, 0x09, 0x7a, 0x04, 0x26, 0xed, 0xee, 0x4e, 0xdb, 0xd0, 0xd5, 0x10, 0x19, 0xcc, 0x27,
-		0x2f, 0x8d, 0x29, 0x32, 0xab, 0x58, 0x0a, 0x88, 0x2f, 0x42, 0xf1, 0x0e, 0xd6, 0x6e, 0x85, 0x49,
-		0x73, 0x94, 0x74, 0x82, 0x14, 0x87, 0x08, 0x17, 0x21, 0xdf, 0xc1, 0xae, 0xab, 0xed, 0x61, 0xd5,
-		0x3b, 0xb4, 0xf1, 0x4c, 0x8a, 0xf6, 0x7e, 0xbe, 0xaf, 0xf7, 0xbd, 0x3d, 0xcf, 0x71, 0xae, 0xe6,
-		0xa1, 0x8d, 0x51, 0x05, 0xb2, 0xd8, 0xec, 0x76, 0x18, 0xc2, 0xd8, 0x00, 0xf9, 0xd5, 0xcc, 0x6e,
-		0xa7, 0x17, 0x25, 0x43, 0xd8, 0x38, 0xc4, 0xb8, 0x8b, 0x9d, 0xdb, 0x86, 0x8e, 0x67, 0xd2, 0x14,
-		0xe0, 0x62, 0x1f, 0x40, 0x83, 0xd5, 0xf7, 0x62, 0x08, 0x3e, 0xb4, 0x08, 0x59, 0x7c, 0xe0, 0x61,
-		0xd3, 0x35, 0x2c, 0x73, 0x66, 0x9c, 0x82, 0x3c, 0x18, 0x33, 0x8a, 0xb8, 0xdd, 0xea, 0x85, 0x08,
-		0xf8, 0xd0, 0x75, 0x18, 0xb7, 0x6c, 0xcf, 0xb0, 0x4c, 0x77, 0x26, 0x33, 0x2f, 0x5d, 0xca, 0x5d,
-		0xbb, 0x2f, 0x56, 0x11, 0x36, 0x18, 0x8d, 0x22, 0x88, 0x51, 0x1d, 0x64, 0xd7, 0xea, 0x3a, 0x3a,
-		0x56, 0x75, 0xab, 0x85, 0x55, 0xc3, 0xdc, 0xb5, 0x66, 0xb2, 0x14, 0x60, 0xae, 0xbf, 0x23, 0x94,
-		0x70, 0xd1, 0x6a, 0xe1, 0xba, 0xb9, 0x6b, 0x29, 0x13, 0x6e, 0xe4, 0x19, 0x9d, 0x82, 0xb4, 0x7b,
-		0x68, 0x7a, 0xda, 0xc1, 0x4c, 0x9e, 0x6a, 0x08, 0x7f, 0x22, 0xaa, 0x83, 0x5b, 0x06, 0x79, 0xdd,
-		0x4c, 0x81, 0xa9, 0x0e, 0x7f, 0x2c, 0xfd, 0x52, 0x1a, 0x8a, 0xa3, 0x28, 0xdf, 0x73, 0x30, 0xb6,
-		0x4b, 0xfa, 0x3f, 0x93, 0x38, 0x8e, 0x74, 0x18, 0x4f, 0x54, 0xbc, 0xe9, 0x7b, 0x14, 0x6f, 0x05,
-		0x72, 0x26, 0x76, 0x3d, 0xdc, 0x62, 0xba, 0x92, 0x1c, 0x51, 0xdb, 0x80, 0x31, 0xf5, 0x2b, 0x5b,
-		0xea, 0x9e, 0x94, 0xed, 0x15, 0x28, 0xfa, 0x4d, 0x52, 0x1d, 0xcd, 0xdc, 0x13, 0x5a, 0x7b, 0x65,
-		0x58, 0x4b, 0x16, 0x6a, 0x82, 0x4f, 0x21, 0x6c, 0xca, 0x04, 0x8e, 0x3c, 0xa3, 0x25, 0x00, 0xcb,
-		0xc4, 0xd6, 0xae, 0xda, 0xc2, 0x7a, 0x7b, 0x26, 0x33, 0x40, 0x4a, 0x1b, 0x84, 0xa4, 0x4f, 0x4a,
-		0x16, 0x2b, 0xd5, 0xdb, 0xe8, 0xd9, 0x40, 0x09, 0xc7, 0x07, 0xe8, 0xd0, 0x1a, 0x9b, 0x7e, 0x7d,
-		0x7a, 0xb8, 0x05, 0x13, 0x0e, 0x26, 0x33, 0x02, 0xb7, 0x78, 0xcf, 0xb2, 0xb4, 0x11, 0x0b, 0x43,
-		0x7b, 0xa6, 0x70, 0x36, 0xd6, 0xb1, 0x82, 0x13, 0x7e, 0x44, 0x17, 0xc0, 0x2f, 0x50, 0xa9, 0x5a,
-		0x01, 0xb5, 0x4f, 0x79, 0x51, 0xb8, 0xae, 0x75, 0xf0, 0xec, 0x1b, 0x30, 0x11, 0x15, 0x0f, 0x9a,
-		0x86, 0x31, 0xd7, 0xd3, 0x1c, 0x8f, 0x6a, 0xe1, 0x98, 0xc2, 0x1e, 0x90, 0x0c, 0x49, 0x6c, 0xb6,
-		0xa8, 0xfd, 0x1b, 0x53, 0xc8, 0x9f, 0xe8, 0x6b, 0x82, 0x0e, 0x27, 0x69, 0x87, 0x1f, 0xea, 0x1f,
-		0xd1, 0x08, 0x72, 0x6f, 0xbf, 0x67, 0x9f, 0x86, 0x42, 0xa4, 0x03, 0xa3, 0xbe, 0xba, 0xf4, 0x7f,
-		0xc3, 0xc9, 0x58, 0x68, 0xf4, 0x0a, 0x4c, 0x77, 0x4d, 0xc3, 0xf4, 0xb0, 0x63, 0x3b, 0x98, 0x68,
-		0x2c, 0x7b, 0xd5, 0xcc, 0x1f, 0x8e, 0x0f, 0xd0, 0xb9, 0xad, 0x30, 0x35, 0x43, 0x51, 0xa6, 0xba,
-		0xfd, 0x85, 0x97, 0xb3, 0x99, 0xcf, 0x8f, 0xcb, 0xef, 0x7f, 0xff, 0xfb, 0xdf, 0x9f, 0x28, 0xfd,
-		0x4a, 0x1a, 0xa6, 0xe3, 0xe6, 0x4c, 0xec, 0xf4, 0x3d, 0x05, 0x69, 0xb3, 0xdb, 0xd9, 0xc1, 0x0e,
-		0x15, 0xd2, 0x98, 0xc2, 0x9f, 0x50, 0x05, 0xc6, 0xda, 0xda, 0x0e, 0x6e, 0xcf, 0xa4, 0xe6, 0xa5,
-		0x4b, 0x13, 0xd7, 0x1e, 0x19, 0x69, 0x56, 0x2e, 0xac, 0x12, 0x16, 0x85, 0x71, 0xa2, 0x17, 0x21,
-		0xc5, 0x8d, 0x37, 0x41, 0xb8, 0x3c, 0x1a, 0x02, 0x99, 0x4b, 0x0a, 0xe5, 0x43, 0x67, 0x21, 0x4b,
-		0xfe, 0x67, 0xba, 0x91, 0xa6, 0x6d, 0xce, 0x90, 0x02, 0xa2, 0x17, 0x68, 0x16, 0x32, 0x74, 0x9a,
-		0xb4, 0xb0, 0x70, 0x7a, 0xfe, 0x33, 0x51, 0xac, 0x16, 0xde, 0xd5, 0xba, 0x6d, 0x4f, 0xbd, 0xad,
-		0xb5, 0xbb, 0x98, 0x2a, 0x7c, 0x56, 0xc9, 0xf3, 0xc2, 0x6d, 0x52, 0x86, 0xe6, 0x20, 0xc7, 0x66,
-		0x95, 0x61, 0xb6, 0xf0, 0x01, 0xb5, 0xab, 0x63, 0x0a, 0x9b, 0x68, 0x75, 0x52, 0x42, 0x5e, 0xff,
-		0xba, 0x6b, 0x99, 0x42, 0x35, 0xe9, 0x2b, 0x48, 0x01, 0x7d, 0xfd, 0xd3, 0xbd, 0x26, 0xfd, 0xfe,
-		0xf8, 0xee, 0xf5, 0xcd, 0xa5, 0x8b, 0x50, 0xa4, 0x14, 0x4f, 0xf0, 0xa1, 0xd7, 0xda, 0x33, 0x93,
-		0xf3, 0xd2, 0xa5, 0x8c, 0x32, 0xc1, 0x8a, 0x37, 0x78, 0x69, 0xe9, 0xe7, 0x13, 0x90, 0xa2, 0x86,
-		0xa5, 0x08, 0xb9, 0xe6, 0xab, 0x9b, 0x35, 0x75, 0x69, 0x63, 0xab, 0xba, 0x5a, 0x93, 0x25, 0x34,
-		0x01, 0x40, 0x0b, 0x96, 0x57, 0x37, 0x2a, 0x4d, 0x39, 0xe1, 0x3f, 0xd7, 0xd7, 0x9b, 0xd7, 0x9f,
-		0x94, 0x93, 0x3e, 0xc3, 0x16, 0x2b, 0x48, 0x85, 0x09, 0x9e, 0xb8, 0x26, 0x8f, 0x21, 0x19, 0xf2,
-		0x0c, 0xa0, 0xfe, 0x4a, 0x6d, 0xe9, 0xfa, 0x93, 0x72, 0x3a, 0x5a, 0xf2, 0xc4, 0x35, 0x79, 0x1c,
-		0x15, 0x20, 0x4b, 0x4b, 0xaa, 0x1b, 0x1b, 0xab, 0x72, 0xc6, 0xc7, 0x6c, 0x34, 0x95, 0xfa, 0xfa,
-		0x8a, 0x9c, 0xf5, 0x31, 0x57, 0x94, 0x8d, 0xad, 0x4d, 0x19, 0x7c, 0x84, 0xb5, 0x5a, 0xa3, 0x51,
-		0x59, 0xa9, 0xc9, 0x39, 0x9f, 0xa2, 0xfa, 0x6a, 0xb3, 0xd6, 0x90, 0xf3, 0x91, 0x66, 0x3d, 0x71,
-		0x4d, 0x2e, 0xf8, 0xaf, 0xa8, 0xad, 0x6f, 0xad, 0xc9, 0x13, 0x68, 0x12, 0x0a, 0xec, 0x15, 0xa2,
-		0x11, 0xc5, 0x9e, 0xa2, 0xeb, 0x4f, 0xca, 0x72, 0xd0, 0x10, 0x86, 0x32, 0x19, 0x29, 0xb8, 0xfe,
-		0xa4, 0x8c, 0x4a, 0x8b, 0x30, 0x46, 0xd5, 0x10, 0x21, 0x98, 0x58, 0xad, 0x54, 0x6b, 0xab, 0xea,
-		0xc6, 0x66, 0xb3, 0xbe, 0xb1, 0x5e, 0x59, 0x95, 0xa5, 0xa0, 0x4c, 0xa9, 0xbd, 0x67, 0xab, 0xae,
-		0xd4, 0x96, 0xe4, 0x44, 0xb8, 0x6c, 0xb3, 0x56, 0x69, 0xd6, 0x96, 0xe4, 0x64, 0x49, 0x87, 0xe9,
-		0x38, 0x83, 0x1a, 0x3b, 0x85, 0x42, 0xba, 0x90, 0x18, 0xa0, 0x0b, 0x14, 0xab, 0x57, 0x17, 0x4a,
-		0x9f, 0x4b, 0xc0, 0x54, 0x8c, 0x53, 0x89, 0x7d, 0xc9, 0x4b, 0x30, 0xc6, 0x74, 0x99, 0xb9, 0xd9,
-		0x87, 0x63, 0xbd, 0x13, 0xd5, 0xec, 0x3e, 0x57, 0x4b, 0xf9, 0xc2, 0x41, 0x48, 0x72, 0x40, 0x10,
-		0x42, 0x20, 0xfa, 0x14, 0xf6, 0xeb, 0xfb, 0x8c, 0x3f, 0xf3, 0x8f, 0xd7, 0x47, 0xf1, 0x8f, 0xb4,
-		0xec, 0x78, 0x4e, 0x60, 0x2c, 0xc6, 0x09, 0x3c, 0x07, 0x93, 0x7d, 0x40, 0x23, 0x1b, 0xe3, 0x6f,
-		0x96, 0x60, 0x66, 0x90, 0x70, 0x86, 0x98, 0xc4, 0x44, 0xc4, 0x24, 0x3e, 0xd7, 0x2b, 0xc1, 0xf3,
-		0x83, 0x07, 0xa1, 0x6f, 0xac, 0x3f, 0x2e, 0xc1, 0xa9, 0xf8, 0x60, 0x33, 0xb6, 0x0d, 0x2f, 0x42,
-		0xba, 0x83, 0xbd, 0x7d, 0x4b, 0x84, 0x55, 0x0f, 0xc5, 0x38, 0x6b, 0x52, 0xdd, 0x3b, 0xd8, 0x9c,
-		0x2b, 0xec, 0xed, 0x93, 0x83, 0x22, 0x46, 0xd6, 0x9a, 0xbe, 0x96, 0x7e, 0x47, 0x02, 0x4e, 0xc6,
-		0x82, 0xc7, 0x36, 0xf4, 0x7e, 0x00, 0xc3, 0xb4, 0xbb, 0x1e, 0x0b, 0x9d, 0x98, 0x25, 0xce, 0xd2,
-		0x12, 0x6a, 0xbc, 0x88, 0x95, 0xed, 0x7a, 0x7e, 0x7d, 0x92, 0xd6, 0x03, 0x2b, 0xa2, 0x04, 0xcf,
-		0x04, 0x0d, 0x4d, 0xd1, 0x86, 0x9e, 0x1b, 0xd0, 0xd3, 0x3e, 0xc5, 0x7c, 0x1c, 0x64, 0xbd, 0x6d,
-		0x60, 0xd3, 0x53, 0x5d, 0xcf, 0xc1, 0x5a, 0xc7, 0x30, 0xf7, 0xa8, 0xab, 0xc9, 0x94, 0xc7, 0x76,
-		0xb5, 0xb6, 0x8b, 0x95, 0x22, 0xab, 0x6e, 0x88, 0x5a, 0xc2, 0x41, 0x15, 0xc8, 0x09, 0x71, 0xa4,
-		0x23, 0x1c, 0xac, 0xda, 0xe7, 0x28, 0x7d, 0x6f, 0x16, 0x72, 0xa1, 0xd0, 0x1c, 0x9d, 0x87, 0xfc,
-		0xeb, 0xda, 0x6d, 0x4d, 0x15, 0xcb, 0x2d, 0x26, 0x89, 0x1c, 0x29, 0xdb, 0xe4, 0x4b, 0xae, 0xc7,
-		0x61, 0x9a, 0x92, 0x58, 0x5d, 0x0f, 0x3b, 0xaa, 0xde, 0xd6, 0x5c, 0x97, 0x0a, 0x2d, 0x43, 0x49,
-		0x11, 0xa9, 0xdb, 0x20, 0x55, 0x8b, 0xa2, 0x06, 0x3d, 0x05, 0x53, 0x94, 0xa3, 0xd3, 0x6d, 0x7b,
-		0x86, 0xdd, 0xc6, 0x2a, 0x59, 0x00, 0xba, 0xd4, 0xe5, 0xf8, 0x2d, 0x9b, 0x24, 0x14, 0x6b, 0x9c,
-		0x80, 0xb4, 0xc8, 0x45, 0x4b, 0x70, 0x3f, 0x65, 0xdb, 0xc3, 0x26, 0x76, 0x34, 0x0f, 0xab, 0xf8,
-		0x7d, 0x5d, 0xad, 0xed, 0xaa, 0x9a, 0xd9, 0x52, 0xf7, 0x35, 0x77, 0x7f, 0x66, 0x9a, 0x00, 0x54,
-		0x13, 0x33, 0x92, 0x72, 0x86, 0x10, 0xae, 0x70, 0xba, 0x1a, 0x25, 0xab, 0x98, 0xad, 0x1b, 0x9a,
-		0xbb, 0x8f, 0xca, 0x70, 0x8a, 0xa2, 0xb8, 0x9e, 0x63, 0x98, 0x7b, 0xaa, 0xbe, 0x8f, 0xf5, 0x5b,
-		0x6a, 0xd7, 0xdb, 0x7d, 0x66, 0xe6, 0x6c, 0xf8, 0xfd, 0xb4, 0x85, 0x0d, 0x4a, 0xb3, 0x48, 0x48,
-		0xb6, 0xbc, 0xdd, 0x67, 0x50, 0x03, 0xf2, 0x64, 0x30, 0x3a, 0xc6, 0x1b, 0x58, 0xdd, 0xb5, 0x1c,
-		0xea, 0x43, 0x27, 0x62, 0x4c, 0x53, 0x48, 0x82, 0x0b, 0x1b, 0x9c, 0x61, 0xcd, 0x6a, 0xe1, 0xf2,
-		0x58, 0x63, 0xb3, 0x56, 0x5b, 0x52, 0x72, 0x02, 0x65, 0xd9, 0x72, 0x88, 0x42, 0xed, 0x59, 0xbe,
-		0x80, 0x73, 0x4c, 0xa1, 0xf6, 0x2c, 0x21, 0xde, 0xa7, 0x60, 0x4a, 0xd7, 0x59, 0x9f, 0x0d, 0x5d,
-		0xe5, 0xcb, 0x34, 0x77, 0x46, 0x8e, 0x08, 0x4b, 0xd7, 0x57, 0x18, 0x01, 0xd7, 0x71, 0x17, 0x3d,
-		0x0b, 0x27, 0x03, 0x61, 0x85, 0x19, 0x27, 0xfb, 0x7a, 0xd9, 0xcb, 0xfa, 0x14, 0x4c, 0xd9, 0x87,
-		0xfd, 0x8c, 0x28, 0xf2, 0x46, 0xfb, 0xb0, 0x97, 0xed, 0x69, 0x98, 0xb6, 0xf7, 0xed, 0x7e, 0xbe,
-		0xcb, 0x61, 0x3e, 0x64, 0xef, 0xdb, 0xbd, 0x8c, 0x0f, 0xd2, 0x35, 0xbb, 0x83, 0x75, 0xcd, 0xc3,
-		0xad, 0x99, 0xd3, 0x61, 0xf2, 0x50, 0x05, 0x5a, 0x00, 0x59, 0xd7, 0x55, 0x6c, 0x6a, 0x3b, 0x6d,
-		0xac, 0x6a, 0x0e, 0x36, 0x35, 0x77, 0x66, 0x8e, 0x12, 0xa7, 0x3c, 0xa7, 0x8b, 0x95, 0x09, 0x5d,
-		0xaf, 0xd1, 0xca, 0x0a, 0xad, 0x43, 0x97, 0x61, 0xd2, 0xda, 0x79, 0x5d, 0x67, 0x1a, 0xa9, 0xda,
-		0x0e, 0xde, 0x35, 0x0e, 0x66, 0x1e, 0xa0, 0xe2, 0x2d, 0x92, 0x0a, 0xaa, 0x8f, 0x9b, 0xb4, 0x18,
-		0x3d, 0x0c, 0xb2, 0xee, 0xee, 0x6b, 0x8e, 0x4d, 0x4d, 0xb2, 0x6b, 0x6b, 0x3a, 0x9e, 0x79, 0x90,
-		0x91, 0xb2, 0xf2, 0x75, 0x51, 0x4c, 0x66, 0x84, 0x7b, 0xc7, 0xd8, 0xf5, 0x04, 0xe2, 0x45, 0x36,
-		0x23, 0x68, 0x19, 0x47, 0xbb, 0x04, 0x32, 0x91, 0x44, 0xe4, 0xc5, 0x97, 0x28, 0xd9, 0x84, 0xbd,
-		0x6f, 0x87, 0xdf, 0x7b, 0x01, 0x0a, 0x84, 0x32, 0x78, 0xe9, 0xc3, 0x2c, 0x70, 0xb3, 0xf7, 0x43,
-		0x6f, 0x7c, 0x12, 0x4e, 0x11, 0xa2, 0x0e, 0xf6, 0xb4, 0x96, 0xe6, 0x69, 0x21, 0xea, 0x47, 0x29,
-		0x35, 0x11, 0xfb, 0x1a, 0xaf, 0x8c, 0xb4, 0xd3, 0xe9, 0xee, 0x1c, 0xfa, 0x8a, 0xf5, 0x18, 0x6b,
-		0x27, 0x29, 0x13, 0xaa, 0xf5, 0xae, 0x05, 0xe7, 0xa5, 0x32, 0xe4, 0xc3, 0x7a, 0x8f, 0xb2, 0xc0,
-		0x34, 0x5f, 0x96, 0x48, 0x10, 0xb4, 0xb8, 0xb1, 0x44, 0xc2, 0x97, 0xd7, 0x6a, 0x72, 0x82, 0x84,
-		0x51, 0xab, 0xf5, 0x66, 0x4d, 0x55, 0xb6, 0xd6, 0x9b, 0xf5, 0xb5, 0x9a, 0x9c, 0x0c, 0x05, 0xf6,
-		0x37, 0x53, 0x99, 0x87, 0xe4, 0x8b, 0xa5, 0x5f, 0x4e, 0xc2, 0x44, 0x74, 0xa5, 0x86, 0x9e, 0x87,
-		0xd3, 0x22, 0xe1, 0xe2, 0x62, 0x4f, 0xbd, 0x63, 0x38, 0x74, 0x42, 0x76, 0x34, 0xe6, 0x1c, 0x7d,
-		0xfd, 0x99, 0xe6, 0x54, 0x0d, 0xec, 0xbd, 0xd7, 0x70, 0xc8, 0x74, 0xeb, 0x68, 0x1e, 0x5a, 0x85,
-		0x39, 0xd3, 0x52, 0x5d, 0x4f, 0x33, 0x5b, 0x9a, 0xd3, 0x52, 0x83, 0x54, 0x97, 0xaa, 0xe9, 0x3a,
-		0x76, 0x5d, 0x8b, 0x39, 0x42, 0x1f, 0xe5, 0x3e, 0xd3, 0x6a, 0x70, 0xe2, 0xc0, 0x43, 0x54, 0x38,
-		0x69, 0x8f, 0xfa, 0x26, 0x07, 0xa9, 0xef, 0x59, 0xc8, 0x76, 0x34, 0x5b, 0xc5, 0xa6, 0xe7, 0x1c,
-		0xd2, 0xf8, 0x3c, 0xa3, 0x64, 0x3a, 0x9a, 0x5d, 0x23, 0xcf, 0x68, 0x1b, 0x1e, 0x0a, 0x48, 0xd5,
-		0x36, 0xde, 0xd3, 0xf4, 0x43, 0x95, 0x06, 0xe3, 0x34, 0x6d, 0xa0, 0xea, 0x96, 0xb9, 0xdb, 0x36,
-		0x74, 0xcf, 0xa5, 0xf6, 0x81, 0xd9, 0xb8, 0x52, 0xc0, 0xb1, 0x4a, 0x19, 0x6e, 0xba, 0x96, 0x49,
-		0x63, 0xf0, 0x45, 0x41, 0xfd, 0x55, 0x59, 0x7e, 0xdd, 0x4c, 0x65, 0x52, 0xf2, 0xd8, 0xcd, 0x54,
-		0x66, 0x4c, 0x4e, 0xdf, 0x4c, 0x65, 0xd2, 0xf2, 0xf8, 0xcd, 0x54, 0x26, 0x23, 0x67, 0x6f, 0xa6,
-		0x32, 0x59, 0x19, 0x4a, 0xbf, 0x90, 0x81, 0x7c, 0x78, 0x65, 0x40, 0x16, 0x5a, 0x3a, 0xf5, 0x8d,
-		0x12, 0xb5, 0x9e, 0x17, 0x8e, 0x5c, 0x47, 0x2c, 0x2c, 0x12, 0xa7, 0x59, 0x4e, 0xb3, 0x30, 0x5c,
-		0x61, 0x9c, 0x24, 0x60, 0x21, 0x6a, 0x8d, 0x59, 0xd8, 0x93, 0x51, 0xf8, 0x13, 0x5a, 0x81, 0xf4,
-		0xeb, 0x2e, 0xc5, 0x4e, 0x53, 0xec, 0x07, 0x8e, 0xc6, 0xbe, 0xd9, 0xa0, 0xe0, 0xd9, 0x9b, 0x0d,
-		0x75, 0x7d, 0x43, 0x59, 0xab, 0xac, 0x2a, 0x9c, 0x1d, 0x9d, 0x81, 0x54, 0x5b, 0x7b, 0xe3, 0x30,
-		0xea, 0x5e, 0x69, 0x11, 0x5a, 0x80, 0x62, 0xd7, 0xbc, 0x8d, 0x1d, 0x63, 0xd7, 0x20, 0x43, 0x45,
-		0xa8, 0x8a, 0x61, 0xaa, 0x89, 0xa0, 0x76, 0x95, 0xd0, 0x8f, 0xa8, 0x1e, 0x67, 0x20, 0x75, 0x07,
-		0x6b, 0xb7, 0xa2, 0x4e, 0x90, 0x16, 0xa1, 0x4b, 0x90, 0x6f, 0xe1, 0x9d, 0xee, 0x9e, 0xea, 0xe0,
-		0x96, 0xa6, 0x7b, 0x51, 0xd3, 0x9f, 0xa3, 0x55, 0x0a, 0xad, 0x41, 0x2f, 0x43, 0x96, 0x8c, 0x91,
-		0x49, 0xc7, 0x78, 0x92, 0x8a, 0xe0, 0xb1, 0xa3, 0x45, 0xc0, 0x87, 0x58, 0x30, 0x29, 0x01, 0x3f,
-		0x5a, 0x86, 0xb4, 0xa7, 0x39, 0x7b, 0xd8, 0xa3, 0x96, 0x7f, 0x22, 0x26, 0xf9, 0x11, 0x83, 0xd4,
-		0xa4, 0x1c, 0x74, 0x4d, 0xcb, 0xb9, 0xdf, 0x45, 0x2b, 0x73, 0x05, 0xc6, 0xa8, 0x7a, 0x20, 0x00,
-		0xae, 0x20, 0xf2, 0x09, 0x94, 0x81, 0xd4, 0xe2, 0x86, 0x42, 0x2c, 0x8d, 0x0c, 0x79, 0x56, 0xaa,
-		0x6e, 0xd6, 0x6b, 0x8b, 0x35, 0x39, 0x51, 0x7a, 0x0a, 0xd2, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x47,
-		0x5d, 0x3e, 0xc1, 0x1f, 0x39, 0x86, 0x24, 0x6a, 0xb7, 0xd6, 0xaa, 0x35, 0x45, 0x4e, 0x94, 0xb6,
-		0xa0, 0xd8, 0x23, 0x27, 0x74, 0x12, 0x26, 0x95, 0x5a, 0xb3, 0xb6, 0x4e, 0xd6, 0x59, 0xea, 0xd6,
-		0xfa, 0xcb, 0xeb, 0x1b, 0xef, 0x5d, 0x97, 0x4f, 0x44, 0x8b, 0x85, 0x49, 0x93, 0xd0, 0x34, 0xc8,
-		0x41, 0x71, 0x63, 0x63, 0x4b, 0xa1, 0xad, 0xf9, 0xae, 0x04, 0xc8, 0xbd, 0x52, 0x43, 0xa7, 0x61,
-		0xaa, 0x59, 0x51, 0x56, 0x6a, 0x4d, 0x95, 0xad, 0x1d, 0x7d, 0xe8, 0x69, 0x90, 0xc3, 0x15, 0xcb,
-		0x75, 0xba, 0x34, 0x9e, 0x83, 0xb3, 0xe1, 0xd2, 0xda, 0x2b, 0xcd, 0xda, 0x7a, 0x83, 0xbe, 0xbc,
-		0xb2, 0xbe, 0x42, 0xec, 0x6b, 0x0f, 0x9e, 0x58, 0xad, 0x26, 0x49, 0x53, 0xa3, 0x78, 0xb5, 0xd5,
-		0x25, 0x39, 0xd5, 0x5b, 0xbc, 0xb1, 0x5e, 0xdb, 0x58, 0x96, 0xc7, 0x7a, 0xdf, 0x4e, 0x57, 0xb0,
-		0x69, 0x34, 0x0b, 0xa7, 0x7a, 0x4b, 0xd5, 0xda, 0x7a, 0x53, 0x79, 0x55, 0x1e, 0xef, 0x7d, 0x71,
-		0xa3, 0xa6, 0x6c, 0xd7, 0x17, 0x6b, 0x72, 0x06, 0x9d, 0x02, 0x14, 0x6d, 0x51, 0xf3, 0xc6, 0xc6,
-		0x92, 0x9c, 0xed, 0xb3, 0x28, 0x25, 0x17, 0xf2, 0xe1, 0x65, 0xe4, 0x57, 0x27, 0x97, 0xf4, 0xc1,
-		0x04, 0xe4, 0x42, 0xcb, 0x42, 0x12, 0xcf, 0x6b, 0xed, 0xb6, 0x75, 0x47, 0xd5, 0xda, 0x86, 0xe6,
-		0x72, 0x7b, 0x03, 0xb4, 0xa8, 0x42, 0x4a, 0x46, 0x9d, 0xdf, 0xa3, 0x5b, 0xf8, 0xf4, 0x5f, 0x47,
-		0x0b, 0x3f, 0x26, 0xa7, 0x4b, 0x1f, 0x95, 0x40, 0xee, 0x5d, 0xef, 0xf5, 0x74, 0x5f, 0x1a, 0xd4,
-		0xfd, 0xaf, 0xca, 0xd8, 0x7d, 0x44, 0x82, 0x89, 0xe8, 0x22, 0xaf, 0xa7, 0x79, 0xe7, 0xff, 0x4a,
-		0x9b, 0xf7, 0x7b, 0x09, 0x28, 0x44, 0x96, 0x76, 0xa3, 0xb6, 0xee, 0x7d, 0x30, 0x69, 0xb4, 0x70,
-		0xc7, 0xb6, 0x3c, 0x6c, 0xea, 0x87, 0x6a, 0x1b, 0xdf, 0xc6, 0xed, 0x99, 0x12, 0x35, 0xca, 0x57,
-		0x8e, 0x5e, 0x3c, 0x2e, 0xd4, 0x03, 0xbe, 0x55, 0xc2, 0x56, 0x9e, 0xaa, 0x2f, 0xd5, 0xd6, 0x36,
-		0x37, 0x9a, 0xb5, 0xf5, 0xc5, 0x57, 0x85, 0x75, 0x51, 0x64, 0xa3, 0x87, 0xec, 0x5d, 0x34, 0xda,
-		0x9b, 0x20, 0xf7, 0x36, 0x8a, 0xd8, 0x8a, 0x98, 0x66, 0xc9, 0x27, 0xd0, 0x14, 0x14, 0xd7, 0x37,
-		0xd4, 0x46, 0x7d, 0xa9, 0xa6, 0xd6, 0x96, 0x97, 0x6b, 0x8b, 0xcd, 0x06, 0x4b, 0x07, 0xfa, 0xd4,
-		0x4d, 0x39, 0x11, 0x16, 0xf1, 0x87, 0x92, 0x30, 0x15, 0xd3, 0x12, 0x54, 0xe1, 0x0b, 0x79, 0x96,
-		0x5b, 0x78, 0x6c, 0x94, 0xd6, 0x2f, 0x90, 0x50, 0x7a, 0x53, 0x73, 0x3c, 0xbe, 0xee, 0x7f, 0x18,
-		0x88, 0x94, 0x4c, 0x8f, 0x78, 0x76, 0x87, 0xa7, 0x59, 0xd9, 0xea, 0xbe, 0x18, 0x94, 0xb3, 0x4c,
-		0xeb, 0xa3, 0x80, 0x6c, 0xcb, 0x35, 0x3c, 0xe3, 0x36, 0x56, 0x0d, 0x53, 0xe4, 0x64, 0xc9, 0x6a,
-		0x3f, 0xa5, 0xc8, 0xa2, 0xa6, 0x6e, 0x7a, 0x3e, 0xb5, 0x89, 0xf7, 0xb4, 0x1e, 0x6a, 0x12, 0x79,
-		0x24, 0x15, 0x59, 0xd4, 0xf8, 0xd4, 0xe7, 0x21, 0xdf, 0xb2, 0xba, 0x64, 0x09, 0xc4, 0xe8, 0x88,
-		0xb5, 0x90, 0x94, 0x1c, 0x2b, 0xf3, 0x49, 0xf8, 0xe2, 0x36, 0x48, 0x06, 0xe7, 0x95, 0x1c, 0x2b,
-		0x63, 0x24, 0x17, 0xa1, 0xa8, 0xed, 0xed, 0x39, 0x04, 0x5c, 0x00, 0xb1, 0xe5, 0xfa, 0x84, 0x5f,
-		0x4c, 0x09, 0x67, 0x6f, 0x42, 0x46, 0xc8, 0x81, 0x44, 0xb0, 0x44, 0x12, 0xaa, 0xcd, 0x72, 0x50,
-		0x89, 0x4b, 0x59, 0x25, 0x63, 0x8a, 0xca, 0xf3, 0x90, 0x37, 0x5c, 0x35, 0xd8, 0xdb, 0x4a, 0xcc,
-		0x27, 0x2e, 0x65, 0x94, 0x9c, 0xe1, 0xfa, 0xfb, 0x02, 0xa5, 0x8f, 0x27, 0x60, 0x22, 0xba, 0x6b,
-		0x87, 0x96, 0x20, 0xd3, 0xb6, 0x74, 0x8d, 0xaa, 0x16, 0xdb, 0x32, 0xbe, 0x34, 0x64, 0xa3, 0x6f,
-		0x61, 0x95, 0xd3, 0x2b, 0x3e, 0xe7, 0xec, 0x6f, 0x4a, 0x90, 0x11, 0xc5, 0xe8, 0x14, 0xa4, 0x6c,
-		0xcd, 0xdb, 0xa7, 0x70, 0x63, 0xd5, 0x84, 0x2c, 0x29, 0xf4, 0x99, 0x94, 0xbb, 0xb6, 0x66, 0x52,
-		0x15, 0xe0, 0xe5, 0xe4, 0x99, 0x8c, 0x6b, 0x1b, 0x6b, 0x2d, 0x9a, 0x0b, 0xb0, 0x3a, 0x1d, 0x6c,
-		0x7a, 0xae, 0x18, 0x57, 0x5e, 0xbe, 0xc8, 0x8b, 0xd1, 0x23, 0x30, 0xe9, 0x39, 0x9a, 0xd1, 0x8e,
-		0xd0, 0xa6, 0x28, 0xad, 0x2c, 0x2a, 0x7c, 0xe2, 0x32, 0x9c, 0x11, 0xb8, 0x2d, 0xec, 0x69, 0xfa,
-		0x3e, 0x6e, 0x05, 0x4c, 0x69, 0x9a, 0xf3, 0x3b, 0xcd, 0x09, 0x96, 0x78, 0xbd, 0xe0, 0x2d, 0x7d,
-		0x26, 0x01, 0x93, 0x22, 0x7b, 0xd1, 0xf2, 0x85, 0xb5, 0x06, 0xa0, 0x99, 0xa6, 0xe5, 0x85, 0xc5,
-		0xd5, 0xaf, 0xca, 0x7d, 0x7c, 0x0b, 0x15, 0x9f, 0x49, 0x09, 0x01, 0xcc, 0x7e, 0x41, 0x02, 0x08,
-		0xaa, 0x06, 0xca, 0x6d, 0x0e, 0x72, 0x7c, 0x4f, 0x96, 0x6e, 0xec, 0xb3, 0x84, 0x17, 0xb0, 0xa2,
-		0x65, 0xa3, 0x4d, 0xd3, 0x92, 0x3b, 0x78, 0xcf, 0x30, 0xf9, 0x7e, 0x0a, 0x7b, 0x10, 0x69, 0xc9,
-		0x54, 0xb0, 0x3d, 0xa5, 0x40, 0xc6, 0xc5, 0x1d, 0xcd, 0xf4, 0x0c, 0x9d, 0xef, 0x90, 0x5c, 0x3f,
-		0x56, 0xe3, 0x17, 0x1a, 0x9c, 0x5b, 0xf1, 0x71, 0x4a, 0x97, 0x20, 0x23, 0x4a, 0x49, 0xe0, 0xb7,
-		0xbe, 0xb1, 0x5e, 0x93, 0x4f, 0xa0, 0x71, 0x48, 0x36, 0x6a, 0x4d, 0x59, 0x22, 0xcb, 0xce, 0xca,
-		0x6a, 0xbd, 0xd2, 0x90, 0x13, 0xd5, 0xff, 0x07, 0xa6, 0x74, 0xab, 0xd3, 0xfb, 0xc2, 0xaa, 0xdc,
-		0x93, 0xf2, 0x73, 0x6f, 0x48, 0xaf, 0x3d, 0xc6, 0x89, 0xf6, 0xac, 0xb6, 0x66, 0xee, 0x2d, 0x58,
-		0xce, 0x5e, 0x70, 0x2c, 0x82, 0xac, 0x0e, 0xdc, 0xd0, 0xe1, 0x08, 0x7b, 0xe7, 0x2f, 0x25, 0xe9,
-		0x47, 0x13, 0xc9, 0x95, 0xcd, 0xea, 0x4f, 0x26, 0x66, 0x57, 0x18, 0xe3, 0xa6, 0xe8, 0x8e, 0x82,
-		0x77, 0xdb, 0x58, 0x27, 0x8d, 0x87, 0x3f, 0x7e, 0x04, 0xa6, 0xf7, 0xac, 0x3d, 0x8b, 0x22, 0x5d,
-		0x21, 0x7f, 0xf1, 0x73, 0x15, 0x59, 0xbf, 0x74, 0x76, 0xe8, 0x21, 0x8c, 0xf2, 0x3a, 0x4c, 0x71,
-		0x62, 0x95, 0x6e, 0xdf, 0xb2, 0xe4, 0x02, 0x3a, 0x32, 0xb3, 0x3d, 0xf3, 0xb3, 0x7f, 0x40, 0xa3,
-		0x12, 0x65, 0x92, 0xb3, 0x92, 0x3a, 0x96, 0x7f, 0x28, 0x2b, 0x70, 0x32, 0x82, 0xc7, 0x6c, 0x04,
-		0x76, 0x86, 0x20, 0xfe, 0x2b, 0x8e, 0x38, 0x15, 0x42, 0x6c, 0x70, 0xd6, 0xf2, 0x22, 0x14, 0x8e,
-		0x83, 0xf5, 0xab, 0x1c, 0x2b, 0x8f, 0xc3, 0x20, 0x2b, 0x50, 0xa4, 0x20, 0x7a, 0xd7, 0xf5, 0xac,
-		0x0e, 0x35, 0xc0, 0x47, 0xc3, 0xfc, 0xeb, 0x3f, 0x60, 0x93, 0x76, 0x82, 0xb0, 0x2d, 0xfa, 0x5c,
-		0xe5, 0x32, 0xd0, 0x1d, 0xeb, 0x16, 0xd6, 0xdb, 0x43, 0x10, 0x7e, 0x8d, 0x37, 0xc4, 0xa7, 0x2f,
-		0x6f, 0xc3, 0x34, 0xf9, 0x9b, 0xda, 0xc7, 0x70, 0x4b, 0x86, 0xa7, 0xc1, 0x67, 0xfe, 0xfd, 0x37,
-		0x33, 0xbb, 0x30, 0xe5, 0x03, 0x84, 0xda, 0x14, 0x1a, 0xc5, 0x3d, 0xec, 0x79, 0xd8, 0x71, 0x55,
-		0xad, 0x1d, 0xd7, 0xbc, 0x50, 0x1e, 0x71, 0xe6, 0x87, 0xbe, 0x18, 0x1d, 0xc5, 0x15, 0xc6, 0x59,
-		0x69, 0xb7, 0xcb, 0x5b, 0x70, 0x3a, 0x46, 0x2b, 0x46, 0xc0, 0xfc, 0x10, 0xc7, 0x9c, 0xee, 0xd3,
-		0x0c, 0x02, 0xbb, 0x09, 0xa2, 0xdc, 0x1f, 0xcb, 0x11, 0x30, 0x3f, 0xcc, 0x31, 0x11, 0xe7, 0x15,
-		0x43, 0x4a, 0x10, 0x6f, 0xc2, 0xe4, 0x6d, 0xec, 0xec, 0x58, 0x2e, 0xcf, 0xdd, 0x8e, 0x00, 0xf7,
-		0x11, 0x0e, 0x57, 0xe4, 0x8c, 0x34, 0x99, 0x4b, 0xb0, 0x9e, 0x85, 0xcc, 0xae, 0xa6, 0xe3, 0x11,
-		0x20, 0xee, 0x72, 0x88, 0x71, 0x42, 0x4f, 0x58, 0x2b, 0x90, 0xdf, 0xb3, 0xb8, 0x8b, 0x1c, 0xce,
-		0xfe, 0x51, 0xce, 0x9e, 0x13, 0x3c, 0x1c, 0xc2, 0xb6, 0xec, 0x6e, 0x9b, 0xf8, 0xcf, 0xe1, 0x10,
-		0x3f, 0x2c, 0x20, 0x04, 0x0f, 0x87, 0x38, 0x86, 0x58, 0x3f, 0x26, 0x20, 0xdc, 0x90, 0x3c, 0x5f,
-		0x82, 0x9c, 0x65, 0xb6, 0x0f, 0x2d, 0x73, 0x94, 0x46, 0xfc, 0x08, 0x47, 0x00, 0xce, 0x42, 0x00,
-		0x9e, 0x83, 0xec, 0xa8, 0x03, 0xf1, 0xe3, 0x5f, 0x14, 0xd3, 0x43, 0x8c, 0xc0, 0x0a, 0x14, 0x85,
-		0x81, 0x32, 0x2c, 0x73, 0x04, 0x88, 0x9f, 0xe0, 0x10, 0x13, 0x21, 0x36, 0xde, 0x0d, 0x0f, 0xbb,
-		0xde, 0x1e, 0x1e, 0x05, 0xe4, 0xe3, 0xa2, 0x1b, 0x9c, 0x85, 0x8b, 0x72, 0x07, 0x9b, 0xfa, 0xfe,
-		0x68, 0x08, 0x9f, 0x10, 0xa2, 0x14, 0x3c, 0x04, 0x62, 0x11, 0x0a, 0x1d, 0xcd, 0x71, 0xf7, 0xb5,
-		0xf6, 0x48, 0xc3, 0xf1, 0x49, 0x8e, 0x91, 0xf7, 0x99, 0xb8, 0x44, 0xba, 0xe6, 0x71, 0x60, 0x7e,
-		0x52, 0x48, 0x24, 0xc4, 0xc6, 0xa7, 0x9e, 0xeb, 0xd1, 0x44, 0xf7, 0x71, 0xd0, 0x7e, 0x4a, 0x4c,
-		0x3d, 0xc6, 0xbb, 0x16, 0x46, 0x7c, 0x0e, 0xb2, 0xae, 0xf1, 0xc6, 0x48, 0x30, 0x7f, 0x5f, 0x8c,
-		0x34, 0x65, 0x20, 0xcc, 0xaf, 0xc2, 0x99, 0x58, 0x37, 0x31, 0x02, 0xd8, 0x3f, 0xe0, 0x60, 0xa7,
-		0x62, 0x5c, 0x05, 0x37, 0x09, 0xc7, 0x85, 0xfc, 0x69, 0x61, 0x12, 0x70, 0x0f, 0xd6, 0x26, 0x59,
-		0xb4, 0xb8, 0xda, 0xee, 0xf1, 0xa4, 0xf6, 0x0f, 0x85, 0xd4, 0x18, 0x6f, 0x44, 0x6a, 0x4d, 0x38,
-		0xc5, 0x11, 0x8f, 0x37, 0xae, 0x3f, 0x23, 0x0c, 0x2b, 0xe3, 0xde, 0x8a, 0x8e, 0xee, 0xd7, 0xc2,
-		0xac, 0x2f, 0x4e, 0x11, 0x1d, 0xbb, 0x6a, 0x47, 0xb3, 0x47, 0x40, 0xfe, 0x59, 0x8e, 0x2c, 0x2c,
-		0xbe, 0x1f, 0x5e, 0xbb, 0x6b, 0x9a, 0x4d, 0xc0, 0x5f, 0x81, 0x19, 0x01, 0xde, 0x35, 0x1d, 0xac,
-		0x5b, 0x7b, 0xa6, 0xf1, 0x06, 0x6e, 0x8d, 0x00, 0xfd, 0x8f, 0x7a, 0x86, 0x6a, 0x2b, 0xc4, 0x4e,
-		0x90, 0xeb, 0x20, 0xfb, 0xb1, 0x8a, 0x6a, 0x74, 0x6c, 0xcb, 0xf1, 0x86, 0x20, 0x7e, 0x4a, 0x8c,
-		0x94, 0xcf, 0x57, 0xa7, 0x6c, 0xe5, 0x1a, 0xb0, 0xd3, 0x1f, 0xa3, 0xaa, 0xe4, 0xa7, 0x39, 0x50,
-		0x21, 0xe0, 0xe2, 0x86, 0x43, 0xb7, 0x3a, 0xb6, 0xe6, 0x8c, 0x62, 0xff, 0x7e, 0x4e, 0x18, 0x0e,
-		0xce, 0xc2, 0x0d, 0x07, 0x89, 0xe8, 0x88, 0xb7, 0x1f, 0x01, 0xe1, 0xe7, 0x85, 0xe1, 0x10, 0x3c,
-		0x1c, 0x42, 0x04, 0x0c, 0x23, 0x40, 0xfc, 0x82, 0x80, 0x10, 0x3c, 0x04, 0xe2, 0x3d, 0x81, 0xa3,
-		0x75, 0xf0, 0x9e, 0xe1, 0x7a, 0x0e, 0x0b, 0xc9, 0x8f, 0x86, 0xfa, 0xc5, 0x2f, 0x46, 0x83, 0x30,
-		0x25, 0xc4, 0x4a, 0x2c, 0x11, 0xdf, 0xfa, 0xa0, 0x4b, 0xb6, 0xe1, 0x0d, 0xfb, 0x25, 0x61, 0x89,
-		0x42, 0x6c, 0xa4, 0x6d, 0xa1, 0x08, 0x91, 0x88, 0x5d, 0x27, 0x0b, 0x95, 0x11, 0xe0, 0xfe, 0x71,
-		0x4f, 0xe3, 0x1a, 0x82, 0x97, 0x60, 0x86, 0xe2, 0x9f, 0xae, 0x79, 0x0b, 0x1f, 0x8e, 0xa4, 0x9d,
-		0xbf, 0xdc, 0x13, 0xff, 0x6c, 0x31, 0x4e, 0x66, 0x43, 0x8a, 0x3d, 0xf1, 0x14, 0x1a, 0x76, 0xd6,
-		0x6f, 0xe6, 0x9b, 0xbe, 0xc4, 0xfb, 0x1b, 0x0d, 0xa7, 0xca, 0xab, 0x44, 0xc9, 0xa3, 0x41, 0xcf,
-		0x70, 0xb0, 0x6f, 0xfe, 0x92, 0xaf, 0xe7, 0x91, 0x98, 0xa7, 0xbc, 0x0c, 0x85, 0x48, 0xc0, 0x33,
-		0x1c, 0xea, 0x5b, 0x38, 0x54, 0x3e, 0x1c, 0xef, 0x94, 0x9f, 0x82, 0x14, 0x09, 0x5e, 0x86, 0xb3,
-		0x7f, 0x2b, 0x67, 0xa7, 0xe4, 0xe5, 0x17, 0x20, 0x23, 0x82, 0x96, 0xe1, 0xac, 0xdf, 0xc6, 0x59,
-		0x7d, 0x16, 0xc2, 0x2e, 0x02, 0x96, 0xe1, 0xec, 0xff, 0x9f, 0x60, 0x17, 0x2c, 0x84, 0x7d, 0x74,
-		0x11, 0xfe, 0xf3, 0xef, 0x4c, 0x71, 0xa7, 0x23, 0x64, 0xf7, 0x1c, 0x8c, 0xf3, 0x48, 0x65, 0x38,
-		0xf7, 0x77, 0xf0, 0x97, 0x0b, 0x8e, 0xf2, 0xd3, 0x30, 0x36, 0xa2, 0xc0, 0xbf, 0x9b, 0xb3, 0x32,
-		0xfa, 0xf2, 0x22, 0xe4, 0x42, 0xd1, 0xc9, 0x70, 0xf6, 0xef, 0xe1, 0xec, 0x61, 0x2e, 0xd2, 0x74,
-		0x1e, 0x9d, 0x0c, 0x07, 0xf8, 0xff, 0x45, 0xd3, 0x39, 0x07, 0x11, 0x9b, 0x08, 0x4c, 0x86, 0x73,
-		0x7f, 0x40, 0x48, 0x5d, 0xb0, 0x94, 0x5f, 0x82, 0xac, 0xef, 0x6c, 0x86, 0xf3, 0x7f, 0x2f, 0xe7,
-		0x0f, 0x78, 0x88, 0x04, 0x42, 0xce, 0x6e, 0x38, 0xc4, 0xdf, 0x11, 0x12, 0x08, 0x71, 0x91, 0x69,
-		0xd4, 0x1b, 0xc0, 0x0c, 0x47, 0xfa, 0x3e, 0x31, 0x8d, 0x7a, 0xe2, 0x17, 0x32, 0x9a, 0xd4, 0xe6,
-		0x0f, 0x87, 0xf8, 0x7e, 0x31, 0x9a, 0x94, 0x9e, 0x34, 0xa3, 0x37, 0x22, 0x18, 0x8e, 0xf1, 0x83,
-		0xa2, 0x19, 0x3d, 0x01, 0x41, 0x79, 0x13, 0x50, 0x7f, 0x34, 0x30, 0x1c, 0xef, 0x83, 0x1c, 0x6f,
-		0xb2, 0x2f, 0x18, 0x28, 0xbf, 0x17, 0x4e, 0xc5, 0x47, 0x02, 0xc3, 0x51, 0x7f, 0xe8, 0x4b, 0x3d,
-		0x6b, 0xb7, 0x70, 0x20, 0x50, 0x6e, 0x06, 0x2e, 0x25, 0x1c, 0x05, 0x0c, 0x87, 0xfd, 0xd0, 0x97,
-		0xa2, 0x86, 0x3b, 0x1c, 0x04, 0x94, 0x2b, 0x00, 0x81, 0x03, 0x1e, 0x8e, 0xf5, 0x11, 0x8e, 0x15,
-		0x62, 0x22, 0x53, 0x83, 0xfb, 0xdf, 0xe1, 0xfc, 0x77, 0xc5, 0xd4, 0xe0, 0x1c, 0x64, 0x6a, 0x08,
-		0xd7, 0x3b, 0x9c, 0xfb, 0xa3, 0x62, 0x6a, 0x08, 0x16, 0xa2, 0xd9, 0x21, 0xef, 0x36, 0x1c, 0xe1,
-		0x47, 0x84, 0x66, 0x87, 0xb8, 0xca, 0xeb, 0x30, 0xd9, 0xe7, 0x10, 0x87, 0x43, 0xfd, 0x28, 0x87,
-		0x92, 0x7b, 0xfd, 0x61, 0xd8, 0x79, 0x71, 0x67, 0x38, 0x1c, 0xed, 0xc7, 0x7a, 0x9c, 0x17, 0xf7,
-		0x85, 0xe5, 0xe7, 0x20, 0x63, 0x76, 0xdb, 0x6d, 0x32, 0x79, 0xd0, 0xd1, 0xe7, 0x73, 0x67, 0xfe,
-		0xcb, 0x57, 0xb8, 0x74, 0x04, 0x43, 0xf9, 0x29, 0x18, 0xc3, 0x9d, 0x1d, 0xdc, 0x1a, 0xc6, 0xf9,
-		0x47, 0x5f, 0x11, 0x06, 0x93, 0x50, 0x97, 0x5f, 0x02, 0x60, 0xa9, 0x11, 0xba, 0x71, 0x3e, 0x84,
-		0xf7, 0x0b, 0x5f, 0xe1, 0x07, 0xe2, 0x02, 0x96, 0x00, 0x80, 0x1d, 0xaf, 0x3b, 0x1a, 0xe0, 0x8b,
-		0x51, 0x00, 0x3a, 0x22, 0xcf, 0xc2, 0xf8, 0xeb, 0xae, 0x65, 0x7a, 0xda, 0xde, 0x30, 0xee, 0x3f,
-		0xe6, 0xdc, 0x82, 0x9e, 0x08, 0xac, 0x63, 0x39, 0xd8, 0xd3, 0xf6, 0xdc, 0x61, 0xbc, 0x7f, 0xc2,
-		0x79, 0x7d, 0x06, 0xc2, 0xac, 0x6b, 0xae, 0x37, 0x4a, 0xbf, 0xff, 0xab, 0x60, 0x16, 0x0c, 0xa4,
-		0xd1, 0xe4, 0xef, 0x5b, 0xf8, 0x70, 0x18, 0xef, 0x9f, 0x8a, 0x46, 0x73, 0xfa, 0xf2, 0x0b, 0x90,
-		0x25, 0x7f, 0xb2, 0x53, 0xae, 0x43, 0x98, 0xff, 0x8c, 0x33, 0x07, 0x1c, 0xe4, 0xcd, 0xae, 0xd7,
-		0xf2, 0x8c, 0xe1, 0xc2, 0xfe, 0x73, 0x3e, 0xd2, 0x82, 0xbe, 0x5c, 0x81, 0x9c, 0xeb, 0xb5, 0x5a,
-		0x5d, 0x1e, 0x9f, 0x0e, 0x61, 0xff, 0x6f, 0x5f, 0xf1, 0x53, 0x16, 0x3e, 0x0f, 0x19, 0xed, 0x3b,
-		0xb7, 0x3c, 0xdb, 0xa2, 0xfb, 0x2d, 0xc3, 0x10, 0xbe, 0xc4, 0x11, 0x42, 0x2c, 0xe5, 0x45, 0xc8,
-		0x93, 0xbe, 0x38, 0xd8, 0xc6, 0x74, 0x73, 0x6c, 0x08, 0xc4, 0x97, 0xb9, 0x00, 0x22, 0x4c, 0xd5,
-		0x6f, 0xfc, 0xb5, 0xcf, 0x9e, 0x93, 0x3e, 0xf3, 0xd9, 0x73, 0xd2, 0xef, 0x7d, 0xf6, 0x9c, 0xf4,
-		0x81, 0xcf, 0x9d, 0x3b, 0xf1, 0x99, 0xcf, 0x9d, 0x3b, 0xf1, 0xdb, 0x9f, 0x3b, 0x77, 0x22, 0x3e,
-		0x4b, 0x0c, 0x2b, 0xd6, 0x8a, 0xc5, 0xf2, 0xc3, 0xaf, 0x3d, 0xb8, 0x67, 0x78, 0xfb, 0xdd, 0x9d,
-		0x05, 0xdd, 0xea, 0x5c, 0xd1, 0x2d, 0xb7, 0x63, 0xb9, 0x57, 0xa2, 0x79, 0x5d, 0xfa, 0x17, 0xfc,
-		0x0f, 0x89, 0xac, 0x99, 0xa3, 0xe9, 0x5c, 0xcd, 0x3c, 0x1c, 0xf4, 0x31, 0xdd, 0x75, 0x48, 0x56,
-		0xcc, 0x43, 0x74, 0x86, 0x19, 0x38, 0xb5, 0xeb, 0xb4, 0xf9, 0x51, 0xcb, 0x71, 0xf2, 0xbc, 0xe5,
-		0xb4, 0xd1, 0x74, 0x70, 0x1e, 0x5a, 0xba, 0x94, 0xe7, 0x87, 0x9c, 0xab, 0xdf, 0x23, 0x1d, 0xaf,
-		0x27, 0x99, 0x8a, 0x79, 0x48, 0x3b, 0xb2, 0x29, 0xbd, 0xf6, 0xe8, 0xd0, 0x3c, 0xf7, 0x2d, 0xd3,
-		0xba, 0x63, 0x92, 0x66, 0xdb, 0x3b, 0x22, 0xc7, 0x7d, 0xae, 0x37, 0xc7, 0xfd, 0x5e, 0xdc, 0x6e,
-		0xbf, 0x4c, 0xe8, 0x9a, 0x84, 0x65, 0x27, 0xcd, 0x4e, 0xf5, 0xc3, 0xf7, 0x25, 0xe0, 0x5c, 0x5f,
-		0x3a, 0x9b, 0x2b, 0xc1, 0x20, 0x21, 0x94, 0x21, 0xb3, 0x24, 0x74, 0x6b, 0x06, 0xc6, 0x5d, 0xac,
-		0x5b, 0x66, 0xcb, 0xa5, 0x82, 0x48, 0x2a, 0xe2, 0x91, 0x08, 0xc2, 0xd4, 0x4c, 0xcb, 0xe5, 0x87,
-		0x95, 0xd9, 0x43, 0xf5, 0xc3, 0xc7, 0x14, 0x44, 0x41, 0xbc, 0x49, 0x48, 0xe3, 0xea, 0x88, 0xd2,
-		0x10, 0x9d, 0x88, 0x64, 0xfe, 0x47, 0x95, 0xca, 0x0f, 0x26, 0x60, 0xae, 0x57, 0x2a, 0x64, 0x66,
-		0xb9, 0x9e, 0xd6, 0xb1, 0x07, 0x89, 0xe5, 0x39, 0xc8, 0x36, 0x05, 0xcd, 0xb1, 0xe5, 0x72, 0xf7,
-		0x98, 0x72, 0x99, 0xf0, 0x5f, 0x25, 0x04, 0x73, 0x6d, 0x44, 0xc1, 0xf8, 0xfd, 0xb8, 0x27, 0xc9,
-		0x7c, 0x38, 0x0b, 0x67, 0xd8, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x0f, 0x5c, 0x26, 0xf9, 0x70, 0xd5,
-		0xf0, 0x7d, 0x92, 0xd2, 0xcb, 0x30, 0x55, 0x27, 0xd6, 0x82, 0xac, 0x82, 0x82, 0x1d, 0x9e, 0xd8,
-		0xf3, 0xdc, 0xf3, 0x91, 0x80, 0x9f, 0xef, 0x6f, 0x85, 0x8b, 0x4a, 0xdf, 0x24, 0x81, 0xdc, 0xd0,
-		0xb5, 0xb6, 0xe6, 0xbc, 0x5d, 0x28, 0xf4, 0x34, 0x00, 0x3b, 0xee, 0xe1, 0x7f, 0xb8, 0x37, 0x71,
-		0x6d, 0x66, 0x21, 0xdc, 0xb9, 0x05, 0xf6, 0x26, 0x7a, 0x82, 0x2a, 0x4b, 0x69, 0xc9, 0x9f, 0x97,
-		0x5f, 0x01, 0x08, 0x2a, 0xd0, 0x59, 0x38, 0xdd, 0x58, 0xac, 0xac, 0x56, 0x14, 0x71, 0x48, 0xa8,
-		0xb1, 0x59, 0x5b, 0xac, 0x2f, 0xd7, 0x6b, 0x4b, 0xf2, 0x09, 0x74, 0x0a, 0x50, 0xb8, 0xd2, 0x3f,
-		0xd4, 0x74, 0x12, 0x26, 0xc3, 0xe5, 0xec, 0x2b, 0x95, 0x44, 0xf9, 0x06, 0x14, 0xd9, 0x11, 0x7a,
-		0x55, 0x6b, 0xb5, 0x70, 0x4b, 0x35, 0x4c, 0x34, 0xe4, 0x44, 0xfa, 0xcc, 0xaf, 0xff, 0xc7, 0x31,
-		0xda, 0xb5, 0x02, 0x63, 0xac, 0x10, 0xbe, 0xba, 0x49, 0x62, 0x4e, 0xa3, 0x63, 0xb7, 0x31, 0xdd,
-		0xc3, 0x54, 0x0d, 0x21, 0xff, 0xe1, 0xe1, 0x0c, 0xc1, 0x4b, 0x5e, 0xca, 0x2a, 0x53, 0x01, 0xbb,
-		0x3f, 0x7a, 0xe5, 0x97, 0x41, 0x16, 0x47, 0x3d, 0xfd, 0x06, 0x0e, 0x45, 0xfc, 0x0d, 0xde, 0x42,
-		0x91, 0xcd, 0x10, 0x4d, 0x5c, 0x85, 0x49, 0x4d, 0xd7, 0xb1, 0x1d, 0x69, 0xdf, 0x10, 0x0f, 0x22,
-		0x7a, 0x2b, 0x73, 0xce, 0xa0, 0x69, 0x4f, 0x43, 0xda, 0xa5, 0x83, 0x32, 0x0c, 0x42, 0x34, 0x87,
-		0x93, 0x97, 0x6b, 0x30, 0xc1, 0xd4, 0xc0, 0xef, 0xd1, 0x10, 0x80, 0x7f, 0xc3, 0x01, 0xf2, 0x94,
-		0x4d, 0xf4, 0xc6, 0x84, 0x49, 0x12, 0xd7, 0x6a, 0x0e, 0x0e, 0xf5, 0xe6, 0xe8, 0x2c, 0xca, 0x3f,
-		0xf9, 0xd4, 0xe3, 0x74, 0xdf, 0xf8, 0x7c, 0x54, 0xe9, 0x62, 0x26, 0x8b, 0x22, 0x73, 0xec, 0xa0,
-		0xbf, 0x18, 0x26, 0xc4, 0xfb, 0x78, 0xbf, 0x8f, 0x7e, 0xd9, 0x3f, 0xe5, 0x2f, 0x3b, 0x17, 0xa7,
-		0xe1, 0xa1, 0x37, 0x15, 0x38, 0x2a, 0xab, 0x28, 0x57, 0xa1, 0xb0, 0x6b, 0xb4, 0x43, 0xc3, 0x7d,
-		0xf4, 0x5b, 0xfe, 0xd9, 0xa7, 0x1e, 0x67, 0x13, 0x8d, 0x30, 0x71, 0xd1, 0x54, 0x6b, 0x83, 0xac,
-		0xde, 0x6b, 0x8f, 0xf4, 0xfb, 0x6f, 0xf6, 0xdf, 0x63, 0x14, 0xfd, 0xb9, 0x70, 0x53, 0x03, 0xeb,
-		0x94, 0x82, 0x49, 0xad, 0x63, 0x98, 0xd6, 0x15, 0xfa, 0x2f, 0xb7, 0x4a, 0x63, 0xf4, 0x61, 0x84,
-		0x6d, 0xdb, 0xeb, 0xcc, 0x58, 0x0c, 0xd7, 0xdb, 0x3f, 0xfb, 0xae, 0x9f, 0x18, 0x0b, 0x0c, 0x4a,
-		0x79, 0x2d, 0xd0, 0x7d, 0x6c, 0xea, 0x56, 0x6b, 0xa4, 0x3c, 0xce, 0x9f, 0x0b, 0x0c, 0x91, 0x01,
-		0xac, 0x71, 0xd6, 0xf2, 0xf3, 0x90, 0xf1, 0x61, 0x86, 0xc5, 0x6e, 0x02, 0xc4, 0xe7, 0x20, 0x91,
-		0x1b, 0x53, 0xda, 0x51, 0xe2, 0xf4, 0x2f, 0x09, 0x7e, 0x66, 0xc3, 0xd6, 0x49, 0x6f, 0x56, 0x60,
-		0xa2, 0x65, 0x99, 0x9e, 0x6a, 0x75, 0x0c, 0x0f, 0x77, 0x6c, 0x6f, 0x68, 0xe4, 0xfb, 0x65, 0x06,
-		0x92, 0x51, 0x0a, 0x84, 0x6f, 0x43, 0xb0, 0x91, 0x96, 0xb0, 0x2f, 0x19, 0x47, 0x69, 0xc9, 0x5f,
-		0xf8, 0x2d, 0xa1, 0x3c, 0xa4, 0x25, 0xf7, 0xa4, 0x1d, 0x6e, 0xeb, 0x16, 0x77, 0x77, 0xde, 0x01,
-		0xd3, 0x02, 0x5f, 0x3b, 0x3e, 0x9e, 0x84, 0x73, 0x9c, 0x78, 0x47, 0x73, 0xf1, 0x95, 0xdb, 0x57,
-		0x77, 0xb0, 0xa7, 0x5d, 0xbd, 0xa2, 0x5b, 0x86, 0x88, 0x75, 0xa6, 0xb8, 0x3b, 0x23, 0xf5, 0x0b,
-		0xbc, 0x7e, 0x36, 0xf6, 0x40, 0xc0, 0xec, 0x60, 0x37, 0x38, 0xdb, 0xaf, 0x83, 0xa5, 0x36, 0xa4,
-		0x16, 0x2d, 0xc3, 0x24, 0xde, 0xbf, 0x85, 0x4d, 0xab, 0xc3, 0x1d, 0x12, 0x7b, 0x40, 0x37, 0x20,
-		0xad, 0x75, 0xac, 0xae, 0xe9, 0x31, 0x67, 0x54, 0x7d, 0xfc, 0xd7, 0xde, 0x9a, 0x3b, 0xf1, 0x3b,
-		0x6f, 0xcd, 0x9d, 0x64, 0xb0, 0x6e, 0xeb, 0xd6, 0x82, 0x61, 0x5d, 0xe9, 0x68, 0xde, 0x3e, 0x31,
-		0x01, 0xbf, 0xf5, 0xe9, 0xc7, 0x80, 0xbf, 0xaf, 0x6e, 0x7a, 0x9f, 0xf8, 0xc3, 0x9f, 0xb9, 0x2c,
-		0x29, 0x9c, 0xbf, 0x9c, 0xfa, 0xfc, 0xc7, 0xe6, 0xa4, 0x92, 0x0d, 0xe3, 0x4b, 0x58, 0x3f, 0xe2,
-		0x85, 0xf5, 0x9e, 0x17, 0x5e, 0xe5, 0x2f, 0x3c, 0xdb, 0xff, 0x42, 0x76, 0xa4, 0x71, 0x09, 0xeb,
-		0xa1, 0xd7, 0x2e, 0x61, 0x3d, 0xfa, 0xc6, 0xea, 0xd2, 0x6f, 0xff, 0xfe, 0xb9, 0x13, 0xef, 0xff,
-		0xec, 0xb9, 0x13, 0x03, 0x87, 0xac, 0x34, 0x7c, 0xc8, 0xfc, 0x91, 0xfa, 0x64, 0x8a, 0x8c, 0x54,
-		0x07, 0x7b, 0x3b, 0xbb, 0xde, 0x15, 0xdd, 0x39, 0xb4, 0x3d, 0xeb, 0xca, 0xed, 0xab, 0x64, 0xe6,
-		0x5a, 0xbb, 0x7c, 0xa4, 0x90, 0xa8, 0x5f, 0x60, 0xf5, 0x0b, 0xb7, 0x07, 0x0c, 0x54, 0x69, 0x17,
-		0xc6, 0x36, 0x09, 0x23, 0x11, 0x85, 0x67, 0x79, 0x5a, 0x9b, 0x47, 0x64, 0xec, 0x81, 0x94, 0xb2,
-		0x2f, 0x6d, 0x13, 0xac, 0xd4, 0x10, 0x1f, 0xd9, 0xb6, 0xb1, 0xb6, 0xcb, 0x3e, 0x58, 0x4a, 0xd2,
-		0x50, 0x3e, 0x43, 0x0a, 0xe8, 0xb7, 0x49, 0xd3, 0x30, 0xa6, 0x75, 0xd9, 0xa1, 0xa2, 0x24, 0x89,
-		0xf1, 0xe9, 0x43, 0x69, 0x15, 0xc6, 0xf9, 0xd9, 0x02, 0x24, 0x43, 0xf2, 0x16, 0x3e, 0xa4, 0xef,
-		0xc9, 0x2b, 0xe4, 0x4f, 0x74, 0x05, 0xc6, 0x68, 0xeb, 0xf9, 0x97, 0x98, 0x67, 0x16, 0xfa, 0x9b,
-		0xbf, 0x40, 0x5b, 0xa9, 0x30, 0xba, 0xd2, 0x4d, 0xc8, 0x2c, 0x59, 0x44, 0x81, 0xa2, 0x70, 0x59,
-		0x06, 0x47, 0x1b, 0x6d, 0x77, 0xf9, 0xf0, 0x29, 0xec, 0x01, 0x9d, 0x82, 0x34, 0xfb, 0x82, 0x8d,
-		0x9f, 0x8c, 0xe2, 0x4f, 0xa5, 0x45, 0x18, 0xa7, 0xd8, 0x1b, 0x36, 0x89, 0x87, 0xfc, 0x43, 0xfd,
-		0x59, 0xfe, 0x3d, 0x33, 0x87, 0x4f, 0x04, 0xad, 0x45, 0x90, 0x6a, 0x69, 0x9e, 0xc6, 0x3b, 0x4e,
-		0xff, 0x2e, 0xbd, 0x04, 0x19, 0x0e, 0xe2, 0xa2, 0x27, 0x20, 0x69, 0xd9, 0x2e, 0x3f, 0xdb, 0x74,
-		0x76, 0x60, 0x5f, 0x36, 0xec, 0x6a, 0x8a, 0x28, 0x96, 0x42, 0xa8, 0xab, 0x6b, 0x03, 0x55, 0xe3,
-		0x89, 0x88, 0x6a, 0x88, 0x61, 0x17, 0x7f, 0x68, 0xb6, 0x71, 0xa5, 0x5f, 0x19, 0x7c, 0x5d, 0xf9,
-		0xef, 0x12, 0xdc, 0x1f, 0xa3, 0x2b, 0xb7, 0xf0, 0xa1, 0x7b, 0x6c, 0x55, 0x79, 0x05, 0xb2, 0x9b,
-		0xf4, 0x76, 0x91, 0x97, 0xf1, 0x21, 0x9a, 0x85, 0x71, 0xdc, 0xba, 0xf6, 0xd4, 0x53, 0x57, 0x9f,
-		0x65, 0x03, 0x79, 0xe3, 0x84, 0x22, 0x0a, 0xd0, 0x39, 0xc8, 0xba, 0x58, 0xb7, 0xaf, 0x3d, 0x75,
-		0xfd, 0xd6, 0x55, 0x26, 0xb8, 0x1b, 0x27, 0x94, 0xa0, 0xa8, 0x9c, 0x21, 0x93, 0xe2, 0xf3, 0x3f,
-		0x32, 0x27, 0x55, 0xc7, 0x20, 0xe9, 0x76, 0x3b, 0xef, 0x56, 0xe7, 0xff, 0x22, 0x0d, 0xe7, 0xfd,
-		0x6a, 0x66, 0xf6, 0x6e, 0x5f, 0xbd, 0x72, 0x5b, 0x6b, 0x1b, 0x2d, 0x2d, 0xb8, 0x13, 0x66, 0xd2,
-		0x17, 0x00, 0x25, 0x19, 0xd8, 0xff, 0xd9, 0xa3, 0x05, 0x59, 0xfa, 0xb4, 0x04, 0xf9, 0x6d, 0x81,
-		0xdd, 0xc0, 0x1e, 0x7a, 0x1e, 0xc0, 0x7f, 0x97, 0x50, 0x87, 0xfb, 0x16, 0xfa, 0xde, 0xb6, 0xe0,
-		0x33, 0x29, 0x21, 0x7a, 0xf4, 0x0c, 0x64, 0x6c, 0xc7, 0xb2, 0x2d, 0x97, 0x7f, 0xd1, 0x3a, 0x8c,
-		0xd7, 0xa7, 0x46, 0x8f, 0x02, 0xa2, 0x93, 0x57, 0xbd, 0x6d, 0x79, 0x86, 0xb9, 0xa7, 0xda, 0xd6,
-		0x1d, 0x7e, 0x51, 0x40, 0x52, 0x91, 0x69, 0xcd, 0x36, 0xad, 0xd8, 0x24, 0xe5, 0xa5, 0x4f, 0x49,
-		0x90, 0xf5, 0x51, 0xc8, 0xca, 0x4c, 0x6b, 0xb5, 0x1c, 0xec, 0xba, 0x7c, 0x7e, 0x8a, 0x47, 0xf4,
-		0x3c, 0x8c, 0xdb, 0xdd, 0x1d, 0x55, 0xcc, 0x85, 0xdc, 0xb5, 0xfb, 0x63, 0x35, 0x5b, 0x28, 0x08,
-		0xd7, 0xed, 0xb4, 0xdd, 0xdd, 0x21, 0xea, 0x72, 0x1e, 0xf2, 0x31, 0xad, 0xc9, 0xdd, 0x0e, 0x1a,
-		0x42, 0x6f, 0xb5, 0xe1, 0x5d, 0x50, 0x6d, 0xc7, 0xb0, 0x1c, 0xc3, 0x3b, 0xa4, 0x47, 0xef, 0x92,
-		0x8a, 0x2c, 0x2a, 0x36, 0x79, 0x79, 0xa9, 0x0d, 0xc5, 0x06, 0x0d, 0xb4, 0x83, 0xa6, 0x5f, 0x0f,
-		0x1a, 0x28, 0x8d, 0xd0, 0xc0, 0x81, 0x4d, 0x4b, 0xf4, 0x35, 0xed, 0xf2, 0x7f, 0x92, 0x20, 0x57,
-		0x6d, 0x5b, 0xfa, 0xad, 0xfa, 0xd2, 0x72, 0x5b, 0xdb, 0x43, 0x57, 0xe1, 0x64, 0x75, 0x75, 0x63,
-		0xf1, 0x65, 0xb5, 0xbe, 0xa4, 0x2e, 0xaf, 0x56, 0x56, 0x82, 0xc3, 0xbe, 0xb3, 0xa7, 0xde, 0xbc,
-		0x3b, 0x8f, 0x42, 0xb4, 0x5b, 0x26, 0x5d, 0x58, 0xa2, 0x2b, 0x30, 0x1d, 0x65, 0xa9, 0x54, 0x1b,
-		0xb5, 0xf5, 0xa6, 0x2c, 0xcd, 0x9e, 0x7c, 0xf3, 0xee, 0xfc, 0x64, 0x88, 0xa3, 0xb2, 0xe3, 0x62,
-		0xd3, 0xeb, 0x67, 0x58, 0xdc, 0x58, 0x5b, 0xab, 0x37, 0xe5, 0x44, 0x1f, 0xc3, 0xa2, 0xd5, 0xe9,
-		0x18, 0x1e, 0x7a, 0x18, 0x26, 0xa3, 0x0c, 0xeb, 0xf5, 0x55, 0x39, 0x39, 0x8b, 0xde, 0xbc, 0x3b,
-		0x3f, 0x11, 0xa2, 0x5e, 0x37, 0xda, 0xb3, 0x99, 0x6f, 0xff, 0xb1, 0x73, 0x27, 0x3e, 0xf1, 0xf7,
-		0xce, 0x49, 0xd5, 0xd5, 0x81, 0x33, 0xef, 0xda, 0xe8, 0x33, 0x4f, 0x4c, 0x2d, 0x7f, 0xe2, 0x7d,
-		0x34, 0x01, 0x73, 0x7e, 0xed, 0x6d, 0xec, 0xb8, 0x86, 0x65, 0x92, 0xd9, 0xc2, 0xd4, 0xd6, 0x0f,
-		0x26, 0xf8, 0xe0, 0x70, 0x82, 0xc1, 0x86, 0xe7, 0x05, 0x48, 0x56, 0x6c, 0x1b, 0xcd, 0xd2, 0x19,
-		0xe1, 0x59, 0xba, 0xc5, 0x9c, 0x54, 0x4a, 0xf1, 0x9f, 0x49, 0x9d, 0x6b, 0xed, 0x7a, 0x77, 0x34,
-		0xc7, 0xbf, 0x58, 0x42, 0x3c, 0x97, 0x9e, 0x85, 0xec, 0xa2, 0x65, 0xba, 0xd8, 0x74, 0xbb, 0x34,
-		0xc1, 0xb0, 0x43, 0x84, 0xc1, 0x11, 0xd8, 0x03, 0x31, 0xf2, 0x9a, 0x6d, 0x53, 0xce, 0x94, 0x42,
-		0xfe, 0xe4, 0x8e, 0x7b, 0x7d, 0xa0, 0x78, 0x9e, 0x1c, 0x5d, 0x3c, 0x81, 0x00, 0x7c, 0x01, 0x7d,
-		0xff, 0xfd, 0x21, 0xb3, 0xec, 0x5b, 0xa6, 0xb0, 0x78, 0x62, 0xac, 0xd2, 0x10, 0xa7, 0x3f, 0x3b,
-		0xdc, 0xd6, 0xcd, 0x0e, 0x1b, 0x95, 0x01, 0x96, 0x6f, 0x58, 0xba, 0xa7, 0xf4, 0x2c, 0x14, 0x36,
-		0x35, 0xc7, 0x6b, 0x60, 0xef, 0x06, 0xd6, 0x5a, 0xd8, 0x89, 0x46, 0x13, 0x05, 0x11, 0x4d, 0x20,
-		0x48, 0xd1, 0x90, 0x81, 0x39, 0x53, 0xfa, 0x77, 0xc9, 0x80, 0x14, 0x3d, 0x7b, 0xed, 0x47, 0x1a,
-		0x9c, 0x83, 0x45, 0x1a, 0x64, 0xb8, 0x0e, 0x3d, 0xec, 0x8a, 0x84, 0x21, 0x7d, 0x40, 0x4f, 0x89,
-		0x78, 0x21, 0x39, 0x24, 0x5e, 0xe0, 0x56, 0x88, 0x47, 0x0d, 0x1d, 0x18, 0xe7, 0x13, 0xc1, 0x6f,
-		0x89, 0x14, 0xb4, 0x04, 0xad, 0x43, 0xd1, 0xd6, 0x1c, 0x8f, 0x7e, 0x8c, 0xb9, 0x4f, 0xbb, 0xc1,
-		0x2d, 0xdd, 0x7c, 0x8c, 0xe1, 0x8d, 0x74, 0x97, 0xbf, 0xa6, 0x60, 0x87, 0x0b, 0x4b, 0x9f, 0x4f,
-		0x41, 0x9a, 0x8b, 0xe3, 0x45, 0x18, 0xe7, 0x02, 0xe7, 0xb6, 0xe9, 0xdc, 0x42, 0x8c, 0xfa, 0x2f,
-		0xf8, 0x6a, 0xca, 0x01, 0x05, 0x13, 0x7a, 0x08, 0x32, 0xfa, 0xbe, 0x66, 0x98, 0xaa, 0xd1, 0xe2,
-		0x31, 0x69, 0xee, 0xb3, 0x6f, 0xcd, 0x8d, 0x2f, 0x92, 0xb2, 0xfa, 0x92, 0x32, 0x4e, 0x2b, 0xeb,
-		0x2d, 0x12, 0xe3, 0xec, 0x63, 0x63, 0x6f, 0xdf, 0xe3, 0x06, 0x96, 0x3f, 0xa1, 0x67, 0x20, 0x45,
-		0x86, 0x8c, 0x7f, 0xac, 0x3f, 0xdb, 0xb7, 0xd8, 0xf0, 0xf3, 0x65, 0xd5, 0x0c, 0x79, 0xf1, 0x07,
-		0xfe, 0xf3, 0x9c, 0xa4, 0x50, 0x0e, 0xb4, 0x04, 0x85, 0xb6, 0xe6, 0x7a, 0x2a, 0x9d, 0x27, 0xe4,
-		0xf5, 0x63, 0x1c, 0xa2, 0x5f, 0x24, 0x5c, 0xb6, 0xbc, 0xed, 0x39, 0xc2, 0xc6, 0x8a, 0x5a, 0xe8,
-		0x12, 0xc8, 0x14, 0x45, 0xa7, 0xa6, 0x8a, 0xc5, 0x8d, 0x69, 0x2a, 0xfa, 0x09, 0x52, 0xce, 0x2c,
-		0x18, 0x8d, 0x1e, 0xcf, 0x42, 0x96, 0x7e, 0x1f, 0x4c, 0x49, 0xd8, 0xa1, 0xff, 0x0c, 0x29, 0xa0,
-		0x95, 0x17, 0xa1, 0x18, 0x78, 0x48, 0x46, 0x92, 0x61, 0x28, 0x41, 0x31, 0x25, 0x7c, 0x1c, 0xa6,
-		0x4d, 0x7c, 0x40, 0x3f, 0x43, 0x88, 0x50, 0x67, 0x29, 0x35, 0x22, 0x75, 0xdb, 0x51, 0x8e, 0x07,
-		0x61, 0x42, 0x17, 0xd2, 0x67, 0xb4, 0x40, 0x69, 0x0b, 0x7e, 0x29, 0x25, 0x3b, 0x03, 0x19, 0xcd,
-		0xb6, 0x19, 0x41, 0x8e, 0x3b, 0x48, 0xdb, 0xa6, 0x55, 0x97, 0x61, 0x92, 0xf6, 0xd1, 0xc1, 0x6e,
-		0xb7, 0xed, 0x71, 0x90, 0x3c, 0xa5, 0x29, 0x92, 0x0a, 0x85, 0x95, 0x53, 0xda, 0x0b, 0x50, 0xc0,
-		0xb7, 0x8d, 0x16, 0x36, 0x75, 0xcc, 0xe8, 0x0a, 0x94, 0x2e, 0x2f, 0x0a, 0x29, 0xd1, 0xc3, 0xe0,
-		0xfb, 0x3d, 0x55, 0x38, 0xe5, 0x09, 0x86, 0x27, 0xca, 0x2b, 0xac, 0xb8, 0x34, 0x03, 0xa9, 0x25,
-		0xcd, 0xd3, 0x88, 0x1d, 0xf3, 0x0e, 0x58, 0xac, 0x91, 0x57, 0xc8, 0x9f, 0xa5, 0x5f, 0x4a, 0x42,
-		0x6a, 0xdb, 0xf2, 0x30, 0x7a, 0x32, 0x14, 0xdb, 0x4e, 0xc4, 0xaa, 0x74, 0xc3, 0xd8, 0x33, 0x71,
-		0x6b, 0xcd, 0xdd, 0x0b, 0xdd, 0xe6, 0x13, 0x28, 0x54, 0x22, 0xa2, 0x50, 0xd3, 0x30, 0xe6, 0x58,
-		0x5d, 0xb3, 0x25, 0xce, 0xcb, 0xd3, 0x07, 0xb4, 0x0c, 0x19, 0x5f, 0x4f, 0x52, 0x43, 0xf5, 0xa4,
-		0x48, 0xf4, 0x84, 0xa8, 0x31, 0x2f, 0x50, 0xc6, 0x77, 0xb8, 0xba, 0x54, 0x21, 0xeb, 0x5b, 0x18,
-		0x5f, 0xe1, 0x46, 0xd1, 0xd9, 0x80, 0x8d, 0x84, 0x13, 0xfe, 0xe8, 0xfb, 0xe2, 0x63, 0x3a, 0x27,
-		0xfb, 0x15, 0x5c, 0x7e, 0x11, 0xc5, 0xe2, 0x57, 0x0b, 0x8d, 0xd3, 0x8e, 0x05, 0x8a, 0xc5, 0xae,
-		0x17, 0xba, 0x0f, 0xb2, 0xae, 0xb1, 0x67, 0x6a, 0x5e, 0xd7, 0xc1, 0x5c, 0xf7, 0x82, 0x02, 0x52,
-		0x1b, 0x7c, 0x3c, 0xc2, 0x74, 0x2d, 0x74, 0xe3, 0xd9, 0x15, 0x98, 0x0a, 0xee, 0x1a, 0x0b, 0x50,
-		0x98, 0x9e, 0x21, 0xbf, 0xaa, 0x21, 0x6a, 0x4a, 0xff, 0x42, 0x82, 0x34, 0x77, 0xee, 0xc1, 0x38,
-		0x48, 0xf1, 0xe3, 0x90, 0x18, 0x34, 0x0e, 0xc9, 0xb7, 0x35, 0x0e, 0xe0, 0xb7, 0xd3, 0xe5, 0x37,
-		0xc8, 0xc4, 0x45, 0xa1, 0xac, 0x91, 0x0d, 0x63, 0x8f, 0xcf, 0xfd, 0x10, 0x57, 0xe9, 0x2d, 0x89,
-		0xb8, 0x5f, 0x5e, 0x8f, 0xaa, 0x50, 0x10, 0x2d, 0x53, 0x77, 0xdb, 0xda, 0x1e, 0x57, 0xc7, 0x73,
-		0x83, 0x9b, 0x47, 0x62, 0x16, 0x25, 0xc7, 0x5b, 0x44, 0xa3, 0xaf, 0xd8, 0x91, 0x4d, 0x0c, 0x18,
-		0xd9, 0x88, 0x2a, 0x25, 0xef, 0x4d, 0x95, 0x22, 0x83, 0x9e, 0xea, 0x19, 0xf4, 0xd2, 0xe7, 0x24,
-		0x7e, 0xd9, 0x59, 0x8b, 0x7d, 0xfc, 0xf2, 0x57, 0x36, 0x5a, 0x5f, 0xcb, 0xf5, 0xab, 0x85, 0x5b,
-		0x6a, 0xdf, 0xb0, 0x3d, 0x10, 0x03, 0x19, 0x6d, 0x75, 0x30, 0x7c, 0x48, 0xc0, 0x34, 0x82, 0x61,
-		0xfc, 0xb9, 0x04, 0x4c, 0xf6, 0xd1, 0xff, 0x0d, 0x1c, 0xce, 0xe8, 0x1c, 0x1e, 0x1b, 0x71, 0x0e,
-		0xa7, 0x07, 0xce, 0xe1, 0x9f, 0x4b, 0xd0, 0xcc, 0x80, 0x6d, 0xb9, 0x5a, 0xfb, 0xab, 0x62, 0x83,
-		0xcf, 0x42, 0xd6, 0xb6, 0xda, 0x2a, 0xab, 0x61, 0x5f, 0x2e, 0x65, 0x6c, 0xab, 0xad, 0xf4, 0xa9,
-		0xda, 0xd8, 0x3b, 0x65, 0xa0, 0xd3, 0xef, 0xc0, 0x30, 0x8c, 0xf7, 0xce, 0x2a, 0x0f, 0xf2, 0x4c,
-		0x16, 0x3c, 0x82, 0xba, 0x4a, 0x84, 0x40, 0x63, 0x32, 0xa9, 0x37, 0xe6, 0xf3, 0xdb, 0xcd, 0x48,
-		0x15, 0x4e, 0x48, 0x58, 0x58, 0xbc, 0xd1, 0x9f, 0x56, 0xea, 0xb1, 0x5c, 0x0a, 0x27, 0x2c, 0x7d,
-		0x50, 0x02, 0x58, 0x25, 0xc2, 0xa5, 0x3d, 0x26, 0xc1, 0x8f, 0x4b, 0x1b, 0xa1, 0x46, 0xde, 0x3d,
-		0x37, 0x70, 0xe0, 0x78, 0x0b, 0xf2, 0x6e, 0xb8, 0xe9, 0x4b, 0x50, 0x08, 0x14, 0xdc, 0xc5, 0xa2,
-		0x39, 0x73, 0x47, 0x2d, 0xe7, 0x1b, 0xd8, 0x53, 0xf2, 0xb7, 0x43, 0x4f, 0xa5, 0x7f, 0x29, 0x41,
-		0x96, 0xb6, 0x6a, 0x0d, 0x7b, 0x5a, 0x64, 0x20, 0xa5, 0xb7, 0x31, 0x90, 0xf7, 0x03, 0x30, 0x1c,
-		0xd7, 0x78, 0x03, 0x73, 0xfd, 0xca, 0xd2, 0x92, 0x86, 0xf1, 0x06, 0x46, 0x4f, 0xfb, 0x52, 0x4f,
-		0x0e, 0x91, 0xba, 0x58, 0xef, 0x73, 0xd9, 0x9f, 0x86, 0x71, 0x7a, 0x33, 0xe7, 0x81, 0xcb, 0x97,
-		0xf0, 0x69, 0xb3, 0xdb, 0x69, 0x1e, 0xb8, 0xa5, 0x5b, 0x30, 0xde, 0x3c, 0x60, 0x19, 0xc7, 0xb3,
-		0x90, 0x75, 0x2c, 0x8b, 0x47, 0x83, 0x2c, 0x10, 0xcf, 0x90, 0x02, 0x1a, 0xfc, 0x88, 0x24, 0x5b,
-		0x22, 0x48, 0xb2, 0x05, 0x69, 0xc2, 0xe4, 0x68, 0x69, 0x42, 0xb2, 0x6e, 0x2f, 0x44, 0x66, 0x14,
-		0x7a, 0x14, 0x4e, 0x37, 0xea, 0x2b, 0xeb, 0xb5, 0x25, 0x75, 0xad, 0xb1, 0xd2, 0x73, 0x3b, 0xc1,
-		0x6c, 0xf1, 0xcd, 0xbb, 0xf3, 0x39, 0xbe, 0x60, 0x1f, 0x44, 0xbd, 0xa9, 0xd4, 0xb6, 0x37, 0x9a,
-		0x35, 0x59, 0x62, 0xd4, 0x9b, 0x0e, 0xbe, 0x6d, 0x79, 0xec, 0xee, 0xdb, 0xc7, 0xe1, 0x4c, 0x0c,
-		0xb5, 0xbf, 0x6c, 0x9f, 0x7c, 0xf3, 0xee, 0x7c, 0x61, 0xd3, 0xc1, 0x4c, 0xd5, 0x28, 0xc7, 0x02,
-		0xcc, 0xf4, 0x73, 0x6c, 0x6c, 0x6e, 0x34, 0x2a, 0xab, 0xf2, 0xfc, 0xac, 0xfc, 0xe6, 0xdd, 0xf9,
-		0xbc, 0xb0, 0x1d, 0x84, 0xfe, 0xdd, 0x5f, 0xb7, 0xa7, 0xfa, 0xcf, 0x3b, 0xdc, 0x71, 0x34, 0xdb,
-		0xc6, 0x8e, 0x3b, 0x68, 0x63, 0xff, 0x02, 0xe4, 0x96, 0x42, 0xdf, 0xed, 0xfa, 0x27, 0x3c, 0x24,
-		0xfa, 0x4d, 0x2f, 0x7b, 0x28, 0x95, 0x00, 0x96, 0xdb, 0x96, 0xe6, 0xc5, 0xd0, 0x24, 0x42, 0x34,
-		0x75, 0xd3, 0xbb, 0xfe, 0x64, 0x0c, 0x4d, 0x52, 0xd0, 0x5c, 0x80, 0xdc, 0xd6, 0x20, 0xa2, 0x54,
-		0x14, 0xe8, 0x89, 0x6b, 0x31, 0x34, 0x63, 0x3d, 0x40, 0xb1, 0x44, 0x05, 0x41, 0x74, 0x1e, 0xb2,
-		0x55, 0xcb, 0x6a, 0xc7, 0x90, 0x64, 0x42, 0x38, 0x8d, 0xd0, 0x27, 0xc9, 0x11, 0xa2, 0x6c, 0xa8,
-		0x41, 0x55, 0xb2, 0x6e, 0x8d, 0xa1, 0xf1, 0xcf, 0xc0, 0x1c, 0xfb, 0xe8, 0xc7, 0x7b, 0xf9, 0xb8,
-		0x1c, 0xf7, 0xe8, 0x87, 0x18, 0xcf, 0x7b, 0x3b, 0xfa, 0x91, 0x0f, 0x6d, 0x3d, 0xf8, 0x59, 0x06,
-		0x5b, 0x73, 0xb4, 0x8e, 0x7b, 0xdc, 0x74, 0xea, 0x90, 0x93, 0x35, 0xb3, 0x43, 0x34, 0x91, 0xac,
-		0x6c, 0x8a, 0xfe, 0x82, 0x79, 0x93, 0x36, 0x01, 0x3d, 0x19, 0xce, 0xee, 0xe4, 0x06, 0xc7, 0x21,
-		0x8c, 0x5c, 0x64, 0x7f, 0x5e, 0x80, 0x8c, 0x58, 0x78, 0x71, 0xdb, 0x7c, 0x3e, 0x2e, 0x5a, 0xe2,
-		0x24, 0x9c, 0xd7, 0x67, 0x41, 0x5f, 0x03, 0x59, 0xdf, 0x52, 0x73, 0xd3, 0x54, 0x3a, 0xca, 0xb6,
-		0x73, 0x80, 0x80, 0x09, 0x95, 0x83, 0xf4, 0x40, 0x6a, 0x60, 0xc6, 0x61, 0x9b, 0x51, 0x70, 0x6e,
-		0x3f, 0x35, 0xf0, 0x14, 0xa4, 0xb4, 0x1d, 0xdd, 0xe0, 0xee, 0xfc, 0xfe, 0x18, 0xc6, 0x4a, 0x75,
-		0xb1, 0xce, 0xb8, 0xe8, 0x85, 0x1c, 0x94, 0x9c, 0x34, 0xda, 0x3d, 0x34, 0xf5, 0x7d, 0xc7, 0x32,
-		0x0f, 0xb9, 0x07, 0x8f, 0x6b, 0x74, 0x43, 0xd0, 0x88, 0x46, 0xfb, 0x4c, 0xa4, 0xd1, 0xbb, 0x38,
-		0xf0, 0xde, 0xf1, 0x8d, 0x5e, 0x66, 0x14, 0xa2, 0xd1, 0x9c, 0xa1, 0x54, 0xe7, 0xf9, 0x54, 0x3e,
-		0x6c, 0xf4, 0x5a, 0xa9, 0x03, 0x95, 0x65, 0x7a, 0xd8, 0x84, 0xcf, 0x74, 0xb4, 0x03, 0x3a, 0x69,
-		0x88, 0x2b, 0x21, 0x95, 0x7b, 0xfc, 0xe2, 0x92, 0xa4, 0x92, 0xee, 0x68, 0x07, 0x2b, 0x9a, 0x7b,
-		0x33, 0x95, 0x49, 0xca, 0xa9, 0xd2, 0x27, 0x49, 0xf8, 0x1d, 0x19, 0x1a, 0xf4, 0x08, 0x20, 0xc2,
-		0xa1, 0xed, 0x61, 0x95, 0x38, 0x21, 0x3a, 0xc8, 0x02, 0xb7, 0xd8, 0xd1, 0x0e, 0x2a, 0x7b, 0x78,
-		0xbd, 0xdb, 0xa1, 0x0d, 0x70, 0xd1, 0x1a, 0xc8, 0x82, 0x58, 0x28, 0xa0, 0x1f, 0x2f, 0xf4, 0x5d,
-		0x94, 0xcc, 0x09, 0x58, 0x40, 0xf3, 0x41, 0x12, 0xd0, 0x4c, 0x30, 0x3c, 0xff, 0xc8, 0x57, 0xa4,
-		0x2b, 0xc9, 0x68, 0x57, 0x4a, 0x2f, 0x41, 0xb1, 0x47, 0x0b, 0x50, 0x09, 0x0a, 0x3c, 0x6b, 0x4d,
-		0x8f, 0xd3, 0xb0, 0xb5, 0x7b, 0x56, 0xc9, 0xb1, 0xe4, 0x34, 0x9d, 0x7d, 0xe5, 0xcc, 0x2f, 0x7e,
-		0x6c, 0x4e, 0xa2, 0x5b, 0x97, 0x8f, 0x40, 0x21, 0xa2, 0x06, 0x22, 0x71, 0x29, 0x05, 0x89, 0xcb,
-		0x80, 0xf8, 0x35, 0xc8, 0x13, 0x57, 0x8a, 0x5b, 0x9c, 0xf6, 0x21, 0x28, 0x32, 0x5f, 0xdf, 0x2b,
-		0x6b, 0x16, 0xc3, 0xaf, 0x09, 0x81, 0x97, 0x44, 0x50, 0x1f, 0x15, 0x7b, 0x4e, 0x50, 0xad, 0x68,
-		0x6e, 0xe9, 0x07, 0x24, 0x28, 0xf6, 0xe8, 0x06, 0x7a, 0x01, 0xb2, 0xb6, 0x83, 0x75, 0x23, 0x94,
-		0xe6, 0x3a, 0x42, 0x84, 0x29, 0x2a, 0xbe, 0x80, 0x83, 0x84, 0x49, 0xe2, 0x9c, 0x40, 0x0b, 0xb7,
-		0xb5, 0xc3, 0xe1, 0xa3, 0xc0, 0x20, 0xc4, 0xad, 0xf5, 0x4b, 0x84, 0xa9, 0xf4, 0xab, 0x12, 0x14,
-		0x22, 0x4a, 0x87, 0x5a, 0x70, 0x3f, 0x71, 0xd1, 0xe1, 0xb3, 0xe9, 0xfc, 0xfe, 0xbd, 0xd0, 0x1a,
-		0x2d, 0x77, 0xed, 0x6c, 0xdf, 0x7b, 0x02, 0x47, 0x43, 0x83, 0x1b, 0x49, 0x99, 0x25, 0x38, 0xc1,
-		0x11, 0x75, 0x76, 0x51, 0xdf, 0x0d, 0x16, 0x8c, 0x6f, 0x00, 0xb2, 0x77, 0xbc, 0x5e, 0xe8, 0xc4,
-		0xa8, 0xd0, 0x32, 0x61, 0x0e, 0x03, 0x96, 0x1a, 0x00, 0xc1, 0xc4, 0x45, 0x95, 0x51, 0x3a, 0x91,
-		0x3c, 0xaa, 0x85, 0xe5, 0xc4, 0x8c, 0x54, 0xdd, 0xfc, 0xc4, 0x67, 0xcf, 0x49, 0xef, 0x4a, 0xe8,
-		0xf0, 0xbb, 0x0d, 0xb8, 0x2f, 0x20, 0xdd, 0xd1, 0x8d, 0xde, 0x84, 0xb6, 0xec, 0x1b, 0x07, 0x52,
-		0x4b, 0xdc, 0xc2, 0xd1, 0xfb, 0x69, 0x43, 0xd3, 0xdd, 0x43, 0x1c, 0xd1, 0x28, 0xe9, 0xf0, 0x7b,
-		0xcc, 0x76, 0xff, 0xbb, 0x2c, 0x8c, 0x2b, 0xf8, 0x7d, 0x5d, 0xec, 0x7a, 0xe8, 0x09, 0x48, 0x61,
-		0x7d, 0xdf, 0xea, 0xdf, 0x72, 0xe2, 0xbd, 0x5c, 0xa8, 0xe9, 0xfb, 0x16, 0x27, 0xbe, 0x71, 0x42,
-		0xa1, 0xc4, 0xe8, 0x3a, 0x8c, 0xed, 0xb6, 0xbb, 0x3c, 0x11, 0x1e, 0x71, 0x53, 0x82, 0x6b, 0x99,
-		0x54, 0x07, 0x6c, 0x8c, 0x9c, 0xbc, 0x8c, 0xfe, 0x9c, 0x40, 0x72, 0xd0, 0xcb, 0xe8, 0xaf, 0x08,
-		0x04, 0x2f, 0x23, 0xc4, 0x68, 0x11, 0xc0, 0x30, 0x0d, 0x4f, 0xa5, 0x39, 0x62, 0xee, 0x26, 0x4a,
-		0x71, 0xac, 0x86, 0x47, 0xf3, 0xc9, 0x01, 0x7f, 0xd6, 0x10, 0x65, 0xa4, 0xc5, 0xef, 0xeb, 0x62,
-		0x47, 0xb8, 0x8a, 0x98, 0x16, 0xbf, 0x87, 0x54, 0x87, 0x5a, 0x4c, 0xc9, 0x89, 0x6b, 0x65, 0x97,
-		0x93, 0x7a, 0x07, 0xfc, 0xca, 0xed, 0xf9, 0x7e, 0x56, 0x7a, 0x37, 0x69, 0xf3, 0x20, 0x60, 0x1e,
-		0xd7, 0x59, 0x09, 0x7a, 0xd6, 0x5f, 0xc2, 0xe5, 0x7a, 0xd7, 0x4c, 0x3e, 0x33, 0x5b, 0xc1, 0xf9,
-		0xbc, 0x9c, 0x01, 0x6d, 0xc0, 0x44, 0xdb, 0x70, 0x3d, 0xd5, 0x35, 0x35, 0xdb, 0xdd, 0xb7, 0x3c,
-		0x97, 0xe6, 0x62, 0x73, 0xd7, 0x1e, 0xea, 0x87, 0x58, 0x35, 0x5c, 0xaf, 0x21, 0xc8, 0x02, 0xa4,
-		0x42, 0x3b, 0x5c, 0x4e, 0x00, 0xad, 0xdd, 0x5d, 0xec, 0xf8, 0x88, 0x34, 0x69, 0x1b, 0x0b, 0xb8,
-		0x41, 0xe8, 0x04, 0x67, 0x08, 0xd0, 0x0a, 0x97, 0xa3, 0xaf, 0x83, 0xa9, 0xb6, 0xa5, 0xb5, 0x7c,
-		0x3c, 0x55, 0xdf, 0xef, 0x9a, 0xb7, 0x68, 0x8a, 0x37, 0x77, 0xed, 0x72, 0x4c, 0x33, 0x2d, 0xad,
-		0x25, 0x98, 0x17, 0x09, 0x69, 0x80, 0x3c, 0xd9, 0xee, 0xad, 0x43, 0x2a, 0x4c, 0x6b, 0xb6, 0xdd,
-		0x3e, 0xec, 0x85, 0x2f, 0x52, 0xf8, 0x47, 0xfa, 0xe1, 0x2b, 0x84, 0x7a, 0x00, 0x3e, 0xd2, 0xfa,
-		0x2a, 0xd1, 0x16, 0xc8, 0xb6, 0x83, 0xe9, 0x77, 0xab, 0x36, 0x5f, 0xa4, 0xd0, 0x5b, 0xfd, 0x72,
-		0xd7, 0x2e, 0xf5, 0x83, 0x6f, 0x32, 0x4a, 0xb1, 0x9a, 0x09, 0x90, 0x8b, 0x76, 0xb4, 0x86, 0xc1,
-		0x5a, 0x3a, 0xa6, 0xb7, 0x8e, 0x72, 0xd8, 0xc9, 0xc1, 0xb0, 0x94, 0x32, 0x16, 0x36, 0x52, 0x83,
-		0x96, 0x21, 0xc7, 0xb2, 0x5a, 0x2a, 0x31, 0x91, 0xf4, 0x36, 0xc0, 0xdc, 0xb5, 0x0b, 0x31, 0xd3,
-		0x95, 0x12, 0x6d, 0x5b, 0x1e, 0x0e, 0xc0, 0x00, 0xfb, 0x85, 0x68, 0x07, 0x4e, 0xd2, 0x9b, 0x11,
-		0x0f, 0xd5, 0xa8, 0x3d, 0x9e, 0x99, 0xa2, 0x88, 0x8f, 0xf6, 0x23, 0x6e, 0x53, 0xf2, 0xed, 0xb0,
-		0x61, 0x0e, 0xa0, 0xa7, 0x6e, 0xf7, 0xd7, 0x12, 0x4d, 0xdb, 0x35, 0x4c, 0xad, 0x6d, 0xbc, 0x81,
-		0x59, 0xf0, 0x42, 0x2f, 0x05, 0x8e, 0xd5, 0xb4, 0x65, 0x4e, 0x47, 0x83, 0x99, 0x90, 0xa6, 0xed,
-		0x86, 0xcb, 0xab, 0xe3, 0x7c, 0xc9, 0xe1, 0xdf, 0x72, 0x39, 0x2e, 0x67, 0xd8, 0xcd, 0x96, 0x37,
-		0x53, 0x19, 0x90, 0x73, 0xa5, 0x8b, 0x90, 0x0b, 0xd9, 0x29, 0x34, 0x03, 0xe3, 0xdc, 0xa9, 0x8a,
-		0x03, 0xfc, 0xfc, 0xb1, 0x34, 0x01, 0xf9, 0xb0, 0x69, 0x2a, 0x7d, 0x40, 0x82, 0x5c, 0xc8, 0xe8,
-		0x10, 0xce, 0xf0, 0x46, 0x57, 0x36, 0x88, 0x53, 0x2f, 0x88, 0xa8, 0x42, 0xd4, 0xb3, 0xcd, 0xd6,
-		0x3c, 0x2d, 0xe4, 0x41, 0x0d, 0x9a, 0x83, 0x9c, 0x7d, 0xcd, 0xf6, 0x49, 0x92, 0x94, 0x04, 0xec,
-		0x6b, 0xb6, 0x20, 0x38, 0x0f, 0x79, 0xd2, 0x75, 0x35, 0x1c, 0x2e, 0x67, 0x95, 0x1c, 0x29, 0xe3,
-		0x24, 0xa5, 0xdf, 0x4c, 0x80, 0xdc, 0x6b, 0xcc, 0xfc, 0x0d, 0x30, 0xe9, 0xd8, 0x1b, 0x60, 0x67,
-		0x7a, 0xb7, 0xde, 0x82, 0xdd, 0xb6, 0x35, 0x90, 0x83, 0x3d, 0x23, 0xe6, 0x7b, 0x8e, 0x88, 0xff,
-		0x7b, 0xd6, 0x2a, 0x4a, 0x51, 0xef, 0x59, 0xbc, 0xac, 0x44, 0xce, 0x8b, 0xa4, 0xfc, 0x23, 0xae,
-		0xbd, 0xfa, 0x24, 0x68, 0xb6, 0xec, 0x96, 0xe6, 0x61, 0x91, 0x72, 0x0f, 0x1d, 0x1d, 0x79, 0x08,
-		0x8a, 0x9a, 0x6d, 0xab, 0xae, 0xa7, 0x79, 0x98, 0x07, 0x7a, 0x2c, 0x91, 0x59, 0xd0, 0x6c, 0xbb,
-		0x41, 0x4a, 0x59, 0xa0, 0xf7, 0x20, 0x4c, 0x10, 0x0b, 0x6f, 0x68, 0x6d, 0x11, 0x45, 0xa4, 0x59,
-		0x3c, 0xc8, 0x4b, 0x79, 0x24, 0xd2, 0x82, 0x7c, 0xd8, 0xb8, 0xfb, 0xa9, 0x19, 0x29, 0x94, 0x9a,
-		0x41, 0xfc, 0xe2, 0x25, 0x26, 0x21, 0x71, 0x59, 0x55, 0xfc, 0x66, 0xe4, 0x34, 0x4d, 0xe3, 0xdc,
-		0x66, 0xb9, 0xd7, 0x8c, 0xc2, 0x1e, 0x4a, 0xaf, 0xc2, 0x44, 0xd4, 0x0f, 0xa0, 0x09, 0x48, 0x78,
-		0x07, 0xfc, 0x2d, 0x09, 0xef, 0x00, 0x5d, 0xe5, 0xd9, 0xd3, 0x24, 0xcd, 0x9e, 0xde, 0x3f, 0xd0,
-		0x8f, 0x04, 0xa9, 0xd3, 0x9b, 0xa9, 0x4c, 0x42, 0x4e, 0x96, 0x8a, 0x50, 0x88, 0x78, 0x89, 0xd2,
-		0x29, 0x98, 0x8e, 0xb3, 0xf9, 0x25, 0x03, 0xa6, 0xe3, 0x4c, 0x37, 0xba, 0x0e, 0x19, 0xdf, 0xe8,
-		0xf7, 0x65, 0xdb, 0xc4, 0xdb, 0x7d, 0x26, 0x9f, 0x36, 0xb2, 0x5b, 0x98, 0x88, 0xec, 0x16, 0x96,
-		0xbe, 0x11, 0x66, 0x06, 0xd9, 0xf3, 0x9e, 0xed, 0x83, 0x94, 0x2f, 0xb8, 0x53, 0x90, 0xe6, 0xf7,
-		0x03, 0x27, 0x68, 0x9a, 0x82, 0x3f, 0x11, 0x81, 0x32, 0xdb, 0x9e, 0x64, 0xd9, 0x0b, 0xfa, 0x50,
-		0x52, 0xe1, 0xcc, 0x40, 0x93, 0x3e, 0x78, 0xb7, 0x9d, 0x01, 0xf1, 0xdd, 0x76, 0xfa, 0x40, 0x7f,
-		0x85, 0x08, 0x9b, 0x22, 0x09, 0x98, 0x55, 0xf8, 0x53, 0xe9, 0x43, 0x49, 0x38, 0x15, 0x6f, 0xd7,
-		0xd1, 0x3c, 0xe4, 0xc9, 0xe2, 0xc1, 0x8b, 0xae, 0x33, 0xa0, 0xa3, 0x1d, 0x34, 0xf9, 0x22, 0x83,
-		0xef, 0x54, 0x26, 0xfc, 0x9d, 0x4a, 0xb4, 0x0d, 0x93, 0x6d, 0x4b, 0xd7, 0xda, 0x6a, 0x68, 0xa7,
-		0x98, 0x4f, 0xa7, 0x07, 0x06, 0xd9, 0x69, 0xb1, 0x17, 0x41, 0x4c, 0x10, 0x9f, 0x08, 0x45, 0x0a,
-		0xb2, 0xea, 0xef, 0x2a, 0xa3, 0x1a, 0xe4, 0x3a, 0x86, 0xbb, 0x83, 0xf7, 0xb5, 0xdb, 0x86, 0xe5,
-		0xf0, 0x79, 0x15, 0xa3, 0x3d, 0x6b, 0x01, 0x91, 0xd8, 0xc2, 0x0e, 0xf1, 0x85, 0x06, 0x65, 0x2c,
-		0x76, 0x6b, 0x3d, 0x7d, 0x6c, 0xcb, 0x32, 0x68, 0x93, 0x7a, 0x7c, 0xe0, 0x26, 0x75, 0xdc, 0x8e,
-		0x70, 0x26, 0x7e, 0x47, 0xf8, 0x4d, 0x3a, 0x38, 0x71, 0xde, 0xb1, 0x7f, 0x93, 0x18, 0x35, 0x61,
-		0x9a, 0xf3, 0xb7, 0x22, 0xd2, 0xef, 0x3b, 0x77, 0x16, 0x0d, 0xba, 0x42, 0x52, 0x47, 0x82, 0x7f,
-		0xb0, 0xe0, 0x93, 0xf7, 0x28, 0x78, 0x71, 0x54, 0x23, 0x15, 0x3a, 0xaa, 0xf1, 0xbf, 0xd9, 0x60,
-		0x7c, 0x6b, 0x52, 0x6c, 0x9e, 0x85, 0x02, 0x8b, 0xd8, 0x33, 0x28, 0x83, 0xf6, 0x7a, 0x44, 0xc7,
-		0x92, 0xc7, 0xee, 0x18, 0x1f, 0xed, 0xd4, 0xf0, 0xd1, 0x1e, 0x7b, 0x27, 0x47, 0x3b, 0x7d, 0x8f,
-		0xa3, 0xfd, 0xae, 0x8e, 0xc3, 0x47, 0x24, 0x98, 0x1d, 0x1c, 0x8e, 0xc5, 0x0e, 0xc8, 0xb1, 0x76,
-		0x27, 0x07, 0x79, 0xbc, 0x07, 0x61, 0xa2, 0x27, 0x5a, 0x64, 0xca, 0x5c, 0x88, 0x2c, 0xd7, 0x4b,
-		0xdf, 0x96, 0x84, 0xe9, 0xb8, 0x80, 0x2e, 0x66, 0xc6, 0x2a, 0x30, 0xd5, 0xc2, 0xba, 0xd1, 0xba,
-		0xe7, 0x09, 0x3b, 0xc9, 0xd9, 0xff, 0xcf, 0x7c, 0x8d, 0xd1, 0x93, 0x1f, 0x07, 0xc8, 0x28, 0xd8,
-		0xb5, 0x49, 0x80, 0xc6, 0x7e, 0xed, 0x4e, 0xc7, 0xb6, 0x17, 0xa4, 0xb5, 0x62, 0xd7, 0x0d, 0x9c,
-		0x44, 0xf0, 0x91, 0xf5, 0xb3, 0xcf, 0x87, 0x9e, 0xe4, 0x69, 0x82, 0x81, 0x0b, 0x7e, 0x16, 0x7e,
-		0xfb, 0xac, 0x2c, 0x4f, 0xf0, 0xb4, 0xc8, 0x13, 0x24, 0x07, 0xad, 0x7e, 0x79, 0x30, 0xee, 0xf3,
-		0xf1, 0x44, 0xc1, 0x93, 0x3c, 0x51, 0x90, 0x1a, 0xf4, 0x3a, 0x16, 0xb3, 0x07, 0xaf, 0x33, 0xd8,
-		0x45, 0xa6, 0xe1, 0x4c, 0x41, 0x7a, 0x50, 0x57, 0x43, 0xc1, 0x75, 0xd0, 0xd5, 0x20, 0x55, 0xf0,
-		0xb4, 0x48, 0x15, 0x8c, 0x0f, 0x6a, 0x34, 0x8f, 0x26, 0x83, 0x46, 0xb3, 0x5c, 0xc1, 0x8b, 0xa1,
-		0x5c, 0x41, 0xb6, 0x37, 0x0d, 0xdf, 0x97, 0x2b, 0xf0, 0xb9, 0xfd, 0x64, 0x41, 0xd9, 0x4f, 0x16,
-		0xe4, 0x07, 0x66, 0x1a, 0x78, 0x18, 0xe8, 0x33, 0x8b, 0x6c, 0xc1, 0x66, 0x5f, 0xb6, 0x80, 0x2d,
-		0xee, 0x2f, 0x0e, 0xcd, 0x16, 0xf8, 0x50, 0x3d, 0xe9, 0x82, 0xcd, 0xbe, 0x74, 0xc1, 0xc4, 0x20,
-		0xc4, 0x9e, 0x98, 0x33, 0x40, 0x8c, 0xe6, 0x0b, 0xbe, 0x3e, 0x3e, 0x5f, 0x30, 0x70, 0x41, 0x1f,
-		0x13, 0x5f, 0xfa, 0xd0, 0x31, 0x09, 0x83, 0x6f, 0x1c, 0x90, 0x30, 0x90, 0x07, 0x2d, 0x6c, 0xe3,
-		0xa2, 0x4b, 0xff, 0x05, 0x71, 0x19, 0x83, 0xed, 0x98, 0x8c, 0x01, 0x5b, 0xda, 0x3f, 0x3c, 0x42,
-		0xc6, 0xc0, 0x87, 0xee, 0x4b, 0x19, 0x6c, 0xc7, 0xa4, 0x0c, 0xd0, 0x60, 0xdc, 0x9e, 0xa0, 0x28,
-		0x8c, 0x1b, 0xcd, 0x19, 0xac, 0x44, 0x73, 0x06, 0x53, 0x47, 0xc7, 0xa2, 0xcc, 0xb5, 0xfb, 0x68,
-		0xe1, 0xa4, 0x81, 0x3e, 0x28, 0x69, 0xc0, 0xd6, 0xf5, 0x8f, 0x8d, 0x98, 0x34, 0xf0, 0xb1, 0x63,
-		0xb3, 0x06, 0x9b, 0x7d, 0x59, 0x83, 0x93, 0x83, 0x14, 0xae, 0xc7, 0xc9, 0x04, 0x0a, 0x37, 0x30,
-		0x6d, 0xc0, 0x7e, 0x16, 0x83, 0xfd, 0x20, 0x06, 0xc8, 0xb9, 0x9b, 0xa9, 0x4c, 0x4e, 0xce, 0x97,
-		0x1e, 0x26, 0x61, 0x4d, 0x8f, 0xdd, 0x23, 0x8b, 0x08, 0xec, 0x38, 0x96, 0x23, 0xf6, 0x40, 0xe9,
-		0x43, 0xe9, 0x12, 0xe4, 0xc3, 0x26, 0xee, 0x88, 0x14, 0x43, 0x11, 0x0a, 0x11, 0xab, 0x56, 0xfa,
-		0x45, 0x09, 0xf2, 0x61, 0x7b, 0x15, 0x59, 0x80, 0x66, 0xf9, 0x02, 0x34, 0x94, 0x78, 0x48, 0x44,
-		0x13, 0x0f, 0x73, 0x90, 0x23, 0x8b, 0xb0, 0x9e, 0x9c, 0x82, 0x66, 0xfb, 0x39, 0x05, 0x71, 0x70,
-		0x93, 0xa5, 0x27, 0xb8, 0x9f, 0x62, 0xa7, 0x16, 0x8a, 0xfe, 0x21, 0x56, 0x9e, 0xe6, 0x7f, 0x0c,
-		0xa6, 0x42, 0xb4, 0xfe, 0xe2, 0x8e, 0x2d, 0xaf, 0x65, 0x9f, 0xba, 0xc2, 0x57, 0x79, 0xbf, 0x2a,
-		0xc1, 0x64, 0x9f, 0xb9, 0x8c, 0xcd, 0x1b, 0x48, 0xef, 0x54, 0xde, 0x20, 0x71, 0xef, 0x79, 0x83,
-		0xf0, 0x72, 0x35, 0x19, 0x5d, 0xae, 0xfe, 0xa5, 0x04, 0x85, 0x88, 0xd9, 0x26, 0x83, 0xa0, 0x5b,
-		0x2d, 0xb1, 0x63, 0x4e, 0xff, 0x26, 0x71, 0x4a, 0xdb, 0xda, 0xe3, 0xcb, 0x44, 0xf2, 0x27, 0xa1,
-		0xf2, 0x1d, 0x51, 0x96, 0xbb, 0x19, 0x7f, 0xed, 0x39, 0x16, 0xfe, 0xa6, 0x8c, 0x7f, 0x67, 0x95,
-		0x0e, 0xbe, 0xb3, 0xf2, 0x37, 0xca, 0xc7, 0x43, 0x1b, 0xe5, 0xe8, 0x59, 0xc8, 0xd2, 0x5d, 0x00,
-		0xd5, 0xb2, 0x83, 0x1f, 0xe6, 0x1d, 0xfc, 0x8d, 0x95, 0x4b, 0x3f, 0x12, 0x60, 0x1f, 0x66, 0x05,
-		0x51, 0x48, 0x36, 0x12, 0x85, 0xdc, 0x07, 0x59, 0xd2, 0x7c, 0xf6, 0x73, 0x44, 0xc0, 0xaf, 0x1a,
-		0x11, 0x05, 0xa5, 0x9f, 0x4a, 0x40, 0xb1, 0xc7, 0xeb, 0xc4, 0x76, 0x3e, 0xee, 0xc4, 0xca, 0x68,
-		0x02, 0x39, 0x07, 0xb0, 0xa7, 0xb9, 0xea, 0x1d, 0xcd, 0xf4, 0x70, 0x8b, 0x4b, 0x25, 0x54, 0x82,
-		0x66, 0x21, 0x43, 0x9e, 0xba, 0x2e, 0x6e, 0xf1, 0x0c, 0x8d, 0xff, 0x8c, 0xea, 0x90, 0xc6, 0xb7,
-		0xe9, 0x75, 0xdc, 0xec, 0x52, 0xfb, 0xd3, 0x31, 0xe6, 0x89, 0xd4, 0x57, 0x67, 0xc8, 0x70, 0xff,
-		0xd1, 0x5b, 0x73, 0x32, 0x23, 0x7f, 0xd4, 0xff, 0x80, 0x55, 0xe1, 0x00, 0x51, 0x31, 0x64, 0x7a,
-		0xc4, 0x40, 0xd3, 0x85, 0x79, 0xb1, 0xf6, 0x27, 0x42, 0x65, 0x5f, 0xe2, 0x28, 0x85, 0x0e, 0xee,
-		0xd8, 0x96, 0xd5, 0x56, 0xd9, 0x3c, 0xaf, 0xc0, 0x44, 0xd4, 0xc9, 0xb2, 0xdf, 0x0a, 0xf4, 0x34,
-		0xc3, 0x54, 0x23, 0xb1, 0x71, 0x9e, 0x15, 0xb2, 0x79, 0x75, 0x33, 0x95, 0x91, 0xe4, 0x04, 0x4f,
-		0xd7, 0xbc, 0x07, 0x4e, 0xc6, 0xfa, 0x58, 0xf4, 0x0c, 0x64, 0x03, 0xff, 0xcc, 0xbe, 0xa7, 0x3a,
-		0x2a, 0x0f, 0x13, 0x10, 0x97, 0xb6, 0xe1, 0x64, 0xac, 0x93, 0x45, 0x2f, 0x40, 0x9a, 0x9d, 0xd7,
-		0xe6, 0x67, 0xf2, 0x1e, 0x1c, 0xee, 0x9d, 0xbb, 0x6d, 0x4f, 0xe1, 0x4c, 0xa5, 0xab, 0x70, 0x66,
-		0xa0, 0x97, 0x0d, 0xb2, 0x29, 0x52, 0x28, 0x9b, 0x52, 0xfa, 0x69, 0x09, 0x66, 0x07, 0x7b, 0x4e,
-		0x54, 0xed, 0x69, 0xd0, 0xe5, 0x11, 0xfd, 0x6e, 0xa8, 0x55, 0x64, 0xb9, 0xe1, 0xe0, 0x5d, 0xec,
-		0xe9, 0xfb, 0xcc, 0x85, 0x33, 0xa3, 0x50, 0x50, 0x0a, 0xbc, 0x94, 0xf2, 0xb8, 0x8c, 0xec, 0x75,
-		0xac, 0x7b, 0x2a, 0x1b, 0x54, 0x97, 0xff, 0xd4, 0x78, 0x81, 0x95, 0x36, 0x58, 0x61, 0xe9, 0x11,
-		0x38, 0x3d, 0xc0, 0x17, 0xc7, 0x1c, 0x37, 0x7f, 0x8d, 0x10, 0xc7, 0x3a, 0x58, 0xf4, 0x12, 0xa4,
-		0x5d, 0x4f, 0xf3, 0xba, 0x2e, 0xef, 0xd9, 0xc5, 0xa1, 0xbe, 0xb9, 0x41, 0xc9, 0x15, 0xce, 0x56,
-		0x7a, 0x0e, 0x50, 0xbf, 0xa7, 0x8d, 0x59, 0x5b, 0x49, 0x71, 0x6b, 0xab, 0x1d, 0x38, 0x7b, 0x84,
-		0x4f, 0x45, 0x8b, 0x3d, 0x8d, 0x7b, 0x64, 0x24, 0x97, 0xdc, 0xd3, 0xc0, 0x3f, 0x49, 0xc0, 0xc9,
-		0x58, 0xd7, 0x1a, 0x9a, 0xa5, 0xd2, 0xdb, 0x9d, 0xa5, 0x2f, 0x00, 0x78, 0x07, 0xe2, 0x23, 0x03,
-		0x6e, 0xed, 0xe3, 0xd6, 0x13, 0x07, 0x58, 0xa7, 0x06, 0x8b, 0x28, 0x46, 0xd6, 0xe3, 0x7f, 0x91,
-		0xc5, 0x7f, 0x68, 0x3d, 0xdb, 0xa5, 0x9e, 0xc0, 0xe5, 0x4b, 0xbd, 0x91, 0x7d, 0x46, 0xb0, 0xf0,
-		0x65, 0xc5, 0x2e, 0x7a, 0x0d, 0x4e, 0xf7, 0x78, 0x34, 0x1f, 0x3b, 0x35, 0xb2, 0x63, 0x3b, 0x19,
-		0x75, 0x6c, 0x02, 0x3b, 0xec, 0x95, 0xc6, 0xa2, 0x5e, 0xe9, 0x35, 0x80, 0x60, 0x61, 0x1b, 0x9c,
-		0x87, 0x95, 0xc2, 0xe7, 0x61, 0xaf, 0xc3, 0x18, 0xd1, 0x04, 0x21, 0xaa, 0x18, 0x83, 0x41, 0x86,
-		0x34, 0xb4, 0x32, 0x66, 0xe4, 0xa5, 0xd7, 0x85, 0xb6, 0x85, 0x73, 0x8c, 0x03, 0xde, 0xf1, 0x62,
-		0xf4, 0x1d, 0xa5, 0xc1, 0xe9, 0xca, 0xf8, 0x77, 0xfd, 0x5f, 0x30, 0x46, 0x87, 0x3f, 0xf6, 0x03,
-		0xe4, 0x6f, 0x00, 0xd0, 0x3c, 0xcf, 0x31, 0x76, 0xba, 0xc1, 0x1b, 0xe6, 0x07, 0xe8, 0x4f, 0x45,
-		0x10, 0x56, 0xef, 0xe3, 0x8a, 0x34, 0x1d, 0xf0, 0x86, 0x94, 0x29, 0x84, 0x58, 0x5a, 0x87, 0x89,
-		0x28, 0x6f, 0xfc, 0x17, 0xd5, 0xc1, 0xbd, 0x4d, 0xe2, 0x5c, 0x5b, 0xe0, 0xc8, 0xf9, 0x5d, 0x6a,
-		0xf4, 0xa1, 0xf4, 0x4d, 0x09, 0xc8, 0x87, 0xb5, 0xef, 0x6f, 0xa1, 0xb3, 0x2c, 0x7d, 0x9b, 0x04,
-		0x19, 0xbf, 0xff, 0x47, 0x7c, 0x0d, 0x10, 0x7c, 0x5b, 0xef, 0xe7, 0xe0, 0xd9, 0xae, 0x47, 0xd2,
-		0xdf, 0xf5, 0x78, 0xde, 0x77, 0x08, 0x03, 0x17, 0xf3, 0x61, 0x69, 0x8b, 0x73, 0xb8, 0xdc, 0x41,
-		0x3d, 0x37, 0xda, 0xc7, 0xbd, 0xd3, 0x30, 0x16, 0xfe, 0x2e, 0x97, 0x3d, 0x94, 0x70, 0xe8, 0xb8,
-		0x12, 0x9b, 0x8d, 0xe1, 0xaf, 0x80, 0xa5, 0xe3, 0x7f, 0x05, 0xec, 0xbf, 0x26, 0x11, 0x7e, 0xcd,
-		0xdf, 0x95, 0x20, 0x23, 0xe6, 0x05, 0x7a, 0x29, 0x7c, 0x98, 0x4e, 0x9c, 0xcc, 0x19, 0x6c, 0x97,
-		0xf8, 0x0b, 0x42, 0x67, 0xe9, 0xfa, 0x3e, 0x49, 0x48, 0x1e, 0xfb, 0x93, 0x04, 0x1e, 0x87, 0x7c,
-		0x59, 0x02, 0xb9, 0x77, 0xde, 0xbe, 0xfd, 0xf6, 0xf5, 0xfb, 0xab, 0x64, 0x8c, 0xbf, 0x1a, 0xf4,
-		0xa1, 0x41, 0x6a, 0xd0, 0x87, 0x06, 0xfd, 0xfd, 0x1e, 0xbb, 0xd7, 0x7e, 0x7f, 0x6b, 0x02, 0x72,
-		0xa1, 0x1c, 0x1f, 0x7a, 0x2a, 0xf2, 0xd5, 0xc2, 0xf9, 0x23, 0x13, 0x82, 0xa1, 0xcf, 0x16, 0x22,
-		0x92, 0x4a, 0xdc, 0x83, 0xa4, 0xde, 0xf9, 0x8f, 0x19, 0xe3, 0xbf, 0x8c, 0x1f, 0x1b, 0xf0, 0x65,
-		0xfc, 0xff, 0x2b, 0x41, 0xc6, 0x4f, 0xbe, 0x1c, 0x77, 0x4f, 0xee, 0x14, 0xa4, 0x79, 0xec, 0xc5,
-		0x36, 0xe5, 0xf8, 0x53, 0x6c, 0x76, 0x74, 0x16, 0x32, 0xe2, 0x77, 0x51, 0xb9, 0x87, 0xf3, 0x9f,
-		0x2f, 0xef, 0x40, 0x2e, 0xb4, 0xad, 0x89, 0xce, 0xc0, 0xc9, 0xc5, 0x1b, 0xb5, 0xc5, 0x97, 0xd5,
-		0xe6, 0x2b, 0xbd, 0xbf, 0xad, 0xd7, 0x57, 0xa5, 0xd4, 0xe8, 0xb3, 0x2c, 0xa1, 0xd3, 0x30, 0x15,
-		0xad, 0x62, 0x15, 0x89, 0xd9, 0xd4, 0xb7, 0xff, 0xd8, 0xb9, 0x13, 0x97, 0xbf, 0x2c, 0xc1, 0x54,
-		0x4c, 0x94, 0x8b, 0xce, 0xc3, 0xfd, 0x1b, 0xcb, 0xcb, 0x35, 0x45, 0x6d, 0xac, 0x57, 0x36, 0x1b,
-		0x37, 0x36, 0x9a, 0xaa, 0x52, 0x6b, 0x6c, 0xad, 0x36, 0x43, 0x2f, 0x9d, 0x87, 0xfb, 0xe2, 0x49,
-		0x2a, 0x8b, 0x8b, 0xb5, 0xcd, 0x26, 0xfb, 0x71, 0xbf, 0x01, 0x14, 0xd5, 0x0d, 0xa5, 0x29, 0x27,
-		0x06, 0x43, 0x28, 0xb5, 0x9b, 0xb5, 0xc5, 0xa6, 0x9c, 0x44, 0x17, 0xe1, 0xc2, 0x51, 0x14, 0xea,
-		0xf2, 0x86, 0xb2, 0x56, 0x69, 0xca, 0xa9, 0xa1, 0x84, 0x8d, 0xda, 0xfa, 0x52, 0x4d, 0x91, 0xc7,
-		0x78, 0xbf, 0x3f, 0x96, 0x80, 0x99, 0x41, 0xc1, 0x34, 0xc1, 0xaa, 0x6c, 0x6e, 0xae, 0xbe, 0x1a,
-		0x60, 0x2d, 0xde, 0xd8, 0x5a, 0x7f, 0xb9, 0x5f, 0x04, 0x0f, 0x41, 0xe9, 0x28, 0x42, 0x5f, 0x10,
-		0x0f, 0xc2, 0xf9, 0x23, 0xe9, 0xb8, 0x38, 0x86, 0x90, 0x29, 0xb5, 0xa6, 0xf2, 0xaa, 0x9c, 0x44,
-		0x0b, 0x70, 0x79, 0x28, 0x99, 0x5f, 0x27, 0xa7, 0xd0, 0x15, 0x78, 0xe4, 0x68, 0x7a, 0x26, 0x20,
-		0xc1, 0x20, 0x44, 0xf4, 0xa6, 0x04, 0x27, 0x63, 0xa3, 0x72, 0x74, 0x01, 0xe6, 0x36, 0x95, 0x8d,
-		0xc5, 0x5a, 0xa3, 0xe1, 0x7f, 0xb3, 0xa0, 0x36, 0x9a, 0x95, 0xe6, 0x56, 0x23, 0x24, 0x9b, 0x12,
-		0x9c, 0x1b, 0x44, 0xe4, 0xcb, 0xe5, 0x08, 0x1a, 0xae, 0x01, 0x42, 0x4f, 0xef, 0x4a, 0x70, 0x66,
-		0x60, 0x14, 0x8e, 0x2e, 0xc1, 0x03, 0xdb, 0x35, 0xa5, 0xbe, 0xfc, 0xaa, 0xba, 0xbd, 0xd1, 0x0c,
-		0xff, 0x8a, 0x64, 0x5f, 0xab, 0x2e, 0xc2, 0x85, 0x23, 0x29, 0xfd, 0xa6, 0x0d, 0x23, 0xec, 0x69,
-		0xdf, 0xb7, 0x48, 0x50, 0xec, 0xb1, 0x85, 0xe8, 0x3e, 0x98, 0x59, 0xab, 0x37, 0xaa, 0xb5, 0x1b,
-		0x95, 0xed, 0xfa, 0x86, 0xd2, 0x3b, 0x67, 0x2f, 0xc0, 0x5c, 0x5f, 0xed, 0xd2, 0xd6, 0xe6, 0x6a,
-		0x7d, 0xb1, 0xd2, 0xac, 0xa9, 0xec, 0x43, 0x13, 0xd2, 0xb1, 0x3e, 0xa2, 0xd5, 0xfa, 0xca, 0x8d,
-		0xa6, 0xba, 0xb8, 0x5a, 0xaf, 0xad, 0x37, 0xd5, 0x4a, 0xb3, 0x59, 0x09, 0xa6, 0x73, 0xf5, 0xe5,
-		0x81, 0x07, 0x3c, 0xaf, 0x8e, 0x7e, 0xc0, 0x93, 0x1f, 0xe1, 0xf4, 0xcf, 0x77, 0xfe, 0x87, 0x27,
-		0xe0, 0x01, 0x7e, 0x31, 0x91, 0xeb, 0x69, 0xb7, 0x0c, 0x73, 0xcf, 0xbf, 0x21, 0x8a, 0x3f, 0xf3,
-		0x73, 0x9e, 0xa7, 0xf8, 0x2d, 0x48, 0xa2, 0x74, 0xc8, 0x3d, 0x51, 0x03, 0xaf, 0x17, 0x1d, 0xfa,
-		0x7d, 0xc0, 0xb0, 0x63, 0x9a, 0x47, 0xdd, 0x41, 0x35, 0xe4, 0xa6, 0xab, 0x98, 0x3b, 0xaa, 0x66,
-		0x8f, 0xbe, 0xaf, 0x61, 0xf6, 0xc8, 0xc3, 0xaf, 0xa5, 0x0f, 0x4a, 0x30, 0x71, 0xc3, 0x70, 0x3d,
-		0xcb, 0x31, 0x74, 0xad, 0x4d, 0x03, 0x89, 0xe7, 0x47, 0xfe, 0xa0, 0xad, 0x9a, 0x25, 0x6e, 0x8c,
-		0xdf, 0x64, 0xb5, 0x2f, 0xbe, 0x29, 0x4b, 0xdf, 0xd6, 0xda, 0xec, 0x63, 0xb2, 0xf0, 0x55, 0x78,
-		0xbd, 0x62, 0x0f, 0xf9, 0xd7, 0x30, 0x0a, 0xe3, 0x2d, 0x27, 0x66, 0xa4, 0xd2, 0x0f, 0x24, 0xa0,
-		0x48, 0x17, 0x38, 0x2e, 0x5d, 0x10, 0xd3, 0x25, 0xd7, 0x4d, 0x48, 0x39, 0x9a, 0xc7, 0x97, 0x21,
-		0xd5, 0xeb, 0xc7, 0xbe, 0xfe, 0x8a, 0xbd, 0x85, 0x62, 0xa0, 0xf7, 0x40, 0xa6, 0xa3, 0x1d, 0xa8,
-		0x14, 0x2f, 0xf1, 0xb6, 0xf0, 0xc6, 0x3b, 0xda, 0x01, 0x69, 0x1f, 0xfa, 0x06, 0x28, 0x12, 0x48,
-		0x7d, 0x5f, 0x33, 0xf7, 0x30, 0x43, 0x4e, 0xbe, 0x2d, 0xe4, 0x42, 0x47, 0x3b, 0x58, 0xa4, 0x68,
-		0x04, 0x9f, 0x5f, 0x13, 0xf6, 0x2b, 0x12, 0x5f, 0x5d, 0x52, 0xc1, 0x20, 0x0d, 0x64, 0xdd, 0x7f,
-		0xa2, 0x2f, 0x15, 0x49, 0xdb, 0x8b, 0x83, 0x64, 0xdf, 0x23, 0xd6, 0x6a, 0x81, 0x34, 0xef, 0x33,
-		0x6f, 0xcd, 0x49, 0xec, 0xad, 0x45, 0xbd, 0x4f, 0xec, 0x39, 0xb6, 0x6a, 0x56, 0x69, 0x7c, 0x93,
-		0x18, 0x1a, 0xdf, 0x14, 0x44, 0x7c, 0xc3, 0x00, 0x81, 0x71, 0x93, 0x7a, 0xde, 0x87, 0x4f, 0x48,
-		0x90, 0x5b, 0x0a, 0x5d, 0xdd, 0x39, 0x03, 0xe3, 0x1d, 0xcb, 0x34, 0x6e, 0x61, 0xc7, 0xcf, 0xba,
-		0xb3, 0x47, 0x12, 0x83, 0xb0, 0x5f, 0x80, 0xf4, 0x0e, 0xc5, 0x05, 0x2a, 0xe2, 0x99, 0x70, 0xdd,
-		0xc1, 0x3b, 0xae, 0x21, 0xe4, 0xac, 0x88, 0x47, 0xf4, 0x30, 0xc8, 0x2e, 0xd6, 0xbb, 0x8e, 0xe1,
-		0x1d, 0xaa, 0xba, 0x65, 0x7a, 0x9a, 0xee, 0xf1, 0xc5, 0x5a, 0x51, 0x94, 0x2f, 0xb2, 0x62, 0x02,
-		0xd2, 0xc2, 0x9e, 0x66, 0xb4, 0xd9, 0x61, 0xb4, 0xac, 0x22, 0x1e, 0x79, 0x53, 0xef, 0x8e, 0x87,
-		0x97, 0x2a, 0x8b, 0x20, 0x5b, 0x36, 0x76, 0x22, 0xbb, 0xee, 0x4c, 0x1b, 0x67, 0x7e, 0xeb, 0xd3,
-		0x8f, 0x4d, 0x73, 0x81, 0xf3, 0xfd, 0x5a, 0xf6, 0x05, 0x96, 0x52, 0x14, 0x1c, 0x62, 0x3b, 0xfe,
-		0xd5, 0x48, 0x9e, 0xbd, 0xbb, 0x13, 0xdc, 0x5d, 0x34, 0xdd, 0x27, 0xd4, 0x8a, 0x79, 0x58, 0x9d,
-		0xf9, 0x8d, 0x00, 0x9a, 0x2f, 0x66, 0x36, 0xe9, 0xc2, 0x25, 0x9c, 0x73, 0xa7, 0x30, 0x24, 0xbc,
-		0x7b, 0x5d, 0x33, 0xda, 0xe2, 0xc7, 0x72, 0x15, 0xfe, 0x84, 0xca, 0x7e, 0x1e, 0x29, 0x45, 0xa3,
-		0xe5, 0xd2, 0x20, 0xdd, 0xa8, 0x5a, 0x66, 0x2b, 0x9a, 0x3e, 0x42, 0x8b, 0x90, 0xf6, 0xac, 0x5b,
-		0xd8, 0xe4, 0x02, 0xaa, 0x3e, 0x72, 0x8c, 0x7b, 0xee, 0x14, 0xce, 0x8a, 0xbe, 0x0e, 0xe4, 0x16,
-		0x6e, 0xe3, 0x3d, 0xf6, 0xb1, 0xe9, 0xbe, 0xe6, 0x60, 0x76, 0xeb, 0xc1, 0x3d, 0xdd, 0x62, 0x57,
-		0xf4, 0xa1, 0x1a, 0x14, 0x09, 0x6d, 0x46, 0x2f, 0x87, 0x1d, 0xf7, 0xb7, 0x88, 0x63, 0xfb, 0x18,
-		0xd2, 0xbc, 0xb0, 0xf5, 0x89, 0x5c, 0x26, 0xfb, 0x30, 0xc8, 0x5d, 0x73, 0xc7, 0x32, 0xe9, 0x6f,
-		0x4c, 0xf2, 0x08, 0x3b, 0xc3, 0xf6, 0x5e, 0xfc, 0x72, 0xbe, 0xf7, 0xb2, 0x09, 0x13, 0x01, 0x29,
-		0x9d, 0x21, 0xd9, 0xe3, 0xce, 0x90, 0x82, 0x0f, 0x40, 0x48, 0xd0, 0x1a, 0x40, 0x30, 0x07, 0x69,
-		0xe6, 0x3f, 0x37, 0x78, 0xc4, 0x82, 0xd9, 0x1c, 0xee, 0x4c, 0x08, 0x00, 0x99, 0x30, 0xd5, 0x31,
-		0x4c, 0xd5, 0xc5, 0xed, 0x5d, 0x95, 0x4b, 0x8e, 0xe0, 0xe6, 0xa8, 0xf8, 0x5f, 0x3c, 0xc6, 0x68,
-		0xfe, 0xce, 0xa7, 0x1f, 0x2b, 0x06, 0xd7, 0xff, 0xcd, 0x3f, 0xbe, 0xf0, 0xe4, 0xd3, 0xca, 0x64,
-		0xc7, 0x30, 0x1b, 0xb8, 0xbd, 0xbb, 0xe4, 0x03, 0xa3, 0xe7, 0xe1, 0x6c, 0x20, 0x10, 0xcb, 0x54,
-		0xf7, 0xad, 0x76, 0x4b, 0x75, 0xf0, 0xae, 0xaa, 0xd3, 0xcb, 0x0b, 0xf3, 0x54, 0x8c, 0xa7, 0x7d,
-		0x92, 0x0d, 0xf3, 0x86, 0xd5, 0x6e, 0x29, 0x78, 0x77, 0x91, 0x54, 0xa3, 0x0b, 0x10, 0x48, 0x43,
-		0x35, 0x5a, 0xee, 0x4c, 0x61, 0x3e, 0x79, 0x29, 0xa5, 0xe4, 0xfd, 0xc2, 0x7a, 0xcb, 0x2d, 0x67,
-		0xbe, 0xfd, 0x63, 0x73, 0x27, 0x3e, 0xff, 0xb1, 0xb9, 0x13, 0xa5, 0x65, 0x7a, 0xbb, 0x19, 0x9f,
-		0x5a, 0xd8, 0x45, 0xd7, 0x21, 0xab, 0x89, 0x07, 0xf6, 0xd1, 0xd2, 0x11, 0x53, 0x33, 0x20, 0x2d,
-		0x7d, 0x52, 0x82, 0xf4, 0xd2, 0xf6, 0xa6, 0x66, 0x38, 0xa8, 0x06, 0x93, 0x81, 0xae, 0x8e, 0x3a,
-		0xcb, 0x03, 0xf5, 0x16, 0xd3, 0x7c, 0x7d, 0xd0, 0x11, 0x9d, 0x6c, 0xf5, 0xfc, 0x6f, 0x7d, 0xfa,
-		0xb1, 0xfb, 0x39, 0xcc, 0x76, 0xcf, 0x69, 0x1d, 0x81, 0xd7, 0x7b, 0x8a, 0x27, 0xd4, 0xe7, 0x9b,
-		0x30, 0xce, 0x9a, 0xea, 0xa2, 0x97, 0x60, 0xcc, 0x26, 0x7f, 0xf0, 0x04, 0xee, 0xb9, 0x81, 0x3a,
-		0x4f, 0xe9, 0xc3, 0x1a, 0xc2, 0xf8, 0x4a, 0xdf, 0x99, 0x00, 0x58, 0xda, 0xde, 0x6e, 0x3a, 0x86,
-		0xdd, 0xc6, 0xde, 0x3b, 0xd5, 0xf7, 0x2d, 0x38, 0x19, 0xfa, 0xb6, 0xdc, 0xd1, 0x8f, 0xdf, 0xff,
-		0xa9, 0xe0, 0x2b, 0x73, 0x47, 0x8f, 0x85, 0x6d, 0xb9, 0x9e, 0x0f, 0x9b, 0x3c, 0x3e, 0xec, 0x92,
-		0xeb, 0xf5, 0x4b, 0xf6, 0x15, 0xc8, 0x05, 0xc2, 0x70, 0x51, 0x1d, 0x32, 0x1e, 0xff, 0x9b, 0x0b,
-		0xb8, 0x34, 0x58, 0xc0, 0x82, 0x2d, 0x2c, 0x64, 0x9f, 0xbd, 0xf4, 0x97, 0x12, 0x40, 0x68, 0x8e,
-		0xfc, 0xf5, 0xd4, 0x31, 0x54, 0x87, 0x34, 0x37, 0xce, 0xc9, 0x7b, 0xbe, 0x62, 0x94, 0x01, 0x84,
-		0x84, 0xfa, 0xdd, 0x09, 0x98, 0xda, 0x12, 0xb3, 0xf7, 0xaf, 0xbf, 0x0c, 0xb6, 0x60, 0x1c, 0x9b,
-		0x9e, 0x63, 0xf8, 0x1b, 0x10, 0x8f, 0x0f, 0x1a, 0xf3, 0x98, 0x4e, 0xd5, 0x4c, 0xcf, 0x39, 0x0c,
-		0x6b, 0x80, 0xc0, 0x0a, 0xc9, 0xe3, 0xc3, 0x49, 0x98, 0x19, 0xc4, 0x8a, 0x2e, 0x42, 0x51, 0x77,
-		0x30, 0x2d, 0x88, 0x7e, 0x43, 0x37, 0x21, 0x8a, 0xb9, 0xdb, 0x51, 0x80, 0x04, 0x6a, 0x44, 0xb9,
-		0x08, 0xe9, 0xbd, 0x45, 0x66, 0x13, 0x01, 0x02, 0x75, 0x3c, 0x4d, 0x28, 0x8a, 0x93, 0xf7, 0x3b,
-		0x5a, 0x5b, 0x33, 0x75, 0x11, 0xc1, 0x1e, 0xcb, 0xe7, 0x8b, 0xd3, 0xfb, 0x55, 0x06, 0x81, 0x6a,
-		0x30, 0x2e, 0xd0, 0x52, 0xc7, 0x47, 0x13, 0xbc, 0xe8, 0x3c, 0xe4, 0xc3, 0x8e, 0x81, 0x46, 0x23,
-		0x29, 0x25, 0x17, 0xf2, 0x0b, 0xc3, 0x3c, 0x4f, 0xfa, 0x48, 0xcf, 0xc3, 0x03, 0xbe, 0x1f, 0x4e,
-		0xc2, 0xa4, 0x82, 0x5b, 0x7f, 0xf3, 0x87, 0x65, 0x13, 0x80, 0x4d, 0x55, 0x62, 0x49, 0xf9, 0xc8,
-		0xdc, 0xc3, 0x7c, 0xcf, 0x32, 0x90, 0x25, 0xd7, 0xfb, 0x6a, 0x8d, 0xd0, 0xef, 0x26, 0x20, 0x1f,
-		0x1e, 0xa1, 0xbf, 0x95, 0x4e, 0x0b, 0xad, 0x07, 0x66, 0x8a, 0x7d, 0x3b, 0xf0, 0xf0, 0x20, 0x33,
-		0xd5, 0xa7, 0xcd, 0x43, 0xec, 0xd3, 0x17, 0x92, 0x90, 0xe6, 0x67, 0x78, 0x36, 0xfa, 0x62, 0xdb,
-		0xa1, 0x1f, 0x50, 0x17, 0xc4, 0x37, 0xe8, 0xb1, 0xa1, 0xed, 0x83, 0x30, 0x41, 0xd6, 0xc8, 0x91,
-		0x83, 0x41, 0xd2, 0xa5, 0x02, 0x5d, 0xea, 0x06, 0x07, 0x63, 0xd1, 0x1c, 0xe4, 0x08, 0x59, 0x60,
-		0x87, 0x09, 0x0d, 0x74, 0xb4, 0x83, 0x1a, 0x2b, 0x41, 0x57, 0x01, 0xed, 0xfb, 0x89, 0x0b, 0x35,
-		0x10, 0x84, 0x74, 0xa9, 0x40, 0x6f, 0x13, 0x98, 0x0c, 0x6a, 0x05, 0xcb, 0xfd, 0x00, 0xa4, 0x25,
-		0x2a, 0xbb, 0x59, 0x9b, 0xdf, 0x5b, 0x4e, 0x4a, 0x96, 0xe8, 0xed, 0xda, 0xdf, 0x22, 0xb1, 0x30,
-		0xb9, 0x67, 0x35, 0xcd, 0x57, 0x29, 0xcd, 0x11, 0x26, 0xc6, 0x9f, 0xbf, 0x35, 0x37, 0x7b, 0xa8,
-		0x75, 0xda, 0xe5, 0x52, 0x0c, 0x4e, 0x29, 0x6e, 0x81, 0x4f, 0x82, 0xe7, 0xe8, 0x6a, 0x1c, 0xd5,
-		0x41, 0xbe, 0x85, 0x0f, 0x55, 0x87, 0xff, 0x30, 0xbb, 0xba, 0x8b, 0xc5, 0x3d, 0x06, 0x67, 0x16,
-		0x62, 0xee, 0x39, 0x5f, 0x58, 0xb4, 0x0c, 0x93, 0xef, 0x51, 0x4c, 0xdc, 0xc2, 0x87, 0x0a, 0xe7,
-		0x5b, 0xc6, 0xb8, 0xfc, 0x00, 0x99, 0x2d, 0x6f, 0xfe, 0xe1, 0xcf, 0x5c, 0x3e, 0x1b, 0xba, 0xb3,
-		0xfb, 0xc0, 0xcf, 0x93, 0xb1, 0x21, 0x26, 0x81, 0x2f, 0x0a, 0x9c, 0x50, 0xe8, 0x30, 0x18, 0x84,
-		0xd6, 0x0a, 0xd2, 0xd1, 0x6b, 0x90, 0x80, 0x3f, 0xb2, 0x06, 0x09, 0x4d, 0xd1, 0x17, 0x03, 0x1f,
-		0x90, 0x18, 0xd6, 0x9b, 0xb0, 0x76, 0x72, 0x26, 0x3a, 0xf3, 0x4f, 0x94, 0xfe, 0xad, 0x04, 0x67,
-		0xfa, 0xb4, 0xd9, 0x6f, 0xb2, 0x0e, 0xc8, 0x09, 0x55, 0x52, 0xad, 0x10, 0xfb, 0x81, 0xf7, 0x36,
-		0x39, 0x26, 0x9d, 0x3e, 0x47, 0xf0, 0xce, 0x38, 0x33, 0x6e, 0xc9, 0x7e, 0x5d, 0x82, 0xe9, 0x70,
-		0x03, 0xfc, 0xae, 0x34, 0x20, 0x1f, 0x7e, 0x35, 0xef, 0xc4, 0x03, 0xa3, 0x74, 0x22, 0xdc, 0xfe,
-		0x08, 0x08, 0xda, 0x0e, 0x2c, 0x06, 0xcb, 0xce, 0x5d, 0x1d, 0x59, 0x28, 0xa2, 0x61, 0xb1, 0x96,
-		0x83, 0x8d, 0xcd, 0x17, 0x24, 0x48, 0x6d, 0x5a, 0x56, 0x1b, 0xbd, 0x0f, 0x26, 0x4d, 0xcb, 0x53,
-		0xc9, 0xcc, 0xc2, 0x2d, 0x95, 0xa7, 0x0e, 0x98, 0x35, 0xae, 0x1d, 0x29, 0xab, 0x3f, 0x7a, 0x6b,
-		0xae, 0x9f, 0x33, 0xee, 0xde, 0xfc, 0xa2, 0x69, 0x79, 0x55, 0x4a, 0xd4, 0x64, 0xd9, 0x85, 0x5d,
-		0x28, 0x44, 0x5f, 0xc7, 0x2c, 0x76, 0x65, 0xd8, 0xeb, 0x0a, 0x43, 0x5f, 0x95, 0xdf, 0x09, 0xbd,
-		0x87, 0xdd, 0x10, 0xfe, 0xa7, 0x64, 0xe4, 0xbe, 0x01, 0xe4, 0xed, 0xde, 0xd3, 0x26, 0xcb, 0x30,
-		0x2e, 0x4e, 0x97, 0x48, 0xa3, 0x9e, 0x5c, 0x09, 0xcb, 0x93, 0x33, 0xd3, 0xf4, 0xe7, 0x67, 0x12,
-		0x70, 0x66, 0xd1, 0x32, 0x5d, 0x9e, 0xe8, 0xe1, 0xb3, 0x9a, 0xe5, 0x6a, 0x0f, 0xd1, 0xc3, 0x03,
-		0xd2, 0x50, 0xf9, 0xfe, 0x64, 0xd3, 0x36, 0x14, 0x89, 0x8b, 0xd5, 0x2d, 0xf3, 0x6d, 0xe6, 0x9a,
-		0x0a, 0x56, 0xbb, 0xc5, 0x5b, 0x74, 0x0b, 0x1f, 0x12, 0x5c, 0x13, 0xdf, 0x89, 0xe0, 0x26, 0xef,
-		0x0d, 0xd7, 0xc4, 0x77, 0x42, 0xb8, 0xc1, 0x86, 0x66, 0x2a, 0xb2, 0xa1, 0x79, 0x1d, 0x92, 0xc4,
-		0x14, 0x8e, 0x1d, 0xc3, 0x78, 0x10, 0x86, 0x90, 0x5b, 0x6b, 0xc0, 0x19, 0x9e, 0x29, 0x70, 0x37,
-		0x76, 0xa9, 0x44, 0x31, 0xed, 0xd0, 0xcb, 0xf8, 0x30, 0x26, 0x6d, 0x90, 0x1f, 0x29, 0x6d, 0x70,
-		0xf9, 0xe7, 0x25, 0x80, 0x20, 0x67, 0x86, 0x1e, 0x85, 0xd3, 0xd5, 0x8d, 0xf5, 0xa5, 0x60, 0x6f,
-		0x27, 0xf4, 0xe3, 0x41, 0xe2, 0x1e, 0x2f, 0xd7, 0xc6, 0xba, 0xb1, 0x6b, 0xe0, 0x16, 0x7a, 0x08,
-		0xa6, 0xa3, 0xd4, 0xe4, 0xa9, 0xb6, 0x24, 0x4b, 0xb3, 0xf9, 0x37, 0xef, 0xce, 0x67, 0xd8, 0x1a,
-		0x01, 0xb7, 0xd0, 0x25, 0x38, 0xd9, 0x4f, 0x57, 0x5f, 0x5f, 0x91, 0x13, 0xb3, 0x85, 0x37, 0xef,
-		0xce, 0x67, 0xfd, 0xc5, 0x04, 0x2a, 0x01, 0x0a, 0x53, 0x72, 0xbc, 0xe4, 0x2c, 0xbc, 0x79, 0x77,
-		0x3e, 0xcd, 0xa6, 0x0c, 0xdf, 0x14, 0xfa, 0x7a, 0x80, 0xba, 0xb9, 0xeb, 0x68, 0x3a, 0x35, 0x0d,
-		0xb3, 0x70, 0xaa, 0xbe, 0xbe, 0xac, 0x54, 0x16, 0x9b, 0xf5, 0x8d, 0xf5, 0x9e, 0xdf, 0x3c, 0x8a,
-		0xd6, 0x2d, 0x6d, 0x6c, 0x55, 0x57, 0x6b, 0x6a, 0xa3, 0xbe, 0xb2, 0xce, 0x76, 0x70, 0x23, 0x75,
-		0xef, 0x5d, 0x6f, 0xd6, 0xd7, 0x6a, 0x72, 0xa2, 0x7a, 0x7d, 0xe0, 0x66, 0xcf, 0x7d, 0x91, 0xc9,
-		0x18, 0xb8, 0xa3, 0xc8, 0x8f, 0x49, 0xfc, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xcf, 0xe7,
-		0x8d, 0x37, 0xa5, 0x00, 0x00,
+		// 12976 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x1c, 0xd7,
+		0x75, 0x18, 0x8c, 0x9e, 0xf7, 0x9c, 0x79, 0xf5, 0xde, 0x5d, 0x00, 0x83, 0x05, 0x09, 0x2c, 0x86,
+		0x2f, 0x10, 0x14, 0x16, 0x04, 0x48, 0x82, 0xc4, 0x52, 0x22, 0x3d, 0x2f, 0x00, 0x03, 0x2e, 0x76,
+		0x57, 0x3d, 0xb3, 0xcb, 0x87, 0x6d, 0xb5, 0x7b, 0x67, 0x7a, 0x77, 0x87, 0x98, 0xe9, 0x1e, 0x4d,
+		0xf7, 0x2c, 0xb0, 0xfa, 0xaa, 0x54, 0xb2, 0x2d, 0xf9, 0x93, 0x29, 0xcb, 0x91, 0x63, 0x47, 0xa6,
+		0x2d, 0x43, 0x96, 0xed, 0xd8, 0x92, 0x9f, 0xf1, 0x83, 0x76, 0x6c, 0xa7, 0xf2, 0x70, 0x2a, 0x71,
+		0x6c, 0x27, 0x71, 0x64, 0x55, 0x39, 0x71, 0xb9, 0xca, 0x4c, 0x22, 0xb9, 0x4c, 0xda, 0x96, 0x9f,
+		0xa1, 0x12, 0xa5, 0x54, 0x29, 0xa5, 0xce, 0x7d, 0xf4, 0x63, 0xa6, 0x67, 0x67, 0x16, 0xa4, 0x1c,
+		0xc6, 0xce, 0x9f, 0xdd, 0xb9, 0xf7, 0x9e, 0x73, 0xee, 0xbd, 0xe7, 0x9e, 0x7b, 0xce, 0xb9, 0xe7,
+		0x3e, 0x1a, 0xbe, 0xa8, 0xc2, 0xc2, 0xb6, 0x69, 0x6e, 0x77, 0xf4, 0x73, 0xbd, 0xbe, 0x69, 0x9b,
+		0x9b, 0x83, 0xad, 0x73, 0x2d, 0xdd, 0x6a, 0xf6, 0xdb, 0x3d, 0xdb, 0xec, 0x2f, 0xd2, 0x3c, 0x92,
+		0x63, 0x10, 0x8b, 0x02, 0xa2, 0x70, 0x1d, 0x66, 0x2e, 0xb7, 0x3b, 0x7a, 0xc5, 0x01, 0xac, 0xeb,
+		0x36, 0x79, 0x02, 0x22, 0x5b, 0xed, 0x8e, 0x9e, 0x97, 0x16, 0xc2, 0xa7, 0x53, 0x17, 0xee, 0x5d,
+		0x1c, 0x42, 0x5a, 0xf4, 0x63, 0xac, 0x61, 0xb6, 0x42, 0x31, 0x0a, 0x2f, 0x47, 0x61, 0x36, 0xa0,
+		0x94, 0x10, 0x88, 0x18, 0x5a, 0x17, 0x29, 0x4a, 0xa7, 0x93, 0x0a, 0xfd, 0x4d, 0xf2, 0x10, 0xef,
+		0x69, 0xcd, 0x1b, 0xda, 0xb6, 0x9e, 0x0f, 0xd1, 0x6c, 0x91, 0x24, 0x27, 0x00, 0x5a, 0x7a, 0x4f,
+		0x37, 0x5a, 0xba, 0xd1, 0xdc, 0xcb, 0x87, 0x17, 0xc2, 0xa7, 0x93, 0x8a, 0x27, 0x87, 0x3c, 0x04,
+		0x33, 0xbd, 0xc1, 0x66, 0xa7, 0xdd, 0x54, 0x3d, 0x60, 0xb0, 0x10, 0x3e, 0x1d, 0x55, 0x64, 0x56,
+		0x50, 0x71, 0x81, 0x1f, 0x80, 0xdc, 0x4d, 0x5d, 0xbb, 0xe1, 0x05, 0x4d, 0x51, 0xd0, 0x2c, 0x66,
+		0x7b, 0x00, 0xcb, 0x90, 0xee, 0xea, 0x96, 0xa5, 0x6d, 0xeb, 0xaa, 0xbd, 0xd7, 0xd3, 0xf3, 0x11,
+		0xda, 0xfb, 0x85, 0x91, 0xde, 0x0f, 0xf7, 0x3c, 0xc5, 0xb1, 0x1a, 0x7b, 0x3d, 0x9d, 0x14, 0x21,
+		0xa9, 0x1b, 0x83, 0x2e, 0xa3, 0x10, 0x1d, 0xc3, 0xbf, 0xaa, 0x31, 0xe8, 0x0e, 0x53, 0x49, 0x20,
+		0x1a, 0x27, 0x11, 0xb7, 0xf4, 0xfe, 0x6e, 0xbb, 0xa9, 0xe7, 0x63, 0x94, 0xc0, 0x03, 0x23, 0x04,
+		0xea, 0xac, 0x7c, 0x98, 0x86, 0xc0, 0x23, 0x65, 0x48, 0xea, 0xb7, 0x6c, 0xdd, 0xb0, 0xda, 0xa6,
+		0x91, 0x8f, 0x53, 0x22, 0xf7, 0x05, 0x8c, 0xa2, 0xde, 0x69, 0x0d, 0x93, 0x70, 0xf1, 0xc8, 0x45,
+		0x88, 0x9b, 0x3d, 0xbb, 0x6d, 0x1a, 0x56, 0x3e, 0xb1, 0x20, 0x9d, 0x4e, 0x5d, 0xb8, 0x2b, 0x50,
+		0x10, 0x56, 0x19, 0x8c, 0x22, 0x80, 0x49, 0x0d, 0x64, 0xcb, 0x1c, 0xf4, 0x9b, 0xba, 0xda, 0x34,
+		0x5b, 0xba, 0xda, 0x36, 0xb6, 0xcc, 0x7c, 0x92, 0x12, 0x38, 0x39, 0xda, 0x11, 0x0a, 0x58, 0x36,
+		0x5b, 0x7a, 0xcd, 0xd8, 0x32, 0x95, 0xac, 0xe5, 0x4b, 0x93, 0x23, 0x10, 0xb3, 0xf6, 0x0c, 0x5b,
+		0xbb, 0x95, 0x4f, 0x53, 0x09, 0xe1, 0x29, 0x72, 0x01, 0xe2, 0x7a, 0xab, 0x8d, 0xd5, 0xe5, 0xb3,
+		0x0b, 0xd2, 0xe9, 0xec, 0x85, 0xfc, 0x28, 0x8f, 0x59, 0xb9, 0x22, 0x00, 0x0b, 0xbf, 0x12, 0x83,
+		0xdc, 0x34, 0x62, 0xf9, 0x24, 0x44, 0xb7, 0x90, 0x33, 0xf9, 0xd0, 0x41, 0xf8, 0xc6, 0x70, 0xfc,
+		0x8c, 0x8f, 0xdd, 0x21, 0xe3, 0x8b, 0x90, 0x32, 0x74, 0xcb, 0xd6, 0x5b, 0x4c, 0x8a, 0xc2, 0x53,
+		0xca, 0x21, 0x30, 0xa4, 0x51, 0x31, 0x8c, 0xdc, 0x91, 0x18, 0x3e, 0x07, 0x39, 0xa7, 0x49, 0x6a,
+		0x5f, 0x33, 0xb6, 0x85, 0x3c, 0x9f, 0x9b, 0xd4, 0x92, 0xc5, 0xaa, 0xc0, 0x53, 0x10, 0x4d, 0xc9,
+		0xea, 0xbe, 0x34, 0xa9, 0x00, 0x98, 0x86, 0x6e, 0x6e, 0xa9, 0x2d, 0xbd, 0xd9, 0xc9, 0x27, 0xc6,
+		0x70, 0x69, 0x15, 0x41, 0x46, 0xb8, 0x64, 0xb2, 0xdc, 0x66, 0x87, 0x5c, 0x72, 0xc5, 0x33, 0x3e,
+		0x46, 0xba, 0xae, 0xb3, 0x89, 0x39, 0x22, 0xa1, 0xeb, 0x90, 0xed, 0xeb, 0x38, 0x57, 0xf4, 0x16,
+		0xef, 0x59, 0x92, 0x36, 0x62, 0x71, 0x62, 0xcf, 0x14, 0x8e, 0xc6, 0x3a, 0x96, 0xe9, 0x7b, 0x93,
+		0xe4, 0x1e, 0x70, 0x32, 0x54, 0x2a, 0x56, 0x40, 0x35, 0x57, 0x5a, 0x64, 0xae, 0x68, 0x5d, 0x7d,
+		0xfe, 0x7d, 0x90, 0xf5, 0xb3, 0x87, 0xcc, 0x41, 0xd4, 0xb2, 0xb5, 0xbe, 0x4d, 0xa5, 0x30, 0xaa,
+		0xb0, 0x04, 0x91, 0x21, 0xac, 0x1b, 0x2d, 0xaa, 0x19, 0xa3, 0x0a, 0xfe, 0x24, 0x5f, 0xe7, 0x76,
+		0x38, 0x4c, 0x3b, 0x7c, 0xff, 0xe8, 0x88, 0xfa, 0x28, 0x0f, 0xf7, 0x7b, 0xfe, 0x71, 0xc8, 0xf8,
+		0x3a, 0x30, 0x6d, 0xd5, 0x85, 0x7f, 0x17, 0x81, 0xc3, 0x81, 0xb4, 0xc9, 0x73, 0x30, 0x37, 0x30,
+		0xda, 0x86, 0xad, 0xf7, 0x7b, 0x7d, 0x1d, 0x45, 0x96, 0xd5, 0x95, 0x7f, 0x2d, 0x3e, 0x46, 0xe8,
+		0xd6, 0xbd, 0xd0, 0x8c, 0x8a, 0x32, 0x3b, 0x18, 0xcd, 0x24, 0xcf, 0x43, 0x0a, 0xe5, 0x43, 0xeb,
+		0x6b, 0x94, 0x20, 0x9b, 0x8d, 0x17, 0xa6, 0xeb, 0xf2, 0x62, 0xc5, 0xc5, 0x2c, 0x85, 0x3f, 0x2c,
+		0x85, 0x14, 0x2f, 0x2d, 0xf2, 0x38, 0x24, 0xb6, 0x74, 0xcd, 0x1e, 0xf4, 0x75, 0x2b, 0x7f, 0x81,
+		0xb2, 0xf2, 0xf8, 0xe8, 0x24, 0x65, 0x00, 0x75, 0xdd, 0x56, 0x1c, 0x60, 0xd2, 0x85, 0xf4, 0xae,
+		0xde, 0x6f, 0x6f, 0xb5, 0x9b, 0xac, 0x51, 0x61, 0xaa, 0x7c, 0x9e, 0x98, 0xb2, 0x51, 0x1b, 0x1e,
+		0xd4, 0xba, 0xad, 0xd9, 0xfa, 0x12, 0xac, 0xaf, 0x6c, 0x54, 0x95, 0xda, 0xe5, 0x5a, 0xb5, 0xc2,
+		0x9a, 0xe9, 0x23, 0x3f, 0xff, 0x3d, 0x12, 0xa4, 0x3c, 0x3d, 0x41, 0x75, 0x68, 0x0c, 0xba, 0x9b,
+		0x7a, 0x9f, 0x8f, 0x17, 0x4f, 0x91, 0xe3, 0x90, 0xdc, 0x1a, 0x74, 0x3a, 0x4c, 0xe8, 0x98, 0x2d,
+		0x4d, 0x60, 0x06, 0x0a, 0x1c, 0xea, 0x38, 0xae, 0x46, 0xa8, 0x8e, 0xc3, 0xdf, 0x64, 0x1e, 0x12,
+		0x42, 0x28, 0xf3, 0xd1, 0x05, 0xe9, 0x74, 0x42, 0x71, 0xd2, 0xac, 0xac, 0xa7, 0x6b, 0xb6, 0xde,
+		0xca, 0xc7, 0x44, 0x19, 0x4b, 0x5f, 0x8b, 0x24, 0x22, 0x72, 0xb4, 0xf0, 0x28, 0xcc, 0x8c, 0x74,
+		0x85, 0xe4, 0x20, 0x55, 0xa9, 0x96, 0x97, 0x8b, 0x4a, 0xb1, 0x51, 0x5b, 0x5d, 0x91, 0x0f, 0x91,
+		0x2c, 0x78, 0x7a, 0x27, 0x4b, 0x67, 0x92, 0x89, 0xd7, 0xe3, 0xf2, 0x07, 0x3e, 0xf0, 0x81, 0x0f,
+		0x84, 0x0a, 0xbf, 0x16, 0x83, 0xb9, 0x20, 0x25, 0x18, 0xa8, 0x8f, 0xdd, 0x4e, 0x87, 0x7d, 0x9d,
+		0x2e, 0x42, 0xb4, 0xa3, 0x6d, 0xea, 0x9d, 0x7c, 0x84, 0x0e, 0xc2, 0x43, 0x53, 0xa9, 0xd9, 0xc5,
+		0x65, 0x44, 0x51, 0x18, 0x26, 0x79, 0x8a, 0xb3, 0x26, 0x4a, 0x29, 0x9c, 0x99, 0x8e, 0x02, 0x2a,
+		0x47, 0xce, 0xc6, 0xe3, 0x90, 0xc4, 0xff, 0x8c, 0xef, 0x31, 0xc6, 0x77, 0xcc, 0xa0, 0x7c, 0x9f,
+		0x87, 0x04, 0xd5, 0x7b, 0x2d, 0xdd, 0x19, 0x13, 0x91, 0x46, 0x4d, 0xd1, 0xd2, 0xb7, 0xb4, 0x41,
+		0xc7, 0x56, 0x77, 0xb5, 0xce, 0x40, 0xa7, 0x1a, 0x2c, 0xa9, 0xa4, 0x79, 0xe6, 0x06, 0xe6, 0x91,
+		0x93, 0x90, 0x62, 0x6a, 0xb2, 0x6d, 0xb4, 0xf4, 0x5b, 0xd4, 0x84, 0x46, 0x15, 0xa6, 0x39, 0x6b,
+		0x98, 0x83, 0xd5, 0xbf, 0x68, 0x99, 0x86, 0xd0, 0x35, 0xb4, 0x0a, 0xcc, 0xa0, 0xd5, 0x3f, 0x3e,
+		0x6c, 0xbd, 0xef, 0x0e, 0xee, 0xde, 0x88, 0x72, 0x7c, 0x00, 0x72, 0x14, 0xe2, 0x11, 0x3e, 0x95,
+		0xb5, 0x4e, 0x7e, 0x86, 0x8a, 0x41, 0x96, 0x65, 0xaf, 0xf2, 0xdc, 0xc2, 0x2f, 0x85, 0x20, 0x42,
+		0x2d, 0x45, 0x0e, 0x52, 0x8d, 0xe7, 0xd7, 0xaa, 0x6a, 0x65, 0x75, 0xbd, 0xb4, 0x5c, 0x95, 0x25,
+		0x1c, 0x7a, 0x9a, 0x71, 0x79, 0x79, 0xb5, 0xd8, 0x90, 0x43, 0x4e, 0xba, 0xb6, 0xd2, 0xb8, 0xf8,
+		0xa8, 0x1c, 0x76, 0x10, 0xd6, 0x59, 0x46, 0xc4, 0x0b, 0xf0, 0xc8, 0x05, 0x39, 0x4a, 0x64, 0x48,
+		0x33, 0x02, 0xb5, 0xe7, 0xaa, 0x95, 0x8b, 0x8f, 0xca, 0x31, 0x7f, 0xce, 0x23, 0x17, 0xe4, 0x38,
+		0xc9, 0x40, 0x92, 0xe6, 0x94, 0x56, 0x57, 0x97, 0xe5, 0x84, 0x43, 0xb3, 0xde, 0x50, 0x6a, 0x2b,
+		0x57, 0xe4, 0xa4, 0x43, 0xf3, 0x8a, 0xb2, 0xba, 0xbe, 0x26, 0x83, 0x43, 0xe1, 0x7a, 0xb5, 0x5e,
+		0x2f, 0x5e, 0xa9, 0xca, 0x29, 0x07, 0xa2, 0xf4, 0x7c, 0xa3, 0x5a, 0x97, 0xd3, 0xbe, 0x66, 0x3d,
+		0x72, 0x41, 0xce, 0x38, 0x55, 0x54, 0x57, 0xd6, 0xaf, 0xcb, 0x59, 0x32, 0x03, 0x19, 0x56, 0x85,
+		0x68, 0x44, 0x6e, 0x28, 0xeb, 0xe2, 0xa3, 0xb2, 0xec, 0x36, 0x84, 0x51, 0x99, 0xf1, 0x65, 0x5c,
+		0x7c, 0x54, 0x26, 0x85, 0x32, 0x44, 0xa9, 0x18, 0x12, 0x02, 0xd9, 0xe5, 0x62, 0xa9, 0xba, 0xac,
+		0xae, 0xae, 0xe1, 0xa4, 0x29, 0x2e, 0xcb, 0x92, 0x9b, 0xa7, 0x54, 0xd7, 0xaa, 0xc5, 0x46, 0xb5,
+		0x22, 0x87, 0xbd, 0x79, 0xef, 0x5e, 0xaf, 0x29, 0xd5, 0x8a, 0x1c, 0x2a, 0x34, 0x61, 0x2e, 0xc8,
+		0x42, 0x06, 0x4e, 0x21, 0x8f, 0x2c, 0x84, 0xc6, 0xc8, 0x02, 0xa5, 0x35, 0x2c, 0x0b, 0x85, 0x2f,
+		0x84, 0x60, 0x36, 0xc0, 0x4b, 0x08, 0xac, 0xe4, 0x69, 0x88, 0x32, 0x59, 0x66, 0x9a, 0xfa, 0xc1,
+		0x40, 0x77, 0x83, 0x4a, 0xf6, 0x88, 0xef, 0x44, 0xf1, 0xbc, 0xfe, 0x66, 0x78, 0x8c, 0xbf, 0x89,
+		0x24, 0x46, 0x04, 0xf6, 0x1b, 0x47, 0xac, 0x39, 0x73, 0x78, 0x2e, 0x4e, 0xe3, 0xf0, 0xd0, 0xbc,
+		0x83, 0x59, 0xf5, 0x68, 0x80, 0x55, 0x7f, 0x12, 0x66, 0x46, 0x08, 0x4d, 0x6d, 0x5d, 0xbf, 0x55,
+		0x82, 0xfc, 0x38, 0xe6, 0x4c, 0x50, 0x89, 0x21, 0x9f, 0x4a, 0x7c, 0x72, 0x98, 0x83, 0xa7, 0xc6,
+		0x0f, 0xc2, 0xc8, 0x58, 0x7f, 0x5a, 0x82, 0x23, 0xc1, 0xeb, 0x8a, 0xc0, 0x36, 0x3c, 0x05, 0xb1,
+		0xae, 0x6e, 0xef, 0x98, 0xc2, 0x4f, 0xbe, 0x3f, 0xc0, 0xfb, 0xc2, 0xe2, 0xe1, 0xc1, 0xe6, 0x58,
+		0xe4, 0xd2, 0x70, 0x5b, 0x4f, 0x8e, 0x5b, 0xe5, 0x8c, 0xb4, 0xf4, 0xdb, 0x43, 0x70, 0x38, 0x90,
+		0x78, 0x60, 0x43, 0xef, 0x06, 0x68, 0x1b, 0xbd, 0x81, 0xcd, 0x7c, 0x61, 0xa6, 0x89, 0x93, 0x34,
+		0x87, 0x2a, 0x2f, 0xd4, 0xb2, 0x03, 0xdb, 0x29, 0x67, 0x56, 0x12, 0x58, 0x16, 0x05, 0x78, 0xc2,
+		0x6d, 0x68, 0x84, 0x36, 0xf4, 0xc4, 0x98, 0x9e, 0x8e, 0x08, 0xe6, 0xc3, 0x20, 0x37, 0x3b, 0x6d,
+		0xdd, 0xb0, 0x55, 0xcb, 0xee, 0xeb, 0x5a, 0xb7, 0x6d, 0x6c, 0x33, 0x6b, 0xbb, 0x14, 0xdd, 0xd2,
+		0x3a, 0x96, 0xae, 0xe4, 0x58, 0x71, 0x5d, 0x94, 0x22, 0x06, 0x15, 0xa0, 0xbe, 0x07, 0x23, 0xe6,
+		0xc3, 0x60, 0xc5, 0x0e, 0x46, 0xe1, 0xa7, 0x93, 0x90, 0xf2, 0xac, 0xc2, 0xc8, 0x29, 0x48, 0xbf,
+		0xa8, 0xed, 0x6a, 0xaa, 0x58, 0x59, 0x33, 0x4e, 0xa4, 0x30, 0x6f, 0x8d, 0x65, 0x91, 0x87, 0x61,
+		0x8e, 0x82, 0x98, 0x03, 0x5b, 0xef, 0xab, 0xcd, 0x8e, 0x66, 0x59, 0x94, 0x69, 0x09, 0x0a, 0x4a,
+		0xb0, 0x6c, 0x15, 0x8b, 0xca, 0xa2, 0x84, 0x3c, 0x06, 0xb3, 0x14, 0xa3, 0x3b, 0xe8, 0xd8, 0xed,
+		0x5e, 0x47, 0x57, 0x71, 0xad, 0x6f, 0xe5, 0xc1, 0xdb, 0xb2, 0x19, 0x84, 0xb8, 0xce, 0x01, 0xb0,
+		0x45, 0x16, 0xa9, 0xc0, 0xdd, 0x14, 0x6d, 0x5b, 0x37, 0xf4, 0xbe, 0x66, 0xeb, 0xaa, 0xfe, 0xde,
+		0x81, 0xd6, 0xb1, 0x54, 0xcd, 0x68, 0xa9, 0x3b, 0x9a, 0xb5, 0x93, 0x9f, 0x43, 0x02, 0xa5, 0x50,
+		0x5e, 0x52, 0x8e, 0x21, 0xe0, 0x15, 0x0e, 0x57, 0xa5, 0x60, 0x45, 0xa3, 0x75, 0x55, 0xb3, 0x76,
+		0xc8, 0x12, 0x1c, 0xa1, 0x54, 0x2c, 0xbb, 0xdf, 0x36, 0xb6, 0xd5, 0xe6, 0x8e, 0xde, 0xbc, 0xa1,
+		0x0e, 0xec, 0xad, 0x27, 0xf2, 0xc7, 0xbd, 0xf5, 0xd3, 0x16, 0xd6, 0x29, 0x4c, 0x19, 0x41, 0xd6,
+		0xed, 0xad, 0x27, 0x48, 0x1d, 0xd2, 0x38, 0x18, 0xdd, 0xf6, 0xfb, 0x74, 0x75, 0xcb, 0xec, 0x53,
+		0x1b, 0x9a, 0x0d, 0x50, 0x4d, 0x1e, 0x0e, 0x2e, 0xae, 0x72, 0x84, 0xeb, 0x66, 0x4b, 0x5f, 0x8a,
+		0xd6, 0xd7, 0xaa, 0xd5, 0x8a, 0x92, 0x12, 0x54, 0x2e, 0x9b, 0x7d, 0x14, 0xa8, 0x6d, 0xd3, 0x61,
+		0x70, 0x8a, 0x09, 0xd4, 0xb6, 0x29, 0xd8, 0xfb, 0x18, 0xcc, 0x36, 0x9b, 0xac, 0xcf, 0xed, 0xa6,
+		0xca, 0x57, 0xe4, 0x56, 0x5e, 0xf6, 0x31, 0xab, 0xd9, 0xbc, 0xc2, 0x00, 0xb8, 0x8c, 0x5b, 0xe4,
+		0x12, 0x1c, 0x76, 0x99, 0xe5, 0x45, 0x9c, 0x19, 0xe9, 0xe5, 0x30, 0xea, 0x63, 0x30, 0xdb, 0xdb,
+		0x1b, 0x45, 0x24, 0xbe, 0x1a, 0x7b, 0x7b, 0xc3, 0x68, 0xf7, 0xd1, 0x28, 0x4b, 0x5f, 0x6f, 0x52,
+		0x57, 0xef, 0xa8, 0x17, 0xda, 0x53, 0x40, 0x16, 0x41, 0x6e, 0x36, 0x55, 0xdd, 0xd0, 0x36, 0x3b,
+		0xba, 0xaa, 0xf5, 0x75, 0x43, 0xb3, 0xf2, 0x27, 0x29, 0x70, 0xc4, 0xee, 0x0f, 0x74, 0x25, 0xdb,
+		0x6c, 0x56, 0x69, 0x61, 0x91, 0x96, 0x91, 0x33, 0x30, 0x63, 0x6e, 0xbe, 0xd8, 0x64, 0x82, 0xa5,
+		0xf6, 0xfa, 0xfa, 0x56, 0xfb, 0x56, 0xfe, 0x5e, 0xca, 0xa5, 0x1c, 0x16, 0x50, 0xb1, 0x5a, 0xa3,
+		0xd9, 0xe4, 0x41, 0x90, 0x9b, 0xd6, 0x8e, 0xd6, 0xef, 0x51, 0xcd, 0x6a, 0xf5, 0xb4, 0xa6, 0x9e,
+		0xbf, 0x8f, 0x81, 0xb2, 0xfc, 0x15, 0x91, 0x8d, 0x82, 0x6d, 0xdd, 0x6c, 0x6f, 0xd9, 0x82, 0xe2,
+		0x03, 0x4c, 0xb0, 0x69, 0x1e, 0xa7, 0x76, 0x1a, 0xe4, 0xde, 0x4e, 0xcf, 0x5f, 0xf1, 0x69, 0x0a,
+		0x96, 0xed, 0xed, 0xf4, 0xbc, 0xf5, 0xde, 0x03, 0x99, 0xde, 0x8e, 0xb7, 0xd2, 0x07, 0x99, 0xff,
+		0xd5, 0xdb, 0xf1, 0xd4, 0xf8, 0x28, 0x1c, 0x41, 0xa0, 0xae, 0x6e, 0x6b, 0x2d, 0xcd, 0xd6, 0x3c,
+		0xd0, 0xef, 0xa0, 0xd0, 0x73, 0xbd, 0x9d, 0xde, 0x75, 0x5e, 0xe8, 0x6b, 0x67, 0x7f, 0xb0, 0xb9,
+		0xe7, 0xc8, 0xc7, 0x59, 0xd6, 0x4e, 0xcc, 0x13, 0x12, 0x72, 0xc7, 0xcb, 0x8f, 0xaf, 0xd9, 0x62,
+		0xab, 0xb0, 0x04, 0x69, 0xaf, 0xdc, 0x93, 0x24, 0x30, 0xc9, 0x97, 0x25, 0x74, 0x82, 0xca, 0xab,
+		0x15, 0x74, 0x5f, 0x5e, 0xa8, 0xca, 0x21, 0x74, 0xa3, 0x96, 0x6b, 0x8d, 0xaa, 0xaa, 0xac, 0xaf,
+		0x34, 0x6a, 0xd7, 0xab, 0x72, 0xd8, 0xe3, 0xd8, 0x5f, 0x8b, 0x24, 0xce, 0xc8, 0x0f, 0x5d, 0x8b,
+		0x24, 0xee, 0x97, 0x1f, 0xa0, 0xec, 0x19, 0x11, 0xca, 0xc2, 0x1b, 0x61, 0xc8, 0xfa, 0x97, 0xe5,
+		0xe4, 0x9d, 0x70, 0x54, 0xc4, 0xdd, 0x2c, 0xdd, 0x56, 0x6f, 0xb6, 0xfb, 0x74, 0xb2, 0x76, 0x35,
+		0x66, 0x38, 0x1d, 0xa1, 0x9c, 0xe3, 0x50, 0x75, 0xdd, 0x7e, 0xb6, 0xdd, 0xc7, 0xa9, 0xd8, 0xd5,
+		0x6c, 0xb2, 0x0c, 0x27, 0x0d, 0x53, 0xb5, 0x6c, 0xcd, 0x68, 0x69, 0xfd, 0x96, 0xea, 0x46, 0x3c,
+		0x55, 0xad, 0xd9, 0xd4, 0x2d, 0xcb, 0x64, 0x46, 0xd2, 0xa1, 0x72, 0x97, 0x61, 0xd6, 0x39, 0xb0,
+		0x6b, 0x3d, 0x8a, 0x1c, 0x74, 0x68, 0x4e, 0x84, 0xc7, 0xcd, 0x89, 0xe3, 0x90, 0xec, 0x6a, 0x3d,
+		0x55, 0x37, 0xec, 0xfe, 0x1e, 0xf5, 0xdd, 0x13, 0x4a, 0xa2, 0xab, 0xf5, 0xaa, 0x98, 0x26, 0x1b,
+		0x70, 0xbf, 0x0b, 0xaa, 0x76, 0xf4, 0x6d, 0xad, 0xb9, 0xa7, 0x52, 0x47, 0x9d, 0xc6, 0x88, 0xd4,
+		0xa6, 0x69, 0x6c, 0x75, 0xda, 0x4d, 0xdb, 0xca, 0xa7, 0x1c, 0xfd, 0x57, 0x70, 0x31, 0x96, 0x29,
+		0xc2, 0x35, 0xcb, 0x34, 0xa8, 0x7f, 0x5e, 0x16, 0xd0, 0x3e, 0xb1, 0x49, 0xbf, 0x2d, 0xc4, 0xc6,
+		0x3f, 0xf4, 0x11, 0x39, 0x7a, 0x2d, 0x92, 0x88, 0xca, 0xb1, 0x6b, 0x91, 0x44, 0x4c, 0x8e, 0x5f,
+		0x8b, 0x24, 0x12, 0x72, 0xf2, 0x5a, 0x24, 0x91, 0x94, 0xa1, 0x70, 0x3b, 0x03, 0x69, 0xef, 0x72,
+		0x03, 0x57, 0x6f, 0x4d, 0x6a, 0x70, 0x25, 0xaa, 0x92, 0xef, 0xd9, 0x77, 0x71, 0xb2, 0x58, 0x46,
+		0x4b, 0xbc, 0x14, 0x63, 0xbe, 0xbd, 0xc2, 0x30, 0xd1, 0x0b, 0xc2, 0x49, 0xa6, 0x33, 0x5f, 0x2a,
+		0xa1, 0xf0, 0x14, 0xb9, 0x02, 0xb1, 0x17, 0x2d, 0x4a, 0x3b, 0x46, 0x69, 0xdf, 0xbb, 0x3f, 0xed,
+		0x6b, 0x75, 0x4a, 0x3c, 0x79, 0xad, 0xae, 0xae, 0xac, 0x2a, 0xd7, 0x8b, 0xcb, 0x0a, 0x47, 0x27,
+		0xc7, 0x20, 0xd2, 0xd1, 0xde, 0xb7, 0xe7, 0xb7, 0xd9, 0x34, 0x8b, 0x2c, 0x42, 0x6e, 0x60, 0xb0,
+		0xb5, 0x3a, 0x8e, 0x31, 0x42, 0xe5, 0xbc, 0x50, 0x59, 0xb7, 0x74, 0x19, 0xe1, 0xa7, 0x94, 0xab,
+		0x63, 0x10, 0xc1, 0xa0, 0xb4, 0xdf, 0xb2, 0xd2, 0x2c, 0x72, 0x1a, 0xd2, 0x2d, 0x7d, 0x73, 0xb0,
+		0xad, 0xf6, 0xf5, 0x96, 0xd6, 0xb4, 0xfd, 0xf6, 0x24, 0x45, 0x8b, 0x14, 0x5a, 0x42, 0x9e, 0x81,
+		0x24, 0x8e, 0x91, 0x41, 0xc7, 0x78, 0x86, 0xb2, 0xe0, 0xec, 0xfe, 0x2c, 0xe0, 0x43, 0x2c, 0x90,
+		0x14, 0x17, 0x9f, 0x5c, 0x85, 0xb8, 0xad, 0xf5, 0xb7, 0x75, 0xdb, 0xca, 0xcf, 0x2e, 0x84, 0x4f,
+		0x67, 0x2f, 0x2c, 0x4e, 0x43, 0xaa, 0x41, 0x51, 0xe8, 0x4a, 0x59, 0xa0, 0x93, 0x67, 0x41, 0xe6,
+		0xa1, 0x58, 0x95, 0x2f, 0x73, 0xad, 0xfc, 0x1c, 0x15, 0xc0, 0x77, 0xec, 0x4f, 0x92, 0x47, 0x72,
+		0x2b, 0x0c, 0x49, 0xc9, 0xe9, 0xbe, 0xb4, 0x7f, 0x5e, 0x1c, 0x3e, 0xc8, 0xbc, 0x58, 0x87, 0x1c,
+		0xff, 0xad, 0x5a, 0x83, 0x5e, 0xcf, 0xec, 0xdb, 0xf9, 0x23, 0x0b, 0xd2, 0xe4, 0x06, 0x09, 0x62,
+		0x0c, 0x47, 0xc9, 0x6e, 0xf9, 0xd2, 0x5f, 0xbb, 0xe9, 0x36, 0xff, 0x02, 0x64, 0xfd, 0xcc, 0xf0,
+		0x06, 0xc2, 0xc3, 0x53, 0x06, 0xc2, 0x71, 0x59, 0x22, 0x16, 0x6a, 0x68, 0x9a, 0x58, 0x62, 0xfe,
+		0xe3, 0x21, 0xc8, 0xfa, 0x3b, 0x46, 0xae, 0x00, 0x11, 0x23, 0xd6, 0x36, 0xec, 0xbe, 0xd9, 0x1a,
+		0x34, 0xf5, 0x56, 0x5e, 0x9a, 0x50, 0xcf, 0x0c, 0xc7, 0xa9, 0x39, 0x28, 0x5e, 0x42, 0x9e, 0x59,
+		0x10, 0x9a, 0x92, 0x50, 0xc5, 0x9d, 0x1f, 0xe7, 0x60, 0x56, 0x10, 0x40, 0x62, 0x37, 0xb5, 0xbe,
+		0x81, 0x2e, 0x32, 0x73, 0xda, 0x89, 0xa7, 0xe8, 0x59, 0x56, 0x42, 0x8a, 0x20, 0xc4, 0x45, 0xed,
+		0xeb, 0x5d, 0x13, 0xe3, 0x5d, 0x91, 0x09, 0xd5, 0x66, 0x39, 0x82, 0xc2, 0xe0, 0x0b, 0xe7, 0x20,
+		0x4a, 0xd5, 0x0f, 0x01, 0xe0, 0x0a, 0x48, 0x3e, 0x44, 0x12, 0x10, 0x29, 0xaf, 0x2a, 0x68, 0x1e,
+		0x65, 0x48, 0xb3, 0x5c, 0x75, 0xad, 0x56, 0x2d, 0x57, 0xe5, 0x50, 0xe1, 0x31, 0x88, 0x31, 0x9d,
+		0x82, 0xa6, 0xd3, 0xd1, 0x2a, 0xf2, 0x21, 0x9e, 0xe4, 0x34, 0x24, 0x51, 0xba, 0x7e, 0xbd, 0x54,
+		0x55, 0xe4, 0x50, 0x61, 0x1d, 0x72, 0x43, 0xf3, 0x90, 0x1c, 0x86, 0x19, 0xa5, 0xda, 0xa8, 0xae,
+		0x60, 0x70, 0x40, 0x5d, 0x5f, 0x79, 0x66, 0x65, 0xf5, 0x59, 0x8c, 0xac, 0xf9, 0xb2, 0x85, 0x1d,
+		0x96, 0xc8, 0x1c, 0xc8, 0x6e, 0x76, 0x7d, 0x75, 0x5d, 0xa1, 0xad, 0xf9, 0x8e, 0x10, 0xc8, 0xc3,
+		0x93, 0x92, 0x1c, 0x85, 0xd9, 0x46, 0x51, 0xb9, 0x52, 0x6d, 0xa8, 0x2c, 0xe0, 0xe1, 0x90, 0x9e,
+		0x03, 0xd9, 0x5b, 0x70, 0xb9, 0x46, 0xe3, 0x39, 0x27, 0xe1, 0xb8, 0x37, 0xb7, 0xfa, 0x5c, 0xa3,
+		0xba, 0x52, 0xa7, 0x95, 0x17, 0x57, 0xae, 0xa0, 0x53, 0x30, 0x44, 0x4f, 0x84, 0x58, 0xc2, 0xd8,
+		0x54, 0x3f, 0xbd, 0xea, 0x72, 0x45, 0x8e, 0x0c, 0x67, 0xaf, 0xae, 0x54, 0x57, 0x2f, 0xcb, 0xd1,
+		0xe1, 0xda, 0x69, 0xd8, 0x25, 0x46, 0xe6, 0xe1, 0xc8, 0x70, 0xae, 0x5a, 0x5d, 0x69, 0x28, 0xcf,
+		0xcb, 0xf1, 0xe1, 0x8a, 0xeb, 0x55, 0x65, 0xa3, 0x56, 0xae, 0xca, 0x09, 0x72, 0x04, 0x88, 0xbf,
+		0x45, 0x8d, 0xab, 0xab, 0x15, 0x39, 0x19, 0x64, 0xb1, 0x88, 0x3c, 0x5b, 0xf8, 0x29, 0x09, 0xd2,
+		0xde, 0x10, 0x88, 0x4f, 0xa9, 0x48, 0x6f, 0x37, 0x63, 0x5b, 0xf8, 0x9d, 0x10, 0xa4, 0x3c, 0xb1,
+		0x10, 0x5c, 0xc4, 0x6a, 0x9d, 0x8e, 0x79, 0x53, 0xd5, 0x3a, 0x6d, 0xcd, 0xe2, 0xf6, 0x10, 0x68,
+		0x56, 0x11, 0x73, 0xa6, 0xb5, 0x3f, 0xd3, 0xbb, 0x2e, 0xb1, 0x3b, 0x76, 0x5d, 0xe2, 0x6f, 0x43,
+		0xd7, 0x25, 0x2a, 0xc7, 0x0a, 0xbf, 0x17, 0x02, 0x79, 0x38, 0x3a, 0x32, 0xc4, 0x37, 0x69, 0x1c,
+		0xdf, 0xbc, 0xfd, 0x0b, 0x1d, 0xa4, 0x7f, 0xc3, 0x56, 0x3d, 0x3c, 0xd6, 0xaa, 0x07, 0x18, 0xab,
+		0xc8, 0xdb, 0xd9, 0x58, 0x79, 0xc5, 0xf5, 0x77, 0x25, 0xc8, 0xfa, 0x83, 0x39, 0x3e, 0x8e, 0x15,
+		0x0e, 0xc2, 0x31, 0xff, 0x88, 0x9c, 0x1a, 0x37, 0x22, 0x7f, 0x23, 0xfd, 0xfa, 0xbe, 0x30, 0x64,
+		0x7c, 0xb1, 0x9f, 0x69, 0x5b, 0xf7, 0x5e, 0x98, 0x69, 0xb7, 0xf4, 0x6e, 0xcf, 0xb4, 0xf1, 0xe4,
+		0x81, 0xda, 0xd1, 0x77, 0xf5, 0x0e, 0x65, 0x43, 0x36, 0x60, 0x77, 0xd5, 0x57, 0xc3, 0x62, 0xcd,
+		0xc5, 0x5b, 0x46, 0xb4, 0xa5, 0xd9, 0x5a, 0xa5, 0x7a, 0x7d, 0x6d, 0xb5, 0x51, 0x5d, 0x29, 0x3f,
+		0x2f, 0x34, 0xb9, 0x22, 0xb7, 0x87, 0xc0, 0x7c, 0x0c, 0xbf, 0xe7, 0xed, 0xb1, 0xe8, 0x5c, 0x03,
+		0x79, 0xb8, 0x37, 0xa8, 0xd0, 0x03, 0xfa, 0x23, 0x1f, 0x22, 0xb3, 0x90, 0x5b, 0x59, 0x55, 0xeb,
+		0xb5, 0x4a, 0x55, 0xad, 0x5e, 0xbe, 0x5c, 0x2d, 0x37, 0xea, 0x6c, 0xa3, 0xc1, 0x81, 0x6e, 0xc8,
+		0x21, 0xef, 0xd8, 0x7c, 0x7f, 0x18, 0x66, 0x03, 0x5a, 0x42, 0x8a, 0x3c, 0x44, 0xc8, 0xa2, 0x96,
+		0x67, 0xa7, 0x69, 0xfd, 0x22, 0xae, 0xee, 0xd7, 0xb4, 0xbe, 0xcd, 0x23, 0x8a, 0x0f, 0x02, 0xb2,
+		0xd7, 0xb0, 0xd1, 0xbd, 0xef, 0xf3, 0x0d, 0x1c, 0xe6, 0x82, 0xe4, 0xdc, 0x7c, 0xb6, 0x87, 0xf3,
+		0x0e, 0x20, 0x3d, 0xd3, 0x6a, 0xdb, 0xed, 0x5d, 0x3c, 0x08, 0x21, 0x76, 0x7b, 0x70, 0xe2, 0x46,
+		0x14, 0x59, 0x94, 0xd4, 0x0c, 0xdb, 0x81, 0x36, 0xf4, 0x6d, 0x6d, 0x08, 0x1a, 0x97, 0x1f, 0x61,
+		0x45, 0x16, 0x25, 0x0e, 0xf4, 0x29, 0x48, 0xb7, 0xcc, 0x01, 0x46, 0x65, 0x18, 0x1c, 0xaa, 0x64,
+		0x49, 0x49, 0xb1, 0x3c, 0x07, 0x84, 0x87, 0xcd, 0xdc, 0x6d, 0xa6, 0xb4, 0x92, 0x62, 0x79, 0x0c,
+		0xe4, 0x01, 0xc8, 0x69, 0xdb, 0xdb, 0x7d, 0x24, 0x2e, 0x08, 0xb1, 0x40, 0x60, 0xd6, 0xc9, 0xa6,
+		0x80, 0xf3, 0xd7, 0x20, 0x21, 0xf8, 0x80, 0xeb, 0x5f, 0xe4, 0x84, 0xda, 0x63, 0xd1, 0xed, 0x10,
+		0xee, 0x3c, 0x19, 0xa2, 0xf0, 0x14, 0xa4, 0xdb, 0x96, 0xea, 0x1e, 0x83, 0x08, 0x2d, 0x84, 0x4e,
+		0x27, 0x94, 0x54, 0xdb, 0x72, 0x76, 0x45, 0x0b, 0x9f, 0x06, 0x00, 0x57, 0xd8, 0xc8, 0x77, 0x49,
+		0x90, 0x65, 0x06, 0xa6, 0xd7, 0xd7, 0x2d, 0xdd, 0x68, 0x8a, 0x65, 0xe1, 0x83, 0xfb, 0x88, 0x28,
+		0x53, 0x73, 0x6b, 0x1c, 0xa1, 0xf4, 0xf4, 0x87, 0x25, 0xe9, 0x65, 0x29, 0xf2, 0xb2, 0x24, 0xfd,
+		0xb0, 0x94, 0x21, 0x89, 0xea, 0x73, 0x6b, 0xcb, 0xb5, 0x72, 0xad, 0x91, 0xff, 0xa3, 0x38, 0x4d,
+		0xd7, 0xae, 0xf3, 0xf4, 0x6b, 0x71, 0x7f, 0xf9, 0xeb, 0xf1, 0x9f, 0x97, 0xc2, 0x89, 0xd7, 0xe3,
+		0x4a, 0x66, 0xcb, 0x4b, 0x8f, 0x74, 0xbc, 0x27, 0x28, 0x42, 0xe3, 0x16, 0x92, 0x6e, 0x6b, 0xaa,
+		0xfc, 0xdc, 0x44, 0xe9, 0x41, 0xda, 0x90, 0x18, 0x6d, 0x48, 0x8a, 0xc4, 0xca, 0xcb, 0xab, 0xf5,
+		0x6a, 0x85, 0x36, 0x23, 0x49, 0x22, 0xab, 0x6b, 0xd5, 0x95, 0xfc, 0x6b, 0xa2, 0x4a, 0xf7, 0xb0,
+		0xc5, 0xcb, 0x12, 0x1c, 0x15, 0xbb, 0xac, 0xdc, 0xd6, 0xea, 0x46, 0xd3, 0x6c, 0x09, 0xef, 0x36,
+		0x7b, 0xe1, 0xfc, 0x7e, 0x95, 0x2b, 0x1c, 0x95, 0xb2, 0xa4, 0xca, 0x11, 0x4b, 0x67, 0x47, 0x58,
+		0x52, 0x5c, 0xa9, 0xf0, 0xb6, 0xa4, 0x48, 0x6c, 0xad, 0x58, 0x7e, 0xa6, 0x5a, 0x71, 0x5b, 0x73,
+		0xb8, 0x1f, 0x44, 0x85, 0xbc, 0x1f, 0x72, 0x18, 0x6d, 0x45, 0xd9, 0x68, 0xb7, 0xd8, 0xb6, 0x77,
+		0x64, 0xdc, 0x7e, 0xa9, 0xdb, 0x22, 0x0c, 0xbf, 0x6e, 0x38, 0x18, 0xa5, 0x07, 0x3d, 0x4d, 0x49,
+		0x92, 0xc8, 0xca, 0xea, 0x4a, 0x55, 0x34, 0x83, 0x6e, 0x11, 0x3f, 0xef, 0x36, 0x23, 0x3b, 0xf0,
+		0xa1, 0x92, 0xf7, 0x83, 0x2c, 0xc2, 0x43, 0x0e, 0x4b, 0xa2, 0xe3, 0xb6, 0x7c, 0xdd, 0x06, 0xf0,
+		0x20, 0x93, 0xc3, 0x8c, 0xfb, 0x3d, 0x2d, 0x98, 0x23, 0xb9, 0xe5, 0xea, 0xca, 0x95, 0xc6, 0x55,
+		0x75, 0x4d, 0xa9, 0xd2, 0x9d, 0xbb, 0xfc, 0x1f, 0x89, 0xea, 0x73, 0x5d, 0x3f, 0x22, 0xf9, 0x16,
+		0x09, 0x52, 0xcc, 0x05, 0x62, 0x31, 0x29, 0x16, 0x54, 0xb8, 0x7f, 0xbf, 0xba, 0xa9, 0x07, 0x44,
+		0xa1, 0x4b, 0x97, 0x68, 0xb5, 0x61, 0x21, 0x10, 0x47, 0x09, 0x59, 0xae, 0x5e, 0x29, 0x96, 0x9f,
+		0x57, 0x4b, 0xd5, 0x7a, 0x03, 0x35, 0xd9, 0xaa, 0xc2, 0x64, 0x14, 0x48, 0xb4, 0xb8, 0xbc, 0xbc,
+		0xfa, 0xac, 0xcb, 0x08, 0x78, 0xd1, 0x21, 0x53, 0xf8, 0x06, 0xc8, 0xf8, 0xc4, 0x1d, 0x9d, 0x62,
+		0xea, 0x4c, 0x63, 0x0f, 0xea, 0xd5, 0x95, 0xb2, 0xd7, 0x89, 0x4f, 0x83, 0x23, 0xde, 0xb2, 0x84,
+		0x29, 0x21, 0xfc, 0x72, 0x08, 0xd5, 0x28, 0x6f, 0x80, 0xb3, 0x97, 0x18, 0x2e, 0x3c, 0x0e, 0x09,
+		0x21, 0xbe, 0xe8, 0x9a, 0x53, 0x0f, 0x7b, 0x68, 0x61, 0x90, 0x00, 0x2a, 0xbb, 0xb2, 0x84, 0xcb,
+		0x20, 0x26, 0xd3, 0x72, 0xa8, 0xb0, 0x01, 0x87, 0x03, 0x45, 0x8f, 0xdc, 0x03, 0x27, 0xc5, 0xfe,
+		0x25, 0x73, 0xfa, 0xd5, 0xea, 0x4a, 0x79, 0xb5, 0x82, 0xcb, 0x24, 0x97, 0x26, 0x00, 0x97, 0x41,
+		0xd6, 0x4a, 0x21, 0x9f, 0x72, 0xa8, 0x50, 0x83, 0xac, 0x5f, 0x80, 0xc8, 0x71, 0x38, 0xba, 0xde,
+		0xb8, 0xfc, 0x84, 0xba, 0x51, 0x5c, 0xae, 0x55, 0x8a, 0x43, 0x0b, 0x22, 0x00, 0x2e, 0x45, 0x72,
+		0x08, 0x1b, 0x8a, 0xd2, 0x25, 0x87, 0x0b, 0x91, 0x84, 0x24, 0x4b, 0x85, 0x3a, 0xe4, 0x86, 0x44,
+		0x81, 0xdc, 0x05, 0x79, 0xbe, 0x42, 0x09, 0x6a, 0xd5, 0x2c, 0x0c, 0x0b, 0x07, 0x5b, 0xab, 0x55,
+		0xaa, 0xcb, 0xb5, 0xeb, 0xb5, 0x06, 0x6d, 0xdf, 0x55, 0x00, 0x77, 0x8c, 0xd1, 0x66, 0x5d, 0xab,
+		0xaf, 0xae, 0xa8, 0x97, 0x71, 0xa1, 0xd7, 0xf0, 0x90, 0x4a, 0x02, 0x1b, 0x53, 0x59, 0xc2, 0xf5,
+		0xc8, 0xe8, 0xc0, 0xcb, 0xa1, 0x33, 0x31, 0xb4, 0x58, 0x1f, 0x59, 0x39, 0x13, 0x4b, 0x7c, 0x64,
+		0x45, 0xfe, 0x18, 0xfe, 0xff, 0xd8, 0x8a, 0xfc, 0x5d, 0x2b, 0xd7, 0x62, 0x89, 0xd7, 0xe2, 0xf2,
+		0xeb, 0xf1, 0xc2, 0x5f, 0x84, 0x81, 0xb8, 0x92, 0xe5, 0xc4, 0x3c, 0x9e, 0x83, 0x84, 0x13, 0x44,
+		0x61, 0xa7, 0x34, 0xdf, 0xb9, 0x8f, 0x40, 0x0a, 0x34, 0x4f, 0xd6, 0x50, 0x50, 0xc5, 0xa1, 0x86,
+		0x2b, 0xe6, 0x6e, 0xdb, 0x68, 0x77, 0x07, 0x5d, 0x55, 0x44, 0x16, 0x26, 0xae, 0x98, 0x39, 0x02,
+		0x4f, 0x53, 0x12, 0xda, 0x2d, 0x1f, 0x89, 0xe8, 0x44, 0x12, 0x0c, 0x81, 0xa7, 0xe7, 0xbf, 0x2c,
+		0x41, 0x7e, 0x5c, 0x63, 0xef, 0x28, 0xe8, 0xb1, 0x02, 0x73, 0xe6, 0xae, 0xde, 0xef, 0xb7, 0x5b,
+		0x74, 0x1f, 0xc3, 0x71, 0x85, 0x22, 0x93, 0x5d, 0xa1, 0x59, 0x0f, 0x22, 0xcf, 0xb6, 0x48, 0x09,
+		0x2d, 0xd6, 0x2d, 0x54, 0xd6, 0x82, 0x52, 0x74, 0x32, 0xa5, 0x0c, 0x45, 0x11, 0x34, 0xae, 0xa1,
+		0x80, 0xe2, 0xea, 0x23, 0x24, 0x87, 0x5d, 0x7f, 0xab, 0xf0, 0xe9, 0x10, 0x64, 0xfd, 0xc7, 0x22,
+		0x49, 0x05, 0x12, 0x1d, 0x93, 0x1f, 0x39, 0x62, 0xa3, 0x7d, 0x7a, 0xc2, 0x49, 0xca, 0xc5, 0x65,
+		0x0e, 0xaf, 0x38, 0x98, 0xf3, 0xbf, 0x2d, 0x41, 0x42, 0x64, 0x93, 0x23, 0x10, 0xe9, 0x69, 0xf6,
+		0x0e, 0x25, 0x17, 0x2d, 0x85, 0x64, 0x49, 0xa1, 0x69, 0xcc, 0xb7, 0x7a, 0x1a, 0x3b, 0x6e, 0xc5,
+		0xf3, 0x31, 0x8d, 0x3e, 0x4f, 0x47, 0xd7, 0x5a, 0x74, 0x07, 0xce, 0xec, 0x76, 0x75, 0xc3, 0xb6,
+		0x84, 0xcf, 0xc3, 0xf3, 0xcb, 0x3c, 0x1b, 0x4f, 0xe7, 0xda, 0x7d, 0xad, 0xdd, 0xf1, 0xc1, 0x46,
+		0x28, 0xac, 0x2c, 0x0a, 0x1c, 0xe0, 0x25, 0x38, 0x26, 0xe8, 0xb6, 0x74, 0x5b, 0x6b, 0xee, 0xe8,
+		0x2d, 0x17, 0x29, 0x46, 0x77, 0xda, 0x8f, 0x72, 0x80, 0x0a, 0x2f, 0x17, 0xb8, 0x85, 0xcf, 0x86,
+		0x60, 0x46, 0xec, 0x19, 0xb6, 0x1c, 0x66, 0x5d, 0x07, 0xd0, 0x0c, 0xc3, 0xb4, 0xbd, 0xec, 0x1a,
+		0x75, 0xf3, 0x46, 0xf0, 0x16, 0x8b, 0x0e, 0x92, 0xe2, 0x21, 0x30, 0xff, 0xa7, 0x12, 0x80, 0x5b,
+		0x34, 0x96, 0x6f, 0x27, 0x21, 0xc5, 0x0f, 0xbd, 0xd2, 0x93, 0xd3, 0x2c, 0xb4, 0x06, 0x2c, 0x0b,
+		0x77, 0x17, 0x31, 0xea, 0xb6, 0xa9, 0x6f, 0xb7, 0x0d, 0x7e, 0x8a, 0x89, 0x25, 0xc4, 0x61, 0x80,
+		0x88, 0x7b, 0xca, 0x4f, 0x81, 0x84, 0xa5, 0x77, 0x35, 0xc3, 0x6e, 0x37, 0xf9, 0xac, 0xb9, 0x78,
+		0xa0, 0xc6, 0x2f, 0xd6, 0x39, 0xb6, 0xe2, 0xd0, 0x29, 0x9c, 0x86, 0x84, 0xc8, 0x75, 0xf4, 0xe3,
+		0x21, 0x12, 0x87, 0x70, 0xbd, 0x8a, 0x16, 0x82, 0xaa, 0xa9, 0x5a, 0xb1, 0x2e, 0x87, 0xce, 0x7c,
+		0x3a, 0x04, 0x71, 0x31, 0x8d, 0x67, 0x21, 0x57, 0xad, 0xd4, 0x86, 0x54, 0xed, 0x2c, 0x64, 0x45,
+		0x26, 0xd3, 0x67, 0xf2, 0x07, 0xe3, 0xde, 0xcc, 0x35, 0x65, 0xb5, 0xb1, 0x7a, 0x41, 0xfe, 0xa3,
+		0xd1, 0xcc, 0x47, 0xe4, 0xd7, 0xe2, 0x64, 0x06, 0xd2, 0x22, 0xf3, 0xc2, 0xc3, 0x17, 0x1e, 0x91,
+		0x5f, 0x1f, 0xce, 0x7a, 0x54, 0xfe, 0x63, 0x1a, 0xd5, 0x11, 0x59, 0xe7, 0xd5, 0x06, 0xea, 0xcb,
+		0xd5, 0x95, 0xe5, 0xe7, 0x65, 0xc9, 0x5b, 0x70, 0xc1, 0x53, 0x10, 0x22, 0x77, 0xc3, 0x51, 0x51,
+		0x70, 0xe9, 0xd2, 0xa5, 0x4b, 0x8f, 0x7b, 0x0a, 0x6f, 0x7f, 0x34, 0x36, 0x5c, 0xfc, 0x84, 0xa7,
+		0xf8, 0x93, 0xa3, 0xc5, 0x97, 0x3c, 0xc5, 0x3f, 0xf8, 0xd1, 0x18, 0x99, 0x85, 0x94, 0x28, 0xbe,
+		0x5e, 0x7c, 0x4e, 0xfe, 0xea, 0x57, 0xbf, 0xfa, 0xd5, 0x78, 0xe9, 0xfd, 0x30, 0xdb, 0x34, 0xbb,
+		0xc3, 0x43, 0x53, 0x92, 0x87, 0x8e, 0x24, 0x58, 0x57, 0xa5, 0x17, 0xce, 0x72, 0xa0, 0x6d, 0xb3,
+		0xa3, 0x19, 0xdb, 0x8b, 0x66, 0x7f, 0xdb, 0x3d, 0xa1, 0x8f, 0xee, 0xa5, 0xe5, 0x39, 0xa7, 0xdf,
+		0xdb, 0xfc, 0xb2, 0x24, 0xfd, 0x70, 0x28, 0x7c, 0x65, 0xad, 0xf4, 0x13, 0xa1, 0xf9, 0x2b, 0x0c,
+		0x71, 0x4d, 0x0c, 0xbc, 0xa2, 0x6f, 0x75, 0xf4, 0x26, 0x8e, 0x0e, 0xfc, 0xd9, 0x43, 0x30, 0xb7,
+		0x6d, 0x6e, 0x9b, 0x94, 0xd2, 0x39, 0xfc, 0xc5, 0x1a, 0x41, 0x92, 0x4e, 0xee, 0xfc, 0xc4, 0xfb,
+		0x00, 0x4b, 0x2b, 0x30, 0xcb, 0x81, 0x55, 0xea, 0xed, 0xb2, 0x5d, 0x53, 0xb2, 0xef, 0xc9, 0x9b,
+		0xfc, 0xcf, 0xfd, 0x21, 0x0d, 0x53, 0x28, 0x33, 0x1c, 0x15, 0xcb, 0xd8, 0xc6, 0xea, 0x92, 0x02,
+		0x87, 0x7d, 0xf4, 0xd8, 0x4a, 0x43, 0xef, 0x4f, 0xa0, 0xf8, 0xaf, 0x39, 0xc5, 0x59, 0x0f, 0xc5,
+		0x3a, 0x47, 0x5d, 0x2a, 0x43, 0xe6, 0x20, 0xb4, 0x7e, 0x9d, 0xd3, 0x4a, 0xeb, 0x5e, 0x22, 0x57,
+		0x20, 0x47, 0x89, 0x34, 0x07, 0x96, 0x6d, 0x76, 0xe9, 0x32, 0x6e, 0x7f, 0x32, 0xff, 0xe6, 0x0f,
+		0x99, 0x7a, 0xcb, 0x22, 0x5a, 0xd9, 0xc1, 0x5a, 0x5a, 0x02, 0xea, 0xb5, 0xe3, 0x71, 0xd2, 0x09,
+		0x14, 0x7e, 0x83, 0x37, 0xc4, 0x81, 0x5f, 0xda, 0x80, 0x39, 0xfc, 0x4d, 0x57, 0x59, 0xde, 0x96,
+		0x4c, 0x3e, 0xa6, 0x93, 0xff, 0x9d, 0x6f, 0x65, 0x1a, 0x74, 0xd6, 0x21, 0xe0, 0x69, 0x93, 0x67,
+		0x14, 0xb7, 0x75, 0xdb, 0xd6, 0xfb, 0x96, 0xaa, 0x75, 0x82, 0x9a, 0xe7, 0x39, 0xe7, 0x90, 0xff,
+		0xbe, 0x2f, 0xfa, 0x47, 0xf1, 0x0a, 0xc3, 0x2c, 0x76, 0x3a, 0x4b, 0xeb, 0x70, 0x34, 0x40, 0x2a,
+		0xa6, 0xa0, 0xf9, 0xfd, 0x9c, 0xe6, 0xdc, 0x88, 0x64, 0x20, 0xd9, 0x35, 0x10, 0xf9, 0xce, 0x58,
+		0x4e, 0x41, 0xf3, 0x13, 0x9c, 0x26, 0xe1, 0xb8, 0x62, 0x48, 0x91, 0xe2, 0x35, 0x98, 0xd9, 0xd5,
+		0xfb, 0x9b, 0xa6, 0xc5, 0xcf, 0x96, 0x4c, 0x41, 0xee, 0x07, 0x38, 0xb9, 0x1c, 0x47, 0xa4, 0x87,
+		0x4d, 0x90, 0xd6, 0x25, 0x48, 0x6c, 0x69, 0x4d, 0x7d, 0x0a, 0x12, 0xb7, 0x39, 0x89, 0x38, 0xc2,
+		0x23, 0x6a, 0x11, 0xd2, 0xdb, 0x26, 0x5f, 0x68, 0x4f, 0x46, 0xff, 0x24, 0x47, 0x4f, 0x09, 0x1c,
+		0x4e, 0xa2, 0x67, 0xf6, 0x06, 0x1d, 0x5c, 0x85, 0x4f, 0x26, 0xf1, 0x83, 0x82, 0x84, 0xc0, 0xe1,
+		0x24, 0x0e, 0xc0, 0xd6, 0x4f, 0x09, 0x12, 0x96, 0x87, 0x9f, 0x4f, 0xe3, 0x91, 0xd3, 0xce, 0x9e,
+		0x69, 0x4c, 0xd3, 0x88, 0x1f, 0xe2, 0x14, 0x80, 0xa3, 0x20, 0x81, 0x27, 0x21, 0x39, 0xed, 0x40,
+		0xfc, 0xe8, 0x17, 0xc5, 0xf4, 0x10, 0x23, 0x70, 0x05, 0x72, 0x42, 0x41, 0xe1, 0x86, 0xcd, 0x64,
+		0x12, 0x3f, 0xc6, 0x49, 0x64, 0x3d, 0x68, 0xbc, 0x1b, 0xb6, 0x6e, 0xd9, 0xdb, 0xfa, 0x34, 0x44,
+		0x3e, 0x2d, 0xba, 0xc1, 0x51, 0x38, 0x2b, 0x37, 0x75, 0xa3, 0xb9, 0x33, 0x1d, 0x85, 0xcf, 0x08,
+		0x56, 0x0a, 0x1c, 0x24, 0x51, 0x86, 0x4c, 0x57, 0xeb, 0x5b, 0x3b, 0x5a, 0x67, 0xaa, 0xe1, 0xf8,
+		0x71, 0x4e, 0x23, 0xed, 0x20, 0x71, 0x8e, 0x0c, 0x8c, 0x83, 0x90, 0xf9, 0x09, 0xc1, 0x91, 0x81,
+		0xe1, 0x23, 0xb4, 0x06, 0x73, 0x96, 0x4d, 0x3d, 0xdf, 0x83, 0x50, 0xfb, 0x49, 0x31, 0xf5, 0x18,
+		0xee, 0x75, 0x2f, 0xc5, 0x27, 0x21, 0x69, 0xb5, 0xdf, 0x37, 0x15, 0x99, 0x9f, 0x12, 0x23, 0x4d,
+		0x11, 0x10, 0xf9, 0x79, 0x38, 0x16, 0x68, 0x26, 0xa6, 0x20, 0xf6, 0xd3, 0x9c, 0xd8, 0x91, 0x00,
+		0x53, 0xc1, 0x55, 0xc2, 0x41, 0x49, 0xfe, 0x8c, 0x50, 0x09, 0xfa, 0x10, 0xad, 0x35, 0x0c, 0x7d,
+		0x5a, 0xda, 0xd6, 0xc1, 0xb8, 0xf6, 0x8f, 0x04, 0xd7, 0x18, 0xae, 0x8f, 0x6b, 0x0d, 0x38, 0xc2,
+		0x29, 0x1e, 0x6c, 0x5c, 0x7f, 0x56, 0x28, 0x56, 0x86, 0xbd, 0xee, 0x1f, 0xdd, 0xaf, 0x87, 0x79,
+		0x87, 0x9d, 0x22, 0xc6, 0x66, 0xa9, 0x78, 0x42, 0x65, 0x32, 0xe5, 0x9f, 0xe3, 0x94, 0x85, 0xc6,
+		0x77, 0x82, 0x74, 0xd6, 0x75, 0xad, 0x87, 0xc4, 0x9f, 0x83, 0xbc, 0x20, 0x3e, 0x30, 0xfa, 0x7a,
+		0xd3, 0xdc, 0x36, 0xda, 0xef, 0xd3, 0x5b, 0x53, 0x90, 0xfe, 0xf9, 0xa1, 0xa1, 0x5a, 0xf7, 0xa0,
+		0x23, 0xe5, 0x1a, 0xc8, 0x8e, 0xaf, 0xa2, 0xb6, 0xbb, 0x74, 0x3f, 0x62, 0x7f, 0x8a, 0xbf, 0x20,
+		0x46, 0xca, 0xc1, 0xab, 0x51, 0xb4, 0xa5, 0x2a, 0xb0, 0xd3, 0xe9, 0xd3, 0x8a, 0xe4, 0x2b, 0x9c,
+		0x50, 0xc6, 0xc5, 0xe2, 0x8a, 0xa3, 0x69, 0x76, 0x7b, 0x5a, 0x7f, 0x1a, 0xfd, 0xf7, 0x8b, 0x42,
+		0x71, 0x70, 0x14, 0xae, 0x38, 0xd0, 0xa3, 0x43, 0x6b, 0x3f, 0x05, 0x85, 0x5f, 0x12, 0x8a, 0x43,
+		0xe0, 0x70, 0x12, 0xc2, 0x61, 0x98, 0x82, 0xc4, 0x3f, 0x16, 0x24, 0x04, 0x0e, 0x92, 0x78, 0xb7,
+		0x6b, 0x68, 0xfb, 0xfa, 0x76, 0xdb, 0xb2, 0xf9, 0xfd, 0x91, 0xfd, 0x49, 0xfd, 0xf2, 0x17, 0xfd,
+		0x4e, 0x98, 0xe2, 0x41, 0x45, 0x4d, 0xc4, 0x03, 0x63, 0x34, 0xf0, 0x3b, 0xb9, 0x61, 0xbf, 0x22,
+		0x34, 0x91, 0x07, 0x0d, 0xdb, 0xe6, 0xf1, 0x10, 0x91, 0xed, 0x4d, 0x5c, 0xd2, 0x4d, 0x41, 0xee,
+		0x57, 0x87, 0x1a, 0x57, 0x17, 0xb8, 0x48, 0xd3, 0xe3, 0xff, 0x0c, 0x8c, 0x1b, 0xfa, 0xde, 0x54,
+		0xd2, 0xf9, 0x4f, 0x86, 0xfc, 0x9f, 0x75, 0x86, 0xc9, 0x74, 0x48, 0x6e, 0xc8, 0x9f, 0x22, 0x93,
+		0x2e, 0x97, 0xe5, 0xbf, 0xf9, 0x0d, 0xde, 0x5f, 0xbf, 0x3b, 0xb5, 0xb4, 0x0c, 0x32, 0xcf, 0x71,
+		0x1d, 0xd8, 0x89, 0xc4, 0xbe, 0xf5, 0x0d, 0x47, 0xce, 0x7d, 0x3e, 0xcf, 0xd2, 0x65, 0xc8, 0xf8,
+		0x1c, 0x9e, 0xc9, 0xa4, 0x3e, 0xc8, 0x49, 0xa5, 0xbd, 0xfe, 0xce, 0xd2, 0x63, 0x10, 0x41, 0xe7,
+		0x65, 0x32, 0xfa, 0x87, 0x38, 0x3a, 0x05, 0x5f, 0x7a, 0x17, 0x24, 0x84, 0xd3, 0x32, 0x19, 0xf5,
+		0xdb, 0x38, 0xaa, 0x83, 0x82, 0xe8, 0xc2, 0x61, 0x99, 0x8c, 0xfe, 0xff, 0x0b, 0x74, 0x81, 0x82,
+		0xe8, 0xd3, 0xb3, 0xf0, 0x5f, 0x7c, 0x24, 0xc2, 0xd0, 0x05, 0xca, 0x12, 0x9e, 0x8e, 0x67, 0x9e,
+		0xca, 0x64, 0xec, 0x6f, 0xe7, 0x95, 0x0b, 0x8c, 0xa5, 0xc7, 0x21, 0x3a, 0x25, 0xc3, 0x3f, 0xca,
+		0x51, 0x19, 0xfc, 0x52, 0x19, 0x52, 0x1e, 0xef, 0x64, 0x32, 0xfa, 0x77, 0x72, 0x74, 0x2f, 0x16,
+		0x36, 0x9d, 0x7b, 0x27, 0x93, 0x09, 0xfc, 0x3d, 0xd1, 0x74, 0x8e, 0x81, 0x6c, 0x13, 0x8e, 0xc9,
+		0x64, 0xec, 0x8f, 0x09, 0xae, 0x0b, 0x94, 0xa5, 0xa7, 0x21, 0xe9, 0x18, 0x9b, 0xc9, 0xf8, 0xdf,
+		0xc5, 0xf1, 0x5d, 0x1c, 0xe4, 0xc0, 0xc0, 0x38, 0x00, 0x89, 0xbf, 0x2f, 0x38, 0xe0, 0xc1, 0xc2,
+		0x69, 0x34, 0xec, 0xc0, 0x4c, 0xa6, 0xf4, 0xdd, 0x62, 0x1a, 0x0d, 0xf9, 0x2f, 0x38, 0x9a, 0x54,
+		0xe7, 0x4f, 0x26, 0xf1, 0x3d, 0x62, 0x34, 0x29, 0x3c, 0x36, 0x63, 0xd8, 0x23, 0x98, 0x4c, 0xe3,
+		0x7b, 0x45, 0x33, 0x86, 0x1c, 0x82, 0xa5, 0x35, 0x20, 0xa3, 0xde, 0xc0, 0x64, 0x7a, 0x2f, 0x73,
+		0x7a, 0x33, 0x23, 0xce, 0xc0, 0xd2, 0xb3, 0x70, 0x24, 0xd8, 0x13, 0x98, 0x4c, 0xf5, 0xfb, 0xde,
+		0x18, 0x5a, 0xbb, 0x79, 0x1d, 0x81, 0xa5, 0x06, 0xcc, 0x05, 0x79, 0x01, 0x93, 0xc9, 0x7e, 0xff,
+		0x1b, 0x7e, 0xc5, 0xed, 0x75, 0x02, 0x96, 0x8a, 0x00, 0xae, 0x01, 0x9e, 0x4c, 0xeb, 0x07, 0x38,
+		0x2d, 0x0f, 0x12, 0x4e, 0x0d, 0x6e, 0x7f, 0x27, 0xe3, 0xdf, 0x16, 0x53, 0x83, 0x63, 0xe0, 0xd4,
+		0x10, 0xa6, 0x77, 0x32, 0xf6, 0x27, 0xc5, 0xd4, 0x10, 0x28, 0x28, 0xd9, 0x1e, 0xeb, 0x36, 0x99,
+		0xc2, 0x0f, 0x09, 0xc9, 0xf6, 0x60, 0x2d, 0xad, 0xc0, 0xcc, 0x88, 0x41, 0x9c, 0x4c, 0xea, 0x87,
+		0x39, 0x29, 0x79, 0xd8, 0x1e, 0x7a, 0x8d, 0x17, 0x37, 0x86, 0x93, 0xa9, 0xfd, 0xc8, 0x90, 0xf1,
+		0xe2, 0xb6, 0x70, 0xe9, 0x49, 0x48, 0x18, 0x83, 0x4e, 0x07, 0x27, 0x0f, 0xd9, 0xff, 0xfe, 0x60,
+		0xfe, 0x8f, 0xbf, 0xc2, 0xb9, 0x23, 0x10, 0x96, 0x1e, 0x83, 0xa8, 0xde, 0xdd, 0xd4, 0x5b, 0x93,
+		0x30, 0xff, 0xe4, 0x2b, 0x42, 0x61, 0x22, 0xf4, 0xd2, 0xd3, 0x00, 0x2c, 0x34, 0x42, 0xcf, 0xe0,
+		0x4e, 0xc0, 0xfd, 0xd3, 0xaf, 0xf0, 0x0b, 0x3b, 0x2e, 0x8a, 0x4b, 0x80, 0x5d, 0xff, 0xd9, 0x9f,
+		0xc0, 0x17, 0xfd, 0x04, 0xe8, 0x88, 0x5c, 0x82, 0x38, 0x6e, 0xbd, 0xd9, 0xda, 0xf6, 0x24, 0xec,
+		0x3f, 0xe3, 0xd8, 0x02, 0x1e, 0x19, 0xd6, 0x35, 0xfb, 0xba, 0xad, 0x6d, 0x5b, 0x93, 0x70, 0xff,
+		0x9c, 0xe3, 0x3a, 0x08, 0x88, 0xdc, 0xd4, 0x2c, 0x7b, 0x9a, 0x7e, 0xff, 0x85, 0x40, 0x16, 0x08,
+		0xd8, 0x68, 0xfc, 0x7d, 0x43, 0xdf, 0x9b, 0x84, 0xfb, 0x97, 0xa2, 0xd1, 0x1c, 0x7e, 0xe9, 0x5d,
+		0x90, 0xc4, 0x9f, 0xec, 0x16, 0xde, 0x04, 0xe4, 0xbf, 0xe2, 0xc8, 0x2e, 0x06, 0xd6, 0x6c, 0xd9,
+		0x2d, 0xbb, 0x3d, 0x99, 0xd9, 0x7f, 0xcd, 0x47, 0x5a, 0xc0, 0x2f, 0x15, 0x21, 0x65, 0xd9, 0xad,
+		0xd6, 0x80, 0xfb, 0xa7, 0x13, 0xd0, 0xff, 0xdb, 0x57, 0x9c, 0x90, 0x85, 0x83, 0x83, 0xa3, 0x7d,
+		0xf3, 0x86, 0xdd, 0x33, 0xe9, 0xa9, 0x8d, 0x49, 0x14, 0xde, 0xe0, 0x14, 0x3c, 0x28, 0x4b, 0x65,
+		0x48, 0x63, 0x5f, 0xc4, 0xe6, 0xf7, 0x24, 0x12, 0x5f, 0xe2, 0x0c, 0xf0, 0x21, 0x95, 0xbe, 0xe9,
+		0x37, 0x3e, 0x7f, 0x42, 0xfa, 0xec, 0xe7, 0x4f, 0x48, 0xff, 0xe5, 0xf3, 0x27, 0xa4, 0x8f, 0x7d,
+		0xe1, 0xc4, 0xa1, 0xcf, 0x7e, 0xe1, 0xc4, 0xa1, 0xdf, 0xfb, 0xc2, 0x89, 0x43, 0xc1, 0x51, 0x62,
+		0xb8, 0x62, 0x5e, 0x31, 0x59, 0x7c, 0xf8, 0x85, 0xfb, 0xb6, 0xdb, 0xf6, 0xce, 0x60, 0x73, 0xb1,
+		0x69, 0x76, 0xcf, 0x35, 0x4d, 0xab, 0x6b, 0x5a, 0xe7, 0xfc, 0x71, 0x5d, 0xfa, 0x0b, 0xfe, 0x97,
+		0x04, 0xc7, 0x18, 0x19, 0x37, 0x9c, 0xab, 0x19, 0x7b, 0xe3, 0xde, 0x75, 0xb9, 0x08, 0xe1, 0xa2,
+		0xb1, 0x47, 0x8e, 0x31, 0x05, 0xa7, 0x0e, 0xfa, 0x1d, 0x7e, 0x15, 0x2c, 0x8e, 0xe9, 0xf5, 0x7e,
+		0xc7, 0x7f, 0x0c, 0x38, 0xcd, 0x8f, 0x01, 0x97, 0xbe, 0x53, 0x3a, 0x58, 0x4f, 0x12, 0x45, 0x63,
+		0x8f, 0x76, 0x64, 0x4d, 0x7a, 0xe1, 0x1d, 0x13, 0xe3, 0xdc, 0x37, 0x0c, 0xf3, 0xa6, 0x81, 0xcd,
+		0xee, 0x6d, 0x8a, 0x18, 0xf7, 0x89, 0xe1, 0x18, 0xf7, 0xb3, 0x7a, 0xa7, 0xf3, 0x0c, 0xc2, 0xe1,
+		0x76, 0xb4, 0xb5, 0x19, 0x63, 0xb7, 0x8e, 0xe1, 0xbb, 0x43, 0x70, 0x62, 0x24, 0x9c, 0xcd, 0x85,
+		0x60, 0x1c, 0x13, 0x96, 0x20, 0x51, 0x11, 0xb2, 0x95, 0xc7, 0x57, 0x55, 0x9a, 0xa6, 0xd1, 0x62,
+		0xe7, 0x39, 0xc3, 0x8a, 0x48, 0x22, 0x23, 0x0c, 0xcd, 0x30, 0x2d, 0x7e, 0x99, 0x92, 0x25, 0x4a,
+		0x9f, 0x38, 0x20, 0x23, 0x32, 0xa2, 0x26, 0xc1, 0x8d, 0xf3, 0x53, 0x72, 0x43, 0x74, 0xc2, 0x17,
+		0xf9, 0x9f, 0x96, 0x2b, 0xdf, 0x1b, 0x82, 0x93, 0xc3, 0x5c, 0xc1, 0x99, 0x65, 0xd9, 0x5a, 0xb7,
+		0x37, 0x8e, 0x2d, 0x4f, 0x42, 0xb2, 0x21, 0x60, 0x0e, 0xcc, 0x97, 0xdb, 0x07, 0xe4, 0x4b, 0xd6,
+		0xa9, 0x4a, 0x30, 0xe6, 0xc2, 0x94, 0x8c, 0x71, 0xfa, 0x71, 0x47, 0x9c, 0xf9, 0x44, 0x12, 0x8e,
+		0xb1, 0xe9, 0xa4, 0xb2, 0xa9, 0xc4, 0x12, 0x9c, 0x27, 0x69, 0x6f, 0xd1, 0xe4, 0x7d, 0x92, 0xc2,
+		0x33, 0x30, 0x5b, 0x43, 0x6d, 0x81, 0xab, 0x20, 0x77, 0x87, 0x27, 0xf0, 0xbe, 0xe9, 0x82, 0xcf,
+		0xe1, 0xe7, 0x3b, 0x81, 0xde, 0xac, 0xc2, 0x37, 0x4b, 0x20, 0xd7, 0x9b, 0x5a, 0x47, 0xeb, 0xbf,
+		0x59, 0x52, 0xe4, 0x71, 0x00, 0x76, 0x5a, 0xc8, 0x79, 0x29, 0x06, 0x77, 0xc3, 0xbd, 0x9d, 0x5b,
+		0x64, 0x35, 0xd1, 0xbb, 0x18, 0x49, 0x0a, 0x8b, 0x3f, 0xcf, 0x3c, 0x07, 0xe0, 0x16, 0xe0, 0xe9,
+		0x8a, 0x7a, 0xb9, 0xb8, 0x5c, 0x54, 0xc4, 0xb1, 0x8f, 0xfa, 0x5a, 0xb5, 0xcc, 0x1e, 0x6e, 0x38,
+		0x84, 0x47, 0x17, 0xbc, 0x85, 0xce, 0xf9, 0xf5, 0xc3, 0x30, 0xe3, 0xcd, 0x67, 0xb7, 0xe8, 0x43,
+		0x4b, 0x57, 0x31, 0x52, 0x80, 0x27, 0x16, 0x55, 0xad, 0xd5, 0xd2, 0x5b, 0x6a, 0xdb, 0x20, 0x13,
+		0x6e, 0xcc, 0xe6, 0x7f, 0xf3, 0x3f, 0x46, 0x69, 0xd7, 0x32, 0x0c, 0xb1, 0x88, 0x78, 0x35, 0x03,
+		0x7d, 0xce, 0x76, 0xb7, 0xd7, 0xd1, 0xe9, 0x6e, 0xaf, 0xda, 0x16, 0xfc, 0x9f, 0xec, 0xce, 0x20,
+		0x3d, 0xdc, 0x39, 0x9e, 0x75, 0xd1, 0x9d, 0xd1, 0x5b, 0x7a, 0xc6, 0x3d, 0x4f, 0xe4, 0x34, 0x70,
+		0x22, 0xc5, 0xdf, 0xe2, 0x2d, 0x14, 0xd1, 0x0c, 0xd1, 0xc4, 0x65, 0x98, 0xc1, 0x6b, 0x66, 0x3d,
+		0x5f, 0xfb, 0x26, 0x58, 0x10, 0xd1, 0x5b, 0x99, 0x63, 0xba, 0x4d, 0x7b, 0x1c, 0x62, 0x16, 0x1d,
+		0x94, 0x49, 0x24, 0x44, 0x73, 0x38, 0x38, 0x86, 0xbf, 0x98, 0x18, 0x38, 0x3d, 0x9a, 0x40, 0xe0,
+		0xdf, 0x72, 0x02, 0x69, 0x8a, 0x26, 0x7a, 0x63, 0xc0, 0x0c, 0x7b, 0x26, 0x45, 0xf7, 0xf4, 0x66,
+		0xff, 0x28, 0xca, 0x3f, 0xfd, 0x85, 0x87, 0xe9, 0x0e, 0xfb, 0x29, 0xbf, 0xd0, 0x05, 0x4c, 0x16,
+		0x45, 0xe6, 0xb4, 0xdd, 0xfe, 0xea, 0x90, 0x15, 0xf5, 0xf1, 0x7e, 0xef, 0x5f, 0xd9, 0x3f, 0xe3,
+		0x95, 0x9d, 0x08, 0x92, 0x70, 0x4f, 0x4d, 0x19, 0x4e, 0x95, 0x15, 0x2c, 0x95, 0x20, 0x83, 0x9b,
+		0xf2, 0x2e, 0x73, 0xf6, 0xaf, 0xe5, 0x9f, 0xff, 0xc2, 0xc3, 0x6c, 0xa2, 0x21, 0x12, 0x67, 0x4d,
+		0xa9, 0x3a, 0x4e, 0xeb, 0xbd, 0xf0, 0xd0, 0xa8, 0xfd, 0x66, 0xff, 0xce, 0x52, 0xea, 0x4f, 0x7a,
+		0x9b, 0xea, 0x6a, 0xa7, 0x08, 0xcc, 0xe0, 0x05, 0x6e, 0xf3, 0x1c, 0xfd, 0xcb, 0xb5, 0x52, 0x94,
+		0x26, 0xa6, 0xd8, 0xb6, 0xbd, 0xc8, 0x94, 0xc5, 0x64, 0xb9, 0xfd, 0xab, 0xef, 0xf8, 0xb1, 0xa8,
+		0xab, 0x50, 0x96, 0xae, 0x8f, 0x9e, 0xa5, 0x9b, 0x4c, 0xe3, 0xaf, 0x05, 0x8d, 0xe1, 0xa3, 0x71,
+		0x4b, 0xef, 0xc4, 0xbd, 0x50, 0x4e, 0x66, 0x92, 0xef, 0x26, 0x88, 0x38, 0x18, 0xe8, 0xb9, 0x31,
+		0xa1, 0x9d, 0xc6, 0x4f, 0x7f, 0x43, 0xe0, 0x33, 0x1d, 0x86, 0x27, 0x51, 0x97, 0xae, 0x40, 0xb6,
+		0x65, 0x1a, 0xb6, 0x6a, 0x76, 0xdb, 0xb6, 0xde, 0xed, 0xd9, 0x13, 0x3d, 0xdf, 0x2f, 0x31, 0x22,
+		0x09, 0x25, 0x83, 0x78, 0xab, 0x02, 0x0d, 0x5b, 0xc2, 0x5e, 0x5a, 0x99, 0xa6, 0x25, 0xff, 0xdd,
+		0x69, 0x09, 0xc5, 0xc1, 0x96, 0xdc, 0x91, 0x74, 0x58, 0xad, 0x1b, 0xdc, 0xdc, 0xd9, 0xb7, 0x98,
+		0x14, 0x38, 0xd2, 0xf1, 0xe9, 0x30, 0x9c, 0xe0, 0xc0, 0x9b, 0x9a, 0xa5, 0x9f, 0xdb, 0x3d, 0xbf,
+		0xa9, 0xdb, 0xda, 0xf9, 0x73, 0x4d, 0xb3, 0x2d, 0x7c, 0x9d, 0x59, 0x56, 0xbe, 0x88, 0xe5, 0x8b,
+		0xbc, 0x7c, 0x3e, 0xf0, 0x40, 0xc0, 0xfc, 0x78, 0x33, 0x38, 0x3f, 0x2a, 0x83, 0x85, 0x0e, 0x44,
+		0xca, 0x66, 0x9b, 0xde, 0x12, 0x6b, 0xe9, 0x86, 0xd9, 0xe5, 0x06, 0x89, 0x25, 0xc8, 0x55, 0x88,
+		0x69, 0x5d, 0x73, 0x60, 0xd8, 0xcc, 0x18, 0x95, 0x1e, 0xfe, 0x8d, 0x57, 0x4f, 0x1e, 0xfa, 0xfd,
+		0x57, 0x4f, 0x1e, 0x66, 0x64, 0xad, 0xd6, 0x8d, 0xc5, 0xb6, 0x79, 0xae, 0xab, 0xd9, 0x3b, 0xa8,
+		0x02, 0x3e, 0xf7, 0xca, 0x59, 0xe0, 0xf5, 0xd5, 0x0c, 0xfb, 0x33, 0xaf, 0xfd, 0xec, 0x19, 0x49,
+		0xe1, 0xf8, 0x4b, 0x91, 0xd7, 0x3f, 0x75, 0x52, 0x2a, 0xf4, 0x20, 0x5e, 0xd1, 0x9b, 0xfb, 0x54,
+		0x58, 0x1b, 0xaa, 0xf0, 0x3c, 0xaf, 0xf0, 0xf8, 0x68, 0x85, 0xec, 0xf6, 0x49, 0x45, 0x6f, 0x7a,
+		0xaa, 0xad, 0xe8, 0x4d, 0x7f, 0x8d, 0xa5, 0xca, 0xef, 0xfd, 0xd7, 0x13, 0x87, 0x3e, 0xf0, 0xf9,
+		0x13, 0x87, 0xc6, 0x0e, 0x59, 0x61, 0xf2, 0x90, 0x39, 0x23, 0xf5, 0xe3, 0x11, 0x1c, 0xa9, 0xae,
+		0x6e, 0x6f, 0x6e, 0xd9, 0xe7, 0x9a, 0xfd, 0xbd, 0x9e, 0x6d, 0x9e, 0xdb, 0x3d, 0x8f, 0x33, 0xd7,
+		0xdc, 0xe2, 0x23, 0x45, 0x44, 0xf9, 0x22, 0x2b, 0x5f, 0xdc, 0x1d, 0x33, 0x50, 0x85, 0x2d, 0x88,
+		0xae, 0x21, 0x22, 0xb2, 0xc2, 0x36, 0x6d, 0xad, 0xc3, 0x3d, 0x32, 0x96, 0xc0, 0x5c, 0xf6, 0x12,
+		0x50, 0x88, 0xe5, 0xb6, 0xc5, 0x23, 0x40, 0x1d, 0x5d, 0xdb, 0x62, 0x0f, 0x2a, 0x84, 0xa9, 0x2b,
+		0x9f, 0xc0, 0x0c, 0xfa, 0x76, 0xc2, 0x1c, 0x44, 0xb5, 0x01, 0x3b, 0x7e, 0x15, 0x46, 0x1f, 0x9f,
+		0x26, 0x0a, 0xcb, 0x10, 0xe7, 0x67, 0x0b, 0xf0, 0xfc, 0xd1, 0x0d, 0x7d, 0x8f, 0xd6, 0x93, 0x56,
+		0xf0, 0x27, 0x39, 0x07, 0x51, 0xda, 0x7a, 0x7e, 0x8f, 0xe5, 0xd8, 0xe2, 0x68, 0xf3, 0x17, 0x69,
+		0x2b, 0x15, 0x06, 0x57, 0xb8, 0x06, 0x89, 0x8a, 0x89, 0x02, 0xe4, 0x27, 0x97, 0x64, 0xe4, 0x68,
+		0xa3, 0x7b, 0x03, 0x5b, 0x5c, 0x36, 0xa4, 0x09, 0xbc, 0xba, 0xcb, 0x5e, 0xd8, 0xe0, 0x67, 0xc8,
+		0x78, 0xaa, 0x50, 0x86, 0x38, 0xa5, 0xbd, 0xda, 0x73, 0x9e, 0xad, 0x92, 0x3c, 0xcf, 0x56, 0x71,
+		0xf2, 0x21, 0xb7, 0xb5, 0x04, 0x22, 0x78, 0xfd, 0x9e, 0x77, 0x9c, 0xfe, 0x2e, 0x3c, 0x0d, 0x09,
+		0x4e, 0xc4, 0x22, 0x8f, 0x40, 0xd8, 0xec, 0x89, 0x23, 0x92, 0xc7, 0xc7, 0xf6, 0x65, 0xb5, 0x57,
+		0x8a, 0xa0, 0x60, 0x29, 0x08, 0x5d, 0xba, 0x3e, 0x56, 0x34, 0x1e, 0xf1, 0x89, 0x86, 0x18, 0x76,
+		0xf1, 0x43, 0xeb, 0xb5, 0xcf, 0x8d, 0x0a, 0x83, 0x23, 0x2b, 0x2f, 0x87, 0xe0, 0xee, 0x00, 0x59,
+		0xb9, 0xa1, 0xef, 0x59, 0x07, 0x16, 0x95, 0x5d, 0x48, 0xae, 0xd1, 0x87, 0x2e, 0x9f, 0xd1, 0xf7,
+		0xc8, 0x3c, 0x9e, 0x87, 0xbc, 0xf0, 0xd8, 0x63, 0xe7, 0x2f, 0xb1, 0x81, 0xbc, 0x7a, 0x48, 0x11,
+		0x19, 0xe4, 0x04, 0x24, 0x2d, 0xbd, 0xd9, 0xbb, 0xf0, 0xd8, 0xc5, 0x1b, 0xe7, 0x19, 0xe3, 0xae,
+		0x1e, 0x52, 0xdc, 0x2c, 0x72, 0x17, 0x24, 0x36, 0x3b, 0xd6, 0xf9, 0x0b, 0x8f, 0x3c, 0x71, 0x9e,
+		0x31, 0xf1, 0xea, 0x21, 0xc5, 0xc9, 0x59, 0x4a, 0xe0, 0x94, 0x79, 0xfd, 0x87, 0x4e, 0x4a, 0xa5,
+		0x28, 0x84, 0xad, 0x41, 0xf7, 0x6b, 0xc5, 0x9a, 0x5f, 0x8d, 0xc3, 0x29, 0xa7, 0x98, 0x29, 0xc5,
+		0xdd, 0xf3, 0xe7, 0xf8, 0x89, 0x73, 0xe7, 0xf1, 0xd2, 0x19, 0x87, 0x3d, 0x14, 0x04, 0xb9, 0xb3,
+		0x3f, 0x43, 0xc7, 0x30, 0xef, 0x15, 0x09, 0xd2, 0x1b, 0x82, 0x36, 0xde, 0x3f, 0x78, 0x27, 0x80,
+		0x53, 0x97, 0x10, 0x96, 0xbb, 0x16, 0x47, 0x6a, 0x5b, 0x74, 0x90, 0x14, 0x0f, 0x3c, 0x79, 0x02,
+		0x12, 0xbd, 0xbe, 0xd9, 0x33, 0x2d, 0xfe, 0x1e, 0xcf, 0x24, 0x5c, 0x07, 0x1a, 0xef, 0x7b, 0xd0,
+		0xa9, 0xad, 0xee, 0x9a, 0x36, 0x9e, 0x1d, 0xe9, 0x99, 0x37, 0xf9, 0x33, 0x67, 0x61, 0x45, 0xa6,
+		0x25, 0x1b, 0xb4, 0x60, 0x0d, 0xf3, 0x0b, 0xff, 0x43, 0x82, 0xa4, 0x43, 0x05, 0xd7, 0x6d, 0x5a,
+		0xab, 0xd5, 0xd7, 0x2d, 0x8b, 0xcf, 0x5e, 0x91, 0x24, 0x4b, 0x10, 0xef, 0x0d, 0x36, 0x55, 0x31,
+		0x53, 0xf0, 0xb5, 0xa7, 0x20, 0xb9, 0x17, 0xe2, 0x43, 0x2f, 0xf1, 0xc5, 0x7a, 0x83, 0x4d, 0x14,
+		0xa5, 0x53, 0x90, 0x0e, 0x68, 0x4b, 0x6a, 0xd7, 0x6d, 0x06, 0x7d, 0x7c, 0x95, 0x77, 0x40, 0xed,
+		0xf5, 0xdb, 0x66, 0xbf, 0x6d, 0xef, 0xd1, 0x03, 0x8c, 0x61, 0x45, 0x16, 0x05, 0x6b, 0x3c, 0x9f,
+		0x14, 0x20, 0xc3, 0xdb, 0xa2, 0x6e, 0xee, 0xd9, 0xfc, 0x94, 0x6c, 0x5a, 0x49, 0xb1, 0xea, 0x4a,
+		0x98, 0x45, 0x16, 0x20, 0x2d, 0x60, 0x9c, 0x5b, 0xfb, 0x49, 0x05, 0x18, 0x08, 0x2e, 0x4f, 0x0a,
+		0x1d, 0xc8, 0xd5, 0xa9, 0x2b, 0xef, 0x76, 0xff, 0xa2, 0xdb, 0x49, 0x69, 0x8a, 0x4e, 0x8e, 0xed,
+		0x60, 0x68, 0xa4, 0x83, 0x67, 0xfe, 0x93, 0x04, 0xa9, 0x52, 0xc7, 0x6c, 0xde, 0xa8, 0x55, 0x2e,
+		0x77, 0xb4, 0x6d, 0x72, 0x1e, 0x0e, 0x97, 0x96, 0x57, 0xcb, 0xcf, 0xa8, 0xb5, 0x8a, 0x7a, 0x79,
+		0xb9, 0xe8, 0x39, 0x2b, 0x3e, 0x7f, 0xe4, 0xa5, 0xdb, 0x0b, 0xc4, 0x03, 0xbb, 0x6e, 0xd0, 0xa5,
+		0x2b, 0x39, 0x07, 0x73, 0x7e, 0x94, 0x62, 0xa9, 0x8e, 0x37, 0x94, 0xa4, 0xf9, 0xc3, 0x2f, 0xdd,
+		0x5e, 0x98, 0xf1, 0x60, 0x14, 0x37, 0x2d, 0xdd, 0xb0, 0x47, 0x11, 0xca, 0xab, 0xd7, 0xaf, 0xe3,
+		0x81, 0xfd, 0x11, 0x04, 0x3c, 0xfd, 0xda, 0xb6, 0xc9, 0x83, 0x30, 0xe3, 0x47, 0x58, 0xa9, 0x2d,
+		0xcb, 0xe1, 0x79, 0xf2, 0xd2, 0xed, 0x85, 0xac, 0x07, 0x7a, 0xa5, 0xdd, 0x99, 0x4f, 0x7c, 0xf8,
+		0x47, 0x4e, 0x1c, 0xfa, 0xcc, 0x3f, 0x3c, 0x21, 0x95, 0x96, 0xc7, 0xce, 0xde, 0x0b, 0xd3, 0xcf,
+		0x5e, 0x31, 0x3d, 0x9d, 0xc9, 0xfb, 0xc9, 0x10, 0x9c, 0x74, 0x4a, 0x77, 0xf5, 0x3e, 0xee, 0x19,
+		0xe0, 0x3c, 0x64, 0xa2, 0xef, 0xb8, 0x2b, 0x7c, 0x70, 0x38, 0xc0, 0x78, 0xd5, 0xf6, 0x2e, 0x08,
+		0x17, 0x7b, 0x3d, 0x7c, 0x3e, 0x8f, 0xa6, 0x9b, 0x26, 0x33, 0x83, 0x11, 0xc5, 0x49, 0x63, 0x99,
+		0x65, 0x6e, 0xd9, 0x37, 0xb5, 0xbe, 0xf3, 0xb4, 0x9e, 0x48, 0x17, 0x2e, 0x41, 0xb2, 0x6c, 0x1a,
+		0x96, 0x6e, 0x58, 0x03, 0x1a, 0xc2, 0xd8, 0x44, 0x66, 0x70, 0x0a, 0x2c, 0x81, 0x66, 0x44, 0xeb,
+		0xf5, 0x28, 0x66, 0x44, 0xc1, 0x9f, 0xdc, 0x35, 0x58, 0x19, 0xcb, 0x9e, 0x47, 0xa7, 0x67, 0x8f,
+		0xcb, 0x00, 0x87, 0x41, 0xdf, 0x73, 0xb7, 0x47, 0xf1, 0x3b, 0xda, 0xcd, 0xcb, 0x9e, 0x00, 0xcd,
+		0x36, 0xc1, 0xad, 0x98, 0x9f, 0xac, 0x2f, 0xe7, 0x27, 0x8d, 0xca, 0x18, 0x7f, 0x71, 0x52, 0x40,
+		0xa9, 0x70, 0x09, 0x32, 0x78, 0xd1, 0xab, 0xae, 0xdb, 0x57, 0x75, 0xad, 0xa5, 0xf7, 0xfd, 0xfe,
+		0x4a, 0x46, 0xf8, 0x2b, 0x04, 0x22, 0xd4, 0x29, 0x61, 0xe6, 0x9a, 0xfe, 0x2e, 0xb4, 0x21, 0x82,
+		0xa8, 0xae, 0x2f, 0xc3, 0x31, 0x68, 0x82, 0x0e, 0x17, 0xd5, 0x12, 0x0c, 0x85, 0x25, 0xc8, 0x63,
+		0xc2, 0x23, 0x09, 0x4f, 0xf0, 0x48, 0xb8, 0x0d, 0xe7, 0x7e, 0x49, 0x17, 0xe2, 0x7c, 0x22, 0x38,
+		0x2d, 0x91, 0xdc, 0x96, 0x90, 0x15, 0xc8, 0xf5, 0xb4, 0xbe, 0x4d, 0x9f, 0x9c, 0xd9, 0xa1, 0xdd,
+		0xe0, 0xda, 0x72, 0x21, 0x40, 0x79, 0xfb, 0xba, 0xcb, 0xab, 0xc9, 0xf4, 0xbc, 0x99, 0x85, 0xd7,
+		0x23, 0x10, 0xe3, 0xec, 0x78, 0x0a, 0xe2, 0x9c, 0xe1, 0x5c, 0x37, 0x9d, 0x58, 0x0c, 0x10, 0xff,
+		0x45, 0x47, 0x4c, 0x39, 0x41, 0x81, 0x44, 0xee, 0x87, 0x44, 0x73, 0x47, 0x6b, 0x1b, 0x6a, 0xbb,
+		0xc5, 0xbd, 0xde, 0xd4, 0xe7, 0x5f, 0x3d, 0x19, 0x2f, 0x63, 0x5e, 0xad, 0xa2, 0xc4, 0x69, 0x61,
+		0xad, 0x85, 0x5e, 0xd4, 0x8e, 0xde, 0xde, 0xde, 0xb1, 0xb9, 0x9a, 0xe6, 0x29, 0x7c, 0xbe, 0x1b,
+		0x87, 0x8c, 0xdf, 0x6d, 0x98, 0x1f, 0x59, 0xce, 0x38, 0x11, 0xb9, 0x52, 0x02, 0x2b, 0xfe, 0xd8,
+		0x7f, 0x3e, 0x29, 0x29, 0x14, 0x83, 0x54, 0x20, 0xd3, 0xd1, 0x2c, 0x5b, 0xa5, 0xf3, 0x04, 0xab,
+		0x8f, 0x72, 0x12, 0xa3, 0x2c, 0xe1, 0xbc, 0xe5, 0x6d, 0x4f, 0x21, 0x1a, 0xcb, 0x6a, 0xe1, 0x3b,
+		0x4c, 0x94, 0x4a, 0x93, 0xaa, 0x2a, 0xe6, 0x99, 0xc6, 0x28, 0xeb, 0xb3, 0x98, 0xcf, 0x34, 0x18,
+		0xf5, 0x4f, 0x8f, 0x43, 0x92, 0x3e, 0xad, 0x44, 0x41, 0xd8, 0xe5, 0xc4, 0x04, 0x66, 0xd0, 0xc2,
+		0x07, 0x20, 0xe7, 0x5a, 0x59, 0x06, 0x92, 0x60, 0x54, 0xdc, 0x6c, 0x0a, 0xf8, 0x30, 0xcc, 0x19,
+		0xfa, 0x2d, 0x5b, 0x1d, 0x86, 0x4e, 0x52, 0x68, 0x82, 0x65, 0x1b, 0x7e, 0x8c, 0xfb, 0x20, 0xdb,
+		0x14, 0xdc, 0x67, 0xb0, 0x40, 0x61, 0x33, 0x4e, 0x2e, 0x05, 0x3b, 0x06, 0x09, 0xad, 0xd7, 0x63,
+		0x00, 0x29, 0x6e, 0x64, 0x7b, 0x3d, 0x5a, 0x74, 0x06, 0x66, 0x68, 0x1f, 0xfb, 0xba, 0x85, 0xd7,
+		0x66, 0x18, 0x4c, 0x9a, 0xc2, 0xe4, 0xb0, 0x40, 0x61, 0xf9, 0x14, 0xf6, 0x1e, 0xc8, 0xe8, 0xbb,
+		0x78, 0x33, 0xb4, 0xa9, 0x33, 0xb8, 0x0c, 0x85, 0x4b, 0x8b, 0x4c, 0x0a, 0xf4, 0x20, 0x38, 0xd6,
+		0x53, 0x15, 0x86, 0x3d, 0xcb, 0xe8, 0x89, 0xfc, 0x22, 0xcb, 0x2e, 0xe4, 0x21, 0x52, 0xd1, 0x6c,
+		0x0d, 0xf5, 0x98, 0x7d, 0x8b, 0xf9, 0x2b, 0x69, 0x05, 0x7f, 0x16, 0x7e, 0x25, 0x0c, 0x91, 0x0d,
+		0xd3, 0xc6, 0xb7, 0xab, 0x22, 0x9e, 0xd7, 0x75, 0x82, 0x44, 0xba, 0xde, 0xde, 0x36, 0xf4, 0xd6,
+		0x75, 0x6b, 0xdb, 0xf3, 0x9e, 0xa9, 0x2b, 0x50, 0x21, 0x9f, 0x40, 0xcd, 0x41, 0xb4, 0x6f, 0x0e,
+		0x8c, 0x96, 0xb8, 0xbb, 0x40, 0x13, 0xe4, 0x32, 0x24, 0x1c, 0x39, 0x89, 0x4c, 0x94, 0x93, 0x1c,
+		0xca, 0x09, 0x8a, 0x31, 0xcf, 0x50, 0xe2, 0x9b, 0x5c, 0x5c, 0x4a, 0x90, 0x74, 0x34, 0x4c, 0x3e,
+		0x7a, 0x00, 0x99, 0x75, 0xd1, 0xd0, 0x29, 0x71, 0x46, 0xdf, 0x61, 0x1f, 0x93, 0x39, 0xd9, 0x29,
+		0xe0, 0xfc, 0xf3, 0x09, 0x16, 0x7f, 0x5c, 0x35, 0x4e, 0x3b, 0xe6, 0x0a, 0x16, 0x7b, 0x60, 0xf5,
+		0x2e, 0x3c, 0xe3, 0xb8, 0x6d, 0xd0, 0x8b, 0x39, 0x5c, 0xf6, 0xdc, 0x0c, 0x2c, 0x75, 0x2f, 0xb9,
+		0x32, 0x59, 0x73, 0x33, 0xf0, 0xa9, 0x12, 0x27, 0xa1, 0xba, 0x54, 0x98, 0x9c, 0x11, 0xa7, 0xa8,
+		0x2e, 0x4a, 0x0a, 0xff, 0x52, 0x82, 0x18, 0x37, 0xee, 0xee, 0x38, 0x48, 0xc1, 0xe3, 0x10, 0x1a,
+		0x37, 0x0e, 0xe1, 0x37, 0x35, 0x0e, 0xe0, 0xb4, 0xd3, 0xe2, 0x6f, 0x68, 0x06, 0x79, 0xb2, 0xac,
+		0x91, 0xf5, 0xf6, 0x36, 0x9f, 0xfb, 0x1e, 0xac, 0xc2, 0xab, 0x12, 0x24, 0x9d, 0x72, 0x52, 0x82,
+		0x8c, 0x68, 0x99, 0xba, 0xd5, 0xd1, 0xb6, 0xb9, 0x38, 0x9e, 0x18, 0xdf, 0x3c, 0xf4, 0x59, 0x94,
+		0x14, 0x6f, 0x11, 0x26, 0x82, 0x47, 0x36, 0x34, 0x66, 0x64, 0x7d, 0xa2, 0x14, 0xbe, 0x33, 0x51,
+		0xf2, 0x0d, 0x7a, 0x64, 0x68, 0xd0, 0x0b, 0x5f, 0x90, 0xf8, 0xfb, 0xdd, 0x2d, 0xbd, 0xf5, 0x7f,
+		0x74, 0xb4, 0xbe, 0x9e, 0xcb, 0x17, 0x86, 0x34, 0x47, 0x86, 0xed, 0xde, 0x00, 0x92, 0xfe, 0x56,
+		0xbb, 0xc3, 0x47, 0x04, 0x99, 0xba, 0x3b, 0x8c, 0xbf, 0x18, 0x82, 0x99, 0x11, 0xf8, 0xbf, 0x85,
+		0xc3, 0xe9, 0x9f, 0xc3, 0xd1, 0x29, 0xe7, 0x70, 0x6c, 0xec, 0x1c, 0xfe, 0xc5, 0x10, 0x8d, 0x3d,
+		0xf4, 0x4c, 0x4b, 0xeb, 0xfc, 0x8d, 0xe8, 0xe0, 0xe3, 0x90, 0xec, 0x99, 0x1d, 0x95, 0x95, 0xb0,
+		0x5b, 0x64, 0x89, 0x9e, 0xd9, 0x51, 0x46, 0x44, 0x2d, 0xfa, 0x56, 0x29, 0xe8, 0xd8, 0x5b, 0x30,
+		0x0c, 0xf1, 0xe1, 0x59, 0x65, 0x43, 0x9a, 0xf1, 0x82, 0x7b, 0x50, 0xe7, 0x91, 0x09, 0xf8, 0x2b,
+		0x2f, 0x0d, 0xfb, 0x7c, 0x4e, 0xbb, 0x19, 0xa8, 0x12, 0xdb, 0x71, 0x50, 0x98, 0xbf, 0x91, 0x0f,
+		0x8d, 0x45, 0x61, 0xa2, 0xac, 0x70, 0xc0, 0xc2, 0xcb, 0x12, 0xc0, 0x32, 0x32, 0x97, 0xf6, 0x18,
+		0x9d, 0x1f, 0x8b, 0x36, 0x42, 0xf5, 0xd5, 0x7d, 0x72, 0xec, 0xc0, 0xf1, 0x16, 0xa4, 0x2d, 0x6f,
+		0xd3, 0x2b, 0x90, 0x71, 0x05, 0xdc, 0xd2, 0x45, 0x73, 0x4e, 0xee, 0x17, 0x12, 0xc0, 0xbb, 0xa1,
+		0xe9, 0x5d, 0x4f, 0xaa, 0xf0, 0xaf, 0x24, 0x48, 0xd2, 0x56, 0xe1, 0x03, 0x93, 0xbe, 0x81, 0x94,
+		0xde, 0xc4, 0x40, 0xde, 0x0d, 0xc0, 0xe8, 0xe0, 0x41, 0x23, 0x2e, 0x5f, 0x49, 0x9a, 0x83, 0xc7,
+		0x87, 0xc8, 0xe3, 0x0e, 0xd7, 0xc3, 0x13, 0xb8, 0xce, 0x55, 0x87, 0xe0, 0xfd, 0x51, 0x88, 0xd3,
+		0xa7, 0x12, 0x6e, 0x59, 0x3c, 0x10, 0x80, 0x0f, 0x12, 0x37, 0x6e, 0x59, 0x85, 0x1b, 0x10, 0x6f,
+		0xdc, 0x62, 0x31, 0xcd, 0xe3, 0x90, 0xec, 0x9b, 0x26, 0xf7, 0x06, 0x99, 0x23, 0x9e, 0xc0, 0x0c,
+		0xea, 0xfc, 0x88, 0x30, 0x5e, 0xc8, 0x0d, 0xe3, 0xb9, 0x81, 0xc8, 0xf0, 0x74, 0x81, 0x48, 0x5c,
+		0xb7, 0x67, 0x7c, 0x33, 0x8a, 0xbc, 0x03, 0x8e, 0xd6, 0x6b, 0x57, 0x56, 0xaa, 0x15, 0xf5, 0x7a,
+		0xfd, 0xca, 0xd0, 0x8d, 0xf6, 0xf9, 0xdc, 0x4b, 0xb7, 0x17, 0x52, 0x7c, 0xc1, 0x3e, 0x0e, 0x7a,
+		0x4d, 0xa9, 0x6e, 0xac, 0x36, 0xaa, 0xb2, 0xc4, 0xa0, 0xd7, 0xfa, 0xfa, 0xae, 0x69, 0xb3, 0x0f,
+		0xbd, 0x3c, 0x0c, 0xc7, 0x02, 0xa0, 0x9d, 0x65, 0xfb, 0xcc, 0x4b, 0xb7, 0x17, 0x32, 0x6b, 0x7d,
+		0x9d, 0x89, 0x1a, 0xc5, 0x58, 0x84, 0xfc, 0x28, 0xc6, 0xea, 0xda, 0x6a, 0xbd, 0xb8, 0x2c, 0x2f,
+		0xcc, 0xcb, 0x2f, 0xdd, 0x5e, 0x48, 0x0b, 0xdd, 0x81, 0xf0, 0x5f, 0xfb, 0x75, 0x7b, 0x64, 0xf4,
+		0x44, 0xc5, 0xcd, 0xbe, 0xd6, 0xeb, 0xe9, 0x7d, 0x6b, 0xdc, 0xd1, 0x81, 0x7b, 0x20, 0x55, 0xf1,
+		0xbc, 0x2f, 0xe2, 0x9c, 0x21, 0x91, 0xe8, 0xdb, 0x23, 0x2c, 0x51, 0x28, 0x00, 0x5c, 0xee, 0x98,
+		0x9a, 0x1d, 0x00, 0x13, 0xf2, 0xc0, 0xd4, 0x0c, 0xfb, 0xe2, 0xa3, 0x01, 0x30, 0x61, 0x01, 0x73,
+		0x0f, 0xa4, 0xd6, 0xc7, 0x01, 0x45, 0xfc, 0x84, 0x1e, 0xb9, 0x10, 0x00, 0x13, 0x1d, 0x22, 0x14,
+		0x08, 0x94, 0x11, 0x40, 0xa7, 0x20, 0x59, 0x32, 0xcd, 0x4e, 0x00, 0x48, 0xc2, 0x43, 0xa7, 0xee,
+		0x79, 0x3a, 0xc5, 0x07, 0x94, 0xf4, 0x34, 0x88, 0xc6, 0xb5, 0x02, 0x60, 0x9c, 0x53, 0x36, 0x07,
+		0x3e, 0x5c, 0xf2, 0x2c, 0x1f, 0x97, 0x83, 0x1e, 0x2e, 0x11, 0xe3, 0x79, 0x67, 0x87, 0x4b, 0xd2,
+		0x9e, 0xcd, 0x0d, 0x27, 0xca, 0xd0, 0xd3, 0xfa, 0x5a, 0x77, 0x9f, 0xc0, 0x45, 0x70, 0x50, 0x61,
+		0xc2, 0xd9, 0x9d, 0xf9, 0x09, 0x92, 0x88, 0x2b, 0x9b, 0x9c, 0xb3, 0x60, 0x5e, 0xa3, 0x4d, 0x20,
+		0x8f, 0x7a, 0xa3, 0x3b, 0xa9, 0xf1, 0x7e, 0x08, 0x03, 0x17, 0xd1, 0x9f, 0x77, 0x41, 0x42, 0x2c,
+		0xbc, 0xb8, 0x6e, 0x3e, 0x15, 0xe4, 0x2d, 0x71, 0x10, 0x8e, 0xeb, 0xa0, 0x90, 0xaf, 0x83, 0xa4,
+		0xa3, 0xa9, 0xb9, 0x6a, 0x2a, 0xec, 0xa7, 0xdb, 0x39, 0x01, 0x17, 0x09, 0xe3, 0xb3, 0x22, 0x3c,
+		0x10, 0x19, 0x1b, 0x71, 0xd8, 0x60, 0x10, 0x1c, 0x5b, 0x20, 0x90, 0xc7, 0x20, 0xa2, 0x6d, 0x36,
+		0xdb, 0xf9, 0xe8, 0x70, 0xcc, 0xd3, 0x41, 0x2c, 0x96, 0xca, 0x35, 0x86, 0x45, 0x03, 0xbb, 0x14,
+		0x1c, 0x1b, 0x6d, 0xed, 0x19, 0xcd, 0x9d, 0xbe, 0x69, 0xec, 0xe5, 0x63, 0x63, 0x1b, 0x5d, 0x17,
+		0x30, 0xa2, 0xd1, 0x0e, 0x12, 0x36, 0x7a, 0x4b, 0x77, 0xad, 0x77, 0x70, 0xa3, 0xf9, 0xcb, 0x06,
+		0xa2, 0xd1, 0x1c, 0xa1, 0x50, 0xe3, 0xf1, 0x54, 0x3e, 0x6c, 0xf4, 0xf1, 0xdc, 0x5b, 0x3c, 0x1e,
+		0xcc, 0x26, 0x7c, 0xa2, 0xab, 0xdd, 0x62, 0xc1, 0xe0, 0xa3, 0x10, 0xc7, 0xc2, 0x6d, 0xfe, 0x8a,
+		0x5d, 0x58, 0x89, 0x75, 0xb5, 0x5b, 0x57, 0x34, 0x7c, 0x2c, 0x21, 0x2c, 0x47, 0x0a, 0x3f, 0x8e,
+		0xee, 0xb7, 0x6f, 0x68, 0xc8, 0x43, 0x40, 0x10, 0x03, 0xf7, 0xb4, 0xd1, 0x08, 0xd1, 0x41, 0x16,
+		0x74, 0xf1, 0x1d, 0x8a, 0xe2, 0xb6, 0xbe, 0x32, 0xe8, 0xd2, 0x06, 0x58, 0x04, 0x37, 0xc0, 0x39,
+		0xb0, 0x10, 0x40, 0xc7, 0x5f, 0x18, 0xf9, 0xf6, 0x0f, 0x07, 0x60, 0x0e, 0xcd, 0xcb, 0xe8, 0xd0,
+		0x64, 0x19, 0x3d, 0x51, 0xe2, 0xef, 0x4a, 0xd8, 0xdf, 0x95, 0xc2, 0xd3, 0x90, 0x1b, 0x92, 0x02,
+		0x6f, 0x38, 0x9c, 0x32, 0x8d, 0xae, 0xdd, 0x93, 0x22, 0x1c, 0x4e, 0x67, 0xdf, 0x52, 0xe2, 0x97,
+		0x71, 0xcf, 0x05, 0x37, 0x47, 0x1f, 0x82, 0x8c, 0x4f, 0x0c, 0x44, 0xe0, 0x52, 0x72, 0x03, 0x97,
+		0x2e, 0xf0, 0x0b, 0x90, 0x46, 0x53, 0xaa, 0xb7, 0x38, 0xec, 0xfd, 0x90, 0x63, 0xb6, 0x7e, 0x98,
+		0xd7, 0xcc, 0x87, 0xbf, 0x2e, 0x18, 0x5e, 0x80, 0x8c, 0x0b, 0xe7, 0xb2, 0x3d, 0x25, 0xa0, 0xae,
+		0x68, 0x56, 0xe1, 0xe3, 0x12, 0xe4, 0x86, 0x64, 0x83, 0xbc, 0x0b, 0x92, 0xbd, 0xbe, 0xde, 0x6c,
+		0x7b, 0xc2, 0x5c, 0xfb, 0xb0, 0x30, 0x42, 0xd9, 0xe7, 0x62, 0xa0, 0x9b, 0x24, 0x4e, 0x22, 0xb4,
+		0xf4, 0x8e, 0xb6, 0x97, 0x0f, 0x4d, 0x47, 0x42, 0x7c, 0xa2, 0xad, 0x82, 0x48, 0x85, 0x5f, 0x97,
+		0x20, 0xe3, 0x13, 0x3a, 0xd2, 0x82, 0xbb, 0xd1, 0x44, 0x7b, 0x4f, 0xbf, 0xf3, 0xa7, 0xcb, 0x3d,
+		0x6b, 0xb4, 0xa0, 0x67, 0x3a, 0x5c, 0x43, 0x43, 0x9d, 0x1b, 0x49, 0x99, 0x47, 0x3a, 0xee, 0x21,
+		0x78, 0xf6, 0xc6, 0xf9, 0x55, 0xe6, 0x8c, 0xaf, 0x02, 0xe9, 0x6d, 0xda, 0xc3, 0xa4, 0x43, 0xd3,
+		0x92, 0x96, 0x11, 0xd9, 0x4b, 0xb0, 0x50, 0x07, 0x70, 0x27, 0x2e, 0x29, 0x4e, 0xd3, 0x89, 0xf0,
+		0x7e, 0x2d, 0x5c, 0x0a, 0xe5, 0xa5, 0xd2, 0xda, 0x67, 0x3e, 0x7f, 0x42, 0xfa, 0x9a, 0xb8, 0x0e,
+		0x5f, 0xae, 0xc3, 0x5d, 0x2e, 0xe8, 0x66, 0xb3, 0x3d, 0x1c, 0xd0, 0x96, 0x45, 0xe9, 0x22, 0x96,
+		0x4e, 0x13, 0xcf, 0x9e, 0x60, 0x69, 0xa6, 0x89, 0x77, 0xdf, 0x61, 0x38, 0xfb, 0x3f, 0x24, 0x21,
+		0xae, 0xe8, 0xef, 0x1d, 0xe8, 0x96, 0x4d, 0x1e, 0x81, 0x88, 0xde, 0xdc, 0x31, 0x47, 0xf7, 0x94,
+		0x78, 0x37, 0x16, 0xab, 0xcd, 0x1d, 0x93, 0x03, 0x5f, 0x3d, 0xa4, 0x50, 0x60, 0x72, 0x11, 0xa2,
+		0x5b, 0x9d, 0x01, 0x8f, 0x74, 0xfb, 0xec, 0x90, 0xc0, 0xba, 0x8c, 0xc5, 0x2e, 0x1a, 0x03, 0xc7,
+		0xca, 0xe8, 0xc7, 0xf1, 0xc2, 0xe3, 0x2a, 0xa3, 0xdf, 0xc4, 0x73, 0x2b, 0x43, 0x60, 0x52, 0x06,
+		0x68, 0x1b, 0x6d, 0x5b, 0xa5, 0x41, 0xe0, 0x7c, 0x74, 0x58, 0x97, 0xbb, 0xa8, 0x6d, 0x9b, 0x06,
+		0x8c, 0x5d, 0xfc, 0x64, 0x5b, 0xe4, 0x61, 0x8b, 0xdf, 0x3b, 0xd0, 0xfb, 0xc2, 0x16, 0x04, 0xb4,
+		0xf8, 0xdd, 0x58, 0xec, 0x69, 0x31, 0x05, 0x47, 0xdb, 0xc9, 0xbe, 0xbf, 0x60, 0xdf, 0xca, 0x27,
+		0x86, 0xcd, 0x80, 0x40, 0xa5, 0x9f, 0x5f, 0x68, 0xdc, 0x72, 0x91, 0xe3, 0x4d, 0x96, 0x43, 0x2e,
+		0x39, 0x6b, 0xb4, 0xd4, 0xf0, 0xa2, 0xc8, 0x41, 0xa6, 0xe5, 0x2e, 0x2e, 0x47, 0x20, 0xab, 0x90,
+		0xed, 0xb4, 0x2d, 0x5b, 0xb5, 0x0c, 0xad, 0x67, 0xed, 0x98, 0xb6, 0x78, 0x02, 0xfd, 0xfe, 0x51,
+		0x12, 0xcb, 0x6d, 0xcb, 0xae, 0x0b, 0x30, 0x97, 0x52, 0xa6, 0xe3, 0xcd, 0x47, 0x82, 0xe6, 0xd6,
+		0x96, 0xde, 0x77, 0x28, 0xe6, 0x33, 0xe3, 0x08, 0xae, 0x22, 0x9c, 0xc0, 0xf4, 0x10, 0x34, 0xbd,
+		0xf9, 0xe4, 0x1b, 0x60, 0xb6, 0x63, 0x6a, 0x2d, 0x87, 0x9e, 0xda, 0xdc, 0x19, 0x18, 0x37, 0x68,
+		0x0c, 0x37, 0x75, 0xe1, 0x4c, 0x40, 0x33, 0x4d, 0xad, 0x25, 0x90, 0xcb, 0x08, 0xea, 0x52, 0x9e,
+		0xe9, 0x0c, 0x97, 0x11, 0x15, 0xe6, 0xb4, 0x5e, 0xaf, 0xb3, 0x37, 0x4c, 0x3e, 0x47, 0xc9, 0x3f,
+		0x34, 0x4a, 0xbe, 0x88, 0xd0, 0x63, 0xe8, 0x13, 0x6d, 0xa4, 0x90, 0xac, 0x63, 0xfc, 0x59, 0xa7,
+		0x57, 0x5f, 0x7b, 0x7c, 0x15, 0x42, 0xdf, 0x18, 0xc7, 0xb7, 0x86, 0x46, 0x88, 0xaf, 0x31, 0x48,
+		0xb1, 0x5c, 0x71, 0x29, 0xe7, 0x7a, 0xfe, 0x12, 0x46, 0xd6, 0x6c, 0xea, 0xf4, 0x8b, 0x0c, 0x9c,
+		0xec, 0xcc, 0x78, 0xb2, 0x14, 0x32, 0x90, 0xac, 0xaf, 0x84, 0x5c, 0x86, 0x14, 0x0b, 0x5b, 0xe1,
+		0xd6, 0xb9, 0x4e, 0x3f, 0x75, 0x91, 0xba, 0x70, 0x4f, 0xc0, 0x74, 0xa5, 0x40, 0x18, 0x12, 0x77,
+		0x89, 0x81, 0xee, 0x64, 0x92, 0x4d, 0x38, 0x4c, 0xdf, 0x69, 0xdf, 0x53, 0xfd, 0x0a, 0x37, 0x3f,
+		0xcb, 0xdf, 0x56, 0x1d, 0xa1, 0x48, 0xbf, 0x7c, 0xb6, 0xb7, 0xe1, 0xd5, 0xbc, 0x2e, 0xe9, 0xd9,
+		0xdd, 0xd1, 0x52, 0x94, 0xb4, 0xad, 0xb6, 0xa1, 0x75, 0xf0, 0x23, 0x24, 0xcc, 0x5f, 0x9d, 0x1b,
+		0x27, 0x69, 0x97, 0x39, 0x1c, 0xf5, 0x56, 0x3c, 0x92, 0xb6, 0xe5, 0xcd, 0x2f, 0xc5, 0xf9, 0x9a,
+		0xc2, 0x79, 0xc1, 0x38, 0x2e, 0x27, 0xd8, 0x3b, 0xfb, 0xd7, 0x22, 0x09, 0x90, 0x53, 0x85, 0x07,
+		0x20, 0xe5, 0xd1, 0x53, 0x78, 0x54, 0x80, 0x5b, 0x4d, 0x71, 0x07, 0x80, 0x27, 0x0b, 0x59, 0x48,
+		0x7b, 0x55, 0x53, 0xe1, 0x63, 0x12, 0xa4, 0x3c, 0x4a, 0x07, 0x31, 0xbd, 0x3b, 0x59, 0x49, 0xd7,
+		0x11, 0xbd, 0x47, 0xb8, 0x0d, 0xa2, 0x9c, 0xed, 0xa6, 0xa6, 0x69, 0x26, 0xf7, 0x5a, 0xf0, 0x51,
+		0xa4, 0xde, 0x85, 0x9e, 0x03, 0x12, 0xa6, 0x20, 0xd0, 0xbb, 0xd0, 0x13, 0x00, 0xa7, 0x20, 0x8d,
+		0x5d, 0x57, 0xbd, 0xfe, 0x70, 0x52, 0x49, 0x61, 0x1e, 0x07, 0x29, 0xfc, 0x76, 0x08, 0xe4, 0x61,
+		0x65, 0xe6, 0xec, 0x70, 0x49, 0x07, 0xde, 0xe1, 0x3a, 0x36, 0xbc, 0xb7, 0xe6, 0x6e, 0xa7, 0x5d,
+		0x07, 0xd9, 0xdd, 0x14, 0x62, 0xb6, 0x67, 0x1f, 0x07, 0x7f, 0x68, 0x31, 0xa2, 0xe4, 0x9a, 0xfe,
+		0x0c, 0x72, 0xc5, 0x77, 0xa8, 0x24, 0xe2, 0x9c, 0x92, 0x1d, 0x96, 0x27, 0x01, 0xb3, 0xde, 0x6b,
+		0x69, 0xb6, 0x2e, 0x62, 0xea, 0x2e, 0x2a, 0x7a, 0x72, 0xb8, 0x0b, 0x65, 0xd9, 0x9a, 0xad, 0xfb,
+		0x4e, 0x51, 0x64, 0xb4, 0x5e, 0x8f, 0x7e, 0x7c, 0x8f, 0x79, 0x72, 0xf7, 0x41, 0x16, 0x35, 0x7c,
+		0x5b, 0xeb, 0x08, 0x37, 0x21, 0xc6, 0x1c, 0x3e, 0x9e, 0xcb, 0x5d, 0x8d, 0x16, 0xa4, 0xbd, 0xca,
+		0xdd, 0x89, 0xbd, 0x48, 0x9e, 0xd8, 0x0b, 0xe1, 0xaf, 0x5c, 0x31, 0x0e, 0x89, 0x97, 0xc1, 0x82,
+		0x77, 0x1b, 0xe7, 0x68, 0x9c, 0x66, 0x97, 0x05, 0x57, 0x13, 0x0a, 0x4b, 0x14, 0x9e, 0x87, 0xac,
+		0xdf, 0x0e, 0x90, 0x2c, 0x84, 0xec, 0x5b, 0xbc, 0x96, 0x90, 0x7d, 0x8b, 0x9c, 0xf7, 0x7c, 0x97,
+		0x30, 0x1b, 0x64, 0xfd, 0x38, 0xbe, 0x1b, 0x1b, 0x65, 0x4f, 0xa5, 0x15, 0x72, 0x90, 0xf1, 0x59,
+		0x89, 0xc2, 0x11, 0x98, 0x0b, 0xd2, 0xf9, 0x85, 0x36, 0xcc, 0x05, 0xa9, 0x6e, 0x72, 0x11, 0x12,
+		0x8e, 0xd2, 0x1f, 0x09, 0xa7, 0x89, 0xda, 0x1d, 0x24, 0x07, 0xd6, 0xb7, 0x1d, 0x18, 0xf2, 0x6d,
+		0x07, 0x16, 0xbe, 0x09, 0xf2, 0xe3, 0xf4, 0xf9, 0xd0, 0xfe, 0x40, 0xc4, 0x61, 0xdc, 0x11, 0x88,
+		0xf1, 0x27, 0x25, 0x43, 0x34, 0x0e, 0xc1, 0x53, 0xc8, 0x50, 0xa6, 0xdb, 0xc3, 0x34, 0x9b, 0x25,
+		0x0a, 0x2a, 0x1c, 0x1b, 0xab, 0xd2, 0xc7, 0x6f, 0xa7, 0x33, 0x42, 0x7c, 0x3b, 0x9d, 0x26, 0xb0,
+		0x5a, 0x0b, 0x03, 0xfb, 0x7d, 0x71, 0xf6, 0x8e, 0xa5, 0xf0, 0xc1, 0xdc, 0x23, 0xc1, 0x7a, 0x1d,
+		0x4f, 0xe8, 0xe0, 0xea, 0xc0, 0xf6, 0x2f, 0x24, 0xa0, 0xab, 0xdd, 0x6a, 0xf0, 0x55, 0x04, 0xdf,
+		0x8a, 0x0c, 0x39, 0x5b, 0x91, 0x64, 0x03, 0x66, 0x3a, 0x66, 0x53, 0xeb, 0xa8, 0x9e, 0xad, 0x60,
+		0x3e, 0x9d, 0xee, 0x1d, 0xa7, 0xa7, 0xc5, 0x66, 0x03, 0xaa, 0x20, 0x3e, 0x11, 0x72, 0x94, 0xc8,
+		0xb2, 0xb3, 0x6d, 0x4c, 0xaa, 0x90, 0xea, 0xb6, 0xad, 0x4d, 0x7d, 0x47, 0xdb, 0x6d, 0x9b, 0x7d,
+		0x3e, 0xaf, 0x02, 0xa4, 0xe7, 0xba, 0x0b, 0x24, 0xf6, 0xa8, 0x3d, 0x78, 0x9e, 0x41, 0x89, 0x06,
+		0xee, 0x9d, 0xc7, 0x0e, 0xac, 0x59, 0xc6, 0xed, 0x42, 0xc7, 0xc7, 0xee, 0x42, 0x07, 0x6d, 0xf9,
+		0x26, 0x82, 0xb7, 0x7c, 0x5f, 0xa2, 0x83, 0x13, 0x64, 0x1d, 0x47, 0x77, 0x81, 0x49, 0x03, 0xe6,
+		0x38, 0x7e, 0xcb, 0xc7, 0xfd, 0x91, 0xc3, 0x69, 0x7e, 0xa7, 0xcb, 0xc3, 0x75, 0x22, 0xf0, 0xc7,
+		0x33, 0x3e, 0x7c, 0x87, 0x8c, 0x17, 0x67, 0x31, 0x22, 0x9e, 0xb3, 0x18, 0xff, 0x97, 0x0d, 0xc6,
+		0x87, 0xc2, 0x62, 0x77, 0xcc, 0xe3, 0x58, 0x04, 0x1e, 0x32, 0x19, 0xb7, 0x99, 0x23, 0x3a, 0x16,
+		0x3e, 0x70, 0xc7, 0xf8, 0x68, 0x47, 0x26, 0x8f, 0x76, 0xf4, 0xad, 0x1c, 0xed, 0xd8, 0x1d, 0x8e,
+		0xf6, 0xd7, 0x74, 0x1c, 0x7e, 0x40, 0x82, 0xf9, 0xf1, 0xee, 0x58, 0xe0, 0x80, 0x1c, 0x68, 0xfb,
+		0x71, 0x9c, 0xc5, 0xbb, 0x0f, 0xb2, 0x43, 0xde, 0x22, 0x13, 0xe6, 0x8c, 0x6f, 0x3d, 0x5e, 0xf8,
+		0xf9, 0x30, 0x7e, 0xe5, 0x76, 0xd4, 0xa1, 0x0b, 0x98, 0xb1, 0x0a, 0x7e, 0xd7, 0xa4, 0xd9, 0x6e,
+		0xdd, 0xf1, 0x84, 0x9d, 0xe1, 0xe8, 0xff, 0x6f, 0xbe, 0x8e, 0xca, 0x09, 0x9e, 0xd5, 0xc1, 0x40,
+		0x26, 0x1e, 0xfa, 0xb4, 0x4d, 0xe1, 0x1b, 0x25, 0x59, 0x80, 0x90, 0x17, 0x34, 0x4c, 0xee, 0x1d,
+		0xfd, 0x28, 0x40, 0x42, 0xd1, 0xad, 0x1e, 0x3a, 0x73, 0xec, 0x6b, 0xee, 0x4d, 0xbd, 0x67, 0xbb,
+		0x31, 0xae, 0xc0, 0x35, 0x06, 0x07, 0x11, 0x78, 0xb8, 0xd6, 0x76, 0xf0, 0x70, 0x1b, 0x98, 0x86,
+		0x14, 0xc6, 0x06, 0x07, 0x98, 0xab, 0xee, 0xa0, 0x52, 0x68, 0xf2, 0xb8, 0x88, 0x29, 0x84, 0xc7,
+		0xad, 0x94, 0xb9, 0xe3, 0xee, 0xe0, 0x31, 0x78, 0xac, 0x8e, 0x06, 0x15, 0x22, 0xe3, 0xaa, 0x63,
+		0xfe, 0xbd, 0x5b, 0x5d, 0x9b, 0xbd, 0x30, 0xeb, 0x8d, 0x2a, 0xc4, 0xc6, 0x75, 0xd5, 0xe3, 0x88,
+		0xbb, 0x5d, 0x75, 0xc3, 0x0a, 0x8f, 0x8b, 0xb0, 0x42, 0x7c, 0x5c, 0xa3, 0xb9, 0xe7, 0xe9, 0x36,
+		0x9a, 0xc2, 0x93, 0xa7, 0x3c, 0x71, 0x85, 0xe4, 0x70, 0x4c, 0x7e, 0x24, 0xae, 0xe0, 0x60, 0x3b,
+		0x81, 0x85, 0x25, 0x27, 0xb0, 0x90, 0x1e, 0x1b, 0x95, 0xe0, 0x2e, 0xa3, 0x83, 0xcc, 0x31, 0xc8,
+		0xda, 0x48, 0x64, 0x81, 0x05, 0x02, 0x1e, 0x98, 0x18, 0x59, 0x70, 0x48, 0x0d, 0x85, 0x16, 0xd6,
+		0x46, 0x42, 0x0b, 0xd9, 0x71, 0x14, 0x87, 0xfc, 0x53, 0x97, 0xa2, 0x3f, 0xb6, 0xf0, 0x8d, 0xc1,
+		0xb1, 0x85, 0xb1, 0x8b, 0xff, 0x00, 0x5f, 0xd4, 0x21, 0x1d, 0x10, 0x5c, 0xf8, 0xa6, 0x31, 0xc1,
+		0x05, 0x79, 0xdc, 0x22, 0x38, 0xc8, 0x13, 0x75, 0x2a, 0x08, 0x8a, 0x2e, 0x6c, 0x04, 0x44, 0x17,
+		0x58, 0x18, 0xe0, 0xc1, 0x29, 0xa2, 0x0b, 0x0e, 0xe9, 0x91, 0xf0, 0xc2, 0x46, 0x40, 0x78, 0x81,
+		0x8c, 0xa7, 0x3b, 0xe4, 0x40, 0x79, 0xe9, 0xfa, 0x8a, 0xc8, 0x15, 0x7f, 0x7c, 0x61, 0x76, 0x7f,
+		0xbf, 0x95, 0xb9, 0x01, 0x0e, 0x35, 0x6f, 0x80, 0xa1, 0x39, 0x2e, 0xc0, 0xc0, 0x62, 0x00, 0x67,
+		0xa7, 0x0c, 0x30, 0x38, 0xb4, 0x03, 0x23, 0x0c, 0x6b, 0x23, 0x11, 0x86, 0xc3, 0xe3, 0x04, 0x6e,
+		0xc8, 0x20, 0xb9, 0x02, 0x37, 0x36, 0xc4, 0xc0, 0x3e, 0xe8, 0xc7, 0x3e, 0xe5, 0x07, 0x72, 0xea,
+		0x5a, 0x24, 0x91, 0x92, 0xd3, 0x85, 0x07, 0xd1, 0x05, 0x1a, 0xd2, 0x7b, 0xb8, 0xe0, 0xd0, 0xfb,
+		0x7d, 0xb3, 0x2f, 0x36, 0x44, 0x69, 0xa2, 0x70, 0x1a, 0xd2, 0x5e, 0x15, 0xb7, 0x4f, 0x38, 0x22,
+		0x07, 0x19, 0x9f, 0x56, 0x2b, 0xfc, 0xb2, 0x04, 0x69, 0xaf, 0xbe, 0xf2, 0x2d, 0x56, 0x93, 0x7c,
+		0xb1, 0xea, 0x09, 0x52, 0x84, 0xfc, 0x41, 0x0a, 0xfc, 0x2c, 0x52, 0x6f, 0x24, 0xfe, 0xa0, 0xf5,
+		0x9c, 0xf8, 0x83, 0x38, 0xc5, 0xc9, 0x42, 0x19, 0xdc, 0x32, 0xb0, 0x23, 0x0c, 0x39, 0xe7, 0x44,
+		0x2b, 0x8f, 0xf9, 0x9f, 0x85, 0x59, 0x0f, 0xac, 0xb3, 0x10, 0x64, 0x4b, 0x71, 0xd9, 0x81, 0x2e,
+		0xf2, 0x15, 0xe1, 0xaf, 0x4b, 0x30, 0x33, 0xa2, 0x2e, 0x03, 0x63, 0x0c, 0xd2, 0x5b, 0x15, 0x63,
+		0x08, 0xdd, 0x79, 0x8c, 0xc1, 0xbb, 0xb4, 0x0d, 0xfb, 0x97, 0xb6, 0x5f, 0x96, 0x20, 0xe3, 0x53,
+		0xdb, 0x38, 0x08, 0x4d, 0xb3, 0x25, 0xb6, 0xcf, 0xe9, 0x6f, 0xf4, 0x69, 0x3a, 0xa6, 0xf8, 0x12,
+		0x1b, 0xfe, 0x44, 0x28, 0xc7, 0x10, 0x25, 0xb9, 0x99, 0x71, 0xd6, 0xa9, 0x51, 0xef, 0x15, 0x36,
+		0x7e, 0xad, 0x2b, 0xe6, 0x5e, 0xeb, 0x72, 0x76, 0xcd, 0xe3, 0x9e, 0x5d, 0x73, 0x72, 0x09, 0xb7,
+		0x9c, 0x4c, 0x73, 0x4b, 0x35, 0x7b, 0xcc, 0xd6, 0xfb, 0x7c, 0xa3, 0x91, 0x2b, 0x5d, 0x16, 0xbd,
+		0x31, 0x40, 0x7f, 0x79, 0x3c, 0x96, 0xa4, 0xcf, 0x63, 0xb9, 0x0b, 0x92, 0xd8, 0x7c, 0xf6, 0x59,
+		0x57, 0xe0, 0x2f, 0x9b, 0x88, 0x8c, 0xc2, 0x4f, 0x86, 0x20, 0x37, 0x64, 0x75, 0x02, 0x3b, 0x1f,
+		0x74, 0x7c, 0x65, 0x3a, 0x86, 0x9c, 0x00, 0xd8, 0xd6, 0x2c, 0xf5, 0xa6, 0x66, 0xd8, 0x7a, 0x8b,
+		0x73, 0xc5, 0x93, 0x83, 0x37, 0x1d, 0x30, 0x35, 0xb0, 0xf4, 0x16, 0x8f, 0xe6, 0x38, 0x69, 0xbc,
+		0x1a, 0xa9, 0xef, 0xd2, 0x77, 0xd2, 0xd9, 0x97, 0x78, 0x8e, 0x06, 0xa8, 0x27, 0x2c, 0x2f, 0xe5,
+		0x71, 0xb8, 0xff, 0xe4, 0xd5, 0x93, 0x32, 0x03, 0x7f, 0x87, 0x73, 0x5f, 0x56, 0xe1, 0x04, 0xfc,
+		0x6c, 0x48, 0x0c, 0xb1, 0x81, 0x86, 0x16, 0xd3, 0x22, 0x4e, 0x80, 0x4c, 0x65, 0x97, 0x7b, 0xf0,
+		0xba, 0x7e, 0xb7, 0x67, 0x9a, 0x1d, 0x95, 0xcd, 0xf3, 0x22, 0x64, 0xfd, 0x46, 0x96, 0x7d, 0x3a,
+		0xdd, 0xc6, 0x68, 0x9b, 0xcf, 0x8f, 0x4e, 0xb3, 0x4c, 0x36, 0xaf, 0xbc, 0xaf, 0xe0, 0x17, 0xde,
+		0x0d, 0x87, 0x03, 0x6d, 0x2c, 0x79, 0x02, 0x92, 0xae, 0x7d, 0x66, 0x17, 0xb4, 0xf6, 0x8b, 0xd9,
+		0xb8, 0xc0, 0xf8, 0x89, 0x8a, 0x40, 0x23, 0x4b, 0xde, 0x05, 0x31, 0x76, 0x78, 0x9b, 0x1f, 0xd0,
+		0xbb, 0x6f, 0xb2, 0x75, 0xc6, 0x2f, 0x25, 0x70, 0xa4, 0xc2, 0x79, 0x38, 0x36, 0xd6, 0xca, 0xba,
+		0x91, 0x17, 0xc9, 0x13, 0x79, 0x29, 0xfc, 0x8c, 0x04, 0xf3, 0xe3, 0x2d, 0x27, 0x29, 0x0d, 0x35,
+		0xe8, 0xcc, 0x94, 0x76, 0xd7, 0xd3, 0x2a, 0x5c, 0x9a, 0xf4, 0xf5, 0x2d, 0xdd, 0x6e, 0xee, 0x30,
+		0x13, 0xce, 0x94, 0x42, 0x46, 0xc9, 0xf0, 0x5c, 0x8a, 0x63, 0x31, 0xb0, 0x17, 0xf5, 0xa6, 0xad,
+		0xb2, 0x41, 0xb5, 0xe8, 0xf2, 0x20, 0xa9, 0x64, 0x58, 0x6e, 0x9d, 0x65, 0x16, 0x1e, 0x82, 0xa3,
+		0x63, 0x6c, 0x71, 0xc0, 0xd9, 0xf3, 0x17, 0x10, 0x38, 0xd0, 0xc0, 0x92, 0xa7, 0x21, 0x66, 0xd9,
+		0x9a, 0x3d, 0xb0, 0x78, 0xcf, 0x1e, 0x98, 0x68, 0x9b, 0xeb, 0x14, 0x5c, 0xe1, 0x68, 0x85, 0x27,
+		0x81, 0x8c, 0x5a, 0xda, 0x80, 0x75, 0x98, 0x14, 0xb4, 0x0e, 0xdb, 0x84, 0xe3, 0xfb, 0xd8, 0x54,
+		0x52, 0x1e, 0x6a, 0xdc, 0x43, 0x53, 0x99, 0xe4, 0xa1, 0x06, 0xfe, 0x79, 0x08, 0x0e, 0x07, 0x9a,
+		0x56, 0xcf, 0x2c, 0x95, 0xde, 0xec, 0x2c, 0x7d, 0x17, 0x80, 0x7d, 0x4b, 0xdc, 0x38, 0xe0, 0xda,
+		0x3e, 0x68, 0x3d, 0x71, 0x4b, 0x6f, 0x36, 0x6e, 0x71, 0xc1, 0x48, 0xda, 0xfc, 0x17, 0x06, 0x0a,
+		0x3c, 0x6b, 0xdf, 0x01, 0xb5, 0x04, 0x56, 0x3e, 0x7c, 0x30, 0x9b, 0x21, 0xef, 0xfa, 0xb3, 0x2d,
+		0xf2, 0x02, 0x1c, 0x1d, 0xb2, 0x68, 0x0e, 0xed, 0xc8, 0xd4, 0x86, 0xed, 0xb0, 0xdf, 0xb0, 0x09,
+		0xda, 0x5e, 0xab, 0x14, 0xf5, 0x5b, 0xa5, 0x17, 0x00, 0xdc, 0x45, 0xb0, 0x7b, 0x38, 0x56, 0xf2,
+		0x1e, 0x8e, 0xbd, 0x08, 0x51, 0x94, 0x04, 0xc1, 0xaa, 0x00, 0x85, 0x81, 0x43, 0xea, 0x59, 0x45,
+		0x33, 0xf0, 0xc2, 0x8b, 0x40, 0x46, 0xe3, 0x91, 0x63, 0xea, 0x78, 0xca, 0x5f, 0x47, 0x61, 0x7c,
+		0x68, 0x33, 0xb8, 0xae, 0xff, 0x0f, 0xa2, 0x74, 0xf8, 0x03, 0xef, 0x3b, 0xbf, 0x07, 0x40, 0xb3,
+		0xed, 0x7e, 0x7b, 0x73, 0xe0, 0xd6, 0xb0, 0x30, 0x46, 0x7e, 0x8a, 0x02, 0xb0, 0x74, 0x17, 0x17,
+		0xa4, 0x39, 0x17, 0xd7, 0x23, 0x4c, 0x1e, 0x8a, 0x85, 0x15, 0xc8, 0xfa, 0x71, 0x83, 0x2f, 0x70,
+		0x8f, 0x7e, 0x2d, 0xd6, 0x35, 0xe4, 0xfc, 0xe9, 0x36, 0x9a, 0x28, 0x7c, 0x73, 0x08, 0xd2, 0x5e,
+		0xe9, 0xfb, 0x3b, 0x68, 0x2c, 0x0b, 0xdf, 0x26, 0x41, 0xc2, 0xe9, 0xff, 0x3e, 0x57, 0x03, 0xdc,
+		0xab, 0xfc, 0x4e, 0xbc, 0x9e, 0xed, 0x90, 0x84, 0x9d, 0x1d, 0x92, 0x77, 0x3a, 0x06, 0x61, 0xec,
+		0x62, 0xde, 0xcb, 0x6d, 0x71, 0x28, 0x97, 0x1b, 0xa8, 0x27, 0xa7, 0xbb, 0x2d, 0x8c, 0xdb, 0x37,
+		0x9e, 0xab, 0xbe, 0x2c, 0x51, 0xb0, 0x3c, 0x67, 0x97, 0xd8, 0x6c, 0x74, 0x01, 0x43, 0x1e, 0xc0,
+		0xd1, 0x0b, 0xbe, 0xe1, 0xc9, 0x17, 0x7c, 0x23, 0xc3, 0x17, 0x7c, 0x99, 0x0f, 0x50, 0xf8, 0x07,
+		0x12, 0x24, 0xc4, 0x2c, 0x21, 0x4f, 0x7b, 0xcf, 0xd9, 0x89, 0x43, 0x3b, 0xe3, 0xb5, 0x14, 0xef,
+		0xbc, 0x8b, 0x33, 0x7a, 0x5b, 0x21, 0x7c, 0xe0, 0xdb, 0x0a, 0xdc, 0x2b, 0xf9, 0x92, 0x04, 0xf2,
+		0xf0, 0x2c, 0x7e, 0xf3, 0xed, 0x1b, 0xb5, 0x5e, 0xe1, 0x00, 0xeb, 0x35, 0xee, 0x0e, 0x42, 0x64,
+		0xdc, 0x1d, 0x84, 0xd1, 0x7e, 0x47, 0xef, 0xb4, 0xdf, 0x1f, 0x0a, 0x41, 0xca, 0x13, 0x1d, 0xc4,
+		0xc3, 0x87, 0x9e, 0x0b, 0x0d, 0xa7, 0xf6, 0x0d, 0x25, 0x7a, 0x6e, 0x34, 0xf8, 0x38, 0x15, 0xba,
+		0x03, 0x4e, 0xbd, 0xf5, 0xf7, 0x1c, 0x83, 0x2f, 0xde, 0x47, 0xc7, 0x5c, 0xbc, 0xff, 0x16, 0x09,
+		0x12, 0x4e, 0x28, 0xe6, 0xa0, 0xbb, 0x79, 0x47, 0x20, 0xc6, 0x3d, 0x31, 0xb6, 0x9d, 0xc7, 0x53,
+		0x81, 0x71, 0xd5, 0x79, 0x48, 0x74, 0x75, 0x5b, 0xa3, 0xea, 0x91, 0xd9, 0x3b, 0x27, 0x7d, 0x66,
+		0x13, 0x52, 0x9e, 0x0d, 0x51, 0x72, 0x0c, 0x0e, 0x97, 0xaf, 0x56, 0xcb, 0xcf, 0xa8, 0x8d, 0xe7,
+		0x86, 0x3f, 0xd5, 0x36, 0x52, 0xa4, 0x54, 0x69, 0x9a, 0x7d, 0x3f, 0xc7, 0x5f, 0xc4, 0x0a, 0x42,
+		0xf3, 0x11, 0x3c, 0x6b, 0x7e, 0xe6, 0x4b, 0x12, 0xcc, 0x06, 0xf8, 0xbc, 0xe4, 0x14, 0xdc, 0xbd,
+		0x7a, 0xf9, 0x72, 0x55, 0x51, 0xeb, 0x2b, 0xc5, 0xb5, 0xfa, 0xd5, 0xd5, 0x86, 0xaa, 0x54, 0xeb,
+		0xeb, 0xcb, 0xde, 0x4f, 0x9d, 0x2d, 0xc0, 0x5d, 0xc1, 0x20, 0xc5, 0x72, 0xb9, 0xba, 0xd6, 0x60,
+		0x1f, 0x91, 0x1e, 0x03, 0x51, 0xa2, 0x9f, 0x42, 0x1b, 0x4f, 0x42, 0xa9, 0x5e, 0xab, 0x96, 0x1b,
+		0x72, 0x98, 0x3c, 0x00, 0xf7, 0xec, 0x07, 0xc1, 0x3f, 0xc0, 0x26, 0x47, 0x26, 0x02, 0xd6, 0xab,
+		0x2b, 0x95, 0xaa, 0x22, 0x47, 0x79, 0xbf, 0x3f, 0x15, 0x82, 0xfc, 0x38, 0xd7, 0x1a, 0x69, 0x15,
+		0xd7, 0xd6, 0x96, 0x9f, 0x77, 0x69, 0x95, 0xaf, 0xae, 0xaf, 0x3c, 0x33, 0xca, 0x82, 0xfb, 0xa1,
+		0xb0, 0x1f, 0xa0, 0xc3, 0x88, 0xfb, 0xe0, 0xd4, 0xbe, 0x70, 0x9c, 0x1d, 0x13, 0xc0, 0x94, 0x2a,
+		0x7e, 0x01, 0x3b, 0x4c, 0x16, 0xe1, 0xcc, 0x44, 0x30, 0xa7, 0x4c, 0x8e, 0x90, 0x73, 0xf0, 0xd0,
+		0xfe, 0xf0, 0x8c, 0x41, 0x02, 0x41, 0xb0, 0xe8, 0x25, 0x09, 0x0e, 0x07, 0xfa, 0xe8, 0xf8, 0xa9,
+		0xbf, 0x35, 0x65, 0xb5, 0x5c, 0xad, 0xd7, 0x9d, 0xeb, 0x0c, 0x6a, 0xbd, 0x51, 0x6c, 0xac, 0xd7,
+		0x3d, 0xbc, 0x29, 0xc0, 0x89, 0x71, 0x40, 0x0e, 0x5f, 0xf6, 0x81, 0xe1, 0x12, 0x20, 0xe4, 0xf4,
+		0xb6, 0x04, 0xc7, 0xc6, 0xfa, 0xe4, 0xe4, 0x34, 0xdc, 0xcb, 0xbe, 0x06, 0xa8, 0xe2, 0xdd, 0x0d,
+		0xcf, 0xd7, 0xca, 0x47, 0x5a, 0xf5, 0x00, 0xdc, 0xb3, 0x2f, 0xa4, 0xd3, 0xb4, 0x49, 0x80, 0x43,
+		0xed, 0xfb, 0xa0, 0x04, 0xb9, 0x21, 0x5d, 0x48, 0x3f, 0x3a, 0x58, 0xab, 0x97, 0xaa, 0x57, 0x8b,
+		0x1b, 0xb5, 0x55, 0x65, 0x78, 0xce, 0xde, 0x03, 0x27, 0x47, 0x4a, 0x2b, 0xeb, 0xf8, 0xd5, 0xc6,
+		0x62, 0xa3, 0xaa, 0xb2, 0x3b, 0x28, 0xd8, 0xb1, 0x11, 0xa0, 0xe5, 0xda, 0x95, 0xab, 0x0d, 0xb5,
+		0xbc, 0x5c, 0xab, 0xae, 0x34, 0xd4, 0x62, 0xa3, 0x51, 0x74, 0xa7, 0x73, 0xe9, 0x99, 0xb1, 0x67,
+		0x3f, 0xcf, 0x4f, 0x7f, 0xf6, 0x93, 0x9f, 0xee, 0x74, 0x8e, 0x7e, 0xfe, 0xcf, 0x25, 0xb8, 0x97,
+		0xbf, 0x8a, 0x64, 0xd9, 0xda, 0x8d, 0xb6, 0xb1, 0xed, 0x3c, 0x4f, 0xc5, 0xd3, 0xfc, 0x08, 0xe8,
+		0x11, 0x06, 0xb5, 0x28, 0x72, 0x27, 0x3c, 0x52, 0x35, 0xf6, 0x6d, 0xd3, 0x89, 0x57, 0x07, 0x26,
+		0x1d, 0xf0, 0xdc, 0xef, 0x01, 0xac, 0x09, 0xcf, 0x6c, 0x05, 0x3c, 0x90, 0x35, 0xbf, 0xff, 0x53,
+		0x0e, 0xf3, 0xfb, 0x9e, 0x8b, 0xc5, 0x2b, 0x67, 0xd9, 0xab, 0x6d, 0xcb, 0x36, 0xfb, 0xed, 0xa6,
+		0xd6, 0xa1, 0x8e, 0xc4, 0x3b, 0xa7, 0xbe, 0xeb, 0x56, 0x4a, 0xa2, 0x19, 0xe3, 0xcf, 0x68, 0xed,
+		0x88, 0xeb, 0x66, 0xb1, 0x5d, 0xad, 0xc3, 0xee, 0x99, 0x79, 0xdf, 0xe1, 0x1b, 0x66, 0xbb, 0xc7,
+		0xbe, 0x7a, 0xa9, 0x30, 0x5c, 0x3c, 0x2d, 0x5c, 0xf8, 0x38, 0x06, 0xbb, 0x70, 0xb9, 0x63, 0xd1,
+		0xe5, 0x31, 0x5d, 0x80, 0x5d, 0x83, 0x48, 0x5f, 0xb3, 0xf9, 0xa2, 0xa4, 0x74, 0xf1, 0xc0, 0x6f,
+		0x6f, 0xb1, 0x5a, 0x28, 0x0d, 0xf2, 0x6e, 0xc0, 0xa3, 0xf1, 0x2a, 0xa5, 0x17, 0x7a, 0x53, 0xf4,
+		0xf0, 0x86, 0x00, 0xb6, 0x8f, 0xbc, 0x87, 0x7e, 0x68, 0x12, 0x37, 0xad, 0x8c, 0x6d, 0x9d, 0x51,
+		0x0e, 0xbf, 0x29, 0xca, 0x99, 0xae, 0x76, 0xab, 0x4c, 0xa9, 0x21, 0x7d, 0xfe, 0x46, 0xd9, 0xaf,
+		0x49, 0x00, 0x2e, 0x63, 0x88, 0x86, 0x21, 0x5c, 0x91, 0xa2, 0x95, 0x8a, 0x10, 0xee, 0x03, 0xe3,
+		0x78, 0x3f, 0xc4, 0xd6, 0x52, 0x06, 0x9b, 0xf7, 0xd9, 0x57, 0x4f, 0x4a, 0xac, 0xd6, 0x5c, 0x73,
+		0x84, 0xed, 0x29, 0xb6, 0x86, 0x56, 0xa9, 0x7f, 0x13, 0x9a, 0xe8, 0xdf, 0x64, 0x84, 0x7f, 0xc3,
+		0x08, 0x02, 0xc3, 0xc6, 0x72, 0xde, 0x87, 0x8f, 0x87, 0x20, 0x55, 0xf1, 0xbc, 0x1b, 0x8a, 0x31,
+		0x78, 0xd3, 0x68, 0xdf, 0xd0, 0xfb, 0x4e, 0x0c, 0x9e, 0x25, 0xd1, 0x07, 0x61, 0x1f, 0xb1, 0xb6,
+		0xf7, 0xc4, 0xdb, 0x2a, 0x22, 0x8d, 0x58, 0x37, 0xf5, 0x4d, 0xab, 0x2d, 0xf8, 0xac, 0x88, 0x24,
+		0xee, 0xc6, 0x5a, 0x7a, 0x73, 0x80, 0x61, 0x41, 0xb5, 0x69, 0x1a, 0x36, 0x7e, 0xcb, 0x9e, 0xb9,
+		0xf9, 0x39, 0x91, 0x5f, 0x66, 0xd9, 0x48, 0xa4, 0xa5, 0xdb, 0x5a, 0xbb, 0xc3, 0x8e, 0xb1, 0x25,
+		0x15, 0x91, 0x24, 0x97, 0x20, 0xc3, 0xe9, 0xa9, 0xec, 0xe6, 0x1f, 0x7d, 0x09, 0xa8, 0x34, 0xf7,
+		0xfb, 0xaf, 0x9c, 0x95, 0x6f, 0x09, 0xed, 0xb2, 0xb0, 0x7b, 0x7e, 0xf1, 0xe1, 0xc5, 0x87, 0x95,
+		0x34, 0x07, 0x65, 0xb7, 0x0b, 0xcf, 0x43, 0xb2, 0xb7, 0xdd, 0xe3, 0x68, 0xf1, 0x7d, 0xd0, 0x12,
+		0xbd, 0xed, 0x1e, 0x45, 0xe1, 0x8c, 0xf9, 0xf6, 0x84, 0x77, 0x99, 0x54, 0x06, 0xd9, 0xec, 0xe9,
+		0x7d, 0xdf, 0xe9, 0x00, 0x26, 0xfb, 0xf9, 0xcf, 0xbd, 0x72, 0x76, 0x8e, 0x0f, 0x2f, 0xdf, 0x57,
+		0x66, 0x57, 0xc1, 0x94, 0x9c, 0xc0, 0xe0, 0xd9, 0xe4, 0x79, 0x5f, 0x8c, 0x7f, 0xb0, 0xe9, 0x3e,
+		0xc4, 0x34, 0x37, 0x32, 0x84, 0x45, 0x63, 0xaf, 0x94, 0xff, 0x2d, 0x97, 0x34, 0x0f, 0x62, 0xaf,
+		0xd1, 0xa5, 0x91, 0x37, 0xde, 0x4f, 0xc9, 0xa0, 0x33, 0xf9, 0xa2, 0xd6, 0xee, 0xe8, 0x2d, 0xbe,
+		0xee, 0xe6, 0x29, 0xdc, 0xff, 0xe4, 0x31, 0x2c, 0xf6, 0xad, 0xd6, 0xc2, 0x38, 0x49, 0x2c, 0x99,
+		0x46, 0xcb, 0x1f, 0xba, 0xc2, 0xf8, 0x97, 0x6d, 0xde, 0xd0, 0x0d, 0x3e, 0x1c, 0xa5, 0x87, 0x0e,
+		0xf0, 0xa4, 0x9f, 0xc2, 0x51, 0xc9, 0x37, 0x80, 0xdc, 0xd2, 0x3b, 0xfa, 0x36, 0xe5, 0x9c, 0xb5,
+		0xa3, 0xe1, 0x55, 0xf4, 0xd8, 0x9d, 0x3e, 0xd8, 0x97, 0x73, 0x48, 0xd5, 0x29, 0x25, 0xb2, 0xe6,
+		0x7f, 0x07, 0x37, 0xee, 0x6c, 0x4f, 0x07, 0xf6, 0xd1, 0x23, 0xe7, 0x5e, 0x5d, 0xe7, 0x25, 0x81,
+		0xf2, 0x3a, 0x30, 0x36, 0x4d, 0x83, 0x7e, 0x78, 0x94, 0xfb, 0xf3, 0x09, 0xb6, 0xef, 0xe3, 0xe4,
+		0xf3, 0x7d, 0x9f, 0x35, 0xc8, 0xba, 0xa0, 0x74, 0x3e, 0x26, 0x0f, 0x3a, 0x1f, 0x33, 0x0e, 0x01,
+		0x04, 0xc1, 0x2f, 0x94, 0xba, 0x33, 0x9e, 0xee, 0x3a, 0xa4, 0x2e, 0x14, 0x26, 0xeb, 0x0e, 0x6f,
+		0x67, 0x3c, 0x04, 0x88, 0x01, 0xb3, 0xdd, 0xb6, 0xa1, 0x5a, 0x7a, 0x67, 0x4b, 0xe5, 0x9c, 0x43,
+		0xba, 0x29, 0xca, 0xfe, 0xa7, 0x0e, 0x30, 0x9a, 0xbf, 0xff, 0xca, 0xd9, 0x9c, 0xfb, 0xd2, 0xe1,
+		0xc2, 0xc3, 0x8b, 0x8f, 0x3e, 0xae, 0xcc, 0x74, 0xdb, 0x46, 0x5d, 0xef, 0x6c, 0x55, 0x1c, 0xc2,
+		0xe4, 0x9d, 0x70, 0xdc, 0x65, 0x88, 0x69, 0xa8, 0x3b, 0x66, 0xa7, 0xa5, 0xf6, 0xf5, 0x2d, 0xb5,
+		0x49, 0xdf, 0x69, 0x4c, 0x53, 0x36, 0x1e, 0x75, 0x40, 0x56, 0x8d, 0xab, 0x66, 0xa7, 0xa5, 0xe8,
+		0x5b, 0x65, 0x2c, 0xc6, 0x3d, 0x01, 0x17, 0xbb, 0xdd, 0xc2, 0xdd, 0xf6, 0x30, 0x1e, 0x1c, 0x76,
+		0x32, 0x6b, 0x2d, 0x8b, 0xbc, 0x08, 0x99, 0x4e, 0xfb, 0xbd, 0x83, 0x76, 0x4b, 0xc8, 0x52, 0x96,
+		0x76, 0xa6, 0x7a, 0x60, 0x59, 0x0a, 0x56, 0x1d, 0x8c, 0x36, 0x13, 0xae, 0xa5, 0xc4, 0x87, 0x3f,
+		0x75, 0xf2, 0xd0, 0xeb, 0x9f, 0x3a, 0x79, 0xa8, 0x70, 0x99, 0x3e, 0x0b, 0xc7, 0xa7, 0xb1, 0x6e,
+		0x91, 0x8b, 0x90, 0xd4, 0x44, 0x82, 0xdd, 0xd4, 0xda, 0x47, 0x0d, 0xb8, 0xa0, 0x78, 0x49, 0x2d,
+		0x56, 0xd9, 0x58, 0xd3, 0xda, 0x7d, 0x52, 0xc5, 0x17, 0x75, 0xc5, 0xbc, 0x98, 0x56, 0xa3, 0xb8,
+		0x53, 0x89, 0xe7, 0x93, 0x95, 0x71, 0xc7, 0x96, 0x92, 0xa5, 0x53, 0x9f, 0x7b, 0xe5, 0xec, 0xdd,
+		0x9c, 0xcc, 0xc6, 0xd0, 0x09, 0x26, 0x41, 0x6f, 0xf8, 0x64, 0x93, 0xa7, 0xcf, 0xd7, 0x20, 0xce,
+		0x9a, 0x6a, 0x91, 0xa7, 0x21, 0xda, 0xc3, 0x1f, 0x3c, 0x50, 0x7d, 0x62, 0xec, 0xfc, 0xa2, 0xf0,
+		0x5e, 0x69, 0x64, 0x78, 0x85, 0x8f, 0x84, 0x00, 0x2a, 0x1b, 0x1b, 0x8d, 0x7e, 0xbb, 0xd7, 0xd1,
+		0xed, 0xb7, 0xaa, 0xef, 0xeb, 0x70, 0xd8, 0xed, 0xbb, 0xd5, 0x6f, 0x1e, 0xbc, 0xff, 0xb3, 0xee,
+		0xd5, 0xfa, 0x7e, 0x33, 0x90, 0x6c, 0xcb, 0xb2, 0x1d, 0xb2, 0xe1, 0x83, 0x93, 0xad, 0x58, 0xf6,
+		0x28, 0x67, 0x9f, 0x83, 0x94, 0xcb, 0x0c, 0x8b, 0xd4, 0x20, 0x61, 0xf3, 0xdf, 0x9c, 0xc1, 0x85,
+		0xf1, 0x0c, 0x16, 0x68, 0x5e, 0x26, 0x3b, 0xe8, 0xb8, 0x23, 0x0b, 0x9e, 0xf9, 0xf8, 0xf6, 0x94,
+		0x31, 0x8c, 0xb8, 0xf2, 0xc9, 0x1b, 0xbe, 0xe3, 0x97, 0x5b, 0xad, 0xe1, 0x29, 0xfa, 0xd1, 0x10,
+		0xcc, 0xae, 0x0b, 0x4d, 0xf1, 0xf6, 0xe7, 0xc1, 0x3a, 0xc4, 0x75, 0xc3, 0xee, 0xb7, 0x9d, 0x8d,
+		0x96, 0x87, 0xc7, 0x8d, 0x79, 0x40, 0xa7, 0xaa, 0x86, 0xdd, 0xdf, 0xf3, 0x4a, 0x80, 0xa0, 0xe5,
+		0xe1, 0xc7, 0x27, 0xc2, 0x90, 0x1f, 0x87, 0x8a, 0xef, 0x1c, 0x35, 0xfb, 0x3a, 0xcd, 0xf0, 0x5f,
+		0x1c, 0xcc, 0x8a, 0x6c, 0x6e, 0xe2, 0x14, 0x40, 0x17, 0x14, 0x85, 0x0b, 0x41, 0xef, 0xcc, 0xe7,
+		0xcc, 0xba, 0x14, 0xa8, 0x91, 0x6b, 0x40, 0x4e, 0xdc, 0x46, 0xd8, 0xd4, 0x3a, 0x1a, 0xde, 0xb6,
+		0x0e, 0x1f, 0xdc, 0xbf, 0x10, 0x37, 0x1a, 0x4a, 0x8c, 0x04, 0xa9, 0x42, 0x5c, 0x50, 0x8b, 0x1c,
+		0x9c, 0x9a, 0xc0, 0xc5, 0x7b, 0x27, 0x5e, 0x23, 0x44, 0x3d, 0x9f, 0x88, 0x92, 0x72, 0xf2, 0x6a,
+		0xad, 0x49, 0x56, 0x2e, 0xb6, 0xaf, 0x95, 0xe3, 0xce, 0xe5, 0x0f, 0x86, 0x61, 0x46, 0xd1, 0x5b,
+		0x7f, 0xfb, 0x87, 0x65, 0x0d, 0x80, 0x4d, 0x55, 0xd4, 0xa4, 0xf9, 0xc8, 0x9d, 0xce, 0xf7, 0x24,
+		0x23, 0x52, 0xb1, 0xec, 0xbf, 0xa9, 0x11, 0xfa, 0x83, 0x10, 0xa4, 0xbd, 0x23, 0xf4, 0x77, 0xd2,
+		0x68, 0x91, 0x15, 0x57, 0x4d, 0xb1, 0xfb, 0x14, 0x0f, 0x8e, 0x53, 0x53, 0x23, 0xd2, 0x3c, 0x41,
+		0x3f, 0xfd, 0x41, 0x1c, 0x62, 0xfc, 0xac, 0xd2, 0xea, 0x88, 0x1f, 0x3d, 0xf1, 0xd6, 0x78, 0x46,
+		0x5c, 0xbc, 0x0f, 0x74, 0xa3, 0xef, 0x03, 0xbc, 0x8e, 0xaf, 0xfa, 0x0e, 0x40, 0x61, 0x84, 0x1d,
+		0x17, 0xf1, 0x4e, 0xef, 0x2d, 0x3c, 0x04, 0x86, 0x60, 0xae, 0x1e, 0x46, 0x18, 0xbc, 0xbb, 0x52,
+		0x65, 0x39, 0xe4, 0x2c, 0x90, 0x1d, 0x27, 0x24, 0xa3, 0xba, 0x8c, 0x40, 0xb8, 0x19, 0xb7, 0x44,
+		0x80, 0xe3, 0x23, 0x3a, 0xa6, 0xd1, 0x52, 0xd9, 0x63, 0xe5, 0xfc, 0x29, 0x78, 0xcc, 0xa9, 0x60,
+		0x06, 0xf9, 0xa0, 0xc4, 0xdc, 0xf1, 0xa1, 0x18, 0x01, 0x5f, 0x0d, 0x35, 0xa6, 0x98, 0x14, 0x7f,
+		0xfd, 0xea, 0xc9, 0xf9, 0x3d, 0xad, 0xdb, 0x59, 0x2a, 0x04, 0xd0, 0x29, 0x04, 0x85, 0x2d, 0xd0,
+		0x49, 0xf7, 0xc7, 0x18, 0x48, 0x0d, 0x64, 0xdc, 0x6f, 0xeb, 0x9b, 0x36, 0x53, 0x34, 0x5b, 0xba,
+		0x78, 0xb8, 0xe1, 0xd8, 0x62, 0xc0, 0xd3, 0xf1, 0x8b, 0xf8, 0x04, 0x3b, 0xdf, 0x79, 0xc9, 0xde,
+		0xd0, 0xf7, 0x14, 0x8e, 0x77, 0x59, 0xd7, 0xc9, 0x7b, 0xe0, 0x94, 0xdd, 0x1f, 0x18, 0x4d, 0x46,
+		0xa8, 0x35, 0xb0, 0x6c, 0x3c, 0x46, 0x8d, 0xed, 0x1a, 0x18, 0xb8, 0xde, 0xef, 0x99, 0x66, 0x87,
+		0x2e, 0x9e, 0x12, 0x63, 0xd6, 0xdc, 0x77, 0xbb, 0xe8, 0x95, 0x81, 0x65, 0x37, 0xcc, 0xb2, 0xc0,
+		0x5d, 0x33, 0xcd, 0x0e, 0xf9, 0x10, 0x7e, 0xd9, 0xa8, 0x63, 0x6e, 0xe2, 0x5d, 0x21, 0xee, 0xf4,
+		0x33, 0x7c, 0xb5, 0xa9, 0xf5, 0xe8, 0x62, 0x2b, 0x59, 0xba, 0xf6, 0x96, 0x78, 0xfe, 0x8c, 0x5b,
+		0x47, 0x58, 0x65, 0xcb, 0x6c, 0x11, 0xc0, 0x00, 0xca, 0x5a, 0x8f, 0x7c, 0x44, 0x82, 0xbb, 0xdc,
+		0xd9, 0x15, 0xd0, 0x14, 0x78, 0xcb, 0x9b, 0x72, 0xcc, 0xa9, 0x6f, 0xa4, 0x35, 0xef, 0xf7, 0x4e,
+		0x75, 0x2a, 0x70, 0x5b, 0x5a, 0x13, 0x77, 0xd0, 0x52, 0x6f, 0x79, 0x2b, 0x5c, 0x9d, 0x80, 0xe1,
+		0x81, 0xcb, 0xb4, 0x9a, 0xa5, 0x7b, 0x51, 0x3f, 0xbe, 0xf4, 0xda, 0xcf, 0x9e, 0x39, 0xee, 0x79,
+		0xfc, 0xde, 0xc1, 0x3f, 0xc7, 0x26, 0x35, 0x2e, 0x75, 0x88, 0xeb, 0x76, 0x78, 0x8e, 0x39, 0x82,
+		0x67, 0x25, 0x2a, 0xed, 0xbf, 0xc2, 0x75, 0xf1, 0x7d, 0x2b, 0x5c, 0x8f, 0x52, 0x7e, 0xca, 0xb5,
+		0xfa, 0xa1, 0x49, 0x32, 0xec, 0xd5, 0x47, 0x1c, 0x89, 0xea, 0xfa, 0x43, 0x85, 0x7f, 0x2f, 0xc1,
+		0xb1, 0x11, 0xfd, 0xe5, 0x34, 0xb9, 0x09, 0xa4, 0xef, 0x29, 0xa4, 0x7a, 0x40, 0xbc, 0x2d, 0x7d,
+		0x67, 0xea, 0x70, 0xa6, 0x3f, 0x5c, 0xfa, 0x16, 0xb9, 0x2f, 0xdc, 0x76, 0xfd, 0xa6, 0x04, 0x73,
+		0xde, 0x06, 0x38, 0x5d, 0xa9, 0x43, 0xda, 0x5b, 0x75, 0x5e, 0x72, 0x4e, 0x2f, 0x4f, 0xec, 0x84,
+		0xb7, 0xfd, 0x3e, 0x22, 0x64, 0xc3, 0xb5, 0x11, 0x2c, 0xd2, 0x7c, 0x7e, 0x6a, 0xa6, 0x88, 0x86,
+		0x05, 0xda, 0x0a, 0x36, 0x36, 0x7f, 0x2a, 0x41, 0x84, 0x2a, 0x83, 0xf7, 0xc2, 0x8c, 0x61, 0xda,
+		0x54, 0xe0, 0xf5, 0x96, 0xca, 0x03, 0x53, 0x92, 0x6f, 0xf5, 0x1f, 0xcc, 0xab, 0x3f, 0x79, 0xf5,
+		0xe4, 0x28, 0x66, 0xd0, 0x07, 0x28, 0x72, 0x86, 0x69, 0x97, 0x28, 0x50, 0x83, 0xc2, 0x90, 0x2d,
+		0xc8, 0xf8, 0xab, 0x63, 0x36, 0xba, 0x38, 0xa9, 0xba, 0xcc, 0xc4, 0xaa, 0xd2, 0x9b, 0x9e, 0x7a,
+		0xd8, 0x63, 0xfa, 0x7f, 0x89, 0x23, 0xf7, 0x1e, 0x90, 0x37, 0x86, 0xcf, 0x51, 0x5d, 0x86, 0xb8,
+		0x38, 0x37, 0x25, 0x4d, 0x7b, 0x26, 0xcb, 0xcb, 0x4f, 0x8e, 0x4c, 0x43, 0xf9, 0x9f, 0x0d, 0xc1,
+		0x31, 0x3c, 0x62, 0xc5, 0xc3, 0x88, 0x5c, 0x97, 0xb3, 0x7d, 0x87, 0x3d, 0x8c, 0x7d, 0x05, 0x06,
+		0x39, 0xd3, 0xa3, 0xa1, 0xcc, 0x0d, 0xc8, 0xa1, 0x53, 0x85, 0x61, 0xc8, 0x37, 0x17, 0xc9, 0xcc,
+		0x98, 0x9d, 0x16, 0x6f, 0x11, 0xc6, 0x31, 0x37, 0x20, 0x67, 0xe8, 0x37, 0x7d, 0x74, 0xc3, 0x77,
+		0x46, 0xd7, 0xd0, 0x6f, 0x7a, 0xe8, 0xba, 0x9b, 0xf3, 0x11, 0xdf, 0xe6, 0xfc, 0x45, 0x08, 0xa3,
+		0x01, 0x8c, 0x1e, 0x40, 0x79, 0x20, 0x82, 0xc7, 0x91, 0xa9, 0xc3, 0x31, 0x1e, 0x1b, 0xb2, 0x56,
+		0xb7, 0x28, 0x47, 0x75, 0xda, 0xa1, 0x67, 0xf4, 0xbd, 0x80, 0x40, 0x51, 0x7a, 0xba, 0x40, 0xd1,
+		0xef, 0x4a, 0x70, 0x78, 0xdd, 0xeb, 0xd3, 0xac, 0xee, 0xea, 0xfd, 0x7e, 0xbb, 0xa5, 0x93, 0x0b,
+		0x10, 0x9f, 0xd6, 0xf9, 0x14, 0x80, 0xf4, 0x32, 0x7d, 0x93, 0xfa, 0xbe, 0xec, 0x98, 0x0d, 0xff,
+		0x5c, 0x18, 0xcf, 0xa3, 0x9b, 0x90, 0xa3, 0x3e, 0x58, 0xf8, 0x4d, 0xf9, 0x60, 0x4b, 0x73, 0x9f,
+		0x0b, 0xb0, 0x2a, 0x67, 0x7e, 0x49, 0x02, 0x70, 0x23, 0xcd, 0xf8, 0xb0, 0x5e, 0x69, 0x75, 0xa5,
+		0xe2, 0xee, 0xbf, 0x7a, 0xbe, 0x2e, 0x26, 0x9e, 0xe1, 0xb3, 0x7a, 0x7a, 0xb3, 0xbd, 0xd5, 0xd6,
+		0x5b, 0xe4, 0x7e, 0x98, 0xf3, 0x43, 0x63, 0xaa, 0x5a, 0x91, 0xa5, 0xf9, 0xf4, 0x4b, 0xb7, 0x17,
+		0x12, 0x8c, 0x5f, 0x3a, 0xbe, 0x32, 0x7d, 0x78, 0x14, 0x0e, 0xbf, 0x4c, 0x16, 0x9a, 0xcf, 0xbc,
+		0x74, 0x7b, 0x21, 0xe9, 0x30, 0x96, 0x14, 0x80, 0x78, 0x21, 0x39, 0xbd, 0xf0, 0x3c, 0xbc, 0x74,
+		0x7b, 0x21, 0xc6, 0x54, 0x01, 0xdf, 0xb8, 0xfd, 0x46, 0x7c, 0x4c, 0x6e, 0xab, 0xaf, 0x35, 0xa9,
+		0xca, 0x9b, 0x87, 0x23, 0xb5, 0x95, 0xcb, 0x4a, 0xb1, 0xdc, 0xc0, 0xad, 0x5e, 0x5f, 0xb3, 0x87,
+		0xca, 0x2a, 0xab, 0xeb, 0xa5, 0xe5, 0xaa, 0x8a, 0xcf, 0xfb, 0xb1, 0x53, 0x16, 0xbe, 0xb2, 0x67,
+		0x57, 0x1a, 0xb5, 0xeb, 0x55, 0x39, 0x54, 0xba, 0x38, 0x76, 0x43, 0xf6, 0x2e, 0x9f, 0x92, 0x71,
+		0xcd, 0xac, 0xef, 0x6b, 0x33, 0xff, 0x7b, 0x00, 0x4e, 0xd7, 0x95, 0x51, 0xe3, 0xb7, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2356,6 +2573,12 @@ func (this *Description) Equal(that interface{}) bool {
 	if this.Details != that1.Details {
 		return false
 	}
+	if this.WebsiteProof != that1.WebsiteProof {
+		return false
+	}
+	if this.PgpProof != that1.PgpProof {
+		return false
+	}
 	return true
 }
 func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
@@ -2476,6 +2699,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if this.TruncationDustToCommunityPool != that1.TruncationDustToCommunityPool {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2693,6 +2928,20 @@ func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PgpProof) > 0 {
+		i -= len(m.PgpProof)
+		copy(dAtA[i:], m.PgpProof)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PgpProof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.WebsiteProof) > 0 {
+		i -= len(m.WebsiteProof)
+		copy(dAtA[i:], m.WebsiteProof)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.WebsiteProof)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Details) > 0 {
 		i -= len(m.Details)
 		copy(dAtA[i:], m.Details)
@@ -2751,6 +3000,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if len(m.UnbondingIds) > 0 {
 		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
 		var j4 int
@@ -3369,6 +3628,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TruncationDustToCommunityPool {
+		i--
+		if m.TruncationDustToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3736,6 +4035,51 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *UnbondingTimeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingTimeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingTimeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintStaking(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3818,6 +4162,14 @@ func (m *Description) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = len(m.WebsiteProof)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PgpProof)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -3866,6 +4218,8 @@ func (m *Validator) Size() (n int) {
 		}
 		n += 1 + sovStaking(uint64(l)) + l
 	}
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4094,6 +4448,15 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.TruncationDustToCommunityPool {
+		n += 2
+	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4209,6 +4572,25 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
+func (m *UnbondingTimeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4789,6 +5171,70 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			}
 			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebsiteProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PgpProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PgpProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5261,6 +5707,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6902,6 +7382,128 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncationDustToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TruncationDustToCommunityPool = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7765,6 +8367,153 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnbondingTimeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingTimeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingTimeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -289,6 +289,10 @@ type MsgBeginRedelegate struct {
 	ValidatorSrcAddress string     `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
 	ValidatorDstAddress string     `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
 	Amount              types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// shares_percentage is the fraction, in (0, 1], of the delegation shares to
+	// redelegate. When set, amount must be left empty and the shares are computed
+	// against the delegation at execution time.
+	SharesPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=shares_percentage,json=sharesPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares_percentage"`
 }
 
 func (m *MsgBeginRedelegate) Reset()         { *m = MsgBeginRedelegate{} }
@@ -375,6 +379,10 @@ type MsgUndelegate struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// shares_percentage is the fraction, in (0, 1], of the delegation shares to
+	// unbond. When set, amount must be left empty and the shares are computed
+	// against the delegation at execution time.
+	SharesPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=shares_percentage,json=sharesPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares_percentage"`
 }
 
 func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
@@ -716,6 +724,307 @@ func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
 
+// MsgSetUnbondingTimeOverride is the Msg/SetUnbondingTimeOverride request type.
+type MsgSetUnbondingTimeOverride struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// override defines the unbonding time override to set, replacing any
+	// existing override for the same delegator or account type.
+	Override UnbondingTimeOverride `protobuf:"bytes,2,opt,name=override,proto3" json:"override"`
+}
+
+func (m *MsgSetUnbondingTimeOverride) Reset()         { *m = MsgSetUnbondingTimeOverride{} }
+func (m *MsgSetUnbondingTimeOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnbondingTimeOverride) ProtoMessage()    {}
+func (*MsgSetUnbondingTimeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{16}
+}
+func (m *MsgSetUnbondingTimeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnbondingTimeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnbondingTimeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnbondingTimeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnbondingTimeOverride.Merge(m, src)
+}
+func (m *MsgSetUnbondingTimeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnbondingTimeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnbondingTimeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnbondingTimeOverride proto.InternalMessageInfo
+
+func (m *MsgSetUnbondingTimeOverride) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetUnbondingTimeOverride) GetOverride() UnbondingTimeOverride {
+	if m != nil {
+		return m.Override
+	}
+	return UnbondingTimeOverride{}
+}
+
+// MsgSetUnbondingTimeOverrideResponse defines the response structure for
+// executing a MsgSetUnbondingTimeOverride message.
+type MsgSetUnbondingTimeOverrideResponse struct {
+}
+
+func (m *MsgSetUnbondingTimeOverrideResponse) Reset()         { *m = MsgSetUnbondingTimeOverrideResponse{} }
+func (m *MsgSetUnbondingTimeOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnbondingTimeOverrideResponse) ProtoMessage()    {}
+func (*MsgSetUnbondingTimeOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{17}
+}
+func (m *MsgSetUnbondingTimeOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnbondingTimeOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnbondingTimeOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnbondingTimeOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnbondingTimeOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetUnbondingTimeOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnbondingTimeOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnbondingTimeOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnbondingTimeOverrideResponse proto.InternalMessageInfo
+
+// MsgRemoveUnbondingTimeOverride is the Msg/RemoveUnbondingTimeOverride request type.
+type MsgRemoveUnbondingTimeOverride struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the delegator address of the override to remove.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// account_type is the account type URL of the override to remove.
+	AccountType string `protobuf:"bytes,3,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+}
+
+func (m *MsgRemoveUnbondingTimeOverride) Reset()         { *m = MsgRemoveUnbondingTimeOverride{} }
+func (m *MsgRemoveUnbondingTimeOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveUnbondingTimeOverride) ProtoMessage()    {}
+func (*MsgRemoveUnbondingTimeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{18}
+}
+func (m *MsgRemoveUnbondingTimeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveUnbondingTimeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveUnbondingTimeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveUnbondingTimeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUnbondingTimeOverride.Merge(m, src)
+}
+func (m *MsgRemoveUnbondingTimeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveUnbondingTimeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUnbondingTimeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveUnbondingTimeOverride proto.InternalMessageInfo
+
+func (m *MsgRemoveUnbondingTimeOverride) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveUnbondingTimeOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRemoveUnbondingTimeOverride) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+// MsgRemoveUnbondingTimeOverrideResponse defines the response structure for
+// executing a MsgRemoveUnbondingTimeOverride message.
+type MsgRemoveUnbondingTimeOverrideResponse struct {
+}
+
+func (m *MsgRemoveUnbondingTimeOverrideResponse) Reset() {
+	*m = MsgRemoveUnbondingTimeOverrideResponse{}
+}
+func (m *MsgRemoveUnbondingTimeOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveUnbondingTimeOverrideResponse) ProtoMessage()    {}
+func (*MsgRemoveUnbondingTimeOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{19}
+}
+func (m *MsgRemoveUnbondingTimeOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveUnbondingTimeOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveUnbondingTimeOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveUnbondingTimeOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveUnbondingTimeOverrideResponse.Merge(m, src)
+}
+func (m *MsgRemoveUnbondingTimeOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveUnbondingTimeOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveUnbondingTimeOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveUnbondingTimeOverrideResponse proto.InternalMessageInfo
+
+// MsgSetLiquidStaker is the Msg/SetLiquidStaker request type.
+type MsgSetLiquidStaker struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the address of the account to register or unregister.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// liquid_staker defines whether the account is registered as a liquid
+	// staking provider.
+	LiquidStaker bool `protobuf:"varint,3,opt,name=liquid_staker,json=liquidStaker,proto3" json:"liquid_staker,omitempty"`
+}
+
+func (m *MsgSetLiquidStaker) Reset()         { *m = MsgSetLiquidStaker{} }
+func (m *MsgSetLiquidStaker) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLiquidStaker) ProtoMessage()    {}
+func (*MsgSetLiquidStaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{20}
+}
+func (m *MsgSetLiquidStaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLiquidStaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLiquidStaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLiquidStaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLiquidStaker.Merge(m, src)
+}
+func (m *MsgSetLiquidStaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLiquidStaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLiquidStaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLiquidStaker proto.InternalMessageInfo
+
+func (m *MsgSetLiquidStaker) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetLiquidStaker) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetLiquidStaker) GetLiquidStaker() bool {
+	if m != nil {
+		return m.LiquidStaker
+	}
+	return false
+}
+
+// MsgSetLiquidStakerResponse defines the response structure for executing a
+// MsgSetLiquidStaker message.
+type MsgSetLiquidStakerResponse struct {
+}
+
+func (m *MsgSetLiquidStakerResponse) Reset()         { *m = MsgSetLiquidStakerResponse{} }
+func (m *MsgSetLiquidStakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLiquidStakerResponse) ProtoMessage()    {}
+func (*MsgSetLiquidStakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{21}
+}
+func (m *MsgSetLiquidStakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLiquidStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLiquidStakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetLiquidStakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLiquidStakerResponse.Merge(m, src)
+}
+func (m *MsgSetLiquidStakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLiquidStakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLiquidStakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetLiquidStakerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -733,92 +1042,116 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKey")
 	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKeyResponse")
+	proto.RegisterType((*MsgSetUnbondingTimeOverride)(nil), "cosmos.staking.v1beta1.MsgSetUnbondingTimeOverride")
+	proto.RegisterType((*MsgSetUnbondingTimeOverrideResponse)(nil), "cosmos.staking.v1beta1.MsgSetUnbondingTimeOverrideResponse")
+	proto.RegisterType((*MsgRemoveUnbondingTimeOverride)(nil), "cosmos.staking.v1beta1.MsgRemoveUnbondingTimeOverride")
+	proto.RegisterType((*MsgRemoveUnbondingTimeOverrideResponse)(nil), "cosmos.staking.v1beta1.MsgRemoveUnbondingTimeOverrideResponse")
+	proto.RegisterType((*MsgSetLiquidStaker)(nil), "cosmos.staking.v1beta1.MsgSetLiquidStaker")
+	proto.RegisterType((*MsgSetLiquidStakerResponse)(nil), "cosmos.staking.v1beta1.MsgSetLiquidStakerResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0x09, 0x29, 0x43, 0xc1, 0xb0, 0x40, 0x62, 0x16, 0x6a, 0xd3, 0x0d, 0x15, 0x94,
-	0xca, 0x6b, 0x20, 0x01, 0x54, 0x27, 0xaa, 0x82, 0x21, 0x6d, 0xd3, 0x96, 0x16, 0x2d, 0x21, 0x95,
-	0xaa, 0xb6, 0xee, 0x78, 0x77, 0x58, 0x56, 0x78, 0x77, 0x9c, 0x9d, 0x31, 0x89, 0x0f, 0x95, 0xaa,
-	0x9e, 0x9a, 0x9e, 0x72, 0xaf, 0x2a, 0xa5, 0x52, 0x2b, 0xf5, 0xc8, 0x81, 0x63, 0x7f, 0x40, 0x94,
-	0x53, 0x44, 0x2f, 0x51, 0x0e, 0xb4, 0x82, 0x03, 0xfd, 0x0f, 0xb9, 0x54, 0xbb, 0x3b, 0x5e, 0x7b,
-	0xd7, 0xde, 0xc5, 0xd0, 0xe6, 0x92, 0x4b, 0x62, 0xde, 0x7c, 0xf3, 0xcd, 0xcc, 0xf7, 0xbe, 0x79,
-	0xf3, 0x16, 0x64, 0x14, 0x4c, 0x0c, 0x4c, 0x72, 0x84, 0xc2, 0x6d, 0xdd, 0xd4, 0x72, 0x3b, 0xb3,
-	0x25, 0x44, 0xe1, 0x6c, 0x8e, 0xde, 0x97, 0x2a, 0x16, 0xa6, 0x98, 0xbf, 0xe8, 0x02, 0x24, 0x06,
-	0x90, 0x18, 0x40, 0x18, 0xd1, 0x30, 0xd6, 0xca, 0x28, 0xe7, 0xa0, 0x4a, 0xd5, 0xcd, 0x1c, 0x34,
-	0x6b, 0xee, 0x14, 0x21, 0x13, 0x1c, 0xa2, 0xba, 0x81, 0x08, 0x85, 0x46, 0x85, 0x01, 0x86, 0x34,
-	0xac, 0x61, 0xe7, 0x67, 0xce, 0xfe, 0xc5, 0xa2, 0x23, 0xee, 0x4a, 0x45, 0x77, 0x80, 0x2d, 0xeb,
-	0x0e, 0xa5, 0xd9, 0x2e, 0x4b, 0x90, 0x20, 0x6f, 0x8b, 0x0a, 0xd6, 0x4d, 0x36, 0x3e, 0x11, 0x72,
-	0x8a, 0xfa, 0xa6, 0x5d, 0xd4, 0x25, 0x86, 0x32, 0x88, 0x8d, 0xb0, 0xff, 0x63, 0x03, 0x03, 0xd0,
-	0xd0, 0x4d, 0x9c, 0x73, 0xfe, 0x75, 0x43, 0xe2, 0x8b, 0x73, 0x80, 0x5f, 0x25, 0xda, 0xb2, 0x85,
-	0x20, 0x45, 0x77, 0x60, 0x59, 0x57, 0x21, 0xc5, 0x16, 0xbf, 0x06, 0x7a, 0x54, 0x44, 0x14, 0x4b,
-	0xaf, 0x50, 0x1d, 0x9b, 0x29, 0x6e, 0x9c, 0x9b, 0xea, 0x99, 0xbb, 0x2c, 0xb5, 0xd7, 0x48, 0x5a,
-	0x69, 0x40, 0x0b, 0xdd, 0x8f, 0x0f, 0x32, 0xb1, 0xdf, 0x8f, 0x77, 0xa7, 0x39, 0xb9, 0x99, 0x82,
-	0x97, 0x01, 0x50, 0xb0, 0x61, 0xe8, 0x84, 0xd8, 0x84, 0x71, 0x87, 0x70, 0x32, 0x8c, 0x70, 0xd9,
-	0x43, 0xca, 0x90, 0x22, 0xd2, 0x4c, 0xda, 0xc4, 0xc2, 0x7f, 0x03, 0x06, 0x0d, 0xdd, 0x2c, 0x12,
-	0x54, 0xde, 0x2c, 0xaa, 0xa8, 0x8c, 0x34, 0xe8, 0xec, 0x36, 0x31, 0xce, 0x4d, 0x75, 0x17, 0x66,
-	0xec, 0x39, 0xcf, 0x0f, 0x32, 0xc3, 0xee, 0x1a, 0x44, 0xdd, 0x96, 0x74, 0x9c, 0x33, 0x20, 0xdd,
-	0x92, 0x6e, 0x99, 0x74, 0x7f, 0x2f, 0x0b, 0xd8, 0xe2, 0xb7, 0x4c, 0xea, 0x52, 0x0f, 0x18, 0xba,
-	0xb9, 0x8e, 0xca, 0x9b, 0x2b, 0x1e, 0x15, 0xff, 0x01, 0x18, 0x60, 0xc4, 0xd8, 0x2a, 0x42, 0x55,
-	0xb5, 0x10, 0x21, 0xa9, 0x73, 0x0e, 0xbf, 0xb0, 0xbf, 0x97, 0x1d, 0x62, 0x14, 0x4b, 0xee, 0xc8,
-	0x3a, 0xb5, 0x74, 0x53, 0x4b, 0x71, 0x72, 0xbf, 0x37, 0x89, 0x8d, 0xf0, 0x9f, 0x82, 0x81, 0x9d,
-	0xba, 0xba, 0x1e, 0xd1, 0x79, 0x87, 0xe8, 0xcd, 0xfd, 0xbd, 0xec, 0x1b, 0x8c, 0xc8, 0xcb, 0x80,
-	0x8f, 0x51, 0xee, 0xdf, 0x09, 0xc4, 0xf9, 0xf7, 0x41, 0x57, 0xa5, 0x5a, 0xda, 0x46, 0xb5, 0x54,
-	0x97, 0x23, 0xe5, 0x90, 0xe4, 0x9a, 0x51, 0xaa, 0x9b, 0x51, 0x5a, 0x32, 0x6b, 0x85, 0xd4, 0x93,
-	0xc6, 0x1e, 0x15, 0xab, 0x56, 0xa1, 0x58, 0x5a, 0xab, 0x96, 0x3e, 0x46, 0x35, 0x99, 0xcd, 0xe6,
-	0xf3, 0xe0, 0xfc, 0x0e, 0x2c, 0x57, 0x51, 0xea, 0x82, 0x43, 0x33, 0x52, 0xcf, 0x88, 0xed, 0xc0,
-	0xa6, 0x74, 0xe8, 0xbe, 0xc4, 0xba, 0x53, 0xf2, 0x37, 0x7e, 0x78, 0x94, 0x89, 0xfd, 0xf3, 0x28,
-	0x13, 0xfb, 0xfe, 0x78, 0x77, 0xba, 0xf5, 0x78, 0x3f, 0x1e, 0xef, 0x4e, 0xb3, 0x73, 0x65, 0x89,
-	0xba, 0x9d, 0x6b, 0xb5, 0x99, 0x38, 0x06, 0x84, 0xd6, 0xa8, 0x8c, 0x48, 0x05, 0x9b, 0x04, 0x89,
-	0xbf, 0x25, 0x40, 0xff, 0x2a, 0xd1, 0x6e, 0xaa, 0x3a, 0x7d, 0x99, 0xce, 0x6c, 0x9b, 0x9a, 0xf8,
-	0xd9, 0x53, 0x73, 0x07, 0x24, 0x1b, 0x1e, 0x2d, 0x5a, 0x90, 0x22, 0xe6, 0xc8, 0xec, 0xf3, 0x83,
-	0xcc, 0x68, 0xab, 0x1b, 0x3f, 0x41, 0x1a, 0x54, 0x6a, 0x2b, 0x48, 0x69, 0xf2, 0xe4, 0x0a, 0x52,
-	0xe4, 0x3e, 0xc5, 0x77, 0x0b, 0xf8, 0xcf, 0xdb, 0xbb, 0xdd, 0x75, 0xe3, 0x64, 0x87, 0x4e, 0x6f,
-	0x63, 0xf2, 0xfc, 0x7b, 0x27, 0xe7, 0x71, 0xd4, 0x9f, 0x47, 0x5f, 0x4a, 0x44, 0x01, 0xa4, 0x82,
-	0x31, 0x2f, 0x87, 0x3f, 0xc7, 0x41, 0xcf, 0x2a, 0xd1, 0xd8, 0x6a, 0x88, 0xbf, 0xd9, 0xee, 0x42,
-	0x71, 0xce, 0x11, 0x52, 0x61, 0x17, 0xaa, 0xd3, 0xeb, 0xf4, 0x1f, 0x72, 0x76, 0x1d, 0x74, 0x41,
-	0x03, 0x57, 0x4d, 0xea, 0xa4, 0xaa, 0xd3, 0x7b, 0xc0, 0xe6, 0xe4, 0xdf, 0xf5, 0x09, 0xd8, 0x72,
-	0x3e, 0x5b, 0xc0, 0x8b, 0x7e, 0x01, 0xeb, 0x7a, 0x88, 0xc3, 0x60, 0xb0, 0xe9, 0x4f, 0x4f, 0xb6,
-	0x07, 0x09, 0xa7, 0x2c, 0x17, 0x90, 0xa6, 0x9b, 0x32, 0x52, 0xff, 0x67, 0xf5, 0x36, 0xc0, 0x70,
-	0x43, 0x3d, 0x62, 0x29, 0xa7, 0x57, 0x70, 0xd0, 0x9b, 0xbf, 0x6e, 0x29, 0x6d, 0x69, 0x55, 0x42,
-	0x3d, 0xda, 0xc4, 0xe9, 0x69, 0x57, 0x08, 0x6d, 0xcd, 0xcd, 0xb9, 0x33, 0xe4, 0xe6, 0xc6, 0xc9,
-	0xb9, 0x09, 0x14, 0xa9, 0x80, 0xe8, 0x62, 0xc5, 0x29, 0x52, 0x81, 0x68, 0x3d, 0x53, 0xbc, 0xec,
-	0xdc, 0xf6, 0x4a, 0x19, 0xd9, 0x57, 0xa9, 0x68, 0x77, 0x00, 0xac, 0x26, 0x09, 0x2d, 0x15, 0xf9,
-	0x76, 0xbd, 0x3d, 0x28, 0xf4, 0xda, 0xfb, 0x7c, 0xf8, 0x57, 0x86, 0x73, 0xf7, 0xda, 0xd7, 0x60,
-	0xb0, 0x31, 0xe2, 0x2f, 0x71, 0xd0, 0xbb, 0x4a, 0xb4, 0x0d, 0x53, 0x7d, 0xa5, 0xaf, 0xcd, 0xb5,
-	0x93, 0x53, 0x93, 0xf2, 0xa7, 0xa6, 0xa1, 0x88, 0xf8, 0x07, 0x07, 0x86, 0x7d, 0x91, 0x97, 0x99,
-	0x11, 0xfe, 0x33, 0xef, 0xa0, 0xf1, 0x93, 0x0e, 0x3a, 0xe6, 0xf4, 0x1d, 0x7b, 0xd9, 0x64, 0x63,
-	0xeb, 0xe3, 0x33, 0xd2, 0xfc, 0x8c, 0xef, 0xec, 0xe2, 0x8b, 0x38, 0x18, 0xb3, 0x9f, 0x3e, 0x68,
-	0x2a, 0xa8, 0xbc, 0x61, 0x96, 0xb0, 0xa9, 0xea, 0xa6, 0xd6, 0xd4, 0x79, 0xbc, 0x8a, 0x19, 0xe7,
-	0x27, 0x41, 0x52, 0xb1, 0x1f, 0x7b, 0x3b, 0x31, 0x5b, 0x48, 0xd7, 0xb6, 0xdc, 0x3b, 0x9d, 0x90,
-	0xfb, 0xea, 0xe1, 0x0f, 0x9d, 0x68, 0xfe, 0xab, 0xba, 0x35, 0xf6, 0x83, 0x42, 0x5e, 0x5d, 0x08,
-	0x77, 0xcb, 0x64, 0xa0, 0xdb, 0x08, 0x13, 0x57, 0xbc, 0x06, 0x26, 0xa2, 0xc6, 0xeb, 0x56, 0xca,
-	0x0f, 0xb6, 0x59, 0x5e, 0x7c, 0xc6, 0x81, 0xa4, 0xed, 0xbc, 0x8a, 0x0a, 0x29, 0x5a, 0x83, 0x16,
-	0x34, 0x08, 0xbf, 0x00, 0xba, 0x61, 0x95, 0x6e, 0x61, 0x4b, 0xa7, 0xb5, 0x13, 0xb3, 0xd4, 0x80,
-	0xf2, 0x4b, 0xa0, 0xab, 0xe2, 0x30, 0x30, 0x5f, 0xa5, 0xc3, 0x1a, 0x19, 0x77, 0x1d, 0x9f, 0xa6,
-	0xee, 0xc4, 0xfc, 0x47, 0xad, 0x7b, 0x5c, 0xb4, 0x25, 0x6a, 0xac, 0x62, 0x4b, 0x33, 0xd1, 0x24,
-	0xcd, 0x7d, 0xef, 0xfb, 0x21, 0x70, 0x0c, 0x51, 0x02, 0x97, 0x02, 0xa1, 0x28, 0x29, 0x16, 0xc5,
-	0x9f, 0xe2, 0xce, 0xf3, 0x25, 0x63, 0x0a, 0x29, 0x5a, 0xc6, 0x26, 0x71, 0xbb, 0xcb, 0xf6, 0xae,
-	0xe3, 0xce, 0xee, 0xba, 0xaf, 0x01, 0x30, 0xd1, 0xbd, 0x22, 0xeb, 0x78, 0xe3, 0x11, 0x1d, 0xef,
-	0xdb, 0x61, 0x1d, 0xef, 0xfe, 0x5e, 0xb6, 0x97, 0xc5, 0x59, 0x0b, 0xdc, 0x6d, 0xa2, 0x7b, 0x6b,
-	0x0e, 0x63, 0xfe, 0x76, 0xa8, 0xdd, 0xe6, 0x67, 0xc3, 0x9b, 0xa2, 0xb4, 0xdf, 0x6e, 0x41, 0x15,
-	0xc4, 0x39, 0x30, 0xda, 0x26, 0x1c, 0xa1, 0xe8, 0xfc, 0xec, 0xdc, 0x9f, 0x17, 0x40, 0x62, 0x95,
-	0x68, 0xfc, 0x5d, 0x90, 0x0c, 0x7e, 0x93, 0x4d, 0x87, 0x79, 0xa3, 0xb5, 0x85, 0x16, 0xe6, 0x3a,
-	0xc7, 0x7a, 0x75, 0x73, 0x1b, 0xf4, 0xfa, 0x5b, 0xed, 0xa9, 0x08, 0x12, 0x1f, 0x52, 0x98, 0xe9,
-	0x14, 0xe9, 0x2d, 0xf6, 0x25, 0x78, 0xcd, 0xeb, 0x09, 0x2f, 0x47, 0xcc, 0xae, 0x83, 0x84, 0x77,
-	0x3a, 0x00, 0x79, 0xec, 0x77, 0x41, 0x32, 0xd8, 0x3a, 0x45, 0xa9, 0x17, 0xc0, 0x46, 0xaa, 0x17,
-	0xd6, 0x07, 0x94, 0x00, 0x68, 0x7a, 0xaf, 0xdf, 0x8a, 0x60, 0x68, 0xc0, 0x84, 0x6c, 0x47, 0x30,
-	0x6f, 0x8d, 0x5f, 0x39, 0x30, 0x12, 0xfe, 0x62, 0x5c, 0x8d, 0xca, 0x79, 0xd8, 0x2c, 0xe1, 0xfa,
-	0x59, 0x66, 0x79, 0x7d, 0xea, 0xe0, 0x93, 0xd6, 0x02, 0xc9, 0x7f, 0x0b, 0x5e, 0xf7, 0x15, 0xc7,
-	0xc9, 0xa8, 0x53, 0x36, 0x01, 0x85, 0x5c, 0x87, 0xc0, 0xa8, 0xe5, 0x17, 0xf9, 0x07, 0x1c, 0xe8,
-	0x6f, 0xa9, 0x48, 0x51, 0xf6, 0x09, 0x82, 0x85, 0x2b, 0xa7, 0x00, 0x47, 0xec, 0x65, 0x7e, 0x56,
-	0x38, 0xff, 0x9d, 0x5d, 0xab, 0x0b, 0x0b, 0x8f, 0x0f, 0xd3, 0xdc, 0xd3, 0xc3, 0x34, 0xf7, 0xf7,
-	0x61, 0x9a, 0x7b, 0x78, 0x94, 0x8e, 0x3d, 0x3d, 0x4a, 0xc7, 0x9e, 0x1d, 0xa5, 0x63, 0x5f, 0x8c,
-	0xf9, 0xbe, 0xd9, 0x1a, 0x95, 0x99, 0xd6, 0x2a, 0x88, 0x94, 0xba, 0x9c, 0xda, 0x76, 0xe5, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xcb, 0xe0, 0xf9, 0xbe, 0x12, 0x00, 0x00,
+	// 1566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0xda, 0x21, 0x90, 0x09, 0xc1, 0xc9, 0x26, 0x01, 0x67, 0x13, 0xec, 0xb0, 0x01, 0x42,
+	0x53, 0x79, 0x9d, 0x18, 0x02, 0xaa, 0x83, 0x50, 0x09, 0xa1, 0x2d, 0x2d, 0x29, 0xd1, 0x26, 0x50,
+	0xa9, 0x6a, 0xeb, 0x8e, 0x77, 0x87, 0xcd, 0x2a, 0xf6, 0xae, 0xd9, 0x19, 0x1b, 0x7c, 0xa8, 0x54,
+	0xf5, 0xd2, 0x1f, 0x27, 0x2e, 0x3d, 0x55, 0x95, 0x50, 0xd5, 0x4a, 0x95, 0x7a, 0xc9, 0x21, 0xc7,
+	0xfe, 0x01, 0x88, 0x13, 0xca, 0x09, 0x71, 0xa0, 0x15, 0x1c, 0xa8, 0xd4, 0xff, 0xa0, 0x08, 0xa9,
+	0xda, 0xdd, 0xf1, 0x7a, 0x7f, 0xc7, 0x49, 0x49, 0x0f, 0x5c, 0xc0, 0xcc, 0x7c, 0xef, 0x7b, 0x33,
+	0xdf, 0xfb, 0x66, 0xe6, 0x2d, 0x20, 0x2b, 0xe9, 0xb8, 0xaa, 0xe3, 0x3c, 0x26, 0x70, 0x5d, 0xd5,
+	0x94, 0x7c, 0x63, 0xb6, 0x8c, 0x08, 0x9c, 0xcd, 0x93, 0x3b, 0x42, 0xcd, 0xd0, 0x89, 0xce, 0x1e,
+	0xb6, 0x01, 0x02, 0x05, 0x08, 0x14, 0xc0, 0x8d, 0x2a, 0xba, 0xae, 0x54, 0x50, 0xde, 0x42, 0x95,
+	0xeb, 0x37, 0xf3, 0x50, 0x6b, 0xda, 0x21, 0x5c, 0xd6, 0x3f, 0x45, 0xd4, 0x2a, 0xc2, 0x04, 0x56,
+	0x6b, 0x14, 0x30, 0xac, 0xe8, 0x8a, 0x6e, 0xfd, 0xcc, 0x9b, 0xbf, 0xe8, 0xe8, 0xa8, 0x9d, 0xa9,
+	0x64, 0x4f, 0xd0, 0xb4, 0xf6, 0x54, 0x86, 0xae, 0xb2, 0x0c, 0x31, 0x72, 0x96, 0x28, 0xe9, 0xaa,
+	0x46, 0xe7, 0x8f, 0x47, 0xec, 0xa2, 0xb5, 0x68, 0x1b, 0x75, 0x84, 0xa2, 0xaa, 0xd8, 0x44, 0x98,
+	0x7f, 0xd1, 0x89, 0x41, 0x58, 0x55, 0x35, 0x3d, 0x6f, 0xfd, 0x69, 0x0f, 0xf1, 0x2f, 0xba, 0x01,
+	0xbb, 0x84, 0x95, 0x4b, 0x06, 0x82, 0x04, 0xdd, 0x80, 0x15, 0x55, 0x86, 0x44, 0x37, 0xd8, 0x65,
+	0xd0, 0x27, 0x23, 0x2c, 0x19, 0x6a, 0x8d, 0xa8, 0xba, 0x96, 0x66, 0x26, 0x98, 0x53, 0x7d, 0x85,
+	0x49, 0x21, 0x5c, 0x23, 0x61, 0xb1, 0x0d, 0x5d, 0xe8, 0xbd, 0xff, 0x24, 0xdb, 0xf5, 0xeb, 0xf3,
+	0x8d, 0x69, 0x46, 0x74, 0x53, 0xb0, 0x22, 0x00, 0x92, 0x5e, 0xad, 0xaa, 0x18, 0x9b, 0x84, 0x09,
+	0x8b, 0x70, 0x2a, 0x8a, 0xf0, 0x92, 0x83, 0x14, 0x21, 0x41, 0xd8, 0x4d, 0xea, 0x62, 0x61, 0x3f,
+	0x07, 0x43, 0x55, 0x55, 0x2b, 0x61, 0x54, 0xb9, 0x59, 0x92, 0x51, 0x05, 0x29, 0xd0, 0x5a, 0x6d,
+	0x72, 0x82, 0x39, 0xd5, 0xbb, 0x30, 0x63, 0xc6, 0x3c, 0x7e, 0x92, 0x1d, 0xb1, 0x73, 0x60, 0x79,
+	0x5d, 0x50, 0xf5, 0x7c, 0x15, 0x92, 0x35, 0xe1, 0x8a, 0x46, 0xb6, 0x36, 0x73, 0x80, 0x26, 0xbf,
+	0xa2, 0x11, 0x9b, 0x7a, 0xb0, 0xaa, 0x6a, 0x2b, 0xa8, 0x72, 0x73, 0xd1, 0xa1, 0x62, 0xdf, 0x05,
+	0x83, 0x94, 0x58, 0x37, 0x4a, 0x50, 0x96, 0x0d, 0x84, 0x71, 0xba, 0xdb, 0xe2, 0xe7, 0xb6, 0x36,
+	0x73, 0xc3, 0x94, 0xe2, 0xa2, 0x3d, 0xb3, 0x42, 0x0c, 0x55, 0x53, 0xd2, 0x8c, 0x38, 0xe0, 0x04,
+	0xd1, 0x19, 0xf6, 0x43, 0x30, 0xd8, 0x68, 0xa9, 0xeb, 0x10, 0xed, 0xb3, 0x88, 0x8e, 0x6d, 0x6d,
+	0xe6, 0x8e, 0x52, 0x22, 0xa7, 0x02, 0x1e, 0x46, 0x71, 0xa0, 0xe1, 0x1b, 0x67, 0xdf, 0x01, 0x3d,
+	0xb5, 0x7a, 0x79, 0x1d, 0x35, 0xd3, 0x3d, 0x96, 0x94, 0xc3, 0x82, 0x6d, 0x46, 0xa1, 0x65, 0x46,
+	0xe1, 0xa2, 0xd6, 0x5c, 0x48, 0x3f, 0x68, 0xaf, 0x51, 0x32, 0x9a, 0x35, 0xa2, 0x0b, 0xcb, 0xf5,
+	0xf2, 0x07, 0xa8, 0x29, 0xd2, 0x68, 0xb6, 0x08, 0xf6, 0x35, 0x60, 0xa5, 0x8e, 0xd2, 0xfb, 0x2d,
+	0x9a, 0xd1, 0x56, 0x45, 0x4c, 0x07, 0xba, 0xca, 0xa1, 0x7a, 0x0a, 0x6b, 0x87, 0x14, 0xdf, 0xfe,
+	0xe6, 0x5e, 0xb6, 0xeb, 0xaf, 0x7b, 0xd9, 0xae, 0xaf, 0x9e, 0x6f, 0x4c, 0x07, 0xb7, 0xf7, 0xdd,
+	0xf3, 0x8d, 0x69, 0xba, 0xaf, 0x1c, 0x96, 0xd7, 0xf3, 0x41, 0x9b, 0xf1, 0xe3, 0x80, 0x0b, 0x8e,
+	0x8a, 0x08, 0xd7, 0x74, 0x0d, 0x23, 0xfe, 0x97, 0x24, 0x18, 0x58, 0xc2, 0xca, 0x65, 0x59, 0x25,
+	0x7b, 0xe9, 0xcc, 0xd0, 0xd2, 0x24, 0x76, 0x5f, 0x9a, 0x1b, 0x20, 0xd5, 0xf6, 0x68, 0xc9, 0x80,
+	0x04, 0x51, 0x47, 0xe6, 0x1e, 0x3f, 0xc9, 0x8e, 0x05, 0xdd, 0x78, 0x15, 0x29, 0x50, 0x6a, 0x2e,
+	0x22, 0xc9, 0xe5, 0xc9, 0x45, 0x24, 0x89, 0x87, 0x24, 0xcf, 0x29, 0x60, 0x3f, 0x0a, 0x77, 0xbb,
+	0xed, 0xc6, 0xa9, 0x0e, 0x9d, 0x1e, 0x62, 0xf2, 0xe2, 0x85, 0xed, 0xeb, 0x38, 0xe6, 0xad, 0xa3,
+	0xa7, 0x24, 0x3c, 0x07, 0xd2, 0xfe, 0x31, 0xa7, 0x86, 0x3f, 0x26, 0x40, 0xdf, 0x12, 0x56, 0x68,
+	0x36, 0xc4, 0x5e, 0x0e, 0x3b, 0x50, 0x8c, 0xb5, 0x85, 0x74, 0xd4, 0x81, 0xea, 0xf4, 0x38, 0xfd,
+	0x87, 0x9a, 0x9d, 0x07, 0x3d, 0xb0, 0xaa, 0xd7, 0x35, 0x92, 0x4e, 0xee, 0xe0, 0x1c, 0xd0, 0x98,
+	0xe2, 0x5b, 0x1e, 0x01, 0x03, 0xfb, 0x33, 0x05, 0x3c, 0xec, 0x15, 0xb0, 0xa5, 0x07, 0x3f, 0x02,
+	0x86, 0x5c, 0xff, 0x74, 0x64, 0x7b, 0x99, 0xb4, 0xae, 0xe5, 0x05, 0xa4, 0xa8, 0x9a, 0x88, 0xe4,
+	0x57, 0xac, 0xde, 0x75, 0x30, 0xd2, 0x56, 0x0f, 0x1b, 0xd2, 0xce, 0x15, 0x1c, 0x72, 0xe2, 0x57,
+	0x0c, 0x29, 0x94, 0x56, 0xc6, 0xc4, 0xa1, 0x4d, 0xee, 0x9c, 0x76, 0x11, 0x93, 0x60, 0x6d, 0xba,
+	0x77, 0x5e, 0x1b, 0xd6, 0x00, 0x83, 0x78, 0x0d, 0x1a, 0x08, 0x97, 0x6a, 0xc8, 0x90, 0x90, 0x46,
+	0xa0, 0x82, 0xe8, 0xc5, 0x7b, 0x99, 0xbe, 0x10, 0x9d, 0x9f, 0xc9, 0xc7, 0x9b, 0xb9, 0x81, 0x3b,
+	0xad, 0xd7, 0x76, 0xa2, 0x31, 0x2b, 0xcc, 0x08, 0x33, 0xe2, 0x80, 0xcd, 0xbf, 0xec, 0xd0, 0xfb,
+	0x2e, 0xc6, 0x50, 0x3f, 0xf8, 0x2e, 0x46, 0x5f, 0xa1, 0xf9, 0x1a, 0xe0, 0x82, 0xa3, 0x2d, 0x77,
+	0xb0, 0xa2, 0x75, 0xc3, 0xd4, 0x2a, 0xc8, 0x3c, 0xbe, 0x25, 0xb3, 0xeb, 0xa0, 0xf7, 0x20, 0x17,
+	0x78, 0x05, 0x56, 0x5b, 0x2d, 0xc9, 0x42, 0xbf, 0xb9, 0xdb, 0xbb, 0x7f, 0x64, 0x19, 0x5b, 0x9f,
+	0x43, 0x6d, 0x06, 0x13, 0xc3, 0x7f, 0x9f, 0x04, 0xfd, 0x4b, 0x58, 0xb9, 0xae, 0xc9, 0xaf, 0xf3,
+	0x51, 0x0d, 0xb7, 0x43, 0xf7, 0xde, 0xda, 0x61, 0x7e, 0x7b, 0x3b, 0xa4, 0xbd, 0x76, 0x68, 0x57,
+	0x81, 0xff, 0x9d, 0x01, 0x23, 0x9e, 0x91, 0xbd, 0x74, 0x01, 0x7b, 0xcd, 0x11, 0x37, 0xb1, 0x9d,
+	0xb8, 0xe3, 0x96, 0x5c, 0x9b, 0xb9, 0x54, 0x7b, 0xe9, 0x13, 0x33, 0xc2, 0xdc, 0x8c, 0x47, 0x6f,
+	0xfe, 0x45, 0x02, 0x8c, 0x9b, 0x4f, 0x3c, 0xd4, 0x24, 0x54, 0xb9, 0xae, 0x95, 0x75, 0x4d, 0x56,
+	0x35, 0xc5, 0xd5, 0x61, 0xbd, 0x96, 0x2e, 0x9b, 0x02, 0x29, 0xc9, 0x40, 0xd6, 0x06, 0x4b, 0x6b,
+	0x48, 0x55, 0xd6, 0xec, 0xbb, 0x2b, 0x29, 0x1e, 0x6a, 0x0d, 0xbf, 0x67, 0x8d, 0x16, 0x3f, 0x6d,
+	0x59, 0x63, 0xcb, 0x2f, 0xe4, 0x99, 0xb3, 0xd1, 0x6e, 0x99, 0xf2, 0x75, 0x55, 0x51, 0xe2, 0xf2,
+	0xf3, 0xe0, 0x78, 0xdc, 0x7c, 0xcb, 0x4a, 0xc5, 0xa1, 0x90, 0xf4, 0xfc, 0x23, 0x06, 0xa4, 0x4c,
+	0xe7, 0xd5, 0x64, 0x48, 0xd0, 0x32, 0x34, 0x60, 0x15, 0xb3, 0x67, 0x41, 0x2f, 0xac, 0x93, 0x35,
+	0xdd, 0x50, 0x49, 0x73, 0xdb, 0x2a, 0xb5, 0xa1, 0xec, 0x45, 0xd0, 0x53, 0xb3, 0x18, 0xa8, 0xaf,
+	0x32, 0x51, 0x0d, 0x9b, 0x9d, 0xc7, 0xa3, 0xa9, 0x1d, 0x58, 0x7c, 0x3f, 0xb8, 0xc6, 0x73, 0xa6,
+	0x44, 0xed, 0x2c, 0xa6, 0x34, 0xc7, 0x5d, 0xd2, 0x38, 0x47, 0x33, 0xef, 0xdb, 0x06, 0x2f, 0x80,
+	0x23, 0xbe, 0xa1, 0x38, 0x29, 0xce, 0xf1, 0x3f, 0x24, 0xac, 0x67, 0x5a, 0xd4, 0x09, 0x24, 0xe8,
+	0x92, 0xae, 0x61, 0xbb, 0x8b, 0x0e, 0x77, 0x1d, 0xb3, 0x7b, 0xd7, 0x7d, 0x06, 0x80, 0x86, 0x6e,
+	0x97, 0x68, 0x67, 0x9f, 0x88, 0xe9, 0xec, 0xdf, 0x88, 0xea, 0xec, 0xb7, 0x36, 0x73, 0xfd, 0x74,
+	0xdc, 0x1e, 0x10, 0x7b, 0x35, 0x74, 0x7b, 0xd9, 0x62, 0x2c, 0xae, 0x46, 0xda, 0x6d, 0x6e, 0x36,
+	0xba, 0xf9, 0xcb, 0x78, 0xed, 0xe6, 0x57, 0x81, 0x2f, 0x80, 0xb1, 0x90, 0xe1, 0x18, 0x45, 0xe7,
+	0x66, 0xf9, 0x7f, 0x18, 0x2b, 0x68, 0x05, 0x11, 0xc7, 0x97, 0xe6, 0x05, 0x74, 0xad, 0x81, 0x0c,
+	0x43, 0x95, 0xd1, 0xae, 0x8d, 0xb6, 0x0a, 0x0e, 0xe8, 0x94, 0x83, 0xea, 0x97, 0x8b, 0xb2, 0x5a,
+	0x68, 0x62, 0xb7, 0xf3, 0x1c, 0xa6, 0xe2, 0xd2, 0x56, 0xc8, 0x4d, 0x1f, 0x34, 0xdf, 0x49, 0xaf,
+	0x50, 0x51, 0x9b, 0xe3, 0xe7, 0xc1, 0x64, 0xcc, 0xb4, 0x23, 0xdc, 0x70, 0x58, 0x56, 0xfe, 0x25,
+	0x03, 0x32, 0xa6, 0xdc, 0xa8, 0xaa, 0x37, 0xd0, 0xab, 0x15, 0xaf, 0x00, 0xf6, 0x7b, 0xaf, 0xce,
+	0xe8, 0xa8, 0x16, 0x90, 0x3d, 0x06, 0x0e, 0x42, 0x49, 0x32, 0x6f, 0xbd, 0x12, 0x69, 0xd6, 0xe8,
+	0xa7, 0x8e, 0xd8, 0x47, 0xc7, 0x56, 0x9b, 0x35, 0x54, 0xbc, 0xda, 0x99, 0x7a, 0x27, 0x7c, 0x36,
+	0xf3, 0x6e, 0xd0, 0x11, 0xef, 0x02, 0x38, 0x19, 0xbf, 0xfd, 0x6d, 0xf4, 0xfb, 0x9b, 0xb1, 0x5a,
+	0xeb, 0x15, 0x44, 0xae, 0xaa, 0xb7, 0xea, 0xaa, 0xbc, 0x42, 0xe0, 0x3a, 0x32, 0xfe, 0x57, 0xcd,
+	0x26, 0x41, 0x7f, 0xc5, 0xca, 0x5d, 0xc2, 0x56, 0x72, 0x4b, 0xb4, 0x03, 0xe2, 0xc1, 0x8a, 0x6b,
+	0x41, 0xc5, 0xc5, 0xce, 0x54, 0x3b, 0x1a, 0xf0, 0x9c, 0x7b, 0x5b, 0x7c, 0x01, 0x70, 0xc1, 0xd1,
+	0x78, 0x85, 0x0a, 0x1b, 0x7d, 0x20, 0xb9, 0x84, 0x15, 0xf6, 0x16, 0x48, 0xf9, 0xff, 0x5f, 0x68,
+	0x3a, 0xea, 0x30, 0x05, 0x3f, 0xe3, 0xb9, 0x42, 0xe7, 0x58, 0xa7, 0xa7, 0x59, 0x07, 0xfd, 0xde,
+	0xcf, 0xfd, 0x53, 0x31, 0x24, 0x1e, 0x24, 0x37, 0xd3, 0x29, 0xd2, 0x49, 0xf6, 0x09, 0x38, 0xe0,
+	0x7c, 0x97, 0x4e, 0xc6, 0x44, 0xb7, 0x40, 0xdc, 0x9b, 0x1d, 0x80, 0x1c, 0xf6, 0x5b, 0x20, 0xe5,
+	0xff, 0x7c, 0x8b, 0x53, 0xcf, 0x87, 0xe5, 0x0a, 0x9d, 0x63, 0x9d, 0x94, 0x65, 0x00, 0x5c, 0xfd,
+	0xfb, 0x89, 0x18, 0x86, 0x36, 0x8c, 0xcb, 0x75, 0x04, 0x73, 0x72, 0xfc, 0xcc, 0x80, 0xd1, 0xe8,
+	0x6e, 0xee, 0x4c, 0x5c, 0xcd, 0xa3, 0xa2, 0xb8, 0xf3, 0xbb, 0x89, 0x72, 0xbe, 0x95, 0x87, 0x1e,
+	0x04, 0x9b, 0x17, 0xf6, 0x0b, 0x70, 0xd0, 0xd3, 0xb8, 0x4c, 0xc5, 0xed, 0xd2, 0x05, 0xe4, 0xf2,
+	0x1d, 0x02, 0xe3, 0xd2, 0x9f, 0x63, 0xbf, 0x65, 0xc0, 0x40, 0xa0, 0x5b, 0x88, 0xb3, 0x8f, 0x1f,
+	0xcc, 0x9d, 0xde, 0x01, 0x38, 0x66, 0x2d, 0x73, 0xb3, 0xec, 0x4f, 0x0c, 0x48, 0x47, 0xbe, 0xb3,
+	0x71, 0x69, 0xa2, 0x82, 0xb8, 0xf9, 0x5d, 0x04, 0x39, 0x6b, 0x1c, 0x7e, 0x10, 0x72, 0xe7, 0xb0,
+	0xbf, 0x31, 0x60, 0x2c, 0xf6, 0x49, 0x8b, 0x93, 0x23, 0x3a, 0x8e, 0xbb, 0xb0, 0xbb, 0xb8, 0x6d,
+	0x56, 0xfb, 0x35, 0x03, 0x52, 0xfe, 0x07, 0x64, 0x3a, 0x5e, 0x14, 0x37, 0x96, 0x2b, 0x74, 0x8e,
+	0x8d, 0x5f, 0x09, 0xb7, 0xef, 0x4b, 0xb3, 0x55, 0x59, 0x38, 0x7b, 0xff, 0x69, 0x86, 0x79, 0xf8,
+	0x34, 0xc3, 0xfc, 0xf9, 0x34, 0xc3, 0xdc, 0x7d, 0x96, 0xe9, 0x7a, 0xf8, 0x2c, 0xd3, 0xf5, 0xe8,
+	0x59, 0xa6, 0xeb, 0xe3, 0x71, 0xcf, 0xd7, 0x6c, 0xbb, 0x25, 0x36, 0x1f, 0x6b, 0x5c, 0xee, 0xb1,
+	0x9a, 0xca, 0xd3, 0xff, 0x0e, 0x00, 0x19, 0x6a, 0xbd, 0xf5, 0x1f, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -855,6 +1188,15 @@ type MsgClient interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// SetUnbondingTimeOverride defines an operation for setting the unbonding
+	// time of the undelegations of a given delegator or account type.
+	SetUnbondingTimeOverride(ctx context.Context, in *MsgSetUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgSetUnbondingTimeOverrideResponse, error)
+	// RemoveUnbondingTimeOverride defines an operation for removing an unbonding
+	// time override.
+	RemoveUnbondingTimeOverride(ctx context.Context, in *MsgRemoveUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgRemoveUnbondingTimeOverrideResponse, error)
+	// SetLiquidStaker defines an operation for registering or unregistering an
+	// account as a liquid staking provider.
+	SetLiquidStaker(ctx context.Context, in *MsgSetLiquidStaker, opts ...grpc.CallOption) (*MsgSetLiquidStakerResponse, error)
 }
 
 type msgClient struct {
@@ -937,6 +1279,33 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) SetUnbondingTimeOverride(ctx context.Context, in *MsgSetUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgSetUnbondingTimeOverrideResponse, error) {
+	out := new(MsgSetUnbondingTimeOverrideResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/SetUnbondingTimeOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveUnbondingTimeOverride(ctx context.Context, in *MsgRemoveUnbondingTimeOverride, opts ...grpc.CallOption) (*MsgRemoveUnbondingTimeOverrideResponse, error) {
+	out := new(MsgRemoveUnbondingTimeOverrideResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/RemoveUnbondingTimeOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLiquidStaker(ctx context.Context, in *MsgSetLiquidStaker, opts ...grpc.CallOption) (*MsgSetLiquidStakerResponse, error) {
+	out := new(MsgSetLiquidStakerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/SetLiquidStaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -961,6 +1330,15 @@ type MsgServer interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// SetUnbondingTimeOverride defines an operation for setting the unbonding
+	// time of the undelegations of a given delegator or account type.
+	SetUnbondingTimeOverride(context.Context, *MsgSetUnbondingTimeOverride) (*MsgSetUnbondingTimeOverrideResponse, error)
+	// RemoveUnbondingTimeOverride defines an operation for removing an unbonding
+	// time override.
+	RemoveUnbondingTimeOverride(context.Context, *MsgRemoveUnbondingTimeOverride) (*MsgRemoveUnbondingTimeOverrideResponse, error)
+	// SetLiquidStaker defines an operation for registering or unregistering an
+	// account as a liquid staking provider.
+	SetLiquidStaker(context.Context, *MsgSetLiquidStaker) (*MsgSetLiquidStakerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -991,6 +1369,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (*UnimplementedMsgServer) SetUnbondingTimeOverride(ctx context.Context, req *MsgSetUnbondingTimeOverride) (*MsgSetUnbondingTimeOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnbondingTimeOverride not implemented")
+}
+func (*UnimplementedMsgServer) RemoveUnbondingTimeOverride(ctx context.Context, req *MsgRemoveUnbondingTimeOverride) (*MsgRemoveUnbondingTimeOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUnbondingTimeOverride not implemented")
+}
+func (*UnimplementedMsgServer) SetLiquidStaker(ctx context.Context, req *MsgSetLiquidStaker) (*MsgSetLiquidStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLiquidStaker not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1140,6 +1527,60 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetUnbondingTimeOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnbondingTimeOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnbondingTimeOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/SetUnbondingTimeOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnbondingTimeOverride(ctx, req.(*MsgSetUnbondingTimeOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveUnbondingTimeOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveUnbondingTimeOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveUnbondingTimeOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/RemoveUnbondingTimeOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveUnbondingTimeOverride(ctx, req.(*MsgRemoveUnbondingTimeOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLiquidStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLiquidStaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLiquidStaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/SetLiquidStaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLiquidStaker(ctx, req.(*MsgSetLiquidStaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1176,6 +1617,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "SetUnbondingTimeOverride",
+			Handler:    _Msg_SetUnbondingTimeOverride_Handler,
+		},
+		{
+			MethodName: "RemoveUnbondingTimeOverride",
+			Handler:    _Msg_RemoveUnbondingTimeOverride_Handler,
+		},
+		{
+			MethodName: "SetLiquidStaker",
+			Handler:    _Msg_SetLiquidStaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1470,6 +1923,16 @@ func (m *MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SharesPercentage.Size()
+		i -= size
+		if _, err := m.SharesPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1555,6 +2018,16 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SharesPercentage.Size()
+		i -= size
+		if _, err := m.SharesPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1826,7 +2299,207 @@ func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+func (m *MsgSetUnbondingTimeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnbondingTimeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnbondingTimeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Override.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnbondingTimeOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnbondingTimeOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnbondingTimeOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveUnbondingTimeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUnbondingTimeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUnbondingTimeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveUnbondingTimeOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveUnbondingTimeOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveUnbondingTimeOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLiquidStaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLiquidStaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLiquidStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidStaker {
+		i--
+		if m.LiquidStaker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLiquidStakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLiquidStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLiquidStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
@@ -1955,6 +2628,8 @@ func (m *MsgBeginRedelegate) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.SharesPercentage.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1985,6 +2660,8 @@ func (m *MsgUndelegate) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.SharesPercentage.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -2082,6 +2759,89 @@ func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetUnbondingTimeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Override.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetUnbondingTimeOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveUnbondingTimeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveUnbondingTimeOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetLiquidStaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LiquidStaker {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetLiquidStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3013,24 +3773,58 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesPercentage", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -3243,6 +4037,40 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3929,6 +4757,551 @@ func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetUnbondingTimeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUnbondingTimeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUnbondingTimeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Override.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUnbondingTimeOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUnbondingTimeOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUnbondingTimeOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveUnbondingTimeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveUnbondingTimeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveUnbondingTimeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveUnbondingTimeOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveUnbondingTimeOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveUnbondingTimeOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLiquidStaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLiquidStaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLiquidStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStaker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidStaker = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLiquidStakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLiquidStakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLiquidStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NewUnbondingTimeOverride returns a new UnbondingTimeOverride. Exactly one of
+// address or accountType must be set.
+func NewUnbondingTimeOverride(address, accountType string, unbondingTime time.Duration) UnbondingTimeOverride {
+	return UnbondingTimeOverride{
+		Address:       address,
+		AccountType:   accountType,
+		UnbondingTime: unbondingTime,
+	}
+}
+
+// Key returns the key of the override in the store, i.e. the delegator address
+// or the account type URL it applies to.
+func (o UnbondingTimeOverride) Key() string {
+	if o.Address != "" {
+		return o.Address
+	}
+
+	return o.AccountType
+}
+
+// Validate performs a stateless validation of the override.
+func (o UnbondingTimeOverride) Validate() error {
+	if err := validateUnbondingTimeOverrideTarget(o.Address, o.AccountType); err != nil {
+		return err
+	}
+
+	if o.UnbondingTime <= 0 {
+		return fmt.Errorf("unbonding time must be positive: %s", o.UnbondingTime)
+	}
+
+	return nil
+}
+
+// validateUnbondingTimeOverrideTarget ensures exactly one of a delegator
+// address or an account type URL is given.
+func validateUnbondingTimeOverrideTarget(address, accountType string) error {
+	if (address == "") == (accountType == "") {
+		return errors.New("exactly one of address or account type must be set")
+	}
+
+	if accountType != "" && !strings.HasPrefix(accountType, "/") {
+		return fmt.Errorf("account type must be a type URL starting with '/': %s", accountType)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the override to remove.
+func (msg MsgRemoveUnbondingTimeOverride) Validate() error {
+	return validateUnbondingTimeOverrideTarget(msg.Address, msg.AccountType)
+}
+
+// Key returns the key in the store of the override to remove.
+func (msg MsgRemoveUnbondingTimeOverride) Key() string {
+	return NewUnbondingTimeOverride(msg.Address, msg.AccountType, 0).Key()
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
@@ -28,6 +29,14 @@ const (
 	MaxWebsiteLength         = 140
 	MaxSecurityContactLength = 140
 	MaxDetailsLength         = 280
+	MaxWebsiteProofLength    = 280
+	MaxPGPProofLength        = 3000
+)
+
+const (
+	pgpSignatureHeader     = "-----BEGIN PGP SIGNATURE-----"
+	pgpSignedMessageHeader = "-----BEGIN PGP SIGNED MESSAGE-----"
+	pgpSignatureFooter     = "-----END PGP SIGNATURE-----"
 )
 
 var (
@@ -59,6 +68,7 @@ func NewValidator(operator string, pubKey cryptotypes.PubKey, description Descri
 		Commission:              NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
 		MinSelfDelegation:       math.OneInt(),
 		UnbondingOnHoldRefCount: 0,
+		LiquidShares:            math.LegacyZeroDec(),
 	}, nil
 }
 
@@ -199,7 +209,7 @@ func NewDescription(moniker, identity, website, securityContact, details string)
 }
 
 // UpdateDescription updates the fields of a given description. An error is
-// returned if the resulting description contains an invalid length.
+// returned if the resulting description is invalid.
 func (d Description) UpdateDescription(d2 Description) (Description, error) {
 	if d2.Moniker == DoNotModifyDesc {
 		d2.Moniker = d.Moniker
@@ -221,13 +231,74 @@ func (d Description) UpdateDescription(d2 Description) (Description, error) {
 		d2.Details = d.Details
 	}
 
-	return NewDescription(
+	if d2.WebsiteProof == DoNotModifyDesc {
+		d2.WebsiteProof = d.WebsiteProof
+	}
+
+	if d2.PgpProof == DoNotModifyDesc {
+		d2.PgpProof = d.PgpProof
+	}
+
+	description := NewDescription(
 		d2.Moniker,
 		d2.Identity,
 		d2.Website,
 		d2.SecurityContact,
 		d2.Details,
-	).EnsureLength()
+	)
+	description.WebsiteProof = d2.WebsiteProof
+	description.PgpProof = d2.PgpProof
+
+	return description.Validate()
+}
+
+// Validate ensures the length of a validator's description as well as the
+// format of its identity proofs.
+func (d Description) Validate() (Description, error) {
+	if _, err := d.EnsureLength(); err != nil {
+		return d, err
+	}
+
+	if d.WebsiteProof != "" {
+		if err := validateWebsiteProof(d.Website, d.WebsiteProof); err != nil {
+			return d, err
+		}
+	}
+
+	if d.PgpProof != "" {
+		proof := strings.TrimSpace(d.PgpProof)
+		if (!strings.HasPrefix(proof, pgpSignatureHeader) && !strings.HasPrefix(proof, pgpSignedMessageHeader)) ||
+			!strings.HasSuffix(proof, pgpSignatureFooter) {
+			return d, errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pgp proof; expected an ASCII-armored PGP signature")
+		}
+	}
+
+	return d, nil
+}
+
+// validateWebsiteProof checks that the website proof is an https URL hosted on
+// the validator's website domain or one of its subdomains.
+func validateWebsiteProof(website, websiteProof string) error {
+	proofURL, err := url.Parse(websiteProof)
+	if err != nil || proofURL.Scheme != "https" || proofURL.Hostname() == "" {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website proof %q; expected an https URL", websiteProof)
+	}
+
+	if !strings.Contains(website, "://") {
+		website = "https://" + website
+	}
+
+	websiteURL, err := url.Parse(website)
+	if err != nil || websiteURL.Hostname() == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid website proof; a valid website must be set")
+	}
+
+	proofHost, websiteHost := strings.ToLower(proofURL.Hostname()), strings.ToLower(websiteURL.Hostname())
+	if proofHost != websiteHost && !strings.HasSuffix(proofHost, "."+websiteHost) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website proof; host %s does not match website %s", proofHost, websiteHost)
+	}
+
+	return nil
 }
 
 // EnsureLength ensures the length of a validator's description.
@@ -252,6 +323,14 @@ func (d Description) EnsureLength() (Description, error) {
 		return d, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid details length; got: %d, max: %d", len(d.Details), MaxDetailsLength)
 	}
 
+	if len(d.WebsiteProof) > MaxWebsiteProofLength {
+		return d, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website proof length; got: %d, max: %d", len(d.WebsiteProof), MaxWebsiteProofLength)
+	}
+
+	if len(d.PgpProof) > MaxPGPProofLength {
+		return d, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid pgp proof length; got: %d, max: %d", len(d.PgpProof), MaxPGPProofLength)
+	}
+
 	return d, nil
 }
 
@@ -495,6 +574,15 @@ func (v Validator) GetCommission() math.LegacyDec      { return v.Commission.Rat
 func (v Validator) GetMinSelfDelegation() math.Int     { return v.MinSelfDelegation }
 func (v Validator) GetDelegatorShares() math.LegacyDec { return v.DelegatorShares }
 
+// GetLiquidShares returns the shares delegated by liquid staking providers, the
+// validators stored before they were tracked have none.
+func (v Validator) GetLiquidShares() math.LegacyDec {
+	if v.LiquidShares.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return v.LiquidShares
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (v Validator) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	var pk cryptotypes.PubKey
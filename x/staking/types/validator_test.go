@@ -57,6 +57,60 @@ func TestUpdateDescription(t *testing.T) {
 	require.Equal(t, d, d3)
 }
 
+func TestDescriptionValidate(t *testing.T) {
+	pgpProof := "-----BEGIN PGP SIGNATURE-----\n\niQEzBAABCAAdFiEE\n-----END PGP SIGNATURE-----\n"
+
+	testCases := []struct {
+		name        string
+		description types.Description
+		expErr      string
+	}{
+		{
+			name:        "empty proofs",
+			description: types.Description{Moniker: "validator"},
+		},
+		{
+			name:        "valid proofs",
+			description: types.Description{Website: "validator.cosmos", WebsiteProof: "https://validator.cosmos/.well-known/cosmos.txt", PgpProof: pgpProof},
+		},
+		{
+			name:        "website proof on a subdomain",
+			description: types.Description{Website: "https://validator.cosmos", WebsiteProof: "https://proofs.validator.cosmos/cosmos.txt"},
+		},
+		{
+			name:        "website proof without website",
+			description: types.Description{WebsiteProof: "https://validator.cosmos/cosmos.txt"},
+			expErr:      "a valid website must be set",
+		},
+		{
+			name:        "website proof not over https",
+			description: types.Description{Website: "validator.cosmos", WebsiteProof: "http://validator.cosmos/cosmos.txt"},
+			expErr:      "expected an https URL",
+		},
+		{
+			name:        "website proof on another host",
+			description: types.Description{Website: "validator.cosmos", WebsiteProof: "https://evilvalidator.cosmos/cosmos.txt"},
+			expErr:      "does not match website",
+		},
+		{
+			name:        "pgp proof not armored",
+			description: types.Description{PgpProof: "keybase.io/validator"},
+			expErr:      "invalid pgp proof",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.description.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestShareTokens(t *testing.T) {
 	validator := mkValidator(100, math.LegacyNewDec(100))
 	assert.True(math.LegacyDecEq(t, math.LegacyNewDec(50), validator.TokensFromShares(math.LegacyNewDec(50))))
@@ -0,0 +1,183 @@
+// Package payload provides a registry of decoders rendering the opaque payloads
+// embedded in messages (e.g. CosmWasm execute messages or EVM calldata) in a
+// human-readable form, instead of base64 or hexadecimal blobs.
+//
+// Decoders are registered by the full name of the bytes field holding the
+// payload, and are used by the `tx decode` command, SIGN_MODE_TEXTUAL and any
+// tooling inspecting transactions.
+package payload
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Decoder renders a payload as human-readable text.
+//
+// Decoders must be deterministic, as the rendered text is part of the
+// SIGN_MODE_TEXTUAL sign bytes: the same decoders must be registered by the
+// chain and by the clients signing with SIGN_MODE_TEXTUAL.
+type Decoder func(payload []byte) (string, error)
+
+// Registry maps the full names of bytes fields (e.g.
+// "cosmwasm.wasm.v1.MsgExecuteContract.msg") to the decoders of their payloads.
+type Registry struct {
+	typeResolver protoregistry.MessageTypeResolver
+	decoders     map[protoreflect.FullName]Decoder
+}
+
+// NewRegistry returns an empty registry. The type resolver is used to unpack
+// the messages packed in Any fields when decoding messages, if it is nil
+// protoregistry.GlobalTypes is used.
+func NewRegistry(typeResolver protoregistry.MessageTypeResolver) *Registry {
+	if typeResolver == nil {
+		typeResolver = protoregistry.GlobalTypes
+	}
+
+	return &Registry{
+		typeResolver: typeResolver,
+		decoders:     make(map[protoreflect.FullName]Decoder),
+	}
+}
+
+// Register registers the decoder of the payloads held in the given bytes field.
+// It fails if a decoder is already registered for the field.
+func (r *Registry) Register(field protoreflect.FullName, decoder Decoder) error {
+	if !field.IsValid() {
+		return fmt.Errorf("invalid field name %q", field)
+	}
+	if decoder == nil {
+		return errors.New("decoder cannot be nil")
+	}
+	if _, ok := r.decoders[field]; ok {
+		return fmt.Errorf("payload decoder already registered for %s", field)
+	}
+
+	r.decoders[field] = decoder
+	return nil
+}
+
+// Get returns the decoder registered for the given field, if any. It can be
+// called on a nil registry.
+func (r *Registry) Get(field protoreflect.FullName) (Decoder, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	decoder, ok := r.decoders[field]
+	return decoder, ok
+}
+
+// DecodedPayload is a payload decoded from a message field.
+type DecodedPayload struct {
+	// Path is the path of the field in the decoded message, made of the proto
+	// field names (e.g. "msgs[0].msg").
+	Path string `json:"path"`
+	// Field is the full name of the field holding the payload.
+	Field string `json:"field"`
+	// Text is the payload rendered by its decoder.
+	Text string `json:"text"`
+}
+
+// DecodeMessage walks the given message, including its nested messages, lists
+// and the messages packed in Any fields, and decodes the payloads of the
+// fields having a registered decoder. The paths of the decoded payloads are
+// prefixed with the given prefix.
+func (r *Registry) DecodeMessage(prefix string, msg protoreflect.Message) ([]DecodedPayload, error) {
+	if r == nil || len(r.decoders) == 0 {
+		return nil, nil
+	}
+
+	var payloads []DecodedPayload
+	err := r.decodeMessage(prefix, msg, &payloads)
+	return payloads, err
+}
+
+func (r *Registry) decodeMessage(path string, msg protoreflect.Message, payloads *[]DecodedPayload) error {
+	if msg.Descriptor().FullName() == anyFullName {
+		return r.decodeAny(path, msg, payloads)
+	}
+
+	// fields are walked in declaration order, so that payloads are always
+	// returned in the same order
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		// payloads are not expected in maps
+		if fd.IsMap() || !msg.Has(fd) {
+			continue
+		}
+
+		fieldPath := string(fd.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+
+		if !fd.IsList() {
+			if err := r.decodeValue(fieldPath, fd, msg.Get(fd), payloads); err != nil {
+				return err
+			}
+			continue
+		}
+
+		list := msg.Get(fd).List()
+		for j := 0; j < list.Len(); j++ {
+			if err := r.decodeValue(fmt.Sprintf("%s[%d]", fieldPath, j), fd, list.Get(j), payloads); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) decodeValue(path string, fd protoreflect.FieldDescriptor, v protoreflect.Value, payloads *[]DecodedPayload) error {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return r.decodeMessage(path, v.Message(), payloads)
+	case protoreflect.BytesKind:
+		decoder, ok := r.decoders[fd.FullName()]
+		if !ok {
+			return nil
+		}
+
+		text, err := decoder(v.Bytes())
+		if err != nil {
+			return fmt.Errorf("decoding payload of %s: %w", path, err)
+		}
+
+		*payloads = append(*payloads, DecodedPayload{Path: path, Field: string(fd.FullName()), Text: text})
+	}
+
+	return nil
+}
+
+var anyFullName = (&anypb.Any{}).ProtoReflect().Descriptor().FullName()
+
+// decodeAny decodes the payloads of the message packed in the given Any. Any
+// whose type can't be resolved are skipped.
+func (r *Registry) decodeAny(path string, msg protoreflect.Message, payloads *[]DecodedPayload) error {
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName("type_url")).String()
+	value := msg.Get(fields.ByName("value")).Bytes()
+
+	typ, err := r.typeResolver.FindMessageByURL(typeURL)
+	if err != nil {
+		if errors.Is(err, protoregistry.NotFound) {
+			return nil
+		}
+		return err
+	}
+
+	packed := typ.New()
+	if err := proto.Unmarshal(value, packed.Interface()); err != nil {
+		return fmt.Errorf("unpacking %s at %s: %w", typeURL, path, err)
+	}
+
+	return r.decodeMessage(path, packed, payloads)
+}
@@ -0,0 +1,77 @@
+package payload_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/x/tx/internal/testpb"
+	"cosmossdk.io/x/tx/payload"
+)
+
+const barDataField = "Bar.data"
+
+// jsonDecoder renders JSON payloads compacted.
+func jsonDecoder(bz []byte) (string, error) {
+	if !json.Valid(bz) {
+		return "", errors.New("invalid JSON")
+	}
+	return "json:" + string(bz), nil
+}
+
+func TestRegister(t *testing.T) {
+	r := payload.NewRegistry(nil)
+	require.NoError(t, r.Register(barDataField, jsonDecoder))
+	require.ErrorContains(t, r.Register(barDataField, jsonDecoder), "already registered")
+	require.ErrorContains(t, r.Register("Bar.other", nil), "cannot be nil")
+	require.ErrorContains(t, r.Register("not a name", jsonDecoder), "invalid field name")
+
+	decoder, ok := r.Get(barDataField)
+	require.True(t, ok)
+	text, err := decoder([]byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, "json:{}", text)
+
+	_, ok = r.Get("Bar.bar_id")
+	require.False(t, ok)
+
+	var nilRegistry *payload.Registry
+	_, ok = nilRegistry.Get(barDataField)
+	require.False(t, ok)
+}
+
+func TestDecodeMessage(t *testing.T) {
+	r := payload.NewRegistry(nil)
+	require.NoError(t, r.Register(barDataField, jsonDecoder))
+
+	packed, err := anypb.New(&testpb.Bar{Data: []byte(`{"packed":true}`)})
+	require.NoError(t, err)
+
+	msg := &testpb.Qux{
+		Messages: []*testpb.Foo{
+			{Bar: &testpb.Bar{BarId: "1", Data: []byte(`{"a":1}`)}},
+			{FullName: "no payload"},
+			{Left: &testpb.Foo{Bar: &testpb.Bar{Payload: packed}}},
+		},
+	}
+
+	payloads, err := r.DecodeMessage("body", msg.ProtoReflect())
+	require.NoError(t, err)
+	require.Equal(t, []payload.DecodedPayload{
+		{Path: "body.messages[0].bar.data", Field: barDataField, Text: `json:{"a":1}`},
+		{Path: "body.messages[2].left.bar.payload.data", Field: barDataField, Text: `json:{"packed":true}`},
+	}, payloads)
+
+	// decoding errors are returned with the path of the payload
+	msg.Messages[1].Bar = &testpb.Bar{Data: []byte("not json")}
+	_, err = r.DecodeMessage("", msg.ProtoReflect())
+	require.ErrorContains(t, err, "decoding payload of messages[1].bar.data: invalid JSON")
+
+	// an empty registry doesn't decode anything
+	payloads, err = payload.NewRegistry(nil).DecodeMessage("", msg.ProtoReflect())
+	require.NoError(t, err)
+	require.Empty(t, payloads)
+}
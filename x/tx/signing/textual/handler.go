@@ -19,6 +19,7 @@ import (
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/payload"
 	"cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/textual/internal/textualpb"
 )
@@ -47,6 +48,11 @@ type SignModeOptions struct {
 	// TypeResolver are the protobuf type resolvers to use for resolving message
 	// types. If it is nil, then a dynamicpb will be used on top of FileResolver.
 	TypeResolver protoregistry.MessageTypeResolver
+
+	// PayloadDecoders are the decoders used to render the opaque payloads
+	// embedded in messages (e.g. CosmWasm execute messages). Bytes fields
+	// without a registered decoder are rendered as hexadecimal. It is optional.
+	PayloadDecoders *payload.Registry
 }
 
 // SignModeHandler holds the configuration for dispatching
@@ -55,6 +61,7 @@ type SignModeHandler struct {
 	fileResolver        signing.ProtoFileResolver
 	typeResolver        protoregistry.MessageTypeResolver
 	coinMetadataQuerier CoinMetadataQueryFn
+	payloadDecoders     *payload.Registry
 	// scalars defines a registry for Cosmos scalars.
 	scalars map[string]ValueRendererCreator
 	// messages defines a registry for custom message renderers.
@@ -82,6 +89,7 @@ func NewSignModeHandler(o SignModeOptions) (*SignModeHandler, error) {
 		coinMetadataQuerier: o.CoinMetadataQuerier,
 		fileResolver:        o.FileResolver,
 		typeResolver:        o.TypeResolver,
+		payloadDecoders:     o.PayloadDecoders,
 	}
 	t.init()
 
@@ -114,6 +122,10 @@ func (r *SignModeHandler) GetFieldValueRenderer(fd protoreflect.FieldDescriptor)
 		return NewStringValueRenderer(), nil
 
 	case fd.Kind() == protoreflect.BytesKind:
+		if decoder, ok := r.payloadDecoders.Get(fd.FullName()); ok {
+			return NewPayloadValueRenderer(decoder), nil
+		}
+
 		return NewBytesValueRenderer(), nil
 
 	// Integers
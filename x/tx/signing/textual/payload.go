@@ -0,0 +1,41 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/x/tx/payload"
+)
+
+// NewPayloadValueRenderer returns a ValueRenderer for Protobuf bytes holding an
+// opaque payload (e.g. a CosmWasm execute message), which are rendered with
+// the given payload decoder. Payloads that can't be decoded are rendered as
+// regular bytes.
+func NewPayloadValueRenderer(decoder payload.Decoder) ValueRenderer {
+	return payloadValueRenderer{decoder: decoder}
+}
+
+type payloadValueRenderer struct {
+	decoder payload.Decoder
+}
+
+func (vr payloadValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	text, err := vr.decoder(v.Bytes())
+	if err != nil || text == "" {
+		return NewBytesValueRenderer().Format(ctx, v)
+	}
+
+	return []Screen{{Content: text}}, nil
+}
+
+// Parse can't invert the payload decoder, so like for hashed bytes, it
+// returns empty bytes.
+func (vr payloadValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) != 1 {
+		return nilValue, fmt.Errorf("expected single screen: %v", screens)
+	}
+
+	return protoreflect.ValueOfBytes([]byte{}), nil
+}
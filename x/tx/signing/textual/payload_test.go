@@ -0,0 +1,52 @@
+package textual_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/x/tx/payload"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+func TestPayloadValueRenderer(t *testing.T) {
+	fd := fieldDescriptorFromName("BYTES")
+	decoders := payload.NewRegistry(nil)
+	err := decoders.Register(fd.FullName(), func(bz []byte) (string, error) {
+		if len(bz) == 0 || bz[0] != '{' {
+			return "", errors.New("not a JSON object")
+		}
+		return string(bz), nil
+	})
+	require.NoError(t, err)
+
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: EmptyCoinMetadataQuerier,
+		PayloadDecoders:     decoders,
+	})
+	require.NoError(t, err)
+
+	vr, err := tr.GetFieldValueRenderer(fd)
+	require.NoError(t, err)
+
+	screens, err := vr.Format(context.Background(), protoreflect.ValueOfBytes([]byte(`{"increment":{}}`)))
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{{Content: `{"increment":{}}`}}, screens)
+
+	// payloads which can't be decoded are rendered as bytes
+	screens, err = vr.Format(context.Background(), protoreflect.ValueOfBytes([]byte{0xab, 0xcd}))
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{{Content: "ABCD"}}, screens)
+
+	// other bytes fields are still rendered as bytes
+	tr, err = textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: EmptyCoinMetadataQuerier})
+	require.NoError(t, err)
+	vr, err = tr.GetFieldValueRenderer(fd)
+	require.NoError(t, err)
+	screens, err = vr.Format(context.Background(), protoreflect.ValueOfBytes([]byte(`{}`)))
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{{Content: "7B7D"}}, screens)
+}
@@ -83,6 +83,10 @@ func (vr txValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]S
 		NonCriticalExtensionOptions: txBody.NonCriticalExtensionOptions,
 		HashOfRawBytes:              getHash(textualData.BodyBytes, textualData.AuthInfoBytes),
 	}
+	if txAuthInfo.Tip != nil {
+		envelope.Tip = txAuthInfo.Tip.Amount
+		envelope.Tipper = txAuthInfo.Tip.Tipper
+	}
 
 	// Find all other tx signers than the current signer. In the case where our
 	// Textual signer is one key of a multisig, then otherSigners will include
@@ -123,6 +127,7 @@ func (vr txValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]S
 		"Public key":                     {},
 		"Fee payer":                      {},
 		"Fee granter":                    {},
+		"Tipper":                         {},
 		"Gas limit":                      {},
 		"Timeout height":                 {},
 		"Other signer":                   {},
@@ -240,6 +245,12 @@ func (vr txValueRenderer) Parse(ctx context.Context, screens []Screen) (protoref
 			Granter:  envelope.FeeGranter,
 		},
 	}
+	if len(envelope.Tip) > 0 || envelope.Tipper != "" {
+		authInfo.Tip = &txv1beta1.Tip{
+			Amount: envelope.Tip,
+			Tipper: envelope.Tipper,
+		}
+	}
 
 	// Figure out the signers in the correct order.
 	signers, err := getSigners(txBody, authInfo)
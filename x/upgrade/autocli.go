@@ -16,6 +16,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the upgrade plan (if one exists)",
 					Long:      "Gets the currently scheduled upgrade plan, if one exists",
 				},
+				{
+					RpcMethod: "PlanBinaries",
+					Use:       "plan-binaries",
+					Short:     "Query the binaries of the upgrade plan (if one exists)",
+					Long:      "Gets the currently scheduled upgrade plan, if one exists, along with the binaries listed in its info and their checksums, against which downloaded binaries can be verified",
+				},
 				{
 					RpcMethod: "AppliedPlan",
 					Use:       "applied [upgrade-name]",
@@ -40,6 +46,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "UpgradeHistory",
+					Use:       "history",
+					Short:     "Query the upgrades applied on chain",
+					Long:      "Gets the list of the upgrades applied on chain, along with the height at which they were applied, ordered by height.",
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -55,6 +67,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:       "Submit a proposal to cancel a planned chain upgrade.",
 					GovProposal: true,
 				},
+				{
+					RpcMethod:   "RescheduleUpgrade",
+					Use:         "reschedule-upgrade-proposal [height]",
+					Short:       "Submit a proposal to move a planned chain upgrade to another height.",
+					GovProposal: true,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "height"},
+					},
+				},
 				{
 					RpcMethod: "SoftwareUpgrade",
 					Skip:      true, // skipped because authority gated
@@ -29,7 +29,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
-		appconfig.Invoke(PopulateVersionMap),
+		appconfig.Invoke(PopulateVersionMap, PopulatePreUpgradeHandlers),
 	)
 }
 
@@ -98,3 +98,17 @@ func PopulateVersionMap(upgradeKeeper *keeper.Keeper, modules map[string]appmodu
 
 	upgradeKeeper.SetInitVersionMap(module.NewManagerFromMap(modules).GetVersionMap())
 }
+
+// PopulatePreUpgradeHandlers registers the modules implementing types.PreUpgradeHandler
+// with the upgrade keeper.
+func PopulatePreUpgradeHandlers(upgradeKeeper *keeper.Keeper, modules map[string]appmodule.AppModule) {
+	if upgradeKeeper == nil {
+		return
+	}
+
+	for name, m := range modules {
+		if h, ok := m.(types.PreUpgradeHandler); ok {
+			upgradeKeeper.SetPreUpgradeHandler(name, h)
+		}
+	}
+}
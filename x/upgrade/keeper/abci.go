@@ -109,6 +109,32 @@ func (k Keeper) PreBlocker(ctx context.Context) error {
 		// Returning an error will end up in a panic
 		return errors.New(downgradeMsg)
 	}
+
+	return nil
+}
+
+// EndBlocker gives modules a chance to prepare before the chain halts for the
+// scheduled upgrade: at the end of the last block before the upgrade height, it
+// calls the registered pre-upgrade handlers, unless the upgrade is skipped at
+// that height.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return nil
+		}
+		return err
+	}
+
+	if k.HeaderService.HeaderInfo(ctx).Height != plan.Height-1 || k.IsSkipHeight(plan.Height) {
+		return nil
+	}
+
+	k.Logger.Info(fmt.Sprintf("running pre-upgrade handlers for upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
+	k.runPreUpgradeHandlers(ctx, plan)
+
 	return nil
 }
 
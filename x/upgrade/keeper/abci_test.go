@@ -12,6 +12,7 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -370,6 +371,98 @@ func TestUpgradeWithoutSkip(t *testing.T) {
 	s.VerifyDone(t, s.ctx, "test")
 }
 
+type preUpgradeRecorder struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (r preUpgradeRecorder) PreUpgrade(_ context.Context, plan types.Plan) error {
+	*r.calls = append(*r.calls, r.name+":"+plan.Name)
+	return r.err
+}
+
+func TestPreUpgradeHandlers(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	var calls []string
+	s.keeper.SetPreUpgradeHandler("foo", preUpgradeRecorder{name: "foo", calls: &calls})
+	s.keeper.SetPreUpgradeHandler("bar", preUpgradeRecorder{name: "bar", calls: &calls})
+
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: s.ctx.HeaderInfo().Height + 2})
+	require.NoError(t, err)
+
+	t.Log("Verify that pre-upgrade handlers are not called before the last block before the upgrade")
+	require.NoError(t, s.keeper.EndBlocker(s.ctx))
+	require.Empty(t, calls)
+
+	t.Log("Verify that pre-upgrade handlers are called at the end of the last block before the upgrade, ordered by module name")
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: s.ctx.HeaderInfo().Height + 1, Time: time.Now()})
+	require.NoError(t, s.preModule.PreBlock(newCtx))
+	require.Empty(t, calls)
+	require.NoError(t, s.keeper.EndBlocker(newCtx))
+	require.Equal(t, []string{"bar:test", "foo:test"}, calls)
+
+	t.Log("Verify that pre-upgrade handlers are not called at the upgrade height")
+	newCtx = s.ctx.WithHeaderInfo(header.Info{Height: s.ctx.HeaderInfo().Height + 2, Time: time.Now()})
+	require.ErrorContains(t, s.preModule.PreBlock(newCtx), "UPGRADE \"test\" NEEDED at height: 12")
+	require.Len(t, calls, 2)
+}
+
+func TestPreUpgradeHandlersNextHeight(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	var calls []string
+	s.keeper.SetPreUpgradeHandler("foo", preUpgradeRecorder{name: "foo", calls: &calls})
+
+	t.Log("Verify that pre-upgrade handlers are called for an upgrade scheduled in the last block before it")
+	require.NoError(t, s.preModule.PreBlock(s.ctx))
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: s.ctx.HeaderInfo().Height + 1})
+	require.NoError(t, err)
+	require.NoError(t, s.keeper.EndBlocker(s.ctx))
+	require.Equal(t, []string{"foo:test"}, calls)
+}
+
+func TestPreUpgradeHandlersSkipHeight(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{11: true})
+	var calls []string
+	s.keeper.SetPreUpgradeHandler("foo", preUpgradeRecorder{name: "foo", calls: &calls})
+
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: s.ctx.HeaderInfo().Height + 1})
+	require.NoError(t, err)
+
+	t.Log("Verify that pre-upgrade handlers are not called for a skipped upgrade")
+	require.NoError(t, s.keeper.EndBlocker(s.ctx))
+	require.Empty(t, calls)
+}
+
+type failingPreUpgradeHandler struct {
+	storeService corestore.KVStoreService
+}
+
+func (h failingPreUpgradeHandler) PreUpgrade(ctx context.Context, _ types.Plan) error {
+	if err := h.storeService.OpenKVStore(ctx).Set([]byte("pre-upgrade"), []byte{1}); err != nil {
+		return err
+	}
+	return errors.New("cannot flush cache")
+}
+
+func TestPreUpgradeHandlerError(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	var calls []string
+	storeService := s.env.KVStoreService
+	s.keeper.SetPreUpgradeHandler("bar", failingPreUpgradeHandler{storeService: storeService})
+	s.keeper.SetPreUpgradeHandler("foo", preUpgradeRecorder{name: "foo", calls: &calls})
+
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: s.ctx.HeaderInfo().Height + 1})
+	require.NoError(t, err)
+
+	t.Log("Verify that a failing pre-upgrade handler does not halt the chain and its changes are discarded")
+	require.NoError(t, s.keeper.EndBlocker(s.ctx))
+	require.Equal(t, []string{"foo:test"}, calls)
+	has, err := storeService.OpenKVStore(s.ctx).Has([]byte("pre-upgrade"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
 func TestDumpUpgradeInfoToFile(t *testing.T) {
 	s := setupTest(t, 10, map[int64]bool{})
 	require := require.New(t)
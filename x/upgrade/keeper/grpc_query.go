@@ -3,9 +3,15 @@ package keeper
 import (
 	"context"
 	"errors"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	upgradeplan "cosmossdk.io/x/upgrade/plan"
 	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -24,6 +30,50 @@ func (k Keeper) CurrentPlan(ctx context.Context, req *types.QueryCurrentPlanRequ
 	return &types.QueryCurrentPlanResponse{Plan: &plan}, nil
 }
 
+// PlanBinaries implements the Query/PlanBinaries gRPC method
+func (k Keeper) PlanBinaries(ctx context.Context, req *types.QueryPlanBinariesRequest) (*types.QueryPlanBinariesResponse, error) {
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return &types.QueryPlanBinariesResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	res := &types.QueryPlanBinariesResponse{Plan: &plan}
+	if !upgradeplan.IsInlineInfo(plan.Info) {
+		return res, nil
+	}
+
+	info, err := upgradeplan.ParseInfo(plan.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	platforms := make([]string, 0, len(info.Binaries))
+	for platform := range info.Binaries {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+
+	for _, platform := range platforms {
+		url := info.Binaries[platform]
+		checksum, err := upgradeplan.URLChecksum(url)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Binaries = append(res.Binaries, &types.PlanBinary{
+			Platform: platform,
+			Url:      url,
+			Checksum: checksum,
+		})
+	}
+
+	return res, nil
+}
+
 // AppliedPlan implements the Query/AppliedPlan gRPC method
 func (k Keeper) AppliedPlan(ctx context.Context, req *types.QueryAppliedPlanRequest) (*types.QueryAppliedPlanResponse, error) {
 	applied, err := k.GetDoneHeight(ctx, req.Name)
@@ -31,6 +81,24 @@ func (k Keeper) AppliedPlan(ctx context.Context, req *types.QueryAppliedPlanRequ
 	return &types.QueryAppliedPlanResponse{Height: applied}, err
 }
 
+// UpgradeHistory implements the Query/UpgradeHistory gRPC method
+func (k Keeper) UpgradeHistory(ctx context.Context, req *types.QueryUpgradeHistoryRequest) (*types.QueryUpgradeHistoryResponse, error) {
+	store := runtime.KVStoreAdapter(k.KVStoreService.OpenKVStore(ctx))
+	doneStore := prefix.NewStore(store, []byte{types.DoneByte})
+
+	var upgrades []*types.AppliedUpgrade
+	pageRes, err := query.Paginate(doneStore, req.Pagination, func(key, _ []byte) error {
+		name, height := parseDoneKey(append([]byte{types.DoneByte}, key...))
+		upgrades = append(upgrades, &types.AppliedUpgrade{Name: name, Height: height})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUpgradeHistoryResponse{Upgrades: upgrades, Pagination: pageRes}, nil
+}
+
 // UpgradedConsensusState implements the Query/UpgradedConsensusState gRPC method
 func (k Keeper) UpgradedConsensusState(ctx context.Context, req *types.QueryUpgradedConsensusStateRequest) (*types.QueryUpgradedConsensusStateResponse, error) { //nolint:staticcheck // we're using a deprecated call for compatibility
 	consState, err := k.GetUpgradedConsensusState(ctx, req.LastHeight)
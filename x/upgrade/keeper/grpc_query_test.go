@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type UpgradeTestSuite struct {
@@ -108,6 +109,62 @@ func (suite *UpgradeTestSuite) TestQueryCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestQueryPlanBinaries() {
+	const checksum = "sha256:2c22e34510bd1d4ad2343cdc54f7165bccf30caef73f39af7dd1db2795a3da48"
+
+	var expResponse types.QueryPlanBinariesResponse
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"without current upgrade plan",
+			func() {
+				expResponse = types.QueryPlanBinariesResponse{}
+			},
+		},
+		{
+			"with current upgrade plan without binaries",
+			func() {
+				plan := types.Plan{Name: "test-plan", Height: 5, Info: "https://example.com/plan-info.json"}
+				suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+				expResponse = types.QueryPlanBinariesResponse{Plan: &plan}
+			},
+		},
+		{
+			"with current upgrade plan with binaries",
+			func() {
+				plan := types.Plan{
+					Name:   "test-plan",
+					Height: 5,
+					Info:   `{"binaries":{"linux/arm64":"https://example.com/simd-arm64","linux/amd64":"https://example.com/simd-amd64?checksum=` + checksum + `"}}`,
+				}
+				suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+				expResponse = types.QueryPlanBinariesResponse{
+					Plan: &plan,
+					Binaries: []*types.PlanBinary{
+						{Platform: "linux/amd64", Url: "https://example.com/simd-amd64?checksum=" + checksum, Checksum: checksum},
+						{Platform: "linux/arm64", Url: "https://example.com/simd-arm64"},
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.queryClient.PlanBinaries(context.Background(), &types.QueryPlanBinariesRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(&expResponse, res)
+		})
+	}
+}
+
 func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	var (
 		req       *types.QueryAppliedPlanRequest
@@ -166,6 +223,47 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestUpgradeHistory() {
+	applyPlan := func(name string, height int64) {
+		plan := types.Plan{Name: name, Height: height}
+		suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+		suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Height: height})
+		suite.upgradeKeeper.SetUpgradeHandler(name, func(ctx context.Context, plan types.Plan, vm appmodule.VersionMap) (appmodule.VersionMap, error) {
+			return vm, nil
+		})
+		suite.Require().NoError(suite.upgradeKeeper.ApplyUpgrade(suite.ctx, plan))
+	}
+
+	res, err := suite.queryClient.UpgradeHistory(context.Background(), &types.QueryUpgradeHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Upgrades)
+
+	applyPlan("v2", 5)
+	applyPlan("v3", 10)
+	applyPlan("v4", 15)
+
+	res, err = suite.queryClient.UpgradeHistory(context.Background(), &types.QueryUpgradeHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.AppliedUpgrade{
+		{Name: "v2", Height: 5},
+		{Name: "v3", Height: 10},
+		{Name: "v4", Height: 15},
+	}, res.Upgrades)
+
+	res, err = suite.queryClient.UpgradeHistory(context.Background(), &types.QueryUpgradeHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Upgrades, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = suite.queryClient.UpgradeHistory(context.Background(), &types.QueryUpgradeHistoryRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.AppliedUpgrade{{Name: "v4", Height: 15}}, res.Upgrades)
+}
+
 func (suite *UpgradeTestSuite) TestModuleVersions() {
 	testCases := []struct {
 		msg     string
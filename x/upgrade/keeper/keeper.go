@@ -18,6 +18,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
+	upgradeplan "cosmossdk.io/x/upgrade/plan"
 	"cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -30,14 +31,15 @@ import (
 type Keeper struct {
 	appmodule.Environment
 
-	homePath           string                          // root directory of app config
-	skipUpgradeHeights map[int64]bool                  // map of heights to skip for an upgrade
-	cdc                codec.BinaryCodec               // App-wide binary codec
-	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
-	versionModifier    app.VersionModifier             // implements setting the protocol version field on BaseApp
-	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
-	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
-	initVersionMap     appmodule.VersionMap            // the module version map at init genesis
+	homePath           string                             // root directory of app config
+	skipUpgradeHeights map[int64]bool                     // map of heights to skip for an upgrade
+	cdc                codec.BinaryCodec                  // App-wide binary codec
+	upgradeHandlers    map[string]types.UpgradeHandler    // map of plan name to upgrade handler
+	preUpgradeHandlers map[string]types.PreUpgradeHandler // map of module name to pre-upgrade handler
+	versionModifier    app.VersionModifier                // implements setting the protocol version field on BaseApp
+	downgradeVerified  bool                               // tells if we've already sanity checked that this binary version isn't being used against an old state.
+	authority          string                             // the address capable of executing and canceling an upgrade. Usually the gov module account
+	initVersionMap     appmodule.VersionMap               // the module version map at init genesis
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -60,6 +62,7 @@ func NewKeeper(
 		skipUpgradeHeights: skipUpgradeHeights,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
+		preUpgradeHandlers: map[string]types.PreUpgradeHandler{},
 		versionModifier:    vs,
 		authority:          authority,
 	}
@@ -90,6 +93,32 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetPreUpgradeHandler sets the PreUpgradeHandler of the given module. It will be called
+// in the last block before the height of any scheduled upgrade.
+func (k Keeper) SetPreUpgradeHandler(moduleName string, preUpgradeHandler types.PreUpgradeHandler) {
+	k.preUpgradeHandlers[moduleName] = preUpgradeHandler
+}
+
+// runPreUpgradeHandlers calls the registered pre-upgrade handlers, ordered by
+// module name. A failing handler does not halt the chain: its state changes are
+// discarded and its error is logged.
+func (k Keeper) runPreUpgradeHandlers(ctx context.Context, plan types.Plan) {
+	moduleNames := make([]string, 0, len(k.preUpgradeHandlers))
+	for moduleName := range k.preUpgradeHandlers {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	for _, moduleName := range moduleNames {
+		err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.preUpgradeHandlers[moduleName].PreUpgrade(ctx, plan)
+		})
+		if err != nil {
+			k.Logger.Error("pre-upgrade handler failed", "module", moduleName, "upgrade", plan.Name, "err", err)
+		}
+	}
+}
+
 // SetModuleVersionMap saves a given version map to state
 func (k Keeper) SetModuleVersionMap(ctx context.Context, vm appmodule.VersionMap) error {
 	if len(vm) > 0 {
@@ -195,6 +224,10 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
+	if err := validatePlanInfo(plan.Info); err != nil {
+		return err
+	}
+
 	// NOTE: allow for the possibility of chains to schedule upgrades in begin block of the same block
 	// as a strategy for emergency hard fork recoveries
 	if plan.Height < k.HeaderService.HeaderInfo(ctx).Height {
@@ -374,6 +407,27 @@ func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 	return store.Delete(types.PlanKey())
 }
 
+// validatePlanInfo checks that a plan info listing the binaries of the upgrade
+// inline follows the schema expected by upgrade tooling such as cosmovisor.
+// Any other info, e.g. a url to the plan info, is application specific and left
+// unchecked.
+func validatePlanInfo(info string) error {
+	if !upgradeplan.IsInlineInfo(info) {
+		return nil
+	}
+
+	planInfo, err := upgradeplan.ParseInfo(info)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := planInfo.Binaries.ValidateBasic(false); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid plan info: %s", err)
+	}
+
+	return nil
+}
+
 // GetUpgradePlan returns the currently scheduled Plan if any. If not found it returns
 // ErrNoUpgradePlanFound, but other errors may be returned if there is an error reading from the store.
 func (k Keeper) GetUpgradePlan(ctx context.Context) (plan types.Plan, err error) {
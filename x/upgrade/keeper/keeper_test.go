@@ -150,6 +150,46 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 			setup:   func() {},
 			expPass: false,
 		},
+		{
+			name: "successful schedule with binaries in info",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:2c22e34510bd1d4ad2343cdc54f7165bccf30caef73f39af7dd1db2795a3da48"}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: true,
+		},
+		{
+			name: "unsuccessful schedule: malformed info json",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
+		{
+			name: "unsuccessful schedule: invalid binaries in info",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux_amd64":"https://example.com/simd"}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
+		{
+			name: "unsuccessful schedule: no binaries in info",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"commit":"abcdef"}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
 		{
 			name: "unsuccessful height schedule: due date in past",
 			plan: types.Plan{
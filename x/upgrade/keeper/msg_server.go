@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/upgrade/types"
 
@@ -22,8 +24,8 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 }
 
 var (
-	_    types.MsgServer = msgServer{}
-	_, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}
+	_       types.MsgServer = msgServer{}
+	_, _, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}, &types.MsgRescheduleUpgrade{}
 )
 
 // SoftwareUpgrade implements the Msg/SoftwareUpgrade Msg service.
@@ -37,6 +39,14 @@ func (k msgServer) SoftwareUpgrade(ctx context.Context, msg *types.MsgSoftwareUp
 		return nil, err
 	}
 
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeScheduleUpgrade,
+		event.NewAttribute(types.AttributeKeyName, msg.Plan.Name),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(msg.Plan.Height, 10)),
+	); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSoftwareUpgradeResponse{}, nil
 }
 
@@ -46,10 +56,56 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
 	}
 
-	err := k.ClearUpgradePlan(ctx)
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		// cancelling without a pending upgrade is a no-op
+		if errors.IsOf(err, types.ErrNoUpgradePlanFound) {
+			return &types.MsgCancelUpgradeResponse{}, nil
+		}
+		return nil, err
+	}
+
+	err = k.ClearUpgradePlan(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCancelUpgrade,
+		event.NewAttribute(types.AttributeKeyName, plan.Name),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+	); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// RescheduleUpgrade implements the Msg/RescheduleUpgrade Msg service.
+func (k msgServer) RescheduleUpgrade(ctx context.Context, msg *types.MsgRescheduleUpgrade) (*types.MsgRescheduleUpgradeResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	oldHeight := plan.Height
+	plan.Height = msg.Height
+	if err := k.ScheduleUpgrade(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRescheduleUpgrade,
+		event.NewAttribute(types.AttributeKeyName, plan.Name),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+		event.NewAttribute(types.AttributeKeyOldHeight, strconv.FormatInt(oldHeight, 10)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRescheduleUpgradeResponse{}, nil
+}
@@ -130,3 +130,108 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRescheduleUpgrade() {
+	testCases := []struct {
+		name      string
+		schedule  bool
+		req       *types.MsgRescheduleUpgrade
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"invalid authority address",
+			true,
+			&types.MsgRescheduleUpgrade{
+				Authority: "authority",
+				Height:    123460000,
+			},
+			true,
+			"expected authority account as only signer for proposal message",
+		},
+		{
+			"unauthorized authority address",
+			true,
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAddrs[0],
+				Height:    123460000,
+			},
+			true,
+			"expected authority account as only signer for proposal message",
+		},
+		{
+			"no upgrade plan to reschedule",
+			false,
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAuthority,
+				Height:    123460000,
+			},
+			true,
+			"upgrade plan not found",
+		},
+		{
+			"height in the past",
+			true,
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAuthority,
+				Height:    1,
+			},
+			true,
+			"upgrade cannot be scheduled in the past",
+		},
+		{
+			"upgrade rescheduled successfully",
+			true,
+			&types.MsgRescheduleUpgrade{
+				Authority: s.encodedAuthority,
+				Height:    123460000,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.schedule {
+				err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
+					Name:   "some name",
+					Info:   "some info",
+					Height: 123450000,
+				})
+				s.Require().NoError(err)
+			}
+
+			_, err := s.msgSrvr.RescheduleUpgrade(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				plan, err := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal("some name", plan.Name)
+				s.Require().Equal(tc.req.Height, plan.Height)
+
+				events := s.ctx.EventManager().Events()
+				s.Require().NotEmpty(events)
+				last := events[len(events)-1]
+				s.Require().Equal(types.EventTypeRescheduleUpgrade, last.Type)
+				oldHeight, found := last.GetAttribute(types.AttributeKeyOldHeight)
+				s.Require().True(found)
+				s.Require().Equal("123450000", oldHeight.Value)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelUpgradeWithoutPlan() {
+	_, err := s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{
+		Authority: s.encodedAuthority,
+	})
+	s.Require().NoError(err)
+
+	for _, e := range s.ctx.EventManager().Events() {
+		s.Require().NotEqual(types.EventTypeCancelUpgrade, e.Type)
+	}
+}
@@ -29,6 +29,7 @@ var (
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasPreBlocker         = AppModule{}
+	_ appmodule.HasEndBlocker         = AppModule{}
 	_ appmodule.HasServices           = AppModule{}
 	_ appmodule.HasMigrations         = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
@@ -149,3 +150,8 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 func (am AppModule) PreBlock(ctx context.Context) error {
 	return am.keeper.PreBlocker(ctx)
 }
+
+// EndBlock calls the pre-upgrade handlers in the last block before an upgrade
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
+}
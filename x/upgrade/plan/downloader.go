@@ -142,6 +142,17 @@ func ValidateURL(urlStr string, mustChecksum bool) error {
 	return nil
 }
 
+// URLChecksum returns the checksum query parameter of the given url, e.g.
+// "sha256:<hex>", or an empty string if the url has no checksum.
+func URLChecksum(urlStr string) (string, error) {
+	url, err := neturl.Parse(urlStr)
+	if err != nil {
+		return "", err
+	}
+
+	return url.Query().Get("checksum"), nil
+}
+
 // getFile downloads the given url into the provided directory.
 func getFile(url, dst string) error {
 	httpGetter := &getter.HttpGetter{
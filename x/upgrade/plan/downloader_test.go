@@ -296,3 +296,16 @@ func (s *DownloaderTestSuite) TestDownloadURL() {
 		assert.Contains(t, err.Error(), "no content returned")
 	})
 }
+
+func (s *DownloaderTestSuite) TestURLChecksum() {
+	checksum, err := URLChecksum("https://example.com/simd?checksum=sha256:2c22e34510bd1d4ad2343cdc54f7165bccf30caef73f39af7dd1db2795a3da48")
+	s.Require().NoError(err)
+	s.Require().Equal("sha256:2c22e34510bd1d4ad2343cdc54f7165bccf30caef73f39af7dd1db2795a3da48", checksum)
+
+	checksum, err = URLChecksum("https://example.com/simd")
+	s.Require().NoError(err)
+	s.Require().Empty(checksum)
+
+	_, err = URLChecksum("https://example.com/%zz")
+	s.Require().Error(err)
+}
@@ -76,6 +76,12 @@ func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 	return &planInfo, nil
 }
 
+// IsInlineInfo returns true if the given Plan.Info string is the json Info
+// itself, as opposed to a url to it or any other application specific info.
+func IsInlineInfo(infoStr string) bool {
+	return strings.HasPrefix(strings.TrimSpace(infoStr), "{")
+}
+
 // ValidateFull does all possible validation of this Info.
 // The provided daemonName is the name of the executable file expected in all downloaded directories.
 // It checks that:
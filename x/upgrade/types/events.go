@@ -0,0 +1,12 @@
+package types
+
+// upgrade module events
+const (
+	EventTypeScheduleUpgrade   = "schedule_upgrade"
+	EventTypeCancelUpgrade     = "cancel_upgrade"
+	EventTypeRescheduleUpgrade = "reschedule_upgrade"
+
+	AttributeKeyName      = "name"
+	AttributeKeyHeight    = "height"
+	AttributeKeyOldHeight = "old_height"
+)
@@ -25,3 +25,15 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx context.Context, plan Plan, fromVM appmodule.VersionMap) (appmodule.VersionMap, error)
+
+// PreUpgradeHandler is an extension interface that modules can implement to
+// prepare for a scheduled upgrade, e.g. by flushing caches or emitting final
+// snapshots of their state.
+//
+// PreUpgrade is called by the upgrade keeper in the EndBlocker of the last block
+// before the upgrade height, i.e. the last block executed by the binary being
+// replaced, unless the upgrade is skipped at that height. Returning an error
+// does not halt the chain, the changes of the handler are discarded instead.
+type PreUpgradeHandler interface {
+	PreUpgrade(ctx context.Context, plan Plan) error
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -484,6 +485,325 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryPlanBinariesRequest is the request type for the Query/PlanBinaries RPC
+// method.
+type QueryPlanBinariesRequest struct {
+}
+
+func (m *QueryPlanBinariesRequest) Reset()         { *m = QueryPlanBinariesRequest{} }
+func (m *QueryPlanBinariesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanBinariesRequest) ProtoMessage()    {}
+func (*QueryPlanBinariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryPlanBinariesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanBinariesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanBinariesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanBinariesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanBinariesRequest.Merge(m, src)
+}
+func (m *QueryPlanBinariesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanBinariesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanBinariesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanBinariesRequest proto.InternalMessageInfo
+
+// QueryPlanBinariesResponse is the response type for the Query/PlanBinaries RPC
+// method.
+type QueryPlanBinariesResponse struct {
+	// plan is the current upgrade plan.
+	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// binaries are the binaries listed in the info of the current upgrade plan,
+	// sorted by platform. It is empty if the plan info does not list binaries
+	// inline, e.g. if it is a url to the info.
+	Binaries []*PlanBinary `protobuf:"bytes,2,rep,name=binaries,proto3" json:"binaries,omitempty"`
+}
+
+func (m *QueryPlanBinariesResponse) Reset()         { *m = QueryPlanBinariesResponse{} }
+func (m *QueryPlanBinariesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanBinariesResponse) ProtoMessage()    {}
+func (*QueryPlanBinariesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryPlanBinariesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlanBinariesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlanBinariesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlanBinariesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanBinariesResponse.Merge(m, src)
+}
+func (m *QueryPlanBinariesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlanBinariesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanBinariesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanBinariesResponse proto.InternalMessageInfo
+
+func (m *QueryPlanBinariesResponse) GetPlan() *Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *QueryPlanBinariesResponse) GetBinaries() []*PlanBinary {
+	if m != nil {
+		return m.Binaries
+	}
+	return nil
+}
+
+// PlanBinary defines a binary of an upgrade plan.
+type PlanBinary struct {
+	// platform is the os/arch the binary is built for (e.g. linux/amd64), or "any".
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// url is the url the binary can be downloaded from.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// checksum is the checksum of the binary, from the checksum query parameter
+	// of its url (e.g. sha256:<hex>). It is empty if the url has no checksum.
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *PlanBinary) Reset()         { *m = PlanBinary{} }
+func (m *PlanBinary) String() string { return proto.CompactTextString(m) }
+func (*PlanBinary) ProtoMessage()    {}
+func (*PlanBinary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *PlanBinary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlanBinary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlanBinary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlanBinary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanBinary.Merge(m, src)
+}
+func (m *PlanBinary) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlanBinary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanBinary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanBinary proto.InternalMessageInfo
+
+func (m *PlanBinary) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *PlanBinary) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *PlanBinary) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+// QueryUpgradeHistoryRequest is the request type for the Query/UpgradeHistory
+// RPC method.
+type QueryUpgradeHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUpgradeHistoryRequest) Reset()         { *m = QueryUpgradeHistoryRequest{} }
+func (m *QueryUpgradeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeHistoryRequest) ProtoMessage()    {}
+func (*QueryUpgradeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{13}
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeHistoryRequest.Merge(m, src)
+}
+func (m *QueryUpgradeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUpgradeHistoryResponse is the response type for the Query/UpgradeHistory
+// RPC method.
+type QueryUpgradeHistoryResponse struct {
+	// upgrades are the applied upgrades, ordered by height.
+	Upgrades []*AppliedUpgrade `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUpgradeHistoryResponse) Reset()         { *m = QueryUpgradeHistoryResponse{} }
+func (m *QueryUpgradeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeHistoryResponse) ProtoMessage()    {}
+func (*QueryUpgradeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{14}
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeHistoryResponse.Merge(m, src)
+}
+func (m *QueryUpgradeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeHistoryResponse) GetUpgrades() []*AppliedUpgrade {
+	if m != nil {
+		return m.Upgrades
+	}
+	return nil
+}
+
+func (m *QueryUpgradeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// AppliedUpgrade defines an upgrade applied on chain.
+type AppliedUpgrade struct {
+	// name is the name of the applied upgrade plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// height is the height at which the upgrade was applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AppliedUpgrade) Reset()         { *m = AppliedUpgrade{} }
+func (m *AppliedUpgrade) String() string { return proto.CompactTextString(m) }
+func (*AppliedUpgrade) ProtoMessage()    {}
+func (*AppliedUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{15}
+}
+func (m *AppliedUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppliedUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppliedUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppliedUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppliedUpgrade.Merge(m, src)
+}
+func (m *AppliedUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppliedUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppliedUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppliedUpgrade proto.InternalMessageInfo
+
+func (m *AppliedUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AppliedUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -495,6 +815,12 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryPlanBinariesRequest)(nil), "cosmos.upgrade.v1beta1.QueryPlanBinariesRequest")
+	proto.RegisterType((*QueryPlanBinariesResponse)(nil), "cosmos.upgrade.v1beta1.QueryPlanBinariesResponse")
+	proto.RegisterType((*PlanBinary)(nil), "cosmos.upgrade.v1beta1.PlanBinary")
+	proto.RegisterType((*QueryUpgradeHistoryRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeHistoryRequest")
+	proto.RegisterType((*QueryUpgradeHistoryResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeHistoryResponse")
+	proto.RegisterType((*AppliedUpgrade)(nil), "cosmos.upgrade.v1beta1.AppliedUpgrade")
 }
 
 func init() {
@@ -502,50 +828,68 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
-	0x1c, 0x65, 0x0a, 0x5f, 0xf8, 0xf2, 0xa9, 0x01, 0x33, 0xc4, 0xba, 0xac, 0xa4, 0xd6, 0x01, 0x15,
-	0x23, 0xdd, 0x85, 0xd6, 0x70, 0x40, 0x63, 0x14, 0x0e, 0x8a, 0x11, 0xa2, 0x35, 0x7a, 0xf0, 0xd2,
-	0x0c, 0xec, 0xa4, 0x34, 0xb4, 0xbb, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x3c, 0x70, 0xf2, 0x68, 0xe2,
-	0xcd, 0x83, 0x37, 0x13, 0xff, 0x00, 0xaf, 0xde, 0x8d, 0x27, 0xa2, 0x17, 0x63, 0x3c, 0x18, 0xf0,
-	0x0f, 0x31, 0x3b, 0x33, 0x25, 0xfd, 0xb1, 0xbb, 0xa0, 0xb7, 0xce, 0xce, 0x7b, 0xef, 0xf3, 0xde,
-	0xce, 0xbc, 0x2d, 0x90, 0x75, 0x8f, 0x37, 0x3d, 0x6e, 0x87, 0x7e, 0x2d, 0xa0, 0x0e, 0xb3, 0xb7,
-	0xe7, 0xd6, 0x98, 0xa0, 0x73, 0xf6, 0x56, 0xc8, 0x82, 0x1d, 0xcb, 0x0f, 0x3c, 0xe1, 0xe1, 0x9c,
-	0xc2, 0x58, 0x1a, 0x63, 0x69, 0x8c, 0x39, 0x51, 0xf3, 0xbc, 0x5a, 0x83, 0xd9, 0xd4, 0xaf, 0xdb,
-	0xd4, 0x75, 0x3d, 0x41, 0x45, 0xdd, 0x73, 0xb9, 0x62, 0x99, 0x53, 0x09, 0xca, 0x2d, 0x15, 0x85,
-	0x1a, 0x57, 0xa8, 0xaa, 0x5c, 0xd9, 0x7a, 0x90, 0x5c, 0x90, 0x71, 0x38, 0xff, 0x38, 0x72, 0xb1,
-	0x14, 0x06, 0x01, 0x73, 0xc5, 0xa3, 0x06, 0x75, 0x2b, 0x6c, 0x2b, 0x64, 0x5c, 0x90, 0x87, 0x60,
-	0xf4, 0x6e, 0x71, 0xdf, 0x73, 0x39, 0xc3, 0xb3, 0x30, 0xe0, 0x37, 0xa8, 0x6b, 0xa0, 0x02, 0x9a,
-	0xce, 0x96, 0x26, 0xac, 0x78, 0xf3, 0x96, 0xe4, 0x48, 0x24, 0x29, 0xea, 0x41, 0x77, 0x7d, 0xbf,
-	0x51, 0x67, 0x4e, 0xdb, 0x20, 0x8c, 0x61, 0xc0, 0xa5, 0x4d, 0x26, 0xc5, 0x86, 0x2b, 0xf2, 0x37,
-	0x29, 0xe9, 0xe1, 0x1d, 0x70, 0x3d, 0x3c, 0x07, 0x83, 0x1b, 0xac, 0x5e, 0xdb, 0x10, 0x92, 0xd1,
-	0x5f, 0xd1, 0x2b, 0xb2, 0x0c, 0x44, 0x72, 0x9e, 0x2a, 0x17, 0xce, 0x52, 0x84, 0x76, 0x79, 0xc8,
-	0x9f, 0x08, 0x2a, 0x58, 0x6b, 0xda, 0x45, 0xc8, 0x36, 0x28, 0x17, 0xd5, 0x0e, 0x09, 0x88, 0x1e,
-	0xdd, 0x97, 0x4f, 0x16, 0x32, 0x06, 0x22, 0x2f, 0x61, 0x32, 0x55, 0x4a, 0x3b, 0x59, 0x01, 0x43,
-	0x47, 0x76, 0xaa, 0xeb, 0x2d, 0x48, 0x95, 0x47, 0x18, 0x23, 0x53, 0x40, 0xd3, 0x67, 0x16, 0xc7,
-	0x7e, 0x7c, 0x2c, 0x8e, 0xaa, 0xb7, 0x53, 0xe4, 0xce, 0x66, 0x61, 0xd6, 0xba, 0x51, 0xae, 0xe4,
-	0xc2, 0x58, 0xd9, 0x68, 0xf2, 0x83, 0x81, 0xff, 0xd1, 0xd9, 0x0c, 0xa9, 0x80, 0x29, 0xe7, 0xaf,
-	0x78, 0x4e, 0xd8, 0x60, 0xcf, 0x58, 0xc0, 0xa3, 0x43, 0x6f, 0x8b, 0xd0, 0x94, 0x1b, 0xd5, 0xb6,
-	0xf7, 0x06, 0xea, 0xd1, 0x2a, 0x6d, 0xb2, 0x85, 0xb1, 0xaf, 0xbd, 0x53, 0xc9, 0x3e, 0x82, 0x0b,
-	0xb1, 0xa2, 0x3a, 0xcc, 0x2a, 0x8c, 0x6a, 0xd5, 0x6d, 0xbd, 0x65, 0xa0, 0x42, 0xff, 0x74, 0xb6,
-	0x74, 0x39, 0xe9, 0x78, 0x3b, 0x84, 0x2a, 0x23, 0xcd, 0x0e, 0xdd, 0x78, 0x13, 0x33, 0x70, 0x4e,
-	0x9d, 0x6b, 0x28, 0x36, 0xbc, 0xa0, 0x2e, 0x76, 0x74, 0xa6, 0x38, 0xf4, 0x3c, 0xb9, 0x07, 0xb9,
-	0x6e, 0xb4, 0x36, 0x6b, 0xc0, 0x10, 0x75, 0x9c, 0x80, 0x71, 0xae, 0xe3, 0xb7, 0x96, 0xb1, 0x42,
-	0xa5, 0xb7, 0x43, 0xf0, 0x9f, 0x54, 0xc2, 0xef, 0x10, 0x64, 0xdb, 0x6e, 0x34, 0xb6, 0x93, 0xc2,
-	0x25, 0xd4, 0xc2, 0x9c, 0x3d, 0x3d, 0x41, 0x79, 0x25, 0x33, 0xfb, 0xdf, 0x7e, 0xbf, 0xc9, 0x5c,
-	0xc1, 0x53, 0x76, 0x42, 0x5b, 0xd7, 0x15, 0xa9, 0x1a, 0x15, 0x05, 0xbf, 0x47, 0x90, 0x6d, 0xbb,
-	0xf5, 0x27, 0x18, 0xec, 0xad, 0xd3, 0x09, 0x06, 0x63, 0x0a, 0x45, 0xca, 0xd2, 0x60, 0x11, 0x5f,
-	0x4f, 0x32, 0x48, 0x15, 0x49, 0x1a, 0xb4, 0x77, 0xa3, 0x4b, 0xb7, 0x87, 0x7f, 0x22, 0xc8, 0xc5,
-	0xd7, 0x03, 0x2f, 0xa4, 0x3a, 0x48, 0xad, 0xa7, 0x79, 0xf3, 0x9f, 0xb8, 0x3a, 0xc8, 0xb2, 0x0c,
-	0x72, 0x07, 0xdf, 0xb6, 0xd3, 0xbf, 0x8b, 0x3d, 0x6d, 0xb5, 0x77, 0xdb, 0xbe, 0x09, 0x7b, 0xaf,
-	0x32, 0x08, 0x7f, 0x42, 0x30, 0xd2, 0x59, 0x14, 0x5c, 0x4a, 0xb5, 0x16, 0x5b, 0x55, 0xb3, 0xfc,
-	0x57, 0x1c, 0x1d, 0x63, 0xf1, 0x4b, 0x6f, 0x73, 0x64, 0xb2, 0x6b, 0xf8, 0x6a, 0x52, 0xb2, 0xae,
-	0xea, 0xe2, 0x0f, 0x08, 0x86, 0x8f, 0x6b, 0x83, 0x8b, 0xe9, 0x77, 0xa2, 0xab, 0x8c, 0xa6, 0x75,
-	0x5a, 0xb8, 0x36, 0x7c, 0xab, 0xd7, 0xf0, 0xbc, 0x34, 0x3c, 0x89, 0x2f, 0x25, 0xde, 0xa9, 0x96,
-	0xca, 0xe2, 0xfc, 0xe7, 0xc3, 0x3c, 0x3a, 0x38, 0xcc, 0xa3, 0x5f, 0x87, 0x79, 0xf4, 0xfa, 0x28,
-	0xdf, 0x77, 0x70, 0x94, 0xef, 0xfb, 0x7e, 0x94, 0xef, 0x7b, 0x3e, 0xa1, 0xb8, 0xdc, 0xd9, 0xb4,
-	0xea, 0x9e, 0xfd, 0xe2, 0x58, 0x43, 0xec, 0xf8, 0x8c, 0xaf, 0x0d, 0xca, 0xbf, 0xb0, 0xf2, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x0d, 0x4f, 0xe3, 0x5f, 0x07, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x6c, 0x42, 0x49, 0xde, 0x56, 0x69, 0x34, 0x85, 0xc5, 0x31, 0xd1, 0xb2, 0xb8, 0x6d,
+	0x12, 0xa0, 0x6b, 0x6f, 0x76, 0x51, 0x0e, 0x01, 0x55, 0x74, 0x23, 0xd1, 0x16, 0xd1, 0x0a, 0x8c,
+	0xe0, 0xc0, 0xc5, 0x9a, 0xac, 0x87, 0x5d, 0x2b, 0x5e, 0xdb, 0xf5, 0xd8, 0x11, 0xa1, 0x2a, 0x87,
+	0x9e, 0x38, 0x22, 0xc1, 0x99, 0x0b, 0x42, 0xe2, 0x07, 0x54, 0xdc, 0x40, 0x1c, 0x51, 0x4f, 0x55,
+	0xb9, 0x20, 0xc4, 0x01, 0x25, 0xfc, 0x10, 0xe4, 0x99, 0xb1, 0xeb, 0xcd, 0xda, 0xce, 0x26, 0xb7,
+	0x1d, 0xfb, 0x7d, 0xdf, 0xfb, 0xbe, 0xe7, 0x37, 0xef, 0x2d, 0x68, 0x03, 0x9f, 0x8d, 0x7d, 0x66,
+	0xc4, 0xc1, 0x30, 0x24, 0x36, 0x35, 0x0e, 0xb6, 0xf6, 0x68, 0x44, 0xb6, 0x8c, 0xfb, 0x31, 0x0d,
+	0x0f, 0xf5, 0x20, 0xf4, 0x23, 0x1f, 0x37, 0x44, 0x8c, 0x2e, 0x63, 0x74, 0x19, 0xa3, 0xae, 0x0d,
+	0x7d, 0x7f, 0xe8, 0x52, 0x83, 0x04, 0x8e, 0x41, 0x3c, 0xcf, 0x8f, 0x48, 0xe4, 0xf8, 0x1e, 0x13,
+	0x28, 0xf5, 0x6a, 0x09, 0x73, 0xca, 0x22, 0xa2, 0x56, 0x45, 0x94, 0xc5, 0x4f, 0x86, 0x4c, 0x24,
+	0x5e, 0xbd, 0x29, 0x09, 0xf6, 0x08, 0xa3, 0x42, 0x4f, 0xc6, 0x11, 0x90, 0xa1, 0xe3, 0xf1, 0x6c,
+	0x22, 0x56, 0x5b, 0x85, 0x57, 0x3e, 0x4e, 0x22, 0x76, 0xe3, 0x30, 0xa4, 0x5e, 0xf4, 0x91, 0x4b,
+	0x3c, 0x93, 0xde, 0x8f, 0x29, 0x8b, 0xb4, 0x0f, 0x41, 0x99, 0x7e, 0xc5, 0x02, 0xdf, 0x63, 0x14,
+	0x77, 0x60, 0x21, 0x70, 0x89, 0xa7, 0xa0, 0x16, 0xda, 0xac, 0x77, 0xd7, 0xf4, 0x62, 0xa3, 0x3a,
+	0xc7, 0xf0, 0x48, 0xad, 0x2d, 0x13, 0xdd, 0x0c, 0x02, 0xd7, 0xa1, 0x76, 0x2e, 0x11, 0xc6, 0xb0,
+	0xe0, 0x91, 0x31, 0xe5, 0x64, 0x4b, 0x26, 0xff, 0xad, 0x75, 0x41, 0x99, 0x0e, 0x97, 0xc9, 0x1b,
+	0x70, 0x61, 0x44, 0x9d, 0xe1, 0x28, 0xe2, 0x88, 0x79, 0x53, 0x9e, 0xb4, 0x3b, 0xa0, 0x71, 0xcc,
+	0xa7, 0x42, 0x85, 0xbd, 0x9b, 0x44, 0x7b, 0x2c, 0x66, 0x9f, 0x44, 0x24, 0xa2, 0x69, 0xb6, 0xd7,
+	0xa0, 0xee, 0x12, 0x16, 0x59, 0x13, 0x14, 0x90, 0x3c, 0xba, 0xcd, 0x9f, 0xec, 0xd4, 0x14, 0xa4,
+	0x7d, 0x0d, 0x57, 0x2a, 0xa9, 0xa4, 0x92, 0xbb, 0xa0, 0x48, 0xcb, 0xb6, 0x35, 0x48, 0x43, 0x2c,
+	0x96, 0xc4, 0x28, 0xb5, 0x16, 0xda, 0xbc, 0xd8, 0xbf, 0xfc, 0xf7, 0xe3, 0xf6, 0x25, 0x51, 0x9d,
+	0x36, 0xb3, 0xf7, 0x5b, 0x1d, 0xfd, 0xed, 0x9e, 0xd9, 0x88, 0x0b, 0x69, 0x93, 0xcc, 0x1f, 0x2c,
+	0x2c, 0xa2, 0x95, 0x9a, 0x66, 0x82, 0xca, 0xf3, 0xdf, 0xf5, 0xed, 0xd8, 0xa5, 0x9f, 0xd1, 0x90,
+	0x25, 0x0d, 0x92, 0xb3, 0x30, 0xe6, 0x2f, 0xac, 0x5c, 0xdd, 0x40, 0x3c, 0xba, 0x47, 0xc6, 0x74,
+	0xe7, 0xf2, 0xb3, 0xe9, 0xac, 0xda, 0x23, 0x04, 0xaf, 0x16, 0x92, 0x4a, 0x33, 0xf7, 0xe0, 0x92,
+	0x64, 0x3d, 0x90, 0xaf, 0x14, 0xd4, 0x9a, 0xdf, 0xac, 0x77, 0xaf, 0x95, 0x7d, 0xde, 0x09, 0x22,
+	0x73, 0x79, 0x3c, 0xc1, 0x5b, 0x2c, 0xe2, 0x3a, 0xbc, 0x2c, 0xbe, 0x6b, 0x1c, 0x8d, 0xfc, 0xd0,
+	0x89, 0x0e, 0xa5, 0xa7, 0xa2, 0xe8, 0x6d, 0xed, 0x16, 0x34, 0x4e, 0x46, 0x4b, 0xb1, 0x0a, 0xbc,
+	0x48, 0x6c, 0x3b, 0xa4, 0x8c, 0x49, 0xfb, 0xe9, 0xb1, 0x98, 0xa8, 0x23, 0xdb, 0x29, 0xe9, 0xa3,
+	0xbe, 0xe3, 0x91, 0xd0, 0xa1, 0x69, 0x35, 0x77, 0x5e, 0x7a, 0xf6, 0xb8, 0xbd, 0xf2, 0x65, 0x7a,
+	0xbd, 0x5a, 0x07, 0x1d, 0xbd, 0xab, 0x77, 0xb4, 0x1f, 0x11, 0xac, 0x16, 0x40, 0xce, 0xdb, 0xff,
+	0xf8, 0x06, 0x2c, 0xee, 0x49, 0x16, 0xa5, 0xc6, 0xcb, 0xaa, 0x55, 0xa1, 0x78, 0xc6, 0x43, 0x33,
+	0xc3, 0x94, 0xa8, 0x74, 0x01, 0x9e, 0x47, 0x63, 0x15, 0x16, 0x03, 0x97, 0x44, 0x5f, 0xf8, 0xe1,
+	0x58, 0x56, 0x25, 0x3b, 0xe3, 0x15, 0x98, 0x8f, 0x43, 0x97, 0x77, 0xe5, 0x92, 0x99, 0xfc, 0x4c,
+	0xa2, 0x07, 0x23, 0x3a, 0xd8, 0x67, 0xf1, 0x58, 0x99, 0x17, 0xd1, 0xe9, 0xb9, 0x24, 0xdb, 0x57,
+	0xa0, 0xe6, 0x6f, 0xc5, 0x6d, 0x87, 0x45, 0x7e, 0x98, 0x7e, 0x41, 0xfc, 0x3e, 0xc0, 0xf3, 0xf1,
+	0x22, 0x2b, 0xb3, 0x9e, 0x7a, 0x4c, 0x66, 0x91, 0x2e, 0x66, 0x63, 0x66, 0x93, 0x0c, 0xd3, 0x4b,
+	0x69, 0xe6, 0x90, 0x25, 0xb9, 0x7f, 0x4f, 0xbb, 0xf7, 0x64, 0x72, 0xf9, 0x45, 0xfa, 0xb0, 0x28,
+	0x11, 0x69, 0xdb, 0xae, 0x97, 0xd5, 0x57, 0xce, 0x14, 0x49, 0x64, 0x66, 0x38, 0x7c, 0x6b, 0xc2,
+	0x41, 0x8d, 0x3b, 0xd8, 0x38, 0xd5, 0x81, 0x10, 0x30, 0x83, 0x05, 0x13, 0x96, 0x27, 0x53, 0x17,
+	0x4d, 0xbe, 0xdc, 0x74, 0xab, 0xe5, 0xa7, 0x5b, 0x31, 0x67, 0xf7, 0x7b, 0x80, 0x17, 0x78, 0x59,
+	0xf0, 0x0f, 0x08, 0xea, 0xb9, 0x51, 0x8d, 0x8d, 0x32, 0xfb, 0x25, 0xf3, 0x5e, 0xed, 0xcc, 0x0e,
+	0x10, 0x96, 0xb5, 0xeb, 0x8f, 0xfe, 0xfc, 0xef, 0xbb, 0xda, 0x3a, 0xbe, 0x6a, 0x94, 0xac, 0xac,
+	0x81, 0x00, 0x59, 0xfc, 0x06, 0xfc, 0x84, 0xa0, 0x9e, 0x1b, 0xe7, 0xa7, 0x08, 0x9c, 0xde, 0x13,
+	0x6a, 0x67, 0x76, 0x80, 0x14, 0xd8, 0xe3, 0x02, 0xdb, 0xf8, 0xad, 0x32, 0x81, 0x44, 0x80, 0xb8,
+	0x40, 0xe3, 0x41, 0x52, 0xff, 0x87, 0xf8, 0x1f, 0x04, 0x8d, 0xe2, 0xb9, 0x8f, 0x77, 0x2a, 0x15,
+	0x54, 0xee, 0x1d, 0xf5, 0x9d, 0x73, 0x61, 0xa5, 0x91, 0x3b, 0xdc, 0xc8, 0x7b, 0xf8, 0x86, 0x51,
+	0xfd, 0xe7, 0x60, 0x6a, 0x0d, 0x19, 0x0f, 0x72, 0xcb, 0xee, 0xe1, 0x37, 0x35, 0x84, 0x7f, 0x45,
+	0xb0, 0x3c, 0xb9, 0x01, 0x70, 0xb7, 0x52, 0x5a, 0xe1, 0x0e, 0x52, 0x7b, 0x67, 0xc2, 0x48, 0x1b,
+	0xfd, 0x27, 0xd3, 0x2b, 0x81, 0x3b, 0x7b, 0x03, 0x6f, 0x94, 0x39, 0x3b, 0xb1, 0x93, 0xf0, 0xcf,
+	0x08, 0x96, 0xb2, 0x7d, 0x80, 0xdb, 0xd5, 0x3d, 0x71, 0x62, 0xcb, 0xa8, 0xfa, 0xac, 0xe1, 0x52,
+	0xf0, 0xbb, 0xd3, 0x82, 0xb7, 0xb9, 0xe0, 0x2b, 0xf8, 0xf5, 0xd2, 0x9e, 0xca, 0xc4, 0xfd, 0x82,
+	0xe0, 0x62, 0x7e, 0x7d, 0xe0, 0xea, 0x0e, 0x2e, 0x58, 0x4e, 0xea, 0xd6, 0x19, 0x10, 0x52, 0xf3,
+	0xcd, 0x27, 0x05, 0x83, 0x82, 0x8b, 0xde, 0xc0, 0xd7, 0xca, 0x44, 0x27, 0x17, 0xc0, 0x4a, 0x97,
+	0x0d, 0xfe, 0x0d, 0xc1, 0xf2, 0xe4, 0x9c, 0x3d, 0xa5, 0x47, 0x0a, 0x37, 0x82, 0xda, 0x3b, 0x13,
+	0x46, 0xca, 0xdf, 0x2d, 0x95, 0x5f, 0xd1, 0x24, 0xf2, 0x6c, 0x8d, 0x04, 0x59, 0x7f, 0xfb, 0x8f,
+	0xa3, 0x26, 0x7a, 0x7a, 0xd4, 0x44, 0xff, 0x1e, 0x35, 0xd1, 0xb7, 0xc7, 0xcd, 0xb9, 0xa7, 0xc7,
+	0xcd, 0xb9, 0xbf, 0x8e, 0x9b, 0x73, 0x9f, 0xaf, 0x09, 0x06, 0x66, 0xef, 0xeb, 0x8e, 0x6f, 0x64,
+	0x79, 0x8c, 0xe8, 0x30, 0xa0, 0x6c, 0xef, 0x02, 0xff, 0x57, 0xdc, 0xfb, 0x7f, 0x00, 0xf7, 0x47,
+	0x1f, 0xc8, 0xde, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -575,6 +919,13 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// PlanBinaries queries the current upgrade plan along with the binaries listed
+	// in its info and their checksums, so that downloaded binaries can be verified
+	// against on-chain data.
+	PlanBinaries(ctx context.Context, in *QueryPlanBinariesRequest, opts ...grpc.CallOption) (*QueryPlanBinariesResponse, error)
+	// UpgradeHistory queries the upgrades applied on chain along with the height
+	// at which they were applied, ordered by height.
+	UpgradeHistory(ctx context.Context, in *QueryUpgradeHistoryRequest, opts ...grpc.CallOption) (*QueryUpgradeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -631,6 +982,24 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) PlanBinaries(ctx context.Context, in *QueryPlanBinariesRequest, opts ...grpc.CallOption) (*QueryPlanBinariesResponse, error) {
+	out := new(QueryPlanBinariesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/PlanBinaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeHistory(ctx context.Context, in *QueryUpgradeHistoryRequest, opts ...grpc.CallOption) (*QueryUpgradeHistoryResponse, error) {
+	out := new(QueryUpgradeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -648,6 +1017,13 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// PlanBinaries queries the current upgrade plan along with the binaries listed
+	// in its info and their checksums, so that downloaded binaries can be verified
+	// against on-chain data.
+	PlanBinaries(context.Context, *QueryPlanBinariesRequest) (*QueryPlanBinariesResponse, error)
+	// UpgradeHistory queries the upgrades applied on chain along with the height
+	// at which they were applied, ordered by height.
+	UpgradeHistory(context.Context, *QueryUpgradeHistoryRequest) (*QueryUpgradeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -669,6 +1045,12 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) PlanBinaries(ctx context.Context, req *QueryPlanBinariesRequest) (*QueryPlanBinariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanBinaries not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeHistory(ctx context.Context, req *QueryUpgradeHistoryRequest) (*QueryUpgradeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -764,6 +1146,42 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PlanBinaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanBinariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlanBinaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/PlanBinaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlanBinaries(ctx, req.(*QueryPlanBinariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeHistory(ctx, req.(*QueryUpgradeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -788,6 +1206,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "PlanBinaries",
+			Handler:    _Query_PlanBinaries_Handler,
+		},
+		{
+			MethodName: "UpgradeHistory",
+			Handler:    _Query_UpgradeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1087,27 +1513,262 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPlanBinariesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCurrentPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryPlanBinariesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentPlanResponse) Size() (n int) {
+func (m *QueryPlanBinariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlanBinariesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanBinariesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanBinariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Binaries) > 0 {
+		for iNdEx := len(m.Binaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Binaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanBinary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanBinary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanBinary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Upgrades) > 0 {
+		for iNdEx := len(m.Upgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppliedUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppliedUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppliedUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentPlanResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1195,38 +1856,422 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryAuthorityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	return n
+}
+
+func (m *QueryAuthorityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanBinariesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPlanBinariesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Binaries) > 0 {
+		for _, e := range m.Binaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlanBinary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Upgrades) > 0 {
+		for _, e := range m.Upgrades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AppliedUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppliedPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppliedPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppliedPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppliedPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAuthorityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,12 +2294,31 @@ func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1276,7 +2340,7 @@ func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1299,17 +2363,17 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedConsensusState", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1319,26 +2383,24 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &Plan{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UpgradedConsensusState = append(m.UpgradedConsensusState[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpgradedConsensusState == nil {
+				m.UpgradedConsensusState = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1362,7 +2424,7 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,15 +2447,15 @@ func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppliedPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppliedPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1421,7 +2483,7 @@ func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1444,7 +2506,7 @@ func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1467,17 +2529,151 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppliedPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppliedPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, &ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1487,11 +2683,24 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1513,7 +2722,7 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPlanBinariesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1536,31 +2745,12 @@ func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPlanBinariesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPlanBinariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
-			}
-			m.LastHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1582,7 +2772,7 @@ func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPlanBinariesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1605,17 +2795,53 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPlanBinariesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPlanBinariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1625,24 +2851,24 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpgradedConsensusState = append(m.UpgradedConsensusState[:0], dAtA[iNdEx:postIndex]...)
-			if m.UpgradedConsensusState == nil {
-				m.UpgradedConsensusState = []byte{}
+			m.Binaries = append(m.Binaries, &PlanBinary{})
+			if err := m.Binaries[len(m.Binaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1666,7 +2892,7 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+func (m *PlanBinary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1689,15 +2915,15 @@ func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlanBinary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlanBinary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1725,7 +2951,71 @@ func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1748,7 +3038,7 @@ func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1771,15 +3061,15 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1806,8 +3096,10 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleVersions = append(m.ModuleVersions, &ModuleVersion{})
-			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1832,7 +3124,7 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1855,12 +3147,82 @@ func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthorityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upgrades = append(m.Upgrades, &AppliedUpgrade{})
+			if err := m.Upgrades[len(m.Upgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1882,7 +3244,7 @@ func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
+func (m *AppliedUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1905,15 +3267,15 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthorityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppliedUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppliedUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1941,8 +3303,27 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
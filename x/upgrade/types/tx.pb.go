@@ -206,44 +206,144 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgRescheduleUpgrade is the Msg/RescheduleUpgrade request type.
+type MsgRescheduleUpgrade struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// height is the new height at which the pending upgrade must be performed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgRescheduleUpgrade) Reset()         { *m = MsgRescheduleUpgrade{} }
+func (m *MsgRescheduleUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleUpgrade) ProtoMessage()    {}
+func (*MsgRescheduleUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgRescheduleUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRescheduleUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleUpgrade.Merge(m, src)
+}
+func (m *MsgRescheduleUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleUpgrade proto.InternalMessageInfo
+
+func (m *MsgRescheduleUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRescheduleUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgRescheduleUpgradeResponse is the Msg/RescheduleUpgrade response type.
+type MsgRescheduleUpgradeResponse struct {
+}
+
+func (m *MsgRescheduleUpgradeResponse) Reset()         { *m = MsgRescheduleUpgradeResponse{} }
+func (m *MsgRescheduleUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleUpgradeResponse) ProtoMessage()    {}
+func (*MsgRescheduleUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleUpgradeResponse.Merge(m, src)
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgRescheduleUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgRescheduleUpgrade")
+	proto.RegisterType((*MsgRescheduleUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgRescheduleUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0x3a, 0xc7, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x39, 0x5f, 0xda, 0xa2, 0xcb, 0x0f, 0xd1, 0xa1, 0x5b, 0x9c, 0x92, 0xad, 0x60, 0xa0,
-	0x67, 0x62, 0xd6, 0xf4, 0x7c, 0x83, 0x16, 0xc2, 0xfc, 0xae, 0xe7, 0x1b, 0xb4, 0x64, 0x11, 0x2a,
-	0xf4, 0x31, 0x5d, 0xae, 0x64, 0xc8, 0x25, 0x85, 0x29, 0x1a, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57,
-	0x9c, 0x6a, 0x25, 0x8c, 0xc5, 0x0a, 0xa5, 0x5e, 0x46, 0x2e, 0x01, 0xdf, 0xe2, 0x74, 0xe7, 0xc4,
-	0xbc, 0xe4, 0xd4, 0x1c, 0x0a, 0x43, 0xc0, 0xca, 0x91, 0x28, 0x4f, 0x48, 0xa3, 0x7a, 0x02, 0xc5,
-	0x6a, 0x25, 0x7d, 0x2e, 0x09, 0x74, 0x31, 0xbc, 0x1e, 0x30, 0x9a, 0xc1, 0xc4, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0xd4, 0xc6, 0xc8, 0xc5, 0x8f, 0x1e, 0x93, 0x5a, 0xb8, 0xe2, 0x00, 0x33, 0x94, 0xa4,
-	0x8c, 0x88, 0x57, 0x0b, 0x73, 0x90, 0x92, 0xf0, 0x29, 0x4c, 0x07, 0x09, 0x35, 0x30, 0x72, 0xf1,
-	0xa2, 0x06, 0xa7, 0x06, 0x1e, 0xa3, 0x51, 0x54, 0x4a, 0x19, 0x10, 0xab, 0x12, 0xaf, 0x13, 0xa4,
-	0x58, 0x1b, 0x40, 0x29, 0xcb, 0xc9, 0xec, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x64, 0x20, 0x3a, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0xe0, 0x79, 0xaa, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x3d, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x63,
-	0xb7, 0xaa, 0xa0, 0xdc, 0x03, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xbf, 0x6f, 0xda, 0x40,
+	0x14, 0xc7, 0xb9, 0xd2, 0x46, 0xe2, 0xaa, 0x2a, 0x89, 0x83, 0x52, 0xea, 0x52, 0x83, 0xac, 0x0e,
+	0x08, 0x95, 0x3b, 0xa0, 0x88, 0x81, 0x4e, 0x21, 0x52, 0x37, 0xa4, 0xca, 0x51, 0x97, 0x2e, 0x95,
+	0x83, 0xaf, 0x87, 0x15, 0xf0, 0x59, 0xbe, 0x83, 0x26, 0x5b, 0xd4, 0xa1, 0x43, 0xa5, 0x48, 0xfd,
+	0x33, 0x32, 0x55, 0x0c, 0xfc, 0x11, 0x51, 0x86, 0x2a, 0x62, 0xea, 0x54, 0x55, 0x30, 0xf0, 0x6f,
+	0x54, 0xf8, 0x0c, 0xc8, 0xb1, 0x83, 0x88, 0xb2, 0xf8, 0xc7, 0x7b, 0x9f, 0xf7, 0xe3, 0x7b, 0xef,
+	0xd9, 0x30, 0xd7, 0x66, 0xbc, 0xc7, 0x38, 0xee, 0xbb, 0xd4, 0x33, 0x2d, 0x82, 0x07, 0x95, 0x63,
+	0x22, 0xcc, 0x0a, 0x16, 0xa7, 0xc8, 0xf5, 0x98, 0x60, 0xca, 0xbe, 0x04, 0x50, 0x00, 0xa0, 0x00,
+	0x50, 0xd3, 0x94, 0x51, 0xe6, 0x23, 0x78, 0xfe, 0x24, 0x69, 0xf5, 0x85, 0xa4, 0x3f, 0x4b, 0x47,
+	0x10, 0x2a, 0x5d, 0xaf, 0xef, 0xa8, 0xb4, 0x48, 0x2c, 0xa9, 0xe7, 0x01, 0xd5, 0xe3, 0x14, 0x0f,
+	0x2a, 0xf3, 0x5b, 0xe0, 0xd8, 0x35, 0x7b, 0xb6, 0xc3, 0xb0, 0x7f, 0x95, 0x26, 0xfd, 0x37, 0x80,
+	0x4a, 0x8b, 0xd3, 0x23, 0xf6, 0x45, 0x7c, 0x35, 0x3d, 0xf2, 0x51, 0x26, 0x52, 0xea, 0x30, 0x65,
+	0xf6, 0x45, 0x87, 0x79, 0xb6, 0x38, 0xcb, 0x80, 0x3c, 0x28, 0xa4, 0x9a, 0x99, 0xf1, 0xa8, 0x94,
+	0x0e, 0xba, 0x39, 0xb0, 0x2c, 0x8f, 0x70, 0x7e, 0x24, 0x3c, 0xdb, 0xa1, 0xc6, 0x0a, 0x55, 0xde,
+	0xc1, 0xc7, 0x6e, 0xd7, 0x74, 0x32, 0x8f, 0xf2, 0xa0, 0xf0, 0xb4, 0x9a, 0x45, 0xf1, 0xc2, 0xd1,
+	0x87, 0xae, 0xe9, 0x34, 0x53, 0x57, 0x7f, 0x73, 0x89, 0xcb, 0xd9, 0xb0, 0x08, 0x0c, 0x3f, 0xa8,
+	0x71, 0x38, 0x1e, 0x95, 0xb6, 0x65, 0x44, 0x89, 0x5b, 0x27, 0xf9, 0x32, 0xaa, 0xd5, 0xbf, 0xcd,
+	0x86, 0xc5, 0x55, 0xfe, 0x1f, 0xb3, 0x61, 0xf1, 0xd5, 0x8a, 0xc0, 0xd1, 0xce, 0xf5, 0x0a, 0x54,
+	0xa3, 0x56, 0x83, 0x70, 0x97, 0x39, 0x9c, 0x34, 0xf6, 0x62, 0x4a, 0xe8, 0x17, 0x00, 0xee, 0xb4,
+	0x38, 0x3d, 0x34, 0x9d, 0x36, 0xe9, 0x3e, 0xf0, 0x04, 0x1a, 0x07, 0x1b, 0x89, 0x78, 0x19, 0x16,
+	0x11, 0x2a, 0xad, 0x63, 0x98, 0xb9, 0x6d, 0x5b, 0x2f, 0xe0, 0x17, 0x80, 0xe9, 0x16, 0xa7, 0x06,
+	0xe1, 0xed, 0x0e, 0xb1, 0xfa, 0xdd, 0x07, 0x8f, 0x71, 0x1f, 0x6e, 0x75, 0x88, 0x4d, 0x3b, 0xc2,
+	0x1f, 0x64, 0xd2, 0x08, 0xde, 0x1a, 0xef, 0xc7, 0xa3, 0xd2, 0xce, 0xe9, 0x62, 0xdd, 0xf2, 0x83,
+	0x32, 0xaa, 0xa2, 0x72, 0x54, 0x5d, 0x2e, 0xac, 0x2e, 0xd2, 0x97, 0x5e, 0x83, 0xd9, 0x38, 0xfb,
+	0x52, 0x65, 0x3a, 0xae, 0x4e, 0xf5, 0x32, 0x09, 0x93, 0x2d, 0x4e, 0x95, 0xef, 0x00, 0x6e, 0xdf,
+	0x5e, 0xd8, 0xe2, 0x5d, 0xab, 0x16, 0x5d, 0x06, 0xb5, 0xba, 0x39, 0xbb, 0xe8, 0x48, 0xdf, 0xbb,
+	0x8e, 0x9e, 0xbb, 0x72, 0x0e, 0xe0, 0xb3, 0xf0, 0xd6, 0x14, 0xd6, 0xa4, 0x0e, 0x91, 0x6a, 0x79,
+	0x53, 0x72, 0x7d, 0x0b, 0x17, 0x00, 0xee, 0x46, 0xe7, 0xfe, 0x66, 0x4d, 0xf2, 0x08, 0xad, 0xd6,
+	0xee, 0x43, 0x2f, 0xdb, 0x49, 0x5f, 0xc7, 0xcc, 0x48, 0x7d, 0x72, 0x3e, 0xff, 0xa0, 0x9b, 0xf5,
+	0xab, 0x89, 0x06, 0x6e, 0x26, 0x1a, 0xf8, 0x37, 0xd1, 0xc0, 0xcf, 0xa9, 0x96, 0xb8, 0x99, 0x6a,
+	0x89, 0x3f, 0x53, 0x2d, 0xf1, 0x29, 0x2b, 0x6b, 0x71, 0xeb, 0x04, 0xd9, 0x0c, 0x2f, 0xe3, 0xb1,
+	0x38, 0x73, 0x09, 0x3f, 0xde, 0xf2, 0xff, 0x4a, 0x6f, 0xff, 0x0f, 0x00, 0x4a, 0x96, 0x00, 0xaa,
+	0x53, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,6 +363,9 @@ type MsgClient interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade is a governance operation for moving a previously
+	// approved software upgrade to another height.
+	RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -291,6 +394,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error) {
+	out := new(MsgRescheduleUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/RescheduleUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -298,6 +410,9 @@ type MsgServer interface {
 	// CancelUpgrade is a governance operation for cancelling a previously
 	// approved software upgrade.
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade is a governance operation for moving a previously
+	// approved software upgrade to another height.
+	RescheduleUpgrade(context.Context, *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -310,6 +425,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) RescheduleUpgrade(ctx context.Context, req *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -351,6 +469,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RescheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescheduleUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/RescheduleUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, req.(*MsgRescheduleUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -363,6 +499,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "RescheduleUpgrade",
+			Handler:    _Msg_RescheduleUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -484,6 +624,64 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRescheduleUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -541,6 +739,31 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRescheduleUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgRescheduleUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -844,6 +1067,157 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRescheduleUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0